@@ -487,6 +487,20 @@ func (r *ApplicationSetReconciler) setApplicationSetStatusCondition(ctx context.
 func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Context, desiredApplications []argov1alpha1.Application, applicationSetInfo argov1alpha1.ApplicationSet) (map[string]error, error) {
 	errorsByApp := map[string]error{}
 	namesSet := map[string]bool{}
+	var appSetProject *argov1alpha1.AppProject
+	if projectName := applicationSetInfo.Spec.Project; projectName != "" {
+		appSetProject = &argov1alpha1.AppProject{}
+		if err := r.Get(ctx, types.NamespacedName{Name: projectName, Namespace: r.ArgoCDNamespace}, appSetProject); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+			// none of the generated applications may be validated without the project of the ApplicationSet
+			for i := range desiredApplications {
+				errorsByApp[desiredApplications[i].QualifiedName()] = fmt.Errorf("ApplicationSet references project %s which does not exist", projectName)
+			}
+			return errorsByApp, nil
+		}
+	}
 	for i := range desiredApplications {
 		app := &desiredApplications[i]
 		if namesSet[app.Name] {
@@ -504,6 +518,11 @@ func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Con
 			return nil, err
 		}
 
+		if appSetProject != nil && !appSetProject.IsApplicationSetTargetProjectPermitted(app.Spec.GetProject()) {
+			errorsByApp[app.QualifiedName()] = fmt.Errorf("application project %s is not allowed by project %s of the ApplicationSet", app.Spec.GetProject(), appSetProject.Name)
+			continue
+		}
+
 		if _, err = argoutil.GetDestinationCluster(ctx, app.Spec.Destination, r.ArgoDB); err != nil {
 			errorsByApp[app.QualifiedName()] = fmt.Errorf("application destination spec is invalid: %s", err.Error())
 			continue
@@ -2113,6 +2113,105 @@ func TestValidateGeneratedApplications(t *testing.T) {
 	}
 }
 
+func TestValidateGeneratedApplicationsTargetProjects(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	platform := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "platform",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.AppProjectSpec{ApplicationSetTargetProjects: []string{"team-*"}},
+	}
+	teamA := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: "argocd"}}
+	defaultProject := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"}}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(platform, teamA, defaultProject).Build()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-secret",
+			Namespace: "argocd",
+			Labels: map[string]string{
+				argocommon.LabelKeySecretType: argocommon.LabelValueSecretTypeCluster,
+			},
+		},
+		Data: map[string][]byte{
+			"name":   []byte("my-cluster"),
+			"server": []byte("https://kubernetes.default.svc"),
+			"config": []byte("{\"username\":\"foo\",\"password\":\"foo\"}"),
+		},
+	}
+	kubeclientset := getDefaultTestClientSet(secret)
+	argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+
+	r := ApplicationSetReconciler{
+		Client:          client,
+		Scheme:          scheme,
+		Recorder:        record.NewFakeRecorder(1),
+		Generators:      map[string]generators.Generator{},
+		ArgoDB:          argodb,
+		ArgoCDNamespace: "argocd",
+		KubeClientset:   kubeclientset,
+		Metrics:         appsetmetrics.NewFakeAppsetMetrics(),
+	}
+
+	newApp := func(name, project string) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1alpha1.ApplicationSpec{
+				Project: project,
+				Source: &v1alpha1.ApplicationSource{
+					RepoURL:        "https://url",
+					Path:           "/",
+					TargetRevision: "HEAD",
+				},
+				Destination: v1alpha1.ApplicationDestination{
+					Namespace: "namespace",
+					Name:      "my-cluster",
+				},
+			},
+		}
+	}
+	apps := []v1alpha1.Application{newApp("app-platform", "platform"), newApp("app-team-a", "team-a"), newApp("app-default", "default")}
+
+	t.Run("target projects are validated against the project of the ApplicationSet", func(t *testing.T) {
+		appSet := v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "appset",
+				Namespace: "argocd",
+			},
+			Spec: v1alpha1.ApplicationSetSpec{Project: "platform"},
+		}
+		validationErrors, err := r.validateGeneratedApplications(t.Context(), apps, appSet)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]error{
+			"app-default": errors.New("application project default is not allowed by project platform of the ApplicationSet"),
+		}, validationErrors)
+	})
+
+	t.Run("missing project of the ApplicationSet", func(t *testing.T) {
+		appSet := v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "appset",
+				Namespace: "argocd",
+			},
+			Spec: v1alpha1.ApplicationSetSpec{Project: "missing"},
+		}
+		validationErrors, err := r.validateGeneratedApplications(t.Context(), apps, appSet)
+		require.NoError(t, err)
+		assert.Len(t, validationErrors, 3)
+		assert.Equal(t, errors.New("ApplicationSet references project missing which does not exist"), validationErrors["app-team-a"])
+	})
+
+	t.Run("target projects are not validated without the annotation", func(t *testing.T) {
+		validationErrors, err := r.validateGeneratedApplications(t.Context(), apps, v1alpha1.ApplicationSet{})
+		require.NoError(t, err)
+		assert.Empty(t, validationErrors)
+	})
+}
+
 func TestReconcilerValidationProjectErrorBehaviour(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -73,7 +73,7 @@ func TestRequeueAfter(t *testing.T) {
 		"SCMProvider":             terminalGenerators["SCMProvider"],
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
-		"Matrix":                  generators.NewMatrixGenerator(terminalGenerators),
+		"Matrix":                  generators.NewMatrixGenerator(terminalGenerators, generators.DefaultMatrixMaxGenerators),
 		"Merge":                   generators.NewMergeGenerator(terminalGenerators),
 	}
 
@@ -84,7 +84,7 @@ func TestRequeueAfter(t *testing.T) {
 		"SCMProvider":             terminalGenerators["SCMProvider"],
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
-		"Matrix":                  generators.NewMatrixGenerator(nestedGenerators),
+		"Matrix":                  generators.NewMatrixGenerator(nestedGenerators, generators.DefaultMatrixMaxGenerators),
 		"Merge":                   generators.NewMergeGenerator(nestedGenerators),
 	}
 
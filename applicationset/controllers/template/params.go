@@ -0,0 +1,150 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"strconv"
+	"strings"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// applyParamsSchema validates the given params against the given schema, and returns the params with the defaults of
+// the missing params and with the values coerced to the types of the schema. The names of the params of Go templates
+// are paths in the nested params, while the params of fasttemplate are flat and their values are coerced to strings.
+// The given params are not modified.
+func applyParamsSchema(schema []argov1alpha1.ApplicationSetParam, params map[string]any, useGoTemplate bool) (map[string]any, error) {
+	if len(schema) == 0 {
+		return params, nil
+	}
+	result := maps.Clone(params)
+	if result == nil {
+		result = map[string]any{}
+	}
+	for _, param := range schema {
+		if param.Name == "" {
+			return nil, errors.New("param schema without name")
+		}
+		path := []string{param.Name}
+		if useGoTemplate {
+			path = strings.Split(param.Name, ".")
+		}
+		value, ok := getParam(result, path)
+		if !ok {
+			switch {
+			case param.Default != nil:
+				value = *param.Default
+			case param.Required:
+				return nil, fmt.Errorf("param '%s' missing", param.Name)
+			default:
+				continue
+			}
+		}
+		coerced, err := coerceParam(value, param.Type)
+		if err != nil {
+			return nil, fmt.Errorf("param '%s': %w", param.Name, err)
+		}
+		if !useGoTemplate {
+			if coerced, err = coerceParam(coerced, argov1alpha1.ApplicationSetParamTypeString); err != nil {
+				return nil, fmt.Errorf("param '%s': %w", param.Name, err)
+			}
+		}
+		setParam(result, path, coerced)
+	}
+	return result, nil
+}
+
+// getParam returns the value of the param at the given path of the given params. A null value is missing.
+func getParam(params map[string]any, path []string) (any, bool) {
+	var current any = params
+	for _, key := range path {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return current, current != nil
+}
+
+// setParam sets the value of the param at the given path of the given params, cloning the nested params along the path
+// so that the params shared with other elements are not modified
+func setParam(params map[string]any, path []string, value any) {
+	current := params
+	for _, key := range path[:len(path)-1] {
+		nested, ok := current[key].(map[string]any)
+		if ok {
+			nested = maps.Clone(nested)
+		} else {
+			nested = map[string]any{}
+		}
+		current[key] = nested
+		current = nested
+	}
+	current[path[len(path)-1]] = value
+}
+
+// coerceParam coerces the given scalar value to the given type. The value is returned as is if no type is given.
+func coerceParam(value any, paramType argov1alpha1.ApplicationSetParamType) (any, error) {
+	switch paramType {
+	case "":
+		return value, nil
+	case argov1alpha1.ApplicationSetParamTypeString:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		case int:
+			return strconv.Itoa(v), nil
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		}
+	case argov1alpha1.ApplicationSetParamTypeInteger:
+		switch v := value.(type) {
+		case string:
+			if n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+				return n, nil
+			}
+		case int:
+			return int64(v), nil
+		case int64:
+			return v, nil
+		case float64:
+			if v == math.Trunc(v) && !math.IsInf(v, 0) {
+				return int64(v), nil
+			}
+		}
+	case argov1alpha1.ApplicationSetParamTypeNumber:
+		switch v := value.(type) {
+		case string:
+			if n, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+				return n, nil
+			}
+		case int:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+	case argov1alpha1.ApplicationSetParamTypeBoolean:
+		switch v := value.(type) {
+		case string:
+			if b, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+				return b, nil
+			}
+		case bool:
+			return v, nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown type %q", paramType)
+	}
+	return nil, fmt.Errorf("cannot coerce %#v to %s", value, paramType)
+}
@@ -0,0 +1,119 @@
+package template
+
+import (
+	"maps"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	genmock "github.com/argoproj/argo-cd/v3/applicationset/generators/mocks"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestApplyParamsSchema(t *testing.T) {
+	schema := []v1alpha1.ApplicationSetParam{
+		{Name: "replicas", Type: v1alpha1.ApplicationSetParamTypeInteger, Required: true},
+		{Name: "ratio", Type: v1alpha1.ApplicationSetParamTypeNumber, Default: ptr.To("0.5")},
+		{Name: "enabled", Type: v1alpha1.ApplicationSetParamTypeBoolean, Default: ptr.To("false")},
+		{Name: "cluster.name", Type: v1alpha1.ApplicationSetParamTypeString, Default: ptr.To("in-cluster")},
+		{Name: "optional"},
+	}
+
+	for _, c := range []struct {
+		name          string
+		params        map[string]any
+		useGoTemplate bool
+		expected      map[string]any
+		expectedError string
+	}{
+		{
+			name:          "Coerces the params of Go templates",
+			params:        map[string]any{"replicas": "3", "ratio": float64(2), "enabled": "true", "cluster": map[string]any{"name": "prod", "server": "https://prod"}},
+			useGoTemplate: true,
+			expected:      map[string]any{"replicas": int64(3), "ratio": float64(2), "enabled": true, "cluster": map[string]any{"name": "prod", "server": "https://prod"}},
+		},
+		{
+			name:          "Defaults the missing params of Go templates",
+			params:        map[string]any{"replicas": float64(1), "cluster": map[string]any{"server": "https://prod"}},
+			useGoTemplate: true,
+			expected:      map[string]any{"replicas": int64(1), "ratio": 0.5, "enabled": false, "cluster": map[string]any{"name": "in-cluster", "server": "https://prod"}},
+		},
+		{
+			name:     "Coerces the params of fasttemplate to strings",
+			params:   map[string]any{"replicas": " 3 ", "enabled": "True", "cluster.name": "prod"},
+			expected: map[string]any{"replicas": "3", "ratio": "0.5", "enabled": "true", "cluster.name": "prod"},
+		},
+		{
+			name:          "Missing required param",
+			params:        map[string]any{"replicas": nil},
+			expectedError: "param 'replicas' missing",
+		},
+		{
+			name:          "Invalid integer",
+			params:        map[string]any{"replicas": "three"},
+			expectedError: `param 'replicas': cannot coerce "three" to integer`,
+		},
+		{
+			name:          "Non integral number",
+			params:        map[string]any{"replicas": 1.5},
+			useGoTemplate: true,
+			expectedError: "param 'replicas': cannot coerce 1.5 to integer",
+		},
+		{
+			name:          "Non scalar value",
+			params:        map[string]any{"replicas": 1, "cluster": map[string]any{"name": []any{"a"}}},
+			useGoTemplate: true,
+			expectedError: "param 'cluster.name': cannot coerce []interface {}{\"a\"} to string",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			original := maps.Clone(c.params)
+			params, err := applyParamsSchema(schema, c.params, c.useGoTemplate)
+			if c.expectedError != "" {
+				require.EqualError(t, err, c.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, params)
+			// the generated params are not modified
+			assert.Equal(t, original, c.params)
+		})
+	}
+}
+
+func TestGenerateApplications_ParamsSchema(t *testing.T) {
+	generator := v1alpha1.ApplicationSetGenerator{List: &v1alpha1.ListGenerator{}}
+	generatorMock := genmock.Generator{}
+	generatorMock.On("GenerateParams", &generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+		Return([]map[string]any{{"name": "app1", "replicas": "2"}, {"name": "app2"}}, nil)
+	generatorMock.On("GetTemplate", &generator).Return(&v1alpha1.ApplicationSetTemplate{})
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{generator},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name:   "{{ .name }}",
+					Labels: map[string]string{"replicas": "{{ add .replicas 1 }}"},
+				},
+			},
+			ParamsSchema: []v1alpha1.ApplicationSetParam{{Name: "replicas", Type: v1alpha1.ApplicationSetParamTypeInteger, Required: true}},
+		},
+	}
+
+	apps, reason, err := GenerateApplications(log.NewEntry(log.StandardLogger()), appSet, map[string]generators.Generator{"List": &generatorMock}, &utils.Render{}, nil)
+	require.EqualError(t, err, "param 'replicas' missing for element 1")
+	assert.Equal(t, v1alpha1.ApplicationSetReasonParamsSchemaValidationError, string(reason))
+	require.Len(t, apps, 1)
+	assert.Equal(t, "app1", apps[0].Name)
+	assert.Equal(t, "3", apps[0].Labels["replicas"])
+}
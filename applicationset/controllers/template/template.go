@@ -34,7 +34,20 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 		for _, a := range t {
 			tmplApplication := GetTempApplication(a.Template)
 
-			for _, p := range a.Params {
+			for i, generatedParams := range a.Params {
+				p, err := applyParamsSchema(applicationSetInfo.Spec.ParamsSchema, generatedParams, applicationSetInfo.Spec.GoTemplate)
+				if err != nil {
+					err = fmt.Errorf("%w for element %d", err, i)
+					logCtx.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
+						Error("error validating params against the params schema")
+
+					if firstError == nil {
+						firstError = err
+						applicationSetReason = argov1alpha1.ApplicationSetReasonParamsSchemaValidationError
+					}
+					continue
+				}
+
 				app, err := renderer.RenderTemplateParams(tmplApplication, applicationSetInfo.Spec.SyncPolicy, p, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
 				if err != nil {
 					logCtx.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
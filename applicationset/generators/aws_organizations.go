@@ -0,0 +1,93 @@
+package generators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/aws_organizations"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*AWSOrganizationsGenerator)(nil)
+
+const (
+	DefaultAWSOrganizationsRequeueAfter = 30 * time.Minute
+)
+
+// AWSOrganizationsService lists the accounts of an AWS organization
+type AWSOrganizationsService interface {
+	ListAccounts(ctx context.Context) ([]*aws_organizations.Account, error)
+}
+
+// AWSOrganizationsGenerator generates a parameter set per account of an AWS organization
+type AWSOrganizationsGenerator struct {
+	newServiceFunc func(*argoprojiov1alpha1.AWSOrganizationsGenerator) (AWSOrganizationsService, error)
+}
+
+func NewAWSOrganizationsGenerator() Generator {
+	return &AWSOrganizationsGenerator{
+		newServiceFunc: func(generatorConfig *argoprojiov1alpha1.AWSOrganizationsGenerator) (AWSOrganizationsService, error) {
+			return aws_organizations.NewAWSOrganizationsService(generatorConfig.TagFilters, generatorConfig.Role, generatorConfig.Region)
+		},
+	}
+}
+
+func (g *AWSOrganizationsGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 30 minutes, if no default is specified.
+
+	if appSetGenerator.AWSOrganizations.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.AWSOrganizations.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultAWSOrganizationsRequeueAfter
+}
+
+func (g *AWSOrganizationsGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.AWSOrganizations.Template
+}
+
+func (g *AWSOrganizationsGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.AWSOrganizations == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	svc, err := g.newServiceFunc(appSetGenerator.AWSOrganizations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the AWS Organizations service: %w", err)
+	}
+	accounts, err := svc.ListAccounts(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error listing accounts: %w", err)
+	}
+
+	params := make([]map[string]any, 0, len(accounts))
+	for _, account := range accounts {
+		paramMap := map[string]any{
+			"accountId": account.ID,
+			"name":      account.Name,
+			"email":     account.Email,
+			"arn":       account.ARN,
+		}
+		if applicationSetInfo.Spec.GoTemplate {
+			paramMap["tags"] = account.Tags
+		} else {
+			for key, value := range account.Tags {
+				paramMap["tags."+key] = value
+			}
+		}
+
+		err := appendTemplatedValues(appSetGenerator.AWSOrganizations.Values, paramMap, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %w", err)
+		}
+		params = append(params, paramMap)
+	}
+	return params, nil
+}
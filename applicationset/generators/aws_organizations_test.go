@@ -0,0 +1,113 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/aws_organizations"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeAWSOrganizationsService struct {
+	accounts []*aws_organizations.Account
+	err      error
+}
+
+func (s *fakeAWSOrganizationsService) ListAccounts(_ context.Context) ([]*aws_organizations.Account, error) {
+	return s.accounts, s.err
+}
+
+func TestAWSOrganizationsGenerateParams(t *testing.T) {
+	accounts := []*aws_organizations.Account{
+		{
+			ID:    "222222222222",
+			Name:  "dev",
+			Email: "dev@example.com",
+			ARN:   "arn:aws:organizations::111111111111:account/o-example/222222222222",
+			Tags:  map[string]string{"env": "dev"},
+		},
+	}
+
+	cases := []struct {
+		name        string
+		goTemplate  bool
+		values      map[string]string
+		err         error
+		expected    []map[string]any
+		expectedErr string
+	}{
+		{
+			name:   "Fasttemplate",
+			values: map[string]string{"cluster": "{{name}}-cluster"},
+			expected: []map[string]any{
+				{
+					"accountId":      "222222222222",
+					"name":           "dev",
+					"email":          "dev@example.com",
+					"arn":            "arn:aws:organizations::111111111111:account/o-example/222222222222",
+					"tags.env":       "dev",
+					"values.cluster": "dev-cluster",
+				},
+			},
+		},
+		{
+			name:       "GoTemplate",
+			goTemplate: true,
+			values:     map[string]string{"cluster": "{{ .name }}-{{ .tags.env }}"},
+			expected: []map[string]any{
+				{
+					"accountId": "222222222222",
+					"name":      "dev",
+					"email":     "dev@example.com",
+					"arn":       "arn:aws:organizations::111111111111:account/o-example/222222222222",
+					"tags":      map[string]string{"env": "dev"},
+					"values":    map[string]string{"cluster": "dev-dev"},
+				},
+			},
+		},
+		{
+			name:        "Error",
+			err:         errors.New("access denied"),
+			expectedErr: "error listing accounts: access denied",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			gen := &AWSOrganizationsGenerator{
+				newServiceFunc: func(*argoprojiov1alpha1.AWSOrganizationsGenerator) (AWSOrganizationsService, error) {
+					return &fakeAWSOrganizationsService{accounts: accounts, err: c.err}, nil
+				},
+			}
+			generatorConfig := argoprojiov1alpha1.ApplicationSetGenerator{
+				AWSOrganizations: &argoprojiov1alpha1.AWSOrganizationsGenerator{Values: c.values},
+			}
+			appSet := &argoprojiov1alpha1.ApplicationSet{Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: c.goTemplate}}
+
+			got, err := gen.GenerateParams(&generatorConfig, appSet, nil)
+			if c.expectedErr != "" {
+				require.EqualError(t, err, c.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, got)
+		})
+	}
+}
+
+func TestAWSOrganizationsGetRequeueAfter(t *testing.T) {
+	gen := NewAWSOrganizationsGenerator()
+	requeueAfterSeconds := int64(60)
+
+	assert.Equal(t, DefaultAWSOrganizationsRequeueAfter, gen.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{
+		AWSOrganizations: &argoprojiov1alpha1.AWSOrganizationsGenerator{},
+	}))
+	assert.Equal(t, time.Minute, gen.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{
+		AWSOrganizations: &argoprojiov1alpha1.AWSOrganizationsGenerator{RequeueAfterSeconds: &requeueAfterSeconds},
+	}))
+}
@@ -3,12 +3,14 @@ package generators
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -41,8 +43,11 @@ func NewClusterGenerator(ctx context.Context, c client.Client, clientset kuberne
 }
 
 // GetRequeueAfter never requeue the cluster generator because the `clusterSecretEventHandler` will requeue the appsets
-// when the cluster secrets change
+// when the cluster secrets change, unless a cluster secret refers to a Cluster API Cluster, whose changes are not watched
 func (g *ClusterGenerator) GetRequeueAfter(_ *argoappsetv1alpha1.ApplicationSetGenerator) time.Duration {
+	if g.hasCAPIClusters() {
+		return getDefaultRequeueAfter()
+	}
 	return NoRequeueAfter
 }
 
@@ -110,6 +115,17 @@ func (g *ClusterGenerator) GenerateParams(appSetGenerator *argoappsetv1alpha1.Ap
 	for _, cluster := range secretsFound {
 		params := g.getClusterParameters(cluster, appSet)
 
+		if _, ok := cluster.Annotations[common.AnnotationKeyCAPICluster]; ok {
+			provisioned, err := g.appendCAPIClusterParameters(g.ctx, cluster, params, appSet.Spec.GoTemplate)
+			if err != nil {
+				return nil, fmt.Errorf("error getting Cluster API parameters for cluster %s: %w", cluster.Name, err)
+			}
+			if !provisioned {
+				logCtx.WithField("cluster", cluster.Name).Info("skipping cluster whose Cluster API Cluster is not provisioned")
+				continue
+			}
+		}
+
 		err = appendTemplatedValues(appSetGenerator.Clusters.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
 		if err != nil {
 			return nil, fmt.Errorf("error appending templated values for cluster: %w", err)
@@ -180,7 +196,23 @@ func (g *ClusterGenerator) getClusterParameters(cluster corev1.Secret, appSet *a
 func (g *ClusterGenerator) getSecretsByClusterName(log *log.Entry, appSetGenerator *argoappsetv1alpha1.ApplicationSetGenerator) (map[string]corev1.Secret, error) {
 	clusterSecretList := &corev1.SecretList{}
 
-	selector := metav1.AddLabelToSelector(&appSetGenerator.Clusters.Selector, common.LabelKeySecretType, common.LabelValueSecretTypeCluster)
+	transforms, err := compileLabelTransforms(appSetGenerator.Clusters.LabelTransforms)
+	if err != nil {
+		return nil, err
+	}
+
+	labelSelector := &appSetGenerator.Clusters.Selector
+	var clusterSelector labels.Selector
+	if len(transforms) > 0 {
+		// the selector may match the derived labels, so it is matched once the labels of the secrets are transformed
+		clusterSelector, err = metav1.LabelSelectorAsSelector(labelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("error converting label selector: %w", err)
+		}
+		labelSelector = &metav1.LabelSelector{}
+	}
+
+	selector := metav1.AddLabelToSelector(labelSelector, common.LabelKeySecretType, common.LabelValueSecretTypeCluster)
 	secretSelector, err := metav1.LabelSelectorAsSelector(selector)
 	if err != nil {
 		return nil, fmt.Errorf("error converting label selector: %w", err)
@@ -194,6 +226,13 @@ func (g *ClusterGenerator) getSecretsByClusterName(log *log.Entry, appSetGenerat
 	res := map[string]corev1.Secret{}
 
 	for _, cluster := range clusterSecretList.Items {
+		if len(transforms) > 0 {
+			cluster.Labels = applyLabelTransforms(cluster.Labels, transforms)
+			if !clusterSelector.Matches(labels.Set(cluster.Labels)) {
+				continue
+			}
+		}
+
 		clusterName := string(cluster.Data["name"])
 
 		res[clusterName] = cluster
@@ -201,3 +240,52 @@ func (g *ClusterGenerator) getSecretsByClusterName(log *log.Entry, appSetGenerat
 
 	return res, nil
 }
+
+// labelTransform is a cluster label transform whose regex is compiled
+type labelTransform struct {
+	argoappsetv1alpha1.ClusterLabelTransform
+	regex *regexp.Regexp
+}
+
+func compileLabelTransforms(transforms []argoappsetv1alpha1.ClusterLabelTransform) ([]labelTransform, error) {
+	compiled := make([]labelTransform, 0, len(transforms))
+	for i, transform := range transforms {
+		if transform.Label == "" || transform.TargetLabel == "" {
+			return nil, fmt.Errorf("label transform %d: label and targetLabel are required", i)
+		}
+		expr := transform.Regex
+		if expr == "" {
+			expr = "(.*)"
+		}
+		regex, err := regexp.Compile("^(?:" + expr + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("label transform %d: invalid regex: %w", i, err)
+		}
+		if transform.Replacement == "" {
+			transform.Replacement = "$1"
+		}
+		compiled = append(compiled, labelTransform{ClusterLabelTransform: transform, regex: regex})
+	}
+	return compiled, nil
+}
+
+// applyLabelTransforms returns the given labels with the labels derived by the transforms. The transforms are applied in
+// order, so that a transform can derive a label from the label derived by a previous one.
+func applyLabelTransforms(clusterLabels map[string]string, transforms []labelTransform) map[string]string {
+	res := make(map[string]string, len(clusterLabels)+len(transforms))
+	for key, value := range clusterLabels {
+		res[key] = value
+	}
+	for _, transform := range transforms {
+		value, ok := res[transform.Label]
+		if !ok {
+			continue
+		}
+		match := transform.regex.FindStringSubmatchIndex(value)
+		if match == nil {
+			continue
+		}
+		res[transform.TargetLabel] = string(transform.regex.ExpandString(nil, transform.Replacement, value, match))
+	}
+	return res
+}
@@ -0,0 +1,127 @@
+package generators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+const (
+	// capiClusterPhaseProvisioned is the phase of the Cluster API Clusters whose control plane is reachable
+	capiClusterPhaseProvisioned = "Provisioned"
+	// capiClusterNameLabel is the label of the Cluster API objects which belong to a Cluster
+	capiClusterNameLabel = "cluster.x-k8s.io/cluster-name"
+)
+
+var (
+	capiClusterGVK               = schema.GroupVersionKind{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "Cluster"}
+	capiMachineDeploymentListGVK = schema.GroupVersionKind{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "MachineDeploymentList"}
+)
+
+// parseCAPIClusterRef returns the namespace and the name of the Cluster API Cluster of a cluster secret, the namespace
+// defaulting to the namespace of the secret
+func parseCAPIClusterRef(secret corev1.Secret) (string, string) {
+	ref := strings.TrimSpace(secret.Annotations[common.AnnotationKeyCAPICluster])
+	if namespace, name, ok := strings.Cut(ref, "/"); ok {
+		return namespace, name
+	}
+	return secret.Namespace, ref
+}
+
+// hasCAPIClusters returns whether any cluster secret refers to a Cluster API Cluster
+func (g *ClusterGenerator) hasCAPIClusters() bool {
+	secrets := &corev1.SecretList{}
+	if err := g.List(g.ctx, secrets, client.MatchingLabels{common.LabelKeySecretType: common.LabelValueSecretTypeCluster}); err != nil {
+		return false
+	}
+	for _, secret := range secrets.Items {
+		if _, ok := secret.Annotations[common.AnnotationKeyCAPICluster]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// appendCAPIClusterParameters adds the parameters of the Cluster API Cluster of the cluster secret and of its
+// MachineDeployments to the parameters of the cluster, and returns whether the Cluster API Cluster is provisioned
+func (g *ClusterGenerator) appendCAPIClusterParameters(ctx context.Context, secret corev1.Secret, params map[string]any, goTemplate bool) (bool, error) {
+	namespace, name := parseCAPIClusterRef(secret)
+	cluster := &unstructured.Unstructured{}
+	cluster.SetGroupVersionKind(capiClusterGVK)
+	if err := g.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error getting Cluster API Cluster %s/%s: %w", namespace, name, err)
+	}
+	phase, _, _ := unstructured.NestedString(cluster.Object, "status", "phase")
+	if phase != capiClusterPhaseProvisioned {
+		return false, nil
+	}
+
+	machineDeployments := &unstructured.UnstructuredList{}
+	machineDeployments.SetGroupVersionKind(capiMachineDeploymentListGVK)
+	if err := g.List(ctx, machineDeployments, client.InNamespace(namespace), client.MatchingLabels{capiClusterNameLabel: name}); err != nil {
+		return false, fmt.Errorf("error listing the MachineDeployments of Cluster API Cluster %s/%s: %w", namespace, name, err)
+	}
+
+	version, _, _ := unstructured.NestedString(cluster.Object, "spec", "topology", "version")
+	infrastructureProvider, _, _ := unstructured.NestedString(cluster.Object, "spec", "infrastructureRef", "kind")
+	controlPlaneProvider, _, _ := unstructured.NestedString(cluster.Object, "spec", "controlPlaneRef", "kind")
+	controlPlaneReady, _, _ := unstructured.NestedBool(cluster.Object, "status", "controlPlaneReady")
+	infrastructureReady, _, _ := unstructured.NestedBool(cluster.Object, "status", "infrastructureReady")
+
+	var machineDeploymentParams []map[string]any
+	for _, machineDeployment := range machineDeployments.Items {
+		mdPhase, _, _ := unstructured.NestedString(machineDeployment.Object, "status", "phase")
+		mdVersion, _, _ := unstructured.NestedString(machineDeployment.Object, "spec", "template", "spec", "version")
+		replicas, _, _ := unstructured.NestedInt64(machineDeployment.Object, "spec", "replicas")
+		readyReplicas, _, _ := unstructured.NestedInt64(machineDeployment.Object, "status", "readyReplicas")
+		if version == "" {
+			version = mdVersion
+		}
+		machineDeploymentParams = append(machineDeploymentParams, map[string]any{
+			"name":          machineDeployment.GetName(),
+			"phase":         mdPhase,
+			"version":       mdVersion,
+			"replicas":      replicas,
+			"readyReplicas": readyReplicas,
+		})
+	}
+
+	capi := map[string]any{
+		"name":                   name,
+		"namespace":              namespace,
+		"phase":                  phase,
+		"version":                version,
+		"infrastructureProvider": infrastructureProvider,
+		"controlPlaneProvider":   controlPlaneProvider,
+		"controlPlaneReady":      controlPlaneReady,
+		"infrastructureReady":    infrastructureReady,
+	}
+	if goTemplate {
+		capi["machineDeployments"] = machineDeploymentParams
+		params["capi"] = capi
+		return true, nil
+	}
+	for key, value := range capi {
+		params["capi."+key] = fmt.Sprint(value)
+	}
+	for _, machineDeployment := range machineDeploymentParams {
+		prefix := fmt.Sprintf("capi.machineDeployments.%s.", machineDeployment["name"])
+		for key, value := range machineDeployment {
+			if key != "name" {
+				params[prefix+key] = fmt.Sprint(value)
+			}
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,140 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newCAPIScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	scheme.AddKnownTypeWithName(capiClusterGVK, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(schema.GroupVersionKind{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "ClusterList"}, &unstructured.UnstructuredList{})
+	scheme.AddKnownTypeWithName(schema.GroupVersionKind{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "MachineDeployment"}, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(capiMachineDeploymentListGVK, &unstructured.UnstructuredList{})
+	return scheme
+}
+
+func newCAPICluster(name, phase string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "cluster.x-k8s.io/v1beta1",
+		"kind":       "Cluster",
+		"metadata":   map[string]any{"name": name, "namespace": "capi"},
+		"spec": map[string]any{
+			"topology":          map[string]any{"version": "v1.30.1"},
+			"infrastructureRef": map[string]any{"kind": "AWSCluster"},
+			"controlPlaneRef":   map[string]any{"kind": "KubeadmControlPlane"},
+		},
+		"status": map[string]any{"phase": phase, "controlPlaneReady": true, "infrastructureReady": true},
+	}}
+}
+
+func newCAPIClusterSecret(name string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "namespace",
+			Labels:      map[string]string{"argocd.argoproj.io/secret-type": "cluster"},
+			Annotations: map[string]string{"argocd.argoproj.io/capi-cluster": "capi/" + name},
+		},
+		Data: map[string][]byte{
+			"name":   []byte(name),
+			"server": []byte("https://" + name + ".example.com"),
+		},
+	}
+}
+
+func TestGenerateParamsCAPI(t *testing.T) {
+	machineDeployment := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "cluster.x-k8s.io/v1beta1",
+		"kind":       "MachineDeployment",
+		"metadata": map[string]any{
+			"name":      "provisioned-md-0",
+			"namespace": "capi",
+			"labels":    map[string]any{"cluster.x-k8s.io/cluster-name": "provisioned"},
+		},
+		"spec":   map[string]any{"replicas": int64(3), "template": map[string]any{"spec": map[string]any{"version": "v1.30.1"}}},
+		"status": map[string]any{"phase": "Running", "readyReplicas": int64(3)},
+	}}
+	objects := []client.Object{
+		newCAPIClusterSecret("provisioned"),
+		newCAPIClusterSecret("provisioning"),
+		newCAPIClusterSecret("missing"),
+		newCAPICluster("provisioned", "Provisioned"),
+		newCAPICluster("provisioning", "Provisioning"),
+		machineDeployment,
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(newCAPIScheme(t)).WithObjects(objects...).Build()
+	appClientset := kubefake.NewClientset(newCAPIClusterSecret("provisioned"), newCAPIClusterSecret("provisioning"), newCAPIClusterSecret("missing"))
+	generator := NewClusterGenerator(t.Context(), fakeClient, appClientset, "namespace")
+	appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{
+		Clusters: &argoprojiov1alpha1.ClusterGenerator{
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{"argocd.argoproj.io/secret-type": "cluster"}},
+		},
+	}
+
+	t.Run("GoTemplate", func(t *testing.T) {
+		appSet := &argoprojiov1alpha1.ApplicationSet{Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: true}}
+		params, err := generator.GenerateParams(appSetGenerator, appSet, nil)
+		require.NoError(t, err)
+		require.Len(t, params, 1)
+		assert.Equal(t, "provisioned", params[0]["name"])
+		assert.Equal(t, map[string]any{
+			"name":                   "provisioned",
+			"namespace":              "capi",
+			"phase":                  "Provisioned",
+			"version":                "v1.30.1",
+			"infrastructureProvider": "AWSCluster",
+			"controlPlaneProvider":   "KubeadmControlPlane",
+			"controlPlaneReady":      true,
+			"infrastructureReady":    true,
+			"machineDeployments": []map[string]any{{
+				"name":          "provisioned-md-0",
+				"phase":         "Running",
+				"version":       "v1.30.1",
+				"replicas":      int64(3),
+				"readyReplicas": int64(3),
+			}},
+		}, params[0]["capi"])
+	})
+
+	t.Run("FastTemplate", func(t *testing.T) {
+		params, err := generator.GenerateParams(appSetGenerator, &argoprojiov1alpha1.ApplicationSet{}, nil)
+		require.NoError(t, err)
+		require.Len(t, params, 1)
+		assert.Equal(t, "Provisioned", params[0]["capi.phase"])
+		assert.Equal(t, "AWSCluster", params[0]["capi.infrastructureProvider"])
+		assert.Equal(t, "true", params[0]["capi.controlPlaneReady"])
+		assert.Equal(t, "3", params[0]["capi.machineDeployments.provisioned-md-0.readyReplicas"])
+	})
+
+	t.Run("RequeueAfter", func(t *testing.T) {
+		assert.Equal(t, getDefaultRequeueAfter(), generator.GetRequeueAfter(appSetGenerator))
+	})
+}
+
+func TestParseCAPIClusterRef(t *testing.T) {
+	secret := corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "argocd", Annotations: map[string]string{"argocd.argoproj.io/capi-cluster": "capi/workload"}}}
+	namespace, name := parseCAPIClusterRef(secret)
+	assert.Equal(t, "capi", namespace)
+	assert.Equal(t, "workload", name)
+
+	secret.Annotations["argocd.argoproj.io/capi-cluster"] = "workload"
+	namespace, name = parseCAPIClusterRef(secret)
+	assert.Equal(t, "argocd", namespace)
+	assert.Equal(t, "workload", name)
+}
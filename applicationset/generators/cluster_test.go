@@ -85,11 +85,12 @@ func TestGenerateParams(t *testing.T) {
 		},
 	}
 	testCases := []struct {
-		name       string
-		selector   metav1.LabelSelector
-		isFlatMode bool
-		values     map[string]string
-		expected   []map[string]any
+		name            string
+		selector        metav1.LabelSelector
+		labelTransforms []argoprojiov1alpha1.ClusterLabelTransform
+		isFlatMode      bool
+		values          map[string]string
+		expected        []map[string]any
 		// clientError is true if a k8s client error should be simulated
 		clientError   bool
 		expectedError error
@@ -221,6 +222,39 @@ func TestGenerateParams(t *testing.T) {
 			clientError:   false,
 			expectedError: nil,
 		},
+		{
+			name: "derived label",
+			selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"env": "prod",
+				},
+			},
+			labelTransforms: []argoprojiov1alpha1.ClusterLabelTransform{
+				{Label: "environment", Regex: "(prod|stag)(uction|ing)", TargetLabel: "env"},
+			},
+			values: map[string]string{
+				"env": "{{ metadata.labels.env }}",
+			},
+			expected: []map[string]any{
+				{
+					"values.env": "prod", "name": "production_01/west", "nameNormalized": "production-01-west", "server": "https://production-01.example.com", "metadata.labels.environment": "production", "metadata.labels.org": "bar",
+					"metadata.labels.argocd.argoproj.io/secret-type": "cluster", "metadata.labels.env": "prod", "metadata.annotations.foo.argoproj.io": "production", "project": "prod-project",
+				},
+			},
+			clientError:   false,
+			expectedError: nil,
+		},
+		{
+			name:     "invalid label transform",
+			selector: metav1.LabelSelector{},
+			labelTransforms: []argoprojiov1alpha1.ClusterLabelTransform{
+				{Label: "environment", Regex: "(prod", TargetLabel: "env"},
+			},
+			values:        nil,
+			expected:      nil,
+			clientError:   false,
+			expectedError: errors.New("error getting cluster secrets: label transform 0: invalid regex: error parsing regexp: missing closing ): `^(?:(prod)$`"),
+		},
 		{
 			name:          "simulate client error",
 			selector:      metav1.LabelSelector{},
@@ -326,9 +360,10 @@ func TestGenerateParams(t *testing.T) {
 
 			got, err := clusterGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
 				Clusters: &argoprojiov1alpha1.ClusterGenerator{
-					Selector: testCase.selector,
-					Values:   testCase.values,
-					FlatList: testCase.isFlatMode,
+					Selector:        testCase.selector,
+					Values:          testCase.values,
+					FlatList:        testCase.isFlatMode,
+					LabelTransforms: testCase.labelTransforms,
 				},
 			}, &applicationSetInfo, nil)
 
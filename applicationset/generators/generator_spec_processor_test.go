@@ -358,7 +358,7 @@ func TestGetRelevantGenerators(t *testing.T) {
 		"Git":      getMockGitGenerator(),
 	}
 
-	testGenerators["Matrix"] = NewMatrixGenerator(testGenerators)
+	testGenerators["Matrix"] = NewMatrixGenerator(testGenerators, DefaultMatrixMaxGenerators)
 	testGenerators["Merge"] = NewMergeGenerator(testGenerators)
 	testGenerators["List"] = NewListGenerator()
 
@@ -1,8 +1,13 @@
 package generators
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"dario.cat/mergo"
@@ -15,19 +20,27 @@ import (
 var _ Generator = (*MatrixGenerator)(nil)
 
 var (
-	ErrMoreThanTwoGenerators      = errors.New("found more than two generators, Matrix support only two")
-	ErrLessThanTwoGenerators      = errors.New("found less than two generators, Matrix support only two")
+	ErrTooManyGenerators          = errors.New("found too many generators in the Matrix generator")
+	ErrLessThanTwoGenerators      = errors.New("found less than two generators, Matrix requires two or more")
 	ErrMoreThenOneInnerGenerators = errors.New("found more than one generator in matrix.Generators")
+	ErrMatrixGeneratorsCycle      = errors.New("found a cycle in the parameters the generators of the Matrix generator depend on")
 )
 
+// DefaultMatrixMaxGenerators is the default maximum number of child generators of a Matrix generator
+const DefaultMatrixMaxGenerators = 5
+
 type MatrixGenerator struct {
 	// The inner generators supported by the matrix generator (cluster, git, list...)
 	supportedGenerators map[string]Generator
+	// maxGenerators bounds the depth of the cartesian product, as the number of generated parameters grows
+	// exponentially with the number of child generators
+	maxGenerators int
 }
 
-func NewMatrixGenerator(supportedGenerators map[string]Generator) Generator {
+func NewMatrixGenerator(supportedGenerators map[string]Generator, maxGenerators int) Generator {
 	m := &MatrixGenerator{
 		supportedGenerators: supportedGenerators,
+		maxGenerators:       maxGenerators,
 	}
 	return m
 }
@@ -41,42 +54,193 @@ func (m *MatrixGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.App
 		return nil, ErrLessThanTwoGenerators
 	}
 
-	if len(appSetGenerator.Matrix.Generators) > 2 {
-		return nil, ErrMoreThanTwoGenerators
+	if len(appSetGenerator.Matrix.Generators) > m.maxGenerators {
+		return nil, fmt.Errorf("%w: found %d generators, the maximum is %d", ErrTooManyGenerators, len(appSetGenerator.Matrix.Generators), m.maxGenerators)
 	}
 
-	res := []map[string]any{}
+	// The child generators are combined in order: each one is rendered with the parameters of every combination of the
+	// preceding ones, whose values take precedence. The parameters can therefore only depend on the preceding
+	// generators, which is checked once the parameters of all the generators are known.
+	refs := make([]map[string]bool, len(appSetGenerator.Matrix.Generators))
+	for i, generator := range appSetGenerator.Matrix.Generators {
+		var err error
+		if refs[i], err = matrixParamRefs(generator, appSet.Spec.GoTemplate); err != nil {
+			return nil, fmt.Errorf("failed to get the params referenced by generator %d in the matrix generator: %w", i+1, err)
+		}
+	}
+	provided := make([]map[string]bool, len(appSetGenerator.Matrix.Generators))
 
-	g0, err := m.getParams(appSetGenerator.Matrix.Generators[0], appSet, nil, client)
+	res, err := m.getParams(appSetGenerator.Matrix.Generators[0], appSet, nil, client)
 	if err != nil {
 		return nil, fmt.Errorf("error failed to get params for first generator in matrix generator: %w", err)
 	}
-	for _, a := range g0 {
-		g1, err := m.getParams(appSetGenerator.Matrix.Generators[1], appSet, a, client)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get params for second generator in the matrix generator: %w", err)
+	provided[0] = paramNames(res)
+	for i, generator := range appSetGenerator.Matrix.Generators[1:] {
+		combined := []map[string]any{}
+		provided[i+1] = map[string]bool{}
+		for _, a := range res {
+			params, err := m.getParams(generator, appSet, a, client)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get params for generator %d in the matrix generator: %w", i+2, err)
+			}
+			for name := range paramNames(params) {
+				provided[i+1][name] = true
+			}
+			for _, b := range params {
+				val, err := combineMatrixParams(a, b, appSet.Spec.GoTemplate)
+				if err != nil {
+					return nil, fmt.Errorf("failed to combine params of generator %d in the matrix generator: %w", i+2, err)
+				}
+				combined = append(combined, val)
+			}
 		}
-		for _, b := range g1 {
-			if appSet.Spec.GoTemplate {
-				tmp := map[string]any{}
-				if err := mergo.Merge(&tmp, b, mergo.WithOverride); err != nil {
-					return nil, fmt.Errorf("failed to merge params from the second generator in the matrix generator with temp map: %w", err)
+		res = combined
+	}
+
+	if cycle := findMatrixCycle(refs, provided); cycle != nil {
+		names := make([]string, len(cycle))
+		for i, generator := range cycle {
+			names[i] = strconv.Itoa(generator + 1)
+		}
+		return nil, fmt.Errorf("%w: generators %s", ErrMatrixGeneratorsCycle, strings.Join(names, " -> "))
+	}
+
+	return res, nil
+}
+
+var (
+	// templateActionRegexp matches the actions of the templates, e.g. {{ .path.basename }}
+	templateActionRegexp = regexp.MustCompile(`\{\{-?\s*([^{}]*?)\s*-?\}\}`)
+	// goTemplateFieldRegexp matches the top-level fields of the data referenced by a Go template action, e.g. .path
+	// but not .basename in .path.basename
+	goTemplateFieldRegexp = regexp.MustCompile(`(?:^|[^\w.)\]$])\.([A-Za-z_]\w*)`)
+)
+
+// matrixParamRefs returns the names of the parameters referenced by a child generator of a matrix. Its template is left
+// out, as it is rendered with the parameters of all the generators.
+func matrixParamRefs(generator argoprojiov1alpha1.ApplicationSetNestedGenerator, goTemplate bool) (map[string]bool, error) {
+	data, err := json.Marshal(generator)
+	if err != nil {
+		return nil, err
+	}
+	var spec any
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+	refs := map[string]bool{}
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			for k, child := range v {
+				if k != "template" {
+					walk(child)
+				}
+			}
+		case []any:
+			for _, child := range v {
+				walk(child)
+			}
+		case string:
+			for _, action := range templateActionRegexp.FindAllStringSubmatch(v, -1) {
+				if !goTemplate {
+					refs[action[1]] = true
+					continue
 				}
-				if err := mergo.Merge(&tmp, a, mergo.WithOverride); err != nil {
-					return nil, fmt.Errorf("failed to merge params from the second generator in the matrix generator with the first: %w", err)
+				for _, field := range goTemplateFieldRegexp.FindAllStringSubmatch(action[1], -1) {
+					refs[field[1]] = true
 				}
-				res = append(res, tmp)
-			} else {
-				val, err := utils.CombineStringMaps(a, b)
-				if err != nil {
-					return nil, fmt.Errorf("failed to combine string maps with merging params for the matrix generator: %w", err)
+			}
+		}
+	}
+	walk(spec)
+	return refs, nil
+}
+
+// paramNames returns the names of the given parameters
+func paramNames(params []map[string]any) map[string]bool {
+	names := map[string]bool{}
+	for _, p := range params {
+		for name := range p {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// findMatrixCycle returns the indexes of the child generators of a matrix forming a cycle, given the names of the
+// parameters each of them references and provides, or nil if there is no cycle. A generator depends on another one if
+// it references a parameter only provided by the other one.
+func findMatrixCycle(refs []map[string]bool, provided []map[string]bool) []int {
+	dependencies := make([][]int, len(refs))
+	for i := range refs {
+		for j := range provided {
+			if i == j {
+				continue
+			}
+			for name := range refs[i] {
+				if provided[j][name] && !provided[i][name] {
+					dependencies[i] = append(dependencies[i], j)
+					break
 				}
-				res = append(res, val)
 			}
 		}
 	}
 
-	return res, nil
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(refs))
+	var path []int
+	var visit func(i int) []int
+	visit = func(i int) []int {
+		state[i] = visiting
+		path = append(path, i)
+		for _, j := range dependencies[i] {
+			switch state[j] {
+			case visiting:
+				start := slices.Index(path, j)
+				return append(slices.Clone(path[start:]), j)
+			case unvisited:
+				if cycle := visit(j); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[i] = visited
+		return nil
+	}
+	for i := range refs {
+		if state[i] == unvisited {
+			if cycle := visit(i); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// combineMatrixParams combines the parameters of a combination of the preceding generators of a matrix with the
+// parameters of the next generator. The values of the preceding generators take precedence.
+func combineMatrixParams(a, b map[string]any, goTemplate bool) (map[string]any, error) {
+	if !goTemplate {
+		val, err := utils.CombineStringMaps(a, b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to combine string maps with merging params for the matrix generator: %w", err)
+		}
+		return val, nil
+	}
+	tmp := map[string]any{}
+	if err := mergo.Merge(&tmp, b, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge params from the next generator in the matrix generator with temp map: %w", err)
+	}
+	if err := mergo.Merge(&tmp, a, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge params from the next generator in the matrix generator with the preceding: %w", err)
+	}
+	return tmp, nil
 }
 
 func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, params map[string]any, client client.Client) ([]map[string]any, error) {
@@ -98,6 +262,7 @@ func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Appli
 			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
 			PullRequest:             appSetBaseGenerator.PullRequest,
 			Plugin:                  appSetBaseGenerator.Plugin,
+			AWSOrganizations:        appSetBaseGenerator.AWSOrganizations,
 			Matrix:                  matrixGen,
 			Merge:                   mergeGen,
 			Selector:                appSetBaseGenerator.Selector,
@@ -137,6 +302,7 @@ func (m *MatrixGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.Ap
 			Git:                     r.Git,
 			PullRequest:             r.PullRequest,
 			Plugin:                  r.Plugin,
+			AWSOrganizations:        r.AWSOrganizations,
 			SCMProvider:             r.SCMProvider,
 			ClusterDecisionResource: r.ClusterDecisionResource,
 			Matrix:                  matrixGen,
@@ -90,19 +90,42 @@ func TestMatrixGenerate(t *testing.T) {
 			expectedErr: ErrLessThanTwoGenerators,
 		},
 		{
-			name: "returns error if there is more than two base generators",
+			name: "combines more than two base generators",
 			baseGenerators: []v1alpha1.ApplicationSetNestedGenerator{
 				{
-					List: listGenerator,
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"a": "1"}`)}, {Raw: []byte(`{"a": "2"}`)}},
+					},
 				},
 				{
-					List: listGenerator,
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"b": "1"}`)}, {Raw: []byte(`{"b": "2"}`)}},
+					},
 				},
 				{
-					List: listGenerator,
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"c": "1"}`)}},
+					},
 				},
 			},
-			expectedErr: ErrMoreThanTwoGenerators,
+			expected: []map[string]any{
+				{"a": "1", "b": "1", "c": "1"},
+				{"a": "1", "b": "2", "c": "1"},
+				{"a": "2", "b": "1", "c": "1"},
+				{"a": "2", "b": "2", "c": "1"},
+			},
+		},
+		{
+			name: "returns error if there are more base generators than the maximum",
+			baseGenerators: []v1alpha1.ApplicationSetNestedGenerator{
+				{List: listGenerator},
+				{List: listGenerator},
+				{List: listGenerator},
+				{List: listGenerator},
+				{List: listGenerator},
+				{List: listGenerator},
+			},
+			expectedErr: ErrTooManyGenerators,
 		},
 		{
 			name: "returns error if there is more than one inner generator in the first base generator",
@@ -171,6 +194,7 @@ func TestMatrixGenerate(t *testing.T) {
 					"Git":  genMock,
 					"List": &ListGenerator{},
 				},
+				DefaultMatrixMaxGenerators,
 			)
 
 			got, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
@@ -297,19 +321,42 @@ func TestMatrixGenerateGoTemplate(t *testing.T) {
 			expectedErr: ErrLessThanTwoGenerators,
 		},
 		{
-			name: "returns error if there is more than two base generators",
+			name: "combines more than two base generators",
 			baseGenerators: []v1alpha1.ApplicationSetNestedGenerator{
 				{
-					List: listGenerator,
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"a": "1"}`)}, {Raw: []byte(`{"a": "2"}`)}},
+					},
 				},
 				{
-					List: listGenerator,
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"b": "1"}`)}, {Raw: []byte(`{"b": "2"}`)}},
+					},
 				},
 				{
-					List: listGenerator,
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"c": "1"}`)}},
+					},
 				},
 			},
-			expectedErr: ErrMoreThanTwoGenerators,
+			expected: []map[string]any{
+				{"a": "1", "b": "1", "c": "1"},
+				{"a": "1", "b": "2", "c": "1"},
+				{"a": "2", "b": "1", "c": "1"},
+				{"a": "2", "b": "2", "c": "1"},
+			},
+		},
+		{
+			name: "returns error if there are more base generators than the maximum",
+			baseGenerators: []v1alpha1.ApplicationSetNestedGenerator{
+				{List: listGenerator},
+				{List: listGenerator},
+				{List: listGenerator},
+				{List: listGenerator},
+				{List: listGenerator},
+				{List: listGenerator},
+			},
+			expectedErr: ErrTooManyGenerators,
 		},
 		{
 			name: "returns error if there is more than one inner generator in the first base generator",
@@ -384,6 +431,7 @@ func TestMatrixGenerateGoTemplate(t *testing.T) {
 					"Git":  genMock,
 					"List": &ListGenerator{},
 				},
+				DefaultMatrixMaxGenerators,
 			)
 
 			got, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
@@ -528,6 +576,7 @@ func TestMatrixGetRequeueAfter(t *testing.T) {
 					"SCMProvider":             &SCMProviderGenerator{},
 					"ClusterDecisionResource": &DuckTypeGenerator{},
 				},
+				DefaultMatrixMaxGenerators,
 			)
 
 			got := matrixGenerator.GetRequeueAfter(&v1alpha1.ApplicationSetGenerator{
@@ -670,6 +719,7 @@ func TestInterpolatedMatrixGenerate(t *testing.T) {
 					"Git":      genMock,
 					"Clusters": clusterGenerator,
 				},
+				DefaultMatrixMaxGenerators,
 			)
 
 			got, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
@@ -857,6 +907,7 @@ func TestInterpolatedMatrixGenerateGoTemplate(t *testing.T) {
 					"Git":      genMock,
 					"Clusters": clusterGenerator,
 				},
+				DefaultMatrixMaxGenerators,
 			)
 
 			got, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
@@ -1018,6 +1069,7 @@ func TestMatrixGenerateListElementsYaml(t *testing.T) {
 					"Git":  genMock,
 					"List": &ListGenerator{},
 				},
+				DefaultMatrixMaxGenerators,
 			)
 
 			got, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
@@ -1094,7 +1146,7 @@ func TestGitGenerator_GenerateParams_list_x_git_matrix_generator(t *testing.T) {
 	matrixGenerator := NewMatrixGenerator(map[string]Generator{
 		"List": listGeneratorMock,
 		"Git":  gitGenerator,
-	})
+	}, DefaultMatrixMaxGenerators)
 
 	matrixGeneratorSpec := &v1alpha1.MatrixGenerator{
 		Generators: []v1alpha1.ApplicationSetNestedGenerator{
@@ -1135,3 +1187,74 @@ func TestGitGenerator_GenerateParams_list_x_git_matrix_generator(t *testing.T) {
 		"test":                    "content",
 	}}, params)
 }
+
+func TestMatrixMaxGenerators(t *testing.T) {
+	listGenerator := &v1alpha1.ListGenerator{
+		Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"cluster": "Cluster","url": "Url"}`)}},
+	}
+	matrixGenerator := NewMatrixGenerator(map[string]Generator{"List": &ListGenerator{}}, 2)
+
+	_, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
+		Matrix: &v1alpha1.MatrixGenerator{
+			Generators: []v1alpha1.ApplicationSetNestedGenerator{{List: listGenerator}, {List: listGenerator}, {List: listGenerator}},
+		},
+	}, &v1alpha1.ApplicationSet{}, nil)
+	require.ErrorIs(t, err, ErrTooManyGenerators)
+	assert.ErrorContains(t, err, "found 3 generators, the maximum is 2")
+}
+
+func TestMatrixGeneratorsCycle(t *testing.T) {
+	matrixGenerator := NewMatrixGenerator(map[string]Generator{"List": &ListGenerator{}}, DefaultMatrixMaxGenerators)
+
+	testCases := []struct {
+		name          string
+		goTemplate    bool
+		first         string
+		second        string
+		expectedError string
+	}{
+		{
+			name:   "Forward reference",
+			first:  `{"cluster": "in-cluster"}`,
+			second: `{"path": "{{cluster}}/app"}`,
+		},
+		{
+			name:          "Cycle",
+			first:         `{"cluster": "{{path}}"}`,
+			second:        `{"path": "{{cluster}}/app"}`,
+			expectedError: "generators 1 -> 2 -> 1",
+		},
+		{
+			name:       "Forward reference with Go template",
+			goTemplate: true,
+			first:      `{"cluster": "in-cluster"}`,
+			second:     `{"path": "{{ .cluster }}/app"}`,
+		},
+		{
+			name:          "Cycle with Go template",
+			goTemplate:    true,
+			first:         `{"cluster": "{{ .path | upper }}"}`,
+			second:        `{"path": "{{ printf \"%s/app\" .cluster }}"}`,
+			expectedError: "generators 1 -> 2 -> 1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
+				Matrix: &v1alpha1.MatrixGenerator{
+					Generators: []v1alpha1.ApplicationSetNestedGenerator{
+						{List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{{Raw: []byte(testCase.first)}}}},
+						{List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{{Raw: []byte(testCase.second)}}}},
+					},
+				},
+			}, &v1alpha1.ApplicationSet{Spec: v1alpha1.ApplicationSetSpec{GoTemplate: testCase.goTemplate}}, nil)
+			if testCase.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrMatrixGeneratorsCycle)
+			assert.ErrorContains(t, err, testCase.expectedError)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"time"
 
 	"dario.cat/mergo"
@@ -19,6 +20,7 @@ var (
 	ErrLessThanTwoGeneratorsInMerge = errors.New("found less than two generators, Merge requires two or more")
 	ErrNoMergeKeys                  = errors.New("no merge keys were specified, Merge requires at least one")
 	ErrNonUniqueParamSets           = errors.New("the parameters from a generator were not unique by the given mergeKeys, Merge requires all param sets to be unique")
+	ErrTooManyMergeOverlays         = errors.New("found more overlays than generators following the base generator in Merge")
 )
 
 type MergeGenerator struct {
@@ -59,45 +61,122 @@ func (m *MergeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.Appl
 		return nil, ErrLessThanTwoGeneratorsInMerge
 	}
 
+	overlays := appSetGenerator.Merge.Overlays
+	if len(overlays) > len(appSetGenerator.Merge.Generators)-1 {
+		return nil, ErrTooManyMergeOverlays
+	}
+
 	paramSetsFromGenerators, err := m.getParamSetsForAllGenerators(appSetGenerator.Merge.Generators, appSet, client)
 	if err != nil {
 		return nil, fmt.Errorf("error getting param sets from generators: %w", err)
 	}
 
-	baseParamSetsByMergeKey, err := getParamSetsByMergeKey(appSetGenerator.Merge.MergeKeys, paramSetsFromGenerators[0])
+	// the param sets of the base generator lacking merge keys are only passed through when an overlay asks for it,
+	// otherwise they are keyed by null values as they always were
+	baseParamSets := paramSetsFromGenerators[0]
+	var unkeyedParamSets []map[string]any
+	if slices.ContainsFunc(overlays, func(overlay argoprojiov1alpha1.MergeGeneratorOverlay) bool { return overlay.PassThrough }) {
+		baseParamSets, unkeyedParamSets = splitUnkeyedParamSets(appSetGenerator.Merge.MergeKeys, baseParamSets)
+	}
+
+	baseParamSetsByMergeKey, err := getParamSetsByMergeKey(appSetGenerator.Merge.MergeKeys, baseParamSets)
 	if err != nil {
 		return nil, fmt.Errorf("error getting param sets by merge key: %w", err)
 	}
 
-	for _, paramSets := range paramSetsFromGenerators[1:] {
+	for i, paramSets := range paramSetsFromGenerators[1:] {
 		paramSetsByMergeKey, err := getParamSetsByMergeKey(appSetGenerator.Merge.MergeKeys, paramSets)
 		if err != nil {
 			return nil, fmt.Errorf("error getting param sets by merge key: %w", err)
 		}
 
+		var overlay argoprojiov1alpha1.MergeGeneratorOverlay
+		if i < len(overlays) {
+			overlay = overlays[i]
+		}
+
 		for mergeKeyValue, baseParamSet := range baseParamSetsByMergeKey {
-			if overrideParamSet, exists := paramSetsByMergeKey[mergeKeyValue]; exists {
-				if appSet.Spec.GoTemplate {
-					if err := mergo.Merge(&baseParamSet, overrideParamSet, mergo.WithOverride); err != nil {
-						return nil, fmt.Errorf("error merging base param set with override param set: %w", err)
-					}
-					baseParamSetsByMergeKey[mergeKeyValue] = baseParamSet
-				} else {
-					maps.Copy(baseParamSet, overrideParamSet)
-					baseParamSetsByMergeKey[mergeKeyValue] = baseParamSet
+			overrideParamSet, exists := paramSetsByMergeKey[mergeKeyValue]
+			if !exists {
+				if !overlay.PassThrough {
+					continue
+				}
+				if overrideParamSet, err = getOverlayDefaults(overlay); err != nil {
+					return nil, fmt.Errorf("error getting the defaults of overlay %d: %w", i+1, err)
 				}
 			}
+			if baseParamSetsByMergeKey[mergeKeyValue], err = mergeParamSet(baseParamSet, overrideParamSet, appSet.Spec.GoTemplate); err != nil {
+				return nil, err
+			}
+		}
+
+		if !overlay.PassThrough {
+			continue
+		}
+		for j, unkeyedParamSet := range unkeyedParamSets {
+			defaults, err := getOverlayDefaults(overlay)
+			if err != nil {
+				return nil, fmt.Errorf("error getting the defaults of overlay %d: %w", i+1, err)
+			}
+			if unkeyedParamSets[j], err = mergeParamSet(unkeyedParamSet, defaults, appSet.Spec.GoTemplate); err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	mergedParamSets := make([]map[string]any, len(baseParamSetsByMergeKey))
-	i := 0
+	mergedParamSets := make([]map[string]any, 0, len(baseParamSetsByMergeKey)+len(unkeyedParamSets))
 	for _, mergedParamSet := range baseParamSetsByMergeKey {
-		mergedParamSets[i] = mergedParamSet
-		i++
+		mergedParamSets = append(mergedParamSets, mergedParamSet)
+	}
+
+	return append(mergedParamSets, unkeyedParamSets...), nil
+}
+
+// mergeParamSet merges the given override param set into the given base param set, with the values of the override
+// param set taking precedence.
+func mergeParamSet(baseParamSet map[string]any, overrideParamSet map[string]any, useGoTemplate bool) (map[string]any, error) {
+	if useGoTemplate {
+		if err := mergo.Merge(&baseParamSet, overrideParamSet, mergo.WithOverride); err != nil {
+			return nil, fmt.Errorf("error merging base param set with override param set: %w", err)
+		}
+		return baseParamSet, nil
+	}
+	maps.Copy(baseParamSet, overrideParamSet)
+	return baseParamSet, nil
+}
+
+// getOverlayDefaults returns a new param set of the defaults of the given overlay, so that the nested params of the
+// defaults are not shared between the param sets they are merged into.
+func getOverlayDefaults(overlay argoprojiov1alpha1.MergeGeneratorOverlay) (map[string]any, error) {
+	defaults := map[string]any{}
+	if overlay.Defaults == nil || len(overlay.Defaults.Raw) == 0 {
+		return defaults, nil
 	}
+	if err := json.Unmarshal(overlay.Defaults.Raw, &defaults); err != nil {
+		return nil, fmt.Errorf("defaults must be an object: %w", err)
+	}
+	return defaults, nil
+}
 
-	return mergedParamSets, nil
+// splitUnkeyedParamSets splits the given param sets into the param sets which have all the given merge keys and the
+// param sets lacking any of them.
+func splitUnkeyedParamSets(mergeKeys []string, paramSets []map[string]any) ([]map[string]any, []map[string]any) {
+	var keyedParamSets, unkeyedParamSets []map[string]any
+	for _, paramSet := range paramSets {
+		keyed := true
+		for _, mergeKey := range mergeKeys {
+			if _, ok := paramSet[mergeKey]; !ok {
+				keyed = false
+				break
+			}
+		}
+		if keyed {
+			keyedParamSets = append(keyedParamSets, paramSet)
+		} else {
+			unkeyedParamSets = append(unkeyedParamSets, paramSet)
+		}
+	}
+	return keyedParamSets, unkeyedParamSets
 }
 
 // getParamSetsByMergeKey converts the given list of parameter sets to a map of parameter sets where the key is the
@@ -153,6 +232,7 @@ func (m *MergeGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Applic
 			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
 			PullRequest:             appSetBaseGenerator.PullRequest,
 			Plugin:                  appSetBaseGenerator.Plugin,
+			AWSOrganizations:        appSetBaseGenerator.AWSOrganizations,
 			Matrix:                  matrixGen,
 			Merge:                   mergeGen,
 			Selector:                appSetBaseGenerator.Selector,
@@ -189,6 +269,7 @@ func (m *MergeGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.App
 			Git:                     r.Git,
 			PullRequest:             r.PullRequest,
 			Plugin:                  r.Plugin,
+			AWSOrganizations:        r.AWSOrganizations,
 			SCMProvider:             r.SCMProvider,
 			ClusterDecisionResource: r.ClusterDecisionResource,
 			Matrix:                  matrixGen,
@@ -2,6 +2,7 @@ package generators
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -20,6 +21,16 @@ func getNestedListGenerator(json string) *argoprojiov1alpha1.ApplicationSetNeste
 	}
 }
 
+func getNestedListGeneratorMultiple(jsons ...string) argoprojiov1alpha1.ApplicationSetNestedGenerator {
+	elements := make([]apiextensionsv1.JSON, len(jsons))
+	for i, json := range jsons {
+		elements[i] = apiextensionsv1.JSON{Raw: []byte(json)}
+	}
+	return argoprojiov1alpha1.ApplicationSetNestedGenerator{
+		List: &argoprojiov1alpha1.ListGenerator{Elements: elements},
+	}
+}
+
 func getTerminalListGeneratorMultiple(jsons []string) argoprojiov1alpha1.ApplicationSetTerminalGenerator {
 	elements := make([]apiextensionsv1.JSON, len(jsons))
 
@@ -56,6 +67,7 @@ func TestMergeGenerate(t *testing.T) {
 		name           string
 		baseGenerators []argoprojiov1alpha1.ApplicationSetNestedGenerator
 		mergeKeys      []string
+		overlays       []argoprojiov1alpha1.MergeGeneratorOverlay
 		expectedErr    error
 		expected       []map[string]any
 	}{
@@ -148,6 +160,68 @@ func TestMergeGenerate(t *testing.T) {
 				{"a": "2", "b": "2"},
 			},
 		},
+		{
+			name: "pass through the unmatched param sets with defaults",
+			baseGenerators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				getNestedListGeneratorMultiple(`{"a": "1", "b": "1"}`, `{"a": "2", "b": "2"}`),
+				*getNestedListGenerator(`{"a": "1", "c": "override"}`),
+				*getNestedListGenerator(`{"a": "2", "d": "override"}`),
+			},
+			mergeKeys: []string{"a"},
+			overlays: []argoprojiov1alpha1.MergeGeneratorOverlay{
+				{PassThrough: true, Defaults: &apiextensionsv1.JSON{Raw: []byte(`{"c": "default"}`)}},
+				{Defaults: &apiextensionsv1.JSON{Raw: []byte(`{"d": "ignored"}`)}},
+			},
+			expected: []map[string]any{
+				{"a": "1", "b": "1", "c": "override"},
+				{"a": "2", "b": "2", "c": "default", "d": "override"},
+			},
+		},
+		{
+			name: "pass through the param sets lacking the merge keys",
+			baseGenerators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				getNestedListGeneratorMultiple(`{"a": "1"}`, `{"b": "1"}`, `{"b": "2"}`),
+				*getNestedListGenerator(`{"a": "1", "c": "override"}`),
+			},
+			mergeKeys: []string{"a"},
+			overlays: []argoprojiov1alpha1.MergeGeneratorOverlay{
+				{PassThrough: true, Defaults: &apiextensionsv1.JSON{Raw: []byte(`{"c": "default"}`)}},
+			},
+			expected: []map[string]any{
+				{"a": "1", "c": "override"},
+				{"b": "1", "c": "default"},
+				{"b": "2", "c": "default"},
+			},
+		},
+		{
+			name: "param sets lacking the merge keys without pass through",
+			baseGenerators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				getNestedListGeneratorMultiple(`{"a": "1"}`, `{"b": "1"}`, `{"b": "2"}`),
+				*getNestedListGenerator(`{"a": "1", "c": "override"}`),
+			},
+			mergeKeys:   []string{"a"},
+			expectedErr: fmt.Errorf("error getting param sets by merge key: %w. Duplicate key was %s", ErrNonUniqueParamSets, `{"a":null}`),
+		},
+		{
+			name: "too many overlays",
+			baseGenerators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				*getNestedListGenerator(`{"a": "1"}`),
+				*getNestedListGenerator(`{"a": "1"}`),
+			},
+			mergeKeys:   []string{"a"},
+			overlays:    []argoprojiov1alpha1.MergeGeneratorOverlay{{}, {}},
+			expectedErr: ErrTooManyMergeOverlays,
+		},
+		{
+			name: "invalid defaults",
+			baseGenerators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				*getNestedListGenerator(`{"a": "1"}`),
+				*getNestedListGenerator(`{"a": "2"}`),
+			},
+			mergeKeys:   []string{"a"},
+			overlays:    []argoprojiov1alpha1.MergeGeneratorOverlay{{PassThrough: true, Defaults: &apiextensionsv1.JSON{Raw: []byte(`["default"]`)}}},
+			expectedErr: errors.New("error getting the defaults of overlay 1: defaults must be an object: json: cannot unmarshal array into Go value of type map[string]interface {}"),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -165,6 +239,7 @@ func TestMergeGenerate(t *testing.T) {
 						supportedGenerators: map[string]Generator{
 							"List": &ListGenerator{},
 						},
+						maxGenerators: DefaultMatrixMaxGenerators,
 					},
 					"Merge": &MergeGenerator{
 						supportedGenerators: map[string]Generator{
@@ -179,6 +254,7 @@ func TestMergeGenerate(t *testing.T) {
 					Generators: testCaseCopy.baseGenerators,
 					MergeKeys:  testCaseCopy.mergeKeys,
 					Template:   argoprojiov1alpha1.ApplicationSetTemplate{},
+					Overlays:   testCaseCopy.overlays,
 				},
 			}, appSet, nil)
 
@@ -10,9 +10,12 @@ import (
 
 	"github.com/jeremywohl/flatten"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/security"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/services/plugin"
@@ -101,7 +104,12 @@ func (g *PluginGenerator) getPluginFromGenerator(ctx context.Context, appSetName
 		}
 	}
 
-	pluginClient, err := plugin.NewPluginService(appSetName, cm["baseUrl"], token, requestTimeout)
+	outboundURLPolicy, err := g.getOutboundURLPolicy(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error loading outbound URL policy: %w", err)
+	}
+
+	pluginClient, err := plugin.NewPluginService(appSetName, cm["baseUrl"], token, requestTimeout, outboundURLPolicy)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing plugin client: %w", err)
 	}
@@ -200,3 +208,17 @@ func (g *PluginGenerator) getConfigMap(ctx context.Context, configMapRef string)
 
 	return cm.Data, nil
 }
+
+// getOutboundURLPolicy loads the policy restricting the URLs of the plugins from argocd-cm. The instance metadata
+// services are denied if argocd-cm doesn't exist.
+func (g *PluginGenerator) getOutboundURLPolicy(ctx context.Context) (*security.OutboundURLPolicy, error) {
+	cm := &corev1.ConfigMap{}
+	err := g.client.Get(ctx, client.ObjectKey{Name: common.ArgoCDConfigMapName, Namespace: g.namespace}, cm)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return settings.GetOutboundURLPolicyFromConfigMap(cm)
+}
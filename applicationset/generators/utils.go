@@ -10,7 +10,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
-func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, namespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig) map[string]Generator {
+func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, namespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig, matrixMaxGenerators int) map[string]Generator {
 	terminalGenerators := map[string]Generator{
 		"List":                    NewListGenerator(),
 		"Clusters":                NewClusterGenerator(ctx, c, k8sClient, namespace),
@@ -19,6 +19,7 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": NewDuckTypeGenerator(ctx, dynamicClient, k8sClient, namespace),
 		"PullRequest":             NewPullRequestGenerator(c, scmConfig),
 		"Plugin":                  NewPluginGenerator(c, namespace),
+		"AWSOrganizations":        NewAWSOrganizationsGenerator(),
 	}
 
 	nestedGenerators := map[string]Generator{
@@ -29,7 +30,8 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
-		"Matrix":                  NewMatrixGenerator(terminalGenerators),
+		"AWSOrganizations":        terminalGenerators["AWSOrganizations"],
+		"Matrix":                  NewMatrixGenerator(terminalGenerators, matrixMaxGenerators),
 		"Merge":                   NewMergeGenerator(terminalGenerators),
 	}
 
@@ -41,7 +43,8 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
-		"Matrix":                  NewMatrixGenerator(nestedGenerators),
+		"AWSOrganizations":        terminalGenerators["AWSOrganizations"],
+		"Matrix":                  NewMatrixGenerator(nestedGenerators, matrixMaxGenerators),
 		"Merge":                   NewMergeGenerator(nestedGenerators),
 	}
 
@@ -0,0 +1,157 @@
+package aws_organizations
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	log "github.com/sirupsen/logrus"
+
+	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// Account is an account of an AWS organization
+type Account struct {
+	ID    string
+	Name  string
+	Email string
+	ARN   string
+	Tags  map[string]string
+}
+
+// AWSOrganizationsClient is a lean facade to the organizationsiface.OrganizationsAPI
+// it helps to reduce the mockery generated code.
+type AWSOrganizationsClient interface {
+	ListAccountsWithContext(aws.Context, *organizations.ListAccountsInput, ...request.Option) (*organizations.ListAccountsOutput, error)
+	ListTagsForResourceWithContext(aws.Context, *organizations.ListTagsForResourceInput, ...request.Option) (*organizations.ListTagsForResourceOutput, error)
+}
+
+type AWSOrganizationsService struct {
+	client     AWSOrganizationsClient
+	tagFilters []*application.TagFilter
+}
+
+func NewAWSOrganizationsService(tagFilters []*application.TagFilter, role string, region string) (*AWSOrganizationsService, error) {
+	client, err := createAWSOrganizationsClient(role, region)
+	if err != nil {
+		return nil, err
+	}
+	return &AWSOrganizationsService{client: client, tagFilters: tagFilters}, nil
+}
+
+// ListAccounts returns the active accounts of the organization matching the tag filters
+func (s *AWSOrganizationsService) ListAccounts(ctx context.Context) ([]*Account, error) {
+	accounts := make([]*Account, 0)
+	input := &organizations.ListAccountsInput{}
+	for {
+		output, err := s.client.ListAccountsWithContext(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the accounts of the organization: %w", err)
+		}
+		for _, account := range output.Accounts {
+			if aws.StringValue(account.Status) != organizations.AccountStatusActive {
+				// the suspended accounts and the ones pending closure cannot be bootstrapped
+				log.Debugf("account %s is %s, skipped", aws.StringValue(account.Id), aws.StringValue(account.Status))
+				continue
+			}
+			tags, err := s.listTags(ctx, aws.StringValue(account.Id))
+			if err != nil {
+				return nil, err
+			}
+			if !s.matchTags(tags) {
+				continue
+			}
+			accounts = append(accounts, &Account{
+				ID:    aws.StringValue(account.Id),
+				Name:  aws.StringValue(account.Name),
+				Email: aws.StringValue(account.Email),
+				ARN:   aws.StringValue(account.Arn),
+				Tags:  tags,
+			})
+		}
+		input.NextToken = output.NextToken
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+	}
+	return accounts, nil
+}
+
+func (s *AWSOrganizationsService) listTags(ctx context.Context, accountID string) (map[string]string, error) {
+	tags := make(map[string]string)
+	input := &organizations.ListTagsForResourceInput{ResourceId: aws.String(accountID)}
+	for {
+		output, err := s.client.ListTagsForResourceWithContext(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the tags of account %s: %w", accountID, err)
+		}
+		for _, tag := range output.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		input.NextToken = output.NextToken
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+	}
+	return tags, nil
+}
+
+// matchTags returns whether the given tags match the tag filters, like the tag filters of the AWS Resource Groups
+// Tagging API: the tags must have the keys of all the filters, and one of the values of the filters of each key, if any
+func (s *AWSOrganizationsService) matchTags(tags map[string]string) bool {
+	values := make(map[string][]string)
+	for _, tagFilter := range s.tagFilters {
+		if _, ok := values[tagFilter.Key]; !ok {
+			values[tagFilter.Key] = []string{}
+		}
+		if tagFilter.Value != "" {
+			values[tagFilter.Key] = append(values[tagFilter.Key], tagFilter.Value)
+		}
+	}
+	for key, allowed := range values {
+		value, ok := tags[key]
+		if !ok {
+			return false
+		}
+		if len(allowed) > 0 && !slices.Contains(allowed, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func createAWSOrganizationsClient(role string, region string) (*organizations.Organizations, error) {
+	podSession, err := session.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("error creating new AWS pod session: %w", err)
+	}
+	discoverySession := podSession
+	// assume role if provided - this allows to list the accounts with a role of the management account.
+	if role != "" {
+		log.Debugf("role %s is provided for AWS Organizations discovery", role)
+		assumeRoleCreds := stscreds.NewCredentials(podSession, role)
+		discoverySession, err = session.NewSession(&aws.Config{
+			Credentials: assumeRoleCreds,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating new AWS discovery session: %w", err)
+		}
+	} else {
+		log.Debugf("role is not provided for AWS Organizations discovery, using pod role")
+	}
+	// AWS Organizations is a global service, but its endpoint differs in the AWS GovCloud and China partitions.
+	if region != "" {
+		log.Debugf("region %s is provided for AWS Organizations discovery", region)
+		discoverySession = discoverySession.Copy(&aws.Config{
+			Region: aws.String(region),
+		})
+	} else {
+		log.Debugf("region is not provided for AWS Organizations discovery, using pod region")
+	}
+	return organizations.New(discoverySession), nil
+}
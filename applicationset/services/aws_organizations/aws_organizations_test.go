@@ -0,0 +1,136 @@
+package aws_organizations
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// fakeAWSOrganizationsClient returns the given accounts one per page
+type fakeAWSOrganizationsClient struct {
+	accounts []*organizations.Account
+	tags     map[string][]*organizations.Tag
+	err      error
+}
+
+func (c *fakeAWSOrganizationsClient) ListAccountsWithContext(_ aws.Context, input *organizations.ListAccountsInput, _ ...request.Option) (*organizations.ListAccountsOutput, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	output := &organizations.ListAccountsOutput{}
+	page := 0
+	if input.NextToken != nil {
+		for i, account := range c.accounts {
+			if aws.StringValue(account.Id) == aws.StringValue(input.NextToken) {
+				page = i
+			}
+		}
+	}
+	if page < len(c.accounts) {
+		output.Accounts = c.accounts[page : page+1]
+	}
+	if page+1 < len(c.accounts) {
+		output.NextToken = c.accounts[page+1].Id
+	}
+	return output, nil
+}
+
+func (c *fakeAWSOrganizationsClient) ListTagsForResourceWithContext(_ aws.Context, input *organizations.ListTagsForResourceInput, _ ...request.Option) (*organizations.ListTagsForResourceOutput, error) {
+	return &organizations.ListTagsForResourceOutput{Tags: c.tags[aws.StringValue(input.ResourceId)]}, nil
+}
+
+func account(id, name, status string) *organizations.Account {
+	return &organizations.Account{
+		Id:     aws.String(id),
+		Name:   aws.String(name),
+		Email:  aws.String(name + "@example.com"),
+		Arn:    aws.String("arn:aws:organizations::111111111111:account/o-example/" + id),
+		Status: aws.String(status),
+	}
+}
+
+func TestAWSOrganizationsListAccounts(t *testing.T) {
+	client := &fakeAWSOrganizationsClient{
+		accounts: []*organizations.Account{
+			account("222222222222", "dev", organizations.AccountStatusActive),
+			account("333333333333", "prod", organizations.AccountStatusActive),
+			account("444444444444", "sandbox", organizations.AccountStatusActive),
+			account("555555555555", "closed", organizations.AccountStatusSuspended),
+		},
+		tags: map[string][]*organizations.Tag{
+			"222222222222": {{Key: aws.String("env"), Value: aws.String("dev")}, {Key: aws.String("argocd"), Value: aws.String("true")}},
+			"333333333333": {{Key: aws.String("env"), Value: aws.String("prod")}, {Key: aws.String("argocd"), Value: aws.String("true")}},
+			"444444444444": {{Key: aws.String("env"), Value: aws.String("sandbox")}},
+			"555555555555": {{Key: aws.String("env"), Value: aws.String("prod")}, {Key: aws.String("argocd"), Value: aws.String("true")}},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		tagFilters  []*v1alpha1.TagFilter
+		expectedIDs []string
+	}{
+		{
+			name:        "All active accounts",
+			expectedIDs: []string{"222222222222", "333333333333", "444444444444"},
+		},
+		{
+			name:        "Tag key",
+			tagFilters:  []*v1alpha1.TagFilter{{Key: "argocd"}},
+			expectedIDs: []string{"222222222222", "333333333333"},
+		},
+		{
+			name:        "Tag values",
+			tagFilters:  []*v1alpha1.TagFilter{{Key: "env", Value: "dev"}, {Key: "env", Value: "sandbox"}},
+			expectedIDs: []string{"222222222222", "444444444444"},
+		},
+		{
+			name:        "Tag key and value",
+			tagFilters:  []*v1alpha1.TagFilter{{Key: "argocd"}, {Key: "env", Value: "prod"}},
+			expectedIDs: []string{"333333333333"},
+		},
+		{
+			name:       "No match",
+			tagFilters: []*v1alpha1.TagFilter{{Key: "team"}},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := &AWSOrganizationsService{client: client, tagFilters: testCase.tagFilters}
+			accounts, err := service.ListAccounts(t.Context())
+			require.NoError(t, err)
+			ids := make([]string, 0, len(accounts))
+			for _, account := range accounts {
+				ids = append(ids, account.ID)
+			}
+			assert.ElementsMatch(t, testCase.expectedIDs, ids)
+		})
+	}
+
+	t.Run("Account", func(t *testing.T) {
+		service := &AWSOrganizationsService{client: client, tagFilters: []*v1alpha1.TagFilter{{Key: "env", Value: "dev"}}}
+		accounts, err := service.ListAccounts(t.Context())
+		require.NoError(t, err)
+		require.Len(t, accounts, 1)
+		assert.Equal(t, &Account{
+			ID:    "222222222222",
+			Name:  "dev",
+			Email: "dev@example.com",
+			ARN:   "arn:aws:organizations::111111111111:account/o-example/222222222222",
+			Tags:  map[string]string{"env": "dev", "argocd": "true"},
+		}, accounts[0])
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		service := &AWSOrganizationsService{client: &fakeAWSOrganizationsClient{err: errors.New("access denied")}}
+		_, err := service.ListAccounts(t.Context())
+		require.ErrorContains(t, err, "access denied")
+	})
+}
@@ -1,8 +1,11 @@
 package services
 
 import (
+	"crypto/sha256"
+	"fmt"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -21,6 +24,9 @@ const (
 	githubAPIRateLimitLimitMetricName     = "argocd_github_api_rate_limit_limit"
 	githubAPIRateLimitResetMetricName     = "argocd_github_api_rate_limit_reset_seconds"
 	githubAPIRateLimitUsedMetricName      = "argocd_github_api_rate_limit_used"
+	githubAPISecondaryRateLimitMetricName = "argocd_github_api_secondary_rate_limit_hits_total"
+	githubAPIThrottledRequestsMetricName  = "argocd_github_api_throttled_requests_total"
+	githubAPIBackoffMetricName            = "argocd_github_api_backoff_seconds"
 )
 
 // GitHubMetrics groups all metric vectors for easier injection and registration
@@ -31,6 +37,9 @@ type GitHubMetrics struct {
 	RateLimitLimit     *prometheus.GaugeVec
 	RateLimitReset     *prometheus.GaugeVec
 	RateLimitUsed      *prometheus.GaugeVec
+	SecondaryRateLimit *prometheus.CounterVec
+	ThrottledRequests  *prometheus.CounterVec
+	Backoff            *prometheus.GaugeVec
 }
 
 // Factory for a new set of GitHub metrics (for tests or custom registries)
@@ -42,6 +51,9 @@ func NewGitHubMetrics() *GitHubMetrics {
 		RateLimitLimit:     NewGitHubAPIRateLimitLimit(),
 		RateLimitReset:     NewGitHubAPIRateLimitReset(),
 		RateLimitUsed:      NewGitHubAPIRateLimitUsed(),
+		SecondaryRateLimit: NewGitHubAPISecondaryRateLimit(),
+		ThrottledRequests:  NewGitHubAPIThrottledRequests(),
+		Backoff:            NewGitHubAPIBackoff(),
 	}
 }
 
@@ -107,6 +119,36 @@ func NewGitHubAPIRateLimitUsed() *prometheus.GaugeVec {
 	)
 }
 
+func NewGitHubAPISecondaryRateLimit() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: githubAPISecondaryRateLimitMetricName,
+			Help: "Total number of GitHub API requests rejected by a secondary rate limit",
+		},
+		[]string{"endpoint", "appset_namespace", "appset_name"},
+	)
+}
+
+func NewGitHubAPIThrottledRequests() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: githubAPIThrottledRequestsMetricName,
+			Help: "Total number of GitHub API requests not sent because the controller rate limit budget is exhausted",
+		},
+		[]string{"endpoint", "appset_namespace", "appset_name"},
+	)
+}
+
+func NewGitHubAPIBackoff() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: githubAPIBackoffMetricName,
+			Help: "The time left until GitHub API requests are allowed again by the controller rate limit budget, in seconds",
+		},
+		[]string{"host", "credential"},
+	)
+}
+
 // Global metrics (registered with the default registry)
 var globalGitHubMetrics = NewGitHubMetrics()
 
@@ -118,6 +160,187 @@ func init() {
 	metrics.Registry.MustRegister(globalGitHubMetrics.RateLimitLimit)
 	metrics.Registry.MustRegister(globalGitHubMetrics.RateLimitReset)
 	metrics.Registry.MustRegister(globalGitHubMetrics.RateLimitUsed)
+	metrics.Registry.MustRegister(globalGitHubMetrics.SecondaryRateLimit)
+	metrics.Registry.MustRegister(globalGitHubMetrics.ThrottledRequests)
+	metrics.Registry.MustRegister(globalGitHubMetrics.Backoff)
+}
+
+const (
+	// Time to wait after a secondary rate limit hit when GitHub does not send a Retry-After header
+	defaultSecondaryRateLimitBackoff = time.Minute
+	// Upper bound of the backoff applied after consecutive secondary rate limit hits
+	maxSecondaryRateLimitBackoff = 15 * time.Minute
+)
+
+// GitHubRateLimitBudget tracks a rate limit of the GitHub API, so that the generators of all the ApplicationSets
+// using it stop calling the GitHub API together once it is close to be exceeded, instead of each of them running into
+// it and getting the whole organization throttled.
+type GitHubRateLimitBudget struct {
+	mu sync.Mutex
+	// reserve is the number of requests of the primary rate limit that are left unused. Zero disables the check.
+	reserve int
+	// blockedUntil is the time until which no request is sent
+	blockedUntil time.Time
+	// backoff is the current backoff after secondary rate limit hits, doubled on each consecutive hit
+	backoff time.Duration
+	now     func() time.Time
+}
+
+// NewGitHubRateLimitBudget returns a budget keeping the given number of requests of the primary rate limit unused
+func NewGitHubRateLimitBudget(reserve int) *GitHubRateLimitBudget {
+	return &GitHubRateLimitBudget{reserve: reserve, now: time.Now}
+}
+
+// SetReserve updates the number of requests of the primary rate limit that are left unused
+func (b *GitHubRateLimitBudget) SetReserve(reserve int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.reserve = reserve
+}
+
+// wait returns how long requests must still be held back, or zero if they are allowed
+func (b *GitHubRateLimitBudget) wait() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if wait := b.blockedUntil.Sub(b.now()); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// update adjusts the budget to the rate limit state reported by a GitHub API response. It returns whether the
+// response is a secondary rate limit rejection.
+func (b *GitHubRateLimitBudget) update(resp *http.Response) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.reserve == 0 {
+		return false
+	}
+	now := b.now()
+	remaining, remainingErr := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+
+	if isSecondaryRateLimit(resp, remaining, remainingErr) {
+		wait := defaultSecondaryRateLimitBackoff
+		if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && retryAfter > 0 {
+			wait = time.Duration(retryAfter) * time.Second
+		}
+		// Back off further on consecutive hits, since the secondary limits are not published
+		b.backoff = min(max(wait, 2*b.backoff), maxSecondaryRateLimitBackoff)
+		b.block(now.Add(b.backoff))
+		return true
+	}
+	if resp.StatusCode < http.StatusBadRequest {
+		b.backoff = 0
+	}
+
+	if remainingErr == nil && (remaining == 0 || remaining <= b.reserve) {
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			b.block(time.Unix(reset, 0))
+		}
+	}
+	return false
+}
+
+func (b *GitHubRateLimitBudget) block(until time.Time) {
+	if until.After(b.blockedUntil) {
+		b.blockedUntil = until
+	}
+}
+
+// isSecondaryRateLimit returns whether the response is a rejection by a secondary rate limit. GitHub answers with a
+// 403 or 429 status in that case, while the primary rate limit still has remaining requests.
+func isSecondaryRateLimit(resp *http.Response, remaining int, remainingErr error) bool {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+	if remainingErr == nil && remaining == 0 {
+		return false
+	}
+	// A 403 is also returned for missing permissions, which comes without a Retry-After header
+	return resp.StatusCode == http.StatusTooManyRequests || resp.Header.Get("Retry-After") != ""
+}
+
+// Time after which the budget of a rate limit that is no longer used is dropped, e.g. once the token of a GitHub App
+// installation has been renewed
+const gitHubRateLimitBudgetIdleTimeout = 2 * time.Hour
+
+// gitHubRateLimitKey identifies a rate limit of the GitHub API, which GitHub counts per API host and credential
+type gitHubRateLimitKey struct {
+	host string
+	// credential is a hash of the Authorization header of the requests, so that the tokens are not kept around
+	credential string
+}
+
+// gitHubRateLimitKeyOf returns the key of the rate limit the given request counts against
+func gitHubRateLimitKeyOf(req *http.Request) gitHubRateLimitKey {
+	key := gitHubRateLimitKey{host: req.URL.Host}
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		key.credential = fmt.Sprintf("%x", sha256.Sum256([]byte(auth)))
+	}
+	return key
+}
+
+type gitHubRateLimitBudgetEntry struct {
+	budget   *GitHubRateLimitBudget
+	lastUsed time.Time
+}
+
+// GitHubRateLimitBudgets holds the budgets of the rate limits used by the GitHub clients of the controller, one per
+// API host and credential
+type GitHubRateLimitBudgets struct {
+	mu      sync.Mutex
+	reserve int
+	budgets map[gitHubRateLimitKey]*gitHubRateLimitBudgetEntry
+	now     func() time.Time
+}
+
+// NewGitHubRateLimitBudgets returns budgets keeping the given number of requests of each primary rate limit unused
+func NewGitHubRateLimitBudgets(reserve int) *GitHubRateLimitBudgets {
+	return &GitHubRateLimitBudgets{reserve: reserve, budgets: map[gitHubRateLimitKey]*gitHubRateLimitBudgetEntry{}, now: time.Now}
+}
+
+// SetReserve updates the number of requests of each primary rate limit that are left unused
+func (b *GitHubRateLimitBudgets) SetReserve(reserve int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.reserve = reserve
+	for _, entry := range b.budgets {
+		entry.budget.SetReserve(reserve)
+	}
+}
+
+// get returns the budget of the rate limit the given request counts against, or nil if the budgets are disabled
+func (b *GitHubRateLimitBudgets) get(req *http.Request) *GitHubRateLimitBudget {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.reserve == 0 {
+		return nil
+	}
+	now := b.now()
+	for key, entry := range b.budgets {
+		if now.Sub(entry.lastUsed) > gitHubRateLimitBudgetIdleTimeout && entry.budget.wait() == 0 {
+			delete(b.budgets, key)
+		}
+	}
+	key := gitHubRateLimitKeyOf(req)
+	entry, ok := b.budgets[key]
+	if !ok {
+		budget := NewGitHubRateLimitBudget(b.reserve)
+		budget.now = b.now
+		entry = &gitHubRateLimitBudgetEntry{budget: budget}
+		b.budgets[key] = entry
+	}
+	entry.lastUsed = now
+	return entry.budget
+}
+
+// Global budgets shared by the default GitHub clients
+var globalGitHubRateLimitBudgets = NewGitHubRateLimitBudgets(0)
+
+// SetGitHubRateLimitReserve sets the number of requests of each primary rate limit that the GitHub clients of the
+// controller leave unused
+func SetGitHubRateLimitReserve(reserve int) {
+	globalGitHubRateLimitBudgets.SetReserve(reserve)
 }
 
 type MetricsContext struct {
@@ -130,6 +353,8 @@ type GitHubMetricsTransport struct {
 	transport      http.RoundTripper
 	metricsContext *MetricsContext
 	metrics        *GitHubMetrics
+	// budgets hold requests back when their rate limit is close to be exceeded. Nil disables them.
+	budgets *GitHubRateLimitBudgets
 }
 
 // RoundTrip implements http.RoundTripper interface and collects metrics along with debug logging
@@ -151,6 +376,20 @@ func (t *GitHubMetricsTransport) RoundTrip(req *http.Request) (*http.Response, e
 		"applicationset": map[string]string{"name": appsetName, "namespace": appsetNamespace},
 	}).Debugf("Invoking GitHub API")
 
+	var budget *GitHubRateLimitBudget
+	if t.budgets != nil {
+		budget = t.budgets.get(req)
+	}
+	// the backoff is reported per rate limit, like the budgets
+	budgetKey := gitHubRateLimitKeyOf(req)
+	if budget != nil {
+		if wait := budget.wait(); wait > 0 {
+			t.metrics.ThrottledRequests.WithLabelValues(endpoint, appsetNamespace, appsetName).Inc()
+			t.metrics.Backoff.WithLabelValues(budgetKey.host, budgetKey.credential).Set(wait.Seconds())
+			return nil, fmt.Errorf("GitHub API rate limit budget exhausted, requests are held back for %s", wait.Round(time.Second))
+		}
+	}
+
 	startTime := time.Now()
 	resp, err := t.transport.RoundTrip(req)
 	duration := time.Since(startTime)
@@ -205,6 +444,17 @@ func (t *GitHubMetricsTransport) RoundTrip(req *http.Request) (*http.Response, e
 			"resource":       resource,
 			"applicationset": map[string]string{"name": appsetName, "namespace": appsetNamespace},
 		}).Debugf("GitHub API rate limit info")
+
+		if budget != nil {
+			if budget.update(resp) {
+				t.metrics.SecondaryRateLimit.WithLabelValues(endpoint, appsetNamespace, appsetName).Inc()
+				log.WithFields(log.Fields{
+					"endpoint":       endpoint,
+					"applicationset": map[string]string{"name": appsetName, "namespace": appsetNamespace},
+				}).Warn("GitHub API secondary rate limit exceeded, backing off")
+			}
+			t.metrics.Backoff.WithLabelValues(budgetKey.host, budgetKey.credential).Set(budget.wait().Seconds())
+		}
 	}
 
 	return resp, err
@@ -223,13 +473,15 @@ func NewGitHubMetricsTransport(
 	}
 }
 
-// Default constructor
+// Default constructor, using the global metrics and rate limit budgets
 func NewDefaultGitHubMetricsTransport(transport http.RoundTripper, metricsContext *MetricsContext) *GitHubMetricsTransport {
-	return NewGitHubMetricsTransport(
+	t := NewGitHubMetricsTransport(
 		transport,
 		metricsContext,
 		globalGitHubMetrics,
 	)
+	t.budgets = globalGitHubRateLimitBudgets
+	return t
 }
 
 // NewGitHubMetricsClient wraps an http.Client with metrics middleware
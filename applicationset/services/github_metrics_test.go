@@ -13,6 +13,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type Metric struct {
@@ -219,3 +220,166 @@ func TestNewGitHubMetricsClient(t *testing.T) {
 		})
 	}
 }
+
+func TestGitHubRateLimitBudget(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	newResponse := func(status int, headers map[string]string) *http.Response {
+		resp := &http.Response{StatusCode: status, Header: http.Header{}}
+		for k, v := range headers {
+			resp.Header.Set(k, v)
+		}
+		return resp
+	}
+	newBudget := func(reserve int) *GitHubRateLimitBudget {
+		budget := NewGitHubRateLimitBudget(reserve)
+		budget.now = func() time.Time { return now }
+		return budget
+	}
+
+	t.Run("Requests are allowed while the reserve is not reached", func(t *testing.T) {
+		budget := newBudget(10)
+		assert.False(t, budget.update(newResponse(http.StatusOK, map[string]string{"X-RateLimit-Remaining": "11", "X-RateLimit-Reset": strconv.FormatInt(now.Unix()+60, 10)})))
+		assert.Zero(t, budget.wait())
+	})
+
+	t.Run("Requests are held back until the reset once the reserve is reached", func(t *testing.T) {
+		budget := newBudget(10)
+		assert.False(t, budget.update(newResponse(http.StatusOK, map[string]string{"X-RateLimit-Remaining": "10", "X-RateLimit-Reset": strconv.FormatInt(now.Unix()+60, 10)})))
+		assert.Equal(t, time.Minute, budget.wait())
+	})
+
+	t.Run("Exhausted primary rate limit is not a secondary rate limit", func(t *testing.T) {
+		budget := newBudget(1)
+		assert.False(t, budget.update(newResponse(http.StatusForbidden, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": strconv.FormatInt(now.Unix()+30, 10)})))
+		assert.Equal(t, 30*time.Second, budget.wait())
+	})
+
+	t.Run("Forbidden without Retry-After is not a secondary rate limit", func(t *testing.T) {
+		budget := newBudget(1)
+		assert.False(t, budget.update(newResponse(http.StatusForbidden, map[string]string{"X-RateLimit-Remaining": "100"})))
+		assert.Zero(t, budget.wait())
+	})
+
+	t.Run("Secondary rate limit backoff doubles on consecutive hits", func(t *testing.T) {
+		budget := newBudget(1)
+		assert.True(t, budget.update(newResponse(http.StatusForbidden, map[string]string{"X-RateLimit-Remaining": "100", "Retry-After": "30"})))
+		assert.Equal(t, 30*time.Second, budget.wait())
+		assert.True(t, budget.update(newResponse(http.StatusTooManyRequests, map[string]string{"Retry-After": "30"})))
+		assert.Equal(t, time.Minute, budget.wait())
+		assert.True(t, budget.update(newResponse(http.StatusTooManyRequests, nil)))
+		assert.Equal(t, 2*time.Minute, budget.wait())
+
+		budget.backoff = maxSecondaryRateLimitBackoff
+		assert.True(t, budget.update(newResponse(http.StatusTooManyRequests, nil)))
+		assert.Equal(t, maxSecondaryRateLimitBackoff, budget.wait())
+	})
+
+	t.Run("Disabled budget never holds requests back", func(t *testing.T) {
+		budget := newBudget(0)
+		assert.False(t, budget.update(newResponse(http.StatusOK, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": strconv.FormatInt(now.Unix()+60, 10)})))
+		assert.False(t, budget.update(newResponse(http.StatusTooManyRequests, map[string]string{"Retry-After": "30"})))
+		assert.Zero(t, budget.wait())
+		assert.Zero(t, budget.backoff)
+	})
+
+	t.Run("Backoff is reset by a successful request", func(t *testing.T) {
+		budget := newBudget(1)
+		assert.True(t, budget.update(newResponse(http.StatusTooManyRequests, nil)))
+		assert.False(t, budget.update(newResponse(http.StatusOK, nil)))
+		assert.Zero(t, budget.backoff)
+	})
+}
+
+func TestGitHubRateLimitBudgets(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	newRequest := func(url, token string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, url, http.NoBody)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		return req
+	}
+	exhausted := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	exhausted.Header.Set("X-RateLimit-Remaining", "0")
+	exhausted.Header.Set("X-RateLimit-Reset", strconv.FormatInt(now.Unix()+60, 10))
+
+	t.Run("Budgets are kept per API host and credential", func(t *testing.T) {
+		budgets := NewGitHubRateLimitBudgets(10)
+		budgets.now = func() time.Time { return now }
+		budgets.get(newRequest("https://api.github.com/repos/a/b", "token-a")).update(exhausted)
+
+		assert.Equal(t, time.Minute, budgets.get(newRequest("https://api.github.com/repos/c/d", "token-a")).wait())
+		assert.Zero(t, budgets.get(newRequest("https://api.github.com/repos/a/b", "token-b")).wait())
+		assert.Zero(t, budgets.get(newRequest("https://api.github.com/repos/a/b", "")).wait())
+		assert.Zero(t, budgets.get(newRequest("https://github.example.com/api/v3/repos/a/b", "token-a")).wait())
+		for key := range budgets.budgets {
+			assert.NotContains(t, key.credential, "token")
+		}
+	})
+
+	t.Run("Idle budgets are dropped", func(t *testing.T) {
+		budgets := NewGitHubRateLimitBudgets(10)
+		budgets.now = func() time.Time { return now }
+		budgets.get(newRequest("https://api.github.com/repos/a/b", "token-a"))
+		budgets.now = func() time.Time { return now.Add(gitHubRateLimitBudgetIdleTimeout + time.Second) }
+		budgets.get(newRequest("https://api.github.com/repos/a/b", "token-b"))
+		assert.Len(t, budgets.budgets, 1)
+	})
+
+	t.Run("Disabled budgets", func(t *testing.T) {
+		budgets := NewGitHubRateLimitBudgets(0)
+		assert.Nil(t, budgets.get(newRequest("https://api.github.com/repos/a/b", "token-a")))
+		budgets.SetReserve(10)
+		assert.NotNil(t, budgets.get(newRequest("https://api.github.com/repos/a/b", "token-a")))
+	})
+}
+
+func TestGitHubMetricsTransport_RateLimitBudget(t *testing.T) {
+	metrics := NewGitHubMetrics()
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(metrics.SecondaryRateLimit, metrics.ThrottledRequests, metrics.Backoff)
+
+	calls := 0
+	client := &http.Client{
+		Transport: &GitHubMetricsTransport{
+			transport: RoundTripperFunc(func(*http.Request) (*http.Response, error) {
+				calls++
+				resp := &http.Response{StatusCode: http.StatusForbidden, Header: http.Header{}, Body: http.NoBody}
+				resp.Header.Set("Retry-After", "60")
+				return resp, nil
+			}),
+			metricsContext: &MetricsContext{AppSetNamespace: appsetNamespace, AppSetName: appsetName},
+			metrics:        metrics,
+			budgets:        NewGitHubRateLimitBudgets(1),
+		},
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.github.com"+URL, http.NoBody)
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// The second request is not sent while backing off
+	_, err = client.Do(req)
+	require.ErrorContains(t, err, "GitHub API rate limit budget exhausted")
+	assert.Equal(t, 1, calls)
+
+	handler := promhttp.HandlerFor(reg, promhttp.HandlerOpts{})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err = http.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	metricsOutput := string(body)
+
+	labels := strings.Join([]string{appsetNameLabel, appsetNamespaceLabel, endpointLabel}, ",")
+	assert.Contains(t, metricsOutput, githubAPISecondaryRateLimitMetricName+"{"+labels+"} 1")
+	assert.Contains(t, metricsOutput, githubAPIThrottledRequestsMetricName+"{"+labels+"} 1")
+	key := gitHubRateLimitKeyOf(req)
+	assert.Contains(t, metricsOutput, githubAPIBackoffMetricName+`{credential="`+key.credential+`",host="api.github.com"} `)
+	assert.NotContains(t, metricsOutput, "token")
+}
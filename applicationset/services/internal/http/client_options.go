@@ -1,6 +1,9 @@
 package http
 
-import "time"
+import (
+	"net/http"
+	"time"
+)
 
 // ClientOptionFunc can be used to customize a new Restful API client.
 type ClientOptionFunc func(*Client) error
@@ -20,3 +23,11 @@ func WithTimeout(timeout int) ClientOptionFunc {
 		return nil
 	}
 }
+
+// WithTransport can be used to configure a custom transport for requests.
+func WithTransport(transport http.RoundTripper) ClientOptionFunc {
+	return func(c *Client) error {
+		c.client.Transport = transport
+		return nil
+	}
+}
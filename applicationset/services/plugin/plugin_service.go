@@ -7,6 +7,7 @@ import (
 
 	internalhttp "github.com/argoproj/argo-cd/v3/applicationset/services/internal/http"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/security"
 )
 
 // ServiceRequest is the request object sent to the plugin service.
@@ -34,10 +35,16 @@ type Service struct {
 	appSetName string
 }
 
-func NewPluginService(appSetName string, baseURL string, token string, requestTimeout int) (*Service, error) {
+// NewPluginService creates a client of the plugin at the given URL, which must be allowed by the outbound URL policy
+func NewPluginService(appSetName string, baseURL string, token string, requestTimeout int, outboundURLPolicy *security.OutboundURLPolicy) (*Service, error) {
+	if err := outboundURLPolicy.ValidateURL(baseURL); err != nil {
+		return nil, fmt.Errorf("invalid plugin URL: %w", err)
+	}
+
 	var clientOptionFns []internalhttp.ClientOptionFunc
 
 	clientOptionFns = append(clientOptionFns, internalhttp.WithToken(token))
+	clientOptionFns = append(clientOptionFns, internalhttp.WithTransport(outboundURLPolicy.WrapTransport(nil)))
 
 	if requestTimeout != 0 {
 		clientOptionFns = append(clientOptionFns, internalhttp.WithTimeout(requestTimeout))
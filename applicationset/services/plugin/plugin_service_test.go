@@ -9,6 +9,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/security"
 )
 
 func TestPlugin(t *testing.T) {
@@ -30,7 +32,7 @@ func TestPlugin(t *testing.T) {
 	ts := httptest.NewServer(handler)
 	defer ts.Close()
 
-	client, err := NewPluginService("plugin-test", ts.URL, token, 0)
+	client, err := NewPluginService("plugin-test", ts.URL, token, 0, nil)
 	require.NoError(t, err)
 
 	data, err := client.List(t.Context(), nil)
@@ -41,3 +43,13 @@ func TestPlugin(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, &expectedData, data)
 }
+
+func TestPluginOutboundURLPolicy(t *testing.T) {
+	_, err := NewPluginService("plugin-test", "http://169.254.169.254/latest", "token", 0, nil)
+	require.ErrorContains(t, err, "instance metadata service")
+
+	policy, err := security.NewOutboundURLPolicy([]string{"plugins.example.com"}, nil, false)
+	require.NoError(t, err)
+	_, err = NewPluginService("plugin-test", "http://plugin.argocd.svc:4355", "token", 0, policy)
+	assert.ErrorContains(t, err, "host plugin.argocd.svc is not allowed")
+}
@@ -76,7 +76,7 @@ func (g *AzureDevOpsProvider) ListRepos(ctx context.Context, _ string) ([]*Repos
 	if err != nil {
 		return nil, fmt.Errorf("failed to get Azure DevOps client: %w", err)
 	}
-	getRepoArgs := azureGit.GetRepositoriesArgs{Project: &g.teamProject}
+	getRepoArgs := azureGit.GetRepositoriesArgs{Project: g.project()}
 	azureRepos, err := gitClient.GetRepositories(ctx, getRepoArgs)
 	if err != nil {
 		return nil, err
@@ -105,15 +105,9 @@ func (g *AzureDevOpsProvider) RepoHasPath(ctx context.Context, repo *Repository,
 		return false, fmt.Errorf("failed to get Azure DevOps client: %w", err)
 	}
 
-	var repoId string
-	if uuid, isUUID := repo.RepositoryId.(uuid.UUID); isUUID { // most likely an UUID, but do type-safe check anyway. Do %v fallback if not expected type.
-		repoId = uuid.String()
-	} else {
-		repoId = fmt.Sprintf("%v", repo.RepositoryId)
-	}
-
+	repoId := getRepositoryId(repo)
 	branchName := repo.Branch
-	getItemArgs := azureGit.GetItemArgs{RepositoryId: &repoId, Project: &g.teamProject, Path: &path, VersionDescriptor: &azureGit.GitVersionDescriptor{Version: &branchName}}
+	getItemArgs := azureGit.GetItemArgs{RepositoryId: &repoId, Project: g.project(), Path: &path, VersionDescriptor: &azureGit.GitVersionDescriptor{Version: &branchName}}
 	_, err = gitClient.GetItem(ctx, getItemArgs)
 	if err != nil {
 		var wrappedError azuredevops.WrappedError
@@ -139,7 +133,7 @@ func (g *AzureDevOpsProvider) GetBranches(ctx context.Context, repo *Repository)
 
 	if !g.allBranches {
 		defaultBranchName := strings.Replace(repo.Branch, "refs/heads/", "", 1) // Azure DevOps returns default branch info like 'refs/heads/main', but does not support branch lookup of this format.
-		getBranchArgs := azureGit.GetBranchArgs{RepositoryId: &repo.Repository, Project: &g.teamProject, Name: &defaultBranchName}
+		getBranchArgs := azureGit.GetBranchArgs{RepositoryId: g.repository(repo), Project: g.project(), Name: &defaultBranchName}
 		branchResult, err := gitClient.GetBranch(ctx, getBranchArgs)
 		if err != nil {
 			var wrappedError azuredevops.WrappedError
@@ -168,7 +162,7 @@ func (g *AzureDevOpsProvider) GetBranches(ctx context.Context, repo *Repository)
 		return repos, nil
 	}
 
-	getBranchesRequest := azureGit.GetBranchesArgs{RepositoryId: &repo.Repository, Project: &g.teamProject}
+	getBranchesRequest := azureGit.GetBranchesArgs{RepositoryId: g.repository(repo), Project: g.project()}
 	branches, err := gitClient.GetBranches(ctx, getBranchesRequest)
 	if err != nil {
 		var wrappedError azuredevops.WrappedError
@@ -199,6 +193,32 @@ func (g *AzureDevOpsProvider) GetBranches(ctx context.Context, repo *Repository)
 	return repos, nil
 }
 
+// project returns the team project of the requests, or nil to discover the repositories of all the projects of the
+// organization.
+func (g *AzureDevOpsProvider) project() *string {
+	if g.teamProject == "" {
+		return nil
+	}
+	return &g.teamProject
+}
+
+// repository returns the identifier of the given repository in the requests. Repository names are only unique within a
+// team project, so the repositories discovered in the whole organization are identified by their ID.
+func (g *AzureDevOpsProvider) repository(repo *Repository) *string {
+	if g.teamProject == "" {
+		repoId := getRepositoryId(repo)
+		return &repoId
+	}
+	return &repo.Repository
+}
+
+func getRepositoryId(repo *Repository) string {
+	if uuid, isUUID := repo.RepositoryId.(uuid.UUID); isUUID { // most likely an UUID, but do type-safe check anyway. Do %v fallback if not expected type.
+		return uuid.String()
+	}
+	return fmt.Sprintf("%v", repo.RepositoryId)
+}
+
 func getValidDevOpsURL(url string, org string) (string, error) {
 	if url == "" {
 		url = AZURE_DEVOPS_DEFAULT_URL
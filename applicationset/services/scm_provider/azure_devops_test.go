@@ -503,6 +503,44 @@ func TestGetAzureDevopsRepositories(t *testing.T) {
 	}
 }
 
+func TestAzureDevOpsOrganizationRepositories(t *testing.T) {
+	organization := "myorg"
+	ctx := t.Context()
+	repoId := uuid.New()
+	repoIdString := repoId.String()
+
+	gitClientMock := azureMock.Client{}
+	gitClientMock.On("GetRepositories", ctx, azureGit.GetRepositoriesArgs{}).Return(&[]azureGit.GitRepository{
+		{Name: s("repo1"), DefaultBranch: s("refs/heads/main"), RemoteUrl: s("https://remoteurl.u"), Id: &repoId},
+	}, nil)
+	gitClientMock.On("GetBranch", ctx, azureGit.GetBranchArgs{RepositoryId: &repoIdString, Name: s("main")}).
+		Return(&azureGit.GitBranchStats{Name: s("main"), Commit: &azureGit.GitCommitRef{CommitId: s("abc123")}}, nil)
+	gitClientMock.On("GetItem", ctx, azureGit.GetItemArgs{RepositoryId: &repoIdString, Path: s("kustomization.yaml"), VersionDescriptor: &azureGit.GitVersionDescriptor{Version: s("refs/heads/main")}}).
+		Return(&azureGit.GitItem{}, nil)
+
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock)
+
+	// without team project, the repositories of all the projects of the organization are discovered and identified by their ID
+	provider := AzureDevOpsProvider{organization: organization, clientFactory: clientFactoryMock}
+
+	repos, err := provider.ListRepos(ctx, "https")
+	require.NoError(t, err)
+	require.Len(t, repos, 1)
+	assert.Equal(t, "repo1", repos[0].Repository)
+
+	hasPath, err := provider.RepoHasPath(ctx, repos[0], "kustomization.yaml")
+	require.NoError(t, err)
+	assert.True(t, hasPath)
+
+	branches, err := provider.GetBranches(ctx, repos[0])
+	require.NoError(t, err)
+	require.Len(t, branches, 1)
+	assert.Equal(t, "abc123", branches[0].SHA)
+
+	gitClientMock.AssertExpectations(t)
+}
+
 type AzureClientFactoryMock struct {
 	mock *mock.Mock
 }
@@ -46,6 +46,9 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, ign
 		if err := mutate(f, key, obj); err != nil {
 			return controllerutil.OperationResultNone, err
 		}
+		if err := preserveUserValues(ignoreAppDifferences, nil, obj); err != nil {
+			return controllerutil.OperationResultNone, fmt.Errorf("failed to preserve user values: %w", err)
+		}
 		if err := c.Create(ctx, obj); err != nil {
 			return controllerutil.OperationResultNone, err
 		}
@@ -59,6 +62,12 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, ign
 		return controllerutil.OperationResultNone, err
 	}
 
+	// Apply the ignoreApplicationDifferences rules preserving the user values, so that the fields changed on the live
+	// application keep their value.
+	if err := preserveUserValues(ignoreAppDifferences, normalizedLive, obj); err != nil {
+		return controllerutil.OperationResultNone, fmt.Errorf("failed to preserve user values: %w", err)
+	}
+
 	// Apply ignoreApplicationDifferences rules to remove ignored fields from both the live and the desired state. This
 	// prevents those differences from appearing in the diff and therefore in the patch.
 	err := applyIgnoreDifferences(ignoreAppDifferences, normalizedLive, obj, ignoreNormalizerOpts)
@@ -136,13 +145,17 @@ func mutate(f controllerutil.MutateFn, key client.ObjectKey, obj client.Object)
 
 // applyIgnoreDifferences applies the ignore differences rules to the found application. It modifies the applications in place.
 func applyIgnoreDifferences(applicationSetIgnoreDifferences argov1alpha1.ApplicationSetIgnoreDifferences, found *argov1alpha1.Application, generatedApp *argov1alpha1.Application, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts) error {
-	if len(applicationSetIgnoreDifferences) == 0 {
+	ignoreDifferences, err := toApplicationIgnoreDifferences(applicationSetIgnoreDifferences)
+	if err != nil {
+		return err
+	}
+	if len(ignoreDifferences) == 0 {
 		return nil
 	}
 
 	generatedAppCopy := generatedApp.DeepCopy()
 	diffConfig, err := argodiff.NewDiffConfigBuilder().
-		WithDiffSettings(applicationSetIgnoreDifferences.ToApplicationIgnoreDifferences(), nil, false, ignoreNormalizerOpts).
+		WithDiffSettings(ignoreDifferences, nil, false, ignoreNormalizerOpts).
 		WithNoCache().
 		Build()
 	if err != nil {
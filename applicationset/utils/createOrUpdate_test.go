@@ -50,6 +50,24 @@ spec:
   source:
     targetRevision: bar`,
 			expectedApp: `
+spec:
+  source:
+    targetRevision: foo`,
+		},
+		{
+			name: "ignore target revision with JSONPath",
+			ignoreDifferences: v1alpha1.ApplicationSetIgnoreDifferences{
+				{JSONPathExpressions: []string{"{.spec.source.targetRevision}"}},
+			},
+			foundApp: `
+spec:
+  source:
+    targetRevision: foo`,
+			generatedApp: `
+spec:
+  source:
+    targetRevision: bar`,
+			expectedApp: `
 spec:
   source:
     targetRevision: foo`,
@@ -0,0 +1,456 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/itchyny/gojq"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+)
+
+var (
+	jqIdentifierRegex     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	jsonPathIntegerRegex  = regexp.MustCompile(`^-?[0-9]+$`)
+	jsonPathFilterRegex   = regexp.MustCompile(`^@((?:\.[^.=!<>\s]+)+)\s*(?:(==|!=)\s*(.+))?$`)
+	jsonPathNumberLiteral = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+)
+
+// jsonPathToJQ translates the given JSONPath expression, in the syntax of kubectl, to the equivalent JQ path
+// expression. Fields, indexes, wildcards and filters comparing a field to a literal with == or != are supported, e.g.
+// {.spec.sources[?(@.repoURL=="https://github.com/org/repo")].targetRevision}.
+func jsonPathToJQ(expr string) (string, error) {
+	path := strings.TrimSpace(expr)
+	if strings.HasPrefix(path, "{") && strings.HasSuffix(path, "}") {
+		path = strings.TrimSpace(path[1 : len(path)-1])
+	}
+	path = strings.TrimPrefix(path, "$")
+	if path != "" && path[0] != '.' && path[0] != '[' {
+		path = "." + path
+	}
+	var jq strings.Builder
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '.':
+			i++
+			if i < len(path) && path[i] == '*' {
+				jq.WriteString("[]")
+				i++
+				continue
+			}
+			end := i
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+			if end == i {
+				return "", fmt.Errorf("invalid JSONPath expression %q: empty field name", expr)
+			}
+			writeJQField(&jq, path[i:end])
+			i = end
+		case '[':
+			end := closingBracket(path, i)
+			if end < 0 {
+				return "", fmt.Errorf("invalid JSONPath expression %q: unterminated bracket", expr)
+			}
+			content := strings.TrimSpace(path[i+1 : end])
+			switch {
+			case content == "*":
+				jq.WriteString("[]")
+			case jsonPathIntegerRegex.MatchString(content):
+				jq.WriteString("[" + content + "]")
+			case isQuoted(content):
+				writeJQField(&jq, content[1:len(content)-1])
+			case strings.HasPrefix(content, "?(") && strings.HasSuffix(content, ")"):
+				filter, err := jsonPathFilterToJQ(strings.TrimSpace(content[2 : len(content)-1]))
+				if err != nil {
+					return "", fmt.Errorf("invalid JSONPath expression %q: %w", expr, err)
+				}
+				jq.WriteString("[] | select(" + filter + ")")
+			default:
+				return "", fmt.Errorf("invalid JSONPath expression %q: unsupported subscript [%s]", expr, content)
+			}
+			i = end + 1
+		default:
+			return "", fmt.Errorf("invalid JSONPath expression %q: unexpected character %q", expr, path[i])
+		}
+	}
+	if jq.Len() == 0 {
+		return "", fmt.Errorf("invalid JSONPath expression %q: empty path", expr)
+	}
+	return jq.String(), nil
+}
+
+// jsonPathFilterToJQ translates the condition of a JSONPath filter, e.g. @.name=="image.tag", to a JQ condition
+func jsonPathFilterToJQ(filter string) (string, error) {
+	matches := jsonPathFilterRegex.FindStringSubmatch(filter)
+	if matches == nil {
+		return "", fmt.Errorf("unsupported filter %q", filter)
+	}
+	var field strings.Builder
+	for _, name := range strings.Split(matches[1], ".")[1:] {
+		writeJQField(&field, name)
+	}
+	if matches[2] == "" {
+		return field.String() + " != null", nil
+	}
+	literal := strings.TrimSpace(matches[3])
+	switch {
+	case isQuoted(literal):
+		literal = strconv.Quote(literal[1 : len(literal)-1])
+	case jsonPathNumberLiteral.MatchString(literal), literal == "true", literal == "false", literal == "null":
+	default:
+		return "", fmt.Errorf("unsupported literal %s in filter %q", literal, filter)
+	}
+	return field.String() + " " + matches[2] + " " + literal, nil
+}
+
+func writeJQField(jq *strings.Builder, name string) {
+	switch {
+	case jqIdentifierRegex.MatchString(name):
+		jq.WriteString("." + name)
+	case jq.Len() == 0:
+		jq.WriteString(".[" + strconv.Quote(name) + "]")
+	default:
+		jq.WriteString("[" + strconv.Quote(name) + "]")
+	}
+}
+
+func isQuoted(s string) bool {
+	return len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0]
+}
+
+// closingBracket returns the index of the bracket closing the one at the given index, ignoring the quoted brackets,
+// or -1 if the bracket is not closed
+func closingBracket(s string, start int) int {
+	var quote byte
+	for i := start + 1; i < len(s); i++ {
+		switch {
+		case quote != 0:
+			if s[i] == quote {
+				quote = 0
+			}
+		case s[i] == '\'' || s[i] == '"':
+			quote = s[i]
+		case s[i] == ']':
+			return i
+		}
+	}
+	return -1
+}
+
+// jqPathExpressions returns the JQ path expressions of the given rule, including its translated JSONPath expressions
+func jqPathExpressions(rule argov1alpha1.ApplicationSetResourceIgnoreDifferences) ([]string, error) {
+	expressions := append([]string{}, rule.JQPathExpressions...)
+	for _, expr := range rule.JSONPathExpressions {
+		jq, err := jsonPathToJQ(expr)
+		if err != nil {
+			return nil, err
+		}
+		expressions = append(expressions, jq)
+	}
+	return expressions, nil
+}
+
+// toApplicationIgnoreDifferences returns the rules ignoring the differences of the given rules, with their JSONPath
+// expressions translated to JQ path expressions
+func toApplicationIgnoreDifferences(ignoreDifferences argov1alpha1.ApplicationSetIgnoreDifferences) ([]argov1alpha1.ResourceIgnoreDifferences, error) {
+	var result []argov1alpha1.ResourceIgnoreDifferences
+	for _, rule := range ignoreDifferences {
+		if rule.MergeMode.PreservesUserValue() {
+			continue
+		}
+		expressions, err := jqPathExpressions(rule)
+		if err != nil {
+			return nil, err
+		}
+		item := rule.ToApplicationResourceIgnoreDifferences()
+		item.JQPathExpressions = expressions
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// preserveUserValues applies the rules whose merge mode is preserve-user-value to the generated application: the
+// fields of the rules which were changed on the found application since the values of the template were last applied
+// keep the value of the found application. The values of the template applied to the fields are recorded in an
+// annotation of the generated application. The found application is nil if the application is created.
+func preserveUserValues(ignoreDifferences argov1alpha1.ApplicationSetIgnoreDifferences, found *argov1alpha1.Application, generatedApp *argov1alpha1.Application) error {
+	var rules []argov1alpha1.ApplicationSetResourceIgnoreDifferences
+	for _, rule := range ignoreDifferences {
+		if rule.MergeMode.PreservesUserValue() && (rule.Name == "" || rule.Name == generatedApp.Name) {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		delete(generatedApp.Annotations, common.AnnotationApplicationSetAppliedValues)
+		return nil
+	}
+
+	generated, err := toJSONObject(generatedApp)
+	if err != nil {
+		return err
+	}
+	var live map[string]any
+	var lastApplied map[string]any
+	if found != nil {
+		if live, err = toJSONObject(found); err != nil {
+			return err
+		}
+		if value, ok := found.Annotations[common.AnnotationApplicationSetAppliedValues]; ok {
+			// the values are applied anew if the annotation is invalid
+			_ = json.Unmarshal([]byte(value), &lastApplied)
+		}
+	}
+
+	paths := map[string][]any{}
+	for _, rule := range rules {
+		for _, obj := range []map[string]any{generated, live} {
+			if obj == nil {
+				continue
+			}
+			rulePaths, err := matchingPaths(rule, obj)
+			if err != nil {
+				return err
+			}
+			for _, path := range rulePaths {
+				paths[jsonPointer(path)] = path
+			}
+		}
+	}
+
+	applied := map[string]any{}
+	preserved := map[string][]any{}
+	for pointer, path := range paths {
+		generatedValue, generatedOK := getPath(generated, path)
+		if generatedOK {
+			applied[pointer] = generatedValue
+		}
+		if live == nil || lastApplied == nil {
+			continue
+		}
+		liveValue, liveOK := getPath(live, path)
+		lastAppliedValue, lastAppliedOK := lastApplied[pointer]
+		if liveOK != lastAppliedOK || (liveOK && !reflect.DeepEqual(liveValue, lastAppliedValue)) {
+			preserved[pointer] = path
+		}
+	}
+	for _, path := range preserved {
+		if liveValue, ok := getPath(live, path); ok {
+			generated = setPath(generated, path, liveValue).(map[string]any)
+		} else {
+			deletePath(generated, path)
+		}
+	}
+
+	generatedCopy := generatedApp.DeepCopy()
+	if err := fromJSONObject(generated, generatedApp); err != nil {
+		return err
+	}
+	// Prohibit the preserved values from mutating silly things.
+	generatedApp.TypeMeta = generatedCopy.TypeMeta
+	generatedApp.Name = generatedCopy.Name
+	generatedApp.Namespace = generatedCopy.Namespace
+	generatedApp.Operation = generatedCopy.Operation
+
+	if len(applied) == 0 {
+		delete(generatedApp.Annotations, common.AnnotationApplicationSetAppliedValues)
+		return nil
+	}
+	appliedJSON, err := json.Marshal(applied)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the applied values: %w", err)
+	}
+	if generatedApp.Annotations == nil {
+		generatedApp.Annotations = map[string]string{}
+	}
+	generatedApp.Annotations[common.AnnotationApplicationSetAppliedValues] = string(appliedJSON)
+	return nil
+}
+
+// matchingPaths returns the paths of the fields of the given object matched by the JSON pointers and the path
+// expressions of the given rule
+func matchingPaths(rule argov1alpha1.ApplicationSetResourceIgnoreDifferences, obj map[string]any) ([][]any, error) {
+	var paths [][]any
+	for _, pointer := range rule.JSONPointers {
+		path, err := pointerPath(pointer, obj)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	expressions, err := jqPathExpressions(rule)
+	if err != nil {
+		return nil, err
+	}
+	for _, expr := range expressions {
+		query, err := gojq.Parse(fmt.Sprintf("path(%s)", expr))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JQ path expression %q: %w", expr, err)
+		}
+		code, err := gojq.Compile(query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile JQ path expression %q: %w", expr, err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), normalizers.DefaultJQExecutionTimeout)
+		iter := code.RunWithContext(ctx, obj)
+		for {
+			value, ok := iter.Next()
+			if !ok {
+				break
+			}
+			if err, ok := value.(error); ok {
+				if errors.Is(err, context.DeadlineExceeded) {
+					cancel()
+					return nil, fmt.Errorf("JQ path expression %q timed out", expr)
+				}
+				// the expression does not match the fields of the object, e.g. it iterates over a missing list
+				break
+			}
+			if path, ok := value.([]any); ok && len(path) > 0 {
+				paths = append(paths, path)
+			}
+		}
+		cancel()
+	}
+	return paths, nil
+}
+
+// pointerPath returns the path of the given JSON pointer in the given object
+func pointerPath(pointer string, obj map[string]any) ([]any, error) {
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+	var path []any
+	var current any = obj
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		if list, ok := current.([]any); ok {
+			index, err := strconv.Atoi(token)
+			if err != nil {
+				return nil, fmt.Errorf("invalid index %q of JSON pointer %q", token, pointer)
+			}
+			path = append(path, index)
+			current = nil
+			if index >= 0 && index < len(list) {
+				current = list[index]
+			}
+			continue
+		}
+		path = append(path, token)
+		current, _ = current.(map[string]any)[token]
+	}
+	return path, nil
+}
+
+// jsonPointer returns the JSON pointer of the given path
+func jsonPointer(path []any) string {
+	var pointer strings.Builder
+	for _, key := range path {
+		pointer.WriteString("/")
+		switch key := key.(type) {
+		case int:
+			pointer.WriteString(strconv.Itoa(key))
+		default:
+			pointer.WriteString(strings.ReplaceAll(strings.ReplaceAll(fmt.Sprint(key), "~", "~0"), "/", "~1"))
+		}
+	}
+	return pointer.String()
+}
+
+func getPath(obj any, path []any) (any, bool) {
+	current := obj
+	for _, key := range path {
+		switch key := key.(type) {
+		case string:
+			m, ok := current.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			if current, ok = m[key]; !ok {
+				return nil, false
+			}
+		case int:
+			list, ok := current.([]any)
+			if !ok || key < 0 || key >= len(list) {
+				return nil, false
+			}
+			current = list[key]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// setPath sets the value at the given path of the given object, creating the missing maps, and returns the object.
+// The value is not set if the path indexes a missing item of a list.
+func setPath(obj any, path []any, value any) any {
+	if len(path) == 0 {
+		return value
+	}
+	switch key := path[0].(type) {
+	case string:
+		m, ok := obj.(map[string]any)
+		if !ok {
+			m = map[string]any{}
+		}
+		m[key] = setPath(m[key], path[1:], value)
+		return m
+	case int:
+		list, ok := obj.([]any)
+		if ok && key >= 0 && key < len(list) {
+			list[key] = setPath(list[key], path[1:], value)
+		}
+		return obj
+	}
+	return obj
+}
+
+// deletePath deletes the value at the given path of the given object
+func deletePath(obj any, path []any) {
+	if len(path) == 0 {
+		return
+	}
+	parent, ok := getPath(obj, path[:len(path)-1])
+	if !ok {
+		return
+	}
+	if m, ok := parent.(map[string]any); ok {
+		if key, ok := path[len(path)-1].(string); ok {
+			delete(m, key)
+		}
+	}
+}
+
+func toJSONObject(app *argov1alpha1.Application) (map[string]any, error) {
+	data, err := json.Marshal(app)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal application to json: %w", err)
+	}
+	obj := map[string]any{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal application json: %w", err)
+	}
+	return obj, nil
+}
+
+func fromJSONObject(obj map[string]any, app *argov1alpha1.Application) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application json: %w", err)
+	}
+	result := &argov1alpha1.Application{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("failed to unmarshal application json to structured app: %w", err)
+	}
+	result.DeepCopyInto(app)
+	return nil
+}
@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func Test_jsonPathToJQ(t *testing.T) {
+	t.Parallel()
+
+	for expr, expected := range map[string]string{
+		"{.spec.syncPolicy.automated}":                                                ".spec.syncPolicy.automated",
+		"$.spec.source.targetRevision":                                                ".spec.source.targetRevision",
+		"spec.source.targetRevision":                                                  ".spec.source.targetRevision",
+		".spec.sources[0].targetRevision":                                             ".spec.sources[0].targetRevision",
+		".spec.sources[*].targetRevision":                                             ".spec.sources[].targetRevision",
+		".spec.sources.*.targetRevision":                                              ".spec.sources[].targetRevision",
+		".metadata.annotations['example.com/key']":                                    `.metadata.annotations["example.com/key"]`,
+		`{.spec.sources[?(@.repoURL=="https://github.com/org/repo")].targetRevision}`: `.spec.sources[] | select(.repoURL == "https://github.com/org/repo").targetRevision`,
+		`.spec.source.helm.parameters[?(@.name != 'image.tag')]`:                      `.spec.source.helm.parameters[] | select(.name != "image.tag")`,
+		`.spec.source.helm.parameters[?(@.forceString)]`:                              `.spec.source.helm.parameters[] | select(.forceString != null)`,
+		`.spec.source.helm.parameters[?(@.forceString == true)].value`:                `.spec.source.helm.parameters[] | select(.forceString == true).value`,
+	} {
+		jq, err := jsonPathToJQ(expr)
+		require.NoError(t, err, expr)
+		assert.Equal(t, expected, jq, expr)
+	}
+
+	for _, expr := range []string{"", "{}", ".spec..source", ".spec.sources[0", ".spec.sources[?(@.name > 1)]", ".spec.sources[?(@.name == foo)]", ".spec.sources[1:2]"} {
+		_, err := jsonPathToJQ(expr)
+		require.Error(t, err, expr)
+	}
+}
+
+func newPreserveApp(t *testing.T, manifest string) *v1alpha1.Application {
+	t.Helper()
+	app := &v1alpha1.Application{}
+	require.NoError(t, yaml.Unmarshal([]byte(manifest), app))
+	return app
+}
+
+func Test_preserveUserValues(t *testing.T) {
+	t.Parallel()
+
+	ignoreDifferences := v1alpha1.ApplicationSetIgnoreDifferences{
+		{JSONPointers: []string{"/spec/syncPolicy/automated"}, MergeMode: v1alpha1.ApplicationSetIgnoreDifferencesMergeModePreserveUserValue},
+		{JSONPathExpressions: []string{`{.spec.sources[?(@.repoURL=="https://github.com/org/repo1")].targetRevision}`}, MergeMode: v1alpha1.ApplicationSetIgnoreDifferencesMergeModePreserveUserValue},
+		{Name: "other", JQPathExpressions: []string{".spec.project"}, MergeMode: v1alpha1.ApplicationSetIgnoreDifferencesMergeModePreserveUserValue},
+	}
+	generate := func(automated string, revision string) *v1alpha1.Application {
+		return newPreserveApp(t, `
+metadata:
+  name: app
+spec:
+  project: default
+  sources:
+  - repoURL: https://github.com/org/repo1
+    targetRevision: `+revision+`
+  - repoURL: https://github.com/org/repo2
+    targetRevision: main
+  syncPolicy:
+    automated: `+automated)
+	}
+
+	// the values of the template are recorded when the application is created
+	found := generate("{selfHeal: true}", "main")
+	require.NoError(t, preserveUserValues(ignoreDifferences, nil, found))
+	assert.JSONEq(t, `{"/spec/syncPolicy/automated": {"selfHeal": true}, "/spec/sources/0/targetRevision": "main"}`, found.Annotations[common.AnnotationApplicationSetAppliedValues])
+
+	// the changes of the template are applied to the fields which were not changed by the user
+	generated := generate("{selfHeal: true, prune: true}", "v1")
+	require.NoError(t, preserveUserValues(ignoreDifferences, found, generated))
+	assert.True(t, generated.Spec.SyncPolicy.Automated.Prune)
+	assert.Equal(t, "v1", generated.Spec.Sources[0].TargetRevision)
+	found = generated
+
+	// the user disables the auto-sync and changes the revision of the first source
+	found.Spec.SyncPolicy.Automated = nil
+	found.Spec.Sources[0].TargetRevision = "fix/bug-123"
+	generated = generate("{selfHeal: true}", "v2")
+	require.NoError(t, preserveUserValues(ignoreDifferences, found, generated))
+	assert.Nil(t, generated.Spec.SyncPolicy.Automated)
+	assert.Equal(t, "fix/bug-123", generated.Spec.Sources[0].TargetRevision)
+	assert.Equal(t, "main", generated.Spec.Sources[1].TargetRevision)
+	assert.Equal(t, "default", generated.Spec.Project)
+	assert.JSONEq(t, `{"/spec/syncPolicy/automated": {"selfHeal": true}, "/spec/sources/0/targetRevision": "v2"}`, generated.Annotations[common.AnnotationApplicationSetAppliedValues])
+	found = generated
+
+	// the user values are kept while the template changes
+	generated = generate("{}", "v3")
+	require.NoError(t, preserveUserValues(ignoreDifferences, found, generated))
+	assert.Nil(t, generated.Spec.SyncPolicy.Automated)
+	assert.Equal(t, "fix/bug-123", generated.Spec.Sources[0].TargetRevision)
+
+	// the applications whose values were not recorded get the values of the template
+	found = generate("null", "fix/bug-123")
+	generated = generate("{selfHeal: true}", "v3")
+	require.NoError(t, preserveUserValues(ignoreDifferences, found, generated))
+	assert.True(t, generated.Spec.SyncPolicy.Automated.SelfHeal)
+	assert.Equal(t, "v3", generated.Spec.Sources[0].TargetRevision)
+
+	// the annotation is removed when there is no rule preserving the user values
+	generated.Annotations = map[string]string{common.AnnotationApplicationSetAppliedValues: "{}"}
+	require.NoError(t, preserveUserValues(v1alpha1.ApplicationSetIgnoreDifferences{{JSONPointers: []string{"/spec/syncPolicy"}}}, found, generated))
+	assert.NotContains(t, generated.Annotations, common.AnnotationApplicationSetAppliedValues)
+}
@@ -38,6 +38,8 @@ type WebhookHandler struct {
 	client         client.Client
 	generators     map[string]generators.Generator
 	queue          chan any
+	// maxWebhookPayloadSizeB is the maximum size of the webhook payloads, in bytes
+	maxWebhookPayloadSizeB int64
 }
 
 type gitGeneratorInfo struct {
@@ -94,6 +96,8 @@ func NewWebhookHandler(webhookParallelism int, argocdSettingsMgr *argosettings.S
 		client:      client,
 		generators:  generators,
 		queue:       make(chan any, payloadQueueSize),
+
+		maxWebhookPayloadSizeB: argocdSettingsMgr.GetMaxWebhookPayloadSize(),
 	}
 
 	webhookHandler.startWorkerPool(webhookParallelism)
@@ -159,6 +163,8 @@ func (h *WebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 	var payload any
 	var err error
 
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxWebhookPayloadSizeB)
+
 	switch {
 	case r.Header.Get("X-GitHub-Event") != "":
 		payload, err = h.github.Parse(r, github.PushEvent, github.PullRequestEvent, github.PingEvent)
@@ -173,6 +179,14 @@ func (h *WebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err != nil {
+		// If the error is due to a large payload, return a more user-friendly error message
+		if err.Error() == "error parsing payload" {
+			msg := fmt.Sprintf("Webhook processing failed: The payload is either too large or corrupted. Please check the payload size (must be under %v MB) and ensure it is valid JSON", h.maxWebhookPayloadSizeB/1024/1024)
+			log.Warn(msg)
+			http.Error(w, msg, http.StatusBadRequest)
+			return
+		}
+
 		log.Infof("Webhook processing failed: %s", err)
 		status := http.StatusBadRequest
 		if r.Method != http.MethodPost {
@@ -471,6 +485,7 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g0.ClusterDecisionResource,
 		PullRequest:             g0.PullRequest,
 		Plugin:                  g0.Plugin,
+		AWSOrganizations:        g0.AWSOrganizations,
 		Matrix:                  matrixGenerator0,
 		Merge:                   mergeGenerator0,
 	}
@@ -526,6 +541,7 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g1.ClusterDecisionResource,
 		PullRequest:             g1.PullRequest,
 		Plugin:                  g1.Plugin,
+		AWSOrganizations:        g1.AWSOrganizations,
 		Matrix:                  matrixGenerator1,
 		Merge:                   mergeGenerator1,
 	}
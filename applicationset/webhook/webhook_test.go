@@ -308,7 +308,7 @@ func mockGenerators() map[string]generators.Generator {
 		"SCMProvider": terminalMockGenerators["SCMProvider"],
 		"PullRequest": terminalMockGenerators["PullRequest"],
 		"Plugin":      terminalMockGenerators["Plugin"],
-		"Matrix":      generators.NewMatrixGenerator(terminalMockGenerators),
+		"Matrix":      generators.NewMatrixGenerator(terminalMockGenerators, generators.DefaultMatrixMaxGenerators),
 		"Merge":       generators.NewMergeGenerator(terminalMockGenerators),
 	}
 
@@ -318,11 +318,46 @@ func mockGenerators() map[string]generators.Generator {
 		"SCMProvider": terminalMockGenerators["SCMProvider"],
 		"PullRequest": terminalMockGenerators["PullRequest"],
 		"Plugin":      terminalMockGenerators["Plugin"],
-		"Matrix":      generators.NewMatrixGenerator(nestedGenerators),
+		"Matrix":      generators.NewMatrixGenerator(nestedGenerators, generators.DefaultMatrixMaxGenerators),
 		"Merge":       generators.NewMergeGenerator(nestedGenerators),
 	}
 }
 
+func TestWebhookHandlerMaxPayloadSize(t *testing.T) {
+	namespace := "test"
+	fakeClient := kubefake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-cm", Namespace: namespace, Labels: map[string]string{
+			"app.kubernetes.io/part-of": "argocd",
+		}},
+		Data: map[string]string{"webhook.maxPayloadSizeMB": "1"},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: namespace, Labels: map[string]string{
+			"app.kubernetes.io/part-of": "argocd",
+		}},
+		Data: map[string][]byte{"server.secretkey": nil},
+	})
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	fc := fake.NewClientBuilder().WithScheme(scheme).Build()
+	set := argosettings.NewSettingsManager(t.Context(), fakeClient, namespace)
+	h, err := NewWebhookHandler(1, set, fc, mockGenerators())
+	require.NoError(t, err)
+
+	eventJSON, err := os.ReadFile(filepath.Join("testdata", "github-commit-event.json"))
+	require.NoError(t, err)
+	// pad the payload beyond the limit of 1 MB
+	eventJSON = append(eventJSON, bytes.Repeat([]byte(" "), 1024*1024)...)
+	req := httptest.NewRequest(http.MethodPost, "/api/webhook", bytes.NewReader(eventJSON))
+	req.Header.Set("X-GitHub-Event", "push")
+	w := httptest.NewRecorder()
+
+	h.Handler(w, req)
+	close(h.queue)
+	h.Wait()
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "The payload is either too large or corrupted. Please check the payload size (must be under 1 MB)")
+}
+
 func TestGenRevisionHasChanged(t *testing.T) {
 	type args struct {
 		gen         *v1alpha1.GitGenerator
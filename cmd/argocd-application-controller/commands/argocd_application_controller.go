@@ -34,8 +34,10 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/spiffe"
 	"github.com/argoproj/argo-cd/v3/util/tls"
 	"github.com/argoproj/argo-cd/v3/util/trace"
 )
@@ -83,6 +85,8 @@ func NewCommand() *cobra.Command {
 		redisClient                      *redis.Client
 		repoServerPlaintext              bool
 		repoServerStrictTLS              bool
+		repoServerSPIFFEID               string
+		commitServerSPIFFEID             string
 		otlpAddress                      string
 		otlpInsecure                     bool
 		otlpHeaders                      map[string]string
@@ -97,6 +101,10 @@ func NewCommand() *cobra.Command {
 		// argocd k8s event logging flag
 		enableK8sEvent  []string
 		hydratorEnabled bool
+
+		dynamicClusterNamespaces bool
+		statusRetention          controller.StatusRetention
+		syncParallelism          controller.SyncParallelism
 	)
 	command := cobra.Command{
 		Use:               cliName,
@@ -154,19 +162,37 @@ func NewCommand() *cobra.Command {
 			// Load CA information to use for validating connections to the
 			// repository server, if strict TLS validation was requested.
 			if !repoServerPlaintext && repoServerStrictTLS {
-				pool, err := tls.LoadX509CertPool(
+				poolReloader, err := tls.NewCertPoolReloader(
 					env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath)+"/controller/tls/tls.crt",
 					env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath)+"/controller/tls/ca.crt",
 				)
 				if err != nil {
 					log.Fatalf("%v", err)
 				}
-				tlsConfig.Certificates = pool
+				poolReloader.StartWatch(ctx)
+				tlsConfig.GetCertificates = poolReloader.Get
 			}
 
-			repoClientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds, tlsConfig)
+			commitClientset := commitclient.NewCommitServerClientset(commitServerAddress, nil)
+
+			// Use the X509-SVID of the SPIFFE Workload API for mTLS with the repo server and the commit server, if
+			// their SPIFFE IDs are configured.
+			if repoServerSPIFFEID != "" || commitServerSPIFFEID != "" {
+				spiffeSource, err := spiffe.NewSource(ctx)
+				errors.CheckError(err)
+				defer utilio.Close(spiffeSource)
+				if repoServerSPIFFEID != "" && !repoServerPlaintext {
+					tlsConfig.ClientConfig, err = spiffeSource.ClientTLSConfig(repoServerSPIFFEID)
+					errors.CheckError(err)
+				}
+				if commitServerSPIFFEID != "" {
+					commitServerTLSConfig, err := spiffeSource.ClientTLSConfig(commitServerSPIFFEID)
+					errors.CheckError(err)
+					commitClientset = commitclient.NewCommitServerClientset(commitServerAddress, commitServerTLSConfig)
+				}
+			}
 
-			commitClientset := commitclient.NewCommitServerClientset(commitServerAddress)
+			repoClientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds, tlsConfig, apiclient.WithAuditComponent(common.ApplicationController))
 
 			cache, err := cacheSource()
 			errors.CheckError(err)
@@ -220,6 +246,9 @@ func NewCommand() *cobra.Command {
 				ignoreNormalizerOpts,
 				enableK8sEvent,
 				hydratorEnabled,
+				dynamicClusterNamespaces,
+				statusRetention,
+				syncParallelism,
 			)
 			errors.CheckError(err)
 			cacheutil.CollectMetrics(redisClient, appController.GetMetricsServer(), nil)
@@ -279,6 +308,8 @@ func NewCommand() *cobra.Command {
 	command.Flags().Int64Var(&kubectlParallelismLimit, "kubectl-parallelism-limit", env.ParseInt64FromEnv("ARGOCD_APPLICATION_CONTROLLER_KUBECTL_PARALLELISM_LIMIT", 20, 0, math.MaxInt64), "Number of allowed concurrent kubectl fork/execs. Any value less than 1 means no limit.")
 	command.Flags().BoolVar(&repoServerPlaintext, "repo-server-plaintext", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_PLAINTEXT", false), "Disable TLS on connections to repo server")
 	command.Flags().BoolVar(&repoServerStrictTLS, "repo-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_STRICT_TLS", false), "Whether to use strict validation of the TLS cert presented by the repo server")
+	command.Flags().StringVar(&repoServerSPIFFEID, "repo-server-spiffe-id", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_SPIFFE_ID", ""), "SPIFFE ID of the repo server. If set, the connections to the repo server use mTLS with the X509-SVID of the SPIFFE Workload API at $SPIFFE_ENDPOINT_SOCKET")
+	command.Flags().StringVar(&commitServerSPIFFEID, "commit-server-spiffe-id", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_COMMIT_SERVER_SPIFFE_ID", ""), "SPIFFE ID of the commit server. If set, the connections to the commit server use mTLS with the X509-SVID of the SPIFFE Workload API at $SPIFFE_ENDPOINT_SOCKET")
 	command.Flags().StringSliceVar(&metricsAplicationLabels, "metrics-application-labels", []string{}, "List of Application labels that will be added to the argocd_application_labels metric")
 	command.Flags().StringSliceVar(&metricsAplicationConditions, "metrics-application-conditions", []string{}, "List of Application conditions that will be added to the argocd_application_conditions metric")
 	command.Flags().StringSliceVar(&metricsClusterLabels, "metrics-cluster-labels", []string{}, "List of Cluster labels that will be added to the argocd_cluster_labels metric")
@@ -304,6 +335,14 @@ func NewCommand() *cobra.Command {
 	// argocd k8s event logging flag
 	command.Flags().StringSliceVar(&enableK8sEvent, "enable-k8s-event", env.StringsFromEnv("ARGOCD_ENABLE_K8S_EVENT", argo.DefaultEnableEventList(), ","), "Enable ArgoCD to use k8s event. For disabling all events, set the value as `none`. (e.g --enable-k8s-event=none), For enabling specific events, set the value as `event reason`. (e.g --enable-k8s-event=StatusRefreshed,ResourceCreated)")
 	command.Flags().BoolVar(&hydratorEnabled, "hydrator-enabled", env.ParseBoolFromEnv("ARGOCD_HYDRATOR_ENABLED", false), "Feature flag to enable Hydrator. Default (\"false\")")
+	command.Flags().BoolVar(&dynamicClusterNamespaces, "dynamic-cluster-namespaces", env.ParseBoolFromEnv("ARGOCD_CONTROLLER_DYNAMIC_CLUSTER_NAMESPACES", false), "Restricts the cluster caches to the namespaces managed by the applications, allowing the controller to run without cluster-wide list and watch permissions")
+	command.Flags().DurationVar(&statusRetention.CompactionInterval, "status-compaction-interval", env.ParseDurationFromEnv("ARGOCD_CONTROLLER_STATUS_COMPACTION_INTERVAL", 0, 0, math.MaxInt64), "Interval at which the application statuses are compacted according to the retention settings. Compaction is disabled if 0")
+	command.Flags().DurationVar(&statusRetention.RevisionHistoryMaxAge, "revision-history-max-age", env.ParseDurationFromEnv("ARGOCD_CONTROLLER_REVISION_HISTORY_MAX_AGE", 0, 0, math.MaxInt64), "Age after which the revision history entries are compacted, except the latest one. Unlimited if 0")
+	command.Flags().DurationVar(&statusRetention.OperationStateMaxAge, "operation-state-max-age", env.ParseDurationFromEnv("ARGOCD_CONTROLLER_OPERATION_STATE_MAX_AGE", 0, 0, math.MaxInt64), "Age after which the resource results of completed operations are compacted. Unlimited if 0")
+	command.Flags().DurationVar(&statusRetention.ConditionsMaxAge, "app-conditions-max-age", env.ParseDurationFromEnv("ARGOCD_CONTROLLER_APP_CONDITIONS_MAX_AGE", 0, 0, math.MaxInt64), "Age after which the resolved application conditions are compacted. Unlimited if 0")
+	command.Flags().IntVar(&statusRetention.ConditionsMaxCount, "app-conditions-max-count", env.ParseNumFromEnv("ARGOCD_CONTROLLER_APP_CONDITIONS_MAX_COUNT", 0, 0, math.MaxInt32), "Maximum number of resolved application conditions kept by the compaction, the most recent first. Unlimited if 0")
+	command.Flags().IntVar(&syncParallelism.PerCluster, "sync-parallelism-per-cluster", env.ParseNumFromEnv("ARGOCD_CONTROLLER_SYNC_PARALLELISM_PER_CLUSTER", 0, 0, math.MaxInt32), "Maximum number of in-flight syncs per destination cluster across the controller replicas. Unlimited if 0")
+	command.Flags().IntVar(&syncParallelism.PerProject, "sync-parallelism-per-project", env.ParseNumFromEnv("ARGOCD_CONTROLLER_SYNC_PARALLELISM_PER_PROJECT", 0, 0, math.MaxInt32), "Maximum number of in-flight syncs per project across the controller replicas. Unlimited if 0")
 	cacheSource = appstatecache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
 			redisClient = client
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
@@ -24,6 +25,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/healthz"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/spiffe"
 )
 
 // NewCommand returns a new instance of an argocd-commit-server command
@@ -33,12 +35,15 @@ func NewCommand() *cobra.Command {
 		listenPort  int
 		metricsPort int
 		metricsHost string
+
+		spiffeEnabled       bool
+		spiffeAuthorizedIDs []string
 	)
 	command := &cobra.Command{
 		Use:   "argocd-commit-server",
 		Short: "Run Argo CD Commit Server",
 		Long:  "Argo CD Commit Server is an internal service which commits and pushes hydrated manifests to git. This command runs Commit Server in the foreground.",
-		RunE: func(_ *cobra.Command, _ []string) error {
+		RunE: func(c *cobra.Command, _ []string) error {
 			vers := common.GetVersion()
 			vers.LogStartupInfo(
 				"Argo CD Commit Server",
@@ -57,7 +62,19 @@ func NewCommand() *cobra.Command {
 			askPassServer := askpass.NewServer(askpass.CommitServerSocketPath)
 			go func() { errors.CheckError(askPassServer.Run()) }()
 
-			server := commitserver.NewServer(askPassServer, metricsServer)
+			// the health check connects to the server itself with the SVID of the server when SPIFFE is enabled
+			var serverTLSConfig, healthCheckTLSConfig *tls.Config
+			if spiffeEnabled {
+				spiffeSource, err := spiffe.NewSource(c.Context())
+				errors.CheckError(err)
+				defer utilio.Close(spiffeSource)
+				serverTLSConfig, err = spiffeSource.ServerTLSConfig(spiffeAuthorizedIDs)
+				errors.CheckError(err)
+				healthCheckTLSConfig, err = spiffeSource.ClientTLSConfig(spiffeSource.ID().String())
+				errors.CheckError(err)
+			}
+
+			server := commitserver.NewServer(askPassServer, metricsServer, serverTLSConfig)
 			grpc := server.CreateGRPC()
 
 			listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", listenHost, listenPort))
@@ -67,7 +84,7 @@ func NewCommand() *cobra.Command {
 				if val, ok := r.URL.Query()["full"]; ok && len(val) > 0 && val[0] == "true" {
 					// connect to itself to make sure commit server is able to serve connection
 					// used by liveness probe to auto restart commit server
-					conn, err := apiclient.NewConnection(fmt.Sprintf("localhost:%d", listenPort))
+					conn, err := apiclient.NewConnection(fmt.Sprintf("localhost:%d", listenPort), healthCheckTLSConfig)
 					if err != nil {
 						return err
 					}
@@ -112,6 +129,8 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortCommitServer, "Listen on given port for incoming connections")
 	command.Flags().StringVar(&metricsHost, "metrics-address", env.StringFromEnv("ARGOCD_COMMIT_SERVER_METRICS_LISTEN_ADDRESS", common.DefaultAddressCommitServerMetrics), "Listen on given address for metrics")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortCommitServerMetrics, "Start metrics server on given port")
+	command.Flags().BoolVar(&spiffeEnabled, "spiffe-enabled", env.ParseBoolFromEnv("ARGOCD_COMMIT_SERVER_SPIFFE_ENABLED", false), "Use the X509-SVID of the SPIFFE Workload API at $SPIFFE_ENDPOINT_SOCKET for mTLS on the gRPC endpoint")
+	command.Flags().StringSliceVar(&spiffeAuthorizedIDs, "spiffe-authorized-ids", env.StringsFromEnv("ARGOCD_COMMIT_SERVER_SPIFFE_AUTHORIZED_IDS", []string{}, ","), "SPIFFE IDs of the clients authorized to connect when SPIFFE is enabled. Any workload of the trust domain of the server is authorized if empty.")
 
 	return command
 }
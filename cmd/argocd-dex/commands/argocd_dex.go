@@ -27,6 +27,9 @@ import (
 
 const (
 	cliName = "argocd-dex"
+
+	tlsCertPath = "/tls/tls.crt"
+	tlsKeyPath  = "/tls/tls.key"
 )
 
 func NewCommand() *cobra.Command {
@@ -83,20 +86,23 @@ func NewRunDexCommand() *cobra.Command {
 			config.UserAgent = fmt.Sprintf("argocd-dex/%s (%s)", vers.Version, vers.Platform)
 			kubeClientset := kubernetes.NewForConfigOrDie(config)
 
+			// dex does not reload its certificate, so it is restarted when the certificate is rotated
+			tlsUpdateCh := make(chan struct{}, 1)
 			if !disableTLS {
-				config, err := tls.CreateServerTLSConfig("/tls/tls.crt", "/tls/tls.key", []string{"localhost", "dexserver"})
-				if err != nil {
-					log.Fatalf("could not create TLS config: %v", err)
-				}
-				certPem, keyPem := tls.EncodeX509KeyPair(config.Certificates[0])
-				err = os.WriteFile("/tmp/tls.crt", certPem, 0o600)
-				if err != nil {
-					log.Fatalf("could not write TLS certificate: %v", err)
-				}
-				err = os.WriteFile("/tmp/tls.key", keyPem, 0o600)
-				if err != nil {
-					log.Fatalf("could not write TLS key: %v", err)
+				if err := writeTLSFiles(); err != nil {
+					log.Fatalf("%v", err)
 				}
+				go func() {
+					err := tls.WatchFiles(ctx, func() {
+						select {
+						case tlsUpdateCh <- struct{}{}:
+						default:
+						}
+					}, tlsCertPath, tlsKeyPath)
+					if err != nil {
+						log.Errorf("Failed to watch the TLS certificate, it will not be reloaded: %v", err)
+					}
+				}()
 			}
 
 			settingsMgr := settings.NewSettingsManager(ctx, kubeClientset, namespace)
@@ -122,23 +128,33 @@ func NewRunDexCommand() *cobra.Command {
 					errors.CheckError(err)
 				}
 
-				// loop until the dex config changes
+				// loop until the dex config or the TLS certificate changes
+			waitForChange:
 				for {
-					newSettings := <-updateCh
-					newDexCfgBytes, err := dex.GenerateDexConfigYAML(newSettings, disableTLS)
-					errors.CheckError(err)
-					if !bytes.Equal(newDexCfgBytes, dexCfgBytes) {
-						prevSettings = newSettings
-						log.Infof("dex config modified. restarting dex")
-						if cmd != nil && cmd.Process != nil {
-							err = cmd.Process.Signal(syscall.SIGTERM)
-							errors.CheckError(err)
-							_, err = cmd.Process.Wait()
-							errors.CheckError(err)
+					select {
+					case newSettings := <-updateCh:
+						newDexCfgBytes, err := dex.GenerateDexConfigYAML(newSettings, disableTLS)
+						errors.CheckError(err)
+						if !bytes.Equal(newDexCfgBytes, dexCfgBytes) {
+							prevSettings = newSettings
+							log.Infof("dex config modified. restarting dex")
+							break waitForChange
 						}
-						break
+						log.Infof("dex config unmodified")
+					case <-tlsUpdateCh:
+						if err := writeTLSFiles(); err != nil {
+							log.Errorf("Failed to reload the TLS certificate, keeping the previous version: %v", err)
+							continue
+						}
+						log.Infof("dex TLS certificate modified. restarting dex")
+						break waitForChange
 					}
-					log.Infof("dex config unmodified")
+				}
+				if cmd != nil && cmd.Process != nil {
+					err = cmd.Process.Signal(syscall.SIGTERM)
+					errors.CheckError(err)
+					_, err = cmd.Process.Wait()
+					errors.CheckError(err)
 				}
 			}
 		},
@@ -151,6 +167,22 @@ func NewRunDexCommand() *cobra.Command {
 	return &command
 }
 
+// writeTLSFiles writes the certificate served by dex, loaded from the mounted secret or self-signed
+func writeTLSFiles() error {
+	config, err := tls.CreateServerTLSConfig(tlsCertPath, tlsKeyPath, []string{"localhost", "dexserver"})
+	if err != nil {
+		return fmt.Errorf("could not create TLS config: %w", err)
+	}
+	certPem, keyPem := tls.EncodeX509KeyPair(config.Certificates[0])
+	if err := os.WriteFile("/tmp/tls.crt", certPem, 0o600); err != nil {
+		return fmt.Errorf("could not write TLS certificate: %w", err)
+	}
+	if err := os.WriteFile("/tmp/tls.key", keyPem, 0o600); err != nil {
+		return fmt.Errorf("could not write TLS key: %w", err)
+	}
+	return nil
+}
+
 func NewGenDexConfigCommand() *cobra.Command {
 	var (
 		clientConfig clientcmd.ClientConfig
@@ -12,11 +12,16 @@ import (
 	"syscall"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	servercache "github.com/argoproj/argo-cd/v3/server/cache"
 
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
+	"github.com/argoproj/argo-cd/v3/util/spiffe"
 	"github.com/argoproj/argo-cd/v3/util/tls"
 
 	notificationscontroller "github.com/argoproj/argo-cd/v3/notification_controller/controller"
@@ -58,10 +63,12 @@ func NewCommand() *cobra.Command {
 		argocdRepoServer               string
 		argocdRepoServerPlaintext      bool
 		argocdRepoServerStrictTLS      bool
+		argocdRepoServerSPIFFEID       string
 		configMapName                  string
 		secretName                     string
 		applicationNamespaces          []string
 		selfServiceNotificationEnabled bool
+		cacheSource                    func() (*appstatecache.Cache, error)
 	)
 	command := cobra.Command{
 		Use:   "controller",
@@ -93,6 +100,10 @@ func NewCommand() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to create Kubernetes client: %w", err)
 			}
+			appClient, err := appclientset.NewForConfig(restConfig)
+			if err != nil {
+				return fmt.Errorf("failed to create Argo CD client: %w", err)
+			}
 			if namespace == "" {
 				namespace, _, err = clientConfig.Namespace()
 				if err != nil {
@@ -128,17 +139,36 @@ func NewCommand() *cobra.Command {
 				StrictValidation: argocdRepoServerStrictTLS,
 			}
 			if !tlsConfig.DisableTLS && tlsConfig.StrictValidation {
-				pool, err := tls.LoadX509CertPool(
+				poolReloader, err := tls.NewCertPoolReloader(
 					env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath)+"/reposerver/tls/tls.crt",
 					env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath)+"/reposerver/tls/ca.crt",
 				)
 				if err != nil {
 					return fmt.Errorf("failed to load repo-server certificate pool: %w", err)
 				}
-				tlsConfig.Certificates = pool
+				poolReloader.StartWatch(ctx)
+				tlsConfig.GetCertificates = poolReloader.Get
+			}
+
+			// Use the X509-SVID of the SPIFFE Workload API for mTLS with the repo server if its SPIFFE ID is configured
+			if argocdRepoServerSPIFFEID != "" && !argocdRepoServerPlaintext {
+				spiffeSource, err := spiffe.NewSource(ctx)
+				if err != nil {
+					return fmt.Errorf("failed to create SPIFFE mTLS config: %w", err)
+				}
+				defer utilio.Close(spiffeSource)
+				tlsConfig.ClientConfig, err = spiffeSource.ClientTLSConfig(argocdRepoServerSPIFFEID)
+				if err != nil {
+					return fmt.Errorf("failed to create SPIFFE mTLS config: %w", err)
+				}
+			}
+			repoClientset := apiclient.NewRepoServerClientset(argocdRepoServer, 5, tlsConfig, apiclient.WithAuditComponent(common.NotificationsController))
+			cache, err := cacheSource()
+			if err != nil {
+				return fmt.Errorf("failed to initialize cache: %w", err)
 			}
-			repoClientset := apiclient.NewRepoServerClientset(argocdRepoServer, 5, tlsConfig)
-			argocdService, err := service.NewArgoCDService(k8sClient, namespace, repoClientset)
+			// the connection states of the repositories are only read from the cache of the API server
+			argocdService, err := service.NewArgoCDService(k8sClient, appClient, namespace, repoClientset, servercache.NewCache(cache, 0, 0))
 			if err != nil {
 				return fmt.Errorf("failed to initialize Argo CD service: %w", err)
 			}
@@ -185,9 +215,11 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&argocdRepoServer, "argocd-repo-server", common.DefaultRepoServerAddr, "Argo CD repo server address")
 	command.Flags().BoolVar(&argocdRepoServerPlaintext, "argocd-repo-server-plaintext", env.ParseBoolFromEnv("ARGOCD_NOTIFICATION_CONTROLLER_REPO_SERVER_PLAINTEXT", false), "Use a plaintext client (non-TLS) to connect to repository server")
 	command.Flags().BoolVar(&argocdRepoServerStrictTLS, "argocd-repo-server-strict-tls", false, "Perform strict validation of TLS certificates when connecting to repo server")
+	command.Flags().StringVar(&argocdRepoServerSPIFFEID, "argocd-repo-server-spiffe-id", env.StringFromEnv("ARGOCD_NOTIFICATION_CONTROLLER_REPO_SERVER_SPIFFE_ID", ""), "SPIFFE ID of the repo server. If set, the connections to the repo server use mTLS with the X509-SVID of the SPIFFE Workload API at $SPIFFE_ENDPOINT_SOCKET")
 	command.Flags().StringVar(&configMapName, "config-map-name", "argocd-notifications-cm", "Set notifications ConfigMap name")
 	command.Flags().StringVar(&secretName, "secret-name", "argocd-notifications-secret", "Set notifications Secret name")
 	command.Flags().StringSliceVar(&applicationNamespaces, "application-namespaces", env.StringsFromEnv("ARGOCD_APPLICATION_NAMESPACES", []string{}, ","), "List of additional namespaces that this controller should send notifications for")
 	command.Flags().BoolVar(&selfServiceNotificationEnabled, "self-service-notification-enabled", env.ParseBoolFromEnv("ARGOCD_NOTIFICATION_CONTROLLER_SELF_SERVICE_NOTIFICATION_ENABLED", false), "Allows the Argo CD notification controller to pull notification config from the namespace that the resource is in. This is useful for self-service notification.")
+	cacheSource = appstatecache.AddCacheFlagsToCmd(&command)
 	return &command
 }
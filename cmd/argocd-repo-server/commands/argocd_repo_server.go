@@ -23,6 +23,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/reposerver"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/audit"
 	reposervercache "github.com/argoproj/argo-cd/v3/reposerver/cache"
 	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
 	"github.com/argoproj/argo-cd/v3/reposerver/repository"
@@ -34,6 +35,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 	"github.com/argoproj/argo-cd/v3/util/healthz"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/spiffe"
 	"github.com/argoproj/argo-cd/v3/util/tls"
 	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
 )
@@ -48,6 +50,7 @@ var (
 	pauseGenerationAfterFailedGenerationAttempts = env.ParseNumFromEnv(common.EnvPauseGenerationAfterFailedAttempts, 3, 0, math.MaxInt32)
 	pauseGenerationOnFailureForMinutes           = env.ParseNumFromEnv(common.EnvPauseGenerationMinutes, 60, 0, math.MaxInt32)
 	pauseGenerationOnFailureForRequests          = env.ParseNumFromEnv(common.EnvPauseGenerationRequests, 0, 0, math.MaxInt32)
+	manifestGenerationErrorCacheTTL              = env.ParseDurationFromEnv(common.EnvManifestGenerationErrorCacheTTL, time.Minute, 0, math.MaxInt64)
 	gitSubmoduleEnabled                          = env.ParseBoolFromEnv(common.EnvGitSubmoduleEnabled, true)
 )
 
@@ -66,6 +69,7 @@ func NewCommand() *cobra.Command {
 		tlsConfigCustomizer                tls.ConfigCustomizer
 		tlsConfigCustomizerSrc             func() (tls.ConfigCustomizer, error)
 		redisClient                        *redis.Client
+		redisKeyPrefix                     string
 		disableTLS                         bool
 		maxCombinedDirectoryManifestsSize  string
 		cmpTarExcludedGlobs                []string
@@ -80,6 +84,16 @@ func NewCommand() *cobra.Command {
 		includeHiddenDirectories           bool
 		cmpUseManifestGeneratePaths        bool
 		ociMediaTypes                      []string
+		parameterDecryptionKeysPath        string
+		spiffeEnabled                      bool
+		spiffeAuthorizedIDs                []string
+		repoAccessAudit                    bool
+		repoAccessAuditMaxRecords          int
+		workspaceRepoQuota                 string
+		workspaceGlobalQuota               string
+		grpcReflection                     bool
+		sshAgentSocket                     string
+		sshAgentRepositories               []string
 	)
 	command := cobra.Command{
 		Use:               cliName,
@@ -113,6 +127,18 @@ func NewCommand() *cobra.Command {
 				errors.CheckError(err)
 			}
 
+			// the health check connects to the server itself with the SVID of the server when SPIFFE is enabled
+			healthCheckTLSConfig := apiclient.TLSConfiguration{DisableTLS: disableTLS}
+			if !disableTLS && spiffeEnabled {
+				spiffeSource, err := spiffe.NewSource(ctx)
+				errors.CheckError(err)
+				defer utilio.Close(spiffeSource)
+				tlsConfigCustomizer, err = spiffeSource.ServerTLSConfigCustomizer(tlsConfigCustomizer, spiffeAuthorizedIDs)
+				errors.CheckError(err)
+				healthCheckTLSConfig.ClientConfig, err = spiffeSource.ClientTLSConfig(spiffeSource.ID().String())
+				errors.CheckError(err)
+			}
+
 			cache, err := cacheSrc()
 			errors.CheckError(err)
 
@@ -134,14 +160,29 @@ func NewCommand() *cobra.Command {
 			helmRegistryMaxIndexSizeQuantity, err := resource.ParseQuantity(helmRegistryMaxIndexSize)
 			errors.CheckError(err)
 
+			workspaceRepoQuotaQuantity, err := resource.ParseQuantity(workspaceRepoQuota)
+			errors.CheckError(err)
+
+			workspaceGlobalQuotaQuantity, err := resource.ParseQuantity(workspaceGlobalQuota)
+			errors.CheckError(err)
+
+			var auditStore audit.Store
+			if repoAccessAudit {
+				if redisClient == nil {
+					log.Fatal("the repository access audit requires Redis")
+				}
+				auditStore = audit.NewRedisStore(redisClient, redisKeyPrefix, repoAccessAuditMaxRecords)
+			}
+
 			askPassServer := askpass.NewServer(askpass.SocketPath)
 			metricsServer := metrics.NewMetricsServer()
 			cacheutil.CollectMetrics(redisClient, metricsServer, nil)
-			server, err := reposerver.NewServer(metricsServer, cache, tlsConfigCustomizer, repository.RepoServerInitConstants{
+			server, err := reposerver.NewServer(ctx, metricsServer, cache, tlsConfigCustomizer, repository.RepoServerInitConstants{
 				ParallelismLimit: parallelismLimit,
 				PauseGenerationAfterFailedGenerationAttempts: pauseGenerationAfterFailedGenerationAttempts,
 				PauseGenerationOnFailureForMinutes:           pauseGenerationOnFailureForMinutes,
 				PauseGenerationOnFailureForRequests:          pauseGenerationOnFailureForRequests,
+				ManifestGenerationErrorCacheTTL:              manifestGenerationErrorCacheTTL,
 				SubmoduleEnabled:                             gitSubmoduleEnabled,
 				MaxCombinedDirectoryManifestsSize:            maxCombinedDirectoryManifestsQuantity,
 				CMPTarExcludedGlobs:                          cmpTarExcludedGlobs,
@@ -155,7 +196,12 @@ func NewCommand() *cobra.Command {
 				IncludeHiddenDirectories:                     includeHiddenDirectories,
 				CMPUseManifestGeneratePaths:                  cmpUseManifestGeneratePaths,
 				OCIMediaTypes:                                ociMediaTypes,
-			}, askPassServer)
+				ParameterDecryptionKeysPath:                  parameterDecryptionKeysPath,
+				WorkspaceRepoQuota:                           workspaceRepoQuotaQuantity.ToDec().Value(),
+				WorkspaceGlobalQuota:                         workspaceGlobalQuotaQuantity.ToDec().Value(),
+				SSHAgentSocket:                               sshAgentSocket,
+				SSHAgentRepositories:                         sshAgentRepositories,
+			}, askPassServer, auditStore)
 			errors.CheckError(err)
 
 			if otlpAddress != "" {
@@ -168,7 +214,7 @@ func NewCommand() *cobra.Command {
 				defer closer()
 			}
 
-			grpc := server.CreateGRPC()
+			grpc := server.CreateGRPC(grpcReflection)
 			listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", listenHost, listenPort))
 			errors.CheckError(err)
 
@@ -177,7 +223,7 @@ func NewCommand() *cobra.Command {
 					// connect to itself to make sure repo server is able to serve connection
 					// used by liveness probe to auto restart repo server
 					// see https://github.com/argoproj/argo-cd/issues/5110 for more information
-					conn, err := apiclient.NewConnection(fmt.Sprintf("localhost:%d", listenPort), 60, &apiclient.TLSConfiguration{DisableTLS: disableTLS})
+					conn, err := apiclient.NewConnection(fmt.Sprintf("localhost:%d", listenPort), 60, &healthCheckTLSConfig)
 					if err != nil {
 						return err
 					}
@@ -264,11 +310,24 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&includeHiddenDirectories, "include-hidden-directories", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_INCLUDE_HIDDEN_DIRECTORIES", false), "Include hidden directories from Git")
 	command.Flags().BoolVar(&cmpUseManifestGeneratePaths, "plugin-use-manifest-generate-paths", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_PLUGIN_USE_MANIFEST_GENERATE_PATHS", false), "Pass the resources described in argocd.argoproj.io/manifest-generate-paths value to the cmpserver to generate the application manifests.")
 	command.Flags().StringSliceVar(&ociMediaTypes, "oci-layer-media-types", env.StringsFromEnv("ARGOCD_REPO_SERVER_OCI_LAYER_MEDIA_TYPES", []string{"application/vnd.oci.image.layer.v1.tar", "application/vnd.oci.image.layer.v1.tar+gzip", "application/vnd.cncf.helm.chart.content.v1.tar+gzip"}, ","), "Comma separated list of allowed media types for OCI media types. This only accounts for media types within layers.")
+	command.Flags().StringVar(&parameterDecryptionKeysPath, "parameter-decryption-keys-path", env.StringFromEnv("ARGOCD_REPO_SERVER_PARAMETER_DECRYPTION_KEYS_PATH", common.DefaultPathParameterDecryptionKeys), "Directory of the PEM encoded private keys decrypting the sealed Helm parameters")
+	command.Flags().StringVar(&workspaceRepoQuota, "workspace-repo-quota", env.StringFromEnv("ARGOCD_REPO_SERVER_WORKSPACE_REPO_QUOTA", "0"), "Maximum disk usage of the checkout of a single repository, or of a single cached Helm chart or OCI image. 0 for no quota")
+	command.Flags().StringVar(&workspaceGlobalQuota, "workspace-global-quota", env.StringFromEnv("ARGOCD_REPO_SERVER_WORKSPACE_GLOBAL_QUOTA", "0"), "Maximum disk usage of all the checkouts and cached Helm charts and OCI images, enforced by removing the least recently used ones. 0 for no quota")
+	command.Flags().BoolVar(&spiffeEnabled, "spiffe-enabled", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_SPIFFE_ENABLED", false), "Use the X509-SVID of the SPIFFE Workload API at $SPIFFE_ENDPOINT_SOCKET for mTLS on the gRPC endpoint")
+	command.Flags().StringSliceVar(&spiffeAuthorizedIDs, "spiffe-authorized-ids", env.StringsFromEnv("ARGOCD_REPO_SERVER_SPIFFE_AUTHORIZED_IDS", []string{}, ","), "SPIFFE IDs of the clients authorized to connect when SPIFFE is enabled. Any workload of the trust domain of the server is authorized if empty.")
+	command.Flags().BoolVar(&repoAccessAudit, "repo-access-audit", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_REPO_ACCESS_AUDIT", false), "Record the user, application and component triggering each repository access in Redis")
+	command.Flags().IntVar(&repoAccessAuditMaxRecords, "repo-access-audit-max-records", env.ParseNumFromEnv("ARGOCD_REPO_SERVER_REPO_ACCESS_AUDIT_MAX_RECORDS", audit.DefaultMaxRecords, 1, math.MaxInt32), "Number of the most recent repository accesses kept by the repository access audit")
+	command.Flags().BoolVar(&grpcReflection, "grpc-reflection", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_GRPC_REFLECTION", true), "Enable the gRPC server reflection service, so that clients like grpcurl can discover the API")
+	command.Flags().StringVar(&sshAgentSocket, "ssh-agent-socket", env.StringFromEnv("ARGOCD_REPO_SERVER_SSH_AGENT_SOCKET", ""), "Socket of the SSH agent authenticating the SSH repositories matching --ssh-agent-repositories. The SSH_AUTH_SOCK environment variable of the repo server is never used.")
+	command.Flags().StringSliceVar(&sshAgentRepositories, "ssh-agent-repositories", env.StringsFromEnv("ARGOCD_REPO_SERVER_SSH_AGENT_REPOSITORIES", []string{}, ","), "Glob patterns of the URLs of the SSH repositories without private key authenticated with the SSH agent at --ssh-agent-socket, e.g. git@github.com:my-org/*")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
 			redisClient = client
 		},
+		OnKeyPrefixParsed: func(keyPrefix string) {
+			redisKeyPrefix = keyPrefix
+		},
 	})
 	return &command
 }
@@ -20,6 +20,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/generators"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -34,7 +35,11 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/dex"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/kube"
+	"github.com/argoproj/argo-cd/v3/util/proxyproto"
+	"github.com/argoproj/argo-cd/v3/util/session"
+	"github.com/argoproj/argo-cd/v3/util/spiffe"
 	"github.com/argoproj/argo-cd/v3/util/templates"
 	"github.com/argoproj/argo-cd/v3/util/tls"
 	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
@@ -55,9 +60,19 @@ var (
 func NewCommand() *cobra.Command {
 	var (
 		redisClient              *redis.Client
+		redisKeyPrefix           string
 		insecure                 bool
 		listenHost               string
 		listenPort               int
+		additionalListeners      []string
+		shutdownDelay            time.Duration
+		shutdownTimeout          time.Duration
+		repoConnectionProbe      time.Duration
+		grpcReflection           bool
+		grpcHealth               bool
+		h2c                      bool
+		proxyProtocol            bool
+		proxyProtocolCIDRs       []string
 		metricsHost              string
 		metricsPort              int
 		otlpAddress              string
@@ -81,12 +96,14 @@ func NewCommand() *cobra.Command {
 		contentSecurityPolicy    string
 		repoServerPlaintext      bool
 		repoServerStrictTLS      bool
+		repoServerSPIFFEID       string
 		dexServerPlaintext       bool
 		dexServerStrictTLS       bool
 		staticAssetsDir          string
 		applicationNamespaces    []string
 		enableProxyExtension     bool
 		webhookParallelism       int
+		webhookRefreshHints      bool
 		hydratorEnabled          bool
 		syncWithReplaceAllowed   bool
 
@@ -96,6 +113,7 @@ func NewCommand() *cobra.Command {
 		allowedScmProviders      []string
 		enableScmProviders       bool
 		enableGitHubAPIMetrics   bool
+		matrixMaxGenerators      int
 
 		// argocd k8s event logging flag
 		enableK8sEvent []string
@@ -136,6 +154,13 @@ func NewCommand() *cobra.Command {
 
 			tlsConfigCustomizer, err := tlsConfigCustomizerSrc()
 			errors.CheckError(err)
+			parsedAdditionalListeners, err := server.ParseAdditionalListeners(additionalListeners)
+			errors.CheckError(err)
+			proxyProtocolNetworks, err := proxyproto.ParseNetworks(proxyProtocolCIDRs)
+			errors.CheckError(err)
+			if proxyProtocol && len(proxyProtocolNetworks) == 0 {
+				log.Fatal("--proxy-protocol requires the networks of the load balancers in --proxy-protocol-trusted-cidrs, so that the other clients cannot spoof their addresses")
+			}
 			cache, err := cacheSrc()
 			errors.CheckError(err)
 			repoServerCache, err := repoServerCacheSrc()
@@ -171,14 +196,15 @@ func NewCommand() *cobra.Command {
 			// Load CA information to use for validating connections to the
 			// repository server, if strict TLS validation was requested.
 			if !repoServerPlaintext && repoServerStrictTLS {
-				pool, err := tls.LoadX509CertPool(
+				poolReloader, err := tls.NewCertPoolReloader(
 					env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath)+"/server/tls/tls.crt",
 					env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath)+"/server/tls/ca.crt",
 				)
 				if err != nil {
 					log.Fatalf("%v", err)
 				}
-				tlsConfig.Certificates = pool
+				poolReloader.StartWatch(ctx)
+				tlsConfig.GetCertificates = poolReloader.Get
 			}
 
 			dexTLSConfig := &dex.DexTLSConfig{
@@ -187,23 +213,36 @@ func NewCommand() *cobra.Command {
 			}
 
 			if !dexServerPlaintext && dexServerStrictTLS {
-				pool, err := tls.LoadX509CertPool(
+				poolReloader, err := tls.NewCertPoolReloader(
 					env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath) + "/dex/tls/ca.crt",
 				)
 				if err != nil {
 					log.Fatalf("%v", err)
 				}
-				dexTLSConfig.RootCAs = pool
-				cert, err := tls.LoadX509Cert(
+				poolReloader.StartWatch(ctx)
+				dexTLSConfig.GetRootCAs = poolReloader.Get
+				certReloader, err := tls.NewCertReloader(
 					env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath) + "/dex/tls/tls.crt",
 				)
 				if err != nil {
 					log.Fatalf("%v", err)
 				}
-				dexTLSConfig.Certificate = cert.Raw
+				certReloader.StartWatch(ctx)
+				dexTLSConfig.GetCertificate = func() []byte {
+					return certReloader.Get().Raw
+				}
 			}
 
-			repoclientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds, tlsConfig)
+			// Use the X509-SVID of the SPIFFE Workload API for mTLS with the repo server if its SPIFFE ID is configured
+			if repoServerSPIFFEID != "" && !repoServerPlaintext {
+				spiffeSource, err := spiffe.NewSource(ctx)
+				errors.CheckError(err)
+				defer utilio.Close(spiffeSource)
+				tlsConfig.ClientConfig, err = spiffeSource.ClientTLSConfig(repoServerSPIFFEID)
+				errors.CheckError(err)
+			}
+
+			repoclientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds, tlsConfig, apiclient.WithAuditComponent(common.DefaultServerName), apiclient.WithAuditUser(session.AuditUsername))
 			if rootPath != "" {
 				if baseHRef != "" && baseHRef != rootPath {
 					log.Warnf("--basehref and --rootpath had conflict: basehref: %s rootpath: %s", baseHRef, rootPath)
@@ -236,15 +275,26 @@ func NewCommand() *cobra.Command {
 				ContentTypes:            contentTypesList,
 				EnableGZip:              enableGZip,
 				TLSConfigCustomizer:     tlsConfigCustomizer,
+				AdditionalListeners:     parsedAdditionalListeners,
+				ShutdownDelay:           shutdownDelay,
+				ShutdownTimeout:         shutdownTimeout,
+				RepoProbeInterval:       repoConnectionProbe,
+				GRPCReflectionEnabled:   grpcReflection,
+				GRPCHealthEnabled:       grpcHealth,
+				H2CEnabled:              h2c,
+				ProxyProtocol:           proxyProtocol,
+				ProxyProtocolNetworks:   proxyProtocolNetworks,
 				Cache:                   cache,
 				RepoServerCache:         repoServerCache,
 				XFrameOptions:           frameOptions,
 				ContentSecurityPolicy:   contentSecurityPolicy,
 				RedisClient:             redisClient,
+				RedisKeyPrefix:          redisKeyPrefix,
 				StaticAssetsDir:         staticAssetsDir,
 				ApplicationNamespaces:   applicationNamespaces,
 				EnableProxyExtension:    enableProxyExtension,
 				WebhookParallelism:      webhookParallelism,
+				WebhookRefreshHints:     webhookRefreshHints,
 				EnableK8sEvent:          enableK8sEvent,
 				HydratorEnabled:         hydratorEnabled,
 				SyncWithReplaceAllowed:  syncWithReplaceAllowed,
@@ -257,6 +307,7 @@ func NewCommand() *cobra.Command {
 				AllowedScmProviders:      allowedScmProviders,
 				EnableScmProviders:       enableScmProviders,
 				EnableGitHubAPIMetrics:   enableGitHubAPIMetrics,
+				MatrixMaxGenerators:      matrixMaxGenerators,
 			}
 
 			stats.RegisterStackDumper()
@@ -310,6 +361,15 @@ func NewCommand() *cobra.Command {
 	command.AddCommand(cli.NewVersionCmd(cliName))
 	command.Flags().StringVar(&listenHost, "address", env.StringFromEnv("ARGOCD_SERVER_LISTEN_ADDRESS", common.DefaultAddressAPIServer), "Listen on given address")
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortAPIServer, "Listen on given port")
+	command.Flags().StringSliceVar(&additionalListeners, "additional-listeners", env.StringsFromEnv("ARGOCD_SERVER_ADDITIONAL_LISTENERS", []string{}, ","), "List of additional addresses to serve the API on, in the form unix:///path/to/socket, tcp://host:port (plaintext) or tls://host:port?minversion=1.3&maxversion=1.3&ciphers=<ciphers>")
+	command.Flags().DurationVar(&shutdownDelay, "shutdown-delay", env.ParseDurationFromEnv("ARGOCD_SERVER_SHUTDOWN_DELAY", 0, 0, math.MaxInt64), "Time to keep serving after failing the readiness checks on termination, so that load balancers stop routing new connections to the server first")
+	command.Flags().DurationVar(&shutdownTimeout, "shutdown-timeout", env.ParseDurationFromEnv("ARGOCD_SERVER_SHUTDOWN_TIMEOUT", server.DefaultShutdownTimeout, 0, math.MaxInt64), "Time given to the in-flight requests to finish on shutdown, after which the remaining connections are closed")
+	command.Flags().DurationVar(&repoConnectionProbe, "repo-connection-probe-interval", env.ParseDurationFromEnv("ARGOCD_SERVER_REPO_CONNECTION_PROBE_INTERVAL", 0, 0, math.MaxInt64), "Interval at which the connections to all the configured repositories are tested in the background. The connections are only tested on request if 0")
+	command.Flags().BoolVar(&grpcReflection, "grpc-reflection", env.ParseBoolFromEnv("ARGOCD_SERVER_GRPC_REFLECTION", true), "Enable the gRPC server reflection service, so that clients like grpcurl can discover the API")
+	command.Flags().BoolVar(&grpcHealth, "grpc-health", env.ParseBoolFromEnv("ARGOCD_SERVER_GRPC_HEALTH", false), "Enable the grpc.health.v1 health service, which does not require authentication")
+	command.Flags().BoolVar(&h2c, "h2c", env.ParseBoolFromEnv("ARGOCD_SERVER_H2C", false), "Serve HTTP/2 without TLS (h2c) on the plaintext ports, e.g. when TLS is terminated by a service mesh sidecar. Requires --insecure")
+	command.Flags().BoolVar(&proxyProtocol, "proxy-protocol", env.ParseBoolFromEnv("ARGOCD_SERVER_PROXY_PROTOCOL", false), "Accept the PROXY protocol headers of the L4 load balancers on the TCP ports, so that the addresses of the clients are known")
+	command.Flags().StringSliceVar(&proxyProtocolCIDRs, "proxy-protocol-trusted-cidrs", env.StringsFromEnv("ARGOCD_SERVER_PROXY_PROTOCOL_TRUSTED_CIDRS", []string{}, ","), "Networks, e.g. the ones of the load balancers, from which the PROXY protocol headers are accepted. Required by --proxy-protocol")
 	command.Flags().StringVar(&metricsHost, env.StringFromEnv("ARGOCD_SERVER_METRICS_LISTEN_ADDRESS", "metrics-address"), common.DefaultAddressAPIServerMetrics, "Listen for metrics on given address")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortArgoCDAPIServerMetrics, "Start metrics on given port")
 	command.Flags().StringVar(&otlpAddress, "otlp-address", env.StringFromEnv("ARGOCD_SERVER_OTLP_ADDRESS", ""), "OpenTelemetry collector address to send traces to")
@@ -321,11 +381,13 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&contentSecurityPolicy, "content-security-policy", env.StringFromEnv("ARGOCD_SERVER_CONTENT_SECURITY_POLICY", "frame-ancestors 'self';"), "Set Content-Security-Policy header in HTTP responses to `value`. To disable, set to \"\".")
 	command.Flags().BoolVar(&repoServerPlaintext, "repo-server-plaintext", env.ParseBoolFromEnv("ARGOCD_SERVER_REPO_SERVER_PLAINTEXT", false), "Use a plaintext client (non-TLS) to connect to repository server")
 	command.Flags().BoolVar(&repoServerStrictTLS, "repo-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_SERVER_REPO_SERVER_STRICT_TLS", false), "Perform strict validation of TLS certificates when connecting to repo server")
+	command.Flags().StringVar(&repoServerSPIFFEID, "repo-server-spiffe-id", env.StringFromEnv("ARGOCD_SERVER_REPO_SERVER_SPIFFE_ID", ""), "SPIFFE ID of the repo server. If set, the connections to the repo server use mTLS with the X509-SVID of the SPIFFE Workload API at $SPIFFE_ENDPOINT_SOCKET")
 	command.Flags().BoolVar(&dexServerPlaintext, "dex-server-plaintext", env.ParseBoolFromEnv("ARGOCD_SERVER_DEX_SERVER_PLAINTEXT", false), "Use a plaintext client (non-TLS) to connect to dex server")
 	command.Flags().BoolVar(&dexServerStrictTLS, "dex-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_SERVER_DEX_SERVER_STRICT_TLS", false), "Perform strict validation of TLS certificates when connecting to dex server")
 	command.Flags().StringSliceVar(&applicationNamespaces, "application-namespaces", env.StringsFromEnv("ARGOCD_APPLICATION_NAMESPACES", []string{}, ","), "List of additional namespaces where application resources can be managed in")
 	command.Flags().BoolVar(&enableProxyExtension, "enable-proxy-extension", env.ParseBoolFromEnv("ARGOCD_SERVER_ENABLE_PROXY_EXTENSION", false), "Enable Proxy Extension feature")
 	command.Flags().IntVar(&webhookParallelism, "webhook-parallelism-limit", env.ParseNumFromEnv("ARGOCD_SERVER_WEBHOOK_PARALLELISM_LIMIT", 50, 1, 1000), "Number of webhook requests processed concurrently")
+	command.Flags().BoolVar(&webhookRefreshHints, "webhook-refresh-hints", env.ParseBoolFromEnv("ARGOCD_SERVER_WEBHOOK_REFRESH_HINTS", false), "Request the refreshes of the applications affected by webhook events to the application controller shards via Redis instead of annotating the applications")
 	command.Flags().StringSliceVar(&enableK8sEvent, "enable-k8s-event", env.StringsFromEnv("ARGOCD_ENABLE_K8S_EVENT", argo.DefaultEnableEventList(), ","), "Enable ArgoCD to use k8s event. For disabling all events, set the value as `none`. (e.g --enable-k8s-event=none), For enabling specific events, set the value as `event reason`. (e.g --enable-k8s-event=StatusRefreshed,ResourceCreated)")
 	command.Flags().BoolVar(&hydratorEnabled, "hydrator-enabled", env.ParseBoolFromEnv("ARGOCD_HYDRATOR_ENABLED", false), "Feature flag to enable Hydrator. Default (\"false\")")
 	command.Flags().BoolVar(&syncWithReplaceAllowed, "sync-with-replace-allowed", env.ParseBoolFromEnv("ARGOCD_SYNC_WITH_REPLACE_ALLOWED", true), "Whether to allow users to select replace for syncs from UI/CLI")
@@ -336,12 +398,16 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&allowedScmProviders, "appset-allowed-scm-providers", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ALLOWED_SCM_PROVIDERS", []string{}, ","), "The list of allowed custom SCM provider API URLs. This restriction does not apply to SCM or PR generators which do not accept a custom API URL. (Default: Empty = all)")
 	command.Flags().BoolVar(&enableNewGitFileGlobbing, "appset-enable-new-git-file-globbing", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_NEW_GIT_FILE_GLOBBING", false), "Enable new globbing in Git files generator.")
 	command.Flags().BoolVar(&enableGitHubAPIMetrics, "appset-enable-github-api-metrics", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_GITHUB_API_METRICS", false), "Enable GitHub API metrics for generators that use the GitHub API")
+	command.Flags().IntVar(&matrixMaxGenerators, "appset-matrix-max-generators", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_MATRIX_MAX_GENERATORS", generators.DefaultMatrixMaxGenerators, 2, 100), "Maximum number of child generators of a Matrix generator")
 
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(command)
 	cacheSrc = servercache.AddCacheFlagsToCmd(command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
 			redisClient = client
 		},
+		OnKeyPrefixParsed: func(keyPrefix string) {
+			redisKeyPrefix = keyPrefix
+		},
 	})
 	repoServerCacheSrc = reposervercache.AddCacheFlagsToCmd(command, cacheutil.Options{FlagPrefix: "repo-server-"})
 	return command
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -23,6 +24,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v3/util/backup"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/localconfig"
@@ -67,73 +69,7 @@ func NewExportCommand() *cobra.Command {
 				}()
 			}
 
-			if len(applicationNamespaces) == 0 || len(applicationsetNamespaces) == 0 {
-				defaultNs := getAdditionalNamespaces(ctx, acdClients.configMaps)
-				if len(applicationNamespaces) == 0 {
-					applicationNamespaces = defaultNs.applicationNamespaces
-				}
-				if len(applicationsetNamespaces) == 0 {
-					applicationsetNamespaces = defaultNs.applicationsetNamespaces
-				}
-			}
-			// To support applications and applicationsets in any namespace, we must list ALL namespaces and filter them afterwards
-			if len(applicationNamespaces) > 0 {
-				acdClients.applications = client.Resource(applicationsResource)
-			}
-			if len(applicationsetNamespaces) > 0 {
-				acdClients.applicationSets = client.Resource(appplicationSetResource)
-			}
-
-			acdConfigMap, err := acdClients.configMaps.Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
-			errors.CheckError(err)
-			export(writer, *acdConfigMap, namespace)
-			acdRBACConfigMap, err := acdClients.configMaps.Get(ctx, common.ArgoCDRBACConfigMapName, metav1.GetOptions{})
-			errors.CheckError(err)
-			export(writer, *acdRBACConfigMap, namespace)
-			acdKnownHostsConfigMap, err := acdClients.configMaps.Get(ctx, common.ArgoCDKnownHostsConfigMapName, metav1.GetOptions{})
-			errors.CheckError(err)
-			export(writer, *acdKnownHostsConfigMap, namespace)
-			acdTLSCertsConfigMap, err := acdClients.configMaps.Get(ctx, common.ArgoCDTLSCertsConfigMapName, metav1.GetOptions{})
-			errors.CheckError(err)
-			export(writer, *acdTLSCertsConfigMap, namespace)
-
-			secrets, err := acdClients.secrets.List(ctx, metav1.ListOptions{})
-			errors.CheckError(err)
-			for _, secret := range secrets.Items {
-				if isArgoCDSecret(secret) {
-					export(writer, secret, namespace)
-				}
-			}
-
-			projects, err := acdClients.projects.List(ctx, metav1.ListOptions{})
-			errors.CheckError(err)
-			for _, proj := range projects.Items {
-				export(writer, proj, namespace)
-			}
-
-			applications, err := acdClients.applications.List(ctx, metav1.ListOptions{})
-			errors.CheckError(err)
-			for _, app := range applications.Items {
-				// Export application only if it is in one of the enabled namespaces
-				if secutil.IsNamespaceEnabled(app.GetNamespace(), namespace, applicationNamespaces) {
-					export(writer, app, namespace)
-				}
-			}
-			applicationSets, err := acdClients.applicationSets.List(ctx, metav1.ListOptions{})
-			if err != nil && !apierrors.IsNotFound(err) {
-				if apierrors.IsForbidden(err) {
-					log.Warn(err)
-				} else {
-					errors.CheckError(err)
-				}
-			}
-			if applicationSets != nil {
-				for _, appSet := range applicationSets.Items {
-					if secutil.IsNamespaceEnabled(appSet.GetNamespace(), namespace, applicationsetNamespaces) {
-						export(writer, appSet, namespace)
-					}
-				}
-			}
+			errors.CheckError(exportResources(ctx, writer, acdClients, client, namespace, applicationNamespaces, applicationsetNamespaces))
 		},
 	}
 
@@ -154,6 +90,89 @@ func NewExportCommand() *cobra.Command {
 	return &command
 }
 
+// exportResources writes the Argo CD configuration, projects, applications and ApplicationSets of the namespace to
+// the writer. The applications and ApplicationSets of the additional namespaces are also written.
+func exportResources(ctx context.Context, w io.Writer, acdClients *argoCDClientsets, client dynamic.Interface, namespace string, applicationNamespaces, applicationsetNamespaces []string) error {
+	if len(applicationNamespaces) == 0 || len(applicationsetNamespaces) == 0 {
+		defaultNs := getAdditionalNamespaces(ctx, acdClients.configMaps)
+		if len(applicationNamespaces) == 0 {
+			applicationNamespaces = defaultNs.applicationNamespaces
+		}
+		if len(applicationsetNamespaces) == 0 {
+			applicationsetNamespaces = defaultNs.applicationsetNamespaces
+		}
+	}
+	// To support applications and applicationsets in any namespace, we must list ALL namespaces and filter them afterwards
+	if len(applicationNamespaces) > 0 {
+		acdClients.applications = client.Resource(applicationsResource)
+	}
+	if len(applicationsetNamespaces) > 0 {
+		acdClients.applicationSets = client.Resource(appplicationSetResource)
+	}
+
+	for _, name := range []string{common.ArgoCDConfigMapName, common.ArgoCDRBACConfigMapName, common.ArgoCDKnownHostsConfigMapName, common.ArgoCDTLSCertsConfigMapName} {
+		configMap, err := acdClients.configMaps.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error getting ConfigMap %s: %w", name, err)
+		}
+		if err := writeResource(w, *configMap, namespace); err != nil {
+			return err
+		}
+	}
+
+	secrets, err := acdClients.secrets.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing secrets: %w", err)
+	}
+	for _, secret := range secrets.Items {
+		if isArgoCDSecret(secret) {
+			if err := writeResource(w, secret, namespace); err != nil {
+				return err
+			}
+		}
+	}
+
+	projects, err := acdClients.projects.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing projects: %w", err)
+	}
+	for _, proj := range projects.Items {
+		if err := writeResource(w, proj, namespace); err != nil {
+			return err
+		}
+	}
+
+	applications, err := acdClients.applications.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing applications: %w", err)
+	}
+	for _, app := range applications.Items {
+		// Export application only if it is in one of the enabled namespaces
+		if secutil.IsNamespaceEnabled(app.GetNamespace(), namespace, applicationNamespaces) {
+			if err := writeResource(w, app, namespace); err != nil {
+				return err
+			}
+		}
+	}
+	applicationSets, err := acdClients.applicationSets.List(ctx, metav1.ListOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		if !apierrors.IsForbidden(err) {
+			return fmt.Errorf("error listing ApplicationSets: %w", err)
+		}
+		log.Warn(err)
+	}
+	if applicationSets != nil {
+		for _, appSet := range applicationSets.Items {
+			if secutil.IsNamespaceEnabled(appSet.GetNamespace(), namespace, applicationsetNamespaces) {
+				if err := writeResource(w, appSet, namespace); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // NewImportCommand defines a new command for exporting Kubernetes and Argo CD resources.
 func NewImportCommand() *cobra.Command {
 	var (
@@ -166,12 +185,13 @@ func NewImportCommand() *cobra.Command {
 		overrideOnConflict       bool
 		promptsEnabled           bool
 		skipResourcesWithLabel   string
+		backupKeyFile            string
 		applicationNamespaces    []string
 		applicationsetNamespaces []string
 	)
 	command := cobra.Command{
 		Use:   "import SOURCE",
-		Short: "Import Argo CD data from stdin (specify `-') or a file",
+		Short: "Import Argo CD data from stdin (specify `-'), a file or a snapshot",
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -191,9 +211,15 @@ func NewImportCommand() *cobra.Command {
 			fmt.Printf("import process started %s\n", namespace)
 			tt := time.Now()
 			var input []byte
-			if in := args[0]; in == "-" {
+			switch in := args[0]; {
+			case in == "-":
 				input, err = io.ReadAll(os.Stdin)
-			} else {
+			case isSnapshotLocation(in):
+				var key []byte
+				key, err = readBackupKey(backupKeyFile)
+				errors.CheckError(err)
+				input, err = backup.ReadSnapshot(ctx, in, key)
+			default:
 				input, err = os.ReadFile(in)
 			}
 			errors.CheckError(err)
@@ -436,6 +462,7 @@ func NewImportCommand() *cobra.Command {
 	command.Flags().StringVarP(&skipResourcesWithLabel, "skip-resources-with-label", "", "", "Skip importing resources based on the label e.g. '--skip-resources-with-label my-label/example.io=true'")
 	command.Flags().StringSliceVarP(&applicationNamespaces, "application-namespaces", "", []string{}, fmt.Sprintf("Comma separated list of namespace globs to which import of applications is allowed. If not provided, value from '%s' in %s will be used. If it's not defined, only applications without an explicit namespace will be imported to the Argo CD namespace", applicationNamespacesCmdParamsKey, common.ArgoCDCmdParamsConfigMapName))
 	command.Flags().StringSliceVarP(&applicationsetNamespaces, "applicationset-namespaces", "", []string{}, fmt.Sprintf("Comma separated list of namespace globs which import of applicationsets is allowed. If not provided, value from '%s' in %s will be used. If it's not defined, only applicationsets without an explicit namespace will be imported to the Argo CD namespace", applicationsetNamespacesCmdParamsKey, common.ArgoCDCmdParamsConfigMapName))
+	command.Flags().StringVar(&backupKeyFile, "backup-key-file", "", "File containing the passphrase decrypting the secrets of the snapshot, if SOURCE is a snapshot location, e.g. s3://bucket/prefix/latest")
 	command.PersistentFlags().BoolVar(&promptsEnabled, "prompts-enabled", localconfig.GetPromptsEnabled(true), "Force optional interactive prompts to be enabled or disabled, overriding local configuration. If not specified, the local configuration value will be used, which is false by default.")
 	return &command
 }
@@ -453,6 +480,11 @@ func checkAppHasNoNeedToStopOperation(liveObj unstructured.Unstructured, stopOpe
 
 // export writes the unstructured object and removes extraneous cruft from output before writing
 func export(w io.Writer, un unstructured.Unstructured, argocdNamespace string) {
+	errors.CheckError(writeResource(w, un, argocdNamespace))
+}
+
+// writeResource writes the unstructured object and removes extraneous cruft from output before writing
+func writeResource(w io.Writer, un unstructured.Unstructured, argocdNamespace string) error {
 	name := un.GetName()
 	finalizers := un.GetFinalizers()
 	apiVersion := un.GetAPIVersion()
@@ -472,11 +504,14 @@ func export(w io.Writer, un unstructured.Unstructured, argocdNamespace string) {
 		un.SetNamespace(namespace)
 	}
 	data, err := yaml.Marshal(un.Object)
-	errors.CheckError(err)
-	_, err = w.Write(data)
-	errors.CheckError(err)
+	if err != nil {
+		return fmt.Errorf("error marshaling %s %s: %w", kind, name, err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
 	_, err = w.Write([]byte(yamlSeparator))
-	errors.CheckError(err)
+	return err
 }
 
 // updateLive replaces the live object's finalizers, spec, annotations, labels, and data from the
@@ -0,0 +1,206 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/backup"
+	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/crypto"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+)
+
+// NewBackupCommand returns a new instance of the `argocd admin backup` command
+func NewBackupCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "backup",
+		Short: "Manage the disaster-recovery snapshots of the Argo CD data",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+		Example: `# Create a snapshot in an S3 bucket
+argocd admin backup create s3://my-bucket/argocd --backup-key-file /etc/argocd-backup/passphrase
+
+# Create a snapshot every hour, and keep the snapshots of the last 7 days
+argocd admin backup run s3://my-bucket/argocd --backup-key-file /etc/argocd-backup/passphrase --schedule "0 * * * *" --retention-max-age 168h
+
+# Restore the latest snapshot
+argocd admin backup restore s3://my-bucket/argocd/latest --backup-key-file /etc/argocd-backup/passphrase`,
+	}
+	command.AddCommand(NewBackupCreateCommand())
+	command.AddCommand(NewBackupRunCommand())
+	command.AddCommand(NewBackupListCommand())
+	command.AddCommand(NewBackupRestoreCommand())
+	return command
+}
+
+type backupOptions struct {
+	clientConfig             clientcmd.ClientConfig
+	backupKeyFile            string
+	retentionCount           int
+	retentionMaxAge          time.Duration
+	applicationNamespaces    []string
+	applicationsetNamespaces []string
+}
+
+func (opts *backupOptions) addFlags(command *cobra.Command) {
+	opts.clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&opts.backupKeyFile, "backup-key-file", "", "File containing the passphrase encrypting the secrets of the snapshots")
+	command.Flags().IntVar(&opts.retentionCount, "retention-count", 0, "Number of the latest snapshots to keep, 0 to keep all the snapshots")
+	command.Flags().DurationVar(&opts.retentionMaxAge, "retention-max-age", 0, "Maximum age of the snapshots to keep, 0 to keep all the snapshots. The latest snapshot is always kept")
+	command.Flags().StringSliceVar(&opts.applicationNamespaces, "application-namespaces", []string{}, fmt.Sprintf("Comma-separated list of namespace globs to back up applications from, in addition to the control plane namespace. If not specified, the value from '%s' in %s is used", applicationNamespacesCmdParamsKey, common.ArgoCDCmdParamsConfigMapName))
+	command.Flags().StringSliceVar(&opts.applicationsetNamespaces, "applicationset-namespaces", []string{}, fmt.Sprintf("Comma-separated list of namespace globs to back up ApplicationSets from, in addition to the control plane namespace. If not specified, the value from '%s' in %s is used", applicationsetNamespacesCmdParamsKey, common.ArgoCDCmdParamsConfigMapName))
+}
+
+func (opts *backupOptions) newBackup(location string) *backup.Backup {
+	store, err := backup.NewStore(location)
+	errors.CheckError(err)
+	key, err := readBackupKey(opts.backupKeyFile)
+	errors.CheckError(err)
+	if key == nil {
+		log.Fatal("--backup-key-file is required to encrypt the secrets of the snapshots")
+	}
+	config, err := opts.clientConfig.ClientConfig()
+	errors.CheckError(err)
+	namespace, _, err := opts.clientConfig.Namespace()
+	errors.CheckError(err)
+	client, err := dynamic.NewForConfig(config)
+	errors.CheckError(err)
+	export := func(ctx context.Context, w io.Writer) error {
+		return exportResources(ctx, w, newArgoCDClientsets(config, namespace), client, namespace, opts.applicationNamespaces, opts.applicationsetNamespaces)
+	}
+	return backup.NewBackup(store, key, export, backup.Retention{Count: opts.retentionCount, MaxAge: opts.retentionMaxAge})
+}
+
+// NewBackupCreateCommand returns a new instance of the `argocd admin backup create` command
+func NewBackupCreateCommand() *cobra.Command {
+	var opts backupOptions
+	command := &cobra.Command{
+		Use:   "create LOCATION",
+		Short: "Create a snapshot of the Argo CD data, and prune the snapshots exceeding the retention",
+		Long: "Create a snapshot of the Argo CD data, i.e. the data exported by `argocd admin export`, in the given location: " +
+			"s3://<bucket>/<prefix>, gs://<bucket>/<prefix> or file://<directory>. The data of the secrets is encrypted with the backup key.",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			b := opts.newBackup(args[0])
+			now := time.Now()
+			name, err := b.Create(ctx, now)
+			errors.CheckError(err)
+			fmt.Printf("Created snapshot %s\n", name)
+			pruned, err := b.Prune(ctx, now)
+			errors.CheckError(err)
+			for _, name := range pruned {
+				fmt.Printf("Pruned snapshot %s\n", name)
+			}
+		},
+	}
+	opts.addFlags(command)
+	return command
+}
+
+// NewBackupRunCommand returns a new instance of the `argocd admin backup run` command
+func NewBackupRunCommand() *cobra.Command {
+	var (
+		opts     backupOptions
+		schedule string
+	)
+	command := &cobra.Command{
+		Use:   "run LOCATION",
+		Short: "Create the snapshots of the Argo CD data on a schedule, and prune the snapshots exceeding the retention",
+		Long:  "Create the snapshots of the Argo CD data on a schedule, e.g. when running as a Deployment in the Argo CD namespace. See `argocd admin backup create` for the locations.",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			cronSchedule, err := cron.ParseStandard(schedule)
+			errors.CheckError(err)
+			opts.newBackup(args[0]).Run(ctx, cronSchedule)
+		},
+	}
+	opts.addFlags(command)
+	command.Flags().StringVar(&schedule, "schedule", "0 * * * *", "Cron schedule of the snapshots")
+	return command
+}
+
+// NewBackupListCommand returns a new instance of the `argocd admin backup list` command
+func NewBackupListCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "list LOCATION",
+		Short: "List the snapshots of the given location, from the latest to the oldest",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			store, err := backup.NewStore(args[0])
+			errors.CheckError(err)
+			snapshots, err := backup.ListSnapshots(ctx, store)
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintf(w, "NAME\tTIME\n")
+			for _, snapshot := range snapshots {
+				_, _ = fmt.Fprintf(w, "%s\t%s\n", snapshot.Name, snapshot.Time.Format(time.RFC3339))
+			}
+			_ = w.Flush()
+		},
+	}
+	return command
+}
+
+// NewBackupRestoreCommand returns a new instance of the `argocd admin backup restore` command, i.e. `argocd admin import`
+// reading a snapshot
+func NewBackupRestoreCommand() *cobra.Command {
+	command := NewImportCommand()
+	command.Use = "restore SNAPSHOT"
+	command.Short = "Restore the Argo CD data of a snapshot"
+	command.Long = "Restore the Argo CD data of a snapshot, e.g. s3://<bucket>/<prefix>/argocd-backup-20250101T000000Z.yaml.gz, " +
+		"or s3://<bucket>/<prefix>/latest for the latest snapshot. This is equivalent to `argocd admin import SNAPSHOT`."
+	return command
+}
+
+// isSnapshotLocation returns whether the import source is the location of a snapshot
+func isSnapshotLocation(source string) bool {
+	for _, scheme := range []string{"s3://", "gs://", "file://"} {
+		if strings.HasPrefix(source, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// readBackupKey returns the key of the passphrase of the given file, or nil if no file is given
+func readBackupKey(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	passphrase, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading backup key file: %w", err)
+	}
+	if strings.TrimSpace(string(passphrase)) == "" {
+		return nil, fmt.Errorf("backup key file %s is empty", path)
+	}
+	return crypto.KeyFromPassphrase(strings.TrimSpace(string(passphrase)))
+}
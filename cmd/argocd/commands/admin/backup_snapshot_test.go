@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsSnapshotLocation(t *testing.T) {
+	assert.True(t, isSnapshotLocation("s3://bucket/prefix/latest"))
+	assert.True(t, isSnapshotLocation("gs://bucket/argocd-backup-20250101T000000Z.yaml.gz"))
+	assert.True(t, isSnapshotLocation("file:///var/backups/latest"))
+	assert.False(t, isSnapshotLocation("-"))
+	assert.False(t, isSnapshotLocation("backup.yaml"))
+}
+
+func TestReadBackupKey(t *testing.T) {
+	key, err := readBackupKey("")
+	require.NoError(t, err)
+	assert.Nil(t, key)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "passphrase")
+	require.NoError(t, os.WriteFile(path, []byte("my-passphrase\n"), 0o600))
+	key, err = readBackupKey(path)
+	require.NoError(t, err)
+	assert.Len(t, key, 32)
+	trimmedPath := filepath.Join(dir, "trimmed")
+	require.NoError(t, os.WriteFile(trimmedPath, []byte("my-passphrase"), 0o600))
+	trimmedKey, err := readBackupKey(trimmedPath)
+	require.NoError(t, err)
+	assert.Equal(t, key, trimmedKey)
+
+	emptyPath := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(emptyPath, []byte(" \n"), 0o600))
+	_, err = readBackupKey(emptyPath)
+	require.ErrorContains(t, err, "is empty")
+	_, err = readBackupKey(filepath.Join(dir, "missing"))
+	assert.ErrorContains(t, err, "error reading backup key file")
+}
@@ -11,15 +11,18 @@ import (
 	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	humanize "github.com/dustin/go-humanize"
 	"github.com/redis/go-redis/v9"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
 
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/common"
@@ -65,6 +68,7 @@ argocd admin cluster namespaces my-cluster `,
 	namespacesCommand := NewClusterNamespacesCommand()
 	namespacesCommand.AddCommand(NewClusterEnableNamespacedMode())
 	namespacesCommand.AddCommand(NewClusterDisableNamespacedMode())
+	namespacesCommand.AddCommand(NewClusterGenerateNamespacedRBAC())
 	command.AddCommand(namespacesCommand)
 
 	return command
@@ -76,9 +80,11 @@ type ClusterWithInfo struct {
 	Shard int
 	// Namespaces holds list of namespaces managed by Argo CD in the cluster
 	Namespaces []string
+	// CacheStats holds statistics of the cluster cache of the application controller, if they are known
+	CacheStats *appstatecache.ClusterCacheStats
 }
 
-func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClient versioned.Interface, replicas int, shardingAlgorithm string, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), shard int, redisName string, redisHaProxyName string, redisCompressionStr string) ([]ClusterWithInfo, error) {
+func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClient versioned.Interface, replicas int, shardingAlgorithm string, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), shard int, redisName string, redisHaProxyName string, redisCompressionStr string, redisKeyPrefix string) ([]ClusterWithInfo, error) {
 	settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
 
 	argoDB := db.NewDB(namespace, settingsMgr, kubeClient)
@@ -114,7 +120,7 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 		if err != nil {
 			return nil, err
 		}
-		cache = appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewRedisCache(client, time.Hour, compressionType)), time.Hour)
+		cache = appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewRedisCacheWithKeyPrefix(client, time.Hour, compressionType, redisKeyPrefix)), time.Hour)
 	} else {
 		cache, err = cacheSrc()
 		if err != nil {
@@ -160,7 +166,11 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 				namespaces = append(namespaces, ns)
 			}
 			_ = cache.GetClusterInfo(cluster.Server, &cluster.Info)
-			clusters[batchStart+i] = ClusterWithInfo{cluster, clusterShard, namespaces}
+			cacheStats := &appstatecache.ClusterCacheStats{}
+			if err := cache.GetClusterCacheStats(cluster.Server, cacheStats); err != nil {
+				cacheStats = nil
+			}
+			clusters[batchStart+i] = ClusterWithInfo{cluster, clusterShard, namespaces, cacheStats}
 			return nil
 		})
 	}
@@ -209,7 +219,7 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 			if replicas == 0 {
 				return
 			}
-			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, namespace, portForwardRedis, cacheSrc, shard, clientOpts.RedisName, clientOpts.RedisHaProxyName, clientOpts.RedisCompression)
+			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, namespace, portForwardRedis, cacheSrc, shard, clientOpts.RedisName, clientOpts.RedisHaProxyName, clientOpts.RedisCompression, clientOpts.RedisKeyPrefix)
 			errors.CheckError(err)
 			if len(clusters) == 0 {
 				return
@@ -457,6 +467,142 @@ func NewClusterDisableNamespacedMode() *cobra.Command {
 	return &command
 }
 
+// namespacedControllerRBAC returns the Role and RoleBinding which allow the application controller service account to
+// watch all the resources of the given namespace and to manage the resources of the given API groups
+func namespacedControllerRBAC(namespace string, apiGroups []string, serviceAccount string, serviceAccountNamespace string) (*rbacv1.Role, *rbacv1.RoleBinding) {
+	name := common.ApplicationController
+	rules := []rbacv1.PolicyRule{{
+		APIGroups: []string{"*"},
+		Resources: []string{"*"},
+		Verbs:     []string{"get", "list", "watch"},
+	}}
+	if len(apiGroups) > 0 {
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups: apiGroups,
+			Resources: []string{"*"},
+			Verbs:     []string{"*"},
+		})
+	}
+	role := &rbacv1.Role{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "Role"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Rules:      rules,
+	}
+	roleBinding := &rbacv1.RoleBinding{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "RoleBinding"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		RoleRef:    rbacv1.RoleRef{APIGroup: "rbac.authorization.k8s.io", Kind: "Role", Name: name},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: serviceAccount, Namespace: serviceAccountNamespace}},
+	}
+	return role, roleBinding
+}
+
+// managedAPIGroups returns the API groups of the resources managed by the applications in each namespace of the given
+// cluster
+func managedAPIGroups(ctx context.Context, argoDB db.ArgoDB, apps []v1alpha1.Application, server string) (map[string][]string, error) {
+	groupsByNamespace := map[string]map[string]bool{}
+	for _, app := range apps {
+		destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, argoDB)
+		if err != nil {
+			return nil, fmt.Errorf("error validating application destination: %w", err)
+		}
+		if destCluster.Server != server {
+			continue
+		}
+		for _, res := range app.Status.Resources {
+			if res.Namespace == "" {
+				continue
+			}
+			if groupsByNamespace[res.Namespace] == nil {
+				groupsByNamespace[res.Namespace] = map[string]bool{}
+			}
+			groupsByNamespace[res.Namespace][res.Group] = true
+		}
+	}
+	result := map[string][]string{}
+	for namespace, groups := range groupsByNamespace {
+		for group := range groups {
+			result[namespace] = append(result[namespace], group)
+		}
+		sort.Strings(result[namespace])
+	}
+	return result, nil
+}
+
+func NewClusterGenerateNamespacedRBAC() *cobra.Command {
+	var (
+		clientConfig            clientcmd.ClientConfig
+		serviceAccount          string
+		serviceAccountNamespace string
+	)
+	command := cobra.Command{
+		Use:   "generate-rbac PATTERN",
+		Short: "Generate the minimal Roles the application controller needs in the namespaces it manages in the clusters which name matches to the specified pattern.",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+
+			log.SetLevel(log.WarnLevel)
+
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			pattern := args[0]
+
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			if serviceAccountNamespace == "" {
+				serviceAccountNamespace = namespace
+			}
+
+			errors.CheckError(runClusterNamespacesCommand(ctx, clientConfig, func(appClient *versioned.Clientset, argoDB db.ArgoDB, clusters map[string][]string) error {
+				appItems, err := appClient.ArgoprojV1alpha1().Applications(namespace).List(ctx, metav1.ListOptions{})
+				if err != nil {
+					return fmt.Errorf("error listing application: %w", err)
+				}
+				servers := make([]string, 0, len(clusters))
+				for server := range clusters {
+					servers = append(servers, server)
+				}
+				sort.Strings(servers)
+				for _, server := range servers {
+					if len(clusters[server]) == 0 || !glob.Match(pattern, server) {
+						continue
+					}
+					cluster, err := argoDB.GetCluster(ctx, server)
+					if err != nil {
+						return fmt.Errorf("error getting cluster from server: %w", err)
+					}
+					if cluster.ClusterResources {
+						_, _ = fmt.Fprintf(os.Stderr, "Cluster %s manages cluster level resources, which require a ClusterRole\n", server)
+					}
+					apiGroups, err := managedAPIGroups(ctx, argoDB, appItems.Items, server)
+					if err != nil {
+						return err
+					}
+					namespaces := clusters[server]
+					sort.Strings(namespaces)
+					for _, ns := range namespaces {
+						role, roleBinding := namespacedControllerRBAC(ns, apiGroups[ns], serviceAccount, serviceAccountNamespace)
+						for _, obj := range []any{role, roleBinding} {
+							out, err := yaml.Marshal(obj)
+							if err != nil {
+								return fmt.Errorf("error marshaling yaml: %w", err)
+							}
+							fmt.Printf("# cluster: %s\n%s---\n", server, out)
+						}
+					}
+				}
+				return nil
+			}))
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(&command)
+	command.Flags().StringVar(&serviceAccount, "service-account", common.ApplicationController, "Name of the service account of the application controller in the managed clusters")
+	command.Flags().StringVar(&serviceAccountNamespace, "service-account-namespace", "", "Namespace of the service account of the application controller in the managed clusters. Defaults to the Argo CD namespace")
+	return &command
+}
+
 func NewClusterStatsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		shard             int
@@ -465,9 +611,10 @@ func NewClusterStatsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 		clientConfig      clientcmd.ClientConfig
 		cacheSrc          func() (*appstatecache.Cache, error)
 		portForwardRedis  bool
+		cacheDetails      bool
 	)
 	command := cobra.Command{
-		Use:   "stats",
+		Use:   "stats [SERVER|NAME]",
 		Short: "Prints information cluster statistics and inferred shard number",
 		Example: `
 #Display stats and shards for clusters 
@@ -477,8 +624,14 @@ argocd admin cluster stats
 argocd admin cluster stats --shard=1
 
 #In a multi-cluster environment to print stats for a specific cluster say(target-cluster)
-argocd admin cluster stats target-cluster`,
-		Run: func(cmd *cobra.Command, _ []string) {
+argocd admin cluster stats target-cluster
+
+#Display the statistics of the cluster caches of the application controller
+argocd admin cluster stats --cache
+
+#Display the cached resources by API version and kind for a specific cluster
+argocd admin cluster stats --cache target-cluster`,
+		Run: func(cmd *cobra.Command, args []string) {
 			ctx := cmd.Context()
 
 			log.SetLevel(log.WarnLevel)
@@ -494,9 +647,17 @@ argocd admin cluster stats target-cluster`,
 				replicas, err = getControllerReplicas(ctx, kubeClient, namespace, clientOpts.AppControllerName)
 				errors.CheckError(err)
 			}
-			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, namespace, portForwardRedis, cacheSrc, shard, clientOpts.RedisName, clientOpts.RedisHaProxyName, clientOpts.RedisCompression)
+			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, namespace, portForwardRedis, cacheSrc, shard, clientOpts.RedisName, clientOpts.RedisHaProxyName, clientOpts.RedisCompression, clientOpts.RedisKeyPrefix)
 			errors.CheckError(err)
 
+			if len(args) > 0 {
+				clusters = filterClusters(clusters, args[0])
+			}
+			if cacheDetails {
+				printCacheStats(clusters, len(args) > 0)
+				return
+			}
+
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 			_, _ = fmt.Fprintf(w, "SERVER\tSHARD\tCONNECTION\tNAMESPACES COUNT\tAPPS COUNT\tRESOURCES COUNT\n")
 			for _, cluster := range clusters {
@@ -510,6 +671,7 @@ argocd admin cluster stats target-cluster`,
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
 	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
+	command.Flags().BoolVar(&cacheDetails, "cache", false, "Print the statistics of the cluster caches of the application controller, and the cached resources by API version and kind of the given cluster")
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 
 	// parse all added flags so far to get the redis-compression flag that was added by AddCacheFlagsToCmd() above
@@ -519,6 +681,69 @@ argocd admin cluster stats target-cluster`,
 	return &command
 }
 
+// filterClusters returns the clusters with the given server or name
+func filterClusters(clusters []ClusterWithInfo, serverOrName string) []ClusterWithInfo {
+	var res []ClusterWithInfo
+	for _, cluster := range clusters {
+		if cluster.Server == serverOrName || cluster.Name == serverOrName {
+			res = append(res, cluster)
+		}
+	}
+	return res
+}
+
+// printCacheStats prints the statistics of the caches of the given clusters, and their cached resources by API version
+// and kind if requested
+func printCacheStats(clusters []ClusterWithInfo, printResources bool) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "SERVER\tSHARD\tRESOURCES COUNT\tMANIFESTS COUNT\tWATCHES COUNT\tLAST FULL RESYNC\tMEMORY ESTIMATE\n")
+	for _, cluster := range clusters {
+		stats := cluster.CacheStats
+		if stats == nil {
+			_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t-\t-\t-\t-\n", cluster.Server, cluster.Shard, cluster.Info.CacheInfo.ResourcesCount)
+			continue
+		}
+		lastFullResync := "-"
+		if stats.LastFullResyncTime != nil {
+			lastFullResync = stats.LastFullResyncTime.Format(time.RFC3339)
+		}
+		var resourcesCount int64
+		for _, count := range stats.ResourcesByGVK {
+			resourcesCount += count
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%s\t%s\n", cluster.Server, cluster.Shard, resourcesCount, stats.ManifestsCount, stats.WatchesCount, lastFullResync, humanize.IBytes(uint64(stats.MemoryEstimateBytes)))
+	}
+	_ = w.Flush()
+
+	if !printResources {
+		return
+	}
+	for _, cluster := range clusters {
+		if cluster.CacheStats == nil {
+			continue
+		}
+		gvks := make([]string, 0, len(cluster.CacheStats.ResourcesByGVK))
+		for gvk := range cluster.CacheStats.ResourcesByGVK {
+			gvks = append(gvks, gvk)
+		}
+		// the most numerous resources are the first candidates to the resource exclusions
+		sort.Slice(gvks, func(i, j int) bool {
+			countI, countJ := cluster.CacheStats.ResourcesByGVK[gvks[i]], cluster.CacheStats.ResourcesByGVK[gvks[j]]
+			if countI != countJ {
+				return countI > countJ
+			}
+			return gvks[i] < gvks[j]
+		})
+		fmt.Printf("\nCached resources of %s:\n", cluster.Server)
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintf(w, "API VERSION/KIND\tCOUNT\n")
+		for _, gvk := range gvks {
+			_, _ = fmt.Fprintf(w, "%s\t%d\n", gvk, cluster.CacheStats.ResourcesByGVK[gvk])
+		}
+		_ = w.Flush()
+	}
+}
+
 // NewClusterConfig returns a new instance of `argocd admin kubeconfig` command
 func NewClusterConfig() *cobra.Command {
 	var clientConfig clientcmd.ClientConfig
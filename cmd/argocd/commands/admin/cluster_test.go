@@ -56,7 +56,7 @@ func Test_loadClusters(t *testing.T) {
 	cacheSrc := func() (*appstate.Cache, error) {
 		return appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Minute)), time.Minute), nil
 	}
-	clusters, err := loadClusters(ctx, kubeClient, appClient, 3, "", "argocd", false, cacheSrc, 0, "", "", "")
+	clusters, err := loadClusters(ctx, kubeClient, appClient, 3, "", "argocd", false, cacheSrc, 0, "", "", "", "")
 	require.NoError(t, err)
 	for i := range clusters {
 		// This changes, nil it to avoid testing it.
@@ -79,3 +79,22 @@ func Test_loadClusters(t *testing.T) {
 	}}
 	assert.Equal(t, expected, clusters)
 }
+
+func Test_namespacedControllerRBAC(t *testing.T) {
+	role, roleBinding := namespacedControllerRBAC("guestbook", []string{"", "apps"}, "argocd-application-controller", "argocd")
+
+	assert.Equal(t, "guestbook", role.Namespace)
+	require.Len(t, role.Rules, 2)
+	assert.Equal(t, []string{"get", "list", "watch"}, role.Rules[0].Verbs)
+	assert.Equal(t, []string{"", "apps"}, role.Rules[1].APIGroups)
+	assert.Equal(t, []string{"*"}, role.Rules[1].Verbs)
+
+	assert.Equal(t, "guestbook", roleBinding.Namespace)
+	assert.Equal(t, role.Name, roleBinding.RoleRef.Name)
+	require.Len(t, roleBinding.Subjects, 1)
+	assert.Equal(t, "argocd-application-controller", roleBinding.Subjects[0].Name)
+	assert.Equal(t, "argocd", roleBinding.Subjects[0].Namespace)
+
+	role, _ = namespacedControllerRBAC("guestbook", nil, "argocd-application-controller", "argocd")
+	assert.Len(t, role.Rules, 1)
+}
@@ -3,21 +3,30 @@ package admin
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
+	"net/http"
 	"os"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	healthutil "github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/templates"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/clientcmd"
@@ -25,19 +34,26 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/common"
 	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/lua"
+	"github.com/argoproj/argo-cd/v3/util/notification/expression"
+	"github.com/argoproj/argo-cd/v3/util/notification/expression/shared"
+	"github.com/argoproj/argo-cd/v3/util/security"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
 type settingsOpts struct {
-	argocdCMPath        string
-	argocdSecretPath    string
-	loadClusterSettings bool
-	clientConfig        clientcmd.ClientConfig
+	argocdCMPath              string
+	argocdSecretPath          string
+	argocdRBACCMPath          string
+	argocdNotificationsCMPath string
+	loadClusterSettings       bool
+	clientConfig              clientcmd.ClientConfig
 }
 
 type commandContext interface {
@@ -123,7 +139,11 @@ func (opts *settingsOpts) createSettingsManager(ctx context.Context) (*settings.
 		}
 	}
 	setSettingsMeta(argocdSecret)
-	clientset := fake.NewClientset(argocdSecret, argocdCM)
+	objs, err := opts.loadOptionalSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clientset := fake.NewClientset(append(objs, argocdSecret, argocdCM)...)
 
 	manager := settings.NewSettingsManager(ctx, clientset, "default")
 	errors.CheckError(manager.ResyncInformers())
@@ -131,6 +151,64 @@ func (opts *settingsOpts) createSettingsManager(ctx context.Context) (*settings.
 	return manager, nil
 }
 
+// loadOptionalSettings loads the RBAC and notifications settings, which are validated only if they are provided by a
+// local file or loaded from the cluster
+func (opts *settingsOpts) loadOptionalSettings(ctx context.Context) ([]runtime.Object, error) {
+	var objs []runtime.Object
+	optionalConfigMaps := []struct {
+		name string
+		path string
+	}{
+		{name: common.ArgoCDRBACConfigMapName, path: opts.argocdRBACCMPath},
+		{name: common.ArgoCDNotificationsConfigMapName, path: opts.argocdNotificationsCMPath},
+	}
+	for _, optional := range optionalConfigMaps {
+		var cm *corev1.ConfigMap
+		switch {
+		case optional.path != "":
+			data, err := os.ReadFile(optional.path)
+			if err != nil {
+				return nil, err
+			}
+			if err := yaml.Unmarshal(data, &cm); err != nil {
+				return nil, err
+			}
+			cm.Name = optional.name
+		case opts.loadClusterSettings:
+			realClientset, ns, err := opts.getK8sClient()
+			if err != nil {
+				return nil, err
+			}
+			cm, err = realClientset.CoreV1().ConfigMaps(ns).Get(ctx, optional.name, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+		default:
+			continue
+		}
+		setSettingsMeta(cm)
+		objs = append(objs, cm)
+	}
+	if opts.loadClusterSettings {
+		realClientset, ns, err := opts.getK8sClient()
+		if err != nil {
+			return nil, err
+		}
+		secret, err := realClientset.CoreV1().Secrets(ns).Get(ctx, common.ArgoCDNotificationsSecretName, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			setSettingsMeta(secret)
+			objs = append(objs, secret)
+		}
+	}
+	return objs, nil
+}
+
 func (opts *settingsOpts) getK8sClient() (*kubernetes.Clientset, string, error) {
 	namespace, _, err := opts.clientConfig.Namespace()
 	if err != nil {
@@ -168,19 +246,27 @@ func NewSettingsCommand() *cobra.Command {
 	opts.clientConfig = cli.AddKubectlFlagsToCmd(command)
 	command.PersistentFlags().StringVar(&opts.argocdCMPath, "argocd-cm-path", "", "Path to local argocd-cm.yaml file")
 	command.PersistentFlags().StringVar(&opts.argocdSecretPath, "argocd-secret-path", "", "Path to local argocd-secret.yaml file")
+	command.PersistentFlags().StringVar(&opts.argocdRBACCMPath, "argocd-rbac-cm-path", "", "Path to local argocd-rbac-cm.yaml file")
+	command.PersistentFlags().StringVar(&opts.argocdNotificationsCMPath, "argocd-notifications-cm-path", "", "Path to local argocd-notifications-cm.yaml file")
 	command.PersistentFlags().BoolVar(&opts.loadClusterSettings, "load-cluster-settings", false,
 		"Indicates that config map and secret should be loaded from cluster unless local file path is provided")
 	return command
 }
 
-type settingValidator func(manager *settings.SettingsManager) (string, error)
+// validateOpts are the options of the validation of the settings
+type validateOpts struct {
+	// offline disables the checks requiring network access, e.g. the reachability of the OIDC issuer
+	offline bool
+}
+
+type settingValidator func(manager *settings.SettingsManager, opts validateOpts) (string, error)
 
 func joinValidators(validators ...settingValidator) settingValidator {
-	return func(manager *settings.SettingsManager) (string, error) {
+	return func(manager *settings.SettingsManager, opts validateOpts) (string, error) {
 		var errorStrs []string
 		var summaries []string
 		for i := range validators {
-			summary, err := validators[i](manager)
+			summary, err := validators[i](manager, opts)
 			if err != nil {
 				errorStrs = append(errorStrs, err.Error())
 			}
@@ -196,7 +282,7 @@ func joinValidators(validators ...settingValidator) settingValidator {
 }
 
 var validatorsByGroup = map[string]settingValidator{
-	"general": joinValidators(func(manager *settings.SettingsManager) (string, error) {
+	"general": joinValidators(func(manager *settings.SettingsManager, opts validateOpts) (string, error) {
 		general, err := manager.GetSettings()
 		if err != nil {
 			return "", err
@@ -211,6 +297,11 @@ var validatorsByGroup = map[string]settingValidator{
 			if err := settings.ValidateOIDCConfig(general.OIDCConfigRAW); err != nil {
 				return "", fmt.Errorf("invalid oidc.config: %w", err)
 			}
+			if !opts.offline {
+				if err := checkOIDCIssuerReachable(general); err != nil {
+					return "", err
+				}
+			}
 			ssoProvider = "OIDC"
 		}
 		var summary string
@@ -224,17 +315,17 @@ var validatorsByGroup = map[string]settingValidator{
 			summary = "SSO is not configured"
 		}
 		return summary, nil
-	}, func(manager *settings.SettingsManager) (string, error) {
+	}, func(manager *settings.SettingsManager, _ validateOpts) (string, error) {
 		_, err := manager.GetAppInstanceLabelKey()
 		return "", err
-	}, func(manager *settings.SettingsManager) (string, error) {
+	}, func(manager *settings.SettingsManager, _ validateOpts) (string, error) {
 		_, err := manager.GetHelp()
 		return "", err
-	}, func(manager *settings.SettingsManager) (string, error) {
+	}, func(manager *settings.SettingsManager, _ validateOpts) (string, error) {
 		_, err := manager.GetGoogleAnalytics()
 		return "", err
 	}),
-	"kustomize": func(manager *settings.SettingsManager) (string, error) {
+	"kustomize": func(manager *settings.SettingsManager, _ validateOpts) (string, error) {
 		opts, err := manager.GetKustomizeSettings()
 		if err != nil {
 			return "", err
@@ -248,24 +339,206 @@ var validatorsByGroup = map[string]settingValidator{
 		}
 		return summary, err
 	},
-	"accounts": func(manager *settings.SettingsManager) (string, error) {
+	"accounts": func(manager *settings.SettingsManager, _ validateOpts) (string, error) {
 		accounts, err := manager.GetAccounts()
 		if err != nil {
 			return "", err
 		}
 		return fmt.Sprintf("%d accounts", len(accounts)), nil
 	},
-	"resource-overrides": func(manager *settings.SettingsManager) (string, error) {
+	"resource-overrides": func(manager *settings.SettingsManager, _ validateOpts) (string, error) {
 		overrides, err := manager.GetResourceOverrides()
 		if err != nil {
 			return "", err
 		}
+		keys := make([]string, 0, len(overrides))
+		for key := range overrides {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var errorStrs []string
+		for _, key := range keys {
+			if err := lua.ValidateResourceOverride(overrides[key]); err != nil {
+				errorStrs = append(errorStrs, fmt.Sprintf("%s: %v", key, err))
+			}
+		}
+		if len(errorStrs) > 0 {
+			return "", stderrors.New(strings.Join(errorStrs, "\n"))
+		}
 		return fmt.Sprintf("%d resource overrides", len(overrides)), nil
 	},
+	"rbac": func(manager *settings.SettingsManager, _ validateOpts) (string, error) {
+		cm, err := manager.GetConfigMapByName(common.ArgoCDRBACConfigMapName)
+		if apierrors.IsNotFound(err) {
+			return "RBAC is not configured", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		return validateRBACConfigMap(cm)
+	},
+	"notifications": func(manager *settings.SettingsManager, _ validateOpts) (string, error) {
+		cm, err := manager.GetConfigMapByName(common.ArgoCDNotificationsConfigMapName)
+		if apierrors.IsNotFound(err) {
+			return "Notifications are not configured", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		secret, err := manager.GetSecretByName(common.ArgoCDNotificationsSecretName)
+		if apierrors.IsNotFound(err) {
+			secret = &corev1.Secret{}
+		} else if err != nil {
+			return "", err
+		}
+		return validateNotificationsConfig(cm, secret)
+	},
+}
+
+// oidcIssuerTimeout is the timeout of the request of the discovery document of the OIDC issuer
+const oidcIssuerTimeout = 10 * time.Second
+
+// checkOIDCIssuerReachable fetches the discovery document of the configured OIDC issuer
+func checkOIDCIssuerReachable(general *settings.ArgoCDSettings) error {
+	oidcConfig := general.OIDCConfig()
+	if oidcConfig == nil || oidcConfig.Issuer == "" {
+		return nil
+	}
+	discoveryURL := strings.TrimSuffix(oidcConfig.Issuer, "/") + "/.well-known/openid-configuration"
+	client := &http.Client{
+		Timeout:   oidcIssuerTimeout,
+		Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: general.OIDCTLSConfig()},
+	}
+	resp, err := client.Get(discoveryURL)
+	if err != nil {
+		return fmt.Errorf("OIDC issuer %s is not reachable: %w", oidcConfig.Issuer, err)
+	}
+	defer utilio.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("OIDC issuer %s is not reachable: %s returned %s", oidcConfig.Issuer, discoveryURL, resp.Status)
+	}
+	return nil
+}
+
+// validateNotificationsConfig parses the triggers and templates of the notifications configuration, and renders the
+// templates for an example application
+func validateNotificationsConfig(cm *corev1.ConfigMap, secret *corev1.Secret) (string, error) {
+	cfg, err := api.ParseConfig(cm, secret)
+	if err != nil {
+		return "", fmt.Errorf("invalid notifications configuration: %w", err)
+	}
+	if _, err := triggers.NewService(cfg.Triggers); err != nil {
+		return "", fmt.Errorf("invalid trigger: %w", err)
+	}
+	templatesSvc, err := templates.NewService(cfg.Templates)
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+	notificationContext := map[string]string{}
+	if contextYAML, ok := cm.Data["context"]; ok {
+		if err := yaml.Unmarshal([]byte(contextYAML), &notificationContext); err != nil {
+			return "", fmt.Errorf("invalid context: %w", err)
+		}
+	}
+	app, err := runtime.DefaultUnstructuredConverter.ToUnstructured(exampleNotificationApp())
+	if err != nil {
+		return "", err
+	}
+	vars := expression.Spawn(&unstructured.Unstructured{Object: app}, offlineNotificationService{}, map[string]any{
+		"app":     app,
+		"context": notificationContext,
+	})
+	names := make([]string, 0, len(cfg.Templates))
+	for name := range cfg.Templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var errorStrs []string
+	for _, name := range names {
+		if _, err := templatesSvc.FormatNotification(vars, name); err != nil {
+			errorStrs = append(errorStrs, fmt.Sprintf("template %s: %v", name, err))
+		}
+	}
+	if len(errorStrs) > 0 {
+		return "", stderrors.New(strings.Join(errorStrs, "\n"))
+	}
+	return fmt.Sprintf("%d triggers, %d templates", len(cfg.Triggers), len(cfg.Templates)), nil
+}
+
+// exampleNotificationApp returns the synced and healthy application for which the notification templates are rendered
+func exampleNotificationApp() *v1alpha1.Application {
+	now := metav1.Now()
+	revision := "a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2"
+	return &v1alpha1.Application{
+		TypeMeta:   metav1.TypeMeta{APIVersion: application.Group + "/v1alpha1", Kind: application.ApplicationKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd", CreationTimestamp: now},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "default",
+			Source: &v1alpha1.ApplicationSource{
+				RepoURL:        "https://github.com/argoproj/argocd-example-apps.git",
+				Path:           "guestbook",
+				TargetRevision: "HEAD",
+			},
+			Destination: v1alpha1.ApplicationDestination{Server: v1alpha1.KubernetesInternalAPIServerAddr, Namespace: "guestbook"},
+		},
+		Status: v1alpha1.ApplicationStatus{
+			Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: revision},
+			Health: v1alpha1.AppHealthStatus{Status: healthutil.HealthStatusHealthy},
+			OperationState: &v1alpha1.OperationState{
+				Operation:  v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: revision}},
+				Phase:      synccommon.OperationSucceeded,
+				Message:    "successfully synced",
+				SyncResult: &v1alpha1.SyncOperationResult{Revision: revision},
+				StartedAt:  now,
+				FinishedAt: &now,
+			},
+		},
+	}
+}
+
+// offlineNotificationService is the Argo CD service of the notification templates rendered by the validation, which
+// returns empty details rather than querying the repositories and the cluster
+type offlineNotificationService struct{}
+
+func (offlineNotificationService) GetCommitMetadata(context.Context, string, string, string) (*shared.CommitMetadata, error) {
+	return &shared.CommitMetadata{}, nil
+}
+
+func (offlineNotificationService) GetAppDetails(context.Context, *v1alpha1.Application) (*shared.AppDetail, error) {
+	return &shared.AppDetail{}, nil
+}
+
+func (offlineNotificationService) GetOutboundURLPolicy() (*security.OutboundURLPolicy, error) {
+	return &security.OutboundURLPolicy{}, nil
+}
+
+func (offlineNotificationService) GetResourcesTree(context.Context, *v1alpha1.Application) (*v1alpha1.ApplicationTree, error) {
+	return &v1alpha1.ApplicationTree{}, nil
+}
+
+func (offlineNotificationService) GetRepoConnectionState(context.Context, string, string) (*v1alpha1.ConnectionState, error) {
+	return &v1alpha1.ConnectionState{}, nil
+}
+
+func (offlineNotificationService) GetAppProject(context.Context, string) (*v1alpha1.AppProject, error) {
+	return &v1alpha1.AppProject{}, nil
+}
+
+// validationResult is the result of the validation of a group of settings
+type validationResult struct {
+	Group   string `json:"group"`
+	Valid   bool   `json:"valid"`
+	Summary string `json:"summary,omitempty"`
+	Error   string `json:"error,omitempty"`
+	Logs    string `json:"logs,omitempty"`
 }
 
 func NewValidateSettingsCommand(cmdCtx commandContext) *cobra.Command {
-	var groups []string
+	var (
+		groups  []string
+		offline bool
+		output  string
+	)
 
 	var allGroups []string
 	for k := range validatorsByGroup {
@@ -278,54 +551,99 @@ func NewValidateSettingsCommand(cmdCtx commandContext) *cobra.Command {
 	command := &cobra.Command{
 		Use:   "validate",
 		Short: "Validate settings",
-		Long:  "Validates settings specified in 'argocd-cm' ConfigMap and 'argocd-secret' Secret",
+		Long: `Validates settings specified in 'argocd-cm', 'argocd-rbac-cm' and 'argocd-notifications-cm' ConfigMaps and 'argocd-secret' Secret.
+The command exits with a non-zero status if any group of settings is invalid.`,
 		Example: `
 #Validates all settings in the specified YAML file
 argocd admin settings validate --argocd-cm-path ./argocd-cm.yaml
 
 #Validates accounts and plugins settings in Kubernetes cluster of current kubeconfig context
-argocd admin settings validate --group accounts --group plugins --load-cluster-settings`,
+argocd admin settings validate --group accounts --group plugins --load-cluster-settings
+
+#Validates the RBAC and notifications settings in the specified YAML files without network access, in JSON
+argocd admin settings validate --argocd-cm-path ./argocd-cm.yaml --argocd-rbac-cm-path ./argocd-rbac-cm.yaml \
+  --argocd-notifications-cm-path ./argocd-notifications-cm.yaml --offline -o json`,
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
+			if output != "text" && output != "json" {
+				errors.Fatalf(errors.ErrorGeneric, "unknown output format: %s", output)
+			}
+			for _, group := range groups {
+				if _, ok := validatorsByGroup[group]; !ok {
+					errors.Fatalf(errors.ErrorGeneric, "unknown group: %s (one of: %s)", group, strings.Join(allGroups, ", "))
+				}
+			}
+
 			settingsManager, err := cmdCtx.createSettingsManager(ctx)
 			errors.CheckError(err)
 
 			if len(groups) == 0 {
 				groups = allGroups
 			}
-			for i, group := range groups {
+			results := make([]validationResult, 0, len(groups))
+			for _, group := range groups {
 				validator := validatorsByGroup[group]
 
-				logs := collectLogs(func() {
-					summary, err := validator(settingsManager)
-
+				result := validationResult{Group: group}
+				result.Logs = collectLogs(func() {
+					summary, err := validator(settingsManager, validateOpts{offline: offline})
 					if err != nil {
-						_, _ = fmt.Fprintf(os.Stdout, "❌ %s\n", group)
-						_, _ = fmt.Fprintf(os.Stdout, "%s\n", err.Error())
+						result.Error = err.Error()
 					} else {
-						_, _ = fmt.Fprintf(os.Stdout, "✅ %s\n", group)
-						if summary != "" {
-							_, _ = fmt.Fprintf(os.Stdout, "%s\n", summary)
-						}
+						result.Valid = true
+						result.Summary = summary
 					}
 				})
-				if logs != "" {
-					_, _ = fmt.Fprintf(os.Stdout, "%s\n", logs)
-				}
-				if i != len(groups)-1 {
-					_, _ = fmt.Fprintf(os.Stdout, "\n")
-				}
+				results = append(results, result)
+			}
+
+			valid := true
+			for _, result := range results {
+				valid = valid && result.Valid
+			}
+			if output == "json" {
+				data, err := json.MarshalIndent(results, "", "  ")
+				errors.CheckError(err)
+				_, _ = fmt.Fprintf(os.Stdout, "%s\n", data)
+			} else {
+				printValidationResults(results)
+			}
+			if !valid {
+				os.Exit(1)
 			}
 		},
 	}
 
 	command.Flags().StringArrayVar(&groups, "group", nil, fmt.Sprintf(
 		"Optional list of setting groups that have to be validated ( one of: %s)", strings.Join(allGroups, ", ")))
+	command.Flags().BoolVar(&offline, "offline", false, "Skip the checks requiring network access, e.g. the reachability of the OIDC issuer")
+	command.Flags().StringVarP(&output, "output", "o", "text", "Output format. One of: text|json")
 
 	return command
 }
 
+// printValidationResults prints the results of the validation of the groups of settings in a human-readable format
+func printValidationResults(results []validationResult) {
+	for i, result := range results {
+		if result.Valid {
+			_, _ = fmt.Fprintf(os.Stdout, "✅ %s\n", result.Group)
+			if result.Summary != "" {
+				_, _ = fmt.Fprintf(os.Stdout, "%s\n", result.Summary)
+			}
+		} else {
+			_, _ = fmt.Fprintf(os.Stdout, "❌ %s\n", result.Group)
+			_, _ = fmt.Fprintf(os.Stdout, "%s\n", result.Error)
+		}
+		if result.Logs != "" {
+			_, _ = fmt.Fprintf(os.Stdout, "%s\n", result.Logs)
+		}
+		if i != len(results)-1 {
+			_, _ = fmt.Fprintf(os.Stdout, "\n")
+		}
+	}
+}
+
 func NewResourceOverridesCommand(cmdCtx commandContext) *cobra.Command {
 	command := &cobra.Command{
 		Use:   "resource-overrides",
@@ -610,7 +928,7 @@ argocd admin settings resource-overrides action /tmp/deploy.yaml restart --argoc
 				action, err := luaVM.GetResourceAction(&res, action)
 				errors.CheckError(err)
 
-				modifiedRes, err := luaVM.ExecuteResourceAction(&res, action.ActionLua, parsedParams)
+				modifiedRes, err := luaVM.ExecuteResourceActionDefinition(&res, action, parsedParams)
 				errors.CheckError(err)
 
 				for _, impactedResource := range modifiedRes {
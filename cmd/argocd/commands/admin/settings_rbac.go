@@ -2,8 +2,10 @@ package admin
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -38,6 +40,7 @@ var resourceMap = map[string]string{
 	"certificate":     rbac.ResourceCertificates,
 	"cluster":         rbac.ResourceClusters,
 	"extension":       rbac.ResourceExtensions,
+	"federation":      rbac.ResourceFederation,
 	"gpgkey":          rbac.ResourceGPGKeys,
 	"key":             rbac.ResourceGPGKeys,
 	"log":             rbac.ResourceLogs,
@@ -59,6 +62,7 @@ var validRBACResourcesActions = map[string]actionTraitMap{
 	rbac.ResourceCertificates:    defaultCRDActions,
 	rbac.ResourceClusters:        defaultCRUDActions,
 	rbac.ResourceExtensions:      extensionActions,
+	rbac.ResourceFederation:      federationActions,
 	rbac.ResourceGPGKeys:         defaultCRDActions,
 	rbac.ResourceLogs:            logsActions,
 	rbac.ResourceExec:            execActions,
@@ -88,11 +92,13 @@ var applicationsActions = actionTraitMap{
 	rbac.ActionAction:   rbacTrait{allowPath: true},
 	rbac.ActionOverride: rbacTrait{},
 	rbac.ActionSync:     rbacTrait{},
+	rbac.ActionFreeze:   rbacTrait{},
 }
 
 var accountsActions = actionTraitMap{
-	rbac.ActionCreate: rbacTrait{},
-	rbac.ActionUpdate: rbacTrait{},
+	rbac.ActionCreate:      rbacTrait{},
+	rbac.ActionUpdate:      rbacTrait{},
+	rbac.ActionImpersonate: rbacTrait{},
 }
 
 var execActions = actionTraitMap{
@@ -107,6 +113,10 @@ var extensionActions = actionTraitMap{
 	rbac.ActionInvoke: rbacTrait{},
 }
 
+var federationActions = actionTraitMap{
+	rbac.ActionGet: rbacTrait{},
+}
+
 // NewRBACCommand is the command for 'rbac'
 func NewRBACCommand() *cobra.Command {
 	command := &cobra.Command{
@@ -363,6 +373,69 @@ func getPolicyFromConfigMap(cm *corev1.ConfigMap) (string, string, string) {
 	return rbac.PolicyCSV(cm.Data), defaultRole, cm.Data[rbac.ConfigMapMatchModeKey]
 }
 
+// validateRBACConfigMap validates the match mode and the syntax of the policy of the given RBAC ConfigMap, and checks
+// that the roles assigned by the policy or by default are defined
+func validateRBACConfigMap(cm *corev1.ConfigMap) (string, error) {
+	userPolicy, defaultRole, matchMode := getPolicyFromConfigMap(cm)
+	switch matchMode {
+	case "", rbac.GlobMatchMode, rbac.RegexMatchMode:
+	default:
+		return "", fmt.Errorf("invalid %s '%s': must be one of %s or %s", rbac.ConfigMapMatchModeKey, matchMode, rbac.GlobMatchMode, rbac.RegexMatchMode)
+	}
+	if err := rbac.ValidatePolicy(userPolicy); err != nil {
+		return "", err
+	}
+	unknownRoles, err := getUnknownRoles(userPolicy, defaultRole)
+	if err != nil {
+		return "", err
+	}
+	if len(unknownRoles) > 0 {
+		return "", fmt.Errorf("unknown roles: %s", strings.Join(unknownRoles, ", "))
+	}
+	if defaultRole == "" {
+		return "no default role", nil
+	}
+	return "default role: " + defaultRole, nil
+}
+
+// getUnknownRoles returns the roles assigned by the given policy or by default which are neither granted a permission
+// nor inherit another role in the policy or in the built-in policy. The roles of the projects are defined in the
+// AppProjects and are therefore not checked.
+func getUnknownRoles(userPolicy, defaultRole string) ([]string, error) {
+	defined := map[string]bool{}
+	var assigned []string
+	for _, policy := range []string{assets.BuiltinPolicyCSV, userPolicy} {
+		reader := csv.NewReader(strings.NewReader(policy))
+		reader.Comment = '#'
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		reader.LazyQuotes = true
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing policy: %w", err)
+		}
+		for _, record := range records {
+			switch {
+			case record[0] == "p" && len(record) > 1:
+				defined[strings.TrimSpace(record[1])] = true
+			case record[0] == "g" && len(record) > 2:
+				defined[strings.TrimSpace(record[1])] = true
+				assigned = append(assigned, strings.TrimSpace(record[2]))
+			}
+		}
+	}
+	if defaultRole != "" {
+		assigned = append(assigned, defaultRole)
+	}
+	var unknownRoles []string
+	for _, role := range assigned {
+		if !defined[role] && !strings.HasPrefix(role, "proj:") && !slices.Contains(unknownRoles, role) {
+			unknownRoles = append(unknownRoles, role)
+		}
+	}
+	return unknownRoles, nil
+}
+
 // getPolicyConfigMap fetches the RBAC config map from K8s cluster
 func getPolicyConfigMap(ctx context.Context, client kubernetes.Interface, namespace string) (*corev1.ConfigMap, error) {
 	cm, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDRBACConfigMapName, metav1.GetOptions{})
@@ -307,3 +307,45 @@ func TestNewRBACValidateCommand(t *testing.T) {
 	assert.Equal(t, "validate", command.Name())
 	assert.Equal(t, "Validate RBAC policy", command.Short)
 }
+
+func TestValidateRBACConfigMap(t *testing.T) {
+	testCases := map[string]struct {
+		data            map[string]string
+		expectedSummary string
+		expectedError   string
+	}{
+		"Valid": {
+			data: map[string]string{
+				"policy.csv": `p, role:deployer, applications, sync, */*, allow
+g, role:lead, role:deployer
+g, my-org:team-alpha, role:lead
+g, my-org:team-beta, role:readonly
+g, my-org:team-gamma, proj:my-project:ci`,
+				"policy.default": "role:readonly",
+			},
+			expectedSummary: "default role: role:readonly",
+		},
+		"UnknownRoles": {
+			data: map[string]string{
+				"policy.csv":     "g, my-org:team-alpha, role:deployer",
+				"policy.default": "role:guest",
+			},
+			expectedError: "unknown roles: role:deployer, role:guest",
+		},
+		"InvalidMatchMode": {
+			data:          map[string]string{"policy.matchMode": "exact"},
+			expectedError: "invalid policy.matchMode 'exact'",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			summary, err := validateRBACConfigMap(&corev1.ConfigMap{Data: tc.data})
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSummary, summary)
+		})
+	}
+}
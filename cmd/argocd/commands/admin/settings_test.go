@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"os"
 	"testing"
@@ -174,6 +175,19 @@ admissionregistration.k8s.io/MutatingWebhookConfiguration:
 			},
 			containsSummary: "2 resource overrides",
 		},
+		"ResourceOverrides_InvalidLua": {
+			validator: "resource-overrides",
+			data: map[string]string{
+				"resource.customizations.health.argoproj.io_Rollout": "hs = {",
+			},
+			containsError: "argoproj.io/Rollout: invalid health.lua",
+		},
+		"RBAC_NotConfigured": {
+			validator: "rbac", containsSummary: "RBAC is not configured",
+		},
+		"Notifications_NotConfigured": {
+			validator: "notifications", containsSummary: "Notifications are not configured",
+		},
 	}
 	for name := range testCases {
 		tc := testCases[name]
@@ -182,7 +196,7 @@ admissionregistration.k8s.io/MutatingWebhookConfiguration:
 			if !assert.True(t, ok) {
 				return
 			}
-			summary, err := validator(newSettingsManager(tc.data))
+			summary, err := validator(newSettingsManager(tc.data), validateOpts{offline: true})
 			if tc.containsSummary != "" {
 				require.NoError(t, err)
 				assert.Contains(t, summary, tc.containsSummary)
@@ -450,3 +464,38 @@ resume   false
 		assert.Contains(t, out, "false")
 	})
 }
+
+func TestValidateSettingsCommand_JSON(t *testing.T) {
+	cmd := NewValidateSettingsCommand(newCmdContext(map[string]string{}))
+	cmd.SetArgs([]string{"--group", "accounts", "--group", "rbac", "-o", "json"})
+	out, err := captureStdout(func() {
+		err := cmd.Execute()
+		require.NoError(t, err)
+	})
+	require.NoError(t, err)
+
+	var results []validationResult
+	require.NoError(t, json.Unmarshal([]byte(out), &results))
+	for i := range results {
+		results[i].Logs = ""
+	}
+	assert.Equal(t, []validationResult{
+		{Group: "accounts", Valid: true, Summary: "1 accounts"},
+		{Group: "rbac", Valid: true, Summary: "RBAC is not configured"},
+	}, results)
+}
+
+func TestValidateNotificationsConfig(t *testing.T) {
+	cm := &corev1.ConfigMap{Data: map[string]string{
+		"context":                     "argocdUrl: https://argocd.example.com",
+		"trigger.on-sync-succeeded":   "- when: app.status.operationState.phase in ['Succeeded']\n  send: [app-sync-succeeded]",
+		"template.app-sync-succeeded": "message: Application {{.app.metadata.name}} has been synced at {{.app.status.operationState.finishedAt}} from {{.context.argocdUrl}}.",
+	}}
+	summary, err := validateNotificationsConfig(cm, &corev1.Secret{})
+	require.NoError(t, err)
+	assert.Equal(t, "1 triggers, 1 templates", summary)
+
+	cm.Data["template.app-deployed"] = "message: Application {{.app.metadata.name has been deployed."
+	_, err = validateNotificationsConfig(cm, &corev1.Secret{})
+	assert.ErrorContains(t, err, "invalid template")
+}
@@ -17,6 +17,7 @@ import (
 	"time"
 	"unicode/utf8"
 
+	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/sync/hook"
@@ -28,6 +29,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -52,6 +54,7 @@ import (
 	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/cost"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/grpc"
@@ -92,6 +95,8 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
+	command.AddCommand(NewApplicationManifestBundleCommand(clientOpts))
+	command.AddCommand(NewApplicationLinksCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
@@ -103,6 +108,9 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationAddSourceCommand(clientOpts))
 	command.AddCommand(NewApplicationRemoveSourceCommand(clientOpts))
 	command.AddCommand(NewApplicationConfirmDeletionCommand(clientOpts))
+	command.AddCommand(NewApplicationSealParameterCommand(clientOpts))
+	command.AddCommand(NewApplicationFreezeCommand(clientOpts))
+	command.AddCommand(NewApplicationUnfreezeCommand(clientOpts))
 	return command
 }
 
@@ -123,6 +131,7 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 		fileURL      string
 		appName      string
 		upsert       bool
+		fieldManager string
 		labels       []string
 		annotations  []string
 		setFinalizer bool
@@ -172,9 +181,10 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 				conn, appIf := argocdClient.NewApplicationClientOrDie()
 				defer utilio.Close(conn)
 				appCreateRequest := application.ApplicationCreateRequest{
-					Application: app,
-					Upsert:      &upsert,
-					Validate:    &appOpts.Validate,
+					Application:  app,
+					Upsert:       &upsert,
+					Validate:     &appOpts.Validate,
+					FieldManager: &fieldManager,
 				}
 
 				// Get app before creating to see if it is being updated or no change
@@ -204,6 +214,7 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 	}
 	command.Flags().StringVar(&appName, "name", "", "A name for the app, ignored if a file is set (DEPRECATED)")
 	command.Flags().BoolVar(&upsert, "upsert", false, "Allows to override application with the same name even if supplied application spec is different from existing spec")
+	command.Flags().StringVar(&fieldManager, "field-manager", "", "Name of the manager of the application. The upsert of an application managed by another manager is rejected")
 	command.Flags().StringVarP(&fileURL, "file", "f", "", "Filename or URL to Kubernetes manifests for the app")
 	command.Flags().StringArrayVarP(&labels, "label", "l", []string{}, "Labels to apply to the app")
 	command.Flags().StringArrayVarP(&annotations, "annotations", "", []string{}, "Set metadata annotations (e.g. example=value)")
@@ -707,6 +718,9 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 	if len(wds) > 0 {
 		fmt.Printf(printOpFmtStr, "Assigned Windows:", strings.Join(wds, ","))
 	}
+	if freeze := app.Spec.Freeze; freeze.IsActive(time.Now()) {
+		fmt.Printf(printOpFmtStr, "Frozen:", freeze.Message())
+	}
 
 	var syncPolicy string
 	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.IsAutomatedSyncEnabled() {
@@ -936,6 +950,8 @@ type unsetOpts struct {
 	kustomizeImages         []string
 	kustomizeReplicas       []string
 	ignoreMissingComponents bool
+	kustomizeComponents     []string
+	kustomizePatches        bool
 	parameters              []string
 	valuesFiles             []string
 	valuesLiteral           bool
@@ -953,8 +969,10 @@ func (o *unsetOpts) KustomizeIsZero() bool {
 			!o.kustomizeVersion &&
 			!o.kustomizeNamespace &&
 			!o.ignoreMissingComponents &&
+			!o.kustomizePatches &&
 			len(o.kustomizeImages) == 0 &&
-			len(o.kustomizeReplicas) == 0
+			len(o.kustomizeReplicas) == 0 &&
+			len(o.kustomizeComponents) == 0
 }
 
 // NewApplicationUnsetCommand returns a new instance of an `argocd app unset` command
@@ -973,6 +991,9 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
   # Unset kustomize override suffix
   argocd app unset my-app --namesuffix
 
+  # Remove a kustomize component and all kustomize patches
+  argocd app unset my-app --kustomize-component ../components/ingress --kustomize-patches
+
   # Unset kustomize override suffix for source at position 1 under spec.sources of app my-app. source-position starts at 1.
   argocd app unset my-app --source-position 1 --namesuffix
 
@@ -1059,6 +1080,8 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	command.Flags().StringArrayVar(&opts.kustomizeImages, "kustomize-image", []string{}, "Kustomize images name (e.g. --kustomize-image node --kustomize-image mysql)")
 	command.Flags().StringArrayVar(&opts.kustomizeReplicas, "kustomize-replica", []string{}, "Kustomize replicas name (e.g. --kustomize-replica my-deployment --kustomize-replica my-statefulset)")
 	command.Flags().BoolVar(&opts.ignoreMissingComponents, "ignore-missing-components", false, "Unset the kustomize ignore-missing-components option (revert to false)")
+	command.Flags().StringArrayVar(&opts.kustomizeComponents, "kustomize-component", []string{}, "Kustomize components (e.g. --kustomize-component ../components/ingress)")
+	command.Flags().BoolVar(&opts.kustomizePatches, "kustomize-patches", false, "Unset all Kustomize patches")
 	command.Flags().StringArrayVar(&opts.pluginEnvs, "plugin-env", []string{}, "Unset plugin env variables (e.g --plugin-env name)")
 	command.Flags().BoolVar(&opts.passCredentials, "pass-credentials", false, "Unset passCredentials")
 	command.Flags().BoolVar(&opts.ref, "ref", false, "Unset ref on the source")
@@ -1129,6 +1152,22 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 				}
 			}
 		}
+
+		for _, kustomizeComponent := range opts.kustomizeComponents {
+			kustomizeComponents := source.Kustomize.Components
+			for i, item := range kustomizeComponents {
+				if kustomizeComponent == item {
+					source.Kustomize.Components = append(kustomizeComponents[0:i], kustomizeComponents[i+1:]...)
+					updated = true
+					break
+				}
+			}
+		}
+
+		if opts.kustomizePatches && len(source.Kustomize.Patches) > 0 {
+			source.Kustomize.Patches = nil
+			updated = true
+		}
 	}
 	if source.Helm != nil {
 		if len(opts.parameters) == 0 && len(opts.valuesFiles) == 0 && !opts.valuesLiteral && !opts.ignoreMissingValueFiles && !opts.passCredentials {
@@ -1219,6 +1258,7 @@ func getLocalObjectsString(ctx context.Context, app *argoappv1.Application, proj
 		Repo:                            &argoappv1.Repository{Repo: source.RepoURL},
 		AppLabelKey:                     appLabelKey,
 		AppName:                         app.Name,
+		AppNamespace:                    app.Namespace,
 		Namespace:                       app.Spec.Destination.Namespace,
 		ApplicationSource:               &source,
 		KustomizeOptions:                kustomizeOptions,
@@ -1268,6 +1308,8 @@ type objKeyLiveTarget struct {
 	key    kube.ResourceKey
 	live   *unstructured.Unstructured
 	target *unstructured.Unstructured
+	// diff is the diff computed by the application controller, which is reused instead of recomputing it
+	diff *argoappv1.ResourceDiff
 }
 
 // NewApplicationDiffCommand returns a new instance of an `argocd app diff` command
@@ -1287,6 +1329,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		sourcePositions      []int64
 		sourceNames          []string
 		ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
+		estimateCost         bool
 	)
 	shortDesc := "Perform a diff against the target and live state."
 	command := &cobra.Command{
@@ -1336,7 +1379,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
-			resources, err := appIf.ManagedResources(ctx, &application.ResourcesQuery{ApplicationName: &appName, AppNamespace: &appNs})
+			resources, err := appIf.ManagedResources(ctx, &application.ResourcesQuery{ApplicationName: &appName, AppNamespace: &appNs, EstimateCost: &estimateCost})
 			errors.CheckError(err)
 			conn, settingsIf := clientset.NewSettingsClientOrDie()
 			defer utilio.Close(conn)
@@ -1418,6 +1461,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
 	command.Flags().DurationVar(&ignoreNormalizerOpts.JQExecutionTimeout, "ignore-normalizer-jq-execution-timeout", normalizers.DefaultJQExecutionTimeout, "Set ignore normalizer JQ execution timeout")
+	command.Flags().BoolVar(&estimateCost, "estimate-cost", false, "Estimate the monthly cost delta of the requested resources, if the cost estimation is configured")
 	return command
 }
 
@@ -1432,6 +1476,34 @@ type DifferenceOption struct {
 	revisions     []string
 }
 
+// diffLiveTarget returns the diff of the live and target states of the item. The diff computed by the application
+// controller is reused when available, since the normalization of the states is expensive for large applications.
+func diffLiveTarget(app *argoappv1.Application, item objKeyLiveTarget, argoSettings *settings.Settings, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts) diff.DiffResult {
+	if item.diff != nil && item.diff.PredictedLiveState != "" {
+		return diff.DiffResult{Modified: item.diff.Modified, PredictedLive: []byte(item.diff.PredictedLiveState)}
+	}
+
+	overrides := make(map[string]argoappv1.ResourceOverride)
+	for k := range argoSettings.ResourceOverrides {
+		val := argoSettings.ResourceOverrides[k]
+		overrides[k] = *val
+	}
+
+	// TODO remove hardcoded IgnoreAggregatedRoles and retrieve the
+	// compareOptions in the protobuf
+	ignoreAggregatedRoles := false
+	diffConfig, err := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(app.Spec.IgnoreDifferences, overrides, ignoreAggregatedRoles, ignoreNormalizerOpts).
+		WithTracking(argoSettings.AppLabelKey, argoSettings.TrackingMethod).
+		WithNoCache().
+		WithLogger(logutils.NewLogrusLogger(logutils.NewWithCurrentConfig())).
+		Build()
+	errors.CheckError(err)
+	diffRes, err := argodiff.StateDiff(item.live, item.target, diffConfig)
+	errors.CheckError(err)
+	return diffRes
+}
+
 // findandPrintDiff ... Prints difference between application current state and state stored in git or locally, returns boolean as true if difference is found else returns false
 func findandPrintDiff(ctx context.Context, app *argoappv1.Application, proj *argoappv1.AppProject, resources *application.ManagedResourcesResponse, argoSettings *settings.Settings, diffOptions *DifferenceOption, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts) bool {
 	var foundDiffs bool
@@ -1471,33 +1543,16 @@ func findandPrintDiff(ctx context.Context, app *argoappv1.Application, proj *arg
 			err = json.Unmarshal([]byte(res.TargetState), &target)
 			errors.CheckError(err)
 
-			items = append(items, objKeyLiveTarget{kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name), live, target})
+			items = append(items, objKeyLiveTarget{kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name), live, target, res})
 		}
 	}
 
+	requests := cost.Requests{}
 	for _, item := range items {
 		if item.target != nil && hook.IsHook(item.target) || item.live != nil && hook.IsHook(item.live) {
 			continue
 		}
-		overrides := make(map[string]argoappv1.ResourceOverride)
-		for k := range argoSettings.ResourceOverrides {
-			val := argoSettings.ResourceOverrides[k]
-			overrides[k] = *val
-		}
-
-		// TODO remove hardcoded IgnoreAggregatedRoles and retrieve the
-		// compareOptions in the protobuf
-		ignoreAggregatedRoles := false
-		diffConfig, err := argodiff.NewDiffConfigBuilder().
-			WithDiffSettings(app.Spec.IgnoreDifferences, overrides, ignoreAggregatedRoles, ignoreNormalizerOpts).
-			WithTracking(argoSettings.AppLabelKey, argoSettings.TrackingMethod).
-			WithNoCache().
-			WithLogger(logutils.NewLogrusLogger(logutils.NewWithCurrentConfig())).
-			Build()
-		errors.CheckError(err)
-		diffRes, err := argodiff.StateDiff(item.live, item.target, diffConfig)
-		errors.CheckError(err)
-
+		diffRes := diffLiveTarget(app, item, argoSettings, ignoreNormalizerOpts)
 		if diffRes.Modified || item.target == nil || item.live == nil {
 			fmt.Printf("\n===== %s/%s %s/%s ======\n", item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name)
 			var live *unstructured.Unstructured
@@ -1515,11 +1570,24 @@ func findandPrintDiff(ctx context.Context, app *argoappv1.Application, proj *arg
 				foundDiffs = true
 			}
 			_ = cli.PrintDiff(item.key.Name, live, target)
+			if resources.CostEstimate != nil {
+				errors.CheckError(requests.AddDelta(target, live))
+			}
 		}
 	}
+	if resources.CostEstimate != nil {
+		printCostEstimate(resources.CostEstimate, requests)
+	}
 	return foundDiffs
 }
 
+// printCostEstimate prints the estimated monthly cost delta of the given requests delta, priced as in the given estimate
+func printCostEstimate(estimate *application.CostEstimate, requests cost.Requests) {
+	pricing := &cost.Pricing{Currency: estimate.GetCurrency(), CPUCoreHour: estimate.GetCpuCoreHour(), MemoryGiBHour: estimate.GetMemoryGiBHour()}
+	fmt.Printf("\nEstimated cost delta: %+.2f %s/month (cpu %s, memory %s)\n",
+		pricing.MonthlyCost(requests), pricing.Currency, requests.Format(corev1.ResourceCPU), requests.Format(corev1.ResourceMemory))
+}
+
 func groupObjsForDiff(resources *application.ManagedResourcesResponse, objs map[kube.ResourceKey]*unstructured.Unstructured, items []objKeyLiveTarget, argoSettings *settings.Settings, appName, namespace string) []objKeyLiveTarget {
 	resourceTracking := argo.NewResourceTracking()
 	for _, res := range resources.Items {
@@ -1539,7 +1607,7 @@ func groupObjsForDiff(resources *application.ManagedResourcesResponse, objs map[
 				errors.CheckError(err)
 			}
 
-			items = append(items, objKeyLiveTarget{key, live, local})
+			items = append(items, objKeyLiveTarget{key, live, local, nil})
 			delete(objs, key)
 		}
 	}
@@ -1549,7 +1617,7 @@ func groupObjsForDiff(resources *application.ManagedResourcesResponse, objs map[
 			delete(objs, key)
 			continue
 		}
-		items = append(items, objKeyLiveTarget{key, nil, local})
+		items = append(items, objKeyLiveTarget{key, nil, local, nil})
 	}
 	return items
 }
@@ -1699,6 +1767,9 @@ func printApplicationTable(apps []argoappv1.Application, output *string) {
 	_ = w.Flush()
 }
 
+// appListPageSize is the number of applications loaded per call by `argocd app list`
+const appListPageSize = 500
+
 // NewApplicationListCommand returns a new instance of an `argocd app list` command
 func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -1708,6 +1779,8 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		repo         string
 		appNamespace string
 		cluster      string
+		offset       int64
+		limit        int64
 	)
 	command := &cobra.Command{
 		Use:   "list",
@@ -1720,19 +1793,34 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app list -l app.kubernetes.io/instance!=my-app
   argocd app list -l app.kubernetes.io/instance
   argocd app list -l '!app.kubernetes.io/instance'
-  argocd app list -l 'app.kubernetes.io/instance notin (my-app,other-app)'`,
+  argocd app list -l 'app.kubernetes.io/instance notin (my-app,other-app)'
+
+  # List the third page of 20 apps
+  argocd app list --offset 40 --limit 20`,
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
 			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 			defer utilio.Close(conn)
-			apps, err := appIf.List(ctx, &application.ApplicationQuery{
+			query := &application.ApplicationQuery{
 				Selector:     ptr.To(selector),
 				AppNamespace: &appNamespace,
-			})
-
-			errors.CheckError(err)
-			appList := apps.Items
+			}
+			var appList []argoappv1.Application
+			if offset > 0 || limit > 0 {
+				query.Offset = ptr.To(offset)
+				query.Limit = ptr.To(limit)
+				apps, err := appIf.List(ctx, query)
+				errors.CheckError(err)
+				appList = apps.Items
+			} else {
+				// load all the applications in batches, to reduce the load on the API server
+				query.Limit = ptr.To(int64(appListPageSize))
+				for app, err := range argocdclient.ListApplications(ctx, appIf, query) {
+					errors.CheckError(err)
+					appList = append(appList, *app)
+				}
+			}
 
 			if len(projects) != 0 {
 				appList = argo.FilterByProjects(appList, projects)
@@ -1763,6 +1851,8 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringVarP(&repo, "repo", "r", "", "List apps by source repo URL")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only list applications in namespace")
 	command.Flags().StringVarP(&cluster, "cluster", "c", "", "List apps by cluster name or url")
+	command.Flags().Int64Var(&offset, "offset", 0, "Number of apps to skip before the listed apps, in the order of their names")
+	command.Flags().Int64Var(&limit, "limit", 0, "Maximum number of apps to list. All the apps are listed if not set")
 	return command
 }
 
@@ -2009,6 +2099,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		infos                   []string
 		diffChanges             bool
 		diffChangesConfirm      bool
+		previewOnly             bool
+		diffHash                string
+		estimateCost            bool
 		projects                []string
 		output                  string
 		appNamespace            string
@@ -2042,7 +2135,12 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app sync my-app --resource apps:Deployment:my-service --resource :Service:my-service
   argocd app sync my-app --resource '!*:Service:*'
   # Specify namespace if the application has resources with the same name in different namespaces
-  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout`,
+  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout
+
+  # Preview the changes of a sync and their hash without syncing, e.g. in a CI pipeline pending an approval
+  argocd app sync my-app --preview-only
+  # Sync only if the changes to apply are still the previewed ones
+  argocd app sync my-app --diff-hash 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) == 0 && selector == "" && len(projects) == 0 {
@@ -2061,6 +2159,18 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				log.Fatal("Cannot use --revisions and --source-names options when 0 or more than 1 application names are passed as argument(s)")
 			}
 
+			if len(args) != 1 && diffHash != "" {
+				log.Fatal("Cannot use --diff-hash option when 0 or more than 1 application names are passed as argument(s)")
+			}
+
+			if diffHash != "" && (local != "" || revision != "" || len(revisions) > 0) {
+				log.Fatal("Cannot use --diff-hash option with --local, --revision or --revisions options")
+			}
+
+			if previewOnly {
+				diffChanges = true
+			}
+
 			if len(sourceNames) > 0 && len(sourcePositions) > 0 {
 				log.Fatal("Only one of source-positions and source-names can be specified.")
 			}
@@ -2261,6 +2371,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					Revisions:       revisions,
 					SourcePositions: sourcePositions,
 				}
+				if diffHash != "" {
+					syncReq.DiffHash = &diffHash
+				}
 
 				switch strategy {
 				case "apply":
@@ -2286,6 +2399,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					resources, err := appIf.ManagedResources(ctx, &application.ResourcesQuery{
 						ApplicationName: &appName,
 						AppNamespace:    &appNs,
+						EstimateCost:    &estimateCost,
 					})
 					errors.CheckError(err)
 					conn, settingsIf := acdClient.NewSettingsClientOrDie()
@@ -2302,6 +2416,12 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 						// if no differences found, then no need to sync
 						return
 					}
+					if local == "" {
+						fmt.Printf("\n====== Diff hash: %s ======\n", resources.GetDiffHash())
+					}
+					if previewOnly {
+						continue
+					}
 					if !diffChangesConfirm {
 						yesno := cli.AskToProceed(fmt.Sprintf("Please review changes to application %s shown above. Do you want to continue the sync process? (y/n): ", appQualifiedName))
 						if !yesno {
@@ -2353,6 +2473,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringArrayVar(&infos, "info", []string{}, "A list of key-value pairs during sync process. These infos will be persisted in app.")
 	command.Flags().BoolVar(&diffChangesConfirm, "assumeYes", false, "Assume yes as answer for all user queries or prompts")
 	command.Flags().BoolVar(&diffChanges, "preview-changes", false, "Preview difference against the target and live state before syncing app and wait for user confirmation")
+	command.Flags().BoolVar(&previewOnly, "preview-only", false, "Preview difference against the target and live state and print the hash of the changes, without syncing app")
+	command.Flags().StringVar(&diffHash, "diff-hash", "", "Sync only if the changes to apply match the previewed changes of this hash, as printed by --preview-changes or --preview-only")
+	command.Flags().BoolVar(&estimateCost, "estimate-cost", false, "Used with --preview-changes, estimate the monthly cost delta of the requested resources, if the cost estimation is configured")
 	command.Flags().StringArrayVar(&projects, "project", []string{}, "Sync apps that belong to the specified projects. This option may be specified repeatedly.")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|tree|tree=detailed")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only sync an application in namespace")
@@ -3187,6 +3310,131 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	return command
 }
 
+// NewApplicationManifestBundleCommand returns a new instance of an `argocd app manifest-bundle` command
+func NewApplicationManifestBundleCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output          string
+		revision        string
+		revisions       []string
+		sourcePositions []int64
+	)
+	command := &cobra.Command{
+		Use:   "manifest-bundle APPNAME",
+		Short: "Print the manifests of an application along with the inputs of their generation",
+		Long: `Print the manifests of an application along with the inputs of their generation: the sources, the revisions, the
+versions of the tools, the parameters and the environment used to generate them, and the digests of the manifests.
+The manifests are generated at the revisions of the last deployment of the application unless revisions are given.`,
+		Example: templates.Examples(`
+  # Get the manifest bundle of the last deployment of an application
+  argocd app manifest-bundle my-app
+
+  # Get the manifest bundle of an application at a specific revision
+  argocd app manifest-bundle my-app --revision 0.0.1 -o yaml
+
+  # Get the manifest bundle of a multi-source application at specific revisions for specific sources
+  argocd app manifest-bundle my-app --revisions 0.0.1 --source-positions 1 --revisions 0.0.2 --source-positions 2
+  		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			if len(revisions) != len(sourcePositions) {
+				errors.Fatal(errors.ErrorGeneric, "While using --revisions and --source-positions, length of values for both flags should be same.")
+			}
+
+			for _, pos := range sourcePositions {
+				if pos <= 0 {
+					log.Fatal("source-position cannot be less than or equal to 0, Counting starts at 1")
+				}
+			}
+
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			bundle, err := appIf.GetManifestBundle(ctx, &application.ApplicationManifestQuery{
+				Name:            &appName,
+				AppNamespace:    &appNs,
+				Revision:        ptr.To(revision),
+				Revisions:       revisions,
+				SourcePositions: sourcePositions,
+			})
+			errors.CheckError(err)
+			errors.CheckError(PrintResource(bundle, output))
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "json", "Output format. One of: json|yaml")
+	command.Flags().StringVar(&revision, "revision", "", "Generate the manifests at a specific revision")
+	command.Flags().StringArrayVar(&revisions, "revisions", []string{}, "Generate the manifests at specific revisions for the source at position in source-positions")
+	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
+	return command
+}
+
+// NewApplicationLinksCommand returns a new instance of an `argocd app links` command
+func NewApplicationLinksCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output  string
+		project string
+	)
+	command := &cobra.Command{
+		Use:   "links APPNAME",
+		Short: "List the links of an application",
+		Long: `List the links of an application: the deep links of the applications configured in the argocd-cm ConfigMap, and the
+links of the link.argocd.argoproj.io annotations of the application, with their URL templates resolved by the API server.`,
+		Example: templates.Examples(`
+  # List the links of an application
+  argocd app links my-app
+
+  # List the links of an application in JSON format
+  argocd app links my-app -o json
+  		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			links, err := appIf.ListLinks(ctx, &application.ListAppLinksRequest{
+				Name:      &appName,
+				Namespace: &appNs,
+				Project:   &project,
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "json", "yaml":
+				errors.CheckError(PrintResourceList(links.Items, output, false))
+			case "wide", "":
+				printApplicationLinksTable(links.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVar(&project, "project", "", "Project of the application")
+	return command
+}
+
+// printApplicationLinksTable prints the table of the given application links
+func printApplicationLinksTable(links []*application.LinkInfo) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "TITLE\tURL\n")
+	for _, link := range links {
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", link.GetTitle(), link.GetUrl())
+	}
+	_ = w.Flush()
+}
+
 // NewApplicationTerminateOpCommand returns a new instance of an `argocd app terminate-op` command
 func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
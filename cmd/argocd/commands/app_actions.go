@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/argoproj/argo-cd/v3/util/templates"
@@ -23,16 +24,18 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 )
 
 type DisplayedAction struct {
-	Group    string
-	Kind     string
-	Name     string
-	Action   string
-	Disabled bool
+	Group                string
+	Kind                 string
+	Name                 string
+	Action               string
+	Disabled             bool
+	RequiresConfirmation bool
 }
 
 var appActionExample = templates.Examples(`
@@ -104,11 +107,12 @@ func NewApplicationResourceActionsListCommand(clientOpts *argocdclient.ClientOpt
 			errors.CheckError(err)
 			for _, action := range availActionsForResource.Actions {
 				displayAction := DisplayedAction{
-					Group:    gvk.Group,
-					Kind:     gvk.Kind,
-					Name:     obj.GetName(),
-					Action:   action.Name,
-					Disabled: action.Disabled,
+					Group:                gvk.Group,
+					Kind:                 gvk.Kind,
+					Name:                 obj.GetName(),
+					Action:               action.Name,
+					Disabled:             action.Disabled,
+					RequiresConfirmation: action.RequiresConfirmation,
 				}
 				availableActions = append(availableActions, displayAction)
 			}
@@ -148,6 +152,8 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	var kind string
 	var group string
 	var all bool
+	var params []string
+	var confirm bool
 	command := &cobra.Command{
 		Use:   "run APPNAME ACTION",
 		Short: "Runs an available action on resource(s) matching the specified filters.",
@@ -155,6 +161,12 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 		Example: templates.Examples(`
 	# Run an available action for an application
 	argocd app actions run APPNAME ACTION --kind KIND [--resource-name RESOURCE] [--namespace NAMESPACE] [--group GROUP]
+
+	# Run an action with parameters
+	argocd app actions run APPNAME scale --kind Deployment --resource-name RESOURCE --param replicas=3
+
+	# Run an action that requires confirmation without prompting
+	argocd app actions run APPNAME ACTION --kind KIND --resource-name RESOURCE --confirm
 	`),
 	}
 
@@ -164,6 +176,8 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	command.Flags().StringVar(&group, "group", "", "Group of the resource on which the action should be run")
 	errors.CheckError(command.MarkFlagRequired("kind"))
 	command.Flags().BoolVar(&all, "all", false, "Indicates whether to run the action on multiple matching resources")
+	command.Flags().StringArrayVar(&params, "param", []string{}, "Action parameter in the form NAME=VALUE. Can be repeated")
+	command.Flags().BoolVar(&confirm, "confirm", false, "Run an action that requires confirmation without prompting")
 
 	command.Run = func(c *cobra.Command, args []string) {
 		ctx := c.Context()
@@ -175,6 +189,18 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 		appName, appNs := argo.ParseFromQualifiedName(args[0], "")
 		actionName := args[1]
 
+		actionParams := make([]*applicationpkg.ResourceActionParameters, 0, len(params))
+		for _, param := range params {
+			name, value, ok := strings.Cut(param, "=")
+			if !ok {
+				log.Fatalf("Invalid parameter format: %s", param)
+			}
+			actionParams = append(actionParams, &applicationpkg.ResourceActionParameters{
+				Name:  ptr.To(name),
+				Value: ptr.To(value),
+			})
+		}
+
 		conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 		defer utilio.Close(conn)
 		resources, err := getActionableResourcesForApplication(ctx, appIf, &appNs, &appName)
@@ -192,17 +218,29 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 			obj := filteredObjects[i]
 			gvk := obj.GroupVersionKind()
 			objResourceName := obj.GetName()
-			_, err := appIf.RunResourceAction(ctx, &applicationpkg.ResourceActionRunRequest{
-				Name:         &appName,
-				AppNamespace: &appNs,
-				Namespace:    ptr.To(obj.GetNamespace()),
-				ResourceName: ptr.To(objResourceName),
-				Group:        ptr.To(gvk.Group),
-				Kind:         ptr.To(gvk.Kind),
-				Version:      ptr.To(gvk.GroupVersion().Version),
-				Action:       ptr.To(actionName),
-			})
+			request := &applicationpkg.ResourceActionRunRequest{
+				Name:                     &appName,
+				AppNamespace:             &appNs,
+				Namespace:                ptr.To(obj.GetNamespace()),
+				ResourceName:             ptr.To(objResourceName),
+				Group:                    ptr.To(gvk.Group),
+				Kind:                     ptr.To(gvk.Kind),
+				Version:                  ptr.To(gvk.GroupVersion().Version),
+				Action:                   ptr.To(actionName),
+				ResourceActionParameters: actionParams,
+			}
+			resp, err := appIf.RunResourceAction(ctx, request)
 			errors.CheckError(err)
+			// the action requires confirmation, it is run by a second call with the returned token
+			if token := resp.GetConfirmationToken(); token != "" {
+				if !confirm && !cli.AskToProceed(fmt.Sprintf("Action '%s' on %s '%s' requires confirmation. Are you sure you want to run it? [y/n] ", actionName, gvk.Kind, objResourceName)) {
+					fmt.Printf("Action '%s' on %s '%s' was not run\n", actionName, gvk.Kind, objResourceName)
+					continue
+				}
+				request.ConfirmationToken = &token
+				_, err = appIf.RunResourceAction(ctx, request)
+				errors.CheckError(err)
+			}
 		}
 	}
 	return command
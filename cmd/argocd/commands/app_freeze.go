@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// NewApplicationFreezeCommand returns a new instance of an `argocd app freeze` command
+func NewApplicationFreezeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		reason       string
+		until        string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "freeze APPNAME",
+		Short: "Freeze an application, blocking its automated and manual syncs",
+		Example: `  # Freeze an application until it is unfrozen
+  argocd app freeze guestbook --reason "incident 42"
+
+  # Freeze an application for the next two hours
+  argocd app freeze guestbook --reason "release freeze" --until 2h
+
+  # Freeze an application until a point in time
+  argocd app freeze guestbook --reason "release freeze" --until 2024-01-01T00:00:00Z`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			freeze := argoappv1.ApplicationFreeze{Reason: reason}
+			if until != "" {
+				untilTime, err := parseFreezeUntil(until, time.Now())
+				errors.CheckError(err)
+				freeze.Until = &metav1.Time{Time: untilTime}
+			}
+			patchApplicationFreeze(c, clientOpts, args[0], appNamespace, &freeze)
+			fmt.Printf("Application '%s' frozen\n", args[0])
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	command.Flags().StringVar(&reason, "reason", "", "The reason the application is frozen for")
+	command.Flags().StringVar(&until, "until", "", "The duration (e.g. 2h) or the RFC3339 time the application is frozen until. The application is frozen until it is unfrozen if it is not set")
+	return command
+}
+
+// NewApplicationUnfreezeCommand returns a new instance of an `argocd app unfreeze` command
+func NewApplicationUnfreezeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var appNamespace string
+	command := &cobra.Command{
+		Use:   "unfreeze APPNAME",
+		Short: "Unfreeze an application",
+		Example: `  # Unfreeze an application
+  argocd app unfreeze guestbook`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			patchApplicationFreeze(c, clientOpts, args[0], appNamespace, nil)
+			fmt.Printf("Application '%s' unfrozen\n", args[0])
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	return command
+}
+
+// parseFreezeUntil parses the --until flag, which is either a duration from now or an RFC3339 time
+func parseFreezeUntil(until string, now time.Time) (time.Time, error) {
+	if duration, err := time.ParseDuration(until); err == nil {
+		return now.Add(duration), nil
+	}
+	untilTime, err := time.Parse(time.RFC3339, until)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --until %q: must be a duration or an RFC3339 time", until)
+	}
+	return untilTime, nil
+}
+
+// patchApplicationFreeze sets the freeze of the application, or removes it if the freeze is nil
+func patchApplicationFreeze(c *cobra.Command, clientOpts *argocdclient.ClientOptions, name string, appNamespace string, freeze *argoappv1.ApplicationFreeze) {
+	var value any
+	if freeze != nil {
+		// the reason and the expiry are always set, so that the merge patch replaces them rather than merging into them
+		value = map[string]any{"reason": freeze.Reason, "until": freeze.Until}
+	}
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{"freeze": value},
+	})
+	errors.CheckError(err)
+
+	appName, appNs := argo.ParseFromQualifiedName(name, appNamespace)
+	conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+	defer utilio.Close(conn)
+	patchType := "merge"
+	patchStr := string(patch)
+	_, err = appIf.Patch(c.Context(), &application.ApplicationPatchRequest{
+		Name:         &appName,
+		AppNamespace: &appNs,
+		Patch:        &patchStr,
+		PatchType:    &patchType,
+	})
+	errors.CheckError(err)
+}
@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFreezeUntil(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	until, err := parseFreezeUntil("2h", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(2*time.Hour), until)
+
+	until, err = parseFreezeUntil("2024-01-02T00:00:00Z", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(24*time.Hour), until)
+
+	_, err = parseFreezeUntil("tomorrow", now)
+	assert.ErrorContains(t, err, `invalid --until "tomorrow"`)
+}
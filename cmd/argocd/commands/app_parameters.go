@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	"github.com/argoproj/argo-cd/v3/common"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	cryptoutil "github.com/argoproj/argo-cd/v3/util/crypto"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// NewApplicationSealParameterCommand returns a new instance of an `argocd app seal-parameter` command
+func NewApplicationSealParameterCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		publicKeyFile string
+		appNamespace  string
+		project       string
+	)
+	command := &cobra.Command{
+		Use:   "seal-parameter APPNAME PARAMETER [VALUE]",
+		Short: "Seal the value of a Helm parameter so that it can only be decrypted by the repo server",
+		Long:  "Seal the value of a Helm parameter with the public key of the parameter encryption secret. The sealed value can only be used for the given parameter of the given application and is decrypted by the repo server when the manifests are generated.",
+		Example: `  # Seal a value read from stdin and set it as a Helm parameter of an application
+  argocd app set my-app --helm-set-string "db.password=$(argocd app seal-parameter my-app db.password < password.txt)"
+
+  # Seal a value without connecting to the API server, with a public key file and the project of the application
+  argocd app seal-parameter argocd/my-app db.password s3cr3t --public-key-file public.pem --project default`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 && len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			var value string
+			if len(args) == 3 {
+				value = args[2]
+			} else {
+				data, err := io.ReadAll(os.Stdin)
+				errors.CheckError(err)
+				value = strings.TrimSuffix(string(data), "\n")
+			}
+
+			var publicKeys []byte
+			var err error
+			if publicKeyFile != "" {
+				publicKeys, err = os.ReadFile(publicKeyFile)
+				errors.CheckError(err)
+			}
+			if publicKeyFile == "" || project == "" || appNs == "" {
+				acdClient := headless.NewClientOrDie(clientOpts, c)
+				if publicKeyFile == "" {
+					publicKeys, err = getParameterEncryptionKey(ctx, acdClient)
+					errors.CheckError(err)
+				}
+				if project == "" || appNs == "" {
+					// the project and namespace of the application are part of the label the value is sealed with
+					conn, appIf := acdClient.NewApplicationClientOrDie()
+					defer utilio.Close(conn)
+					app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
+					errors.CheckError(err)
+					project, appNs = app.Spec.GetProject(), app.Namespace
+				}
+			}
+
+			sealed, err := sealParameter(publicKeys, project, appNs, appName, args[1], value)
+			errors.CheckError(err)
+			fmt.Println(sealed)
+		},
+	}
+	command.Flags().StringVar(&publicKeyFile, "public-key-file", "", "PEM encoded public key sealing the value, defaults to the key published by the API server")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	command.Flags().StringVar(&project, "project", "", "Project of the application, defaults to the project of the application retrieved from the API server")
+	return command
+}
+
+// getParameterEncryptionKey returns the PEM encoded public keys published by the API server
+func getParameterEncryptionKey(ctx context.Context, acdClient argocdclient.Client) ([]byte, error) {
+	httpClient, err := acdClient.HTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	opts := acdClient.ClientOptions()
+	scheme := "https"
+	if opts.PlainText {
+		scheme = "http"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s://%s%s", scheme, opts.ServerAddr, common.ParameterEncryptionKeyEndpoint), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving parameter encryption key: %w", err)
+	}
+	defer utilio.Close(resp.Body)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading parameter encryption key: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error retrieving parameter encryption key: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// sealParameter seals the value of the parameter of the application with the first of the given public keys
+func sealParameter(publicKeys []byte, project, appNamespace, appName, name string, value string) (string, error) {
+	if name == "" {
+		return "", stderrors.New("parameter name must not be empty")
+	}
+	if project == "" || appNamespace == "" || appName == "" {
+		return "", stderrors.New("project, namespace and name of the application must not be empty")
+	}
+	keys, err := cryptoutil.ParseSealingPublicKeys(publicKeys)
+	if err != nil {
+		return "", err
+	}
+	return cryptoutil.SealValue(keys[0], cryptoutil.SealLabel(project, appNamespace, appName, name), value)
+}
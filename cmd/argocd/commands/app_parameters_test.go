@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cryptoutil "github.com/argoproj/argo-cd/v3/util/crypto"
+)
+
+func TestSealParameter(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	publicKeys := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	sealed, err := sealParameter(publicKeys, "default", "argocd", "my-app", "db.password", "s3cr3t")
+	require.NoError(t, err)
+	assert.True(t, cryptoutil.IsSealedValue(sealed))
+	value, err := cryptoutil.UnsealValue([]*rsa.PrivateKey{key}, "default/argocd/my-app/db.password", sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", value)
+	_, err = cryptoutil.UnsealValue([]*rsa.PrivateKey{key}, "default/argocd/other-app/db.password", sealed)
+	require.Error(t, err)
+
+	_, err = sealParameter(publicKeys, "default", "argocd", "my-app", "", "s3cr3t")
+	require.EqualError(t, err, "parameter name must not be empty")
+	_, err = sealParameter(publicKeys, "", "argocd", "my-app", "db.password", "s3cr3t")
+	require.EqualError(t, err, "project, namespace and name of the application must not be empty")
+	_, err = sealParameter([]byte("invalid"), "default", "argocd", "my-app", "db.password", "s3cr3t")
+	assert.Error(t, err)
+}
@@ -46,6 +46,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 )
 
 func Test_getInfos(t *testing.T) {
@@ -1051,6 +1052,8 @@ func Test_unset(t *testing.T) {
 	kustomizeSource := &v1alpha1.ApplicationSource{
 		Kustomize: &v1alpha1.ApplicationSourceKustomize{
 			IgnoreMissingComponents: true,
+			Components:              []string{"../components/a", "../components/b"},
+			Patches:                 v1alpha1.KustomizePatches{{Path: "patch.yaml"}},
 			NamePrefix:              "some-prefix",
 			NameSuffix:              "some-suffix",
 			Version:                 "123",
@@ -1162,6 +1165,24 @@ func Test_unset(t *testing.T) {
 	assert.False(t, updated)
 	assert.False(t, nothingToUnset)
 
+	assert.Len(t, kustomizeSource.Kustomize.Components, 2)
+	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeComponents: []string{"../components/a"}})
+	assert.Equal(t, []string{"../components/b"}, kustomizeSource.Kustomize.Components)
+	assert.True(t, updated)
+	assert.False(t, nothingToUnset)
+	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeComponents: []string{"../components/a"}})
+	assert.False(t, updated)
+	assert.False(t, nothingToUnset)
+
+	assert.Len(t, kustomizeSource.Kustomize.Patches, 1)
+	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizePatches: true})
+	assert.Empty(t, kustomizeSource.Kustomize.Patches)
+	assert.True(t, updated)
+	assert.False(t, nothingToUnset)
+	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizePatches: true})
+	assert.False(t, updated)
+	assert.False(t, nothingToUnset)
+
 	assert.Len(t, helmSource.Helm.Parameters, 2)
 	updated, nothingToUnset = unset(helmSource, unsetOpts{parameters: []string{"name-1"}})
 	assert.Len(t, helmSource.Helm.Parameters, 1)
@@ -2172,6 +2193,10 @@ func (c *fakeAppServiceClient) GetManifests(_ context.Context, _ *applicationpkg
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) GetManifestBundle(_ context.Context, _ *applicationpkg.ApplicationManifestQuery, _ ...grpc.CallOption) (*applicationpkg.ManifestBundle, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) GetManifestsWithFiles(_ context.Context, _ ...grpc.CallOption) (applicationpkg.ApplicationService_GetManifestsWithFilesClient, error) {
 	return nil, nil
 }
@@ -2208,6 +2233,10 @@ func (c *fakeAppServiceClient) WatchResourceTree(_ context.Context, _ *applicati
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) WatchResourceTreeDeltas(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (applicationpkg.ApplicationService_WatchResourceTreeDeltasClient, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) Rollback(_ context.Context, _ *applicationpkg.ApplicationRollbackRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
 	return nil, nil
 }
@@ -2377,3 +2406,32 @@ func (c *fakeAcdClient) WatchApplicationWithRetry(_ context.Context, _ string, _
 	}()
 	return appEventsCh
 }
+
+func Test_diffLiveTarget(t *testing.T) {
+	live := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "cm", "namespace": "default"},
+		"data":       map[string]any{"key": "live"},
+	}}
+	target := live.DeepCopy()
+	target.Object["data"] = map[string]any{"key": "target"}
+	app := &v1alpha1.Application{}
+	argoSettings := &settingspkg.Settings{AppLabelKey: "app.kubernetes.io/instance"}
+
+	t.Run("Reuse controller diff", func(t *testing.T) {
+		item := objKeyLiveTarget{key: kube.GetResourceKey(live), live: live, target: target, diff: &v1alpha1.ResourceDiff{
+			Modified:           false,
+			PredictedLiveState: `{"data":{"key":"live"}}`,
+		}}
+		res := diffLiveTarget(app, item, argoSettings, normalizers.IgnoreNormalizerOpts{})
+		assert.False(t, res.Modified)
+		assert.JSONEq(t, `{"data":{"key":"live"}}`, string(res.PredictedLive))
+	})
+
+	t.Run("Compute diff", func(t *testing.T) {
+		item := objKeyLiveTarget{key: kube.GetResourceKey(live), live: live, target: target}
+		res := diffLiveTarget(app, item, argoSettings, normalizers.IgnoreNormalizerOpts{})
+		assert.True(t, res.Modified)
+	})
+}
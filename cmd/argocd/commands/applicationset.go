@@ -412,7 +412,7 @@ func printApplicationSetTable(apps []arogappsetv1.ApplicationSet, output *string
 		}
 		vals := []any{
 			app.QualifiedName(),
-			app.Spec.Template.Spec.Project,
+			app.GetProject(),
 			app.Spec.SyncPolicy,
 			conditions,
 		}
@@ -434,7 +434,7 @@ func getServerForAppSet(appSet *arogappsetv1.ApplicationSet) string {
 
 func printAppSetSummaryTable(appSet *arogappsetv1.ApplicationSet) {
 	fmt.Printf(printOpFmtStr, "Name:", appSet.QualifiedName())
-	fmt.Printf(printOpFmtStr, "Project:", appSet.Spec.Template.Spec.GetProject())
+	fmt.Printf(printOpFmtStr, "Project:", appSet.GetProject())
 	fmt.Printf(printOpFmtStr, "Server:", getServerForAppSet(appSet))
 	fmt.Printf(printOpFmtStr, "Namespace:", appSet.Spec.Template.Spec.Destination.Namespace)
 	if !appSet.Spec.Template.Spec.HasMultipleSources() {
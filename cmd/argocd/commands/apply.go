@@ -0,0 +1,264 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	"github.com/argoproj/argo-cd/v3/common"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	applicationsetpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	repocredspkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repocreds"
+	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/config"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// applyResource is an Argo CD resource applied through the API
+type applyResource struct {
+	// kind and name identify the resource in the output
+	kind string
+	name string
+	// order is the order in which the resource is applied, so that e.g. the projects exist when their applications
+	// are validated
+	order int
+	apply func(ctx context.Context, argocdClient argocdclient.Client) error
+}
+
+// NewApplyCommand returns a new instance of an `argocd apply` command
+func NewApplyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		filenames []string
+		recursive bool
+		validate  bool
+	)
+	command := &cobra.Command{
+		Use:   "apply -f FILENAME",
+		Short: "Apply the Argo CD resources declared in files or directories through the API",
+		Long: `Apply the Applications, AppProjects, ApplicationSets, and the repository, repository credentials and cluster
+Secrets declared in files or directories through the API, so that they are validated and authorized by the API server.
+The resources are created, or updated if they exist.`,
+		Example: templates.Examples(`
+	# Apply the resources declared in a file
+	argocd apply -f guestbook.yaml
+
+	# Apply the resources declared in the files of a directory and of its subdirectories
+	argocd apply -f argocd/ -R
+
+	# Apply the resources read from stdin
+	kustomize build argocd/ | argocd apply -f -
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(filenames) == 0 || len(args) > 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			objs, err := readManifests(filenames, recursive)
+			errors.CheckError(err)
+			resources := make([]*applyResource, 0, len(objs))
+			for _, obj := range objs {
+				resource, err := newApplyResource(obj, validate)
+				errors.CheckError(err)
+				resources = append(resources, resource)
+			}
+			if len(resources) == 0 {
+				errors.Fatal(errors.ErrorGeneric, "No resources found while parsing the input files")
+			}
+			slices.SortStableFunc(resources, func(a, b *applyResource) int {
+				return a.order - b.order
+			})
+
+			argocdClient := headless.NewClientOrDie(clientOpts, c)
+			for _, resource := range resources {
+				err := resource.apply(ctx, argocdClient)
+				errors.CheckError(err)
+				fmt.Printf("%s '%s' applied\n", resource.kind, resource.name)
+			}
+		},
+	}
+	command.Flags().StringArrayVarP(&filenames, "filename", "f", nil, "Files, directories or URLs of the resources to apply, - to read from stdin")
+	command.Flags().BoolVarP(&recursive, "recursive", "R", false, "Read the files of the subdirectories of the given directories")
+	command.Flags().BoolVar(&validate, "validate", true, "Validate the source and the destination of the applications")
+	return command
+}
+
+// readManifests reads the objects declared in the given files, directories or URLs
+func readManifests(filenames []string, recursive bool) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	for _, filename := range filenames {
+		paths := []string{filename}
+		if info, err := os.Stat(filename); err == nil && info.IsDir() {
+			paths, err = manifestPaths(filename, recursive)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, path := range paths {
+			data, err := readManifest(path)
+			if err != nil {
+				return nil, fmt.Errorf("error reading %s: %w", path, err)
+			}
+			fileObjs, err := kube.SplitYAML(data)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing %s: %w", path, err)
+			}
+			objs = append(objs, fileObjs...)
+		}
+	}
+	return objs, nil
+}
+
+// manifestPaths returns the paths of the YAML and JSON files of the given directory
+func manifestPaths(dir string, recursive bool) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != dir && !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch filepath.Ext(path) {
+		case ".yaml", ".yml", ".json":
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory %s: %w", dir, err)
+	}
+	return paths, nil
+}
+
+func readManifest(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	if parsedURL, err := url.ParseRequestURI(path); err == nil && (parsedURL.Scheme == "http" || parsedURL.Scheme == "https") {
+		return config.ReadRemoteFile(path)
+	}
+	return os.ReadFile(path)
+}
+
+// newApplyResource returns the resource applying the given object through the API of the matching kind
+func newApplyResource(obj *unstructured.Unstructured, validate bool) (*applyResource, error) {
+	gvk := obj.GroupVersionKind()
+	switch {
+	case gvk.Group == application.Group && gvk.Kind == application.AppProjectKind:
+		var proj v1alpha1.AppProject
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &proj); err != nil {
+			return nil, fmt.Errorf("error converting project %s: %w", obj.GetName(), err)
+		}
+		return &applyResource{kind: "project", name: proj.Name, order: 0, apply: func(ctx context.Context, argocdClient argocdclient.Client) error {
+			conn, projIf := argocdClient.NewProjectClientOrDie()
+			defer utilio.Close(conn)
+			_, err := projIf.Create(ctx, &projectpkg.ProjectCreateRequest{Project: &proj, Upsert: true})
+			return err
+		}}, nil
+	case gvk.Group == application.Group && gvk.Kind == application.ApplicationKind:
+		var app v1alpha1.Application
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &app); err != nil {
+			return nil, fmt.Errorf("error converting application %s: %w", obj.GetName(), err)
+		}
+		return &applyResource{kind: "application", name: app.QualifiedName(), order: 2, apply: func(ctx context.Context, argocdClient argocdclient.Client) error {
+			conn, appIf := argocdClient.NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			_, err := appIf.Create(ctx, &applicationpkg.ApplicationCreateRequest{Application: &app, Upsert: ptr.To(true), Validate: ptr.To(validate)})
+			return err
+		}}, nil
+	case gvk.Group == application.Group && gvk.Kind == application.ApplicationSetKind:
+		var appset v1alpha1.ApplicationSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &appset); err != nil {
+			return nil, fmt.Errorf("error converting applicationset %s: %w", obj.GetName(), err)
+		}
+		return &applyResource{kind: "applicationset", name: appset.QualifiedName(), order: 2, apply: func(ctx context.Context, argocdClient argocdclient.Client) error {
+			conn, appsetIf := argocdClient.NewApplicationSetClientOrDie()
+			defer utilio.Close(conn)
+			_, err := appsetIf.Create(ctx, &applicationsetpkg.ApplicationSetCreateRequest{Applicationset: &appset, Upsert: true})
+			return err
+		}}, nil
+	case gvk.Group == "" && gvk.Kind == kube.SecretKind:
+		return newSecretApplyResource(obj)
+	}
+	return nil, fmt.Errorf("unsupported resource %s %s: only Applications, AppProjects, ApplicationSets and repository, repository credentials and cluster Secrets can be applied", gvk.Kind, obj.GetName())
+}
+
+// newSecretApplyResource returns the resource applying the given declarative repository, repository credentials or
+// cluster Secret through the API
+func newSecretApplyResource(obj *unstructured.Unstructured) (*applyResource, error) {
+	var secret corev1.Secret
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &secret); err != nil {
+		return nil, fmt.Errorf("error converting secret %s: %w", obj.GetName(), err)
+	}
+	// the API server merges the string data into the data of the secrets, which the conversions read
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte, len(secret.StringData))
+	}
+	for key, value := range secret.StringData {
+		secret.Data[key] = []byte(value)
+	}
+	switch secretType := secret.Labels[common.LabelKeySecretType]; secretType {
+	case common.LabelValueSecretTypeRepository:
+		repo, err := db.SecretToRepository(&secret)
+		if err != nil {
+			return nil, fmt.Errorf("error converting repository secret %s: %w", secret.Name, err)
+		}
+		return &applyResource{kind: "repository", name: repo.Repo, order: 1, apply: func(ctx context.Context, argocdClient argocdclient.Client) error {
+			conn, repoIf := argocdClient.NewRepoClientOrDie()
+			defer utilio.Close(conn)
+			_, err := repoIf.CreateRepository(ctx, &repositorypkg.RepoCreateRequest{Repo: repo, Upsert: true})
+			return err
+		}}, nil
+	case common.LabelValueSecretTypeRepoCreds:
+		creds, err := db.SecretToRepoCreds(&secret)
+		if err != nil {
+			return nil, fmt.Errorf("error converting repository credentials secret %s: %w", secret.Name, err)
+		}
+		return &applyResource{kind: "repository credentials", name: creds.URL, order: 1, apply: func(ctx context.Context, argocdClient argocdclient.Client) error {
+			conn, repoCredsIf := argocdClient.NewRepoCredsClientOrDie()
+			defer utilio.Close(conn)
+			_, err := repoCredsIf.CreateRepositoryCredentials(ctx, &repocredspkg.RepoCredsCreateRequest{Creds: creds, Upsert: true})
+			return err
+		}}, nil
+	case common.LabelValueSecretTypeCluster:
+		cluster, err := db.SecretToCluster(&secret)
+		if err != nil {
+			return nil, fmt.Errorf("error converting cluster secret %s: %w", secret.Name, err)
+		}
+		return &applyResource{kind: "cluster", name: cluster.Server, order: 1, apply: func(ctx context.Context, argocdClient argocdclient.Client) error {
+			conn, clusterIf := argocdClient.NewClusterClientOrDie()
+			defer utilio.Close(conn)
+			_, err := clusterIf.Create(ctx, &clusterpkg.ClusterCreateRequest{Cluster: cluster, Upsert: true})
+			return err
+		}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported secret %s: the %s label must be one of %s, %s or %s", secret.Name, common.LabelKeySecretType,
+			common.LabelValueSecretTypeRepository, common.LabelValueSecretTypeRepoCreds, common.LabelValueSecretTypeCluster)
+	}
+}
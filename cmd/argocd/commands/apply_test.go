@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const applyManifests = `apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: guestbook
+  namespace: argocd
+spec:
+  project: platform
+  source:
+    repoURL: https://github.com/argoproj/argocd-example-apps.git
+    path: guestbook
+  destination:
+    server: https://kubernetes.default.svc
+    namespace: guestbook
+---
+apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+  name: platform
+  namespace: argocd
+spec:
+  sourceRepos:
+  - '*'
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: argocd-example-apps
+  namespace: argocd
+  labels:
+    argocd.argoproj.io/secret-type: repository
+stringData:
+  type: git
+  url: https://github.com/argoproj/argocd-example-apps.git
+`
+
+func TestReadManifests(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "apps.yaml"), []byte(applyManifests), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Argo CD"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "clusters"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "clusters", "in-cluster.yaml"), []byte(`apiVersion: v1
+kind: Secret
+metadata:
+  name: in-cluster
+  labels:
+    argocd.argoproj.io/secret-type: cluster
+stringData:
+  name: in-cluster
+  server: https://kubernetes.default.svc
+`), 0o600))
+
+	objs, err := readManifests([]string{dir}, false)
+	require.NoError(t, err)
+	assert.Len(t, objs, 3)
+
+	objs, err = readManifests([]string{dir}, true)
+	require.NoError(t, err)
+	require.Len(t, objs, 4)
+
+	var resources []string
+	for _, obj := range objs {
+		resource, err := newApplyResource(obj, true)
+		require.NoError(t, err)
+		resources = append(resources, resource.kind+" "+resource.name)
+	}
+	assert.ElementsMatch(t, []string{
+		"application argocd/guestbook",
+		"project platform",
+		"repository https://github.com/argoproj/argocd-example-apps.git",
+		"cluster https://kubernetes.default.svc",
+	}, resources)
+}
+
+func TestNewApplyResource_Unsupported(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "manifests.yaml")
+	for name, manifest := range map[string]string{
+		"ConfigMap": "{apiVersion: v1, kind: ConfigMap, metadata: {name: argocd-cm}}",
+		"Secret":    "{apiVersion: v1, kind: Secret, metadata: {name: argocd-secret}}",
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, os.WriteFile(path, []byte(manifest), 0o600))
+			objs, err := readManifests([]string{path}, false)
+			require.NoError(t, err)
+			require.Len(t, objs, 1)
+			_, err = newApplyResource(objs[0], true)
+			assert.ErrorContains(t, err, "unsupported")
+		})
+	}
+}
@@ -47,6 +47,7 @@ type forwardCacheClient struct {
 	init             sync.Once
 	client           cache.CacheClient
 	compression      cache.RedisCompressionType
+	keyPrefix        string
 	err              error
 	redisHaProxyName string
 	redisName        string
@@ -68,7 +69,7 @@ func (c *forwardCacheClient) doLazy(action func(client cache.CacheClient) error)
 		}
 
 		redisClient := redis.NewClient(&redis.Options{Addr: fmt.Sprintf("localhost:%d", redisPort), Password: c.redisPassword})
-		c.client = cache.NewRedisCache(redisClient, time.Hour, c.compression)
+		c.client = cache.NewRedisCacheWithKeyPrefix(redisClient, time.Hour, c.compression, c.keyPrefix)
 	})
 	if c.err != nil {
 		return c.err
@@ -112,6 +113,18 @@ func (c *forwardCacheClient) NotifyUpdated(key string) error {
 	})
 }
 
+func (c *forwardCacheClient) OnMessage(ctx context.Context, key string, callback func(message string) error) error {
+	return c.doLazy(func(client cache.CacheClient) error {
+		return client.OnMessage(ctx, key, callback)
+	})
+}
+
+func (c *forwardCacheClient) PublishMessage(key string, message string) error {
+	return c.doLazy(func(client cache.CacheClient) error {
+		return client.PublishMessage(key, message)
+	})
+}
+
 type forwardRepoClientset struct {
 	namespace      string
 	context        string
@@ -270,7 +283,7 @@ func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOpti
 		log.Warnf("Failed to fetch & set redis password for namespace %s: %v", namespace, err)
 	}
 
-	appstateCache := appstatecache.NewCache(cache.NewCache(&forwardCacheClient{namespace: namespace, context: ctxStr, compression: cache.RedisCompressionType(clientOpts.RedisCompression), redisHaProxyName: clientOpts.RedisHaProxyName, redisName: clientOpts.RedisName, redisPassword: redisOptions.Password}), time.Hour)
+	appstateCache := appstatecache.NewCache(cache.NewCache(&forwardCacheClient{namespace: namespace, context: ctxStr, compression: cache.RedisCompressionType(clientOpts.RedisCompression), keyPrefix: clientOpts.RedisKeyPrefix, redisHaProxyName: clientOpts.RedisHaProxyName, redisName: clientOpts.RedisName, redisPassword: redisOptions.Password}), time.Hour)
 	srv := server.NewServer(ctx, server.ArgoCDServerOpts{
 		EnableGZip:              false,
 		Namespace:               namespace,
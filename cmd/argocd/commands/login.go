@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"html"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -20,6 +21,7 @@ import (
 	"github.com/skratchdot/open-golang/open"
 	"github.com/spf13/cobra"
 	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
@@ -45,6 +47,10 @@ func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comman
 		ssoPort          int
 		skipTestTLS      bool
 		ssoLaunchBrowser bool
+		clientID         string
+		clientSecret     string
+		scopes           []string
+		audience         string
 	)
 	command := &cobra.Command{
 		Use:   "login SERVER",
@@ -56,6 +62,9 @@ argocd login cd.argoproj.io
 # Login to Argo CD using SSO
 argocd login cd.argoproj.io --sso
 
+# Login to Argo CD as a machine identity using the client credentials grant of the OIDC provider
+ARGOCD_CLIENT_SECRET=<secret> argocd login cd.argoproj.io --client-id ci-pipeline
+
 # Configure direct access using Kubernetes API server
 argocd login cd.argoproj.io --core`,
 		Run: func(c *cobra.Command, args []string) {
@@ -128,9 +137,20 @@ argocd login cd.argoproj.io --core`,
 				acdClient := headless.NewClientOrDie(&clientOpts, c)
 				setConn, setIf := acdClient.NewSettingsClientOrDie()
 				defer utilio.Close(setConn)
-				if !sso {
+				switch {
+				case clientID != "":
+					httpClient, err := acdClient.HTTPClient()
+					errors.CheckError(err)
+					ctx = oidc.ClientContext(ctx, httpClient)
+					acdSet, err := setIf.Get(ctx, &settingspkg.SettingsQuery{})
+					errors.CheckError(err)
+					if clientSecret == "" {
+						clientSecret = os.Getenv(clientSecretEnvVar)
+					}
+					tokenString = clientCredentialsLogin(ctx, acdSet.GetOIDCConfig(), clientID, clientSecret, scopes, audience)
+				case !sso:
 					tokenString = passwordLogin(ctx, acdClient, username, password)
-				} else {
+				default:
 					httpClient, err := acdClient.HTTPClient()
 					errors.CheckError(err)
 					ctx = oidc.ClientContext(ctx, httpClient)
@@ -187,9 +207,43 @@ argocd login cd.argoproj.io --core`,
 	command.Flags().IntVar(&ssoPort, "sso-port", DefaultSSOLocalPort, "Port to run local OAuth2 login application")
 	command.Flags().BoolVar(&skipTestTLS, "skip-test-tls", false, "Skip testing whether the server is configured with TLS (this can help when the command hangs for no apparent reason)")
 	command.Flags().BoolVar(&ssoLaunchBrowser, "sso-launch-browser", true, "Automatically launch the system default browser when performing SSO login")
+	command.Flags().StringVar(&clientID, "client-id", "", "Log in as a machine identity with the client credentials grant of the OIDC provider, using this client ID")
+	command.Flags().StringVar(&clientSecret, "client-secret", "", "The client secret of the machine identity. Defaults to the "+clientSecretEnvVar+" environment variable")
+	command.Flags().StringSliceVar(&scopes, "client-credentials-scopes", nil, "The scopes requested with the client credentials grant")
+	command.Flags().StringVar(&audience, "client-credentials-audience", "", "The audience requested with the client credentials grant, for the OIDC providers which require it")
+	command.MarkFlagsMutuallyExclusive("client-id", "sso")
+	command.MarkFlagsMutuallyExclusive("client-id", "username")
 	return command
 }
 
+// clientSecretEnvVar is the environment variable holding the client secret of the machine identities
+const clientSecretEnvVar = "ARGOCD_CLIENT_SECRET"
+
+// clientCredentialsLogin obtains an access token from the OIDC provider of Argo CD with the OAuth2 client credentials
+// grant. The token has no refresh token, the machine identity logs in again once it expires.
+func clientCredentialsLogin(ctx context.Context, oidcSettings *settingspkg.OIDCConfig, clientID string, clientSecret string, scopes []string, audience string) string {
+	if oidcSettings == nil || oidcSettings.Issuer == "" {
+		log.Fatal("Client credentials login requires Argo CD to be configured with an external OIDC provider")
+	}
+	if clientSecret == "" {
+		log.Fatalf("A client secret is required, set the --client-secret flag or the %s environment variable", clientSecretEnvVar)
+	}
+	provider, err := oidc.NewProvider(ctx, oidcSettings.Issuer)
+	errors.CheckError(err)
+	conf := clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     provider.Endpoint().TokenURL,
+		Scopes:       scopes,
+	}
+	if audience != "" {
+		conf.EndpointParams = url.Values{"audience": []string{audience}}
+	}
+	token, err := conf.Token(ctx)
+	errors.CheckError(err)
+	return token.AccessToken
+}
+
 func userDisplayName(claims jwt.MapClaims) string {
 	if email := jwtutil.StringField(claims, "email"); email != "" {
 		return email
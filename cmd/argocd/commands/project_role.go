@@ -10,6 +10,7 @@ import (
 	timeutil "github.com/argoproj/pkg/v2/time"
 	jwtgo "github.com/golang-jwt/jwt/v5"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
@@ -48,6 +49,7 @@ func NewProjectRoleCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	roleCommand.AddCommand(NewProjectRoleRemovePolicyCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleAddGroupCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleRemoveGroupCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectRoleListExpiringGroupsCommand(clientOpts))
 	return roleCommand
 }
 
@@ -624,9 +626,17 @@ ID          ISSUED-AT                                  EXPIRES-AT
 
 // NewProjectRoleAddGroupCommand returns a new instance of an `argocd proj role add-group` command
 func NewProjectRoleAddGroupCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var expiresIn string
 	command := &cobra.Command{
 		Use:   "add-group PROJECT ROLE-NAME GROUP-CLAIM",
 		Short: "Add a group claim to a project role",
+		Example: templates.Examples(`
+  # Bind the group to the role
+  argocd proj role add-group PROJECT ROLE-NAME GROUP-CLAIM
+
+  # Bind the group to the role for 30 days, or extend its binding if the group is already bound to the role
+  argocd proj role add-group PROJECT ROLE-NAME GROUP-CLAIM --expires-in 30d
+  		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -635,11 +645,17 @@ func NewProjectRoleAddGroupCommand(clientOpts *argocdclient.ClientOptions) *cobr
 				os.Exit(1)
 			}
 			projName, roleName, groupName := args[0], args[1], args[2]
+			var expiresAt *metav1.Time
+			if expiresIn != "" {
+				duration, err := timeutil.ParseDuration(expiresIn)
+				errors.CheckError(err)
+				expiresAt = &metav1.Time{Time: time.Now().Add(*duration).Truncate(time.Second)}
+			}
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
-			updated, err := proj.AddGroupToRole(roleName, groupName)
+			updated, err := proj.AddGroupToRoleWithExpiry(roleName, groupName, expiresAt)
 			errors.CheckError(err)
 			if !updated {
 				fmt.Printf("Group '%s' already present in role '%s'\n", groupName, roleName)
@@ -647,9 +663,16 @@ func NewProjectRoleAddGroupCommand(clientOpts *argocdclient.ClientOptions) *cobr
 			}
 			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
 			errors.CheckError(err)
-			fmt.Printf("Group '%s' added to role '%s'\n", groupName, roleName)
+			if expiresAt != nil {
+				fmt.Printf("Group '%s' added to role '%s' until %s\n", groupName, roleName, expiresAt.Format(time.RFC3339))
+			} else {
+				fmt.Printf("Group '%s' added to role '%s'\n", groupName, roleName)
+			}
 		},
 	}
+	command.Flags().StringVarP(&expiresIn, "expires-in", "e", "",
+		"Duration before the binding of the group to the role expires, e.g. \"12h\", \"30d\". (Default: No expiration)",
+	)
 	return command
 }
 
@@ -692,3 +715,54 @@ func NewProjectRoleRemoveGroupCommand(clientOpts *argocdclient.ClientOptions) *c
 	}
 	return command
 }
+
+// NewProjectRoleListExpiringGroupsCommand returns a new instance of an `argocd proj role list-expiring-groups` command
+func NewProjectRoleListExpiringGroupsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		within string
+		output string
+	)
+	command := &cobra.Command{
+		Use:   "list-expiring-groups PROJECT",
+		Short: "List the bindings of the group claims to the roles of a project which expire soon, or have expired",
+		Example: templates.Examples(`
+  # List the bindings of the groups to the roles of the project which expire within 30 days
+  argocd proj role list-expiring-groups PROJECT
+
+  # List the bindings of the groups which expire within a week, in JSON
+  argocd proj role list-expiring-groups PROJECT --within 7d --output json
+  		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			duration, err := timeutil.ParseDuration(within)
+			errors.CheckError(err)
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			res, err := projIf.ListExpiringGroupBindings(ctx, &projectpkg.ExpiringGroupBindingsQuery{Name: args[0], Within: duration.String()})
+			errors.CheckError(err)
+			switch output {
+			case "json", "yaml":
+				err := PrintResourceList(res.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintf(w, "ROLE-NAME\tGROUP\tEXPIRES-AT\tEXPIRED\n")
+				for _, binding := range res.Items {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%t\n", binding.Role, binding.Expiration.Group, humanizeTimestamp(binding.Expiration.ExpiresAt.Unix()), binding.Expired)
+				}
+				_ = w.Flush()
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVar(&within, "within", "30d", "Duration the bindings expire within, e.g. \"7d\"")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
@@ -55,7 +55,10 @@ argocd repo rm https://github.com/yourusername/your-repo.git
 
 // NewRepoAddCommand returns a new instance of an `argocd repo add` command
 func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var repoOpts cmdutil.RepoOptions
+	var (
+		repoOpts       cmdutil.RepoOptions
+		skipValidation bool
+	)
 
 	// For better readability and easier formatting
 	repoAddExamples := `  # Add a Git repository via SSH using a private key for authentication, ignoring the server's host key:
@@ -216,42 +219,45 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 			err = cmdutil.ValidateBearerTokenForHTTPSRepoOnly(repoOpts.Repo.BearerToken, git.IsHTTPSURL(repoOpts.Repo.Repo))
 			errors.CheckError(err)
 
-			// We let the server check access to the repository before adding it. If
-			// it is a private repo, but we cannot access with the credentials
-			// that were supplied, we bail out.
-			//
-			// Skip validation if we are just adding credentials template, chances
-			// are high that we do not have the given URL pointing to a valid Git
-			// repo anyway.
-			repoAccessReq := repositorypkg.RepoAccessQuery{
-				Repo:                       repoOpts.Repo.Repo,
-				Type:                       repoOpts.Repo.Type,
-				Name:                       repoOpts.Repo.Name,
-				Username:                   repoOpts.Repo.Username,
-				Password:                   repoOpts.Repo.Password,
-				BearerToken:                repoOpts.Repo.BearerToken,
-				SshPrivateKey:              repoOpts.Repo.SSHPrivateKey,
-				TlsClientCertData:          repoOpts.Repo.TLSClientCertData,
-				TlsClientCertKey:           repoOpts.Repo.TLSClientCertKey,
-				Insecure:                   repoOpts.Repo.IsInsecure(),
-				EnableOci:                  repoOpts.Repo.EnableOCI,
-				GithubAppPrivateKey:        repoOpts.Repo.GithubAppPrivateKey,
-				GithubAppID:                repoOpts.Repo.GithubAppId,
-				GithubAppInstallationID:    repoOpts.Repo.GithubAppInstallationId,
-				GithubAppEnterpriseBaseUrl: repoOpts.Repo.GitHubAppEnterpriseBaseURL,
-				Proxy:                      repoOpts.Proxy,
-				Project:                    repoOpts.Repo.Project,
-				GcpServiceAccountKey:       repoOpts.Repo.GCPServiceAccountKey,
-				ForceHttpBasicAuth:         repoOpts.Repo.ForceHttpBasicAuth,
-				UseAzureWorkloadIdentity:   repoOpts.Repo.UseAzureWorkloadIdentity,
-				InsecureOciForceHttp:       repoOpts.Repo.InsecureOCIForceHttp,
+			if !skipValidation {
+				// We let the server check access to the repository before adding it. If
+				// it is a private repo, but we cannot access with the credentials
+				// that were supplied, we bail out.
+				//
+				// Skip validation if we are just adding credentials template, chances
+				// are high that we do not have the given URL pointing to a valid Git
+				// repo anyway.
+				repoAccessReq := repositorypkg.RepoAccessQuery{
+					Repo:                       repoOpts.Repo.Repo,
+					Type:                       repoOpts.Repo.Type,
+					Name:                       repoOpts.Repo.Name,
+					Username:                   repoOpts.Repo.Username,
+					Password:                   repoOpts.Repo.Password,
+					BearerToken:                repoOpts.Repo.BearerToken,
+					SshPrivateKey:              repoOpts.Repo.SSHPrivateKey,
+					TlsClientCertData:          repoOpts.Repo.TLSClientCertData,
+					TlsClientCertKey:           repoOpts.Repo.TLSClientCertKey,
+					Insecure:                   repoOpts.Repo.IsInsecure(),
+					EnableOci:                  repoOpts.Repo.EnableOCI,
+					GithubAppPrivateKey:        repoOpts.Repo.GithubAppPrivateKey,
+					GithubAppID:                repoOpts.Repo.GithubAppId,
+					GithubAppInstallationID:    repoOpts.Repo.GithubAppInstallationId,
+					GithubAppEnterpriseBaseUrl: repoOpts.Repo.GitHubAppEnterpriseBaseURL,
+					Proxy:                      repoOpts.Proxy,
+					Project:                    repoOpts.Repo.Project,
+					GcpServiceAccountKey:       repoOpts.Repo.GCPServiceAccountKey,
+					ForceHttpBasicAuth:         repoOpts.Repo.ForceHttpBasicAuth,
+					UseAzureWorkloadIdentity:   repoOpts.Repo.UseAzureWorkloadIdentity,
+					InsecureOciForceHttp:       repoOpts.Repo.InsecureOCIForceHttp,
+				}
+				_, err = repoIf.ValidateAccess(ctx, &repoAccessReq)
+				errors.CheckError(err)
 			}
-			_, err = repoIf.ValidateAccess(ctx, &repoAccessReq)
-			errors.CheckError(err)
 
 			repoCreateReq := repositorypkg.RepoCreateRequest{
-				Repo:   &repoOpts.Repo,
-				Upsert: repoOpts.Upsert,
+				Repo:           &repoOpts.Repo,
+				Upsert:         repoOpts.Upsert,
+				SkipValidation: skipValidation,
 			}
 
 			createdRepo, err := repoIf.CreateRepository(ctx, &repoCreateReq)
@@ -260,6 +266,7 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 		},
 	}
 	command.Flags().BoolVar(&repoOpts.Upsert, "upsert", false, "Override an existing repository with the same name even if the spec differs")
+	command.Flags().BoolVar(&skipValidation, "skip-validation", false, "Add the repository without testing the connection to it")
 	cmdutil.AddRepoFlags(command, &repoOpts)
 	return command
 }
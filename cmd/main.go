@@ -2,6 +2,7 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -22,6 +23,7 @@ import (
 	cli "github.com/argoproj/argo-cd/v3/cmd/argocd/commands"
 	"github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/util/log"
+	"github.com/argoproj/argo-cd/v3/util/tls"
 )
 
 const (
@@ -76,6 +78,11 @@ func main() {
 	}
 	util.SetAutoMaxProcs(isArgocdCLI)
 
+	if err := tls.ValidateFIPSMode(); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
 	if isArgocdCLI {
 		// silence errors and usages since we'll be printing them manually.
 		// This is because if we execute a plugin, the initial
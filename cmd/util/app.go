@@ -21,6 +21,7 @@ import (
 	"github.com/spf13/pflag"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -37,6 +38,7 @@ type AppOptions struct {
 	env                             string
 	revision                        string
 	revisionHistoryLimit            int
+	refreshInterval                 string
 	destName                        string
 	destServer                      string
 	destNamespace                   string
@@ -62,6 +64,7 @@ type AppOptions struct {
 	autoPrune                       bool
 	selfHeal                        bool
 	allowEmpty                      bool
+	syncConcurrencyKey              string
 	namePrefix                      string
 	nameSuffix                      string
 	directoryRecurse                bool
@@ -84,6 +87,8 @@ type AppOptions struct {
 	kustomizeKubeVersion            string
 	kustomizeApiVersions            []string //nolint:revive //FIXME(var-naming)
 	ignoreMissingComponents         bool
+	kustomizeComponents             []string
+	kustomizePatchFiles             []string
 	pluginEnvs                      []string
 	Validate                        bool
 	directoryExclude                string
@@ -94,6 +99,7 @@ type AppOptions struct {
 	retryBackoffFactor              int64
 	ref                             string
 	SourceName                      string
+	watchPaths                      []string
 	drySourceRepo                   string
 	drySourceRevision               string
 	drySourcePath                   string
@@ -128,6 +134,7 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringVar(&opts.syncSourcePath, "sync-source-path", "", "The path in the repository from which the app will sync")
 	command.Flags().StringVar(&opts.hydrateToBranch, "hydrate-to-branch", "", "The branch to hydrate the app to")
 	command.Flags().IntVar(&opts.revisionHistoryLimit, "revision-history-limit", argoappv1.RevisionHistoryLimit, "How many items to keep in revision history")
+	command.Flags().StringVar(&opts.refreshInterval, "refresh-interval", "", "Interval at which the app is refreshed, overriding the instance-wide one (e.g. 1m, 1h)")
 	command.Flags().StringVar(&opts.destServer, "dest-server", "", "K8s cluster URL (e.g. https://kubernetes.default.svc)")
 	command.Flags().StringVar(&opts.destName, "dest-name", "", "K8s cluster Name (e.g. minikube)")
 	command.Flags().StringVar(&opts.destNamespace, "dest-namespace", "", "K8s target namespace")
@@ -153,6 +160,7 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().BoolVar(&opts.autoPrune, "auto-prune", false, "Set automatic pruning when sync is automated")
 	command.Flags().BoolVar(&opts.selfHeal, "self-heal", false, "Set self healing when sync is automated")
 	command.Flags().BoolVar(&opts.allowEmpty, "allow-empty", false, "Set allow zero live resources when sync is automated")
+	command.Flags().StringVar(&opts.syncConcurrencyKey, "sync-concurrency-key", "", "Key of the mutex of the syncs: the apps with the same key never sync concurrently. Remove using an empty key")
 	command.Flags().StringVar(&opts.namePrefix, "nameprefix", "", "Kustomize nameprefix")
 	command.Flags().StringVar(&opts.nameSuffix, "namesuffix", "", "Kustomize namesuffix")
 	command.Flags().StringVar(&opts.kustomizeVersion, "kustomize-version", "", "Kustomize version")
@@ -166,6 +174,8 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringArrayVar(&opts.kustomizeImages, "kustomize-image", []string{}, "Kustomize images (e.g. --kustomize-image node:8.15.0 --kustomize-image mysql=mariadb,alpine@sha256:24a0c4b4a4c0eb97a1aabb8e29f18e917d05abfe1b7a7c07857230879ce7d3d)")
 	command.Flags().StringArrayVar(&opts.kustomizeReplicas, "kustomize-replica", []string{}, "Kustomize replicas (e.g. --kustomize-replica my-development=2 --kustomize-replica my-statefulset=4)")
 	command.Flags().BoolVar(&opts.ignoreMissingComponents, "ignore-missing-components", false, "Ignore locally missing component directories when setting Kustomize components")
+	command.Flags().StringArrayVar(&opts.kustomizeComponents, "kustomize-component", []string{}, "Kustomize components, relative to the application path (e.g. --kustomize-component ../components/ingress)")
+	command.Flags().StringArrayVar(&opts.kustomizePatchFiles, "kustomize-patch-file", []string{}, "Filename of a list of Kustomize patches in the format of the kustomization patches field")
 	command.Flags().StringArrayVar(&opts.pluginEnvs, "plugin-env", []string{}, "Additional plugin envs")
 	command.Flags().BoolVar(&opts.Validate, "validate", true, "Validation of repo and cluster")
 	command.Flags().StringArrayVar(&opts.kustomizeCommonLabels, "kustomize-common-label", []string{}, "Set common labels in Kustomize")
@@ -185,6 +195,7 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().Int64Var(&opts.retryBackoffFactor, "sync-retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed sync retry")
 	command.Flags().StringVar(&opts.ref, "ref", "", "Ref is reference to another source within sources field")
 	command.Flags().StringVar(&opts.SourceName, "source-name", "", "Name of the source from the list of sources of the app.")
+	command.Flags().StringArrayVar(&opts.watchPaths, "watch-path", []string{}, "Path whose changes trigger a refresh of the app, relative to the source path or to the repository root when starting with '/' (can be repeated multiple times to add multiple paths)")
 }
 
 func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, appOpts *AppOptions, sourcePosition int) int {
@@ -222,6 +233,8 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 		case "revision-history-limit":
 			i := int64(appOpts.revisionHistoryLimit)
 			spec.RevisionHistoryLimit = &i
+		case "refresh-interval":
+			spec.RefreshInterval = appOpts.refreshInterval
 		case "dest-name":
 			spec.Destination.Name = appOpts.destName
 		case "dest-server":
@@ -263,6 +276,14 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			if spec.SyncPolicy.IsZero() {
 				spec.SyncPolicy = nil
 			}
+		case "sync-concurrency-key":
+			if spec.SyncPolicy == nil {
+				spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			spec.SyncPolicy.ConcurrencyKey = appOpts.syncConcurrencyKey
+			if spec.SyncPolicy.IsZero() {
+				spec.SyncPolicy = nil
+			}
 		case "sync-retry-limit":
 			switch {
 			case appOpts.retryLimit > 0:
@@ -326,6 +347,8 @@ type kustomizeOpts struct {
 	kubeVersion             string
 	apiVersions             []string
 	ignoreMissingComponents bool
+	components              []string
+	patches                 argoappv1.KustomizePatches
 }
 
 func setKustomizeOpt(src *argoappv1.ApplicationSource, opts kustomizeOpts) {
@@ -381,12 +404,35 @@ func setKustomizeOpt(src *argoappv1.ApplicationSource, opts kustomizeOpts) {
 		}
 		src.Kustomize.MergeReplica(*r)
 	}
+	for _, component := range opts.components {
+		src.Kustomize.MergeComponent(component)
+	}
+	for _, patch := range opts.patches {
+		src.Kustomize.MergePatch(patch)
+	}
 
 	if src.Kustomize.IsZero() {
 		src.Kustomize = nil
 	}
 }
 
+// readKustomizePatches reads the lists of Kustomize patches of the given files
+func readKustomizePatches(paths []string) (argoappv1.KustomizePatches, error) {
+	var patches argoappv1.KustomizePatches
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Kustomize patches file %s: %w", path, err)
+		}
+		var filePatches argoappv1.KustomizePatches
+		if err := yaml.UnmarshalStrict(data, &filePatches); err != nil {
+			return nil, fmt.Errorf("failed to parse Kustomize patches file %s: %w", path, err)
+		}
+		patches = append(patches, filePatches...)
+	}
+	return patches, nil
+}
+
 func setPluginOptEnvs(src *argoappv1.ApplicationSource, envs []string) {
 	if src.Plugin == nil {
 		src.Plugin = &argoappv1.ApplicationSourcePlugin{}
@@ -782,6 +828,12 @@ func ConstructSource(source *argoappv1.ApplicationSource, appOpts AppOptions, fl
 			setKustomizeOpt(source, kustomizeOpts{forceCommonAnnotations: appOpts.kustomizeForceCommonAnnotations})
 		case "ignore-missing-components":
 			setKustomizeOpt(source, kustomizeOpts{ignoreMissingComponents: appOpts.ignoreMissingComponents})
+		case "kustomize-component":
+			setKustomizeOpt(source, kustomizeOpts{components: appOpts.kustomizeComponents})
+		case "kustomize-patch-file":
+			patches, err := readKustomizePatches(appOpts.kustomizePatchFiles)
+			errors.CheckError(err)
+			setKustomizeOpt(source, kustomizeOpts{patches: patches})
 		case "jsonnet-tla-str":
 			setJsonnetOpt(source, appOpts.jsonnetTlaStr, false)
 		case "jsonnet-tla-code":
@@ -798,6 +850,8 @@ func ConstructSource(source *argoappv1.ApplicationSource, appOpts AppOptions, fl
 			source.Ref = appOpts.ref
 		case "source-name":
 			source.Name = appOpts.SourceName
+		case "watch-path":
+			source.WatchPaths = appOpts.watchPaths
 		}
 	})
 	return source, visited
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"log"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -176,6 +177,22 @@ func Test_setKustomizeOpt(t *testing.T) {
 		t.Logf("HERE IS THE SOURCE\n %+v\n", src)
 		assert.True(t, src.Kustomize.IgnoreMissingComponents)
 	})
+	t.Run("Components", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setKustomizeOpt(&src, kustomizeOpts{components: []string{"../components/a", "../components/b"}})
+		setKustomizeOpt(&src, kustomizeOpts{components: []string{"../components/a"}})
+		assert.Equal(t, &v1alpha1.ApplicationSourceKustomize{Components: []string{"../components/a", "../components/b"}}, src.Kustomize)
+	})
+	t.Run("Patches", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		patch := v1alpha1.KustomizePatch{
+			Patch:  `[{"op": "replace", "path": "/spec/replicas", "value": 2}]`,
+			Target: &v1alpha1.KustomizeSelector{KustomizeResId: v1alpha1.KustomizeResId{KustomizeGvk: v1alpha1.KustomizeGvk{Kind: "Deployment"}}},
+		}
+		setKustomizeOpt(&src, kustomizeOpts{patches: v1alpha1.KustomizePatches{patch}})
+		setKustomizeOpt(&src, kustomizeOpts{patches: v1alpha1.KustomizePatches{patch}})
+		assert.Equal(t, &v1alpha1.ApplicationSourceKustomize{Patches: v1alpha1.KustomizePatches{patch}}, src.Kustomize)
+	})
 }
 
 func Test_setJsonnetOpt(t *testing.T) {
@@ -245,6 +262,8 @@ func newAppOptionsFixture() *appOptionsFixture {
 
 func Test_setAppSpecOptions(t *testing.T) {
 	f := newAppOptionsFixture()
+	// the patch files are read again by the flags set after them
+	patchesDir := t.TempDir()
 	t.Run("SyncPolicy", func(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-policy", "automated"))
 		assert.NotNil(t, f.spec.SyncPolicy.Automated)
@@ -268,6 +287,13 @@ func Test_setAppSpecOptions(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-option", "!a=1"))
 		assert.Nil(t, f.spec.SyncPolicy)
 	})
+	t.Run("SyncConcurrencyKey", func(t *testing.T) {
+		require.NoError(t, f.SetFlag("sync-concurrency-key", "db"))
+		assert.Equal(t, "db", f.spec.SyncPolicy.ConcurrencyKey)
+
+		require.NoError(t, f.SetFlag("sync-concurrency-key", ""))
+		assert.Nil(t, f.spec.SyncPolicy)
+	})
 	t.Run("RetryLimit", func(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-retry-limit", "5"))
 		assert.Equal(t, int64(5), f.spec.SyncPolicy.Retry.Limit)
@@ -293,6 +319,39 @@ func Test_setAppSpecOptions(t *testing.T) {
 		require.NoError(t, f.SetFlag("kustomize-api-versions", "v2"))
 		assert.Equal(t, []string{"v1", "v2"}, f.spec.Source.Kustomize.APIVersions)
 	})
+	t.Run("Kustomize Components", func(t *testing.T) {
+		require.NoError(t, f.SetFlag("kustomize-component", "../components/a"))
+		require.NoError(t, f.SetFlag("kustomize-component", "../components/b"))
+		assert.Equal(t, []string{"../components/a", "../components/b"}, f.spec.Source.Kustomize.Components)
+	})
+	t.Run("Kustomize Patches", func(t *testing.T) {
+		patchFile := filepath.Join(patchesDir, "patches.yaml")
+		require.NoError(t, os.WriteFile(patchFile, []byte(`- target:
+    kind: Deployment
+    name: guestbook-ui
+  patch: |-
+    - op: replace
+      path: /spec/replicas
+      value: 2
+`), 0o600))
+		require.NoError(t, f.SetFlag("kustomize-patch-file", patchFile))
+		assert.Equal(t, v1alpha1.KustomizePatches{{
+			Patch: "- op: replace\n  path: /spec/replicas\n  value: 2",
+			Target: &v1alpha1.KustomizeSelector{KustomizeResId: v1alpha1.KustomizeResId{
+				KustomizeGvk: v1alpha1.KustomizeGvk{Kind: "Deployment"},
+				Name:         "guestbook-ui",
+			}},
+		}}, f.spec.Source.Kustomize.Patches)
+	})
+	t.Run("Refresh Interval", func(t *testing.T) {
+		require.NoError(t, f.SetFlag("refresh-interval", "1h"))
+		assert.Equal(t, "1h", f.spec.RefreshInterval)
+	})
+	t.Run("Watch Paths", func(t *testing.T) {
+		require.NoError(t, f.SetFlag("watch-path", "."))
+		require.NoError(t, f.SetFlag("watch-path", "/shared"))
+		assert.Equal(t, []string{".", "/shared"}, f.spec.Source.WatchPaths)
+	})
 	t.Run("Helm Namespace", func(t *testing.T) {
 		require.NoError(t, f.SetFlag("helm-namespace", "override-namespace"))
 		assert.Equal(t, "override-namespace", f.spec.Source.Helm.Namespace)
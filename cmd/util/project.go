@@ -26,6 +26,7 @@ type ProjectOpts struct {
 	Sources                    []string
 	SignatureKeys              []string
 	SourceNamespaces           []string
+	MaxRefreshInterval         string
 
 	orphanedResourcesEnabled   bool
 	orphanedResourcesWarn      bool
@@ -50,6 +51,7 @@ func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
 	command.Flags().StringSliceVar(&opts.SourceNamespaces, "source-namespaces", []string{}, "List of source namespaces for applications")
 	command.Flags().StringArrayVar(&opts.destinationServiceAccounts, "dest-service-accounts", []string{},
 		"Destination server, namespace and target service account (e.g. https://192.168.99.100:8443,default,default-sa)")
+	command.Flags().StringVar(&opts.MaxRefreshInterval, "max-refresh-interval", "", "Maximum interval at which the applications of the project are refreshed (e.g. 1h)")
 }
 
 func getGroupKindList(values []string) []metav1.GroupKind {
@@ -186,6 +188,8 @@ func SetProjSpecOptions(flags *pflag.FlagSet, spec *v1alpha1.AppProjectSpec, pro
 			spec.SourceNamespaces = projOpts.GetSourceNamespaces()
 		case "dest-service-accounts":
 			spec.DestinationServiceAccounts = projOpts.GetDestinationServiceAccounts()
+		case "max-refresh-interval":
+			spec.MaxRefreshInterval = projOpts.MaxRefreshInterval
 		}
 	})
 	if flags.Changed("orphaned-resources") || flags.Changed("orphaned-resources-warn") {
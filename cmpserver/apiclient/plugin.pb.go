@@ -263,11 +263,13 @@ func (m *EnvEntry) GetValue() string {
 }
 
 type ManifestResponse struct {
-	Manifests            []string `protobuf:"bytes,1,rep,name=manifests,proto3" json:"manifests,omitempty"`
-	SourceType           string   `protobuf:"bytes,2,opt,name=sourceType,proto3" json:"sourceType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Manifests  []string `protobuf:"bytes,1,rep,name=manifests,proto3" json:"manifests,omitempty"`
+	SourceType string   `protobuf:"bytes,2,opt,name=sourceType,proto3" json:"sourceType,omitempty"`
+	// the SHA-256 digests of the binaries of the init and generate commands, by path
+	ToolDigests          map[string]string `protobuf:"bytes,3,rep,name=toolDigests,proto3" json:"toolDigests,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
@@ -317,6 +319,13 @@ func (m *ManifestResponse) GetSourceType() string {
 	return ""
 }
 
+func (m *ManifestResponse) GetToolDigests() map[string]string {
+	if m != nil {
+		return m.ToolDigests
+	}
+	return nil
+}
+
 type RepositoryResponse struct {
 	IsSupported          bool     `protobuf:"varint,1,opt,name=isSupported,proto3" json:"isSupported,omitempty"`
 	IsDiscoveryEnabled   bool     `protobuf:"varint,2,opt,name=isDiscoveryEnabled,proto3" json:"isDiscoveryEnabled,omitempty"`
@@ -529,6 +538,7 @@ func init() {
 	proto.RegisterType((*ManifestRequestMetadata)(nil), "plugin.ManifestRequestMetadata")
 	proto.RegisterType((*EnvEntry)(nil), "plugin.EnvEntry")
 	proto.RegisterType((*ManifestResponse)(nil), "plugin.ManifestResponse")
+	proto.RegisterMapType((map[string]string)(nil), "plugin.ManifestResponse.ToolDigestsEntry")
 	proto.RegisterType((*RepositoryResponse)(nil), "plugin.RepositoryResponse")
 	proto.RegisterType((*ParametersAnnouncementResponse)(nil), "plugin.ParametersAnnouncementResponse")
 	proto.RegisterType((*File)(nil), "plugin.File")
@@ -538,49 +548,52 @@ func init() {
 func init() { proto.RegisterFile("cmpserver/plugin/plugin.proto", fileDescriptor_b21875a7079a06ed) }
 
 var fileDescriptor_b21875a7079a06ed = []byte{
-	// 668 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x9b, 0xb4, 0x4d, 0x26, 0x95, 0x1a, 0xad, 0xa0, 0x98, 0xd0, 0x86, 0xe0, 0x03, 0xca,
-	0x05, 0x47, 0x4a, 0x7b, 0x45, 0xa2, 0x2d, 0xa1, 0x15, 0x28, 0x28, 0x72, 0xb9, 0xc0, 0x01, 0x69,
-	0xe3, 0x4c, 0x92, 0xa5, 0xf6, 0xee, 0xb2, 0x5e, 0x5b, 0x0a, 0x5c, 0x10, 0x2f, 0xc3, 0xab, 0x70,
-	0xe4, 0x11, 0x50, 0x5f, 0x83, 0x0b, 0xf2, 0xda, 0x4e, 0xa2, 0x36, 0x6d, 0x4f, 0x9e, 0xbf, 0xfd,
-	0xfc, 0xcd, 0xec, 0x37, 0x0b, 0x07, 0x7e, 0x28, 0x23, 0x54, 0x09, 0xaa, 0xae, 0x0c, 0xe2, 0x29,
-	0xe3, 0xf9, 0xc7, 0x95, 0x4a, 0x68, 0x41, 0xb6, 0x32, 0xaf, 0xd9, 0x9f, 0x32, 0x3d, 0x8b, 0x47,
-	0xae, 0x2f, 0xc2, 0x2e, 0x55, 0x53, 0x21, 0x95, 0xf8, 0x62, 0x8c, 0x17, 0xfe, 0xb8, 0x9b, 0x1c,
-	0x76, 0x15, 0x4a, 0x91, 0xc3, 0x18, 0x93, 0x69, 0xa1, 0xe6, 0x2b, 0x66, 0x06, 0xd7, 0x7c, 0x32,
-	0x15, 0x62, 0x1a, 0x60, 0xd7, 0x78, 0xa3, 0x78, 0xd2, 0xc5, 0x50, 0xea, 0x3c, 0xe9, 0xfc, 0xb0,
-	0xa0, 0x71, 0x2c, 0xe5, 0x85, 0x56, 0x48, 0x43, 0x0f, 0xbf, 0xc6, 0x18, 0x69, 0xf2, 0x12, 0xaa,
-	0x21, 0x6a, 0x3a, 0xa6, 0x9a, 0xda, 0x56, 0xdb, 0xea, 0xd4, 0x7b, 0x4f, 0xdd, 0x9c, 0xe1, 0x80,
-	0x72, 0x36, 0xc1, 0x48, 0xe7, 0xa5, 0x83, 0xbc, 0xec, 0xbc, 0xe4, 0x2d, 0x8e, 0x10, 0x07, 0x2a,
-	0x13, 0x16, 0xa0, 0xbd, 0x61, 0x8e, 0xee, 0x14, 0x47, 0xdf, 0xb0, 0x00, 0xcf, 0x4b, 0x9e, 0xc9,
-	0x9d, 0xd4, 0x60, 0x5b, 0x65, 0x10, 0xce, 0x2f, 0x0b, 0x1e, 0xdd, 0x02, 0x4b, 0x6c, 0xd8, 0xa6,
-	0x52, 0xbe, 0xa7, 0x21, 0x1a, 0x22, 0x35, 0xaf, 0x70, 0x49, 0x0b, 0x80, 0x4a, 0xe9, 0x61, 0x30,
-	0xa4, 0x7a, 0x66, 0x7e, 0x55, 0xf3, 0x56, 0x22, 0xa4, 0x09, 0x55, 0x7f, 0x86, 0xfe, 0x65, 0x14,
-	0x87, 0x76, 0xd9, 0x64, 0x17, 0x3e, 0x21, 0x50, 0x89, 0xd8, 0x37, 0xb4, 0x2b, 0x6d, 0xab, 0x53,
-	0xf6, 0x8c, 0x4d, 0x1c, 0x28, 0x23, 0x4f, 0xec, 0xcd, 0x76, 0xb9, 0x53, 0xef, 0x35, 0x0a, 0xce,
-	0x7d, 0x9e, 0xf4, 0xb9, 0x56, 0x73, 0x2f, 0x4d, 0x3a, 0x47, 0x50, 0x2d, 0x02, 0x29, 0x06, 0x5f,
-	0xd2, 0x32, 0x36, 0x79, 0x00, 0x9b, 0x09, 0x0d, 0x62, 0xcc, 0xe9, 0x64, 0x8e, 0x33, 0x84, 0xc6,
-	0xb2, 0xbd, 0x48, 0x0a, 0x1e, 0x21, 0xd9, 0x87, 0x5a, 0x98, 0xc7, 0x22, 0xdb, 0x6a, 0x97, 0x3b,
-	0x35, 0x6f, 0x19, 0x48, 0x7b, 0x8b, 0x44, 0xac, 0x7c, 0xfc, 0x30, 0x97, 0x05, 0xd8, 0x4a, 0xc4,
-	0x99, 0x00, 0xf1, 0x16, 0xb7, 0xbc, 0xc0, 0x6c, 0x43, 0x9d, 0x45, 0x17, 0xb1, 0x94, 0x42, 0x69,
-	0x1c, 0x1b, 0x62, 0x55, 0x6f, 0x35, 0x44, 0x5c, 0x20, 0x2c, 0x7a, 0xcd, 0x22, 0x5f, 0x24, 0xa8,
-	0xe6, 0x7d, 0x4e, 0x47, 0x01, 0x8e, 0x0d, 0x7e, 0xd5, 0x5b, 0x93, 0x71, 0xbe, 0x43, 0x6b, 0x48,
-	0x15, 0x0d, 0x51, 0xa3, 0x8a, 0x8e, 0x39, 0x17, 0x31, 0xf7, 0x31, 0x44, 0xbe, 0xec, 0xe3, 0x23,
-	0xec, 0xc9, 0xa2, 0x62, 0xb5, 0x20, 0x6b, 0xaa, 0xde, 0x7b, 0xe6, 0xae, 0xc8, 0x71, 0xb8, 0xae,
-	0xd2, 0xbb, 0x05, 0xc0, 0xd9, 0x87, 0x4a, 0xaa, 0x98, 0x74, 0xa8, 0xfe, 0x2c, 0xe6, 0x97, 0xa6,
-	0xa1, 0x1d, 0x2f, 0x73, 0x9c, 0x9f, 0x16, 0xb4, 0x4f, 0xd3, 0xfb, 0x1c, 0x9a, 0x8b, 0x3a, 0x15,
-	0x7c, 0xc2, 0xa6, 0xb1, 0xa2, 0x9a, 0x09, 0xbe, 0x60, 0x77, 0x04, 0x0f, 0x57, 0xba, 0x2a, 0x6a,
-	0x16, 0xb3, 0x59, 0x9f, 0x24, 0x1d, 0xd8, 0x95, 0x4a, 0x24, 0x6c, 0x8c, 0x67, 0x4c, 0x9f, 0x2a,
-	0x1c, 0x47, 0xf9, 0x88, 0xae, 0x87, 0x7b, 0xff, 0x36, 0xe0, 0x20, 0x3b, 0x38, 0xa0, 0x9c, 0x4e,
-	0x0d, 0xf1, 0x8c, 0xcf, 0x05, 0xaa, 0x84, 0xf9, 0x48, 0xde, 0x42, 0xe3, 0x0c, 0x39, 0x2a, 0xaa,
-	0xb1, 0xd0, 0x00, 0xb1, 0x0b, 0x71, 0x5d, 0xdf, 0xbb, 0xa6, 0x7d, 0x73, 0xcb, 0xb2, 0x4e, 0x9c,
-	0x52, 0xc7, 0x22, 0x9f, 0xc1, 0xbe, 0xad, 0x63, 0xb2, 0xe7, 0x66, 0x4b, 0xee, 0x16, 0x4b, 0xee,
-	0xf6, 0xd3, 0x25, 0x6f, 0x76, 0x0a, 0xc4, 0xfb, 0x66, 0xe5, 0x94, 0xc8, 0x3b, 0xd8, 0x1d, 0x50,
-	0xed, 0xcf, 0x96, 0xd2, 0xba, 0x83, 0x6a, 0xb3, 0xc8, 0xdc, 0x14, 0xa2, 0x21, 0x4b, 0xe1, 0xf1,
-	0x19, 0xea, 0xf5, 0xea, 0xb9, 0x03, 0xf6, 0x79, 0x91, 0xb9, 0x5b, 0x77, 0xe9, 0x2f, 0x4e, 0x5e,
-	0xfd, 0xbe, 0x6a, 0x59, 0x7f, 0xae, 0x5a, 0xd6, 0xdf, 0xab, 0x96, 0xf5, 0xa9, 0x77, 0xcf, 0x63,
-	0xb9, 0x7c, 0x72, 0xa9, 0x64, 0x7e, 0xc0, 0x90, 0xeb, 0xd1, 0x96, 0x99, 0xd6, 0xe1, 0xff, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xb0, 0x8d, 0xb8, 0x47, 0x90, 0x05, 0x00, 0x00,
+	// 716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcf, 0x6e, 0xfb, 0x44,
+	0x10, 0x8e, 0x9b, 0xfc, 0xda, 0x64, 0x52, 0xa9, 0xd1, 0x0a, 0x8a, 0x09, 0x6d, 0x08, 0x3e, 0xa0,
+	0x70, 0xc0, 0x91, 0xd2, 0x1e, 0x10, 0x12, 0x88, 0xfe, 0x09, 0xad, 0xa8, 0x82, 0x22, 0xb7, 0x17,
+	0x38, 0x20, 0x6d, 0x9c, 0x89, 0xb3, 0xd4, 0xde, 0x5d, 0xd6, 0x6b, 0x4b, 0x81, 0x0b, 0xe2, 0x65,
+	0x78, 0x15, 0x8e, 0xbc, 0x00, 0x12, 0xea, 0x6b, 0x70, 0x41, 0x5e, 0xdb, 0x89, 0xd5, 0x26, 0xed,
+	0xa9, 0xf3, 0xf7, 0xdb, 0x6f, 0xc6, 0x5f, 0x27, 0x70, 0xea, 0x47, 0x32, 0x46, 0x95, 0xa2, 0x1a,
+	0xca, 0x30, 0x09, 0x18, 0x2f, 0xfe, 0xb8, 0x52, 0x09, 0x2d, 0xc8, 0x7e, 0xee, 0x75, 0xc7, 0x01,
+	0xd3, 0xcb, 0x64, 0xe6, 0xfa, 0x22, 0x1a, 0x52, 0x15, 0x08, 0xa9, 0xc4, 0xcf, 0xc6, 0xf8, 0xdc,
+	0x9f, 0x0f, 0xd3, 0xb3, 0xa1, 0x42, 0x29, 0x0a, 0x18, 0x63, 0x32, 0x2d, 0xd4, 0xaa, 0x62, 0xe6,
+	0x70, 0xdd, 0x8f, 0x02, 0x21, 0x82, 0x10, 0x87, 0xc6, 0x9b, 0x25, 0x8b, 0x21, 0x46, 0x52, 0x17,
+	0x49, 0xe7, 0x77, 0x0b, 0x3a, 0x17, 0x52, 0xde, 0x6b, 0x85, 0x34, 0xf2, 0xf0, 0x97, 0x04, 0x63,
+	0x4d, 0xbe, 0x82, 0x66, 0x84, 0x9a, 0xce, 0xa9, 0xa6, 0xb6, 0xd5, 0xb7, 0x06, 0xed, 0xd1, 0xc7,
+	0x6e, 0xc1, 0x70, 0x42, 0x39, 0x5b, 0x60, 0xac, 0x8b, 0xd2, 0x49, 0x51, 0x76, 0x5b, 0xf3, 0xd6,
+	0x2d, 0xc4, 0x81, 0xc6, 0x82, 0x85, 0x68, 0xef, 0x99, 0xd6, 0xc3, 0xb2, 0xf5, 0x5b, 0x16, 0xe2,
+	0x6d, 0xcd, 0x33, 0xb9, 0xcb, 0x16, 0x1c, 0xa8, 0x1c, 0xc2, 0xf9, 0xd3, 0x82, 0x0f, 0x76, 0xc0,
+	0x12, 0x1b, 0x0e, 0xa8, 0x94, 0xdf, 0xd3, 0x08, 0x0d, 0x91, 0x96, 0x57, 0xba, 0xa4, 0x07, 0x40,
+	0xa5, 0xf4, 0x30, 0x9c, 0x52, 0xbd, 0x34, 0x4f, 0xb5, 0xbc, 0x4a, 0x84, 0x74, 0xa1, 0xe9, 0x2f,
+	0xd1, 0x7f, 0x8c, 0x93, 0xc8, 0xae, 0x9b, 0xec, 0xda, 0x27, 0x04, 0x1a, 0x31, 0xfb, 0x15, 0xed,
+	0x46, 0xdf, 0x1a, 0xd4, 0x3d, 0x63, 0x13, 0x07, 0xea, 0xc8, 0x53, 0xfb, 0x5d, 0xbf, 0x3e, 0x68,
+	0x8f, 0x3a, 0x25, 0xe7, 0x31, 0x4f, 0xc7, 0x5c, 0xab, 0x95, 0x97, 0x25, 0x9d, 0x73, 0x68, 0x96,
+	0x81, 0x0c, 0x83, 0x6f, 0x68, 0x19, 0x9b, 0xbc, 0x07, 0xef, 0x52, 0x1a, 0x26, 0x58, 0xd0, 0xc9,
+	0x1d, 0xe7, 0x1f, 0x0b, 0x3a, 0x9b, 0xf9, 0x62, 0x29, 0x78, 0x8c, 0xe4, 0x04, 0x5a, 0x51, 0x11,
+	0x8b, 0x6d, 0xab, 0x5f, 0x1f, 0xb4, 0xbc, 0x4d, 0x20, 0x1b, 0x2e, 0x16, 0x89, 0xf2, 0xf1, 0x61,
+	0x25, 0x4b, 0xb4, 0x4a, 0x84, 0xdc, 0x41, 0x5b, 0x0b, 0x11, 0x5e, 0xb3, 0xc0, 0xf4, 0xd7, 0x0d,
+	0xe9, 0xcf, 0x5e, 0x7e, 0xa3, 0xfc, 0x31, 0xf7, 0x61, 0x53, 0x9b, 0x4f, 0x53, 0xed, 0xee, 0x7e,
+	0x0d, 0x9d, 0xe7, 0x05, 0xa4, 0x03, 0xf5, 0x47, 0x5c, 0x15, 0xc3, 0x65, 0xe6, 0xf6, 0xd9, 0xbe,
+	0xdc, 0xfb, 0xc2, 0x72, 0x16, 0x40, 0xbc, 0xb5, 0xe6, 0xd6, 0x03, 0xf6, 0xa1, 0xcd, 0xe2, 0xfb,
+	0x44, 0x4a, 0xa1, 0x34, 0xce, 0x0d, 0x52, 0xd3, 0xab, 0x86, 0x88, 0x0b, 0x84, 0xc5, 0xd7, 0x2c,
+	0xf6, 0x45, 0x8a, 0x6a, 0x35, 0xe6, 0x74, 0x16, 0xe2, 0xdc, 0xc0, 0x37, 0xbd, 0x2d, 0x19, 0xe7,
+	0x37, 0xe8, 0x4d, 0xa9, 0xa2, 0x11, 0x6a, 0x54, 0xf1, 0x05, 0xe7, 0x22, 0xe1, 0x3e, 0x46, 0xc8,
+	0x37, 0x4b, 0xfd, 0x01, 0x8e, 0x65, 0x59, 0x51, 0x2d, 0xc8, 0x37, 0xdc, 0x1e, 0x7d, 0xe2, 0x56,
+	0xfe, 0x39, 0xa6, 0xdb, 0x2a, 0xbd, 0x1d, 0x00, 0xce, 0x09, 0x34, 0x32, 0xfd, 0x66, 0x6b, 0xf0,
+	0x97, 0x09, 0x7f, 0x34, 0x03, 0x1d, 0x7a, 0xb9, 0xe3, 0xfc, 0x61, 0x41, 0xff, 0x2a, 0x53, 0xd7,
+	0xd4, 0x7c, 0x81, 0x2b, 0xc1, 0x17, 0x2c, 0x48, 0x14, 0xd5, 0x4c, 0xf0, 0x35, 0xbb, 0x73, 0x78,
+	0xbf, 0x32, 0x55, 0x59, 0xb3, 0xde, 0xcd, 0xf6, 0x24, 0x19, 0xc0, 0x91, 0x54, 0x22, 0x65, 0x73,
+	0xbc, 0x61, 0xfa, 0x4a, 0xe1, 0x3c, 0x2e, 0x56, 0xf4, 0x3c, 0x3c, 0xfa, 0x6f, 0x0f, 0x4e, 0xf3,
+	0xc6, 0x09, 0xe5, 0x34, 0x30, 0xc4, 0x73, 0x3e, 0xf7, 0xa8, 0x52, 0xe6, 0x23, 0xf9, 0x0e, 0x3a,
+	0x37, 0xc8, 0x51, 0x51, 0x8d, 0xa5, 0x46, 0x88, 0x5d, 0xaa, 0xe6, 0xf9, 0x15, 0xe8, 0xda, 0xbb,
+	0xf4, 0xe4, 0xd4, 0x06, 0x16, 0xf9, 0x09, 0xec, 0x5d, 0x13, 0x93, 0x63, 0x37, 0x3f, 0x39, 0x6e,
+	0x79, 0x72, 0xdc, 0x71, 0x76, 0x72, 0xba, 0x83, 0x12, 0xf1, 0xad, 0x5d, 0x39, 0x35, 0x72, 0x07,
+	0x47, 0x13, 0xaa, 0xfd, 0xe5, 0x46, 0x5a, 0xaf, 0x50, 0xed, 0x96, 0x99, 0x97, 0x42, 0x34, 0x64,
+	0x29, 0x7c, 0x78, 0x83, 0x7a, 0xbb, 0x7a, 0x5e, 0x81, 0xfd, 0xb4, 0xcc, 0xbc, 0xae, 0xbb, 0xec,
+	0x89, 0xcb, 0x6f, 0xfe, 0x7a, 0xea, 0x59, 0x7f, 0x3f, 0xf5, 0xac, 0x7f, 0x9f, 0x7a, 0xd6, 0x8f,
+	0xa3, 0x37, 0x4e, 0xf7, 0xe6, 0x07, 0x80, 0x4a, 0xe6, 0x87, 0x0c, 0xb9, 0x9e, 0xed, 0x9b, 0x6d,
+	0x9d, 0xfd, 0x3f, 0x00, 0x95, 0x34, 0x27, 0x06, 0x1e, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1096,6 +1109,25 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ToolDigests) > 0 {
+		for k := range m.ToolDigests {
+			v := m.ToolDigests[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPlugin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPlugin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPlugin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.SourceType) > 0 {
 		i -= len(m.SourceType)
 		copy(dAtA[i:], m.SourceType)
@@ -1403,6 +1435,14 @@ func (m *ManifestResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlugin(uint64(l))
 	}
+	if len(m.ToolDigests) > 0 {
+		for k, v := range m.ToolDigests {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPlugin(uint64(len(k))) + 1 + len(v) + sovPlugin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPlugin(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2014,6 +2054,133 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.SourceType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToolDigests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ToolDigests == nil {
+				m.ToolDigests = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlugin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlugin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPlugin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPlugin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlugin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPlugin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPlugin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPlugin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPlugin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ToolDigests[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlugin(dAtA[iNdEx:])
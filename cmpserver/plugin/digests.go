@@ -0,0 +1,79 @@
+package plugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// binaryDigest is the digest of a binary, valid as long as the binary is not modified
+type binaryDigest struct {
+	modTime time.Time
+	size    int64
+	digest  string
+}
+
+// binaryDigestsCache caches the digests of the binaries of the commands, by path, as the binaries can be large
+var binaryDigestsCache sync.Map
+
+// commandDigests returns the SHA-256 digests of the binaries of the given commands, by path, so that the binaries
+// which generated the manifests can be audited. The binaries whose digest cannot be computed are left out.
+func commandDigests(commands ...Command) map[string]string {
+	digests := map[string]string{}
+	for _, command := range commands {
+		if len(command.Command) == 0 {
+			continue
+		}
+		path, err := exec.LookPath(command.Command[0])
+		if err != nil {
+			log.Warnf("Failed to find the binary of command %s: %v", command.Command[0], err)
+			continue
+		}
+		if path, err = filepath.Abs(path); err != nil {
+			log.Warnf("Failed to get the absolute path of binary %s: %v", command.Command[0], err)
+			continue
+		}
+		digest, err := fileDigest(path)
+		if err != nil {
+			log.Warnf("Failed to compute the digest of binary %s: %v", path, err)
+			continue
+		}
+		digests[path] = digest
+	}
+	return digests
+}
+
+// fileDigest returns the SHA-256 digest of a file, computing it again only if the file was modified
+func fileDigest(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if cached, ok := binaryDigestsCache.Load(path); ok {
+		if d := cached.(binaryDigest); d.modTime.Equal(info.ModTime()) && d.size == info.Size() {
+			return d.digest, nil
+		}
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer utilio.Close(f)
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("error reading %s: %w", path, err)
+	}
+	digest := "sha256:" + hex.EncodeToString(h.Sum(nil))
+	binaryDigestsCache.Store(path, binaryDigest{modTime: info.ModTime(), size: info.Size(), digest: digest})
+	return digest, nil
+}
@@ -273,7 +273,8 @@ func (s *Service) generateManifest(ctx context.Context, appDir string, envEntrie
 	}
 
 	return &apiclient.ManifestResponse{
-		Manifests: manifests,
+		Manifests:   manifests,
+		ToolDigests: commandDigests(config.Spec.Init, config.Spec.Generate),
 	}, err
 }
 
@@ -3,9 +3,11 @@ package plugin
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"path"
 	"path/filepath"
 	"testing"
@@ -316,6 +318,7 @@ func TestGenerateManifest(t *testing.T) {
 		if res1 != nil {
 			require.Equal(t, expectedOutput, res1.Manifests[0])
 		}
+		assert.Len(t, res1.ToolDigests, 1)
 	})
 	t.Run("bad generate command", func(t *testing.T) {
 		service, err := newService(configFilePath)
@@ -337,6 +340,26 @@ func TestGenerateManifest(t *testing.T) {
 	})
 }
 
+func TestCommandDigests(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	require.NoError(t, err)
+	sh, err = filepath.Abs(sh)
+	require.NoError(t, err)
+	data, err := os.ReadFile(sh)
+	require.NoError(t, err)
+
+	digests := commandDigests(Command{Command: []string{"sh", "-c"}}, Command{Command: []string{sh}}, Command{}, Command{Command: []string{"bad-command"}})
+	assert.Equal(t, map[string]string{sh: fmt.Sprintf("sha256:%x", sha256.Sum256(data))}, digests)
+
+	t.Run("Modified binary", func(t *testing.T) {
+		binary := filepath.Join(t.TempDir(), "plugin")
+		require.NoError(t, os.WriteFile(binary, []byte("v1"), 0o755))
+		assert.Equal(t, fmt.Sprintf("sha256:%x", sha256.Sum256([]byte("v1"))), commandDigests(Command{Command: []string{binary}})[binary])
+		require.NoError(t, os.WriteFile(binary, []byte("v2.0"), 0o755))
+		assert.Equal(t, fmt.Sprintf("sha256:%x", sha256.Sum256([]byte("v2.0"))), commandDigests(Command{Command: []string{binary}})[binary])
+	})
+}
+
 func TestGenerateManifest_deadline_exceeded(t *testing.T) {
 	configFilePath := "./testdata/kustomize/config"
 	service, err := newService(configFilePath)
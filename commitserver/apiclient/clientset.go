@@ -1,6 +1,7 @@
 package apiclient
 
 import (
+	"crypto/tls"
 	"fmt"
 	"math"
 
@@ -9,6 +10,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
@@ -23,22 +25,27 @@ type Clientset interface {
 }
 
 type clientSet struct {
-	address string
+	address   string
+	tlsConfig *tls.Config
 }
 
 // NewCommitServerClient creates new instance of commit server client
 func (c *clientSet) NewCommitServerClient() (utilio.Closer, CommitServiceClient, error) {
-	conn, err := NewConnection(c.address)
+	conn, err := NewConnection(c.address, c.tlsConfig)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to open a new connection to commit server: %w", err)
 	}
 	return conn, NewCommitServiceClient(conn), nil
 }
 
-// NewConnection creates new connection to commit server
-func NewConnection(address string) (*grpc.ClientConn, error) {
+// NewConnection creates new connection to commit server. The connection uses TLS if tlsConfig is not nil.
+func NewConnection(address string, tlsConfig *tls.Config) (*grpc.ClientConn, error) {
 	var opts []grpc.DialOption
-	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if tlsConfig != nil {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig.Clone())))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
 
 	// TODO: switch to grpc.NewClient.
 	//nolint:staticcheck
@@ -50,7 +57,8 @@ func NewConnection(address string) (*grpc.ClientConn, error) {
 	return conn, nil
 }
 
-// NewCommitServerClientset creates new instance of commit server Clientset
-func NewCommitServerClientset(address string) Clientset {
-	return &clientSet{address: address}
+// NewCommitServerClientset creates new instance of commit server Clientset. The connections use TLS if tlsConfig is not
+// nil.
+func NewCommitServerClientset(address string, tlsConfig *tls.Config) Clientset {
+	return &clientSet{address: address, tlsConfig: tlsConfig}
 }
@@ -1,7 +1,10 @@
 package commitserver
 
 import (
+	"crypto/tls"
+
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 
@@ -16,16 +19,22 @@ import (
 // ArgoCDCommitServer is the server that handles commit requests.
 type ArgoCDCommitServer struct {
 	commitService *commit.Service
+	tlsConfig     *tls.Config
 }
 
-// NewServer returns a new instance of the commit server.
-func NewServer(gitCredsStore git.CredsStore, metricsServer *metrics.Server) *ArgoCDCommitServer {
-	return &ArgoCDCommitServer{commitService: commit.NewService(gitCredsStore, metricsServer)}
+// NewServer returns a new instance of the commit server. The gRPC endpoint uses TLS if tlsConfig is not nil, e.g. the
+// mTLS config of the SPIFFE identities.
+func NewServer(gitCredsStore git.CredsStore, metricsServer *metrics.Server, tlsConfig *tls.Config) *ArgoCDCommitServer {
+	return &ArgoCDCommitServer{commitService: commit.NewService(gitCredsStore, metricsServer), tlsConfig: tlsConfig}
 }
 
 // CreateGRPC creates a new gRPC server.
 func (a *ArgoCDCommitServer) CreateGRPC() *grpc.Server {
-	server := grpc.NewServer(grpc.MaxRecvMsgSize(apiclient.MaxGRPCMessageSize))
+	opts := []grpc.ServerOption{grpc.MaxRecvMsgSize(apiclient.MaxGRPCMessageSize)}
+	if a.tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(a.tlsConfig)))
+	}
+	server := grpc.NewServer(opts...)
 	versionpkg.RegisterVersionServiceServer(server, version.NewServer(nil, func() (bool, error) {
 		return true, nil
 	}))
@@ -12,15 +12,17 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/errorcode"
 )
 
 // Component names
 const (
 	ApplicationController    = "argocd-application-controller"
 	ApplicationSetController = "argocd-applicationset-controller"
+	NotificationsController  = "argocd-notifications-controller"
 )
 
 // Default service addresses and URLS of Argo CD internal services
@@ -47,6 +49,9 @@ const (
 	// ArgoCDTLSCertsConfigMapName contains TLS certificate data for connecting repositories. Will get mounted as volume to pods
 	ArgoCDTLSCertsConfigMapName = "argocd-tls-certs-cm"
 	ArgoCDGPGKeysConfigMapName  = "argocd-gpg-keys-cm"
+	// ArgoCDParameterEncryptionSecretName contains the key pair sealing the application parameters. The private key gets
+	// mounted as volume to the repo server.
+	ArgoCDParameterEncryptionSecretName = "argocd-parameter-encryption"
 	// ArgoCDAppControllerShardConfigMapName contains the application controller to shard mapping
 	ArgoCDAppControllerShardConfigMapName = "argocd-app-controller-shard-cm"
 	ArgoCDCmdParamsConfigMapName          = "argocd-cmd-params-cm"
@@ -86,6 +91,9 @@ const (
 	DefaultPathTLSConfig = "/app/config/tls"
 	// DefaultPathSSHConfig is the default path where SSH known hosts are stored
 	DefaultPathSSHConfig = "/app/config/ssh"
+	// DefaultPathParameterDecryptionKeys is the default path where the private keys unsealing the application parameters
+	// are located
+	DefaultPathParameterDecryptionKeys = "/app/config/parameter-encryption"
 	// DefaultSSHKnownHostsName is the Default name for the SSH known hosts file
 	DefaultSSHKnownHostsName = "ssh_known_hosts"
 	// DefaultGnuPgHomePath is the Default path to GnuPG home directory
@@ -147,6 +155,19 @@ const (
 	LoginEndpoint = "/auth/login"
 	// LogoutEndpoint is Argo CD's shorthand logout endpoint which invalidates OIDC session after logout
 	LogoutEndpoint = "/auth/logout"
+	// TokenExchangeEndpoint is Argo CD's RFC 8693 token exchange endpoint which issues short-lived project role tokens
+	// in exchange for tokens of trusted workload identity issuers
+	TokenExchangeEndpoint = "/api/token-exchange"
+	// JWKSEndpoint is Argo CD's endpoint publishing the public keys of the token signing keys
+	JWKSEndpoint = "/.well-known/jwks.json"
+	// ParameterEncryptionKeyEndpoint is Argo CD's endpoint publishing the public keys sealing the application parameters
+	ParameterEncryptionKeyEndpoint = "/api/parameter-encryption-key"
+	// RepositoryAccessAuditEndpoint is Argo CD's endpoint serving the repository accesses recorded by the repo server
+	RepositoryAccessAuditEndpoint = "/api/repository-access-audit"
+	// DeploymentLogEndpoint is Argo CD's endpoint serving the deployments recorded in the revision history of the applications
+	DeploymentLogEndpoint = "/api/deployment-log"
+	// FederationApplicationsEndpoint is Argo CD's endpoint serving the applications of the peer Argo CD instances
+	FederationApplicationsEndpoint = "/api/federation/applications"
 	// CallbackEndpoint is Argo CD's final callback endpoint we reach after OAuth 2.0 login flow has been completed
 	CallbackEndpoint = "/auth/callback"
 	// DexCallbackEndpoint is Argo CD's final callback endpoint when Dex is configured
@@ -199,6 +220,19 @@ const (
 	// AnnotationClientSideApplyMigrationManager specifies a custom field manager for client-side apply migration
 	AnnotationClientSideApplyMigrationManager = "argocd.argoproj.io/client-side-apply-migration-manager"
 
+	// AnnotationServerSideApplyIgnoredConflicts is the comma separated list of field paths, e.g. .spec.replicas, of a resource
+	// which are not applied when they conflict with another field manager and the ServerSideApplyConflicts=ignore sync
+	// option is set
+	AnnotationServerSideApplyIgnoredConflicts = "argocd.argoproj.io/server-side-apply-ignored-conflicts"
+
+	// AnnotationHookTimeout is the duration, e.g. 5m, after which a running sync hook is considered failed
+	AnnotationHookTimeout = "argocd.argoproj.io/hook-timeout"
+	// AnnotationHookFailurePolicy specifies how the failure of a sync hook is handled: FailFast (the default), Continue
+	// or Retry=<limit>
+	AnnotationHookFailurePolicy = "argocd.argoproj.io/hook-failure-policy"
+	// AnnotationHookAttempt is the attempt number of a sync hook recreated by its Retry failure policy
+	AnnotationHookAttempt = "argocd.argoproj.io/hook-attempt"
+
 	// AnnotationIgnoreHealthCheck when set on an Application's immediate child indicates that its health check
 	// can be disregarded.
 	AnnotationIgnoreHealthCheck = "argocd.argoproj.io/ignore-healthcheck"
@@ -221,6 +255,20 @@ const (
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
+	// AnnotationKeyImageRegistryRewrites is the comma separated list of the images of a resource rewritten by the image
+	// registry rewrite rules of its project, in the original=rewritten format
+	AnnotationKeyImageRegistryRewrites = "argocd.argoproj.io/image-registry-rewrites"
+	// AnnotationKeyResourceInclusions is the list of resources watched in a cluster, overriding the resource.inclusions
+	// setting of argocd-cm when set on the cluster secret
+	AnnotationKeyResourceInclusions = "argocd.argoproj.io/resource-inclusions"
+	// AnnotationKeyResourceExclusions is the list of resources not watched in a cluster, overriding the
+	// resource.exclusions setting of argocd-cm when set on the cluster secret
+	AnnotationKeyResourceExclusions = "argocd.argoproj.io/resource-exclusions"
+	// AnnotationKeyMutatedBy is the comma separated list of the resource mutation webhooks which mutated a resource
+	AnnotationKeyMutatedBy = "argocd.argoproj.io/mutated-by"
+	// AnnotationKeyFieldManager is the name of the external manager, such as a Terraform provider, which owns an
+	// Application or an AppProject. Upserts requested by another field manager are rejected.
+	AnnotationKeyFieldManager = "argocd.argoproj.io/field-manager"
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -257,6 +305,8 @@ const (
 	EnvPauseGenerationMinutes = "ARGOCD_PAUSE_GEN_MINUTES"
 	// EnvPauseGenerationRequests pauses manifest generation for the specified number of requests, after sufficient manifest generation failures
 	EnvPauseGenerationRequests = "ARGOCD_PAUSE_GEN_REQUESTS"
+	// EnvManifestGenerationErrorCacheTTL is the duration during which the template errors of the manifest generation are cached
+	EnvManifestGenerationErrorCacheTTL = "ARGOCD_MANIFEST_GEN_ERROR_CACHE_TTL"
 	// EnvControllerReplicas is the number of controller replicas
 	EnvControllerReplicas = "ARGOCD_CONTROLLER_REPLICAS"
 	// EnvControllerHeartbeatTime will update the heartbeat for application controller to claim shard
@@ -406,6 +456,23 @@ func GetCMPWorkDir() string {
 const (
 	// AnnotationApplicationSetRefresh is an annotation that is added when an ApplicationSet is requested to be refreshed by a webhook. The ApplicationSet controller will remove this annotation at the end of reconciliation.
 	AnnotationApplicationSetRefresh = "argocd.argoproj.io/application-set-refresh"
+	// AnnotationApplicationSetAppliedValues is the JSON object of the values of the template last applied by the
+	// ApplicationSet controller to the fields of the ignoreApplicationDifferences rules preserving the user values of an
+	// application, by JSON pointer
+	AnnotationApplicationSetAppliedValues = "argocd.argoproj.io/application-set-applied-values"
+	// AnnotationKeyCAPICluster is the <namespace>/<name> of the Cluster API Cluster of a cluster secret. The cluster
+	// generator only generates parameters for the cluster once the Cluster API Cluster is provisioned, and adds the
+	// parameters of the Cluster API Cluster and of its MachineDeployments.
+	AnnotationKeyCAPICluster = "argocd.argoproj.io/capi-cluster"
+)
+
+const (
+	// AnnotationClusterSyncQPS is the annotation of a cluster secret limiting the rate of the creations, updates,
+	// patches and deletions of resources per second by the syncs to the cluster
+	AnnotationClusterSyncQPS = "argocd.argoproj.io/sync-qps"
+	// AnnotationClusterSyncBurst is the annotation of a cluster secret setting the burst of the
+	// AnnotationClusterSyncQPS rate limit. It defaults to the limit, rounded up.
+	AnnotationClusterSyncBurst = "argocd.argoproj.io/sync-burst"
 )
 
 // gRPC settings
@@ -449,7 +516,7 @@ const TokenVerificationError = "failed to verify the token"
 
 var ErrTokenVerification = errors.New(TokenVerificationError)
 
-var PermissionDeniedAPIError = status.Error(codes.PermissionDenied, "permission denied")
+var PermissionDeniedAPIError = errorcode.Error(codes.PermissionDenied, errorcode.PermissionDenied, "permission denied")
 
 // Redis password consts
 const (
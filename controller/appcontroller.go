@@ -42,6 +42,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
 
 	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/common"
@@ -80,6 +81,16 @@ const (
 	orphanedIndex = "orphaned"
 )
 
+const (
+	// appRefreshPriorityRequested is the priority of the refreshes requested by users or webhooks, which are processed first
+	appRefreshPriorityRequested = 10
+	// appRefreshPriorityChanged is the priority of the refreshes of the applications which recently changed
+	appRefreshPriorityChanged = 0
+	// appRefreshPriorityPeriodic is the priority of the periodic refreshes and of the refreshes of all the applications,
+	// e.g. after a restart, which are processed last
+	appRefreshPriorityPeriodic = -100
+)
+
 type CompareWith int
 
 const (
@@ -110,7 +121,7 @@ type ApplicationController struct {
 	applicationClientset appclientset.Interface
 	auditLogger          *argo.AuditLogger
 	// queue contains app namespace/name
-	appRefreshQueue workqueue.TypedRateLimitingInterface[string]
+	appRefreshQueue priorityqueue.PriorityQueue[string]
 	// queue contains app namespace/name/comparisonType and used to request app refresh with the predefined comparison type
 	appComparisonTypeRefreshQueue workqueue.TypedRateLimitingInterface[string]
 	appOperationQueue             workqueue.TypedRateLimitingInterface[string]
@@ -133,6 +144,9 @@ type ApplicationController struct {
 	settingsMgr                   *settings_util.SettingsManager
 	refreshRequestedApps          map[string]CompareWith
 	refreshRequestedAppsMutex     *sync.Mutex
+	resourceTreeUpdates           *resourceTreeUpdates
+	statusRetention               StatusRetention
+	syncParallelism               SyncParallelism
 	metricsServer                 *metrics.MetricsServer
 	metricsClusterLabels          []string
 	kubectlSemaphore              *semaphore.Weighted
@@ -148,6 +162,14 @@ type ApplicationController struct {
 	hydrator *hydrator.Hydrator
 }
 
+// newAppRefreshQueue returns the queue of the applications to refresh, which processes the applications with the highest
+// refresh priority first
+func newAppRefreshQueue(rateLimiterConfig *ratelimiter.AppControllerRateLimiterConfig) priorityqueue.PriorityQueue[string] {
+	return priorityqueue.New("app_reconciliation_queue", func(o *priorityqueue.Opts[string]) {
+		o.RateLimiter = ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig)
+	})
+}
+
 // NewApplicationController creates new instance of ApplicationController.
 func NewApplicationController(
 	namespace string,
@@ -181,6 +203,9 @@ func NewApplicationController(
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
 	enableK8sEvent []string,
 	hydratorEnabled bool,
+	dynamicClusterNamespaces bool,
+	statusRetention StatusRetention,
+	syncParallelism SyncParallelism,
 ) (*ApplicationController, error) {
 	log.Infof("appResyncPeriod=%v, appHardResyncPeriod=%v, appResyncJitter=%v", appResyncPeriod, appHardResyncPeriod, appResyncJitter)
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
@@ -194,7 +219,7 @@ func NewApplicationController(
 		kubeClientset:                     kubeClientset,
 		kubectl:                           kubectl,
 		applicationClientset:              applicationClientset,
-		appRefreshQueue:                   workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_reconciliation_queue"}),
+		appRefreshQueue:                   newAppRefreshQueue(rateLimiterConfig),
 		appOperationQueue:                 workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_operation_processing_queue"}),
 		projectRefreshQueue:               workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "project_reconciliation_queue"}),
 		appComparisonTypeRefreshQueue:     workqueue.NewTypedRateLimitingQueue(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig)),
@@ -206,6 +231,9 @@ func NewApplicationController(
 		statusRefreshJitter:               appResyncJitter,
 		refreshRequestedApps:              make(map[string]CompareWith),
 		refreshRequestedAppsMutex:         &sync.Mutex{},
+		resourceTreeUpdates:               newResourceTreeUpdates(),
+		statusRetention:                   statusRetention,
+		syncParallelism:                   syncParallelism,
 		auditLogger:                       argo.NewAuditLogger(kubeClientset, common.ApplicationController, enableK8sEvent),
 		settingsMgr:                       settingsMgr,
 		selfHealTimeout:                   selfHealTimeout,
@@ -306,7 +334,7 @@ func NewApplicationController(
 						}
 						key, err := cache.MetaNamespaceKeyFunc(app)
 						if err == nil {
-							ctrl.appRefreshQueue.AddRateLimited(key)
+							ctrl.appRefreshQueue.AddWithOpts(priorityqueue.AddOpts{After: ctrl.randomRefreshJitter(), Priority: appRefreshPriorityPeriodic}, key)
 							ctrl.clusterSharding.AddApp(app)
 						}
 					}
@@ -328,7 +356,7 @@ func NewApplicationController(
 			return nil, err
 		}
 	}
-	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
+	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking(), dynamicClusterNamespaces)
 	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
@@ -443,6 +471,8 @@ func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]b
 				}
 
 				managedByApp[app.InstanceName(ctrl.namespace)] = true
+				// orphaned resources are not tracked by the resource tree updates
+				ctrl.resourceTreeUpdates.requireRebuild(app.InstanceName(ctrl.namespace))
 			}
 		}
 	}
@@ -473,6 +503,9 @@ func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]b
 		level := ComparisonWithNothing
 		if isManagedResource {
 			level = CompareWithRecent
+		} else {
+			gv, _ := schema.ParseGroupVersion(ref.APIVersion)
+			ctrl.resourceTreeUpdates.add(appName, kube.NewResourceKey(gv.Group, ref.Kind, ref.Namespace, ref.Name))
 		}
 
 		namespace := ref.Namespace
@@ -510,6 +543,7 @@ func (ctrl *ApplicationController) setAppManagedResources(destCluster *appv1.Clu
 	if err != nil {
 		return nil, fmt.Errorf("error getting managed resources: %w", err)
 	}
+	generation := ctrl.resourceTreeUpdates.generation(a.InstanceName(ctrl.namespace))
 	tree, err := ctrl.getResourceTree(destCluster, a, managedResources)
 	ts.AddCheckpoint("get_resource_tree_ms")
 	if err != nil {
@@ -520,11 +554,19 @@ func (ctrl *ApplicationController) setAppManagedResources(destCluster *appv1.Clu
 	if err != nil {
 		return nil, fmt.Errorf("error setting app resource tree: %w", err)
 	}
+	ctrl.resourceTreeUpdates.setBuilt(a.InstanceName(ctrl.namespace), generation)
 	err = ctrl.cache.SetAppManagedResources(a.InstanceName(ctrl.namespace), managedResources)
 	ts.AddCheckpoint("set_app_managed_resources_ms")
 	if err != nil {
 		return nil, fmt.Errorf("error setting app managed resources: %w", err)
 	}
+	// the version allows the API server to reuse the managed resources as long as they match the application
+	if syncStatus := comparisonResult.GetSyncStatus(); syncStatus != nil {
+		err = ctrl.cache.SetAppManagedResourcesVersion(a.InstanceName(ctrl.namespace), appstatecache.NewManagedResourcesVersion(a.Generation, *syncStatus))
+		if err != nil {
+			return nil, fmt.Errorf("error setting app managed resources version: %w", err)
+		}
+	}
 	return tree, nil
 }
 
@@ -791,6 +833,11 @@ func (ctrl *ApplicationController) getAppHosts(destCluster *appv1.Cluster, a *ap
 }
 
 func (ctrl *ApplicationController) hideSecretData(destCluster *appv1.Cluster, app *appv1.Application, comparisonResult *comparisonResult) ([]*appv1.ResourceDiff, error) {
+	redactions, err := ctrl.settingsMgr.GetResourceRedactions()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource redactions: %w", err)
+	}
+	redactor := argodiff.NewRedactor(redactions)
 	items := make([]*appv1.ResourceDiff, len(comparisonResult.managedResources))
 	for i := range comparisonResult.managedResources {
 		res := comparisonResult.managedResources[i]
@@ -806,12 +853,16 @@ func (ctrl *ApplicationController) hideSecretData(destCluster *appv1.Cluster, ap
 		target := res.Target
 		live := res.Live
 		resDiff := res.Diff
-		if res.Kind == kube.SecretKind && res.Group == "" {
+		isSecret := res.Kind == kube.SecretKind && res.Group == ""
+		if isSecret || redactor.Matches(schema.GroupKind{Group: res.Group, Kind: res.Kind}) {
 			var err error
-			target, live, err = diff.HideSecretData(res.Target, res.Live, ctrl.settingsMgr.GetSensitiveAnnotations())
-			if err != nil {
-				return nil, fmt.Errorf("error hiding secret data: %w", err)
+			if isSecret {
+				target, live, err = diff.HideSecretData(res.Target, res.Live, ctrl.settingsMgr.GetSensitiveAnnotations())
+				if err != nil {
+					return nil, fmt.Errorf("error hiding secret data: %w", err)
+				}
 			}
+			target, live = redactor.Redact(target, live)
 			compareOptions, err := ctrl.settingsMgr.GetResourceCompareOptions()
 			if err != nil {
 				return nil, fmt.Errorf("error getting resource compare options: %w", err)
@@ -922,6 +973,7 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
 	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
+	go ctrl.watchAppRefreshHints(ctx)
 
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
@@ -947,6 +999,12 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 		}
 	}, time.Second, ctx.Done())
 
+	go wait.Until(ctrl.ensureConfigApplication, configApplicationResync, ctx.Done())
+
+	if ctrl.statusRetention.CompactionInterval > 0 {
+		go wait.Until(ctrl.compactAppStatuses, ctrl.statusRetention.CompactionInterval, ctx.Done())
+	}
+
 	if ctrl.hydrator != nil {
 		go wait.Until(func() {
 			for ctrl.processAppHydrateQueueItem() {
@@ -965,6 +1023,12 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 // requestAppRefresh adds a request for given app to the refresh queue. appName
 // needs to be the qualified name of the application, i.e. <namespace>/<name>.
 func (ctrl *ApplicationController) requestAppRefresh(appName string, compareWith *CompareWith, after *time.Duration) {
+	ctrl.requestAppRefreshWithPriority(appName, compareWith, after, appRefreshPriorityChanged)
+}
+
+// requestAppRefreshWithPriority adds a request for given app to the refresh queue, which is processed before the
+// requests of lower priority
+func (ctrl *ApplicationController) requestAppRefreshWithPriority(appName string, compareWith *CompareWith, after *time.Duration, priority int) {
 	key := ctrl.toAppKey(appName)
 
 	if compareWith != nil && after != nil {
@@ -976,13 +1040,37 @@ func (ctrl *ApplicationController) requestAppRefresh(appName string, compareWith
 			ctrl.refreshRequestedAppsMutex.Unlock()
 		}
 		if after != nil {
-			ctrl.appRefreshQueue.AddAfter(key, *after)
+			ctrl.appRefreshQueue.AddWithOpts(priorityqueue.AddOpts{After: *after, Priority: priority}, key)
 		} else {
-			ctrl.appRefreshQueue.AddRateLimited(key)
+			ctrl.appRefreshQueue.AddWithOpts(priorityqueue.AddOpts{RateLimited: true, Priority: priority}, key)
 		}
 	}
 }
 
+// watchAppRefreshHints refreshes the applications whose refresh is requested via Redis, e.g. by the webhook handler of
+// the API server, until the context is done
+func (ctrl *ApplicationController) watchAppRefreshHints(ctx context.Context) {
+	err := ctrl.cache.OnAppsRefreshRequested(ctx, func(appNames []string) error {
+		ctrl.handleAppRefreshHints(appNames)
+		return nil
+	})
+	if err != nil {
+		log.Warnf("Failed to watch the application refresh hints: %v", err)
+	}
+}
+
+// handleAppRefreshHints requests the refresh of the given applications which are managed by this controller shard
+func (ctrl *ApplicationController) handleAppRefreshHints(appNames []string) {
+	for _, appName := range appNames {
+		obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(ctrl.toAppKey(appName))
+		if err != nil || !exists || !ctrl.canProcessApp(obj) {
+			// the application is managed by another controller shard, or was deleted in the meantime
+			continue
+		}
+		ctrl.requestAppRefreshWithPriority(appName, CompareWithLatestForceResolve.Pointer(), nil, appRefreshPriorityRequested)
+	}
+}
+
 func (ctrl *ApplicationController) isRefreshRequested(appName string) (bool, CompareWith) {
 	ctrl.refreshRequestedAppsMutex.Lock()
 	defer ctrl.refreshRequestedAppsMutex.Unlock()
@@ -1317,9 +1405,14 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 			return err
 		}
 
+		if err := ctrl.cache.SetAppManagedResourcesVersion(app.Name, nil); err != nil {
+			return err
+		}
+
 		if err := ctrl.cache.SetAppResourcesTree(app.Name, nil); err != nil {
 			return err
 		}
+		ctrl.resourceTreeUpdates.requireRebuild(app.InstanceName(ctrl.namespace))
 		ctrl.projectRefreshQueue.Add(fmt.Sprintf("%s/%s", ctrl.namespace, app.Spec.GetProject()))
 	}
 
@@ -1442,6 +1535,17 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		state.Phase = synccommon.OperationError
 		state.Message = fmt.Sprintf("Failed to load application project: %v", err)
 	} else {
+		if !terminating {
+			if reason, position := ctrl.acquireSyncSlots(app); reason != "" {
+				logCtx.Infof("Delaying operation: %s", reason)
+				state.Message = "Operation is " + reason
+				state.QueuePosition = int64(position)
+				ctrl.setOperationState(app, state)
+				ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), syncSlotRetryInterval)
+				return
+			}
+			state.QueuePosition = 0
+		}
 		// Start or resume the sync
 		ctrl.appStateManager.SyncAppState(app, project, state)
 	}
@@ -1480,6 +1584,9 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 
 	ctrl.setOperationState(app, state)
 	ts.AddCheckpoint("final_set_operation_state")
+	if state.Phase.Completed() {
+		ctrl.releaseSyncSlots(app, ctrl.syncSlotScopes(app))
+	}
 	if state.Phase.Completed() && (app.Operation.Sync != nil && !app.Operation.Sync.DryRun) {
 		// if we just completed an operation, force a refresh so that UI will report up-to-date
 		// sync/health information
@@ -1529,6 +1636,13 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			return
 		}
 	}
+	if app.Status.OperationState != nil && app.Status.OperationState.QueuePosition != 0 && state.QueuePosition == 0 {
+		patchJSON, err = jsonpatch.MergeMergePatches(patchJSON, []byte(`{"status": {"operationState": {"queuePosition": null}}}`))
+		if err != nil {
+			logCtx.Errorf("error merging operation state patch: %v", err)
+			return
+		}
+	}
 
 	kube.RetryUntilSucceed(context.Background(), updateOperationStateTimeout, "Update application operation state", logutils.NewLogrusLogger(logutils.NewWithCurrentConfig()), func() error {
 		_, err := ctrl.PatchAppWithWriteBack(context.Background(), app.Name, app.Namespace, types.MergePatchType, patchJSON, metav1.PatchOptions{})
@@ -1564,7 +1678,7 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			eventInfo.Type = corev1.EventTypeWarning
 			messages = append(messages, "failed:", state.Message)
 		}
-		ctrl.logAppEvent(context.TODO(), app, eventInfo, strings.Join(messages, " "))
+		ctrl.logAppOperationEvent(context.TODO(), app, eventInfo, strings.Join(messages, " "))
 
 		destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db)
 		if err != nil {
@@ -1633,7 +1747,12 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return
 	}
 	origApp = origApp.DeepCopy()
-	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout, ctrl.statusHardRefreshTimeout)
+	refreshTimeout := ctrl.statusRefreshTimeout
+	if proj, err := ctrl.getAppProj(origApp); err == nil {
+		refreshTimeout = origApp.Spec.GetRefreshInterval(proj, ctrl.statusRefreshTimeout)
+	}
+	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, refreshTimeout, ctrl.statusHardRefreshTimeout)
+	ctrl.requeueAppRefresh(origApp, appKey, needRefresh, refreshTimeout)
 
 	if !needRefresh {
 		return
@@ -1675,12 +1794,15 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 			managedResources := make([]*appv1.ResourceDiff, 0)
 			if err := ctrl.cache.GetAppManagedResources(app.InstanceName(ctrl.namespace), &managedResources); err == nil {
 				var tree *appv1.ApplicationTree
-				if tree, err = ctrl.getResourceTree(destCluster, app, managedResources); err == nil {
+				generation := ctrl.resourceTreeUpdates.generation(app.InstanceName(ctrl.namespace))
+				if tree, err = ctrl.getUpdatedResourceTree(destCluster, app, managedResources); err == nil {
 					app.Status.Summary = tree.GetSummary(app)
 					if err := ctrl.cache.SetAppResourcesTree(app.InstanceName(ctrl.namespace), tree); err != nil {
+						ctrl.resourceTreeUpdates.requireRebuild(app.InstanceName(ctrl.namespace))
 						logCtx.Errorf("Failed to cache resources tree: %v", err)
 						return
 					}
+					ctrl.resourceTreeUpdates.setBuilt(app.InstanceName(ctrl.namespace), generation)
 				}
 
 				patchDuration = ctrl.persistAppStatus(origApp, &app.Status)
@@ -1699,12 +1821,16 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Health.Status = health.HealthStatusUnknown
 		patchDuration = ctrl.persistAppStatus(origApp, &app.Status)
 
+		ctrl.resourceTreeUpdates.requireRebuild(app.InstanceName(ctrl.namespace))
 		if err := ctrl.cache.SetAppResourcesTree(app.InstanceName(ctrl.namespace), &appv1.ApplicationTree{}); err != nil {
 			logCtx.Warnf("failed to set app resource tree: %v", err)
 		}
 		if err := ctrl.cache.SetAppManagedResources(app.InstanceName(ctrl.namespace), nil); err != nil {
 			logCtx.Warnf("failed to set app managed resources tree: %v", err)
 		}
+		if err := ctrl.cache.SetAppManagedResourcesVersion(app.InstanceName(ctrl.namespace), nil); err != nil {
+			logCtx.Warnf("failed to set app managed resources version: %v", err)
+		}
 		ts.AddCheckpoint("process_refresh_app_conditions_errors_ms")
 		return
 	}
@@ -1772,8 +1898,24 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
+	var freezeConditions []appv1.ApplicationCondition
+	freeze := app.Spec.Freeze
+	frozen := freeze.IsActive(now.Time)
+	if frozen {
+		freezeConditions = append(freezeConditions, appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionSyncFrozen,
+			Message: freeze.Message(),
+		})
+		if freeze.Until != nil {
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), ptr.To(time.Until(freeze.Until.Time)))
+		}
+	}
+	app.Status.SetConditions(freezeConditions, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncFrozen: true})
+
 	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false)
-	if canSync {
+	if frozen {
+		logCtx.Info("Sync prevented by freeze")
+	} else if canSync {
 		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionUpdated)
 		setOpDuration = opDuration
 		if syncErrCond != nil {
@@ -1792,6 +1934,18 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	}
 	ts.AddCheckpoint("auto_sync_ms")
 
+	var ssaConflictConditions []appv1.ApplicationCondition
+	if condition := ssaConflictCondition(app.Status.OperationState); condition != nil {
+		ssaConflictConditions = append(ssaConflictConditions, *condition)
+	}
+	app.Status.SetConditions(ssaConflictConditions, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionServerSideApplyConflict: true})
+
+	var configDriftConditions []appv1.ApplicationCondition
+	if condition := configDriftCondition(app, ctrl.namespace, compareResult.resources); condition != nil {
+		configDriftConditions = append(configDriftConditions, *condition)
+	}
+	app.Status.SetConditions(configDriftConditions, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionConfigurationDrift: true})
+
 	if app.Status.ReconciledAt == nil || comparisonLevel >= CompareWithLatest {
 		app.Status.ReconciledAt = &now
 	}
@@ -1900,6 +2054,25 @@ func currentSourceEqualsSyncedSource(app *appv1.Application) bool {
 	return source.Equals(&app.Status.Sync.ComparedTo.Source)
 }
 
+// randomRefreshJitter returns a random delay, bounded by the refresh jitter, used to spread the refreshes of many
+// applications over time
+func (ctrl *ApplicationController) randomRefreshJitter() time.Duration {
+	return time.Duration(float64(ctrl.statusRefreshJitter) * rand.Float64())
+}
+
+// requeueAppRefresh schedules the next refresh of an application whose refresh interval is shorter than the
+// instance-wide one, since the informer only resyncs the applications at the instance-wide interval
+func (ctrl *ApplicationController) requeueAppRefresh(app *appv1.Application, appKey string, refreshing bool, refreshTimeout time.Duration) {
+	if refreshTimeout <= 0 || (ctrl.statusRefreshTimeout > 0 && refreshTimeout >= ctrl.statusRefreshTimeout) {
+		return
+	}
+	delay := refreshTimeout
+	if !refreshing && app.Status.ReconciledAt != nil {
+		delay = time.Until(app.Status.ReconciledAt.Add(refreshTimeout))
+	}
+	ctrl.appRefreshQueue.AddWithOpts(priorityqueue.AddOpts{After: delay, Priority: appRefreshPriorityPeriodic}, appKey)
+}
+
 // needRefreshAppStatus answers if application status needs to be refreshed.
 // Returns true if application never been compared, has changed or comparison result has expired.
 // Additionally, it returns whether full refresh was requested or not.
@@ -2218,7 +2391,7 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		target = desiredCommitSHA
 	}
 	message := fmt.Sprintf("Initiated automated sync to '%s'", target)
-	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: corev1.EventTypeNormal}, message)
+	ctrl.logAppOperationEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: corev1.EventTypeNormal}, message)
 	logCtx.Info(message)
 	return nil, setOpTime
 }
@@ -2410,14 +2583,19 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 	)
 	lister := applisters.NewApplicationLister(informer.GetIndexer())
 	_, err := informer.AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc: func(obj any) {
+		cache.ResourceEventHandlerDetailedFuncs{
+			AddFunc: func(obj any, isInInitialList bool) {
 				if !ctrl.canProcessApp(obj) {
 					return
 				}
 				key, err := cache.MetaNamespaceKeyFunc(obj)
 				if err == nil {
-					ctrl.appRefreshQueue.AddRateLimited(key)
+					if isInInitialList {
+						// all the applications are listed after a restart, spread their refreshes to avoid spikes
+						ctrl.appRefreshQueue.AddWithOpts(priorityqueue.AddOpts{After: ctrl.randomRefreshJitter(), Priority: appRefreshPriorityPeriodic}, key)
+					} else {
+						ctrl.appRefreshQueue.AddRateLimited(key)
+					}
 				}
 				newApp, newOK := obj.(*appv1.Application)
 				if err == nil && newOK {
@@ -2436,6 +2614,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 
 				var compareWith *CompareWith
 				var delay *time.Duration
+				priority := appRefreshPriorityChanged
 
 				oldApp, oldOK := old.(*appv1.Application)
 				newApp, newOK := new.(*appv1.Application)
@@ -2444,14 +2623,19 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 						log.WithFields(applog.GetAppLogFields(newApp)).Info("Enabled automated sync")
 						compareWith = CompareWithLatest.Pointer()
 					}
-					if ctrl.statusRefreshJitter != 0 && oldApp.ResourceVersion == newApp.ResourceVersion {
-						// Handler is refreshing the apps, add a random jitter to spread the load and avoid spikes
-						jitter := time.Duration(float64(ctrl.statusRefreshJitter) * rand.Float64())
-						delay = &jitter
+					if oldApp.ResourceVersion == newApp.ResourceVersion {
+						priority = appRefreshPriorityPeriodic
+						if ctrl.statusRefreshJitter != 0 {
+							// Handler is refreshing the apps, add a random jitter to spread the load and avoid spikes
+							jitter := ctrl.randomRefreshJitter()
+							delay = &jitter
+						}
+					} else if _, ok := newApp.IsRefreshRequested(); ok {
+						priority = appRefreshPriorityRequested
 					}
 				}
 
-				ctrl.requestAppRefresh(newApp.QualifiedName(), compareWith, delay)
+				ctrl.requestAppRefreshWithPriority(newApp.QualifiedName(), compareWith, delay, priority)
 				if !newOK || (delay != nil && *delay != time.Duration(0)) {
 					ctrl.appOperationQueue.AddRateLimited(key)
 				}
@@ -2576,4 +2760,33 @@ func (ctrl *ApplicationController) logAppEvent(ctx context.Context, a *appv1.App
 	ctrl.auditLogger.LogAppEvent(a, eventInfo, message, "", eventLabels)
 }
 
+// logAppOperationEvent logs an event of the operation of the application, recording the service account impersonated
+// to sync it
+func (ctrl *ApplicationController) logAppOperationEvent(ctx context.Context, a *appv1.Application, eventInfo argo.EventInfo, message string) {
+	eventLabels := argo.GetAppEventLabels(ctx, a, applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()), ctrl.namespace, ctrl.settingsMgr, ctrl.db)
+	ctrl.auditLogger.LogAppEventAs(a, eventInfo, message, "", ctrl.impersonatedServiceAccount(ctx, a), eventLabels)
+}
+
+// impersonatedServiceAccount returns the service account impersonated to sync the application, or an empty string if
+// the sync impersonation is disabled or the service account cannot be determined
+func (ctrl *ApplicationController) impersonatedServiceAccount(ctx context.Context, a *appv1.Application) string {
+	impersonationEnabled, err := ctrl.settingsMgr.IsImpersonationEnabled()
+	if err != nil || !impersonationEnabled {
+		return ""
+	}
+	proj, err := ctrl.getAppProj(a)
+	if err != nil {
+		return ""
+	}
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, ctrl.db)
+	if err != nil {
+		return ""
+	}
+	serviceAccount, err := deriveServiceAccountToImpersonate(proj, a, destCluster)
+	if err != nil {
+		return ""
+	}
+	return serviceAccount
+}
+
 type ClusterFilterFunction func(c *appv1.Cluster, distributionFunction sharding.DistributionFunction) bool
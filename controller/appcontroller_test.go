@@ -69,6 +69,7 @@ type fakeData struct {
 	manifestResponse               *apiclient.ManifestResponse
 	manifestResponses              []*apiclient.ManifestResponse
 	managedLiveObjs                map[kube.ResourceKey]*unstructured.Unstructured
+	managedLiveObjsErr             error
 	namespacedResources            map[kube.ResourceKey]namespacedResource
 	configMapData                  map[string]string
 	metricsCacheExpiration         time.Duration
@@ -194,6 +195,9 @@ func newFakeControllerWithResync(data *fakeData, appResyncPeriod time.Duration,
 		normalizers.IgnoreNormalizerOpts{},
 		testEnableEventList,
 		false,
+		false,
+		StatusRetention{},
+		SyncParallelism{},
 	)
 	db := &dbmocks.ArgoDB{}
 	db.On("GetApplicationControllerReplicas").Return(1)
@@ -215,7 +219,7 @@ func newFakeControllerWithResync(data *fakeData, appResyncPeriod time.Duration,
 	ctrl.appStateManager.(*appStateManager).liveStateCache = &mockStateCache
 	ctrl.stateCache = &mockStateCache
 	mockStateCache.On("IsNamespaced", mock.Anything, mock.Anything).Return(true, nil)
-	mockStateCache.On("GetManagedLiveObjs", mock.Anything, mock.Anything, mock.Anything).Return(data.managedLiveObjs, nil)
+	mockStateCache.On("GetManagedLiveObjs", mock.Anything, mock.Anything, mock.Anything).Return(data.managedLiveObjs, data.managedLiveObjsErr)
 	mockStateCache.On("GetVersionsInfo", mock.Anything).Return("v1.2.3", nil, nil)
 	response := make(map[kube.ResourceKey]v1alpha1.ResourceNode)
 	for k, v := range data.namespacedResources {
@@ -1280,6 +1284,27 @@ func TestHandleAppUpdated(t *testing.T) {
 	assert.Equal(t, CompareWithRecent, level)
 }
 
+func TestHandleAppRefreshHints(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+	app.Spec.Destination.Server = v1alpha1.KubernetesInternalAPIServerAddr
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+
+	ctrl.handleAppRefreshHints([]string{app.QualifiedName(), "other-namespace/unknown"})
+	isRequested, level := ctrl.isRefreshRequested(app.QualifiedName())
+	assert.True(t, isRequested)
+	assert.Equal(t, CompareWithLatestForceResolve, level)
+	isRequested, _ = ctrl.isRefreshRequested("other-namespace/unknown")
+	assert.False(t, isRequested)
+
+	key, priority, shutdown := ctrl.appRefreshQueue.GetWithPriority()
+	require.False(t, shutdown)
+	assert.Equal(t, app.QualifiedName(), key)
+	assert.Equal(t, appRefreshPriorityRequested, priority)
+	ctrl.appRefreshQueue.Done(key)
+	assert.Equal(t, 0, ctrl.appRefreshQueue.Len())
+}
+
 func TestHandleOrphanedResourceUpdated(t *testing.T) {
 	app1 := newFakeApp()
 	app1.Name = "app1"
@@ -1948,6 +1973,30 @@ apps/Deployment:
 	}
 }
 
+func TestRequestAppRefreshPriority(t *testing.T) {
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}}, nil)
+	ctrl.requestAppRefreshWithPriority("periodic", nil, ptr.To(time.Duration(0)), appRefreshPriorityPeriodic)
+	ctrl.requestAppRefreshWithPriority("changed", nil, ptr.To(time.Duration(0)), appRefreshPriorityChanged)
+	ctrl.requestAppRefreshWithPriority("requested", nil, ptr.To(time.Duration(0)), appRefreshPriorityRequested)
+	// a lower priority request does not lower the priority of a queued application
+	ctrl.requestAppRefreshWithPriority("requested", nil, ptr.To(time.Duration(0)), appRefreshPriorityPeriodic)
+
+	for _, expected := range []struct {
+		name     string
+		priority int
+	}{
+		{"requested", appRefreshPriorityRequested},
+		{"changed", appRefreshPriorityChanged},
+		{"periodic", appRefreshPriorityPeriodic},
+	} {
+		key, priority, shutdown := ctrl.appRefreshQueue.GetWithPriority()
+		require.False(t, shutdown)
+		assert.Equal(t, test.FakeArgoCDNamespace+"/"+expected.name, key)
+		assert.Equal(t, expected.priority, priority)
+		ctrl.appRefreshQueue.Done(key)
+	}
+}
+
 func TestProjectErrorToCondition(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.Project = "wrong project"
@@ -2197,6 +2246,96 @@ func TestProcessRequestedAppOperation_Successful(t *testing.T) {
 	assert.Equal(t, CompareWithLatestForceResolve, level)
 }
 
+func TestProcessRequestedAppOperation_SyncParallelism(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "default"
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponses: []*apiclient.ManifestResponse{{
+			Manifests: []string{},
+		}},
+	}, nil)
+	ctrl.syncParallelism = SyncParallelism{PerProject: 1}
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	// the only sync slot of the project is held by another application
+	acquired, err := ctrl.cache.AcquireSyncSlot("project|default", 1, "argocd/other-app", time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired)
+	ctrl.processRequestedAppOperation(app)
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationRunning), phase)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, "Operation is waiting for one of the 1 in-flight syncs of project default to complete", message)
+
+	// the sync starts once the slot is released, and releases the slot when it completes
+	require.NoError(t, ctrl.cache.ReleaseSyncSlot("project|default", 1, "argocd/other-app"))
+	app.Status.OperationState = nil
+	ctrl.processRequestedAppOperation(app)
+	phase, _, _ = unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationSucceeded), phase)
+	acquired, err = ctrl.cache.AcquireSyncSlot("project|default", 1, "argocd/other-app", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+}
+
+func TestProcessRequestedAppOperation_ConcurrencyKey(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{ConcurrencyKey: "db"}
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponses: []*apiclient.ManifestResponse{{
+			Manifests: []string{},
+		}},
+	}, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	// another application of the concurrency key is syncing, and a third one is queued first
+	acquired, _, err := ctrl.cache.AcquireQueuedSyncSlot("concurrency|db", "argocd/syncing-app", time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired)
+	_, _, err = ctrl.cache.AcquireQueuedSyncSlot("concurrency|db", "argocd/queued-app", time.Minute)
+	require.NoError(t, err)
+	ctrl.processRequestedAppOperation(app)
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationRunning), phase)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, "Operation is waiting for the in-flight sync of concurrency key db to complete (position 2 in the queue)", message)
+	position, _, _ := unstructured.NestedFieldNoCopy(receivedPatch, "status", "operationState", "queuePosition")
+	assert.InDelta(t, 2, position, 0)
+
+	// the sync starts once the applications ahead completed their syncs, and releases the key when it completes
+	require.NoError(t, ctrl.cache.ReleaseSyncSlot("concurrency|db", 1, "argocd/syncing-app"))
+	require.NoError(t, ctrl.cache.LeaveSyncQueue("concurrency|db", "argocd/queued-app"))
+	app.Status.OperationState = nil
+	ctrl.processRequestedAppOperation(app)
+	phase, _, _ = unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationSucceeded), phase)
+	acquired, _, err = ctrl.cache.AcquireQueuedSyncSlot("concurrency|db", "argocd/other-app", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+}
+
 func TestGetAppHosts(t *testing.T) {
 	app := newFakeApp()
 	data := &fakeData{
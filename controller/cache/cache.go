@@ -29,6 +29,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
 	"github.com/argoproj/argo-cd/v3/controller/sharding"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
@@ -75,6 +76,9 @@ const (
 	// EnvClusterCacheEventsProcessingInterval is the env variable to control the interval between processing events when BatchEventsProcessing is enabled
 	EnvClusterCacheEventsProcessingInterval = "ARGOCD_CLUSTER_CACHE_EVENTS_PROCESSING_INTERVAL"
 
+	// EnvClusterCachePrimingParallelism is the env variable to control the number of cluster caches synchronized concurrently for the first time
+	EnvClusterCachePrimingParallelism = "ARGOCD_CLUSTER_CACHE_PRIMING_PARALLELISM"
+
 	// AnnotationIgnoreResourceUpdates when set to true on an untracked resource,
 	// argo will apply `ignoreResourceUpdates` configuration on it.
 	AnnotationIgnoreResourceUpdates = "argocd.argoproj.io/ignore-resource-updates"
@@ -115,6 +119,10 @@ var (
 
 	// clusterCacheEventsProcessingInterval specifies the interval between processing events when BatchEventsProcessing is enabled
 	clusterCacheEventsProcessingInterval = 100 * time.Millisecond
+
+	// clusterCachePrimingParallelism limits the number of cluster caches synchronized concurrently for the first time,
+	// e.g. on the start of the controller. If set to 0, the number is not limited.
+	clusterCachePrimingParallelism int64 = 10
 )
 
 func init() {
@@ -128,6 +136,7 @@ func init() {
 	clusterCacheRetryUseBackoff = env.ParseBoolFromEnv(EnvClusterCacheRetryUseBackoff, false)
 	clusterCacheBatchEventsProcessing = env.ParseBoolFromEnv(EnvClusterCacheBatchEventsProcessing, true)
 	clusterCacheEventsProcessingInterval = env.ParseDurationFromEnv(EnvClusterCacheEventsProcessingInterval, clusterCacheEventsProcessingInterval, 0, math.MaxInt64)
+	clusterCachePrimingParallelism = env.ParseInt64FromEnv(EnvClusterCachePrimingParallelism, clusterCachePrimingParallelism, 0, math.MaxInt64)
 }
 
 type LiveStateCache interface {
@@ -145,7 +154,7 @@ type LiveStateCache interface {
 	GetManagedLiveObjs(destCluster *appv1.Cluster, a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error)
 	// IterateResources iterates all resource stored in cache
 	IterateResources(server *appv1.Cluster, callback func(res *clustercache.Resource, info *ResourceInfo)) error
-	// Returns all top level resources (resources without owner references) of a specified namespace
+	// Returns all top level resources (resources without owner references nor existing label owners) of a specified namespace
 	GetNamespaceTopLevelResources(server *appv1.Cluster, namespace string) (map[kube.ResourceKey]appv1.ResourceNode, error)
 	// Starts watching resources of each controlled cluster.
 	Run(ctx context.Context) error
@@ -157,6 +166,21 @@ type LiveStateCache interface {
 	UpdateShard(shard int) bool
 }
 
+// ClusterUnreachableError is the error of the caches of the clusters which failed to synchronize because the cluster
+// is unreachable
+type ClusterUnreachableError struct {
+	Server string
+	Err    error
+}
+
+func (e *ClusterUnreachableError) Error() string {
+	return fmt.Sprintf("cluster %s is unreachable: %v", e.Server, e.Err)
+}
+
+func (e *ClusterUnreachableError) Unwrap() error {
+	return e.Err
+}
+
 type ObjectUpdatedHandler = func(managedByApp map[string]bool, ref corev1.ObjectReference)
 
 type PodInfo struct {
@@ -166,10 +190,18 @@ type PodInfo struct {
 }
 
 type NodeInfo struct {
-	Name       string
-	Capacity   corev1.ResourceList
-	SystemInfo corev1.NodeSystemInfo
-	Labels     map[string]string
+	Name        string
+	Capacity    corev1.ResourceList
+	Allocatable corev1.ResourceList
+	SystemInfo  corev1.NodeSystemInfo
+	Labels      map[string]string
+	// Unschedulable is true if the node is cordoned
+	Unschedulable bool
+}
+
+type ResourceQuotaInfo struct {
+	Hard corev1.ResourceList
+	Used corev1.ResourceList
 }
 
 type ResourceInfo struct {
@@ -183,8 +215,12 @@ type ResourceInfo struct {
 	PodInfo *PodInfo
 	// NodeInfo is available for nodes only
 	NodeInfo *NodeInfo
+	// ResourceQuotaInfo is available for resource quotas only
+	ResourceQuotaInfo *ResourceQuotaInfo
 
 	manifestHash string
+	// labelOwnerRefs are the owners the resource refers to with the labels of the resource owner references
+	labelOwnerRefs []labelOwnerRef
 }
 
 func NewLiveStateCache(
@@ -195,16 +231,21 @@ func NewLiveStateCache(
 	onObjectUpdated ObjectUpdatedHandler,
 	clusterSharding sharding.ClusterShardingCache,
 	resourceTracking argo.ResourceTracking,
+	dynamicClusterNamespaces bool,
 ) LiveStateCache {
 	return &liveStateCache{
-		appInformer:      appInformer,
-		db:               db,
-		clusters:         make(map[string]clustercache.ClusterCache),
-		onObjectUpdated:  onObjectUpdated,
-		settingsMgr:      settingsMgr,
-		metricsServer:    metricsServer,
-		clusterSharding:  clusterSharding,
-		resourceTracking: resourceTracking,
+		appInformer:              appInformer,
+		db:                       db,
+		clusters:                 make(map[string]clustercache.ClusterCache),
+		clusterNamespaces:        make(map[string][]string),
+		onObjectUpdated:          onObjectUpdated,
+		settingsMgr:              settingsMgr,
+		metricsServer:            metricsServer,
+		connectivity:             metrics.NewClusterConnectivityTracker(metricsServer),
+		primer:                   newClusterCachePrimer(metricsServer, clusterCachePrimingParallelism),
+		clusterSharding:          clusterSharding,
+		resourceTracking:         resourceTracking,
+		dynamicClusterNamespaces: dynamicClusterNamespaces,
 	}
 }
 
@@ -218,6 +259,12 @@ type cacheSettings struct {
 
 	// ignoreResourceUpdates is a flag to enable resource-ignore rules.
 	ignoreResourceUpdatesEnabled bool
+
+	// resourcesFilter is the resources filter of argocd-cm, which the annotations of the cluster secrets may override
+	resourcesFilter *settings.ResourcesFilter
+
+	// resourceOwnerReferences are the labels referring to the owners of the resources created by external controllers
+	resourceOwnerReferences []settings.ResourceOwnerReference
 }
 
 type liveStateCache struct {
@@ -226,13 +273,19 @@ type liveStateCache struct {
 	onObjectUpdated      ObjectUpdatedHandler
 	settingsMgr          *settings.SettingsManager
 	metricsServer        *metrics.MetricsServer
+	connectivity         *metrics.ClusterConnectivityTracker
+	primer               *clusterCachePrimer
 	clusterSharding      sharding.ClusterShardingCache
 	resourceTracking     argo.ResourceTracking
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
-
-	clusters      map[string]clustercache.ClusterCache
-	cacheSettings cacheSettings
-	lock          sync.RWMutex
+	// dynamicClusterNamespaces restricts the cluster caches to the namespaces managed by the applications
+	dynamicClusterNamespaces bool
+
+	clusters map[string]clustercache.ClusterCache
+	// clusterNamespaces are the namespaces watched by the cluster caches
+	clusterNamespaces map[string][]string
+	cacheSettings     cacheSettings
+	lock              sync.RWMutex
 }
 
 func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
@@ -264,12 +317,16 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 	if err != nil {
 		return nil, err
 	}
+	resourceOwnerReferences, err := c.settingsMgr.GetResourceOwnerReferences()
+	if err != nil {
+		return nil, err
+	}
 	clusterSettings := clustercache.Settings{
 		ResourceHealthOverride: lua.ResourceHealthOverrides(resourceOverrides),
 		ResourcesFilter:        resourcesFilter,
 	}
 
-	return &cacheSettings{clusterSettings, appInstanceLabelKey, appv1.TrackingMethod(trackingMethod), installationID, resourceUpdatesOverrides, ignoreResourceUpdatesEnabled}, nil
+	return &cacheSettings{clusterSettings, appInstanceLabelKey, appv1.TrackingMethod(trackingMethod), installationID, resourceUpdatesOverrides, ignoreResourceUpdatesEnabled, resourcesFilter, resourceOwnerReferences}, nil
 }
 
 func asResourceNode(r *clustercache.Resource) appv1.ResourceNode {
@@ -476,6 +533,21 @@ func isTransientNetworkErr(err error) bool {
 	return false
 }
 
+// clusterCacheSettings returns the settings of the cache of the given cluster, with the resources filter overridden by
+// the annotations of the cluster secret
+func clusterCacheSettings(cacheSettings cacheSettings, cluster *appv1.Cluster) (clustercache.Settings, error) {
+	clusterSettings := cacheSettings.clusterSettings
+	if cacheSettings.resourcesFilter == nil {
+		return clusterSettings, nil
+	}
+	resourcesFilter, err := cacheSettings.resourcesFilter.ForCluster(cluster)
+	if err != nil {
+		return clusterSettings, err
+	}
+	clusterSettings.ResourcesFilter = resourcesFilter
+	return clusterSettings, nil
+}
+
 func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.ClusterCache, error) {
 	c.lock.RLock()
 	clusterCache, ok := c.clusters[cluster.Server]
@@ -512,10 +584,16 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		return nil, fmt.Errorf("error getting value for %v: %w", settings.RespectRBAC, err)
 	}
 
+	clusterSettings, err := clusterCacheSettings(cacheSettings, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cluster resources filter: %w", err)
+	}
+
 	clusterCacheConfig, err := cluster.RESTConfig()
 	if err != nil {
 		return nil, fmt.Errorf("error getting cluster RESTConfig: %w", err)
 	}
+	clusterCacheConfig = c.primer.wrapConfig(cluster.Server, c.connectivity.WrapConfig(cluster.Server, clusterCacheConfig))
 	// Controller dynamically fetches all resource types available on the cluster
 	// using a discovery API that may contain deprecated APIs.
 	// This causes log flooding when managing a large number of clusters.
@@ -529,6 +607,9 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		clusterCacheConfig.WarningHandler = rest.NoWarnings{}
 	}
 
+	namespaces := c.clusterCacheNamespaces(cluster)
+	c.setClusterNamespaces(cluster.Server, namespaces)
+
 	clusterCacheOpts := []clustercache.UpdateSettingsFunc{
 		clustercache.SetListSemaphore(semaphore.NewWeighted(clusterCacheListSemaphoreSize)),
 		clustercache.SetListPageSize(clusterCacheListPageSize),
@@ -536,10 +617,12 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		clustercache.SetWatchResyncTimeout(clusterCacheWatchResyncDuration),
 		clustercache.SetClusterSyncRetryTimeout(clusterSyncRetryTimeoutDuration),
 		clustercache.SetResyncTimeout(clusterCacheResyncDuration),
-		clustercache.SetSettings(cacheSettings.clusterSettings),
-		clustercache.SetNamespaces(cluster.Namespaces),
+		clustercache.SetSettings(clusterSettings),
+		clustercache.SetNamespaces(namespaces),
+		clustercache.SetKubectl(c.clusterKubectl(cluster.Server, namespaces)),
 		clustercache.SetClusterResources(cluster.ClusterResources),
 		clustercache.SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, isRoot bool) (any, bool) {
+			c.primer.resourceListed(cluster.Server)
 			res := &ResourceInfo{}
 			populateNodeInfo(un, res, resourceCustomLabels)
 			c.lock.RLock()
@@ -547,6 +630,7 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 			c.lock.RUnlock()
 
 			res.Health, _ = health.GetResourceHealth(un, cacheSettings.clusterSettings.ResourceHealthOverride)
+			res.labelOwnerRefs = getLabelOwnerRefs(un, cacheSettings.resourceOwnerReferences)
 
 			appName := c.resourceTracking.GetAppName(un, cacheSettings.appInstanceLabelKey, cacheSettings.trackingMethod, cacheSettings.installationID)
 			if isRoot && appName != "" {
@@ -641,9 +725,13 @@ func (c *liveStateCache) getSyncedCluster(server *appv1.Cluster) (clustercache.C
 	if err != nil {
 		return nil, fmt.Errorf("error getting cluster: %w", err)
 	}
-	err = clusterCache.EnsureSynced()
+	err = c.primer.ensureSynced(server.Server, clusterCache)
 	if err != nil {
-		return nil, fmt.Errorf("error synchronizing cache state : %w", err)
+		err = fmt.Errorf("error synchronizing cache state : %w", err)
+		if c.connectivity.GetClusterConnectivity(server.Server).Unreachable {
+			return nil, &ClusterUnreachableError{Server: server.Server, Err: err}
+		}
+		return nil, err
 	}
 	return clusterCache, nil
 }
@@ -655,8 +743,15 @@ func (c *liveStateCache) invalidate(cacheSettings cacheSettings) {
 	clusters := c.clusters
 	c.lock.Unlock()
 
-	for _, clust := range clusters {
-		clust.Invalidate(clustercache.SetSettings(cacheSettings.clusterSettings))
+	for server, clust := range clusters {
+		clusterSettings := cacheSettings.clusterSettings
+		if cluster, err := c.db.GetCluster(context.Background(), server); err == nil {
+			if clusterSettings, err = clusterCacheSettings(cacheSettings, cluster); err != nil {
+				log.Errorf("error getting resources filter of cluster %s, using the resources filter of the settings: %v", server, err)
+				clusterSettings = cacheSettings.clusterSettings
+			}
+		}
+		clust.Invalidate(clustercache.SetSettings(clusterSettings))
 	}
 	log.Info("live state cache invalidated")
 }
@@ -711,8 +806,12 @@ func (c *liveStateCache) GetNamespaceTopLevelResources(server *appv1.Cluster, na
 		return nil, err
 	}
 	resources := clusterInfo.FindResources(namespace, clustercache.TopLevelResource)
+	owners := newLabelOwnerIndex(clusterInfo, resources)
 	res := make(map[kube.ResourceKey]appv1.ResourceNode)
 	for k, r := range resources {
+		if owners.hasLabelOwner(r) {
+			continue
+		}
 		res[k] = asResourceNode(r)
 	}
 	return res, nil
@@ -800,6 +899,12 @@ func (c *liveStateCache) Init() error {
 func (c *liveStateCache) Run(ctx context.Context) error {
 	go c.watchSettings(ctx)
 
+	if c.dynamicClusterNamespaces && c.appInformer != nil {
+		if _, err := c.appInformer.AddEventHandler(c.appEventHandler()); err != nil {
+			return fmt.Errorf("error adding application event handler: %w", err)
+		}
+	}
+
 	kube.RetryUntilSucceed(ctx, clustercache.ClusterRetryTimeout, "watch clusters", logutils.NewLogrusLogger(logutils.NewWithCurrentConfig()), func() error {
 		return c.db.WatchClusters(ctx, c.handleAddEvent, c.handleModEvent, c.handleDeleteEvent)
 	})
@@ -847,7 +952,9 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 			cluster.Invalidate()
 			c.lock.Lock()
 			delete(c.clusters, newCluster.Server)
+			delete(c.clusterNamespaces, newCluster.Server)
 			c.lock.Unlock()
+			c.primer.forget(newCluster.Server)
 			return
 		}
 
@@ -855,17 +962,33 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 		if !reflect.DeepEqual(oldCluster.Config, newCluster.Config) {
 			newClusterRESTConfig, err := newCluster.RESTConfig()
 			if err == nil {
-				updateSettings = append(updateSettings, clustercache.SetConfig(newClusterRESTConfig))
+				updateSettings = append(updateSettings, clustercache.SetConfig(c.primer.wrapConfig(newCluster.Server, c.connectivity.WrapConfig(newCluster.Server, newClusterRESTConfig))))
 			} else {
 				log.Errorf("error getting cluster REST config: %v", err)
 			}
 		}
 		if !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) {
-			updateSettings = append(updateSettings, clustercache.SetNamespaces(newCluster.Namespaces))
+			namespaces := c.clusterCacheNamespaces(newCluster)
+			c.lock.Lock()
+			c.setClusterNamespaces(newCluster.Server, namespaces)
+			c.lock.Unlock()
+			updateSettings = append(updateSettings, clustercache.SetNamespaces(namespaces), clustercache.SetKubectl(c.clusterKubectl(newCluster.Server, namespaces)))
 		}
 		if !reflect.DeepEqual(oldCluster.ClusterResources, newCluster.ClusterResources) {
 			updateSettings = append(updateSettings, clustercache.SetClusterResources(newCluster.ClusterResources))
 		}
+		if oldCluster.Annotations[common.AnnotationKeyResourceInclusions] != newCluster.Annotations[common.AnnotationKeyResourceInclusions] ||
+			oldCluster.Annotations[common.AnnotationKeyResourceExclusions] != newCluster.Annotations[common.AnnotationKeyResourceExclusions] {
+			c.lock.RLock()
+			cacheSettings := c.cacheSettings
+			c.lock.RUnlock()
+			clusterSettings, err := clusterCacheSettings(cacheSettings, newCluster)
+			if err == nil {
+				updateSettings = append(updateSettings, clustercache.SetSettings(clusterSettings))
+			} else {
+				log.Errorf("error getting cluster resources filter: %v", err)
+			}
+		}
 		forceInvalidate := false
 		if newCluster.RefreshRequestedAt != nil &&
 			cluster.GetClusterInfo().LastCacheSyncTime != nil &&
@@ -892,7 +1015,10 @@ func (c *liveStateCache) handleDeleteEvent(clusterServer string) {
 		cluster.Invalidate()
 		c.lock.Lock()
 		delete(c.clusters, clusterServer)
+		delete(c.clusterNamespaces, clusterServer)
 		c.lock.Unlock()
+		c.connectivity.Forget(clusterServer)
+		c.primer.forget(clusterServer)
 	}
 }
 
@@ -905,14 +1031,24 @@ func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
 	c.lock.RUnlock()
 
 	res := make([]clustercache.ClusterInfo, 0)
-	for server, c := range clusters {
-		info := c.GetClusterInfo()
+	for server, clusterCache := range clusters {
+		// the caches being primed are locked until they are synced, so their progress is reported instead
+		if apis, resources, ok := c.primer.getProgress(server); ok {
+			res = append(res, clustercache.ClusterInfo{Server: server, APIsCount: apis, ResourcesCount: resources})
+			continue
+		}
+		info := clusterCache.GetClusterInfo()
 		info.Server = server
 		res = append(res, info)
 	}
 	return res
 }
 
+// GetClusterConnectivity returns the connectivity of the cache of the given cluster to the cluster
+func (c *liveStateCache) GetClusterConnectivity(server string) metrics.ClusterConnectivity {
+	return c.connectivity.GetClusterConnectivity(server)
+}
+
 func (c *liveStateCache) GetClusterCache(server *appv1.Cluster) (clustercache.ClusterCache, error) {
 	return c.getSyncedCluster(server)
 }
@@ -72,7 +72,7 @@ func fixtures(data map[string]string, opts ...func(secret *corev1.Secret)) (*fak
 
 func TestHandleModEvent_HasChanges(_ *testing.T) {
 	clusterCache := &mocks.ClusterCache{}
-	clusterCache.On("Invalidate", mock.Anything, mock.Anything).Return(nil).Once()
+	clusterCache.On("Invalidate", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	clusterCache.On("EnsureSynced").Return(nil).Once()
 	db := &dbmocks.ArgoDB{}
 	db.On("GetApplicationControllerReplicas").Return(1)
@@ -95,7 +95,7 @@ func TestHandleModEvent_HasChanges(_ *testing.T) {
 
 func TestHandleModEvent_ClusterExcluded(t *testing.T) {
 	clusterCache := &mocks.ClusterCache{}
-	clusterCache.On("Invalidate", mock.Anything, mock.Anything).Return(nil).Once()
+	clusterCache.On("Invalidate", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	clusterCache.On("EnsureSynced").Return(nil).Once()
 	db := &dbmocks.ArgoDB{}
 	db.On("GetApplicationControllerReplicas").Return(1)
@@ -148,6 +148,52 @@ func TestHandleModEvent_NoChanges(_ *testing.T) {
 	})
 }
 
+func TestClusterCacheSettings(t *testing.T) {
+	resourcesFilter := &argosettings.ResourcesFilter{ResourceExclusions: []argosettings.FilteredResource{{APIGroups: []string{"noisy.example.com"}}}}
+	cacheSettings := cacheSettings{clusterSettings: cache.Settings{ResourcesFilter: resourcesFilter}, resourcesFilter: resourcesFilter}
+
+	clusterSettings, err := clusterCacheSettings(cacheSettings, &appv1.Cluster{Server: "https://mycluster"})
+	require.NoError(t, err)
+	assert.Same(t, resourcesFilter, clusterSettings.ResourcesFilter)
+
+	clusterSettings, err = clusterCacheSettings(cacheSettings, &appv1.Cluster{
+		Server:      "https://mycluster",
+		Annotations: map[string]string{common.AnnotationKeyResourceExclusions: "[]"},
+	})
+	require.NoError(t, err)
+	assert.False(t, clusterSettings.ResourcesFilter.IsExcludedResource("noisy.example.com", "Widget", "https://mycluster"))
+	assert.True(t, cacheSettings.clusterSettings.ResourcesFilter.IsExcludedResource("noisy.example.com", "Widget", "https://mycluster"))
+
+	_, err = clusterCacheSettings(cacheSettings, &appv1.Cluster{
+		Server:      "https://mycluster",
+		Annotations: map[string]string{common.AnnotationKeyResourceExclusions: "invalid"},
+	})
+	assert.Error(t, err)
+}
+
+func TestHandleModEvent_ResourcesFilterChanged(t *testing.T) {
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("Invalidate", mock.Anything).Return(nil).Once()
+	clusterCache.On("EnsureSynced").Return(nil).Maybe()
+	db := &dbmocks.ArgoDB{}
+	db.On("GetApplicationControllerReplicas").Return(1)
+	clustersCache := liveStateCache{
+		clusters: map[string]cache.ClusterCache{
+			"https://mycluster": clusterCache,
+		},
+		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
+		cacheSettings:   cacheSettings{resourcesFilter: &argosettings.ResourcesFilter{}},
+	}
+
+	clustersCache.handleModEvent(&appv1.Cluster{
+		Server: "https://mycluster",
+	}, &appv1.Cluster{
+		Server:      "https://mycluster",
+		Annotations: map[string]string{common.AnnotationKeyResourceExclusions: "[{apiGroups: [noisy.example.com]}]"},
+	})
+	clusterCache.AssertCalled(t, "Invalidate", mock.Anything)
+}
+
 func TestHandleAddEvent_ClusterExcluded(t *testing.T) {
 	db := &dbmocks.ArgoDB{}
 	db.On("GetApplicationControllerReplicas").Return(1)
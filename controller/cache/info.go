@@ -56,6 +56,8 @@ func populateNodeInfo(un *unstructured.Unstructured, res *ResourceInfo, customLa
 			populateServiceInfo(un, res)
 		case "Node":
 			populateHostNodeInfo(un, res)
+		case "ResourceQuota":
+			populateResourceQuotaInfo(un, res)
 		}
 	case "extensions", "networking.k8s.io":
 		if gvk.Kind == kube.IngressKind {
@@ -469,13 +471,24 @@ func populateHostNodeInfo(un *unstructured.Unstructured, res *ResourceInfo) {
 		return
 	}
 	res.NodeInfo = &NodeInfo{
-		Name:       node.Name,
-		Capacity:   node.Status.Capacity,
-		SystemInfo: node.Status.NodeInfo,
-		Labels:     node.Labels,
+		Name:          node.Name,
+		Capacity:      node.Status.Capacity,
+		Allocatable:   node.Status.Allocatable,
+		SystemInfo:    node.Status.NodeInfo,
+		Labels:        node.Labels,
+		Unschedulable: node.Spec.Unschedulable,
 	}
 }
 
+func populateResourceQuotaInfo(un *unstructured.Unstructured, res *ResourceInfo) {
+	quota := corev1.ResourceQuota{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(un.Object, &quota)
+	if err != nil {
+		return
+	}
+	res.ResourceQuotaInfo = &ResourceQuotaInfo{Hard: quota.Status.Hard, Used: quota.Status.Used}
+}
+
 func generateManifestHash(un *unstructured.Unstructured, ignores []v1alpha1.ResourceIgnoreDifferences, overrides map[string]v1alpha1.ResourceOverride, opts normalizers.IgnoreNormalizerOpts) (string, error) {
 	normalizer, err := normalizers.NewIgnoreNormalizer(ignores, overrides, opts)
 	if err != nil {
@@ -1375,3 +1375,29 @@ func TestManifestHash(t *testing.T) {
 	assert.Equal(t, expected, hash)
 	assert.NoError(t, err)
 }
+
+func TestGetResourceQuotaInfo(t *testing.T) {
+	quota := strToUnstructured(`
+  apiVersion: v1
+  kind: ResourceQuota
+  metadata:
+    name: compute
+    namespace: default
+  spec:
+    hard:
+      requests.cpu: "2"
+  status:
+    hard:
+      requests.cpu: "2"
+    used:
+      requests.cpu: 500m
+`)
+
+	info := &ResourceInfo{}
+	populateNodeInfo(quota, info, []string{})
+	require.NotNil(t, info.ResourceQuotaInfo)
+	hard := info.ResourceQuotaInfo.Hard[corev1.ResourceRequestsCPU]
+	used := info.ResourceQuotaInfo.Used[corev1.ResourceRequestsCPU]
+	assert.Equal(t, int64(2000), hard.MilliValue())
+	assert.Equal(t, int64(500), used.MilliValue())
+}
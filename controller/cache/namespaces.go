@@ -0,0 +1,204 @@
+package cache
+
+import (
+	"context"
+	"slices"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/tracing"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	logutils "github.com/argoproj/argo-cd/v3/util/log"
+)
+
+// appManagedNamespaces returns the namespaces of the resources managed by the application: its destination namespace
+// and the namespaces of the resources in its status, which may differ from the destination namespace
+func appManagedNamespaces(app *appv1.Application) []string {
+	var namespaces []string
+	if app.Spec.Destination.Namespace != "" {
+		namespaces = append(namespaces, app.Spec.Destination.Namespace)
+	}
+	for _, res := range app.Status.Resources {
+		if res.Namespace != "" {
+			namespaces = append(namespaces, res.Namespace)
+		}
+	}
+	slices.Sort(namespaces)
+	return slices.Compact(namespaces)
+}
+
+// clusterCacheNamespaces returns the namespaces the cache of the given cluster watches. They are the namespaces of the
+// cluster unless the dynamic cluster namespaces are enabled, in which case they are the namespaces of the resources
+// managed by the applications deployed to the cluster, among the namespaces of the cluster if it restricts them. No
+// namespace is returned if no application manages namespaced resources in a cluster which does not restrict its
+// namespaces, in which case the cache watches the cluster-scoped resources only (see isClusterScopedOnly).
+func (c *liveStateCache) clusterCacheNamespaces(cluster *appv1.Cluster) []string {
+	if !c.dynamicClusterNamespaces || c.appInformer == nil {
+		return cluster.Namespaces
+	}
+	var namespaces []string
+	for _, obj := range c.appInformer.GetStore().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		deployed, err := c.isAppDeployedTo(app, cluster)
+		if err != nil {
+			log.Errorf("Failed to resolve the destination of application %s, ignoring the namespaces it manages in cluster %s: %v", app.QualifiedName(), cluster.Server, err)
+			continue
+		}
+		if !deployed {
+			continue
+		}
+		for _, ns := range appManagedNamespaces(app) {
+			if len(cluster.Namespaces) == 0 || slices.Contains(cluster.Namespaces, ns) {
+				namespaces = append(namespaces, ns)
+			}
+		}
+	}
+	if len(namespaces) == 0 {
+		if len(cluster.Namespaces) == 0 {
+			log.Infof("No application manages namespaced resources in cluster %s, watching its cluster-scoped resources only", cluster.Server)
+			return nil
+		}
+		log.Warnf("No application manages namespaced resources in cluster %s, using the namespaces of the cluster", cluster.Server)
+		return cluster.Namespaces
+	}
+	slices.Sort(namespaces)
+	return slices.Compact(namespaces)
+}
+
+// isClusterScopedOnly returns whether a cluster cache watching the given namespaces watches the cluster-scoped resources
+// only. Watching no namespace means watching all the namespaces of the cluster, which the dynamic cluster namespaces
+// never do.
+func (c *liveStateCache) isClusterScopedOnly(namespaces []string) bool {
+	return c.dynamicClusterNamespaces && c.appInformer != nil && len(namespaces) == 0
+}
+
+// clusterKubectl returns the kubectl used by the cache of the given cluster to watch the given namespaces. It lists the
+// cluster-scoped APIs only if the cache watches the cluster-scoped resources only.
+func (c *liveStateCache) clusterKubectl(server string, namespaces []string) kube.Kubectl {
+	kubectl := &kube.KubectlCmd{
+		Log:    logutils.NewLogrusLogger(log.WithField("server", server)),
+		Tracer: tracing.NopTracer{},
+	}
+	if !c.isClusterScopedOnly(namespaces) {
+		return kubectl
+	}
+	return &clusterScopedKubectl{Kubectl: kubectl}
+}
+
+// clusterScopedKubectl is a kubectl listing the cluster-scoped APIs only
+type clusterScopedKubectl struct {
+	kube.Kubectl
+}
+
+func (k *clusterScopedKubectl) GetAPIResources(config *rest.Config, preferred bool, resourceFilter kube.ResourceFilter) ([]kube.APIResourceInfo, error) {
+	apis, err := k.Kubectl.GetAPIResources(config, preferred, resourceFilter)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(apis, func(api kube.APIResourceInfo) bool {
+		return api.Meta.Namespaced
+	}), nil
+}
+
+// isAppDeployedTo returns whether the destination of the given application is the given cluster. The applications
+// whose destination names match no cluster or several clusters are not deployed to any cluster.
+func (c *liveStateCache) isAppDeployedTo(app *appv1.Application, cluster *appv1.Cluster) (bool, error) {
+	if app.Spec.Destination.Server != "" || app.Spec.Destination.Name == "" {
+		return app.Spec.Destination.Server == cluster.Server && app.Spec.Destination.Name == "", nil
+	}
+	servers, err := c.db.GetClusterServersByName(context.Background(), app.Spec.Destination.Name)
+	if err != nil {
+		return false, err
+	}
+	return len(servers) == 1 && servers[0] == cluster.Server, nil
+}
+
+// handleAppEvent updates the namespaces watched by the cluster caches when the namespaces managed by an application
+// change
+func (c *liveStateCache) handleAppEvent(oldApp *appv1.Application, newApp *appv1.Application) {
+	if oldApp != nil && newApp != nil && oldApp.Spec.Destination == newApp.Spec.Destination &&
+		slices.Equal(appManagedNamespaces(oldApp), appManagedNamespaces(newApp)) {
+		return
+	}
+	servers := map[string]*appv1.Cluster{}
+	for _, app := range []*appv1.Application{oldApp, newApp} {
+		if app == nil {
+			continue
+		}
+		destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, c.db)
+		if err != nil {
+			log.Warnf("Failed to get destination cluster: %v", err)
+			continue
+		}
+		servers[destCluster.Server] = destCluster
+	}
+	for _, cluster := range servers {
+		c.updateClusterCacheNamespaces(cluster)
+	}
+}
+
+// updateClusterCacheNamespaces invalidates the cache of the given cluster, if any, when the namespaces it must watch
+// changed
+func (c *liveStateCache) updateClusterCacheNamespaces(cluster *appv1.Cluster) {
+	c.lock.RLock()
+	clusterCache, ok := c.clusters[cluster.Server]
+	c.lock.RUnlock()
+	if !ok {
+		// the cache is created with the current namespaces when it is first needed
+		return
+	}
+	namespaces := c.clusterCacheNamespaces(cluster)
+	c.lock.Lock()
+	changed := c.setClusterNamespaces(cluster.Server, namespaces)
+	c.lock.Unlock()
+	if !changed {
+		return
+	}
+	log.Infof("Namespaces managed in cluster %s changed to %v, invalidating its cache", cluster.Server, namespaces)
+	clusterCache.Invalidate(clustercache.SetNamespaces(namespaces), clustercache.SetKubectl(c.clusterKubectl(cluster.Server, namespaces)))
+	go func() {
+		// warm up cluster cache
+		_ = clusterCache.EnsureSynced()
+	}()
+}
+
+// setClusterNamespaces records the namespaces watched by the cache of the given cluster and returns whether they
+// changed. The caller must hold the lock.
+func (c *liveStateCache) setClusterNamespaces(server string, namespaces []string) bool {
+	if c.clusterNamespaces == nil {
+		c.clusterNamespaces = make(map[string][]string)
+	}
+	prevNamespaces, ok := c.clusterNamespaces[server]
+	c.clusterNamespaces[server] = namespaces
+	return !ok || !slices.Equal(prevNamespaces, namespaces)
+}
+
+// appEventHandler returns the handler of the application events updating the namespaces watched by the cluster caches
+func (c *liveStateCache) appEventHandler() cache.ResourceEventHandler {
+	toApp := func(obj any) *appv1.Application {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		app, _ := obj.(*appv1.Application)
+		return app
+	}
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			c.handleAppEvent(nil, toApp(obj))
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			c.handleAppEvent(toApp(oldObj), toApp(newObj))
+		},
+		DeleteFunc: func(obj any) {
+			c.handleAppEvent(toApp(obj), nil)
+		},
+	}
+}
@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8scache "k8s.io/client-go/tools/cache"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+)
+
+func newTestApp(name string, server string, namespace string, resourceNamespaces ...string) *appv1.Application {
+	app := &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+		Spec:       appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Server: server, Namespace: namespace}},
+	}
+	for _, ns := range resourceNamespaces {
+		app.Status.Resources = append(app.Status.Resources, appv1.ResourceStatus{Kind: "ConfigMap", Name: "cm", Namespace: ns})
+	}
+	return app
+}
+
+func newDynamicNamespacesCache(t *testing.T, clusters []*appv1.Cluster, apps ...*appv1.Application) *liveStateCache {
+	t.Helper()
+	db := &dbmocks.ArgoDB{}
+	for _, cluster := range clusters {
+		db.On("GetCluster", mock.Anything, cluster.Server).Return(cluster, nil)
+	}
+	appInformer := k8scache.NewSharedIndexInformer(&k8scache.ListWatch{}, &appv1.Application{}, 0, k8scache.Indexers{})
+	for _, app := range apps {
+		require.NoError(t, appInformer.GetStore().Add(app))
+	}
+	return &liveStateCache{
+		db:                       db,
+		appInformer:              appInformer,
+		dynamicClusterNamespaces: true,
+		clusters:                 map[string]cache.ClusterCache{},
+		clusterNamespaces:        map[string][]string{},
+	}
+}
+
+func TestAppManagedNamespaces(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, appManagedNamespaces(newTestApp("app", "https://cluster", "b", "a", "b", "")))
+	assert.Empty(t, appManagedNamespaces(newTestApp("app", "https://cluster", "")))
+}
+
+func TestClusterCacheNamespaces(t *testing.T) {
+	cluster := &appv1.Cluster{Server: "https://cluster"}
+	other := &appv1.Cluster{Server: "https://other"}
+	apps := []*appv1.Application{
+		newTestApp("app1", cluster.Server, "ns1"),
+		newTestApp("app2", cluster.Server, "ns2", "ns3"),
+		newTestApp("app3", other.Server, "ns4"),
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		c := newDynamicNamespacesCache(t, []*appv1.Cluster{cluster, other}, apps...)
+		c.dynamicClusterNamespaces = false
+		namespaces := c.clusterCacheNamespaces(cluster)
+		assert.Empty(t, namespaces)
+		assert.False(t, c.isClusterScopedOnly(namespaces))
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		c := newDynamicNamespacesCache(t, []*appv1.Cluster{cluster, other}, apps...)
+		assert.Equal(t, []string{"ns1", "ns2", "ns3"}, c.clusterCacheNamespaces(cluster))
+		assert.Equal(t, []string{"ns4"}, c.clusterCacheNamespaces(other))
+	})
+
+	t.Run("RestrictedByCluster", func(t *testing.T) {
+		restricted := &appv1.Cluster{Server: cluster.Server, Namespaces: []string{"ns1", "ns3", "ns5"}}
+		c := newDynamicNamespacesCache(t, []*appv1.Cluster{restricted, other}, apps...)
+		assert.Equal(t, []string{"ns1", "ns3"}, c.clusterCacheNamespaces(restricted))
+	})
+
+	t.Run("DestinationName", func(t *testing.T) {
+		named := newTestApp("app4", "", "ns5")
+		named.Spec.Destination.Name = "in-cluster"
+		c := newDynamicNamespacesCache(t, []*appv1.Cluster{cluster, other}, append(apps, named)...)
+		c.db.(*dbmocks.ArgoDB).On("GetClusterServersByName", mock.Anything, "in-cluster").Return([]string{cluster.Server}, nil)
+		assert.Equal(t, []string{"ns1", "ns2", "ns3", "ns5"}, c.clusterCacheNamespaces(cluster))
+	})
+
+	t.Run("DestinationNotResolved", func(t *testing.T) {
+		named := newTestApp("app4", "", "ns5")
+		named.Spec.Destination.Name = "in-cluster"
+		c := newDynamicNamespacesCache(t, []*appv1.Cluster{cluster, other}, append(apps, named)...)
+		c.db.(*dbmocks.ArgoDB).On("GetClusterServersByName", mock.Anything, "in-cluster").Return(nil, errors.New("connection refused"))
+		// the application is ignored rather than failing the cache of the cluster
+		assert.Equal(t, []string{"ns1", "ns2", "ns3"}, c.clusterCacheNamespaces(cluster))
+	})
+
+	t.Run("NoApplications", func(t *testing.T) {
+		restricted := &appv1.Cluster{Server: "https://empty", Namespaces: []string{"ns1"}}
+		c := newDynamicNamespacesCache(t, []*appv1.Cluster{restricted, cluster, other}, apps...)
+		namespaces := c.clusterCacheNamespaces(restricted)
+		assert.Equal(t, []string{"ns1"}, namespaces)
+		assert.False(t, c.isClusterScopedOnly(namespaces))
+
+		// the cache watches the cluster-scoped resources only rather than all the namespaces of the cluster
+		namespaces = c.clusterCacheNamespaces(&appv1.Cluster{Server: "https://empty"})
+		assert.Empty(t, namespaces)
+		assert.True(t, c.isClusterScopedOnly(namespaces))
+	})
+}
+
+func TestHandleAppEvent(t *testing.T) {
+	cluster := &appv1.Cluster{Server: "https://cluster"}
+	app := newTestApp("app1", cluster.Server, "ns1")
+
+	t.Run("NamespacesChanged", func(t *testing.T) {
+		clusterCache := &mocks.ClusterCache{}
+		clusterCache.On("Invalidate", mock.Anything, mock.Anything).Return(nil).Once()
+		clusterCache.On("EnsureSynced").Return(nil).Maybe()
+		newApp := newTestApp("app1", cluster.Server, "ns1", "ns2")
+		c := newDynamicNamespacesCache(t, []*appv1.Cluster{cluster}, newApp)
+		c.clusters[cluster.Server] = clusterCache
+		c.clusterNamespaces[cluster.Server] = []string{"ns1"}
+
+		c.handleAppEvent(app, newApp)
+
+		assert.Equal(t, []string{"ns1", "ns2"}, c.clusterNamespaces[cluster.Server])
+		clusterCache.AssertCalled(t, "Invalidate", mock.Anything, mock.Anything)
+	})
+
+	t.Run("NamespacesUnchanged", func(t *testing.T) {
+		clusterCache := &mocks.ClusterCache{}
+		c := newDynamicNamespacesCache(t, []*appv1.Cluster{cluster}, app)
+		c.clusters[cluster.Server] = clusterCache
+		c.clusterNamespaces[cluster.Server] = []string{"ns1"}
+
+		newApp := app.DeepCopy()
+		newApp.Status.Sync.Status = appv1.SyncStatusCodeSynced
+		c.handleAppEvent(app, newApp)
+		c.handleAppEvent(nil, app)
+
+		clusterCache.AssertNotCalled(t, "Invalidate", mock.Anything)
+	})
+
+	t.Run("ClusterScopedOnly", func(t *testing.T) {
+		clusterCache := &mocks.ClusterCache{}
+		clusterCache.On("Invalidate", mock.Anything, mock.Anything).Return(nil).Once()
+		clusterCache.On("EnsureSynced").Return(nil).Maybe()
+		c := newDynamicNamespacesCache(t, []*appv1.Cluster{cluster})
+		c.clusters[cluster.Server] = clusterCache
+		c.clusterNamespaces[cluster.Server] = []string{"ns1"}
+
+		// the cache watches the cluster-scoped resources only rather than all the namespaces
+		c.handleAppEvent(app, nil)
+
+		clusterCache.AssertCalled(t, "Invalidate", mock.Anything, mock.Anything)
+		assert.Equal(t, clusterCache, c.clusters[cluster.Server])
+		assert.Empty(t, c.clusterNamespaces[cluster.Server])
+
+		// the cache is rebuilt when an application starts managing a namespace of the cluster
+		clusterCache.On("Invalidate", mock.Anything, mock.Anything).Return(nil).Once()
+		require.NoError(t, c.appInformer.GetStore().Add(app))
+		c.handleAppEvent(nil, app)
+
+		clusterCache.AssertNumberOfCalls(t, "Invalidate", 2)
+		assert.Equal(t, []string{"ns1"}, c.clusterNamespaces[cluster.Server])
+	})
+
+	t.Run("ClusterNotCached", func(t *testing.T) {
+		c := newDynamicNamespacesCache(t, []*appv1.Cluster{cluster}, app)
+		c.handleAppEvent(nil, app)
+		assert.Empty(t, c.clusterNamespaces)
+	})
+}
+
+func TestClusterKubectl(t *testing.T) {
+	namespaced := kube.APIResourceInfo{GroupKind: schema.GroupKind{Kind: "ConfigMap"}, Meta: metav1.APIResource{Namespaced: true}}
+	clusterScoped := kube.APIResourceInfo{GroupKind: schema.GroupKind{Kind: "Namespace"}}
+	c := newDynamicNamespacesCache(t, nil)
+
+	assert.IsType(t, &kube.KubectlCmd{}, c.clusterKubectl("https://cluster", []string{"ns1"}))
+
+	kubectl := c.clusterKubectl("https://cluster", nil)
+	require.IsType(t, &clusterScopedKubectl{}, kubectl)
+	kubectl.(*clusterScopedKubectl).Kubectl = &kubetest.MockKubectlCmd{APIResources: []kube.APIResourceInfo{namespaced, clusterScoped}}
+	apis, err := kubectl.GetAPIResources(nil, true, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []kube.APIResourceInfo{clusterScoped}, apis)
+}
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// labelOwnerRef is a reference to the owner of a resource found in the labels of the resource
+type labelOwnerRef struct {
+	// group and kind are globs matching the group and the kind of the owner
+	group string
+	kind  string
+	// namespaces are the namespaces the owner is looked up in, an empty namespace standing for the cluster-scoped
+	// resources
+	namespaces []string
+	name       string
+}
+
+func matchesOptionalGlob(pattern string, value string) bool {
+	return pattern == "" || glob.Match(pattern, value)
+}
+
+// getLabelOwnerRefs returns the owners the resource refers to with the labels of the given resource owner references
+func getLabelOwnerRefs(un *unstructured.Unstructured, references []settings.ResourceOwnerReference) []labelOwnerRef {
+	if len(references) == 0 {
+		return nil
+	}
+	labels := un.GetLabels()
+	gvk := un.GroupVersionKind()
+	var refs []labelOwnerRef
+	for _, reference := range references {
+		name := labels[reference.NameLabel]
+		if name == "" || !matchesOptionalGlob(reference.Group, gvk.Group) || !matchesOptionalGlob(reference.Kind, gvk.Kind) {
+			continue
+		}
+		namespaces := []string{un.GetNamespace(), ""}
+		if reference.NamespaceLabel != "" {
+			namespace, ok := labels[reference.NamespaceLabel]
+			if !ok {
+				continue
+			}
+			namespaces = []string{namespace}
+		}
+		refs = append(refs, labelOwnerRef{group: reference.OwnerGroup, kind: reference.OwnerKind, namespaces: namespaces, name: name})
+	}
+	return refs
+}
+
+// labelOwnerKey is the namespace and the name of an owner referred to by labels
+type labelOwnerKey struct {
+	namespace string
+	name      string
+}
+
+// labelOwnerIndex indexes the keys of the resources of a cluster which may be referred to as owners by the labels of
+// other resources, by namespace and name
+type labelOwnerIndex map[labelOwnerKey][]kube.ResourceKey
+
+// newLabelOwnerIndex indexes the resources of the cluster which are referred to as owners by the labels of the given
+// resources, with a single pass over the resources of the cluster
+func newLabelOwnerIndex(clusterInfo clustercache.ClusterCache, resources map[kube.ResourceKey]*clustercache.Resource) labelOwnerIndex {
+	referred := map[labelOwnerKey]bool{}
+	for _, r := range resources {
+		for _, ref := range resInfo(r).labelOwnerRefs {
+			for _, namespace := range ref.namespaces {
+				referred[labelOwnerKey{namespace: namespace, name: ref.name}] = true
+			}
+		}
+	}
+	if len(referred) == 0 {
+		return nil
+	}
+	index := labelOwnerIndex{}
+	_ = clusterInfo.FindResources("", func(owner *clustercache.Resource) bool {
+		key := owner.ResourceKey()
+		ownerKey := labelOwnerKey{namespace: key.Namespace, name: key.Name}
+		if referred[ownerKey] {
+			index[ownerKey] = append(index[ownerKey], key)
+		}
+		return false
+	})
+	return index
+}
+
+// hasLabelOwner returns whether any of the owners the resource refers to with its labels exists in the cluster
+func (index labelOwnerIndex) hasLabelOwner(r *clustercache.Resource) bool {
+	for _, ref := range resInfo(r).labelOwnerRefs {
+		for _, namespace := range ref.namespaces {
+			for _, key := range index[labelOwnerKey{namespace: namespace, name: ref.name}] {
+				if matchesOptionalGlob(ref.group, key.Group) && matchesOptionalGlob(ref.kind, key.Kind) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	argosettings "github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+var crossplaneOwnerReferences = []argosettings.ResourceOwnerReference{
+	{NameLabel: "crossplane.io/claim-name", NamespaceLabel: "crossplane.io/claim-namespace"},
+	{NameLabel: "crossplane.io/composite", OwnerGroup: "*.example.org"},
+}
+
+func newLabelledSecret(labels map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "db-conn", "namespace": "default", "labels": labels},
+	}}
+}
+
+func TestGetLabelOwnerRefs(t *testing.T) {
+	refs := getLabelOwnerRefs(newLabelledSecret(map[string]any{
+		"crossplane.io/claim-name":      "db",
+		"crossplane.io/claim-namespace": "team-a",
+		"crossplane.io/composite":       "db-x7k2p",
+	}), crossplaneOwnerReferences)
+	assert.Equal(t, []labelOwnerRef{
+		{namespaces: []string{"team-a"}, name: "db"},
+		{group: "*.example.org", namespaces: []string{"default", ""}, name: "db-x7k2p"},
+	}, refs)
+
+	// the claim reference is skipped without the namespace label
+	refs = getLabelOwnerRefs(newLabelledSecret(map[string]any{"crossplane.io/claim-name": "db"}), crossplaneOwnerReferences)
+	assert.Empty(t, refs)
+
+	refs = getLabelOwnerRefs(newLabelledSecret(map[string]any{"crossplane.io/composite": "db-x7k2p"}), []argosettings.ResourceOwnerReference{
+		{Kind: "ConfigMap", NameLabel: "crossplane.io/composite"},
+	})
+	assert.Empty(t, refs)
+}
+
+func TestHasLabelOwner(t *testing.T) {
+	composite := &cache.Resource{Ref: corev1.ObjectReference{APIVersion: "database.example.org/v1alpha1", Kind: "XPostgreSQLInstance", Name: "db-x7k2p"}}
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("FindResources", mock.Anything, mock.Anything).Return(func(_ string, predicates ...func(*cache.Resource) bool) map[kube.ResourceKey]*cache.Resource {
+		result := map[kube.ResourceKey]*cache.Resource{}
+		for _, r := range []*cache.Resource{composite} {
+			matches := true
+			for _, predicate := range predicates {
+				matches = matches && predicate(r)
+			}
+			if matches {
+				result[r.ResourceKey()] = r
+			}
+		}
+		return result
+	})
+
+	owned := &cache.Resource{Info: &ResourceInfo{labelOwnerRefs: getLabelOwnerRefs(newLabelledSecret(map[string]any{"crossplane.io/composite": "db-x7k2p"}), crossplaneOwnerReferences)}}
+	deletedOwner := &cache.Resource{Info: &ResourceInfo{labelOwnerRefs: getLabelOwnerRefs(newLabelledSecret(map[string]any{"crossplane.io/composite": "db-deleted"}), crossplaneOwnerReferences)}}
+	notOwned := &cache.Resource{Info: &ResourceInfo{}}
+	owners := newLabelOwnerIndex(clusterCache, map[kube.ResourceKey]*cache.Resource{
+		kube.NewResourceKey("", "Secret", "default", "owned"):         owned,
+		kube.NewResourceKey("", "Secret", "default", "deleted-owner"): deletedOwner,
+		kube.NewResourceKey("", "Secret", "default", "not-owned"):     notOwned,
+	})
+	assert.True(t, owners.hasLabelOwner(owned))
+	assert.False(t, owners.hasLabelOwner(deletedOwner))
+	assert.False(t, owners.hasLabelOwner(notOwned))
+	clusterCache.AssertNumberOfCalls(t, "FindResources", 1)
+
+	// the cluster is not searched when no resource refers to owners with labels
+	assert.Nil(t, newLabelOwnerIndex(clusterCache, map[kube.ResourceKey]*cache.Resource{kube.NewResourceKey("", "Secret", "default", "not-owned"): notOwned}))
+	clusterCache.AssertNumberOfCalls(t, "FindResources", 1)
+}
@@ -0,0 +1,192 @@
+package cache
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/semaphore"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/controller/metrics"
+)
+
+// The states of the priming of the cluster caches
+const (
+	primingStatePending    = "pending"
+	primingStateInProgress = "in_progress"
+)
+
+// primingProgress is the progress of the initial synchronization of a cluster cache
+type primingProgress struct {
+	// done is closed once the priming is over
+	done       chan struct{}
+	inProgress bool
+	start      time.Time
+	apis       map[string]bool
+	resources  int
+}
+
+// clusterCachePrimer bounds the concurrency of the initial synchronizations of the cluster caches, and tracks their
+// progress: the APIs listed and the resources listed so far. A nil primer synchronizes the caches without bound nor
+// tracking.
+type clusterCachePrimer struct {
+	// semaphore bounds the concurrency of the primings, or is nil if they are not bounded
+	semaphore     *semaphore.Weighted
+	metricsServer *metrics.MetricsServer
+	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
+	now func() time.Time
+
+	lock     sync.Mutex
+	clusters map[string]*primingProgress
+	primed   map[string]bool
+}
+
+// newClusterCachePrimer returns a primer priming at most the given number of cluster caches concurrently, or any number
+// of them if it is 0
+func newClusterCachePrimer(metricsServer *metrics.MetricsServer, parallelism int64) *clusterCachePrimer {
+	p := &clusterCachePrimer{
+		metricsServer: metricsServer,
+		now:           time.Now,
+		clusters:      map[string]*primingProgress{},
+		primed:        map[string]bool{},
+	}
+	if parallelism > 0 {
+		p.semaphore = semaphore.NewWeighted(parallelism)
+	}
+	return p
+}
+
+// ensureSynced synchronizes the given cache of the given cluster. The first synchronization of the cache waits for the
+// concurrent primings of the other caches to be under the bound, while the concurrent callers wait for it to be over.
+func (p *clusterCachePrimer) ensureSynced(server string, clusterCache clustercache.ClusterCache) error {
+	if p == nil {
+		return clusterCache.EnsureSynced()
+	}
+	p.lock.Lock()
+	if p.primed[server] {
+		p.lock.Unlock()
+		return clusterCache.EnsureSynced()
+	}
+	if progress, ok := p.clusters[server]; ok {
+		p.lock.Unlock()
+		<-progress.done
+		return clusterCache.EnsureSynced()
+	}
+	progress := &primingProgress{done: make(chan struct{}), apis: map[string]bool{}}
+	p.clusters[server] = progress
+	p.lock.Unlock()
+	p.addMetric(primingStatePending, 1)
+
+	if p.semaphore != nil {
+		// the context is never canceled, so the semaphore is always acquired
+		_ = p.semaphore.Acquire(context.Background(), 1)
+		defer p.semaphore.Release(1)
+	}
+
+	p.lock.Lock()
+	progress.inProgress = true
+	progress.start = p.now()
+	p.lock.Unlock()
+	p.addMetric(primingStatePending, -1)
+	p.addMetric(primingStateInProgress, 1)
+
+	err := clusterCache.EnsureSynced()
+
+	p.lock.Lock()
+	delete(p.clusters, server)
+	if err == nil {
+		p.primed[server] = true
+	}
+	duration := p.now().Sub(progress.start)
+	apis, resources := len(progress.apis), progress.resources
+	p.lock.Unlock()
+	close(progress.done)
+	p.addMetric(primingStateInProgress, -1)
+
+	if err != nil {
+		log.WithField("server", server).Warnf("Failed to prime the cluster cache after %v: %v", duration, err)
+		return err
+	}
+	if p.metricsServer != nil {
+		p.metricsServer.ObserveClusterCachePrimingDuration(server, duration)
+	}
+	log.WithField("server", server).Infof("Primed the cluster cache in %v: %d APIs and %d resources listed", duration, apis, resources)
+	return nil
+}
+
+// getProgress returns the number of the APIs and of the resources listed so far by the priming of the cache of the
+// given cluster, if it is in progress
+func (p *clusterCachePrimer) getProgress(server string) (apis int, resources int, ok bool) {
+	if p == nil {
+		return 0, 0, false
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	progress, ok := p.clusters[server]
+	if !ok || !progress.inProgress {
+		return 0, 0, false
+	}
+	return len(progress.apis), progress.resources, true
+}
+
+// forget removes the given cluster, whose cache is removed, so that its next cache is primed again
+func (p *clusterCachePrimer) forget(server string) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.primed, server)
+}
+
+// resourceListed records a resource listed by the cache of the given cluster
+func (p *clusterCachePrimer) resourceListed(server string) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if progress, ok := p.clusters[server]; ok && progress.inProgress {
+		progress.resources++
+	}
+}
+
+// wrapConfig returns a copy of the given config of the given cluster recording the APIs listed by the priming of its
+// cache
+func (p *clusterCachePrimer) wrapConfig(server string, config *rest.Config) *rest.Config {
+	if p == nil {
+		return config
+	}
+	config = rest.CopyConfig(config)
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &primingRoundTripper{primer: p, server: server, next: rt}
+	})
+	return config
+}
+
+func (p *clusterCachePrimer) addMetric(state string, delta float64) {
+	if p.metricsServer != nil {
+		p.metricsServer.AddClusterCachePriming(state, delta)
+	}
+}
+
+type primingRoundTripper struct {
+	primer *clusterCachePrimer
+	server string
+	next   http.RoundTripper
+}
+
+func (rt *primingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if resource, ok := metrics.ListedResource(req); ok {
+		rt.primer.lock.Lock()
+		if progress, ok := rt.primer.clusters[rt.server]; ok && progress.inProgress {
+			progress.apis[resource] = true
+		}
+		rt.primer.lock.Unlock()
+	}
+	return rt.next.RoundTrip(req)
+}
@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClusterCachePrimer_Parallelism(t *testing.T) {
+	p := newClusterCachePrimer(nil, 1)
+	started := make(chan string, 10)
+	release := make(chan struct{})
+	newClusterCache := func(server string) *mocks.ClusterCache {
+		clusterCache := &mocks.ClusterCache{}
+		clusterCache.On("EnsureSynced").Run(func(_ mock.Arguments) {
+			started <- server
+			<-release
+		}).Return(nil)
+		return clusterCache
+	}
+	cache1, cache2 := newClusterCache("https://cluster-1"), newClusterCache("https://cluster-2")
+
+	errs := make(chan error, 3)
+	go func() { errs <- p.ensureSynced("https://cluster-1", cache1) }()
+	require.Equal(t, "https://cluster-1", <-started)
+	go func() { errs <- p.ensureSynced("https://cluster-2", cache2) }()
+	go func() { errs <- p.ensureSynced("https://cluster-1", cache1) }()
+
+	// the priming of the second cluster waits for the priming of the first one
+	select {
+	case server := <-started:
+		t.Fatalf("unexpected synchronization of %s", server)
+	case <-time.After(100 * time.Millisecond):
+	}
+	_, _, ok := p.getProgress("https://cluster-2")
+	assert.False(t, ok)
+
+	// the progress of the priming is tracked
+	rt := &primingRoundTripper{primer: p, server: "https://cluster-1", next: roundTripperFunc(func(_ *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})}
+	for _, url := range []string{
+		"https://cluster-1/api/v1/pods?limit=500",
+		"https://cluster-1/api/v1/pods?limit=500&continue=abc",
+		"https://cluster-1/apis/apps/v1/deployments?limit=500",
+		"https://cluster-1/api/v1/namespaces/default/pods/my-pod",
+	} {
+		req, err := http.NewRequest(http.MethodGet, url, http.NoBody)
+		require.NoError(t, err)
+		_, err = rt.RoundTrip(req)
+		require.NoError(t, err)
+	}
+	p.resourceListed("https://cluster-1")
+	p.resourceListed("https://cluster-1")
+	p.resourceListed("https://cluster-2")
+	apis, resources, ok := p.getProgress("https://cluster-1")
+	require.True(t, ok)
+	assert.Equal(t, 2, apis)
+	assert.Equal(t, 2, resources)
+
+	close(release)
+	for range 3 {
+		require.NoError(t, <-errs)
+	}
+	_, _, ok = p.getProgress("https://cluster-1")
+	assert.False(t, ok)
+	cache2.AssertNumberOfCalls(t, "EnsureSynced", 1)
+
+	// the primed caches are not bounded anymore
+	require.NoError(t, p.ensureSynced("https://cluster-2", cache2))
+	assert.True(t, p.primed["https://cluster-2"])
+}
+
+func TestClusterCachePrimer_Failure(t *testing.T) {
+	p := newClusterCachePrimer(nil, 1)
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("EnsureSynced").Return(errors.New("connection refused")).Once()
+	clusterCache.On("EnsureSynced").Return(nil)
+
+	// the cache is primed again once its priming failed
+	require.EqualError(t, p.ensureSynced("https://cluster", clusterCache), "connection refused")
+	assert.False(t, p.primed["https://cluster"])
+	require.NoError(t, p.ensureSynced("https://cluster", clusterCache))
+	assert.True(t, p.primed["https://cluster"])
+
+	p.forget("https://cluster")
+	assert.False(t, p.primed["https://cluster"])
+}
+
+func TestClusterCachePrimer_Nil(t *testing.T) {
+	var p *clusterCachePrimer
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("EnsureSynced").Return(nil)
+	require.NoError(t, p.ensureSynced("https://cluster", clusterCache))
+	p.resourceListed("https://cluster")
+	_, _, ok := p.getProgress("https://cluster")
+	assert.False(t, ok)
+}
@@ -0,0 +1,69 @@
+package cache
+
+import (
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+)
+
+// resourceOverheadBytes is a rough estimate of the memory used by the cache for each resource besides its manifest,
+// i.e. for the resource, its info and its entries in the indexes of the cache
+const resourceOverheadBytes = 1024
+
+// GetClusterCacheStats returns the statistics of the cache of the given cluster, if the cache is synced
+func (c *liveStateCache) GetClusterCacheStats(server string) (*appstatecache.ClusterCacheStats, bool) {
+	c.lock.RLock()
+	clusterCache, ok := c.clusters[server]
+	c.lock.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	// the caches being primed are locked until they are synced
+	if _, _, priming := c.primer.getProgress(server); priming {
+		return nil, false
+	}
+	info := clusterCache.GetClusterInfo()
+	if info.LastCacheSyncTime == nil {
+		return nil, false
+	}
+
+	stats := &appstatecache.ClusterCacheStats{
+		ResourcesByGVK:     map[string]int64{},
+		WatchesCount:       c.connectivity.GetClusterConnectivity(server).Watches,
+		LastFullResyncTime: info.LastCacheSyncTime,
+	}
+	_ = clusterCache.FindResources("", func(r *clustercache.Resource) bool {
+		stats.ResourcesByGVK[r.Ref.APIVersion+"/"+r.Ref.Kind]++
+		stats.MemoryEstimateBytes += resourceOverheadBytes
+		if r.Resource != nil {
+			stats.ManifestsCount++
+			stats.MemoryEstimateBytes += estimateSize(r.Resource.Object)
+		}
+		return false
+	})
+	return stats, true
+}
+
+// estimateSize returns a rough estimate of the memory used by the given value of an unstructured object
+func estimateSize(value any) int64 {
+	// the sizes of the headers of the strings, slices and maps, and of the interfaces holding the values
+	const stringHeader, sliceHeader, mapHeader, iface = 16, 24, 48, 16
+	switch v := value.(type) {
+	case map[string]any:
+		size := int64(mapHeader)
+		for key, item := range v {
+			size += stringHeader + int64(len(key)) + iface + estimateSize(item)
+		}
+		return size
+	case []any:
+		size := int64(sliceHeader)
+		for _, item := range v {
+			size += iface + estimateSize(item)
+		}
+		return size
+	case string:
+		return stringHeader + int64(len(v))
+	default:
+		return 8
+	}
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestGetClusterCacheStats(t *testing.T) {
+	syncTime := time.Now()
+	manifest := &unstructured.Unstructured{Object: map[string]any{"apiVersion": "apps/v1", "kind": "Deployment"}}
+	resources := []*cache.Resource{
+		{Ref: corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "pod-1"}},
+		{Ref: corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "pod-2"}},
+		{Ref: corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "deploy"}, Resource: manifest},
+	}
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("GetClusterInfo").Return(cache.ClusterInfo{LastCacheSyncTime: &syncTime})
+	clusterCache.On("FindResources", mock.Anything, mock.Anything).Return(func(_ string, predicates ...func(*cache.Resource) bool) map[kube.ResourceKey]*cache.Resource {
+		for _, r := range resources {
+			for _, predicate := range predicates {
+				predicate(r)
+			}
+		}
+		return map[kube.ResourceKey]*cache.Resource{}
+	})
+	c := &liveStateCache{clusters: map[string]cache.ClusterCache{"https://cluster": clusterCache}}
+
+	stats, ok := c.GetClusterCacheStats("https://cluster")
+	require.True(t, ok)
+	assert.Equal(t, map[string]int64{"v1/Pod": 2, "apps/v1/Deployment": 1}, stats.ResourcesByGVK)
+	assert.Equal(t, int64(1), stats.ManifestsCount)
+	assert.Equal(t, &syncTime, stats.LastFullResyncTime)
+	assert.Equal(t, 3*resourceOverheadBytes+estimateSize(manifest.Object), stats.MemoryEstimateBytes)
+
+	// the stats of the clusters which are not cached are not reported
+	_, ok = c.GetClusterCacheStats("https://other-cluster")
+	assert.False(t, ok)
+}
+
+func TestEstimateSize(t *testing.T) {
+	assert.Equal(t, int64(16+5), estimateSize("hello"))
+	assert.Equal(t, int64(24+2*(16+8)), estimateSize([]any{int64(1), true}))
+	assert.Equal(t, int64(48+16+4+16+16+5), estimateSize(map[string]any{"name": "hello"}))
+}
@@ -31,6 +31,16 @@ const (
 
 var clusterInfoTimeout = env.ParseDurationFromEnv(EnvClusterInfoTimeout, defaultSecretUpdateInterval, defaultSecretUpdateInterval, 1*time.Minute)
 
+// clusterCacheStatsInterval is the interval of the updates of the statistics of the cluster caches, which iterate all
+// the cached resources
+const clusterCacheStatsInterval = time.Minute
+
+// clusterCacheStatsSource is implemented by the sources of the information about the clusters which also report the
+// statistics of the cluster caches
+type clusterCacheStatsSource interface {
+	GetClusterCacheStats(server string) (*appstatecache.ClusterCacheStats, bool)
+}
+
 type clusterInfoUpdater struct {
 	infoSource    metrics.HasClustersInfo
 	db            db.ArgoDB
@@ -40,6 +50,8 @@ type clusterInfoUpdater struct {
 	projGetter    func(app *appv1.Application) (*appv1.AppProject, error)
 	namespace     string
 	lastUpdated   time.Time
+	// lastStatsUpdated is the time of the last update of the statistics of the cluster caches
+	lastStatsUpdated time.Time
 }
 
 func NewClusterInfoUpdater(
@@ -51,7 +63,7 @@ func NewClusterInfoUpdater(
 	projGetter func(app *appv1.Application) (*appv1.AppProject, error),
 	namespace string,
 ) *clusterInfoUpdater {
-	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}}
+	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}, time.Time{}}
 }
 
 func (c *clusterInfoUpdater) Run(ctx context.Context) {
@@ -100,6 +112,8 @@ func (c *clusterInfoUpdater) updateClusters() {
 			}
 		}
 	}
+	statsSource, hasStats := c.infoSource.(clusterCacheStatsSource)
+	updateStats := hasStats && time.Since(c.lastStatsUpdated) >= clusterCacheStatsInterval
 	_ = kube.RunAllAsync(len(clustersFiltered), func(i int) error {
 		cluster := clustersFiltered[i]
 		clusterInfo := infoByServer[cluster.Server]
@@ -108,8 +122,18 @@ func (c *clusterInfoUpdater) updateClusters() {
 		} else if err := updateClusterLabels(ctx, clusterInfo, cluster, c.db.UpdateCluster); err != nil {
 			log.Warnf("Failed to update cluster labels: %v", err)
 		}
+		if updateStats {
+			if stats, ok := statsSource.GetClusterCacheStats(cluster.Server); ok {
+				if err := c.cache.SetClusterCacheStats(cluster.Server, stats); err != nil {
+					log.Warnf("Failed to save cluster cache stats: %v", err)
+				}
+			}
+		}
 		return nil
 	})
+	if updateStats {
+		c.lastStatsUpdated = time.Now()
+	}
 	log.Debugf("Successfully saved info of %d clusters", len(clustersFiltered))
 }
 
@@ -150,6 +174,12 @@ func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*
 		switch {
 		case info.LastCacheSyncTime == nil:
 			clusterInfo.ConnectionState.Status = appv1.ConnectionStatusUnknown
+			if info.APIsCount > 0 || info.ResourcesCount > 0 {
+				// the progress of the synchronization of the cache is reported while it is being primed
+				clusterInfo.ConnectionState.Message = fmt.Sprintf("Cluster cache is being synchronized: %d APIs and %d resources listed.", info.APIsCount, info.ResourcesCount)
+				clusterInfo.CacheInfo.APIsCount = int64(info.APIsCount)
+				clusterInfo.CacheInfo.ResourcesCount = int64(info.ResourcesCount)
+			}
 		case info.SyncError == nil:
 			clusterInfo.ConnectionState.Status = appv1.ConnectionStatusSuccessful
 			syncTime := metav1.NewTime(*info.LastCacheSyncTime)
@@ -166,6 +196,15 @@ func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*
 			clusterInfo.ConnectionState.Message = "Cluster has no applications and is not being monitored."
 		}
 	}
+	if connectivitySource, ok := c.infoSource.(metrics.HasClustersConnectivity); ok {
+		connectivity := connectivitySource.GetClusterConnectivity(cluster.Server)
+		clusterInfo.ConnectivityInfo = appv1.ClusterConnectivityInfo{
+			WatchDisconnects:    connectivity.WatchDisconnects,
+			ListLatencyMs:       connectivity.ListLatency.Milliseconds(),
+			RequestsCount:       connectivity.Requests,
+			FailedRequestsCount: connectivity.FailedRequests,
+		}
+	}
 
 	return clusterInfo
 }
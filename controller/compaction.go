@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+)
+
+// StatusRetention holds the retention of the application status fields which grow over time
+type StatusRetention struct {
+	// CompactionInterval is the interval at which the application statuses are compacted. Compaction is disabled if zero.
+	CompactionInterval time.Duration
+	// RevisionHistoryMaxAge is the age after which revision history entries are removed. The latest entry is kept.
+	RevisionHistoryMaxAge time.Duration
+	// OperationStateMaxAge is the age after which the resource results of a completed operation are removed
+	OperationStateMaxAge time.Duration
+	// ConditionsMaxAge is the age after which the resolved conditions are removed, see conditionResolved
+	ConditionsMaxAge time.Duration
+	// ConditionsMaxCount is the maximum number of resolved conditions kept, the most recent ones first. Unlimited if
+	// zero.
+	ConditionsMaxCount int
+}
+
+// reconciledConditionTypes are the types of the conditions evaluated by every reconciliation. The conditions of these
+// types are removed by the reconciliation once they no longer apply, so those of the status are never resolved.
+var reconciledConditionTypes = map[appv1.ApplicationConditionType]bool{
+	appv1.ApplicationConditionComparisonError:         true,
+	appv1.ApplicationConditionClusterUnreachable:      true,
+	appv1.ApplicationConditionSharedResourceWarning:   true,
+	appv1.ApplicationConditionRepeatedResourceWarning: true,
+	appv1.ApplicationConditionExcludedResourceWarning: true,
+	appv1.ApplicationConditionOrphanedResourceWarning: true,
+	appv1.ApplicationConditionInvalidSpecError:        true,
+	appv1.ApplicationConditionUnknownError:            true,
+	appv1.ApplicationConditionSyncFrozen:              true,
+	appv1.ApplicationConditionServerSideApplyConflict: true,
+	appv1.ApplicationConditionConfigurationDrift:      true,
+}
+
+// conditionResolved returns whether the given condition of the status is resolved, i.e. it is not evaluated by every
+// reconciliation and an operation of the application succeeded since it was observed, e.g. a sync error followed by a
+// successful sync
+func conditionResolved(status *appv1.ApplicationStatus, condition appv1.ApplicationCondition) bool {
+	if reconciledConditionTypes[condition.Type] || condition.LastTransitionTime == nil {
+		return false
+	}
+	state := status.OperationState
+	return state != nil && state.Phase == synccommon.OperationSucceeded && state.FinishedAt != nil &&
+		state.FinishedAt.After(condition.LastTransitionTime.Time)
+}
+
+// compactAppStatus removes the revision history entries, operation results and resolved conditions of the given status
+// which are beyond the retention, and returns whether the status was modified. The conditions which still apply are
+// kept.
+func compactAppStatus(status *appv1.ApplicationStatus, revisionHistoryLimit int, retention StatusRetention, now time.Time) bool {
+	modified := false
+
+	history := status.History.Trunc(revisionHistoryLimit)
+	if retention.RevisionHistoryMaxAge > 0 {
+		for len(history) > 1 && now.Sub(history[0].DeployedAt.Time) > retention.RevisionHistoryMaxAge {
+			history = history[1:]
+		}
+	}
+	if len(history) != len(status.History) {
+		status.History = history
+		modified = true
+	}
+
+	if state := status.OperationState; retention.OperationStateMaxAge > 0 && state != nil && state.Phase.Completed() &&
+		state.FinishedAt != nil && now.Sub(state.FinishedAt.Time) > retention.OperationStateMaxAge &&
+		state.SyncResult != nil && len(state.SyncResult.Resources) > 0 {
+		state.SyncResult.Resources = nil
+		modified = true
+	}
+
+	var active, resolved []appv1.ApplicationCondition
+	for _, condition := range status.Conditions {
+		if conditionResolved(status, condition) {
+			resolved = append(resolved, condition)
+		} else {
+			active = append(active, condition)
+		}
+	}
+	if retention.ConditionsMaxAge > 0 {
+		resolved = slices.DeleteFunc(resolved, func(condition appv1.ApplicationCondition) bool {
+			return now.Sub(condition.LastTransitionTime.Time) > retention.ConditionsMaxAge
+		})
+	}
+	if retention.ConditionsMaxCount > 0 && len(resolved) > retention.ConditionsMaxCount {
+		slices.SortStableFunc(resolved, func(a, b appv1.ApplicationCondition) int {
+			return b.LastTransitionTime.Compare(a.LastTransitionTime.Time)
+		})
+		resolved = resolved[:retention.ConditionsMaxCount]
+	}
+	if len(active)+len(resolved) != len(status.Conditions) {
+		// the order of the conditions is kept
+		status.Conditions = slices.DeleteFunc(status.Conditions, func(condition appv1.ApplicationCondition) bool {
+			return conditionResolved(status, condition) && !slices.Contains(resolved, condition)
+		})
+		modified = true
+	}
+
+	return modified
+}
+
+// compactAppStatuses compacts the statuses of the applications processed by this controller
+func (ctrl *ApplicationController) compactAppStatuses() {
+	apps, err := ctrl.appLister.List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to list applications for status compaction: %v", err)
+		return
+	}
+	now := time.Now()
+	for _, app := range apps {
+		if !ctrl.canProcessApp(app) || app.Operation != nil || app.DeletionTimestamp != nil {
+			continue
+		}
+		status := app.Status.DeepCopy()
+		if !compactAppStatus(status, app.Spec.GetRevisionHistoryLimit(), ctrl.statusRetention, now) {
+			continue
+		}
+		logCtx := log.WithFields(applog.GetAppLogFields(app))
+		// the resource version is a precondition of the patch, so that the status updated by a reconciliation since the
+		// application was listed is not overwritten
+		patch, modified, err := createMergePatch(
+			&appv1.Application{Status: app.Status},
+			&appv1.Application{ObjectMeta: metav1.ObjectMeta{ResourceVersion: app.ResourceVersion}, Status: *status})
+		if err != nil {
+			logCtx.Errorf("Error constructing app status compaction patch: %v", err)
+			continue
+		}
+		if !modified {
+			continue
+		}
+		if _, err := ctrl.PatchAppWithWriteBack(context.Background(), app.Name, app.Namespace, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			if apierrors.IsConflict(err) {
+				// the application is compacted again at the next interval
+				logCtx.Debugf("Application status modified during compaction: %v", err)
+			} else {
+				logCtx.Warnf("Error compacting application status: %v", err)
+			}
+			continue
+		}
+		logCtx.Infof("Compacted application status")
+	}
+}
@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+)
+
+func TestCompactAppStatus(t *testing.T) {
+	now := time.Now()
+	ago := func(d time.Duration) *metav1.Time {
+		return &metav1.Time{Time: now.Add(-d)}
+	}
+	newStatus := func() *v1alpha1.ApplicationStatus {
+		return &v1alpha1.ApplicationStatus{
+			History: v1alpha1.RevisionHistories{
+				{ID: 1, DeployedAt: *ago(72 * time.Hour)},
+				{ID: 2, DeployedAt: *ago(48 * time.Hour)},
+				{ID: 3, DeployedAt: *ago(time.Hour)},
+			},
+			OperationState: &v1alpha1.OperationState{
+				Phase:      synccommon.OperationSucceeded,
+				FinishedAt: ago(48 * time.Hour),
+				SyncResult: &v1alpha1.SyncOperationResult{Revision: "abc", Resources: v1alpha1.ResourceResults{{Name: "guestbook-ui"}}},
+			},
+			Conditions: []v1alpha1.ApplicationCondition{
+				{Type: v1alpha1.ApplicationConditionSyncError, Message: "old", LastTransitionTime: ago(72 * time.Hour)},
+				{Type: v1alpha1.ApplicationConditionComparisonError, LastTransitionTime: ago(72 * time.Hour)},
+				{Type: v1alpha1.ApplicationConditionSyncError, Message: "recent", LastTransitionTime: ago(50 * time.Hour)},
+				{Type: v1alpha1.ApplicationConditionOrphanedResourceWarning, LastTransitionTime: ago(time.Hour)},
+			},
+		}
+	}
+
+	t.Run("NoRetention", func(t *testing.T) {
+		status := newStatus()
+		assert.False(t, compactAppStatus(status, 10, StatusRetention{}, now))
+		assert.Equal(t, newStatus(), status)
+	})
+
+	t.Run("RevisionHistoryLimit", func(t *testing.T) {
+		status := newStatus()
+		assert.True(t, compactAppStatus(status, 2, StatusRetention{}, now))
+		assert.Equal(t, newStatus().History[1:], status.History)
+	})
+
+	t.Run("RevisionHistoryMaxAge", func(t *testing.T) {
+		status := newStatus()
+		assert.True(t, compactAppStatus(status, 10, StatusRetention{RevisionHistoryMaxAge: 24 * time.Hour}, now))
+		assert.Equal(t, newStatus().History[2:], status.History)
+
+		status = newStatus()
+		assert.True(t, compactAppStatus(status, 10, StatusRetention{RevisionHistoryMaxAge: time.Minute}, now))
+		assert.Equal(t, newStatus().History[2:], status.History, "the latest entry is kept")
+	})
+
+	t.Run("OperationStateMaxAge", func(t *testing.T) {
+		status := newStatus()
+		assert.True(t, compactAppStatus(status, 10, StatusRetention{OperationStateMaxAge: 24 * time.Hour}, now))
+		assert.Empty(t, status.OperationState.SyncResult.Resources)
+		assert.Equal(t, "abc", status.OperationState.SyncResult.Revision)
+
+		status = newStatus()
+		status.OperationState.Phase = synccommon.OperationRunning
+		assert.False(t, compactAppStatus(status, 10, StatusRetention{OperationStateMaxAge: 24 * time.Hour}, now))
+	})
+
+	t.Run("ConditionsMaxAge", func(t *testing.T) {
+		status := newStatus()
+		assert.True(t, compactAppStatus(status, 10, StatusRetention{ConditionsMaxAge: 24 * time.Hour}, now))
+		// the conditions evaluated by the reconciliations still apply
+		assert.Equal(t, []v1alpha1.ApplicationCondition{newStatus().Conditions[1], newStatus().Conditions[3]}, status.Conditions)
+	})
+
+	t.Run("ConditionsMaxCount", func(t *testing.T) {
+		status := newStatus()
+		assert.True(t, compactAppStatus(status, 10, StatusRetention{ConditionsMaxCount: 1}, now))
+		assert.Equal(t, newStatus().Conditions[1:], status.Conditions)
+
+		status = newStatus()
+		assert.False(t, compactAppStatus(status, 10, StatusRetention{ConditionsMaxCount: 2}, now))
+	})
+
+	t.Run("ConditionsNotResolved", func(t *testing.T) {
+		// the sync errors are not resolved until an operation succeeds
+		status := newStatus()
+		status.OperationState.Phase = synccommon.OperationFailed
+		status.OperationState.SyncResult.Resources = nil
+		assert.False(t, compactAppStatus(status, 10, StatusRetention{ConditionsMaxAge: time.Minute, ConditionsMaxCount: 1}, now))
+	})
+}
+
+func TestCompactAppStatuses(t *testing.T) {
+	app := newFakeApp()
+	app.ResourceVersion = "42"
+	app.Status.History = v1alpha1.RevisionHistories{
+		{ID: 1, DeployedAt: metav1.NewTime(time.Now().Add(-48 * time.Hour))},
+		{ID: 2, DeployedAt: metav1.Now()},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	ctrl.statusRetention = StatusRetention{RevisionHistoryMaxAge: 24 * time.Hour}
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	ctrl.compactAppStatuses()
+
+	// the resource version is a precondition of the patch
+	assert.Equal(t, map[string]any{"resourceVersion": "42"}, receivedPatch["metadata"])
+	require.Contains(t, receivedPatch, "status")
+	assert.Len(t, receivedPatch["status"].(map[string]any)["history"], 1)
+}
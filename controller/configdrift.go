@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// configApplicationName is the name of the application bootstrapped from the declared source of the configuration
+	// of Argo CD
+	configApplicationName = "argocd-config"
+	// configApplicationResync is the period with which the argocd-config application is reconciled with the settings
+	configApplicationResync = 3 * time.Minute
+)
+
+// configObjects are the names of the ConfigMaps and Secrets holding the configuration of Argo CD, per kind
+var configObjects = map[string][]string{
+	"ConfigMap": {common.ArgoCDConfigMapName, common.ArgoCDRBACConfigMapName, common.ArgoCDCmdParamsConfigMapName},
+	"Secret":    {common.ArgoCDSecretName},
+}
+
+// ensureConfigApplication creates or updates the argocd-config application from the declared source of the
+// configuration of Argo CD. The application is never synced automatically, its sync status only reports the drift of
+// the live configuration. It is left as is if the source is not declared.
+func (ctrl *ApplicationController) ensureConfigApplication() {
+	logCtx := log.WithField("application", configApplicationName)
+	configManagement, err := ctrl.settingsMgr.GetConfigManagementSettings()
+	if err != nil {
+		logCtx.Warnf("Failed to get the config management settings: %v", err)
+		return
+	}
+	if configManagement == nil {
+		return
+	}
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace)
+	app, err := appIf.Get(context.Background(), configApplicationName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		app = &appv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: configApplicationName, Namespace: ctrl.namespace},
+			Spec: appv1.ApplicationSpec{
+				Project:     configManagement.Project,
+				Source:      configManagement.Source.DeepCopy(),
+				Destination: appv1.ApplicationDestination{Server: appv1.KubernetesInternalAPIServerAddr, Namespace: ctrl.namespace},
+			},
+		}
+		if _, err := appIf.Create(context.Background(), app, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+			logCtx.Warnf("Failed to create the application: %v", err)
+			return
+		}
+		logCtx.Info("Bootstrapped the application from the config management settings")
+		return
+	}
+	if err != nil {
+		logCtx.Warnf("Failed to get the application: %v", err)
+		return
+	}
+	if app.Spec.Project == configManagement.Project && app.Spec.Source != nil && app.Spec.Source.Equals(&configManagement.Source) {
+		return
+	}
+	app.Spec.Project = configManagement.Project
+	app.Spec.Source = configManagement.Source.DeepCopy()
+	app.Spec.Sources = nil
+	if _, err := appIf.Update(context.Background(), app, metav1.UpdateOptions{}); err != nil {
+		logCtx.Warnf("Failed to update the application: %v", err)
+		return
+	}
+	logCtx.Info("Updated the application from the config management settings")
+}
+
+// configDriftCondition returns a warning listing the configuration objects of Argo CD which are out of sync with their
+// declared state, if the given application is the argocd-config application of the controller namespace
+func configDriftCondition(app *appv1.Application, namespace string, resources []appv1.ResourceStatus) *appv1.ApplicationCondition {
+	if app.Name != configApplicationName || app.Namespace != namespace {
+		return nil
+	}
+	var drifted []string
+	for _, res := range resources {
+		if res.Group != "" || res.Namespace != namespace || res.Status != appv1.SyncStatusCodeOutOfSync {
+			continue
+		}
+		if slices.Contains(configObjects[res.Kind], res.Name) {
+			drifted = append(drifted, fmt.Sprintf("%s/%s", res.Kind, res.Name))
+		}
+	}
+	if len(drifted) == 0 {
+		return nil
+	}
+	return &appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionConfigurationDrift,
+		Message: "The live configuration of Argo CD differs from its declared state: " + strings.Join(drifted, ", "),
+	}
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func TestConfigDriftCondition(t *testing.T) {
+	app := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: configApplicationName, Namespace: test.FakeArgoCDNamespace}}
+	resources := []v1alpha1.ResourceStatus{
+		{Kind: "ConfigMap", Namespace: test.FakeArgoCDNamespace, Name: "argocd-cm", Status: v1alpha1.SyncStatusCodeOutOfSync},
+		{Kind: "ConfigMap", Namespace: test.FakeArgoCDNamespace, Name: "argocd-rbac-cm", Status: v1alpha1.SyncStatusCodeSynced},
+		{Kind: "Secret", Namespace: test.FakeArgoCDNamespace, Name: "argocd-secret", Status: v1alpha1.SyncStatusCodeOutOfSync},
+		{Kind: "ConfigMap", Namespace: test.FakeArgoCDNamespace, Name: "my-config", Status: v1alpha1.SyncStatusCodeOutOfSync},
+		{Kind: "ConfigMap", Namespace: "default", Name: "argocd-cmd-params-cm", Status: v1alpha1.SyncStatusCodeOutOfSync},
+	}
+	assert.Equal(t, &v1alpha1.ApplicationCondition{
+		Type:    v1alpha1.ApplicationConditionConfigurationDrift,
+		Message: "The live configuration of Argo CD differs from its declared state: ConfigMap/argocd-cm, Secret/argocd-secret",
+	}, configDriftCondition(app, test.FakeArgoCDNamespace, resources))
+
+	assert.Nil(t, configDriftCondition(app, test.FakeArgoCDNamespace, resources[1:2]))
+
+	otherApp := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: test.FakeArgoCDNamespace}}
+	assert.Nil(t, configDriftCondition(otherApp, test.FakeArgoCDNamespace, resources))
+}
+
+func TestEnsureConfigApplication(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{}, nil)
+		ctrl.ensureConfigApplication()
+		_, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), configApplicationName, metav1.GetOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("Bootstrap", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{configMapData: map[string]string{
+			"configManagement": "{source: {repoURL: https://github.com/argoproj/argocd-example-apps, path: argocd}}",
+		}}, nil)
+		ctrl.ensureConfigApplication()
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), configApplicationName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "default", app.Spec.Project)
+		assert.Equal(t, &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "argocd"}, app.Spec.Source)
+		assert.Equal(t, v1alpha1.ApplicationDestination{Server: v1alpha1.KubernetesInternalAPIServerAddr, Namespace: test.FakeArgoCDNamespace}, app.Spec.Destination)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		app := newFakeApp()
+		app.Name = configApplicationName
+		ctrl := newFakeController(&fakeData{
+			apps: []runtime.Object{app},
+			configMapData: map[string]string{
+				"configManagement": "{project: platform, source: {repoURL: https://github.com/argoproj/argocd-example-apps, path: argocd}}",
+			},
+		}, nil)
+		ctrl.ensureConfigApplication()
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), configApplicationName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "platform", app.Spec.Project)
+		assert.Equal(t, &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "argocd"}, app.Spec.Source)
+	})
+}
@@ -18,7 +18,7 @@ import (
 )
 
 // setApplicationHealth updates the health statuses of all resources performed in the comparison
-func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, persistResourceHealth bool) (health.HealthStatusCode, error) {
+func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, healthRollup *resourceHealthRollup, app *appv1.Application, persistResourceHealth bool) (health.HealthStatusCode, error) {
 	var savedErr error
 	var errCount uint
 
@@ -53,6 +53,15 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 				// also log so we don't lose the message
 				log.WithFields(applog.GetAppLogFields(app)).Warn(savedErr)
 			}
+			if rolledUp, err := healthRollup.apply(res.Live, healthStatus); err != nil {
+				errCount++
+				if savedErr == nil {
+					savedErr = err
+				}
+				log.WithFields(applog.GetAppLogFields(app)).Warn(err)
+			} else {
+				healthStatus = rolledUp
+			}
 		}
 
 		if healthStatus == nil {
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	kubeutil "github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/argoproj/argo-cd/v3/util/lua"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// resourceHealthRollup rolls up the health of the child resources into the health of their parent resources, as
+// configured by the resource.customizations.healthRollup.<group_kind> keys of argocd-cm
+type resourceHealthRollup struct {
+	rollups map[string][]settings.ResourceHealthRollup
+	// getChildren returns the resources owned, directly or not, by the given resource
+	getChildren func(obj *unstructured.Unstructured) ([]appv1.ResourceNode, error)
+}
+
+// apply returns the health of the given resource rolled up with the health of its child resources: the worst health
+// of the resource and of the matching children. A resource without health check is healthy if its children are.
+func (r *resourceHealthRollup) apply(obj *unstructured.Unstructured, healthStatus *health.HealthStatus) (*health.HealthStatus, error) {
+	if r == nil || obj == nil {
+		return healthStatus, nil
+	}
+	rollups := r.rollups[lua.GetConfigMapKey(obj.GroupVersionKind())]
+	if len(rollups) == 0 {
+		return healthStatus, nil
+	}
+	if healthStatus == nil {
+		healthStatus = &health.HealthStatus{Status: health.HealthStatusHealthy}
+	}
+	children, err := r.getChildren(obj)
+	if err != nil {
+		return healthStatus, fmt.Errorf("failed to get the child resources of %s %q: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	rolledUp := healthStatus
+	for _, rollup := range rollups {
+		found := false
+		for _, child := range children {
+			if !glob.Match(rollup.Group, child.Group) || !glob.Match(rollup.Kind, child.Kind) {
+				continue
+			}
+			found = true
+			if child.Health == nil || !health.IsWorse(rolledUp.Status, child.Health.Status) {
+				continue
+			}
+			message := fmt.Sprintf("%s/%s is %s", child.Kind, child.Name, child.Health.Status)
+			if child.Health.Message != "" {
+				message += ": " + child.Health.Message
+			}
+			rolledUp = &health.HealthStatus{Status: child.Health.Status, Message: message}
+		}
+		if !found && rollup.Required && health.IsWorse(rolledUp.Status, health.HealthStatusDegraded) {
+			rolledUp = &health.HealthStatus{Status: health.HealthStatusDegraded, Message: fmt.Sprintf("No child resource of kind %s found", rollup.Kind)}
+		}
+	}
+	return rolledUp, nil
+}
+
+// newResourceHealthRollup returns the health rollup of the resources of the given cluster, or nil if no health rollup
+// is configured
+func (m *appStateManager) newResourceHealthRollup(destCluster *appv1.Cluster) (*resourceHealthRollup, error) {
+	rollups, err := m.settingsMgr.GetResourceHealthRollups()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource health rollups: %w", err)
+	}
+	if len(rollups) == 0 {
+		return nil, nil
+	}
+	return &resourceHealthRollup{
+		rollups: rollups,
+		getChildren: func(obj *unstructured.Unstructured) ([]appv1.ResourceNode, error) {
+			key := kubeutil.GetResourceKey(obj)
+			var children []appv1.ResourceNode
+			err := m.liveStateCache.IterateHierarchyV2(destCluster, []kubeutil.ResourceKey{key}, func(child appv1.ResourceNode, _ string) bool {
+				if kubeutil.NewResourceKey(child.Group, child.Kind, child.Namespace, child.Name) != key {
+					children = append(children, child)
+				}
+				return true
+			})
+			return children, err
+		},
+	}, nil
+}
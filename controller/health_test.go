@@ -19,6 +19,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/lua"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
 var (
@@ -64,7 +65,7 @@ func TestSetApplicationHealth(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 	assert.Equal(t, health.HealthStatusHealthy, resourceStatuses[0].Health.Status)
@@ -73,7 +74,7 @@ func TestSetApplicationHealth(t *testing.T) {
 
 	// now mark the job as a hook and retry. it should ignore the hook and consider the app healthy
 	failedJob.SetAnnotations(map[string]string{synccommon.AnnotationKeyHook: "PreSync"})
-	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, app, true)
+	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	app.Status.Health.Status = healthStatus
@@ -83,7 +84,7 @@ func TestSetApplicationHealth(t *testing.T) {
 	failedJob.SetAnnotations(nil)
 	failedJobIgnoreHealthcheck := resourceFromFile("./testdata/job-failed-ignore-healthcheck.yaml")
 	resources[1].Target = &failedJobIgnoreHealthcheck
-	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, app, true)
+	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 }
@@ -96,7 +97,7 @@ func TestSetApplicationHealth_ResourceHealthNotPersisted(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, false)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, false)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 
@@ -111,7 +112,7 @@ func TestSetApplicationHealth_MissingResource(t *testing.T) {
 	}, {}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusMissing, healthStatus)
 }
@@ -142,7 +143,7 @@ func TestSetApplicationHealth_HealthImproves(t *testing.T) {
 		resourceStatuses := initStatuses(resources)
 
 		t.Run(string(fmt.Sprintf("%s to %s", tc.oldStatus, tc.newStatus)), func(t *testing.T) {
-			healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+			healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, nil, app, true)
 			require.NoError(t, err)
 			assert.Equal(t, tc.newStatus, healthStatus)
 		})
@@ -158,7 +159,7 @@ func TestSetApplicationHealth_MissingResourceNoBuiltHealthCheck(t *testing.T) {
 	resourceStatuses := initStatuses(resources)
 
 	t.Run("NoOverride", func(t *testing.T) {
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, true)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 		assert.Equal(t, health.HealthStatusMissing, resourceStatuses[0].Health.Status)
@@ -169,7 +170,7 @@ func TestSetApplicationHealth_MissingResourceNoBuiltHealthCheck(t *testing.T) {
 			lua.GetConfigMapKey(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}): appv1.ResourceOverride{
 				HealthLua: "some health check",
 			},
-		}, app, true)
+		}, nil, app, true)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusMissing, healthStatus)
 	})
@@ -220,7 +221,7 @@ return hs`,
 		}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, nil, app, true)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 	})
@@ -232,8 +233,77 @@ return hs`,
 		}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, nil, app, true)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	})
 }
+
+func TestResourceHealthRollup(t *testing.T) {
+	parent := &unstructured.Unstructured{}
+	parent.SetAPIVersion("example.com/v1")
+	parent.SetKind("MyApp")
+	parent.SetName("my-app")
+	healthy := &health.HealthStatus{Status: health.HealthStatusHealthy}
+
+	newRollup := func(children ...appv1.ResourceNode) *resourceHealthRollup {
+		return &resourceHealthRollup{
+			rollups: map[string][]settings.ResourceHealthRollup{
+				"example.com/MyApp": {
+					{Group: "autoscaling", Kind: "HorizontalPodAutoscaler", Required: true},
+					{Group: "policy", Kind: "PodDisruptionBudget"},
+				},
+			},
+			getChildren: func(_ *unstructured.Unstructured) ([]appv1.ResourceNode, error) {
+				return children, nil
+			},
+		}
+	}
+	newChild := func(group string, kind string, status health.HealthStatusCode, message string) appv1.ResourceNode {
+		return appv1.ResourceNode{
+			ResourceRef: appv1.ResourceRef{Group: group, Kind: kind, Name: "my-app"},
+			Health:      &appv1.HealthStatus{Status: status, Message: message},
+		}
+	}
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		var rollup *resourceHealthRollup
+		healthStatus, err := rollup.apply(parent, healthy)
+		require.NoError(t, err)
+		assert.Same(t, healthy, healthStatus)
+	})
+
+	t.Run("HealthyChildren", func(t *testing.T) {
+		healthStatus, err := newRollup(
+			newChild("autoscaling", "HorizontalPodAutoscaler", health.HealthStatusHealthy, ""),
+			newChild("apps", "Deployment", health.HealthStatusDegraded, "not rolled up"),
+		).apply(parent, healthy)
+		require.NoError(t, err)
+		assert.Equal(t, healthy, healthStatus)
+	})
+
+	t.Run("DegradedChild", func(t *testing.T) {
+		healthStatus, err := newRollup(
+			newChild("autoscaling", "HorizontalPodAutoscaler", health.HealthStatusProgressing, ""),
+			newChild("policy", "PodDisruptionBudget", health.HealthStatusDegraded, "no disruption allowed"),
+		).apply(parent, healthy)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "PodDisruptionBudget/my-app is Degraded: no disruption allowed"}, healthStatus)
+	})
+
+	t.Run("MissingRequiredChild", func(t *testing.T) {
+		healthStatus, err := newRollup(newChild("policy", "PodDisruptionBudget", health.HealthStatusHealthy, "")).apply(parent, healthy)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "No child resource of kind HorizontalPodAutoscaler found"}, healthStatus)
+	})
+
+	t.Run("ApplicationHealth", func(t *testing.T) {
+		resources := []managedResource{{Group: "example.com", Version: "v1", Kind: "MyApp", Live: parent}}
+		resourceStatuses := initStatuses(resources)
+		rollup := newRollup(newChild("autoscaling", "HorizontalPodAutoscaler", health.HealthStatusProgressing, "scaling"))
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, rollup, app, true)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus)
+		assert.Equal(t, "HorizontalPodAutoscaler/my-app is Progressing: scaling", resourceStatuses[0].Health.Message)
+	})
+}
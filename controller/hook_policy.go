@@ -0,0 +1,234 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+const (
+	// hookFailurePolicyFailFast fails the sync operation when the hook fails. This is the default policy.
+	hookFailurePolicyFailFast = "FailFast"
+	// hookFailurePolicyContinue considers the hook successful when it fails
+	hookFailurePolicyContinue = "Continue"
+	// hookFailurePolicyRetry recreates the hook when it fails, up to the given number of times
+	hookFailurePolicyRetry = "Retry"
+
+	hookRecreationTimeout = 10 * time.Second
+)
+
+// hookPolicy is the timeout and failure policy of a sync hook, configured by its annotations
+type hookPolicy struct {
+	timeout       time.Duration
+	failurePolicy string
+	retryLimit    int
+}
+
+func getHookPolicy(obj *unstructured.Unstructured) (hookPolicy, error) {
+	policy := hookPolicy{failurePolicy: hookFailurePolicyFailFast}
+	annotations := obj.GetAnnotations()
+	if value, ok := annotations[common.AnnotationHookTimeout]; ok {
+		timeout, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil || timeout <= 0 {
+			return policy, fmt.Errorf("invalid hook timeout %q", value)
+		}
+		policy.timeout = timeout
+	}
+	if value, ok := annotations[common.AnnotationHookFailurePolicy]; ok {
+		name, limit, hasLimit := strings.Cut(strings.TrimSpace(value), "=")
+		switch {
+		case name == hookFailurePolicyFailFast && !hasLimit, name == hookFailurePolicyContinue && !hasLimit:
+			policy.failurePolicy = name
+		case name == hookFailurePolicyRetry && hasLimit:
+			retryLimit, err := strconv.Atoi(limit)
+			if err != nil || retryLimit <= 0 {
+				return policy, fmt.Errorf("invalid hook retry limit %q", limit)
+			}
+			policy.failurePolicy, policy.retryLimit = name, retryLimit
+		default:
+			return policy, fmt.Errorf("invalid hook failure policy %q, must be one of %s, %s or %s=<limit>", value, hookFailurePolicyFailFast, hookFailurePolicyContinue, hookFailurePolicyRetry)
+		}
+	}
+	return policy, nil
+}
+
+// getHookAttempt returns the attempt number of the given hook, starting at 1
+func getHookAttempt(obj *unstructured.Unstructured) int {
+	if attempt, err := strconv.Atoi(obj.GetAnnotations()[common.AnnotationHookAttempt]); err == nil && attempt > 0 {
+		return attempt
+	}
+	return 1
+}
+
+// hookPolicyHealthOverride applies the timeout and failure policy of the sync hooks on top of their health, which
+// the sync engine uses to determine whether a hook is running, succeeded or failed. The failed hooks which can be
+// retried are reported as running and recorded, to be recreated by retryHooks.
+type hookPolicyHealthOverride struct {
+	health.HealthOverride
+	now func() time.Time
+
+	lock        sync.Mutex
+	failedHooks map[kube.ResourceKey]*unstructured.Unstructured
+}
+
+func newHookPolicyHealthOverride(override health.HealthOverride) *hookPolicyHealthOverride {
+	return &hookPolicyHealthOverride{
+		HealthOverride: override,
+		now:            time.Now,
+		failedHooks:    map[kube.ResourceKey]*unstructured.Unstructured{},
+	}
+}
+
+func (o *hookPolicyHealthOverride) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	if !hook.IsHook(obj) {
+		return o.HealthOverride.GetResourceHealth(obj)
+	}
+	policy, err := getHookPolicy(obj)
+	if err != nil {
+		return &health.HealthStatus{Status: health.HealthStatusDegraded, Message: err.Error()}, nil
+	}
+	healthStatus, err := health.GetResourceHealth(obj, o.HealthOverride)
+	if err != nil || healthStatus == nil {
+		return healthStatus, err
+	}
+
+	switch healthStatus.Status {
+	case health.HealthStatusProgressing, health.HealthStatusSuspended:
+		if policy.timeout == 0 || o.now().Sub(obj.GetCreationTimestamp().Time) <= policy.timeout {
+			return healthStatus, nil
+		}
+		healthStatus = &health.HealthStatus{Status: health.HealthStatusDegraded, Message: fmt.Sprintf("Hook timed out after %s", policy.timeout)}
+	case health.HealthStatusDegraded, health.HealthStatusUnknown:
+		// the hook failed
+	default:
+		return healthStatus, nil
+	}
+
+	switch policy.failurePolicy {
+	case hookFailurePolicyContinue:
+		return &health.HealthStatus{Status: health.HealthStatusHealthy, Message: "Hook failure ignored: " + healthStatus.Message}, nil
+	case hookFailurePolicyRetry:
+		if attempt := getHookAttempt(obj); attempt <= policy.retryLimit {
+			o.lock.Lock()
+			o.failedHooks[kube.GetResourceKey(obj)] = obj
+			o.lock.Unlock()
+			return &health.HealthStatus{
+				Status:  health.HealthStatusProgressing,
+				Message: fmt.Sprintf("Retrying hook (attempt %d of %d): %s", attempt+1, policy.retryLimit+1, healthStatus.Message),
+			}, nil
+		}
+	}
+	return healthStatus, nil
+}
+
+// retryHooks deletes the failed hooks recorded during the sync and creates them again from their target manifests,
+// incrementing their attempt number
+func (o *hookPolicyHealthOverride) retryHooks(ctx context.Context, kubectl kube.Kubectl, config *rest.Config, targets []*unstructured.Unstructured, logCtx *log.Entry) {
+	o.lock.Lock()
+	failedHooks := o.failedHooks
+	o.failedHooks = map[kube.ResourceKey]*unstructured.Unstructured{}
+	o.lock.Unlock()
+
+	for key, failed := range failedHooks {
+		if err := retryHook(ctx, kubectl, config, failed, targets); err != nil {
+			logCtx.Errorf("Failed to retry hook %s: %v", key.String(), err)
+			continue
+		}
+		logCtx.Infof("Retried hook %s (attempt %d)", key.String(), getHookAttempt(failed)+1)
+	}
+}
+
+func retryHook(ctx context.Context, kubectl kube.Kubectl, config *rest.Config, failed *unstructured.Unstructured, targets []*unstructured.Unstructured) error {
+	target := getHookTarget(failed, targets)
+	if target == nil {
+		return fmt.Errorf("hook %s is no longer part of the application", failed.GetName())
+	}
+	gvk := failed.GroupVersionKind()
+
+	live, err := kubectl.GetResource(ctx, config, gvk, failed.GetName(), failed.GetNamespace())
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		return fmt.Errorf("failed to get hook: %w", err)
+	case live.GetUID() != failed.GetUID():
+		// the hook was already recreated, the live state cache is not up-to-date yet
+		return nil
+	default:
+		var finalizers []string
+		for _, finalizer := range live.GetFinalizers() {
+			if finalizer != hook.HookFinalizer {
+				finalizers = append(finalizers, finalizer)
+			}
+		}
+		patch, err := json.Marshal(map[string]any{"metadata": map[string]any{"finalizers": finalizers}})
+		if err != nil {
+			return fmt.Errorf("failed to marshal hook finalizers patch: %w", err)
+		}
+		if _, err := kubectl.PatchResource(ctx, config, gvk, live.GetName(), live.GetNamespace(), types.MergePatchType, patch); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to remove hook finalizer: %w", err)
+		}
+		propagationPolicy := metav1.DeletePropagationBackground
+		err = kubectl.DeleteResource(ctx, config, gvk, live.GetName(), live.GetNamespace(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete hook: %w", err)
+		}
+	}
+
+	annotations := target.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[common.AnnotationHookAttempt] = strconv.Itoa(getHookAttempt(failed) + 1)
+	target.SetAnnotations(annotations)
+
+	// the deletion of the previous attempt may take a moment to complete
+	return wait.PollUntilContextTimeout(ctx, time.Second, hookRecreationTimeout, true, func(ctx context.Context) (bool, error) {
+		_, err := kubectl.CreateResource(ctx, config, gvk, target.GetName(), target.GetNamespace(), target, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to create hook: %w", err)
+		}
+		return true, nil
+	})
+}
+
+// getHookTarget returns a copy of the target manifest of the given live hook, named like the live hook when its name
+// was generated
+func getHookTarget(live *unstructured.Unstructured, targets []*unstructured.Unstructured) *unstructured.Unstructured {
+	for _, target := range targets {
+		if target.GroupVersionKind().GroupKind() != live.GroupVersionKind().GroupKind() ||
+			(target.GetNamespace() != "" && target.GetNamespace() != live.GetNamespace()) {
+			continue
+		}
+		if target.GetName() != live.GetName() && (target.GetName() != "" || target.GetGenerateName() == "" || !strings.HasPrefix(live.GetName(), target.GetGenerateName())) {
+			continue
+		}
+		obj := target.DeepCopy()
+		obj.SetName(live.GetName())
+		obj.SetNamespace(live.GetNamespace())
+		if !hook.HasHookFinalizer(obj) {
+			obj.SetFinalizers(append(obj.GetFinalizers(), hook.HookFinalizer))
+		}
+		return obj
+	}
+	return nil
+}
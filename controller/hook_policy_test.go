@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/lua"
+)
+
+func newHookPod(phase string, annotations map[string]string) *unstructured.Unstructured {
+	pod := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"spec":       map[string]any{"restartPolicy": "Never"},
+		"status":     map[string]any{"phase": phase},
+	}}
+	pod.SetName("post-sync")
+	pod.SetNamespace("default")
+	pod.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-10 * time.Minute)))
+	hookAnnotations := map[string]string{"argocd.argoproj.io/hook": "PostSync"}
+	for k, v := range annotations {
+		hookAnnotations[k] = v
+	}
+	pod.SetAnnotations(hookAnnotations)
+	return pod
+}
+
+func TestGetHookPolicy(t *testing.T) {
+	policy, err := getHookPolicy(newHookPod("Running", nil))
+	require.NoError(t, err)
+	assert.Equal(t, hookPolicy{failurePolicy: hookFailurePolicyFailFast}, policy)
+
+	policy, err = getHookPolicy(newHookPod("Running", map[string]string{
+		common.AnnotationHookTimeout:       "5m",
+		common.AnnotationHookFailurePolicy: "Retry=3",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, hookPolicy{timeout: 5 * time.Minute, failurePolicy: hookFailurePolicyRetry, retryLimit: 3}, policy)
+
+	for name, annotations := range map[string]map[string]string{
+		"InvalidTimeout":    {common.AnnotationHookTimeout: "five minutes"},
+		"NegativeTimeout":   {common.AnnotationHookTimeout: "-5m"},
+		"UnknownPolicy":     {common.AnnotationHookFailurePolicy: "Ignore"},
+		"MissingRetryLimit": {common.AnnotationHookFailurePolicy: "Retry"},
+		"InvalidRetryLimit": {common.AnnotationHookFailurePolicy: "Retry=0"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := getHookPolicy(newHookPod("Running", annotations))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestHookPolicyHealthOverride(t *testing.T) {
+	getHealth := func(pod *unstructured.Unstructured) (*hookPolicyHealthOverride, *health.HealthStatus) {
+		override := newHookPolicyHealthOverride(lua.ResourceHealthOverrides{})
+		healthStatus, err := health.GetResourceHealth(pod, override)
+		require.NoError(t, err)
+		return override, healthStatus
+	}
+
+	t.Run("NotHook", func(t *testing.T) {
+		pod := newHookPod("Failed", map[string]string{common.AnnotationHookFailurePolicy: "Continue"})
+		pod.SetAnnotations(nil)
+		_, healthStatus := getHealth(pod)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+	})
+
+	t.Run("FailFast", func(t *testing.T) {
+		_, healthStatus := getHealth(newHookPod("Failed", nil))
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+	})
+
+	t.Run("InvalidPolicy", func(t *testing.T) {
+		_, healthStatus := getHealth(newHookPod("Succeeded", map[string]string{common.AnnotationHookTimeout: "soon"}))
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: `invalid hook timeout "soon"`}, healthStatus)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		_, healthStatus := getHealth(newHookPod("Running", map[string]string{common.AnnotationHookTimeout: "5m"}))
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "Hook timed out after 5m0s"}, healthStatus)
+
+		_, healthStatus = getHealth(newHookPod("Running", map[string]string{common.AnnotationHookTimeout: "1h"}))
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+	})
+
+	t.Run("Continue", func(t *testing.T) {
+		_, healthStatus := getHealth(newHookPod("Running", map[string]string{
+			common.AnnotationHookTimeout:       "5m",
+			common.AnnotationHookFailurePolicy: "Continue",
+		}))
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy, Message: "Hook failure ignored: Hook timed out after 5m0s"}, healthStatus)
+	})
+
+	t.Run("Retry", func(t *testing.T) {
+		pod := newHookPod("Failed", map[string]string{common.AnnotationHookFailurePolicy: "Retry=2"})
+		override, healthStatus := getHealth(pod)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+		assert.Contains(t, healthStatus.Message, "Retrying hook (attempt 2 of 3)")
+		assert.Len(t, override.failedHooks, 1)
+
+		pod.SetAnnotations(map[string]string{
+			"argocd.argoproj.io/hook":          "PostSync",
+			common.AnnotationHookFailurePolicy: "Retry=2",
+			common.AnnotationHookAttempt:       "3",
+		})
+		override, healthStatus = getHealth(pod)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+		assert.Empty(t, override.failedHooks)
+	})
+}
+
+func TestGetHookTarget(t *testing.T) {
+	live := newHookPod("Failed", nil)
+	live.SetName("post-sync-abc1234-postsync-1700000000")
+
+	named := newHookPod("", nil)
+	named.SetNamespace("")
+	generated := newHookPod("", nil)
+	generated.SetName("")
+	generated.SetGenerateName("post-sync-")
+
+	assert.Nil(t, getHookTarget(live, []*unstructured.Unstructured{named}))
+
+	target := getHookTarget(live, []*unstructured.Unstructured{named, generated})
+	require.NotNil(t, target)
+	assert.Equal(t, live.GetName(), target.GetName())
+	assert.Equal(t, "default", target.GetNamespace())
+	assert.True(t, hook.HasHookFinalizer(target))
+	assert.Empty(t, generated.GetFinalizers(), "the target manifest is not modified")
+}
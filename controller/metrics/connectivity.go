@@ -0,0 +1,312 @@
+package metrics
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/client-go/rest"
+)
+
+// connectivityWindow is the period the recent requests to the clusters are counted over. The recent requests are the
+// requests of the current and of the previous windows.
+const connectivityWindow = 5 * time.Minute
+
+// ClusterConnectivity is the connectivity of the cluster cache to a cluster
+type ClusterConnectivity struct {
+	// WatchDisconnects is the number of the watches of the cluster disconnected by an error
+	WatchDisconnects int64
+	// Watches is the number of the open watches of the cluster
+	Watches int64
+	// ListLatency is the average duration of the recent list requests
+	ListLatency time.Duration
+	// Requests is the number of the recent requests to the cluster
+	Requests int64
+	// FailedRequests is the number of the recent requests to the cluster which failed
+	FailedRequests int64
+	// Unreachable is whether the requests to the cluster failed to reach it since the last request which reached it
+	Unreachable bool
+}
+
+// HasClustersConnectivity is implemented by the sources of the information about the clusters which also track the
+// connectivity to the clusters
+type HasClustersConnectivity interface {
+	GetClusterConnectivity(server string) ClusterConnectivity
+}
+
+type connectivityCounts struct {
+	requests       int64
+	failedRequests int64
+	lists          int64
+	listDuration   time.Duration
+}
+
+type clusterConnectivity struct {
+	watchDisconnects int64
+	watches          int64
+	unreachable      bool
+	windowStart      time.Time
+	current          connectivityCounts
+	previous         connectivityCounts
+}
+
+// rotate starts a new window if the current one is over
+func (c *clusterConnectivity) rotate(now time.Time) {
+	elapsed := now.Sub(c.windowStart)
+	if elapsed < connectivityWindow {
+		return
+	}
+	if elapsed < 2*connectivityWindow {
+		c.previous = c.current
+	} else {
+		c.previous = connectivityCounts{}
+	}
+	c.current = connectivityCounts{}
+	c.windowStart = now
+}
+
+// ClusterConnectivityTracker tracks the watch disconnects, the list latencies and the failed requests of the cluster
+// caches, by wrapping the transport of their configs. A nil tracker tracks nothing.
+type ClusterConnectivityTracker struct {
+	metricsServer *MetricsServer
+	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
+	now func() time.Time
+
+	lock     sync.Mutex
+	clusters map[string]*clusterConnectivity
+}
+
+// NewClusterConnectivityTracker returns a tracker recording the connectivity to the clusters in the given metrics
+// server, if any
+func NewClusterConnectivityTracker(metricsServer *MetricsServer) *ClusterConnectivityTracker {
+	return &ClusterConnectivityTracker{
+		metricsServer: metricsServer,
+		now:           time.Now,
+		clusters:      map[string]*clusterConnectivity{},
+	}
+}
+
+// WrapConfig returns a copy of the given config of the given cluster whose requests are tracked
+func (t *ClusterConnectivityTracker) WrapConfig(server string, config *rest.Config) *rest.Config {
+	if t == nil {
+		return config
+	}
+	config = rest.CopyConfig(config)
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &connectivityRoundTripper{tracker: t, server: server, next: rt}
+	})
+	return config
+}
+
+// GetClusterConnectivity returns the connectivity to the given cluster
+func (t *ClusterConnectivityTracker) GetClusterConnectivity(server string) ClusterConnectivity {
+	if t == nil {
+		return ClusterConnectivity{}
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	c, ok := t.clusters[server]
+	if !ok {
+		return ClusterConnectivity{}
+	}
+	c.rotate(t.now())
+	res := ClusterConnectivity{
+		WatchDisconnects: c.watchDisconnects,
+		Watches:          c.watches,
+		Requests:         c.current.requests + c.previous.requests,
+		FailedRequests:   c.current.failedRequests + c.previous.failedRequests,
+		Unreachable:      c.unreachable,
+	}
+	if lists := c.current.lists + c.previous.lists; lists > 0 {
+		res.ListLatency = (c.current.listDuration + c.previous.listDuration) / time.Duration(lists)
+	}
+	return res
+}
+
+// Forget removes the connectivity of the given cluster, which is no longer cached
+func (t *ClusterConnectivityTracker) Forget(server string) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.clusters, server)
+}
+
+func (t *ClusterConnectivityTracker) update(server string, update func(c *clusterConnectivity)) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	c, ok := t.clusters[server]
+	if !ok {
+		c = &clusterConnectivity{windowStart: t.now()}
+		t.clusters[server] = c
+	}
+	c.rotate(t.now())
+	update(c)
+}
+
+func (t *ClusterConnectivityTracker) recordRequest(server string, failed bool, reached bool) {
+	t.update(server, func(c *clusterConnectivity) {
+		c.current.requests++
+		if failed {
+			c.current.failedRequests++
+		}
+		c.unreachable = !reached
+	})
+	if t.metricsServer != nil {
+		t.metricsServer.IncClusterRequest(server, failed)
+	}
+}
+
+func (t *ClusterConnectivityTracker) recordList(server string, duration time.Duration) {
+	t.update(server, func(c *clusterConnectivity) {
+		c.current.lists++
+		c.current.listDuration += duration
+	})
+	if t.metricsServer != nil {
+		t.metricsServer.ObserveClusterListDuration(server, duration)
+	}
+}
+
+func (t *ClusterConnectivityTracker) recordWatchDisconnect(server string) {
+	t.update(server, func(c *clusterConnectivity) {
+		c.watchDisconnects++
+	})
+	if t.metricsServer != nil {
+		t.metricsServer.IncClusterWatchDisconnect(server)
+	}
+}
+
+func (t *ClusterConnectivityTracker) recordWatch(server string, delta int64) {
+	t.update(server, func(c *clusterConnectivity) {
+		c.watches += delta
+	})
+}
+
+type connectivityRoundTripper struct {
+	tracker *ClusterConnectivityTracker
+	server  string
+	next    http.RoundTripper
+}
+
+func (rt *connectivityRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := rt.tracker.now()
+	resp, err := rt.next.RoundTrip(req)
+	if req.Context().Err() != nil {
+		// the requests canceled by the cluster cache, e.g. the watches stopped on resync, are not tracked
+		return resp, err
+	}
+	// the requests failing in the proxies in front of the API server of the cluster do not reach it either
+	reached := err == nil && resp.StatusCode != http.StatusBadGateway && resp.StatusCode != http.StatusServiceUnavailable && resp.StatusCode != http.StatusGatewayTimeout
+	failed := err != nil || resp.StatusCode >= http.StatusInternalServerError
+	rt.tracker.recordRequest(rt.server, failed, reached)
+
+	switch {
+	case isWatchRequest(req):
+		if failed {
+			rt.tracker.recordWatchDisconnect(rt.server)
+		} else {
+			rt.tracker.recordWatch(rt.server, 1)
+			resp.Body = &watchBody{ReadCloser: resp.Body, req: req, onDisconnect: func() {
+				rt.tracker.recordWatchDisconnect(rt.server)
+			}, onClose: func() {
+				rt.tracker.recordWatch(rt.server, -1)
+			}}
+		}
+	case !failed && isListRequest(req):
+		// the duration of the lists includes the transfer of the listed resources
+		resp.Body = &listBody{ReadCloser: resp.Body, onClose: func() {
+			rt.tracker.recordList(rt.server, rt.tracker.now().Sub(start))
+		}}
+	}
+	return resp, err
+}
+
+// watchBody records the disconnect of the watch if reading its events fails before it is closed, and the close of the
+// watch
+type watchBody struct {
+	io.ReadCloser
+	req          *http.Request
+	onDisconnect func()
+	onClose      func()
+	closed       atomic.Bool
+	once         sync.Once
+	closeOnce    sync.Once
+}
+
+func (b *watchBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) && !b.closed.Load() && b.req.Context().Err() == nil {
+		b.once.Do(b.onDisconnect)
+	}
+	return n, err
+}
+
+func (b *watchBody) Close() error {
+	b.closed.Store(true)
+	b.closeOnce.Do(b.onClose)
+	return b.ReadCloser.Close()
+}
+
+// listBody records the duration of the list once its response is closed
+type listBody struct {
+	io.ReadCloser
+	onClose func()
+	once    sync.Once
+}
+
+func (b *listBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.onClose)
+	return err
+}
+
+func isWatchRequest(req *http.Request) bool {
+	if req.Method != http.MethodGet {
+		return false
+	}
+	watch := req.URL.Query().Get("watch")
+	return watch == "true" || watch == "1"
+}
+
+// ListedResource returns the type of the resources listed by the given request, e.g. "apps/v1/deployments", if the
+// request lists the resources of a type, i.e. gets the resources of a type rather than a single resource, either
+// cluster-wide or in a namespace
+func ListedResource(req *http.Request) (string, bool) {
+	if req.Method != http.MethodGet || isWatchRequest(req) {
+		return "", false
+	}
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	// the API server of the cluster may be served under a path, e.g. behind a proxy
+	prefix := slices.IndexFunc(segments, func(segment string) bool {
+		return segment == "api" || segment == "apis"
+	})
+	var groupVersion []string
+	switch {
+	case prefix < 0:
+		return "", false
+	case segments[prefix] == "api" && len(segments) >= prefix+2:
+		groupVersion, segments = segments[prefix+1:prefix+2], segments[prefix+2:]
+	case segments[prefix] == "apis" && len(segments) >= prefix+3:
+		groupVersion, segments = segments[prefix+1:prefix+3], segments[prefix+3:]
+	default:
+		return "", false
+	}
+	if len(segments) >= 3 && segments[0] == "namespaces" {
+		segments = segments[2:]
+	}
+	if len(segments) != 1 {
+		return "", false
+	}
+	return strings.Join(append(slices.Clone(groupVersion), segments[0]), "/"), true
+}
+
+func isListRequest(req *http.Request) bool {
+	_, ok := ListedResource(req)
+	return ok
+}
@@ -0,0 +1,130 @@
+package metrics
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRoundTripper func(req *http.Request) (*http.Response, error)
+
+func (f fakeRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(_ []byte) (int, error) {
+	return 0, errors.New("connection reset by peer")
+}
+
+func TestListedResource(t *testing.T) {
+	for path, expected := range map[string]string{
+		"/api/v1/pods":                                    "v1/pods",
+		"/api/v1/namespaces":                              "v1/namespaces",
+		"/api/v1/namespaces/default":                      "",
+		"/api/v1/namespaces/default/pods":                 "v1/pods",
+		"/api/v1/namespaces/default/pods/my-pod":          "",
+		"/apis/apps/v1/deployments":                       "apps/v1/deployments",
+		"/apis/apps/v1/namespaces/default/deployments":    "apps/v1/deployments",
+		"/apis/apps/v1/namespaces/default/deployments/my": "",
+		"/k8s/clusters/c-1/api/v1/pods":                   "v1/pods",
+		"/version":                                        "",
+	} {
+		req, err := http.NewRequest(http.MethodGet, "https://cluster"+path, http.NoBody)
+		require.NoError(t, err)
+		resource, ok := ListedResource(req)
+		assert.Equal(t, expected, resource, path)
+		assert.Equal(t, expected != "", ok, path)
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://cluster/api/v1/pods?watch=true", http.NoBody)
+	require.NoError(t, err)
+	assert.False(t, isListRequest(req))
+	assert.True(t, isWatchRequest(req))
+}
+
+func TestClusterConnectivityTracker(t *testing.T) {
+	now := time.Now()
+	tracker := NewClusterConnectivityTracker(nil)
+	tracker.now = func() time.Time {
+		return now
+	}
+
+	var status int
+	var body io.Reader
+	var transportErr error
+	rt := &connectivityRoundTripper{tracker: tracker, server: "https://cluster", next: fakeRoundTripper(func(_ *http.Request) (*http.Response, error) {
+		now = now.Add(time.Second)
+		if transportErr != nil {
+			return nil, transportErr
+		}
+		return &http.Response{StatusCode: status, Body: io.NopCloser(body)}, nil
+	})}
+	do := func(url string) {
+		req, err := http.NewRequest(http.MethodGet, url, http.NoBody)
+		require.NoError(t, err)
+		resp, err := rt.RoundTrip(req)
+		if err == nil {
+			_, _ = io.ReadAll(resp.Body)
+			now = now.Add(time.Second)
+			require.NoError(t, resp.Body.Close())
+		}
+	}
+
+	status, body = http.StatusOK, strings.NewReader("{}")
+	do("https://cluster/api/v1/pods")
+	body = strings.NewReader("{}")
+	do("https://cluster/api/v1/namespaces/default/pods/my-pod")
+	connectivity := tracker.GetClusterConnectivity("https://cluster")
+	assert.Equal(t, int64(2), connectivity.Requests)
+	assert.Zero(t, connectivity.FailedRequests)
+	// the duration of the lists includes the transfer of the listed resources
+	assert.Equal(t, 2*time.Second, connectivity.ListLatency)
+	assert.False(t, connectivity.Unreachable)
+
+	// the watches disconnected by an error are counted
+	body = failingReader{}
+	do("https://cluster/api/v1/pods?watch=true")
+	assert.Equal(t, int64(1), tracker.GetClusterConnectivity("https://cluster").WatchDisconnects)
+
+	// the open watches are counted until they are closed
+	body = strings.NewReader("{}")
+	req, err := http.NewRequest(http.MethodGet, "https://cluster/api/v1/pods?watch=true", http.NoBody)
+	require.NoError(t, err)
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), tracker.GetClusterConnectivity("https://cluster").Watches)
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, resp.Body.Close())
+	assert.Zero(t, tracker.GetClusterConnectivity("https://cluster").Watches)
+
+	// the errors of the API server do not make the cluster unreachable, unlike the transport errors
+	status, body = http.StatusInternalServerError, strings.NewReader("{}")
+	do("https://cluster/api/v1/pods")
+	connectivity = tracker.GetClusterConnectivity("https://cluster")
+	assert.Equal(t, int64(1), connectivity.FailedRequests)
+	assert.False(t, connectivity.Unreachable)
+	transportErr = errors.New("dial tcp: connection refused")
+	do("https://cluster/api/v1/pods?watch=true")
+	connectivity = tracker.GetClusterConnectivity("https://cluster")
+	assert.Equal(t, int64(6), connectivity.Requests)
+	assert.Equal(t, int64(2), connectivity.FailedRequests)
+	assert.Equal(t, int64(2), connectivity.WatchDisconnects)
+	assert.True(t, connectivity.Unreachable)
+
+	// the requests are counted over the recent windows only
+	now = now.Add(2 * connectivityWindow)
+	connectivity = tracker.GetClusterConnectivity("https://cluster")
+	assert.Zero(t, connectivity.Requests)
+	assert.Zero(t, connectivity.ListLatency)
+	assert.Equal(t, int64(2), connectivity.WatchDisconnects)
+
+	tracker.Forget("https://cluster")
+	assert.Equal(t, ClusterConnectivity{}, tracker.GetClusterConnectivity("https://cluster"))
+}
@@ -39,6 +39,13 @@ type MetricsServer struct {
 	orphanedResourcesGauge            *prometheus.GaugeVec
 	k8sRequestCounter                 *prometheus.CounterVec
 	clusterEventsCounter              *prometheus.CounterVec
+	syncThrottledRequestCounter       *prometheus.CounterVec
+	syncThrottledDuration             *prometheus.CounterVec
+	clusterWatchDisconnectCounter     *prometheus.CounterVec
+	clusterListHistogram              *prometheus.HistogramVec
+	clusterRequestCounter             *prometheus.CounterVec
+	clusterCachePrimingGauge          *prometheus.GaugeVec
+	clusterCachePrimingHistogram      *prometheus.HistogramVec
 	redisRequestCounter               *prometheus.CounterVec
 	reconcileHistogram                *prometheus.HistogramVec
 	redisRequestHistogram             *prometheus.HistogramVec
@@ -69,6 +76,13 @@ var (
 		nil,
 	)
 
+	descAppStatusSize = prometheus.NewDesc(
+		"argocd_app_status_size_bytes",
+		"Approximate size of the application status in bytes.",
+		append(descAppDefaultLabels, "field"),
+		nil,
+	)
+
 	syncCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "argocd_app_sync_total",
@@ -118,6 +132,43 @@ var (
 		Help: "Number of processes k8s resource events.",
 	}, append(descClusterDefaultLabels, "group", "kind"))
 
+	syncThrottledRequestCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_cluster_sync_throttled_requests_total",
+		Help: "Number of sync requests delayed by the sync rate limit of the cluster.",
+	}, descClusterDefaultLabels)
+
+	syncThrottledDuration = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_cluster_sync_throttled_duration_seconds_total",
+		Help: "Delay of the sync requests by the sync rate limit of the cluster in seconds total.",
+	}, descClusterDefaultLabels)
+
+	clusterWatchDisconnectCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_cluster_watch_disconnects_total",
+		Help: "Number of watches of the cluster disconnected by an error.",
+	}, descClusterDefaultLabels)
+
+	clusterListHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "argocd_cluster_list_duration_seconds",
+		Help:    "Duration of the list requests of the cluster cache in seconds.",
+		Buckets: []float64{0.1, 0.25, .5, 1, 2, 5, 10, 30},
+	}, descClusterDefaultLabels)
+
+	clusterRequestCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_cluster_requests_total",
+		Help: "Number of requests of the cluster cache to the cluster.",
+	}, append(descClusterDefaultLabels, "failed"))
+
+	clusterCachePrimingGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_cluster_cache_priming_clusters",
+		Help: "Number of clusters whose cache is waiting to be primed or being primed.",
+	}, []string{"state"})
+
+	clusterCachePrimingHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "argocd_cluster_cache_priming_duration_seconds",
+		Help:    "Duration of the initial synchronization of the cluster cache in seconds.",
+		Buckets: []float64{1, 5, 10, 30, 60, 120, 300, 600},
+	}, descClusterDefaultLabels)
+
 	redisRequestCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "argocd_redis_request_total",
@@ -204,6 +255,13 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(orphanedResourcesGauge)
 	registry.MustRegister(reconcileHistogram)
 	registry.MustRegister(clusterEventsCounter)
+	registry.MustRegister(syncThrottledRequestCounter)
+	registry.MustRegister(syncThrottledDuration)
+	registry.MustRegister(clusterWatchDisconnectCounter)
+	registry.MustRegister(clusterListHistogram)
+	registry.MustRegister(clusterRequestCounter)
+	registry.MustRegister(clusterCachePrimingGauge)
+	registry.MustRegister(clusterCachePrimingHistogram)
 	registry.MustRegister(redisRequestCounter)
 	registry.MustRegister(redisRequestHistogram)
 	registry.MustRegister(resourceEventsProcessingHistogram)
@@ -226,6 +284,13 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		orphanedResourcesGauge:            orphanedResourcesGauge,
 		reconcileHistogram:                reconcileHistogram,
 		clusterEventsCounter:              clusterEventsCounter,
+		syncThrottledRequestCounter:       syncThrottledRequestCounter,
+		syncThrottledDuration:             syncThrottledDuration,
+		clusterWatchDisconnectCounter:     clusterWatchDisconnectCounter,
+		clusterListHistogram:              clusterListHistogram,
+		clusterRequestCounter:             clusterRequestCounter,
+		clusterCachePrimingGauge:          clusterCachePrimingGauge,
+		clusterCachePrimingHistogram:      clusterCachePrimingHistogram,
 		redisRequestCounter:               redisRequestCounter,
 		redisRequestHistogram:             redisRequestHistogram,
 		resourceEventsProcessingHistogram: resourceEventsProcessingHistogram,
@@ -283,6 +348,38 @@ func (m *MetricsServer) IncClusterEventsCount(server, group, kind string) {
 	m.clusterEventsCounter.WithLabelValues(server, group, kind).Inc()
 }
 
+// IncSyncThrottledRequest increments the number and the delay of the sync requests throttled by the sync rate limit
+// of a cluster
+func (m *MetricsServer) IncSyncThrottledRequest(server string, delay time.Duration) {
+	m.syncThrottledRequestCounter.WithLabelValues(server).Inc()
+	m.syncThrottledDuration.WithLabelValues(server).Add(delay.Seconds())
+}
+
+// IncClusterWatchDisconnect increments the number of the watches of the cluster disconnected by an error
+func (m *MetricsServer) IncClusterWatchDisconnect(server string) {
+	m.clusterWatchDisconnectCounter.WithLabelValues(server).Inc()
+}
+
+// ObserveClusterListDuration observes the duration of a list request of the cluster cache
+func (m *MetricsServer) ObserveClusterListDuration(server string, duration time.Duration) {
+	m.clusterListHistogram.WithLabelValues(server).Observe(duration.Seconds())
+}
+
+// IncClusterRequest increments the number of the requests of the cluster cache to the cluster
+func (m *MetricsServer) IncClusterRequest(server string, failed bool) {
+	m.clusterRequestCounter.WithLabelValues(server, strconv.FormatBool(failed)).Inc()
+}
+
+// AddClusterCachePriming adds the given delta to the number of the clusters whose cache is in the given priming state
+func (m *MetricsServer) AddClusterCachePriming(state string, delta float64) {
+	m.clusterCachePrimingGauge.WithLabelValues(state).Add(delta)
+}
+
+// ObserveClusterCachePrimingDuration observes the duration of the initial synchronization of the cluster cache
+func (m *MetricsServer) ObserveClusterCachePrimingDuration(server string, duration time.Duration) {
+	m.clusterCachePrimingHistogram.WithLabelValues(server).Observe(duration.Seconds())
+}
+
 // IncKubernetesRequest increments the kubernetes requests counter for an application
 func (m *MetricsServer) IncKubernetesRequest(app *argoappv1.Application, server, statusCode, verb, resourceKind, resourceNamespace string) {
 	var namespace, name, project string
@@ -339,6 +436,8 @@ func (m *MetricsServer) SetExpiration(cacheExpiration time.Duration) error {
 		m.orphanedResourcesGauge.Reset()
 		m.k8sRequestCounter.Reset()
 		m.clusterEventsCounter.Reset()
+		m.syncThrottledRequestCounter.Reset()
+		m.syncThrottledDuration.Reset()
 		m.redisRequestCounter.Reset()
 		m.reconcileHistogram.Reset()
 		m.redisRequestHistogram.Reset()
@@ -389,6 +488,7 @@ func (c *appCollector) Describe(ch chan<- *prometheus.Desc) {
 		ch <- descAppConditions
 	}
 	ch <- descAppInfo
+	ch <- descAppStatusSize
 }
 
 // Collect implements the prometheus.Collector interface
@@ -450,6 +550,19 @@ func (c *appCollector) collectApps(ch chan<- prometheus.Metric, app *argoappv1.A
 
 	addGauge(descAppInfo, 1, strconv.FormatBool(autoSyncEnabled), git.NormalizeGitURL(app.Spec.GetSource().RepoURL), destServer, app.Spec.Destination.Namespace, string(syncStatus), string(healthStatus), operation)
 
+	historySize := 0
+	for i := range app.Status.History {
+		historySize += app.Status.History[i].Size()
+	}
+	conditionsSize := 0
+	for i := range app.Status.Conditions {
+		conditionsSize += app.Status.Conditions[i].Size()
+	}
+	addGauge(descAppStatusSize, float64(app.Status.Size()), "total")
+	addGauge(descAppStatusSize, float64(historySize), "history")
+	addGauge(descAppStatusSize, float64(app.Status.OperationState.Size()), "operationState")
+	addGauge(descAppStatusSize, float64(conditionsSize), "conditions")
+
 	if len(c.appLabels) > 0 {
 		labelValues := []string{}
 		for _, desiredLabel := range c.appLabels {
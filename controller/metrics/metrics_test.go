@@ -374,6 +374,17 @@ argocd_app_info{autosync_enabled="false",dest_namespace="dummy-namespace",dest_s
 	}
 }
 
+func TestMetricsAppStatusSize(t *testing.T) {
+	expectedResponse := `
+# HELP argocd_app_status_size_bytes Approximate size of the application status in bytes.
+# TYPE argocd_app_status_size_bytes gauge
+argocd_app_status_size_bytes{field="conditions",name="my-app",namespace="argocd",project="important-project"} 0
+argocd_app_status_size_bytes{field="history",name="my-app",namespace="argocd",project="important-project"} 0
+argocd_app_status_size_bytes{field="operationState",name="my-app",namespace="argocd",project="important-project"} 0
+`
+	testApp(t, []string{fakeApp}, expectedResponse)
+}
+
 func TestMetricLabels(t *testing.T) {
 	type testCases struct {
 		testCombination
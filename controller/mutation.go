@@ -0,0 +1,189 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	defaultMutationWebhookTimeout = 10 * time.Second
+	// mutationCacheExpiration is the expiration of the cached mutations, which are only computed again when the
+	// rendered resources or the webhooks change
+	mutationCacheExpiration = time.Hour
+	maxMutationResponseSize = 10 * 1024 * 1024
+)
+
+// resourceMutationRequest is the body of the requests sent to the resource mutation webhooks
+type resourceMutationRequest struct {
+	Application struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+		Project   string `json:"project"`
+	} `json:"application"`
+	Object *unstructured.Unstructured `json:"object"`
+}
+
+// resourceMutationResponse is the body of the responses of the resource mutation webhooks. The object is unchanged if
+// the response holds no object.
+type resourceMutationResponse struct {
+	Object *unstructured.Unstructured `json:"object,omitempty"`
+}
+
+// resourceMutator passes the rendered resources of the applications through the mutation webhooks configured in
+// argocd-cm, so that the mutations are part of the desired state of the resources
+type resourceMutator struct {
+	settingsMgr *settings.SettingsManager
+	cache       *gocache.Cache
+}
+
+func newResourceMutator(settingsMgr *settings.SettingsManager) *resourceMutator {
+	return &resourceMutator{
+		settingsMgr: settingsMgr,
+		cache:       gocache.New(mutationCacheExpiration, mutationCacheExpiration),
+	}
+}
+
+// mutate replaces the given resources with their mutations. The resources which could not be mutated by a webhook with
+// the Fail failure policy are reported as errors.
+func (m *resourceMutator) mutate(app *v1alpha1.Application, targetObjs []*unstructured.Unstructured) ([]error, error) {
+	if m == nil {
+		return nil, nil
+	}
+	webhooks, err := m.settingsMgr.GetResourceMutationWebhooks()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource mutation webhooks: %w", err)
+	}
+	if len(webhooks) == 0 {
+		return nil, nil
+	}
+	var errs []error
+	for _, obj := range targetObjs {
+		gvk := obj.GroupVersionKind()
+		for _, webhook := range webhooks {
+			if (webhook.Group != "" && !glob.Match(webhook.Group, gvk.Group)) || (webhook.Kind != "" && !glob.Match(webhook.Kind, gvk.Kind)) {
+				continue
+			}
+			mutated, err := m.mutateWithWebhook(app, obj, webhook)
+			if err != nil {
+				if webhook.FailurePolicy != "Ignore" {
+					errs = append(errs, fmt.Errorf("resource mutation webhook %s failed to mutate %s/%s: %w", webhook.Name, gvk.Kind, obj.GetName(), err))
+				}
+				continue
+			}
+			if mutated != nil {
+				obj.Object = mutated.Object
+			}
+		}
+	}
+	return errs, nil
+}
+
+// mutateWithWebhook returns the mutation of the given resource by the given webhook, or nil if it is unchanged
+func (m *resourceMutator) mutateWithWebhook(app *v1alpha1.Application, obj *unstructured.Unstructured, webhook settings.ResourceMutationWebhook) (*unstructured.Unstructured, error) {
+	var request resourceMutationRequest
+	request.Application.Name = app.Name
+	request.Application.Namespace = app.Namespace
+	request.Application.Project = app.Spec.GetProject()
+	request.Object = obj
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal mutation request: %w", err)
+	}
+	webhookConfig, err := json.Marshal(webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal webhook configuration: %w", err)
+	}
+	hash := sha256.Sum256(append(webhookConfig, body...))
+	cacheKey := hex.EncodeToString(hash[:])
+	if cached, ok := m.cache.Get(cacheKey); ok {
+		if cached == nil {
+			return nil, nil
+		}
+		return cached.(*unstructured.Unstructured).DeepCopy(), nil
+	}
+
+	mutated, err := callMutationWebhook(webhook, body)
+	if err != nil {
+		return nil, err
+	}
+	if mutated != nil {
+		if mutated.GroupVersionKind() != obj.GroupVersionKind() || mutated.GetName() != obj.GetName() || mutated.GetNamespace() != obj.GetNamespace() {
+			return nil, fmt.Errorf("the mutated resource is %s %s/%s", mutated.GroupVersionKind(), mutated.GetNamespace(), mutated.GetName())
+		}
+		annotations := mutated.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		mutatedBy := obj.GetAnnotations()[common.AnnotationKeyMutatedBy]
+		if mutatedBy != "" {
+			mutatedBy += ","
+		}
+		annotations[common.AnnotationKeyMutatedBy] = mutatedBy + webhook.Name
+		mutated.SetAnnotations(annotations)
+		m.cache.SetDefault(cacheKey, mutated.DeepCopy())
+	} else {
+		m.cache.SetDefault(cacheKey, nil)
+	}
+	return mutated, nil
+}
+
+func callMutationWebhook(webhook settings.ResourceMutationWebhook, body []byte) (*unstructured.Unstructured, error) {
+	timeout := defaultMutationWebhookTimeout
+	if webhook.Timeout != "" {
+		if d, err := time.ParseDuration(webhook.Timeout); err == nil {
+			timeout = d
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range webhook.Headers {
+		req.Header.Set(k, v)
+	}
+	client := &http.Client{}
+	if webhook.Insecure {
+		client.Transport = &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			/* #nosec G402 */
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxMutationResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("webhook responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	var response resourceMutationResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal webhook response: %w", err)
+	}
+	return response.Object, nil
+}
@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func newFakeMutator(t *testing.T, webhooks string) *resourceMutator {
+	t.Helper()
+	cm := test.NewFakeConfigMap()
+	cm.Data["resource.mutationWebhooks"] = webhooks
+	secret := test.NewFakeSecret()
+	secret.Labels = map[string]string{"app.kubernetes.io/part-of": "argocd"}
+	secret.Data["webhook.token"] = []byte("secret-token")
+	kubeClient := fake.NewClientset(cm, secret)
+	return newResourceMutator(settings.NewSettingsManager(t.Context(), kubeClient, test.FakeArgoCDNamespace))
+}
+
+func TestResourceMutator_Mutate(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "secret-token", r.Header.Get("Authorization"))
+		var request resourceMutationRequest
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&request)) {
+			return
+		}
+		assert.Equal(t, "my-app", request.Application.Name)
+		switch request.Object.GetName() {
+		case "unchanged":
+			_, _ = w.Write([]byte(`{}`))
+		case "renamed":
+			request.Object.SetName("other")
+			_ = json.NewEncoder(w).Encode(resourceMutationResponse{Object: request.Object})
+		default:
+			labels := request.Object.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels["mutated"] = "true"
+			request.Object.SetLabels(labels)
+			_ = json.NewEncoder(w).Encode(resourceMutationResponse{Object: request.Object})
+		}
+	}))
+	defer server.Close()
+
+	mutator := newFakeMutator(t, `
+- name: labeler
+  url: `+server.URL+`
+  group: apps
+  headers:
+    Authorization: $webhook.token
+`)
+	newDeployment := func(name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("apps/v1")
+		obj.SetKind("Deployment")
+		obj.SetName(name)
+		return obj
+	}
+	configMap := &unstructured.Unstructured{}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetName("my-config")
+	targets := []*unstructured.Unstructured{newDeployment("mutated"), newDeployment("unchanged"), newDeployment("renamed"), configMap}
+
+	errs, err := mutator.mutate(newFakeApp(), targets)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], "resource mutation webhook labeler failed to mutate Deployment/renamed")
+	assert.Equal(t, map[string]string{"mutated": "true"}, targets[0].GetLabels())
+	assert.Equal(t, "labeler", targets[0].GetAnnotations()[common.AnnotationKeyMutatedBy])
+	assert.Equal(t, newDeployment("unchanged"), targets[1])
+	assert.Equal(t, newDeployment("renamed"), targets[2])
+	assert.Equal(t, 3, requests, "resources not matching the webhook are not sent")
+
+	targets = []*unstructured.Unstructured{newDeployment("mutated")}
+	_, err = mutator.mutate(newFakeApp(), targets)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"mutated": "true"}, targets[0].GetLabels())
+	assert.Equal(t, 3, requests, "mutations are cached")
+}
+
+func TestResourceMutator_FailurePolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName("my-config")
+
+	errs, err := newFakeMutator(t, "[{name: failing, url: "+server.URL+"}]").mutate(newFakeApp(), []*unstructured.Unstructured{obj.DeepCopy()})
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], "webhook responded with status 503: unavailable")
+
+	errs, err = newFakeMutator(t, "[{name: failing, url: "+server.URL+", failurePolicy: Ignore}]").mutate(newFakeApp(), []*unstructured.Unstructured{obj.DeepCopy()})
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+
+	_, err = newFakeMutator(t, "[{name: failing, url: "+server.URL+", failurePolicy: Retry}]").mutate(newFakeApp(), []*unstructured.Unstructured{obj.DeepCopy()})
+	assert.ErrorContains(t, err, `invalid failure policy "Retry"`)
+}
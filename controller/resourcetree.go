@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+)
+
+// resourceTreeUpdates tracks the resources updated since the resource tree of each application was built, so that
+// updates of non-root resources, such as pods, are applied to the cached tree instead of rebuilding it. The patched tree
+// is still cached and sent to the watchers as a whole.
+type resourceTreeUpdates struct {
+	lock sync.Mutex
+	// built holds the applications whose cached resource tree was built by this controller
+	built map[string]bool
+	// updated holds the keys of the resources updated since the tree of each application was last updated
+	updated map[string]map[kube.ResourceKey]bool
+	// generations counts the resource updates of each application, so that the updates received while a tree is built
+	// are not forgotten once it is cached
+	generations map[string]uint64
+}
+
+func newResourceTreeUpdates() *resourceTreeUpdates {
+	return &resourceTreeUpdates{
+		built:       make(map[string]bool),
+		updated:     make(map[string]map[kube.ResourceKey]bool),
+		generations: make(map[string]uint64),
+	}
+}
+
+// generation returns the number of resource updates of the application, to be passed to setBuilt once the tree built
+// from the current state of the resources is cached
+func (u *resourceTreeUpdates) generation(appName string) uint64 {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	return u.generations[appName]
+}
+
+// setBuilt records that the resource tree of the application was built from the state of the resources at the given
+// generation and cached. The resources updated before are part of the tree, hence they are forgotten unless some
+// resources were updated since the given generation, in which case the tree may be missing their update.
+func (u *resourceTreeUpdates) setBuilt(appName string, generation uint64) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	if u.generations[appName] != generation {
+		// the updates received during the build are applied to the tree by the next refresh, or the whole tree is
+		// rebuilt if it was not built before since they were not recorded
+		return
+	}
+	u.built[appName] = true
+	delete(u.updated, appName)
+}
+
+// requireRebuild makes the next refresh of the application rebuild its resource tree
+func (u *resourceTreeUpdates) requireRebuild(appName string) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	delete(u.built, appName)
+	delete(u.updated, appName)
+}
+
+// add records the update of a resource of the application
+func (u *resourceTreeUpdates) add(appName string, key kube.ResourceKey) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.generations[appName]++
+	if !u.built[appName] {
+		// the tree will be rebuilt anyway
+		return
+	}
+	if u.updated[appName] == nil {
+		u.updated[appName] = make(map[kube.ResourceKey]bool)
+	}
+	u.updated[appName][key] = true
+}
+
+// take returns and forgets the resources of the application updated since the last call. It returns false if the
+// resource tree of the application must be rebuilt instead.
+func (u *resourceTreeUpdates) take(appName string) ([]kube.ResourceKey, bool) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	updated := u.updated[appName]
+	delete(u.updated, appName)
+	if !u.built[appName] || len(updated) == 0 {
+		return nil, false
+	}
+	keys := make([]kube.ResourceKey, 0, len(updated))
+	for key := range updated {
+		keys = append(keys, key)
+	}
+	return keys, true
+}
+
+// getUpdatedResourceTree returns the resource tree of the application. The cached tree is patched with the resources
+// updated since it was built when possible, otherwise the whole tree is rebuilt.
+func (ctrl *ApplicationController) getUpdatedResourceTree(destCluster *appv1.Cluster, a *appv1.Application, managedResources []*appv1.ResourceDiff) (*appv1.ApplicationTree, error) {
+	appName := a.InstanceName(ctrl.namespace)
+	if keys, ok := ctrl.resourceTreeUpdates.take(appName); ok {
+		logCtx := log.WithFields(applog.GetAppLogFields(a))
+		var tree appv1.ApplicationTree
+		if err := ctrl.cache.GetAppResourcesTree(appName, &tree); err != nil {
+			logCtx.Warnf("Failed to get cached resources tree, rebuilding it: %v", err)
+		} else if updated, err := ctrl.updateResourceTree(destCluster, a, &tree, keys); err != nil {
+			logCtx.Warnf("Failed to update resources tree, rebuilding it: %v", err)
+		} else if updated {
+			logCtx.Debugf("Updated %d resources of the resources tree", len(keys))
+			return &tree, nil
+		}
+	}
+	return ctrl.getResourceTree(destCluster, a, managedResources)
+}
+
+// updateResourceTree replaces the nodes of the given resources in the tree with their current state, adding the
+// resources which joined the hierarchy of the application and removing the ones which left it. It returns false if
+// the tree must be rebuilt instead, which is the case when an orphaned resource was updated.
+func (ctrl *ApplicationController) updateResourceTree(destCluster *appv1.Cluster, a *appv1.Application, tree *appv1.ApplicationTree, keys []kube.ResourceKey) (bool, error) {
+	proj, err := ctrl.getAppProj(a)
+	if err != nil {
+		return false, fmt.Errorf("failed to get project: %w", err)
+	}
+	for _, node := range tree.OrphanedNodes {
+		for _, key := range keys {
+			if kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name) == key {
+				return false, nil
+			}
+		}
+	}
+
+	nodes := make(map[kube.ResourceKey]appv1.ResourceNode, len(tree.Nodes))
+	for _, node := range tree.Nodes {
+		nodes[kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)] = node
+	}
+	appName := a.InstanceName(ctrl.namespace)
+	podsUpdated := false
+	for _, key := range keys {
+		if key.Group == "" && key.Kind == kube.PodKind {
+			podsUpdated = true
+		}
+		delete(nodes, key)
+		err = ctrl.stateCache.IterateHierarchyV2(destCluster, []kube.ResourceKey{key}, func(child appv1.ResourceNode, childAppName string) bool {
+			if kube.NewResourceKey(child.Group, child.Kind, child.Namespace, child.Name) != key || childAppName != appName {
+				return false
+			}
+			permitted, _ := proj.IsResourcePermitted(schema.GroupKind{Group: child.Group, Kind: child.Kind}, child.Namespace, destCluster, func(project string) ([]*appv1.Cluster, error) {
+				return ctrl.db.GetProjectClusters(context.TODO(), project)
+			})
+			if permitted {
+				nodes[key] = child
+			}
+			// the children of the resource are updated by their own events
+			return false
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to iterate resource hierarchy v2: %w", err)
+		}
+	}
+
+	tree.Nodes = make([]appv1.ResourceNode, 0, len(nodes))
+	for _, node := range nodes {
+		tree.Nodes = append(tree.Nodes, node)
+	}
+	if podsUpdated {
+		hosts, err := ctrl.getAppHosts(destCluster, a, tree.Nodes)
+		if err != nil {
+			return false, fmt.Errorf("failed to get app hosts: %w", err)
+		}
+		tree.Hosts = hosts
+	}
+	tree.ShardsCount = 0
+	tree.Normalize()
+	return true, nil
+}
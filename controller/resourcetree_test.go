@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func TestResourceTreeUpdates(t *testing.T) {
+	podKey := kube.NewResourceKey("", "Pod", "default", "pod")
+
+	t.Run("NotBuilt", func(t *testing.T) {
+		updates := newResourceTreeUpdates()
+		updates.add("my-app", podKey)
+		_, ok := updates.take("my-app")
+		assert.False(t, ok)
+	})
+
+	t.Run("Built", func(t *testing.T) {
+		updates := newResourceTreeUpdates()
+		updates.setBuilt("my-app", updates.generation("my-app"))
+		_, ok := updates.take("my-app")
+		assert.False(t, ok)
+
+		updates.add("my-app", podKey)
+		updates.add("my-app", podKey)
+		keys, ok := updates.take("my-app")
+		require.True(t, ok)
+		assert.Equal(t, []kube.ResourceKey{podKey}, keys)
+		_, ok = updates.take("my-app")
+		assert.False(t, ok)
+	})
+
+	t.Run("Rebuilt", func(t *testing.T) {
+		updates := newResourceTreeUpdates()
+		updates.setBuilt("my-app", updates.generation("my-app"))
+		updates.add("my-app", podKey)
+		// the updated pod is part of the rebuilt tree
+		updates.setBuilt("my-app", updates.generation("my-app"))
+		_, ok := updates.take("my-app")
+		assert.False(t, ok)
+	})
+
+	t.Run("UpdatedDuringBuild", func(t *testing.T) {
+		updates := newResourceTreeUpdates()
+		updates.setBuilt("my-app", updates.generation("my-app"))
+		generation := updates.generation("my-app")
+		// the pod is updated after the state of the resources was read to build the tree
+		updates.add("my-app", podKey)
+		updates.setBuilt("my-app", generation)
+		keys, ok := updates.take("my-app")
+		require.True(t, ok)
+		assert.Equal(t, []kube.ResourceKey{podKey}, keys)
+	})
+
+	t.Run("UpdatedDuringFirstBuild", func(t *testing.T) {
+		updates := newResourceTreeUpdates()
+		generation := updates.generation("my-app")
+		updates.add("my-app", podKey)
+		updates.setBuilt("my-app", generation)
+		// the update was not recorded, so the tree is rebuilt
+		_, ok := updates.take("my-app")
+		assert.False(t, ok)
+		updates.setBuilt("my-app", updates.generation("my-app"))
+		updates.add("my-app", podKey)
+		_, ok = updates.take("my-app")
+		assert.True(t, ok)
+	})
+
+	t.Run("RebuildRequired", func(t *testing.T) {
+		updates := newResourceTreeUpdates()
+		updates.setBuilt("my-app", updates.generation("my-app"))
+		updates.add("my-app", podKey)
+		updates.requireRebuild("my-app")
+		updates.add("my-app", podKey)
+		_, ok := updates.take("my-app")
+		assert.False(t, ok)
+	})
+}
+
+func TestUpdateResourceTree(t *testing.T) {
+	app := newFakeApp()
+	deploy := v1alpha1.ResourceNode{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "nginx-deployment"}}
+	replicaSet := v1alpha1.ResourceNode{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "ReplicaSet", Namespace: "default", Name: "nginx-deployment-1"}}
+	deletedPod := v1alpha1.ResourceNode{ResourceRef: v1alpha1.ResourceRef{Kind: "Pod", Namespace: "default", Name: "nginx-deployment-1-a"}}
+	createdPod := v1alpha1.ResourceNode{ResourceRef: v1alpha1.ResourceRef{Kind: "Pod", Namespace: "default", Name: "nginx-deployment-1-b"}}
+	otherPod := v1alpha1.ResourceNode{ResourceRef: v1alpha1.ResourceRef{Kind: "Pod", Namespace: "default", Name: "other"}}
+	orphanedDeploy := v1alpha1.ResourceNode{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "orphaned"}}
+
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		namespacedResources: map[kube.ResourceKey]namespacedResource{
+			kube.NewResourceKey("", "Pod", "default", createdPod.Name): {ResourceNode: createdPod, AppName: app.InstanceName(test.FakeArgoCDNamespace)},
+			kube.NewResourceKey("", "Pod", "default", otherPod.Name):   {ResourceNode: otherPod, AppName: "other-app"},
+		},
+	}, nil)
+	cluster := &v1alpha1.Cluster{Server: "https://localhost:6443", Name: "fake-cluster"}
+	newTree := func() *v1alpha1.ApplicationTree {
+		return &v1alpha1.ApplicationTree{
+			Nodes:         []v1alpha1.ResourceNode{deploy, replicaSet, deletedPod, otherPod},
+			OrphanedNodes: []v1alpha1.ResourceNode{orphanedDeploy},
+		}
+	}
+
+	t.Run("Updated", func(t *testing.T) {
+		tree := newTree()
+		updated, err := ctrl.updateResourceTree(cluster, app, tree, []kube.ResourceKey{
+			kube.NewResourceKey("", "Pod", "default", deletedPod.Name),
+			kube.NewResourceKey("", "Pod", "default", createdPod.Name),
+			kube.NewResourceKey("", "Pod", "default", otherPod.Name),
+		})
+		require.NoError(t, err)
+		assert.True(t, updated)
+		assert.Equal(t, []v1alpha1.ResourceNode{createdPod, deploy, replicaSet}, tree.Nodes)
+		assert.Equal(t, []v1alpha1.ResourceNode{orphanedDeploy}, tree.OrphanedNodes)
+	})
+
+	t.Run("OrphanedResourceUpdated", func(t *testing.T) {
+		tree := newTree()
+		updated, err := ctrl.updateResourceTree(cluster, app, tree, []kube.ResourceKey{
+			kube.NewResourceKey("apps", "Deployment", "default", orphanedDeploy.Name),
+		})
+		require.NoError(t, err)
+		assert.False(t, updated)
+		assert.Equal(t, newTree(), tree)
+	})
+}
@@ -0,0 +1,352 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/resource"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/openapi"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// syncOptionServerSideApplyConflicts is the sync option selecting how server-side apply conflicts are resolved
+	syncOptionServerSideApplyConflicts = "ServerSideApplyConflicts"
+
+	// ssaConflictsForce takes the ownership of the conflicting fields. This is the default strategy.
+	ssaConflictsForce = "force"
+	// ssaConflictsFail fails the sync of the resource
+	ssaConflictsFail = "fail"
+	// ssaConflictsIgnore leaves the conflicting fields to their managers
+	ssaConflictsIgnore = "ignore"
+
+	ssaConflictsMessage        = "server-side apply conflicts: "
+	ssaIgnoredConflictsMessage = "ignored " + ssaConflictsMessage
+)
+
+// ssaConflictsStrategy returns the server-side apply conflicts strategy set by the given sync options, if any
+func ssaConflictsStrategy(syncOptions []string) string {
+	for _, option := range syncOptions {
+		if key, value, ok := strings.Cut(strings.TrimSpace(option), "="); ok && key == syncOptionServerSideApplyConflicts {
+			return value
+		}
+	}
+	return ""
+}
+
+// ssaConflictsKubectl resolves the conflicts of the server-side apply operations of a sync with the strategy
+// configured on the application or on the resources, instead of always forcing them
+type ssaConflictsKubectl struct {
+	kube.Kubectl
+	// strategy is the strategy set by the application sync options
+	strategy string
+	// ignoredFields are the ignored conflicting fields set by the application sync policy
+	ignoredFields []string
+}
+
+func newSSAConflictsKubectl(kubectl kube.Kubectl, app *appv1.Application, syncOptions appv1.SyncOptions) kube.Kubectl {
+	return &ssaConflictsKubectl{
+		Kubectl:       kubectl,
+		strategy:      ssaConflictsStrategy(syncOptions),
+		ignoredFields: ssaIgnoredConflicts(app),
+	}
+}
+
+// ssaIgnoredConflicts returns the ignored conflicting fields set by the sync policy of the given application
+func ssaIgnoredConflicts(app *appv1.Application) []string {
+	if app.Spec.SyncPolicy == nil {
+		return nil
+	}
+	return app.Spec.SyncPolicy.ServerSideApplyIgnoredConflicts
+}
+
+// resourceSSAConflictsStrategy returns the conflicts strategy and the ignored conflicting fields of the given resource,
+// the resource annotations taking precedence over the given application ones
+func resourceSSAConflictsStrategy(obj *unstructured.Unstructured, strategy string, ignoredFields []string) (string, []string) {
+	if resourceStrategy := ssaConflictsStrategy(resource.GetAnnotationCSVs(obj, synccommon.AnnotationSyncOptions)); resourceStrategy != "" {
+		strategy = resourceStrategy
+	}
+	if value, ok := obj.GetAnnotations()[common.AnnotationServerSideApplyIgnoredConflicts]; ok {
+		ignoredFields = parseFieldPaths(value)
+	}
+	return strategy, ignoredFields
+}
+
+func (k *ssaConflictsKubectl) ManageResources(config *rest.Config, openAPISchema openapi.Resources) (kube.ResourceOperations, func(), error) {
+	ops, cleanup, err := k.Kubectl.ManageResources(config, openAPISchema)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &ssaConflictsResourceOperations{ResourceOperations: ops, kubectl: k, config: config}, cleanup, nil
+}
+
+type ssaConflictsResourceOperations struct {
+	kube.ResourceOperations
+	kubectl   *ssaConflictsKubectl
+	config    *rest.Config
+	dynamicIf dynamic.Interface
+	disco     discovery.DiscoveryInterface
+}
+
+// resourceStrategy returns the conflicts strategy and the ignored conflicting fields of the given resource
+func (o *ssaConflictsResourceOperations) resourceStrategy(obj *unstructured.Unstructured) (string, []string) {
+	return resourceSSAConflictsStrategy(obj, o.kubectl.strategy, o.kubectl.ignoredFields)
+}
+
+func (o *ssaConflictsResourceOperations) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force, validate, serverSideApply bool, manager string) (string, error) {
+	strategy, ignoredFields := o.resourceStrategy(obj)
+	if !serverSideApply || strategy == "" || strategy == ssaConflictsForce || dryRunStrategy == cmdutil.DryRunClient {
+		return o.ResourceOperations.ApplyResource(ctx, obj, dryRunStrategy, force, validate, serverSideApply, manager)
+	}
+	if strategy != ssaConflictsFail && strategy != ssaConflictsIgnore {
+		return "", fmt.Errorf("unknown server-side apply conflicts strategy %q", strategy)
+	}
+
+	conflicts, err := o.getConflicts(ctx, obj, manager)
+	if err != nil {
+		return "", err
+	}
+	if len(conflicts) == 0 {
+		return o.ResourceOperations.ApplyResource(ctx, obj, dryRunStrategy, force, validate, serverSideApply, manager)
+	}
+	if strategy == ssaConflictsFail {
+		return "", errors.New(ssaConflictsMessage + formatConflicts(conflicts))
+	}
+
+	obj = obj.DeepCopy()
+	var unresolved []metav1.StatusCause
+	for _, conflict := range conflicts {
+		if !removeConflictingField(obj, conflict.Field, ignoredFields) {
+			unresolved = append(unresolved, conflict)
+		}
+	}
+	if len(unresolved) > 0 {
+		return "", errors.New(ssaConflictsMessage + formatConflicts(unresolved))
+	}
+	message, err := o.ResourceOperations.ApplyResource(ctx, obj, dryRunStrategy, force, validate, serverSideApply, manager)
+	if err != nil {
+		return message, err
+	}
+	return fmt.Sprintf("%s. %s%s", message, ssaIgnoredConflictsMessage, formatConflicts(conflicts)), nil
+}
+
+// getConflicts returns the fields of the given resource which are managed by other field managers, using a
+// server-side dry-run apply which does not force the conflicts
+func (o *ssaConflictsResourceOperations) getConflicts(ctx context.Context, obj *unstructured.Unstructured, manager string) ([]metav1.StatusCause, error) {
+	if o.dynamicIf == nil {
+		dynamicIf, err := o.kubectl.NewDynamicClient(o.config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+		}
+		disco, err := discovery.NewDiscoveryClientForConfig(o.config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create discovery client: %w", err)
+		}
+		o.dynamicIf, o.disco = dynamicIf, memory.NewMemCacheClient(disco)
+	}
+	gvk := obj.GroupVersionKind()
+	apiResource, err := kube.ServerResourceForGroupVersionKind(o.disco, gvk, "patch")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API resource of %s: %w", gvk, err)
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	gvr := kube.ToGroupVersionResource(gvk.GroupVersion().String(), apiResource)
+	_, err = kube.ToResourceInterface(o.dynamicIf, apiResource, gvr, obj.GetNamespace()).Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: manager,
+		Force:        ptr.To(false),
+		DryRun:       []string{metav1.DryRunAll},
+	})
+	return getConflictCauses(err), nil
+}
+
+// getConflictCauses returns the field manager conflicts of the given server-side apply error. Other errors are
+// reported by the actual apply.
+func getConflictCauses(err error) []metav1.StatusCause {
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsConflict(err) {
+		log.Debugf("Server-side apply conflicts dry-run failed: %v", err)
+		return nil
+	}
+	var statusErr apierrors.APIStatus
+	if !errors.As(err, &statusErr) || statusErr.Status().Details == nil {
+		return nil
+	}
+	var conflicts []metav1.StatusCause
+	for _, cause := range statusErr.Status().Details.Causes {
+		if cause.Type == metav1.CauseTypeFieldManagerConflict {
+			conflicts = append(conflicts, cause)
+		}
+	}
+	return conflicts
+}
+
+// removeConflictingField removes the given conflicting field from the resource if it is ignored, and returns whether
+// it was removed. All conflicting fields are ignored if no field is listed, and a listed field ignores the conflicts on
+// all its sub-fields. Only leaf fields are removed: removing a parent field would remove the fields owned by Argo CD
+// from the server-side apply, which the API server would then delete.
+func removeConflictingField(obj *unstructured.Unstructured, field string, ignoredFields []string) bool {
+	if !isIgnoredConflict(field, ignoredFields) {
+		return false
+	}
+	// list items are identified by their keys and cannot be removed individually
+	if !strings.HasPrefix(field, ".") || strings.Contains(field, "[") {
+		return false
+	}
+	path := strings.Split(strings.TrimPrefix(field, "."), ".")
+	value, ok, err := unstructured.NestedFieldNoCopy(obj.Object, path...)
+	if err != nil || !ok {
+		return false
+	}
+	switch value.(type) {
+	case map[string]any, []any:
+		return false
+	}
+	unstructured.RemoveNestedField(obj.Object, path...)
+	return true
+}
+
+// isIgnoredConflict returns whether the conflicts on the given field are ignored by the given field paths
+func isIgnoredConflict(field string, ignoredFields []string) bool {
+	return len(ignoredFields) == 0 || slices.ContainsFunc(ignoredFields, func(ignored string) bool {
+		return field == ignored || strings.HasPrefix(field, ignored+".") || strings.HasPrefix(field, ignored+"[")
+	})
+}
+
+// ignoredConflictingFields returns the leaf fields of the given live resource which are managed by other field
+// managers than Argo CD and whose conflicts are ignored by the given field paths
+func ignoredConflictingFields(live *unstructured.Unstructured, ignoredFields []string) [][]string {
+	var fields [][]string
+	for _, managedFields := range live.GetManagedFields() {
+		if managedFields.Manager == common.ArgoCDSSAManager || managedFields.Subresource != "" || managedFields.FieldsV1 == nil {
+			continue
+		}
+		set := &fieldpath.Set{}
+		if err := set.FromJSON(bytes.NewReader(managedFields.FieldsV1.Raw)); err != nil {
+			log.Debugf("Failed to parse the fields managed by %s: %v", managedFields.Manager, err)
+			continue
+		}
+		set.Leaves().Iterate(func(path fieldpath.Path) {
+			if !isIgnoredConflict(path.String(), ignoredFields) {
+				return
+			}
+			names := make([]string, 0, len(path))
+			for _, element := range path {
+				// list items cannot be removed individually, their conflicts are not ignored
+				if element.FieldName == nil {
+					return
+				}
+				names = append(names, *element.FieldName)
+			}
+			fields = append(fields, names)
+		})
+	}
+	return fields
+}
+
+// removeIgnoredSSAConflicts returns the target and live states to compare, without the conflicting fields which are
+// left to their managers by the ignore strategy of the server-side apply conflicts. Otherwise, the resources would
+// stay out of sync with the fields the syncs do not apply.
+func removeIgnoredSSAConflicts(app *appv1.Application, targets, lives []*unstructured.Unstructured) ([]*unstructured.Unstructured, []*unstructured.Unstructured) {
+	var syncOptions appv1.SyncOptions
+	if app.Spec.SyncPolicy != nil {
+		syncOptions = app.Spec.SyncPolicy.SyncOptions
+	}
+	appStrategy := ssaConflictsStrategy(syncOptions)
+	appServerSideApply := syncOptions.HasOption(synccommon.SyncOptionServerSideApply)
+	ignored := ssaIgnoredConflicts(app)
+
+	targets, lives = slices.Clone(targets), slices.Clone(lives)
+	for i, target := range targets {
+		live := lives[i]
+		if target == nil || live == nil {
+			continue
+		}
+		if resource.HasAnnotationOption(target, synccommon.AnnotationSyncOptions, synccommon.SyncOptionDisableServerSideApply) ||
+			!appServerSideApply && !resource.HasAnnotationOption(target, synccommon.AnnotationSyncOptions, synccommon.SyncOptionServerSideApply) {
+			continue
+		}
+		strategy, ignoredFields := resourceSSAConflictsStrategy(target, appStrategy, ignored)
+		if strategy != ssaConflictsIgnore {
+			continue
+		}
+		fields := ignoredConflictingFields(live, ignoredFields)
+		if len(fields) == 0 {
+			continue
+		}
+		target, live = target.DeepCopy(), live.DeepCopy()
+		for _, field := range fields {
+			unstructured.RemoveNestedField(target.Object, field...)
+			unstructured.RemoveNestedField(live.Object, field...)
+		}
+		targets[i], lives[i] = target, live
+	}
+	return targets, lives
+}
+
+func parseFieldPaths(value string) []string {
+	var paths []string
+	for _, path := range strings.Split(value, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+func formatConflicts(conflicts []metav1.StatusCause) string {
+	messages := make([]string, len(conflicts))
+	for i, conflict := range conflicts {
+		messages[i] = fmt.Sprintf("%s (%s)", conflict.Field, conflict.Message)
+	}
+	return strings.Join(messages, ", ")
+}
+
+// ssaConflictCondition returns the condition reporting the server-side apply conflicts found by the given operation
+func ssaConflictCondition(state *appv1.OperationState) *appv1.ApplicationCondition {
+	if state == nil || !state.Phase.Completed() || state.SyncResult == nil {
+		return nil
+	}
+	var messages []string
+	for _, res := range state.SyncResult.Resources {
+		i := strings.Index(res.Message, ssaIgnoredConflictsMessage)
+		if i < 0 {
+			i = strings.Index(res.Message, ssaConflictsMessage)
+		}
+		if i >= 0 {
+			messages = append(messages, fmt.Sprintf("%s/%s: %s", res.Kind, res.Name, res.Message[i:]))
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return &appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionServerSideApplyConflict,
+		Message: strings.Join(messages, "; "),
+	}
+}
@@ -0,0 +1,201 @@
+package controller
+
+import (
+	"testing"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestSSAConflictsResourceOperations_ApplyResource(t *testing.T) {
+	app := newFakeApp()
+	newDeployment := func(annotations map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("apps/v1")
+		obj.SetKind("Deployment")
+		obj.SetName("my-deploy")
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+
+	t.Run("DefaultStrategy", func(t *testing.T) {
+		mockOps := &kubetest.MockResourceOps{}
+		ops := &ssaConflictsResourceOperations{ResourceOperations: mockOps, kubectl: newSSAConflictsKubectl(&kubetest.MockKubectlCmd{}, app, nil).(*ssaConflictsKubectl)}
+		_, err := ops.ApplyResource(t.Context(), newDeployment(nil), cmdutil.DryRunNone, false, true, true, common.ArgoCDSSAManager)
+		require.NoError(t, err)
+		assert.True(t, mockOps.GetLastServerSideApply())
+	})
+
+	t.Run("ClientSideApply", func(t *testing.T) {
+		mockOps := &kubetest.MockResourceOps{}
+		ops := &ssaConflictsResourceOperations{ResourceOperations: mockOps, kubectl: newSSAConflictsKubectl(&kubetest.MockKubectlCmd{}, app, v1alpha1.SyncOptions{"ServerSideApplyConflicts=fail"}).(*ssaConflictsKubectl)}
+		_, err := ops.ApplyResource(t.Context(), newDeployment(nil), cmdutil.DryRunNone, false, true, false, "")
+		require.NoError(t, err)
+	})
+
+	t.Run("UnknownStrategy", func(t *testing.T) {
+		ops := &ssaConflictsResourceOperations{ResourceOperations: &kubetest.MockResourceOps{}, kubectl: newSSAConflictsKubectl(&kubetest.MockKubectlCmd{}, app, nil).(*ssaConflictsKubectl)}
+		obj := newDeployment(map[string]string{synccommon.AnnotationSyncOptions: "ServerSideApply=true,ServerSideApplyConflicts=merge"})
+		_, err := ops.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, true, true, common.ArgoCDSSAManager)
+		assert.EqualError(t, err, `unknown server-side apply conflicts strategy "merge"`)
+	})
+}
+
+func TestSSAConflictsResourceOperations_ResourceStrategy(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{ServerSideApplyIgnoredConflicts: []string{".spec.replicas"}}
+	ops := &ssaConflictsResourceOperations{kubectl: newSSAConflictsKubectl(&kubetest.MockKubectlCmd{}, app, v1alpha1.SyncOptions{"ServerSideApply=true", "ServerSideApplyConflicts=ignore"}).(*ssaConflictsKubectl)}
+
+	strategy, ignoredFields := ops.resourceStrategy(&unstructured.Unstructured{Object: map[string]any{}})
+	assert.Equal(t, ssaConflictsIgnore, strategy)
+	assert.Equal(t, []string{".spec.replicas"}, ignoredFields)
+
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetAnnotations(map[string]string{
+		synccommon.AnnotationSyncOptions:                 "ServerSideApplyConflicts=fail",
+		common.AnnotationServerSideApplyIgnoredConflicts: ".spec.template, .metadata.labels",
+	})
+	strategy, ignoredFields = ops.resourceStrategy(obj)
+	assert.Equal(t, ssaConflictsFail, strategy)
+	assert.Equal(t, []string{".spec.template", ".metadata.labels"}, ignoredFields)
+}
+
+func TestGetConflictCauses(t *testing.T) {
+	assert.Nil(t, getConflictCauses(nil))
+	assert.Nil(t, getConflictCauses(apierrors.NewBadRequest("invalid")))
+
+	replicas := metav1.StatusCause{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kube-controller-manager"`, Field: ".spec.replicas"}
+	err := apierrors.NewApplyConflict([]metav1.StatusCause{replicas, {Type: metav1.CauseTypeFieldValueInvalid, Field: ".spec"}}, "Apply failed with 1 conflict")
+	assert.Equal(t, []metav1.StatusCause{replicas}, getConflictCauses(err))
+	assert.Nil(t, getConflictCauses(apierrors.NewConflict(schema.GroupResource{Resource: "deployments"}, "my-deploy", assert.AnError)))
+}
+
+func TestRemoveConflictingField(t *testing.T) {
+	newObj := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{
+				"replicas": int64(3),
+				"template": map[string]any{
+					"metadata": map[string]any{"labels": map[string]any{"app": "nginx"}},
+					"spec":     map[string]any{"containers": []any{map[string]any{"name": "nginx", "image": "nginx"}}},
+				},
+			},
+		}}
+	}
+
+	obj := newObj()
+	assert.True(t, removeConflictingField(obj, ".spec.replicas", nil))
+	assert.NotContains(t, obj.Object["spec"], "replicas")
+
+	obj = newObj()
+	assert.False(t, removeConflictingField(obj, ".spec.replicas", []string{".spec.template"}))
+	assert.Equal(t, newObj(), obj)
+
+	obj = newObj()
+	assert.True(t, removeConflictingField(obj, ".spec.template.metadata.labels.app", []string{".spec.template"}))
+	expected := newObj()
+	unstructured.RemoveNestedField(expected.Object, "spec", "template", "metadata", "labels", "app")
+	assert.Equal(t, expected, obj, "only the conflicting field is removed")
+
+	obj = newObj()
+	assert.False(t, removeConflictingField(obj, ".spec.template.metadata", nil), "parent fields are not removed")
+	assert.Equal(t, newObj(), obj)
+
+	obj = newObj()
+	assert.False(t, removeConflictingField(obj, `.spec.template.spec.containers[name="nginx"].image`, []string{".spec.template"}), "list items cannot be removed")
+	assert.Equal(t, newObj(), obj)
+}
+
+func TestRemoveIgnoredSSAConflicts(t *testing.T) {
+	newDeployment := func(annotations map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "my-deploy"},
+			"spec": map[string]any{
+				"replicas": int64(3),
+				"template": map[string]any{"spec": map[string]any{"containers": []any{map[string]any{"name": "nginx", "image": "nginx"}}}},
+			},
+		}}
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+	newLive := func() *unstructured.Unstructured {
+		live := newDeployment(nil)
+		live.Object["spec"].(map[string]any)["replicas"] = int64(5)
+		live.SetManagedFields([]metav1.ManagedFieldsEntry{
+			{Manager: common.ArgoCDSSAManager, Operation: metav1.ManagedFieldsOperationApply, FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:template":{}}}`)}},
+			{Manager: "kube-controller-manager", Operation: metav1.ManagedFieldsOperationUpdate, FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{},"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"nginx\"}":{"f:image":{}}}}}}}`)}},
+			{Manager: "kube-controller-manager", Operation: metav1.ManagedFieldsOperationUpdate, Subresource: "status", FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:replicas":{}}}`)}},
+		})
+		return live
+	}
+	replicas := func(obj *unstructured.Unstructured) any {
+		return obj.Object["spec"].(map[string]any)["replicas"]
+	}
+
+	t.Run("IgnoreStrategy", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"ServerSideApply=true", "ServerSideApplyConflicts=ignore"}}
+		targets, lives := []*unstructured.Unstructured{newDeployment(nil)}, []*unstructured.Unstructured{newLive()}
+		diffTargets, diffLives := removeIgnoredSSAConflicts(app, targets, lives)
+		assert.Nil(t, replicas(diffTargets[0]))
+		assert.Nil(t, replicas(diffLives[0]))
+		assert.Equal(t, "nginx", diffTargets[0].Object["spec"].(map[string]any)["template"].(map[string]any)["spec"].(map[string]any)["containers"].([]any)[0].(map[string]any)["image"], "list items are not ignored")
+		assert.Equal(t, int64(3), replicas(targets[0]), "the target state is not modified")
+		assert.Equal(t, int64(5), replicas(lives[0]), "the live state is not modified")
+	})
+
+	t.Run("IgnoredFields", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"ServerSideApply=true", "ServerSideApplyConflicts=ignore"}, ServerSideApplyIgnoredConflicts: []string{".spec.template"}}
+		diffTargets, _ := removeIgnoredSSAConflicts(app, []*unstructured.Unstructured{newDeployment(nil)}, []*unstructured.Unstructured{newLive()})
+		assert.Equal(t, int64(3), replicas(diffTargets[0]))
+	})
+
+	t.Run("ResourceStrategy", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"ServerSideApply=true"}}
+		target := newDeployment(map[string]string{synccommon.AnnotationSyncOptions: "ServerSideApplyConflicts=ignore"})
+		diffTargets, _ := removeIgnoredSSAConflicts(app, []*unstructured.Unstructured{target, nil}, []*unstructured.Unstructured{newLive(), newLive()})
+		assert.Nil(t, replicas(diffTargets[0]))
+	})
+
+	t.Run("ClientSideApply", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"ServerSideApplyConflicts=ignore"}}
+		diffTargets, _ := removeIgnoredSSAConflicts(app, []*unstructured.Unstructured{newDeployment(nil)}, []*unstructured.Unstructured{newLive()})
+		assert.Equal(t, int64(3), replicas(diffTargets[0]))
+	})
+}
+
+func TestSSAConflictCondition(t *testing.T) {
+	assert.Nil(t, ssaConflictCondition(nil))
+
+	state := &v1alpha1.OperationState{
+		Phase: synccommon.OperationFailed,
+		SyncResult: &v1alpha1.SyncOperationResult{Resources: v1alpha1.ResourceResults{
+			{Group: "apps", Kind: "Deployment", Name: "my-deploy", Message: `server-side apply conflicts: .spec.replicas (conflict with "kube-controller-manager")`},
+			{Kind: "ConfigMap", Name: "my-config", Message: `configmap/my-config serverside-applied. ignored server-side apply conflicts: .data.key (conflict with "kubectl")`},
+			{Kind: "Service", Name: "my-service", Message: "service/my-service serverside-applied"},
+		}},
+	}
+	assert.Equal(t, &v1alpha1.ApplicationCondition{
+		Type: v1alpha1.ApplicationConditionServerSideApplyConflict,
+		Message: `Deployment/my-deploy: server-side apply conflicts: .spec.replicas (conflict with "kube-controller-manager"); ` +
+			`ConfigMap/my-config: ignored server-side apply conflicts: .data.key (conflict with "kubectl")`,
+	}, ssaConflictCondition(state))
+
+	state.Phase = synccommon.OperationRunning
+	assert.Nil(t, ssaConflictCondition(state))
+}
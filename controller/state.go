@@ -120,6 +120,8 @@ type appStateManager struct {
 	repoErrorGracePeriod  time.Duration
 	serverSideDiff        bool
 	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
+	resourceMutator       *resourceMutator
+	syncRateLimiters      *syncRateLimiters
 }
 
 // GetRepoObjs will generate the manifests for the given application delegating the
@@ -226,7 +228,10 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 
 	atLeastOneRevisionIsNotPossibleToBeUpdated := false
 
-	keyManifestGenerateAnnotationVal, keyManifestGenerateAnnotationExists := app.Annotations[v1alpha1.AnnotationKeyManifestGeneratePaths]
+	refreshPaths := path.GetAppRefreshPaths(app)
+
+	// attribute the repository accesses to the application in the repository access audit of the repo server
+	repoCtx := apiclient.ContextWithAuditInfo(context.Background(), apiclient.AuditInfo{Application: app.QualifiedName()})
 
 	for i, source := range sources {
 		if len(revisions) < len(sources) || revisions[i] == "" {
@@ -258,14 +263,14 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 			appNamespace = ""
 		}
 
-		if !source.IsHelm() && !source.IsOCI() && syncedRevision != "" && keyManifestGenerateAnnotationExists && keyManifestGenerateAnnotationVal != "" {
-			// Validate the manifest-generate-path annotation to avoid generating manifests if it has not changed.
-			updateRevisionResult, err := repoClient.UpdateRevisionForPaths(context.Background(), &apiclient.UpdateRevisionForPathsRequest{
+		if !source.IsHelm() && !source.IsOCI() && syncedRevision != "" && len(refreshPaths) > 0 {
+			// Validate the manifest-generate-path annotation and the watch paths to avoid generating manifests if they have not changed.
+			updateRevisionResult, err := repoClient.UpdateRevisionForPaths(repoCtx, &apiclient.UpdateRevisionForPathsRequest{
 				Repo:               repo,
 				Revision:           revision,
 				SyncedRevision:     syncedRevision,
 				NoRevisionCache:    noRevisionCache,
-				Paths:              path.GetAppRefreshPaths(app),
+				Paths:              refreshPaths,
 				AppLabelKey:        appLabelKey,
 				AppName:            app.InstanceName(m.namespace),
 				Namespace:          appNamespace,
@@ -306,7 +311,7 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 		}
 
 		log.Debugf("Generating Manifest for source %s revision %s", source, revision)
-		manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+		manifestInfo, err := repoClient.GenerateManifest(repoCtx, &apiclient.ManifestRequest{
 			Repo:                            repo,
 			Repos:                           repos,
 			Revision:                        revision,
@@ -314,6 +319,7 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 			NoRevisionCache:                 noRevisionCache,
 			AppLabelKey:                     appLabelKey,
 			AppName:                         app.InstanceName(m.namespace),
+			AppNamespace:                    app.Namespace,
 			Namespace:                       appNamespace,
 			ApplicationSource:               &source,
 			KustomizeOptions:                kustomizeOptions,
@@ -665,10 +671,15 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	}
 	conditions = append(conditions, dedupConditions...)
 
+	clusterResFilter, err := resFilter.ForCluster(destCluster)
+	if err != nil {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+		clusterResFilter = resFilter
+	}
 	for i := len(targetObjs) - 1; i >= 0; i-- {
 		targetObj := targetObjs[i]
 		gvk := targetObj.GroupVersionKind()
-		if resFilter.IsExcludedResource(gvk.Group, gvk.Kind, destCluster.Server) {
+		if clusterResFilter.IsExcludedResource(gvk.Group, gvk.Kind, destCluster.Server) {
 			targetObjs = append(targetObjs[:i], targetObjs[i+1:]...)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{
 				Type:               v1alpha1.ApplicationConditionExcludedResourceWarning,
@@ -684,13 +695,46 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 			targetNsExists = true
 		}
 	}
+	syncOptionsPolicies, err := argo.GetSyncOptionsPolicies(project)
+	if err != nil {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+	}
+	metadataPropagationPolicy, err := argo.GetMetadataPropagationPolicy(project)
+	if err != nil {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+	}
+	imageRegistryRewriteRules, err := argo.GetImageRegistryRewriteRules(project)
+	if err != nil {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+	}
+	imageRegistryRewriteRules = imageRegistryRewriteRules.ForCluster(destCluster)
+	for _, targetObj := range targetObjs {
+		// the options enforced, the metadata propagated and the images rewritten by the project are part of the desired
+		// state of the resources
+		syncOptionsPolicies.Apply(targetObj)
+		argo.ApplyMetadataPropagationPolicy(metadataPropagationPolicy, app, targetObj)
+		imageRegistryRewriteRules.Apply(targetObj)
+	}
+	mutationErrs, err := m.resourceMutator.mutate(app, targetObjs)
+	if err != nil {
+		mutationErrs = append(mutationErrs, err)
+	}
+	for _, err := range mutationErrs {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+	}
 	ts.AddCheckpoint("dedup_ms")
 
 	liveObjByKey, err := m.liveStateCache.GetManagedLiveObjs(destCluster, app, targetObjs)
 	if err != nil {
 		liveObjByKey = make(map[kubeutil.ResourceKey]*unstructured.Unstructured)
 		msg := "Failed to load live state: " + err.Error()
-		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+		conditionType := v1alpha1.ApplicationConditionComparisonError
+		var unreachableErr *statecache.ClusterUnreachableError
+		if errors.As(err, &unreachableErr) {
+			// the applications of the unreachable clusters are reported distinctly from the failed comparisons
+			conditionType = v1alpha1.ApplicationConditionClusterUnreachable
+		}
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: conditionType, Message: msg, LastTransitionTime: &now})
 		failedToLoadObjs = true
 	}
 
@@ -834,7 +878,8 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	// application conditions as argo.StateDiffs will validate this diffConfig again.
 	diffConfig, _ := diffConfigBuilder.Build()
 
-	diffResults, err := argodiff.StateDiffs(reconciliation.Live, reconciliation.Target, diffConfig)
+	diffTargets, diffLives := removeIgnoredSSAConflicts(app, reconciliation.Target, reconciliation.Live)
+	diffResults, err := argodiff.StateDiffs(diffLives, diffTargets, diffConfig)
 	if err != nil {
 		diffResults = &diff.DiffResultList{}
 		failedToLoadObjs = true
@@ -979,7 +1024,11 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 
 	ts.AddCheckpoint("sync_ms")
 
-	healthStatus, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, m.persistResourceHealth)
+	healthRollup, err := m.newResourceHealthRollup(destCluster)
+	if err != nil {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+	}
+	healthStatus, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, healthRollup, app, m.persistResourceHealth)
 	if err != nil {
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: "error setting app health: " + err.Error(), LastTransitionTime: &now})
 	}
@@ -1018,6 +1067,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 
 	app.Status.SetConditions(conditions, map[v1alpha1.ApplicationConditionType]bool{
 		v1alpha1.ApplicationConditionComparisonError:         true,
+		v1alpha1.ApplicationConditionClusterUnreachable:      true,
 		v1alpha1.ApplicationConditionSharedResourceWarning:   true,
 		v1alpha1.ApplicationConditionRepeatedResourceWarning: true,
 		v1alpha1.ApplicationConditionExcludedResourceWarning: true,
@@ -1163,6 +1213,8 @@ func NewAppStateManager(
 		repoErrorGracePeriod:  repoErrorGracePeriod,
 		serverSideDiff:        serverSideDiff,
 		ignoreNormalizerOpts:  ignoreNormalizerOpts,
+		resourceMutator:       newResourceMutator(settingsMgr),
+		syncRateLimiters:      newSyncRateLimiters(metricsServer.IncSyncThrottledRequest),
 	}
 }
 
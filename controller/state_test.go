@@ -28,6 +28,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
 	"github.com/argoproj/argo-cd/v3/controller/testdata"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
@@ -322,6 +323,43 @@ func TestCompareAppStateMissing(t *testing.T) {
 }
 
 // TestCompareAppStateExtra tests when there is an extra object in live but not defined in git
+func TestCompareAppStateClusterUnreachable(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err               error
+		expectedCondition v1alpha1.ApplicationConditionType
+	}{
+		"unreachable": {
+			err:               &statecache.ClusterUnreachableError{Server: test.FakeClusterURL, Err: errors.New("dial tcp: connection refused")},
+			expectedCondition: v1alpha1.ApplicationConditionClusterUnreachable,
+		},
+		"other error": {
+			err:               errors.New("error synchronizing cache state : forbidden"),
+			expectedCondition: v1alpha1.ApplicationConditionComparisonError,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			app := newFakeApp()
+			data := fakeData{
+				manifestResponse: &apiclient.ManifestResponse{
+					Manifests: []string{},
+					Namespace: test.FakeDestNamespace,
+					Server:    test.FakeClusterURL,
+					Revision:  "abc123",
+				},
+				managedLiveObjsErr: tc.err,
+			}
+			ctrl := newFakeController(&data, nil)
+			sources := []v1alpha1.ApplicationSource{app.Spec.GetSource()}
+			revisions := []string{""}
+			_, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, revisions, sources, false, false, nil, false)
+			require.NoError(t, err)
+			require.Len(t, app.Status.Conditions, 1)
+			assert.Equal(t, tc.expectedCondition, app.Status.Conditions[0].Type)
+			assert.Contains(t, app.Status.Conditions[0].Message, "Failed to load live state: ")
+		})
+	}
+}
+
 func TestCompareAppStateExtra(t *testing.T) {
 	pod := NewPod()
 	pod.SetNamespace(test.FakeDestNamespace)
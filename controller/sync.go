@@ -33,6 +33,7 @@ import (
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/argo/diff"
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
@@ -43,10 +44,6 @@ const (
 	// EnvVarSyncWaveDelay is an environment variable which controls the delay in seconds between
 	// each sync-wave
 	EnvVarSyncWaveDelay = "ARGOCD_SYNC_WAVE_DELAY"
-
-	// serviceAccountDisallowedCharSet contains the characters that are not allowed to be present
-	// in a DefaultServiceAccount configured for a DestinationServiceAccount
-	serviceAccountDisallowedCharSet = "!*[]{}\\/"
 )
 
 func (m *appStateManager) getOpenAPISchema(server *v1alpha1.Cluster) (openapi.Resources, error) {
@@ -137,6 +134,8 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		}
 	}
 
+	// the sync is started by this call, rather than resumed
+	starting := state.SyncResult == nil
 	if state.SyncResult != nil {
 		syncRes = state.SyncResult
 		revision = state.SyncResult.Revision
@@ -179,6 +178,14 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		return
 	}
 
+	// Manual syncs of a frozen application are only permitted to users who may freeze it, which the API server checks.
+	// Unlike with sync windows, an automated sync is not resumed once the freeze is lifted.
+	if freeze := app.Spec.Freeze; freeze.IsActive(time.Now()) && state.Operation.InitiatedBy.Automated {
+		state.Phase = common.OperationFailed
+		state.Message = "Sync operation blocked by freeze: " + freeze.Message()
+		return
+	}
+
 	if !isMultiSourceRevision {
 		sources = []v1alpha1.ApplicationSource{source}
 		revisions = []string{revision}
@@ -208,8 +215,9 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 
 	// If there are any comparison or spec errors error conditions do not perform the operation
 	if errConditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
-		v1alpha1.ApplicationConditionComparisonError:  true,
-		v1alpha1.ApplicationConditionInvalidSpecError: true,
+		v1alpha1.ApplicationConditionComparisonError:    true,
+		v1alpha1.ApplicationConditionInvalidSpecError:   true,
+		v1alpha1.ApplicationConditionClusterUnreachable: true,
 	}); len(errConditions) > 0 {
 		state.Phase = common.OperationError
 		state.Message = argo.FormatAppConditions(errConditions)
@@ -236,7 +244,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		state.Message = err.Error()
 		return
 	}
-	restConfig := metrics.AddMetricsTransportWrapper(m.metricsServer, app, clusterRESTConfig)
+	restConfig := metrics.AddMetricsTransportWrapper(m.metricsServer, app, m.syncRateLimiters.wrap(destCluster, clusterRESTConfig))
 
 	resourceOverrides, err := m.settingsMgr.GetResourceOverrides()
 	if err != nil {
@@ -299,6 +307,58 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		reconciliationResult.Target = patchedTargets
 	}
 
+	// the sync options of the application apply to all its resources, unlike the options forbidden by the project
+	syncOptionsPolicies, err := argo.GetSyncOptionsPolicies(project)
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = err.Error()
+		return
+	}
+	appSyncOptions := append([]string{}, syncOp.SyncOptions...)
+	if syncOp.SyncStrategy.Force() {
+		appSyncOptions = append(appSyncOptions, common.SyncOptionForce)
+	}
+	for _, target := range reconciliationResult.Target {
+		if target == nil {
+			continue
+		}
+		gvk := target.GroupVersionKind()
+		if len(syncOp.Resources) > 0 && !argo.ContainsSyncResource(target.GetName(), target.GetNamespace(), gvk, syncOp.Resources) {
+			continue
+		}
+		if option, forbidden := syncOptionsPolicies.ForbiddenOption(target, appSyncOptions); forbidden {
+			state.Phase = common.OperationFailed
+			state.Message = fmt.Sprintf("Sync option %s is forbidden by project %s for resource %s/%s %s", option, project.Name, gvk.Group, gvk.Kind, target.GetName())
+			return
+		}
+	}
+
+	if starting && !syncOp.DryRun && syncOp.SyncOptions.HasOption(syncOptionCheckCapacity) {
+		var targets, lives []*unstructured.Unstructured
+		for i, target := range reconciliationResult.Target {
+			if target == nil || (len(syncOp.Resources) > 0 && !argo.ContainsSyncResource(target.GetName(), target.GetNamespace(), target.GroupVersionKind(), syncOp.Resources)) {
+				continue
+			}
+			targets = append(targets, target)
+			lives = append(lives, reconciliationResult.Live[i])
+		}
+		capacity, err := newSyncCapacity(targets, lives)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to compute the capacity required by the sync: %v", err)
+			return
+		}
+		insufficient, err := m.checkSyncCapacity(destCluster, capacity)
+		if err != nil {
+			// the syncs are not blocked by the failures of the check
+			logEntry.Warnf("Failed to check the capacity of the destination cluster: %v", err)
+		} else if insufficient != "" {
+			state.Phase = common.OperationFailed
+			state.Message = "Insufficient capacity in the destination cluster: " + insufficient
+			return
+		}
+	}
+
 	installationID, err := m.settingsMgr.GetInstallationID()
 	if err != nil {
 		log.Errorf("Could not get installation ID: %v", err)
@@ -332,9 +392,11 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		}
 	}
 
+	hookHealthOverride := newHookPolicyHealthOverride(lua.ResourceHealthOverrides(resourceOverrides))
+	healthRecorder := newHealthMessageRecorder(hookHealthOverride)
 	opts := []sync.SyncOpt{
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
-		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
+		sync.WithHealthOverride(healthRecorder),
 		sync.WithPermissionValidator(func(un *unstructured.Unstructured, res *metav1.APIResource) error {
 			if !project.IsGroupKindPermitted(un.GroupVersionKind().GroupKind(), res.Namespaced) {
 				return fmt.Errorf("resource %s:%s is not permitted in project %s", un.GroupVersionKind().Group, un.GroupVersionKind().Kind, project.Name)
@@ -386,7 +448,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		reconciliationResult,
 		restConfig,
 		rawConfig,
-		m.kubectl,
+		newSSAConflictsKubectl(m.kubectl, app, syncOp.SyncOptions),
 		app.Spec.Destination.Namespace,
 		openAPISchema,
 		opts...,
@@ -405,11 +467,24 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		syncCtx.Terminate()
 	} else {
 		syncCtx.Sync()
+		hookHealthOverride.retryHooks(context.Background(), m.kubectl, restConfig, reconciliationResult.Hooks, logEntry)
 	}
 	var resState []common.ResourceSyncResult
 	state.Phase, state.Message, resState = syncCtx.GetState()
+	if state.Phase == common.OperationRunning {
+		state.Message = healthRecorder.describe(state.Message)
+	}
 	state.SyncResult.Resources = nil
 
+	// the messages of the sync operation may quote the applied resources, and are recorded in the events and the logs
+	redactions, err := m.settingsMgr.GetResourceRedactions()
+	if err != nil {
+		logEntry.Warnf("Failed to load resource redactions: %v", err)
+	}
+	redactor := diff.NewRedactor(redactions)
+	redact := executil.Redact(append(redactor.Values(reconciliationResult.Target...), redactor.Values(reconciliationResult.Live...)...))
+	state.Message = redact(state.Message)
+
 	if app.Spec.SyncPolicy != nil {
 		state.SyncResult.ManagedNamespaceMetadata = app.Spec.SyncPolicy.ManagedNamespaceMetadata
 	}
@@ -431,6 +506,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		} else {
 			res.Message = augmentedMsg
 		}
+		res.Message = redact(res.Message)
 
 		state.SyncResult.Resources = append(state.SyncResult.Resources, &v1alpha1.ResourceResult{
 			HookType:  res.HookType,
@@ -449,6 +525,13 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
+	if !syncOp.DryRun && state.Phase == common.OperationSucceeded {
+		if err := m.runVerificationProbes(app, restConfig, logEntry); err != nil {
+			state.Phase = common.OperationFailed
+			state.Message = redact(fmt.Sprintf("Verification failed: %v", err))
+		}
+	}
+
 	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
 		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceRevision, state.StartedAt, state.Operation.InitiatedBy)
 		if err != nil {
@@ -599,6 +682,9 @@ func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject
 
 // deriveServiceAccountToImpersonate determines the service account to be used for impersonation for the sync operation.
 // The returned service account will be fully qualified including namespace and the service account name in the format system:serviceaccount:<namespace>:<service_account>
+// When the application requests a service account with its spec.destinationServiceAccount field,
+// it must be the service account of one of the destination service accounts of the project matching the destination.
+// Otherwise, the service account of the first matching destination service account is used.
 func deriveServiceAccountToImpersonate(project *v1alpha1.AppProject, application *v1alpha1.Application, destCluster *v1alpha1.Cluster) (string, error) {
 	// spec.Destination.Namespace is optional. If not specified, use the Application's
 	// namespace
@@ -606,6 +692,13 @@ func deriveServiceAccountToImpersonate(project *v1alpha1.AppProject, application
 	if serviceAccountNamespace == "" {
 		serviceAccountNamespace = application.Namespace
 	}
+	requestedServiceAccount := application.Spec.DestinationServiceAccount
+	if requestedServiceAccount != "" {
+		if !isValidServiceAccount(requestedServiceAccount) {
+			return "", fmt.Errorf("requested service account contains invalid chars '%s'", requestedServiceAccount)
+		}
+		requestedServiceAccount = qualifyServiceAccount(requestedServiceAccount, serviceAccountNamespace)
+	}
 	// Loop through the destinationServiceAccounts and see if there is any destination that is a candidate.
 	// if so, return the service account specified for that destination.
 	for _, item := range project.Spec.DestinationServiceAccounts {
@@ -618,16 +711,33 @@ func deriveServiceAccountToImpersonate(project *v1alpha1.AppProject, application
 			return "", fmt.Errorf("invalid glob pattern for destination namespace: %w", err)
 		}
 		if dstServerMatched && dstNamespaceMatched {
-			if strings.Trim(item.DefaultServiceAccount, " ") == "" || strings.ContainsAny(item.DefaultServiceAccount, serviceAccountDisallowedCharSet) {
+			if !isValidServiceAccount(item.DefaultServiceAccount) {
 				return "", fmt.Errorf("default service account contains invalid chars '%s'", item.DefaultServiceAccount)
-			} else if strings.Contains(item.DefaultServiceAccount, ":") {
-				// service account is specified along with its namespace.
-				return "system:serviceaccount:" + item.DefaultServiceAccount, nil
 			}
-			// service account needs to be prefixed with a namespace
-			return fmt.Sprintf("system:serviceaccount:%s:%s", serviceAccountNamespace, item.DefaultServiceAccount), nil
+			serviceAccount := qualifyServiceAccount(item.DefaultServiceAccount, serviceAccountNamespace)
+			if requestedServiceAccount == "" || requestedServiceAccount == serviceAccount {
+				return serviceAccount, nil
+			}
 		}
 	}
+	if requestedServiceAccount != "" {
+		return "", fmt.Errorf("service account %s is not allowed by project %s for destination server %s and namespace %s", requestedServiceAccount, project.Name, application.Spec.Destination.Server, serviceAccountNamespace)
+	}
 	// if there is no match found in the AppProject.Spec.DestinationServiceAccounts, use the default service account of the destination namespace.
 	return "", fmt.Errorf("no matching service account found for destination server %s and namespace %s", application.Spec.Destination.Server, serviceAccountNamespace)
 }
+
+func isValidServiceAccount(serviceAccount string) bool {
+	return strings.Trim(serviceAccount, " ") != "" && !strings.ContainsAny(serviceAccount, v1alpha1.ServiceAccountDisallowedCharSet)
+}
+
+// qualifyServiceAccount returns the user name of the given service account, which is prefixed with the given namespace
+// unless it is specified along with its namespace
+func qualifyServiceAccount(serviceAccount string, namespace string) string {
+	if strings.Contains(serviceAccount, ":") {
+		// service account is specified along with its namespace.
+		return "system:serviceaccount:" + serviceAccount
+	}
+	// service account needs to be prefixed with a namespace
+	return fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccount)
+}
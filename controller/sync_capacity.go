@@ -0,0 +1,191 @@
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argoresource "github.com/argoproj/argo-cd/v3/util/resource"
+)
+
+// syncOptionCheckCapacity fails the syncs whose workloads obviously do not fit in the destination cluster, instead of
+// leaving their pods pending
+const syncOptionCheckCapacity = "CheckCapacity=true"
+
+// capacityResources are the resources whose requests are checked against the capacity of the destination cluster
+var capacityResources = argoresource.WorkloadResources
+
+// quotaResources are the names of the quota limits of the requests of the capacity resources
+var quotaResources = map[corev1.ResourceName][]corev1.ResourceName{
+	corev1.ResourceCPU:    {corev1.ResourceRequestsCPU, corev1.ResourceCPU},
+	corev1.ResourceMemory: {corev1.ResourceRequestsMemory, corev1.ResourceMemory},
+}
+
+// syncCapacity is the capacity required by a sync, in milli units
+type syncCapacity struct {
+	// total is the increase of the requests of the workloads of the sync
+	total map[corev1.ResourceName]int64
+	// perNamespace is the increase of the requests of the workloads of the sync per namespace
+	perNamespace map[string]map[corev1.ResourceName]int64
+	// largestPod is the largest requests of the pods of the sync
+	largestPod map[corev1.ResourceName]int64
+}
+
+// newSyncCapacity returns the capacity required to sync the given target resources, given their live state. The
+// requests of the live workloads are deducted from the requests of the target ones.
+func newSyncCapacity(targets []*unstructured.Unstructured, lives []*unstructured.Unstructured) (*syncCapacity, error) {
+	capacity := &syncCapacity{
+		total:        map[corev1.ResourceName]int64{},
+		perNamespace: map[string]map[corev1.ResourceName]int64{},
+		largestPod:   map[corev1.ResourceName]int64{},
+	}
+	for i, target := range targets {
+		if target == nil {
+			continue
+		}
+		targetRequests, targetReplicas, err := argoresource.GetWorkloadRequests(target)
+		if err != nil {
+			return nil, err
+		}
+		if targetReplicas == 0 {
+			continue
+		}
+		liveRequests, liveReplicas, err := argoresource.GetWorkloadRequests(lives[i])
+		if err != nil {
+			return nil, err
+		}
+		namespace := target.GetNamespace()
+		if capacity.perNamespace[namespace] == nil {
+			capacity.perNamespace[namespace] = map[corev1.ResourceName]int64{}
+		}
+		for _, name := range capacityResources {
+			delta := targetRequests[name]*targetReplicas - liveRequests[name]*liveReplicas
+			capacity.total[name] += delta
+			capacity.perNamespace[namespace][name] += delta
+			// the pods which are not changed are already scheduled
+			if targetRequests[name] > liveRequests[name] || targetReplicas > liveReplicas {
+				capacity.largestPod[name] = max(capacity.largestPod[name], targetRequests[name])
+			}
+		}
+	}
+	return capacity, nil
+}
+
+// checkSyncCapacity checks that the destination cluster has the capacity required by a sync: the schedulable nodes
+// must have enough allocatable resources left for the increase of the requests of the workloads, one of them must be
+// large enough for the largest pod, and the resource quotas of the namespaces must not be exceeded. It returns the
+// description of the insufficient capacities, if any. The nodes and quotas which are not visible to Argo CD are not
+// checked.
+func (m *appStateManager) checkSyncCapacity(destCluster *appv1.Cluster, capacity *syncCapacity) (string, error) {
+	allocatable := map[corev1.ResourceName]int64{}
+	largestNode := map[corev1.ResourceName]int64{}
+	schedulableNodes := map[string]bool{}
+	requestsByNode := map[string]map[corev1.ResourceName]int64{}
+	type namedQuota struct {
+		name string
+		*statecache.ResourceQuotaInfo
+	}
+	quotas := map[string][]namedQuota{}
+	err := m.liveStateCache.IterateResources(destCluster, func(res *clustercache.Resource, info *statecache.ResourceInfo) {
+		key := res.ResourceKey()
+		switch {
+		case info.NodeInfo != nil && key.Group == "" && key.Kind == "Node":
+			if info.NodeInfo.Unschedulable {
+				return
+			}
+			schedulableNodes[key.Name] = true
+			for _, name := range capacityResources {
+				if quantity, ok := info.NodeInfo.Allocatable[name]; ok {
+					allocatable[name] += quantity.MilliValue()
+					largestNode[name] = max(largestNode[name], quantity.MilliValue())
+				}
+			}
+		case info.PodInfo != nil && key.Group == "" && key.Kind == kube.PodKind:
+			if info.PodInfo.NodeName == "" || info.PodInfo.Phase == corev1.PodSucceeded || info.PodInfo.Phase == corev1.PodFailed {
+				return
+			}
+			if requestsByNode[info.PodInfo.NodeName] == nil {
+				requestsByNode[info.PodInfo.NodeName] = map[corev1.ResourceName]int64{}
+			}
+			for _, name := range capacityResources {
+				if quantity, ok := info.PodInfo.ResourceRequests[name]; ok {
+					requestsByNode[info.PodInfo.NodeName][name] += quantity.MilliValue()
+				}
+			}
+		case info.ResourceQuotaInfo != nil && key.Group == "" && key.Kind == "ResourceQuota":
+			if _, ok := capacity.perNamespace[key.Namespace]; ok {
+				quotas[key.Namespace] = append(quotas[key.Namespace], namedQuota{name: key.Name, ResourceQuotaInfo: info.ResourceQuotaInfo})
+			}
+		}
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to iterate the resources of the destination cluster: %w", err)
+	}
+
+	var insufficient []string
+	if len(schedulableNodes) > 0 {
+		for _, name := range capacityResources {
+			if allocatable[name] == 0 {
+				continue
+			}
+			available := allocatable[name]
+			for nodeName, requests := range requestsByNode {
+				if schedulableNodes[nodeName] {
+					available -= requests[name]
+				}
+			}
+			if capacity.total[name] > 0 && capacity.total[name] > available {
+				insufficient = append(insufficient, fmt.Sprintf("%s: %s more requested, %s available on the schedulable nodes",
+					name, formatMilliQuantity(name, capacity.total[name]), formatMilliQuantity(name, max(available, 0))))
+			}
+			if capacity.largestPod[name] > largestNode[name] {
+				insufficient = append(insufficient, fmt.Sprintf("%s: pods requesting %s do not fit on any schedulable node, which allocate at most %s",
+					name, formatMilliQuantity(name, capacity.largestPod[name]), formatMilliQuantity(name, largestNode[name])))
+			}
+		}
+	}
+
+	namespaces := make([]string, 0, len(quotas))
+	for namespace := range quotas {
+		namespaces = append(namespaces, namespace)
+	}
+	slices.Sort(namespaces)
+	for _, namespace := range namespaces {
+		for _, quota := range quotas[namespace] {
+			for _, name := range capacityResources {
+				requested := capacity.perNamespace[namespace][name]
+				if requested <= 0 {
+					continue
+				}
+				for _, quotaResource := range quotaResources[name] {
+					hard, ok := quota.Hard[quotaResource]
+					if !ok {
+						continue
+					}
+					used := quota.Used[quotaResource]
+					if left := hard.MilliValue() - used.MilliValue(); requested > left {
+						insufficient = append(insufficient, fmt.Sprintf("%s: %s more requested, %s left in quota %s of namespace %s",
+							quotaResource, formatMilliQuantity(name, requested), formatMilliQuantity(name, max(left, 0)), quota.name, namespace))
+					}
+				}
+			}
+		}
+	}
+	return strings.Join(insufficient, "; "), nil
+}
+
+// formatMilliQuantity formats the given quantity of the given resource, in milli units
+func formatMilliQuantity(name corev1.ResourceName, milliValue int64) string {
+	if name == corev1.ResourceMemory {
+		return resource.NewQuantity(milliValue/1000, resource.BinarySI).String()
+	}
+	return resource.NewMilliQuantity(milliValue, resource.DecimalSI).String()
+}
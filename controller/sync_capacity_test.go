@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"testing"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
+	mockstatecache "github.com/argoproj/argo-cd/v3/controller/cache/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newCapacityDeployment(replicas int, cpu string) *unstructured.Unstructured {
+	deployment := test.YamlToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook
+`)
+	_ = unstructured.SetNestedField(deployment.Object, int64(replicas), "spec", "replicas")
+	_ = unstructured.SetNestedSlice(deployment.Object, []any{map[string]any{
+		"name":      "guestbook",
+		"image":     "guestbook",
+		"resources": map[string]any{"requests": map[string]any{"cpu": cpu, "memory": "64Mi"}},
+	}}, "spec", "template", "spec", "containers")
+	return deployment
+}
+
+func TestNewSyncCapacity(t *testing.T) {
+	capacity, err := newSyncCapacity(
+		[]*unstructured.Unstructured{newCapacityDeployment(3, "1"), test.NewConfigMap()},
+		[]*unstructured.Unstructured{newCapacityDeployment(1, "1"), nil},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), capacity.total[corev1.ResourceCPU])
+	assert.Equal(t, int64(2000), capacity.perNamespace["default"][corev1.ResourceCPU])
+	assert.Equal(t, int64(1000), capacity.largestPod[corev1.ResourceCPU])
+
+	// the pods which are not changed are not required to fit on a node
+	capacity, err = newSyncCapacity([]*unstructured.Unstructured{newCapacityDeployment(1, "1")}, []*unstructured.Unstructured{newCapacityDeployment(1, "1")})
+	require.NoError(t, err)
+	assert.Zero(t, capacity.total[corev1.ResourceCPU])
+	assert.Zero(t, capacity.largestPod[corev1.ResourceCPU])
+}
+
+func TestCheckSyncCapacity(t *testing.T) {
+	mockStateCache := &mockstatecache.LiveStateCache{}
+	mockStateCache.On("IterateResources", mock.Anything, mock.MatchedBy(func(callback func(res *clustercache.Resource, info *statecache.ResourceInfo)) bool {
+		// a schedulable node with 2 CPUs, one of which is requested
+		callback(&clustercache.Resource{
+			Ref: corev1.ObjectReference{Name: "node1", Kind: "Node", APIVersion: "v1"},
+		}, &statecache.ResourceInfo{NodeInfo: &statecache.NodeInfo{
+			Name:        "node1",
+			Allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+		}})
+		callback(&clustercache.Resource{
+			Ref: corev1.ObjectReference{Name: "pod1", Kind: kube.PodKind, APIVersion: "v1", Namespace: "default"},
+		}, &statecache.ResourceInfo{PodInfo: &statecache.PodInfo{
+			NodeName:         "node1",
+			ResourceRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			Phase:            corev1.PodRunning,
+		}})
+		// a cordoned node
+		callback(&clustercache.Resource{
+			Ref: corev1.ObjectReference{Name: "node2", Kind: "Node", APIVersion: "v1"},
+		}, &statecache.ResourceInfo{NodeInfo: &statecache.NodeInfo{
+			Name:          "node2",
+			Allocatable:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")},
+			Unschedulable: true,
+		}})
+		// a quota of the namespace of the workloads
+		callback(&clustercache.Resource{
+			Ref: corev1.ObjectReference{Name: "compute", Kind: "ResourceQuota", APIVersion: "v1", Namespace: "default"},
+		}, &statecache.ResourceInfo{ResourceQuotaInfo: &statecache.ResourceQuotaInfo{
+			Hard: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("4")},
+			Used: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("3500m")},
+		}})
+		return true
+	})).Return(nil)
+	m := &appStateManager{liveStateCache: mockStateCache}
+	cluster := &v1alpha1.Cluster{Server: "https://kubernetes.default.svc"}
+
+	insufficient, err := m.checkSyncCapacity(cluster, &syncCapacity{
+		total:        map[corev1.ResourceName]int64{corev1.ResourceCPU: 500},
+		perNamespace: map[string]map[corev1.ResourceName]int64{"default": {corev1.ResourceCPU: 500}},
+		largestPod:   map[corev1.ResourceName]int64{corev1.ResourceCPU: 500},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, insufficient)
+
+	insufficient, err = m.checkSyncCapacity(cluster, &syncCapacity{
+		total:        map[corev1.ResourceName]int64{corev1.ResourceCPU: 3000},
+		perNamespace: map[string]map[corev1.ResourceName]int64{"default": {corev1.ResourceCPU: 3000}},
+		largestPod:   map[corev1.ResourceName]int64{corev1.ResourceCPU: 3000},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "cpu: 3 more requested, 1 available on the schedulable nodes; "+
+		"cpu: pods requesting 3 do not fit on any schedulable node, which allocate at most 2; "+
+		"requests.cpu: 3 more requested, 500m left in quota compute of namespace default", insufficient)
+}
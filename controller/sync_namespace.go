@@ -5,8 +5,58 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
 )
 
+// managedNamespaceMetadataPolicy controls how the managed namespace metadata of an application is applied to its
+// namespace
+type managedNamespaceMetadataPolicy struct {
+	// replaceLabels removes the namespace labels which are not part of the managed namespace metadata
+	replaceLabels bool
+	// replaceAnnotations removes the namespace annotations which are not part of the managed namespace metadata
+	replaceAnnotations bool
+	// ignoredKeys are globs matching the labels and annotations managed by other controllers
+	ignoredKeys []string
+}
+
+// getManagedNamespaceMetadataPolicy returns the policy of the given managed namespace metadata
+func getManagedNamespaceMetadataPolicy(metadata *v1alpha1.ManagedNamespaceMetadata) managedNamespaceMetadataPolicy {
+	return managedNamespaceMetadataPolicy{
+		replaceLabels:      metadata.LabelsPolicy == v1alpha1.ManagedNamespaceMetadataPolicyReplace,
+		replaceAnnotations: metadata.AnnotationsPolicy == v1alpha1.ManagedNamespaceMetadataPolicyReplace,
+		ignoredKeys:        metadata.IgnoredKeys,
+	}
+}
+
+func (p managedNamespaceMetadataPolicy) isIgnored(key string) bool {
+	for _, pattern := range p.ignoredKeys {
+		if glob.Match(pattern, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// getMetadata returns the labels or annotations to set on the namespace: the managed ones which are not ignored, and
+// the live ones which are ignored or kept by the merge mode
+func (p managedNamespaceMetadataPolicy) getMetadata(managed map[string]string, live map[string]string, replace bool) map[string]string {
+	if len(p.ignoredKeys) == 0 && (!replace || live == nil) {
+		return managed
+	}
+	r := map[string]string{}
+	for k, v := range live {
+		if !replace || p.isIgnored(k) {
+			r[k] = v
+		}
+	}
+	for k, v := range managed {
+		if !p.isIgnored(k) {
+			r[k] = v
+		}
+	}
+	return r
+}
+
 // syncNamespace determine if Argo CD should create and/or manage the namespace
 // where the application will be deployed.
 func syncNamespace(syncPolicy *v1alpha1.SyncPolicy) func(m *unstructured.Unstructured, l *unstructured.Unstructured) (bool, error) {
@@ -27,10 +77,27 @@ func syncNamespace(syncPolicy *v1alpha1.SyncPolicy) func(m *unstructured.Unstruc
 
 		if isManagedNamespace {
 			managedNamespaceMetadata := syncPolicy.ManagedNamespaceMetadata
-			managedNs.SetLabels(managedNamespaceMetadata.Labels)
-			// managedNamespaceMetadata relies on SSA in order to avoid overriding
-			// existing labels and annotations in namespaces
-			managedNs.SetAnnotations(appendSSAAnnotation(managedNamespaceMetadata.Annotations))
+			if err := managedNamespaceMetadata.Validate(); err != nil {
+				return false, err
+			}
+			metadataPolicy := getManagedNamespaceMetadataPolicy(managedNamespaceMetadata)
+			if !metadataPolicy.replaceLabels && !metadataPolicy.replaceAnnotations {
+				managedNs.SetLabels(metadataPolicy.getMetadata(managedNamespaceMetadata.Labels, nil, false))
+				// managedNamespaceMetadata relies on SSA in order to avoid overriding
+				// existing labels and annotations in namespaces
+				managedNs.SetAnnotations(appendSyncOptionAnnotation(metadataPolicy.getMetadata(managedNamespaceMetadata.Annotations, nil, false), gitopscommon.SyncOptionServerSideApply))
+			} else {
+				// the namespace is replaced in order to remove the labels or annotations which are not managed, so the
+				// live metadata which is kept must be part of the managed namespace
+				var liveLabels, liveAnnotations map[string]string
+				if liveNs != nil {
+					liveLabels, liveAnnotations = liveNs.GetLabels(), liveNs.GetAnnotations()
+					managedNs.SetFinalizers(liveNs.GetFinalizers())
+					managedNs.SetOwnerReferences(liveNs.GetOwnerReferences())
+				}
+				managedNs.SetLabels(metadataPolicy.getMetadata(managedNamespaceMetadata.Labels, liveLabels, metadataPolicy.replaceLabels))
+				managedNs.SetAnnotations(appendSyncOptionAnnotation(metadataPolicy.getMetadata(managedNamespaceMetadata.Annotations, liveAnnotations, metadataPolicy.replaceAnnotations), gitopscommon.SyncOptionReplace))
+			}
 		}
 
 		// TODO: https://github.com/argoproj/argo-cd/issues/11196
@@ -43,13 +110,13 @@ func syncNamespace(syncPolicy *v1alpha1.SyncPolicy) func(m *unstructured.Unstruc
 	}
 }
 
-// appendSSAAnnotation will set the managed namespace to be synced
-// with server-side apply
-func appendSSAAnnotation(in map[string]string) map[string]string {
+// appendSyncOptionAnnotation will set the sync option with which the
+// managed namespace is synced
+func appendSyncOptionAnnotation(in map[string]string, option string) map[string]string {
 	r := map[string]string{}
 	for k, v := range in {
 		r[k] = v
 	}
-	r[gitopscommon.AnnotationSyncOptions] = gitopscommon.SyncOptionServerSideApply
+	r[gitopscommon.AnnotationSyncOptions] = option
 	return r
 }
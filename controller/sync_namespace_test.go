@@ -260,3 +260,62 @@ func Test_shouldNamespaceSync(t *testing.T) {
 		})
 	}
 }
+
+func Test_getManagedNamespaceMetadataPolicy(t *testing.T) {
+	assert.Equal(t, managedNamespaceMetadataPolicy{}, getManagedNamespaceMetadataPolicy(&v1alpha1.ManagedNamespaceMetadata{}))
+
+	policy := getManagedNamespaceMetadataPolicy(&v1alpha1.ManagedNamespaceMetadata{
+		LabelsPolicy:      v1alpha1.ManagedNamespaceMetadataPolicyReplace,
+		AnnotationsPolicy: v1alpha1.ManagedNamespaceMetadataPolicyMerge,
+		IgnoredKeys:       []string{"kyverno.io/*", "iam.gke.io/gcp-service-account"},
+	})
+	assert.Equal(t, managedNamespaceMetadataPolicy{replaceLabels: true, ignoredKeys: []string{"kyverno.io/*", "iam.gke.io/gcp-service-account"}}, policy)
+}
+
+func Test_syncNamespaceMetadataPolicy(t *testing.T) {
+	newSyncPolicy := func(labelsPolicy, annotationsPolicy v1alpha1.ManagedNamespaceMetadataPolicy, ignoredKeys ...string) *v1alpha1.SyncPolicy {
+		return &v1alpha1.SyncPolicy{
+			ManagedNamespaceMetadata: &v1alpha1.ManagedNamespaceMetadata{
+				Labels:            map[string]string{"team": "a", "kyverno.io/owner": "argocd"},
+				Annotations:       map[string]string{"contact": "team-a"},
+				LabelsPolicy:      labelsPolicy,
+				AnnotationsPolicy: annotationsPolicy,
+				IgnoredKeys:       ignoredKeys,
+			},
+		}
+	}
+	liveNs := createFakeNamespace("", "1", map[string]string{"team": "b", "stale": "true", "kyverno.io/owner": "kyverno"}, map[string]string{"other": "true"})
+	liveNs.SetFinalizers([]string{"example.com/cleanup"})
+
+	t.Run("MergeIgnoringKeys", func(t *testing.T) {
+		managedNs := createFakeNamespace("", "", nil, nil)
+		_, err := syncNamespace(newSyncPolicy("", "", "kyverno.io/*"))(managedNs, liveNs)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"team": "a"}, managedNs.GetLabels())
+		assert.Equal(t, map[string]string{"contact": "team-a", "argocd.argoproj.io/sync-options": "ServerSideApply=true"}, managedNs.GetAnnotations())
+	})
+
+	t.Run("ReplaceLabels", func(t *testing.T) {
+		managedNs := createFakeNamespace("", "", nil, nil)
+		_, err := syncNamespace(newSyncPolicy(v1alpha1.ManagedNamespaceMetadataPolicyReplace, v1alpha1.ManagedNamespaceMetadataPolicyMerge, "kyverno.io/*"))(managedNs, liveNs)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"team": "a", "kyverno.io/owner": "kyverno"}, managedNs.GetLabels())
+		assert.Equal(t, map[string]string{"contact": "team-a", "other": "true", "argocd.argoproj.io/sync-options": "Replace=true"}, managedNs.GetAnnotations())
+		assert.Equal(t, []string{"example.com/cleanup"}, managedNs.GetFinalizers())
+	})
+
+	t.Run("ReplaceNewNamespace", func(t *testing.T) {
+		managedNs := createFakeNamespace("", "", nil, nil)
+		syncPolicy := newSyncPolicy(v1alpha1.ManagedNamespaceMetadataPolicyReplace, v1alpha1.ManagedNamespaceMetadataPolicyReplace)
+		_, err := syncNamespace(syncPolicy)(managedNs, nil)
+		require.NoError(t, err)
+		assert.Equal(t, syncPolicy.ManagedNamespaceMetadata.Labels, managedNs.GetLabels())
+		assert.Equal(t, map[string]string{"contact": "team-a", "argocd.argoproj.io/sync-options": "Replace=true"}, managedNs.GetAnnotations())
+	})
+
+	t.Run("InvalidPolicy", func(t *testing.T) {
+		managedNs := createFakeNamespace("", "", nil, nil)
+		_, err := syncNamespace(newSyncPolicy("overwrite", ""))(managedNs, liveNs)
+		assert.ErrorContains(t, err, "invalid managed namespace metadata policy")
+	})
+}
@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+const (
+	// syncSlotExpiration is the expiration of the sync slot of an application whose operation is not processed
+	// anymore, e.g. because its controller crashed. The slot is refreshed whenever the operation is processed.
+	syncSlotExpiration = 10 * time.Minute
+	// syncSlotRetryInterval is the interval at which the operations waiting for a sync slot are processed again
+	syncSlotRetryInterval = 10 * time.Second
+)
+
+// SyncParallelism holds the maximum numbers of in-flight syncs. The syncs are coordinated across the controller
+// replicas with the cache, so that the syncs to the clusters and projects of a fleet are leveled.
+type SyncParallelism struct {
+	// PerCluster is the maximum number of in-flight syncs per destination cluster. Unlimited if zero.
+	PerCluster int
+	// PerProject is the maximum number of in-flight syncs per project. Unlimited if zero.
+	PerProject int
+}
+
+// syncSlotScope is a scope whose number of in-flight syncs is limited
+type syncSlotScope struct {
+	key         string
+	slots       int
+	description string
+	// queued is true if the single slot of the scope is acquired in first-come, first-served order
+	queued bool
+}
+
+// syncSlotScopes returns the scopes of the in-flight syncs of the given application
+func (ctrl *ApplicationController) syncSlotScopes(app *appv1.Application) []syncSlotScope {
+	var scopes []syncSlotScope
+	if ctrl.syncParallelism.PerCluster > 0 {
+		destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db)
+		if err != nil {
+			// the sync reports the invalid destination
+			log.WithFields(applog.GetAppLogFields(app)).Warnf("Failed to get the destination cluster to limit the in-flight syncs: %v", err)
+		} else {
+			scopes = append(scopes, syncSlotScope{key: "cluster|" + destCluster.Server, slots: ctrl.syncParallelism.PerCluster, description: "cluster " + destCluster.Server})
+		}
+	}
+	if ctrl.syncParallelism.PerProject > 0 {
+		scopes = append(scopes, syncSlotScope{key: "project|" + app.Spec.GetProject(), slots: ctrl.syncParallelism.PerProject, description: "project " + app.Spec.GetProject()})
+	}
+	// the concurrency key is acquired last, so that the application keeps its position in the queue of the key while
+	// waiting for the other slots
+	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.ConcurrencyKey != "" {
+		key := app.Spec.SyncPolicy.ConcurrencyKey
+		scopes = append(scopes, syncSlotScope{key: "concurrency|" + key, slots: 1, description: "concurrency key " + key, queued: true})
+	}
+	return scopes
+}
+
+// acquireSyncSlots acquires the sync slots of the destination cluster, of the project and of the concurrency key of the
+// given application, and returns the reason the operation has to wait for, if any, along with the position of the
+// application in the queue of its concurrency key. The slots already acquired are released when the operation has to
+// wait, not to hold them while waiting.
+func (ctrl *ApplicationController) acquireSyncSlots(app *appv1.Application) (string, int) {
+	scopes := ctrl.syncSlotScopes(app)
+	for i, scope := range scopes {
+		var acquired bool
+		var position int
+		var err error
+		if scope.queued {
+			acquired, position, err = ctrl.cache.AcquireQueuedSyncSlot(scope.key, app.QualifiedName(), syncSlotExpiration)
+		} else {
+			acquired, err = ctrl.cache.AcquireSyncSlot(scope.key, scope.slots, app.QualifiedName(), syncSlotExpiration)
+		}
+		if err != nil {
+			// the syncs are not blocked by the unavailability of the cache
+			log.WithFields(applog.GetAppLogFields(app)).Warnf("Failed to acquire the sync slot of %s: %v", scope.description, err)
+			continue
+		}
+		if !acquired {
+			ctrl.releaseSyncSlots(app, scopes[:i])
+			if scope.queued {
+				return fmt.Sprintf("waiting for the in-flight sync of %s to complete (position %d in the queue)", scope.description, position), position
+			}
+			return fmt.Sprintf("waiting for one of the %d in-flight syncs of %s to complete", scope.slots, scope.description), 0
+		}
+	}
+	return "", 0
+}
+
+// releaseSyncSlots releases the sync slots of the given scopes acquired by the given application, and removes the
+// application from the queues of the scopes
+func (ctrl *ApplicationController) releaseSyncSlots(app *appv1.Application, scopes []syncSlotScope) {
+	for _, scope := range scopes {
+		if err := ctrl.cache.ReleaseSyncSlot(scope.key, scope.slots, app.QualifiedName()); err != nil {
+			log.WithFields(applog.GetAppLogFields(app)).Warnf("Failed to release the sync slot of %s: %v", scope.description, err)
+		}
+		if scope.queued {
+			if err := ctrl.cache.LeaveSyncQueue(scope.key, app.QualifiedName()); err != nil {
+				log.WithFields(applog.GetAppLogFields(app)).Warnf("Failed to leave the sync queue of %s: %v", scope.description, err)
+			}
+		}
+	}
+}
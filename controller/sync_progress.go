@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// waitingForHealthyStatePrefix is the prefix of the message of the running sync operations waiting for resources to
+// become healthy, which is followed by the group/kind/name of the first of them
+const waitingForHealthyStatePrefix = "waiting for healthy state of "
+
+// healthMessageRecorder records the health messages of the resources the sync engine waits for, so that the message
+// of the sync operation describes the progress of the resource it is waiting for, e.g. the analysis of a Rollout,
+// rather than only its name
+type healthMessageRecorder struct {
+	health.HealthOverride
+
+	lock     sync.Mutex
+	messages map[string]string
+}
+
+func newHealthMessageRecorder(override health.HealthOverride) *healthMessageRecorder {
+	return &healthMessageRecorder{HealthOverride: override, messages: map[string]string{}}
+}
+
+func healthMessageKey(group, kind, name string) string {
+	return fmt.Sprintf("%s/%s/%s", group, kind, name)
+}
+
+func (r *healthMessageRecorder) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	healthStatus, err := health.GetResourceHealth(obj, r.HealthOverride)
+	if err != nil || healthStatus == nil {
+		return healthStatus, err
+	}
+	gvk := obj.GroupVersionKind()
+	r.lock.Lock()
+	r.messages[healthMessageKey(gvk.Group, gvk.Kind, obj.GetName())] = healthStatus.Message
+	r.lock.Unlock()
+	return healthStatus, nil
+}
+
+// describe appends the recorded health message of the resource the sync operation is waiting for to its message
+func (r *healthMessageRecorder) describe(message string) string {
+	resources, ok := strings.CutPrefix(message, waitingForHealthyStatePrefix)
+	if !ok {
+		return message
+	}
+	key, more, _ := strings.Cut(resources, " ")
+	r.lock.Lock()
+	healthMessage := r.messages[key]
+	r.lock.Unlock()
+	if healthMessage == "" {
+		return message
+	}
+	message = fmt.Sprintf("%s%s (%s)", waitingForHealthyStatePrefix, key, healthMessage)
+	if more != "" {
+		message += " " + more
+	}
+	return message
+}
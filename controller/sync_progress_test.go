@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type fakeHealthOverride map[string]*health.HealthStatus
+
+func (o fakeHealthOverride) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	return o[obj.GetName()], nil
+}
+
+func TestHealthMessageRecorder(t *testing.T) {
+	recorder := newHealthMessageRecorder(fakeHealthOverride{
+		"guestbook": {Status: health.HealthStatusProgressing, Message: "step analysis guestbook-6b8cf6f7db-2-1 is Running"},
+	})
+	rollout := &unstructured.Unstructured{}
+	rollout.SetAPIVersion("argoproj.io/v1alpha1")
+	rollout.SetKind("Rollout")
+	rollout.SetName("guestbook")
+	healthStatus, err := recorder.GetResourceHealth(rollout)
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+
+	assert.Equal(t,
+		"waiting for healthy state of argoproj.io/Rollout/guestbook (step analysis guestbook-6b8cf6f7db-2-1 is Running)",
+		recorder.describe("waiting for healthy state of argoproj.io/Rollout/guestbook"))
+	assert.Equal(t,
+		"waiting for healthy state of argoproj.io/Rollout/guestbook (step analysis guestbook-6b8cf6f7db-2-1 is Running) and 2 more resources",
+		recorder.describe("waiting for healthy state of argoproj.io/Rollout/guestbook and 2 more resources"))
+	assert.Equal(t,
+		"waiting for healthy state of apps/Deployment/guestbook",
+		recorder.describe("waiting for healthy state of apps/Deployment/guestbook"))
+	assert.Equal(t, "one or more tasks are running", recorder.describe("one or more tasks are running"))
+}
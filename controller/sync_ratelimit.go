@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// syncRateLimiters limits the rate of the mutating requests of the syncs to the destination clusters with a
+// AnnotationClusterSyncQPS annotation. The limiters are shared by all the syncs to a cluster, so that a mass sync of
+// large applications does not overwhelm its API server.
+type syncRateLimiters struct {
+	lock     sync.Mutex
+	limiters map[string]*rate.Limiter
+	// onThrottled is called with the delay of the throttled requests
+	onThrottled func(server string, delay time.Duration)
+}
+
+func newSyncRateLimiters(onThrottled func(server string, delay time.Duration)) *syncRateLimiters {
+	return &syncRateLimiters{
+		limiters:    map[string]*rate.Limiter{},
+		onThrottled: onThrottled,
+	}
+}
+
+// getLimiter returns the limiter of the given cluster, or nil if the rate of its syncs is not limited
+func (l *syncRateLimiters) getLimiter(cluster *v1alpha1.Cluster) *rate.Limiter {
+	qpsStr, ok := cluster.Annotations[common.AnnotationClusterSyncQPS]
+	if !ok {
+		return nil
+	}
+	qps, err := strconv.ParseFloat(qpsStr, 64)
+	if err != nil || qps <= 0 {
+		log.Warnf("Ignoring invalid %s annotation %q of cluster %s", common.AnnotationClusterSyncQPS, qpsStr, cluster.Server)
+		return nil
+	}
+	burst := int(math.Ceil(qps))
+	if burstStr, ok := cluster.Annotations[common.AnnotationClusterSyncBurst]; ok {
+		if burst, err = strconv.Atoi(burstStr); err != nil || burst <= 0 {
+			log.Warnf("Ignoring invalid %s annotation %q of cluster %s", common.AnnotationClusterSyncBurst, burstStr, cluster.Server)
+			burst = int(math.Ceil(qps))
+		}
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	limiter, ok := l.limiters[cluster.Server]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(qps), burst)
+		l.limiters[cluster.Server] = limiter
+		return limiter
+	}
+	// the annotations of the cluster may have changed since the creation of the limiter
+	if limiter.Limit() != rate.Limit(qps) {
+		limiter.SetLimit(rate.Limit(qps))
+	}
+	if limiter.Burst() != burst {
+		limiter.SetBurst(burst)
+	}
+	return limiter
+}
+
+// wrap returns a copy of the given config of the given cluster whose mutating requests are throttled by the limiter of
+// the cluster, or the config itself if the rate of the syncs to the cluster is not limited
+func (l *syncRateLimiters) wrap(cluster *v1alpha1.Cluster, config *rest.Config) *rest.Config {
+	if l == nil {
+		return config
+	}
+	limiter := l.getLimiter(cluster)
+	if limiter == nil {
+		return config
+	}
+	newConfig := rest.CopyConfig(config)
+	newConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &rateLimitedRoundTripper{
+			roundTripper: rt,
+			limiter:      limiter,
+			onThrottled: func(delay time.Duration) {
+				if l.onThrottled != nil {
+					l.onThrottled(cluster.Server, delay)
+				}
+			},
+		}
+	})
+	return newConfig
+}
+
+// rateLimitedRoundTripper waits for the limiter before sending the requests creating, updating, patching or deleting
+// resources
+type rateLimitedRoundTripper struct {
+	roundTripper http.RoundTripper
+	limiter      *rate.Limiter
+	onThrottled  func(delay time.Duration)
+}
+
+func (rt *rateLimitedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return rt.roundTripper.RoundTrip(req)
+	}
+	reservation := rt.limiter.Reserve()
+	if delay := reservation.Delay(); delay > 0 {
+		rt.onThrottled(delay)
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			reservation.Cancel()
+			return nil, req.Context().Err()
+		}
+	}
+	return rt.roundTripper.RoundTrip(req)
+}
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newRateLimitedCluster(annotations map[string]string) *v1alpha1.Cluster {
+	return &v1alpha1.Cluster{Server: "https://kubernetes.default.svc", Annotations: annotations}
+}
+
+func TestSyncRateLimiters_GetLimiter(t *testing.T) {
+	limiters := newSyncRateLimiters(nil)
+
+	assert.Nil(t, limiters.getLimiter(newRateLimitedCluster(nil)))
+	assert.Nil(t, limiters.getLimiter(newRateLimitedCluster(map[string]string{common.AnnotationClusterSyncQPS: "invalid"})))
+	assert.Nil(t, limiters.getLimiter(newRateLimitedCluster(map[string]string{common.AnnotationClusterSyncQPS: "0"})))
+
+	limiter := limiters.getLimiter(newRateLimitedCluster(map[string]string{common.AnnotationClusterSyncQPS: "2.5"}))
+	require.NotNil(t, limiter)
+	assert.Equal(t, rate.Limit(2.5), limiter.Limit())
+	assert.Equal(t, 3, limiter.Burst())
+
+	// the limiter of the cluster is updated with its annotations
+	updated := limiters.getLimiter(newRateLimitedCluster(map[string]string{common.AnnotationClusterSyncQPS: "5", common.AnnotationClusterSyncBurst: "10"}))
+	assert.Same(t, limiter, updated)
+	assert.Equal(t, rate.Limit(5), limiter.Limit())
+	assert.Equal(t, 10, limiter.Burst())
+
+	// invalid bursts default to the limit
+	limiters.getLimiter(newRateLimitedCluster(map[string]string{common.AnnotationClusterSyncQPS: "5", common.AnnotationClusterSyncBurst: "-1"}))
+	assert.Equal(t, 5, limiter.Burst())
+}
+
+func TestSyncRateLimiters_Wrap(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var lock sync.Mutex
+	throttled := 0
+	limiters := newSyncRateLimiters(func(server string, delay time.Duration) {
+		lock.Lock()
+		defer lock.Unlock()
+		assert.Equal(t, "https://kubernetes.default.svc", server)
+		assert.Positive(t, delay)
+		throttled++
+	})
+	config := &rest.Config{Host: server.URL}
+
+	// the config is unchanged without rate limit
+	assert.Same(t, config, limiters.wrap(newRateLimitedCluster(nil), config))
+	var nilLimiters *syncRateLimiters
+	assert.Same(t, config, nilLimiters.wrap(newRateLimitedCluster(nil), config))
+
+	wrapped := limiters.wrap(newRateLimitedCluster(map[string]string{common.AnnotationClusterSyncQPS: "10", common.AnnotationClusterSyncBurst: "1"}), config)
+	require.NotSame(t, config, wrapped)
+	assert.Nil(t, config.WrapTransport)
+	client, err := rest.HTTPClientFor(wrapped)
+	require.NoError(t, err)
+
+	do := func(method string) {
+		req, err := http.NewRequestWithContext(t.Context(), method, server.URL, http.NoBody)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+	// the reads are not throttled
+	for i := 0; i < 5; i++ {
+		do(http.MethodGet)
+	}
+	assert.Equal(t, 0, throttled)
+	// the first mutating request uses the burst, the next ones are throttled
+	for _, method := range []string{http.MethodPost, http.MethodPatch, http.MethodPut, http.MethodDelete} {
+		do(method)
+	}
+	assert.Equal(t, 3, throttled)
+}
+
+func TestRateLimitedRoundTripper_Canceled(t *testing.T) {
+	limiter := rate.NewLimiter(rate.Every(time.Hour), 1)
+	require.True(t, limiter.Allow())
+	rt := &rateLimitedRoundTripper{
+		roundTripper: http.DefaultTransport,
+		limiter:      limiter,
+		onThrottled:  func(time.Duration) {},
+	}
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, "http://localhost", http.NoBody)
+	require.NoError(t, err)
+	_, err = rt.RoundTrip(req)
+	require.ErrorIs(t, err, context.Canceled)
+	// the reservation of the canceled request is released
+	assert.InDelta(t, 0, limiter.Tokens(), 0.01)
+}
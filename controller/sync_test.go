@@ -351,6 +351,63 @@ func TestSyncWindowDeniesSync(t *testing.T) {
 	})
 }
 
+func TestFreezeDeniesAutomatedSync(t *testing.T) {
+	t.Parallel()
+
+	setup := func() (*v1alpha1.Application, *v1alpha1.AppProject, *ApplicationController) {
+		app := newFakeApp()
+		app.Status.OperationState = nil
+		app.Status.History = nil
+		app.Spec.Freeze = &v1alpha1.ApplicationFreeze{By: "alice", Reason: "incident"}
+		project := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: test.FakeArgoCDNamespace,
+				Name:      "default",
+			},
+		}
+		data := fakeData{
+			apps: []runtime.Object{app, project},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		}
+		return app, project, newFakeController(&data, nil)
+	}
+
+	t.Run("will fail an automated sync while the application is frozen", func(t *testing.T) {
+		t.Parallel()
+		app, project, ctrl := setup()
+		opState := &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{
+				Sync:        &v1alpha1.SyncOperation{Source: &v1alpha1.ApplicationSource{}},
+				InitiatedBy: v1alpha1.OperationInitiator{Automated: true},
+			},
+			Phase: synccommon.OperationRunning,
+		}
+		ctrl.appStateManager.SyncAppState(app, project, opState)
+		assert.Equal(t, synccommon.OperationFailed, opState.Phase)
+		assert.Equal(t, "Sync operation blocked by freeze: application is frozen by alice: incident", opState.Message)
+	})
+
+	t.Run("will run a manual sync while the application is frozen", func(t *testing.T) {
+		t.Parallel()
+		app, project, ctrl := setup()
+		opState := &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{
+				Sync:        &v1alpha1.SyncOperation{Source: &v1alpha1.ApplicationSource{}},
+				InitiatedBy: v1alpha1.OperationInitiator{Username: "alice"},
+			},
+			Phase: synccommon.OperationRunning,
+		}
+		ctrl.appStateManager.SyncAppState(app, project, opState)
+		assert.NotContains(t, opState.Message, "blocked by freeze")
+	})
+}
+
 func TestNormalizeTargetResources(t *testing.T) {
 	type fixture struct {
 		comparisonResult *comparisonResult
@@ -1363,6 +1420,70 @@ func TestDeriveServiceAccountMatchingServers(t *testing.T) {
 	})
 }
 
+func TestDeriveServiceAccountRequestedByApplication(t *testing.T) {
+	t.Parallel()
+
+	project := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "argocd-ns", Name: "testProj"},
+		Spec: v1alpha1.AppProjectSpec{
+			DestinationServiceAccounts: []v1alpha1.ApplicationDestinationServiceAccount{
+				{Server: "https://kubernetes.svc.local", Namespace: "testns", DefaultServiceAccount: "default-sa"},
+				{Server: "https://kubernetes.svc.local", Namespace: "testns", DefaultServiceAccount: "team-a-sa"},
+				{Server: "https://kubernetes.svc.local", Namespace: "*", DefaultServiceAccount: "other-ns:team-b-sa"},
+			},
+		},
+	}
+	cluster := &v1alpha1.Cluster{Server: "https://kubernetes.svc.local", Name: "test-cluster"}
+	newApp := func(serviceAccount string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "argocd-ns",
+				Name:      "testApp",
+			},
+			Spec: v1alpha1.ApplicationSpec{
+				Project:                   "testProj",
+				Destination:               v1alpha1.ApplicationDestination{Server: "https://kubernetes.svc.local", Namespace: "testns"},
+				DestinationServiceAccount: serviceAccount,
+			},
+		}
+	}
+
+	t.Run("allowed service account", func(t *testing.T) {
+		t.Parallel()
+		sa, err := deriveServiceAccountToImpersonate(project, newApp("team-a-sa"), cluster)
+		require.NoError(t, err)
+		assert.Equal(t, "system:serviceaccount:testns:team-a-sa", sa)
+	})
+
+	t.Run("allowed service account with namespace", func(t *testing.T) {
+		t.Parallel()
+		sa, err := deriveServiceAccountToImpersonate(project, newApp("other-ns:team-b-sa"), cluster)
+		require.NoError(t, err)
+		assert.Equal(t, "system:serviceaccount:other-ns:team-b-sa", sa)
+	})
+
+	t.Run("service account not allowed", func(t *testing.T) {
+		t.Parallel()
+		sa, err := deriveServiceAccountToImpersonate(project, newApp("cluster-admin"), cluster)
+		assert.Empty(t, sa)
+		assert.EqualError(t, err, "service account system:serviceaccount:testns:cluster-admin is not allowed by project testProj for destination server https://kubernetes.svc.local and namespace testns")
+	})
+
+	t.Run("invalid service account", func(t *testing.T) {
+		t.Parallel()
+		sa, err := deriveServiceAccountToImpersonate(project, newApp("team-*"), cluster)
+		assert.Empty(t, sa)
+		assert.EqualError(t, err, "requested service account contains invalid chars 'team-*'")
+	})
+
+	t.Run("no requested service account", func(t *testing.T) {
+		t.Parallel()
+		sa, err := deriveServiceAccountToImpersonate(project, newApp(""), cluster)
+		require.NoError(t, err)
+		assert.Equal(t, "system:serviceaccount:testns:default-sa", sa)
+	})
+}
+
 func TestSyncWithImpersonate(t *testing.T) {
 	type fixture struct {
 		application *v1alpha1.Application
@@ -1653,3 +1774,71 @@ func dig(obj any, path ...any) any {
 
 	return i
 }
+
+func TestSyncOptionsForbiddenByProject(t *testing.T) {
+	setup := func(syncOptionsPolicy v1alpha1.SyncOptionsPolicy) (*ApplicationController, *v1alpha1.Application, *v1alpha1.AppProject) {
+		app := newFakeApp()
+		app.Status.OperationState = nil
+		app.Status.History = nil
+		project := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: test.FakeArgoCDNamespace,
+				Name:      "default",
+			},
+			Spec: v1alpha1.AppProjectSpec{
+				Destinations:        []v1alpha1.ApplicationDestination{{Namespace: "*", Server: "*"}},
+				SyncOptionsPolicies: []v1alpha1.SyncOptionsPolicy{syncOptionsPolicy},
+			},
+		}
+		ctrl := newFakeController(&fakeData{
+			apps: []runtime.Object{app, project},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "my-map", "namespace": "` + test.FakeDestNamespace + `"}}`},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{},
+		}, nil)
+		return ctrl, app, project
+	}
+
+	t.Run("forbidden application sync option", func(t *testing.T) {
+		ctrl, app, project := setup(v1alpha1.SyncOptionsPolicy{Kind: "ConfigMap", Forbid: []string{"Replace=true"}})
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{Source: &v1alpha1.ApplicationSource{}, SyncOptions: []string{"Replace=true"}},
+		}}
+
+		ctrl.appStateManager.SyncAppState(app, project, opState)
+
+		assert.Equal(t, synccommon.OperationFailed, opState.Phase)
+		assert.Equal(t, "Sync option Replace=true is forbidden by project default for resource /ConfigMap my-map", opState.Message)
+	})
+
+	t.Run("forbidden force", func(t *testing.T) {
+		ctrl, app, project := setup(v1alpha1.SyncOptionsPolicy{Kind: "*", Forbid: []string{"Force=true"}})
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{
+				Source:       &v1alpha1.ApplicationSource{},
+				SyncStrategy: &v1alpha1.SyncStrategy{Apply: &v1alpha1.SyncStrategyApply{Force: true}},
+			},
+		}}
+
+		ctrl.appStateManager.SyncAppState(app, project, opState)
+
+		assert.Equal(t, synccommon.OperationFailed, opState.Phase)
+		assert.Contains(t, opState.Message, "Sync option Force=true is forbidden by project default")
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		ctrl, app, project := setup(v1alpha1.SyncOptionsPolicy{Forbid: []string{"Force=true"}})
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{Source: &v1alpha1.ApplicationSource{}},
+		}}
+
+		ctrl.appStateManager.SyncAppState(app, project, opState)
+
+		assert.Equal(t, synccommon.OperationError, opState.Phase)
+		assert.Contains(t, opState.Message, "invalid sync options policy of project default")
+	})
+}
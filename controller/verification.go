@@ -0,0 +1,205 @@
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/lua"
+	"github.com/argoproj/argo-cd/v3/util/security"
+)
+
+const (
+	defaultVerificationProbeTimeout       = 10 * time.Second
+	defaultVerificationProbeRetries       = 3
+	defaultVerificationProbeRetryInterval = 10 * time.Second
+	// maxVerificationProbeBodySize is the size of the response body of the HTTP probes quoted in the failure messages
+	maxVerificationProbeBodySize = 256
+)
+
+// verificationProbeSettings are the parsed timeout and retries of a probe
+type verificationProbeSettings struct {
+	timeout       time.Duration
+	retries       int
+	retryInterval time.Duration
+}
+
+func parseDurationOrDefault(value string, defaultValue time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return duration, nil
+}
+
+func getVerificationProbeSettings(p *v1alpha1.VerificationProbe) (verificationProbeSettings, error) {
+	settings := verificationProbeSettings{retries: defaultVerificationProbeRetries}
+	var err error
+	if settings.timeout, err = parseDurationOrDefault(p.Timeout, defaultVerificationProbeTimeout); err != nil {
+		return settings, fmt.Errorf("invalid timeout: %w", err)
+	}
+	if settings.retryInterval, err = parseDurationOrDefault(p.RetryInterval, defaultVerificationProbeRetryInterval); err != nil {
+		return settings, fmt.Errorf("invalid retry interval: %w", err)
+	}
+	if p.Retries != nil {
+		if *p.Retries < 0 {
+			return settings, fmt.Errorf("invalid retries %d", *p.Retries)
+		}
+		settings.retries = int(*p.Retries)
+	}
+	return settings, nil
+}
+
+// getVerificationProbes returns the validated verification probes of the sync policy of the application
+func getVerificationProbes(app *v1alpha1.Application) ([]v1alpha1.VerificationProbe, error) {
+	if app.Spec.SyncPolicy == nil {
+		return nil, nil
+	}
+	probes := app.Spec.SyncPolicy.VerificationProbes
+	for i := range probes {
+		if err := probes[i].Validate(); err != nil {
+			return nil, fmt.Errorf("invalid verification probes: %w", err)
+		}
+	}
+	return probes, nil
+}
+
+// runVerificationProbes runs the verification probes of the application after it is synced, and returns an error if
+// any of them still fails after its retries
+func (m *appStateManager) runVerificationProbes(app *v1alpha1.Application, restConfig *rest.Config, logEntry *log.Entry) error {
+	probes, err := getVerificationProbes(app)
+	if err != nil || len(probes) == 0 {
+		return err
+	}
+	policy, err := m.settingsMgr.GetOutboundURLPolicy()
+	if err != nil {
+		return fmt.Errorf("failed to load the outbound URL policy: %w", err)
+	}
+	for i := range probes {
+		probe := &probes[i]
+		settings, _ := getVerificationProbeSettings(probe)
+		for attempt := 0; ; attempt++ {
+			ctx, cancel := context.WithTimeout(context.Background(), settings.timeout)
+			err = m.runVerificationProbe(ctx, app, probe, restConfig, policy)
+			cancel()
+			if err == nil {
+				logEntry.Infof("Verification probe %s succeeded", probe.Name)
+				break
+			}
+			if attempt >= settings.retries {
+				return fmt.Errorf("probe %s failed: %w", probe.Name, err)
+			}
+			logEntry.Infof("Verification probe %s failed, retrying in %s: %v", probe.Name, settings.retryInterval, err)
+			time.Sleep(settings.retryInterval)
+		}
+	}
+	return nil
+}
+
+func (m *appStateManager) runVerificationProbe(ctx context.Context, app *v1alpha1.Application, probe *v1alpha1.VerificationProbe, restConfig *rest.Config, policy *security.OutboundURLPolicy) error {
+	switch {
+	case probe.HTTP != nil:
+		return runHTTPVerificationProbe(ctx, probe.HTTP, policy)
+	case probe.GRPC != nil:
+		return runGRPCVerificationProbe(ctx, probe.GRPC, policy)
+	default:
+		return m.runLuaVerificationProbe(ctx, app, probe.Lua, restConfig)
+	}
+}
+
+func runHTTPVerificationProbe(ctx context.Context, probe *v1alpha1.HTTPVerificationProbe, policy *security.OutboundURLPolicy) error {
+	method := probe.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	req, err := http.NewRequestWithContext(ctx, method, probe.URL, http.NoBody)
+	if err != nil {
+		return err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if probe.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	client := &http.Client{Transport: policy.WrapTransport(transport)}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if (len(probe.ExpectedStatus) == 0 && resp.StatusCode >= 200 && resp.StatusCode < 300) || slices.Contains(probe.ExpectedStatus, int32(resp.StatusCode)) {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxVerificationProbeBodySize))
+	return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+func runGRPCVerificationProbe(ctx context.Context, probe *v1alpha1.GRPCVerificationProbe, policy *security.OutboundURLPolicy) error {
+	if err := policy.ValidateURL("http://" + probe.Address); err != nil {
+		return err
+	}
+	creds := insecure.NewCredentials()
+	if probe.TLS {
+		creds = credentials.NewTLS(&tls.Config{})
+	}
+	conn, err := grpc.NewClient(probe.Address,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			dialer := net.Dialer{Control: policy.DialControl}
+			return dialer.DialContext(ctx, "tcp", address)
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: probe.Service})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("service status is %s", resp.GetStatus())
+	}
+	return nil
+}
+
+func (m *appStateManager) runLuaVerificationProbe(ctx context.Context, app *v1alpha1.Application, probe *v1alpha1.LuaVerificationProbe, restConfig *rest.Config) error {
+	gv, err := schema.ParseGroupVersion(probe.APIVersion)
+	if err != nil {
+		return err
+	}
+	namespace := probe.Namespace
+	if namespace == "" {
+		namespace = app.Spec.Destination.Namespace
+	}
+	obj, err := m.kubectl.GetResource(ctx, restConfig, gv.WithKind(probe.Kind), probe.Name, namespace)
+	if err != nil {
+		return err
+	}
+	status, err := lua.VM{}.ExecuteHealthLua(obj, probe.Script)
+	if err != nil {
+		return err
+	}
+	if status.Status != health.HealthStatusHealthy {
+		return fmt.Errorf("resource %s/%s is %s: %s", probe.Kind, probe.Name, status.Status, status.Message)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/security"
+)
+
+func TestGetVerificationProbes(t *testing.T) {
+	t.Run("NoProbes", func(t *testing.T) {
+		probes, err := getVerificationProbes(newFakeApp())
+		require.NoError(t, err)
+		assert.Empty(t, probes)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{VerificationProbes: []v1alpha1.VerificationProbe{
+			{Name: "ping", HTTP: &v1alpha1.HTTPVerificationProbe{URL: "http://guestbook.default.svc/healthz"}, Retries: ptr.To(int64(1)), RetryInterval: "5s"},
+			{Name: "grpc", GRPC: &v1alpha1.GRPCVerificationProbe{Address: "guestbook.default.svc:9090"}, Timeout: "2s"},
+		}}
+		probes, err := getVerificationProbes(app)
+		require.NoError(t, err)
+		require.Len(t, probes, 2)
+		settings, err := getVerificationProbeSettings(&probes[0])
+		require.NoError(t, err)
+		assert.Equal(t, verificationProbeSettings{timeout: defaultVerificationProbeTimeout, retries: 1, retryInterval: 5 * time.Second}, settings)
+		settings, err = getVerificationProbeSettings(&probes[1])
+		require.NoError(t, err)
+		assert.Equal(t, verificationProbeSettings{timeout: 2 * time.Second, retries: defaultVerificationProbeRetries, retryInterval: defaultVerificationProbeRetryInterval}, settings)
+	})
+
+	for name, probe := range map[string]v1alpha1.VerificationProbe{
+		"NoKind":          {Name: "ping"},
+		"TwoKinds":        {Name: "ping", HTTP: &v1alpha1.HTTPVerificationProbe{URL: "http://a"}, GRPC: &v1alpha1.GRPCVerificationProbe{Address: "a:80"}},
+		"InvalidURL":      {Name: "ping", HTTP: &v1alpha1.HTTPVerificationProbe{URL: "a/healthz"}},
+		"InvalidAddress":  {Name: "grpc", GRPC: &v1alpha1.GRPCVerificationProbe{Address: "a"}},
+		"InvalidTimeout":  {Name: "ping", HTTP: &v1alpha1.HTTPVerificationProbe{URL: "http://a"}, Timeout: "soon"},
+		"NegativeRetries": {Name: "ping", HTTP: &v1alpha1.HTTPVerificationProbe{URL: "http://a"}, Retries: ptr.To(int64(-1))},
+		"IncompleteLua":   {Name: "lua", Lua: &v1alpha1.LuaVerificationProbe{Kind: "Deployment", Name: "guestbook"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			app := newFakeApp()
+			app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{VerificationProbes: []v1alpha1.VerificationProbe{probe}}
+			_, err := getVerificationProbes(app)
+			assert.ErrorContains(t, err, "invalid verification probes")
+		})
+	}
+}
+
+func TestRunHTTPVerificationProbe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("database unavailable"))
+	}))
+	defer server.Close()
+
+	require.NoError(t, runHTTPVerificationProbe(t.Context(), &v1alpha1.HTTPVerificationProbe{URL: server.URL + "/healthz"}, nil))
+	require.NoError(t, runHTTPVerificationProbe(t.Context(), &v1alpha1.HTTPVerificationProbe{URL: server.URL + "/ready", ExpectedStatus: []int32{http.StatusServiceUnavailable}}, nil))
+
+	err := runHTTPVerificationProbe(t.Context(), &v1alpha1.HTTPVerificationProbe{URL: server.URL + "/ready"}, nil)
+	assert.EqualError(t, err, "unexpected status code 503: database unavailable")
+
+	policy, err := security.NewOutboundURLPolicy(nil, nil, true)
+	require.NoError(t, err)
+	err = runHTTPVerificationProbe(t.Context(), &v1alpha1.HTTPVerificationProbe{URL: server.URL + "/healthz"}, policy)
+	assert.ErrorContains(t, err, "is denied")
+}
+
+func TestRunGRPCVerificationProbe(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("guestbook", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("worker", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	address := listener.Addr().String()
+	require.NoError(t, runGRPCVerificationProbe(t.Context(), &v1alpha1.GRPCVerificationProbe{Address: address, Service: "guestbook"}, nil))
+	err = runGRPCVerificationProbe(t.Context(), &v1alpha1.GRPCVerificationProbe{Address: address, Service: "worker"}, nil)
+	assert.EqualError(t, err, "service status is NOT_SERVING")
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"os"
+
+	"github.com/argoproj/argo-cd/v3/util/swagger"
+)
+
+// main generates the OpenAPI 3.1 document of the API from its Swagger 2.0 document
+func main() {
+	swaggerJSON, err := os.ReadFile("assets/swagger.json")
+	checkErr(err)
+	openAPIJSON, err := swagger.ConvertToOpenAPI3(swaggerJSON)
+	checkErr(err)
+	checkErr(os.WriteFile("assets/openapi.json", openAPIJSON, 0o644))
+}
+
+func checkErr(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -161,6 +161,7 @@ clean_swagger() {
 }
 
 collect_swagger server
+go run ./hack/gen-openapi-spec
 clean_swagger server
 clean_swagger reposerver
 clean_swagger controller
@@ -33,6 +33,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
 const (
@@ -138,9 +139,25 @@ func (c *notificationController) alterDestinations(obj metav1.Object, destinatio
 		return destinations
 	}
 
-	if proj := getAppProj(app, c.appProjInformer); proj != nil {
-		destinations.Merge(subscriptions.NewAnnotations(proj.GetAnnotations()).GetDestinations(cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
-		destinations.Merge(settings.GetLegacyDestinations(proj.GetAnnotations(), cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
+	projName, _, _ := unstructured.NestedString(app.Object, "spec", "project")
+	proj := getAppProj(app, c.appProjInformer)
+	var projAnnotations map[string]string
+	var allowlist *v1alpha1.NotificationAllowlist
+	if proj != nil {
+		projAnnotations = proj.GetAnnotations()
+		var err error
+		if allowlist, err = getNotificationAllowlist(proj); err != nil {
+			log.Warnf("Notifications of application %s are not sent: invalid notification allowlist of project %s: %v", app.GetName(), projName, err)
+			destinations = services.Destinations{}
+		}
+	}
+	// the subscriptions of the application are restricted by the project, unlike the subscriptions of the project
+	destinations = settings.RestrictDestinations(projName, allowlist, destinations, cfg)
+	if proj != nil {
+		projDestinations := services.Destinations{}
+		projDestinations.Merge(subscriptions.NewAnnotations(projAnnotations).GetDestinations(cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
+		projDestinations.Merge(settings.GetLegacyDestinations(projAnnotations, cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
+		destinations.Merge(settings.ScopeDestinations(projName, projDestinations, cfg))
 	}
 	return destinations
 }
@@ -217,6 +234,25 @@ func getAppProj(app *unstructured.Unstructured, appProjInformer cache.SharedInde
 	return proj
 }
 
+// getNotificationAllowlist returns the validated notification allowlist of the given project, or nil if it has none
+func getNotificationAllowlist(proj *unstructured.Unstructured) (*v1alpha1.NotificationAllowlist, error) {
+	obj, ok, err := unstructured.NestedMap(proj.Object, "spec", "notificationAllowlist")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	var allowlist v1alpha1.NotificationAllowlist
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, &allowlist); err != nil {
+		return nil, err
+	}
+	if err := allowlist.Validate(); err != nil {
+		return nil, err
+	}
+	return &allowlist, nil
+}
+
 // Checks if the application SyncStatus has been refreshed by Argo CD after an operation has completed
 func isAppSyncStatusRefreshed(app *unstructured.Unstructured, logEntry *log.Entry) bool {
 	_, ok, err := unstructured.NestedMap(app.Object, "status", "operationState")
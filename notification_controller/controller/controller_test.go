@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -102,6 +104,78 @@ func TestGetAppProj_invalidProjectNestedString(t *testing.T) {
 	assert.Nil(t, proj)
 }
 
+func TestAlterDestinations(t *testing.T) {
+	informer := cache.NewSharedIndexInformer(nil, &unstructured.Unstructured{}, 0, cache.Indexers{})
+	proj := &unstructured.Unstructured{}
+	proj.SetNamespace("default")
+	proj.SetName("team-a")
+	proj.SetAnnotations(map[string]string{
+		"notifications.argoproj.io/subscribe.on-deployed.webhook": "github",
+	})
+	require.NoError(t, unstructured.SetNestedField(proj.Object, map[string]any{
+		"services": []any{"slack", "email"},
+		"triggers": []any{"on-sync-*", "on-deployed"},
+	}, "spec", "notificationAllowlist"))
+	require.NoError(t, informer.GetIndexer().Add(proj))
+	app := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "guestbook", "namespace": "default"},
+		"spec":     map[string]any{"project": "team-a"},
+	}}
+	cfg := api.Config{Services: map[string]api.ServiceFactory{
+		"slack":        nil,
+		"email":        nil,
+		"webhook":      nil,
+		"team-a/slack": nil,
+		"team-b/slack": nil,
+	}}
+	c := &notificationController{appProjInformer: informer}
+
+	destinations := c.alterDestinations(app, services.Destinations{
+		"on-sync-succeeded": {
+			{Service: "slack", Recipient: "team-a"},
+			{Service: "email", Recipient: "team-a@example.com"},
+			{Service: "webhook", Recipient: ""},
+			{Service: "team-b/slack", Recipient: "team-b"},
+		},
+		"on-health-degraded": {
+			{Service: "slack", Recipient: "team-a"},
+		},
+	}, cfg)
+
+	assert.Equal(t, services.Destinations{
+		"on-sync-succeeded": {
+			{Service: "team-a/slack", Recipient: "team-a"},
+			{Service: "email", Recipient: "team-a@example.com"},
+		},
+		// the subscriptions of the project are not restricted
+		"on-deployed": {
+			{Service: "webhook", Recipient: "github"},
+		},
+	}, destinations)
+}
+
+func TestAlterDestinations_InvalidNotificationAllowlist(t *testing.T) {
+	informer := cache.NewSharedIndexInformer(nil, &unstructured.Unstructured{}, 0, cache.Indexers{})
+	proj := &unstructured.Unstructured{}
+	proj.SetNamespace("default")
+	proj.SetName("team-a")
+	require.NoError(t, unstructured.SetNestedField(proj.Object, map[string]any{
+		"services": []any{"slack["},
+	}, "spec", "notificationAllowlist"))
+	require.NoError(t, informer.GetIndexer().Add(proj))
+	app := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "guestbook", "namespace": "default"},
+		"spec":     map[string]any{"project": "team-a"},
+	}}
+	c := &notificationController{appProjInformer: informer}
+
+	destinations := c.alterDestinations(app, services.Destinations{
+		"on-sync-succeeded": {{Service: "slack", Recipient: "team-a"}},
+	}, api.Config{Services: map[string]api.ServiceFactory{"slack": nil}})
+
+	assert.Empty(t, destinations)
+}
+
 func TestInit(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.SchemeBuilder.AddToScheme(scheme)
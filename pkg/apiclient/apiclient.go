@@ -125,8 +125,26 @@ type ClientOptions struct {
 	RedisHaProxyName     string
 	RedisName            string
 	RedisCompression     string
+	RedisKeyPrefix       string
 	RepoServerName       string
 	PromptsEnabled       bool
+	// RetryPolicy defines how the failed gRPC calls are retried. DefaultRetryPolicy is used if nil.
+	RetryPolicy *RetryPolicy
+}
+
+// RetryPolicy defines how the gRPC calls of the API client which fail with a transient error are retried
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries of a call. The calls are not retried if zero.
+	MaxRetries uint
+	// Backoff returns the duration to wait before the given retry attempt of a call. A short jittered delay is used
+	// if nil.
+	Backoff func(ctx context.Context, attempt uint) time.Duration
+}
+
+// DefaultRetryPolicy retries the failed gRPC calls 3 times, waiting 1 second between the attempts
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	Backoff:    grpc_retry.BackoffLinear(1000 * time.Millisecond),
 }
 
 type client struct {
@@ -141,6 +159,7 @@ type client struct {
 	GRPCWeb         bool
 	GRPCWebRootPath string
 	Headers         []string
+	RetryPolicy     RetryPolicy
 
 	proxyMutex      *sync.Mutex
 	proxyListener   net.Listener
@@ -261,6 +280,11 @@ func NewClient(opts *ClientOptions) (Client, error) {
 		c.GRPCWebRootPath = opts.GRPCWebRootPath
 	}
 
+	c.RetryPolicy = DefaultRetryPolicy
+	if opts.RetryPolicy != nil {
+		c.RetryPolicy = *opts.RetryPolicy
+	}
+
 	if opts.HttpRetryMax > 0 {
 		retryClient := retryablehttp.NewClient()
 		retryClient.RetryMax = opts.HttpRetryMax
@@ -516,8 +540,10 @@ func (c *client) newConn() (*grpc.ClientConn, io.Closer, error) {
 		Token: c.AuthToken,
 	}
 	retryOpts := []grpc_retry.CallOption{
-		grpc_retry.WithMax(3),
-		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(1000 * time.Millisecond)),
+		grpc_retry.WithMax(c.RetryPolicy.MaxRetries),
+	}
+	if c.RetryPolicy.Backoff != nil {
+		retryOpts = append(retryOpts, grpc_retry.WithBackoff(c.RetryPolicy.Backoff))
 	}
 	var dialOpts []grpc.DialOption
 	dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(endpointCredentials))
@@ -1,10 +1,14 @@
 package apiclient
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func Test_parseHeaders(t *testing.T) {
@@ -39,3 +43,34 @@ func Test_parseGRPCHeaders(t *testing.T) {
 		assert.ErrorContains(t, err, "additional headers must be colon(:)-separated: foo")
 	})
 }
+
+func TestErrors(t *testing.T) {
+	err := fmt.Errorf("failed to get app: %w", status.Error(codes.PermissionDenied, "permission denied: applications, get, default/guestbook"))
+	assert.True(t, IsPermissionDenied(err))
+	assert.False(t, IsNotFound(err))
+	assert.Equal(t, "permission denied: applications, get, default/guestbook", ErrorMessage(err))
+
+	assert.True(t, IsNotFound(status.Error(codes.NotFound, "not found")))
+	assert.True(t, IsUnauthenticated(status.Error(codes.Unauthenticated, "invalid session")))
+	assert.True(t, IsAlreadyExists(status.Error(codes.AlreadyExists, "already exists")))
+	assert.True(t, IsInvalidArgument(status.Error(codes.InvalidArgument, "invalid spec")))
+	assert.False(t, IsNotFound(nil))
+	assert.Empty(t, ErrorMessage(nil))
+}
+
+func Test_listItems(t *testing.T) {
+	var items []string
+	for item, err := range listItems(func() ([]string, error) { return []string{"a", "b", "c"}, nil }) {
+		require.NoError(t, err)
+		items = append(items, *item)
+		if *item == "b" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"a", "b"}, items)
+
+	for item, err := range listItems(func() ([]string, error) { return nil, errors.New("list failed") }) {
+		require.EqualError(t, err, "list failed")
+		assert.Nil(t, item)
+	}
+}
@@ -145,6 +145,7 @@ func init() {
 	forward_ApplicationService_PodLogs_0 = logsForwarder
 	forward_ApplicationService_PodLogs_1 = logsForwarder
 	forward_ApplicationService_WatchResourceTree_0 = http.StreamForwarder
+	forward_ApplicationService_WatchResourceTreeDeltas_0 = http.StreamForwarder
 	forward_ApplicationService_Watch_0 = http.NewStreamForwarder(func(message proto.Message) (string, error) {
 		event, ok := message.(*v1alpha1.ApplicationWatchEvent)
 		if !ok {
@@ -0,0 +1,112 @@
+package application
+
+import (
+	"reflect"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// NewResourceTreeDelta returns the delta changing the given previous resource tree into the given tree, holding the whole
+// tree if there is no previous tree, or nil if the trees are equal
+func NewResourceTreeDelta(previous, tree *v1alpha1.ApplicationTree) *ResourceTreeDelta {
+	if previous == nil {
+		return &ResourceTreeDelta{Tree: tree}
+	}
+	if reflect.DeepEqual(previous, tree) {
+		return nil
+	}
+	delta := &ResourceTreeDelta{}
+	delta.UpdatedNodes, delta.RemovedNodes = resourceNodesDelta(previous.Nodes, tree.Nodes)
+	delta.UpdatedOrphanedNodes, delta.RemovedOrphanedNodes = resourceNodesDelta(previous.OrphanedNodes, tree.OrphanedNodes)
+	if !reflect.DeepEqual(previous.Hosts, tree.Hosts) {
+		delta.HostsChanged = ptr.To(true)
+		for i := range tree.Hosts {
+			delta.Hosts = append(delta.Hosts, &tree.Hosts[i])
+		}
+	}
+	if previous.ShardsCount != tree.ShardsCount {
+		delta.ShardsCount = ptr.To(tree.ShardsCount)
+	}
+	return delta
+}
+
+// resourceNodesDelta returns the nodes added or changed, and the references of the nodes removed, from the given previous
+// nodes to the given nodes
+func resourceNodesDelta(previous, nodes []v1alpha1.ResourceNode) ([]*v1alpha1.ResourceNode, []*v1alpha1.ResourceRef) {
+	previousByRef := make(map[v1alpha1.ResourceRef]*v1alpha1.ResourceNode, len(previous))
+	for i := range previous {
+		previousByRef[previous[i].ResourceRef] = &previous[i]
+	}
+	var updated []*v1alpha1.ResourceNode
+	for i := range nodes {
+		previousNode, ok := previousByRef[nodes[i].ResourceRef]
+		if !ok || !reflect.DeepEqual(previousNode, &nodes[i]) {
+			updated = append(updated, &nodes[i])
+		}
+		delete(previousByRef, nodes[i].ResourceRef)
+	}
+	var removed []*v1alpha1.ResourceRef
+	for i := range previous {
+		if _, ok := previousByRef[previous[i].ResourceRef]; ok {
+			removed = append(removed, &previous[i].ResourceRef)
+		}
+	}
+	return updated, removed
+}
+
+// ApplyResourceTreeDelta returns the resource tree resulting from the given delta applied to the given tree. The updated
+// nodes replace the nodes with the same reference, or are appended to the nodes of the tree if it has no such node.
+func ApplyResourceTreeDelta(tree *v1alpha1.ApplicationTree, delta *ResourceTreeDelta) *v1alpha1.ApplicationTree {
+	if delta.Tree != nil {
+		return delta.Tree
+	}
+	if tree == nil {
+		tree = &v1alpha1.ApplicationTree{}
+	}
+	result := &v1alpha1.ApplicationTree{
+		Nodes:         applyResourceNodesDelta(tree.Nodes, delta.UpdatedNodes, delta.RemovedNodes),
+		OrphanedNodes: applyResourceNodesDelta(tree.OrphanedNodes, delta.UpdatedOrphanedNodes, delta.RemovedOrphanedNodes),
+		Hosts:         tree.Hosts,
+		ShardsCount:   tree.ShardsCount,
+	}
+	if delta.ShardsCount != nil {
+		result.ShardsCount = delta.GetShardsCount()
+	}
+	if delta.GetHostsChanged() {
+		result.Hosts = nil
+		for _, host := range delta.Hosts {
+			result.Hosts = append(result.Hosts, *host)
+		}
+	}
+	return result
+}
+
+func applyResourceNodesDelta(nodes []v1alpha1.ResourceNode, updated []*v1alpha1.ResourceNode, removed []*v1alpha1.ResourceRef) []v1alpha1.ResourceNode {
+	updatedByRef := make(map[v1alpha1.ResourceRef]*v1alpha1.ResourceNode, len(updated))
+	for _, node := range updated {
+		updatedByRef[node.ResourceRef] = node
+	}
+	removedRefs := make(map[v1alpha1.ResourceRef]bool, len(removed))
+	for _, ref := range removed {
+		removedRefs[*ref] = true
+	}
+	var result []v1alpha1.ResourceNode
+	for _, node := range nodes {
+		if removedRefs[node.ResourceRef] {
+			continue
+		}
+		if updatedNode, ok := updatedByRef[node.ResourceRef]; ok {
+			node = *updatedNode
+			delete(updatedByRef, node.ResourceRef)
+		}
+		result = append(result, node)
+	}
+	for _, node := range updated {
+		if _, ok := updatedByRef[node.ResourceRef]; ok {
+			result = append(result, *node)
+		}
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newResourceNode(kind, name string, status health.HealthStatusCode) v1alpha1.ResourceNode {
+	return v1alpha1.ResourceNode{
+		ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: kind, Namespace: "default", Name: name},
+		Health:      &v1alpha1.HealthStatus{Status: status},
+	}
+}
+
+func TestResourceTreeDelta(t *testing.T) {
+	tree := &v1alpha1.ApplicationTree{
+		Nodes: []v1alpha1.ResourceNode{
+			newResourceNode("Service", "guestbook", health.HealthStatusHealthy),
+			newResourceNode("Pod", "guestbook-1", health.HealthStatusHealthy),
+			newResourceNode("Pod", "guestbook-2", health.HealthStatusProgressing),
+		},
+		OrphanedNodes: []v1alpha1.ResourceNode{newResourceNode("ConfigMap", "orphan", health.HealthStatusHealthy)},
+		Hosts:         []v1alpha1.HostInfo{{Name: "node-1"}},
+	}
+
+	delta := NewResourceTreeDelta(nil, tree)
+	assert.Equal(t, &ResourceTreeDelta{Tree: tree}, delta, "the first delta holds the whole tree")
+	assert.Equal(t, tree, ApplyResourceTreeDelta(nil, delta))
+
+	assert.Nil(t, NewResourceTreeDelta(tree, tree.DeepCopy()), "no delta is returned for an unchanged tree")
+
+	t.Run("NodesChanged", func(t *testing.T) {
+		newTree := &v1alpha1.ApplicationTree{
+			Nodes: []v1alpha1.ResourceNode{
+				newResourceNode("Service", "guestbook", health.HealthStatusHealthy),
+				newResourceNode("Pod", "guestbook-2", health.HealthStatusHealthy),
+				newResourceNode("Pod", "guestbook-3", health.HealthStatusProgressing),
+			},
+			Hosts: tree.Hosts,
+		}
+		delta := NewResourceTreeDelta(tree, newTree)
+		require.NotNil(t, delta)
+		assert.Nil(t, delta.Tree)
+		assert.Equal(t, []*v1alpha1.ResourceNode{&newTree.Nodes[1], &newTree.Nodes[2]}, delta.UpdatedNodes)
+		assert.Equal(t, []*v1alpha1.ResourceRef{&tree.Nodes[1].ResourceRef}, delta.RemovedNodes)
+		assert.Empty(t, delta.UpdatedOrphanedNodes)
+		assert.Equal(t, []*v1alpha1.ResourceRef{&tree.OrphanedNodes[0].ResourceRef}, delta.RemovedOrphanedNodes)
+		assert.False(t, delta.GetHostsChanged())
+		assert.Nil(t, delta.ShardsCount)
+
+		assert.Equal(t, newTree, ApplyResourceTreeDelta(tree, delta))
+	})
+
+	t.Run("HostsAndShardsChanged", func(t *testing.T) {
+		newTree := tree.DeepCopy()
+		newTree.Hosts = nil
+		newTree.ShardsCount = 2
+		delta := NewResourceTreeDelta(tree, newTree)
+		require.NotNil(t, delta)
+		assert.Empty(t, delta.UpdatedNodes)
+		assert.Empty(t, delta.RemovedNodes)
+		assert.True(t, delta.GetHostsChanged())
+		assert.Empty(t, delta.Hosts)
+		assert.Equal(t, int64(2), delta.GetShardsCount())
+
+		assert.Equal(t, newTree, ApplyResourceTreeDelta(tree, delta))
+	})
+}
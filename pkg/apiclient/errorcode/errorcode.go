@@ -0,0 +1,75 @@
+// Package errorcode defines the stable, machine-readable codes of the causes of the errors of the API, which let
+// automation branch on the causes of the failures instead of matching the error messages. The codes are attached to the
+// gRPC status of the errors, as an ErrorInfo detail of the argoproj.io domain, and to the bodies of the errors of the
+// REST API, as an errorCode field.
+package errorcode
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain is the domain of the ErrorInfo details carrying the error codes
+const Domain = "argoproj.io"
+
+// Code is the code of the cause of an error of the API. The codes are stable: they are never renamed or reused for
+// other causes.
+type Code string
+
+const (
+	// PermissionDenied is the code of the errors of the calls which the RBAC policy does not allow
+	PermissionDenied Code = "ERR_PERMISSION_DENIED"
+	// ApplicationNotFound is the code of the errors of the calls referencing an application which does not exist
+	ApplicationNotFound Code = "ERR_APPLICATION_NOT_FOUND"
+	// ApplicationDeleting is the code of the errors of the operations of an application being deleted
+	ApplicationDeleting Code = "ERR_APPLICATION_DELETING"
+	// ApplicationFrozen is the code of the errors of the syncs of a frozen application
+	ApplicationFrozen Code = "ERR_APPLICATION_FROZEN"
+	// ProjectSourceDenied is the code of the errors of the applications whose sources are not permitted by their project
+	ProjectSourceDenied Code = "ERR_PROJECT_SOURCE_DENIED"
+	// ProjectDestinationDenied is the code of the errors of the applications whose destination is not permitted by their
+	// project
+	ProjectDestinationDenied Code = "ERR_PROJECT_DESTINATION_DENIED"
+	// RevisionNotFound is the code of the errors of the revisions which cannot be resolved in their repository
+	RevisionNotFound Code = "ERR_REVISION_NOT_FOUND"
+	// SyncWindowDenied is the code of the errors of the syncs blocked by a sync window
+	SyncWindowDenied Code = "ERR_SYNC_WINDOW_DENIED"
+	// DiffHashMismatch is the code of the errors of the syncs whose changes differ from the previewed ones
+	DiffHashMismatch Code = "ERR_DIFF_HASH_MISMATCH"
+)
+
+// Error returns a gRPC status error of the given code and message, carrying the given error code. The error code is
+// omitted if it is empty.
+func Error(c codes.Code, code Code, msg string) error {
+	s := status.New(c, msg)
+	if code == "" {
+		return s.Err()
+	}
+	withCode, err := s.WithDetails(&errdetails.ErrorInfo{Reason: string(code), Domain: Domain})
+	if err != nil {
+		return s.Err()
+	}
+	return withCode.Err()
+}
+
+// Errorf is like Error but formats the message of the error
+func Errorf(c codes.Code, code Code, format string, a ...any) error {
+	return Error(c, code, fmt.Sprintf(format, a...))
+}
+
+// FromError returns the error code carried by the given error, or its wrapped errors, or an empty code if there is none
+func FromError(err error) Code {
+	s, ok := status.FromError(err)
+	if !ok {
+		return ""
+	}
+	for _, detail := range s.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetDomain() == Domain {
+			return Code(info.GetReason())
+		}
+	}
+	return ""
+}
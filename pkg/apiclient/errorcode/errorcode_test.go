@@ -0,0 +1,30 @@
+package errorcode
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestError(t *testing.T) {
+	err := Errorf(codes.InvalidArgument, ProjectDestinationDenied, "destination %s is not permitted", "in-cluster")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "destination in-cluster is not permitted", status.Convert(err).Message())
+	assert.Equal(t, ProjectDestinationDenied, FromError(err))
+	// the code is kept by the wrapping errors
+	assert.Equal(t, ProjectDestinationDenied, FromError(fmt.Errorf("error creating application: %w", err)))
+
+	err = Error(codes.NotFound, "", "not found")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Empty(t, FromError(err))
+}
+
+func TestFromError(t *testing.T) {
+	assert.Empty(t, FromError(nil))
+	assert.Empty(t, FromError(errors.New("error")))
+	assert.Empty(t, FromError(status.Error(codes.Internal, "error")))
+}
@@ -0,0 +1,52 @@
+package apiclient
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IsNotFound returns whether the error returned by an API call reports that the requested object does not exist. Note
+// that the API server reports a permission denied error instead when the caller is not allowed to get the object, so
+// that the existence of the objects is not disclosed to the unauthorized callers.
+func IsNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
+
+// IsPermissionDenied returns whether the error returned by an API call reports that the RBAC policy does not allow the
+// caller to perform the call
+func IsPermissionDenied(err error) bool {
+	return status.Code(err) == codes.PermissionDenied
+}
+
+// IsUnauthenticated returns whether the error returned by an API call reports that the caller is not authenticated,
+// e.g. because its token is expired or revoked
+func IsUnauthenticated(err error) bool {
+	return status.Code(err) == codes.Unauthenticated
+}
+
+// IsAlreadyExists returns whether the error returned by an API call reports that the object to create already exists
+// with a different spec
+func IsAlreadyExists(err error) bool {
+	return status.Code(err) == codes.AlreadyExists
+}
+
+// IsInvalidArgument returns whether the error returned by an API call reports that the request is invalid, e.g.
+// because the spec of an object fails the validation
+func IsInvalidArgument(err error) bool {
+	return status.Code(err) == codes.InvalidArgument
+}
+
+// ErrorMessage returns the message of the error returned by an API call, without the gRPC status code prefixing it. The
+// message of an error wrapping the status is the message of the status, without the context added by the wrapping.
+func ErrorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		return grpcErr.GRPCStatus().Message()
+	}
+	return err.Error()
+}
@@ -0,0 +1,112 @@
+package apiclient
+
+import (
+	"context"
+	"iter"
+
+	"k8s.io/utils/ptr"
+
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	applicationsetpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ListApplications returns an iterator over the applications matching the query. If the query sets a limit, the
+// applications are fetched in pages of this size, starting at the offset of the query, as the iteration goes on.
+// Otherwise they are fetched with a single call when the iteration starts. The error of a call, if any, is yielded with
+// a nil application and ends the iteration.
+func ListApplications(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, query *applicationpkg.ApplicationQuery) iter.Seq2[*v1alpha1.Application, error] {
+	return func(yield func(*v1alpha1.Application, error) bool) {
+		var pageQuery applicationpkg.ApplicationQuery
+		if query != nil {
+			pageQuery = *query
+		}
+		for {
+			list, err := appIf.List(ctx, &pageQuery)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for i := range list.Items {
+				if !yield(&list.Items[i], nil) {
+					return
+				}
+			}
+			// the API servers not supporting the pagination return all the applications, without a remaining count
+			if pageQuery.GetLimit() <= 0 || list.RemainingItemCount == nil || *list.RemainingItemCount <= 0 {
+				return
+			}
+			pageQuery.Offset = ptr.To(pageQuery.GetOffset() + int64(len(list.Items)))
+		}
+	}
+}
+
+// ListApplicationSets returns an iterator over the application sets matching the query. The API server does not
+// paginate the application sets, so they are fetched with a single call when the iteration starts. The error of the
+// call, if any, is yielded with a nil application set and ends the iteration.
+func ListApplicationSets(ctx context.Context, appSetIf applicationsetpkg.ApplicationSetServiceClient, query *applicationsetpkg.ApplicationSetListQuery) iter.Seq2[*v1alpha1.ApplicationSet, error] {
+	return listItems(func() ([]v1alpha1.ApplicationSet, error) {
+		list, err := appSetIf.List(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+// ListProjects returns an iterator over the projects, in the same way as ListApplicationSets
+func ListProjects(ctx context.Context, projIf projectpkg.ProjectServiceClient, query *projectpkg.ProjectQuery) iter.Seq2[*v1alpha1.AppProject, error] {
+	return listItems(func() ([]v1alpha1.AppProject, error) {
+		list, err := projIf.List(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+// ListClusters returns an iterator over the clusters matching the query, in the same way as ListApplicationSets
+func ListClusters(ctx context.Context, clusterIf clusterpkg.ClusterServiceClient, query *clusterpkg.ClusterQuery) iter.Seq2[*v1alpha1.Cluster, error] {
+	return listItems(func() ([]v1alpha1.Cluster, error) {
+		list, err := clusterIf.List(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+// ListRepositories returns an iterator over the repositories matching the query, in the same way as ListApplicationSets
+func ListRepositories(ctx context.Context, repoIf repositorypkg.RepositoryServiceClient, query *repositorypkg.RepoQuery) iter.Seq2[*v1alpha1.Repository, error] {
+	return func(yield func(*v1alpha1.Repository, error) bool) {
+		list, err := repoIf.List(ctx, query)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for _, repo := range list.Items {
+			if !yield(repo, nil) {
+				return
+			}
+		}
+	}
+}
+
+// listItems returns an iterator over the items returned by the given list function
+func listItems[T any](list func() ([]T, error)) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		items, err := list()
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for i := range items {
+			if !yield(&items[i], nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package apiclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// fakeApplicationServiceClient lists the given applications, paginating them like the API server unless paginate is
+// false
+type fakeApplicationServiceClient struct {
+	applicationpkg.ApplicationServiceClient
+	apps     []string
+	paginate bool
+	calls    int
+}
+
+func (c *fakeApplicationServiceClient) List(_ context.Context, q *applicationpkg.ApplicationQuery, _ ...grpc.CallOption) (*v1alpha1.ApplicationList, error) {
+	c.calls++
+	names := c.apps
+	var remaining *int64
+	if c.paginate && q.Limit != nil {
+		start := min(int(q.GetOffset()), len(names))
+		end := min(start+int(q.GetLimit()), len(names))
+		names = names[start:end]
+		remaining = ptr.To(int64(len(c.apps) - end))
+	}
+	list := &v1alpha1.ApplicationList{ListMeta: metav1.ListMeta{RemainingItemCount: remaining}}
+	for _, name := range names {
+		list.Items = append(list.Items, v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+	return list, nil
+}
+
+func TestListApplications(t *testing.T) {
+	apps := []string{"a", "b", "c", "d", "e"}
+	listNames := func(t *testing.T, appIf applicationpkg.ApplicationServiceClient, q *applicationpkg.ApplicationQuery) []string {
+		t.Helper()
+		var names []string
+		for app, err := range ListApplications(t.Context(), appIf, q) {
+			require.NoError(t, err)
+			names = append(names, app.Name)
+		}
+		return names
+	}
+
+	t.Run("Paginated", func(t *testing.T) {
+		appIf := &fakeApplicationServiceClient{apps: apps, paginate: true}
+		assert.Equal(t, apps, listNames(t, appIf, &applicationpkg.ApplicationQuery{Limit: ptr.To(int64(2))}))
+		assert.Equal(t, 3, appIf.calls)
+	})
+
+	t.Run("Offset", func(t *testing.T) {
+		appIf := &fakeApplicationServiceClient{apps: apps, paginate: true}
+		assert.Equal(t, []string{"d", "e"}, listNames(t, appIf, &applicationpkg.ApplicationQuery{Offset: ptr.To(int64(3)), Limit: ptr.To(int64(2))}))
+		assert.Equal(t, 1, appIf.calls)
+	})
+
+	t.Run("NotPaginated", func(t *testing.T) {
+		appIf := &fakeApplicationServiceClient{apps: apps}
+		assert.Equal(t, apps, listNames(t, appIf, &applicationpkg.ApplicationQuery{Limit: ptr.To(int64(2))}))
+		assert.Equal(t, 1, appIf.calls)
+	})
+}
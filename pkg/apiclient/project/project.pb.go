@@ -36,11 +36,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ProjectCreateRequest defines project creation parameters.
 type ProjectCreateRequest struct {
-	Project              *v1alpha1.AppProject `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	Upsert               bool                 `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Project *v1alpha1.AppProject `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Upsert  bool                 `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	// fieldManager is the name of the manager of the project, e.g. an infrastructure as code tool. The upsert of a
+	// project managed by another manager is rejected.
+	FieldManager         string   `protobuf:"bytes,3,opt,name=fieldManager,proto3" json:"fieldManager,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProjectCreateRequest) Reset()         { *m = ProjectCreateRequest{} }
@@ -90,6 +93,13 @@ func (m *ProjectCreateRequest) GetUpsert() bool {
 	return false
 }
 
+func (m *ProjectCreateRequest) GetFieldManager() string {
+	if m != nil {
+		return m.FieldManager
+	}
+	return ""
+}
+
 // ProjectTokenCreateRequest defines project token deletion parameters.
 type ProjectTokenDeleteRequest struct {
 	Project              string   `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
@@ -684,6 +694,174 @@ func (m *ListProjectLinksRequest) GetName() string {
 	return ""
 }
 
+// ExpiringGroupBindingsQuery is a query for the bindings of the OIDC groups to the roles of a project which expire soon
+type ExpiringGroupBindingsQuery struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// within is the duration the bindings expire within, e.g. 168h. Defaults to 720h.
+	Within               string   `protobuf:"bytes,2,opt,name=within,proto3" json:"within,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExpiringGroupBindingsQuery) Reset()         { *m = ExpiringGroupBindingsQuery{} }
+func (m *ExpiringGroupBindingsQuery) String() string { return proto.CompactTextString(m) }
+func (*ExpiringGroupBindingsQuery) ProtoMessage()    {}
+func (*ExpiringGroupBindingsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{12}
+}
+func (m *ExpiringGroupBindingsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiringGroupBindingsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiringGroupBindingsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpiringGroupBindingsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiringGroupBindingsQuery.Merge(m, src)
+}
+func (m *ExpiringGroupBindingsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiringGroupBindingsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiringGroupBindingsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpiringGroupBindingsQuery proto.InternalMessageInfo
+
+func (m *ExpiringGroupBindingsQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExpiringGroupBindingsQuery) GetWithin() string {
+	if m != nil {
+		return m.Within
+	}
+	return ""
+}
+
+// ExpiringGroupBinding is a binding of an OIDC group to a role of a project which expires soon, or has expired
+type ExpiringGroupBinding struct {
+	Role                 string                    `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Expiration           *v1alpha1.GroupExpiration `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Expired              bool                      `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ExpiringGroupBinding) Reset()         { *m = ExpiringGroupBinding{} }
+func (m *ExpiringGroupBinding) String() string { return proto.CompactTextString(m) }
+func (*ExpiringGroupBinding) ProtoMessage()    {}
+func (*ExpiringGroupBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{13}
+}
+func (m *ExpiringGroupBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiringGroupBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiringGroupBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpiringGroupBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiringGroupBinding.Merge(m, src)
+}
+func (m *ExpiringGroupBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiringGroupBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiringGroupBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpiringGroupBinding proto.InternalMessageInfo
+
+func (m *ExpiringGroupBinding) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ExpiringGroupBinding) GetExpiration() *v1alpha1.GroupExpiration {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *ExpiringGroupBinding) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+type ExpiringGroupBindingsResponse struct {
+	Items                []*ExpiringGroupBinding `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ExpiringGroupBindingsResponse) Reset()         { *m = ExpiringGroupBindingsResponse{} }
+func (m *ExpiringGroupBindingsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExpiringGroupBindingsResponse) ProtoMessage()    {}
+func (*ExpiringGroupBindingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{14}
+}
+func (m *ExpiringGroupBindingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiringGroupBindingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiringGroupBindingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpiringGroupBindingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiringGroupBindingsResponse.Merge(m, src)
+}
+func (m *ExpiringGroupBindingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiringGroupBindingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiringGroupBindingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpiringGroupBindingsResponse proto.InternalMessageInfo
+
+func (m *ExpiringGroupBindingsResponse) GetItems() []*ExpiringGroupBinding {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProjectCreateRequest)(nil), "project.ProjectCreateRequest")
 	proto.RegisterType((*ProjectTokenDeleteRequest)(nil), "project.ProjectTokenDeleteRequest")
@@ -697,75 +875,88 @@ func init() {
 	proto.RegisterType((*GlobalProjectsResponse)(nil), "project.GlobalProjectsResponse")
 	proto.RegisterType((*DetailedProjectsResponse)(nil), "project.DetailedProjectsResponse")
 	proto.RegisterType((*ListProjectLinksRequest)(nil), "project.ListProjectLinksRequest")
+	proto.RegisterType((*ExpiringGroupBindingsQuery)(nil), "project.ExpiringGroupBindingsQuery")
+	proto.RegisterType((*ExpiringGroupBinding)(nil), "project.ExpiringGroupBinding")
+	proto.RegisterType((*ExpiringGroupBindingsResponse)(nil), "project.ExpiringGroupBindingsResponse")
 }
 
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 1007 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x96, 0x93, 0x36, 0xbb, 0x7d, 0x2d, 0xa5, 0xcc, 0xee, 0x76, 0xdd, 0xd0, 0x1f, 0x61, 0xd0,
-	0x56, 0x51, 0xa1, 0xb6, 0xda, 0x80, 0xb4, 0x82, 0x13, 0xdb, 0xad, 0x02, 0x52, 0x0f, 0xe0, 0x82,
-	0x40, 0x1c, 0x40, 0x8e, 0xfd, 0x94, 0x9d, 0x8d, 0x63, 0x1b, 0xcf, 0x24, 0xdb, 0x10, 0xf5, 0x82,
-	0x04, 0x48, 0x1c, 0x38, 0xc0, 0x89, 0x0b, 0x47, 0xfe, 0x0f, 0x6e, 0x1c, 0x91, 0xf8, 0x07, 0x50,
-	0xc5, 0x1f, 0x82, 0x66, 0x3c, 0x76, 0xe2, 0xa4, 0xe6, 0x87, 0x1a, 0x38, 0x65, 0x3c, 0x7e, 0xfe,
-	0xbe, 0xef, 0xbd, 0x79, 0xf3, 0xcd, 0x04, 0xb6, 0x39, 0x26, 0x43, 0x4c, 0xec, 0x38, 0x89, 0x9e,
-	0xa2, 0x27, 0xb2, 0x5f, 0x2b, 0x4e, 0x22, 0x11, 0x91, 0x5b, 0xfa, 0xb1, 0xbe, 0xdd, 0x8d, 0xa2,
-	0x6e, 0x80, 0xb6, 0x1b, 0x33, 0xdb, 0x0d, 0xc3, 0x48, 0xb8, 0x82, 0x45, 0x21, 0x4f, 0xc3, 0xea,
-	0xb4, 0xf7, 0x90, 0x5b, 0x2c, 0x52, 0x6f, 0xbd, 0x28, 0x41, 0x7b, 0x78, 0x64, 0x77, 0x31, 0xc4,
-	0xc4, 0x15, 0xe8, 0xeb, 0x98, 0xb3, 0x2e, 0x13, 0x4f, 0x06, 0x1d, 0xcb, 0x8b, 0xfa, 0xb6, 0x9b,
-	0x74, 0x23, 0x89, 0xac, 0x06, 0x87, 0x9e, 0x6f, 0x0f, 0x5b, 0x76, 0xdc, 0xeb, 0xca, 0xef, 0xb9,
-	0xed, 0xc6, 0x71, 0xc0, 0x3c, 0x85, 0x6f, 0x0f, 0x8f, 0xdc, 0x20, 0x7e, 0xe2, 0xce, 0xa3, 0x9d,
-	0xfc, 0x0d, 0x9a, 0xce, 0x6a, 0x1a, 0x6b, 0x6a, 0x9c, 0x82, 0xd0, 0xef, 0x0c, 0xb8, 0xfb, 0x6e,
-	0x9a, 0xe0, 0x49, 0x82, 0xae, 0x40, 0x07, 0x3f, 0x1b, 0x20, 0x17, 0xa4, 0x03, 0x59, 0xe2, 0xa6,
-	0xd1, 0x30, 0x9a, 0xab, 0xc7, 0x6f, 0x5b, 0x13, 0x3e, 0x2b, 0xe3, 0x53, 0x83, 0x4f, 0x3d, 0xdf,
-	0x1a, 0xb6, 0xac, 0xb8, 0xd7, 0xb5, 0xa4, 0x7a, 0x6b, 0x9a, 0x25, 0x53, 0x6f, 0xbd, 0x15, 0xc7,
-	0x9a, 0xc7, 0xc9, 0x80, 0xc9, 0x26, 0xd4, 0x06, 0x31, 0xc7, 0x44, 0x98, 0x95, 0x86, 0xd1, 0xbc,
-	0xed, 0xe8, 0x27, 0xda, 0x83, 0x2d, 0x1d, 0xfb, 0x7e, 0xd4, 0xc3, 0xf0, 0x31, 0x06, 0x38, 0x11,
-	0x66, 0x16, 0x85, 0xad, 0x4c, 0xe0, 0x08, 0x2c, 0x25, 0x51, 0x80, 0x0a, 0x6c, 0xc5, 0x51, 0x63,
-	0xb2, 0x01, 0x55, 0xe6, 0x0a, 0xb3, 0xda, 0x30, 0x9a, 0x55, 0x47, 0x0e, 0xc9, 0x3a, 0x54, 0x98,
-	0x6f, 0x2e, 0xa9, 0x98, 0x0a, 0xf3, 0xe9, 0x0f, 0x46, 0x91, 0xad, 0x58, 0x86, 0x72, 0xb6, 0x06,
-	0xac, 0xfa, 0xc8, 0xbd, 0x84, 0xc5, 0x32, 0x51, 0x4d, 0x3a, 0x3d, 0x95, 0xeb, 0xa9, 0x4e, 0xe9,
-	0xd9, 0x86, 0x15, 0xbc, 0x88, 0x59, 0x82, 0xfc, 0x9d, 0x50, 0x89, 0xa8, 0x3a, 0x93, 0x09, 0xad,
-	0x6d, 0x39, 0xd7, 0xf6, 0x6a, 0xbe, 0x38, 0x4a, 0x9a, 0x83, 0x3c, 0x8e, 0x42, 0x8e, 0xe4, 0x2e,
-	0x2c, 0x0b, 0x39, 0xa1, 0x35, 0xa5, 0x0f, 0x94, 0xc2, 0x9a, 0x8e, 0x7e, 0x6f, 0x80, 0xc9, 0x48,
-	0xf2, 0x87, 0x6e, 0x1f, 0x75, 0x90, 0x1a, 0xd3, 0xcf, 0x73, 0xc4, 0x0f, 0x62, 0xff, 0xff, 0x5d,
-	0x6e, 0xfa, 0x3c, 0x3c, 0x77, 0xda, 0x8f, 0xc5, 0x28, 0x4b, 0x83, 0xee, 0xc3, 0xc6, 0xf9, 0x28,
-	0xf4, 0x3e, 0x64, 0xa1, 0x1f, 0x3d, 0xe3, 0xe5, 0xa2, 0x47, 0x70, 0x67, 0x2a, 0x2e, 0xaf, 0x42,
-	0x07, 0x6e, 0x3d, 0x4b, 0xa7, 0x4c, 0xa3, 0x51, 0xbd, 0xb9, 0xe6, 0x09, 0x87, 0x93, 0x01, 0xd3,
-	0x0b, 0xd8, 0x6c, 0x07, 0x51, 0xc7, 0x0d, 0x74, 0x36, 0x13, 0xf6, 0x4f, 0x60, 0x99, 0x09, 0xec,
-	0x2f, 0x88, 0x7b, 0xaa, 0x5e, 0x29, 0x2c, 0xfd, 0xb9, 0x0a, 0xe6, 0x63, 0x14, 0x2e, 0x0b, 0xd0,
-	0x9f, 0x23, 0x8f, 0x61, 0xbd, 0x5b, 0x90, 0xb5, 0x70, 0x15, 0x33, 0xf8, 0xd3, 0x0d, 0x52, 0xf9,
-	0xaf, 0xfc, 0x20, 0x80, 0xb5, 0x04, 0xe3, 0x88, 0x33, 0x11, 0x25, 0x0c, 0xb9, 0x59, 0x5d, 0x44,
-	0x4e, 0x4e, 0x86, 0x38, 0x72, 0x0a, 0xe8, 0xc4, 0x85, 0xdb, 0x5e, 0x30, 0xe0, 0x02, 0x13, 0x6e,
-	0x2e, 0x29, 0xa6, 0xd3, 0x9b, 0x31, 0x9d, 0xa4, 0x68, 0x4e, 0x0e, 0x4b, 0x0f, 0xe1, 0xfe, 0x19,
-	0xe3, 0x42, 0x27, 0x7a, 0xc6, 0xc2, 0x1e, 0xcf, 0x36, 0xdc, 0x35, 0x7d, 0x7e, 0xfc, 0xe3, 0x1a,
-	0xac, 0xeb, 0xd8, 0x73, 0x4c, 0x86, 0xcc, 0x43, 0xf2, 0x8d, 0x01, 0xab, 0xa9, 0x23, 0x29, 0x07,
-	0x20, 0xd4, 0xca, 0x4e, 0xa7, 0x52, 0xcf, 0xaa, 0xef, 0x5c, 0x1b, 0x93, 0xef, 0xba, 0x87, 0x5f,
-	0xfc, 0xf6, 0xc7, 0xf7, 0x95, 0x63, 0x7a, 0xa8, 0xce, 0xaa, 0xe1, 0x51, 0x76, 0xde, 0x71, 0x7b,
-	0xac, 0x47, 0x97, 0xb6, 0xf4, 0x2a, 0x6e, 0x8f, 0xe5, 0xcf, 0xa5, 0xad, 0xdc, 0xe5, 0x0d, 0xe3,
-	0x80, 0x7c, 0x65, 0xc0, 0x6a, 0x6a, 0xc6, 0x7f, 0x25, 0xa6, 0x60, 0xd7, 0xf5, 0xcd, 0x3c, 0xa6,
-	0xb8, 0xf7, 0xdf, 0x54, 0x2a, 0x5e, 0x3f, 0x68, 0xfd, 0x2b, 0x15, 0xf6, 0x98, 0xb9, 0xe2, 0x92,
-	0x7c, 0x6b, 0x40, 0x2d, 0xcd, 0x99, 0xcc, 0x25, 0x5b, 0xac, 0xc5, 0xc2, 0xba, 0x94, 0xbe, 0xa8,
-	0x04, 0xdf, 0xa3, 0x1b, 0xb3, 0x82, 0x65, 0x65, 0xbe, 0x34, 0x60, 0x49, 0xae, 0x34, 0xb9, 0x37,
-	0x2b, 0x47, 0xb9, 0x5a, 0xfd, 0x6c, 0x51, 0x32, 0x24, 0x09, 0x35, 0x95, 0x14, 0x42, 0xe6, 0xa4,
-	0x90, 0x0b, 0x20, 0x6d, 0x14, 0x33, 0xb6, 0x51, 0x26, 0xea, 0xa5, 0x7c, 0xba, 0xcc, 0x67, 0x68,
-	0x53, 0x31, 0x51, 0xd2, 0x98, 0x5f, 0x25, 0xd9, 0xb1, 0x97, 0xb6, 0xaf, 0xbf, 0x24, 0x5f, 0x1b,
-	0x50, 0x6d, 0x63, 0x29, 0xd7, 0xe2, 0xd6, 0x61, 0x4f, 0x49, 0xda, 0x22, 0xf7, 0x4b, 0x24, 0x91,
-	0x31, 0xbc, 0xd0, 0x46, 0x51, 0x74, 0xed, 0x32, 0x59, 0x7b, 0xf9, 0xf4, 0xf5, 0x2e, 0x4f, 0x2d,
-	0xc5, 0xd6, 0x24, 0xfb, 0x65, 0x05, 0x48, 0x6d, 0x32, 0x5f, 0x80, 0x9f, 0x0c, 0xa8, 0xa5, 0x27,
-	0xeb, 0x7c, 0x67, 0x16, 0x4e, 0xdc, 0x05, 0x56, 0xa4, 0xa5, 0x34, 0x1e, 0xd6, 0x9b, 0xa5, 0x5b,
-	0xc9, 0xea, 0xa3, 0x70, 0x7d, 0x57, 0xb8, 0x96, 0x12, 0x2d, 0x3b, 0xf6, 0x23, 0xa8, 0xa5, 0x1b,
-	0xb5, 0xac, 0x34, 0x65, 0x1b, 0x57, 0xd7, 0xff, 0xa0, 0xb4, 0xfe, 0x4f, 0x01, 0x64, 0x97, 0x9e,
-	0x0e, 0x31, 0x2c, 0x2f, 0xfc, 0x8e, 0x95, 0xde, 0x97, 0x65, 0x86, 0x96, 0xbc, 0x2f, 0x5b, 0xc3,
-	0x23, 0x4b, 0x7d, 0xa2, 0x3a, 0x7c, 0x5f, 0x91, 0x34, 0xc8, 0x6e, 0x59, 0xd9, 0x31, 0x45, 0x1f,
-	0xc3, 0x9d, 0x36, 0x8a, 0xa9, 0xcb, 0xc1, 0xb9, 0x90, 0xa5, 0xdf, 0xca, 0x49, 0x67, 0xef, 0x17,
-	0xf5, 0xed, 0xeb, 0x5e, 0xe5, 0xc9, 0xbd, 0xa2, 0x78, 0x1f, 0x90, 0x97, 0xcb, 0x78, 0xf9, 0x28,
-	0xf4, 0xf4, 0xdd, 0x80, 0xc4, 0xb0, 0x22, 0xc5, 0x2a, 0x5b, 0x27, 0x8d, 0x1c, 0xb7, 0xc4, 0xf1,
-	0xeb, 0xf5, 0xc2, 0x42, 0xea, 0x57, 0x9a, 0xf7, 0x81, 0xe2, 0xdd, 0x23, 0x3b, 0x65, 0xbc, 0x81,
-	0x0c, 0x7f, 0xf4, 0xe8, 0x97, 0xab, 0x5d, 0xe3, 0xd7, 0xab, 0x5d, 0xe3, 0xf7, 0xab, 0x5d, 0xe3,
-	0xe3, 0xd7, 0xfe, 0xd9, 0xdf, 0x09, 0x2f, 0x60, 0x18, 0xe6, 0xff, 0x6a, 0x3a, 0x35, 0x75, 0xf1,
-	0x6f, 0xfd, 0x19, 0x00, 0x00, 0xff, 0xff, 0xae, 0xc9, 0xe8, 0xa8, 0xf6, 0x0c, 0x00, 0x00,
+	// 1156 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x97, 0x9b, 0xb6, 0xdb, 0xbe, 0x76, 0x4b, 0x99, 0xed, 0x76, 0xd3, 0xd0, 0x3f, 0x61, 0x56,
+	0x5b, 0x45, 0x85, 0xda, 0x6a, 0xc3, 0x4a, 0x2b, 0x38, 0xd1, 0x6e, 0x95, 0x45, 0xea, 0x4a, 0xe0,
+	0x2e, 0x02, 0x71, 0x00, 0xb9, 0xf6, 0xc3, 0x9d, 0x8d, 0x63, 0x1b, 0x7b, 0x92, 0x36, 0x54, 0xbd,
+	0x20, 0x01, 0x12, 0x07, 0x2e, 0x9c, 0x38, 0x71, 0xe3, 0x0b, 0x20, 0x71, 0x86, 0x1b, 0x47, 0x24,
+	0xbe, 0x00, 0xaa, 0xf8, 0x20, 0x68, 0xc6, 0x63, 0xc7, 0x6e, 0xe2, 0x02, 0x6a, 0xd8, 0x93, 0x67,
+	0xc6, 0x33, 0xbf, 0xdf, 0xef, 0xbd, 0x99, 0xf7, 0xde, 0x0c, 0xac, 0xc6, 0x18, 0xf5, 0x30, 0x32,
+	0xc2, 0x28, 0x78, 0x8e, 0x36, 0x4f, 0xbf, 0x7a, 0x18, 0x05, 0x3c, 0x20, 0xb7, 0x54, 0xb7, 0xb6,
+	0xea, 0x06, 0x81, 0xeb, 0xa1, 0x61, 0x85, 0xcc, 0xb0, 0x7c, 0x3f, 0xe0, 0x16, 0x67, 0x81, 0x1f,
+	0x27, 0xd3, 0x6a, 0xb4, 0xfd, 0x28, 0xd6, 0x59, 0x20, 0xff, 0xda, 0x41, 0x84, 0x46, 0x6f, 0xc7,
+	0x70, 0xd1, 0xc7, 0xc8, 0xe2, 0xe8, 0xa8, 0x39, 0x87, 0x2e, 0xe3, 0x27, 0xdd, 0x63, 0xdd, 0x0e,
+	0x3a, 0x86, 0x15, 0xb9, 0x81, 0x40, 0x96, 0x8d, 0x6d, 0xdb, 0x31, 0x7a, 0x4d, 0x23, 0x6c, 0xbb,
+	0x62, 0x7d, 0x6c, 0x58, 0x61, 0xe8, 0x31, 0x5b, 0xe2, 0x1b, 0xbd, 0x1d, 0xcb, 0x0b, 0x4f, 0xac,
+	0x61, 0xb4, 0xfd, 0x7f, 0x40, 0x53, 0x56, 0xe5, 0xb1, 0x72, 0xed, 0x04, 0x84, 0xfe, 0xac, 0xc1,
+	0xd2, 0xbb, 0x89, 0x81, 0xfb, 0x11, 0x5a, 0x1c, 0x4d, 0xfc, 0xac, 0x8b, 0x31, 0x27, 0xc7, 0x90,
+	0x1a, 0x5e, 0xd5, 0xea, 0x5a, 0x63, 0x6e, 0xf7, 0x89, 0x3e, 0xe0, 0xd3, 0x53, 0x3e, 0xd9, 0xf8,
+	0xc4, 0x76, 0xf4, 0x5e, 0x53, 0x0f, 0xdb, 0xae, 0x2e, 0xd4, 0xeb, 0x79, 0x96, 0x54, 0xbd, 0xfe,
+	0x76, 0x18, 0x2a, 0x1e, 0x33, 0x05, 0x26, 0xcb, 0x30, 0xdd, 0x0d, 0x63, 0x8c, 0x78, 0x75, 0xa2,
+	0xae, 0x35, 0x66, 0x4c, 0xd5, 0x23, 0x14, 0xe6, 0x3f, 0x65, 0xe8, 0x39, 0x4f, 0x2d, 0xdf, 0x72,
+	0x31, 0xaa, 0x56, 0xea, 0x5a, 0x63, 0xd6, 0x2c, 0x8c, 0xd1, 0x36, 0xac, 0x28, 0xbc, 0x67, 0x41,
+	0x1b, 0xfd, 0xc7, 0xe8, 0xe1, 0x40, 0x7c, 0xb5, 0x28, 0x7e, 0x76, 0x40, 0x49, 0x60, 0x32, 0x0a,
+	0x3c, 0x94, 0x84, 0xb3, 0xa6, 0x6c, 0x93, 0x45, 0xa8, 0x30, 0x8b, 0x4b, 0x96, 0x8a, 0x29, 0x9a,
+	0x64, 0x01, 0x26, 0x98, 0x53, 0x9d, 0x94, 0x73, 0x26, 0x98, 0x43, 0xbf, 0xd7, 0x8a, 0x6c, 0x45,
+	0x57, 0x95, 0xb3, 0xd5, 0x61, 0xce, 0xc1, 0xd8, 0x8e, 0x58, 0x28, 0x9c, 0xa1, 0x48, 0xf3, 0x43,
+	0x99, 0x9e, 0x4a, 0x4e, 0xcf, 0x2a, 0xcc, 0xe2, 0x59, 0xc8, 0x22, 0x8c, 0xdf, 0xf1, 0xa5, 0x88,
+	0x8a, 0x39, 0x18, 0x50, 0xda, 0xa6, 0x32, 0x6d, 0xaf, 0xc3, 0x52, 0x5e, 0x9a, 0x89, 0x71, 0x18,
+	0xf8, 0x31, 0x92, 0x25, 0x98, 0xe2, 0x62, 0x40, 0x69, 0x4a, 0x3a, 0x94, 0xc2, 0xbc, 0x9a, 0xfd,
+	0x5e, 0x17, 0xa3, 0xbe, 0xe0, 0xf7, 0xad, 0x0e, 0xaa, 0x49, 0xb2, 0x4d, 0x3f, 0xcf, 0x10, 0xdf,
+	0x0f, 0x9d, 0x17, 0x7b, 0x24, 0xe8, 0x4b, 0x70, 0xfb, 0xa0, 0x13, 0xf2, 0x7e, 0x6a, 0x06, 0xdd,
+	0x84, 0xc5, 0xa3, 0xbe, 0x6f, 0x7f, 0xc0, 0x7c, 0x27, 0x38, 0x8d, 0xcb, 0x45, 0xf7, 0xe1, 0x4e,
+	0x6e, 0x5e, 0xe6, 0x85, 0x63, 0xb8, 0x75, 0x9a, 0x0c, 0x55, 0xb5, 0x7a, 0xe5, 0xe6, 0x9a, 0x07,
+	0x1c, 0x66, 0x0a, 0x4c, 0xcf, 0x60, 0xb9, 0xe5, 0x05, 0xc7, 0x96, 0xa7, 0xac, 0x19, 0xb0, 0x7f,
+	0x0c, 0x53, 0x8c, 0x63, 0x67, 0x4c, 0xdc, 0x39, 0x7f, 0x25, 0xb0, 0xf4, 0xd7, 0x0a, 0x54, 0x1f,
+	0x23, 0xb7, 0x98, 0x87, 0xce, 0x10, 0x79, 0x08, 0x0b, 0x6e, 0x41, 0xd6, 0xd8, 0x55, 0x5c, 0xc1,
+	0xcf, 0x1f, 0x90, 0x89, 0xff, 0x2b, 0x67, 0x78, 0x30, 0x1f, 0x61, 0x18, 0xc4, 0x8c, 0x07, 0x11,
+	0xc3, 0xb8, 0x5a, 0x19, 0x87, 0x4d, 0x66, 0x8a, 0xd8, 0x37, 0x0b, 0xe8, 0xc4, 0x82, 0x19, 0xdb,
+	0xeb, 0xc6, 0x1c, 0xa3, 0xb8, 0x3a, 0x29, 0x99, 0x0e, 0x6e, 0xc6, 0xb4, 0x9f, 0xa0, 0x99, 0x19,
+	0x2c, 0xdd, 0x86, 0x7b, 0x87, 0x2c, 0xe6, 0xca, 0xd0, 0x43, 0xe6, 0xb7, 0xe3, 0x34, 0xe0, 0x46,
+	0x9d, 0xf3, 0x27, 0x50, 0x3b, 0x10, 0xb9, 0x80, 0xf9, 0x6e, 0x2b, 0x0a, 0xba, 0xe1, 0x1e, 0xf3,
+	0x1d, 0xe6, 0xbb, 0xe5, 0x91, 0x21, 0xb2, 0xec, 0x29, 0xe3, 0x27, 0x2c, 0xcd, 0x3f, 0xaa, 0x47,
+	0x7f, 0xd2, 0x60, 0x69, 0x14, 0x54, 0x96, 0x93, 0xb4, 0x5c, 0x4e, 0xea, 0x00, 0xc8, 0x14, 0x64,
+	0x65, 0x89, 0x6c, 0x6e, 0xf7, 0xe9, 0xcd, 0x5c, 0x21, 0x39, 0x0f, 0x32, 0x50, 0x33, 0x47, 0x20,
+	0x52, 0xaa, 0xec, 0xa1, 0x23, 0x33, 0xe3, 0x8c, 0x99, 0x76, 0xe9, 0x33, 0x58, 0x1b, 0x69, 0x7f,
+	0x76, 0xec, 0x9b, 0xc5, 0x98, 0x5b, 0xd3, 0xd3, 0x72, 0x3e, 0x6a, 0x99, 0x0a, 0xa4, 0xdd, 0x5f,
+	0x6e, 0xc3, 0x82, 0xda, 0x81, 0x23, 0x8c, 0x7a, 0xcc, 0x46, 0xf2, 0x8d, 0x06, 0x73, 0x49, 0x9e,
+	0x97, 0x79, 0x95, 0xd0, 0x0c, 0xa8, 0xb4, 0x12, 0xd4, 0xd6, 0x46, 0xce, 0xc9, 0x72, 0xd9, 0xa3,
+	0x2f, 0xfe, 0xf8, 0xeb, 0xbb, 0x89, 0x5d, 0xba, 0x2d, 0x6f, 0x09, 0xbd, 0x9d, 0xf4, 0xa6, 0x11,
+	0x1b, 0xe7, 0xaa, 0x75, 0x61, 0x08, 0x6f, 0xc7, 0xc6, 0xb9, 0xf8, 0x5c, 0x18, 0x32, 0x67, 0xbf,
+	0xa9, 0x6d, 0x91, 0xaf, 0x34, 0x98, 0x4b, 0x4a, 0xdc, 0x75, 0x62, 0x0a, 0x45, 0xb0, 0xb6, 0x3c,
+	0xb0, 0xbc, 0x90, 0x51, 0xdf, 0x92, 0x2a, 0x1e, 0x6e, 0x35, 0xff, 0x93, 0x0a, 0xe3, 0x9c, 0x59,
+	0xfc, 0x82, 0x7c, 0xab, 0xc1, 0x74, 0x62, 0x33, 0x19, 0x32, 0xb6, 0xe8, 0x8b, 0xb1, 0xc5, 0x3e,
+	0x7d, 0x45, 0x0a, 0xbe, 0x4b, 0x17, 0xaf, 0x0a, 0x16, 0x9e, 0xf9, 0x52, 0x83, 0x49, 0x11, 0x3f,
+	0xe4, 0xee, 0x55, 0x39, 0x32, 0x22, 0x6a, 0x87, 0xe3, 0x92, 0x21, 0x48, 0x68, 0x55, 0x4a, 0x21,
+	0x64, 0x48, 0x0a, 0x39, 0x03, 0xd2, 0x42, 0x7e, 0x25, 0x19, 0x97, 0x89, 0x7a, 0x35, 0x1b, 0x2e,
+	0xcb, 0xde, 0xb4, 0x21, 0x99, 0x28, 0xa9, 0x0f, 0xef, 0x92, 0x88, 0xea, 0x0b, 0xc3, 0x51, 0x2b,
+	0xc9, 0xd7, 0x1a, 0x54, 0x5a, 0x58, 0xca, 0x35, 0xbe, 0x7d, 0xd8, 0x90, 0x92, 0x56, 0xc8, 0xbd,
+	0x12, 0x49, 0xe4, 0x1c, 0x5e, 0x6e, 0x21, 0x2f, 0xd6, 0xc2, 0x32, 0x59, 0x1b, 0xd9, 0xf0, 0xe8,
+	0xda, 0x49, 0x75, 0xc9, 0xd6, 0x20, 0x9b, 0x65, 0x0e, 0x48, 0x8a, 0x4f, 0xb6, 0x01, 0x3f, 0x6a,
+	0x30, 0x9d, 0xdc, 0x57, 0x86, 0x4f, 0x66, 0xe1, 0x1e, 0x33, 0x46, 0x8f, 0x34, 0xa5, 0xc6, 0xed,
+	0x5a, 0xa3, 0x34, 0x94, 0xf4, 0x0e, 0x72, 0xcb, 0xb1, 0xb8, 0xa5, 0x4b, 0xd1, 0xe2, 0xc4, 0x7e,
+	0x08, 0xd3, 0x49, 0xa0, 0x96, 0xb9, 0xa6, 0x2c, 0x70, 0x95, 0xff, 0xb7, 0x4a, 0xfd, 0xff, 0x1c,
+	0x40, 0x9c, 0xd2, 0x83, 0x1e, 0xfa, 0xe5, 0x8e, 0x5f, 0xd3, 0x93, 0x97, 0x8a, 0xb0, 0x50, 0xb7,
+	0x83, 0x08, 0xf5, 0xde, 0x8e, 0x2e, 0x97, 0xc8, 0x13, 0xbe, 0x29, 0x49, 0xea, 0x64, 0xbd, 0xcc,
+	0xed, 0x98, 0xa0, 0x9f, 0xc3, 0x9d, 0x16, 0xf2, 0xdc, 0x95, 0xeb, 0x88, 0x0b, 0xd7, 0xaf, 0x64,
+	0xa4, 0x57, 0x6f, 0x6d, 0xb5, 0xd5, 0x51, 0xbf, 0x32, 0xe3, 0x5e, 0x93, 0xbc, 0x0f, 0xc8, 0xfd,
+	0x32, 0xde, 0xb8, 0xef, 0xdb, 0xea, 0xc6, 0x45, 0x42, 0x98, 0x15, 0x62, 0x65, 0xb1, 0x24, 0xf5,
+	0x0c, 0xb7, 0xa4, 0x8e, 0xd6, 0x6a, 0x85, 0x8d, 0x54, 0xbf, 0x14, 0xef, 0x03, 0xc9, 0xbb, 0x41,
+	0xd6, 0xca, 0x78, 0x3d, 0x49, 0xf2, 0x83, 0x06, 0x2b, 0xd2, 0xb7, 0xa3, 0x6a, 0x0f, 0xb9, 0x7f,
+	0x6d, 0x91, 0x51, 0xf6, 0x6f, 0x5e, 0x3f, 0x29, 0x53, 0xf4, 0x50, 0x2a, 0x32, 0xc8, 0x76, 0x99,
+	0xa2, 0x24, 0x39, 0xbb, 0x62, 0x71, 0x6c, 0xa0, 0xc2, 0xda, 0xdb, 0xfb, 0xed, 0x72, 0x5d, 0xfb,
+	0xfd, 0x72, 0x5d, 0xfb, 0xf3, 0x72, 0x5d, 0xfb, 0xe8, 0x8d, 0x7f, 0xf7, 0xd4, 0xb4, 0x3d, 0x86,
+	0x7e, 0xf6, 0xe2, 0x3d, 0x9e, 0x96, 0x8f, 0xc2, 0xe6, 0xdf, 0x03, 0x00, 0x21, 0x58, 0xad, 0xb8,
+	0x12, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -804,6 +995,8 @@ type ProjectServiceClient interface {
 	GetSyncWindowsState(ctx context.Context, in *SyncWindowsQuery, opts ...grpc.CallOption) (*SyncWindowsResponse, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(ctx context.Context, in *ListProjectLinksRequest, opts ...grpc.CallOption) (*application.LinksResponse, error)
+	// ListExpiringGroupBindings returns the bindings of the OIDC groups to the roles of the project which expire soon, or have expired
+	ListExpiringGroupBindings(ctx context.Context, in *ExpiringGroupBindingsQuery, opts ...grpc.CallOption) (*ExpiringGroupBindingsResponse, error)
 }
 
 type projectServiceClient struct {
@@ -922,6 +1115,15 @@ func (c *projectServiceClient) ListLinks(ctx context.Context, in *ListProjectLin
 	return out, nil
 }
 
+func (c *projectServiceClient) ListExpiringGroupBindings(ctx context.Context, in *ExpiringGroupBindingsQuery, opts ...grpc.CallOption) (*ExpiringGroupBindingsResponse, error) {
+	out := new(ExpiringGroupBindingsResponse)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/ListExpiringGroupBindings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 type ProjectServiceServer interface {
 	// Create a new project token
@@ -948,6 +1150,8 @@ type ProjectServiceServer interface {
 	GetSyncWindowsState(context.Context, *SyncWindowsQuery) (*SyncWindowsResponse, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(context.Context, *ListProjectLinksRequest) (*application.LinksResponse, error)
+	// ListExpiringGroupBindings returns the bindings of the OIDC groups to the roles of the project which expire soon, or have expired
+	ListExpiringGroupBindings(context.Context, *ExpiringGroupBindingsQuery) (*ExpiringGroupBindingsResponse, error)
 }
 
 // UnimplementedProjectServiceServer can be embedded to have forward compatible implementations.
@@ -990,6 +1194,9 @@ func (*UnimplementedProjectServiceServer) GetSyncWindowsState(ctx context.Contex
 func (*UnimplementedProjectServiceServer) ListLinks(ctx context.Context, req *ListProjectLinksRequest) (*application.LinksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
 }
+func (*UnimplementedProjectServiceServer) ListExpiringGroupBindings(ctx context.Context, req *ExpiringGroupBindingsQuery) (*ExpiringGroupBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringGroupBindings not implemented")
+}
 
 func RegisterProjectServiceServer(s *grpc.Server, srv ProjectServiceServer) {
 	s.RegisterService(&_ProjectService_serviceDesc, srv)
@@ -1211,6 +1418,24 @@ func _ProjectService_ListLinks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_ListExpiringGroupBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpiringGroupBindingsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListExpiringGroupBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/ListExpiringGroupBindings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListExpiringGroupBindings(ctx, req.(*ExpiringGroupBindingsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ProjectService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "project.ProjectService",
 	HandlerType: (*ProjectServiceServer)(nil),
@@ -1263,6 +1488,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListLinks",
 			Handler:    _ProjectService_ListLinks_Handler,
 		},
+		{
+			MethodName: "ListExpiringGroupBindings",
+			Handler:    _ProjectService_ListExpiringGroupBindings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/project/project.proto",
@@ -1292,6 +1521,13 @@ func (m *ProjectCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.FieldManager) > 0 {
+		i -= len(m.FieldManager)
+		copy(dAtA[i:], m.FieldManager)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.FieldManager)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Upsert {
 		i--
 		if m.Upsert {
@@ -1795,75 +2031,217 @@ func (m *ListProjectLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProject(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProject(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExpiringGroupBindingsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ProjectCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ExpiringGroupBindingsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiringGroupBindingsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Project != nil {
-		l = m.Project.Size()
-		n += 1 + l + sovProject(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Upsert {
-		n += 2
+	if len(m.Within) > 0 {
+		i -= len(m.Within)
+		copy(dAtA[i:], m.Within)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Within)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProjectTokenDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExpiringGroupBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpiringGroupBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiringGroupBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Project)
-	if l > 0 {
-		n += 1 + l + sovProject(uint64(l))
-	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovProject(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Iat != 0 {
-		n += 1 + sovProject(uint64(m.Iat))
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovProject(uint64(l))
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProject(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProjectTokenCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Project)
-	if l > 0 {
-		n += 1 + l + sovProject(uint64(l))
+func (m *ExpiringGroupBindingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Description)
-	if l > 0 {
+	return dAtA[:n], nil
+}
+
+func (m *ExpiringGroupBindingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiringGroupBindingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProject(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProject(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProject(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProjectCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Project != nil {
+		l = m.Project.Size()
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.Upsert {
+		n += 2
+	}
+	l = len(m.FieldManager)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectTokenDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.Iat != 0 {
+		n += 1 + sovProject(uint64(m.Iat))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectTokenCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
 		n += 1 + l + sovProject(uint64(l))
 	}
 	l = len(m.Role)
@@ -2045,6 +2423,67 @@ func (m *ListProjectLinksRequest) Size() (n int) {
 	return n
 }
 
+func (m *ExpiringGroupBindingsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Within)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExpiringGroupBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.Expired {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExpiringGroupBindingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovProject(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2136,6 +2575,38 @@ func (m *ProjectCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Upsert = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
@@ -3351,6 +3822,345 @@ func (m *ListProjectLinksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExpiringGroupBindingsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiringGroupBindingsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiringGroupBindingsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Within", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Within = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiringGroupBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiringGroupBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiringGroupBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &v1alpha1.GroupExpiration{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiringGroupBindingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiringGroupBindingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiringGroupBindingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &ExpiringGroupBinding{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProject(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
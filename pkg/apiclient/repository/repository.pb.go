@@ -633,7 +633,9 @@ type RepoCreateRequest struct {
 	// Whether to create in upsert mode
 	Upsert bool `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
 	// Whether to operate on credential set instead of repository
-	CredsOnly            bool     `protobuf:"varint,3,opt,name=credsOnly,proto3" json:"credsOnly,omitempty"`
+	CredsOnly bool `protobuf:"varint,3,opt,name=credsOnly,proto3" json:"credsOnly,omitempty"`
+	// Whether to skip the test of the connection to the repository
+	SkipValidation       bool     `protobuf:"varint,4,opt,name=skipValidation,proto3" json:"skipValidation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -693,6 +695,13 @@ func (m *RepoCreateRequest) GetCredsOnly() bool {
 	return false
 }
 
+func (m *RepoCreateRequest) GetSkipValidation() bool {
+	if m != nil {
+		return m.SkipValidation
+	}
+	return false
+}
+
 type RepoUpdateRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -757,94 +766,95 @@ func init() {
 }
 
 var fileDescriptor_8d38260443475705 = []byte{
-	// 1392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdf, 0x6e, 0x1b, 0xc5,
-	0x17, 0xd6, 0x26, 0x8d, 0x9b, 0x4c, 0x9a, 0xd6, 0x9d, 0x24, 0xed, 0xfe, 0xdc, 0x34, 0xcd, 0x6f,
-	0x5b, 0xa2, 0x34, 0x6a, 0xd7, 0x4d, 0x0a, 0xa2, 0x2a, 0x02, 0xc9, 0x4d, 0x4a, 0x6b, 0x11, 0x91,
-	0xb2, 0x6d, 0xa9, 0x84, 0x40, 0x68, 0xb2, 0x3e, 0xb1, 0xb7, 0xd9, 0xec, 0x4e, 0x67, 0xc6, 0x6e,
-	0x4d, 0xd5, 0x1b, 0x84, 0x10, 0x12, 0xdc, 0x20, 0x04, 0xe2, 0x0e, 0x2e, 0x90, 0x90, 0xe0, 0x12,
-	0x89, 0x67, 0xe0, 0x12, 0x89, 0x17, 0x40, 0x15, 0x0f, 0xc1, 0x25, 0x9a, 0x33, 0xeb, 0xf5, 0x3a,
-	0xf1, 0x9f, 0x44, 0x4d, 0x73, 0x37, 0x73, 0xce, 0xec, 0xf9, 0xbe, 0xf3, 0xcd, 0x99, 0x33, 0x63,
-	0x13, 0x47, 0x82, 0x68, 0x80, 0x28, 0x0a, 0xe0, 0xb1, 0x0c, 0x54, 0x2c, 0x9a, 0x99, 0xa1, 0xcb,
-	0x45, 0xac, 0x62, 0x4a, 0xda, 0x96, 0xc2, 0x4c, 0x35, 0x8e, 0xab, 0x21, 0x14, 0x19, 0x0f, 0x8a,
-	0x2c, 0x8a, 0x62, 0xc5, 0x54, 0x10, 0x47, 0xd2, 0xac, 0x2c, 0xac, 0x55, 0x03, 0x55, 0xab, 0x6f,
-	0xb8, 0x7e, 0xbc, 0x5d, 0x64, 0xa2, 0x1a, 0x73, 0x11, 0x3f, 0xc4, 0xc1, 0x65, 0xbf, 0x52, 0x6c,
-	0x5c, 0x2d, 0xf2, 0xad, 0xaa, 0xfe, 0x52, 0x16, 0x19, 0xe7, 0x61, 0xe0, 0xe3, 0xb7, 0xc5, 0xc6,
-	0x12, 0x0b, 0x79, 0x8d, 0x2d, 0x15, 0xab, 0x10, 0x81, 0x60, 0x0a, 0x2a, 0x49, 0xb4, 0x9b, 0x03,
-	0xa2, 0x21, 0xad, 0x81, 0xf4, 0x9d, 0x26, 0x99, 0xf0, 0x80, 0xc7, 0x25, 0xce, 0xe5, 0x7b, 0x75,
-	0x10, 0x4d, 0x4a, 0xc9, 0x11, 0xbd, 0xc8, 0xb6, 0xe6, 0xac, 0x85, 0x31, 0x0f, 0xc7, 0xb4, 0x40,
-	0x46, 0x05, 0x34, 0x02, 0x19, 0xc4, 0x91, 0x3d, 0x84, 0xf6, 0x74, 0x4e, 0x6d, 0x72, 0x94, 0x71,
-	0xfe, 0x2e, 0xdb, 0x06, 0x7b, 0x18, 0x5d, 0xad, 0x29, 0x9d, 0x25, 0x84, 0x71, 0x7e, 0x47, 0xc4,
-	0x0f, 0xc1, 0x57, 0xf6, 0x11, 0x74, 0x66, 0x2c, 0xce, 0x12, 0x39, 0x5a, 0xe2, 0xbc, 0x1c, 0x6d,
-	0xc6, 0x1a, 0x54, 0x35, 0x39, 0xb4, 0x40, 0xf5, 0x58, 0xdb, 0x38, 0x53, 0xb5, 0x04, 0x10, 0xc7,
-	0xce, 0xbf, 0x16, 0x99, 0x4c, 0xe8, 0xae, 0x82, 0x62, 0x41, 0x98, 0x90, 0xae, 0x92, 0x9c, 0x8c,
-	0xeb, 0xc2, 0x37, 0x11, 0xc6, 0x97, 0xd7, 0xdd, 0xb6, 0x3a, 0x6e, 0x4b, 0x1d, 0x1c, 0x7c, 0xec,
-	0x57, 0xdc, 0xc6, 0x55, 0x97, 0x6f, 0x55, 0x5d, 0xad, 0xb5, 0x9b, 0xd1, 0xda, 0x6d, 0x69, 0xed,
-	0x96, 0xda, 0xc6, 0xbb, 0x18, 0xd6, 0x4b, 0xc2, 0x67, 0xb3, 0x1d, 0xea, 0x97, 0xed, 0xf0, 0xce,
-	0x6c, 0xe9, 0x1c, 0x19, 0x37, 0x31, 0xca, 0x51, 0x05, 0x9e, 0xa0, 0x1c, 0x23, 0x5e, 0xd6, 0x44,
-	0x67, 0xc8, 0x58, 0x03, 0x84, 0x16, 0xb5, 0x5c, 0xb1, 0x47, 0xd0, 0xdf, 0x36, 0x38, 0x6f, 0x92,
-	0x7c, 0x6b, 0xa3, 0x3c, 0x90, 0x3c, 0x8e, 0x24, 0xd0, 0x8b, 0x64, 0x24, 0x50, 0xb0, 0x2d, 0x6d,
-	0x6b, 0x6e, 0x78, 0x61, 0x7c, 0x79, 0xd2, 0xcd, 0x6c, 0x6f, 0x22, 0xad, 0x67, 0x56, 0x38, 0x3e,
-	0x19, 0xd3, 0x9f, 0xf7, 0xde, 0x63, 0x87, 0x1c, 0xdb, 0x8c, 0x75, 0xaa, 0xb0, 0x29, 0x40, 0x1a,
-	0xd9, 0x47, 0xbd, 0x0e, 0xdb, 0xa0, 0x1c, 0x9d, 0xdf, 0x72, 0xe4, 0x04, 0x92, 0xf4, 0x7d, 0x90,
-	0xfd, 0xeb, 0xa9, 0x2e, 0x41, 0x44, 0x6d, 0x19, 0xd3, 0xb9, 0xf6, 0x71, 0x26, 0xe5, 0xe3, 0x58,
-	0x54, 0x12, 0x84, 0x74, 0x4e, 0x2f, 0x90, 0x09, 0x29, 0x6b, 0x77, 0x44, 0xd0, 0x60, 0x0a, 0xde,
-	0x81, 0x66, 0x52, 0x54, 0x9d, 0x46, 0x1d, 0x21, 0x88, 0x24, 0xf8, 0x75, 0x01, 0x28, 0xe3, 0xa8,
-	0x97, 0xce, 0xe9, 0x25, 0x72, 0x52, 0x85, 0x72, 0x25, 0x0c, 0x20, 0x52, 0x2b, 0x20, 0xd4, 0x2a,
-	0x53, 0xcc, 0xce, 0x61, 0x94, 0xdd, 0x0e, 0xba, 0x48, 0xf2, 0x1d, 0x46, 0x0d, 0x79, 0x14, 0x17,
-	0xef, 0xb2, 0xa7, 0x25, 0x3c, 0xd6, 0x59, 0xc2, 0x98, 0x23, 0x31, 0x36, 0xcc, 0x6f, 0x86, 0x8c,
-	0x41, 0xc4, 0x36, 0x42, 0x58, 0xf7, 0x03, 0x7b, 0x1c, 0xe9, 0xb5, 0x0d, 0xf4, 0x0a, 0x99, 0x34,
-	0x95, 0x5b, 0xd2, 0xaa, 0xa6, 0x79, 0x1e, 0xc3, 0x00, 0xdd, 0x5c, 0xba, 0xae, 0x52, 0x73, 0x79,
-	0xd5, 0x9e, 0x98, 0xb3, 0x16, 0x86, 0xbd, 0xac, 0x89, 0x5e, 0x23, 0xa7, 0xdb, 0xd3, 0x48, 0x2a,
-	0x16, 0x86, 0x58, 0xda, 0xe5, 0x55, 0xfb, 0x38, 0xae, 0xee, 0xe5, 0xa6, 0x6f, 0x91, 0x42, 0xea,
-	0xba, 0x19, 0x29, 0x10, 0x5c, 0x04, 0x12, 0x6e, 0x30, 0x09, 0xf7, 0x45, 0x68, 0x9f, 0x40, 0x52,
-	0x7d, 0x56, 0xd0, 0x29, 0x32, 0xc2, 0x45, 0xfc, 0xa4, 0x69, 0xe7, 0x71, 0xa9, 0x99, 0xe8, 0x33,
-	0xc4, 0x93, 0x12, 0x3a, 0x69, 0xce, 0x50, 0x32, 0xa5, 0xcb, 0x64, 0xaa, 0xea, 0xf3, 0xbb, 0x20,
-	0x1a, 0x81, 0x0f, 0x25, 0xdf, 0x8f, 0xeb, 0x11, 0x6a, 0x4e, 0x71, 0x59, 0x57, 0x1f, 0x75, 0x09,
-	0xc5, 0x1a, 0xbd, 0xad, 0x14, 0xbf, 0xc1, 0x64, 0xe0, 0x97, 0xea, 0xaa, 0x66, 0x4f, 0xa2, 0xb0,
-	0x5d, 0x3c, 0xf4, 0x3a, 0xb1, 0xeb, 0x12, 0x4a, 0x9f, 0xd4, 0x05, 0x3c, 0x88, 0xc5, 0x56, 0x18,
-	0xb3, 0x4a, 0xb9, 0x02, 0x91, 0x0a, 0x54, 0xd3, 0x9e, 0xc2, 0xaf, 0x7a, 0xfa, 0xb5, 0xd6, 0x1b,
-	0xc0, 0x04, 0x88, 0x7b, 0xf1, 0x16, 0x44, 0xf6, 0x34, 0xd2, 0xca, 0x9a, 0x74, 0x06, 0xad, 0x5a,
-	0x5b, 0xf7, 0x83, 0xb7, 0x5b, 0xf0, 0xf6, 0x29, 0x8c, 0xdc, 0xd5, 0xe7, 0x1c, 0x27, 0xc7, 0xf4,
-	0xa1, 0x69, 0x9d, 0x6a, 0xe7, 0x67, 0x8b, 0x9c, 0xd4, 0x86, 0x15, 0x01, 0x4c, 0x81, 0x07, 0x8f,
-	0xea, 0x20, 0x15, 0xfd, 0x30, 0x73, 0x8e, 0xc6, 0x97, 0x6f, 0xbf, 0x58, 0x83, 0xf3, 0xd2, 0x3e,
-	0x91, 0x9c, 0xc8, 0x53, 0x24, 0x57, 0xe7, 0x12, 0x84, 0x4a, 0xce, 0x7d, 0x32, 0xd3, 0xd5, 0xea,
-	0x0b, 0xa8, 0xc8, 0xf5, 0x28, 0x6c, 0xe2, 0x71, 0x1c, 0xf5, 0xda, 0x06, 0xe7, 0x91, 0x21, 0x7a,
-	0x9f, 0x57, 0x0e, 0x8b, 0xe8, 0xf2, 0x67, 0xa7, 0x0d, 0xa6, 0x31, 0x26, 0xe5, 0x40, 0xbf, 0xb2,
-	0xc8, 0x91, 0xb5, 0x40, 0x2a, 0x3a, 0x9d, 0x6d, 0x81, 0x69, 0xc3, 0x2b, 0xac, 0x1d, 0x14, 0x0b,
-	0x0d, 0xe2, 0x9c, 0xfb, 0xf4, 0xaf, 0x7f, 0xbe, 0x19, 0x3a, 0x45, 0xa7, 0xf0, 0xa2, 0x6f, 0x2c,
-	0xb5, 0x6f, 0xd5, 0x00, 0xe4, 0x17, 0x43, 0x16, 0xfd, 0xd2, 0x22, 0xc3, 0xb7, 0xa0, 0x27, 0x9b,
-	0x03, 0xd3, 0xc4, 0x39, 0x8f, 0x4c, 0xce, 0xd2, 0x33, 0xdd, 0x98, 0x14, 0x9f, 0xea, 0xd9, 0x33,
-	0xfa, 0x9d, 0x45, 0x46, 0x6f, 0x81, 0x7a, 0x20, 0x02, 0x05, 0x2f, 0x9f, 0xd2, 0x45, 0xa4, 0x74,
-	0x9e, 0xfe, 0xbf, 0x45, 0xe9, 0xb1, 0xc6, 0xbd, 0xdc, 0x8d, 0xd8, 0xb7, 0x16, 0xc9, 0x6b, 0x41,
-	0xbd, 0x8c, 0xef, 0x70, 0x76, 0x70, 0xa6, 0xdf, 0x0e, 0xd2, 0x1f, 0x2d, 0x32, 0xad, 0x97, 0xa1,
-	0x62, 0x87, 0x4f, 0xce, 0x41, 0x72, 0x33, 0xb4, 0xd0, 0x5b, 0x41, 0xfa, 0x11, 0x19, 0x35, 0xca,
-	0x6d, 0xf6, 0x24, 0x95, 0xef, 0x34, 0x6f, 0x4a, 0x67, 0x01, 0x03, 0x3b, 0x74, 0xae, 0x4f, 0xb5,
-	0x14, 0x85, 0x0e, 0x59, 0x21, 0xe3, 0x3a, 0xfc, 0xfa, 0x4a, 0xf9, 0x1e, 0xab, 0xee, 0x03, 0xe1,
-	0x12, 0x22, 0xcc, 0xd3, 0x0b, 0xfd, 0x10, 0x62, 0x3f, 0xb8, 0xac, 0x74, 0xd8, 0x6d, 0x93, 0x84,
-	0x7e, 0xd2, 0xd0, 0xff, 0xed, 0x84, 0x48, 0x5f, 0xa4, 0x85, 0x99, 0x6e, 0xae, 0xb4, 0x5b, 0xee,
-	0x29, 0x29, 0xa6, 0x21, 0xbe, 0xb6, 0xc8, 0xc4, 0x2d, 0x50, 0xed, 0xb7, 0x23, 0x3d, 0xd7, 0x25,
-	0x72, 0xf6, 0x5d, 0x59, 0x70, 0x7a, 0x2f, 0x48, 0x09, 0xbc, 0x81, 0x04, 0x5e, 0x73, 0xae, 0x74,
-	0x27, 0x60, 0x5e, 0x78, 0x18, 0xe7, 0xbe, 0xb7, 0x86, 0x54, 0x2a, 0x26, 0xc2, 0x75, 0x6b, 0x91,
-	0x36, 0x90, 0xd2, 0x6d, 0x08, 0xb7, 0x57, 0x6a, 0x4c, 0xa8, 0x9e, 0x52, 0xcf, 0x66, 0xcd, 0xed,
-	0xe5, 0x29, 0x09, 0x17, 0x49, 0x2c, 0xd0, 0xf9, 0x7e, 0x2a, 0xd4, 0x20, 0xdc, 0xf6, 0x0d, 0xcc,
-	0xf7, 0x16, 0xc9, 0x99, 0xfb, 0x85, 0x9e, 0xdd, 0x89, 0xd8, 0x71, 0xef, 0x1c, 0x60, 0x67, 0x78,
-	0xc5, 0xd4, 0xb5, 0xd3, 0xf5, 0xd0, 0x5d, 0xc7, 0xf6, 0xae, 0x9b, 0xe7, 0x0f, 0x16, 0xc9, 0xb7,
-	0x28, 0xb4, 0xbe, 0x3d, 0x3c, 0x92, 0xce, 0x60, 0x92, 0xf4, 0x17, 0x8b, 0x4c, 0x1b, 0xfc, 0xce,
-	0x0e, 0x71, 0x88, 0x34, 0x93, 0xaa, 0x77, 0xfa, 0xf4, 0x88, 0x84, 0xec, 0x4f, 0x16, 0xc9, 0x99,
-	0x0b, 0x7a, 0x37, 0xbb, 0x8e, 0x8b, 0xfb, 0x00, 0xd9, 0x2d, 0x99, 0x6a, 0x2c, 0xf4, 0x39, 0x93,
-	0x48, 0xe5, 0x59, 0x7b, 0xd7, 0x7f, 0xb5, 0x48, 0xbe, 0x45, 0xa7, 0xb7, 0x9c, 0x2f, 0x8b, 0xb0,
-	0xbb, 0x3f, 0xc2, 0xf4, 0x77, 0x8b, 0x4c, 0x1b, 0x2e, 0x03, 0x2b, 0xe0, 0x65, 0x51, 0x7e, 0x15,
-	0x29, 0xbb, 0x85, 0xf9, 0x41, 0xf7, 0x6c, 0x07, 0x71, 0x46, 0x72, 0xab, 0x10, 0x42, 0xef, 0x87,
-	0x80, 0xbd, 0xd3, 0x9c, 0xb6, 0x98, 0x79, 0xf3, 0xd6, 0x58, 0xec, 0xf7, 0xd6, 0xd0, 0x3b, 0x59,
-	0x23, 0x79, 0x03, 0x91, 0x51, 0x65, 0xdf, 0x60, 0xe7, 0xf7, 0x00, 0x46, 0x25, 0x99, 0x36, 0x48,
-	0x3b, 0x37, 0x61, 0xdf, 0x70, 0xc9, 0xa3, 0x65, 0x71, 0x0f, 0x8f, 0x96, 0xa7, 0xe4, 0xf8, 0xfb,
-	0x2c, 0x0c, 0xf4, 0xa6, 0x9a, 0x9f, 0xb9, 0xf4, 0xcc, 0xae, 0x4b, 0xa2, 0xfd, 0xf3, 0xb7, 0x0f,
-	0xe6, 0x32, 0x62, 0x5e, 0x72, 0xfa, 0xde, 0x95, 0x8d, 0x04, 0x2a, 0xd9, 0xbe, 0xcf, 0x2d, 0x32,
-	0xd9, 0x42, 0xc7, 0xa4, 0x5f, 0x8c, 0xc2, 0x35, 0xa4, 0xb0, 0xec, 0x2c, 0x0e, 0x4c, 0x7b, 0x07,
-	0x91, 0x1b, 0x37, 0xff, 0x78, 0x3e, 0x6b, 0xfd, 0xf9, 0x7c, 0xd6, 0xfa, 0xfb, 0xf9, 0xac, 0xf5,
-	0xc1, 0xeb, 0x7b, 0xfb, 0x67, 0xcb, 0xc7, 0x1f, 0xcc, 0x99, 0xff, 0xa0, 0x36, 0x72, 0xf8, 0x27,
-	0xd4, 0xd5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x0b, 0xd2, 0x3f, 0x69, 0x13, 0x00, 0x00,
+	// 1405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xd7, 0x26, 0x8d, 0x9b, 0x4c, 0x9a, 0xd4, 0x99, 0x24, 0xed, 0x7e, 0xdd, 0x34, 0xcd, 0x77,
+	0x5b, 0xa2, 0x34, 0x6a, 0xd7, 0x8d, 0x0b, 0xa2, 0x2a, 0x02, 0xc9, 0x4d, 0x4a, 0x6b, 0x11, 0x91,
+	0xb2, 0x6d, 0xa9, 0x84, 0x40, 0x68, 0xb2, 0x7e, 0xb1, 0xb7, 0xd9, 0xec, 0x4e, 0x67, 0xc6, 0x6e,
+	0x4d, 0xd5, 0x0b, 0x42, 0x08, 0x09, 0x2e, 0x08, 0x81, 0xb8, 0xc1, 0x81, 0x13, 0x1c, 0x91, 0xf8,
+	0x1b, 0xb8, 0x81, 0xc4, 0x3f, 0x80, 0x2a, 0xfe, 0x08, 0x8e, 0x68, 0xde, 0xae, 0x77, 0xd7, 0x89,
+	0x7f, 0x24, 0x6a, 0x9a, 0xdb, 0xcc, 0x7b, 0xb3, 0xef, 0xf3, 0x79, 0x9f, 0x79, 0x33, 0xf3, 0x6c,
+	0x62, 0x49, 0x10, 0x4d, 0x10, 0x45, 0x01, 0x3c, 0x94, 0x9e, 0x0a, 0x45, 0x2b, 0x33, 0xb4, 0xb9,
+	0x08, 0x55, 0x48, 0x49, 0x6a, 0x29, 0xcc, 0xd5, 0xc2, 0xb0, 0xe6, 0x43, 0x91, 0x71, 0xaf, 0xc8,
+	0x82, 0x20, 0x54, 0x4c, 0x79, 0x61, 0x20, 0xa3, 0x95, 0x85, 0xf5, 0x9a, 0xa7, 0xea, 0x8d, 0x4d,
+	0xdb, 0x0d, 0x77, 0x8a, 0x4c, 0xd4, 0x42, 0x2e, 0xc2, 0x87, 0x38, 0xb8, 0xec, 0x56, 0x8b, 0xcd,
+	0xab, 0x45, 0xbe, 0x5d, 0xd3, 0x5f, 0xca, 0x22, 0xe3, 0xdc, 0xf7, 0x5c, 0xfc, 0xb6, 0xd8, 0x5c,
+	0x61, 0x3e, 0xaf, 0xb3, 0x95, 0x62, 0x0d, 0x02, 0x10, 0x4c, 0x41, 0x35, 0x8e, 0x76, 0x73, 0x40,
+	0x34, 0xa4, 0x35, 0x90, 0xbe, 0xd5, 0x22, 0x13, 0x0e, 0xf0, 0xb0, 0xcc, 0xb9, 0x7c, 0xaf, 0x01,
+	0xa2, 0x45, 0x29, 0x39, 0xa6, 0x17, 0x99, 0xc6, 0x82, 0xb1, 0x34, 0xe6, 0xe0, 0x98, 0x16, 0xc8,
+	0xa8, 0x80, 0xa6, 0x27, 0xbd, 0x30, 0x30, 0x87, 0xd0, 0x9e, 0xcc, 0xa9, 0x49, 0x8e, 0x33, 0xce,
+	0xdf, 0x65, 0x3b, 0x60, 0x0e, 0xa3, 0xab, 0x3d, 0xa5, 0xf3, 0x84, 0x30, 0xce, 0xef, 0x88, 0xf0,
+	0x21, 0xb8, 0xca, 0x3c, 0x86, 0xce, 0x8c, 0xc5, 0x5a, 0x21, 0xc7, 0xcb, 0x9c, 0x57, 0x82, 0xad,
+	0x50, 0x83, 0xaa, 0x16, 0x87, 0x36, 0xa8, 0x1e, 0x6b, 0x1b, 0x67, 0xaa, 0x1e, 0x03, 0xe2, 0xd8,
+	0xfa, 0xd7, 0x20, 0xd3, 0x31, 0xdd, 0x35, 0x50, 0xcc, 0xf3, 0x63, 0xd2, 0x35, 0x92, 0x93, 0x61,
+	0x43, 0xb8, 0x51, 0x84, 0xf1, 0xd2, 0x86, 0x9d, 0xaa, 0x63, 0xb7, 0xd5, 0xc1, 0xc1, 0xc7, 0x6e,
+	0xd5, 0x6e, 0x5e, 0xb5, 0xf9, 0x76, 0xcd, 0xd6, 0x5a, 0xdb, 0x19, 0xad, 0xed, 0xb6, 0xd6, 0x76,
+	0x39, 0x35, 0xde, 0xc5, 0xb0, 0x4e, 0x1c, 0x3e, 0x9b, 0xed, 0x50, 0xbf, 0x6c, 0x87, 0x77, 0x67,
+	0x4b, 0x17, 0xc8, 0x78, 0x14, 0xa3, 0x12, 0x54, 0xe1, 0x09, 0xca, 0x31, 0xe2, 0x64, 0x4d, 0x74,
+	0x8e, 0x8c, 0x35, 0x41, 0x68, 0x51, 0x2b, 0x55, 0x73, 0x04, 0xfd, 0xa9, 0xc1, 0x7a, 0x93, 0xe4,
+	0xdb, 0x1b, 0xe5, 0x80, 0xe4, 0x61, 0x20, 0x81, 0x5e, 0x24, 0x23, 0x9e, 0x82, 0x1d, 0x69, 0x1a,
+	0x0b, 0xc3, 0x4b, 0xe3, 0xa5, 0x69, 0x3b, 0xb3, 0xbd, 0xb1, 0xb4, 0x4e, 0xb4, 0xc2, 0x72, 0xc9,
+	0x98, 0xfe, 0xbc, 0xf7, 0x1e, 0x5b, 0xe4, 0xc4, 0x56, 0xa8, 0x53, 0x85, 0x2d, 0x01, 0x32, 0x92,
+	0x7d, 0xd4, 0xe9, 0xb0, 0x0d, 0xca, 0xd1, 0xfa, 0x35, 0x47, 0x4e, 0x22, 0x49, 0xd7, 0x05, 0xd9,
+	0xbf, 0x9e, 0x1a, 0x12, 0x44, 0x90, 0xca, 0x98, 0xcc, 0xb5, 0x8f, 0x33, 0x29, 0x1f, 0x87, 0xa2,
+	0x1a, 0x23, 0x24, 0x73, 0x7a, 0x81, 0x4c, 0x48, 0x59, 0xbf, 0x23, 0xbc, 0x26, 0x53, 0xf0, 0x0e,
+	0xb4, 0xe2, 0xa2, 0xea, 0x34, 0xea, 0x08, 0x5e, 0x20, 0xc1, 0x6d, 0x08, 0x40, 0x19, 0x47, 0x9d,
+	0x64, 0x4e, 0x2f, 0x91, 0x29, 0xe5, 0xcb, 0x55, 0xdf, 0x83, 0x40, 0xad, 0x82, 0x50, 0x6b, 0x4c,
+	0x31, 0x33, 0x87, 0x51, 0xf6, 0x3a, 0xe8, 0x32, 0xc9, 0x77, 0x18, 0x35, 0xe4, 0x71, 0x5c, 0xbc,
+	0xc7, 0x9e, 0x94, 0xf0, 0x58, 0x67, 0x09, 0x63, 0x8e, 0x24, 0xb2, 0x61, 0x7e, 0x73, 0x64, 0x0c,
+	0x02, 0xb6, 0xe9, 0xc3, 0x86, 0xeb, 0x99, 0xe3, 0x48, 0x2f, 0x35, 0xd0, 0x2b, 0x64, 0x3a, 0xaa,
+	0xdc, 0x32, 0xe7, 0x69, 0x4a, 0xe6, 0x09, 0x0c, 0xd0, 0xcd, 0xa5, 0xeb, 0x2a, 0x31, 0x57, 0xd6,
+	0xcc, 0x89, 0x05, 0x63, 0x69, 0xd8, 0xc9, 0x9a, 0xe8, 0x35, 0x72, 0x3a, 0x9d, 0x06, 0x52, 0x31,
+	0xdf, 0xc7, 0xd2, 0xae, 0xac, 0x99, 0x93, 0xb8, 0xba, 0x97, 0x9b, 0xbe, 0x45, 0x0a, 0x89, 0xeb,
+	0x66, 0xa0, 0x40, 0x70, 0xe1, 0x49, 0xb8, 0xc1, 0x24, 0xdc, 0x17, 0xbe, 0x79, 0x12, 0x49, 0xf5,
+	0x59, 0x41, 0x67, 0xc8, 0x08, 0x17, 0xe1, 0x93, 0x96, 0x99, 0xc7, 0xa5, 0xd1, 0x44, 0x9f, 0x21,
+	0x1e, 0x97, 0xd0, 0x54, 0x74, 0x86, 0xe2, 0x29, 0x2d, 0x91, 0x99, 0x9a, 0xcb, 0xef, 0x82, 0x68,
+	0x7a, 0x2e, 0x94, 0x5d, 0x37, 0x6c, 0x04, 0xa8, 0x39, 0xc5, 0x65, 0x5d, 0x7d, 0xd4, 0x26, 0x14,
+	0x6b, 0xf4, 0xb6, 0x52, 0xfc, 0x06, 0x93, 0x9e, 0x5b, 0x6e, 0xa8, 0xba, 0x39, 0x8d, 0xc2, 0x76,
+	0xf1, 0xd0, 0xeb, 0xc4, 0x6c, 0x48, 0x28, 0x7f, 0xd2, 0x10, 0xf0, 0x20, 0x14, 0xdb, 0x7e, 0xc8,
+	0xaa, 0x95, 0x2a, 0x04, 0xca, 0x53, 0x2d, 0x73, 0x06, 0xbf, 0xea, 0xe9, 0xd7, 0x5a, 0x6f, 0x02,
+	0x13, 0x20, 0xee, 0x85, 0xdb, 0x10, 0x98, 0xb3, 0x48, 0x2b, 0x6b, 0xd2, 0x19, 0xb4, 0x6b, 0x6d,
+	0xc3, 0xf5, 0xde, 0x6e, 0xc3, 0x9b, 0xa7, 0x30, 0x72, 0x57, 0x9f, 0x35, 0x49, 0x4e, 0xe8, 0x43,
+	0xd3, 0x3e, 0xd5, 0xd6, 0x1f, 0x06, 0x99, 0xd2, 0x86, 0x55, 0x01, 0x4c, 0x81, 0x03, 0x8f, 0x1a,
+	0x20, 0x15, 0xfd, 0x30, 0x73, 0x8e, 0xc6, 0x4b, 0xb7, 0x5f, 0xec, 0x82, 0x73, 0x92, 0x7b, 0x22,
+	0x3e, 0x91, 0xa7, 0x48, 0xae, 0xc1, 0x25, 0x08, 0x15, 0x9f, 0xfb, 0x78, 0xa6, 0xab, 0xd5, 0x15,
+	0x50, 0x95, 0x1b, 0x81, 0xdf, 0xc2, 0xe3, 0x38, 0xea, 0xa4, 0x06, 0xba, 0x48, 0x26, 0xe5, 0xb6,
+	0xc7, 0xdf, 0x67, 0xbe, 0x57, 0x45, 0x04, 0x3c, 0x90, 0xa3, 0xce, 0x2e, 0xab, 0xf5, 0x28, 0x4a,
+	0xe8, 0x3e, 0xaf, 0x1e, 0x55, 0x42, 0xa5, 0xcf, 0x4e, 0x93, 0xa9, 0xd4, 0x18, 0x97, 0x0d, 0xfd,
+	0xca, 0x20, 0xc7, 0xd6, 0x3d, 0xa9, 0xe8, 0x6c, 0xf6, 0xaa, 0x4c, 0x2e, 0xc6, 0xc2, 0xfa, 0x61,
+	0xb1, 0xd0, 0x20, 0xd6, 0xb9, 0x4f, 0xff, 0xfa, 0xe7, 0x9b, 0xa1, 0x53, 0x74, 0x06, 0x1b, 0x82,
+	0xe6, 0x4a, 0xfa, 0xfa, 0x7a, 0x20, 0xbf, 0x18, 0x32, 0xe8, 0x97, 0x06, 0x19, 0xbe, 0x05, 0x3d,
+	0xd9, 0x1c, 0x9a, 0x26, 0xd6, 0x79, 0x64, 0x72, 0x96, 0x9e, 0xe9, 0xc6, 0xa4, 0xf8, 0x54, 0xcf,
+	0x9e, 0xd1, 0xef, 0x0c, 0x32, 0x7a, 0x0b, 0xd4, 0x03, 0xe1, 0x29, 0x78, 0xf9, 0x94, 0x2e, 0x22,
+	0xa5, 0xf3, 0xf4, 0xff, 0x6d, 0x4a, 0x8f, 0x35, 0xee, 0xe5, 0x6e, 0xc4, 0xbe, 0x35, 0x48, 0x5e,
+	0x0b, 0xea, 0x64, 0x7c, 0x47, 0xb3, 0x83, 0x73, 0xfd, 0x76, 0x90, 0xfe, 0x68, 0x90, 0x59, 0xbd,
+	0x0c, 0x15, 0x3b, 0x7a, 0x72, 0x16, 0x92, 0x9b, 0xa3, 0x85, 0xde, 0x0a, 0xd2, 0x8f, 0xc8, 0x68,
+	0xa4, 0xdc, 0x56, 0x4f, 0x52, 0xf9, 0x4e, 0xf3, 0x96, 0xb4, 0x96, 0x30, 0xb0, 0x45, 0x17, 0xfa,
+	0x54, 0x4b, 0x51, 0xe8, 0x90, 0x55, 0x32, 0xae, 0xc3, 0x6f, 0xac, 0x56, 0xee, 0xb1, 0xda, 0x01,
+	0x10, 0x2e, 0x21, 0xc2, 0x22, 0xbd, 0xd0, 0x0f, 0x21, 0x74, 0xbd, 0xcb, 0x4a, 0x87, 0xdd, 0x89,
+	0x92, 0xd0, 0xad, 0x0f, 0xfd, 0xdf, 0x6e, 0x88, 0xa4, 0x73, 0x2d, 0xcc, 0x75, 0x73, 0x25, 0xb7,
+	0xea, 0xbe, 0x92, 0x62, 0x1a, 0xe2, 0x6b, 0x83, 0x4c, 0xdc, 0x02, 0x95, 0xf6, 0x98, 0xf4, 0x5c,
+	0x97, 0xc8, 0xd9, 0xfe, 0xb3, 0x60, 0xf5, 0x5e, 0x90, 0x10, 0x78, 0x03, 0x09, 0xbc, 0x66, 0x5d,
+	0xe9, 0x4e, 0x20, 0xea, 0x04, 0x31, 0xce, 0x7d, 0x67, 0x1d, 0xa9, 0x54, 0xa3, 0x08, 0xd7, 0x8d,
+	0x65, 0xda, 0x44, 0x4a, 0xb7, 0xc1, 0xdf, 0x59, 0xad, 0x33, 0xa1, 0x7a, 0x4a, 0x3d, 0x9f, 0x35,
+	0xa7, 0xcb, 0x13, 0x12, 0x36, 0x92, 0x58, 0xa2, 0x8b, 0xfd, 0x54, 0xa8, 0x83, 0xbf, 0xe3, 0x46,
+	0x30, 0xdf, 0x1b, 0x24, 0x17, 0xbd, 0x43, 0xf4, 0xec, 0x6e, 0xc4, 0x8e, 0xf7, 0xe9, 0x10, 0x6f,
+	0x86, 0x57, 0xa2, 0xba, 0xb6, 0xba, 0x1e, 0xba, 0xeb, 0x78, 0xbd, 0xeb, 0xcb, 0xf3, 0x07, 0x83,
+	0xe4, 0xdb, 0x14, 0xda, 0xdf, 0x1e, 0x1d, 0x49, 0x6b, 0x30, 0x49, 0xfa, 0xb3, 0x41, 0x66, 0x23,
+	0xfc, 0xce, 0x1b, 0xe2, 0x08, 0x69, 0xc6, 0x55, 0x6f, 0xf5, 0xb9, 0x23, 0x62, 0xb2, 0x3f, 0x19,
+	0x24, 0x17, 0x3d, 0xd0, 0x7b, 0xd9, 0x75, 0x3c, 0xdc, 0x87, 0xc8, 0x6e, 0x25, 0xaa, 0xc6, 0x42,
+	0x9f, 0x33, 0x89, 0x54, 0x9e, 0xa5, 0xbb, 0xfe, 0x8b, 0x41, 0xf2, 0x6d, 0x3a, 0xbd, 0xe5, 0x7c,
+	0x59, 0x84, 0xed, 0x83, 0x11, 0xa6, 0xbf, 0x19, 0x64, 0x36, 0xe2, 0x32, 0xb0, 0x02, 0x5e, 0x16,
+	0xe5, 0x57, 0x91, 0xb2, 0x5d, 0x58, 0x1c, 0xf4, 0xce, 0x76, 0x10, 0x67, 0x24, 0xb7, 0x06, 0x3e,
+	0xf4, 0x6e, 0x04, 0xcc, 0xdd, 0xe6, 0xe4, 0x8a, 0x59, 0x8c, 0x7a, 0x8d, 0xe5, 0x7e, 0xbd, 0x86,
+	0xde, 0xc9, 0x3a, 0xc9, 0x47, 0x10, 0x19, 0x55, 0x0e, 0x0c, 0x76, 0x7e, 0x1f, 0x60, 0x54, 0x92,
+	0xd9, 0x08, 0x69, 0xf7, 0x26, 0x1c, 0x18, 0x2e, 0x6e, 0x5a, 0x96, 0xf7, 0xd1, 0xb4, 0x3c, 0x25,
+	0x93, 0x71, 0x07, 0x0c, 0xd1, 0xcf, 0x61, 0x7a, 0x66, 0xcf, 0x23, 0x91, 0xfe, 0x4c, 0xee, 0x83,
+	0x59, 0x42, 0xcc, 0x4b, 0xd1, 0xc6, 0x58, 0x7d, 0x5f, 0xcc, 0x66, 0x0c, 0x48, 0x3f, 0x37, 0xc8,
+	0x74, 0x1b, 0x1d, 0x93, 0x7e, 0x31, 0x0a, 0xd7, 0x90, 0x42, 0x29, 0xa6, 0xb0, 0x3c, 0x30, 0xf9,
+	0x84, 0xc8, 0x8d, 0x9b, 0xbf, 0x3f, 0x9f, 0x37, 0xfe, 0x7c, 0x3e, 0x6f, 0xfc, 0xfd, 0x7c, 0xde,
+	0xf8, 0xe0, 0xf5, 0xfd, 0xfd, 0x03, 0xe6, 0xe2, 0x0f, 0xeb, 0x34, 0xc3, 0xd6, 0x66, 0x0e, 0xff,
+	0xac, 0xba, 0xfa, 0xdf, 0x00, 0x3c, 0x2b, 0x5b, 0x1b, 0x91, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2190,6 +2200,16 @@ func (m *RepoCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SkipValidation {
+		i--
+		if m.SkipValidation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.CredsOnly {
 		i--
 		if m.CredsOnly {
@@ -2511,6 +2531,9 @@ func (m *RepoCreateRequest) Size() (n int) {
 	if m.CredsOnly {
 		n += 2
 	}
+	if m.SkipValidation {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4035,6 +4058,26 @@ func (m *RepoCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CredsOnly = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipValidation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipValidation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
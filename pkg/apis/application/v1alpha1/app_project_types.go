@@ -2,26 +2,33 @@ package v1alpha1
 
 import (
 	"fmt"
+	"net/url"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	globutil "github.com/gobwas/glob"
+	"github.com/google/cel-go/cel"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/lru"
 
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 )
 
 const (
-	// serviceAccountDisallowedCharSet contains the characters that are not allowed to be present
-	// in a DefaultServiceAccount configured for a DestinationServiceAccount
-	serviceAccountDisallowedCharSet = "!*[]{}\\/"
+	// ServiceAccountDisallowedCharSet contains the characters that are not allowed to be present
+	// in a DefaultServiceAccount configured for a DestinationServiceAccount, or in the destination service account of
+	// an application
+	ServiceAccountDisallowedCharSet = "!*[]{}\\/"
 )
 
 type ErrApplicationNotAllowedToUseProject struct {
@@ -200,6 +207,17 @@ func (proj *AppProject) ValidateProject() error {
 		destKeys[key] = true
 	}
 
+	if proj.Spec.DestinationExpression != "" {
+		if _, err := compileProjectExpression(destinationExpressionEnv(), proj.Spec.DestinationExpression); err != nil {
+			return status.Errorf(codes.InvalidArgument, "destination expression is invalid: %v", err)
+		}
+	}
+	if proj.Spec.SourceRepoExpression != "" {
+		if _, err := compileProjectExpression(sourceRepoExpressionEnv(), proj.Spec.SourceRepoExpression); err != nil {
+			return status.Errorf(codes.InvalidArgument, "source repository expression is invalid: %v", err)
+		}
+	}
+
 	srcNamespaces := make(map[string]bool)
 	for _, ns := range proj.Spec.SourceNamespaces {
 		if _, ok := srcNamespaces[ns]; ok {
@@ -248,6 +266,16 @@ func (proj *AppProject) ValidateProject() error {
 			}
 			existingGroups[group] = true
 		}
+		expiringGroups := make(map[string]bool)
+		for _, expiration := range role.GroupExpirations {
+			if !existingGroups[expiration.Group] {
+				return status.Errorf(codes.InvalidArgument, "expiration of group '%s' does not match a group of role '%s'", expiration.Group, role.Name)
+			}
+			if expiringGroups[expiration.Group] {
+				return status.Errorf(codes.AlreadyExists, "expiration of group '%s' already exists for role '%s'", expiration.Group, role.Name)
+			}
+			expiringGroups[expiration.Group] = true
+		}
 		roleNames[role.Name] = true
 	}
 
@@ -271,6 +299,64 @@ func (proj *AppProject) ValidateProject() error {
 		}
 	}
 
+	if proj.Spec.MaxRefreshInterval != "" {
+		if interval, err := time.ParseDuration(proj.Spec.MaxRefreshInterval); err != nil || interval <= 0 {
+			return status.Errorf(codes.InvalidArgument, "maxRefreshInterval '%s' is not a positive duration", proj.Spec.MaxRefreshInterval)
+		}
+	}
+
+	for _, pattern := range proj.Spec.AllowedLinkURLs {
+		if _, err := globutil.Compile(pattern); err != nil {
+			return status.Errorf(codes.InvalidArgument, "allowed link URL '%s' has an invalid format: %v", pattern, err)
+		}
+	}
+
+	for _, pattern := range proj.Spec.ApplicationSetTargetProjects {
+		if pattern == "" {
+			return status.Errorf(codes.InvalidArgument, "ApplicationSet target project cannot be empty")
+		}
+		if _, err := globutil.Compile(pattern); err != nil {
+			return status.Errorf(codes.InvalidArgument, "ApplicationSet target project '%s' has an invalid format: %v", pattern, err)
+		}
+	}
+
+	for _, pattern := range proj.Spec.HelmPostRenderers {
+		if pattern == "" {
+			return status.Errorf(codes.InvalidArgument, "helm post-renderer cannot be empty")
+		}
+		if _, err := globutil.Compile(pattern); err != nil {
+			return status.Errorf(codes.InvalidArgument, "helm post-renderer '%s' has an invalid format: %v", pattern, err)
+		}
+		if pattern != "kustomize" && !strings.HasPrefix(pattern, "plugin:") && !strings.HasPrefix(pattern, "transformer:") &&
+			!strings.ContainsAny(pattern, "*?[{") {
+			return status.Errorf(codes.InvalidArgument, "helm post-renderer '%s' must be kustomize, plugin:<name> or transformer:<name>", pattern)
+		}
+	}
+
+	for i, policy := range proj.Spec.SyncOptionsPolicies {
+		if err := policy.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "sync options policy %d is invalid: %v", i, err)
+		}
+	}
+
+	for i, rule := range proj.Spec.ImageRegistryRewriteRules {
+		if err := rule.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "image registry rewrite rule %d is invalid: %v", i, err)
+		}
+	}
+
+	if proj.Spec.MetadataPropagationPolicy != nil {
+		if err := proj.Spec.MetadataPropagationPolicy.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "metadata propagation policy is invalid: %v", err)
+		}
+	}
+
+	if proj.Spec.NotificationAllowlist != nil {
+		if err := proj.Spec.NotificationAllowlist.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "notification allowlist is invalid: %v", err)
+		}
+	}
+
 	destServiceAccts := make(map[string]bool)
 	for _, destServiceAcct := range proj.Spec.DestinationServiceAccounts {
 		if strings.Contains(destServiceAcct.Server, "!") {
@@ -282,7 +368,7 @@ func (proj *AppProject) ValidateProject() error {
 		}
 
 		if strings.Trim(destServiceAcct.DefaultServiceAccount, " ") == "" ||
-			strings.ContainsAny(destServiceAcct.DefaultServiceAccount, serviceAccountDisallowedCharSet) {
+			strings.ContainsAny(destServiceAcct.DefaultServiceAccount, ServiceAccountDisallowedCharSet) {
 			return status.Errorf(codes.InvalidArgument, "defaultServiceAccount has an invalid format, '%s'", destServiceAcct.DefaultServiceAccount)
 		}
 
@@ -308,18 +394,36 @@ func (proj *AppProject) ValidateProject() error {
 
 // AddGroupToRole adds an OIDC group to a role
 func (proj *AppProject) AddGroupToRole(roleName, group string) (bool, error) {
+	return proj.AddGroupToRoleWithExpiry(roleName, group, nil)
+}
+
+// AddGroupToRoleWithExpiry adds an OIDC group to a role, bound until the given time unless it is nil. The expiry time
+// of a group already bound to the role is updated unless the given time is nil.
+func (proj *AppProject) AddGroupToRoleWithExpiry(roleName, group string, expiresAt *metav1.Time) (bool, error) {
 	role, roleIndex, err := proj.GetRoleByName(roleName)
 	if err != nil {
 		return false, err
 	}
-	for _, roleGroup := range role.Groups {
-		if group == roleGroup {
-			return false, nil
+	updated := false
+	if !slices.Contains(role.Groups, group) {
+		role.Groups = append(role.Groups, group)
+		updated = true
+	}
+	if expiresAt != nil {
+		i := slices.IndexFunc(role.GroupExpirations, func(expiration GroupExpiration) bool {
+			return expiration.Group == group
+		})
+		switch {
+		case i < 0:
+			role.GroupExpirations = append(role.GroupExpirations, GroupExpiration{Group: group, ExpiresAt: *expiresAt})
+			updated = true
+		case !role.GroupExpirations[i].ExpiresAt.Equal(expiresAt):
+			role.GroupExpirations[i].ExpiresAt = *expiresAt
+			updated = true
 		}
 	}
-	role.Groups = append(role.Groups, group)
 	proj.Spec.Roles[roleIndex] = *role
-	return true, nil
+	return updated, nil
 }
 
 // RemoveGroupFromRole removes an OIDC group from a role
@@ -331,6 +435,9 @@ func (proj *AppProject) RemoveGroupFromRole(roleName, group string) (bool, error
 	for i, roleGroup := range role.Groups {
 		if group == roleGroup {
 			role.Groups = append(role.Groups[:i], role.Groups[i+1:]...)
+			role.GroupExpirations = slices.DeleteFunc(role.GroupExpirations, func(expiration GroupExpiration) bool {
+				return expiration.Group == group
+			})
 			proj.Spec.Roles[roleIndex] = *role
 			return true, nil
 		}
@@ -338,6 +445,31 @@ func (proj *AppProject) RemoveGroupFromRole(roleName, group string) (bool, error
 	return false, nil
 }
 
+// IsGroupExpired returns whether the binding of the given group to the role has expired at the given time
+func (role ProjectRole) IsGroupExpired(group string, now time.Time) bool {
+	for _, expiration := range role.GroupExpirations {
+		if expiration.Group == group {
+			return !now.Before(expiration.ExpiresAt.Time)
+		}
+	}
+	return false
+}
+
+// GroupExpirationsBefore returns the expiry times of the bindings of the groups to the role which expire before the
+// given time, including the expired bindings, soonest first
+func (role ProjectRole) GroupExpirationsBefore(deadline time.Time) []GroupExpiration {
+	var expirations []GroupExpiration
+	for _, expiration := range role.GroupExpirations {
+		if slices.Contains(role.Groups, expiration.Group) && expiration.ExpiresAt.Time.Before(deadline) {
+			expirations = append(expirations, expiration)
+		}
+	}
+	sort.SliceStable(expirations, func(i, j int) bool {
+		return expirations[i].ExpiresAt.Before(&expirations[j].ExpiresAt)
+	})
+	return expirations
+}
+
 // NormalizePolicies normalizes the policies in the project
 func (proj *AppProject) NormalizePolicies() {
 	for i, role := range proj.Spec.Roles {
@@ -362,14 +494,19 @@ func (proj *AppProject) normalizePolicy(policy string) string {
 	return normalizedPolicy
 }
 
-// ProjectPoliciesString returns a Casbin formatted string of a project's policies for each role
+// ProjectPoliciesString returns a Casbin formatted string of a project's policies for each role. The groups whose
+// bindings to the roles have expired are left out.
 func (proj *AppProject) ProjectPoliciesString() string {
 	var policies []string
+	now := time.Now()
 	for _, role := range proj.Spec.Roles {
 		projectPolicy := fmt.Sprintf("p, proj:%s:%s, projects, get, %s, allow", proj.Name, role.Name, proj.Name)
 		policies = append(policies, projectPolicy)
 		policies = append(policies, role.Policies...)
 		for _, groupName := range role.Groups {
+			if role.IsGroupExpired(groupName, now) {
+				continue
+			}
 			policies = append(policies, fmt.Sprintf("g, %s, proj:%s:%s", groupName, proj.Name, role.Name))
 		}
 	}
@@ -456,7 +593,12 @@ func (proj AppProject) IsSourcePermitted(src ApplicationSource) bool {
 		}
 	}
 
-	return anySourceMatched
+	if !anySourceMatched {
+		return proj.evalExpression(sourceRepoExpressionEnv(), proj.Spec.SourceRepoExpression, map[string]any{
+			"source": map[string]string{"repoURL": src.RepoURL},
+		})
+	}
+	return true
 }
 
 // IsDestinationPermitted validates if the provided application's destination is one of the allowed destinations for the project
@@ -503,7 +645,90 @@ func (proj AppProject) isDestinationMatched(dst ApplicationDestination) bool {
 		}
 	}
 
-	return anyDestinationMatched
+	if !anyDestinationMatched {
+		return proj.evalExpression(destinationExpressionEnv(), proj.Spec.DestinationExpression, map[string]any{
+			"destination": map[string]string{"server": dst.Server, "name": dst.Name, "namespace": dst.Namespace},
+		})
+	}
+	return true
+}
+
+// projectExpressionsCacheSize is the maximum number of compiled expressions of the AppProjects kept in the cache
+const projectExpressionsCacheSize = 1000
+
+var (
+	// destinationExpressionEnv is the CEL environment of the destination expressions of the AppProjects
+	destinationExpressionEnv = sync.OnceValue(func() *cel.Env {
+		return newProjectExpressionEnv(cel.Variable("destination", cel.MapType(cel.StringType, cel.StringType)))
+	})
+	// sourceRepoExpressionEnv is the CEL environment of the source repository expressions of the AppProjects
+	sourceRepoExpressionEnv = sync.OnceValue(func() *cel.Env {
+		return newProjectExpressionEnv(cel.Variable("source", cel.MapType(cel.StringType, cel.StringType)))
+	})
+	// projectExpressions caches the compiled expressions of the AppProjects, by environment and expression, as they are
+	// evaluated for every application of the projects
+	projectExpressions = lru.New(projectExpressionsCacheSize)
+)
+
+type projectExpressionKey struct {
+	env        *cel.Env
+	expression string
+}
+
+// newProjectExpressionEnv returns a CEL environment with the given variables, and the project variable with the name
+// and labels of the project
+func newProjectExpressionEnv(variables ...cel.EnvOption) *cel.Env {
+	env, err := cel.NewEnv(append(variables, cel.Variable("project", cel.MapType(cel.StringType, cel.DynType)))...)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create the CEL environment of the project expressions: %v", err))
+	}
+	return env
+}
+
+func compileProjectExpression(env *cel.Env, expression string) (cel.Program, error) {
+	key := projectExpressionKey{env: env, expression: expression}
+	if program, ok := projectExpressions.Get(key); ok {
+		return program.(cel.Program), nil
+	}
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if !ast.OutputType().IsExactType(cel.BoolType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression must evaluate to a bool, not %s", ast.OutputType())
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	projectExpressions.Add(key, program)
+	return program, nil
+}
+
+// evalExpression evaluates the given expression of the project with the given variables, and the project variable with
+// the name and labels of the project. It returns false if the expression is empty, invalid or fails to evaluate.
+func (proj AppProject) evalExpression(env *cel.Env, expression string, vars map[string]any) bool {
+	if strings.TrimSpace(expression) == "" {
+		return false
+	}
+	program, err := compileProjectExpression(env, expression)
+	if err != nil {
+		return false
+	}
+	labels := proj.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	vars["project"] = map[string]any{
+		"name":   proj.Name,
+		"labels": labels,
+	}
+	out, _, err := program.Eval(vars)
+	if err != nil {
+		return false
+	}
+	result, _ := out.Value().(bool)
+	return result
 }
 
 func isDenyPattern(pattern string) bool {
@@ -608,3 +833,29 @@ func (proj AppProject) IsAppNamespacePermitted(app *Application, controllerNs st
 
 	return glob.MatchStringInList(proj.Spec.SourceNamespaces, app.Namespace, glob.REGEXP)
 }
+
+// IsApplicationSetTargetProjectPermitted checks whether the applications generated by the ApplicationSets of this
+// AppProject may belong to the given project, according to the applicationSetTargetProjects of the AppProject. The
+// AppProject itself is always permitted.
+func (proj AppProject) IsApplicationSetTargetProjectPermitted(project string) bool {
+	if project == proj.Name {
+		return true
+	}
+	return glob.MatchStringInList(proj.Spec.ApplicationSetTargetProjects, project, glob.GLOB)
+}
+
+// IsHelmPostRendererPermitted checks whether the applications of this AppProject may use the given helm post-render
+// step, according to the helmPostRenderers of the AppProject.
+func (proj AppProject) IsHelmPostRendererPermitted(renderer HelmPostRenderer) bool {
+	return glob.MatchStringInList(proj.Spec.HelmPostRenderers, renderer.Name(), glob.GLOB)
+}
+
+// IsLinkURLPermitted checks whether the link annotations of the applications of this AppProject may point to the given
+// URL, according to the allowed link URLs of the AppProject. Only the absolute http and https URLs are permitted.
+func (proj AppProject) IsLinkURLPermitted(linkURL string) bool {
+	u, err := url.Parse(linkURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return false
+	}
+	return len(proj.Spec.AllowedLinkURLs) == 0 || glob.MatchStringInList(proj.Spec.AllowedLinkURLs, linkURL, glob.GLOB)
+}
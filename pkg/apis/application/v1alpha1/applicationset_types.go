@@ -55,7 +55,15 @@ type ApplicationSet struct {
 
 // RBACName formats fully qualified application name for RBAC check.
 func (a *ApplicationSet) RBACName(defaultNS string) string {
-	return security.RBACName(defaultNS, a.Spec.Template.Spec.GetProject(), a.Namespace, a.Name)
+	return security.RBACName(defaultNS, a.GetProject(), a.Namespace, a.Name)
+}
+
+// GetProject returns the project of the ApplicationSet, i.e. its spec.project, if any, or the project of its template.
+func (a *ApplicationSet) GetProject() string {
+	if a.Spec.Project != "" {
+		return a.Spec.Project
+	}
+	return a.Spec.Template.Spec.GetProject()
 }
 
 // ApplicationSetSpec represents a class of application set state.
@@ -73,6 +81,37 @@ type ApplicationSetSpec struct {
 	ApplyNestedSelectors         bool                            `json:"applyNestedSelectors,omitempty" protobuf:"bytes,8,name=applyNestedSelectors"`
 	IgnoreApplicationDifferences ApplicationSetIgnoreDifferences `json:"ignoreApplicationDifferences,omitempty" protobuf:"bytes,9,name=ignoreApplicationDifferences"`
 	TemplatePatch                *string                         `json:"templatePatch,omitempty" protobuf:"bytes,10,name=templatePatch"`
+	// ParamsSchema declares the parameters of the template. The parameters generated for each element are validated and
+	// coerced against the schema before the template is rendered.
+	ParamsSchema []ApplicationSetParam `json:"paramsSchema,omitempty" protobuf:"bytes,11,rep,name=paramsSchema"`
+	// Project is the project of the ApplicationSet when its template has a templated project. The projects of the generated
+	// applications must then be allowed by the applicationSetTargetProjects of this project.
+	Project string `json:"project,omitempty" protobuf:"bytes,12,opt,name=project"`
+}
+
+// ApplicationSetParamType is the type of a parameter of the template of an ApplicationSet
+type ApplicationSetParamType string
+
+// Types of the parameters of the template of an ApplicationSet
+const (
+	ApplicationSetParamTypeString  ApplicationSetParamType = "string"
+	ApplicationSetParamTypeInteger ApplicationSetParamType = "integer"
+	ApplicationSetParamTypeNumber  ApplicationSetParamType = "number"
+	ApplicationSetParamTypeBoolean ApplicationSetParamType = "boolean"
+)
+
+// ApplicationSetParam declares a parameter of the template of an ApplicationSet
+type ApplicationSetParam struct {
+	// Name is the name of the parameter. The names of the nested parameters of Go templates are separated by dots, e.g. path.basename
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Type is the type the value of the parameter is coerced to. Possible values are string, integer, number and boolean. The value is not coerced if no type is defined
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=string;integer;number;boolean
+	Type ApplicationSetParamType `json:"type,omitempty" protobuf:"bytes,2,opt,name=type,casttype=ApplicationSetParamType"`
+	// Default is the value of the parameter when it is not generated, coerced to the type of the parameter
+	Default *string `json:"default,omitempty" protobuf:"bytes,3,opt,name=default"`
+	// Required is whether the parameter must be generated when it has no default
+	Required bool `json:"required,omitempty" protobuf:"varint,4,opt,name=required"`
 }
 
 type ApplicationPreservedFields struct {
@@ -140,14 +179,33 @@ type ApplicationSetSyncPolicy struct {
 // applications when applying changes from generated applications.
 type ApplicationSetIgnoreDifferences []ApplicationSetResourceIgnoreDifferences
 
+// ToApplicationIgnoreDifferences returns the rules ignoring the differences, i.e. the rules whose merge mode is ignore
 func (a ApplicationSetIgnoreDifferences) ToApplicationIgnoreDifferences() []ResourceIgnoreDifferences {
 	var result []ResourceIgnoreDifferences
 	for _, item := range a {
+		if item.MergeMode.PreservesUserValue() {
+			continue
+		}
 		result = append(result, item.ToApplicationResourceIgnoreDifferences())
 	}
 	return result
 }
 
+// ApplicationSetIgnoreDifferencesMergeMode is how the differences of the fields of an ignore rule are merged
+// "ignore" means the differences are ignored: the fields keep the value of the Application once it is created
+// "preserve-user-value" means the changes of the template are applied to the fields, unless they were changed on the Application
+// If no ApplicationSetIgnoreDifferencesMergeMode is defined, it defaults it to ignore
+type ApplicationSetIgnoreDifferencesMergeMode string
+
+const (
+	ApplicationSetIgnoreDifferencesMergeModeIgnore            ApplicationSetIgnoreDifferencesMergeMode = "ignore"
+	ApplicationSetIgnoreDifferencesMergeModePreserveUserValue ApplicationSetIgnoreDifferencesMergeMode = "preserve-user-value"
+)
+
+func (m ApplicationSetIgnoreDifferencesMergeMode) PreservesUserValue() bool {
+	return m == ApplicationSetIgnoreDifferencesMergeModePreserveUserValue
+}
+
 // ApplicationSetResourceIgnoreDifferences configures how the ApplicationSet controller will ignore differences in live
 // applications when applying changes from generated applications.
 type ApplicationSetResourceIgnoreDifferences struct {
@@ -157,6 +215,12 @@ type ApplicationSetResourceIgnoreDifferences struct {
 	JSONPointers []string `json:"jsonPointers,omitempty" protobuf:"bytes,2,name=jsonPointers"`
 	// JQPathExpressions is a list of JQ path expressions to fields to ignore differences for.
 	JQPathExpressions []string `json:"jqPathExpressions,omitempty" protobuf:"bytes,3,name=jqExpressions"`
+	// JSONPathExpressions is a list of JSONPath expressions to fields to ignore differences for, e.g. {.spec.syncPolicy.automated}.
+	JSONPathExpressions []string `json:"jsonPathExpressions,omitempty" protobuf:"bytes,4,name=jsonPathExpressions"`
+	// MergeMode is how the differences of the fields are merged. Possible values are ignore, the default, and preserve-user-value
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ignore;preserve-user-value
+	MergeMode ApplicationSetIgnoreDifferencesMergeMode `json:"mergeMode,omitempty" protobuf:"bytes,5,opt,name=mergeMode,casttype=ApplicationSetIgnoreDifferencesMergeMode"`
 }
 
 func (a *ApplicationSetResourceIgnoreDifferences) ToApplicationResourceIgnoreDifferences() ResourceIgnoreDifferences {
@@ -200,6 +264,8 @@ type ApplicationSetGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	AWSOrganizations *AWSOrganizationsGenerator `json:"awsOrganizations,omitempty" protobuf:"bytes,11,name=awsOrganizations"`
 }
 
 // ApplicationSetNestedGenerator represents a generator nested within a combination-type generator (MatrixGenerator or
@@ -222,6 +288,8 @@ type ApplicationSetNestedGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	AWSOrganizations *AWSOrganizationsGenerator `json:"awsOrganizations,omitempty" protobuf:"bytes,11,name=awsOrganizations"`
 }
 
 type ApplicationSetNestedGenerators []ApplicationSetNestedGenerator
@@ -241,6 +309,8 @@ type ApplicationSetTerminalGenerator struct {
 
 	// Selector allows to post-filter all generator.
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,8,name=selector"`
+
+	AWSOrganizations *AWSOrganizationsGenerator `json:"awsOrganizations,omitempty" protobuf:"bytes,9,name=awsOrganizations"`
 }
 
 type ApplicationSetTerminalGenerators []ApplicationSetTerminalGenerator
@@ -260,6 +330,7 @@ func (g ApplicationSetTerminalGenerators) toApplicationSetNestedGenerators() []A
 			PullRequest:             terminalGenerator.PullRequest,
 			Plugin:                  terminalGenerator.Plugin,
 			Selector:                terminalGenerator.Selector,
+			AWSOrganizations:        terminalGenerator.AWSOrganizations,
 		}
 	}
 	return nestedGenerators
@@ -330,6 +401,20 @@ type MergeGenerator struct {
 	Generators []ApplicationSetNestedGenerator `json:"generators" protobuf:"bytes,1,name=generators"`
 	MergeKeys  []string                        `json:"mergeKeys" protobuf:"bytes,2,name=mergeKeys"`
 	Template   ApplicationSetTemplate          `json:"template,omitempty" protobuf:"bytes,3,name=template"`
+	// Overlays configures the merge of the parameters of the overlay generators, i.e. of the generators following the
+	// base generator: the first overlay configures the second generator, and so on.
+	Overlays []MergeGeneratorOverlay `json:"overlays,omitempty" protobuf:"bytes,4,rep,name=overlays"`
+}
+
+// MergeGeneratorOverlay configures how the parameter sets of an overlay generator of a MergeGenerator are merged into
+// the parameter sets of the base generator.
+type MergeGeneratorOverlay struct {
+	// PassThrough merges the Defaults into the parameter sets of the base generator which have no matching parameter set
+	// in the overlay. The parameter sets of the base generator lacking any of the merge keys are not matched with the
+	// overlays and do not need to be unique: they are passed through with the defaults too.
+	PassThrough bool `json:"passThrough,omitempty" protobuf:"varint,1,opt,name=passThrough"`
+	// Defaults is an object of the parameters merged into the unmatched parameter sets of the base generator
+	Defaults *apiextensionsv1.JSON `json:"defaults,omitempty" protobuf:"bytes,2,opt,name=defaults"`
 }
 
 // NestedMergeGenerator is a MergeGenerator nested under another combination-type generator (MatrixGenerator or
@@ -342,6 +427,7 @@ type MergeGenerator struct {
 type NestedMergeGenerator struct {
 	Generators ApplicationSetTerminalGenerators `json:"generators" protobuf:"bytes,1,name=generators"`
 	MergeKeys  []string                         `json:"mergeKeys" protobuf:"bytes,2,name=mergeKeys"`
+	Overlays   []MergeGeneratorOverlay          `json:"overlays,omitempty" protobuf:"bytes,3,rep,name=overlays"`
 }
 
 // ToNestedMergeGenerator converts a JSON struct (from the K8s resource) to corresponding
@@ -367,6 +453,7 @@ func (g NestedMergeGenerator) ToMergeGenerator() *MergeGenerator {
 	return &MergeGenerator{
 		Generators: g.Generators.toApplicationSetNestedGenerators(),
 		MergeKeys:  g.MergeKeys,
+		Overlays:   g.Overlays,
 	}
 }
 
@@ -383,6 +470,24 @@ type ClusterGenerator struct {
 
 	// returns the clusters a single 'clusters' value in the template
 	FlatList bool `json:"flatList,omitempty" protobuf:"bytes,4,name=flatList"`
+
+	// LabelTransforms derive labels of the clusters from the values of their labels. The derived labels are matched
+	// by the selector and passed as parameters to the template like the labels of the cluster secrets.
+	LabelTransforms []ClusterLabelTransform `json:"labelTransforms,omitempty" protobuf:"bytes,5,rep,name=labelTransforms"`
+}
+
+// ClusterLabelTransform derives a label of the clusters from the value of another label, e.g. the region of the
+// clusters from their zone
+type ClusterLabelTransform struct {
+	// Label is the key of the label whose value is transformed, e.g. topology.kubernetes.io/zone
+	Label string `json:"label" protobuf:"bytes,1,opt,name=label"`
+	// Regex must match the whole value of the label, (.*) by default. The clusters whose value does not match the
+	// regex, or without the label, do not get the derived label.
+	Regex string `json:"regex,omitempty" protobuf:"bytes,2,opt,name=regex"`
+	// Replacement is the value of the derived label, which can refer to the capture groups of the regex, $1 by default
+	Replacement string `json:"replacement,omitempty" protobuf:"bytes,3,opt,name=replacement"`
+	// TargetLabel is the key of the derived label, e.g. region
+	TargetLabel string `json:"targetLabel" protobuf:"bytes,4,opt,name=targetLabel"`
 }
 
 // DuckType defines a generator to match against clusters registered with ArgoCD.
@@ -554,8 +659,8 @@ type SCMProviderGeneratorAzureDevOps struct {
 	Organization string `json:"organization" protobuf:"bytes,5,opt,name=organization"`
 	// The URL to Azure DevOps. If blank, use https://dev.azure.com.
 	API string `json:"api,omitempty" protobuf:"bytes,6,opt,name=api"`
-	// Azure Devops team project. Required. E.g. "my-team".
-	TeamProject string `json:"teamProject" protobuf:"bytes,7,opt,name=teamProject"`
+	// Azure Devops team project. E.g. "my-team". If blank, the repositories of all the team projects of the organization are discovered.
+	TeamProject string `json:"teamProject,omitempty" protobuf:"bytes,7,opt,name=teamProject"`
 	// The Personal Access Token (PAT) to use when connecting. Required.
 	AccessTokenRef *SecretRef `json:"accessTokenRef" protobuf:"bytes,8,opt,name=accessTokenRef"`
 	// Scan all branches instead of just the default branch.
@@ -795,6 +900,24 @@ type PluginGenerator struct {
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,5,name=values"`
 }
 
+// AWSOrganizationsGenerator defines a generator listing the accounts of an AWS organization.
+type AWSOrganizationsGenerator struct {
+	// TagFilters provides the tag filter(s) of the accounts to list.
+	// If not provided, all the active accounts of the organization are listed.
+	TagFilters []*TagFilter `json:"tagFilters,omitempty" protobuf:"bytes,1,rep,name=tagFilters"`
+	// Role provides the AWS IAM role to assume to list the accounts, e.g. a role of the management account of the organization.
+	// if not provided, AppSet controller will use its pod/node identity to list the accounts.
+	Role string `json:"role,omitempty" protobuf:"bytes,2,opt,name=role"`
+	// Region provides the AWS region of the AWS Organizations endpoint, e.g. us-gov-west-1 for the AWS GovCloud (US) partition.
+	// if not provided, AppSet controller will infer the current region from environment.
+	Region string `json:"region,omitempty" protobuf:"bytes,3,opt,name=region"`
+	// RequeueAfterSeconds determines how long the ApplicationSet controller will wait before reconciling the ApplicationSet again.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,4,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,5,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,6,name=values"`
+}
+
 // ApplicationSetStatus defines the observed state of ApplicationSet
 type ApplicationSetStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -856,6 +979,7 @@ const (
 	ApplicationSetReasonUpdateApplicationError           = "UpdateApplicationError"
 	ApplicationSetReasonApplicationParamsGenerationError = "ApplicationGenerationFromParamsError"
 	ApplicationSetReasonRenderTemplateParamsError        = "RenderTemplateParamsError"
+	ApplicationSetReasonParamsSchemaValidationError      = "ParamsSchemaValidationError"
 	ApplicationSetReasonCreateApplicationError           = "CreateApplicationError"
 	ApplicationSetReasonDeleteApplicationError           = "DeleteApplicationError"
 	ApplicationSetReasonRefreshApplicationError          = "RefreshApplicationError"
@@ -72,6 +72,22 @@ func TestApplicationSetRBACName(t *testing.T) {
 		a.Spec.Template.Spec.Project = "test"
 		assert.Equal(t, "test/test-appset", a.RBACName("argocd"))
 	})
+
+	t.Run("Test RBAC name with project", func(t *testing.T) {
+		a := newTestAppSet("test-appset", "argocd", testRepo)
+		a.Spec.Template.Spec.Project = "{{.project}}"
+		a.Spec.Project = "platform"
+		assert.Equal(t, "platform/test-appset", a.RBACName("argocd"))
+	})
+}
+
+func TestApplicationSetGetProject(t *testing.T) {
+	a := newTestAppSet("test-appset", "argocd", "https://github.com/org/repo")
+	assert.Equal(t, "default", a.GetProject())
+	a.Spec.Template.Spec.Project = "test"
+	assert.Equal(t, "test", a.GetProject())
+	a.Spec.Project = "platform"
+	assert.Equal(t, "platform", a.GetProject())
 }
 
 func TestApplicationSetSetConditions(t *testing.T) {
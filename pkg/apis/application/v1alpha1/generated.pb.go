@@ -67,10 +67,38 @@ func (m *AWSAuthConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AWSAuthConfig proto.InternalMessageInfo
 
+func (m *AWSOrganizationsGenerator) Reset()      { *m = AWSOrganizationsGenerator{} }
+func (*AWSOrganizationsGenerator) ProtoMessage() {}
+func (*AWSOrganizationsGenerator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{1}
+}
+func (m *AWSOrganizationsGenerator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AWSOrganizationsGenerator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AWSOrganizationsGenerator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AWSOrganizationsGenerator.Merge(m, src)
+}
+func (m *AWSOrganizationsGenerator) XXX_Size() int {
+	return m.Size()
+}
+func (m *AWSOrganizationsGenerator) XXX_DiscardUnknown() {
+	xxx_messageInfo_AWSOrganizationsGenerator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AWSOrganizationsGenerator proto.InternalMessageInfo
+
 func (m *AppHealthStatus) Reset()      { *m = AppHealthStatus{} }
 func (*AppHealthStatus) ProtoMessage() {}
 func (*AppHealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{1}
+	return fileDescriptor_c078c3c476799f44, []int{2}
 }
 func (m *AppHealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -98,7 +126,7 @@ var xxx_messageInfo_AppHealthStatus proto.InternalMessageInfo
 func (m *AppProject) Reset()      { *m = AppProject{} }
 func (*AppProject) ProtoMessage() {}
 func (*AppProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{2}
+	return fileDescriptor_c078c3c476799f44, []int{3}
 }
 func (m *AppProject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +154,7 @@ var xxx_messageInfo_AppProject proto.InternalMessageInfo
 func (m *AppProjectList) Reset()      { *m = AppProjectList{} }
 func (*AppProjectList) ProtoMessage() {}
 func (*AppProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{3}
+	return fileDescriptor_c078c3c476799f44, []int{4}
 }
 func (m *AppProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -154,7 +182,7 @@ var xxx_messageInfo_AppProjectList proto.InternalMessageInfo
 func (m *AppProjectSpec) Reset()      { *m = AppProjectSpec{} }
 func (*AppProjectSpec) ProtoMessage() {}
 func (*AppProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{4}
+	return fileDescriptor_c078c3c476799f44, []int{5}
 }
 func (m *AppProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,7 +210,7 @@ var xxx_messageInfo_AppProjectSpec proto.InternalMessageInfo
 func (m *AppProjectStatus) Reset()      { *m = AppProjectStatus{} }
 func (*AppProjectStatus) ProtoMessage() {}
 func (*AppProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{5}
+	return fileDescriptor_c078c3c476799f44, []int{6}
 }
 func (m *AppProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +238,7 @@ var xxx_messageInfo_AppProjectStatus proto.InternalMessageInfo
 func (m *Application) Reset()      { *m = Application{} }
 func (*Application) ProtoMessage() {}
 func (*Application) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{6}
+	return fileDescriptor_c078c3c476799f44, []int{7}
 }
 func (m *Application) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,7 +266,7 @@ var xxx_messageInfo_Application proto.InternalMessageInfo
 func (m *ApplicationCondition) Reset()      { *m = ApplicationCondition{} }
 func (*ApplicationCondition) ProtoMessage() {}
 func (*ApplicationCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{7}
+	return fileDescriptor_c078c3c476799f44, []int{8}
 }
 func (m *ApplicationCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +294,7 @@ var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{8}
+	return fileDescriptor_c078c3c476799f44, []int{9}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +322,7 @@ var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
 func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
 func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{9}
+	return fileDescriptor_c078c3c476799f44, []int{10}
 }
 func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,10 +347,38 @@ func (m *ApplicationDestinationServiceAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageInfo
 
+func (m *ApplicationFreeze) Reset()      { *m = ApplicationFreeze{} }
+func (*ApplicationFreeze) ProtoMessage() {}
+func (*ApplicationFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{11}
+}
+func (m *ApplicationFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationFreeze.Merge(m, src)
+}
+func (m *ApplicationFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationFreeze proto.InternalMessageInfo
+
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{10}
+	return fileDescriptor_c078c3c476799f44, []int{12}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +406,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationMatchExpression) Reset()      { *m = ApplicationMatchExpression{} }
 func (*ApplicationMatchExpression) ProtoMessage() {}
 func (*ApplicationMatchExpression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{11}
+	return fileDescriptor_c078c3c476799f44, []int{13}
 }
 func (m *ApplicationMatchExpression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +434,7 @@ var xxx_messageInfo_ApplicationMatchExpression proto.InternalMessageInfo
 func (m *ApplicationPreservedFields) Reset()      { *m = ApplicationPreservedFields{} }
 func (*ApplicationPreservedFields) ProtoMessage() {}
 func (*ApplicationPreservedFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{12}
+	return fileDescriptor_c078c3c476799f44, []int{14}
 }
 func (m *ApplicationPreservedFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +462,7 @@ var xxx_messageInfo_ApplicationPreservedFields proto.InternalMessageInfo
 func (m *ApplicationSet) Reset()      { *m = ApplicationSet{} }
 func (*ApplicationSet) ProtoMessage() {}
 func (*ApplicationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{13}
+	return fileDescriptor_c078c3c476799f44, []int{15}
 }
 func (m *ApplicationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +490,7 @@ var xxx_messageInfo_ApplicationSet proto.InternalMessageInfo
 func (m *ApplicationSetApplicationStatus) Reset()      { *m = ApplicationSetApplicationStatus{} }
 func (*ApplicationSetApplicationStatus) ProtoMessage() {}
 func (*ApplicationSetApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{14}
+	return fileDescriptor_c078c3c476799f44, []int{16}
 }
 func (m *ApplicationSetApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +518,7 @@ var xxx_messageInfo_ApplicationSetApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSetCondition) Reset()      { *m = ApplicationSetCondition{} }
 func (*ApplicationSetCondition) ProtoMessage() {}
 func (*ApplicationSetCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{15}
+	return fileDescriptor_c078c3c476799f44, []int{17}
 }
 func (m *ApplicationSetCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +546,7 @@ var xxx_messageInfo_ApplicationSetCondition proto.InternalMessageInfo
 func (m *ApplicationSetGenerator) Reset()      { *m = ApplicationSetGenerator{} }
 func (*ApplicationSetGenerator) ProtoMessage() {}
 func (*ApplicationSetGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{16}
+	return fileDescriptor_c078c3c476799f44, []int{18}
 }
 func (m *ApplicationSetGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +574,7 @@ var xxx_messageInfo_ApplicationSetGenerator proto.InternalMessageInfo
 func (m *ApplicationSetList) Reset()      { *m = ApplicationSetList{} }
 func (*ApplicationSetList) ProtoMessage() {}
 func (*ApplicationSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{17}
+	return fileDescriptor_c078c3c476799f44, []int{19}
 }
 func (m *ApplicationSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +602,7 @@ var xxx_messageInfo_ApplicationSetList proto.InternalMessageInfo
 func (m *ApplicationSetNestedGenerator) Reset()      { *m = ApplicationSetNestedGenerator{} }
 func (*ApplicationSetNestedGenerator) ProtoMessage() {}
 func (*ApplicationSetNestedGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{18}
+	return fileDescriptor_c078c3c476799f44, []int{20}
 }
 func (m *ApplicationSetNestedGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,12 +627,40 @@ func (m *ApplicationSetNestedGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetNestedGenerator proto.InternalMessageInfo
 
+func (m *ApplicationSetParam) Reset()      { *m = ApplicationSetParam{} }
+func (*ApplicationSetParam) ProtoMessage() {}
+func (*ApplicationSetParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{21}
+}
+func (m *ApplicationSetParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetParam.Merge(m, src)
+}
+func (m *ApplicationSetParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetParam proto.InternalMessageInfo
+
 func (m *ApplicationSetResourceIgnoreDifferences) Reset() {
 	*m = ApplicationSetResourceIgnoreDifferences{}
 }
 func (*ApplicationSetResourceIgnoreDifferences) ProtoMessage() {}
 func (*ApplicationSetResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{19}
+	return fileDescriptor_c078c3c476799f44, []int{22}
 }
 func (m *ApplicationSetResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +688,7 @@ var xxx_messageInfo_ApplicationSetResourceIgnoreDifferences proto.InternalMessag
 func (m *ApplicationSetRolloutStep) Reset()      { *m = ApplicationSetRolloutStep{} }
 func (*ApplicationSetRolloutStep) ProtoMessage() {}
 func (*ApplicationSetRolloutStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{20}
+	return fileDescriptor_c078c3c476799f44, []int{23}
 }
 func (m *ApplicationSetRolloutStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +716,7 @@ var xxx_messageInfo_ApplicationSetRolloutStep proto.InternalMessageInfo
 func (m *ApplicationSetRolloutStrategy) Reset()      { *m = ApplicationSetRolloutStrategy{} }
 func (*ApplicationSetRolloutStrategy) ProtoMessage() {}
 func (*ApplicationSetRolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{21}
+	return fileDescriptor_c078c3c476799f44, []int{24}
 }
 func (m *ApplicationSetRolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +744,7 @@ var xxx_messageInfo_ApplicationSetRolloutStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSpec) Reset()      { *m = ApplicationSetSpec{} }
 func (*ApplicationSetSpec) ProtoMessage() {}
 func (*ApplicationSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{22}
+	return fileDescriptor_c078c3c476799f44, []int{25}
 }
 func (m *ApplicationSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +772,7 @@ var xxx_messageInfo_ApplicationSetSpec proto.InternalMessageInfo
 func (m *ApplicationSetStatus) Reset()      { *m = ApplicationSetStatus{} }
 func (*ApplicationSetStatus) ProtoMessage() {}
 func (*ApplicationSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{23}
+	return fileDescriptor_c078c3c476799f44, []int{26}
 }
 func (m *ApplicationSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +800,7 @@ var xxx_messageInfo_ApplicationSetStatus proto.InternalMessageInfo
 func (m *ApplicationSetStrategy) Reset()      { *m = ApplicationSetStrategy{} }
 func (*ApplicationSetStrategy) ProtoMessage() {}
 func (*ApplicationSetStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{24}
+	return fileDescriptor_c078c3c476799f44, []int{27}
 }
 func (m *ApplicationSetStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +828,7 @@ var xxx_messageInfo_ApplicationSetStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSyncPolicy) Reset()      { *m = ApplicationSetSyncPolicy{} }
 func (*ApplicationSetSyncPolicy) ProtoMessage() {}
 func (*ApplicationSetSyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{25}
+	return fileDescriptor_c078c3c476799f44, []int{28}
 }
 func (m *ApplicationSetSyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +856,7 @@ var xxx_messageInfo_ApplicationSetSyncPolicy proto.InternalMessageInfo
 func (m *ApplicationSetTemplate) Reset()      { *m = ApplicationSetTemplate{} }
 func (*ApplicationSetTemplate) ProtoMessage() {}
 func (*ApplicationSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{26}
+	return fileDescriptor_c078c3c476799f44, []int{29}
 }
 func (m *ApplicationSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +884,7 @@ var xxx_messageInfo_ApplicationSetTemplate proto.InternalMessageInfo
 func (m *ApplicationSetTemplateMeta) Reset()      { *m = ApplicationSetTemplateMeta{} }
 func (*ApplicationSetTemplateMeta) ProtoMessage() {}
 func (*ApplicationSetTemplateMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{27}
+	return fileDescriptor_c078c3c476799f44, []int{30}
 }
 func (m *ApplicationSetTemplateMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +912,7 @@ var xxx_messageInfo_ApplicationSetTemplateMeta proto.InternalMessageInfo
 func (m *ApplicationSetTerminalGenerator) Reset()      { *m = ApplicationSetTerminalGenerator{} }
 func (*ApplicationSetTerminalGenerator) ProtoMessage() {}
 func (*ApplicationSetTerminalGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{28}
+	return fileDescriptor_c078c3c476799f44, []int{31}
 }
 func (m *ApplicationSetTerminalGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +940,7 @@ var xxx_messageInfo_ApplicationSetTerminalGenerator proto.InternalMessageInfo
 func (m *ApplicationSetTree) Reset()      { *m = ApplicationSetTree{} }
 func (*ApplicationSetTree) ProtoMessage() {}
 func (*ApplicationSetTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{29}
+	return fileDescriptor_c078c3c476799f44, []int{32}
 }
 func (m *ApplicationSetTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +968,7 @@ var xxx_messageInfo_ApplicationSetTree proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{30}
+	return fileDescriptor_c078c3c476799f44, []int{33}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +996,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{31}
+	return fileDescriptor_c078c3c476799f44, []int{34}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +1024,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{32}
+	return fileDescriptor_c078c3c476799f44, []int{35}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1052,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{33}
+	return fileDescriptor_c078c3c476799f44, []int{36}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1080,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{34}
+	return fileDescriptor_c078c3c476799f44, []int{37}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1108,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{35}
+	return fileDescriptor_c078c3c476799f44, []int{38}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1136,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
 func (*ApplicationSourcePluginParameter) ProtoMessage() {}
 func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{36}
+	return fileDescriptor_c078c3c476799f44, []int{39}
 }
 func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1164,7 @@ var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{37}
+	return fileDescriptor_c078c3c476799f44, []int{40}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1192,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{38}
+	return fileDescriptor_c078c3c476799f44, []int{41}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1220,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{39}
+	return fileDescriptor_c078c3c476799f44, []int{42}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1248,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{40}
+	return fileDescriptor_c078c3c476799f44, []int{43}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1276,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{41}
+	return fileDescriptor_c078c3c476799f44, []int{44}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1304,7 @@ var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{42}
+	return fileDescriptor_c078c3c476799f44, []int{45}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1332,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{43}
+	return fileDescriptor_c078c3c476799f44, []int{46}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1360,7 @@ var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 func (m *BearerTokenBitbucket) Reset()      { *m = BearerTokenBitbucket{} }
 func (*BearerTokenBitbucket) ProtoMessage() {}
 func (*BearerTokenBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{44}
+	return fileDescriptor_c078c3c476799f44, []int{47}
 }
 func (m *BearerTokenBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1388,7 @@ var xxx_messageInfo_BearerTokenBitbucket proto.InternalMessageInfo
 func (m *BearerTokenBitbucketCloud) Reset()      { *m = BearerTokenBitbucketCloud{} }
 func (*BearerTokenBitbucketCloud) ProtoMessage() {}
 func (*BearerTokenBitbucketCloud) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{45}
+	return fileDescriptor_c078c3c476799f44, []int{48}
 }
 func (m *BearerTokenBitbucketCloud) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1416,7 @@ var xxx_messageInfo_BearerTokenBitbucketCloud proto.InternalMessageInfo
 func (m *ChartDetails) Reset()      { *m = ChartDetails{} }
 func (*ChartDetails) ProtoMessage() {}
 func (*ChartDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{46}
+	return fileDescriptor_c078c3c476799f44, []int{49}
 }
 func (m *ChartDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1444,7 @@ var xxx_messageInfo_ChartDetails proto.InternalMessageInfo
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{47}
+	return fileDescriptor_c078c3c476799f44, []int{50}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1472,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{48}
+	return fileDescriptor_c078c3c476799f44, []int{51}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1500,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{49}
+	return fileDescriptor_c078c3c476799f44, []int{52}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1441,10 +1525,38 @@ func (m *ClusterConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 
+func (m *ClusterConnectivityInfo) Reset()      { *m = ClusterConnectivityInfo{} }
+func (*ClusterConnectivityInfo) ProtoMessage() {}
+func (*ClusterConnectivityInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{53}
+}
+func (m *ClusterConnectivityInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterConnectivityInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterConnectivityInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterConnectivityInfo.Merge(m, src)
+}
+func (m *ClusterConnectivityInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterConnectivityInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterConnectivityInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterConnectivityInfo proto.InternalMessageInfo
+
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{50}
+	return fileDescriptor_c078c3c476799f44, []int{54}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1584,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{51}
+	return fileDescriptor_c078c3c476799f44, []int{55}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1497,10 +1609,38 @@ func (m *ClusterInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 
+func (m *ClusterLabelTransform) Reset()      { *m = ClusterLabelTransform{} }
+func (*ClusterLabelTransform) ProtoMessage() {}
+func (*ClusterLabelTransform) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{56}
+}
+func (m *ClusterLabelTransform) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterLabelTransform) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterLabelTransform) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterLabelTransform.Merge(m, src)
+}
+func (m *ClusterLabelTransform) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterLabelTransform) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterLabelTransform.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterLabelTransform proto.InternalMessageInfo
+
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{52}
+	return fileDescriptor_c078c3c476799f44, []int{57}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1528,7 +1668,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{53}
+	return fileDescriptor_c078c3c476799f44, []int{58}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1696,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *CommitMetadata) Reset()      { *m = CommitMetadata{} }
 func (*CommitMetadata) ProtoMessage() {}
 func (*CommitMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{54}
+	return fileDescriptor_c078c3c476799f44, []int{59}
 }
 func (m *CommitMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1724,7 @@ var xxx_messageInfo_CommitMetadata proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{55}
+	return fileDescriptor_c078c3c476799f44, []int{60}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1752,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{56}
+	return fileDescriptor_c078c3c476799f44, []int{61}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1780,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{57}
+	return fileDescriptor_c078c3c476799f44, []int{62}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +1808,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConfigMapKeyRef) Reset()      { *m = ConfigMapKeyRef{} }
 func (*ConfigMapKeyRef) ProtoMessage() {}
 func (*ConfigMapKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{58}
+	return fileDescriptor_c078c3c476799f44, []int{63}
 }
 func (m *ConfigMapKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +1836,7 @@ var xxx_messageInfo_ConfigMapKeyRef proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{59}
+	return fileDescriptor_c078c3c476799f44, []int{64}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +1864,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *DrySource) Reset()      { *m = DrySource{} }
 func (*DrySource) ProtoMessage() {}
 func (*DrySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{60}
+	return fileDescriptor_c078c3c476799f44, []int{65}
 }
 func (m *DrySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1892,7 @@ var xxx_messageInfo_DrySource proto.InternalMessageInfo
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{61}
+	return fileDescriptor_c078c3c476799f44, []int{66}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +1920,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{62}
+	return fileDescriptor_c078c3c476799f44, []int{67}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +1948,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ErrApplicationNotAllowedToUseProject) Reset()      { *m = ErrApplicationNotAllowedToUseProject{} }
 func (*ErrApplicationNotAllowedToUseProject) ProtoMessage() {}
 func (*ErrApplicationNotAllowedToUseProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{63}
+	return fileDescriptor_c078c3c476799f44, []int{68}
 }
 func (m *ErrApplicationNotAllowedToUseProject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +1976,7 @@ var xxx_messageInfo_ErrApplicationNotAllowedToUseProject proto.InternalMessageIn
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{64}
+	return fileDescriptor_c078c3c476799f44, []int{69}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1861,10 +2001,38 @@ func (m *ExecProviderConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 
+func (m *GRPCVerificationProbe) Reset()      { *m = GRPCVerificationProbe{} }
+func (*GRPCVerificationProbe) ProtoMessage() {}
+func (*GRPCVerificationProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{70}
+}
+func (m *GRPCVerificationProbe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPCVerificationProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GRPCVerificationProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCVerificationProbe.Merge(m, src)
+}
+func (m *GRPCVerificationProbe) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPCVerificationProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCVerificationProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPCVerificationProbe proto.InternalMessageInfo
+
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{65}
+	return fileDescriptor_c078c3c476799f44, []int{71}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +2060,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{66}
+	return fileDescriptor_c078c3c476799f44, []int{72}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +2088,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{67}
+	return fileDescriptor_c078c3c476799f44, []int{73}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +2116,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{68}
+	return fileDescriptor_c078c3c476799f44, []int{74}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2144,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{69}
+	return fileDescriptor_c078c3c476799f44, []int{75}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2001,10 +2169,66 @@ func (m *GnuPGPublicKeyList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 
+func (m *GroupExpiration) Reset()      { *m = GroupExpiration{} }
+func (*GroupExpiration) ProtoMessage() {}
+func (*GroupExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{76}
+}
+func (m *GroupExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GroupExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupExpiration.Merge(m, src)
+}
+func (m *GroupExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupExpiration proto.InternalMessageInfo
+
+func (m *HTTPVerificationProbe) Reset()      { *m = HTTPVerificationProbe{} }
+func (*HTTPVerificationProbe) ProtoMessage() {}
+func (*HTTPVerificationProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{77}
+}
+func (m *HTTPVerificationProbe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPVerificationProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPVerificationProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPVerificationProbe.Merge(m, src)
+}
+func (m *HTTPVerificationProbe) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPVerificationProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPVerificationProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPVerificationProbe proto.InternalMessageInfo
+
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{70}
+	return fileDescriptor_c078c3c476799f44, []int{78}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2256,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{71}
+	return fileDescriptor_c078c3c476799f44, []int{79}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2284,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{72}
+	return fileDescriptor_c078c3c476799f44, []int{80}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2312,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{73}
+	return fileDescriptor_c078c3c476799f44, []int{81}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2113,10 +2337,38 @@ func (m *HelmParameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 
+func (m *HelmPostRenderer) Reset()      { *m = HelmPostRenderer{} }
+func (*HelmPostRenderer) ProtoMessage() {}
+func (*HelmPostRenderer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{82}
+}
+func (m *HelmPostRenderer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmPostRenderer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HelmPostRenderer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmPostRenderer.Merge(m, src)
+}
+func (m *HelmPostRenderer) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmPostRenderer) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmPostRenderer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmPostRenderer proto.InternalMessageInfo
+
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{74}
+	return fileDescriptor_c078c3c476799f44, []int{83}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2396,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{75}
+	return fileDescriptor_c078c3c476799f44, []int{84}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2172,7 +2424,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *HydrateOperation) Reset()      { *m = HydrateOperation{} }
 func (*HydrateOperation) ProtoMessage() {}
 func (*HydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{76}
+	return fileDescriptor_c078c3c476799f44, []int{85}
 }
 func (m *HydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2452,7 @@ var xxx_messageInfo_HydrateOperation proto.InternalMessageInfo
 func (m *HydrateTo) Reset()      { *m = HydrateTo{} }
 func (*HydrateTo) ProtoMessage() {}
 func (*HydrateTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{77}
+	return fileDescriptor_c078c3c476799f44, []int{86}
 }
 func (m *HydrateTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2225,10 +2477,38 @@ func (m *HydrateTo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HydrateTo proto.InternalMessageInfo
 
+func (m *ImageRegistryRewriteRule) Reset()      { *m = ImageRegistryRewriteRule{} }
+func (*ImageRegistryRewriteRule) ProtoMessage() {}
+func (*ImageRegistryRewriteRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{87}
+}
+func (m *ImageRegistryRewriteRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageRegistryRewriteRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ImageRegistryRewriteRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageRegistryRewriteRule.Merge(m, src)
+}
+func (m *ImageRegistryRewriteRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageRegistryRewriteRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageRegistryRewriteRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageRegistryRewriteRule proto.InternalMessageInfo
+
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{78}
+	return fileDescriptor_c078c3c476799f44, []int{88}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2536,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{79}
+	return fileDescriptor_c078c3c476799f44, []int{89}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2564,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{80}
+	return fileDescriptor_c078c3c476799f44, []int{90}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2592,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{81}
+	return fileDescriptor_c078c3c476799f44, []int{91}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2620,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{82}
+	return fileDescriptor_c078c3c476799f44, []int{92}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2648,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{83}
+	return fileDescriptor_c078c3c476799f44, []int{93}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2676,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeGvk) Reset()      { *m = KustomizeGvk{} }
 func (*KustomizeGvk) ProtoMessage() {}
 func (*KustomizeGvk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{84}
+	return fileDescriptor_c078c3c476799f44, []int{94}
 }
 func (m *KustomizeGvk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2704,7 @@ var xxx_messageInfo_KustomizeGvk proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{85}
+	return fileDescriptor_c078c3c476799f44, []int{95}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2732,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
 func (*KustomizePatch) ProtoMessage() {}
 func (*KustomizePatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{86}
+	return fileDescriptor_c078c3c476799f44, []int{96}
 }
 func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2760,7 @@ var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{87}
+	return fileDescriptor_c078c3c476799f44, []int{97}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2788,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *KustomizeResId) Reset()      { *m = KustomizeResId{} }
 func (*KustomizeResId) ProtoMessage() {}
 func (*KustomizeResId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{88}
+	return fileDescriptor_c078c3c476799f44, []int{98}
 }
 func (m *KustomizeResId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2816,7 @@ var xxx_messageInfo_KustomizeResId proto.InternalMessageInfo
 func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
 func (*KustomizeSelector) ProtoMessage() {}
 func (*KustomizeSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{89}
+	return fileDescriptor_c078c3c476799f44, []int{99}
 }
 func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +2844,7 @@ var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{90}
+	return fileDescriptor_c078c3c476799f44, []int{100}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2589,10 +2869,38 @@ func (m *ListGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 
+func (m *LuaVerificationProbe) Reset()      { *m = LuaVerificationProbe{} }
+func (*LuaVerificationProbe) ProtoMessage() {}
+func (*LuaVerificationProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{101}
+}
+func (m *LuaVerificationProbe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LuaVerificationProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LuaVerificationProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LuaVerificationProbe.Merge(m, src)
+}
+func (m *LuaVerificationProbe) XXX_Size() int {
+	return m.Size()
+}
+func (m *LuaVerificationProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_LuaVerificationProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LuaVerificationProbe proto.InternalMessageInfo
+
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{91}
+	return fileDescriptor_c078c3c476799f44, []int{102}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +2928,7 @@ var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{92}
+	return fileDescriptor_c078c3c476799f44, []int{103}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2956,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{93}
+	return fileDescriptor_c078c3c476799f44, []int{104}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2673,10 +2981,66 @@ func (m *MergeGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 
+func (m *MergeGeneratorOverlay) Reset()      { *m = MergeGeneratorOverlay{} }
+func (*MergeGeneratorOverlay) ProtoMessage() {}
+func (*MergeGeneratorOverlay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{105}
+}
+func (m *MergeGeneratorOverlay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeGeneratorOverlay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MergeGeneratorOverlay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeGeneratorOverlay.Merge(m, src)
+}
+func (m *MergeGeneratorOverlay) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeGeneratorOverlay) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeGeneratorOverlay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeGeneratorOverlay proto.InternalMessageInfo
+
+func (m *MetadataPropagationPolicy) Reset()      { *m = MetadataPropagationPolicy{} }
+func (*MetadataPropagationPolicy) ProtoMessage() {}
+func (*MetadataPropagationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{106}
+}
+func (m *MetadataPropagationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataPropagationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MetadataPropagationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataPropagationPolicy.Merge(m, src)
+}
+func (m *MetadataPropagationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataPropagationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataPropagationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataPropagationPolicy proto.InternalMessageInfo
+
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{94}
+	return fileDescriptor_c078c3c476799f44, []int{107}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,7 +3068,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{95}
+	return fileDescriptor_c078c3c476799f44, []int{108}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2729,10 +3093,38 @@ func (m *NestedMergeGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 
+func (m *NotificationAllowlist) Reset()      { *m = NotificationAllowlist{} }
+func (*NotificationAllowlist) ProtoMessage() {}
+func (*NotificationAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{109}
+}
+func (m *NotificationAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NotificationAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationAllowlist.Merge(m, src)
+}
+func (m *NotificationAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationAllowlist proto.InternalMessageInfo
+
 func (m *OCIMetadata) Reset()      { *m = OCIMetadata{} }
 func (*OCIMetadata) ProtoMessage() {}
 func (*OCIMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{96}
+	return fileDescriptor_c078c3c476799f44, []int{110}
 }
 func (m *OCIMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +3152,7 @@ var xxx_messageInfo_OCIMetadata proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{97}
+	return fileDescriptor_c078c3c476799f44, []int{111}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +3180,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{98}
+	return fileDescriptor_c078c3c476799f44, []int{112}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +3208,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{99}
+	return fileDescriptor_c078c3c476799f44, []int{113}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +3236,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{100}
+	return fileDescriptor_c078c3c476799f44, []int{114}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +3264,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{101}
+	return fileDescriptor_c078c3c476799f44, []int{115}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +3292,7 @@ var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{102}
+	return fileDescriptor_c078c3c476799f44, []int{116}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3320,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{103}
+	return fileDescriptor_c078c3c476799f44, []int{117}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3348,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{104}
+	return fileDescriptor_c078c3c476799f44, []int{118}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3376,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{105}
+	return fileDescriptor_c078c3c476799f44, []int{119}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3404,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{106}
+	return fileDescriptor_c078c3c476799f44, []int{120}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3432,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{107}
+	return fileDescriptor_c078c3c476799f44, []int{121}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3460,7 @@ var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{108}
+	return fileDescriptor_c078c3c476799f44, []int{122}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3488,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{109}
+	return fileDescriptor_c078c3c476799f44, []int{123}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3516,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
 func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
 func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{110}
+	return fileDescriptor_c078c3c476799f44, []int{124}
 }
 func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3152,7 +3544,7 @@ var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
 func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{111}
+	return fileDescriptor_c078c3c476799f44, []int{125}
 }
 func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3572,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{112}
+	return fileDescriptor_c078c3c476799f44, []int{126}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3600,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{113}
+	return fileDescriptor_c078c3c476799f44, []int{127}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3628,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{114}
+	return fileDescriptor_c078c3c476799f44, []int{128}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3656,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{115}
+	return fileDescriptor_c078c3c476799f44, []int{129}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3684,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{116}
+	return fileDescriptor_c078c3c476799f44, []int{130}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3712,7 @@ var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{117}
+	return fileDescriptor_c078c3c476799f44, []int{131}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3740,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{118}
+	return fileDescriptor_c078c3c476799f44, []int{132}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3768,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{119}
+	return fileDescriptor_c078c3c476799f44, []int{133}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3796,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{120}
+	return fileDescriptor_c078c3c476799f44, []int{134}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3824,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{121}
+	return fileDescriptor_c078c3c476799f44, []int{135}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3852,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{122}
+	return fileDescriptor_c078c3c476799f44, []int{136}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +3880,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{123}
+	return fileDescriptor_c078c3c476799f44, []int{137}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3908,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{124}
+	return fileDescriptor_c078c3c476799f44, []int{138}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3936,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{125}
+	return fileDescriptor_c078c3c476799f44, []int{139}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3964,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{126}
+	return fileDescriptor_c078c3c476799f44, []int{140}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3992,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{127}
+	return fileDescriptor_c078c3c476799f44, []int{141}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +4020,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{128}
+	return fileDescriptor_c078c3c476799f44, []int{142}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +4048,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{129}
+	return fileDescriptor_c078c3c476799f44, []int{143}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3684,7 +4076,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{130}
+	return fileDescriptor_c078c3c476799f44, []int{144}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +4104,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{131}
+	return fileDescriptor_c078c3c476799f44, []int{145}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +4132,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{132}
+	return fileDescriptor_c078c3c476799f44, []int{146}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +4160,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{133}
+	return fileDescriptor_c078c3c476799f44, []int{147}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +4188,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{134}
+	return fileDescriptor_c078c3c476799f44, []int{148}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +4216,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{135}
+	return fileDescriptor_c078c3c476799f44, []int{149}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +4244,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{136}
+	return fileDescriptor_c078c3c476799f44, []int{150}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +4272,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{137}
+	return fileDescriptor_c078c3c476799f44, []int{151}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +4300,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{138}
+	return fileDescriptor_c078c3c476799f44, []int{152}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3933,10 +4325,38 @@ func (m *RevisionMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 
+func (m *RevisionPullRequest) Reset()      { *m = RevisionPullRequest{} }
+func (*RevisionPullRequest) ProtoMessage() {}
+func (*RevisionPullRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{153}
+}
+func (m *RevisionPullRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevisionPullRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RevisionPullRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionPullRequest.Merge(m, src)
+}
+func (m *RevisionPullRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevisionPullRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionPullRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevisionPullRequest proto.InternalMessageInfo
+
 func (m *RevisionReference) Reset()      { *m = RevisionReference{} }
 func (*RevisionReference) ProtoMessage() {}
 func (*RevisionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{139}
+	return fileDescriptor_c078c3c476799f44, []int{154}
 }
 func (m *RevisionReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +4384,7 @@ var xxx_messageInfo_RevisionReference proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{140}
+	return fileDescriptor_c078c3c476799f44, []int{155}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3992,7 +4412,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{141}
+	return fileDescriptor_c078c3c476799f44, []int{156}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4440,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{142}
+	return fileDescriptor_c078c3c476799f44, []int{157}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4468,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{143}
+	return fileDescriptor_c078c3c476799f44, []int{158}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,7 +4496,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{144}
+	return fileDescriptor_c078c3c476799f44, []int{159}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4524,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{145}
+	return fileDescriptor_c078c3c476799f44, []int{160}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4552,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{146}
+	return fileDescriptor_c078c3c476799f44, []int{161}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4580,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{147}
+	return fileDescriptor_c078c3c476799f44, []int{162}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4608,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{148}
+	return fileDescriptor_c078c3c476799f44, []int{163}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4216,7 +4636,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{149}
+	return fileDescriptor_c078c3c476799f44, []int{164}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4244,7 +4664,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{150}
+	return fileDescriptor_c078c3c476799f44, []int{165}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +4692,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SourceHydrator) Reset()      { *m = SourceHydrator{} }
 func (*SourceHydrator) ProtoMessage() {}
 func (*SourceHydrator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{151}
+	return fileDescriptor_c078c3c476799f44, []int{166}
 }
 func (m *SourceHydrator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4720,7 @@ var xxx_messageInfo_SourceHydrator proto.InternalMessageInfo
 func (m *SourceHydratorStatus) Reset()      { *m = SourceHydratorStatus{} }
 func (*SourceHydratorStatus) ProtoMessage() {}
 func (*SourceHydratorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{152}
+	return fileDescriptor_c078c3c476799f44, []int{167}
 }
 func (m *SourceHydratorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4328,7 +4748,7 @@ var xxx_messageInfo_SourceHydratorStatus proto.InternalMessageInfo
 func (m *SuccessfulHydrateOperation) Reset()      { *m = SuccessfulHydrateOperation{} }
 func (*SuccessfulHydrateOperation) ProtoMessage() {}
 func (*SuccessfulHydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{153}
+	return fileDescriptor_c078c3c476799f44, []int{168}
 }
 func (m *SuccessfulHydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4356,7 +4776,7 @@ var xxx_messageInfo_SuccessfulHydrateOperation proto.InternalMessageInfo
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{154}
+	return fileDescriptor_c078c3c476799f44, []int{169}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4384,7 +4804,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{155}
+	return fileDescriptor_c078c3c476799f44, []int{170}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4412,7 +4832,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{156}
+	return fileDescriptor_c078c3c476799f44, []int{171}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4437,10 +4857,38 @@ func (m *SyncOperationResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 
+func (m *SyncOptionsPolicy) Reset()      { *m = SyncOptionsPolicy{} }
+func (*SyncOptionsPolicy) ProtoMessage() {}
+func (*SyncOptionsPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{172}
+}
+func (m *SyncOptionsPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncOptionsPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncOptionsPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncOptionsPolicy.Merge(m, src)
+}
+func (m *SyncOptionsPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncOptionsPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncOptionsPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncOptionsPolicy proto.InternalMessageInfo
+
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{157}
+	return fileDescriptor_c078c3c476799f44, []int{173}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4468,7 +4916,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{158}
+	return fileDescriptor_c078c3c476799f44, []int{174}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4496,7 +4944,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncSource) Reset()      { *m = SyncSource{} }
 func (*SyncSource) ProtoMessage() {}
 func (*SyncSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{159}
+	return fileDescriptor_c078c3c476799f44, []int{175}
 }
 func (m *SyncSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4524,7 +4972,7 @@ var xxx_messageInfo_SyncSource proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{160}
+	return fileDescriptor_c078c3c476799f44, []int{176}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4552,7 +5000,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{161}
+	return fileDescriptor_c078c3c476799f44, []int{177}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4580,7 +5028,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{162}
+	return fileDescriptor_c078c3c476799f44, []int{178}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4608,7 +5056,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{163}
+	return fileDescriptor_c078c3c476799f44, []int{179}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4636,7 +5084,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{164}
+	return fileDescriptor_c078c3c476799f44, []int{180}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4664,7 +5112,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{165}
+	return fileDescriptor_c078c3c476799f44, []int{181}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4692,7 +5140,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{166}
+	return fileDescriptor_c078c3c476799f44, []int{182}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4717,8 +5165,38 @@ func (m *TagFilter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TagFilter proto.InternalMessageInfo
 
+func (m *VerificationProbe) Reset()      { *m = VerificationProbe{} }
+func (*VerificationProbe) ProtoMessage() {}
+func (*VerificationProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{183}
+}
+func (m *VerificationProbe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VerificationProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationProbe.Merge(m, src)
+}
+func (m *VerificationProbe) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationProbe proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AWSAuthConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AWSAuthConfig")
+	proto.RegisterType((*AWSOrganizationsGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AWSOrganizationsGenerator")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AWSOrganizationsGenerator.ValuesEntry")
 	proto.RegisterType((*AppHealthStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppHealthStatus")
 	proto.RegisterType((*AppProject)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProject")
 	proto.RegisterType((*AppProjectList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectList")
@@ -4729,6 +5207,7 @@ func init() {
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationCondition")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestination")
 	proto.RegisterType((*ApplicationDestinationServiceAccount)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestinationServiceAccount")
+	proto.RegisterType((*ApplicationFreeze)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationFreeze")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationList")
 	proto.RegisterType((*ApplicationMatchExpression)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationMatchExpression")
 	proto.RegisterType((*ApplicationPreservedFields)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationPreservedFields")
@@ -4738,6 +5217,7 @@ func init() {
 	proto.RegisterType((*ApplicationSetGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetGenerator")
 	proto.RegisterType((*ApplicationSetList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetList")
 	proto.RegisterType((*ApplicationSetNestedGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetNestedGenerator")
+	proto.RegisterType((*ApplicationSetParam)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetParam")
 	proto.RegisterType((*ApplicationSetResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetResourceIgnoreDifferences")
 	proto.RegisterType((*ApplicationSetRolloutStep)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutStep")
 	proto.RegisterType((*ApplicationSetRolloutStrategy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutStrategy")
@@ -4775,9 +5255,11 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Cluster.LabelsEntry")
 	proto.RegisterType((*ClusterCacheInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterCacheInfo")
 	proto.RegisterType((*ClusterConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterConfig")
+	proto.RegisterType((*ClusterConnectivityInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterConnectivityInfo")
 	proto.RegisterType((*ClusterGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterGenerator")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterGenerator.ValuesEntry")
 	proto.RegisterType((*ClusterInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterInfo")
+	proto.RegisterType((*ClusterLabelTransform)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterLabelTransform")
 	proto.RegisterType((*ClusterList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterList")
 	proto.RegisterType((*Command)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Command")
 	proto.RegisterType((*CommitMetadata)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.CommitMetadata")
@@ -4793,21 +5275,27 @@ func init() {
 	proto.RegisterType((*ErrApplicationNotAllowedToUseProject)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ErrApplicationNotAllowedToUseProject")
 	proto.RegisterType((*ExecProviderConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ExecProviderConfig")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ExecProviderConfig.EnvEntry")
+	proto.RegisterType((*GRPCVerificationProbe)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.GRPCVerificationProbe")
 	proto.RegisterType((*GitDirectoryGeneratorItem)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.GitDirectoryGeneratorItem")
 	proto.RegisterType((*GitFileGeneratorItem)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.GitFileGeneratorItem")
 	proto.RegisterType((*GitGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.GitGenerator")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.GitGenerator.ValuesEntry")
 	proto.RegisterType((*GnuPGPublicKey)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.GnuPGPublicKey")
 	proto.RegisterType((*GnuPGPublicKeyList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.GnuPGPublicKeyList")
+	proto.RegisterType((*GroupExpiration)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.GroupExpiration")
+	proto.RegisterType((*HTTPVerificationProbe)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HTTPVerificationProbe")
 	proto.RegisterType((*HealthStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HealthStatus")
 	proto.RegisterType((*HelmFileParameter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmFileParameter")
 	proto.RegisterType((*HelmOptions)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmOptions")
 	proto.RegisterType((*HelmParameter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmParameter")
+	proto.RegisterType((*HelmPostRenderer)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmPostRenderer")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmPostRenderer.ValuesEntry")
 	proto.RegisterType((*HostInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostInfo")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostInfo.LabelsEntry")
 	proto.RegisterType((*HostResourceInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostResourceInfo")
 	proto.RegisterType((*HydrateOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateOperation")
 	proto.RegisterType((*HydrateTo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateTo")
+	proto.RegisterType((*ImageRegistryRewriteRule)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ImageRegistryRewriteRule")
 	proto.RegisterType((*Info)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Info")
 	proto.RegisterType((*InfoItem)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.InfoItem")
 	proto.RegisterType((*JWTToken)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.JWTToken")
@@ -4822,13 +5310,17 @@ func init() {
 	proto.RegisterType((*KustomizeResId)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.KustomizeResId")
 	proto.RegisterType((*KustomizeSelector)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.KustomizeSelector")
 	proto.RegisterType((*ListGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ListGenerator")
+	proto.RegisterType((*LuaVerificationProbe)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.LuaVerificationProbe")
 	proto.RegisterType((*ManagedNamespaceMetadata)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata.LabelsEntry")
 	proto.RegisterType((*MatrixGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.MatrixGenerator")
 	proto.RegisterType((*MergeGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.MergeGenerator")
+	proto.RegisterType((*MergeGeneratorOverlay)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.MergeGeneratorOverlay")
+	proto.RegisterType((*MetadataPropagationPolicy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.MetadataPropagationPolicy")
 	proto.RegisterType((*NestedMatrixGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMatrixGenerator")
 	proto.RegisterType((*NestedMergeGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMergeGenerator")
+	proto.RegisterType((*NotificationAllowlist)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NotificationAllowlist")
 	proto.RegisterType((*OCIMetadata)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCIMetadata")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Operation")
 	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationInitiator")
@@ -4878,6 +5370,7 @@ func init() {
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.RetryStrategy")
 	proto.RegisterType((*RevisionHistory)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.RevisionHistory")
 	proto.RegisterType((*RevisionMetadata)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.RevisionMetadata")
+	proto.RegisterType((*RevisionPullRequest)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.RevisionPullRequest")
 	proto.RegisterType((*RevisionReference)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.RevisionReference")
 	proto.RegisterType((*SCMProviderGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SCMProviderGenerator")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SCMProviderGenerator.ValuesEntry")
@@ -4897,6 +5390,7 @@ func init() {
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperation")
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperationResult")
+	proto.RegisterType((*SyncOptionsPolicy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOptionsPolicy")
 	proto.RegisterType((*SyncPolicy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicy")
 	proto.RegisterType((*SyncPolicyAutomated)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicyAutomated")
 	proto.RegisterType((*SyncSource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncSource")
@@ -4907,6 +5401,7 @@ func init() {
 	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindow")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TLSClientConfig")
 	proto.RegisterType((*TagFilter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TagFilter")
+	proto.RegisterType((*VerificationProbe)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.VerificationProbe")
 }
 
 func init() {
@@ -4914,779 +5409,900 @@ func init() {
 }
 
 var fileDescriptor_c078c3c476799f44 = []byte{
-	// 12344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x24, 0xc7,
-	0x75, 0x98, 0x66, 0x17, 0x0b, 0xec, 0x3e, 0x7c, 0x1d, 0xfa, 0xee, 0x48, 0xdc, 0xf1, 0x03, 0xe7,
-	0xa1, 0x4c, 0xd1, 0x11, 0x09, 0x98, 0x47, 0x52, 0x66, 0x4c, 0x5b, 0x36, 0x3e, 0xee, 0x03, 0x77,
-	0xc0, 0x01, 0xec, 0xc5, 0xdd, 0x59, 0x94, 0x29, 0x6a, 0xb0, 0xdb, 0x58, 0xcc, 0x61, 0x76, 0x66,
-	0x39, 0x33, 0x8b, 0xc3, 0xd2, 0xb2, 0x2c, 0x59, 0x92, 0x2d, 0x5b, 0x5f, 0x8c, 0x95, 0x8a, 0xa8,
-	0x24, 0x52, 0xe4, 0xd8, 0xf9, 0xaa, 0x94, 0xca, 0x4a, 0xfc, 0x23, 0xae, 0xb2, 0x5d, 0x2a, 0x7f,
-	0x94, 0x4a, 0x8e, 0x93, 0xd8, 0x51, 0x29, 0x96, 0x13, 0x5b, 0x88, 0x74, 0x49, 0xca, 0xae, 0x54,
-	0xc5, 0x55, 0x76, 0xf2, 0x23, 0x75, 0x49, 0xa5, 0x52, 0xfd, 0xdd, 0x33, 0x3b, 0x0b, 0x2c, 0x0e,
-	0x83, 0xbb, 0x93, 0xcc, 0x7f, 0xbb, 0xfd, 0xde, 0xbc, 0xd7, 0xd3, 0xd3, 0xfd, 0xde, 0xeb, 0xd7,
-	0xef, 0xbd, 0x86, 0xa5, 0x86, 0x1b, 0x6f, 0xb6, 0xd7, 0xa7, 0x6b, 0x41, 0x73, 0xc6, 0x09, 0x1b,
-	0x41, 0x2b, 0x0c, 0x6e, 0xb0, 0x1f, 0x4f, 0xd5, 0xea, 0x33, 0xdb, 0xcf, 0xcc, 0xb4, 0xb6, 0x1a,
-	0x33, 0x4e, 0xcb, 0x8d, 0x66, 0x9c, 0x56, 0xcb, 0x73, 0x6b, 0x4e, 0xec, 0x06, 0xfe, 0xcc, 0xf6,
-	0xd3, 0x8e, 0xd7, 0xda, 0x74, 0x9e, 0x9e, 0x69, 0x10, 0x9f, 0x84, 0x4e, 0x4c, 0xea, 0xd3, 0xad,
-	0x30, 0x88, 0x03, 0xf4, 0x43, 0x9a, 0xda, 0xb4, 0xa4, 0xc6, 0x7e, 0xbc, 0x52, 0xab, 0x4f, 0x6f,
-	0x3f, 0x33, 0xdd, 0xda, 0x6a, 0x4c, 0x53, 0x6a, 0xd3, 0x06, 0xb5, 0x69, 0x49, 0xed, 0xf4, 0x53,
-	0x46, 0x5f, 0x1a, 0x41, 0x23, 0x98, 0x61, 0x44, 0xd7, 0xdb, 0x1b, 0xec, 0x1f, 0xfb, 0xc3, 0x7e,
-	0x71, 0x66, 0xa7, 0xed, 0xad, 0xe7, 0xa3, 0x69, 0x37, 0xa0, 0xdd, 0x9b, 0xa9, 0x05, 0x21, 0x99,
-	0xd9, 0xee, 0xea, 0xd0, 0xe9, 0x8b, 0x1a, 0x87, 0xec, 0xc4, 0xc4, 0x8f, 0xdc, 0xc0, 0x8f, 0x9e,
-	0xa2, 0x5d, 0x20, 0xe1, 0x36, 0x09, 0xcd, 0xd7, 0x33, 0x10, 0xb2, 0x28, 0x3d, 0xab, 0x29, 0x35,
-	0x9d, 0xda, 0xa6, 0xeb, 0x93, 0xb0, 0xa3, 0x1f, 0x6f, 0x92, 0xd8, 0xc9, 0x7a, 0x6a, 0xa6, 0xd7,
-	0x53, 0x61, 0xdb, 0x8f, 0xdd, 0x26, 0xe9, 0x7a, 0xe0, 0x1d, 0xfb, 0x3d, 0x10, 0xd5, 0x36, 0x49,
-	0xd3, 0xe9, 0x7a, 0xee, 0x99, 0x5e, 0xcf, 0xb5, 0x63, 0xd7, 0x9b, 0x71, 0xfd, 0x38, 0x8a, 0xc3,
-	0xf4, 0x43, 0xf6, 0xdf, 0xb7, 0x60, 0x74, 0xf6, 0x7a, 0x75, 0xb6, 0x1d, 0x6f, 0xce, 0x07, 0xfe,
-	0x86, 0xdb, 0x40, 0xcf, 0xc1, 0x70, 0xcd, 0x6b, 0x47, 0x31, 0x09, 0xaf, 0x38, 0x4d, 0x32, 0x69,
-	0x9d, 0xb1, 0x9e, 0xa8, 0xcc, 0x1d, 0xff, 0xea, 0xee, 0xd4, 0x5b, 0x6e, 0xed, 0x4e, 0x0d, 0xcf,
-	0x6b, 0x10, 0x36, 0xf1, 0xd0, 0xf7, 0xc1, 0x50, 0x18, 0x78, 0x64, 0x16, 0x5f, 0x99, 0x2c, 0xb0,
-	0x47, 0xc6, 0xc5, 0x23, 0x43, 0x98, 0x37, 0x63, 0x09, 0xa7, 0xa8, 0xad, 0x30, 0xd8, 0x70, 0x3d,
-	0x32, 0x59, 0x4c, 0xa2, 0xae, 0xf2, 0x66, 0x2c, 0xe1, 0xf6, 0x67, 0x0b, 0x30, 0x3e, 0xdb, 0x6a,
-	0x5d, 0x24, 0x8e, 0x17, 0x6f, 0x56, 0x63, 0x27, 0x6e, 0x47, 0xa8, 0x01, 0x83, 0x11, 0xfb, 0x25,
-	0xfa, 0xb6, 0x22, 0x9e, 0x1e, 0xe4, 0xf0, 0xdb, 0xbb, 0x53, 0x3f, 0x9c, 0x35, 0xa3, 0x1b, 0x6e,
-	0x1c, 0xb4, 0xa2, 0xa7, 0x88, 0xdf, 0x70, 0x7d, 0xc2, 0xc6, 0x65, 0x93, 0x51, 0x9d, 0x36, 0x89,
-	0xcf, 0x07, 0x75, 0x82, 0x05, 0x79, 0xda, 0xcf, 0x26, 0x89, 0x22, 0xa7, 0x41, 0xd2, 0xaf, 0xb4,
-	0xcc, 0x9b, 0xb1, 0x84, 0xa3, 0x10, 0x90, 0xe7, 0x44, 0xf1, 0x5a, 0xe8, 0xf8, 0x91, 0x4b, 0xa7,
-	0xf4, 0x9a, 0xdb, 0xe4, 0x6f, 0x37, 0x7c, 0xf6, 0x6f, 0x4c, 0xf3, 0x0f, 0x33, 0x6d, 0x7e, 0x18,
-	0xbd, 0x0e, 0xe8, 0xbc, 0x99, 0xde, 0x7e, 0x7a, 0x9a, 0x3e, 0x31, 0xf7, 0xc0, 0xad, 0xdd, 0x29,
-	0xb4, 0xd4, 0x45, 0x09, 0x67, 0x50, 0xb7, 0xff, 0xa8, 0x00, 0x30, 0xdb, 0x6a, 0xad, 0x86, 0xc1,
-	0x0d, 0x52, 0x8b, 0xd1, 0x7b, 0xa1, 0x4c, 0x49, 0xd5, 0x9d, 0xd8, 0x61, 0x03, 0x33, 0x7c, 0xf6,
-	0xfb, 0xfb, 0x63, 0xbc, 0xb2, 0x4e, 0x9f, 0x5f, 0x26, 0xb1, 0x33, 0x87, 0xc4, 0x0b, 0x82, 0x6e,
-	0xc3, 0x8a, 0x2a, 0xf2, 0x61, 0x20, 0x6a, 0x91, 0x1a, 0x1b, 0x8c, 0xe1, 0xb3, 0x4b, 0xd3, 0x87,
-	0x59, 0xe9, 0xd3, 0xba, 0xe7, 0xd5, 0x16, 0xa9, 0xcd, 0x8d, 0x08, 0xce, 0x03, 0xf4, 0x1f, 0x66,
-	0x7c, 0xd0, 0xb6, 0xfa, 0xd0, 0x7c, 0x20, 0xaf, 0xe4, 0xc6, 0x91, 0x51, 0x9d, 0x1b, 0x4b, 0x4e,
-	0x1c, 0xf9, 0xdd, 0xed, 0x6f, 0x5a, 0x30, 0xa6, 0x91, 0x97, 0xdc, 0x28, 0x46, 0x3f, 0xde, 0x35,
-	0xb8, 0xd3, 0xfd, 0x0d, 0x2e, 0x7d, 0x9a, 0x0d, 0xed, 0x31, 0xc1, 0xac, 0x2c, 0x5b, 0x8c, 0x81,
-	0x6d, 0x42, 0xc9, 0x8d, 0x49, 0x33, 0x9a, 0x2c, 0x9c, 0x29, 0x3e, 0x31, 0x7c, 0xf6, 0x62, 0x5e,
-	0xef, 0x39, 0x37, 0x2a, 0x98, 0x96, 0x16, 0x29, 0x79, 0xcc, 0xb9, 0xd8, 0x7f, 0x35, 0x6a, 0xbe,
-	0x1f, 0x1d, 0x70, 0xf4, 0x34, 0x0c, 0x47, 0x41, 0x3b, 0xac, 0x11, 0x4c, 0x5a, 0x01, 0x5d, 0x58,
-	0x45, 0x3a, 0xdd, 0xe9, 0x82, 0xaf, 0xea, 0x66, 0x6c, 0xe2, 0xa0, 0x4f, 0x5a, 0x30, 0x52, 0x27,
-	0x51, 0xec, 0xfa, 0x8c, 0xbf, 0xec, 0xfc, 0xda, 0xa1, 0x3b, 0x2f, 0x1b, 0x17, 0x34, 0xf1, 0xb9,
-	0x13, 0xe2, 0x45, 0x46, 0x8c, 0xc6, 0x08, 0x27, 0xf8, 0x53, 0xc1, 0x55, 0x27, 0x51, 0x2d, 0x74,
-	0x5b, 0xf4, 0xbf, 0x10, 0x2d, 0x4a, 0x70, 0x2d, 0x68, 0x10, 0x36, 0xf1, 0x90, 0x0f, 0x25, 0x2a,
-	0x98, 0xa2, 0xc9, 0x01, 0xd6, 0xff, 0xc5, 0xc3, 0xf5, 0x5f, 0x0c, 0x2a, 0x95, 0x79, 0x7a, 0xf4,
-	0xe9, 0xbf, 0x08, 0x73, 0x36, 0xe8, 0x13, 0x16, 0x4c, 0x0a, 0xc1, 0x89, 0x09, 0x1f, 0xd0, 0xeb,
-	0x9b, 0x6e, 0x4c, 0x3c, 0x37, 0x8a, 0x27, 0x4b, 0xac, 0x0f, 0x33, 0xfd, 0xcd, 0xad, 0x0b, 0x61,
-	0xd0, 0x6e, 0x5d, 0x76, 0xfd, 0xfa, 0xdc, 0x19, 0xc1, 0x69, 0x72, 0xbe, 0x07, 0x61, 0xdc, 0x93,
-	0x25, 0xfa, 0xb4, 0x05, 0xa7, 0x7d, 0xa7, 0x49, 0xa2, 0x96, 0x43, 0x3f, 0x2d, 0x07, 0xcf, 0x79,
-	0x4e, 0x6d, 0x8b, 0xf5, 0x68, 0xf0, 0xce, 0x7a, 0x64, 0x8b, 0x1e, 0x9d, 0xbe, 0xd2, 0x93, 0x34,
-	0xde, 0x83, 0x2d, 0xfa, 0x25, 0x0b, 0x26, 0x82, 0xb0, 0xb5, 0xe9, 0xf8, 0xa4, 0x2e, 0xa1, 0xd1,
-	0xe4, 0x10, 0x5b, 0x7a, 0xef, 0x39, 0xdc, 0x27, 0x5a, 0x49, 0x93, 0x5d, 0x0e, 0x7c, 0x37, 0x0e,
-	0xc2, 0x2a, 0x89, 0x63, 0xd7, 0x6f, 0x44, 0x73, 0x27, 0x6f, 0xed, 0x4e, 0x4d, 0x74, 0x61, 0xe1,
-	0xee, 0xfe, 0xa0, 0x9f, 0x80, 0xe1, 0xa8, 0xe3, 0xd7, 0xae, 0xbb, 0x7e, 0x3d, 0xb8, 0x19, 0x4d,
-	0x96, 0xf3, 0x58, 0xbe, 0x55, 0x45, 0x50, 0x2c, 0x40, 0xcd, 0x00, 0x9b, 0xdc, 0xb2, 0x3f, 0x9c,
-	0x9e, 0x4a, 0x95, 0xbc, 0x3f, 0x9c, 0x9e, 0x4c, 0x7b, 0xb0, 0x45, 0x3f, 0x6b, 0xc1, 0x68, 0xe4,
-	0x36, 0x7c, 0x27, 0x6e, 0x87, 0xe4, 0x32, 0xe9, 0x44, 0x93, 0xc0, 0x3a, 0x72, 0xe9, 0x90, 0xa3,
-	0x62, 0x90, 0x9c, 0x3b, 0x29, 0xfa, 0x38, 0x6a, 0xb6, 0x46, 0x38, 0xc9, 0x37, 0x6b, 0xa1, 0xe9,
-	0x69, 0x3d, 0x9c, 0xef, 0x42, 0xd3, 0x93, 0xba, 0x27, 0x4b, 0xf4, 0xa3, 0x70, 0x8c, 0x37, 0xa9,
-	0x91, 0x8d, 0x26, 0x47, 0x98, 0xa0, 0x3d, 0x71, 0x6b, 0x77, 0xea, 0x58, 0x35, 0x05, 0xc3, 0x5d,
-	0xd8, 0xe8, 0x55, 0x98, 0x6a, 0x91, 0xb0, 0xe9, 0xc6, 0x2b, 0xbe, 0xd7, 0x91, 0xe2, 0xbb, 0x16,
-	0xb4, 0x48, 0x5d, 0x74, 0x27, 0x9a, 0x1c, 0x3d, 0x63, 0x3d, 0x51, 0x9e, 0x7b, 0x9b, 0xe8, 0xe6,
-	0xd4, 0xea, 0xde, 0xe8, 0x78, 0x3f, 0x7a, 0xe8, 0x2b, 0x16, 0x9c, 0x36, 0xa4, 0x6c, 0x95, 0x84,
-	0xdb, 0x6e, 0x8d, 0xcc, 0xd6, 0x6a, 0x41, 0xdb, 0x8f, 0xa3, 0xc9, 0x31, 0x36, 0x8c, 0xeb, 0x47,
-	0x21, 0xf3, 0x93, 0xac, 0xf4, 0xbc, 0xec, 0x89, 0x12, 0xe1, 0x3d, 0x7a, 0x6a, 0xff, 0x5e, 0x01,
-	0x8e, 0xa5, 0x2d, 0x00, 0xf4, 0x8f, 0x2d, 0x18, 0xbf, 0x71, 0x33, 0x5e, 0x0b, 0xb6, 0x88, 0x1f,
-	0xcd, 0x75, 0xa8, 0x9c, 0x66, 0xba, 0x6f, 0xf8, 0x6c, 0x2d, 0x5f, 0x5b, 0x63, 0xfa, 0x52, 0x92,
-	0xcb, 0x39, 0x3f, 0x0e, 0x3b, 0x73, 0x0f, 0x8a, 0x77, 0x1a, 0xbf, 0x74, 0x7d, 0xcd, 0x84, 0xe2,
-	0x74, 0xa7, 0x4e, 0x7f, 0xcc, 0x82, 0x13, 0x59, 0x24, 0xd0, 0x31, 0x28, 0x6e, 0x91, 0x0e, 0xb7,
-	0x84, 0x31, 0xfd, 0x89, 0x5e, 0x86, 0xd2, 0xb6, 0xe3, 0xb5, 0x89, 0x30, 0xd3, 0x2e, 0x1c, 0xee,
-	0x45, 0x54, 0xcf, 0x30, 0xa7, 0xfa, 0x83, 0x85, 0xe7, 0x2d, 0xfb, 0x0f, 0x8a, 0x30, 0x6c, 0x7c,
-	0xb4, 0xbb, 0x60, 0x7a, 0x06, 0x09, 0xd3, 0x73, 0x39, 0xb7, 0xf9, 0xd6, 0xd3, 0xf6, 0xbc, 0x99,
-	0xb2, 0x3d, 0x57, 0xf2, 0x63, 0xb9, 0xa7, 0xf1, 0x89, 0x62, 0xa8, 0x04, 0x2d, 0xba, 0x45, 0xa3,
-	0x36, 0xcc, 0x40, 0x1e, 0x9f, 0x70, 0x45, 0x92, 0x9b, 0x1b, 0xbd, 0xb5, 0x3b, 0x55, 0x51, 0x7f,
-	0xb1, 0x66, 0x64, 0x7f, 0xc3, 0x82, 0x13, 0x46, 0x1f, 0xe7, 0x03, 0xbf, 0xce, 0x36, 0x1a, 0xe8,
-	0x0c, 0x0c, 0xc4, 0x9d, 0x96, 0xdc, 0x06, 0xaa, 0x91, 0x5a, 0xeb, 0xb4, 0x08, 0x66, 0x90, 0xfb,
-	0x7d, 0x97, 0xf4, 0x69, 0x0b, 0x1e, 0xc8, 0x16, 0x30, 0xe8, 0x71, 0x18, 0xe4, 0x3e, 0x00, 0xf1,
-	0x76, 0xfa, 0x93, 0xb0, 0x56, 0x2c, 0xa0, 0x68, 0x06, 0x2a, 0x4a, 0xe1, 0x89, 0x77, 0x9c, 0x10,
-	0xa8, 0x15, 0xad, 0x25, 0x35, 0x0e, 0x1d, 0x34, 0xfa, 0x47, 0x98, 0xa0, 0x6a, 0xd0, 0xd8, 0xa6,
-	0x99, 0x41, 0xec, 0xaf, 0x5b, 0xf0, 0xd6, 0x7e, 0xc4, 0xde, 0xd1, 0xf5, 0xb1, 0x0a, 0x27, 0xeb,
-	0x64, 0xc3, 0x69, 0x7b, 0x71, 0x92, 0xa3, 0xe8, 0xf4, 0x23, 0xe2, 0xe1, 0x93, 0x0b, 0x59, 0x48,
-	0x38, 0xfb, 0x59, 0xfb, 0x3f, 0x5b, 0x6c, 0xbb, 0x2e, 0x5f, 0xeb, 0x2e, 0x6c, 0x9d, 0xfc, 0xe4,
-	0xd6, 0x69, 0x31, 0xb7, 0x65, 0xda, 0x63, 0xef, 0xf4, 0x09, 0x0b, 0x4e, 0x1b, 0x58, 0xcb, 0x4e,
-	0x5c, 0xdb, 0x3c, 0xb7, 0xd3, 0x0a, 0x49, 0x14, 0xd1, 0x29, 0xf5, 0x88, 0x21, 0x8e, 0xe7, 0x86,
-	0x05, 0x85, 0xe2, 0x65, 0xd2, 0xe1, 0xb2, 0xf9, 0x49, 0x28, 0xf3, 0x35, 0x17, 0x84, 0xe2, 0x23,
-	0xa9, 0x77, 0x5b, 0x11, 0xed, 0x58, 0x61, 0x20, 0x1b, 0x06, 0x99, 0xcc, 0xa5, 0x32, 0x88, 0x9a,
-	0x09, 0x40, 0xbf, 0xfb, 0x35, 0xd6, 0x82, 0x05, 0xc4, 0x8e, 0x12, 0xdd, 0x59, 0x0d, 0x09, 0x9b,
-	0x0f, 0xf5, 0xf3, 0x2e, 0xf1, 0xea, 0x11, 0xdd, 0xd6, 0x39, 0xbe, 0x1f, 0xc4, 0x62, 0x87, 0x66,
-	0x6c, 0xeb, 0x66, 0x75, 0x33, 0x36, 0x71, 0x28, 0x53, 0xcf, 0x59, 0x27, 0x1e, 0x1f, 0x51, 0xc1,
-	0x74, 0x89, 0xb5, 0x60, 0x01, 0xb1, 0x6f, 0x15, 0xd8, 0x06, 0x52, 0x49, 0x34, 0x72, 0x37, 0xbc,
-	0x0f, 0x61, 0x42, 0x05, 0xac, 0xe6, 0x27, 0x8f, 0x49, 0x6f, 0x0f, 0xc4, 0x6b, 0x29, 0x2d, 0x80,
-	0x73, 0xe5, 0xba, 0xb7, 0x17, 0xe2, 0x03, 0x45, 0x98, 0x4a, 0x3e, 0xd0, 0xa5, 0x44, 0xe8, 0x96,
-	0xd7, 0x60, 0x94, 0xf6, 0xd5, 0x19, 0xf8, 0xd8, 0xc4, 0xeb, 0x21, 0x87, 0x0b, 0x47, 0x29, 0x87,
-	0x4d, 0x35, 0x51, 0xdc, 0x47, 0x4d, 0x3c, 0xae, 0x46, 0x7d, 0x20, 0x25, 0xf3, 0x92, 0xaa, 0xf2,
-	0x0c, 0x0c, 0x44, 0x31, 0x69, 0x4d, 0x96, 0x92, 0x62, 0xb6, 0x1a, 0x93, 0x16, 0x66, 0x10, 0xf4,
-	0xc3, 0x30, 0x1e, 0x3b, 0x61, 0x83, 0xc4, 0x21, 0xd9, 0x76, 0x99, 0x5f, 0x97, 0xed, 0x67, 0x2b,
-	0x73, 0xc7, 0xa9, 0xd5, 0xb5, 0xc6, 0x40, 0x58, 0x82, 0x70, 0x1a, 0xd7, 0xfe, 0xef, 0x05, 0x78,
-	0x30, 0xf9, 0x09, 0xb4, 0x62, 0xfc, 0x91, 0x84, 0x62, 0x7c, 0xbb, 0xa9, 0x18, 0x6f, 0xef, 0x4e,
-	0x3d, 0xd4, 0xe3, 0xb1, 0xef, 0x18, 0xbd, 0x89, 0x2e, 0xa4, 0x3e, 0xc2, 0x4c, 0x97, 0x97, 0xf5,
-	0x91, 0x1e, 0xef, 0x98, 0xfa, 0x4a, 0x8f, 0xc3, 0x60, 0x48, 0x9c, 0x28, 0xf0, 0xc5, 0x77, 0x52,
-	0x5f, 0x13, 0xb3, 0x56, 0x2c, 0xa0, 0xf6, 0xd7, 0x2a, 0xe9, 0xc1, 0xbe, 0xc0, 0x7d, 0xd5, 0x41,
-	0x88, 0x5c, 0x18, 0x60, 0xbb, 0x36, 0x2e, 0x59, 0x2e, 0x1f, 0x6e, 0x15, 0x52, 0x2d, 0xa2, 0x48,
-	0xcf, 0x95, 0xe9, 0x57, 0xa3, 0x4d, 0x98, 0xb1, 0x40, 0x3b, 0x50, 0xae, 0xc9, 0xcd, 0x54, 0x21,
-	0x0f, 0xb7, 0xa3, 0xd8, 0x4a, 0x69, 0x8e, 0x23, 0x54, 0xdc, 0xab, 0x1d, 0x98, 0xe2, 0x86, 0x08,
-	0x14, 0x1b, 0x6e, 0x2c, 0x3e, 0xeb, 0x21, 0xb7, 0xcb, 0x17, 0x5c, 0xe3, 0x15, 0x87, 0xa8, 0x0e,
-	0xba, 0xe0, 0xc6, 0x98, 0xd2, 0x47, 0x1f, 0xb1, 0x60, 0x38, 0xaa, 0x35, 0x57, 0xc3, 0x60, 0xdb,
-	0xad, 0x93, 0x50, 0xd8, 0x98, 0x87, 0x94, 0x6c, 0xd5, 0xf9, 0x65, 0x49, 0x50, 0xf3, 0xe5, 0xee,
-	0x0b, 0x0d, 0xc1, 0x26, 0x5f, 0xba, 0xf7, 0x7a, 0x50, 0xbc, 0xfb, 0x02, 0xa9, 0xb1, 0x15, 0x27,
-	0xf7, 0xcc, 0x6c, 0xa6, 0x1c, 0xda, 0xe6, 0x5e, 0x68, 0xd7, 0xb6, 0xe8, 0x7a, 0xd3, 0x1d, 0x7a,
-	0xe8, 0xd6, 0xee, 0xd4, 0x83, 0xf3, 0xd9, 0x3c, 0x71, 0xaf, 0xce, 0xb0, 0x01, 0x6b, 0xb5, 0x3d,
-	0x0f, 0x93, 0x57, 0xdb, 0x84, 0x79, 0xc4, 0x72, 0x18, 0xb0, 0x55, 0x4d, 0x30, 0x35, 0x60, 0x06,
-	0x04, 0x9b, 0x7c, 0xd1, 0xab, 0x30, 0xd8, 0x74, 0xe2, 0xd0, 0xdd, 0x11, 0x6e, 0xb0, 0x43, 0xee,
-	0x82, 0x96, 0x19, 0x2d, 0xcd, 0x9c, 0x29, 0x7a, 0xde, 0x88, 0x05, 0x23, 0xd4, 0x84, 0x52, 0x93,
-	0x84, 0x0d, 0x32, 0x59, 0xce, 0xc3, 0xe5, 0xbf, 0x4c, 0x49, 0x69, 0x86, 0x15, 0x6a, 0x5c, 0xb1,
-	0x36, 0xcc, 0xb9, 0xa0, 0x97, 0xa1, 0x1c, 0x11, 0x8f, 0xd4, 0xa8, 0x79, 0x54, 0x61, 0x1c, 0x9f,
-	0xe9, 0xd3, 0x54, 0xa4, 0x76, 0x49, 0x55, 0x3c, 0xca, 0x17, 0x98, 0xfc, 0x87, 0x15, 0x49, 0x3a,
-	0x80, 0x2d, 0xaf, 0xdd, 0x70, 0xfd, 0x49, 0xc8, 0x63, 0x00, 0x57, 0x19, 0xad, 0xd4, 0x00, 0xf2,
-	0x46, 0x2c, 0x18, 0xd9, 0xff, 0xcd, 0x02, 0x94, 0x14, 0x6a, 0x77, 0xc1, 0x26, 0x7e, 0x35, 0x69,
-	0x13, 0x2f, 0xe5, 0x69, 0xb4, 0xf4, 0x30, 0x8b, 0x7f, 0xbd, 0x02, 0x29, 0x75, 0x70, 0x85, 0x44,
-	0x31, 0xa9, 0xbf, 0x29, 0xc2, 0xdf, 0x14, 0xe1, 0x6f, 0x8a, 0x70, 0x25, 0xc2, 0xd7, 0x53, 0x22,
-	0xfc, 0x9d, 0xc6, 0xaa, 0xd7, 0xb1, 0x07, 0xaf, 0xa8, 0xe0, 0x04, 0xb3, 0x07, 0x06, 0x02, 0x95,
-	0x04, 0x97, 0xaa, 0x2b, 0x57, 0x32, 0x65, 0xf6, 0x2b, 0x49, 0x99, 0x7d, 0x58, 0x16, 0x7f, 0x1d,
-	0xa4, 0xf4, 0x57, 0x2c, 0x78, 0x5b, 0x52, 0x7a, 0xc9, 0x99, 0xb3, 0xd8, 0xf0, 0x83, 0x90, 0x2c,
-	0xb8, 0x1b, 0x1b, 0x24, 0x24, 0x7e, 0x8d, 0x44, 0xca, 0xb7, 0x63, 0xf5, 0xf2, 0xed, 0xa0, 0x67,
-	0x61, 0xe4, 0x46, 0x14, 0xf8, 0xab, 0x81, 0xeb, 0x0b, 0x11, 0x44, 0x77, 0x1c, 0xc7, 0x6e, 0xed,
-	0x4e, 0x8d, 0xd0, 0x11, 0x95, 0xed, 0x38, 0x81, 0x85, 0xe6, 0x61, 0xe2, 0xc6, 0xab, 0xab, 0x4e,
-	0x6c, 0x78, 0x13, 0xe4, 0xbe, 0x9f, 0x9d, 0x47, 0x5d, 0x7a, 0x31, 0x05, 0xc4, 0xdd, 0xf8, 0xf6,
-	0xdf, 0x2b, 0xc0, 0xa9, 0xd4, 0x8b, 0x04, 0x9e, 0x17, 0xb4, 0x63, 0xba, 0x27, 0x42, 0x9f, 0xb7,
-	0xe0, 0x58, 0x33, 0xe9, 0xb0, 0x88, 0x84, 0xbb, 0xfb, 0xc7, 0x72, 0xd3, 0x11, 0x29, 0x8f, 0xc8,
-	0xdc, 0xa4, 0x18, 0xa1, 0x63, 0x29, 0x40, 0x84, 0xbb, 0xfa, 0x82, 0x5e, 0x86, 0x4a, 0xd3, 0xd9,
-	0xb9, 0xda, 0xaa, 0x3b, 0xb1, 0xdc, 0x8e, 0xf6, 0xf6, 0x22, 0xb4, 0x63, 0xd7, 0x9b, 0xe6, 0x51,
-	0x2d, 0xd3, 0x8b, 0x7e, 0xbc, 0x12, 0x56, 0xe3, 0xd0, 0xf5, 0x1b, 0xdc, 0xc9, 0xb9, 0x2c, 0xc9,
-	0x60, 0x4d, 0xd1, 0xfe, 0x9c, 0x95, 0x56, 0x52, 0x6a, 0x74, 0x42, 0x27, 0x26, 0x8d, 0x0e, 0x7a,
-	0x1f, 0x94, 0xe8, 0xbe, 0x51, 0x8e, 0xca, 0xf5, 0x3c, 0x35, 0xa7, 0xf1, 0x25, 0xb4, 0x12, 0xa5,
-	0xff, 0x22, 0xcc, 0x99, 0xda, 0x9f, 0xaf, 0xa4, 0x8d, 0x05, 0x76, 0x36, 0x7f, 0x16, 0xa0, 0x11,
-	0xac, 0x91, 0x66, 0xcb, 0xa3, 0xc3, 0x62, 0xb1, 0x03, 0x1e, 0xe5, 0x2a, 0xb9, 0xa0, 0x20, 0xd8,
-	0xc0, 0x42, 0x3f, 0x67, 0x01, 0x34, 0xe4, 0x9c, 0x97, 0x86, 0xc0, 0xd5, 0x3c, 0x5f, 0x47, 0xaf,
-	0x28, 0xdd, 0x17, 0xc5, 0x10, 0x1b, 0xcc, 0xd1, 0x4f, 0x5b, 0x50, 0x8e, 0x65, 0xf7, 0xb9, 0x6a,
-	0x5c, 0xcb, 0xb3, 0x27, 0xf2, 0xa5, 0xb5, 0x4d, 0xa4, 0x86, 0x44, 0xf1, 0x45, 0x3f, 0x63, 0x01,
-	0x44, 0x1d, 0xbf, 0xb6, 0x1a, 0x78, 0x6e, 0xad, 0x23, 0x34, 0xe6, 0xb5, 0x5c, 0xdd, 0x39, 0x8a,
-	0xfa, 0xdc, 0x18, 0x1d, 0x0d, 0xfd, 0x1f, 0x1b, 0x9c, 0xd1, 0xfb, 0xa1, 0x1c, 0x89, 0xe9, 0x26,
-	0x74, 0xe4, 0x5a, 0xbe, 0x4e, 0x25, 0x4e, 0x5b, 0x88, 0x57, 0xf1, 0x0f, 0x2b, 0x9e, 0xe8, 0x33,
-	0x16, 0x8c, 0xb7, 0x92, 0x6e, 0x42, 0xa1, 0x0e, 0xf3, 0x93, 0x01, 0x29, 0x37, 0x24, 0xf7, 0xb6,
-	0xa4, 0x1a, 0x71, 0xba, 0x17, 0x54, 0x02, 0xea, 0x19, 0xbc, 0xd2, 0xe2, 0x2e, 0xcb, 0x21, 0x2d,
-	0x01, 0x2f, 0xa4, 0x81, 0xb8, 0x1b, 0x1f, 0xad, 0xc2, 0x09, 0xda, 0xbb, 0x0e, 0x37, 0x3f, 0xa5,
-	0x7a, 0x89, 0x98, 0x32, 0x2c, 0xcf, 0x3d, 0x2c, 0x66, 0x08, 0x3b, 0xeb, 0x48, 0xe3, 0xe0, 0xcc,
-	0x27, 0xd1, 0x1f, 0x58, 0xf0, 0xb0, 0xcb, 0xd4, 0x80, 0xe9, 0xb0, 0xd7, 0x1a, 0x41, 0x1c, 0xb4,
-	0x93, 0x5c, 0x65, 0x45, 0x2f, 0xf5, 0x33, 0xf7, 0x56, 0xf1, 0x06, 0x0f, 0x2f, 0xee, 0xd1, 0x25,
-	0xbc, 0x67, 0x87, 0xd1, 0x0f, 0xc0, 0xa8, 0x5c, 0x17, 0xab, 0x54, 0x04, 0x33, 0x45, 0x5b, 0x99,
-	0x9b, 0xb8, 0xb5, 0x3b, 0x35, 0xba, 0x66, 0x02, 0x70, 0x12, 0xcf, 0xfe, 0xd7, 0xc5, 0xc4, 0x29,
-	0x91, 0xf2, 0x61, 0x32, 0x71, 0x53, 0x93, 0xfe, 0x1f, 0x29, 0x3d, 0x73, 0x15, 0x37, 0xca, 0xbb,
-	0xa4, 0xc5, 0x8d, 0x6a, 0x8a, 0xb0, 0xc1, 0x9c, 0x1a, 0xa5, 0x13, 0x4e, 0xda, 0x53, 0x2a, 0x24,
-	0xe0, 0xcb, 0x79, 0x76, 0xa9, 0xfb, 0x4c, 0xef, 0x94, 0xe8, 0xda, 0x44, 0x17, 0x08, 0x77, 0x77,
-	0x09, 0xfd, 0x24, 0x54, 0x42, 0x15, 0xd9, 0x52, 0xcc, 0x63, 0xab, 0x26, 0xa7, 0x8d, 0xe8, 0x8e,
-	0x3a, 0x00, 0xd2, 0x31, 0x2c, 0x9a, 0xa3, 0xfd, 0xfb, 0xc9, 0x83, 0x31, 0x43, 0x76, 0xf4, 0x71,
-	0xe8, 0xf7, 0x49, 0x0b, 0x86, 0xc3, 0xc0, 0xf3, 0x5c, 0xbf, 0x41, 0xe5, 0x9c, 0x50, 0xd6, 0xef,
-	0x3e, 0x12, 0x7d, 0x29, 0x04, 0x1a, 0xb3, 0xac, 0xb1, 0xe6, 0x89, 0xcd, 0x0e, 0xd8, 0xdf, 0xb4,
-	0x60, 0xb2, 0x97, 0x3c, 0x46, 0x04, 0x1e, 0x92, 0xc2, 0x46, 0x0d, 0xc5, 0x8a, 0xbf, 0x40, 0x3c,
-	0xa2, 0xdc, 0xe6, 0xe5, 0xb9, 0xc7, 0xc4, 0x6b, 0x3e, 0xb4, 0xda, 0x1b, 0x15, 0xef, 0x45, 0x07,
-	0xbd, 0x04, 0xc7, 0x8c, 0xf7, 0x8a, 0xd4, 0xc0, 0x54, 0xe6, 0xa6, 0xa9, 0x01, 0x34, 0x9b, 0x82,
-	0xdd, 0xde, 0x9d, 0x7a, 0x20, 0xdd, 0x26, 0x14, 0x46, 0x17, 0x1d, 0xfb, 0x97, 0x0b, 0xe9, 0xaf,
-	0xa5, 0x74, 0xfd, 0x1b, 0x56, 0x97, 0x37, 0xe1, 0xc7, 0x8e, 0x42, 0xbf, 0x32, 0xbf, 0x83, 0x0a,
-	0xc3, 0xe8, 0x8d, 0x73, 0x0f, 0x8f, 0xed, 0xed, 0x7f, 0x33, 0x00, 0x7b, 0xf4, 0xac, 0x0f, 0xe3,
-	0xfd, 0xc0, 0xe7, 0xa8, 0x1f, 0xb7, 0xd4, 0x81, 0x19, 0x5f, 0xc3, 0xf5, 0xa3, 0x1a, 0x7b, 0xbe,
-	0x7f, 0x8a, 0x78, 0xe8, 0x88, 0xf2, 0xa2, 0x27, 0x8f, 0xe6, 0xd0, 0x17, 0xac, 0xe4, 0x91, 0x1f,
-	0x0f, 0x6a, 0x74, 0x8f, 0xac, 0x4f, 0xc6, 0x39, 0x22, 0xef, 0x98, 0x3e, 0x7d, 0xea, 0x75, 0xc2,
-	0x38, 0x0d, 0xb0, 0xe1, 0xfa, 0x8e, 0xe7, 0xbe, 0x46, 0x77, 0x47, 0x25, 0xa6, 0xe0, 0x99, 0xc5,
-	0x74, 0x5e, 0xb5, 0x62, 0x03, 0xe3, 0xf4, 0xdf, 0x84, 0x61, 0xe3, 0xcd, 0x33, 0x22, 0x5e, 0x4e,
-	0x98, 0x11, 0x2f, 0x15, 0x23, 0x50, 0xe5, 0xf4, 0x3b, 0xe1, 0x58, 0xba, 0x83, 0x07, 0x79, 0xde,
-	0xfe, 0xdf, 0x43, 0xe9, 0x33, 0xb8, 0x35, 0x12, 0x36, 0x69, 0xd7, 0xde, 0x74, 0x6c, 0xbd, 0xe9,
-	0xd8, 0x7a, 0xd3, 0xb1, 0x65, 0x9e, 0x4d, 0x08, 0xa7, 0xcd, 0xd0, 0x5d, 0x72, 0xda, 0x24, 0xdc,
-	0x50, 0xe5, 0xdc, 0xdd, 0x50, 0xf6, 0x47, 0xba, 0x3c, 0xf7, 0x6b, 0x21, 0x21, 0x28, 0x80, 0x92,
-	0x1f, 0xd4, 0x89, 0xb4, 0x71, 0x2f, 0xe5, 0x63, 0xb0, 0x5d, 0x09, 0xea, 0x46, 0xb8, 0x38, 0xfd,
-	0x17, 0x61, 0xce, 0xc7, 0xfe, 0xf0, 0x20, 0x24, 0xcc, 0x49, 0xfe, 0xdd, 0xbf, 0x0f, 0x86, 0x42,
-	0xd2, 0x0a, 0xae, 0xe2, 0x25, 0xa1, 0xcb, 0x74, 0xb6, 0x0d, 0x6f, 0xc6, 0x12, 0x4e, 0x75, 0x5e,
-	0xcb, 0x89, 0x37, 0x85, 0x32, 0x53, 0x3a, 0x6f, 0xd5, 0x89, 0x37, 0x31, 0x83, 0xa0, 0x77, 0xc2,
-	0x58, 0x9c, 0x38, 0x0a, 0x17, 0x47, 0xbe, 0x0f, 0x08, 0xdc, 0xb1, 0xe4, 0x41, 0x39, 0x4e, 0x61,
-	0xa3, 0x57, 0x61, 0x60, 0x93, 0x78, 0x4d, 0xf1, 0xe9, 0xab, 0xf9, 0xe9, 0x1a, 0xf6, 0xae, 0x17,
-	0x89, 0xd7, 0xe4, 0x92, 0x90, 0xfe, 0xc2, 0x8c, 0x15, 0x9d, 0xf7, 0x95, 0xad, 0x76, 0x14, 0x07,
-	0x4d, 0xf7, 0x35, 0xe9, 0xe9, 0xfc, 0xb1, 0x9c, 0x19, 0x5f, 0x96, 0xf4, 0xb9, 0x4b, 0x49, 0xfd,
-	0xc5, 0x9a, 0x33, 0xeb, 0x47, 0xdd, 0x0d, 0xd9, 0x94, 0xe9, 0x08, 0x87, 0x65, 0xde, 0xfd, 0x58,
-	0x90, 0xf4, 0x79, 0x3f, 0xd4, 0x5f, 0xac, 0x39, 0xa3, 0x8e, 0x5a, 0x7f, 0xc3, 0xac, 0x0f, 0x57,
-	0x73, 0xee, 0x03, 0x5f, 0x7b, 0x99, 0xeb, 0xf0, 0x31, 0x28, 0xd5, 0x36, 0x9d, 0x30, 0x9e, 0x1c,
-	0x61, 0x93, 0x46, 0xcd, 0xe2, 0x79, 0xda, 0x88, 0x39, 0x0c, 0x3d, 0x02, 0xc5, 0x90, 0x6c, 0xb0,
-	0xe8, 0x64, 0x23, 0x2e, 0x0a, 0x93, 0x0d, 0x4c, 0xdb, 0x95, 0x5d, 0x36, 0xd6, 0x33, 0x60, 0xee,
-	0x17, 0x0b, 0x49, 0xc3, 0x2e, 0x39, 0x32, 0x7c, 0x3d, 0xd4, 0xda, 0x61, 0x24, 0x1d, 0x64, 0xc6,
-	0x7a, 0x60, 0xcd, 0x58, 0xc2, 0xd1, 0x07, 0x2d, 0x18, 0xba, 0x11, 0x05, 0xbe, 0x4f, 0x62, 0xa1,
-	0x44, 0xaf, 0xe5, 0x3c, 0x58, 0x97, 0x38, 0x75, 0xdd, 0x07, 0xd1, 0x80, 0x25, 0x5f, 0xda, 0x5d,
-	0xb2, 0x53, 0xf3, 0xda, 0xf5, 0xae, 0x60, 0x98, 0x73, 0xbc, 0x19, 0x4b, 0x38, 0x45, 0x75, 0x7d,
-	0x8e, 0x3a, 0x90, 0x44, 0x5d, 0xf4, 0x05, 0xaa, 0x80, 0xdb, 0xbf, 0x5a, 0x86, 0x93, 0x99, 0xcb,
-	0x87, 0x9a, 0x5c, 0xcc, 0xa8, 0x39, 0xef, 0x7a, 0x44, 0x86, 0x81, 0x31, 0x93, 0xeb, 0x9a, 0x6a,
-	0xc5, 0x06, 0x06, 0xfa, 0x29, 0x80, 0x96, 0x13, 0x3a, 0x4d, 0xa2, 0x1c, 0xd8, 0x87, 0xb6, 0x6c,
-	0x68, 0x3f, 0x56, 0x25, 0x4d, 0xbd, 0x89, 0x57, 0x4d, 0x11, 0x36, 0x58, 0xa2, 0xe7, 0x60, 0x38,
-	0x24, 0x1e, 0x71, 0x22, 0x16, 0xfe, 0x9e, 0xce, 0xe5, 0xc1, 0x1a, 0x84, 0x4d, 0x3c, 0xf4, 0xb8,
-	0x8a, 0x98, 0x4b, 0x45, 0x0e, 0x25, 0xa3, 0xe6, 0xd0, 0xa7, 0x2c, 0x18, 0xdb, 0x70, 0x3d, 0xa2,
-	0xb9, 0x8b, 0xcc, 0x9b, 0x95, 0xc3, 0xbf, 0xe4, 0x79, 0x93, 0xae, 0x96, 0xa1, 0x89, 0xe6, 0x08,
-	0xa7, 0xd8, 0xd3, 0xcf, 0xbc, 0x4d, 0x42, 0x26, 0x7c, 0x07, 0x93, 0x9f, 0xf9, 0x1a, 0x6f, 0xc6,
-	0x12, 0x8e, 0x66, 0x61, 0xbc, 0xe5, 0x44, 0xd1, 0x7c, 0x48, 0xea, 0xc4, 0x8f, 0x5d, 0xc7, 0xe3,
-	0x79, 0x31, 0x65, 0x1d, 0x4e, 0xbe, 0x9a, 0x04, 0xe3, 0x34, 0x3e, 0x7a, 0x17, 0x3c, 0xc8, 0x3d,
-	0x44, 0xcb, 0x6e, 0x14, 0xb9, 0x7e, 0x43, 0x4f, 0x03, 0xe1, 0x28, 0x9b, 0x12, 0xa4, 0x1e, 0x5c,
-	0xcc, 0x46, 0xc3, 0xbd, 0x9e, 0x47, 0x4f, 0x42, 0x39, 0xda, 0x72, 0x5b, 0xf3, 0x61, 0x3d, 0x62,
-	0xa7, 0x43, 0x65, 0xed, 0x96, 0xad, 0x8a, 0x76, 0xac, 0x30, 0x50, 0x0d, 0x46, 0xf8, 0x27, 0xe1,
-	0x21, 0x7f, 0x42, 0x82, 0x3e, 0xd5, 0x53, 0x91, 0x8b, 0x14, 0xd8, 0x69, 0xec, 0xdc, 0x3c, 0x27,
-	0xcf, 0xaa, 0xf8, 0xd1, 0xca, 0x35, 0x83, 0x0c, 0x4e, 0x10, 0x4d, 0xee, 0xe9, 0x86, 0xfb, 0xd8,
-	0xd3, 0x3d, 0x07, 0xc3, 0x5b, 0xed, 0x75, 0x22, 0x46, 0x5e, 0x08, 0x36, 0x35, 0xfb, 0x2e, 0x6b,
-	0x10, 0x36, 0xf1, 0x58, 0xb4, 0x65, 0xcb, 0x15, 0xff, 0xa2, 0xc9, 0x51, 0x23, 0xda, 0x72, 0x75,
-	0x51, 0x36, 0x63, 0x13, 0x87, 0x76, 0x8d, 0x8e, 0xc5, 0x1a, 0x89, 0x58, 0x32, 0x05, 0x1d, 0x2e,
-	0xd5, 0xb5, 0xaa, 0x04, 0x60, 0x8d, 0x83, 0x56, 0xe1, 0x04, 0xfd, 0x53, 0x65, 0x29, 0xc0, 0xd7,
-	0x1c, 0xcf, 0xad, 0xf3, 0xd0, 0xbf, 0xf1, 0xa4, 0x7f, 0xb3, 0x9a, 0x81, 0x83, 0x33, 0x9f, 0xb4,
-	0x3f, 0x5b, 0x48, 0x7a, 0x4e, 0x4c, 0x11, 0x86, 0x22, 0x2a, 0xa8, 0xe2, 0x6b, 0x4e, 0x28, 0x0d,
-	0x9e, 0x43, 0x26, 0x37, 0x09, 0xba, 0xd7, 0x9c, 0xd0, 0x14, 0x79, 0x8c, 0x01, 0x96, 0x9c, 0xd0,
-	0x0d, 0x18, 0x88, 0x3d, 0x27, 0xa7, 0x6c, 0x48, 0x83, 0xa3, 0x76, 0x64, 0x2d, 0xcd, 0x46, 0x98,
-	0xf1, 0x40, 0x0f, 0xd3, 0xdd, 0xdb, 0xba, 0x3c, 0x69, 0x13, 0x1b, 0xae, 0xf5, 0x08, 0xb3, 0x56,
-	0xfb, 0x6f, 0x8f, 0x66, 0x68, 0x1d, 0x65, 0x08, 0xa0, 0xb3, 0x00, 0x74, 0xd2, 0xac, 0x86, 0x64,
-	0xc3, 0xdd, 0x11, 0x86, 0x98, 0x92, 0x6c, 0x57, 0x14, 0x04, 0x1b, 0x58, 0xf2, 0x99, 0x6a, 0x7b,
-	0x83, 0x3e, 0x53, 0xe8, 0x7e, 0x86, 0x43, 0xb0, 0x81, 0x85, 0x9e, 0x85, 0x41, 0xb7, 0xe9, 0x34,
-	0x54, 0x20, 0xf0, 0xc3, 0x54, 0xa4, 0x2d, 0xb2, 0x96, 0xdb, 0xbb, 0x53, 0x63, 0xaa, 0x43, 0xac,
-	0x09, 0x0b, 0x5c, 0xf4, 0xcb, 0x16, 0x8c, 0xd4, 0x82, 0x66, 0x33, 0xf0, 0xf9, 0xf6, 0x59, 0xf8,
-	0x02, 0x6e, 0x1c, 0x95, 0x99, 0x34, 0x3d, 0x6f, 0x30, 0xe3, 0xce, 0x00, 0x95, 0xb6, 0x69, 0x82,
-	0x70, 0xa2, 0x57, 0xa6, 0xe4, 0x2b, 0xed, 0x23, 0xf9, 0x7e, 0xcd, 0x82, 0x09, 0xfe, 0xac, 0xb1,
-	0xab, 0x17, 0x19, 0x8a, 0xc1, 0x11, 0xbf, 0x56, 0x97, 0xa3, 0x43, 0x39, 0x7b, 0xbb, 0xe0, 0xb8,
-	0xbb, 0x93, 0xe8, 0x02, 0x4c, 0x6c, 0x04, 0x61, 0x8d, 0x98, 0x03, 0x21, 0xc4, 0xb6, 0x22, 0x74,
-	0x3e, 0x8d, 0x80, 0xbb, 0x9f, 0x41, 0xd7, 0xe0, 0x01, 0xa3, 0xd1, 0x1c, 0x07, 0x2e, 0xb9, 0x1f,
-	0x15, 0xd4, 0x1e, 0x38, 0x9f, 0x89, 0x85, 0x7b, 0x3c, 0x9d, 0x14, 0x92, 0x95, 0x3e, 0x84, 0xe4,
-	0x2b, 0x70, 0xaa, 0xd6, 0x3d, 0x32, 0xdb, 0x51, 0x7b, 0x3d, 0xe2, 0x72, 0xbc, 0x3c, 0xf7, 0x3d,
-	0x82, 0xc0, 0xa9, 0xf9, 0x5e, 0x88, 0xb8, 0x37, 0x0d, 0xf4, 0x3e, 0x28, 0x87, 0x84, 0x7d, 0x95,
-	0x48, 0xa4, 0xeb, 0x1d, 0xd2, 0xdb, 0xa1, 0x2d, 0x78, 0x4e, 0x56, 0x6b, 0x26, 0xd1, 0x10, 0x61,
-	0xc5, 0x11, 0xdd, 0x84, 0xa1, 0x96, 0x13, 0xd7, 0x36, 0x45, 0x92, 0xde, 0xa1, 0x7d, 0xf3, 0x8a,
-	0x39, 0x3b, 0x4a, 0x31, 0x4a, 0x1e, 0x70, 0x26, 0x58, 0x72, 0xa3, 0xb6, 0x5a, 0x2d, 0x68, 0xb6,
-	0x02, 0x9f, 0xf8, 0xb1, 0x54, 0x22, 0x63, 0xfc, 0xbc, 0x43, 0xb6, 0x62, 0x03, 0xa3, 0x4b, 0x97,
-	0x6b, 0xb4, 0xc9, 0x89, 0x3d, 0x74, 0xb9, 0x41, 0xad, 0xd7, 0xf3, 0x54, 0xd9, 0x30, 0xb7, 0xe2,
-	0x75, 0x37, 0xde, 0x0c, 0xda, 0xb1, 0xdc, 0x25, 0x0b, 0x45, 0xa5, 0x94, 0xcd, 0x52, 0x06, 0x0e,
-	0xce, 0x7c, 0x32, 0xad, 0x59, 0xc7, 0xef, 0x4c, 0xb3, 0x1e, 0xeb, 0x43, 0xb3, 0x56, 0xe1, 0x24,
-	0xeb, 0x81, 0xb0, 0x92, 0xa5, 0xd3, 0x32, 0x9a, 0x44, 0xac, 0xf3, 0x2a, 0xbf, 0x65, 0x29, 0x0b,
-	0x09, 0x67, 0x3f, 0x7b, 0xfa, 0x47, 0x60, 0xa2, 0x4b, 0xc8, 0x1d, 0xc8, 0x21, 0xb9, 0x00, 0x0f,
-	0x64, 0x8b, 0x93, 0x03, 0xb9, 0x25, 0x7f, 0x35, 0x15, 0x97, 0x6e, 0x6c, 0xd1, 0xfa, 0x70, 0x71,
-	0x3b, 0x50, 0x24, 0xfe, 0xb6, 0xd0, 0xae, 0xe7, 0x0f, 0x37, 0xab, 0xcf, 0xf9, 0xdb, 0x5c, 0x1a,
-	0x32, 0x3f, 0xde, 0x39, 0x7f, 0x1b, 0x53, 0xda, 0xe8, 0x17, 0xac, 0xc4, 0x06, 0x82, 0x3b, 0xc6,
-	0xdf, 0x73, 0x24, 0x7b, 0xd2, 0xbe, 0xf7, 0x14, 0xf6, 0xbf, 0x2d, 0xc0, 0x99, 0xfd, 0x88, 0xf4,
-	0x31, 0x7c, 0x8f, 0xc1, 0x60, 0xc4, 0x22, 0x4d, 0x84, 0xba, 0x1a, 0xa6, 0xab, 0x98, 0xc7, 0x9e,
-	0xbc, 0x82, 0x05, 0x08, 0x79, 0x50, 0x6c, 0x3a, 0x2d, 0xe1, 0x2f, 0x5d, 0x3c, 0x6c, 0xfe, 0x1e,
-	0xfd, 0xef, 0x78, 0xcb, 0x4e, 0x8b, 0xcf, 0x79, 0xa3, 0x01, 0x53, 0x36, 0x28, 0x86, 0x92, 0x13,
-	0x86, 0x8e, 0x0c, 0x6b, 0xb8, 0x9c, 0x0f, 0xbf, 0x59, 0x4a, 0x92, 0x9f, 0x0a, 0x27, 0x9a, 0x30,
-	0x67, 0x66, 0x7f, 0xa6, 0x9c, 0x48, 0xf6, 0x62, 0xb1, 0x2a, 0x11, 0x0c, 0x0a, 0x37, 0xa9, 0x95,
-	0x77, 0xda, 0x24, 0xcf, 0xa6, 0x66, 0x1e, 0x08, 0x51, 0x93, 0x42, 0xb0, 0x42, 0x1f, 0xb3, 0x58,
-	0xe5, 0x07, 0x99, 0x41, 0x27, 0x76, 0xf5, 0x47, 0x53, 0x88, 0xc2, 0xac, 0x27, 0x21, 0x1b, 0xb1,
-	0xc9, 0x5d, 0x54, 0xb7, 0x61, 0xbb, 0x99, 0xee, 0xea, 0x36, 0x6c, 0x77, 0x22, 0xe1, 0x68, 0x27,
-	0x23, 0x26, 0x25, 0x87, 0xea, 0x01, 0x7d, 0x44, 0xa1, 0x7c, 0xc1, 0x82, 0x09, 0x37, 0x1d, 0x5c,
-	0x20, 0xf6, 0xc0, 0xd7, 0xf3, 0xf1, 0x69, 0x76, 0xc7, 0x2e, 0x28, 0x43, 0xa7, 0x0b, 0x84, 0xbb,
-	0x3b, 0x83, 0xea, 0x30, 0xe0, 0xfa, 0x1b, 0x81, 0x30, 0xef, 0xe6, 0x0e, 0xd7, 0xa9, 0x45, 0x7f,
-	0x23, 0xd0, 0xab, 0x99, 0xfe, 0xc3, 0x8c, 0x3a, 0x5a, 0x82, 0x13, 0x32, 0xdf, 0xe7, 0xa2, 0x1b,
-	0xc5, 0x41, 0xd8, 0x59, 0x72, 0x9b, 0x6e, 0xcc, 0x4c, 0xb3, 0xe2, 0xdc, 0x24, 0x55, 0x6f, 0x38,
-	0x03, 0x8e, 0x33, 0x9f, 0x42, 0xaf, 0xc1, 0x90, 0x3c, 0xd0, 0x2f, 0xe7, 0xe1, 0x4f, 0xe8, 0x9e,
-	0xff, 0x6a, 0x32, 0x55, 0xc5, 0x89, 0xbe, 0x64, 0x88, 0x3e, 0x6a, 0xc1, 0x18, 0xff, 0x7d, 0xb1,
-	0x53, 0xe7, 0x29, 0x86, 0x95, 0x3c, 0xa2, 0xf6, 0xab, 0x09, 0x9a, 0x73, 0xe8, 0xd6, 0xee, 0xd4,
-	0x58, 0xb2, 0x0d, 0xa7, 0xf8, 0xda, 0xff, 0x64, 0x04, 0xba, 0x43, 0x20, 0x92, 0xf1, 0x0e, 0xd6,
-	0xdd, 0x8e, 0x77, 0xa0, 0xbb, 0xca, 0x48, 0x87, 0x2a, 0xe4, 0xb0, 0xcc, 0x04, 0x57, 0x7d, 0x0c,
-	0xdd, 0xf1, 0x6b, 0x98, 0xf1, 0x40, 0x6d, 0x18, 0xe4, 0xc5, 0xa5, 0x84, 0x06, 0x38, 0xfc, 0xc9,
-	0xb7, 0x59, 0xa4, 0x4a, 0xbb, 0xb5, 0x78, 0x2b, 0x16, 0xcc, 0xd0, 0x0e, 0x0c, 0x6d, 0xf2, 0xe9,
-	0x28, 0xf6, 0x7a, 0xcb, 0x87, 0x1d, 0xdf, 0xc4, 0x1c, 0xd7, 0x93, 0x4f, 0x34, 0x60, 0xc9, 0x8e,
-	0x85, 0xd7, 0x19, 0x01, 0x40, 0x5c, 0x90, 0xe4, 0x97, 0x2d, 0xd9, 0x7f, 0xf4, 0xcf, 0x7b, 0x61,
-	0x24, 0x24, 0xb5, 0xc0, 0xaf, 0xb9, 0x1e, 0xa9, 0xcf, 0xca, 0x03, 0xb1, 0x83, 0x24, 0xc9, 0x31,
-	0x6f, 0x12, 0x36, 0x68, 0xe0, 0x04, 0x45, 0xb6, 0xce, 0x54, 0xe2, 0x3c, 0xfd, 0x20, 0x44, 0x1c,
-	0x7c, 0x2c, 0xe5, 0x94, 0xa6, 0xcf, 0x68, 0xf2, 0x75, 0x96, 0x6c, 0xc3, 0x29, 0xbe, 0xe8, 0x25,
-	0x80, 0x60, 0x9d, 0xc7, 0xd0, 0xcd, 0xc6, 0xe2, 0x14, 0xe4, 0x20, 0xaf, 0x3a, 0xc6, 0x93, 0x6d,
-	0x25, 0x05, 0x6c, 0x50, 0x43, 0x97, 0x01, 0xf8, 0xca, 0x59, 0xeb, 0xb4, 0xe4, 0x86, 0x50, 0x66,
-	0x39, 0x42, 0x55, 0x41, 0x6e, 0xef, 0x4e, 0x75, 0xfb, 0x9c, 0x59, 0xa0, 0x90, 0xf1, 0x38, 0xfa,
-	0x09, 0x18, 0x8a, 0xda, 0xcd, 0xa6, 0xa3, 0xce, 0x48, 0x72, 0x4c, 0xdf, 0xe5, 0x74, 0x0d, 0xc1,
-	0xc8, 0x1b, 0xb0, 0xe4, 0x88, 0x6e, 0x50, 0x11, 0x2f, 0x24, 0x14, 0x5f, 0x45, 0xdc, 0x42, 0xe1,
-	0x9e, 0xc0, 0x77, 0xc8, 0x5d, 0x0c, 0xce, 0xc0, 0xb9, 0xbd, 0x3b, 0xf5, 0x40, 0xb2, 0x7d, 0x29,
-	0x10, 0x09, 0xb5, 0x99, 0x34, 0xd1, 0x25, 0x59, 0x47, 0x8b, 0xbe, 0xb6, 0x2c, 0xef, 0xf2, 0x84,
-	0xae, 0xa3, 0xc5, 0x9a, 0x7b, 0x8f, 0x99, 0xf9, 0x30, 0x5a, 0x86, 0xe3, 0xb5, 0xc0, 0x8f, 0xc3,
-	0xc0, 0xf3, 0x78, 0x8d, 0x3d, 0xbe, 0x37, 0xe7, 0x67, 0x28, 0x0f, 0x89, 0x6e, 0x1f, 0x9f, 0xef,
-	0x46, 0xc1, 0x59, 0xcf, 0x51, 0x9b, 0x3c, 0xad, 0x1f, 0xc6, 0x72, 0x39, 0x5e, 0x4f, 0xd0, 0x14,
-	0x12, 0x4a, 0xb9, 0xbd, 0xf7, 0xd1, 0x14, 0x7e, 0xf2, 0x90, 0x55, 0x7c, 0xb1, 0x67, 0x61, 0x84,
-	0xec, 0xc4, 0x24, 0xf4, 0x1d, 0xef, 0x2a, 0x5e, 0x92, 0x07, 0x16, 0x6c, 0x61, 0x9e, 0x33, 0xda,
-	0x71, 0x02, 0x0b, 0xd9, 0xca, 0x4b, 0x66, 0x64, 0xae, 0x73, 0x2f, 0x99, 0xf4, 0x89, 0xd9, 0x5f,
-	0x2a, 0x26, 0x6c, 0xd6, 0x7b, 0x72, 0xa4, 0xcb, 0x4a, 0x24, 0xc9, 0x5a, 0x52, 0x0c, 0x20, 0xf6,
-	0x62, 0x79, 0x72, 0x56, 0x25, 0x92, 0x56, 0x4c, 0x46, 0x38, 0xc9, 0x17, 0x6d, 0x41, 0x69, 0x33,
-	0x88, 0x62, 0xb9, 0x43, 0x3b, 0xe4, 0x66, 0xf0, 0x62, 0x10, 0xc5, 0xcc, 0xd0, 0x52, 0xaf, 0x4d,
-	0x5b, 0x22, 0xcc, 0x79, 0xd0, 0xbd, 0x7f, 0xb4, 0xe9, 0x84, 0xf5, 0x68, 0x9e, 0xd5, 0x99, 0x18,
-	0x60, 0x16, 0x96, 0xb2, 0xa7, 0xab, 0x1a, 0x84, 0x4d, 0x3c, 0xfb, 0xcf, 0xac, 0xc4, 0xa9, 0xd6,
-	0x75, 0x96, 0x34, 0xb0, 0x4d, 0x7c, 0x2a, 0xa2, 0xcc, 0x30, 0xc5, 0x1f, 0x48, 0xa5, 0x60, 0xbf,
-	0xad, 0x57, 0x39, 0xcc, 0x9b, 0x94, 0xc2, 0x34, 0x23, 0x61, 0x44, 0x34, 0x7e, 0xc0, 0x4a, 0xe6,
-	0xd2, 0x17, 0xf2, 0xd8, 0xba, 0x99, 0xf5, 0x24, 0xf6, 0x4d, 0xcb, 0xb7, 0x7f, 0xc1, 0x82, 0xa1,
-	0x39, 0xa7, 0xb6, 0x15, 0x6c, 0x6c, 0xa0, 0x27, 0xa1, 0x5c, 0x6f, 0x87, 0x66, 0x5a, 0xbf, 0x72,
-	0x56, 0x2d, 0x88, 0x76, 0xac, 0x30, 0xe8, 0xd4, 0xdf, 0x70, 0x6a, 0xb2, 0xaa, 0x44, 0x91, 0x4f,
-	0xfd, 0xf3, 0xac, 0x05, 0x0b, 0x08, 0x1d, 0xfe, 0xa6, 0xb3, 0x23, 0x1f, 0x4e, 0x1f, 0xa9, 0x2d,
-	0x6b, 0x10, 0x36, 0xf1, 0xec, 0xdf, 0xb5, 0x60, 0x72, 0xce, 0x89, 0xdc, 0xda, 0x6c, 0x3b, 0xde,
-	0x9c, 0x73, 0xe3, 0xf5, 0x76, 0x6d, 0x8b, 0xc4, 0xbc, 0xfa, 0x08, 0xed, 0x65, 0x3b, 0xa2, 0x2b,
-	0x50, 0xed, 0x98, 0x55, 0x2f, 0xaf, 0x8a, 0x76, 0xac, 0x30, 0xd0, 0x6b, 0x30, 0xdc, 0x72, 0xa2,
-	0xe8, 0x66, 0x10, 0xd6, 0x31, 0xd9, 0xc8, 0xa7, 0x3e, 0x51, 0x95, 0xd4, 0x42, 0x12, 0x63, 0xb2,
-	0x21, 0x02, 0x54, 0x34, 0x7d, 0x6c, 0x32, 0xb3, 0x7f, 0xce, 0x82, 0x13, 0x73, 0xc4, 0x09, 0x49,
-	0xc8, 0xca, 0x19, 0xa9, 0x17, 0x41, 0xaf, 0x42, 0x39, 0xa6, 0x2d, 0xb4, 0x47, 0x56, 0xbe, 0x3d,
-	0x62, 0xa1, 0x25, 0x6b, 0x82, 0x38, 0x56, 0x6c, 0xec, 0x4f, 0x5a, 0x70, 0x2a, 0xab, 0x2f, 0xf3,
-	0x5e, 0xd0, 0xae, 0xdf, 0x8b, 0x0e, 0xfd, 0x5d, 0x0b, 0x46, 0xd8, 0x71, 0xfd, 0x02, 0x89, 0x1d,
-	0xd7, 0xeb, 0x2a, 0xa5, 0x68, 0xf5, 0x59, 0x4a, 0xf1, 0x0c, 0x0c, 0x6c, 0x06, 0x4d, 0x92, 0x0e,
-	0x35, 0xb9, 0x18, 0x34, 0x09, 0x66, 0x10, 0xf4, 0x34, 0x9d, 0x84, 0xae, 0x1f, 0x3b, 0x74, 0x39,
-	0xca, 0xe3, 0x8c, 0x71, 0x3e, 0x01, 0x55, 0x33, 0x36, 0x71, 0xec, 0xdf, 0xaa, 0xc0, 0x90, 0x88,
-	0x8b, 0xea, 0xbb, 0x1a, 0x8e, 0xf4, 0xe2, 0x14, 0x7a, 0x7a, 0x71, 0x22, 0x18, 0xac, 0xb1, 0x7a,
-	0xb7, 0xc2, 0x42, 0xbf, 0x9c, 0x4b, 0x20, 0x1d, 0x2f, 0xa1, 0xab, 0xbb, 0xc5, 0xff, 0x63, 0xc1,
-	0x0a, 0xbd, 0x6e, 0xc1, 0x78, 0x2d, 0xf0, 0x7d, 0x52, 0xd3, 0xb6, 0xe3, 0x40, 0x1e, 0x1b, 0x84,
-	0xf9, 0x24, 0x51, 0x7d, 0x12, 0x9c, 0x02, 0xe0, 0x34, 0x7b, 0xf4, 0x02, 0x8c, 0xf2, 0x31, 0xbb,
-	0x96, 0x38, 0x83, 0xd1, 0x15, 0xf6, 0x4c, 0x20, 0x4e, 0xe2, 0xa2, 0x69, 0x7e, 0x96, 0x25, 0x6a,
-	0xd9, 0x0d, 0x6a, 0x57, 0xb5, 0x51, 0xc5, 0xce, 0xc0, 0x40, 0x21, 0xa0, 0x90, 0x6c, 0x84, 0x24,
-	0xda, 0x14, 0x71, 0x63, 0xcc, 0x6e, 0x1d, 0xba, 0xb3, 0x3a, 0x16, 0xb8, 0x8b, 0x12, 0xce, 0xa0,
-	0x8e, 0xb6, 0x84, 0x1b, 0xa1, 0x9c, 0x87, 0x3c, 0x17, 0x9f, 0xb9, 0xa7, 0x37, 0x61, 0x0a, 0x4a,
-	0x4c, 0x75, 0x31, 0x7b, 0xb9, 0xc8, 0x73, 0x27, 0x99, 0x62, 0xc3, 0xbc, 0x1d, 0x2d, 0xc0, 0xb1,
-	0x54, 0x7d, 0xc0, 0x48, 0x9c, 0x95, 0xa8, 0x3c, 0xb9, 0x54, 0x65, 0xc1, 0x08, 0x77, 0x3d, 0x61,
-	0xba, 0x98, 0x86, 0xf7, 0x71, 0x31, 0x75, 0x54, 0x74, 0x32, 0x3f, 0xc5, 0x78, 0x31, 0x97, 0x01,
-	0xe8, 0x2b, 0x14, 0xf9, 0x13, 0xa9, 0x50, 0xe4, 0x51, 0xd6, 0x81, 0x6b, 0xf9, 0x74, 0xe0, 0xe0,
-	0x71, 0xc7, 0xf7, 0x32, 0x8e, 0xf8, 0x7f, 0x59, 0x20, 0xbf, 0xeb, 0xbc, 0x53, 0xdb, 0x24, 0x74,
-	0xca, 0xa0, 0x77, 0xc2, 0x98, 0xf2, 0x4e, 0x70, 0x93, 0xc8, 0x62, 0xb3, 0x46, 0xd9, 0xce, 0x38,
-	0x01, 0xc5, 0x29, 0x6c, 0x34, 0x03, 0x15, 0x3a, 0x4e, 0xfc, 0x51, 0xae, 0xf7, 0x95, 0x07, 0x64,
-	0x76, 0x75, 0x51, 0x3c, 0xa5, 0x71, 0x50, 0x00, 0x13, 0x9e, 0x13, 0xc5, 0xac, 0x07, 0xd5, 0x8e,
-	0x5f, 0xbb, 0xc3, 0x2a, 0x32, 0x2c, 0x19, 0x6b, 0x29, 0x4d, 0x08, 0x77, 0xd3, 0xb6, 0xff, 0x7d,
-	0x09, 0x46, 0x13, 0x92, 0xf1, 0x80, 0x06, 0xc3, 0x93, 0x50, 0x96, 0x3a, 0x3c, 0x5d, 0x2e, 0x4b,
-	0x29, 0x7a, 0x85, 0x41, 0x95, 0xd6, 0xba, 0xd6, 0xaa, 0x69, 0x03, 0xc7, 0x50, 0xb8, 0xd8, 0xc4,
-	0x63, 0x42, 0x39, 0xf6, 0xa2, 0x79, 0xcf, 0x25, 0x7e, 0xcc, 0xbb, 0x99, 0x8f, 0x50, 0x5e, 0x5b,
-	0xaa, 0x9a, 0x44, 0xb5, 0x50, 0x4e, 0x01, 0x70, 0x9a, 0x3d, 0xfa, 0xb0, 0x05, 0xa3, 0xce, 0xcd,
-	0x48, 0x17, 0x65, 0x17, 0x41, 0xc7, 0x87, 0x54, 0x52, 0x89, 0x3a, 0xef, 0xdc, 0xb1, 0x9f, 0x68,
-	0xc2, 0x49, 0xa6, 0xe8, 0x0d, 0x0b, 0x10, 0xd9, 0x21, 0x35, 0x19, 0x16, 0x2d, 0xfa, 0x32, 0x98,
-	0xc7, 0x0e, 0xfe, 0x5c, 0x17, 0x5d, 0x2e, 0xd5, 0xbb, 0xdb, 0x71, 0x46, 0x1f, 0xd0, 0x25, 0x40,
-	0x75, 0x37, 0x72, 0xd6, 0x3d, 0x32, 0x1f, 0x34, 0x65, 0x02, 0xb1, 0x38, 0x4f, 0x3f, 0x2d, 0xc6,
-	0x19, 0x2d, 0x74, 0x61, 0xe0, 0x8c, 0xa7, 0xd8, 0x2c, 0x0b, 0x83, 0x9d, 0xce, 0xd5, 0xd0, 0x63,
-	0x5a, 0xc2, 0x9c, 0x65, 0xa2, 0x1d, 0x2b, 0x0c, 0xfb, 0xcf, 0x8b, 0x6a, 0x29, 0xeb, 0x1c, 0x00,
-	0xc7, 0x88, 0x45, 0xb6, 0xee, 0x3c, 0x16, 0x59, 0x47, 0x4a, 0x75, 0xa7, 0xc5, 0x27, 0xb2, 0x68,
-	0x0b, 0xf7, 0x28, 0x8b, 0xf6, 0xa7, 0xad, 0x44, 0x49, 0xba, 0xe1, 0xb3, 0x2f, 0xe5, 0x9b, 0x7f,
-	0x30, 0xcd, 0xa3, 0xb8, 0x52, 0x7a, 0x25, 0x15, 0xbc, 0xf7, 0x24, 0x94, 0x37, 0x3c, 0x87, 0x15,
-	0x52, 0x61, 0x0b, 0xd5, 0x88, 0x30, 0x3b, 0x2f, 0xda, 0xb1, 0xc2, 0xa0, 0x52, 0xdf, 0x20, 0x7a,
-	0x20, 0xa9, 0xfd, 0x9f, 0x8a, 0x30, 0x6c, 0x68, 0xfc, 0x4c, 0xf3, 0xcd, 0xba, 0xcf, 0xcc, 0xb7,
-	0xc2, 0x01, 0xcc, 0xb7, 0x9f, 0x82, 0x4a, 0x4d, 0x6a, 0xa3, 0x7c, 0x4a, 0xec, 0xa7, 0x75, 0x9c,
-	0x56, 0x48, 0xaa, 0x09, 0x6b, 0x9e, 0xe8, 0x42, 0x22, 0x53, 0x33, 0xe1, 0x17, 0xc8, 0x4a, 0xa5,
-	0x14, 0x1a, 0xad, 0xfb, 0x99, 0x74, 0x7c, 0x40, 0x69, 0xff, 0xf8, 0x00, 0xfb, 0x1b, 0x96, 0xfa,
-	0xb8, 0x77, 0xa1, 0x24, 0xcf, 0x8d, 0x64, 0x49, 0x9e, 0x73, 0xb9, 0x0c, 0x73, 0x8f, 0x5a, 0x3c,
-	0x57, 0x60, 0x68, 0x3e, 0x68, 0x36, 0x1d, 0xbf, 0x8e, 0xbe, 0x17, 0x86, 0x6a, 0xfc, 0xa7, 0xf0,
-	0xa1, 0xb1, 0xc3, 0x6a, 0x01, 0xc5, 0x12, 0x86, 0x1e, 0x86, 0x01, 0x27, 0x6c, 0x48, 0xbf, 0x19,
-	0x0b, 0x82, 0x9b, 0x0d, 0x1b, 0x11, 0x66, 0xad, 0xf6, 0x5f, 0x5a, 0x30, 0x46, 0x1f, 0x71, 0xd9,
-	0x4b, 0xb1, 0xd7, 0x79, 0x1c, 0x06, 0x9d, 0x76, 0xbc, 0x19, 0x74, 0xed, 0xc3, 0x66, 0x59, 0x2b,
-	0x16, 0x50, 0xba, 0x0f, 0x53, 0xb5, 0x1c, 0x8c, 0x7d, 0xd8, 0x02, 0x9d, 0xcb, 0x0c, 0x42, 0x4d,
-	0xd9, 0xa8, 0xbd, 0x9e, 0x75, 0x5a, 0x5a, 0xe5, 0xcd, 0x58, 0xc2, 0x29, 0xb1, 0xf5, 0xa0, 0xde,
-	0x11, 0xa1, 0xbd, 0x8a, 0xd8, 0x5c, 0x50, 0xef, 0x60, 0x06, 0x41, 0x8f, 0x40, 0x31, 0xda, 0x74,
-	0xe4, 0xb9, 0xbc, 0x8c, 0x32, 0xaf, 0x5e, 0x9c, 0xc5, 0xb4, 0x5d, 0x25, 0x4d, 0x84, 0x5e, 0x3a,
-	0xc6, 0x36, 0x99, 0x34, 0x11, 0x7a, 0xf6, 0xbf, 0x1c, 0x00, 0x16, 0x6f, 0xe3, 0x84, 0xa4, 0xbe,
-	0x16, 0xb0, 0x6a, 0xc0, 0x47, 0x7a, 0xac, 0xad, 0x37, 0xb2, 0xf7, 0xf3, 0xd1, 0xb6, 0x71, 0xbc,
-	0x59, 0xbc, 0xdb, 0xc7, 0x9b, 0xd9, 0x27, 0xd6, 0x03, 0xf7, 0xd1, 0x89, 0xb5, 0xfd, 0x71, 0x0b,
-	0x90, 0x8a, 0x9e, 0xd2, 0x21, 0x25, 0x33, 0x50, 0x51, 0xe1, 0x5a, 0x62, 0xbd, 0x68, 0xb1, 0x28,
-	0x01, 0x58, 0xe3, 0xf4, 0xe1, 0xbd, 0x78, 0x4c, 0xea, 0xac, 0x62, 0x32, 0xe7, 0x82, 0x69, 0x3a,
-	0xa1, 0xc2, 0xec, 0xdf, 0x2e, 0xc0, 0x03, 0xdc, 0x5c, 0x5a, 0x76, 0x7c, 0xa7, 0x41, 0x9a, 0xb4,
-	0x57, 0xfd, 0x06, 0x09, 0xd5, 0xe8, 0xb6, 0xd9, 0x95, 0x19, 0x12, 0x87, 0x95, 0x57, 0x5c, 0xce,
-	0x70, 0xc9, 0xb2, 0xe8, 0xbb, 0x31, 0x66, 0xc4, 0x51, 0x04, 0x65, 0x79, 0x1f, 0x91, 0xd0, 0x3f,
-	0x39, 0x31, 0x52, 0xa2, 0x58, 0x58, 0x16, 0x04, 0x2b, 0x46, 0xd4, 0x7c, 0xf0, 0x82, 0xda, 0x16,
-	0x5d, 0xf2, 0x69, 0xf3, 0x61, 0x49, 0xb4, 0x63, 0x85, 0x61, 0x37, 0x61, 0x5c, 0x8e, 0x61, 0xeb,
-	0x32, 0xe9, 0x60, 0xb2, 0x41, 0x75, 0x6e, 0x4d, 0x36, 0x19, 0x57, 0x24, 0x29, 0x9d, 0x3b, 0x6f,
-	0x02, 0x71, 0x12, 0x57, 0x16, 0x08, 0x2e, 0x64, 0x17, 0x08, 0xb6, 0x7f, 0xdb, 0x82, 0xb4, 0xd2,
-	0x37, 0xca, 0xa1, 0x5a, 0x7b, 0x96, 0x43, 0x3d, 0x40, 0x41, 0xd1, 0x1f, 0x87, 0x61, 0x27, 0xa6,
-	0x56, 0x1d, 0xf7, 0xc0, 0x14, 0xef, 0xec, 0xe4, 0x70, 0x39, 0xa8, 0xbb, 0x1b, 0x2e, 0xf3, 0xbc,
-	0x98, 0xe4, 0xec, 0x37, 0x2c, 0xa8, 0x2c, 0x84, 0x9d, 0x83, 0xa7, 0xaa, 0x75, 0x27, 0xa2, 0x15,
-	0x0e, 0x94, 0x88, 0x26, 0x53, 0xdd, 0x8a, 0xbd, 0x52, 0xdd, 0xec, 0xbf, 0x1a, 0x80, 0x89, 0xae,
-	0xdc, 0x4b, 0xf4, 0x3c, 0x8c, 0xa8, 0xaf, 0x24, 0xdd, 0xae, 0x15, 0x33, 0x78, 0x59, 0xc3, 0x70,
-	0x02, 0xb3, 0x8f, 0xa5, 0xba, 0x08, 0xc7, 0x43, 0xf2, 0x6a, 0x9b, 0xb4, 0xc9, 0xec, 0x46, 0x4c,
-	0xc2, 0x2a, 0xa9, 0x05, 0x7e, 0x9d, 0xd7, 0x13, 0x2e, 0xce, 0x3d, 0x78, 0x6b, 0x77, 0xea, 0x38,
-	0xee, 0x06, 0xe3, 0xac, 0x67, 0x50, 0x0b, 0x46, 0x3d, 0x73, 0xbf, 0x20, 0xb6, 0xa9, 0x77, 0xb4,
-	0xd5, 0x50, 0xb3, 0x35, 0xd1, 0x8c, 0x93, 0x0c, 0x92, 0x9b, 0x8e, 0xd2, 0x3d, 0xda, 0x74, 0x7c,
-	0x48, 0x6f, 0x3a, 0x78, 0x2c, 0xd0, 0xbb, 0x73, 0xce, 0xbd, 0xed, 0x67, 0xd7, 0x71, 0x98, 0x7d,
-	0xc4, 0x8b, 0x50, 0x96, 0x71, 0x92, 0x7d, 0xc5, 0x17, 0x9a, 0x74, 0x7a, 0xc8, 0xf6, 0xc7, 0xe1,
-	0xad, 0xe7, 0xc2, 0xd0, 0x18, 0xcc, 0x2b, 0x41, 0x3c, 0xeb, 0x79, 0xc1, 0x4d, 0x6a, 0xae, 0x5c,
-	0x8d, 0x88, 0xf0, 0x03, 0xda, 0xb7, 0x0b, 0x90, 0xb1, 0xa5, 0xa6, 0x6b, 0x52, 0xdb, 0x85, 0x89,
-	0x35, 0x79, 0x30, 0xdb, 0x10, 0xed, 0xf0, 0x58, 0x52, 0x6e, 0x0d, 0xbc, 0x2b, 0x6f, 0x97, 0x80,
-	0x0e, 0x2f, 0x55, 0x92, 0x52, 0x85, 0x98, 0x9e, 0x05, 0xd0, 0xe6, 0xbc, 0xb0, 0x09, 0x55, 0x70,
-	0x88, 0xb6, 0xfa, 0xb1, 0x81, 0x85, 0x9e, 0x83, 0x61, 0xd7, 0x8f, 0x62, 0xc7, 0xf3, 0x2e, 0xba,
-	0x7e, 0x2c, 0xec, 0x44, 0x65, 0xf6, 0x2c, 0x6a, 0x10, 0x36, 0xf1, 0x4e, 0xbf, 0xc3, 0xf8, 0x7e,
-	0x07, 0xf9, 0xee, 0x9b, 0x70, 0xea, 0x82, 0x1b, 0xab, 0x24, 0x45, 0x35, 0xdf, 0xa8, 0xb5, 0xae,
-	0x64, 0x95, 0xd5, 0x33, 0x2d, 0xd7, 0x48, 0x12, 0x2c, 0x24, 0x73, 0x1a, 0xd3, 0x49, 0x82, 0x76,
-	0x0d, 0x4e, 0x5c, 0x70, 0xe3, 0xf3, 0xae, 0x47, 0x8e, 0x90, 0xc9, 0x97, 0x07, 0x61, 0xc4, 0xcc,
-	0xdd, 0x3f, 0x88, 0x64, 0xff, 0x24, 0xb5, 0x63, 0xc5, 0x40, 0xb8, 0xea, 0xc0, 0xfb, 0xfa, 0xa1,
-	0x0b, 0x09, 0x64, 0x0f, 0xae, 0x61, 0xca, 0x6a, 0x9e, 0xd8, 0xec, 0x00, 0xba, 0x09, 0xa5, 0x0d,
-	0x96, 0xef, 0x56, 0xcc, 0x23, 0x54, 0x29, 0x6b, 0xf0, 0xf5, 0xca, 0xe5, 0x19, 0x73, 0x9c, 0x1f,
-	0x35, 0x3f, 0xc2, 0x64, 0x9a, 0xb5, 0x91, 0x85, 0x20, 0xf4, 0x9a, 0xc2, 0xe8, 0xa5, 0x3d, 0x4a,
-	0x77, 0xa0, 0x3d, 0x12, 0xb2, 0x7c, 0xf0, 0x1e, 0xc9, 0x72, 0x96, 0xbb, 0x18, 0x6f, 0x32, 0xe3,
-	0x58, 0xa4, 0x4d, 0x0d, 0xb1, 0x41, 0x30, 0x72, 0x17, 0x13, 0x60, 0x9c, 0xc6, 0x47, 0xef, 0x57,
-	0xda, 0xa0, 0x9c, 0xc7, 0x81, 0x82, 0x39, 0xa3, 0x8f, 0x5a, 0x11, 0x7c, 0xbc, 0x00, 0x63, 0x17,
-	0xfc, 0xf6, 0xea, 0x85, 0xd5, 0xf6, 0xba, 0xe7, 0xd6, 0x2e, 0x93, 0x0e, 0x95, 0xf6, 0x5b, 0xa4,
-	0xb3, 0xb8, 0x20, 0x56, 0x90, 0x9a, 0x33, 0x97, 0x69, 0x23, 0xe6, 0x30, 0x2a, 0xb7, 0x36, 0x5c,
-	0xbf, 0x41, 0xc2, 0x56, 0xe8, 0x0a, 0x5f, 0xbf, 0x21, 0xb7, 0xce, 0x6b, 0x10, 0x36, 0xf1, 0x28,
-	0xed, 0xe0, 0xa6, 0x4f, 0xc2, 0xf4, 0x2e, 0x61, 0x85, 0x36, 0x62, 0x0e, 0xa3, 0x48, 0x71, 0xd8,
-	0x16, 0xae, 0x34, 0x03, 0x69, 0x8d, 0x36, 0x62, 0x0e, 0x13, 0xbb, 0x74, 0x16, 0x09, 0x56, 0xea,
-	0xda, 0xa5, 0xb3, 0x20, 0x0a, 0x09, 0xa7, 0xa8, 0x5b, 0xa4, 0xb3, 0xe0, 0xc4, 0x4e, 0x7a, 0x93,
-	0x7d, 0x99, 0x37, 0x63, 0x09, 0x67, 0xc5, 0x91, 0x93, 0xc3, 0xf1, 0x1d, 0x57, 0x1c, 0x39, 0xd9,
-	0xfd, 0x1e, 0x0e, 0x99, 0xbf, 0x53, 0x80, 0x91, 0x37, 0x6f, 0x30, 0xcd, 0xb8, 0x9b, 0xe7, 0x3a,
-	0x4c, 0x74, 0x65, 0x4c, 0xf7, 0x61, 0x21, 0xed, 0x5b, 0xd1, 0xc2, 0xc6, 0x30, 0x4c, 0x09, 0xcb,
-	0xa2, 0x80, 0xf3, 0x30, 0xc1, 0x17, 0x2f, 0xe5, 0xc4, 0x12, 0x60, 0x55, 0x16, 0x3c, 0x3b, 0xcc,
-	0xba, 0x96, 0x06, 0xe2, 0x6e, 0x7c, 0xfb, 0x13, 0x16, 0x8c, 0x26, 0x92, 0xd8, 0x73, 0xb2, 0xe5,
-	0xd8, 0xea, 0x0e, 0x58, 0x14, 0x33, 0xcb, 0x2a, 0x29, 0x32, 0x35, 0xac, 0x57, 0xb7, 0x06, 0x61,
-	0x13, 0xcf, 0xfe, 0xbd, 0x22, 0x94, 0x65, 0xc4, 0x55, 0x1f, 0x5d, 0xf9, 0x98, 0x05, 0xa3, 0xea,
-	0x00, 0x91, 0x79, 0x7c, 0x0b, 0x79, 0xe4, 0xd4, 0xd1, 0x1e, 0x28, 0xff, 0x89, 0xbf, 0x11, 0xe8,
-	0x8d, 0x05, 0x36, 0x99, 0xe1, 0x24, 0x6f, 0x74, 0x0d, 0x20, 0xea, 0x44, 0x31, 0x69, 0x1a, 0xbe,
-	0x67, 0xdb, 0x98, 0x65, 0xd3, 0xb5, 0x20, 0x24, 0x74, 0x4e, 0x5d, 0x09, 0xea, 0xa4, 0xaa, 0x30,
-	0xb5, 0x85, 0xa7, 0xdb, 0xb0, 0x41, 0x09, 0xbd, 0xa6, 0x8e, 0xbb, 0x07, 0xf2, 0xd0, 0xeb, 0x72,
-	0x7c, 0xfb, 0x39, 0xef, 0x3e, 0xc4, 0xf9, 0xb2, 0xfd, 0x2b, 0x05, 0x38, 0x96, 0x1e, 0x49, 0xf4,
-	0x6e, 0x18, 0x91, 0x83, 0x66, 0xb8, 0x19, 0x64, 0x98, 0xdb, 0x08, 0x36, 0x60, 0xb7, 0x77, 0xa7,
-	0xa6, 0xba, 0xaf, 0xc2, 0x9e, 0x36, 0x51, 0x70, 0x82, 0x18, 0x3f, 0x7c, 0x16, 0x51, 0x12, 0x73,
-	0x9d, 0xd9, 0x56, 0x4b, 0x9c, 0x20, 0x1b, 0x87, 0xcf, 0x26, 0x14, 0xa7, 0xb0, 0xd1, 0x2a, 0x9c,
-	0x30, 0x5a, 0xae, 0x10, 0xb7, 0xb1, 0xb9, 0x1e, 0x84, 0x72, 0x5f, 0xfb, 0xb0, 0x0e, 0xaa, 0xed,
-	0xc6, 0xc1, 0x99, 0x4f, 0x52, 0xc3, 0xa8, 0xe6, 0xb4, 0x9c, 0x9a, 0x1b, 0x77, 0xc4, 0x19, 0x80,
-	0x12, 0xe3, 0xf3, 0xa2, 0x1d, 0x2b, 0x0c, 0xfb, 0x1f, 0x0e, 0xc0, 0x31, 0x1e, 0x45, 0x4a, 0x54,
-	0x90, 0x34, 0x7a, 0x37, 0x54, 0xa2, 0xd8, 0x09, 0xb9, 0x53, 0xc3, 0x3a, 0xb0, 0xe8, 0xd2, 0x99,
-	0xf7, 0x92, 0x08, 0xd6, 0xf4, 0xd0, 0x4b, 0xac, 0x6c, 0x99, 0x1b, 0x6d, 0x32, 0xea, 0x85, 0x3b,
-	0x73, 0x99, 0x9c, 0x57, 0x14, 0xb0, 0x41, 0x0d, 0xfd, 0x10, 0x94, 0x5a, 0x9b, 0x4e, 0x24, 0xfd,
-	0x79, 0x8f, 0x4b, 0x39, 0xb1, 0x4a, 0x1b, 0x6f, 0xef, 0x4e, 0x9d, 0x4c, 0xbf, 0x2a, 0x03, 0x60,
-	0xfe, 0x90, 0x29, 0xe5, 0x07, 0xf6, 0xbf, 0x5a, 0xa7, 0x1e, 0x76, 0xaa, 0x17, 0x67, 0xd3, 0x97,
-	0xb1, 0x2c, 0xb0, 0x56, 0x2c, 0xa0, 0x54, 0x26, 0x6d, 0x72, 0x96, 0x75, 0x8a, 0x3c, 0x98, 0xb4,
-	0x38, 0x2e, 0x6a, 0x10, 0x36, 0xf1, 0xd0, 0xc7, 0xbb, 0x63, 0x8c, 0x87, 0x8e, 0x20, 0x07, 0xa5,
-	0xdf, 0xe8, 0xe2, 0x73, 0x50, 0x11, 0x5d, 0x5d, 0x0b, 0xd0, 0xf3, 0x30, 0xc2, 0xdd, 0x45, 0x73,
-	0xa1, 0xe3, 0xd7, 0x36, 0xd3, 0x4e, 0x9e, 0x35, 0x03, 0x86, 0x13, 0x98, 0xf6, 0x32, 0x0c, 0xf4,
-	0x29, 0x64, 0xfb, 0xda, 0xbb, 0xbf, 0x08, 0x65, 0x4a, 0x4e, 0x6e, 0xd0, 0xf2, 0x20, 0x19, 0x40,
-	0x59, 0x5e, 0xd4, 0x88, 0x6c, 0x28, 0xba, 0x8e, 0x8c, 0x25, 0x51, 0x4b, 0x68, 0x31, 0x8a, 0xda,
-	0x6c, 0xda, 0x51, 0x20, 0x7a, 0x0c, 0x8a, 0x64, 0xa7, 0x95, 0x0e, 0x1a, 0x39, 0xb7, 0xd3, 0x72,
-	0x43, 0x12, 0x51, 0x24, 0xb2, 0xd3, 0x42, 0xa7, 0xa1, 0xe0, 0xd6, 0xc5, 0x8c, 0x04, 0x81, 0x53,
-	0x58, 0x5c, 0xc0, 0x05, 0xb7, 0x6e, 0xef, 0x40, 0x45, 0xdd, 0x0c, 0x89, 0xb6, 0xa4, 0x49, 0x65,
-	0xe5, 0x11, 0x45, 0x2c, 0xe9, 0xf6, 0x30, 0xa6, 0xda, 0x00, 0xba, 0xa4, 0x43, 0x5e, 0x2a, 0xf8,
-	0x0c, 0x0c, 0xd4, 0x02, 0x51, 0x8c, 0xa7, 0xac, 0xc9, 0x30, 0x5b, 0x8a, 0x41, 0xec, 0xeb, 0x30,
-	0x76, 0xd9, 0x0f, 0x6e, 0xb2, 0x0b, 0x9c, 0x58, 0xbd, 0x62, 0x4a, 0x78, 0x83, 0xfe, 0x48, 0x5b,
-	0xee, 0x0c, 0x8a, 0x39, 0x4c, 0x55, 0x52, 0x2d, 0xf4, 0xaa, 0xa4, 0x6a, 0x7f, 0xc0, 0x82, 0x11,
-	0x95, 0x1b, 0x7e, 0x61, 0x7b, 0x8b, 0xd2, 0x6d, 0x84, 0x41, 0xbb, 0x95, 0xa6, 0xcb, 0x2e, 0xa1,
-	0xc5, 0x1c, 0x66, 0x16, 0x4d, 0x28, 0xec, 0x53, 0x34, 0xe1, 0x0c, 0x0c, 0x6c, 0xb9, 0x7e, 0x3d,
-	0xed, 0x14, 0xbd, 0xec, 0xfa, 0x75, 0xcc, 0x20, 0xb4, 0x0b, 0xc7, 0x54, 0x17, 0xa4, 0xcd, 0xf4,
-	0x3c, 0x8c, 0xac, 0xb7, 0x5d, 0xaf, 0x2e, 0x0b, 0x31, 0xa7, 0x96, 0xcb, 0x9c, 0x01, 0xc3, 0x09,
-	0x4c, 0x74, 0x16, 0x60, 0xdd, 0xf5, 0x9d, 0xb0, 0xb3, 0xaa, 0x8d, 0x34, 0xa5, 0xb7, 0xe7, 0x14,
-	0x04, 0x1b, 0x58, 0xf6, 0xa7, 0x8a, 0x30, 0x96, 0xcc, 0x90, 0xef, 0xc3, 0x77, 0xf1, 0x18, 0x94,
-	0x58, 0xd2, 0x7c, 0xfa, 0xd3, 0xf2, 0xda, 0xc5, 0x1c, 0x86, 0x22, 0x18, 0xe4, 0x8b, 0x39, 0x9f,
-	0x8b, 0x3c, 0x55, 0x27, 0x95, 0x27, 0x95, 0xc5, 0x5a, 0x0b, 0xc7, 0xb4, 0x60, 0x85, 0x3e, 0x6c,
-	0xc1, 0x50, 0xd0, 0x32, 0x2b, 0x70, 0xbe, 0x2b, 0xcf, 0xea, 0x01, 0x22, 0x45, 0x57, 0xd8, 0x23,
-	0xea, 0xd3, 0xcb, 0xcf, 0x21, 0x59, 0x9f, 0xfe, 0x41, 0x18, 0x31, 0x31, 0xf7, 0x33, 0x49, 0xca,
-	0xa6, 0x49, 0xf2, 0x31, 0x73, 0x52, 0x88, 0xfa, 0x08, 0x7d, 0x2c, 0xb7, 0xab, 0x50, 0xaa, 0xa9,
-	0x80, 0xb4, 0x3b, 0x2a, 0xdf, 0xaf, 0xea, 0x87, 0xb1, 0xc3, 0x7e, 0x4e, 0xcd, 0xfe, 0x86, 0x65,
-	0xcc, 0x0f, 0x4c, 0xa2, 0xc5, 0x3a, 0x0a, 0xa1, 0xd8, 0xd8, 0xde, 0x12, 0x6a, 0xfe, 0x52, 0x4e,
-	0xc3, 0x7b, 0x61, 0x7b, 0x4b, 0xcf, 0x71, 0xb3, 0x15, 0x53, 0x66, 0x7d, 0xb8, 0xfb, 0x13, 0x65,
-	0x34, 0x8a, 0xfb, 0x97, 0xd1, 0xb0, 0xdf, 0x28, 0xc0, 0x44, 0xd7, 0xa4, 0x42, 0xaf, 0x41, 0x29,
-	0xa4, 0x6f, 0x29, 0x5e, 0x6f, 0x29, 0xb7, 0xc2, 0x17, 0xd1, 0x62, 0x5d, 0xab, 0xcf, 0x64, 0x3b,
-	0xe6, 0x2c, 0xd1, 0x25, 0x40, 0x3a, 0x6c, 0x52, 0x9d, 0x35, 0xf0, 0x57, 0x56, 0xb1, 0x55, 0xb3,
-	0x5d, 0x18, 0x38, 0xe3, 0x29, 0xf4, 0x42, 0xfa, 0xc8, 0xa2, 0x98, 0x3c, 0x2b, 0xdb, 0xeb, 0xf4,
-	0xc1, 0xfe, 0x8d, 0x02, 0x8c, 0x26, 0x0a, 0xa2, 0x22, 0x0f, 0xca, 0xc4, 0x63, 0x07, 0x99, 0x52,
-	0xd9, 0x1c, 0xf6, 0x7a, 0x13, 0xa5, 0x20, 0xcf, 0x09, 0xba, 0x58, 0x71, 0xb8, 0x3f, 0x42, 0xae,
-	0x9e, 0x87, 0x11, 0xd9, 0xa1, 0x77, 0x39, 0x4d, 0x4f, 0x0c, 0xa0, 0x9a, 0xa3, 0xe7, 0x0c, 0x18,
-	0x4e, 0x60, 0xda, 0xbf, 0x53, 0x84, 0x49, 0x7e, 0xf2, 0x5b, 0x57, 0x33, 0x4f, 0x45, 0x70, 0xfc,
-	0xbc, 0x2e, 0x5b, 0x6c, 0xe5, 0x71, 0x87, 0x77, 0x2f, 0x46, 0x7d, 0x45, 0x0a, 0x7f, 0x3e, 0x15,
-	0x29, 0xcc, 0x77, 0xa6, 0x8d, 0x23, 0xea, 0xd1, 0x77, 0x56, 0xe8, 0xf0, 0x3f, 0x2d, 0xc0, 0x78,
-	0xea, 0xaa, 0x36, 0xf4, 0xa9, 0xe4, 0xed, 0x1e, 0x56, 0x1e, 0xa7, 0x62, 0x7b, 0xde, 0xde, 0x75,
-	0xb0, 0x3b, 0x3e, 0xee, 0xd1, 0x52, 0xb1, 0xbf, 0x5e, 0x80, 0xb1, 0xe4, 0x1d, 0x73, 0xf7, 0xe1,
-	0x48, 0xbd, 0x1d, 0x2a, 0xec, 0x1a, 0xa5, 0xcb, 0xa4, 0x23, 0x0f, 0xd5, 0xf8, 0x8d, 0x35, 0xb2,
-	0x11, 0x6b, 0xf8, 0x7d, 0x71, 0x75, 0x8a, 0xfd, 0xcf, 0x2d, 0x38, 0xc9, 0xdf, 0x32, 0x3d, 0x0f,
-	0xff, 0x56, 0xd6, 0xe8, 0xbe, 0x9c, 0x6f, 0x07, 0x53, 0xe5, 0xb6, 0xf7, 0x1b, 0x5f, 0x76, 0x93,
-	0xb9, 0xe8, 0x6d, 0x72, 0x2a, 0xdc, 0x87, 0x9d, 0x3d, 0xd0, 0x64, 0xb0, 0xff, 0x43, 0x01, 0x86,
-	0x57, 0xe6, 0x17, 0x95, 0x08, 0x9f, 0x81, 0x4a, 0x2d, 0x24, 0x8e, 0xf6, 0x76, 0x98, 0x71, 0x45,
-	0x12, 0x80, 0x35, 0x0e, 0xdd, 0x34, 0xf0, 0xb8, 0xbc, 0x28, 0xbd, 0x69, 0xe0, 0x61, 0x7b, 0x11,
-	0x96, 0x70, 0xf4, 0x24, 0x94, 0x59, 0xc6, 0xec, 0xd5, 0x50, 0x6a, 0x1c, 0xbd, 0x93, 0x64, 0xed,
-	0x78, 0x09, 0x2b, 0x0c, 0x4a, 0xb8, 0x1e, 0xd4, 0x22, 0x8a, 0x9c, 0x72, 0x40, 0x2c, 0xd0, 0x66,
-	0xbc, 0x84, 0x25, 0x9c, 0x15, 0x3c, 0x64, 0x9b, 0x74, 0x8a, 0x5c, 0x4a, 0x76, 0x9a, 0xef, 0xe6,
-	0x29, 0xba, 0xc6, 0x39, 0x48, 0x61, 0xcc, 0x54, 0xd6, 0xda, 0x50, 0x7f, 0x59, 0x6b, 0xf6, 0xd7,
-	0x8b, 0xa0, 0x2f, 0xc5, 0x47, 0xae, 0x28, 0x13, 0x91, 0x4b, 0x39, 0xf7, 0x6a, 0xc7, 0xaf, 0xe9,
-	0xeb, 0xf7, 0xcb, 0xa9, 0x2a, 0x11, 0x3f, 0x6b, 0xc1, 0xb0, 0xeb, 0xbb, 0xb1, 0xeb, 0x30, 0x57,
-	0x58, 0x3e, 0x37, 0x5b, 0x2b, 0x76, 0x8b, 0x9c, 0x72, 0x10, 0x9a, 0x27, 0xdc, 0x8a, 0x19, 0x36,
-	0x39, 0xa3, 0xf7, 0x8a, 0x24, 0xa9, 0x62, 0x6e, 0xb5, 0x56, 0xca, 0xa9, 0xcc, 0xa8, 0x16, 0x35,
-	0x68, 0xe3, 0x30, 0xa7, 0x12, 0x45, 0x98, 0x92, 0x52, 0x37, 0x83, 0xa8, 0x2d, 0x03, 0x6b, 0xc6,
-	0x9c, 0x91, 0x1d, 0x01, 0xea, 0x1e, 0x8b, 0x03, 0x26, 0xa0, 0xcc, 0x40, 0xc5, 0x69, 0xc7, 0x41,
-	0x93, 0x0e, 0x93, 0x38, 0x1f, 0xd7, 0x29, 0x36, 0x12, 0x80, 0x35, 0x8e, 0xfd, 0xa9, 0x12, 0xa4,
-	0x8a, 0x36, 0xa0, 0x1d, 0xa8, 0xa8, 0xb2, 0x0d, 0xf9, 0x24, 0x74, 0xea, 0x19, 0xa5, 0x3a, 0xa3,
-	0x9a, 0xb0, 0x66, 0x86, 0x1a, 0xd2, 0xab, 0xc8, 0x57, 0xfb, 0x8b, 0x69, 0xaf, 0xe2, 0x8f, 0xf6,
-	0x77, 0xc8, 0x44, 0xe7, 0xea, 0x0c, 0x2f, 0xd3, 0x37, 0xbd, 0xaf, 0x03, 0x72, 0xbf, 0xbb, 0xbd,
-	0x3f, 0x28, 0xee, 0xe1, 0xc2, 0x24, 0x6a, 0x7b, 0xb1, 0x98, 0x0d, 0x2f, 0xe6, 0xb8, 0xca, 0x38,
-	0x61, 0x5d, 0xfc, 0x88, 0xff, 0xc7, 0x06, 0xd3, 0xa4, 0x9b, 0x78, 0xf0, 0x48, 0xdd, 0xc4, 0x43,
-	0xb9, 0xba, 0x89, 0xcf, 0x02, 0xb0, 0xb9, 0xcd, 0x03, 0xe5, 0xcb, 0xcc, 0x7b, 0xa7, 0x54, 0x0c,
-	0x56, 0x10, 0x6c, 0x60, 0xd9, 0xdf, 0x0f, 0xc9, 0xea, 0x5d, 0x68, 0x4a, 0x16, 0x0b, 0xe3, 0x07,
-	0x60, 0x2c, 0x47, 0x31, 0x51, 0xd7, 0xeb, 0xd7, 0x2c, 0x30, 0x4b, 0x8c, 0xa1, 0x57, 0x79, 0x2d,
-	0x33, 0x2b, 0x8f, 0x03, 0x15, 0x83, 0xee, 0xf4, 0xb2, 0xd3, 0x4a, 0x05, 0xf7, 0xc8, 0x82, 0x66,
-	0xa7, 0xdf, 0x01, 0x65, 0x09, 0x3d, 0x90, 0xb1, 0xfc, 0x7e, 0x38, 0x2e, 0xeb, 0x1d, 0xc8, 0xb3,
-	0x0f, 0x71, 0xc8, 0xbe, 0xbf, 0x4b, 0x4d, 0xfa, 0xc9, 0x0a, 0xbd, 0xfc, 0x64, 0x6a, 0xf7, 0x5f,
-	0xec, 0x59, 0xa5, 0xfc, 0xd7, 0x2d, 0x38, 0x93, 0xee, 0x40, 0xb4, 0x1c, 0xf8, 0x6e, 0x1c, 0x84,
-	0x55, 0x12, 0xc7, 0xae, 0xdf, 0x60, 0x25, 0x67, 0x6f, 0x3a, 0xa1, 0xbc, 0x76, 0x88, 0x09, 0xca,
-	0xeb, 0x4e, 0xe8, 0x63, 0xd6, 0x8a, 0x3a, 0x30, 0xc8, 0x23, 0x8b, 0xc5, 0x2e, 0xe8, 0x90, 0x6b,
-	0x23, 0x63, 0x38, 0xf4, 0x36, 0x8c, 0x47, 0x35, 0x63, 0xc1, 0xd0, 0xfe, 0x96, 0x05, 0x68, 0x65,
-	0x9b, 0x84, 0xa1, 0x5b, 0x37, 0x62, 0xa1, 0xd9, 0x7d, 0x96, 0xc6, 0xbd, 0x95, 0x66, 0x35, 0x8e,
-	0xd4, 0x7d, 0x96, 0xc6, 0xbf, 0xec, 0xfb, 0x2c, 0x0b, 0x07, 0xbb, 0xcf, 0x12, 0xad, 0xc0, 0xc9,
-	0x26, 0xdf, 0xc6, 0xf1, 0x3b, 0xe2, 0xf8, 0x9e, 0x4e, 0x25, 0x8e, 0x9f, 0xba, 0xb5, 0x3b, 0x75,
-	0x72, 0x39, 0x0b, 0x01, 0x67, 0x3f, 0x67, 0xbf, 0x03, 0x10, 0x0f, 0x81, 0x9e, 0xcf, 0x8a, 0xe2,
-	0xec, 0xe9, 0xd6, 0xb2, 0x3f, 0x57, 0x82, 0xf1, 0xd4, 0xa5, 0x14, 0x74, 0x0b, 0xdd, 0x1d, 0x36,
-	0x7a, 0x68, 0xfd, 0xdd, 0xdd, 0xbd, 0xbe, 0x02, 0x51, 0x7d, 0x28, 0xb9, 0x7e, 0xab, 0x1d, 0xe7,
-	0x53, 0xb7, 0x82, 0x77, 0x62, 0x91, 0x12, 0x34, 0xdc, 0xf0, 0xf4, 0x2f, 0xe6, 0x6c, 0xf2, 0x0c,
-	0x6b, 0x4d, 0x6c, 0x72, 0x06, 0xee, 0x91, 0x9b, 0xe5, 0x83, 0x3a, 0xc8, 0xb4, 0x94, 0x87, 0xc3,
-	0x36, 0x35, 0x59, 0x8e, 0x3a, 0xb2, 0xe8, 0x4b, 0x05, 0x18, 0x36, 0x3e, 0x1a, 0xfa, 0xc5, 0x64,
-	0x01, 0x4e, 0x2b, 0xbf, 0x57, 0x62, 0xf4, 0xa7, 0x75, 0x89, 0x4d, 0xfe, 0x4a, 0x8f, 0x77, 0xd7,
-	0xde, 0xbc, 0xbd, 0x3b, 0x75, 0x2c, 0x55, 0x5d, 0x33, 0x51, 0x8f, 0xf3, 0xf4, 0x4f, 0xc2, 0x78,
-	0x8a, 0x4c, 0xc6, 0x2b, 0xaf, 0x99, 0xaf, 0x7c, 0x68, 0x77, 0x9f, 0x39, 0x64, 0x5f, 0xa4, 0x43,
-	0x26, 0xd2, 0xe5, 0x03, 0x8f, 0xf4, 0xe1, 0xdb, 0x4e, 0xed, 0x2f, 0x0a, 0x7d, 0x56, 0xc5, 0x78,
-	0x02, 0xca, 0xad, 0xc0, 0x73, 0x6b, 0xae, 0xaa, 0xdf, 0xcd, 0xea, 0x70, 0xac, 0x8a, 0x36, 0xac,
-	0xa0, 0xe8, 0x26, 0x54, 0x6e, 0xdc, 0x8c, 0xf9, 0xa9, 0x9a, 0x38, 0x37, 0xc8, 0xeb, 0x30, 0x4d,
-	0x19, 0x2d, 0xea, 0xd8, 0x0e, 0x6b, 0x5e, 0xc8, 0x86, 0x41, 0xa6, 0x04, 0x65, 0xea, 0x1c, 0x3b,
-	0xd3, 0x60, 0xda, 0x31, 0xc2, 0x02, 0x62, 0xff, 0xbb, 0x61, 0x38, 0x91, 0x75, 0x33, 0x10, 0x7a,
-	0x1f, 0x0c, 0xf2, 0x3e, 0xe6, 0x73, 0xf9, 0x5c, 0x16, 0x8f, 0x0b, 0x8c, 0xa0, 0xe8, 0x16, 0xfb,
-	0x8d, 0x05, 0x4f, 0xc1, 0xdd, 0x73, 0xd6, 0xc5, 0x0c, 0x39, 0x1a, 0xee, 0x4b, 0x8e, 0xe6, 0xbe,
-	0xe4, 0x70, 0xee, 0x9e, 0xb3, 0x8e, 0x76, 0xa0, 0xd4, 0x70, 0x63, 0xe2, 0x08, 0xe7, 0xcc, 0xf5,
-	0x23, 0x61, 0x4e, 0x1c, 0x6e, 0xa5, 0xb1, 0x9f, 0x98, 0x33, 0x44, 0x5f, 0xb0, 0x60, 0x7c, 0x3d,
-	0x59, 0x8e, 0x47, 0x08, 0x4f, 0xe7, 0x08, 0x6e, 0x7f, 0x4a, 0x32, 0xe2, 0x17, 0xba, 0xa6, 0x1a,
-	0x71, 0xba, 0x3b, 0xe8, 0x43, 0x16, 0x0c, 0x6d, 0xb8, 0x9e, 0x71, 0xbd, 0xc6, 0x11, 0x7c, 0x9c,
-	0xf3, 0x8c, 0x81, 0xde, 0x71, 0xf0, 0xff, 0x11, 0x96, 0x9c, 0x7b, 0x69, 0xaa, 0xc1, 0xc3, 0x6a,
-	0xaa, 0xa1, 0x7b, 0xa4, 0xa9, 0x3e, 0x6a, 0x41, 0x45, 0x8d, 0xb4, 0x28, 0x6b, 0xf2, 0xee, 0x23,
-	0xfc, 0xe4, 0xdc, 0x23, 0xa5, 0xfe, 0x62, 0xcd, 0x1c, 0xbd, 0x6e, 0xc1, 0xb0, 0xf3, 0x5a, 0x3b,
-	0x24, 0x75, 0xb2, 0x1d, 0xb4, 0x22, 0x51, 0x6f, 0xf4, 0xe5, 0xfc, 0x3b, 0x33, 0x4b, 0x99, 0x2c,
-	0x90, 0xed, 0x95, 0x56, 0x24, 0xd2, 0x7a, 0x75, 0x03, 0x36, 0xbb, 0x80, 0x7e, 0x46, 0xeb, 0x71,
-	0xc8, 0xa3, 0xea, 0x74, 0x56, 0x6f, 0xfa, 0xca, 0x52, 0x27, 0xf0, 0x50, 0x2d, 0xf0, 0x63, 0xd7,
-	0x6f, 0x93, 0x15, 0x1f, 0x93, 0x56, 0x70, 0x25, 0x88, 0xcf, 0x07, 0x6d, 0xbf, 0x7e, 0x2e, 0x0c,
-	0x83, 0x90, 0xd5, 0x6d, 0x31, 0xee, 0x1c, 0x9d, 0xef, 0x8d, 0x8a, 0xf7, 0xa2, 0x73, 0x18, 0x9b,
-	0x61, 0xb7, 0x00, 0x53, 0xfb, 0x0c, 0x36, 0x7a, 0x1e, 0x46, 0x82, 0xb0, 0xe1, 0xf8, 0xee, 0x6b,
-	0x66, 0x29, 0x32, 0x65, 0x90, 0xae, 0x18, 0x30, 0x9c, 0xc0, 0x34, 0x6b, 0xd4, 0x14, 0xf6, 0xa9,
-	0x51, 0x73, 0x06, 0x06, 0x42, 0xd2, 0x0a, 0xd2, 0xfb, 0x2a, 0x96, 0x89, 0xc7, 0x20, 0xe8, 0x11,
-	0x28, 0x3a, 0x2d, 0x57, 0x38, 0x17, 0xd5, 0x76, 0x71, 0x76, 0x75, 0x11, 0xd3, 0xf6, 0x44, 0xc9,
-	0xac, 0xd2, 0x5d, 0x29, 0x99, 0x45, 0x35, 0xa6, 0x38, 0x3e, 0x1b, 0xd4, 0x1a, 0x33, 0x79, 0xac,
-	0x65, 0xbf, 0x51, 0x84, 0x47, 0xf6, 0x5c, 0x5a, 0x3a, 0x42, 0xdb, 0xda, 0x23, 0x42, 0x5b, 0x0e,
-	0x4f, 0x61, 0xbf, 0xe1, 0x29, 0xf6, 0x18, 0x9e, 0x0f, 0x51, 0x89, 0x21, 0x4b, 0xb8, 0xe5, 0x73,
-	0xf5, 0x79, 0xaf, 0x8a, 0x70, 0x42, 0x58, 0x48, 0x28, 0xd6, 0x7c, 0xe9, 0x76, 0x29, 0x51, 0x9f,
-	0xa5, 0x94, 0x87, 0xc6, 0xec, 0x59, 0x46, 0x8d, 0x8b, 0x89, 0x5e, 0x45, 0x5f, 0xec, 0xdf, 0x1c,
-	0x80, 0xc7, 0xfa, 0x50, 0x74, 0xe6, 0x2c, 0xb6, 0xfa, 0x9c, 0xc5, 0xdf, 0xe1, 0x9f, 0xe9, 0x23,
-	0x99, 0x9f, 0x09, 0xe7, 0xff, 0x99, 0xf6, 0xfe, 0x42, 0xec, 0x04, 0xc2, 0x8f, 0x48, 0xad, 0x1d,
-	0xf2, 0x6c, 0x15, 0x23, 0x4d, 0x77, 0x51, 0xb4, 0x63, 0x85, 0x41, 0xb7, 0xbf, 0x35, 0x87, 0x2e,
-	0xff, 0xa1, 0x9c, 0xea, 0x71, 0x98, 0x19, 0xbf, 0xdc, 0xfa, 0x9a, 0x9f, 0xa5, 0x12, 0x80, 0xb3,
-	0xb1, 0x7f, 0xd7, 0x82, 0xd3, 0xbd, 0xad, 0x11, 0xf4, 0x34, 0x0c, 0xaf, 0xb3, 0xd8, 0xc1, 0x65,
-	0x16, 0x9f, 0x24, 0xa6, 0x0e, 0x7b, 0x5f, 0xdd, 0x8c, 0x4d, 0x1c, 0x34, 0x0f, 0x13, 0x66, 0xd0,
-	0xe1, 0xb2, 0x11, 0xd8, 0xc4, 0xfc, 0x25, 0x6b, 0x69, 0x20, 0xee, 0xc6, 0x47, 0xd3, 0x00, 0xb1,
-	0x1b, 0x7b, 0x84, 0x3f, 0xcd, 0x27, 0x1a, 0x73, 0x28, 0xae, 0xa9, 0x56, 0x6c, 0x60, 0xd8, 0xdf,
-	0x2e, 0x66, 0xbf, 0x06, 0xb7, 0x72, 0x0f, 0x32, 0xfb, 0xc5, 0xdc, 0x2e, 0xf4, 0x21, 0xa1, 0x8b,
-	0x77, 0x5b, 0x42, 0x0f, 0xf4, 0x92, 0xd0, 0x68, 0x01, 0x8e, 0x19, 0xb7, 0x98, 0xf2, 0x8a, 0x2e,
-	0xfc, 0x50, 0x4a, 0x95, 0x63, 0x5b, 0x4d, 0xc1, 0x71, 0xd7, 0x13, 0xf7, 0xf9, 0x54, 0xfd, 0x4a,
-	0x01, 0x4e, 0xf5, 0xdc, 0x58, 0xdc, 0x25, 0x0d, 0x64, 0x7e, 0xfe, 0x81, 0xbb, 0xf3, 0xf9, 0xcd,
-	0x8f, 0x52, 0xda, 0xf7, 0xa3, 0xf4, 0xa3, 0xce, 0xff, 0xa8, 0xd0, 0x73, 0xb1, 0xd0, 0x8d, 0xe8,
-	0x77, 0xed, 0x48, 0xbe, 0x00, 0xa3, 0x4e, 0xab, 0xc5, 0xf1, 0x58, 0x22, 0x42, 0xaa, 0x44, 0xe4,
-	0xac, 0x09, 0xc4, 0x49, 0xdc, 0xbe, 0x06, 0xf6, 0x4f, 0x2d, 0xa8, 0x60, 0xb2, 0xc1, 0x25, 0x1c,
-	0xba, 0x21, 0x86, 0xc8, 0xca, 0xa3, 0x4e, 0x3f, 0x1d, 0xd8, 0xc8, 0x65, 0xc5, 0xeb, 0xb3, 0x06,
-	0xfb, 0xb0, 0x05, 0x07, 0xd4, 0xdd, 0xa7, 0xc5, 0xde, 0x77, 0x9f, 0xda, 0x5f, 0xae, 0xd0, 0xd7,
-	0x6b, 0x05, 0xf3, 0x21, 0xa9, 0x47, 0xf4, 0xfb, 0xb6, 0x43, 0x4f, 0x4c, 0x12, 0xf5, 0x7d, 0xaf,
-	0xe2, 0x25, 0x4c, 0xdb, 0x13, 0xe7, 0x93, 0x85, 0x03, 0x15, 0xc8, 0x2b, 0xee, 0x5b, 0x20, 0xef,
-	0x05, 0x18, 0x8d, 0xa2, 0xcd, 0xd5, 0xd0, 0xdd, 0x76, 0x62, 0x72, 0x99, 0xc8, 0x4a, 0x3a, 0xba,
-	0x58, 0x54, 0xf5, 0xa2, 0x06, 0xe2, 0x24, 0x2e, 0xba, 0x00, 0x13, 0xba, 0x4c, 0x1d, 0x09, 0x63,
-	0x96, 0xe1, 0xc7, 0x67, 0x82, 0xaa, 0x92, 0xa2, 0x0b, 0xdb, 0x09, 0x04, 0xdc, 0xfd, 0x0c, 0x95,
-	0xb9, 0x89, 0x46, 0xda, 0x91, 0xc1, 0xa4, 0xcc, 0x4d, 0xd0, 0xa1, 0x7d, 0xe9, 0x7a, 0x02, 0x2d,
-	0xc3, 0x71, 0x3e, 0x31, 0x66, 0x5b, 0x2d, 0xe3, 0x8d, 0x86, 0x92, 0xc5, 0xd1, 0x2f, 0x74, 0xa3,
-	0xe0, 0xac, 0xe7, 0xd0, 0x73, 0x30, 0xac, 0x9a, 0x17, 0x17, 0xc4, 0xd1, 0x9a, 0x72, 0xed, 0x29,
-	0x32, 0x8b, 0x75, 0x6c, 0xe2, 0xa1, 0x77, 0xc1, 0x83, 0xfa, 0x2f, 0xcf, 0x18, 0xe7, 0xe7, 0xcd,
-	0x0b, 0xa2, 0x02, 0xa8, 0xba, 0x7b, 0xeb, 0x42, 0x26, 0x5a, 0x1d, 0xf7, 0x7a, 0x1e, 0xad, 0xc3,
-	0x69, 0x05, 0x3a, 0xe7, 0xc7, 0x2c, 0xa7, 0x33, 0x22, 0x73, 0x4e, 0xc4, 0x22, 0x27, 0x80, 0xbd,
-	0xa7, 0x2d, 0xa8, 0x9f, 0xbe, 0xe0, 0xc6, 0x17, 0xb3, 0x30, 0xf1, 0x12, 0xde, 0x83, 0x0a, 0x9a,
-	0x81, 0x0a, 0xf1, 0x9d, 0x75, 0x8f, 0xac, 0xcc, 0x2f, 0x8a, 0x1d, 0xa9, 0x4e, 0x06, 0x90, 0x00,
-	0xac, 0x71, 0x54, 0x38, 0xfb, 0x48, 0xaf, 0x70, 0x76, 0xb4, 0x0a, 0x27, 0x1a, 0xb5, 0x16, 0xb5,
-	0x32, 0xdd, 0x1a, 0x99, 0xad, 0xb1, 0xe8, 0x5d, 0xfa, 0x61, 0x78, 0xd5, 0x7a, 0x95, 0x17, 0x74,
-	0x61, 0x7e, 0xb5, 0x0b, 0x07, 0x67, 0x3e, 0xc9, 0xa2, 0xbc, 0xc3, 0x60, 0xa7, 0x33, 0x79, 0x3c,
-	0x15, 0xe5, 0x4d, 0x1b, 0x31, 0x87, 0xa1, 0x4b, 0x80, 0x58, 0x6e, 0xdc, 0xc5, 0x38, 0x6e, 0x29,
-	0xb3, 0x76, 0xf2, 0x44, 0xb2, 0x1e, 0xe0, 0xf9, 0x2e, 0x0c, 0x9c, 0xf1, 0x14, 0xb5, 0x7a, 0xfc,
-	0x80, 0x51, 0x9f, 0x7c, 0x30, 0x69, 0xf5, 0x5c, 0xe1, 0xcd, 0x58, 0xc2, 0xd1, 0x8f, 0xc3, 0x64,
-	0x3b, 0x22, 0x6c, 0xc3, 0x7c, 0x3d, 0x08, 0xb7, 0xbc, 0xc0, 0xa9, 0x2f, 0xb2, 0x4b, 0x56, 0xe3,
-	0xce, 0xe4, 0x24, 0x63, 0x7e, 0x46, 0x3c, 0x3b, 0x79, 0xb5, 0x07, 0x1e, 0xee, 0x49, 0x21, 0x5d,
-	0xd0, 0xf2, 0x54, 0x9f, 0x05, 0x2d, 0x57, 0xe1, 0x84, 0xd4, 0x6b, 0x2b, 0xf3, 0x8b, 0xea, 0xa5,
-	0x27, 0x4f, 0x27, 0x6f, 0x6d, 0x5b, 0xcc, 0xc0, 0xc1, 0x99, 0x4f, 0xda, 0x7f, 0x62, 0xc1, 0xa8,
-	0x92, 0x60, 0x77, 0x21, 0x47, 0xd7, 0x4b, 0xe6, 0xe8, 0x5e, 0x38, 0xbc, 0x0e, 0x60, 0x3d, 0xef,
-	0x91, 0x51, 0xf2, 0x99, 0x51, 0x00, 0xad, 0x27, 0x94, 0x8a, 0xb6, 0x7a, 0xaa, 0xe8, 0xfb, 0x56,
-	0x46, 0x67, 0x15, 0x28, 0x2c, 0xdd, 0xdb, 0x02, 0x85, 0x55, 0x38, 0x29, 0xa7, 0x14, 0x3f, 0x52,
-	0xbe, 0x18, 0x44, 0x4a, 0xe4, 0x1b, 0xd7, 0xf0, 0x2d, 0x66, 0x21, 0xe1, 0xec, 0x67, 0x13, 0xb6,
-	0xdd, 0xd0, 0xbe, 0xb6, 0x9d, 0x92, 0x72, 0x4b, 0x1b, 0xf2, 0x92, 0xcc, 0x94, 0x94, 0x5b, 0x3a,
-	0x5f, 0xc5, 0x1a, 0x27, 0x5b, 0xd5, 0x55, 0x72, 0x52, 0x75, 0x70, 0x60, 0x55, 0x27, 0x85, 0xee,
-	0x70, 0x4f, 0xa1, 0x2b, 0x8f, 0xae, 0x46, 0x7a, 0x1e, 0x5d, 0xbd, 0x13, 0xc6, 0x5c, 0x7f, 0x93,
-	0x84, 0x6e, 0x4c, 0xea, 0x6c, 0x2d, 0x30, 0x81, 0x5c, 0xd6, 0x86, 0xce, 0x62, 0x02, 0x8a, 0x53,
-	0xd8, 0x49, 0x4d, 0x31, 0xd6, 0x87, 0xa6, 0xe8, 0xa1, 0x9f, 0xc7, 0xf3, 0xd1, 0xcf, 0xc7, 0x0e,
-	0xaf, 0x9f, 0x27, 0x8e, 0x54, 0x3f, 0xa3, 0x5c, 0xf4, 0x73, 0x5f, 0xaa, 0xcf, 0xd8, 0xa4, 0x9f,
-	0xd8, 0x67, 0x93, 0xde, 0x4b, 0x39, 0x9f, 0xbc, 0x63, 0xe5, 0x9c, 0xad, 0x77, 0x1f, 0x78, 0x53,
-	0xef, 0xe6, 0xa2, 0x77, 0x3f, 0x5a, 0x80, 0x93, 0x5a, 0x33, 0x51, 0x79, 0xe0, 0x6e, 0x50, 0xd9,
-	0xcc, 0x6e, 0x9e, 0xe6, 0x07, 0xde, 0x46, 0x66, 0xb8, 0xce, 0x8d, 0x57, 0x10, 0x6c, 0x60, 0xb1,
-	0x04, 0x6b, 0x12, 0xb2, 0x3b, 0x4f, 0xd2, 0x6a, 0x6b, 0x5e, 0xb4, 0x63, 0x85, 0x41, 0x07, 0x81,
-	0xfe, 0x16, 0xf5, 0x3d, 0xd2, 0xd5, 0xb4, 0xe7, 0x35, 0x08, 0x9b, 0x78, 0xe8, 0x09, 0xce, 0x84,
-	0x89, 0x4c, 0xaa, 0xba, 0x46, 0xf8, 0xb6, 0x52, 0x49, 0x49, 0x05, 0x95, 0xdd, 0x61, 0x05, 0x00,
-	0x4a, 0xdd, 0xdd, 0x61, 0xb1, 0xa3, 0x0a, 0xc3, 0xfe, 0x9f, 0x16, 0x9c, 0xca, 0x1c, 0x8a, 0xbb,
-	0x60, 0x8e, 0xec, 0x24, 0xcd, 0x91, 0x6a, 0x5e, 0x5b, 0x52, 0xe3, 0x2d, 0x7a, 0x98, 0x26, 0xff,
-	0xd1, 0x82, 0x31, 0x8d, 0x7f, 0x17, 0x5e, 0xd5, 0x4d, 0xbe, 0x6a, 0x7e, 0xbb, 0xef, 0x4a, 0xd7,
-	0xbb, 0xfd, 0x4e, 0x01, 0x54, 0x85, 0xfb, 0xd9, 0x9a, 0xbc, 0x3f, 0x64, 0x9f, 0x10, 0x8c, 0x0e,
-	0x0c, 0xb2, 0x08, 0x92, 0x28, 0x9f, 0xe8, 0xb8, 0x24, 0x7f, 0x16, 0x8d, 0xa2, 0x0f, 0xf4, 0xd8,
-	0xdf, 0x08, 0x0b, 0x86, 0xec, 0x46, 0x1e, 0x5e, 0x3c, 0xbc, 0x2e, 0xf2, 0x84, 0xf5, 0x8d, 0x3c,
-	0xa2, 0x1d, 0x2b, 0x0c, 0xaa, 0x30, 0xdd, 0x5a, 0xe0, 0xcf, 0x7b, 0x4e, 0x14, 0x09, 0x1b, 0x4e,
-	0x29, 0xcc, 0x45, 0x09, 0xc0, 0x1a, 0x87, 0x05, 0x97, 0xb8, 0x51, 0xcb, 0x73, 0x3a, 0x86, 0x8f,
-	0xc5, 0xa8, 0x63, 0xa5, 0x40, 0xd8, 0xc4, 0xb3, 0x9b, 0x30, 0x99, 0x7c, 0x89, 0x05, 0xb2, 0xc1,
-	0x22, 0xbb, 0xfb, 0x1a, 0xce, 0x19, 0xa8, 0x38, 0xec, 0xa9, 0xa5, 0xb6, 0x23, 0x64, 0x82, 0x8e,
-	0x6f, 0x96, 0x00, 0xac, 0x71, 0xec, 0x7f, 0x66, 0xc1, 0xf1, 0x8c, 0x41, 0xcb, 0x31, 0x0f, 0x3b,
-	0xd6, 0xd2, 0x26, 0xcb, 0xd4, 0xf9, 0x3e, 0x18, 0xaa, 0x93, 0x0d, 0x47, 0xc6, 0x0e, 0x9b, 0xa9,
-	0x06, 0xbc, 0x19, 0x4b, 0xb8, 0xfd, 0x1b, 0x05, 0x18, 0x4f, 0xf6, 0x35, 0x62, 0xb9, 0x8d, 0x7c,
-	0x98, 0xdc, 0xa8, 0x16, 0x6c, 0x93, 0xb0, 0x43, 0xdf, 0xdc, 0x4a, 0xe5, 0x36, 0x76, 0x61, 0xe0,
-	0x8c, 0xa7, 0xd8, 0xfd, 0x16, 0x75, 0x35, 0xda, 0x72, 0x46, 0x5e, 0xcb, 0x73, 0x46, 0xea, 0x8f,
-	0x69, 0xc6, 0x19, 0x29, 0x96, 0xd8, 0xe4, 0x4f, 0x4d, 0x2e, 0x96, 0x2c, 0x32, 0xd7, 0x76, 0xbd,
-	0xd8, 0xf5, 0xc5, 0x2b, 0x8b, 0xb9, 0xaa, 0x4c, 0xae, 0xe5, 0x6e, 0x14, 0x9c, 0xf5, 0x9c, 0xfd,
-	0xad, 0x01, 0x50, 0x35, 0x46, 0x58, 0x1c, 0x68, 0x4e, 0x51, 0xb4, 0x07, 0xcd, 0x90, 0x55, 0x73,
-	0x6b, 0x60, 0xaf, 0xc0, 0x2c, 0xee, 0x98, 0x33, 0x3d, 0xf8, 0x6a, 0xc0, 0xd6, 0x34, 0x08, 0x9b,
-	0x78, 0xb4, 0x27, 0x9e, 0xbb, 0x4d, 0xf8, 0x43, 0x83, 0xc9, 0x9e, 0x2c, 0x49, 0x00, 0xd6, 0x38,
-	0xac, 0x9c, 0xb5, 0xbb, 0xb1, 0x21, 0xbc, 0x4c, 0xba, 0x9c, 0xb5, 0xbb, 0xb1, 0x81, 0x19, 0x84,
-	0xdf, 0x80, 0x14, 0x6c, 0x89, 0x6d, 0x86, 0x71, 0x03, 0x52, 0xb0, 0x85, 0x19, 0x84, 0x7e, 0x25,
-	0x3f, 0x08, 0x9b, 0x8e, 0xe7, 0xbe, 0x46, 0xea, 0x8a, 0x8b, 0xd8, 0x5e, 0xa8, 0xaf, 0x74, 0xa5,
-	0x1b, 0x05, 0x67, 0x3d, 0x47, 0x27, 0x74, 0x2b, 0x24, 0x75, 0xb7, 0x16, 0x9b, 0xd4, 0x20, 0x39,
-	0xa1, 0x57, 0xbb, 0x30, 0x70, 0xc6, 0x53, 0x68, 0x16, 0xc6, 0x65, 0x8d, 0x18, 0x59, 0x57, 0x71,
-	0x38, 0x59, 0x9c, 0x0d, 0x27, 0xc1, 0x38, 0x8d, 0x4f, 0x85, 0x64, 0x53, 0x54, 0x85, 0x65, 0xbb,
-	0x11, 0x43, 0x48, 0xca, 0x6a, 0xb1, 0x58, 0x61, 0xd8, 0x1f, 0x2c, 0x52, 0xa5, 0xde, 0xa3, 0xf8,
-	0xf2, 0x5d, 0x8b, 0xda, 0x4e, 0xce, 0xc8, 0x81, 0x3e, 0x66, 0xe4, 0xb3, 0x30, 0x72, 0x23, 0x0a,
-	0x7c, 0x15, 0x11, 0x5d, 0xea, 0x19, 0x11, 0x6d, 0x60, 0x65, 0x47, 0x44, 0x0f, 0xe6, 0x15, 0x11,
-	0x3d, 0x74, 0x87, 0x11, 0xd1, 0xbf, 0x5f, 0x02, 0x75, 0xc5, 0xe5, 0x15, 0x12, 0xdf, 0x0c, 0xc2,
-	0x2d, 0xd7, 0x6f, 0xb0, 0x7a, 0x27, 0x5f, 0xb0, 0x64, 0xc9, 0x94, 0x25, 0x33, 0x53, 0x78, 0x23,
-	0xa7, 0x6b, 0x0a, 0x13, 0xcc, 0xa6, 0xd7, 0x0c, 0x46, 0x3c, 0xb2, 0x26, 0x55, 0x9a, 0x45, 0x1c,
-	0x1a, 0x24, 0x7a, 0x84, 0x7e, 0x12, 0x40, 0xba, 0xe4, 0x37, 0xa4, 0x04, 0x5e, 0xcc, 0xa7, 0x7f,
-	0x98, 0x6c, 0x68, 0x93, 0x7a, 0x4d, 0x31, 0xc1, 0x06, 0x43, 0xf4, 0x51, 0x9d, 0x45, 0xcd, 0x53,
-	0xa7, 0xde, 0x7b, 0x24, 0x63, 0xd3, 0x4f, 0x0e, 0x35, 0x86, 0x21, 0xd7, 0x6f, 0xd0, 0x79, 0x22,
-	0x22, 0x47, 0xdf, 0x96, 0x55, 0x4e, 0x6b, 0x29, 0x70, 0xea, 0x73, 0x8e, 0xe7, 0xf8, 0x35, 0x12,
-	0x2e, 0x72, 0x74, 0xad, 0x41, 0x45, 0x03, 0x96, 0x84, 0xba, 0xee, 0xe1, 0x2c, 0xf5, 0x73, 0x0f,
-	0xe7, 0xe9, 0x1f, 0x81, 0x89, 0xae, 0x8f, 0x79, 0xa0, 0x94, 0xe9, 0x43, 0x14, 0xd2, 0xfa, 0xcd,
-	0x41, 0xad, 0xb4, 0xae, 0x04, 0x75, 0x7e, 0xad, 0x63, 0xa8, 0xbf, 0xa8, 0x30, 0x99, 0x73, 0x9c,
-	0x22, 0x4a, 0xcd, 0x18, 0x8d, 0xd8, 0x64, 0x49, 0xe7, 0x68, 0xcb, 0x09, 0x89, 0x7f, 0xd4, 0x73,
-	0x74, 0x55, 0x31, 0xc1, 0x06, 0x43, 0xb4, 0x99, 0xc8, 0xed, 0x3b, 0x7f, 0xf8, 0xdc, 0x3e, 0x56,
-	0xdc, 0x34, 0xeb, 0xf6, 0xb3, 0xd7, 0x2d, 0x18, 0xf3, 0x13, 0x33, 0x37, 0x9f, 0x70, 0xfe, 0xec,
-	0x55, 0xc1, 0x6f, 0x48, 0x4e, 0xb6, 0xe1, 0x14, 0xff, 0x2c, 0x95, 0x56, 0x3a, 0xa0, 0x4a, 0xd3,
-	0xd7, 0xca, 0x0e, 0xf6, 0xba, 0x56, 0x16, 0xf9, 0xea, 0xbe, 0xef, 0xa1, 0x3c, 0xca, 0x91, 0x24,
-	0x2e, 0xfb, 0x86, 0x8c, 0x8b, 0xbe, 0xaf, 0x9b, 0xa9, 0xbf, 0x07, 0xbf, 0xf7, 0x79, 0xb4, 0x57,
-	0x8a, 0xb0, 0xfd, 0x7f, 0x06, 0xe0, 0x98, 0x1c, 0x11, 0x99, 0x0a, 0x44, 0xf5, 0x23, 0xe7, 0xab,
-	0x6d, 0x65, 0xa5, 0x1f, 0x2f, 0x4a, 0x00, 0xd6, 0x38, 0xd4, 0x1e, 0x6b, 0x47, 0x64, 0xa5, 0x45,
-	0xfc, 0x25, 0x77, 0x3d, 0x12, 0xc7, 0xef, 0x6a, 0xa1, 0x5c, 0xd5, 0x20, 0x6c, 0xe2, 0xb1, 0xfc,
-	0x64, 0xc3, 0x68, 0x35, 0xf3, 0x93, 0x85, 0xa1, 0x2a, 0xe1, 0xe8, 0xb3, 0x99, 0xb7, 0x41, 0xe4,
-	0x93, 0x40, 0xdb, 0x95, 0x01, 0x75, 0xb0, 0x6b, 0x20, 0xd0, 0x3f, 0xb2, 0xe0, 0x24, 0x6f, 0x95,
-	0x23, 0x79, 0xb5, 0x55, 0x77, 0x62, 0x12, 0xe5, 0x73, 0x73, 0x56, 0x46, 0xff, 0xb4, 0x17, 0x3d,
-	0x8b, 0x2d, 0xce, 0xee, 0x0d, 0xfa, 0x94, 0x05, 0xe3, 0x5b, 0x89, 0x9a, 0x56, 0x52, 0x75, 0x1c,
-	0xb6, 0xdc, 0x4c, 0x82, 0xa8, 0x5e, 0x6a, 0xc9, 0xf6, 0x08, 0xa7, 0xb9, 0xdb, 0x7f, 0x69, 0x81,
-	0x29, 0x46, 0xef, 0x7e, 0x29, 0xac, 0x83, 0x9b, 0x82, 0xd2, 0xba, 0x2c, 0xf5, 0xb4, 0x2e, 0x1f,
-	0x81, 0x62, 0xdb, 0xad, 0x8b, 0xfd, 0x85, 0x3e, 0xf0, 0x5f, 0x5c, 0xc0, 0xb4, 0xdd, 0xfe, 0x66,
-	0x49, 0xbb, 0x41, 0x44, 0x7e, 0xea, 0x77, 0xc5, 0x6b, 0x6f, 0xa8, 0x1a, 0xb7, 0xfc, 0xcd, 0xaf,
-	0x74, 0xd5, 0xb8, 0xfd, 0xa1, 0x83, 0xa7, 0x1f, 0xf3, 0x01, 0xea, 0x55, 0xe2, 0x76, 0x68, 0x9f,
-	0xdc, 0xe3, 0x1b, 0x50, 0xa6, 0x5b, 0x30, 0xe6, 0xcf, 0x2c, 0x27, 0x3a, 0x55, 0xbe, 0x28, 0xda,
-	0x6f, 0xef, 0x4e, 0xfd, 0xe0, 0xc1, 0xbb, 0x25, 0x9f, 0xc6, 0x8a, 0x3e, 0x8a, 0xa0, 0x42, 0x7f,
-	0xb3, 0x34, 0x69, 0xb1, 0xb9, 0xbb, 0xaa, 0x64, 0xa6, 0x04, 0xe4, 0x92, 0x83, 0xad, 0xf9, 0x20,
-	0x1f, 0x2a, 0x14, 0x91, 0x33, 0xe5, 0x7b, 0xc0, 0x55, 0x95, 0xac, 0x2c, 0x01, 0xb7, 0x77, 0xa7,
-	0x5e, 0x38, 0x38, 0x53, 0xf5, 0x38, 0xd6, 0x2c, 0x0c, 0xd5, 0x38, 0xdc, 0xf3, 0xc6, 0xf5, 0xff,
-	0x3b, 0xa0, 0xe7, 0xb7, 0x28, 0x7f, 0xfc, 0x5d, 0x31, 0xbf, 0x9f, 0x4f, 0xcd, 0xef, 0x33, 0x5d,
-	0xf3, 0x7b, 0x8c, 0x8e, 0x59, 0x46, 0x51, 0xe6, 0xbb, 0x6d, 0x2c, 0xec, 0xef, 0x93, 0x60, 0x56,
-	0xd2, 0xab, 0x6d, 0x37, 0x24, 0xd1, 0x6a, 0xd8, 0xf6, 0x5d, 0xbf, 0xc1, 0xa6, 0x6c, 0xd9, 0xb4,
-	0x92, 0x12, 0x60, 0x9c, 0xc6, 0xa7, 0x1b, 0x7f, 0x3a, 0x2f, 0xae, 0x3b, 0xdb, 0x7c, 0xe6, 0x19,
-	0xa5, 0x27, 0xab, 0xa2, 0x1d, 0x2b, 0x0c, 0xb4, 0x09, 0x0f, 0x4b, 0x02, 0x0b, 0xc4, 0x23, 0xf4,
-	0x85, 0x58, 0x20, 0x63, 0xd8, 0xe4, 0x69, 0x06, 0x3c, 0x16, 0xe5, 0xad, 0x82, 0xc2, 0xc3, 0x78,
-	0x0f, 0x5c, 0xbc, 0x27, 0x25, 0xfb, 0x8b, 0x2c, 0x74, 0xc1, 0xa8, 0x16, 0x41, 0x67, 0x9f, 0xe7,
-	0x36, 0x5d, 0x59, 0x21, 0x53, 0xcd, 0xbe, 0x25, 0xda, 0x88, 0x39, 0x0c, 0xdd, 0x84, 0xa1, 0x75,
-	0x7e, 0x13, 0x7b, 0x3e, 0x37, 0x20, 0x89, 0x6b, 0xdd, 0x59, 0x75, 0x6c, 0x79, 0xc7, 0xfb, 0x6d,
-	0xfd, 0x13, 0x4b, 0x6e, 0xf6, 0xd7, 0x4a, 0x30, 0x2e, 0xc3, 0xcb, 0x2e, 0xba, 0x11, 0x8b, 0x48,
-	0x30, 0xaf, 0x0c, 0x28, 0xec, 0x7b, 0x65, 0xc0, 0x7b, 0x00, 0xea, 0xa4, 0xe5, 0x05, 0x1d, 0x66,
-	0x1c, 0x0e, 0x1c, 0xd8, 0x38, 0x54, 0xfb, 0x89, 0x05, 0x45, 0x05, 0x1b, 0x14, 0x45, 0x59, 0x50,
-	0x7e, 0x03, 0x41, 0xaa, 0x2c, 0xa8, 0x71, 0x4f, 0xda, 0xe0, 0xdd, 0xbd, 0x27, 0xcd, 0x85, 0x71,
-	0xde, 0x45, 0x55, 0x93, 0xe1, 0x0e, 0x4a, 0x2f, 0xb0, 0xac, 0xb6, 0x85, 0x24, 0x19, 0x9c, 0xa6,
-	0x6b, 0x5e, 0x82, 0x56, 0xbe, 0xdb, 0x97, 0xa0, 0xbd, 0x1d, 0x2a, 0xf2, 0x3b, 0x47, 0x93, 0x15,
-	0x5d, 0x2f, 0x48, 0x4e, 0x83, 0x08, 0x6b, 0x78, 0x57, 0x79, 0x19, 0xb8, 0x57, 0xe5, 0x65, 0xec,
-	0xd7, 0x8b, 0x74, 0x57, 0xc1, 0xfb, 0x75, 0xe0, 0x3b, 0x04, 0x2f, 0x1a, 0x77, 0x08, 0x1e, 0xec,
-	0x7b, 0x96, 0x53, 0x77, 0x0d, 0x3e, 0x0c, 0x03, 0xb1, 0xd3, 0x90, 0x49, 0xb8, 0x0c, 0xba, 0xe6,
-	0x34, 0x22, 0xcc, 0x5a, 0x0f, 0x52, 0x45, 0xf9, 0x05, 0x18, 0x8d, 0xdc, 0x86, 0xef, 0xc4, 0xed,
-	0x90, 0x18, 0xe7, 0x97, 0x3a, 0x48, 0xc7, 0x04, 0xe2, 0x24, 0x2e, 0xfa, 0x90, 0x05, 0x10, 0x12,
-	0xb5, 0x67, 0x19, 0xcc, 0x63, 0x0e, 0x29, 0x31, 0x20, 0xe9, 0x9a, 0x65, 0x41, 0xd4, 0x5e, 0xc5,
-	0x60, 0x6b, 0x7f, 0xc4, 0x82, 0x89, 0xae, 0xa7, 0x50, 0x0b, 0x06, 0x6b, 0xec, 0xa6, 0xc7, 0x7c,
-	0xea, 0x4e, 0x26, 0x6f, 0x8d, 0xe4, 0xca, 0x89, 0xb7, 0x61, 0xc1, 0xc7, 0xfe, 0xf2, 0x08, 0x9c,
-	0xa8, 0xce, 0x2f, 0xcb, 0x7b, 0x7f, 0x8e, 0x2c, 0xab, 0x38, 0x8b, 0xc7, 0xdd, 0xcb, 0x2a, 0xee,
-	0xc1, 0xdd, 0x33, 0xb2, 0x8a, 0x3d, 0x23, 0xab, 0x38, 0x99, 0xe2, 0x59, 0xcc, 0x23, 0xc5, 0x33,
-	0xab, 0x07, 0xfd, 0xa4, 0x78, 0x1e, 0x59, 0x9a, 0xf1, 0x9e, 0x1d, 0x3a, 0x50, 0x9a, 0xb1, 0xca,
-	0xc1, 0xce, 0x25, 0xa3, 0xac, 0xc7, 0xa7, 0xca, 0xcc, 0xc1, 0x56, 0xf9, 0xaf, 0x3c, 0x5b, 0x52,
-	0x28, 0xbd, 0x97, 0xf3, 0xef, 0x40, 0x1f, 0xf9, 0xaf, 0x22, 0x61, 0xd3, 0xcc, 0xb9, 0x1e, 0xca,
-	0x23, 0xe7, 0x3a, 0xab, 0x3b, 0xfb, 0xe6, 0x5c, 0xbf, 0x00, 0xa3, 0x35, 0x2f, 0xf0, 0xc9, 0x6a,
-	0x18, 0xc4, 0x41, 0x2d, 0x90, 0xf7, 0x6a, 0xeb, 0x2b, 0x12, 0x4d, 0x20, 0x4e, 0xe2, 0xf6, 0x4a,
-	0xd8, 0xae, 0x1c, 0x36, 0x61, 0x1b, 0xee, 0x51, 0xc2, 0xb6, 0x91, 0x92, 0x3c, 0x9c, 0x47, 0x4a,
-	0x72, 0xd6, 0x17, 0xe9, 0x2b, 0x25, 0xf9, 0x0d, 0x7e, 0xad, 0x3c, 0xdd, 0x8c, 0x70, 0x29, 0xcc,
-	0x8e, 0xe8, 0x86, 0xcf, 0xbe, 0x72, 0x04, 0x13, 0xf6, 0x7a, 0x55, 0xb3, 0x51, 0x57, 0xcd, 0xeb,
-	0x26, 0x9c, 0xec, 0xc8, 0x61, 0xd2, 0x98, 0x3f, 0x57, 0x80, 0xef, 0xd9, 0xb7, 0x0b, 0xe8, 0x26,
-	0x40, 0xec, 0x34, 0xc4, 0x44, 0x15, 0x07, 0x59, 0x87, 0x8c, 0x2b, 0x5e, 0x93, 0xf4, 0x44, 0x8a,
-	0x9d, 0x22, 0x8f, 0x0d, 0x56, 0x2c, 0x9c, 0x38, 0xf0, 0xba, 0x4a, 0x46, 0xe3, 0xc0, 0x23, 0x98,
-	0x41, 0xa8, 0x21, 0x14, 0x92, 0x06, 0x35, 0xee, 0x8b, 0x49, 0x43, 0x08, 0xb3, 0x56, 0x2c, 0xa0,
-	0xe8, 0x39, 0x18, 0x76, 0x3c, 0x8f, 0xa7, 0xfb, 0x91, 0x48, 0xdc, 0x5d, 0xaa, 0x6b, 0xd7, 0x6a,
-	0x10, 0x36, 0xf1, 0xec, 0xbf, 0x28, 0xc0, 0xd4, 0x3e, 0x32, 0xa5, 0x2b, 0xcd, 0xbb, 0xd4, 0x77,
-	0x9a, 0xb7, 0x48, 0x57, 0x1a, 0xec, 0x91, 0xae, 0xf4, 0x1c, 0x0c, 0xc7, 0xc4, 0x69, 0x8a, 0x48,
-	0xc4, 0x74, 0x49, 0xc6, 0x35, 0x0d, 0xc2, 0x26, 0x1e, 0x95, 0x62, 0x63, 0x4e, 0xad, 0x46, 0xa2,
-	0x48, 0xe6, 0x23, 0x09, 0x2f, 0x77, 0x6e, 0xc9, 0x4e, 0xec, 0xf0, 0x60, 0x36, 0xc1, 0x02, 0xa7,
-	0x58, 0xa6, 0x07, 0xbc, 0xd2, 0xe7, 0x80, 0xff, 0x52, 0x01, 0x1e, 0xd9, 0x53, 0xbb, 0xf5, 0x9d,
-	0x2a, 0xd6, 0x8e, 0x48, 0x98, 0x9e, 0x38, 0x57, 0x23, 0x12, 0x62, 0x06, 0xe1, 0xa3, 0xd4, 0x6a,
-	0xa9, 0x28, 0xf2, 0xfc, 0x73, 0x2b, 0xf9, 0x28, 0x25, 0x58, 0xe0, 0x14, 0xcb, 0x3b, 0x9d, 0x96,
-	0x5f, 0x1b, 0x80, 0xc7, 0xfa, 0xb0, 0x01, 0x72, 0xcc, 0x41, 0x4d, 0xe6, 0x57, 0x17, 0xef, 0x51,
-	0x7e, 0xf5, 0x9d, 0x0d, 0xd7, 0x9b, 0x69, 0xd9, 0x7d, 0xe5, 0xba, 0x7e, 0xb1, 0x00, 0xa7, 0x7b,
-	0x1b, 0x2c, 0xe8, 0x87, 0x61, 0x3c, 0x54, 0x21, 0x89, 0x66, 0x6a, 0xf6, 0x71, 0xee, 0xe3, 0x4a,
-	0x80, 0x70, 0x1a, 0x17, 0x4d, 0x03, 0xb4, 0x9c, 0x78, 0x33, 0x3a, 0xb7, 0xe3, 0x46, 0xb1, 0xa8,
-	0x65, 0x37, 0xc6, 0x4f, 0x5e, 0x65, 0x2b, 0x36, 0x30, 0x28, 0x3b, 0xf6, 0x6f, 0x21, 0xb8, 0x12,
-	0xc4, 0xfc, 0x21, 0xbe, 0xf5, 0x3c, 0x2e, 0x2f, 0x3a, 0x34, 0x40, 0x38, 0x8d, 0x4b, 0xd9, 0xb1,
-	0xb3, 0x7d, 0xde, 0xd1, 0x01, 0x9d, 0xcc, 0xbd, 0xa4, 0x5a, 0xb1, 0x81, 0x91, 0x4e, 0x3a, 0x2f,
-	0xed, 0x9f, 0x74, 0x6e, 0xff, 0xab, 0x02, 0x9c, 0xea, 0x69, 0xf0, 0xf6, 0x27, 0xa6, 0xee, 0xbf,
-	0xc4, 0xef, 0x3b, 0x5c, 0x61, 0x07, 0x4a, 0x18, 0xb6, 0xff, 0xb4, 0xc7, 0x4c, 0x13, 0xc9, 0xc0,
-	0x77, 0x5e, 0x37, 0xe5, 0xfe, 0x1b, 0xcf, 0xae, 0xfc, 0xdf, 0x81, 0x03, 0xe4, 0xff, 0xa6, 0x3e,
-	0x46, 0xa9, 0x4f, 0xed, 0xf0, 0x5f, 0x07, 0x7a, 0x0e, 0x2f, 0xdd, 0x20, 0xf7, 0x75, 0x82, 0xb0,
-	0x00, 0xc7, 0x5c, 0x9f, 0x5d, 0x5d, 0x5b, 0x6d, 0xaf, 0x8b, 0xf2, 0x66, 0xbc, 0x86, 0xaf, 0xca,
-	0xbe, 0x59, 0x4c, 0xc1, 0x71, 0xd7, 0x13, 0xf7, 0x61, 0x3e, 0xf6, 0x9d, 0x0d, 0xe9, 0x01, 0x25,
-	0xf7, 0x0a, 0x9c, 0x94, 0x43, 0xb1, 0xe9, 0x84, 0xa4, 0x2e, 0x94, 0x6d, 0x24, 0xf2, 0xad, 0x4e,
-	0xf1, 0x9c, 0xad, 0x0c, 0x04, 0x9c, 0xfd, 0x1c, 0xbb, 0x67, 0x34, 0x68, 0xb9, 0x35, 0xb1, 0x15,
-	0xd4, 0xf7, 0x8c, 0xd2, 0x46, 0xcc, 0x61, 0x5a, 0x5f, 0x54, 0xee, 0x8e, 0xbe, 0x78, 0x0f, 0x54,
-	0xd4, 0x78, 0xf3, 0x9c, 0x0a, 0x35, 0xc9, 0xbb, 0x72, 0x2a, 0xd4, 0x0c, 0x37, 0xb0, 0xf6, 0xbb,
-	0xce, 0xff, 0x19, 0x18, 0x51, 0xbe, 0xc0, 0x7e, 0x6f, 0x7b, 0xb5, 0xff, 0x5f, 0x01, 0x52, 0x17,
-	0x9b, 0xa1, 0x1d, 0xa8, 0xd4, 0xe5, 0x85, 0xfa, 0xf9, 0xd4, 0x90, 0x56, 0xf7, 0xf3, 0xeb, 0x83,
-	0x30, 0xd5, 0x84, 0x35, 0x33, 0xf4, 0x3e, 0x5e, 0xae, 0x59, 0xb0, 0x2e, 0xe4, 0x91, 0x93, 0x5f,
-	0x55, 0xf4, 0xcc, 0xeb, 0x1c, 0x65, 0x1b, 0x36, 0xf8, 0xa1, 0x18, 0x2a, 0x9b, 0xf2, 0x02, 0xb7,
-	0x7c, 0xc4, 0x9d, 0xba, 0x0f, 0x8e, 0x9b, 0x68, 0xea, 0x2f, 0xd6, 0x8c, 0xec, 0x3f, 0x29, 0xc0,
-	0x89, 0xe4, 0x07, 0x10, 0x07, 0x97, 0xbf, 0x62, 0xc1, 0x83, 0x9e, 0x13, 0xc5, 0xd5, 0x36, 0xdb,
-	0x28, 0x6c, 0xb4, 0xbd, 0x95, 0x54, 0x65, 0xef, 0xc3, 0x3a, 0x5b, 0x14, 0xe1, 0xf4, 0x85, 0x7f,
-	0x73, 0x0f, 0xdd, 0xda, 0x9d, 0x7a, 0x70, 0x29, 0x9b, 0x39, 0xee, 0xd5, 0x2b, 0xf4, 0xba, 0x05,
-	0xc7, 0x6a, 0xed, 0x30, 0x24, 0x7e, 0xac, 0xbb, 0xca, 0xbf, 0xe2, 0x95, 0x5c, 0x06, 0x52, 0x77,
-	0xf0, 0x04, 0x15, 0xa8, 0xf3, 0x29, 0x5e, 0xb8, 0x8b, 0xbb, 0xfd, 0xf3, 0x54, 0x73, 0xf6, 0x7c,
-	0xcf, 0xbf, 0x66, 0x37, 0x14, 0xfe, 0xd9, 0x20, 0x8c, 0x26, 0xca, 0x97, 0x27, 0x0e, 0xfb, 0xac,
-	0x7d, 0x0f, 0xfb, 0x58, 0x86, 0x60, 0xdb, 0x97, 0x97, 0xb7, 0x1b, 0x19, 0x82, 0x6d, 0x9f, 0x60,
-	0x0e, 0x13, 0x43, 0x8a, 0xdb, 0xbe, 0xc8, 0x05, 0x30, 0x87, 0x14, 0xb7, 0x7d, 0x2c, 0xa0, 0xe8,
-	0x03, 0x16, 0x8c, 0xb0, 0xc5, 0x27, 0x8e, 0x4a, 0x85, 0x42, 0xbb, 0x94, 0xc3, 0x72, 0x97, 0xa5,
-	0xfa, 0x59, 0xec, 0xa8, 0xd9, 0x82, 0x13, 0x1c, 0xd1, 0x87, 0x2d, 0xa8, 0xa8, 0x9b, 0x62, 0xc5,
-	0xd9, 0x48, 0x35, 0xdf, 0xea, 0xf0, 0x29, 0xa9, 0xa7, 0xca, 0x74, 0x63, 0xcd, 0x18, 0x45, 0xea,
-	0x1c, 0x73, 0xe8, 0x68, 0xce, 0x31, 0x21, 0xe3, 0x0c, 0xf3, 0xed, 0x50, 0x69, 0x3a, 0xbe, 0xbb,
-	0x41, 0xa2, 0x98, 0x1f, 0x2d, 0xca, 0xcb, 0x40, 0x64, 0x23, 0xd6, 0x70, 0x6a, 0xec, 0x47, 0xec,
-	0xc5, 0x62, 0xe3, 0x2c, 0x90, 0x19, 0xfb, 0x55, 0xdd, 0x8c, 0x4d, 0x1c, 0xf3, 0xe0, 0x12, 0xee,
-	0xe9, 0xc1, 0xe5, 0xf0, 0x3e, 0x07, 0x97, 0x55, 0x38, 0xe9, 0xb4, 0xe3, 0xe0, 0x22, 0x71, 0xbc,
-	0xd9, 0x38, 0x26, 0xcd, 0x56, 0x1c, 0xf1, 0x8a, 0xf7, 0x23, 0xcc, 0x05, 0xac, 0xa2, 0xdd, 0xaa,
-	0xc4, 0xdb, 0xe8, 0x42, 0xc2, 0xd9, 0xcf, 0xda, 0xff, 0xc2, 0x82, 0x93, 0x99, 0x53, 0xe1, 0xfe,
-	0xcd, 0x33, 0xb0, 0x3f, 0x5d, 0x82, 0xe3, 0x19, 0x97, 0x1b, 0xa0, 0x8e, 0xb9, 0x48, 0xac, 0x3c,
-	0x42, 0xf6, 0x92, 0x11, 0x68, 0xf2, 0xdb, 0x64, 0xac, 0x8c, 0x83, 0xc5, 0x22, 0xe8, 0x78, 0x80,
-	0xe2, 0xdd, 0x8d, 0x07, 0x30, 0xe6, 0xfa, 0xc0, 0x3d, 0x9d, 0xeb, 0xa5, 0x7d, 0xe6, 0xfa, 0x97,
-	0x2c, 0x98, 0x6c, 0xf6, 0xb8, 0xa9, 0x4c, 0x9c, 0x27, 0x5d, 0x3b, 0x9a, 0x7b, 0xd0, 0xe6, 0x1e,
-	0xbe, 0xb5, 0x3b, 0xd5, 0xf3, 0x82, 0x38, 0xdc, 0xb3, 0x57, 0xf6, 0xb7, 0x8a, 0xc0, 0xec, 0x35,
-	0x56, 0xc0, 0xba, 0x83, 0xde, 0x6f, 0xde, 0x91, 0x62, 0xe5, 0x75, 0x9f, 0x07, 0x27, 0xae, 0xee,
-	0x58, 0xe1, 0x23, 0x98, 0x75, 0xe5, 0x4a, 0x5a, 0x12, 0x16, 0xfa, 0x90, 0x84, 0x9e, 0xbc, 0x8c,
-	0xa6, 0x98, 0xff, 0x65, 0x34, 0x95, 0xf4, 0x45, 0x34, 0x7b, 0x7f, 0xe2, 0x81, 0xfb, 0xf2, 0x13,
-	0xff, 0x96, 0xc5, 0x05, 0x4f, 0xea, 0x2b, 0x68, 0x73, 0xc3, 0xda, 0xc3, 0xdc, 0x78, 0x12, 0xca,
-	0x91, 0x90, 0xcc, 0xc2, 0x2c, 0xd1, 0xa1, 0x60, 0xa2, 0x1d, 0x2b, 0x0c, 0xba, 0xeb, 0x72, 0x3c,
-	0x2f, 0xb8, 0x79, 0xae, 0xd9, 0x8a, 0x3b, 0xc2, 0x40, 0x51, 0xdb, 0x82, 0x59, 0x05, 0xc1, 0x06,
-	0x16, 0x7a, 0x0c, 0x06, 0x79, 0xa5, 0x09, 0xe1, 0xdc, 0x19, 0xa6, 0xeb, 0x90, 0x97, 0xa1, 0xa8,
-	0x63, 0x01, 0xb2, 0x37, 0xc1, 0xd8, 0x55, 0xdc, 0xf9, 0xed, 0xcf, 0xea, 0x1e, 0xda, 0x42, 0xaf,
-	0x7b, 0x68, 0xed, 0x7f, 0x50, 0x10, 0xac, 0xf8, 0x2e, 0x41, 0x47, 0x06, 0x5a, 0x07, 0x8c, 0x0c,
-	0x7c, 0x1f, 0x40, 0x2d, 0x68, 0xb6, 0xe8, 0xbe, 0x79, 0x2d, 0xc8, 0x67, 0xb3, 0x35, 0xaf, 0xe8,
-	0xe9, 0x51, 0xd5, 0x6d, 0xd8, 0xe0, 0x97, 0x10, 0xed, 0xc5, 0x7d, 0x45, 0x7b, 0x42, 0xca, 0x0d,
-	0xec, 0x2d, 0xe5, 0xec, 0xbf, 0xb0, 0x20, 0x61, 0xf5, 0xa1, 0x16, 0x94, 0x68, 0x77, 0x3b, 0x42,
-	0x60, 0xac, 0xe4, 0x67, 0x62, 0x52, 0x49, 0x2d, 0x56, 0x21, 0xfb, 0x89, 0x39, 0x23, 0xe4, 0x89,
-	0x28, 0xc8, 0x5c, 0x36, 0x3f, 0x26, 0xc3, 0x8b, 0x41, 0xb0, 0xc5, 0x83, 0x89, 0x74, 0x44, 0xa5,
-	0xfd, 0x3c, 0x4c, 0x74, 0x75, 0x8a, 0xdd, 0x18, 0x1d, 0xc8, 0x1d, 0xbc, 0xb1, 0x7a, 0x58, 0xc1,
-	0x07, 0xcc, 0x61, 0xf6, 0x17, 0x2d, 0x38, 0x96, 0x26, 0x8f, 0xde, 0xb0, 0x60, 0x22, 0x4a, 0xd3,
-	0x3b, 0xaa, 0xb1, 0x53, 0xd9, 0x0e, 0x5d, 0x20, 0xdc, 0xdd, 0x09, 0xfb, 0x7f, 0x08, 0x6d, 0x70,
-	0xdd, 0xf5, 0xeb, 0xc1, 0x4d, 0x65, 0x27, 0x59, 0x3d, 0xed, 0x24, 0x2a, 0x1e, 0x6a, 0x9b, 0xa4,
-	0xde, 0xf6, 0xba, 0xca, 0x50, 0x54, 0x45, 0x3b, 0x56, 0x18, 0x2c, 0xeb, 0xbe, 0x2d, 0xf6, 0xad,
-	0xa9, 0x49, 0xb9, 0x20, 0xda, 0xb1, 0xc2, 0x40, 0xcf, 0xc2, 0x88, 0xf1, 0x92, 0x72, 0x5e, 0xb2,
-	0x4d, 0x87, 0xa1, 0xc1, 0x23, 0x9c, 0xc0, 0x42, 0xd3, 0x00, 0xca, 0xe6, 0x92, 0x1a, 0x9b, 0x39,
-	0xda, 0x95, 0x60, 0x8c, 0xb0, 0x81, 0xc1, 0x6a, 0x5c, 0x78, 0xed, 0x88, 0x9d, 0x24, 0x0f, 0xea,
-	0x0b, 0x1d, 0xe6, 0x45, 0x1b, 0x56, 0x50, 0x2a, 0xdc, 0x9a, 0x8e, 0xdf, 0x76, 0x3c, 0x3a, 0x42,
-	0xc2, 0x75, 0xa6, 0x96, 0xe1, 0xb2, 0x82, 0x60, 0x03, 0x8b, 0xbe, 0x71, 0xec, 0x36, 0xc9, 0x4b,
-	0x81, 0x2f, 0xa3, 0xd4, 0x75, 0x70, 0x81, 0x68, 0xc7, 0x0a, 0x03, 0x3d, 0x0f, 0xc3, 0x8e, 0x5f,
-	0xe7, 0x06, 0x62, 0x10, 0x8a, 0x33, 0x4a, 0xb5, 0xfb, 0xbc, 0x1a, 0x91, 0x59, 0x0d, 0xc5, 0x26,
-	0x6a, 0xfa, 0x36, 0x0b, 0xe8, 0xf3, 0xb6, 0xbc, 0x3f, 0xb7, 0x60, 0x5c, 0x17, 0x2d, 0x62, 0x1e,
-	0xb6, 0x84, 0x6b, 0xd1, 0xda, 0xd7, 0xb5, 0x98, 0xac, 0x5d, 0x52, 0xe8, 0xab, 0x76, 0x89, 0x59,
-	0x56, 0xa4, 0xb8, 0x67, 0x59, 0x91, 0xef, 0x85, 0xa1, 0x2d, 0xd2, 0x31, 0xea, 0x8f, 0x30, 0xe5,
-	0x70, 0x99, 0x37, 0x61, 0x09, 0x43, 0x36, 0x0c, 0xd6, 0x1c, 0x55, 0xc3, 0x70, 0x44, 0xc4, 0xa6,
-	0xcd, 0x32, 0x24, 0x01, 0xb1, 0x57, 0xa0, 0xa2, 0x0e, 0xf5, 0xa5, 0xa7, 0xcf, 0xca, 0xf6, 0xf4,
-	0xf5, 0x55, 0xde, 0x60, 0x6e, 0xfd, 0xab, 0xdf, 0x7e, 0xf4, 0x2d, 0x7f, 0xf8, 0xed, 0x47, 0xdf,
-	0xf2, 0xc7, 0xdf, 0x7e, 0xf4, 0x2d, 0x1f, 0xb8, 0xf5, 0xa8, 0xf5, 0xd5, 0x5b, 0x8f, 0x5a, 0x7f,
-	0x78, 0xeb, 0x51, 0xeb, 0x8f, 0x6f, 0x3d, 0x6a, 0x7d, 0xeb, 0xd6, 0xa3, 0xd6, 0xeb, 0xff, 0xe5,
-	0xd1, 0xb7, 0xbc, 0x94, 0x99, 0x17, 0x41, 0x7f, 0x3c, 0x55, 0xab, 0xcf, 0x6c, 0x3f, 0xc3, 0x42,
-	0xf3, 0xe9, 0x7a, 0x9e, 0x31, 0x26, 0xf1, 0x8c, 0x5c, 0xcf, 0xff, 0x3f, 0x00, 0x00, 0xff, 0xff,
-	0xae, 0x1f, 0x8f, 0xcd, 0xce, 0xff, 0x00, 0x00,
+	// 14286 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x70, 0x25, 0xd9,
+	0x59, 0x98, 0xfb, 0x3e, 0xf4, 0x38, 0xd2, 0xe8, 0xd1, 0x33, 0xb3, 0x7b, 0x67, 0xf6, 0xa1, 0xa1,
+	0xd7, 0xd8, 0x9b, 0xc0, 0x6a, 0xf0, 0xda, 0x98, 0x8d, 0x8d, 0x0d, 0x7a, 0xcc, 0x43, 0x3b, 0xd2,
+	0x48, 0xfb, 0x5d, 0xcd, 0x0c, 0xb6, 0xf1, 0xa3, 0x75, 0xef, 0xd1, 0x55, 0x8f, 0xfa, 0x76, 0xdf,
+	0xed, 0xee, 0xab, 0x19, 0x2d, 0xc6, 0xd8, 0x38, 0x0e, 0x06, 0xcc, 0x23, 0x98, 0x80, 0x1d, 0x02,
+	0x81, 0x00, 0x09, 0xa9, 0x84, 0xc2, 0x49, 0xa8, 0x82, 0x4a, 0x42, 0x51, 0x24, 0x29, 0x0a, 0x02,
+	0x04, 0x42, 0x11, 0x20, 0x05, 0x4c, 0xf0, 0xe4, 0x01, 0x95, 0xaa, 0xb8, 0x2a, 0x8f, 0xaa, 0xa4,
+	0x36, 0xa9, 0x54, 0xea, 0x3b, 0xef, 0x7e, 0x5c, 0xe9, 0x6a, 0xd4, 0x9a, 0x19, 0xc8, 0xfe, 0x92,
+	0xee, 0xf9, 0xbe, 0xf3, 0x7d, 0xa7, 0x4f, 0x9f, 0x3e, 0xdf, 0x77, 0xbe, 0xf3, 0x3d, 0xc8, 0x6a,
+	0xc7, 0x4b, 0x76, 0xfa, 0x5b, 0xf3, 0xad, 0xb0, 0x7b, 0xd1, 0x8d, 0x3a, 0x61, 0x2f, 0x0a, 0x6f,
+	0xb3, 0x7f, 0x5e, 0x68, 0xb5, 0x2f, 0xee, 0xbd, 0xfd, 0x62, 0x6f, 0xb7, 0x73, 0xd1, 0xed, 0x79,
+	0xf1, 0x45, 0xb7, 0xd7, 0xf3, 0xbd, 0x96, 0x9b, 0x78, 0x61, 0x70, 0x71, 0xef, 0x6d, 0xae, 0xdf,
+	0xdb, 0x71, 0xdf, 0x76, 0xb1, 0x43, 0x03, 0x1a, 0xb9, 0x09, 0x6d, 0xcf, 0xf7, 0xa2, 0x30, 0x09,
+	0xed, 0xaf, 0xd5, 0xd4, 0xe6, 0x25, 0x35, 0xf6, 0xcf, 0x87, 0x5b, 0xed, 0xf9, 0xbd, 0xb7, 0xcf,
+	0xf7, 0x76, 0x3b, 0xf3, 0x48, 0x6d, 0xde, 0xa0, 0x36, 0x2f, 0xa9, 0x9d, 0x7f, 0xc1, 0x18, 0x4b,
+	0x27, 0xec, 0x84, 0x17, 0x19, 0xd1, 0xad, 0xfe, 0x36, 0xfb, 0xc5, 0x7e, 0xb0, 0xff, 0x38, 0xb3,
+	0xf3, 0xce, 0xee, 0x4b, 0xf1, 0xbc, 0x17, 0xe2, 0xf0, 0x2e, 0xb6, 0xc2, 0x88, 0x5e, 0xdc, 0xcb,
+	0x0d, 0xe8, 0xfc, 0x55, 0x8d, 0x43, 0xef, 0x26, 0x34, 0x88, 0xbd, 0x30, 0x88, 0x5f, 0xc0, 0x21,
+	0xd0, 0x68, 0x8f, 0x46, 0xe6, 0xe3, 0x19, 0x08, 0x45, 0x94, 0xde, 0xa1, 0x29, 0x75, 0xdd, 0xd6,
+	0x8e, 0x17, 0xd0, 0x68, 0x5f, 0x77, 0xef, 0xd2, 0xc4, 0x2d, 0xea, 0x75, 0x71, 0x50, 0xaf, 0xa8,
+	0x1f, 0x24, 0x5e, 0x97, 0xe6, 0x3a, 0xbc, 0xf3, 0xb0, 0x0e, 0x71, 0x6b, 0x87, 0x76, 0xdd, 0x5c,
+	0xbf, 0xb7, 0x0f, 0xea, 0xd7, 0x4f, 0x3c, 0xff, 0xa2, 0x17, 0x24, 0x71, 0x12, 0x65, 0x3b, 0x39,
+	0x7f, 0xc3, 0x22, 0xa7, 0x16, 0x6e, 0x35, 0x17, 0xfa, 0xc9, 0xce, 0x52, 0x18, 0x6c, 0x7b, 0x1d,
+	0xfb, 0xab, 0xc9, 0x44, 0xcb, 0xef, 0xc7, 0x09, 0x8d, 0xae, 0xbb, 0x5d, 0xda, 0xb0, 0x2e, 0x58,
+	0xcf, 0x8f, 0x2f, 0x9e, 0xfe, 0x95, 0x7b, 0x73, 0x6f, 0xba, 0x7f, 0x6f, 0x6e, 0x62, 0x49, 0x83,
+	0xc0, 0xc4, 0xb3, 0xff, 0x02, 0x19, 0x8d, 0x42, 0x9f, 0x2e, 0xc0, 0xf5, 0x46, 0x85, 0x75, 0x99,
+	0x16, 0x5d, 0x46, 0x81, 0x37, 0x83, 0x84, 0x23, 0x6a, 0x2f, 0x0a, 0xb7, 0x3d, 0x9f, 0x36, 0xaa,
+	0x69, 0xd4, 0x0d, 0xde, 0x0c, 0x12, 0xee, 0xfc, 0xcf, 0x1a, 0x39, 0xb7, 0x70, 0xab, 0xb9, 0x1e,
+	0x75, 0xdc, 0xc0, 0x7b, 0x8d, 0x2d, 0x96, 0xf8, 0x0a, 0x7f, 0x84, 0x30, 0xb2, 0xef, 0x10, 0x92,
+	0xb8, 0x9d, 0xcb, 0x9e, 0x9f, 0xd0, 0x28, 0x6e, 0x58, 0x17, 0xaa, 0xcf, 0x4f, 0xbc, 0x78, 0x65,
+	0xfe, 0x38, 0x0b, 0x70, 0x7e, 0x53, 0xd2, 0x5b, 0x9c, 0xba, 0x7f, 0x6f, 0x8e, 0xa8, 0x9f, 0x31,
+	0x18, 0xac, 0xec, 0x0b, 0xa4, 0x86, 0x0f, 0x23, 0x9e, 0x74, 0x52, 0x0c, 0xbf, 0x86, 0x4f, 0x0a,
+	0x0c, 0x62, 0xbf, 0x85, 0x8c, 0x44, 0xb4, 0xe3, 0x85, 0x81, 0x78, 0xc4, 0x29, 0x81, 0x33, 0x02,
+	0xac, 0x15, 0x04, 0xd4, 0x5e, 0x21, 0xa7, 0x23, 0xfa, 0x6a, 0x9f, 0xf6, 0xe9, 0xc2, 0x76, 0x42,
+	0xa3, 0x26, 0x6d, 0x85, 0x41, 0x3b, 0x6e, 0xd4, 0x2e, 0x58, 0xcf, 0x57, 0x17, 0x9f, 0xbc, 0x7f,
+	0x6f, 0xee, 0x34, 0xe4, 0xc1, 0x50, 0xd4, 0xc7, 0xfe, 0x56, 0x8b, 0x8c, 0x25, 0xb4, 0xdb, 0xf3,
+	0xdd, 0x84, 0x36, 0xea, 0x17, 0xac, 0xe7, 0x27, 0x5e, 0xdc, 0x3c, 0xde, 0x64, 0x2c, 0xe8, 0xc6,
+	0x26, 0x4d, 0x36, 0x05, 0xed, 0xc5, 0x19, 0xf1, 0x2c, 0x63, 0xb2, 0x05, 0x14, 0x5f, 0xfb, 0x3b,
+	0x2d, 0x32, 0xb2, 0xe7, 0xfa, 0x7d, 0x1a, 0x37, 0x46, 0xd8, 0xfb, 0x68, 0x1d, 0x73, 0x08, 0x83,
+	0x5e, 0xfe, 0xfc, 0x4d, 0xc6, 0xe5, 0x52, 0x90, 0x44, 0xfb, 0x7a, 0x76, 0x79, 0x23, 0x88, 0x21,
+	0x9c, 0xff, 0x4b, 0x64, 0xc2, 0x40, 0xb3, 0x67, 0x48, 0x75, 0x97, 0xee, 0xf3, 0x25, 0x0d, 0xf8,
+	0xaf, 0x7d, 0x86, 0xd4, 0x19, 0x2a, 0x7f, 0x93, 0xc0, 0x7f, 0xbc, 0xab, 0xf2, 0x92, 0xe5, 0x7c,
+	0xbe, 0x42, 0xa6, 0x17, 0x7a, 0xbd, 0xab, 0xd4, 0xf5, 0x93, 0x9d, 0x66, 0xe2, 0x26, 0xfd, 0xd8,
+	0xee, 0x90, 0x91, 0x98, 0xfd, 0x27, 0xbe, 0x8a, 0x75, 0xc9, 0x96, 0xc3, 0x5f, 0xbf, 0x37, 0xf7,
+	0x9e, 0xa2, 0xbd, 0xb4, 0xe3, 0x25, 0x61, 0x2f, 0x7e, 0x81, 0x06, 0x1d, 0x2f, 0xa0, 0xec, 0x8b,
+	0xdc, 0x61, 0x54, 0xe7, 0x4d, 0xe2, 0x4b, 0x61, 0x9b, 0x82, 0x20, 0x8f, 0x5f, 0x48, 0x97, 0xc6,
+	0xb1, 0xdb, 0xa1, 0xd9, 0x8f, 0x69, 0x8d, 0x37, 0x83, 0x84, 0xdb, 0x11, 0xb1, 0x7d, 0x37, 0x4e,
+	0x36, 0x23, 0x37, 0x88, 0x3d, 0x9c, 0xa2, 0x4d, 0xaf, 0xcb, 0xbf, 0xab, 0x89, 0x17, 0xff, 0xe2,
+	0x3c, 0xdf, 0x12, 0xe6, 0xcd, 0x2d, 0x41, 0x4f, 0x38, 0xee, 0x58, 0xf3, 0x7b, 0x6f, 0x9b, 0xc7,
+	0x1e, 0x8b, 0x4f, 0xdc, 0xbf, 0x37, 0x67, 0xaf, 0xe6, 0x28, 0x41, 0x01, 0x75, 0xe7, 0x77, 0x2b,
+	0x84, 0x2c, 0xf4, 0x7a, 0x1b, 0x51, 0x78, 0x9b, 0xb6, 0x12, 0xfb, 0x23, 0x64, 0x0c, 0x49, 0xb5,
+	0xdd, 0xc4, 0x65, 0x13, 0x33, 0xf1, 0xe2, 0x57, 0x0d, 0xc7, 0x78, 0x7d, 0x0b, 0xfb, 0xaf, 0xd1,
+	0xc4, 0x5d, 0xb4, 0xc5, 0x03, 0x12, 0xdd, 0x06, 0x8a, 0xaa, 0x1d, 0x90, 0x5a, 0xdc, 0xa3, 0x2d,
+	0x36, 0x19, 0x13, 0x2f, 0xae, 0x1e, 0x7b, 0x55, 0x8b, 0x91, 0x37, 0x7b, 0xb4, 0xa5, 0xbf, 0x5e,
+	0xfc, 0x05, 0x8c, 0x8f, 0xbd, 0xa7, 0x5e, 0x34, 0x9f, 0xc8, 0xeb, 0xa5, 0x71, 0x64, 0x54, 0xf5,
+	0x7a, 0xe5, 0xbf, 0xe5, 0x7b, 0x77, 0xfe, 0xc8, 0x22, 0x53, 0x1a, 0x79, 0xd5, 0x8b, 0x13, 0xfb,
+	0x1b, 0x73, 0x93, 0x3b, 0x3f, 0xdc, 0xe4, 0x62, 0x6f, 0x36, 0xb5, 0xea, 0x73, 0x95, 0x2d, 0xc6,
+	0xc4, 0x76, 0x49, 0xdd, 0x4b, 0x68, 0x37, 0x6e, 0x54, 0xd8, 0xc7, 0x7a, 0xb5, 0xac, 0xe7, 0x5c,
+	0x3c, 0x25, 0x98, 0xd6, 0x57, 0x90, 0x3c, 0x70, 0x2e, 0xce, 0xa7, 0x9f, 0x34, 0x9f, 0x0f, 0x27,
+	0xdc, 0x7e, 0x1b, 0x99, 0x88, 0xc3, 0x7e, 0xd4, 0xa2, 0x40, 0x7b, 0x21, 0xdf, 0xc4, 0xc7, 0x17,
+	0xa7, 0x51, 0xd4, 0x34, 0x75, 0x33, 0x98, 0x38, 0xf6, 0x77, 0x5b, 0x64, 0xb2, 0x4d, 0xe3, 0xc4,
+	0x0b, 0xf8, 0x9e, 0x20, 0x06, 0x5f, 0xde, 0x66, 0xb7, 0xac, 0x89, 0x2f, 0x9e, 0x11, 0x0f, 0x32,
+	0x69, 0x34, 0xc6, 0x90, 0xe2, 0x8f, 0x22, 0xb3, 0x4d, 0xe3, 0x56, 0xe4, 0xf5, 0x12, 0xbd, 0xe3,
+	0x2b, 0x91, 0xb9, 0xac, 0x41, 0x60, 0xe2, 0xd9, 0x01, 0xa9, 0xa3, 0xac, 0xc0, 0xdd, 0x1e, 0xc7,
+	0xbf, 0x72, 0xbc, 0xf1, 0x8b, 0x49, 0x45, 0x19, 0xa4, 0x67, 0x1f, 0x7f, 0xc5, 0xc0, 0xd9, 0xd8,
+	0xdf, 0x65, 0x91, 0x86, 0x10, 0xd9, 0x40, 0xf9, 0x84, 0xde, 0xda, 0xf1, 0x12, 0xea, 0x7b, 0x71,
+	0xd2, 0xa8, 0xb3, 0x31, 0x5c, 0x1c, 0x6e, 0x6d, 0x5d, 0x89, 0xc2, 0x7e, 0xef, 0x9a, 0x17, 0xb4,
+	0x17, 0x2f, 0x08, 0x4e, 0x8d, 0xa5, 0x01, 0x84, 0x61, 0x20, 0x4b, 0xfb, 0xb3, 0x16, 0x39, 0x1f,
+	0xb8, 0x5d, 0x1a, 0xf7, 0xdc, 0x16, 0x95, 0xe0, 0x45, 0xdf, 0x6d, 0xed, 0xb2, 0x11, 0x8d, 0x3c,
+	0xd8, 0x88, 0x1c, 0x31, 0xa2, 0xf3, 0xd7, 0x07, 0x92, 0x86, 0x03, 0xd8, 0xda, 0x3f, 0x6e, 0x91,
+	0xd9, 0x30, 0xea, 0xed, 0xb8, 0x01, 0x6d, 0x4b, 0x68, 0xdc, 0x18, 0x65, 0x9f, 0xde, 0x87, 0x8e,
+	0xf7, 0x8a, 0xd6, 0xb3, 0x64, 0xd7, 0xc2, 0xc0, 0x4b, 0xc2, 0xa8, 0x49, 0x93, 0xc4, 0x0b, 0x3a,
+	0xf1, 0xe2, 0xd9, 0xfb, 0xf7, 0xe6, 0x66, 0x73, 0x58, 0x90, 0x1f, 0x8f, 0xfd, 0x4d, 0x64, 0x22,
+	0xde, 0x0f, 0x5a, 0xb7, 0xbc, 0xa0, 0x1d, 0xde, 0x89, 0x1b, 0x63, 0x65, 0x7c, 0xbe, 0x4d, 0x45,
+	0x50, 0x7c, 0x80, 0x9a, 0x01, 0x98, 0xdc, 0x8a, 0x5f, 0x9c, 0x5e, 0x4a, 0xe3, 0x65, 0xbf, 0x38,
+	0xbd, 0x98, 0x0e, 0x60, 0x6b, 0x7f, 0x9b, 0x45, 0x4e, 0xc5, 0x5e, 0x27, 0x70, 0x93, 0x7e, 0x44,
+	0xaf, 0xd1, 0xfd, 0xb8, 0x41, 0xd8, 0x40, 0x5e, 0x3e, 0xe6, 0xac, 0x18, 0x24, 0x17, 0xcf, 0x8a,
+	0x31, 0x9e, 0x32, 0x5b, 0x63, 0x48, 0xf3, 0x2d, 0xfa, 0xd0, 0xf4, 0xb2, 0x9e, 0x28, 0xf7, 0x43,
+	0xd3, 0x8b, 0x7a, 0x20, 0x4b, 0xfb, 0xeb, 0xc9, 0x0c, 0x6f, 0x52, 0x33, 0x1b, 0x37, 0x26, 0xd9,
+	0x46, 0x7b, 0xe6, 0xfe, 0xbd, 0xb9, 0x99, 0x66, 0x06, 0x06, 0x39, 0x6c, 0xfb, 0x55, 0x32, 0xd7,
+	0xa3, 0x51, 0xd7, 0x4b, 0xd6, 0x03, 0x7f, 0x5f, 0x6e, 0xdf, 0xad, 0xb0, 0x47, 0xdb, 0x62, 0x38,
+	0x71, 0xe3, 0xd4, 0x05, 0xeb, 0xf9, 0xb1, 0xc5, 0xb7, 0x8a, 0x61, 0xce, 0x6d, 0x1c, 0x8c, 0x0e,
+	0x87, 0xd1, 0xb3, 0x7f, 0xd9, 0x22, 0xe7, 0x8d, 0x5d, 0xb6, 0x49, 0xa3, 0x3d, 0xaf, 0x45, 0x17,
+	0x5a, 0xad, 0xb0, 0x1f, 0x24, 0x71, 0x63, 0x8a, 0x4d, 0xe3, 0xd6, 0x49, 0xec, 0xf9, 0x69, 0x56,
+	0x7a, 0x5d, 0x0e, 0x44, 0x89, 0xe1, 0x80, 0x91, 0xda, 0x2f, 0x13, 0xbb, 0xeb, 0xde, 0x05, 0xba,
+	0x1d, 0xd1, 0x78, 0x67, 0x25, 0x48, 0x68, 0xb4, 0xe7, 0xfa, 0x8d, 0x69, 0x26, 0x24, 0xce, 0x0b,
+	0xda, 0xf6, 0x5a, 0x0e, 0x03, 0x0a, 0x7a, 0xd9, 0xef, 0x21, 0xd3, 0xae, 0xef, 0x87, 0x77, 0x68,
+	0x7b, 0xd5, 0x0b, 0x76, 0x6f, 0xc0, 0x6a, 0xdc, 0x98, 0x61, 0x2f, 0xf2, 0xf4, 0xfd, 0x7b, 0x73,
+	0xd3, 0x0b, 0x69, 0x10, 0x64, 0x71, 0xed, 0x26, 0x39, 0x6b, 0x0c, 0xf4, 0xd2, 0xdd, 0x5e, 0x44,
+	0x63, 0x3c, 0xee, 0x36, 0x66, 0xd9, 0x68, 0x9e, 0x11, 0xa3, 0x39, 0xbb, 0x5c, 0x84, 0x04, 0xc5,
+	0x7d, 0xed, 0x0d, 0x72, 0x46, 0x4b, 0x67, 0x83, 0xa6, 0xcd, 0x68, 0x3e, 0x2d, 0x68, 0x9e, 0x69,
+	0x16, 0xe0, 0x40, 0x61, 0x4f, 0xbb, 0x4d, 0x9e, 0x76, 0xd3, 0x47, 0x0f, 0x37, 0xea, 0xd0, 0x44,
+	0xac, 0x93, 0xb8, 0x71, 0x9a, 0x3d, 0xf2, 0x85, 0xfb, 0xf7, 0xe6, 0x9e, 0x5e, 0x38, 0x00, 0x0f,
+	0x0e, 0xa4, 0x62, 0x2f, 0x91, 0xd9, 0x1d, 0xea, 0x77, 0x37, 0xc2, 0x38, 0x01, 0x1a, 0xb4, 0x69,
+	0x84, 0xab, 0xf8, 0x0c, 0x23, 0xcd, 0xf6, 0xe1, 0xab, 0x59, 0x20, 0xe4, 0xf1, 0xed, 0x1f, 0xb1,
+	0xc8, 0x69, 0xdc, 0x1a, 0xd7, 0x99, 0x48, 0x8f, 0x37, 0x42, 0xdf, 0x6b, 0x79, 0x34, 0x6e, 0x9c,
+	0x65, 0xcb, 0x73, 0xfd, 0xf8, 0x1b, 0xb2, 0x49, 0x78, 0x7f, 0xf1, 0x29, 0x31, 0x9b, 0xa7, 0x9b,
+	0x79, 0x9e, 0x50, 0x34, 0x10, 0xfb, 0xe7, 0x2c, 0x72, 0xce, 0xeb, 0xe2, 0x91, 0x81, 0x76, 0xbc,
+	0x38, 0x89, 0xf6, 0x81, 0xde, 0x89, 0xbc, 0x84, 0x42, 0x1f, 0x35, 0x8f, 0x27, 0xd8, 0x30, 0x6f,
+	0x1e, 0x6f, 0x98, 0x2b, 0x03, 0xc8, 0x2f, 0x7e, 0x99, 0x18, 0xed, 0xb9, 0x41, 0x18, 0x31, 0x0c,
+	0x1e, 0x9b, 0xfd, 0xb3, 0x16, 0x39, 0x27, 0x15, 0xd5, 0x8d, 0x28, 0xec, 0xb9, 0x1d, 0xc6, 0x97,
+	0xcf, 0x44, 0xe3, 0x49, 0x26, 0x90, 0x6f, 0x1d, 0x6f, 0xe4, 0x6b, 0x83, 0xc8, 0x2f, 0x3e, 0x83,
+	0xc3, 0x1e, 0x08, 0x86, 0xc1, 0x03, 0xb3, 0x7f, 0xc2, 0x22, 0x67, 0x83, 0x30, 0xf1, 0xb6, 0x05,
+	0x37, 0xf6, 0x51, 0xb2, 0x9d, 0xbf, 0xc1, 0x86, 0xdc, 0x3c, 0xde, 0x90, 0xaf, 0x17, 0x91, 0x5e,
+	0x3c, 0x87, 0x5f, 0x6d, 0x21, 0x08, 0x8a, 0x07, 0xe3, 0xfc, 0x6a, 0x85, 0xcc, 0x64, 0xcf, 0x25,
+	0xf6, 0xdf, 0xb6, 0xc8, 0xf4, 0xed, 0x3b, 0xc9, 0x66, 0xb8, 0x4b, 0x83, 0x78, 0x71, 0x1f, 0xb5,
+	0xc7, 0x86, 0x55, 0xca, 0x31, 0x3e, 0xc3, 0x69, 0xfe, 0xe5, 0x34, 0x17, 0x7e, 0x8c, 0x7f, 0x52,
+	0xac, 0x97, 0xe9, 0x97, 0x6f, 0x6d, 0x9a, 0x50, 0xc8, 0x0e, 0xea, 0xfc, 0x77, 0x5a, 0xe4, 0x4c,
+	0x11, 0x89, 0x82, 0x23, 0xfe, 0x07, 0xcd, 0x23, 0xfe, 0xb1, 0xed, 0x43, 0x6a, 0x64, 0xa6, 0xad,
+	0xe0, 0x37, 0xab, 0x64, 0xc2, 0xd8, 0x88, 0x1e, 0xc2, 0x81, 0x38, 0x4c, 0x1d, 0x88, 0xd7, 0xca,
+	0x33, 0xf3, 0x0c, 0x3a, 0x11, 0xdf, 0xc9, 0x9c, 0x88, 0xd7, 0xcb, 0x63, 0x79, 0xe0, 0x91, 0xd8,
+	0x4e, 0xc8, 0x78, 0xd8, 0xa3, 0x11, 0x43, 0x6d, 0xd4, 0xca, 0x78, 0x85, 0xeb, 0x92, 0xdc, 0xe2,
+	0xa9, 0xfb, 0xf7, 0xe6, 0xc6, 0xd5, 0x4f, 0xd0, 0x8c, 0x9c, 0xdf, 0xb3, 0xc8, 0x19, 0x63, 0x8c,
+	0x4b, 0x61, 0xd0, 0x66, 0xe6, 0x0f, 0xb4, 0xfc, 0x25, 0xfb, 0x3d, 0x69, 0x16, 0x55, 0x33, 0xb5,
+	0xb9, 0xdf, 0xa3, 0xc0, 0x20, 0x8f, 0xbb, 0xed, 0xe6, 0xb3, 0x16, 0x79, 0xa2, 0x58, 0xed, 0x41,
+	0x9b, 0x25, 0xb7, 0x89, 0x8b, 0xa7, 0xd3, 0xaf, 0x84, 0xb5, 0x82, 0x80, 0xda, 0x17, 0xc9, 0xb8,
+	0x52, 0xc3, 0xc5, 0x33, 0xce, 0x0a, 0xd4, 0x71, 0xad, 0xbb, 0x6b, 0x1c, 0x9c, 0xb4, 0xc0, 0x15,
+	0x4f, 0x66, 0x4c, 0x1a, 0xe2, 0x02, 0x83, 0x38, 0xbf, 0x63, 0x91, 0x37, 0x0f, 0xa3, 0x8c, 0x9d,
+	0xdc, 0x18, 0x99, 0x6a, 0xb4, 0xed, 0xf6, 0xfd, 0x24, 0xcd, 0xb1, 0x51, 0xcd, 0xaa, 0x46, 0x05,
+	0x48, 0x50, 0xdc, 0xd7, 0xf9, 0x53, 0x8b, 0xcc, 0x1a, 0x8f, 0x75, 0x39, 0xa2, 0xf4, 0x35, 0x6a,
+	0x9f, 0x27, 0x95, 0x2d, 0xb1, 0x45, 0x2d, 0x12, 0x41, 0xb7, 0xb2, 0xb8, 0x0f, 0x95, 0xad, 0x7d,
+	0x6e, 0x37, 0x76, 0xe3, 0x30, 0x68, 0x54, 0xd2, 0xcf, 0x07, 0xac, 0x15, 0x04, 0xd4, 0x5e, 0x24,
+	0x15, 0x37, 0x79, 0x80, 0xa5, 0x32, 0x82, 0xbc, 0x16, 0x12, 0xa8, 0xb8, 0x89, 0x7d, 0x8d, 0xd4,
+	0xf1, 0x46, 0xc1, 0x6f, 0xd4, 0x8e, 0x4c, 0x66, 0x1c, 0x8d, 0x0b, 0x37, 0xb0, 0x33, 0x70, 0x1a,
+	0xce, 0xbf, 0xb3, 0xc8, 0xb4, 0xf1, 0xa8, 0x0f, 0xc1, 0x76, 0x15, 0xa4, 0x6d, 0x57, 0x2b, 0xa5,
+	0xed, 0x48, 0x03, 0x8c, 0x57, 0xdf, 0x65, 0x91, 0xf3, 0x06, 0xd6, 0x9a, 0x9b, 0xb4, 0x76, 0x0c,
+	0xa5, 0xf5, 0x19, 0x43, 0xf2, 0x2c, 0x4e, 0x08, 0x0a, 0xd5, 0x6b, 0x74, 0x9f, 0x8b, 0xa1, 0xaf,
+	0x24, 0x63, 0x7c, 0x7b, 0x09, 0x23, 0xf1, 0x6a, 0xd5, 0xb3, 0xad, 0x8b, 0x76, 0x50, 0x18, 0xb6,
+	0xa3, 0xac, 0xe8, 0x55, 0xa6, 0x90, 0x92, 0xbc, 0x71, 0xdb, 0x89, 0x53, 0xc3, 0xd9, 0x88, 0x28,
+	0x5b, 0xfa, 0xed, 0xcb, 0x1e, 0xf5, 0xdb, 0x31, 0xda, 0xd5, 0xdc, 0x20, 0x08, 0x13, 0x61, 0x22,
+	0x33, 0xec, 0x6a, 0x0b, 0xba, 0x19, 0x4c, 0x1c, 0x64, 0xea, 0xbb, 0x5b, 0xd4, 0xe7, 0x33, 0x2a,
+	0x98, 0xae, 0xb2, 0x16, 0x10, 0x10, 0xe7, 0x7e, 0x85, 0x4c, 0x19, 0x5c, 0x9b, 0xf4, 0x61, 0x98,
+	0x7f, 0xa3, 0x94, 0xb4, 0xdb, 0x28, 0xf3, 0x52, 0x63, 0xa0, 0xc0, 0x7b, 0x2d, 0x23, 0xf0, 0xa0,
+	0x54, 0xae, 0x07, 0x9b, 0x81, 0x3f, 0x5e, 0x25, 0x73, 0xe9, 0x0e, 0x39, 0x79, 0x89, 0x36, 0x47,
+	0x83, 0x51, 0xf6, 0x9a, 0xce, 0xc0, 0x07, 0x13, 0x6f, 0x80, 0xc8, 0xa9, 0x9c, 0xa4, 0xc8, 0x31,
+	0x25, 0x62, 0xf5, 0x10, 0x89, 0xf8, 0x16, 0x35, 0xeb, 0xb5, 0xcc, 0xf6, 0x9e, 0xd6, 0x0a, 0x2e,
+	0x90, 0x5a, 0x9c, 0xd0, 0x5e, 0xa3, 0x9e, 0x96, 0x28, 0xcd, 0x84, 0xf6, 0x80, 0x41, 0xf0, 0xa4,
+	0x9c, 0xb0, 0xf3, 0x5e, 0x44, 0xf7, 0x3c, 0x76, 0xa5, 0xdb, 0x18, 0xd1, 0x27, 0x65, 0x7e, 0x14,
+	0x04, 0x09, 0x82, 0x2c, 0xae, 0xf3, 0x9f, 0x2b, 0xe4, 0xc9, 0xf4, 0x2b, 0xd0, 0x3a, 0xc0, 0xd7,
+	0xa5, 0x74, 0x80, 0xaf, 0x30, 0x75, 0x80, 0xd7, 0xef, 0xcd, 0x3d, 0x35, 0xa0, 0xdb, 0x9f, 0x19,
+	0x15, 0xc1, 0xbe, 0x92, 0x79, 0x09, 0x17, 0x73, 0xd7, 0x5c, 0xcf, 0x0c, 0x78, 0xc6, 0xcc, 0x5b,
+	0xd2, 0xc2, 0xac, 0x7e, 0x90, 0x30, 0x73, 0xbe, 0x44, 0xb2, 0x93, 0xad, 0xef, 0x78, 0x3d, 0x52,
+	0x63, 0x87, 0x27, 0xbe, 0xb3, 0x5c, 0x3b, 0xde, 0x57, 0x88, 0x52, 0x44, 0x91, 0x5e, 0x1c, 0xc3,
+	0xb7, 0x86, 0x4d, 0xc0, 0x58, 0xd8, 0x77, 0xc9, 0x58, 0x4b, 0x5a, 0xb3, 0x2a, 0x65, 0xdc, 0xfb,
+	0x08, 0x5b, 0x96, 0xe6, 0x38, 0x89, 0xdb, 0xbd, 0x32, 0x81, 0x29, 0x6e, 0x36, 0x25, 0xd5, 0x8e,
+	0x27, 0xc5, 0xf9, 0x31, 0xed, 0x95, 0x57, 0x3c, 0xe3, 0x11, 0x47, 0x51, 0x06, 0x5d, 0xf1, 0x12,
+	0x40, 0xfa, 0xf6, 0xa7, 0x2c, 0x32, 0x11, 0xb7, 0xba, 0x1b, 0x51, 0xb8, 0xe7, 0xb5, 0x69, 0xd4,
+	0xa8, 0x95, 0xb1, 0xb3, 0x35, 0x97, 0xd6, 0x24, 0x41, 0xcd, 0x97, 0xdb, 0x8f, 0x35, 0x04, 0x4c,
+	0xbe, 0x78, 0xcc, 0x7c, 0x52, 0x3c, 0xfb, 0x32, 0x6d, 0xb1, 0x2f, 0x4e, 0x1a, 0x2d, 0x1b, 0xf5,
+	0x32, 0x8e, 0x17, 0xcb, 0xfd, 0xd6, 0x2e, 0x7e, 0x6f, 0x7a, 0x40, 0x4f, 0xdd, 0xbf, 0x37, 0xf7,
+	0xe4, 0x52, 0x31, 0x4f, 0x18, 0x34, 0x18, 0x36, 0x61, 0xbd, 0xbe, 0xef, 0xb3, 0x3b, 0x78, 0x76,
+	0x25, 0x51, 0xc2, 0x84, 0x6d, 0x68, 0x82, 0x99, 0x09, 0x33, 0x20, 0x60, 0xf2, 0xb5, 0x5f, 0x25,
+	0x23, 0x5d, 0x37, 0x89, 0xbc, 0xbb, 0x8d, 0xd1, 0x32, 0x0e, 0x7c, 0x6b, 0x8c, 0x96, 0x66, 0xce,
+	0x04, 0x3d, 0x6f, 0x04, 0xc1, 0x08, 0x6f, 0x06, 0xbb, 0x34, 0xea, 0xd0, 0xc6, 0x58, 0x19, 0x77,
+	0xae, 0x6b, 0x48, 0x4a, 0x33, 0x64, 0xea, 0x23, 0x6b, 0x03, 0xce, 0xc5, 0xfe, 0x20, 0x19, 0x8b,
+	0xa9, 0x4f, 0x5b, 0xa8, 0x1e, 0x8d, 0x33, 0x8e, 0x6f, 0x1f, 0x52, 0x55, 0x44, 0xbd, 0xa4, 0x29,
+	0xba, 0xf2, 0x0f, 0x4c, 0xfe, 0x02, 0x45, 0x12, 0x27, 0xb0, 0xe7, 0xf7, 0x3b, 0x5e, 0xd0, 0x20,
+	0x65, 0x4c, 0xe0, 0x06, 0xa3, 0x95, 0x99, 0x40, 0xde, 0x08, 0x82, 0x91, 0xfd, 0x79, 0x8b, 0xcc,
+	0xb8, 0x77, 0xe2, 0x94, 0xf7, 0x42, 0x63, 0xa2, 0x0c, 0xab, 0xd5, 0x40, 0x9f, 0x08, 0x6e, 0xce,
+	0xcf, 0x82, 0x21, 0x37, 0x0c, 0xe7, 0x3f, 0x5a, 0xc4, 0x4e, 0x6f, 0xb8, 0x0f, 0x41, 0x5f, 0x7f,
+	0x35, 0xad, 0xaf, 0xaf, 0x96, 0xa9, 0x50, 0x0d, 0x50, 0xd9, 0x7f, 0x8f, 0x90, 0x8c, 0xa8, 0xba,
+	0x4e, 0xe3, 0x84, 0xb6, 0xdf, 0x10, 0x2f, 0x6f, 0x88, 0x97, 0x37, 0xc4, 0x8b, 0xfc, 0x61, 0x6f,
+	0x65, 0xc4, 0xcb, 0x7b, 0x8d, 0xaf, 0x5e, 0xbb, 0x44, 0x7e, 0x58, 0xf9, 0x4c, 0x9a, 0x23, 0x30,
+	0x10, 0x70, 0x27, 0x78, 0xb9, 0xb9, 0x7e, 0xbd, 0x50, 0x9e, 0x7c, 0x38, 0x2d, 0x4f, 0x8e, 0xcb,
+	0xe2, 0x0d, 0x09, 0xf2, 0x68, 0x25, 0xc8, 0xaf, 0x5a, 0xe4, 0x74, 0x7a, 0x67, 0xdd, 0x70, 0x23,
+	0xb7, 0xab, 0x4c, 0x7d, 0xd6, 0x20, 0x53, 0x9f, 0xfd, 0x6e, 0x71, 0x7a, 0xe2, 0x27, 0x9f, 0xb7,
+	0x66, 0x4e, 0x4f, 0x4f, 0x16, 0x10, 0x35, 0x4e, 0x4e, 0x5f, 0x4e, 0x46, 0x85, 0xa5, 0x4d, 0x1c,
+	0x25, 0x27, 0xf0, 0xd4, 0x24, 0x6c, 0x72, 0x20, 0x61, 0x68, 0x6c, 0x41, 0x0f, 0x49, 0x2f, 0xa2,
+	0x6d, 0xb6, 0x0b, 0x8d, 0x69, 0xc1, 0x04, 0xa2, 0x1d, 0x14, 0x86, 0xf3, 0xa5, 0x0a, 0x79, 0x6b,
+	0x9a, 0xad, 0xfc, 0x42, 0x57, 0x3a, 0x41, 0x18, 0xd1, 0x65, 0x6f, 0x7b, 0x9b, 0x46, 0x34, 0xc0,
+	0x8b, 0xf0, 0xc3, 0x9f, 0xef, 0x1d, 0x64, 0xf2, 0x76, 0x8c, 0xb7, 0x41, 0x5e, 0x20, 0xb6, 0x7a,
+	0x3c, 0x75, 0xce, 0xa0, 0x0b, 0x11, 0xae, 0x5c, 0xd9, 0x0e, 0x29, 0x2c, 0xbc, 0x8c, 0xbc, 0xfd,
+	0xea, 0x86, 0x9b, 0x18, 0x16, 0x25, 0x69, 0xfb, 0x61, 0x97, 0x91, 0x2f, 0xbf, 0x92, 0x01, 0x42,
+	0x1e, 0x1f, 0x9d, 0x49, 0x19, 0xd1, 0x0c, 0x99, 0x1a, 0x23, 0xc3, 0x9c, 0x49, 0xd9, 0x08, 0x32,
+	0x84, 0x8a, 0xfa, 0xd8, 0x1f, 0x20, 0xe3, 0xec, 0xb3, 0x5a, 0x0b, 0xdb, 0x54, 0x9c, 0xde, 0xde,
+	0x23, 0xed, 0xa7, 0x6b, 0x12, 0xf0, 0xfa, 0xbd, 0xb9, 0xe7, 0xd3, 0x13, 0x97, 0x9b, 0x30, 0x85,
+	0x0b, 0x9a, 0x9e, 0xf3, 0x43, 0x15, 0x72, 0x2e, 0x33, 0xe1, 0xa1, 0xef, 0x87, 0xfd, 0x04, 0xcf,
+	0xef, 0x78, 0xa5, 0x3a, 0xd3, 0x4d, 0x1b, 0xd7, 0xa4, 0x73, 0xef, 0x37, 0x94, 0xa6, 0x33, 0x64,
+	0xac, 0x77, 0x8b, 0x0d, 0xf1, 0x70, 0x33, 0x19, 0x40, 0x0c, 0xb9, 0xb1, 0xd8, 0x1f, 0x24, 0xe3,
+	0x5d, 0xf7, 0xee, 0x8d, 0x5e, 0xdb, 0x4d, 0xa4, 0xe9, 0x64, 0xb0, 0xc5, 0xab, 0x9f, 0x78, 0xfe,
+	0x3c, 0x77, 0xbe, 0x9e, 0x5f, 0x09, 0x92, 0xf5, 0xa8, 0x99, 0x44, 0x5e, 0xd0, 0xe1, 0x77, 0x0f,
+	0x6b, 0x92, 0x0c, 0x68, 0x8a, 0xce, 0x0f, 0x5b, 0xe4, 0x99, 0x01, 0xb3, 0x13, 0xb9, 0x09, 0xed,
+	0xec, 0xdb, 0x1f, 0x25, 0xf5, 0x38, 0xa1, 0x3d, 0x39, 0x2b, 0xb7, 0xca, 0xd4, 0xa4, 0x8c, 0x37,
+	0xa1, 0x95, 0x2a, 0xfc, 0x15, 0x03, 0x67, 0x8a, 0x4a, 0x95, 0x9d, 0x37, 0xa2, 0xd9, 0x2f, 0x12,
+	0xd2, 0x09, 0xa5, 0x47, 0x30, 0xfb, 0x3e, 0xc6, 0xb4, 0x59, 0xef, 0x8a, 0x82, 0x80, 0x81, 0x65,
+	0x7f, 0xbb, 0x45, 0x48, 0x47, 0xee, 0x3d, 0x52, 0x31, 0xbc, 0x51, 0xe6, 0xe3, 0xe8, 0x9d, 0x4d,
+	0x8f, 0x45, 0x31, 0x04, 0x83, 0x79, 0xda, 0x7d, 0xba, 0xfa, 0x88, 0xdc, 0xa7, 0xff, 0x8a, 0x45,
+	0x08, 0xde, 0xe2, 0x8b, 0x4b, 0x6e, 0xae, 0x41, 0xdd, 0x2c, 0xd5, 0xf4, 0xa8, 0xa8, 0x73, 0x0f,
+	0x77, 0xfd, 0x1b, 0x0c, 0xce, 0xf6, 0xc7, 0xc8, 0x58, 0x2c, 0x96, 0xdb, 0x49, 0xf8, 0x92, 0xcb,
+	0xa5, 0x2c, 0xc4, 0xad, 0xf8, 0x05, 0x8a, 0xa7, 0xfd, 0x83, 0x16, 0x99, 0xee, 0xa5, 0x4d, 0xda,
+	0x42, 0x3d, 0x2a, 0x6f, 0x0f, 0xc8, 0x98, 0xcc, 0xb9, 0x65, 0x30, 0xd3, 0x08, 0xd9, 0x51, 0xe0,
+	0x4e, 0xad, 0x57, 0xb0, 0xf0, 0xb6, 0x68, 0x8c, 0xea, 0x9d, 0xfa, 0x4a, 0x16, 0x08, 0x79, 0x7c,
+	0xf4, 0x99, 0xc1, 0xd1, 0xed, 0xf3, 0xe3, 0x88, 0x54, 0x37, 0x62, 0xa6, 0x1c, 0x8d, 0x69, 0x9f,
+	0x99, 0x85, 0x02, 0x1c, 0x28, 0xec, 0x69, 0xff, 0xa6, 0x45, 0x9e, 0xf6, 0xd8, 0xee, 0x6b, 0xde,
+	0xa3, 0xe9, 0x8d, 0x58, 0x78, 0xe5, 0xd1, 0x52, 0xf7, 0x8a, 0x41, 0x62, 0x72, 0xf1, 0xcd, 0xe2,
+	0x09, 0x9e, 0x5e, 0x39, 0x60, 0x48, 0x70, 0xe0, 0x80, 0xed, 0xaf, 0x21, 0xa7, 0xe4, 0x77, 0xb1,
+	0x81, 0x5b, 0x30, 0x53, 0xbc, 0xc6, 0x17, 0x67, 0xd1, 0xfd, 0x6e, 0xd3, 0x04, 0x40, 0x1a, 0x0f,
+	0x63, 0x10, 0x26, 0x7b, 0xa8, 0x38, 0xc4, 0x4d, 0x16, 0x29, 0x23, 0x5c, 0xee, 0x5e, 0x29, 0xf3,
+	0xd1, 0x99, 0x62, 0xa2, 0x9d, 0x83, 0x37, 0x0c, 0x76, 0x90, 0x62, 0x2e, 0xa2, 0x5d, 0xf0, 0x56,
+	0xa3, 0x31, 0x99, 0x8b, 0x76, 0xc1, 0x66, 0x90, 0x70, 0xe7, 0x5f, 0x56, 0xc9, 0x99, 0xec, 0x77,
+	0xc2, 0x0c, 0xa9, 0xb8, 0x4f, 0xb6, 0xa4, 0x91, 0x55, 0x6e, 0xfb, 0xa5, 0xee, 0x93, 0xca, 0x84,
+	0xab, 0xf7, 0x49, 0xd5, 0x14, 0x83, 0xc1, 0x1c, 0x4f, 0x57, 0xb3, 0x6e, 0xf6, 0x3a, 0x42, 0x6c,
+	0xdd, 0x1f, 0x2c, 0x73, 0x48, 0x79, 0x1f, 0x81, 0x73, 0x62, 0x68, 0xb3, 0x39, 0x10, 0xe4, 0x87,
+	0x64, 0x7f, 0x33, 0x19, 0x8f, 0x94, 0xff, 0x6e, 0xb5, 0x0c, 0x9b, 0x83, 0x5c, 0xef, 0x62, 0x38,
+	0xea, 0x42, 0x59, 0x7b, 0xea, 0x6a, 0x8e, 0xce, 0xaf, 0xa5, 0x2f, 0xda, 0x8d, 0x4d, 0x6f, 0x08,
+	0x27, 0x82, 0xef, 0xb6, 0xc8, 0x44, 0x14, 0xfa, 0xbe, 0x17, 0x74, 0x70, 0x83, 0x16, 0x5a, 0xc6,
+	0x07, 0x4e, 0x44, 0xd0, 0x8b, 0x9d, 0x98, 0x1d, 0x11, 0x41, 0xf3, 0x04, 0x73, 0x00, 0x18, 0x99,
+	0xd0, 0x18, 0x24, 0x48, 0x6c, 0x4a, 0x9e, 0x92, 0xbb, 0xa4, 0x9a, 0x8a, 0xf5, 0x60, 0x99, 0xfa,
+	0x54, 0xdd, 0x4d, 0x8d, 0x2d, 0x3e, 0x27, 0x1e, 0xf3, 0xa9, 0x8d, 0xc1, 0xa8, 0x70, 0x10, 0x1d,
+	0xfb, 0xfd, 0x64, 0xc6, 0x78, 0xae, 0x58, 0x4d, 0xcc, 0xf8, 0xe2, 0x3c, 0x3b, 0xb5, 0x64, 0x60,
+	0xaf, 0xdf, 0x9b, 0x7b, 0x22, 0xdb, 0x26, 0x24, 0x5d, 0x8e, 0x8e, 0xf3, 0x13, 0x95, 0xec, 0xdb,
+	0x52, 0x4a, 0xca, 0xe7, 0xac, 0x9c, 0x59, 0xec, 0x1b, 0x4e, 0x42, 0x31, 0x60, 0x06, 0x34, 0xe5,
+	0x6c, 0x3a, 0x18, 0xe7, 0x11, 0xba, 0x01, 0x39, 0xbf, 0x5e, 0x23, 0x07, 0x8c, 0x6c, 0x88, 0xd3,
+	0xd1, 0x91, 0xfd, 0x32, 0x3e, 0x63, 0xa9, 0x5b, 0x69, 0xfe, 0x0d, 0xb7, 0x4f, 0x6a, 0xee, 0xb9,
+	0x21, 0x20, 0x1b, 0x51, 0x96, 0xbe, 0xff, 0xb6, 0x7f, 0xd4, 0x4a, 0xdf, 0xab, 0xf3, 0xd0, 0x0d,
+	0xef, 0xc4, 0xc6, 0x64, 0x5c, 0xd6, 0xf3, 0x81, 0xe9, 0x2b, 0xde, 0x41, 0xd7, 0xf8, 0xf3, 0x84,
+	0x6c, 0x7b, 0x81, 0xeb, 0x7b, 0xaf, 0xe1, 0xf1, 0xb3, 0xce, 0x34, 0x13, 0xa6, 0xea, 0x5d, 0x56,
+	0xad, 0x60, 0x60, 0x60, 0x90, 0x9c, 0xf1, 0xe4, 0x47, 0x09, 0x92, 0x3b, 0xff, 0x5e, 0x32, 0x93,
+	0x1d, 0xe0, 0x91, 0x82, 0xec, 0xbe, 0x7f, 0x3c, 0x7b, 0xd1, 0xbd, 0x49, 0xa3, 0x2e, 0x0e, 0xed,
+	0x0d, 0x0b, 0xed, 0x1b, 0x16, 0xda, 0x37, 0x2c, 0xb4, 0xe6, 0x05, 0xa0, 0xb0, 0x3e, 0x8e, 0x3e,
+	0x2c, 0xeb, 0xa3, 0x69, 0x4f, 0x1d, 0x2b, 0xdf, 0x9e, 0x5a, 0x68, 0xdc, 0x1c, 0x7f, 0x3c, 0x8c,
+	0x9b, 0x9f, 0xca, 0x5d, 0x8f, 0x6d, 0x46, 0x94, 0xda, 0x21, 0xa9, 0x07, 0x61, 0x9b, 0x4a, 0xfd,
+	0xfb, 0xe5, 0x72, 0x94, 0xc9, 0xeb, 0x61, 0xdb, 0x08, 0xd8, 0xc3, 0x5f, 0x31, 0x70, 0x3e, 0xce,
+	0xcf, 0x8e, 0xa4, 0xdc, 0x07, 0x79, 0x04, 0x05, 0x8b, 0xb4, 0xa7, 0xbd, 0xf0, 0x06, 0xac, 0x36,
+	0xac, 0xf4, 0x81, 0x02, 0x78, 0x33, 0x48, 0x38, 0xca, 0xe3, 0x9e, 0x9b, 0xec, 0x64, 0xe3, 0xd4,
+	0xd1, 0xd8, 0x07, 0x0c, 0x62, 0xbf, 0x97, 0x4c, 0x25, 0x29, 0x5f, 0x18, 0xe1, 0xf3, 0xf1, 0x84,
+	0xc0, 0x9d, 0x4a, 0x7b, 0xca, 0x40, 0x06, 0xdb, 0x7e, 0x95, 0xd4, 0x30, 0x28, 0xa2, 0x31, 0x5a,
+	0x86, 0x6f, 0x7b, 0xee, 0x59, 0x31, 0x04, 0x83, 0xef, 0xd2, 0xf8, 0x1f, 0x30, 0x56, 0xf8, 0x4d,
+	0x8e, 0xef, 0xf6, 0xe3, 0x24, 0xec, 0x7a, 0xaf, 0xc9, 0xeb, 0x84, 0x6f, 0x28, 0x99, 0xf1, 0x35,
+	0x49, 0x9f, 0xdb, 0xe9, 0xd4, 0x4f, 0xd0, 0x9c, 0xd9, 0x38, 0xda, 0x5e, 0xc4, 0x96, 0xf3, 0x7e,
+	0x83, 0x9c, 0xc8, 0x38, 0x96, 0x25, 0x7d, 0x3e, 0x0e, 0xf5, 0x13, 0x34, 0x67, 0x7b, 0x5f, 0xed,
+	0x0d, 0xfc, 0x6e, 0xe0, 0x46, 0xc9, 0x63, 0xe0, 0xfb, 0x42, 0xe1, 0x1e, 0xf1, 0x1c, 0xa9, 0xb7,
+	0x76, 0xdc, 0x48, 0x9e, 0x6c, 0xd5, 0x2a, 0x5e, 0xc2, 0x46, 0xe0, 0x30, 0x74, 0x8c, 0x8c, 0xe8,
+	0x76, 0xe3, 0x54, 0xda, 0x31, 0x12, 0xe8, 0x36, 0x60, 0xbb, 0xd2, 0x19, 0xa7, 0x06, 0xea, 0x8c,
+	0xf3, 0x84, 0xdc, 0xc1, 0x83, 0x3d, 0x2e, 0xdb, 0xb8, 0x31, 0xad, 0x15, 0x9a, 0x5b, 0xaa, 0x15,
+	0x0c, 0x0c, 0xe7, 0xc7, 0x2a, 0xe4, 0x7c, 0xee, 0x29, 0xd4, 0xd4, 0xf1, 0xef, 0xa7, 0xd5, 0x8f,
+	0x62, 0x69, 0xa5, 0x34, 0xbe, 0x1f, 0xd6, 0x0c, 0x12, 0x6e, 0x7f, 0xc2, 0x22, 0xa3, 0x68, 0x1d,
+	0x0f, 0x68, 0xd2, 0xa8, 0x94, 0x6d, 0x8b, 0x63, 0xc3, 0x7a, 0x99, 0x53, 0xd7, 0x63, 0x10, 0x0d,
+	0x20, 0xf9, 0xe2, 0x70, 0xe9, 0xdd, 0x96, 0xdf, 0x6f, 0xe7, 0xbc, 0xe7, 0x2e, 0xf1, 0x66, 0x90,
+	0x70, 0x44, 0xf5, 0x02, 0x8e, 0x5a, 0x4b, 0xa3, 0xae, 0x04, 0x02, 0x55, 0xc0, 0x9d, 0x5f, 0x1a,
+	0x27, 0x67, 0x0b, 0x3f, 0x37, 0x9c, 0x6d, 0xa6, 0xa0, 0x5d, 0xf6, 0x7c, 0x2a, 0xfd, 0x46, 0xd9,
+	0x6c, 0xdf, 0x54, 0xad, 0x60, 0x60, 0xd8, 0xdf, 0x42, 0x08, 0xb3, 0x77, 0x50, 0x75, 0xdb, 0x71,
+	0x6c, 0x2d, 0x8d, 0x45, 0x5e, 0x49, 0x9a, 0xda, 0x20, 0xa1, 0x9a, 0x62, 0x30, 0x58, 0xa2, 0x27,
+	0x64, 0x44, 0x7d, 0xea, 0xc6, 0x2c, 0x60, 0x31, 0x1b, 0x7d, 0x0d, 0x1a, 0x04, 0x26, 0x1e, 0x3a,
+	0xa7, 0x09, 0x17, 0xdb, 0x8c, 0xab, 0x61, 0xda, 0xcd, 0xd6, 0xfe, 0x1e, 0x8b, 0x4c, 0x61, 0x2e,
+	0x12, 0xcd, 0x5d, 0xc4, 0x4a, 0xaf, 0x1f, 0xff, 0x21, 0x2f, 0x9b, 0x74, 0xf5, 0x9e, 0x9b, 0x6a,
+	0x8e, 0x21, 0xc3, 0x1e, 0x5f, 0xf3, 0x1e, 0x8d, 0xd8, 0x66, 0x3d, 0x92, 0x7e, 0xcd, 0x37, 0x79,
+	0x33, 0x48, 0xb8, 0xbd, 0x40, 0xa6, 0x7b, 0x6e, 0x1c, 0x2f, 0x45, 0xb4, 0x4d, 0x83, 0xc4, 0x73,
+	0x7d, 0x1e, 0xc9, 0x3c, 0xa6, 0x43, 0x6d, 0x36, 0xd2, 0x60, 0xc8, 0xe2, 0xdb, 0xef, 0x23, 0x4f,
+	0x72, 0x33, 0xdd, 0x9a, 0x17, 0xc7, 0x5e, 0xd0, 0xd1, 0xcb, 0x40, 0x58, 0x2b, 0xe7, 0x04, 0xa9,
+	0x27, 0x57, 0x8a, 0xd1, 0x60, 0x50, 0x7f, 0xbc, 0xa6, 0x8b, 0x77, 0xbd, 0xde, 0x52, 0xd4, 0xe6,
+	0xa2, 0xdf, 0xb8, 0xa6, 0x6b, 0x8a, 0x76, 0x50, 0x18, 0x76, 0x8b, 0x4c, 0xf2, 0x57, 0xc2, 0x7d,
+	0x84, 0xc5, 0x8e, 0xfb, 0xc2, 0x40, 0xa5, 0x44, 0xa4, 0xcb, 0x99, 0x07, 0xf7, 0xce, 0x25, 0x79,
+	0x81, 0xcc, 0xef, 0xe1, 0x6e, 0x1a, 0x64, 0x20, 0x45, 0x34, 0x7d, 0x3e, 0x9d, 0x18, 0xe2, 0x7c,
+	0xfa, 0xd5, 0x64, 0x62, 0xb7, 0xbf, 0x45, 0xc5, 0xcc, 0x37, 0x26, 0xd3, 0xab, 0xef, 0x9a, 0x06,
+	0x81, 0x89, 0xc7, 0xdc, 0xb3, 0x7b, 0x9e, 0xf8, 0x85, 0xc1, 0xb3, 0xda, 0x3d, 0x7b, 0x63, 0x45,
+	0x36, 0x83, 0x89, 0x83, 0x43, 0xc3, 0xb9, 0xd8, 0xa4, 0x31, 0x0b, 0x7f, 0xc5, 0xe9, 0x52, 0x43,
+	0x6b, 0x4a, 0x00, 0x68, 0x1c, 0x16, 0x98, 0xb9, 0xeb, 0xf5, 0xb8, 0x1d, 0xf2, 0xa6, 0xeb, 0x7b,
+	0x6d, 0xee, 0x2b, 0x3c, 0x9d, 0x36, 0x32, 0x37, 0x0b, 0x70, 0xa0, 0xb0, 0x27, 0x5a, 0x56, 0x4f,
+	0xf5, 0x52, 0xf1, 0x92, 0x33, 0x17, 0xaa, 0xc7, 0x3f, 0x26, 0x65, 0x23, 0x2d, 0x75, 0x98, 0x75,
+	0x3a, 0xfe, 0x32, 0xcd, 0x1b, 0x53, 0xb4, 0x34, 0x06, 0x6d, 0xa8, 0x76, 0x8c, 0xdb, 0x66, 0x72,
+	0xd3, 0x55, 0x89, 0x81, 0x8e, 0x19, 0x1c, 0x2f, 0xe8, 0xde, 0x74, 0x23, 0x73, 0x03, 0x66, 0x0c,
+	0x40, 0x72, 0xb2, 0x6f, 0x93, 0x5a, 0xe2, 0xbb, 0x25, 0x65, 0xd3, 0x30, 0x38, 0x6a, 0x13, 0xe1,
+	0xea, 0x42, 0x0c, 0x8c, 0x87, 0xfd, 0x34, 0x9e, 0x8b, 0xb7, 0xe4, 0x25, 0xb1, 0x38, 0xca, 0x6e,
+	0xc5, 0xc0, 0x5a, 0x9d, 0xef, 0x3f, 0x55, 0x20, 0x03, 0x95, 0x1a, 0x83, 0x97, 0x75, 0xb8, 0x84,
+	0x37, 0x22, 0xba, 0xed, 0xdd, 0x15, 0x6a, 0xa4, 0xda, 0x67, 0xaf, 0x2b, 0x08, 0x18, 0x58, 0xb2,
+	0x4f, 0xb3, 0xbf, 0x8d, 0x7d, 0x2a, 0xf9, 0x3e, 0x1c, 0x02, 0x06, 0x96, 0xfd, 0x0e, 0x32, 0xc2,
+	0x02, 0x3c, 0xe5, 0x30, 0x9f, 0xc6, 0x0d, 0x96, 0x45, 0x83, 0xa2, 0x1b, 0xf1, 0x94, 0x1a, 0x10,
+	0x6b, 0x02, 0x81, 0x8b, 0x21, 0x94, 0x93, 0xad, 0xb0, 0xdb, 0x0d, 0x03, 0x6e, 0x98, 0x10, 0x56,
+	0x96, 0xdb, 0x27, 0xa5, 0xe4, 0xcd, 0x2f, 0x19, 0xcc, 0xb8, 0x99, 0x45, 0x59, 0xf6, 0x4d, 0x10,
+	0xa4, 0x46, 0x65, 0xee, 0xc3, 0xf5, 0x43, 0xf6, 0xe1, 0x9f, 0xb7, 0xc8, 0x2c, 0xef, 0x6b, 0xd8,
+	0x4b, 0x44, 0x86, 0x8b, 0xf0, 0x84, 0x1f, 0x2b, 0x67, 0x42, 0x52, 0x66, 0xf4, 0x1c, 0x1c, 0xf2,
+	0x83, 0xb4, 0xaf, 0x90, 0xd9, 0xed, 0x30, 0x6a, 0x51, 0x73, 0x22, 0x84, 0x10, 0x51, 0x84, 0x2e,
+	0x67, 0x11, 0x20, 0xdf, 0xc7, 0xbe, 0x49, 0x9e, 0x30, 0x1a, 0xcd, 0x79, 0xe0, 0x72, 0xe4, 0x59,
+	0x41, 0xed, 0x89, 0xcb, 0x85, 0x58, 0x30, 0xa0, 0x77, 0x7a, 0xcb, 0x1e, 0x1f, 0x62, 0xcb, 0xfe,
+	0x30, 0x39, 0xd7, 0xca, 0xcf, 0xcc, 0x5e, 0xdc, 0xdf, 0x8a, 0xb9, 0x54, 0x19, 0xd3, 0x91, 0xcb,
+	0x4b, 0x83, 0x10, 0x61, 0x30, 0x0d, 0xfb, 0xa3, 0xe8, 0x7e, 0xc2, 0xde, 0x4a, 0xdc, 0x98, 0x28,
+	0x63, 0x83, 0xd4, 0xe7, 0x0f, 0x4e, 0xd6, 0x74, 0x67, 0xe1, 0x7c, 0x40, 0x71, 0xb4, 0xef, 0x90,
+	0xd1, 0x1e, 0x2a, 0xc3, 0x22, 0xc9, 0xc3, 0xb1, 0x6f, 0x3d, 0x14, 0x73, 0x76, 0xbb, 0x66, 0x5c,
+	0x5f, 0x71, 0x26, 0x20, 0xb9, 0xa1, 0xe6, 0xd8, 0x0a, 0xbb, 0xbd, 0x30, 0xa0, 0x41, 0x22, 0x45,
+	0xda, 0x14, 0xbf, 0x49, 0x92, 0xad, 0x60, 0x60, 0xe4, 0x34, 0x0b, 0x8d, 0xd6, 0x98, 0x3d, 0x40,
+	0xb3, 0x30, 0xa8, 0x0d, 0xea, 0x8f, 0xa2, 0x8f, 0x19, 0x6c, 0x6f, 0x79, 0xc9, 0x0e, 0x5e, 0x72,
+	0x48, 0x43, 0xc6, 0x54, 0x5a, 0xf4, 0xad, 0x16, 0xe0, 0x40, 0x61, 0xcf, 0xac, 0x9c, 0x9f, 0x7e,
+	0x30, 0x39, 0x3f, 0x33, 0x84, 0x9c, 0x6f, 0x92, 0xb3, 0x6c, 0x04, 0x42, 0x67, 0x97, 0xe6, 0xe0,
+	0x98, 0x25, 0x54, 0x18, 0xd3, 0x91, 0x88, 0xab, 0x45, 0x48, 0x50, 0xdc, 0xf7, 0xfc, 0xd7, 0x91,
+	0xd9, 0xdc, 0x26, 0x77, 0x24, 0x53, 0xef, 0x32, 0x79, 0xa2, 0x78, 0x3b, 0x39, 0x92, 0xc1, 0xf7,
+	0x1f, 0x65, 0xc2, 0x6a, 0x8c, 0x03, 0xe6, 0x10, 0x97, 0x07, 0x2e, 0xa9, 0xd2, 0x60, 0x4f, 0x48,
+	0xd7, 0xcb, 0xc7, 0x5b, 0xd5, 0x97, 0x82, 0x3d, 0xbe, 0x1b, 0x32, 0x0b, 0xe9, 0xa5, 0x60, 0x0f,
+	0x90, 0xb6, 0xfd, 0x7d, 0x56, 0xea, 0x38, 0xc3, 0xaf, 0x1c, 0x3e, 0x74, 0x22, 0x27, 0xea, 0xa1,
+	0x4f, 0x38, 0xce, 0x6f, 0x54, 0xc8, 0x85, 0xc3, 0x88, 0x0c, 0x31, 0x7d, 0xcf, 0x61, 0x5c, 0x4f,
+	0xe4, 0x05, 0x9d, 0x46, 0x5d, 0xfb, 0xce, 0x71, 0x77, 0xa4, 0x0f, 0x83, 0x00, 0xd9, 0x3e, 0xa9,
+	0x76, 0xdd, 0x9e, 0xb0, 0x44, 0xaf, 0x1c, 0x37, 0xd2, 0x1a, 0x7f, 0xbb, 0xfe, 0x9a, 0xdb, 0xe3,
+	0x6b, 0xde, 0x68, 0x00, 0x64, 0x63, 0x27, 0xa4, 0xee, 0x46, 0x91, 0x2b, 0x3d, 0x5d, 0xae, 0x95,
+	0xc3, 0x6f, 0x01, 0x49, 0x72, 0x47, 0x81, 0x54, 0x13, 0x70, 0x66, 0xce, 0x17, 0x48, 0x2a, 0x56,
+	0x95, 0xb9, 0x2f, 0xc5, 0x64, 0x44, 0x18, 0xa0, 0xad, 0xb2, 0x03, 0xdc, 0x19, 0x59, 0x6e, 0x3f,
+	0xe1, 0xff, 0x83, 0x60, 0x85, 0xfa, 0xf4, 0x84, 0x91, 0x54, 0xa5, 0x51, 0x29, 0xd9, 0xd3, 0xc6,
+	0x4c, 0x64, 0x66, 0xe6, 0x23, 0x93, 0x8d, 0x60, 0x72, 0x37, 0x3d, 0x15, 0xaa, 0x07, 0x7b, 0x2a,
+	0xd8, 0x77, 0x0b, 0xdc, 0x94, 0x4a, 0xc8, 0x3e, 0x35, 0x84, 0x63, 0xd2, 0x8f, 0x5a, 0x64, 0xd6,
+	0xcb, 0xfa, 0x9b, 0x88, 0x13, 0xf9, 0xad, 0x72, 0x2c, 0xb2, 0x79, 0x77, 0x16, 0xa5, 0xe8, 0xe4,
+	0x40, 0x90, 0x1f, 0x8c, 0xdd, 0x26, 0x35, 0x2f, 0xd8, 0x0e, 0x85, 0x7a, 0xb7, 0x78, 0xcc, 0xe4,
+	0x2a, 0xc1, 0x76, 0xa8, 0xbf, 0x66, 0xfc, 0x05, 0x8c, 0xba, 0xbd, 0x4a, 0xce, 0xc8, 0x70, 0xc5,
+	0xab, 0x5e, 0x8c, 0x96, 0xad, 0x55, 0xaf, 0xeb, 0x25, 0x4c, 0x35, 0xab, 0x2e, 0x36, 0x50, 0xbc,
+	0x41, 0x01, 0x1c, 0x0a, 0x7b, 0xd9, 0xaf, 0x91, 0x51, 0xe9, 0x2a, 0x31, 0x56, 0x86, 0x75, 0x23,
+	0xbf, 0xfe, 0xd5, 0x62, 0xe2, 0xbf, 0x63, 0x90, 0x0c, 0xed, 0x4f, 0x5b, 0x64, 0x8a, 0xff, 0x7f,
+	0x75, 0xbf, 0xcd, 0x23, 0xa4, 0xc7, 0xcb, 0x08, 0x3a, 0x6a, 0xa6, 0x68, 0x2e, 0xda, 0x68, 0x5a,
+	0x49, 0xb7, 0x41, 0x86, 0x2f, 0xda, 0x4b, 0xa2, 0x4c, 0xa2, 0x26, 0xee, 0x75, 0xa4, 0xec, 0x25,
+	0xd9, 0x2c, 0x4d, 0x59, 0x7c, 0xd4, 0x2e, 0x07, 0x26, 0x83, 0x12, 0x16, 0x05, 0xa5, 0x5d, 0x0e,
+	0xcc, 0x73, 0x00, 0x83, 0x69, 0xe0, 0x4e, 0xb5, 0xcd, 0x12, 0x09, 0x30, 0x63, 0x43, 0x99, 0x6f,
+	0x8a, 0xe7, 0x27, 0xe0, 0x3b, 0x15, 0xff, 0x1f, 0x04, 0x2b, 0xe7, 0xef, 0x4c, 0x92, 0xd9, 0x85,
+	0x83, 0x5d, 0x6c, 0xac, 0x87, 0xed, 0x62, 0x83, 0xc7, 0xed, 0x58, 0x7b, 0xc7, 0x94, 0xb0, 0xff,
+	0x08, 0xae, 0xda, 0xf3, 0x01, 0xfd, 0x60, 0x18, 0x0f, 0xbb, 0x4f, 0x46, 0x78, 0xd6, 0xd6, 0x46,
+	0xb5, 0x8c, 0x1b, 0xb8, 0x4c, 0x6a, 0x59, 0x6d, 0x7d, 0xe4, 0xad, 0x20, 0x98, 0xd9, 0x77, 0xc9,
+	0xe8, 0x0e, 0xff, 0x4e, 0xc5, 0x21, 0x78, 0xed, 0xb8, 0xf3, 0x9b, 0xfa, 0xf8, 0xf5, 0x57, 0x29,
+	0x1a, 0x40, 0xb2, 0x63, 0xae, 0xa8, 0x86, 0xcf, 0x19, 0xdf, 0x61, 0xcb, 0x8b, 0x82, 0x1f, 0xde,
+	0xe1, 0xec, 0x23, 0x64, 0x32, 0xa2, 0xad, 0x30, 0x68, 0x79, 0x3e, 0x6d, 0x2f, 0xc8, 0x3b, 0xd8,
+	0xa3, 0x04, 0x3f, 0x33, 0xa3, 0x1f, 0x18, 0x34, 0x20, 0x45, 0x91, 0x6d, 0x40, 0x2a, 0xf7, 0x0b,
+	0xbe, 0x10, 0x2a, 0xee, 0xb3, 0x56, 0x4b, 0xca, 0x34, 0xc3, 0x68, 0xf2, 0x0d, 0x28, 0xdd, 0x06,
+	0x19, 0xbe, 0xf6, 0xfb, 0x09, 0x09, 0xb7, 0xb8, 0xbf, 0xe9, 0x42, 0xd2, 0x18, 0x3b, 0xf2, 0xa3,
+	0x4e, 0xf1, 0x24, 0x0a, 0x92, 0x02, 0x18, 0xd4, 0xec, 0x6b, 0x84, 0xf0, 0x2f, 0x07, 0x6f, 0xc6,
+	0x1b, 0xe3, 0xa9, 0xe8, 0x75, 0xd2, 0x54, 0x90, 0xd7, 0xef, 0xcd, 0xe5, 0xaf, 0x06, 0x10, 0x00,
+	0x46, 0x77, 0xfb, 0x9b, 0xc8, 0x68, 0xdc, 0xef, 0x76, 0x5d, 0x75, 0xf5, 0x55, 0x62, 0x5a, 0x06,
+	0x4e, 0xd7, 0x90, 0x18, 0xbc, 0x01, 0x24, 0x47, 0xfb, 0x36, 0xca, 0x3e, 0xb1, 0x75, 0xf3, 0xaf,
+	0x88, 0xfd, 0x2f, 0xb6, 0xd7, 0x77, 0xca, 0xe3, 0x1d, 0x14, 0xe0, 0xa0, 0x57, 0x58, 0xba, 0x7d,
+	0x35, 0x6c, 0x09, 0x9b, 0x67, 0x11, 0x4d, 0xfb, 0x65, 0x32, 0xa1, 0x1f, 0x5b, 0xe6, 0x4d, 0x7c,
+	0x5e, 0x27, 0xa8, 0x65, 0xcd, 0x83, 0xe7, 0xcc, 0xec, 0x6c, 0xaf, 0x91, 0xd3, 0xad, 0x30, 0x48,
+	0xa2, 0xd0, 0xf7, 0x79, 0xda, 0x74, 0x6e, 0xb4, 0xe0, 0x57, 0x63, 0x2a, 0xb7, 0xdb, 0x52, 0x1e,
+	0x05, 0x8a, 0xfa, 0xe1, 0x61, 0x25, 0x2b, 0x38, 0xa7, 0x4a, 0xf1, 0xe8, 0x48, 0xd1, 0x14, 0x3b,
+	0x94, 0xba, 0x9d, 0x38, 0x58, 0x84, 0x3a, 0x41, 0xfa, 0xee, 0x5c, 0xbc, 0xb1, 0x77, 0x90, 0x49,
+	0x8c, 0xe2, 0x8a, 0x02, 0xd7, 0x67, 0x59, 0x0b, 0x2d, 0x1d, 0x15, 0x73, 0xc9, 0x68, 0x87, 0x14,
+	0x16, 0x66, 0x24, 0x11, 0xe6, 0x43, 0x23, 0x23, 0x09, 0x37, 0x1f, 0x4a, 0x63, 0xa1, 0xf3, 0x85,
+	0x6a, 0x4a, 0x99, 0x7f, 0x24, 0x37, 0xf5, 0x2c, 0xf7, 0xa8, 0x4c, 0xd2, 0xca, 0x00, 0x8d, 0x4a,
+	0xe9, 0x9c, 0x95, 0x51, 0x7c, 0xdd, 0x64, 0x04, 0x69, 0xbe, 0xf6, 0x2e, 0xa9, 0xef, 0x84, 0x71,
+	0x22, 0x8f, 0xae, 0xc7, 0x3c, 0x25, 0x5f, 0x0d, 0xe3, 0x84, 0x69, 0xa0, 0xea, 0xb1, 0xb1, 0x25,
+	0x06, 0xce, 0x03, 0x8d, 0x22, 0xf1, 0x8e, 0x1b, 0xb5, 0xe3, 0x25, 0xa6, 0xdd, 0xf0, 0xac, 0xf5,
+	0xea, 0xa0, 0xd1, 0xd4, 0x20, 0x30, 0xf1, 0x9c, 0x3f, 0xb1, 0x52, 0x97, 0x8f, 0xec, 0x1a, 0xf7,
+	0xd2, 0x1e, 0x0d, 0x70, 0x8b, 0x32, 0x3d, 0x63, 0xbf, 0x26, 0x13, 0x1c, 0xf6, 0xd6, 0x41, 0x15,
+	0x0e, 0xd8, 0xe5, 0xef, 0x3c, 0x23, 0x61, 0x38, 0xd1, 0x7e, 0xdc, 0x4a, 0xe7, 0x48, 0xa9, 0x94,
+	0x71, 0xa6, 0x35, 0xc6, 0x7d, 0x78, 0xba, 0x15, 0xe7, 0xfb, 0x2c, 0x32, 0xba, 0xe8, 0xb6, 0x76,
+	0xc3, 0xed, 0x6d, 0xbc, 0xed, 0x6a, 0xf7, 0x23, 0x33, 0x5d, 0x8b, 0xb2, 0xe2, 0x2d, 0x8b, 0x76,
+	0x50, 0x18, 0xb8, 0xf4, 0xb7, 0xdd, 0x96, 0xcc, 0x16, 0x54, 0x15, 0x4a, 0x19, 0x6b, 0x01, 0x01,
+	0xc1, 0xe9, 0xef, 0xba, 0x77, 0x65, 0xe7, 0xec, 0xcd, 0xe7, 0x9a, 0x06, 0x81, 0x89, 0xe7, 0xfc,
+	0x0b, 0x8b, 0x34, 0x16, 0xdd, 0xd8, 0x6b, 0x61, 0xd5, 0x87, 0x45, 0x2f, 0xd9, 0xea, 0xb7, 0x76,
+	0x69, 0xc2, 0x13, 0x68, 0xe1, 0x28, 0xfb, 0x31, 0x8d, 0x0c, 0x53, 0x82, 0x1a, 0xe5, 0x0d, 0xd1,
+	0x0e, 0x0a, 0xc3, 0x7e, 0x8d, 0x4c, 0xe0, 0x7d, 0xe1, 0x9d, 0x30, 0x6a, 0x03, 0xdd, 0x2e, 0x27,
+	0xc5, 0x5e, 0x93, 0xb6, 0x22, 0x9a, 0x00, 0xdd, 0x16, 0x3e, 0x51, 0x9a, 0x3e, 0x98, 0xcc, 0x9c,
+	0x6f, 0xb7, 0xc8, 0x99, 0x45, 0xea, 0x46, 0x34, 0x62, 0x19, 0xf9, 0xd4, 0x83, 0xd8, 0xaf, 0x92,
+	0xb1, 0x04, 0x5b, 0x70, 0x44, 0x56, 0xb9, 0x23, 0x62, 0xde, 0x4c, 0x9b, 0x82, 0x38, 0x28, 0x36,
+	0xce, 0x77, 0x5b, 0xe4, 0x5c, 0xd1, 0x58, 0x96, 0xfc, 0xb0, 0xdf, 0x7e, 0x14, 0x03, 0xfa, 0xeb,
+	0x16, 0x99, 0x64, 0x5e, 0x18, 0xcb, 0x34, 0x71, 0x3d, 0x3f, 0x97, 0xa3, 0xdc, 0x1a, 0x32, 0x47,
+	0xf9, 0x05, 0x52, 0xdb, 0x09, 0xbb, 0xb9, 0x4a, 0x17, 0x57, 0x43, 0xb4, 0x2a, 0x21, 0x04, 0x2d,
+	0x9c, 0x5d, 0xd7, 0x0b, 0x12, 0x17, 0x3f, 0x47, 0x79, 0xcf, 0x33, 0xcd, 0x17, 0xa0, 0x6a, 0x06,
+	0x13, 0x07, 0x9d, 0x0f, 0x46, 0x85, 0x2b, 0xde, 0xd0, 0x09, 0xdd, 0xa4, 0x79, 0xab, 0x32, 0xd0,
+	0xbc, 0x15, 0x93, 0x91, 0x16, 0x2b, 0x61, 0xd2, 0xa8, 0x96, 0x61, 0x4c, 0x12, 0x03, 0xe4, 0x55,
+	0x51, 0xf4, 0xb0, 0xf8, 0x6f, 0x10, 0xac, 0xec, 0xef, 0xb5, 0xc8, 0x74, 0x2b, 0x0c, 0x02, 0xda,
+	0xd2, 0xba, 0x63, 0xad, 0x8c, 0x03, 0xc2, 0x52, 0x9a, 0xa8, 0x3e, 0x80, 0x66, 0x00, 0x90, 0x65,
+	0x6f, 0xbf, 0x9b, 0x9c, 0xe2, 0x73, 0x76, 0x33, 0x75, 0x39, 0xa5, 0x53, 0x57, 0x9b, 0x40, 0x48,
+	0xe3, 0xa2, 0x0d, 0x3f, 0xd0, 0x49, 0xa2, 0x47, 0xb4, 0x0d, 0xdf, 0x48, 0x0f, 0x6d, 0x60, 0x60,
+	0x7e, 0x22, 0x71, 0x00, 0x16, 0xae, 0x8a, 0x4c, 0x6f, 0x1d, 0x7d, 0xb0, 0xfc, 0x44, 0x90, 0xa3,
+	0x04, 0x05, 0xd4, 0xed, 0x5d, 0x61, 0x5f, 0x19, 0x2b, 0x63, 0x3f, 0x17, 0xaf, 0x79, 0xa0, 0x99,
+	0x65, 0x8e, 0xd4, 0x99, 0xe8, 0x62, 0xfa, 0x72, 0x95, 0xc7, 0x9d, 0x33, 0xc1, 0x06, 0xbc, 0xdd,
+	0x5e, 0x26, 0x33, 0x99, 0xc4, 0xdb, 0xb1, 0xb8, 0x44, 0x52, 0x31, 0xa5, 0x99, 0x94, 0xdd, 0x31,
+	0xe4, 0x7a, 0x98, 0xb6, 0xb7, 0x89, 0x43, 0x6c, 0x6f, 0xfb, 0xca, 0x21, 0x7e, 0xb2, 0x8c, 0xb8,
+	0x26, 0x31, 0xb8, 0xa1, 0xbc, 0xdf, 0xbf, 0x2b, 0xe3, 0xfd, 0x7e, 0xaa, 0x8c, 0xf4, 0xc1, 0x72,
+	0x00, 0x47, 0x77, 0x75, 0x7f, 0x94, 0xae, 0xeb, 0xff, 0xc3, 0x22, 0xf2, 0xbd, 0x2e, 0xb9, 0xad,
+	0x1d, 0x8a, 0x4b, 0x06, 0xbd, 0x29, 0x95, 0x75, 0x82, 0xab, 0x44, 0x16, 0x5b, 0x35, 0x4a, 0x77,
+	0x86, 0x14, 0x14, 0x32, 0xd8, 0x78, 0x95, 0x89, 0xf3, 0xc4, 0xbb, 0x72, 0xb9, 0xaf, 0x2c, 0x20,
+	0x0b, 0x1b, 0x2b, 0xa2, 0x97, 0xc6, 0xb1, 0x43, 0x32, 0xeb, 0xbb, 0x71, 0xc2, 0x46, 0x80, 0xc6,
+	0x8a, 0x07, 0xcc, 0x0e, 0xc6, 0x02, 0x17, 0x57, 0xb3, 0x84, 0x20, 0x4f, 0xdb, 0xf9, 0xd7, 0x75,
+	0x72, 0x2a, 0xb5, 0x33, 0x1e, 0x51, 0x61, 0xf8, 0x4a, 0x32, 0x26, 0x65, 0x78, 0x36, 0x0d, 0xa2,
+	0x12, 0xf4, 0x0a, 0x03, 0x85, 0xd6, 0x96, 0x96, 0xaa, 0x59, 0x05, 0xc7, 0x10, 0xb8, 0x60, 0xe2,
+	0xb1, 0x4d, 0x39, 0xf1, 0xe3, 0x25, 0xdf, 0xa3, 0x41, 0xc2, 0x87, 0x59, 0xce, 0xa6, 0xbc, 0xb9,
+	0xda, 0x34, 0x89, 0xea, 0x4d, 0x39, 0x03, 0x80, 0x2c, 0x7b, 0xfb, 0x2f, 0x5b, 0xe4, 0x94, 0x7b,
+	0x27, 0xd6, 0x75, 0xb6, 0x1a, 0xf5, 0x32, 0x84, 0x54, 0xaa, 0x74, 0x17, 0xbf, 0xf1, 0x48, 0x35,
+	0x41, 0x9a, 0x29, 0xc6, 0x32, 0xd9, 0xf4, 0x2e, 0x6d, 0x49, 0x4f, 0x7c, 0x31, 0x96, 0x91, 0x32,
+	0x4e, 0xf0, 0x97, 0x72, 0x74, 0xf9, 0xae, 0x9e, 0x6f, 0x87, 0x82, 0x31, 0x60, 0x9a, 0xfc, 0xb6,
+	0x17, 0xbb, 0x5b, 0x3e, 0x5e, 0xf1, 0xab, 0x24, 0xf2, 0xdc, 0xd1, 0x40, 0xa5, 0xc9, 0x5f, 0xce,
+	0x61, 0x40, 0x41, 0x2f, 0xb6, 0xca, 0xa2, 0xf0, 0xee, 0xfe, 0x8d, 0xc8, 0x6f, 0x8c, 0x65, 0x56,
+	0x99, 0x68, 0x07, 0x85, 0xe1, 0xfc, 0x54, 0x85, 0x3c, 0xa9, 0xd7, 0x34, 0x93, 0xa5, 0x7b, 0x5e,
+	0xb2, 0xcf, 0xbe, 0xe8, 0x65, 0x32, 0xc3, 0x0e, 0x17, 0xcb, 0x5e, 0x2c, 0xe4, 0x6c, 0x2c, 0xbe,
+	0x69, 0xb5, 0xbb, 0xdf, 0xca, 0xc0, 0x21, 0xd7, 0x03, 0x45, 0xb2, 0xef, 0xc5, 0xc9, 0xaa, 0x9b,
+	0xd0, 0xa0, 0xb5, 0xbf, 0x16, 0x8b, 0x6f, 0x5b, 0x89, 0xe4, 0x55, 0x13, 0x08, 0x69, 0x5c, 0xec,
+	0x1c, 0x71, 0xe9, 0x27, 0x36, 0x86, 0x6a, 0xba, 0x33, 0x98, 0x40, 0x48, 0xe3, 0xa2, 0xc5, 0x61,
+	0xdb, 0x45, 0x33, 0x57, 0x0a, 0x4b, 0x9c, 0xd4, 0x94, 0xc5, 0xe1, 0x72, 0x1e, 0x05, 0x8a, 0xfa,
+	0x39, 0x9f, 0xa8, 0xab, 0x5d, 0x4f, 0x47, 0xe8, 0xb8, 0x46, 0xa4, 0x80, 0xf5, 0xe0, 0x91, 0x02,
+	0xda, 0xf7, 0x2f, 0x1f, 0x2d, 0x90, 0x0a, 0xce, 0xaf, 0x3c, 0xa2, 0xe0, 0xfc, 0x6f, 0xb5, 0x52,
+	0x59, 0x59, 0x27, 0x5e, 0x7c, 0x7f, 0xb9, 0xd1, 0x41, 0xc3, 0x94, 0x34, 0xc3, 0xa5, 0xbd, 0xed,
+	0xbb, 0x2c, 0x5f, 0x57, 0x36, 0xb5, 0xc9, 0x65, 0xd1, 0x0e, 0x0a, 0xc3, 0xfe, 0x01, 0x8b, 0x4c,
+	0x33, 0xd9, 0xcd, 0x52, 0x3c, 0x6e, 0x87, 0x51, 0x57, 0x5a, 0x72, 0x9b, 0xa5, 0x8c, 0x7d, 0x35,
+	0x45, 0x5b, 0xef, 0x87, 0xe9, 0xf6, 0x18, 0xb2, 0x83, 0x38, 0x4e, 0x65, 0xb6, 0xff, 0x55, 0x23,
+	0x13, 0x86, 0xd6, 0x56, 0xa8, 0x82, 0x5b, 0x8f, 0x99, 0x0a, 0x5e, 0x39, 0x82, 0x0a, 0xfe, 0x2d,
+	0x64, 0xbc, 0x25, 0x35, 0x8a, 0x72, 0xea, 0x8f, 0x65, 0xf5, 0x14, 0xad, 0x54, 0xa8, 0x26, 0xd0,
+	0x3c, 0xd1, 0xe3, 0xcb, 0x20, 0x93, 0xda, 0x31, 0x8a, 0x22, 0xb0, 0xc5, 0x7e, 0x91, 0xef, 0x93,
+	0x75, 0x7e, 0xa9, 0x0f, 0xe1, 0xfc, 0xf2, 0x43, 0x16, 0x99, 0x69, 0x65, 0x36, 0xe1, 0xc6, 0x48,
+	0x19, 0x71, 0x0d, 0x03, 0x76, 0x78, 0x43, 0x4b, 0xcf, 0x40, 0x20, 0x37, 0x10, 0xe7, 0x37, 0x2d,
+	0x72, 0xb6, 0x70, 0xe5, 0x63, 0x24, 0x04, 0x5b, 0xe2, 0x42, 0x05, 0x52, 0xe6, 0x32, 0x86, 0x06,
+	0x1c, 0x86, 0x48, 0x11, 0xed, 0x50, 0xe9, 0x3c, 0xa9, 0x90, 0x00, 0x1b, 0x81, 0xc3, 0xb8, 0x3b,
+	0x7b, 0xcf, 0x77, 0x5b, 0xb4, 0x4b, 0x83, 0x24, 0xab, 0xf3, 0x80, 0x06, 0x81, 0x89, 0x87, 0xdd,
+	0x78, 0x68, 0x0e, 0xe3, 0xd8, 0xa8, 0xa5, 0xbb, 0x6d, 0x6a, 0x10, 0x98, 0x78, 0x98, 0xe8, 0x5e,
+	0x7e, 0x4c, 0x0f, 0x21, 0x05, 0xe0, 0xed, 0x74, 0x0a, 0xc0, 0x4b, 0xa5, 0xbc, 0xd1, 0x01, 0xb9,
+	0xff, 0xae, 0x93, 0x51, 0x74, 0x58, 0x72, 0x83, 0x36, 0x66, 0x8d, 0x6a, 0xf1, 0x7f, 0x85, 0xdd,
+	0x99, 0x79, 0xbe, 0x08, 0x28, 0x48, 0x18, 0x7a, 0xd4, 0xba, 0x51, 0x47, 0xda, 0x9a, 0x99, 0x47,
+	0xed, 0x42, 0xd4, 0x89, 0x81, 0xb5, 0x3a, 0xff, 0xd5, 0x22, 0x53, 0xd8, 0xc5, 0x4b, 0x64, 0x59,
+	0x10, 0xb4, 0x5d, 0xb8, 0xfd, 0x64, 0x27, 0xcc, 0xd9, 0x2e, 0x16, 0x58, 0x2b, 0x08, 0x28, 0xda,
+	0x2e, 0x54, 0xae, 0x20, 0xc3, 0x76, 0xb1, 0x8c, 0x7b, 0x07, 0x83, 0xe0, 0xf1, 0x2f, 0xee, 0x6f,
+	0x15, 0xb9, 0x5e, 0x34, 0x79, 0x33, 0x48, 0x38, 0x12, 0xdb, 0x0a, 0xdb, 0xfb, 0x8d, 0x5a, 0x9a,
+	0xd8, 0x62, 0xd8, 0xde, 0x07, 0x06, 0xc1, 0x80, 0x9b, 0x78, 0xc7, 0x95, 0x4e, 0x3e, 0x02, 0xa1,
+	0xda, 0xbc, 0xba, 0x00, 0xd8, 0xae, 0xe2, 0xc7, 0x22, 0xbf, 0x31, 0x72, 0x50, 0xfc, 0x58, 0xe4,
+	0x3b, 0xff, 0xb0, 0x46, 0x98, 0xf3, 0x9e, 0x1b, 0xd1, 0xf6, 0x66, 0xc8, 0x8a, 0x40, 0x9c, 0xa8,
+	0x8f, 0x8c, 0x36, 0xfe, 0x3c, 0xce, 0x7e, 0x32, 0x86, 0xaf, 0x44, 0xf5, 0x61, 0xfb, 0x4a, 0x14,
+	0xbb, 0xbf, 0xd4, 0x1e, 0x23, 0xf7, 0x17, 0xe7, 0x33, 0x16, 0xb1, 0x95, 0x2b, 0xa6, 0xf6, 0x4f,
+	0xbb, 0x48, 0xc6, 0x95, 0xef, 0xa7, 0xf8, 0x5e, 0xb4, 0x18, 0x92, 0x00, 0xd0, 0x38, 0x43, 0x58,
+	0xfc, 0x9e, 0x93, 0x3a, 0x42, 0x35, 0xbd, 0x9f, 0x32, 0xcd, 0x42, 0xa8, 0x0c, 0xce, 0x3f, 0xab,
+	0x90, 0x27, 0xf8, 0x11, 0x63, 0xcd, 0x0d, 0xdc, 0x0e, 0xdb, 0x2d, 0x87, 0xf6, 0x38, 0x6c, 0xa1,
+	0xa9, 0xc9, 0x93, 0xc1, 0x5f, 0xc7, 0xdd, 0xaf, 0xf8, 0x3e, 0xc3, 0x77, 0x96, 0x95, 0xc0, 0x4b,
+	0x80, 0x11, 0xb7, 0x63, 0x32, 0x26, 0xcb, 0x32, 0x37, 0xaa, 0x65, 0x32, 0x52, 0x5b, 0xb1, 0x50,
+	0x31, 0x29, 0x28, 0x46, 0xa8, 0x47, 0xfa, 0x61, 0x6b, 0x17, 0x3f, 0xf9, 0xac, 0x1e, 0xb9, 0x2a,
+	0xda, 0x41, 0x61, 0x38, 0x5d, 0x32, 0x2d, 0xe7, 0xb0, 0x87, 0x25, 0x0d, 0xe8, 0x36, 0xea, 0x38,
+	0x2d, 0xd9, 0x64, 0x54, 0x8a, 0x56, 0x3a, 0xce, 0x92, 0x09, 0x84, 0x34, 0xae, 0x2c, 0x96, 0x50,
+	0x29, 0x2e, 0x96, 0x80, 0xef, 0x2c, 0xab, 0x64, 0x19, 0xa9, 0xe1, 0xad, 0x03, 0x53, 0xc3, 0x1f,
+	0x21, 0xb9, 0xfa, 0x37, 0x92, 0x09, 0x37, 0x41, 0xf5, 0x9e, 0x5b, 0x2d, 0xab, 0x0f, 0x76, 0xdb,
+	0xbe, 0x16, 0xb6, 0xbd, 0x6d, 0x0f, 0x29, 0x80, 0x49, 0x0e, 0x17, 0xbc, 0xaf, 0x0e, 0x7c, 0xb5,
+	0xb4, 0x31, 0x47, 0x1f, 0xf6, 0x34, 0x8e, 0xb8, 0x1d, 0x8e, 0x69, 0xab, 0x9f, 0x78, 0x7b, 0x14,
+	0xcf, 0x64, 0xfd, 0x88, 0xf9, 0xb6, 0xa5, 0xce, 0x6a, 0x4b, 0x79, 0x14, 0x28, 0xea, 0xe7, 0x7c,
+	0xce, 0x22, 0xe3, 0xcb, 0xd1, 0xfe, 0xd1, 0xa3, 0x86, 0xf3, 0x31, 0xc1, 0x95, 0x23, 0xc5, 0x04,
+	0xcb, 0xa8, 0xe3, 0xea, 0xa0, 0xa8, 0x63, 0xe7, 0xbf, 0xd5, 0xc8, 0x6c, 0x2e, 0x44, 0xdf, 0x7e,
+	0x89, 0x4c, 0xaa, 0x55, 0x22, 0xaf, 0x4a, 0xc6, 0xcd, 0x48, 0x0c, 0x0d, 0x83, 0x14, 0xe6, 0x10,
+	0x5b, 0xc5, 0x80, 0x3a, 0xdb, 0xd5, 0x07, 0xa8, 0xb3, 0xdd, 0x23, 0xa7, 0x7c, 0xf3, 0xe0, 0xda,
+	0xa8, 0x3d, 0xf8, 0x99, 0x57, 0x5b, 0x00, 0xcc, 0x66, 0x48, 0x33, 0x78, 0x3c, 0x2a, 0x7b, 0x7f,
+	0x32, 0x5b, 0xd9, 0xfb, 0x03, 0x25, 0xa7, 0x68, 0x38, 0xe9, 0x8a, 0xde, 0xaf, 0x90, 0x31, 0xe9,
+	0xf4, 0x3d, 0x94, 0xb3, 0xb4, 0x49, 0x67, 0x80, 0x6c, 0x79, 0x0b, 0x79, 0xf3, 0xa5, 0x28, 0x32,
+	0x26, 0xf3, 0x7a, 0x98, 0x88, 0x22, 0x8c, 0x9b, 0xe1, 0x8d, 0x98, 0x0a, 0xdb, 0xbd, 0xf3, 0x7a,
+	0x85, 0x14, 0x98, 0xc1, 0xf0, 0x9b, 0xd4, 0x7a, 0x69, 0xea, 0x9b, 0x3c, 0x9a, 0x6e, 0x6a, 0xdf,
+	0xe5, 0x8e, 0xf1, 0x5c, 0x1b, 0x79, 0x5f, 0xd9, 0x66, 0x3c, 0xed, 0x2b, 0xaf, 0x76, 0x6a, 0xe5,
+	0x2f, 0xff, 0x22, 0x21, 0xfa, 0xf8, 0x26, 0x74, 0x52, 0xe5, 0xd0, 0xa5, 0x4f, 0x79, 0x60, 0x60,
+	0xe1, 0x51, 0xc5, 0x0b, 0xe2, 0xc4, 0xf5, 0xfd, 0xab, 0x5e, 0x90, 0x08, 0x3d, 0x55, 0xa9, 0x5d,
+	0x2b, 0x1a, 0x04, 0x26, 0xde, 0xf9, 0x77, 0x1a, 0xef, 0xef, 0x28, 0xef, 0xfd, 0x33, 0x16, 0x39,
+	0x7b, 0x05, 0x36, 0x96, 0x6e, 0xd2, 0x48, 0xd5, 0xc1, 0xdb, 0x88, 0xc2, 0x2d, 0xb6, 0x27, 0xba,
+	0xed, 0x36, 0x1a, 0x0d, 0xb3, 0xf3, 0xbf, 0xc0, 0x9b, 0x41, 0xc2, 0x11, 0x35, 0xe6, 0x6e, 0x98,
+	0x59, 0xa9, 0x22, 0xbc, 0x33, 0x41, 0xc2, 0x51, 0xb6, 0x25, 0x3e, 0xdf, 0x5a, 0xc6, 0xf4, 0x8c,
+	0x6d, 0xae, 0x36, 0x01, 0xdb, 0x9d, 0x1d, 0x72, 0xee, 0x8a, 0x97, 0xa8, 0x70, 0x74, 0xb5, 0xfc,
+	0xf1, 0xf0, 0xa2, 0xb6, 0x4e, 0x6b, 0x60, 0xc2, 0x06, 0x23, 0x1c, 0xbc, 0x92, 0x8e, 0x5e, 0xcf,
+	0x86, 0x83, 0x3b, 0x2d, 0x72, 0xe6, 0x8a, 0x97, 0x60, 0xa8, 0xed, 0x09, 0x32, 0xf9, 0xc5, 0x11,
+	0x32, 0x69, 0x66, 0x9c, 0x39, 0x8a, 0xa0, 0xc1, 0x2c, 0x67, 0x32, 0x8f, 0x81, 0xa7, 0x7c, 0x66,
+	0x6e, 0x1d, 0x3b, 0xfd, 0x4d, 0xf1, 0xe4, 0x1a, 0x9a, 0xbd, 0xe6, 0x09, 0xe6, 0x00, 0xec, 0x3b,
+	0xa4, 0xbe, 0xcd, 0x22, 0x9b, 0xab, 0x65, 0x78, 0x3b, 0x16, 0x4d, 0xbe, 0xde, 0x48, 0x78, 0x6c,
+	0x34, 0xe7, 0xc7, 0x13, 0x16, 0xa7, 0x12, 0x70, 0x18, 0x11, 0x5e, 0xbc, 0x1d, 0x14, 0xc6, 0x20,
+	0x61, 0x56, 0x7f, 0x00, 0x61, 0x96, 0x12, 0x2d, 0x23, 0x8f, 0x48, 0xb4, 0xb0, 0x28, 0xf5, 0x64,
+	0x87, 0x9d, 0x15, 0x44, 0x48, 0xea, 0x68, 0xda, 0xeb, 0x7a, 0x23, 0x0d, 0x86, 0x2c, 0xbe, 0xfd,
+	0x31, 0x25, 0x9c, 0xc6, 0xca, 0xb8, 0x93, 0x34, 0x57, 0xf4, 0x49, 0xcb, 0xa5, 0xcf, 0x54, 0xc8,
+	0xd4, 0x95, 0xa0, 0xbf, 0x71, 0x65, 0xa3, 0xbf, 0xe5, 0x7b, 0xad, 0x6b, 0x74, 0x1f, 0x85, 0xcf,
+	0x2e, 0xdd, 0x5f, 0x59, 0xce, 0x5a, 0x93, 0xae, 0x61, 0x23, 0x70, 0x18, 0x6e, 0xa3, 0xdb, 0x5e,
+	0xd0, 0xa1, 0x51, 0x2f, 0xf2, 0xc4, 0x75, 0xa1, 0xb1, 0x8d, 0x5e, 0xd6, 0x20, 0x30, 0xf1, 0x90,
+	0x76, 0x78, 0x27, 0xa0, 0x51, 0xf6, 0xd0, 0xb4, 0x8e, 0x8d, 0xc0, 0x61, 0x88, 0x94, 0x44, 0x7d,
+	0x61, 0x62, 0x36, 0x90, 0x36, 0xb1, 0x11, 0x38, 0x4c, 0x18, 0x2d, 0x98, 0x33, 0x69, 0x3d, 0x67,
+	0xb4, 0xc0, 0x66, 0x90, 0x70, 0x44, 0xdd, 0xa5, 0xfb, 0xcb, 0x68, 0x55, 0xca, 0xd8, 0x1c, 0xae,
+	0xf1, 0x66, 0x90, 0x70, 0x56, 0x9b, 0x22, 0x3d, 0x1d, 0x7f, 0xe6, 0x6a, 0x53, 0xa4, 0x87, 0x3f,
+	0xc0, 0x3e, 0xf5, 0x59, 0x8b, 0x4c, 0xb3, 0xf2, 0xde, 0x97, 0xee, 0xf6, 0x3c, 0xe1, 0xf4, 0xf5,
+	0x1c, 0xa9, 0x77, 0xb0, 0x29, 0xfb, 0xde, 0x19, 0x1e, 0x70, 0x18, 0xa6, 0xe6, 0xa6, 0xd8, 0x85,
+	0xc6, 0x0b, 0xc9, 0x03, 0x54, 0xee, 0x52, 0x67, 0x90, 0x4b, 0x92, 0x08, 0x68, 0x7a, 0xce, 0x7f,
+	0xb2, 0xc8, 0xd9, 0xab, 0x9b, 0x9b, 0x1b, 0x79, 0x61, 0xf9, 0x0c, 0xa9, 0xf6, 0x23, 0x3f, 0x5b,
+	0xdf, 0x0e, 0xf7, 0x73, 0x6c, 0xc7, 0xe3, 0x59, 0x97, 0x26, 0x3b, 0x61, 0x3b, 0x5b, 0xb8, 0x70,
+	0x8d, 0xb5, 0x82, 0x80, 0xda, 0xef, 0x22, 0x53, 0xf4, 0x6e, 0x8f, 0xb6, 0x30, 0x79, 0xad, 0xac,
+	0xaf, 0x56, 0x7d, 0xbe, 0xce, 0x9d, 0xa3, 0x2f, 0xa5, 0x20, 0x90, 0xc1, 0xc4, 0x2b, 0x42, 0x8f,
+	0x9d, 0x73, 0x22, 0x8a, 0x49, 0x0b, 0xd8, 0x18, 0xf7, 0x1b, 0xb5, 0xf4, 0x15, 0xe1, 0x4a, 0x0e,
+	0x03, 0x0a, 0x7a, 0x39, 0x3f, 0x50, 0x21, 0x93, 0xa6, 0x07, 0xbe, 0xdd, 0xc9, 0x9c, 0x2f, 0xd7,
+	0x73, 0x55, 0xaf, 0xde, 0xa3, 0x17, 0xc5, 0x45, 0xb9, 0x28, 0x2e, 0x76, 0xbc, 0x24, 0xec, 0xc5,
+	0x2f, 0xd0, 0xa0, 0xe3, 0x05, 0x94, 0x39, 0x23, 0x72, 0xcf, 0xfd, 0x79, 0x93, 0xf8, 0x12, 0x26,
+	0x40, 0x7f, 0x80, 0x03, 0xea, 0xa3, 0x28, 0x10, 0x7a, 0x8b, 0xcc, 0xe6, 0x52, 0x93, 0x0c, 0xa1,
+	0x2f, 0x1f, 0x9a, 0x6a, 0xca, 0x01, 0x32, 0x81, 0x84, 0x65, 0x0a, 0xe4, 0x25, 0x32, 0xcb, 0xf7,
+	0x4e, 0xe4, 0xc4, 0x32, 0x4d, 0xa8, 0x74, 0x33, 0xcc, 0x1d, 0xe1, 0x66, 0x16, 0x08, 0x79, 0x7c,
+	0xac, 0xc9, 0x78, 0x2a, 0x95, 0x2d, 0xa6, 0x24, 0xcd, 0x9e, 0x6d, 0xae, 0x21, 0x8b, 0x43, 0x61,
+	0x01, 0x93, 0x5c, 0x99, 0xd3, 0x9b, 0xab, 0x06, 0x81, 0x89, 0x87, 0xe5, 0x11, 0x67, 0xb2, 0xd9,
+	0x2c, 0xd0, 0x10, 0xa0, 0xf3, 0x55, 0x65, 0x2c, 0x5f, 0x85, 0x99, 0xa5, 0xde, 0xa2, 0x32, 0x3a,
+	0x65, 0xbe, 0xa5, 0x4c, 0xfa, 0x25, 0xb4, 0xf9, 0xcb, 0x1b, 0x08, 0xb5, 0xa1, 0x6b, 0x9b, 0xbf,
+	0x06, 0x81, 0x89, 0x67, 0xde, 0x63, 0xd6, 0xca, 0xb8, 0xc7, 0xcc, 0x3e, 0xf0, 0x49, 0x0b, 0xcc,
+	0x5f, 0xad, 0x92, 0x31, 0xe9, 0x98, 0x3c, 0xc4, 0xfb, 0xc6, 0xa4, 0x25, 0xca, 0xcf, 0x06, 0xfb,
+	0x88, 0x4d, 0xfe, 0xfa, 0xf1, 0x5d, 0xa3, 0x95, 0xc9, 0x14, 0x2f, 0x92, 0x8c, 0x0b, 0x79, 0x83,
+	0x19, 0xa4, 0x79, 0xdb, 0x37, 0x31, 0x72, 0x32, 0x4e, 0x68, 0xd7, 0xb8, 0xde, 0x73, 0x8c, 0x4f,
+	0x79, 0xbe, 0x15, 0x46, 0x14, 0x3f, 0x5c, 0x74, 0xe7, 0x6e, 0x2a, 0x4c, 0x7d, 0xa8, 0xd2, 0x6d,
+	0x60, 0x50, 0xc2, 0x7a, 0x95, 0xbe, 0x99, 0x2c, 0x03, 0xca, 0x71, 0xfc, 0x1e, 0xc6, 0x2d, 0xec,
+	0x18, 0x6e, 0x58, 0xce, 0xcf, 0xe0, 0x07, 0x93, 0x99, 0x49, 0xfb, 0x03, 0x18, 0xf1, 0xc3, 0x7f,
+	0x1b, 0x96, 0x45, 0xe9, 0x0d, 0x3e, 0x09, 0x06, 0xec, 0xf5, 0x7b, 0x73, 0x73, 0xda, 0x2b, 0xfc,
+	0x22, 0x4e, 0xde, 0xc5, 0x3d, 0xc3, 0x71, 0x1e, 0x97, 0x41, 0x8a, 0x18, 0xf7, 0xd1, 0x12, 0xce,
+	0x84, 0x8b, 0xfb, 0x0b, 0xbd, 0x9e, 0x70, 0xc6, 0x30, 0x7c, 0xb4, 0x4c, 0x28, 0x64, 0xb0, 0x31,
+	0xb5, 0x80, 0xd1, 0x72, 0x9d, 0x7a, 0x9d, 0x9d, 0xad, 0x30, 0x92, 0xa6, 0xa4, 0xa7, 0x75, 0xec,
+	0x49, 0x1e, 0x07, 0x0a, 0x7b, 0xa2, 0xf2, 0xdf, 0x72, 0x7b, 0x6e, 0xcb, 0x4b, 0xf6, 0x85, 0x9d,
+	0x50, 0xa9, 0x2a, 0x4b, 0xa2, 0x1d, 0x14, 0x86, 0xf3, 0xb7, 0x6a, 0x64, 0x86, 0x07, 0x5b, 0x50,
+	0x15, 0x4b, 0x84, 0x3a, 0x41, 0x9c, 0xb8, 0x11, 0xb7, 0x63, 0x5a, 0x0f, 0xae, 0x13, 0x34, 0x25,
+	0x11, 0xd0, 0xf4, 0x30, 0x26, 0x69, 0xdb, 0x0b, 0xbc, 0x78, 0x87, 0x51, 0xaf, 0x3c, 0x98, 0x95,
+	0xf4, 0xb2, 0xa2, 0x00, 0x06, 0x35, 0xfb, 0x6b, 0x49, 0xbd, 0xb7, 0xe3, 0xc6, 0xd2, 0x84, 0xff,
+	0x16, 0xb9, 0x19, 0x6f, 0x60, 0x23, 0x46, 0xd5, 0x64, 0x1f, 0x95, 0x01, 0x80, 0x77, 0x32, 0x45,
+	0x69, 0xed, 0xf0, 0xca, 0xa2, 0xed, 0x68, 0xbf, 0x79, 0x75, 0x21, 0x5b, 0x8b, 0x72, 0x99, 0xb5,
+	0x82, 0x80, 0xe2, 0x9e, 0xba, 0xc3, 0x59, 0xb6, 0x11, 0x79, 0x24, 0xbd, 0xa7, 0x5e, 0xd5, 0x20,
+	0x30, 0xf1, 0x30, 0x4d, 0x71, 0x36, 0x14, 0x67, 0xf4, 0x04, 0x62, 0x58, 0x87, 0x0d, 0xc2, 0xb9,
+	0x44, 0xc6, 0xf9, 0xff, 0x74, 0x33, 0x44, 0xbb, 0x2a, 0xb7, 0xd0, 0x2e, 0x46, 0x6e, 0xd0, 0xda,
+	0xc9, 0xda, 0x55, 0x37, 0x0d, 0x18, 0xa4, 0x30, 0x9d, 0x6f, 0xb5, 0x48, 0x83, 0xa7, 0xe6, 0xa1,
+	0x1d, 0x2f, 0x4e, 0xa2, 0x7d, 0xa0, 0x77, 0x22, 0x2f, 0xa1, 0xd0, 0xf7, 0x99, 0xc4, 0xdf, 0x8e,
+	0xc2, 0x6e, 0x76, 0xe7, 0xbd, 0x1c, 0x85, 0x5d, 0x60, 0x10, 0x2c, 0x74, 0x9d, 0x84, 0x8d, 0x4a,
+	0xba, 0xd0, 0xf5, 0x66, 0x08, 0x95, 0x24, 0xb4, 0x9f, 0x37, 0x72, 0xed, 0x72, 0x9f, 0xf1, 0x01,
+	0xb9, 0x71, 0x9d, 0x35, 0x52, 0x1b, 0x72, 0xa7, 0x1f, 0xca, 0x66, 0xf7, 0x0a, 0x19, 0x43, 0x72,
+	0xd2, 0x12, 0x52, 0x06, 0xc9, 0x90, 0x8c, 0xbd, 0x7c, 0x6b, 0x93, 0xfb, 0x1e, 0x3a, 0xa4, 0xea,
+	0xb9, 0xd2, 0xef, 0x53, 0x7d, 0xc7, 0x2b, 0x71, 0xdc, 0x67, 0x6b, 0x1f, 0x81, 0xf6, 0x73, 0xa4,
+	0x4a, 0xef, 0xf6, 0xb2, 0x0e, 0x9e, 0x5a, 0x1f, 0x47, 0x28, 0x4e, 0x9e, 0xd7, 0x6e, 0x54, 0xd3,
+	0x93, 0xb7, 0xb2, 0x0c, 0x15, 0xaf, 0xed, 0xdc, 0x25, 0xe3, 0x92, 0x21, 0x8b, 0xf8, 0xe1, 0x67,
+	0x17, 0xab, 0x8c, 0x88, 0x1f, 0x49, 0x77, 0xc0, 0xa9, 0xa5, 0x4f, 0x88, 0xce, 0x4b, 0x55, 0x96,
+	0xb2, 0x75, 0x81, 0xd4, 0x5a, 0xa1, 0xc8, 0x6f, 0x38, 0xa6, 0xc9, 0x30, 0xad, 0x99, 0x41, 0x9c,
+	0x5b, 0x64, 0xea, 0x5a, 0x10, 0xde, 0x61, 0x45, 0x74, 0x59, 0x1d, 0x0e, 0x24, 0xbc, 0x8d, 0xff,
+	0x64, 0x8f, 0x4a, 0x0c, 0x0a, 0x1c, 0xa6, 0x12, 0xed, 0x57, 0x06, 0x25, 0xda, 0x77, 0x3e, 0x6e,
+	0x91, 0x49, 0xa5, 0x83, 0x5d, 0xd9, 0xdb, 0x1d, 0xee, 0x08, 0x66, 0x64, 0x7e, 0xaa, 0x1c, 0x92,
+	0xf9, 0xe9, 0x02, 0xa9, 0xed, 0x7a, 0x41, 0x3b, 0x7b, 0x19, 0x72, 0xcd, 0x0b, 0xda, 0xc0, 0x20,
+	0x38, 0x84, 0x19, 0x35, 0x04, 0xa9, 0x1d, 0xbf, 0x44, 0x26, 0xb7, 0xfa, 0x9e, 0xdf, 0x16, 0xbf,
+	0xb3, 0xdf, 0xec, 0xa2, 0x01, 0x83, 0x14, 0x26, 0x5a, 0x64, 0xb7, 0xbc, 0xc0, 0x8d, 0xf6, 0x37,
+	0xb4, 0x3a, 0xae, 0x94, 0x87, 0x45, 0x05, 0x01, 0x03, 0xcb, 0xf9, 0x9e, 0x2a, 0x99, 0x4a, 0xa7,
+	0xf9, 0x19, 0xc2, 0x48, 0xf8, 0x1c, 0xa9, 0xb3, 0xcc, 0x3f, 0xd9, 0x57, 0xcb, 0xfa, 0x03, 0x87,
+	0x61, 0x50, 0x06, 0xdf, 0x51, 0x84, 0xaa, 0xb3, 0x5e, 0x52, 0x2e, 0x22, 0x75, 0x83, 0xc2, 0xe2,
+	0xa2, 0xc4, 0x85, 0x94, 0x60, 0x85, 0xce, 0xb6, 0xa3, 0x61, 0xcf, 0x4c, 0xd0, 0xfe, 0xbe, 0x32,
+	0x53, 0x20, 0x89, 0x3c, 0x23, 0x42, 0x29, 0x52, 0xaf, 0x5e, 0xbe, 0x0e, 0xc9, 0xfa, 0xfc, 0xbb,
+	0xc8, 0xa4, 0x89, 0x79, 0x98, 0x5e, 0x34, 0x66, 0xea, 0x45, 0xdf, 0x69, 0x2e, 0x0a, 0x91, 0xe4,
+	0x69, 0x88, 0xcf, 0xed, 0x06, 0xa9, 0xb7, 0x94, 0xf3, 0xf8, 0x03, 0x95, 0xa5, 0x52, 0x29, 0x5c,
+	0x91, 0x0c, 0x70, 0x6a, 0xe8, 0x25, 0x34, 0x65, 0x8c, 0x26, 0x5e, 0x69, 0xdb, 0x11, 0xa9, 0x76,
+	0xf6, 0x76, 0x85, 0xae, 0xf1, 0x72, 0x49, 0xd3, 0x7b, 0x65, 0x6f, 0x57, 0xaf, 0x71, 0xb3, 0x15,
+	0x90, 0xd9, 0x10, 0xd7, 0x7c, 0xa9, 0x5c, 0x60, 0xd5, 0xc3, 0x73, 0x81, 0x39, 0x9f, 0xab, 0x90,
+	0xd9, 0xdc, 0xa2, 0xb2, 0x5f, 0x43, 0x47, 0xad, 0x78, 0xa5, 0xdd, 0xb0, 0xca, 0x90, 0xe1, 0xe9,
+	0x99, 0xd3, 0x32, 0x3c, 0xdd, 0x0e, 0x9c, 0x25, 0x1a, 0x39, 0x74, 0x88, 0x83, 0xba, 0x63, 0xe4,
+	0x8f, 0xac, 0x8c, 0x1c, 0x0b, 0x39, 0x0c, 0x28, 0xe8, 0xc5, 0xfc, 0x8e, 0x53, 0x57, 0x95, 0xd5,
+	0xf4, 0x1d, 0xfd, 0x41, 0xb7, 0x8e, 0xce, 0x3f, 0xad, 0x90, 0x53, 0xa9, 0x7c, 0xf9, 0xb6, 0x4f,
+	0xc6, 0xa8, 0xcf, 0x1c, 0x28, 0xa4, 0xb0, 0x39, 0x6e, 0x19, 0x47, 0x25, 0x20, 0x2f, 0x09, 0xba,
+	0xa0, 0x38, 0x3c, 0x1e, 0x3e, 0xbf, 0x2f, 0x91, 0x49, 0x39, 0xa0, 0xf7, 0xb9, 0x5d, 0x5f, 0x4c,
+	0xa0, 0x5a, 0xa3, 0x97, 0x0c, 0x18, 0xa4, 0x30, 0x31, 0xc8, 0xf5, 0xcc, 0x6a, 0xdf, 0xcd, 0x1b,
+	0xd2, 0xd2, 0x57, 0x66, 0xd6, 0x50, 0x57, 0x66, 0x52, 0x8a, 0x54, 0x06, 0x49, 0x11, 0xf5, 0x6d,
+	0x54, 0x87, 0xfb, 0x36, 0x6a, 0x87, 0x7f, 0x1b, 0xcc, 0xe3, 0x82, 0x05, 0x02, 0x66, 0x55, 0xe6,
+	0x26, 0x6b, 0x05, 0x01, 0x75, 0x7e, 0xa1, 0x4e, 0x1a, 0xdc, 0xb7, 0xa6, 0xad, 0xe8, 0x28, 0x1f,
+	0xb9, 0xef, 0xd0, 0xf5, 0x3b, 0xf8, 0x92, 0xd9, 0x3a, 0x6e, 0xed, 0xea, 0x62, 0x46, 0x43, 0xc5,
+	0x2f, 0xfd, 0x48, 0x26, 0x7e, 0x89, 0x1b, 0x02, 0x3a, 0x27, 0x34, 0xa2, 0x07, 0xa8, 0xdd, 0xf1,
+	0x7e, 0x32, 0xc9, 0x87, 0x2a, 0x32, 0x2b, 0x55, 0x53, 0x09, 0x0d, 0x26, 0x57, 0x0d, 0xd8, 0xeb,
+	0xf7, 0xe6, 0x9e, 0x1d, 0xc4, 0x9a, 0x63, 0x40, 0x8a, 0x96, 0xed, 0x91, 0x59, 0x83, 0x95, 0x91,
+	0xba, 0x69, 0x7c, 0xf1, 0xdd, 0xca, 0xad, 0x37, 0x8b, 0x30, 0x04, 0x97, 0x3c, 0x55, 0x74, 0xfc,
+	0xe5, 0x7e, 0x61, 0xed, 0x6b, 0x74, 0x3f, 0xe5, 0xf8, 0xbb, 0xa2, 0x9b, 0xc1, 0xc4, 0x79, 0x94,
+	0xa1, 0x5c, 0x3f, 0x55, 0x21, 0xd3, 0x99, 0x92, 0xe8, 0x98, 0xf5, 0xd9, 0xac, 0x4c, 0x68, 0x95,
+	0xe1, 0xf1, 0x70, 0x60, 0x25, 0xea, 0xa3, 0xd5, 0x27, 0x7c, 0x44, 0xdb, 0xa1, 0xf3, 0xfb, 0x55,
+	0x32, 0x95, 0xae, 0xe5, 0xfe, 0x18, 0xce, 0xd4, 0x57, 0x88, 0xda, 0xa5, 0x6c, 0xed, 0x71, 0x87,
+	0x89, 0x53, 0xaa, 0x6e, 0x29, 0x5b, 0x79, 0x1a, 0xfe, 0x78, 0x94, 0x7d, 0xfc, 0x84, 0x45, 0xc6,
+	0xc2, 0x3d, 0x1a, 0xf9, 0xee, 0xbe, 0xd4, 0x58, 0x9b, 0x65, 0x16, 0xdc, 0x5f, 0xe7, 0xb4, 0xf5,
+	0x18, 0x44, 0x43, 0x0c, 0x8a, 0xad, 0xf3, 0xf3, 0x16, 0x39, 0x5b, 0xd8, 0x0b, 0x4d, 0x22, 0x3d,
+	0x37, 0x8e, 0x37, 0x77, 0xa2, 0xb0, 0xdf, 0xd9, 0x11, 0x49, 0xf3, 0xd5, 0x5e, 0xb6, 0xa1, 0x41,
+	0x60, 0xe2, 0xd9, 0x3b, 0x64, 0x4c, 0xd4, 0xe3, 0x95, 0xd5, 0x74, 0x8e, 0xab, 0x2d, 0x30, 0x0b,
+	0x81, 0x28, 0xf6, 0x1b, 0x83, 0xa2, 0xee, 0xfc, 0x7d, 0x8b, 0x9c, 0x53, 0x9b, 0x52, 0x14, 0xf6,
+	0xdc, 0x0e, 0x17, 0xb7, 0x7c, 0x33, 0x72, 0x52, 0x02, 0x48, 0x24, 0x11, 0xc9, 0x08, 0x86, 0xb7,
+	0xe5, 0xe5, 0x82, 0x8c, 0x54, 0x18, 0xb4, 0x55, 0xbf, 0x44, 0x26, 0x7b, 0x61, 0x5b, 0x67, 0xe7,
+	0xe4, 0x87, 0x57, 0x5d, 0x11, 0xd0, 0x80, 0x41, 0x0a, 0xd3, 0xf9, 0x7b, 0x16, 0x39, 0xcb, 0xd7,
+	0x74, 0x76, 0xd7, 0xf9, 0xab, 0x45, 0xdf, 0xd2, 0x07, 0xcb, 0x5d, 0x8e, 0x99, 0xfa, 0x4a, 0x87,
+	0x7d, 0x4d, 0xce, 0x1f, 0x54, 0xc8, 0x19, 0x31, 0xda, 0xf4, 0x87, 0xff, 0x18, 0x0e, 0xf6, 0x68,
+	0x9f, 0x7e, 0xea, 0xab, 0xab, 0x3e, 0x9a, 0xaf, 0x6e, 0x97, 0x9c, 0xbd, 0x1e, 0x26, 0x4a, 0x43,
+	0x64, 0xce, 0x63, 0xac, 0x16, 0xd5, 0xf3, 0x64, 0x4c, 0x38, 0x14, 0xc9, 0x75, 0x2b, 0x2a, 0xe9,
+	0xf0, 0x36, 0x50, 0x50, 0xc4, 0x4c, 0x22, 0xaf, 0xd3, 0xd1, 0xc5, 0xa6, 0x19, 0xe6, 0xa6, 0x68,
+	0x03, 0x05, 0x75, 0xfe, 0x4d, 0x85, 0x4c, 0xac, 0x2f, 0xad, 0x28, 0xdd, 0x0c, 0x5d, 0xb2, 0x23,
+	0xea, 0x6a, 0xab, 0xb1, 0xe9, 0x92, 0x2d, 0x01, 0xa0, 0x71, 0x98, 0xc3, 0x14, 0x0b, 0x69, 0x88,
+	0xb3, 0x76, 0x0f, 0x1e, 0xf1, 0x80, 0x0e, 0x53, 0xfc, 0x1f, 0x34, 0x6a, 0xb3, 0x04, 0x3d, 0x18,
+	0x66, 0x50, 0x4d, 0x7b, 0xb4, 0x30, 0x8b, 0x22, 0x5e, 0x1c, 0x2b, 0x0c, 0x24, 0xdc, 0x0e, 0x5b,
+	0x31, 0x22, 0x67, 0x0c, 0xb9, 0xcb, 0xd8, 0x8c, 0x4e, 0x43, 0x02, 0x8e, 0x83, 0xe6, 0xc6, 0x4e,
+	0x44, 0xae, 0xa7, 0x07, 0xcd, 0xad, 0xa2, 0x88, 0xae, 0x71, 0x8e, 0x52, 0x2e, 0x21, 0x93, 0x24,
+	0x63, 0x74, 0xb8, 0x24, 0x19, 0xce, 0xef, 0x54, 0xc9, 0xb8, 0xb6, 0xc5, 0x7b, 0x22, 0x2b, 0x5d,
+	0x29, 0x05, 0xcb, 0x30, 0xf0, 0x5a, 0x91, 0xe6, 0x7e, 0x7f, 0x46, 0x52, 0xba, 0x6f, 0xb3, 0xd0,
+	0x95, 0xce, 0x4b, 0x3c, 0x97, 0x5d, 0x29, 0x34, 0x2a, 0x65, 0xc4, 0xf1, 0x2a, 0x76, 0x2b, 0x9c,
+	0x72, 0x18, 0x99, 0xce, 0x79, 0x8a, 0x19, 0x98, 0x9c, 0xed, 0x8f, 0x88, 0x9c, 0x0c, 0xd5, 0xd2,
+	0x72, 0x5e, 0x8e, 0x65, 0x12, 0x31, 0xf4, 0xf0, 0x4c, 0x9e, 0x44, 0x25, 0xa5, 0x8a, 0x05, 0x24,
+	0xa5, 0x6a, 0x5f, 0x1a, 0x91, 0x58, 0x68, 0xe4, 0xe6, 0x8c, 0x9c, 0x98, 0xd8, 0xf9, 0xb9, 0x38,
+	0x62, 0xbc, 0x3b, 0x46, 0xf4, 0xf7, 0x93, 0xb0, 0x8b, 0xd3, 0x24, 0x7c, 0xe9, 0x74, 0x44, 0xbf,
+	0x04, 0x80, 0xc6, 0x71, 0x7e, 0xbd, 0x4e, 0x32, 0x39, 0xe2, 0xec, 0xbb, 0x64, 0x5c, 0x65, 0x89,
+	0x2b, 0x27, 0x7f, 0x8c, 0x5e, 0x51, 0x6a, 0x30, 0xaa, 0x09, 0x34, 0x33, 0xbb, 0x23, 0x6f, 0x67,
+	0xf8, 0xd7, 0xfe, 0x4a, 0xf6, 0x76, 0xe6, 0xeb, 0x87, 0xf3, 0x88, 0xc0, 0xb5, 0x7a, 0x91, 0xa7,
+	0x4b, 0x9f, 0x3f, 0xf4, 0x22, 0xa7, 0x7a, 0xc8, 0x45, 0xce, 0x27, 0x44, 0x89, 0x6c, 0xa0, 0x71,
+	0xdf, 0x4f, 0xc4, 0x6a, 0x78, 0xa5, 0xc4, 0xaf, 0x8c, 0x13, 0xd6, 0x49, 0x68, 0xf9, 0x6f, 0x30,
+	0x98, 0xa6, 0xaf, 0xdb, 0x46, 0x4e, 0xf4, 0xba, 0x6d, 0xb4, 0xd4, 0xeb, 0xb6, 0x17, 0x09, 0x61,
+	0x6b, 0x9b, 0xc7, 0x74, 0x8e, 0xb1, 0x0b, 0x08, 0x25, 0x53, 0x41, 0x41, 0xc0, 0xc0, 0x42, 0x23,
+	0x12, 0xf3, 0x1b, 0xdc, 0x08, 0xb9, 0x97, 0x88, 0xc8, 0x84, 0xa2, 0x8c, 0x48, 0xaf, 0x98, 0x40,
+	0x48, 0xe3, 0x3a, 0x5f, 0x45, 0xd2, 0x29, 0x98, 0x31, 0x9f, 0x0a, 0xcf, 0xf8, 0xcc, 0x85, 0x1a,
+	0xcb, 0xa7, 0x92, 0x4a, 0xce, 0xfc, 0xf3, 0x16, 0x31, 0xf3, 0x44, 0xdb, 0xaf, 0xf2, 0x84, 0xd4,
+	0x56, 0x19, 0xb7, 0xda, 0x06, 0xdd, 0xf9, 0x35, 0xb7, 0x97, 0x71, 0x6a, 0x96, 0x59, 0xa9, 0xd1,
+	0xd3, 0x58, 0x42, 0x8f, 0x74, 0x90, 0xfc, 0x18, 0x39, 0x2d, 0x73, 0xb3, 0xc9, 0x0b, 0x68, 0xe1,
+	0xcd, 0x77, 0xf8, 0x95, 0x42, 0x09, 0x16, 0x1e, 0xe7, 0x9f, 0x58, 0xe4, 0x42, 0x76, 0x00, 0xf1,
+	0x5a, 0x18, 0x78, 0x49, 0x18, 0x35, 0x69, 0x92, 0x78, 0x41, 0x87, 0xd5, 0x0d, 0xb9, 0xe3, 0x46,
+	0xb2, 0x2a, 0x2f, 0xdb, 0x65, 0x6f, 0xb9, 0x51, 0x00, 0xac, 0x15, 0x93, 0xcb, 0xf0, 0x53, 0xb9,
+	0xb0, 0x8d, 0x1c, 0xf3, 0xc3, 0x2a, 0x98, 0x0e, 0x6d, 0x9c, 0xe1, 0xb6, 0x00, 0x10, 0x0c, 0x9d,
+	0x3f, 0xb6, 0x88, 0x8d, 0x1a, 0x52, 0xe4, 0xb5, 0x8d, 0x18, 0x34, 0xcc, 0x1c, 0x78, 0xbb, 0xb9,
+	0x7e, 0x7d, 0x23, 0xf4, 0x02, 0x76, 0x59, 0x68, 0x64, 0x0e, 0x7c, 0xd9, 0x68, 0x87, 0x14, 0x16,
+	0x7a, 0x17, 0xdd, 0x7e, 0x15, 0x2f, 0x2b, 0x2e, 0xdd, 0x95, 0x59, 0x21, 0xa4, 0x76, 0xc4, 0xbc,
+	0x8b, 0x5e, 0x7e, 0x25, 0x03, 0x84, 0x3c, 0xbe, 0xbd, 0x4e, 0xce, 0x76, 0xb9, 0xed, 0x83, 0xd7,
+	0x7e, 0xe7, 0x86, 0x10, 0x75, 0x61, 0x79, 0x0e, 0xb3, 0xf0, 0xaf, 0x15, 0x21, 0x40, 0x71, 0x3f,
+	0xe7, 0x9d, 0xc4, 0xe6, 0x2e, 0x3c, 0x4b, 0x45, 0xd1, 0x2b, 0x03, 0xcd, 0xfa, 0xce, 0x0f, 0xd7,
+	0xc9, 0x74, 0xa6, 0x66, 0x23, 0x1a, 0xd6, 0xf2, 0xe1, 0x32, 0xc7, 0x16, 0xfe, 0xf9, 0xe1, 0x0d,
+	0x15, 0x80, 0x13, 0x90, 0xba, 0x17, 0xf4, 0xfa, 0x49, 0x39, 0x39, 0xf6, 0xf8, 0x20, 0x56, 0x90,
+	0xa0, 0x71, 0x0d, 0x89, 0x3f, 0x81, 0xb3, 0x29, 0x33, 0x9c, 0x27, 0x65, 0x00, 0xa8, 0x3d, 0x3a,
+	0x03, 0x80, 0x74, 0xc9, 0xaa, 0x97, 0x71, 0x61, 0x95, 0x59, 0x2c, 0x27, 0xed, 0x91, 0xf5, 0x85,
+	0x0a, 0x99, 0x30, 0x5e, 0x9a, 0xfd, 0x63, 0xe9, 0x2a, 0x0a, 0x56, 0x79, 0x8f, 0xc4, 0xe8, 0xcf,
+	0xeb, 0x3a, 0x09, 0xfc, 0x91, 0xde, 0x92, 0x2f, 0xa0, 0xf0, 0xfa, 0xbd, 0xb9, 0x99, 0x4c, 0x89,
+	0x84, 0x54, 0x51, 0x85, 0xf3, 0xdf, 0x4c, 0xa6, 0x33, 0x64, 0x0a, 0x1e, 0x79, 0xd3, 0x7c, 0xe4,
+	0x63, 0x1b, 0x30, 0xcc, 0x29, 0xfb, 0xe7, 0x55, 0x32, 0x21, 0x53, 0x7b, 0x85, 0xdc, 0x9b, 0xe2,
+	0x90, 0xbb, 0xbd, 0xcc, 0xe1, 0xa4, 0x32, 0x64, 0x06, 0xbf, 0xe7, 0xc9, 0x58, 0x2f, 0xf4, 0xbd,
+	0x96, 0x47, 0x53, 0x8e, 0x16, 0x1b, 0xa2, 0x0d, 0x14, 0xd4, 0xbe, 0x43, 0xc6, 0x6f, 0xdf, 0x49,
+	0xb8, 0x57, 0x41, 0xa3, 0x56, 0xaa, 0x33, 0x81, 0xd2, 0x78, 0x64, 0x4b, 0x0c, 0x9a, 0x17, 0x5a,
+	0x68, 0x98, 0x10, 0x94, 0x96, 0x62, 0x66, 0xa1, 0x61, 0xd2, 0x31, 0x06, 0x01, 0xb1, 0x3f, 0x6b,
+	0x91, 0x99, 0x4e, 0xda, 0x5d, 0x5a, 0x06, 0xa2, 0x1d, 0x33, 0xcd, 0x47, 0xc6, 0x09, 0x5b, 0x27,
+	0x84, 0xc8, 0x00, 0x62, 0xc8, 0x0d, 0xc0, 0xf9, 0x57, 0x13, 0xe4, 0x4c, 0x51, 0x39, 0x5f, 0xfb,
+	0xa3, 0x64, 0x84, 0x0f, 0xaa, 0x9c, 0x8a, 0xf1, 0x45, 0x3c, 0xae, 0x30, 0x82, 0x62, 0xb2, 0xd8,
+	0xff, 0x20, 0x78, 0x0a, 0xee, 0xbe, 0xbb, 0xd5, 0xa8, 0x9c, 0x20, 0xf7, 0x55, 0x57, 0x73, 0x5f,
+	0x75, 0x39, 0x77, 0xdf, 0xdd, 0xb2, 0xef, 0x92, 0x7a, 0xc7, 0x4b, 0xa8, 0x2b, 0xcc, 0xa9, 0xb7,
+	0x4e, 0x84, 0x39, 0x75, 0xb9, 0xee, 0xc8, 0xfe, 0x05, 0xce, 0x10, 0xa3, 0xe3, 0xa7, 0xb7, 0xd2,
+	0x09, 0x4d, 0xc5, 0x96, 0xee, 0x96, 0x3f, 0x88, 0x4c, 0xe6, 0xd4, 0xc5, 0xd3, 0x18, 0xa8, 0x92,
+	0x69, 0x84, 0xec, 0x70, 0xec, 0x4f, 0x5a, 0x64, 0x74, 0xdb, 0xf3, 0x8d, 0x3a, 0x92, 0x27, 0xf0,
+	0x72, 0x2e, 0x33, 0x06, 0xfa, 0x10, 0xc5, 0x7f, 0xc7, 0x20, 0x39, 0x0f, 0x92, 0x9f, 0x23, 0xc7,
+	0x95, 0x9f, 0xa3, 0x8f, 0x48, 0x7e, 0x7e, 0xda, 0x22, 0xe3, 0x6a, 0xa6, 0x45, 0x62, 0xc8, 0x0f,
+	0x9c, 0xe0, 0x2b, 0xe7, 0x56, 0x45, 0xf5, 0x13, 0x34, 0x73, 0x4c, 0x47, 0x34, 0xe1, 0xbe, 0xd6,
+	0x8f, 0x68, 0x9b, 0xee, 0x85, 0x3d, 0x59, 0xd3, 0xfa, 0x83, 0xe5, 0x0f, 0x66, 0x01, 0x99, 0x2c,
+	0xd3, 0xbd, 0xf5, 0x5e, 0x2c, 0x4c, 0xd5, 0xba, 0x01, 0xcc, 0x21, 0x60, 0x2a, 0x7f, 0xa9, 0x5d,
+	0x90, 0x32, 0x0a, 0x1a, 0x15, 0x8d, 0x66, 0xa8, 0xe4, 0x55, 0x94, 0x3c, 0xd5, 0x0a, 0x83, 0xc4,
+	0x0b, 0xfa, 0x74, 0x3d, 0x00, 0xda, 0x0b, 0xaf, 0x87, 0xc9, 0xe5, 0xb0, 0x1f, 0xb4, 0x2f, 0x45,
+	0x51, 0x18, 0xb1, 0xcc, 0x97, 0x63, 0x8b, 0xcf, 0x89, 0xce, 0x4f, 0x2d, 0x0d, 0x46, 0x85, 0x83,
+	0xe8, 0x1c, 0x47, 0x93, 0xb9, 0x57, 0x21, 0x73, 0x87, 0x4c, 0x36, 0x5a, 0xfe, 0x43, 0xa3, 0x5e,
+	0x78, 0xd6, 0x37, 0xcb, 0xac, 0x25, 0x0e, 0x29, 0x4c, 0x33, 0xcb, 0x67, 0xe5, 0x90, 0x2c, 0x9f,
+	0x17, 0x48, 0x2d, 0xa2, 0xbd, 0x30, 0x7b, 0xda, 0xc3, 0x87, 0x05, 0x06, 0xc1, 0x80, 0x1c, 0xb7,
+	0xe7, 0x09, 0x7b, 0xa9, 0x3a, 0xc4, 0x2e, 0x6c, 0xac, 0x00, 0xb6, 0xa7, 0x92, 0x0e, 0xd7, 0x1f,
+	0x4a, 0xd2, 0x61, 0xe3, 0xa6, 0x65, 0x64, 0xd0, 0x4d, 0x8b, 0xf3, 0xb9, 0x2a, 0x79, 0xe6, 0xc0,
+	0x4f, 0x4b, 0x07, 0xa8, 0x59, 0x07, 0x04, 0xa8, 0xc9, 0xe9, 0xa9, 0x1c, 0x36, 0x3d, 0xd5, 0x01,
+	0xd3, 0xf3, 0x49, 0xdc, 0x31, 0x64, 0x12, 0x6c, 0x21, 0x24, 0x8e, 0x19, 0x34, 0x38, 0x28, 0xa7,
+	0xb6, 0xd8, 0x2c, 0x24, 0x14, 0x34, 0x5f, 0x3c, 0xc4, 0xa5, 0x32, 0x5c, 0xd6, 0xcb, 0x90, 0x98,
+	0x03, 0x13, 0x51, 0xf3, 0x6d, 0x62, 0x50, 0xda, 0x4c, 0xe7, 0x17, 0x6a, 0xe4, 0xb9, 0x21, 0x04,
+	0x9d, 0xb9, 0x8a, 0xad, 0x21, 0x57, 0xf1, 0x9f, 0xf1, 0xd7, 0xf4, 0xa9, 0xc2, 0xd7, 0x04, 0xe5,
+	0xbf, 0xa6, 0x83, 0xdf, 0x10, 0xbb, 0x54, 0x11, 0xa1, 0x6c, 0x8d, 0x91, 0x74, 0xd2, 0x16, 0x19,
+	0xf6, 0x06, 0x0a, 0x03, 0x0f, 0xe5, 0x2d, 0x17, 0x3f, 0xff, 0xd1, 0x92, 0xb2, 0xe1, 0x99, 0xf9,
+	0x5f, 0xb8, 0xf6, 0xb5, 0xb4, 0x80, 0x3b, 0x00, 0x67, 0x83, 0x79, 0xe5, 0xcf, 0x0f, 0xd6, 0x46,
+	0xf0, 0x8e, 0x75, 0x8b, 0xb9, 0x95, 0xaf, 0x31, 0xaf, 0x51, 0xb1, 0x74, 0xd8, 0xf3, 0xea, 0x66,
+	0x30, 0x71, 0xd0, 0x8a, 0x63, 0xfa, 0xa3, 0xaf, 0x19, 0xee, 0xa6, 0xcc, 0x8a, 0xb3, 0x99, 0x05,
+	0x42, 0x1e, 0x1f, 0x53, 0x5a, 0x27, 0x5e, 0xe2, 0x53, 0xde, 0x9b, 0x2f, 0x34, 0x66, 0x23, 0xdd,
+	0x54, 0xad, 0x60, 0x60, 0x38, 0x5f, 0xac, 0x16, 0x3f, 0x06, 0xd7, 0x72, 0x8f, 0xb2, 0xfa, 0xc5,
+	0xda, 0xae, 0x0c, 0xb1, 0x43, 0x57, 0x1f, 0xf6, 0x0e, 0x5d, 0x1b, 0x78, 0x17, 0xbe, 0x4c, 0x66,
+	0x7a, 0xfa, 0xf1, 0x79, 0x3e, 0x45, 0x7e, 0xcf, 0xa6, 0x4e, 0x46, 0x1b, 0x19, 0x38, 0xe4, 0x7a,
+	0x3c, 0xe6, 0x4b, 0xf5, 0x97, 0x2b, 0xe4, 0xdc, 0xc0, 0x83, 0xc5, 0x43, 0x92, 0x40, 0xe6, 0xeb,
+	0xaf, 0x3d, 0x9c, 0xd7, 0x6f, 0xbe, 0x94, 0xfa, 0xa1, 0x2f, 0x65, 0x18, 0x71, 0xfe, 0xbb, 0x95,
+	0x81, 0x1f, 0x0b, 0x1e, 0x44, 0xff, 0xdc, 0xce, 0xe4, 0xbb, 0xc9, 0x29, 0xb7, 0xd7, 0xe3, 0x78,
+	0x2c, 0x46, 0x2d, 0x93, 0x64, 0x7f, 0xc1, 0x04, 0x42, 0x1a, 0x77, 0xa8, 0x89, 0xfd, 0x43, 0x8b,
+	0x8c, 0x03, 0xdd, 0xe6, 0x3b, 0x1c, 0x56, 0x3a, 0x63, 0x53, 0x64, 0x95, 0x51, 0xe9, 0x0c, 0x27,
+	0x36, 0xf6, 0x58, 0xf9, 0xaf, 0xa2, 0xc9, 0x3e, 0x6e, 0xfa, 0xa7, 0xe7, 0x48, 0xbd, 0xb5, 0xe3,
+	0x46, 0x49, 0x36, 0xc1, 0x00, 0x2b, 0x47, 0x01, 0x1c, 0xe6, 0xfc, 0xe2, 0x38, 0x3e, 0x5e, 0x2f,
+	0x5c, 0x8a, 0x68, 0x3b, 0x3e, 0x2c, 0xb6, 0xdc, 0xbc, 0x72, 0xad, 0x1c, 0x29, 0xc5, 0x78, 0xf5,
+	0xd0, 0x14, 0xe3, 0x98, 0xaa, 0x35, 0xde, 0xd9, 0x88, 0xbc, 0x3d, 0x37, 0xc1, 0xeb, 0x89, 0x46,
+	0x2d, 0xfd, 0x22, 0x9b, 0xcd, 0xab, 0x1a, 0x08, 0x69, 0x5c, 0xcc, 0x94, 0xaa, 0x13, 0x7d, 0xd3,
+	0x28, 0x61, 0x09, 0x0e, 0xf8, 0x4a, 0x50, 0x39, 0xf3, 0x74, 0x6a, 0x70, 0x81, 0x00, 0xf9, 0x3e,
+	0xb8, 0xe7, 0xa6, 0x1a, 0x71, 0x20, 0x23, 0xe9, 0x3d, 0x37, 0x45, 0x07, 0xc7, 0x92, 0xeb, 0x81,
+	0x09, 0xc4, 0xf8, 0xc2, 0x58, 0xe8, 0xf5, 0x8c, 0x27, 0x1a, 0x4d, 0x97, 0x97, 0xba, 0x92, 0x47,
+	0x81, 0xa2, 0x7e, 0x68, 0x70, 0x54, 0xcd, 0x2b, 0xcb, 0xe2, 0xb6, 0x50, 0x19, 0x1c, 0x15, 0x99,
+	0x95, 0x36, 0x98, 0x78, 0x58, 0xd6, 0x59, 0xff, 0xe4, 0xf9, 0x7b, 0xf8, 0x15, 0xfa, 0xb2, 0xb8,
+	0x39, 0x54, 0x65, 0x9d, 0xaf, 0x14, 0xa2, 0xb5, 0x61, 0x50, 0x7f, 0x7b, 0x8b, 0x9c, 0x57, 0xa0,
+	0x4b, 0x41, 0xc2, 0x52, 0x5a, 0xc4, 0x74, 0xd1, 0x8d, 0x99, 0x33, 0x08, 0xaf, 0xd4, 0xe8, 0x08,
+	0xea, 0xe7, 0xaf, 0x78, 0xc9, 0xd5, 0x22, 0x4c, 0x58, 0x85, 0x03, 0xa8, 0xe0, 0x8d, 0x3d, 0x0d,
+	0xdc, 0x2d, 0x9f, 0xae, 0x2f, 0xad, 0x88, 0x13, 0xa9, 0x0e, 0xd1, 0x92, 0x00, 0xd0, 0x38, 0x2a,
+	0xc8, 0x68, 0x72, 0x50, 0x90, 0x11, 0x86, 0x8c, 0x76, 0x5a, 0xbd, 0x74, 0x19, 0x47, 0x7c, 0x31,
+	0xbc, 0xee, 0x97, 0x0a, 0x19, 0xbd, 0xb2, 0xb4, 0x91, 0xc3, 0x81, 0xc2, 0x9e, 0x2c, 0xf6, 0x06,
+	0xd3, 0x97, 0x37, 0x4e, 0x67, 0x62, 0x6f, 0xb0, 0x11, 0x38, 0x0c, 0x23, 0x09, 0x58, 0x6c, 0xfa,
+	0xd5, 0x24, 0xe9, 0x29, 0xb5, 0xb6, 0x71, 0x26, 0x9d, 0x2e, 0xe1, 0x72, 0x0e, 0x03, 0x0a, 0x7a,
+	0xa1, 0xd6, 0x13, 0x84, 0x8c, 0x7a, 0xe3, 0xc9, 0xb4, 0xd6, 0x73, 0x9d, 0x37, 0x83, 0x84, 0xdb,
+	0xdf, 0x48, 0x1a, 0xfd, 0x98, 0xb2, 0x03, 0xf3, 0xad, 0x30, 0xda, 0xf5, 0x43, 0xb7, 0xbd, 0xd2,
+	0xa6, 0x41, 0x82, 0xe1, 0xad, 0x0d, 0xc6, 0xfc, 0x82, 0xe8, 0xdb, 0xb8, 0x31, 0x00, 0x0f, 0x06,
+	0x52, 0xc8, 0x96, 0x04, 0x38, 0x37, 0x64, 0x49, 0x80, 0x0d, 0x72, 0x46, 0xca, 0xb5, 0xf5, 0xa5,
+	0x15, 0xf5, 0xd0, 0x8d, 0xf3, 0xe9, 0x82, 0xe0, 0x2b, 0x05, 0x38, 0x50, 0xd8, 0xd3, 0xf9, 0x03,
+	0x8b, 0x9c, 0x52, 0x3b, 0xd8, 0x43, 0x48, 0x51, 0xe2, 0xa7, 0x53, 0x94, 0x5c, 0x39, 0xbe, 0x0c,
+	0x60, 0x23, 0x1f, 0x10, 0xe7, 0xf7, 0x83, 0xa7, 0x08, 0xd1, 0x72, 0x42, 0x89, 0x68, 0x6b, 0xa0,
+	0x88, 0x7e, 0x6c, 0xf7, 0xe8, 0xa2, 0xf4, 0xe0, 0xf5, 0x47, 0x9b, 0x1e, 0xbc, 0x49, 0xce, 0xca,
+	0x25, 0xc5, 0x2f, 0xba, 0x31, 0x02, 0x5e, 0x6e, 0xf9, 0x46, 0x85, 0xf7, 0x95, 0x22, 0x24, 0x28,
+	0xee, 0x9b, 0xd2, 0xed, 0x46, 0x0f, 0xd5, 0xed, 0xd4, 0x2e, 0xb7, 0xba, 0x1d, 0x37, 0xc6, 0x8a,
+	0x76, 0xb9, 0xd5, 0xcb, 0x4d, 0xd0, 0x38, 0xc5, 0xa2, 0x6e, 0xbc, 0x24, 0x51, 0x47, 0x8e, 0x2c,
+	0xea, 0xe4, 0xa6, 0x3b, 0x31, 0x70, 0xd3, 0x95, 0x17, 0x6a, 0x93, 0x03, 0x2f, 0xd4, 0xde, 0x4b,
+	0xa6, 0xbc, 0x60, 0x87, 0x46, 0x5e, 0x42, 0xdb, 0xec, 0x5b, 0x60, 0x1b, 0xf2, 0x98, 0x56, 0x74,
+	0x56, 0x52, 0x50, 0xc8, 0x60, 0xa7, 0x25, 0xc5, 0xd4, 0x10, 0x92, 0x62, 0x80, 0x7c, 0x9e, 0x2e,
+	0x47, 0x3e, 0xcf, 0x1c, 0x5f, 0x3e, 0xcf, 0x9e, 0xa8, 0x7c, 0xb6, 0x4b, 0x91, 0xcf, 0x43, 0x89,
+	0x3e, 0xe3, 0x90, 0x7e, 0xe6, 0x90, 0x43, 0xfa, 0x20, 0xe1, 0x7c, 0xf6, 0x81, 0x85, 0x73, 0xb1,
+	0xdc, 0x7d, 0xe2, 0x0d, 0xb9, 0x5b, 0x8a, 0xdc, 0xfd, 0x74, 0x85, 0x9c, 0xd5, 0x92, 0x09, 0xf7,
+	0x03, 0xee, 0x3d, 0xcd, 0x02, 0xeb, 0xf8, 0x35, 0xbc, 0x91, 0x34, 0x44, 0xa7, 0x4d, 0x51, 0x10,
+	0x30, 0xb0, 0x58, 0xee, 0x0d, 0x1a, 0xb1, 0xaa, 0x91, 0x59, 0xb1, 0xb5, 0x24, 0xda, 0x41, 0x61,
+	0xe0, 0x24, 0xe0, 0xff, 0x22, 0xbd, 0x59, 0x36, 0xe1, 0xce, 0x92, 0x06, 0x81, 0x89, 0xc7, 0x72,
+	0x1d, 0xc8, 0x2d, 0x13, 0x45, 0xd7, 0xa4, 0xc8, 0x75, 0x20, 0xda, 0x40, 0x41, 0xe5, 0x70, 0x58,
+	0x6e, 0x98, 0x7a, 0x7e, 0x38, 0xd8, 0x0e, 0x0a, 0xc3, 0xf9, 0xef, 0x16, 0x39, 0x57, 0x38, 0x15,
+	0x0f, 0x41, 0x1d, 0xb9, 0x9b, 0x56, 0x47, 0x9a, 0x65, 0x1d, 0x49, 0x8d, 0xa7, 0x18, 0xa0, 0x9a,
+	0xfc, 0x5b, 0x8b, 0x4c, 0x69, 0xfc, 0x87, 0xf0, 0xa8, 0x5e, 0xfa, 0x51, 0xcb, 0x3b, 0x7d, 0x8f,
+	0xe7, 0x9e, 0xed, 0xaf, 0x55, 0x89, 0xaa, 0x11, 0xb6, 0xd0, 0x92, 0x15, 0x18, 0x0f, 0x71, 0x0c,
+	0xd9, 0x27, 0x23, 0xcc, 0xaf, 0x25, 0x2e, 0xc7, 0x67, 0x2f, 0xcd, 0x9f, 0xf9, 0xc8, 0x18, 0x19,
+	0xa8, 0x18, 0x23, 0x10, 0x0c, 0x59, 0x4d, 0x53, 0x5e, 0x7e, 0xa9, 0x2d, 0x02, 0x60, 0x74, 0x4d,
+	0x53, 0xd1, 0x0e, 0x0a, 0x03, 0x05, 0xa6, 0xd7, 0x0a, 0x83, 0x25, 0xdf, 0x8d, 0xe3, 0x6c, 0x04,
+	0xea, 0x8a, 0x04, 0x80, 0xc6, 0x61, 0x2e, 0x2f, 0x5e, 0xdc, 0xf3, 0xdd, 0x7d, 0xc3, 0xc6, 0x62,
+	0xa4, 0xf1, 0x54, 0x20, 0x30, 0xf1, 0x64, 0x8a, 0x1e, 0x2f, 0xc2, 0xba, 0x6a, 0xc1, 0xb6, 0x17,
+	0x75, 0xf9, 0x45, 0xdd, 0x48, 0x7a, 0xd3, 0x81, 0x02, 0x1c, 0x28, 0xec, 0xe9, 0xfc, 0xe3, 0x0a,
+	0x69, 0xa4, 0xe7, 0x65, 0x99, 0x6e, 0x33, 0xff, 0xf7, 0xa1, 0xde, 0x10, 0x7a, 0x81, 0xb3, 0x5e,
+	0xab, 0x7d, 0xb7, 0x51, 0x49, 0x3f, 0xf8, 0x82, 0x04, 0x80, 0xc6, 0x31, 0x5e, 0x69, 0xf5, 0x61,
+	0xbf, 0xd2, 0x41, 0x93, 0x57, 0x7b, 0xe0, 0xc9, 0xfb, 0xbb, 0x16, 0x39, 0x5d, 0x30, 0x82, 0x12,
+	0xb3, 0x87, 0x24, 0x7a, 0x37, 0x2e, 0x52, 0x05, 0x31, 0xba, 0x84, 0xc7, 0x8a, 0xe5, 0xa2, 0x4b,
+	0x78, 0x33, 0x48, 0x38, 0x06, 0xbd, 0x4f, 0xa7, 0xc7, 0xca, 0xd2, 0x0e, 0xf2, 0x37, 0xc3, 0x4a,
+	0x7a, 0xed, 0xd1, 0x68, 0x1f, 0x5f, 0xa3, 0x95, 0x89, 0xc8, 0xcf, 0x61, 0x40, 0x41, 0x2f, 0x56,
+	0x41, 0xb1, 0xad, 0x96, 0x8e, 0xfc, 0x62, 0x6f, 0x96, 0xf9, 0x7a, 0xf5, 0xca, 0x34, 0x3e, 0x15,
+	0xcd, 0x12, 0x4c, 0xfe, 0xa8, 0x92, 0xb2, 0x80, 0x28, 0x4c, 0x28, 0x92, 0x78, 0x81, 0x78, 0x64,
+	0xf1, 0x2d, 0x2b, 0x95, 0x74, 0x2d, 0x8f, 0x02, 0x45, 0xfd, 0x9c, 0x3f, 0xae, 0x11, 0x95, 0x9e,
+	0x8b, 0x79, 0xef, 0x96, 0xe4, 0xfb, 0x7c, 0xd4, 0xbc, 0x0e, 0x6a, 0x6d, 0xd5, 0x0e, 0x72, 0xa7,
+	0xe3, 0x86, 0x4b, 0xf3, 0x86, 0x23, 0x53, 0x30, 0x87, 0x81, 0xc0, 0xc4, 0xc3, 0x91, 0xf8, 0xde,
+	0x1e, 0xe5, 0x9d, 0x46, 0xd2, 0x23, 0x59, 0x95, 0x00, 0xd0, 0x38, 0x38, 0x92, 0xb6, 0xb7, 0xbd,
+	0xdd, 0x18, 0x4d, 0x8f, 0x04, 0x67, 0x07, 0x18, 0x84, 0xd7, 0xd8, 0x0d, 0x77, 0xc5, 0x31, 0xcc,
+	0xa8, 0xb1, 0x1b, 0xee, 0x02, 0x83, 0xe0, 0x5b, 0x0a, 0xc2, 0xa8, 0xeb, 0xfa, 0xde, 0x6b, 0xb4,
+	0xad, 0xb8, 0x88, 0xe3, 0x97, 0x7a, 0x4b, 0xd7, 0xf3, 0x28, 0x50, 0xd4, 0x0f, 0x17, 0x74, 0x2f,
+	0xa2, 0x6d, 0xaf, 0x95, 0x98, 0xd4, 0x48, 0x7a, 0x41, 0x6f, 0xe4, 0x30, 0xa0, 0xa0, 0x17, 0xe6,
+	0xee, 0x95, 0xe9, 0xd5, 0x64, 0x4a, 0x83, 0x89, 0x74, 0xee, 0x5e, 0x48, 0x83, 0x21, 0x8b, 0x8f,
+	0x42, 0xa4, 0x2b, 0x6a, 0x28, 0x34, 0x26, 0xd3, 0x42, 0x44, 0xd6, 0x56, 0x00, 0x85, 0xe1, 0x7c,
+	0xa2, 0x8a, 0x4a, 0xcf, 0x80, 0x52, 0x25, 0x0f, 0xcd, 0xd7, 0xfe, 0xe8, 0xd9, 0x14, 0xd0, 0x8f,
+	0x3d, 0x0e, 0x03, 0xe9, 0xa1, 0xde, 0xa8, 0x0f, 0xf4, 0x63, 0x37, 0xb0, 0x8a, 0xfd, 0xd8, 0x47,
+	0xca, 0xf2, 0x63, 0x1f, 0x7d, 0x40, 0x3f, 0xf6, 0x5f, 0xab, 0x93, 0x27, 0x54, 0x8a, 0x3d, 0x9a,
+	0xdc, 0x09, 0xa3, 0x5d, 0x2f, 0xe8, 0xb0, 0x2c, 0x5d, 0x3f, 0x6a, 0xc9, 0x6c, 0x63, 0xab, 0x66,
+	0xd6, 0x87, 0xed, 0x92, 0x0a, 0xe1, 0xa7, 0x98, 0xcd, 0x1b, 0x65, 0xad, 0x84, 0xe7, 0x51, 0x26,
+	0xab, 0x19, 0x07, 0x41, 0x6a, 0x44, 0xf6, 0x37, 0x13, 0x22, 0xaf, 0x2c, 0xb6, 0xe5, 0x0e, 0xbc,
+	0x52, 0xce, 0xf8, 0xf0, 0xca, 0x48, 0x1d, 0x39, 0x36, 0x15, 0x13, 0x30, 0x18, 0xa2, 0xaf, 0x9a,
+	0xbc, 0xfe, 0xe1, 0xc2, 0xfd, 0x23, 0x27, 0x32, 0x37, 0xc3, 0xe4, 0xc3, 0x00, 0x32, 0xea, 0x05,
+	0x1d, 0x96, 0x00, 0x9f, 0xfb, 0xfb, 0xbe, 0xb5, 0x28, 0x13, 0xe5, 0x6a, 0xe8, 0xb6, 0x17, 0x5d,
+	0xdf, 0x0d, 0x5a, 0x58, 0x71, 0x8f, 0xa1, 0x6b, 0x09, 0x2a, 0x1a, 0x40, 0x12, 0xc2, 0x75, 0x8e,
+	0x9e, 0xcf, 0x51, 0xe0, 0xfa, 0x37, 0x60, 0x35, 0xb5, 0xce, 0x2f, 0x19, 0xed, 0x90, 0xc2, 0x3a,
+	0xff, 0x75, 0x64, 0x36, 0xf7, 0x32, 0x8f, 0x94, 0x04, 0xe2, 0x18, 0x39, 0x28, 0x7f, 0x61, 0x44,
+	0x0b, 0x2d, 0xcc, 0xba, 0x69, 0x7f, 0xdc, 0xc2, 0x1a, 0x6c, 0xea, 0x8d, 0x8a, 0x23, 0x45, 0x89,
+	0x4b, 0xc4, 0x28, 0xe7, 0xa6, 0x1a, 0xc1, 0x64, 0x89, 0x6b, 0xb4, 0xe7, 0x46, 0x34, 0x38, 0xe9,
+	0x35, 0xba, 0xa1, 0x98, 0x80, 0xc1, 0xd0, 0xde, 0x49, 0x85, 0x73, 0x5e, 0x3e, 0x7e, 0x38, 0x27,
+	0xcb, 0x7d, 0x5f, 0x54, 0x5f, 0xfb, 0x7b, 0x2d, 0x32, 0x15, 0xa4, 0x56, 0x6e, 0x39, 0x41, 0x18,
+	0xc5, 0x5f, 0x05, 0x4f, 0x33, 0x9d, 0x6e, 0x83, 0x0c, 0xff, 0x22, 0x91, 0x56, 0x3f, 0xa2, 0x48,
+	0x73, 0xc8, 0x08, 0x8b, 0x6d, 0x4e, 0xdd, 0xf0, 0xb2, 0xb8, 0xe7, 0x18, 0x04, 0xc4, 0x0e, 0xc8,
+	0x08, 0x4f, 0x15, 0xdd, 0x18, 0x2d, 0x23, 0x89, 0x96, 0x99, 0x6f, 0x9a, 0xf3, 0xe3, 0x2d, 0x20,
+	0xb8, 0xd8, 0xb7, 0xcc, 0x68, 0xef, 0xb1, 0x23, 0x87, 0x15, 0x9e, 0x1a, 0x14, 0x15, 0xee, 0xfc,
+	0xef, 0x1a, 0x99, 0x91, 0x33, 0x22, 0x03, 0xb8, 0x50, 0x3e, 0x72, 0xbe, 0x5a, 0x57, 0x56, 0xf2,
+	0xf1, 0xaa, 0x04, 0x80, 0xc6, 0x41, 0x7d, 0xac, 0x1f, 0x63, 0x9e, 0xcf, 0x60, 0xd5, 0xdb, 0x8a,
+	0x85, 0x7b, 0x82, 0xfa, 0x50, 0x6e, 0x68, 0x10, 0x98, 0x78, 0x2c, 0x24, 0xdd, 0x50, 0x5a, 0xcd,
+	0x90, 0xf4, 0x96, 0xc8, 0xc7, 0x26, 0xe0, 0xf6, 0xe7, 0x0b, 0x6b, 0xa7, 0x95, 0x13, 0x33, 0x9d,
+	0x8b, 0x5b, 0x3b, 0x5a, 0xd1, 0x34, 0xfb, 0x27, 0x2d, 0x72, 0x96, 0xb7, 0xca, 0x99, 0xbc, 0xd1,
+	0x6b, 0xb3, 0xbc, 0x12, 0x23, 0x27, 0x34, 0x3e, 0x7d, 0xcb, 0x50, 0xc4, 0x16, 0x8a, 0x47, 0x83,
+	0xd9, 0x5e, 0xa6, 0x77, 0x53, 0x99, 0x18, 0xa5, 0xe8, 0x38, 0x6e, 0x92, 0xb4, 0x14, 0x51, 0xfd,
+	0xa9, 0xa5, 0xdb, 0x63, 0xc8, 0x72, 0xc7, 0xba, 0x8c, 0xe6, 0x36, 0xfa, 0xf0, 0x13, 0x38, 0x1e,
+	0x5d, 0x15, 0x94, 0xda, 0x65, 0x7d, 0xa0, 0x76, 0x89, 0x0e, 0x11, 0x5e, 0xbb, 0x31, 0x92, 0x71,
+	0x88, 0x58, 0x59, 0x06, 0x6c, 0x77, 0xfe, 0xa8, 0xae, 0xcd, 0x44, 0x22, 0x24, 0xf9, 0xcf, 0xc5,
+	0x63, 0x6f, 0xab, 0x1c, 0xfc, 0xfc, 0xc9, 0xaf, 0xe7, 0x72, 0xf0, 0x7f, 0xed, 0xd1, 0x23, 0xce,
+	0xf9, 0x04, 0x0d, 0x4a, 0xc1, 0x3f, 0x7a, 0x48, 0xb8, 0xf9, 0x6d, 0x32, 0x86, 0x47, 0x30, 0x66,
+	0xef, 0x1d, 0x4b, 0x0d, 0x6a, 0xec, 0xaa, 0x68, 0x7f, 0xfd, 0xde, 0xdc, 0xbb, 0x8e, 0x3e, 0x2c,
+	0xd9, 0x1b, 0x14, 0x7d, 0x3b, 0x26, 0xe3, 0xf8, 0x3f, 0x8b, 0x8c, 0x17, 0x87, 0xbb, 0x1b, 0x6a,
+	0xcf, 0x94, 0x80, 0x52, 0xc2, 0xee, 0x35, 0x1f, 0x3b, 0x20, 0xe3, 0x88, 0xc8, 0x99, 0xf2, 0x33,
+	0xe0, 0x86, 0x64, 0xda, 0x94, 0x80, 0xd7, 0xef, 0xcd, 0xbd, 0xfb, 0xe8, 0x4c, 0x55, 0x77, 0xd0,
+	0x2c, 0x0c, 0xd1, 0x38, 0x31, 0x48, 0x34, 0x3a, 0xff, 0xa7, 0xa6, 0xd7, 0x37, 0x7f, 0xf5, 0x7f,
+	0x3e, 0xd6, 0xf7, 0x4b, 0x99, 0xf5, 0x7d, 0x21, 0xb7, 0xbe, 0xa7, 0x70, 0xce, 0x0a, 0x8a, 0x46,
+	0x3c, 0x6c, 0x65, 0xe1, 0x70, 0x9b, 0x04, 0xd3, 0x92, 0xb8, 0xb5, 0x6f, 0x23, 0xea, 0x07, 0x58,
+	0x25, 0x61, 0x9c, 0x21, 0x1b, 0x5a, 0x52, 0x0a, 0x0c, 0x59, 0x7c, 0x3c, 0xf8, 0xe3, 0xba, 0xb8,
+	0xe5, 0xee, 0xf1, 0x95, 0x67, 0x24, 0x4c, 0x6e, 0x8a, 0x76, 0x50, 0x18, 0xf6, 0x0e, 0x79, 0x5a,
+	0x12, 0x58, 0xa6, 0x3e, 0xc5, 0x07, 0x4a, 0x19, 0x28, 0xb9, 0xaf, 0xce, 0x9b, 0x05, 0x85, 0xa7,
+	0xe1, 0x00, 0x5c, 0x38, 0x90, 0x92, 0xf3, 0xd3, 0xcc, 0xb5, 0xc3, 0x48, 0x10, 0x82, 0xab, 0xcf,
+	0xf7, 0xba, 0x9e, 0xcc, 0xeb, 0xac, 0xcb, 0x3b, 0x63, 0x23, 0x70, 0x98, 0x7d, 0x87, 0x8c, 0x6e,
+	0xb9, 0xad, 0xdd, 0x70, 0x7b, 0xbb, 0x9c, 0x7a, 0xa1, 0x8b, 0x9c, 0x18, 0xab, 0xde, 0x31, 0x2a,
+	0x7e, 0xbc, 0xae, 0xff, 0x05, 0xc9, 0xcd, 0xf9, 0xed, 0x3a, 0x99, 0x96, 0xee, 0x77, 0x57, 0xbd,
+	0x98, 0x79, 0x6c, 0x98, 0x15, 0xa5, 0x2a, 0x87, 0x56, 0x94, 0xfa, 0x10, 0x21, 0x6d, 0xda, 0xf3,
+	0xc3, 0x7d, 0xa6, 0x1c, 0xd6, 0x8e, 0xac, 0x1c, 0xaa, 0xf3, 0xc4, 0xb2, 0xa2, 0x02, 0x06, 0x45,
+	0x91, 0xcc, 0x9a, 0x17, 0xa8, 0xca, 0x24, 0xb3, 0x36, 0xaa, 0x0a, 0x8f, 0x3c, 0xdc, 0xaa, 0xc2,
+	0x1e, 0x99, 0xe6, 0x43, 0x54, 0x69, 0x38, 0x1e, 0x20, 0xdb, 0x06, 0x8b, 0xfa, 0x5b, 0x4e, 0x93,
+	0x81, 0x2c, 0x5d, 0xb3, 0x64, 0xf0, 0xd8, 0xc3, 0x2e, 0x19, 0xfc, 0x15, 0x64, 0x5c, 0xbe, 0x67,
+	0x8c, 0x46, 0x53, 0x39, 0xb1, 0xe4, 0x32, 0x88, 0x41, 0xc3, 0x73, 0x19, 0x85, 0xc8, 0xa3, 0xca,
+	0x28, 0xe4, 0xfc, 0x06, 0x3b, 0x55, 0xf0, 0x71, 0x1d, 0xb9, 0xe2, 0xf6, 0x55, 0xa3, 0xe2, 0xf6,
+	0xd1, 0xde, 0xe7, 0x58, 0xa6, 0x32, 0xf7, 0xd3, 0xa4, 0x96, 0xb8, 0x1d, 0x19, 0x3a, 0xcd, 0xa0,
+	0x9b, 0x2e, 0x16, 0x5e, 0xc4, 0xd6, 0xa3, 0x14, 0x20, 0x40, 0x27, 0x26, 0xaf, 0x13, 0xb8, 0x09,
+	0x7a, 0xee, 0xe8, 0xfb, 0x5d, 0xed, 0xc4, 0x64, 0x02, 0x21, 0x8d, 0x6b, 0x7f, 0xd2, 0xc2, 0xc4,
+	0x2d, 0xea, 0xcc, 0x32, 0x52, 0xc6, 0x1a, 0x52, 0xdb, 0x80, 0xa4, 0x6b, 0x66, 0x82, 0x51, 0x67,
+	0x15, 0x83, 0x2d, 0x9a, 0x76, 0x5a, 0x3b, 0x6e, 0xc0, 0xec, 0x81, 0x3e, 0x95, 0xe6, 0x43, 0x66,
+	0xda, 0x59, 0x32, 0xda, 0x21, 0x85, 0x85, 0xd9, 0xb8, 0x27, 0x8c, 0xf0, 0x00, 0x71, 0xf4, 0x7c,
+	0xa5, 0x9c, 0xc1, 0x1b, 0xbe, 0xe7, 0x3c, 0x96, 0xc4, 0x68, 0x00, 0x93, 0xad, 0xb8, 0x85, 0xca,
+	0xf5, 0xc2, 0x25, 0x15, 0xf4, 0xbb, 0x5b, 0xc2, 0x47, 0xbd, 0xaa, 0x97, 0xd4, 0x75, 0xd6, 0x0a,
+	0x02, 0x8a, 0x22, 0x80, 0x05, 0x89, 0x64, 0xef, 0xa2, 0x58, 0x14, 0x09, 0x70, 0x98, 0xb1, 0x3e,
+	0xab, 0x07, 0xae, 0x4f, 0xe1, 0xf0, 0x5c, 0x2b, 0x76, 0x78, 0x76, 0x3e, 0x65, 0x91, 0xd9, 0xdc,
+	0xeb, 0xb1, 0x7b, 0x64, 0xa4, 0xc5, 0x0a, 0xd0, 0x97, 0x93, 0x96, 0x3a, 0x5d, 0xcc, 0x9e, 0x6b,
+	0x01, 0xbc, 0x0d, 0x04, 0x1f, 0xe7, 0x17, 0x27, 0xc9, 0x99, 0xe6, 0xd2, 0x9a, 0x2c, 0x07, 0x7a,
+	0x62, 0xe1, 0xed, 0x45, 0x3c, 0x1e, 0x5e, 0x78, 0xfb, 0x00, 0xee, 0xbe, 0x11, 0xde, 0xee, 0x1b,
+	0xe1, 0xed, 0xe9, 0x58, 0xe3, 0x6a, 0x19, 0xb1, 0xc6, 0x45, 0x23, 0x18, 0x26, 0xd6, 0xf8, 0xc4,
+	0xe2, 0xdd, 0x0f, 0x1c, 0xd0, 0x91, 0xe2, 0xdd, 0x55, 0x32, 0x80, 0x52, 0x42, 0x1b, 0x07, 0xbc,
+	0xaa, 0xc2, 0x64, 0x00, 0x2a, 0x10, 0x9b, 0x87, 0xed, 0x36, 0x46, 0xca, 0x08, 0xc4, 0x2e, 0x1a,
+	0xc0, 0x10, 0x81, 0xd8, 0xfc, 0x47, 0x2a, 0xf8, 0x7f, 0xb4, 0x8c, 0xe0, 0xff, 0xa2, 0xe1, 0x1c,
+	0x1a, 0xfc, 0x8f, 0x95, 0xdb, 0xfd, 0x30, 0xc0, 0xea, 0xc4, 0x49, 0xd8, 0x0a, 0xfd, 0xc6, 0x58,
+	0x5a, 0x12, 0x2d, 0x99, 0x40, 0x48, 0xe3, 0x0e, 0xca, 0x1c, 0x30, 0x7e, 0xdc, 0xcc, 0x01, 0xe4,
+	0x11, 0x65, 0x0e, 0x30, 0x62, 0xe3, 0x27, 0xca, 0x88, 0x8d, 0x2f, 0x7a, 0x23, 0x43, 0xc5, 0xc6,
+	0x7f, 0xce, 0x22, 0xa7, 0xdc, 0x3b, 0xec, 0xd4, 0xc7, 0x77, 0x61, 0x76, 0x17, 0x3a, 0xf1, 0xe2,
+	0x87, 0x4f, 0x60, 0xc1, 0xde, 0x6a, 0x6a, 0x36, 0x8b, 0xb3, 0x2c, 0x5e, 0xc9, 0x6c, 0x82, 0xf4,
+	0x40, 0x8e, 0x13, 0x4f, 0xff, 0xc3, 0x15, 0xf2, 0x65, 0x87, 0x0e, 0xc1, 0xbe, 0x83, 0x37, 0x72,
+	0x1d, 0xb1, 0x50, 0x1b, 0x56, 0x19, 0x0e, 0xee, 0x9b, 0x92, 0x9e, 0x88, 0xf5, 0x54, 0xe4, 0xc1,
+	0x60, 0xc5, 0xfc, 0xda, 0x43, 0x3f, 0x57, 0x51, 0x02, 0x42, 0x9f, 0x02, 0x83, 0xa0, 0x44, 0x8f,
+	0x68, 0x07, 0x4f, 0x51, 0x19, 0x89, 0x0e, 0xac, 0x15, 0x04, 0x14, 0xcd, 0xd7, 0xae, 0xef, 0xf3,
+	0xb8, 0x53, 0x1a, 0x0b, 0x6f, 0x19, 0x9d, 0xf0, 0x5d, 0x83, 0xc0, 0xc4, 0x73, 0xbe, 0x54, 0x21,
+	0x73, 0x87, 0xec, 0x29, 0xb9, 0x7c, 0x03, 0xf5, 0xa1, 0xf3, 0x0d, 0x88, 0xb8, 0xb9, 0x91, 0x01,
+	0x71, 0x73, 0xe8, 0x02, 0x41, 0xb1, 0x84, 0x2e, 0xf7, 0x94, 0xcd, 0xa4, 0x3b, 0xdd, 0xd4, 0x20,
+	0x30, 0xf1, 0x70, 0x17, 0x9b, 0x72, 0x5b, 0x2d, 0x1a, 0xc7, 0x32, 0x30, 0x4e, 0xe8, 0x74, 0xa5,
+	0x45, 0xdd, 0xb1, 0x5b, 0x9a, 0x85, 0x14, 0x0b, 0xc8, 0xb0, 0xcc, 0x4e, 0xf8, 0xf8, 0x90, 0x13,
+	0xfe, 0xe3, 0x15, 0xf2, 0xcc, 0x81, 0xd2, 0x6d, 0xe8, 0x98, 0xc5, 0x7e, 0x4c, 0xa3, 0xec, 0xc2,
+	0xc1, 0x50, 0x07, 0x60, 0x10, 0x3e, 0x4b, 0xbd, 0x9e, 0x0a, 0x67, 0x28, 0x3f, 0xc8, 0x97, 0xcf,
+	0x52, 0x8a, 0x05, 0x64, 0x58, 0x3e, 0xe8, 0xb2, 0xfc, 0xed, 0x1a, 0x79, 0x6e, 0x08, 0x1d, 0xa0,
+	0xc4, 0x60, 0xe8, 0x74, 0xa0, 0x7f, 0xf5, 0x11, 0x05, 0xfa, 0x3f, 0xd8, 0x74, 0xbd, 0x91, 0x1f,
+	0x60, 0xa8, 0xa0, 0xeb, 0x9f, 0xae, 0x90, 0xf3, 0x83, 0x15, 0x16, 0xfb, 0x3d, 0x68, 0x50, 0x94,
+	0xbe, 0xb1, 0x66, 0x8e, 0x80, 0xd3, 0xdc, 0x98, 0x98, 0x02, 0x41, 0x16, 0x17, 0xc3, 0xfc, 0x7b,
+	0x6e, 0xb2, 0x13, 0x5f, 0xba, 0xeb, 0xc5, 0x89, 0x48, 0xf5, 0x38, 0xc5, 0xaf, 0xb8, 0x65, 0x2b,
+	0x18, 0x18, 0xc8, 0x8e, 0xfd, 0x5a, 0xc6, 0xe4, 0x31, 0xbc, 0x13, 0x3f, 0xe3, 0x9f, 0x96, 0x05,
+	0xc7, 0x0d, 0x10, 0x64, 0x71, 0x91, 0x1d, 0x73, 0xa2, 0xe0, 0x03, 0xad, 0xe9, 0xac, 0x02, 0xab,
+	0xaa, 0x15, 0x0c, 0x8c, 0x6c, 0xf6, 0x83, 0xfa, 0xe1, 0xd9, 0x0f, 0x9c, 0x9f, 0xab, 0x90, 0x73,
+	0x03, 0x15, 0xde, 0xe1, 0xb6, 0xa9, 0xc7, 0x2f, 0x03, 0xc1, 0x03, 0x7e, 0x61, 0x47, 0x8a, 0x5c,
+	0x77, 0xfe, 0x70, 0xc0, 0x4a, 0x13, 0x51, 0xe9, 0x0f, 0x9e, 0xc0, 0xe7, 0xf1, 0x9b, 0xcf, 0x5c,
+	0x20, 0x7a, 0xed, 0x08, 0x81, 0xe8, 0x99, 0x97, 0x51, 0x1f, 0x52, 0x3a, 0xfc, 0x87, 0xda, 0xc0,
+	0xe9, 0xc5, 0x03, 0xf2, 0x50, 0x57, 0x35, 0xcb, 0x64, 0xc6, 0x0b, 0x5a, 0x7e, 0xbf, 0x4d, 0x9b,
+	0xfd, 0x2d, 0x91, 0xfd, 0x8f, 0xe7, 0xc7, 0x56, 0x61, 0x60, 0x2b, 0x19, 0x38, 0xe4, 0x7a, 0x3c,
+	0x86, 0x89, 0x01, 0x1e, 0x6c, 0x4a, 0x8f, 0xb8, 0x73, 0xaf, 0x93, 0xb3, 0x72, 0x2a, 0x76, 0xdc,
+	0x88, 0xb6, 0x85, 0xb0, 0x8d, 0x45, 0xe0, 0xdf, 0x39, 0x1e, 0x3c, 0x58, 0x80, 0x00, 0xc5, 0xfd,
+	0x98, 0x71, 0x2b, 0xec, 0x79, 0xad, 0xc6, 0x58, 0xfa, 0x95, 0x6d, 0x62, 0x23, 0x70, 0x98, 0x96,
+	0x17, 0xe3, 0x0f, 0x47, 0x5e, 0x7c, 0x88, 0x8c, 0xab, 0xf9, 0xe6, 0xc1, 0x3d, 0x6a, 0x91, 0xe7,
+	0x82, 0x7b, 0xd4, 0x0a, 0x37, 0xb0, 0xec, 0x67, 0xf8, 0x41, 0x25, 0xf3, 0xb5, 0x22, 0x3f, 0x6c,
+	0x77, 0xde, 0x4e, 0x26, 0x95, 0xd1, 0x55, 0x04, 0x55, 0xef, 0xd2, 0xfd, 0x95, 0xe5, 0xec, 0xba,
+	0xbd, 0x86, 0x8d, 0xc0, 0x61, 0xce, 0xff, 0xad, 0x90, 0x4c, 0xf1, 0x55, 0xcc, 0xcf, 0x8e, 0xc5,
+	0x63, 0x59, 0x63, 0x39, 0xf9, 0xd9, 0x97, 0x25, 0x39, 0x7d, 0xe3, 0xa8, 0x9a, 0x40, 0x33, 0xb3,
+	0x3f, 0xca, 0x53, 0xa1, 0x0b, 0xd6, 0x95, 0x32, 0x92, 0x43, 0x34, 0x15, 0x3d, 0x63, 0x7a, 0x55,
+	0x1b, 0x18, 0xfc, 0xec, 0x84, 0x8c, 0xef, 0xc8, 0x22, 0xb3, 0xe5, 0x6c, 0x77, 0xaa, 0x66, 0x2d,
+	0x57, 0xd1, 0xd4, 0x4f, 0xd0, 0x8c, 0x58, 0x3d, 0x92, 0xf4, 0x0b, 0x10, 0x37, 0xc4, 0x3f, 0x63,
+	0x91, 0x27, 0x7d, 0x37, 0x4e, 0x9a, 0x7d, 0x76, 0x50, 0xd8, 0xee, 0xfb, 0xeb, 0x99, 0xac, 0xf9,
+	0xc7, 0x35, 0xb6, 0x28, 0xc2, 0xd9, 0xa2, 0xc4, 0x8b, 0x4f, 0x61, 0xb8, 0xe4, 0x6a, 0x31, 0x73,
+	0x18, 0x34, 0x2a, 0xb4, 0x50, 0xcd, 0xb4, 0xfa, 0x51, 0x44, 0x83, 0x44, 0x0f, 0x95, 0xbf, 0xc5,
+	0xeb, 0xa5, 0x4c, 0xa4, 0x1e, 0xe0, 0x19, 0xdc, 0x50, 0x97, 0x32, 0xbc, 0x20, 0xc7, 0xdd, 0xf9,
+	0x0e, 0x94, 0x9c, 0x03, 0x9f, 0xf3, 0xff, 0xb3, 0x2a, 0xca, 0x7f, 0x32, 0x42, 0x4e, 0xa5, 0x4a,
+	0x03, 0xa4, 0x6e, 0x55, 0xad, 0x43, 0x6f, 0x55, 0x59, 0xa8, 0x6a, 0x3f, 0x10, 0xe5, 0x3d, 0xcd,
+	0x50, 0xd5, 0x7e, 0x80, 0xa5, 0x0f, 0xf0, 0x8f, 0x98, 0x52, 0xe8, 0x07, 0x22, 0xe8, 0xc2, 0x9c,
+	0x52, 0xe8, 0x07, 0x20, 0xa0, 0xe8, 0x94, 0x3a, 0xc9, 0x3e, 0x3e, 0x71, 0x27, 0xdd, 0xa8, 0x95,
+	0xe1, 0x08, 0xd0, 0x34, 0x28, 0xf2, 0x9b, 0x1c, 0xb3, 0x05, 0x52, 0x1c, 0xf1, 0x26, 0x67, 0x5c,
+	0x55, 0xb3, 0x6f, 0x8c, 0x94, 0x11, 0xf8, 0x97, 0xad, 0xbc, 0x90, 0xd9, 0xf5, 0x64, 0x0b, 0xbb,
+	0xa3, 0x14, 0xff, 0x62, 0x4d, 0x59, 0xfe, 0xaf, 0x58, 0x1c, 0xa5, 0xdf, 0xa5, 0x92, 0x82, 0xcb,
+	0x62, 0xac, 0x2c, 0xe4, 0x06, 0xde, 0x36, 0x8d, 0x13, 0x7e, 0x87, 0x2b, 0x2b, 0x0b, 0xc9, 0x46,
+	0xd0, 0x70, 0x54, 0xf6, 0x63, 0xf6, 0x60, 0x89, 0x71, 0xe9, 0xca, 0x94, 0xfd, 0xa6, 0x6e, 0x06,
+	0x13, 0xc7, 0xbc, 0x21, 0x26, 0x8f, 0xf4, 0x86, 0x78, 0xe2, 0x90, 0x1b, 0xe2, 0x26, 0x39, 0xeb,
+	0xf6, 0x93, 0x10, 0xfd, 0x45, 0x16, 0x12, 0x34, 0xa3, 0x26, 0x31, 0xaf, 0x26, 0x31, 0xc9, 0x4c,
+	0xc0, 0xca, 0xad, 0xb0, 0x49, 0xfd, 0xed, 0x1c, 0x12, 0x14, 0xf7, 0x75, 0xfe, 0x81, 0x45, 0xce,
+	0x16, 0x2e, 0x85, 0xc7, 0x37, 0xa0, 0xc3, 0xf9, 0x6c, 0x9d, 0x9c, 0x2e, 0x28, 0x1c, 0x62, 0xef,
+	0x9b, 0x1f, 0x89, 0x55, 0x86, 0x6f, 0x64, 0xda, 0xd5, 0x4f, 0xbe, 0x9b, 0x82, 0x2f, 0xe3, 0x68,
+	0x4e, 0x1f, 0xda, 0xf1, 0xa2, 0xfa, 0x70, 0x1d, 0x2f, 0x8c, 0xb5, 0x5e, 0x7b, 0xa4, 0x6b, 0xbd,
+	0x7e, 0xc8, 0x5a, 0xff, 0x82, 0x45, 0x1a, 0xdd, 0x01, 0xd5, 0x2f, 0x1b, 0x23, 0x65, 0xd8, 0xa8,
+	0x06, 0xd5, 0xd6, 0x5c, 0x7c, 0x1a, 0xe3, 0xf4, 0x07, 0x41, 0x61, 0xe0, 0xa8, 0x9c, 0x9f, 0xb4,
+	0xc8, 0xac, 0xb1, 0xc9, 0x88, 0x1a, 0x78, 0x25, 0x7d, 0x45, 0x5f, 0x4e, 0x46, 0x69, 0xc0, 0xb2,
+	0x14, 0x08, 0x5b, 0xc9, 0x04, 0xce, 0xf1, 0x25, 0xde, 0x04, 0x12, 0x86, 0x2e, 0x7e, 0xdb, 0x61,
+	0xb4, 0xe5, 0xb5, 0xcd, 0x2c, 0x83, 0x97, 0x59, 0x0b, 0x08, 0x88, 0xf3, 0xd3, 0x23, 0x84, 0xe9,
+	0x95, 0x62, 0x80, 0x1f, 0x33, 0xeb, 0x24, 0x59, 0x65, 0xd5, 0xf4, 0xe1, 0xc4, 0x55, 0x9d, 0x25,
+	0xfe, 0xa6, 0x8b, 0xca, 0x2e, 0x65, 0x77, 0xec, 0xca, 0x10, 0x3b, 0xb6, 0x2f, 0x0b, 0x52, 0x55,
+	0xcb, 0x2f, 0x48, 0x35, 0x9e, 0x2d, 0x46, 0x75, 0xf0, 0x52, 0xac, 0x3d, 0x8e, 0x4b, 0x11, 0xf3,
+	0xab, 0xb4, 0xc2, 0x80, 0xab, 0x98, 0xad, 0x7d, 0xcc, 0xa9, 0x51, 0x4f, 0x27, 0x92, 0x5b, 0x4a,
+	0x41, 0x21, 0x83, 0x6d, 0x77, 0xc9, 0x1c, 0xcf, 0xd4, 0xd0, 0xf4, 0xda, 0x14, 0xbf, 0xf1, 0x7d,
+	0x51, 0x3d, 0x16, 0x8f, 0x8e, 0xbe, 0xd7, 0x4a, 0xb8, 0x0e, 0x32, 0xbe, 0xf8, 0xdc, 0xfd, 0x7b,
+	0x73, 0x73, 0xcd, 0x83, 0x51, 0xe1, 0x30, 0x5a, 0xf6, 0x0f, 0x59, 0xc4, 0xde, 0xcb, 0x56, 0x6b,
+	0x96, 0xf7, 0xb4, 0xc7, 0xdc, 0xa1, 0x72, 0x55, 0xa0, 0x75, 0x9c, 0x65, 0x0e, 0x14, 0x43, 0xc1,
+	0x30, 0x9c, 0x5f, 0xb2, 0xc8, 0xe9, 0x82, 0x25, 0xad, 0x75, 0x4c, 0xeb, 0x00, 0x1d, 0x13, 0x1d,
+	0x2d, 0x85, 0x38, 0x16, 0xba, 0xa8, 0x76, 0xb4, 0x14, 0xed, 0xa0, 0x30, 0x58, 0x81, 0x6a, 0xac,
+	0x43, 0x78, 0xa9, 0xdb, 0x4b, 0xf6, 0x85, 0x56, 0xaa, 0x0b, 0x54, 0x2b, 0x08, 0x18, 0x58, 0xf6,
+	0x73, 0x64, 0x84, 0xe7, 0xb9, 0x11, 0x16, 0x3d, 0xb1, 0x31, 0xf0, 0x0c, 0x00, 0x02, 0xe4, 0xec,
+	0x10, 0xe3, 0x28, 0x89, 0x56, 0x38, 0x33, 0x59, 0x6b, 0xd6, 0x0a, 0x67, 0xe6, 0x76, 0x85, 0x14,
+	0x26, 0x6e, 0x54, 0x68, 0x8e, 0xcd, 0x6e, 0x54, 0x68, 0xb3, 0x05, 0x06, 0x71, 0xfe, 0x66, 0x45,
+	0xb0, 0xe2, 0x47, 0x43, 0xed, 0x77, 0x6b, 0x1d, 0xd1, 0xef, 0xf6, 0xa3, 0x84, 0xb4, 0xc2, 0x6e,
+	0xcf, 0x8d, 0x68, 0x7b, 0x33, 0x2c, 0xe7, 0x84, 0xbd, 0xa4, 0xe8, 0xe9, 0x59, 0xd5, 0x6d, 0x60,
+	0xf0, 0x4b, 0xc9, 0xf3, 0xea, 0xa1, 0xf2, 0x3c, 0x25, 0xda, 0x6a, 0x07, 0x8b, 0x36, 0xe7, 0x4b,
+	0x16, 0x49, 0xa9, 0xfa, 0x58, 0x5f, 0x0f, 0x87, 0xbb, 0x2f, 0x76, 0xdf, 0xf5, 0xf2, 0xce, 0x15,
+	0xec, 0x73, 0x13, 0x95, 0xbe, 0xf0, 0x5f, 0xe0, 0x8c, 0x6c, 0x5f, 0xf8, 0x18, 0x97, 0x72, 0xe2,
+	0x35, 0x19, 0xa2, 0x97, 0x32, 0x77, 0xd5, 0xd3, 0xfe, 0xca, 0xce, 0x4b, 0x64, 0xd6, 0xc4, 0x61,
+	0x23, 0xc1, 0xaf, 0x87, 0x8b, 0xb3, 0xcc, 0xd7, 0xc3, 0xd2, 0xcd, 0x00, 0x87, 0xa1, 0x3b, 0xf0,
+	0x4c, 0x96, 0x3c, 0x5e, 0xd7, 0xcf, 0xc6, 0x59, 0x7a, 0x27, 0x35, 0x77, 0x2a, 0x96, 0x28, 0x07,
+	0x82, 0xfc, 0x20, 0x9c, 0xff, 0x52, 0xe5, 0x8b, 0xff, 0x96, 0x17, 0xb4, 0xc3, 0x3b, 0x4a, 0xac,
+	0x5b, 0x03, 0xc5, 0x3a, 0x6e, 0x0f, 0xad, 0x1d, 0xda, 0xee, 0xfb, 0xb9, 0x24, 0x38, 0x4d, 0xd1,
+	0x0e, 0x0a, 0x03, 0xb1, 0xdb, 0x7d, 0x61, 0xac, 0xc8, 0x2c, 0xca, 0x65, 0xd1, 0x0e, 0x0a, 0x03,
+	0x7d, 0x06, 0x8d, 0x87, 0x94, 0xeb, 0x92, 0x9d, 0x34, 0x0d, 0xb5, 0x2d, 0x86, 0x14, 0x16, 0xde,
+	0xae, 0x28, 0x45, 0x5b, 0xaa, 0x69, 0xec, 0x76, 0x45, 0x49, 0x99, 0x18, 0x0c, 0x0c, 0x96, 0x61,
+	0xc7, 0xef, 0xc7, 0xcc, 0x7d, 0x60, 0x44, 0x17, 0xb9, 0x59, 0x12, 0x6d, 0xa0, 0xa0, 0xb8, 0xb9,
+	0x75, 0xdd, 0xa0, 0xef, 0xfa, 0x38, 0x43, 0xc2, 0x5e, 0xaa, 0x3e, 0xc3, 0x35, 0x05, 0x01, 0x03,
+	0x0b, 0x9f, 0x38, 0xf1, 0xba, 0xf4, 0xfd, 0x61, 0x20, 0x63, 0x40, 0xb4, 0x47, 0x89, 0x68, 0x07,
+	0x85, 0x61, 0xbf, 0x84, 0xb5, 0x84, 0xdb, 0xfc, 0x54, 0x10, 0x46, 0xe2, 0x62, 0x5a, 0xc9, 0x3c,
+	0x4c, 0xbd, 0xa4, 0xa1, 0x60, 0xa2, 0x66, 0x2b, 0xfc, 0x90, 0x21, 0xcb, 0x8f, 0xfe, 0xa9, 0x45,
+	0xa6, 0x75, 0xca, 0x34, 0x66, 0x56, 0x4d, 0xd9, 0x93, 0xad, 0x43, 0xed, 0xc9, 0xe9, 0xcc, 0x49,
+	0x95, 0xa1, 0x32, 0x27, 0x99, 0x49, 0x8d, 0xaa, 0x07, 0x26, 0x35, 0xfa, 0x72, 0x32, 0xba, 0x4b,
+	0xf7, 0x8d, 0xec, 0x47, 0x4c, 0x38, 0x5c, 0xe3, 0x4d, 0x20, 0x61, 0xa8, 0x35, 0xb6, 0x5c, 0x95,
+	0x41, 0x75, 0x52, 0x38, 0x24, 0x2e, 0x30, 0x24, 0x01, 0x71, 0xd6, 0xc9, 0xb8, 0xf2, 0xe4, 0x90,
+	0xe6, 0x5d, 0xab, 0xd8, 0xbc, 0x3b, 0x54, 0xf2, 0x10, 0xe7, 0xf3, 0x35, 0x32, 0x9b, 0x93, 0xc0,
+	0x43, 0x64, 0x26, 0x79, 0x95, 0xd4, 0x76, 0x30, 0x8f, 0x15, 0xdf, 0xbb, 0x8e, 0x69, 0x04, 0xb9,
+	0xba, 0xb9, 0xb9, 0x91, 0xd7, 0x10, 0xf8, 0x06, 0xb6, 0xb9, 0xb9, 0x01, 0x8c, 0x15, 0xb2, 0xec,
+	0x44, 0xbd, 0x56, 0xa3, 0x5a, 0x06, 0xcb, 0x2b, 0xb0, 0xb1, 0x34, 0x80, 0x25, 0x82, 0x80, 0xb1,
+	0xb2, 0xbb, 0xa4, 0xea, 0xf7, 0xa5, 0x7a, 0x79, 0xcc, 0xeb, 0xec, 0xd5, 0xbe, 0x9b, 0x67, 0x38,
+	0x8a, 0x6f, 0x0c, 0x03, 0x41, 0x91, 0x0f, 0x7a, 0x13, 0xe0, 0x57, 0x14, 0xf6, 0x13, 0x79, 0x3f,
+	0x2a, 0x8f, 0x71, 0x9b, 0xbc, 0x19, 0x24, 0x1c, 0xd7, 0x54, 0x44, 0x93, 0xc8, 0xa3, 0xb2, 0x68,
+	0x0d, 0x5b, 0x53, 0xc0, 0x9b, 0x40, 0xc2, 0xf0, 0x76, 0x0c, 0xff, 0xdd, 0x5f, 0x09, 0x12, 0x1a,
+	0xed, 0xb9, 0x7e, 0x63, 0x34, 0x7d, 0x3b, 0x06, 0x26, 0x10, 0xd2, 0xb8, 0x8b, 0x5b, 0xbf, 0xf2,
+	0xc5, 0x67, 0xdf, 0xf4, 0x5b, 0x5f, 0x7c, 0xf6, 0x4d, 0xbf, 0xff, 0xc5, 0x67, 0xdf, 0xf4, 0xf1,
+	0xfb, 0xcf, 0x5a, 0xbf, 0x72, 0xff, 0x59, 0xeb, 0xb7, 0xee, 0x3f, 0x6b, 0xfd, 0xfe, 0xfd, 0x67,
+	0xad, 0x3f, 0xbe, 0xff, 0xac, 0xf5, 0xbd, 0xff, 0xfe, 0xd9, 0x37, 0xbd, 0xbf, 0x30, 0x22, 0x0d,
+	0xff, 0x79, 0xa1, 0xd5, 0xbe, 0xb8, 0xf7, 0x76, 0x16, 0x14, 0x85, 0xb3, 0x72, 0xd1, 0x98, 0x95,
+	0x8b, 0x72, 0x56, 0xfe, 0xdf, 0x00, 0x6a, 0xb9, 0xaf, 0xaf, 0x7d, 0x21, 0x01, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -5727,6 +6343,92 @@ func (m *AWSAuthConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AWSOrganizationsGenerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AWSOrganizationsGenerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AWSOrganizationsGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		keysForValues := make([]string, 0, len(m.Values))
+		for k := range m.Values {
+			keysForValues = append(keysForValues, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+		for iNdEx := len(keysForValues) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Values[string(keysForValues[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForValues[iNdEx])
+			copy(dAtA[i:], keysForValues[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.RequeueAfterSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RequeueAfterSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Role)
+	copy(dAtA[i:], m.Role)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Role)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.TagFilters) > 0 {
+		for iNdEx := len(m.TagFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TagFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AppHealthStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5892,6 +6594,118 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NotificationAllowlist != nil {
+		{
+			size, err := m.NotificationAllowlist.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.MetadataPropagationPolicy != nil {
+		{
+			size, err := m.MetadataPropagationPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.ImageRegistryRewriteRules) > 0 {
+		for iNdEx := len(m.ImageRegistryRewriteRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ImageRegistryRewriteRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.SyncOptionsPolicies) > 0 {
+		for iNdEx := len(m.SyncOptionsPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SyncOptionsPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.HelmPostRenderers) > 0 {
+		for iNdEx := len(m.HelmPostRenderers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HelmPostRenderers[iNdEx])
+			copy(dAtA[i:], m.HelmPostRenderers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.HelmPostRenderers[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ApplicationSetTargetProjects) > 0 {
+		for iNdEx := len(m.ApplicationSetTargetProjects) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApplicationSetTargetProjects[iNdEx])
+			copy(dAtA[i:], m.ApplicationSetTargetProjects[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ApplicationSetTargetProjects[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	i -= len(m.SourceRepoExpression)
+	copy(dAtA[i:], m.SourceRepoExpression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SourceRepoExpression)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	i -= len(m.DestinationExpression)
+	copy(dAtA[i:], m.DestinationExpression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DestinationExpression)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.AllowedLinkURLs) > 0 {
+		for iNdEx := len(m.AllowedLinkURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedLinkURLs[iNdEx])
+			copy(dAtA[i:], m.AllowedLinkURLs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AllowedLinkURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	i -= len(m.MaxRefreshInterval)
+	copy(dAtA[i:], m.MaxRefreshInterval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxRefreshInterval)))
+	i--
+	dAtA[i] = 0x7a
 	if len(m.DestinationServiceAccounts) > 0 {
 		for iNdEx := len(m.DestinationServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6302,6 +7116,63 @@ func (m *ApplicationDestinationServiceAccount) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Until != nil {
+		{
+			size, err := m.Until.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.At != nil {
+		{
+			size, err := m.At.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.By)
+	copy(dAtA[i:], m.By)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.By)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6624,6 +7495,18 @@ func (m *ApplicationSetGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.AWSOrganizations != nil {
+		{
+			size, err := m.AWSOrganizations.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -6814,6 +7697,18 @@ func (m *ApplicationSetNestedGenerator) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.AWSOrganizations != nil {
+		{
+			size, err := m.AWSOrganizations.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -6937,6 +7832,54 @@ func (m *ApplicationSetNestedGenerator) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Required {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if m.Default != nil {
+		i -= len(*m.Default)
+		copy(dAtA[i:], *m.Default)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Default)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6957,6 +7900,20 @@ func (m *ApplicationSetResourceIgnoreDifferences) MarshalToSizedBuffer(dAtA []by
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.MergeMode)
+	copy(dAtA[i:], m.MergeMode)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MergeMode)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.JSONPathExpressions) > 0 {
+		for iNdEx := len(m.JSONPathExpressions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JSONPathExpressions[iNdEx])
+			copy(dAtA[i:], m.JSONPathExpressions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPathExpressions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.JQPathExpressions) > 0 {
 		for iNdEx := len(m.JQPathExpressions) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.JQPathExpressions[iNdEx])
@@ -7089,6 +8046,25 @@ func (m *ApplicationSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Project)
+	copy(dAtA[i:], m.Project)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Project)))
+	i--
+	dAtA[i] = 0x62
+	if len(m.ParamsSchema) > 0 {
+		for iNdEx := len(m.ParamsSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.TemplatePatch != nil {
 		i -= len(*m.TemplatePatch)
 		copy(dAtA[i:], *m.TemplatePatch)
@@ -7494,6 +8470,18 @@ func (m *ApplicationSetTerminalGenerator) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.AWSOrganizations != nil {
+		{
+			size, err := m.AWSOrganizations.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Selector != nil {
 		{
 			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
@@ -7650,6 +8638,15 @@ func (m *ApplicationSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WatchPaths) > 0 {
+		for iNdEx := len(m.WatchPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WatchPaths[iNdEx])
+			copy(dAtA[i:], m.WatchPaths[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.WatchPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
@@ -7802,6 +8799,22 @@ func (m *ApplicationSourceHelm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PostRenderers) > 0 {
+		for iNdEx := len(m.PostRenderers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostRenderers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	i--
 	if m.SkipSchemaValidation {
 		dAtA[i] = 1
@@ -8328,6 +9341,28 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Freeze != nil {
+		{
+			size, err := m.Freeze.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	i -= len(m.DestinationServiceAccount)
+	copy(dAtA[i:], m.DestinationServiceAccount)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DestinationServiceAccount)))
+	i--
+	dAtA[i] = 0x5a
+	i -= len(m.RefreshInterval)
+	copy(dAtA[i:], m.RefreshInterval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RefreshInterval)))
+	i--
+	dAtA[i] = 0x52
 	if m.SourceHydrator != nil {
 		{
 			size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
@@ -9212,6 +10247,41 @@ func (m *ClusterConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterConnectivityInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterConnectivityInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterConnectivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.FailedRequestsCount))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RequestsCount))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ListLatencyMs))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.WatchDisconnects))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9232,6 +10302,20 @@ func (m *ClusterGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LabelTransforms) > 0 {
+		for iNdEx := len(m.LabelTransforms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LabelTransforms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	i--
 	if m.FlatList {
 		dAtA[i] = 1
@@ -9307,6 +10391,16 @@ func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ConnectivityInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.APIVersions) > 0 {
 		for iNdEx := len(m.APIVersions) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.APIVersions[iNdEx])
@@ -9347,6 +10441,49 @@ func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterLabelTransform) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterLabelTransform) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterLabelTransform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TargetLabel)
+	copy(dAtA[i:], m.TargetLabel)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetLabel)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Replacement)
+	copy(dAtA[i:], m.Replacement)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Replacement)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Regex)
+	copy(dAtA[i:], m.Regex)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Regex)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Label)
+	copy(dAtA[i:], m.Label)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Label)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9708,6 +10845,12 @@ func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ConsecutiveFailures))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintGenerated(dAtA, i, uint64(m.LatencyMs))
+	i--
+	dAtA[i] = 0x20
 	if m.ModifiedAt != nil {
 		{
 			size, err := m.ModifiedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -9980,6 +11123,47 @@ func (m *ExecProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GRPCVerificationProbe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GRPCVerificationProbe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GRPCVerificationProbe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.TLS {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GitDirectoryGeneratorItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10257,6 +11441,92 @@ func (m *GnuPGPublicKeyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GroupExpiration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupExpiration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HTTPVerificationProbe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPVerificationProbe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPVerificationProbe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.InsecureSkipVerify {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if len(m.ExpectedStatus) > 0 {
+		for iNdEx := len(m.ExpectedStatus) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.ExpectedStatus[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HealthStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10408,6 +11678,68 @@ func (m *HelmParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HelmPostRenderer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmPostRenderer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmPostRenderer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		keysForValues := make([]string, 0, len(m.Values))
+		for k := range m.Values {
+			keysForValues = append(keysForValues, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+		for iNdEx := len(keysForValues) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Values[string(keysForValues[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForValues[iNdEx])
+			copy(dAtA[i:], keysForValues[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Transformer)
+	copy(dAtA[i:], m.Transformer)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Transformer)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Plugin)
+	copy(dAtA[i:], m.Plugin)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Plugin)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kustomize)
+	copy(dAtA[i:], m.Kustomize)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kustomize)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HostInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10624,6 +11956,48 @@ func (m *HydrateTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ImageRegistryRewriteRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageRegistryRewriteRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageRegistryRewriteRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Clusters[iNdEx])
+			copy(dAtA[i:], m.Clusters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Clusters[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.To)
+	copy(dAtA[i:], m.To)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.To)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.From)
+	copy(dAtA[i:], m.From)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.From)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Info) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11154,6 +12528,54 @@ func (m *ListGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LuaVerificationProbe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LuaVerificationProbe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LuaVerificationProbe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Script)
+	copy(dAtA[i:], m.Script)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Script)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.APIVersion)
+	copy(dAtA[i:], m.APIVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIVersion)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ManagedNamespaceMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11174,6 +12596,25 @@ func (m *ManagedNamespaceMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.IgnoredKeys) > 0 {
+		for iNdEx := len(m.IgnoredKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IgnoredKeys[iNdEx])
+			copy(dAtA[i:], m.IgnoredKeys[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.IgnoredKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.AnnotationsPolicy)
+	copy(dAtA[i:], m.AnnotationsPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AnnotationsPolicy)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.LabelsPolicy)
+	copy(dAtA[i:], m.LabelsPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelsPolicy)))
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -11292,6 +12733,20 @@ func (m *MergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Overlays) > 0 {
+		for iNdEx := len(m.Overlays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overlays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -11328,6 +12783,98 @@ func (m *MergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MergeGeneratorOverlay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeGeneratorOverlay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeGeneratorOverlay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Defaults != nil {
+		{
+			size, err := m.Defaults.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i--
+	if m.PassThrough {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *MetadataPropagationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataPropagationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataPropagationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.PodTemplates {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if len(m.Annotations) > 0 {
+		for iNdEx := len(m.Annotations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Annotations[iNdEx])
+			copy(dAtA[i:], m.Annotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Annotations[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NestedMatrixGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11385,6 +12932,20 @@ func (m *NestedMergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Overlays) > 0 {
+		for iNdEx := len(m.Overlays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overlays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.MergeKeys) > 0 {
 		for iNdEx := len(m.MergeKeys) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.MergeKeys[iNdEx])
@@ -11411,6 +12972,47 @@ func (m *NestedMergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NotificationAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Triggers) > 0 {
+		for iNdEx := len(m.Triggers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Triggers[iNdEx])
+			copy(dAtA[i:], m.Triggers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Triggers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Services[iNdEx])
+			copy(dAtA[i:], m.Services[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Services[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OCIMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11594,6 +13196,9 @@ func (m *OperationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.QueuePosition))
+	i--
+	dAtA[i] = 0x48
 	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryCount))
 	i--
 	dAtA[i] = 0x40
@@ -12050,6 +13655,20 @@ func (m *ProjectRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupExpirations) > 0 {
+		for iNdEx := len(m.GroupExpirations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupExpirations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Groups) > 0 {
 		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Groups[iNdEx])
@@ -13305,6 +14924,14 @@ func (m *ResourceAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.RequiresConfirmation {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
 	i -= len(m.DisplayName)
 	copy(dAtA[i:], m.DisplayName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
@@ -13365,6 +14992,28 @@ func (m *ResourceActionDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.RequiresConfirmation {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	i -= len(m.ActionLua)
 	copy(dAtA[i:], m.ActionLua)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ActionLua)))
@@ -14305,6 +15954,27 @@ func (m *RevisionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ChangedFiles) > 0 {
+		for iNdEx := len(m.ChangedFiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedFiles[iNdEx])
+			copy(dAtA[i:], m.ChangedFiles[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChangedFiles[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.References) > 0 {
 		for iNdEx := len(m.References) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -14358,6 +16028,47 @@ func (m *RevisionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RevisionPullRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionPullRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevisionPullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Author)
+	copy(dAtA[i:], m.Author)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Author)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Title)
+	copy(dAtA[i:], m.Title)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Title)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Number))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *RevisionReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15548,6 +17259,57 @@ func (m *SyncOperationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncOptionsPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncOptionsPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncOptionsPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Forbid) > 0 {
+		for iNdEx := len(m.Forbid) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Forbid[iNdEx])
+			copy(dAtA[i:], m.Forbid[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Forbid[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Enforce) > 0 {
+		for iNdEx := len(m.Enforce) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Enforce[iNdEx])
+			copy(dAtA[i:], m.Enforce[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Enforce[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15568,6 +17330,34 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VerificationProbes) > 0 {
+		for iNdEx := len(m.VerificationProbes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerificationProbes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ServerSideApplyIgnoredConflicts) > 0 {
+		for iNdEx := len(m.ServerSideApplyIgnoredConflicts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ServerSideApplyIgnoredConflicts[iNdEx])
+			copy(dAtA[i:], m.ServerSideApplyIgnoredConflicts[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerSideApplyIgnoredConflicts[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.ConcurrencyKey)
+	copy(dAtA[i:], m.ConcurrencyKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConcurrencyKey)))
+	i--
+	dAtA[i] = 0x2a
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -16050,6 +17840,85 @@ func (m *TagFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VerificationProbe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationProbe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationProbe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.RetryInterval)
+	copy(dAtA[i:], m.RetryInterval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RetryInterval)))
+	i--
+	dAtA[i] = 0x3a
+	if m.Retries != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Retries))
+		i--
+		dAtA[i] = 0x30
+	}
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x2a
+	if m.Lua != nil {
+		{
+			size, err := m.Lua.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GRPC != nil {
+		{
+			size, err := m.GRPC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenerated(v)
 	base := offset
@@ -16076,6 +17945,38 @@ func (m *AWSAuthConfig) Size() (n int) {
 	return n
 }
 
+func (m *AWSOrganizationsGenerator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TagFilters) > 0 {
+		for _, e := range m.TagFilters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Role)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RequeueAfterSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.RequeueAfterSeconds))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *AppHealthStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16204,6 +18105,50 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.MaxRefreshInterval)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.AllowedLinkURLs) > 0 {
+		for _, s := range m.AllowedLinkURLs {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.DestinationExpression)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.SourceRepoExpression)
+	n += 2 + l + sovGenerated(uint64(l))
+	if len(m.ApplicationSetTargetProjects) > 0 {
+		for _, s := range m.ApplicationSetTargetProjects {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.HelmPostRenderers) > 0 {
+		for _, s := range m.HelmPostRenderers {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.SyncOptionsPolicies) > 0 {
+		for _, e := range m.SyncOptionsPolicies {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ImageRegistryRewriteRules) > 0 {
+		for _, e := range m.ImageRegistryRewriteRules {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.MetadataPropagationPolicy != nil {
+		l = m.MetadataPropagationPolicy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.NotificationAllowlist != nil {
+		l = m.NotificationAllowlist.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16291,6 +18236,27 @@ func (m *ApplicationDestinationServiceAccount) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.By)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.At != nil {
+		l = m.At.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Until != nil {
+		l = m.Until.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16457,6 +18423,10 @@ func (m *ApplicationSetGenerator) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.AWSOrganizations != nil {
+		l = m.AWSOrganizations.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16523,6 +18493,28 @@ func (m *ApplicationSetNestedGenerator) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.AWSOrganizations != nil {
+		l = m.AWSOrganizations.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSetParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Default != nil {
+		l = len(*m.Default)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
 	return n
 }
 
@@ -16546,6 +18538,14 @@ func (m *ApplicationSetResourceIgnoreDifferences) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.JSONPathExpressions) > 0 {
+		for _, s := range m.JSONPathExpressions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.MergeMode)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16627,6 +18627,14 @@ func (m *ApplicationSetSpec) Size() (n int) {
 		l = len(*m.TemplatePatch)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.ParamsSchema) > 0 {
+		for _, e := range m.ParamsSchema {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Project)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16772,6 +18780,10 @@ func (m *ApplicationSetTerminalGenerator) Size() (n int) {
 		l = m.Selector.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.AWSOrganizations != nil {
+		l = m.AWSOrganizations.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16824,6 +18836,12 @@ func (m *ApplicationSource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.WatchPaths) > 0 {
+		for _, s := range m.WatchPaths {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16892,6 +18910,12 @@ func (m *ApplicationSourceHelm) Size() (n int) {
 	}
 	n += 2
 	n += 2
+	if len(m.PostRenderers) > 0 {
+		for _, e := range m.PostRenderers {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17082,6 +19106,14 @@ func (m *ApplicationSpec) Size() (n int) {
 		l = m.SourceHydrator.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.RefreshInterval)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DestinationServiceAccount)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Freeze != nil {
+		l = m.Freeze.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17378,6 +19410,19 @@ func (m *ClusterConfig) Size() (n int) {
 	return n
 }
 
+func (m *ClusterConnectivityInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.WatchDisconnects))
+	n += 1 + sovGenerated(uint64(m.ListLatencyMs))
+	n += 1 + sovGenerated(uint64(m.RequestsCount))
+	n += 1 + sovGenerated(uint64(m.FailedRequestsCount))
+	return n
+}
+
 func (m *ClusterGenerator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17397,6 +19442,12 @@ func (m *ClusterGenerator) Size() (n int) {
 		}
 	}
 	n += 2
+	if len(m.LabelTransforms) > 0 {
+		for _, e := range m.LabelTransforms {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17419,6 +19470,25 @@ func (m *ClusterInfo) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = m.ConnectivityInfo.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ClusterLabelTransform) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Label)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Regex)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Replacement)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TargetLabel)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17566,6 +19636,8 @@ func (m *ConnectionState) Size() (n int) {
 		l = m.ModifiedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 1 + sovGenerated(uint64(m.LatencyMs))
+	n += 1 + sovGenerated(uint64(m.ConsecutiveFailures))
 	return n
 }
 
@@ -17663,6 +19735,20 @@ func (m *ExecProviderConfig) Size() (n int) {
 	return n
 }
 
+func (m *GRPCVerificationProbe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Service)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
 func (m *GitDirectoryGeneratorItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17765,6 +19851,38 @@ func (m *GnuPGPublicKeyList) Size() (n int) {
 	return n
 }
 
+func (m *GroupExpiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ExpiresAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *HTTPVerificationProbe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ExpectedStatus) > 0 {
+		for _, e := range m.ExpectedStatus {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	n += 2
+	return n
+}
+
 func (m *HealthStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17824,6 +19942,29 @@ func (m *HelmParameter) Size() (n int) {
 	return n
 }
 
+func (m *HelmPostRenderer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kustomize)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Plugin)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Transformer)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *HostInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17901,6 +20042,25 @@ func (m *HydrateTo) Size() (n int) {
 	return n
 }
 
+func (m *ImageRegistryRewriteRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.To)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Info) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18097,6 +20257,25 @@ func (m *ListGenerator) Size() (n int) {
 	return n
 }
 
+func (m *LuaVerificationProbe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.APIVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Script)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ManagedNamespaceMetadata) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18119,6 +20298,16 @@ func (m *ManagedNamespaceMetadata) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.LabelsPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AnnotationsPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.IgnoredKeys) > 0 {
+		for _, s := range m.IgnoredKeys {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18159,6 +20348,48 @@ func (m *MergeGenerator) Size() (n int) {
 	}
 	l = m.Template.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Overlays) > 0 {
+		for _, e := range m.Overlays {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MergeGeneratorOverlay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	if m.Defaults != nil {
+		l = m.Defaults.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MetadataPropagationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for _, s := range m.Annotations {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
 	return n
 }
 
@@ -18195,6 +20426,33 @@ func (m *NestedMergeGenerator) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Overlays) > 0 {
+		for _, e := range m.Overlays {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NotificationAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, s := range m.Services {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Triggers) > 0 {
+		for _, s := range m.Triggers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18279,6 +20537,7 @@ func (m *OperationState) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 1 + sovGenerated(uint64(m.RetryCount))
+	n += 1 + sovGenerated(uint64(m.QueuePosition))
 	return n
 }
 
@@ -18457,6 +20716,12 @@ func (m *ProjectRole) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.GroupExpirations) > 0 {
+		for _, e := range m.GroupExpirations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18893,6 +21158,7 @@ func (m *ResourceAction) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.DisplayName)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -18906,6 +21172,13 @@ func (m *ResourceActionDefinition) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.ActionLua)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
 	return n
 }
 
@@ -19281,6 +21554,32 @@ func (m *RevisionMetadata) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.ChangedFiles) > 0 {
+		for _, s := range m.ChangedFiles {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *RevisionPullRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Number))
+	l = len(m.Title)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Author)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19718,6 +22017,31 @@ func (m *SyncOperationResult) Size() (n int) {
 	return n
 }
 
+func (m *SyncOptionsPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Enforce) > 0 {
+		for _, s := range m.Enforce {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Forbid) > 0 {
+		for _, s := range m.Forbid {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SyncPolicy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19742,6 +22066,20 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.ConcurrencyKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ServerSideApplyIgnoredConflicts) > 0 {
+		for _, s := range m.ServerSideApplyIgnoredConflicts {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.VerificationProbes) > 0 {
+		for _, e := range m.VerificationProbes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19908,6 +22246,36 @@ func (m *TagFilter) Size() (n int) {
 	return n
 }
 
+func (m *VerificationProbe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.GRPC != nil {
+		l = m.GRPC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Lua != nil {
+		l = m.Lua.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Retries != nil {
+		n += 1 + sovGenerated(uint64(*m.Retries))
+	}
+	l = len(m.RetryInterval)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func sovGenerated(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -19926,6 +22294,36 @@ func (this *AWSAuthConfig) String() string {
 	}, "")
 	return s
 }
+func (this *AWSOrganizationsGenerator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTagFilters := "[]*TagFilter{"
+	for _, f := range this.TagFilters {
+		repeatedStringForTagFilters += strings.Replace(f.String(), "TagFilter", "TagFilter", 1) + ","
+	}
+	repeatedStringForTagFilters += "}"
+	keysForValues := make([]string, 0, len(this.Values))
+	for k := range this.Values {
+		keysForValues = append(keysForValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+	mapStringForValues := "map[string]string{"
+	for _, k := range keysForValues {
+		mapStringForValues += fmt.Sprintf("%v: %v,", k, this.Values[k])
+	}
+	mapStringForValues += "}"
+	s := strings.Join([]string{`&AWSOrganizationsGenerator{`,
+		`TagFilters:` + repeatedStringForTagFilters + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`RequeueAfterSeconds:` + valueToStringGenerated(this.RequeueAfterSeconds) + `,`,
+		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
+		`Values:` + mapStringForValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *AppHealthStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -20015,6 +22413,16 @@ func (this *AppProjectSpec) String() string {
 		repeatedStringForDestinationServiceAccounts += strings.Replace(strings.Replace(f.String(), "ApplicationDestinationServiceAccount", "ApplicationDestinationServiceAccount", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForDestinationServiceAccounts += "}"
+	repeatedStringForSyncOptionsPolicies := "[]SyncOptionsPolicy{"
+	for _, f := range this.SyncOptionsPolicies {
+		repeatedStringForSyncOptionsPolicies += strings.Replace(strings.Replace(f.String(), "SyncOptionsPolicy", "SyncOptionsPolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSyncOptionsPolicies += "}"
+	repeatedStringForImageRegistryRewriteRules := "[]ImageRegistryRewriteRule{"
+	for _, f := range this.ImageRegistryRewriteRules {
+		repeatedStringForImageRegistryRewriteRules += strings.Replace(strings.Replace(f.String(), "ImageRegistryRewriteRule", "ImageRegistryRewriteRule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImageRegistryRewriteRules += "}"
 	s := strings.Join([]string{`&AppProjectSpec{`,
 		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
 		`Destinations:` + repeatedStringForDestinations + `,`,
@@ -20030,6 +22438,16 @@ func (this *AppProjectSpec) String() string {
 		`SourceNamespaces:` + fmt.Sprintf("%v", this.SourceNamespaces) + `,`,
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
 		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
+		`MaxRefreshInterval:` + fmt.Sprintf("%v", this.MaxRefreshInterval) + `,`,
+		`AllowedLinkURLs:` + fmt.Sprintf("%v", this.AllowedLinkURLs) + `,`,
+		`DestinationExpression:` + fmt.Sprintf("%v", this.DestinationExpression) + `,`,
+		`SourceRepoExpression:` + fmt.Sprintf("%v", this.SourceRepoExpression) + `,`,
+		`ApplicationSetTargetProjects:` + fmt.Sprintf("%v", this.ApplicationSetTargetProjects) + `,`,
+		`HelmPostRenderers:` + fmt.Sprintf("%v", this.HelmPostRenderers) + `,`,
+		`SyncOptionsPolicies:` + repeatedStringForSyncOptionsPolicies + `,`,
+		`ImageRegistryRewriteRules:` + repeatedStringForImageRegistryRewriteRules + `,`,
+		`MetadataPropagationPolicy:` + strings.Replace(this.MetadataPropagationPolicy.String(), "MetadataPropagationPolicy", "MetadataPropagationPolicy", 1) + `,`,
+		`NotificationAllowlist:` + strings.Replace(this.NotificationAllowlist.String(), "NotificationAllowlist", "NotificationAllowlist", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20103,6 +22521,19 @@ func (this *ApplicationDestinationServiceAccount) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationFreeze) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationFreeze{`,
+		`By:` + fmt.Sprintf("%v", this.By) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`At:` + strings.Replace(fmt.Sprintf("%v", this.At), "Time", "v1.Time", 1) + `,`,
+		`Until:` + strings.Replace(fmt.Sprintf("%v", this.Until), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationList) String() string {
 	if this == nil {
 		return "nil"
@@ -20198,6 +22629,7 @@ func (this *ApplicationSetGenerator) String() string {
 		`Merge:` + strings.Replace(this.Merge.String(), "MergeGenerator", "MergeGenerator", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
+		`AWSOrganizations:` + strings.Replace(this.AWSOrganizations.String(), "AWSOrganizationsGenerator", "AWSOrganizationsGenerator", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20233,6 +22665,20 @@ func (this *ApplicationSetNestedGenerator) String() string {
 		`Merge:` + strings.Replace(fmt.Sprintf("%v", this.Merge), "JSON", "v11.JSON", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
+		`AWSOrganizations:` + strings.Replace(this.AWSOrganizations.String(), "AWSOrganizationsGenerator", "AWSOrganizationsGenerator", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetParam) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetParam{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Default:` + valueToStringGenerated(this.Default) + `,`,
+		`Required:` + fmt.Sprintf("%v", this.Required) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20245,6 +22691,8 @@ func (this *ApplicationSetResourceIgnoreDifferences) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`JSONPointers:` + fmt.Sprintf("%v", this.JSONPointers) + `,`,
 		`JQPathExpressions:` + fmt.Sprintf("%v", this.JQPathExpressions) + `,`,
+		`JSONPathExpressions:` + fmt.Sprintf("%v", this.JSONPathExpressions) + `,`,
+		`MergeMode:` + fmt.Sprintf("%v", this.MergeMode) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20294,6 +22742,11 @@ func (this *ApplicationSetSpec) String() string {
 		repeatedStringForIgnoreApplicationDifferences += strings.Replace(strings.Replace(f.String(), "ApplicationSetResourceIgnoreDifferences", "ApplicationSetResourceIgnoreDifferences", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForIgnoreApplicationDifferences += "}"
+	repeatedStringForParamsSchema := "[]ApplicationSetParam{"
+	for _, f := range this.ParamsSchema {
+		repeatedStringForParamsSchema += strings.Replace(strings.Replace(f.String(), "ApplicationSetParam", "ApplicationSetParam", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParamsSchema += "}"
 	s := strings.Join([]string{`&ApplicationSetSpec{`,
 		`GoTemplate:` + fmt.Sprintf("%v", this.GoTemplate) + `,`,
 		`Generators:` + repeatedStringForGenerators + `,`,
@@ -20305,6 +22758,8 @@ func (this *ApplicationSetSpec) String() string {
 		`ApplyNestedSelectors:` + fmt.Sprintf("%v", this.ApplyNestedSelectors) + `,`,
 		`IgnoreApplicationDifferences:` + repeatedStringForIgnoreApplicationDifferences + `,`,
 		`TemplatePatch:` + valueToStringGenerated(this.TemplatePatch) + `,`,
+		`ParamsSchema:` + repeatedStringForParamsSchema + `,`,
+		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20416,6 +22871,7 @@ func (this *ApplicationSetTerminalGenerator) String() string {
 		`PullRequest:` + strings.Replace(this.PullRequest.String(), "PullRequestGenerator", "PullRequestGenerator", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`AWSOrganizations:` + strings.Replace(this.AWSOrganizations.String(), "AWSOrganizationsGenerator", "AWSOrganizationsGenerator", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20450,6 +22906,7 @@ func (this *ApplicationSource) String() string {
 		`Chart:` + fmt.Sprintf("%v", this.Chart) + `,`,
 		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`WatchPaths:` + fmt.Sprintf("%v", this.WatchPaths) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20481,6 +22938,11 @@ func (this *ApplicationSourceHelm) String() string {
 		repeatedStringForFileParameters += strings.Replace(strings.Replace(f.String(), "HelmFileParameter", "HelmFileParameter", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForFileParameters += "}"
+	repeatedStringForPostRenderers := "[]HelmPostRenderer{"
+	for _, f := range this.PostRenderers {
+		repeatedStringForPostRenderers += strings.Replace(strings.Replace(f.String(), "HelmPostRenderer", "HelmPostRenderer", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPostRenderers += "}"
 	s := strings.Join([]string{`&ApplicationSourceHelm{`,
 		`ValueFiles:` + fmt.Sprintf("%v", this.ValueFiles) + `,`,
 		`Parameters:` + repeatedStringForParameters + `,`,
@@ -20497,6 +22959,7 @@ func (this *ApplicationSourceHelm) String() string {
 		`APIVersions:` + fmt.Sprintf("%v", this.APIVersions) + `,`,
 		`SkipTests:` + fmt.Sprintf("%v", this.SkipTests) + `,`,
 		`SkipSchemaValidation:` + fmt.Sprintf("%v", this.SkipSchemaValidation) + `,`,
+		`PostRenderers:` + repeatedStringForPostRenderers + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20644,6 +23107,9 @@ func (this *ApplicationSpec) String() string {
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
 		`SourceHydrator:` + strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1) + `,`,
+		`RefreshInterval:` + fmt.Sprintf("%v", this.RefreshInterval) + `,`,
+		`DestinationServiceAccount:` + fmt.Sprintf("%v", this.DestinationServiceAccount) + `,`,
+		`Freeze:` + strings.Replace(this.Freeze.String(), "ApplicationFreeze", "ApplicationFreeze", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20862,10 +23328,28 @@ func (this *ClusterConfig) String() string {
 	}, "")
 	return s
 }
+func (this *ClusterConnectivityInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterConnectivityInfo{`,
+		`WatchDisconnects:` + fmt.Sprintf("%v", this.WatchDisconnects) + `,`,
+		`ListLatencyMs:` + fmt.Sprintf("%v", this.ListLatencyMs) + `,`,
+		`RequestsCount:` + fmt.Sprintf("%v", this.RequestsCount) + `,`,
+		`FailedRequestsCount:` + fmt.Sprintf("%v", this.FailedRequestsCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ClusterGenerator) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForLabelTransforms := "[]ClusterLabelTransform{"
+	for _, f := range this.LabelTransforms {
+		repeatedStringForLabelTransforms += strings.Replace(strings.Replace(f.String(), "ClusterLabelTransform", "ClusterLabelTransform", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForLabelTransforms += "}"
 	keysForValues := make([]string, 0, len(this.Values))
 	for k := range this.Values {
 		keysForValues = append(keysForValues, k)
@@ -20881,6 +23365,7 @@ func (this *ClusterGenerator) String() string {
 		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
 		`Values:` + mapStringForValues + `,`,
 		`FlatList:` + fmt.Sprintf("%v", this.FlatList) + `,`,
+		`LabelTransforms:` + repeatedStringForLabelTransforms + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20895,6 +23380,20 @@ func (this *ClusterInfo) String() string {
 		`CacheInfo:` + strings.Replace(strings.Replace(this.CacheInfo.String(), "ClusterCacheInfo", "ClusterCacheInfo", 1), `&`, ``, 1) + `,`,
 		`ApplicationsCount:` + fmt.Sprintf("%v", this.ApplicationsCount) + `,`,
 		`APIVersions:` + fmt.Sprintf("%v", this.APIVersions) + `,`,
+		`ConnectivityInfo:` + strings.Replace(strings.Replace(this.ConnectivityInfo.String(), "ClusterConnectivityInfo", "ClusterConnectivityInfo", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterLabelTransform) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterLabelTransform{`,
+		`Label:` + fmt.Sprintf("%v", this.Label) + `,`,
+		`Regex:` + fmt.Sprintf("%v", this.Regex) + `,`,
+		`Replacement:` + fmt.Sprintf("%v", this.Replacement) + `,`,
+		`TargetLabel:` + fmt.Sprintf("%v", this.TargetLabel) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21008,6 +23507,8 @@ func (this *ConnectionState) String() string {
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`ModifiedAt:` + strings.Replace(fmt.Sprintf("%v", this.ModifiedAt), "Time", "v1.Time", 1) + `,`,
+		`LatencyMs:` + fmt.Sprintf("%v", this.LatencyMs) + `,`,
+		`ConsecutiveFailures:` + fmt.Sprintf("%v", this.ConsecutiveFailures) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21093,6 +23594,18 @@ func (this *ExecProviderConfig) String() string {
 	}, "")
 	return s
 }
+func (this *GRPCVerificationProbe) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GRPCVerificationProbe{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`TLS:` + fmt.Sprintf("%v", this.TLS) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GitDirectoryGeneratorItem) String() string {
 	if this == nil {
 		return "nil"
@@ -21183,6 +23696,30 @@ func (this *GnuPGPublicKeyList) String() string {
 	}, "")
 	return s
 }
+func (this *GroupExpiration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GroupExpiration{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`ExpiresAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPVerificationProbe) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPVerificationProbe{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`ExpectedStatus:` + fmt.Sprintf("%v", this.ExpectedStatus) + `,`,
+		`InsecureSkipVerify:` + fmt.Sprintf("%v", this.InsecureSkipVerify) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HealthStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -21228,6 +23765,29 @@ func (this *HelmParameter) String() string {
 	}, "")
 	return s
 }
+func (this *HelmPostRenderer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForValues := make([]string, 0, len(this.Values))
+	for k := range this.Values {
+		keysForValues = append(keysForValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+	mapStringForValues := "map[string]string{"
+	for _, k := range keysForValues {
+		mapStringForValues += fmt.Sprintf("%v: %v,", k, this.Values[k])
+	}
+	mapStringForValues += "}"
+	s := strings.Join([]string{`&HelmPostRenderer{`,
+		`Kustomize:` + fmt.Sprintf("%v", this.Kustomize) + `,`,
+		`Plugin:` + fmt.Sprintf("%v", this.Plugin) + `,`,
+		`Transformer:` + fmt.Sprintf("%v", this.Transformer) + `,`,
+		`Values:` + mapStringForValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HostInfo) String() string {
 	if this == nil {
 		return "nil"
@@ -21295,6 +23855,18 @@ func (this *HydrateTo) String() string {
 	}, "")
 	return s
 }
+func (this *ImageRegistryRewriteRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageRegistryRewriteRule{`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Info) String() string {
 	if this == nil {
 		return "nil"
@@ -21465,6 +24037,20 @@ func (this *ListGenerator) String() string {
 	}, "")
 	return s
 }
+func (this *LuaVerificationProbe) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LuaVerificationProbe{`,
+		`APIVersion:` + fmt.Sprintf("%v", this.APIVersion) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Script:` + fmt.Sprintf("%v", this.Script) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ManagedNamespaceMetadata) String() string {
 	if this == nil {
 		return "nil"
@@ -21492,6 +24078,9 @@ func (this *ManagedNamespaceMetadata) String() string {
 	s := strings.Join([]string{`&ManagedNamespaceMetadata{`,
 		`Labels:` + mapStringForLabels + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
+		`LabelsPolicy:` + fmt.Sprintf("%v", this.LabelsPolicy) + `,`,
+		`AnnotationsPolicy:` + fmt.Sprintf("%v", this.AnnotationsPolicy) + `,`,
+		`IgnoredKeys:` + fmt.Sprintf("%v", this.IgnoredKeys) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21521,10 +24110,39 @@ func (this *MergeGenerator) String() string {
 		repeatedStringForGenerators += strings.Replace(strings.Replace(f.String(), "ApplicationSetNestedGenerator", "ApplicationSetNestedGenerator", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForGenerators += "}"
+	repeatedStringForOverlays := "[]MergeGeneratorOverlay{"
+	for _, f := range this.Overlays {
+		repeatedStringForOverlays += strings.Replace(strings.Replace(f.String(), "MergeGeneratorOverlay", "MergeGeneratorOverlay", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOverlays += "}"
 	s := strings.Join([]string{`&MergeGenerator{`,
 		`Generators:` + repeatedStringForGenerators + `,`,
 		`MergeKeys:` + fmt.Sprintf("%v", this.MergeKeys) + `,`,
 		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
+		`Overlays:` + repeatedStringForOverlays + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeGeneratorOverlay) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeGeneratorOverlay{`,
+		`PassThrough:` + fmt.Sprintf("%v", this.PassThrough) + `,`,
+		`Defaults:` + strings.Replace(fmt.Sprintf("%v", this.Defaults), "JSON", "v11.JSON", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MetadataPropagationPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MetadataPropagationPolicy{`,
+		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
+		`Annotations:` + fmt.Sprintf("%v", this.Annotations) + `,`,
+		`PodTemplates:` + fmt.Sprintf("%v", this.PodTemplates) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21553,9 +24171,26 @@ func (this *NestedMergeGenerator) String() string {
 		repeatedStringForGenerators += strings.Replace(strings.Replace(f.String(), "ApplicationSetTerminalGenerator", "ApplicationSetTerminalGenerator", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForGenerators += "}"
+	repeatedStringForOverlays := "[]MergeGeneratorOverlay{"
+	for _, f := range this.Overlays {
+		repeatedStringForOverlays += strings.Replace(strings.Replace(f.String(), "MergeGeneratorOverlay", "MergeGeneratorOverlay", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOverlays += "}"
 	s := strings.Join([]string{`&NestedMergeGenerator{`,
 		`Generators:` + repeatedStringForGenerators + `,`,
 		`MergeKeys:` + fmt.Sprintf("%v", this.MergeKeys) + `,`,
+		`Overlays:` + repeatedStringForOverlays + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NotificationAllowlist) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NotificationAllowlist{`,
+		`Services:` + fmt.Sprintf("%v", this.Services) + `,`,
+		`Triggers:` + fmt.Sprintf("%v", this.Triggers) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21617,6 +24252,7 @@ func (this *OperationState) String() string {
 		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
 		`RetryCount:` + fmt.Sprintf("%v", this.RetryCount) + `,`,
+		`QueuePosition:` + fmt.Sprintf("%v", this.QueuePosition) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21754,12 +24390,18 @@ func (this *ProjectRole) String() string {
 		repeatedStringForJWTTokens += strings.Replace(strings.Replace(f.String(), "JWTToken", "JWTToken", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForJWTTokens += "}"
+	repeatedStringForGroupExpirations := "[]GroupExpiration{"
+	for _, f := range this.GroupExpirations {
+		repeatedStringForGroupExpirations += strings.Replace(strings.Replace(f.String(), "GroupExpiration", "GroupExpiration", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForGroupExpirations += "}"
 	s := strings.Join([]string{`&ProjectRole{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`Policies:` + fmt.Sprintf("%v", this.Policies) + `,`,
 		`JWTTokens:` + repeatedStringForJWTTokens + `,`,
 		`Groups:` + fmt.Sprintf("%v", this.Groups) + `,`,
+		`GroupExpirations:` + repeatedStringForGroupExpirations + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22054,6 +24696,7 @@ func (this *ResourceAction) String() string {
 		`Disabled:` + fmt.Sprintf("%v", this.Disabled) + `,`,
 		`IconClass:` + fmt.Sprintf("%v", this.IconClass) + `,`,
 		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`RequiresConfirmation:` + fmt.Sprintf("%v", this.RequiresConfirmation) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22062,9 +24705,16 @@ func (this *ResourceActionDefinition) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForParams := "[]ResourceActionParam{"
+	for _, f := range this.Params {
+		repeatedStringForParams += strings.Replace(strings.Replace(f.String(), "ResourceActionParam", "ResourceActionParam", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParams += "}"
 	s := strings.Join([]string{`&ResourceActionDefinition{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`ActionLua:` + fmt.Sprintf("%v", this.ActionLua) + `,`,
+		`Params:` + repeatedStringForParams + `,`,
+		`RequiresConfirmation:` + fmt.Sprintf("%v", this.RequiresConfirmation) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22331,6 +24981,21 @@ func (this *RevisionMetadata) String() string {
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`SignatureInfo:` + fmt.Sprintf("%v", this.SignatureInfo) + `,`,
 		`References:` + repeatedStringForReferences + `,`,
+		`ChangedFiles:` + fmt.Sprintf("%v", this.ChangedFiles) + `,`,
+		`PullRequest:` + strings.Replace(this.PullRequest.String(), "RevisionPullRequest", "RevisionPullRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevisionPullRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevisionPullRequest{`,
+		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
+		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
+		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22626,15 +25291,36 @@ func (this *SyncOperationResult) String() string {
 	}, "")
 	return s
 }
+func (this *SyncOptionsPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncOptionsPolicy{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Enforce:` + fmt.Sprintf("%v", this.Enforce) + `,`,
+		`Forbid:` + fmt.Sprintf("%v", this.Forbid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncPolicy) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForVerificationProbes := "[]VerificationProbe{"
+	for _, f := range this.VerificationProbes {
+		repeatedStringForVerificationProbes += strings.Replace(strings.Replace(f.String(), "VerificationProbe", "VerificationProbe", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVerificationProbes += "}"
 	s := strings.Join([]string{`&SyncPolicy{`,
 		`Automated:` + strings.Replace(this.Automated.String(), "SyncPolicyAutomated", "SyncPolicyAutomated", 1) + `,`,
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
 		`Retry:` + strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`ConcurrencyKey:` + fmt.Sprintf("%v", this.ConcurrencyKey) + `,`,
+		`ServerSideApplyIgnoredConflicts:` + fmt.Sprintf("%v", this.ServerSideApplyIgnoredConflicts) + `,`,
+		`VerificationProbes:` + repeatedStringForVerificationProbes + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22751,6 +25437,22 @@ func (this *TagFilter) String() string {
 	}, "")
 	return s
 }
+func (this *VerificationProbe) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerificationProbe{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPVerificationProbe", "HTTPVerificationProbe", 1) + `,`,
+		`GRPC:` + strings.Replace(this.GRPC.String(), "GRPCVerificationProbe", "GRPCVerificationProbe", 1) + `,`,
+		`Lua:` + strings.Replace(this.Lua.String(), "LuaVerificationProbe", "LuaVerificationProbe", 1) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`Retries:` + valueToStringGenerated(this.Retries) + `,`,
+		`RetryInterval:` + fmt.Sprintf("%v", this.RetryInterval) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGenerated(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -22905,7 +25607,7 @@ func (m *AWSAuthConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppHealthStatus) Unmarshal(dAtA []byte) error {
+func (m *AWSOrganizationsGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22928,15 +25630,343 @@ func (m *AppHealthStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppHealthStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: AWSOrganizationsGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppHealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AWSOrganizationsGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TagFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagFilters = append(m.TagFilters, &TagFilter{})
+			if err := m.TagFilters[len(m.TagFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppHealthStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppHealthStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppHealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23808,6 +26838,338 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRefreshInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxRefreshInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLinkURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedLinkURLs = append(m.AllowedLinkURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRepoExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRepoExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetTargetProjects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationSetTargetProjects = append(m.ApplicationSetTargetProjects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmPostRenderers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmPostRenderers = append(m.HelmPostRenderers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptionsPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncOptionsPolicies = append(m.SyncOptionsPolicies, SyncOptionsPolicy{})
+			if err := m.SyncOptionsPolicies[len(m.SyncOptionsPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageRegistryRewriteRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageRegistryRewriteRules = append(m.ImageRegistryRewriteRules, ImageRegistryRewriteRule{})
+			if err := m.ImageRegistryRewriteRules[len(m.ImageRegistryRewriteRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataPropagationPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MetadataPropagationPolicy == nil {
+				m.MetadataPropagationPolicy = &MetadataPropagationPolicy{}
+			}
+			if err := m.MetadataPropagationPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotificationAllowlist == nil {
+				m.NotificationAllowlist = &NotificationAllowlist{}
+			}
+			if err := m.NotificationAllowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24635,7 +27997,7 @@ func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationList) Unmarshal(dAtA []byte) error {
+func (m *ApplicationFreeze) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24658,15 +28020,79 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationFreeze: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field By", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.By = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24693,13 +28119,16 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.At == nil {
+				m.At = &v1.Time{}
+			}
+			if err := m.At.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24726,8 +28155,10 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Application{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Until == nil {
+				m.Until = &v1.Time{}
+			}
+			if err := m.Until.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24752,7 +28183,7 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
+func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24775,17 +28206,17 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationMatchExpression: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationMatchExpression: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24795,29 +28226,30 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24827,55 +28259,25 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, Application{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24898,7 +28300,7 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
+func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24921,15 +28323,15 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPreservedFields: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationMatchExpression: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPreservedFields: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationMatchExpression: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24957,11 +28359,11 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Annotations = append(m.Annotations, string(dAtA[iNdEx:postIndex]))
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24989,7 +28391,39 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25012,7 +28446,7 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
+func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25035,17 +28469,17 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationPreservedFields: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationPreservedFields: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25055,63 +28489,29 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Annotations = append(m.Annotations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25121,24 +28521,23 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25161,7 +28560,156 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26010,59 +29558,9 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSOrganizations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26089,41 +29587,10 @@ func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			if m.AWSOrganizations == nil {
+				m.AWSOrganizations = &AWSOrganizationsGenerator{}
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, ApplicationSet{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AWSOrganizations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26148,7 +29615,7 @@ func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26171,15 +29638,15 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetNestedGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetNestedGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26206,52 +29673,169 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.List == nil {
-				m.List = &ListGenerator{}
-			}
-			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Clusters == nil {
-				m.Clusters = &ClusterGenerator{}
-			}
-			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ApplicationSet{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetNestedGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetNestedGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.List == nil {
+				m.List = &ListGenerator{}
+			}
+			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Clusters == nil {
+				m.Clusters = &ClusterGenerator{}
+			}
+			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26537,6 +30121,209 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSOrganizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AWSOrganizations == nil {
+				m.AWSOrganizations = &AWSOrganizationsGenerator{}
+			}
+			if err := m.AWSOrganizations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = ApplicationSetParamType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Default = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26683,6 +30470,70 @@ func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			}
 			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPathExpressions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPathExpressions = append(m.JSONPathExpressions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergeMode = ApplicationSetIgnoreDifferencesMergeMode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27251,6 +31102,72 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.TemplatePatch = &s
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsSchema = append(m.ParamsSchema, ApplicationSetParam{})
+			if err := m.ParamsSchema[len(m.ParamsSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28478,6 +32395,42 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSOrganizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AWSOrganizations == nil {
+				m.AWSOrganizations = &AWSOrganizationsGenerator{}
+			}
+			if err := m.AWSOrganizations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28948,144 +32901,9 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Recurse = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Exclude = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchPaths", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29113,7 +32931,7 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Include = string(dAtA[iNdEx:postIndex])
+			m.WatchPaths = append(m.WatchPaths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29136,7 +32954,7 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29159,17 +32977,17 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29179,27 +32997,194 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Recurse = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exclude = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Include = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29593,6 +33578,40 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SkipSchemaValidation = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRenderers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostRenderers = append(m.PostRenderers, HelmPostRenderer{})
+			if err := m.PostRenderers[len(m.PostRenderers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31173,61 +35192,11 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshInterval", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31237,31 +35206,29 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, ResourceStatus{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RefreshInterval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationServiceAccount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31271,28 +35238,180 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DestinationServiceAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freeze", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Freeze == nil {
+				m.Freeze = &ApplicationFreeze{}
+			}
+			if err := m.Freeze.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33750,7 +37869,7 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
+func (m *ClusterConnectivityInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33773,17 +37892,17 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterConnectivityInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterConnectivityInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchDisconnects", wireType)
 			}
-			var msglen int
+			m.WatchDisconnects = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33793,30 +37912,54 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WatchDisconnects |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListLatencyMs", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.ListLatencyMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ListLatencyMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsCount", wireType)
 			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RequestsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequestsCount", wireType)
 			}
-			var msglen int
+			m.FailedRequestsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33826,28 +37969,130 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FailedRequestsCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33992,6 +38237,40 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.FlatList = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelTransforms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelTransforms = append(m.LabelTransforms, ClusterLabelTransform{})
+			if err := m.LabelTransforms[len(m.LabelTransforms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34191,6 +38470,39 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectivityInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConnectivityInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34212,7 +38524,7 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterList) Unmarshal(dAtA []byte) error {
+func (m *ClusterLabelTransform) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34235,17 +38547,17 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterLabelTransform: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterLabelTransform: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34255,30 +38567,29 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34288,25 +38599,87 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Cluster{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Regex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replacement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replacement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34329,7 +38702,7 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Command) Unmarshal(dAtA []byte) error {
+func (m *ClusterList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34352,17 +38725,17 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34372,29 +38745,30 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34404,23 +38778,25 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Items = append(m.Items, Cluster{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34443,7 +38819,7 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
+func (m *Command) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34466,15 +38842,129 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35429,6 +39919,44 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyMs", wireType)
+			}
+			m.LatencyMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatencyMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36392,6 +40920,140 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GRPCVerificationProbe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GRPCVerificationProbe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GRPCVerificationProbe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLS = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37349,6 +42011,331 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GroupExpiration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupExpiration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPVerificationProbe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPVerificationProbe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPVerificationProbe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExpectedStatus = append(m.ExpectedStatus, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExpectedStatus) == 0 {
+					m.ExpectedStatus = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExpectedStatus = append(m.ExpectedStatus, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedStatus", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipVerify = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37829,7 +42816,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostInfo) Unmarshal(dAtA []byte) error {
+func (m *HelmPostRenderer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37852,15 +42839,15 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmPostRenderer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmPostRenderer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37888,13 +42875,13 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Kustomize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37904,31 +42891,29 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
-			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Plugin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transformer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37938,28 +42923,27 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Transformer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37986,8 +42970,284 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
+			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -38606,7 +43866,7 @@ func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Info) Unmarshal(dAtA []byte) error {
+func (m *ImageRegistryRewriteRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38629,15 +43889,15 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageRegistryRewriteRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageRegistryRewriteRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38665,11 +43925,11 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38697,7 +43957,39 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38720,7 +44012,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
+func (m *Info) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38743,10 +44035,10 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -38834,7 +44126,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38857,17 +44149,17 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.IssuedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38877,33 +44169,27 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ExpiresAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpiresAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38931,7 +44217,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38954,7 +44240,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTTokens) Unmarshal(dAtA []byte) error {
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38977,17 +44263,17 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
 			}
-			var msglen int
+			m.IssuedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38997,81 +44283,16 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.IssuedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, JWTToken{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39081,27 +44302,14 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39129,28 +44337,8 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39172,7 +44360,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39195,17 +44383,17 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39215,55 +44403,25 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, JWTToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39286,7 +44444,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39309,15 +44467,15 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39345,11 +44503,11 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39377,13 +44535,13 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39393,24 +44551,12 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39432,7 +44578,7 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39455,15 +44601,15 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39491,11 +44637,11 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39523,7 +44669,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39546,7 +44692,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
+func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39569,15 +44715,15 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39605,11 +44751,11 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39637,49 +44783,13 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Patch = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Target == nil {
-				m.Target = &KustomizeSelector{}
-			}
-			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39689,106 +44799,23 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Options == nil {
-				m.Options = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Options[mapkey] = mapvalue
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39811,7 +44838,7 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
+func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39834,15 +44861,15 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39870,13 +44897,13 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39886,24 +44913,23 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39926,7 +44952,7 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
+func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39949,48 +44975,15 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeResId: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeResId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeGvk", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.KustomizeGvk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40018,11 +45011,424 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &KustomizeSelector{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Options[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeResId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeResId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeGvk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KustomizeGvk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40297,28 +45703,238 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElementsYaml", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ElementsYaml = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LuaVerificationProbe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LuaVerificationProbe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LuaVerificationProbe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElementsYaml", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40346,7 +45962,7 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ElementsYaml = string(dAtA[iNdEx:postIndex])
+			m.Script = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40652,6 +46268,102 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelsPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelsPolicy = ManagedNamespaceMetadataPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationsPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnotationsPolicy = ManagedNamespaceMetadataPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoredKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoredKeys = append(m.IgnoredKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40790,7 +46502,430 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
+func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overlays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overlays = append(m.Overlays, MergeGeneratorOverlay{})
+			if err := m.Overlays[len(m.Overlays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeGeneratorOverlay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeGeneratorOverlay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeGeneratorOverlay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassThrough", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PassThrough = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Defaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Defaults == nil {
+				m.Defaults = &v11.JSON{}
+			}
+			if err := m.Defaults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataPropagationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataPropagationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataPropagationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotations = append(m.Annotations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplates", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PodTemplates = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40813,10 +46948,10 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -40848,76 +46983,11 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
 			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40939,7 +47009,7 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
+func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40962,10 +47032,10 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -41002,6 +47072,72 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overlays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overlays = append(m.Overlays, MergeGeneratorOverlay{})
+			if err := m.Overlays[len(m.Overlays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -41023,7 +47159,7 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
+func (m *NotificationAllowlist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41046,17 +47182,17 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: NotificationAllowlist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NotificationAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41066,29 +47202,27 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Services = append(m.Services, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41116,7 +47250,7 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			m.Triggers = append(m.Triggers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41951,6 +48085,25 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuePosition", wireType)
+			}
+			m.QueuePosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuePosition |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -43376,6 +49529,40 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			}
 			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupExpirations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupExpirations = append(m.GroupExpirations, GroupExpiration{})
+			if err := m.GroupExpirations[len(m.GroupExpirations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47768,6 +53955,26 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			}
 			m.DisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresConfirmation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiresConfirmation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47848,13 +54055,45 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47864,24 +54103,46 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionLua = string(dAtA[iNdEx:postIndex])
+			m.Params = append(m.Params, ResourceActionParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresConfirmation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiresConfirmation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51497,6 +57758,239 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedFiles = append(m.ChangedFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &RevisionPullRequest{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionPullRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionPullRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -54675,16 +61169,427 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ApplicationSource{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Source == nil {
+				m.Source = &ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealAttemptsCount", wireType)
+			}
+			m.SelfHealAttemptsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfHealAttemptsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &ResourceResult{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54712,13 +61617,13 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54728,25 +61633,26 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
@@ -54780,7 +61686,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
@@ -54812,11 +61718,11 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			}
 			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealAttemptsCount", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
 			}
-			m.SelfHealAttemptsCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54826,11 +61732,28 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SelfHealAttemptsCount |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -54852,7 +61775,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+func (m *SyncOptionsPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54875,10 +61798,10 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOptionsPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOptionsPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -54947,7 +61870,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enforce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54975,11 +61898,11 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Enforce = append(m.Enforce, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forbid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55007,7 +61930,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Forbid = append(m.Forbid, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -55030,7 +61953,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55053,15 +61976,15 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55088,14 +62011,16 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &ResourceResult{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Automated == nil {
+				m.Automated = &SyncPolicyAutomated{}
+			}
+			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55123,11 +62048,11 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55154,13 +62079,16 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55187,14 +62115,16 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55222,133 +62152,11 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.ConcurrencyKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
-			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Automated == nil {
-				m.Automated = &SyncPolicyAutomated{}
-			}
-			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSideApplyIgnoredConflicts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55376,47 +62184,11 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Retry == nil {
-				m.Retry = &RetryStrategy{}
-			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServerSideApplyIgnoredConflicts = append(m.ServerSideApplyIgnoredConflicts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationProbes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55443,10 +62215,8 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
-			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VerificationProbes = append(m.VerificationProbes, VerificationProbe{})
+			if err := m.VerificationProbes[len(m.VerificationProbes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -56834,6 +63604,280 @@ func (m *TagFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VerificationProbe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerificationProbe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerificationProbe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPVerificationProbe{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GRPC == nil {
+				m.GRPC = &GRPCVerificationProbe{}
+			}
+			if err := m.GRPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lua", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lua == nil {
+				m.Lua = &LuaVerificationProbe{}
+			}
+			if err := m.Lua.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retries = &v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenerated(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -43,6 +44,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/env"
+	"github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/argoproj/argo-cd/v3/util/helm"
 	utilhttp "github.com/argoproj/argo-cd/v3/util/http"
 	"github.com/argoproj/argo-cd/v3/util/security"
@@ -92,6 +94,51 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// RefreshInterval overrides the instance-wide interval at which the application is refreshed (e.g. "1m", "1h"). It is
+	// bounded by the maximum refresh interval of the project.
+	RefreshInterval string `json:"refreshInterval,omitempty" protobuf:"bytes,10,opt,name=refreshInterval"`
+
+	// DestinationServiceAccount is the service account to impersonate for the sync operations of the application when
+	// the sync impersonation is enabled, optionally prefixed with its namespace (e.g. "guestbook:guestbook-deployer"). It
+	// must be one of the destination service accounts of the project matching the destination of the application.
+	DestinationServiceAccount string `json:"destinationServiceAccount,omitempty" protobuf:"bytes,11,opt,name=destinationServiceAccount"`
+
+	// Freeze is the freeze of the application, which blocks its automated and manual syncs while it is active
+	Freeze *ApplicationFreeze `json:"freeze,omitempty" protobuf:"bytes,12,opt,name=freeze"`
+}
+
+// ApplicationFreeze is the freeze of an application. A frozen application cannot be synced, neither automatically nor
+// manually, unless the user is permitted to freeze it.
+type ApplicationFreeze struct {
+	// By is the user who froze the application
+	By string `json:"by,omitempty" protobuf:"bytes,1,opt,name=by"`
+	// Reason is the reason the application was frozen for
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+	// At is the time the application was frozen at
+	At *metav1.Time `json:"at,omitempty" protobuf:"bytes,3,opt,name=at"`
+	// Until is the time the freeze expires at. The freeze never expires if it is not set.
+	Until *metav1.Time `json:"until,omitempty" protobuf:"bytes,4,opt,name=until"`
+}
+
+// IsActive returns whether the freeze blocks the syncs at the given time
+func (f *ApplicationFreeze) IsActive(now time.Time) bool {
+	return f != nil && (f.Until == nil || now.Before(f.Until.Time))
+}
+
+// Message returns a human-readable description of the freeze
+func (f *ApplicationFreeze) Message() string {
+	message := "application is frozen"
+	if f.By != "" {
+		message += " by " + f.By
+	}
+	if f.Until != nil {
+		message += " until " + f.Until.UTC().Format(time.RFC3339)
+	}
+	if f.Reason != "" {
+		message = fmt.Sprintf("%s: %s", message, f.Reason)
+	}
+	return message
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -204,6 +251,10 @@ type ApplicationSource struct {
 	Ref string `json:"ref,omitempty" protobuf:"bytes,13,opt,name=ref"`
 	// Name is used to refer to a source and is displayed in the UI. It is used in multi-source Applications.
 	Name string `json:"name,omitempty" protobuf:"bytes,14,opt,name=name"`
+	// WatchPaths is a list of paths whose changes trigger a refresh of the application. Paths are relative to the path of
+	// the source, or to the root of the repository when starting with a "/", and may contain glob patterns. When set,
+	// commits which do not change any of these paths neither refresh the application nor regenerate its manifests.
+	WatchPaths []string `json:"watchPaths,omitempty" protobuf:"bytes,15,rep,name=watchPaths"`
 }
 
 // ApplicationSources contains list of required information about the sources of an application
@@ -299,6 +350,10 @@ func (source *ApplicationSource) AllowsConcurrentProcessing() bool {
 	if source.Kustomize != nil {
 		return source.Kustomize.AllowsConcurrentProcessing()
 	}
+	// Helm post-rendering with kustomize or plugins requires writing the rendered manifests to the source
+	if source.Helm != nil {
+		return source.Helm.AllowsConcurrentProcessing()
+	}
 	return true
 }
 
@@ -526,6 +581,79 @@ type ApplicationSourceHelm struct {
 	SkipTests bool `json:"skipTests,omitempty" protobuf:"bytes,14,opt,name=skipTests"`
 	// SkipSchemaValidation skips JSON schema validation (Helm's --skip-schema-validation)
 	SkipSchemaValidation bool `json:"skipSchemaValidation,omitempty" protobuf:"bytes,15,opt,name=skipSchemaValidation"`
+	// PostRenderers is the ordered list of the steps transforming the manifests rendered by helm template. The steps
+	// must be permitted by the project of the application.
+	PostRenderers []HelmPostRenderer `json:"postRenderers,omitempty" protobuf:"bytes,16,opt,name=postRenderers"`
+}
+
+const (
+	// HelmPostRendererTransformerCommonLabels is the built-in transformer adding its values as labels of the manifests
+	HelmPostRendererTransformerCommonLabels = "commonLabels"
+	// HelmPostRendererTransformerCommonAnnotations is the built-in transformer adding its values as annotations of the
+	// manifests
+	HelmPostRendererTransformerCommonAnnotations = "commonAnnotations"
+)
+
+// HelmPostRenderer is a step transforming the manifests rendered by helm template. Exactly one of Kustomize, Plugin
+// and Transformer must be set.
+type HelmPostRenderer struct {
+	// Kustomize is the path, relative to the application path, of a kustomization built on top of the rendered
+	// manifests. The rendered manifests are written to the helm-rendered.yaml file of the kustomization directory, which
+	// the kustomization must list in its resources.
+	Kustomize string `json:"kustomize,omitempty" protobuf:"bytes,1,opt,name=kustomize"`
+	// Plugin is the name of a config management plugin generating the manifests from the rendered manifests, which are
+	// written to the helm-rendered.yaml file of the application path.
+	Plugin string `json:"plugin,omitempty" protobuf:"bytes,2,opt,name=plugin"`
+	// Transformer is the name of a built-in transformer: commonLabels or commonAnnotations
+	Transformer string `json:"transformer,omitempty" protobuf:"bytes,3,opt,name=transformer"`
+	// Values are the values of the built-in transformer
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,4,opt,name=values"`
+}
+
+// AllowsConcurrentProcessing returns true if multiple processes can render the Helm source at the same time. The kustomize
+// and plugin post-render steps write the rendered manifests to the helm-rendered.yaml file of the source.
+func (h *ApplicationSourceHelm) AllowsConcurrentProcessing() bool {
+	for _, renderer := range h.PostRenderers {
+		if renderer.Kustomize != "" || renderer.Plugin != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Name returns the name of the step checked against the helm post-renderers permitted by projects: kustomize,
+// plugin:<name> or transformer:<name>
+func (r HelmPostRenderer) Name() string {
+	switch {
+	case r.Plugin != "":
+		return "plugin:" + r.Plugin
+	case r.Transformer != "":
+		return "transformer:" + r.Transformer
+	default:
+		return "kustomize"
+	}
+}
+
+// Validate checks that exactly one kind of step is set and that built-in transformers are known
+func (r HelmPostRenderer) Validate() error {
+	set := 0
+	for _, v := range []string{r.Kustomize, r.Plugin, r.Transformer} {
+		if v != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return errors.New("exactly one of kustomize, plugin and transformer must be set")
+	}
+	switch r.Transformer {
+	case "", HelmPostRendererTransformerCommonLabels, HelmPostRendererTransformerCommonAnnotations:
+	default:
+		return fmt.Errorf("unknown transformer %q", r.Transformer)
+	}
+	if r.Transformer == "" && len(r.Values) > 0 {
+		return errors.New("values are only supported by transformers")
+	}
+	return nil
 }
 
 // HelmParameter is a parameter that's passed to helm template during manifest generation
@@ -607,7 +735,7 @@ func (ash *ApplicationSourceHelm) AddFileParameter(p HelmFileParameter) {
 
 // IsZero Returns true if the Helm options in an application source are considered zero
 func (ash *ApplicationSourceHelm) IsZero() bool {
-	return ash == nil || (ash.Version == "") && (ash.ReleaseName == "") && len(ash.ValueFiles) == 0 && len(ash.Parameters) == 0 && len(ash.FileParameters) == 0 && ash.ValuesIsEmpty() && !ash.PassCredentials && !ash.IgnoreMissingValueFiles && !ash.SkipCrds && !ash.SkipTests && !ash.SkipSchemaValidation && ash.KubeVersion == "" && len(ash.APIVersions) == 0 && ash.Namespace == ""
+	return ash == nil || (ash.Version == "") && (ash.ReleaseName == "") && len(ash.ValueFiles) == 0 && len(ash.Parameters) == 0 && len(ash.FileParameters) == 0 && ash.ValuesIsEmpty() && !ash.PassCredentials && !ash.IgnoreMissingValueFiles && !ash.SkipCrds && !ash.SkipTests && !ash.SkipSchemaValidation && ash.KubeVersion == "" && len(ash.APIVersions) == 0 && ash.Namespace == "" && len(ash.PostRenderers) == 0
 }
 
 // KustomizeImage represents a Kustomize image definition in the format [old_image_name=]<image_name>:<image_tag>
@@ -813,6 +941,23 @@ func (k *ApplicationSourceKustomize) MergeReplica(replica KustomizeReplica) {
 	}
 }
 
+// MergeComponent adds a Kustomize component to the list of components unless it is already present
+func (k *ApplicationSourceKustomize) MergeComponent(component string) {
+	if !slices.Contains(k.Components, component) {
+		k.Components = append(k.Components, component)
+	}
+}
+
+// MergePatch adds a Kustomize patch to the list of patches unless an equal patch is already present
+func (k *ApplicationSourceKustomize) MergePatch(patch KustomizePatch) {
+	for _, p := range k.Patches {
+		if p.Equals(patch) {
+			return
+		}
+	}
+	k.Patches = append(k.Patches, patch)
+}
+
 // Find returns a positive integer representing the index in the list of replicas
 func (rs KustomizeReplicas) FindByName(name string) int {
 	for i, r := range rs {
@@ -1386,6 +1531,9 @@ type OperationState struct {
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
 	// RetryCount contains time of operation retries
 	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
+	// QueuePosition is the position of the operation in the queue of the syncs of the concurrency key of the
+	// application, starting at 1, while the operation waits for the syncs of other applications to complete
+	QueuePosition int64 `json:"queuePosition,omitempty" protobuf:"bytes,9,opt,name=queuePosition"`
 }
 
 type Info struct {
@@ -1430,6 +1578,45 @@ func (o SyncOptions) HasOption(option string) bool {
 type ManagedNamespaceMetadata struct {
 	Labels      map[string]string `json:"labels,omitempty" protobuf:"bytes,1,opt,name=labels"`
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,opt,name=annotations"`
+	// LabelsPolicy controls how the labels are applied to the namespace: merge (the default) keeps the labels of the
+	// namespace which are not managed, replace removes them
+	// +kubebuilder:validation:Enum=merge;replace
+	LabelsPolicy ManagedNamespaceMetadataPolicy `json:"labelsPolicy,omitempty" protobuf:"bytes,3,opt,name=labelsPolicy,casttype=ManagedNamespaceMetadataPolicy"`
+	// AnnotationsPolicy controls how the annotations are applied to the namespace: merge (the default) keeps the
+	// annotations of the namespace which are not managed, replace removes them
+	// +kubebuilder:validation:Enum=merge;replace
+	AnnotationsPolicy ManagedNamespaceMetadataPolicy `json:"annotationsPolicy,omitempty" protobuf:"bytes,4,opt,name=annotationsPolicy,casttype=ManagedNamespaceMetadataPolicy"`
+	// IgnoredKeys are globs matching the labels and annotations of the namespace which are managed by other controllers.
+	// Argo CD neither sets nor removes them, even when they are managed.
+	IgnoredKeys []string `json:"ignoredKeys,omitempty" protobuf:"bytes,5,rep,name=ignoredKeys"`
+}
+
+// ManagedNamespaceMetadataPolicy controls how the managed labels or annotations are applied to the namespace
+type ManagedNamespaceMetadataPolicy string
+
+const (
+	// ManagedNamespaceMetadataPolicyMerge keeps the labels or annotations of the namespace which are not managed
+	ManagedNamespaceMetadataPolicyMerge ManagedNamespaceMetadataPolicy = "merge"
+	// ManagedNamespaceMetadataPolicyReplace removes the labels or annotations of the namespace which are not managed
+	ManagedNamespaceMetadataPolicyReplace ManagedNamespaceMetadataPolicy = "replace"
+)
+
+// Validate returns an error if the policies or the ignored keys of the managed namespace metadata are invalid
+func (m *ManagedNamespaceMetadata) Validate() error {
+	for _, policy := range []ManagedNamespaceMetadataPolicy{m.LabelsPolicy, m.AnnotationsPolicy} {
+		if policy != "" && policy != ManagedNamespaceMetadataPolicyMerge && policy != ManagedNamespaceMetadataPolicyReplace {
+			return fmt.Errorf("invalid managed namespace metadata policy %q: must be %s or %s", policy, ManagedNamespaceMetadataPolicyMerge, ManagedNamespaceMetadataPolicyReplace)
+		}
+	}
+	for _, key := range m.IgnoredKeys {
+		if strings.TrimSpace(key) == "" {
+			return errors.New("invalid managed namespace metadata ignored key: must not be empty")
+		}
+		if _, err := glob.MatchWithError(key, ""); err != nil {
+			return fmt.Errorf("invalid managed namespace metadata ignored key %q: %w", key, err)
+		}
+	}
+	return nil
 }
 
 // SyncPolicy controls when a sync will be performed in response to updates in git
@@ -1442,9 +1629,127 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// ConcurrencyKey is the key of the mutex of the syncs of the application. The applications declaring the same key
+	// never sync concurrently, their syncs are queued in first-come, first-served order.
+	ConcurrencyKey string `json:"concurrencyKey,omitempty" protobuf:"bytes,5,opt,name=concurrencyKey"`
+	// ServerSideApplyIgnoredConflicts is the list of field paths, e.g. .spec.replicas, whose server-side apply conflicts
+	// are ignored when the ServerSideApplyConflicts=ignore sync option is set. A listed field ignores the conflicts on
+	// all its sub-fields. All the conflicts are ignored if no field is listed.
+	ServerSideApplyIgnoredConflicts []string `json:"serverSideApplyIgnoredConflicts,omitempty" protobuf:"bytes,6,rep,name=serverSideApplyIgnoredConflicts"`
+	// VerificationProbes are run one after the other after the application is synced. The sync operation fails if any
+	// of the probes still fails after its retries.
+	VerificationProbes []VerificationProbe `json:"verificationProbes,omitempty" protobuf:"bytes,7,rep,name=verificationProbes"`
 	// If you add a field here, be sure to update IsZero.
 }
 
+// VerificationProbe is a probe verifying an application after it is synced. Exactly one of HTTP, GRPC and Lua must be
+// set.
+type VerificationProbe struct {
+	// Name is the name of the probe
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// HTTP probes an HTTP endpoint, which must respond with one of the expected status codes
+	HTTP *HTTPVerificationProbe `json:"http,omitempty" protobuf:"bytes,2,opt,name=http"`
+	// GRPC probes a gRPC endpoint with the gRPC health checking protocol, which must report the service as serving
+	GRPC *GRPCVerificationProbe `json:"grpc,omitempty" protobuf:"bytes,3,opt,name=grpc"`
+	// Lua probes a live resource with a Lua script returning a health status, which must be Healthy
+	Lua *LuaVerificationProbe `json:"lua,omitempty" protobuf:"bytes,4,opt,name=lua"`
+	// Timeout is the timeout of each attempt of the probe, e.g. 10s
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,5,opt,name=timeout"`
+	// Retries is the number of times the probe is retried when it fails
+	Retries *int64 `json:"retries,omitempty" protobuf:"varint,6,opt,name=retries"`
+	// RetryInterval is the duration between the attempts of the probe, e.g. 10s
+	RetryInterval string `json:"retryInterval,omitempty" protobuf:"bytes,7,opt,name=retryInterval"`
+}
+
+func isPositiveDurationOrEmpty(value string) bool {
+	if value == "" {
+		return true
+	}
+	duration, err := time.ParseDuration(value)
+	return err == nil && duration > 0
+}
+
+// HTTPVerificationProbe probes an HTTP endpoint
+type HTTPVerificationProbe struct {
+	// URL is the http or https URL of the endpoint
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Method is the method of the request, GET if not set
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// ExpectedStatus are the expected status codes of the response, any 2xx status code if not set
+	ExpectedStatus []int32 `json:"expectedStatus,omitempty" protobuf:"varint,3,rep,name=expectedStatus"`
+	// InsecureSkipVerify skips the verification of the TLS certificate of the endpoint
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"varint,4,opt,name=insecureSkipVerify"`
+}
+
+// GRPCVerificationProbe probes a gRPC endpoint with the gRPC health checking protocol
+type GRPCVerificationProbe struct {
+	// Address is the host:port address of the endpoint
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Service is the name of the probed service, the overall health of the server if not set
+	Service string `json:"service,omitempty" protobuf:"bytes,2,opt,name=service"`
+	// TLS connects to the endpoint with TLS
+	TLS bool `json:"tls,omitempty" protobuf:"varint,3,opt,name=tls"`
+}
+
+// LuaVerificationProbe probes a live resource with a Lua health script
+type LuaVerificationProbe struct {
+	APIVersion string `json:"apiVersion" protobuf:"bytes,1,opt,name=apiVersion"`
+	Kind       string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	Name       string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// Namespace is the namespace of the resource, the destination namespace of the application if not set
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+	// Script is a health script receiving the live resource as obj
+	Script string `json:"script" protobuf:"bytes,5,opt,name=script"`
+}
+
+// Validate returns an error if the verification probe is invalid
+func (p *VerificationProbe) Validate() error {
+	if p.Name == "" {
+		return errors.New("the name of the verification probe is missing")
+	}
+	count := 0
+	if p.HTTP != nil {
+		count++
+		u, err := url.Parse(p.HTTP.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("verification probe %s: invalid HTTP URL %q", p.Name, p.HTTP.URL)
+		}
+		for _, code := range p.HTTP.ExpectedStatus {
+			if code < 100 || code > 599 {
+				return fmt.Errorf("verification probe %s: invalid expected status %d", p.Name, code)
+			}
+		}
+	}
+	if p.GRPC != nil {
+		count++
+		if _, _, err := net.SplitHostPort(p.GRPC.Address); err != nil {
+			return fmt.Errorf("verification probe %s: invalid gRPC address %q", p.Name, p.GRPC.Address)
+		}
+	}
+	if p.Lua != nil {
+		count++
+		if p.Lua.APIVersion == "" || p.Lua.Kind == "" || p.Lua.Name == "" || p.Lua.Script == "" {
+			return fmt.Errorf("verification probe %s: the apiVersion, kind, name and script of the Lua probe are required", p.Name)
+		}
+		if _, err := schema.ParseGroupVersion(p.Lua.APIVersion); err != nil {
+			return fmt.Errorf("verification probe %s: invalid apiVersion %q", p.Name, p.Lua.APIVersion)
+		}
+	}
+	if count != 1 {
+		return fmt.Errorf("verification probe %s: exactly one of http, grpc and lua must be set", p.Name)
+	}
+	if !isPositiveDurationOrEmpty(p.Timeout) {
+		return fmt.Errorf("verification probe %s: invalid timeout %q", p.Name, p.Timeout)
+	}
+	if !isPositiveDurationOrEmpty(p.RetryInterval) {
+		return fmt.Errorf("verification probe %s: invalid retry interval %q", p.Name, p.RetryInterval)
+	}
+	if p.Retries != nil && *p.Retries < 0 {
+		return fmt.Errorf("verification probe %s: invalid retries %d", p.Name, *p.Retries)
+	}
+	return nil
+}
+
 // IsAutomatedSyncEnabled checks if the automated sync is enabled or disabled
 func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 	if p.Automated != nil && (p.Automated.Enabled == nil || *p.Automated.Enabled) {
@@ -1455,7 +1760,7 @@ func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.ConcurrencyKey == "" && len(p.ServerSideApplyIgnoredConflicts) == 0 && len(p.VerificationProbes) == 0)
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1620,6 +1925,23 @@ type RevisionMetadata struct {
 	SignatureInfo string `json:"signatureInfo,omitempty" protobuf:"bytes,5,opt,name=signatureInfo"`
 	// References contains references to information that's related to this commit in some way.
 	References []RevisionReference `json:"references,omitempty" protobuf:"bytes,6,opt,name=references"`
+	// ChangedFiles lists the paths changed since the previously synced revision. It is only set when requested.
+	ChangedFiles []string `json:"changedFiles,omitempty" protobuf:"bytes,7,rep,name=changedFiles"`
+	// PullRequest contains the metadata of the pull request which introduced the revision, if any. It is only set when
+	// requested, and only for repositories hosted on GitHub.
+	PullRequest *RevisionPullRequest `json:"pullRequest,omitempty" protobuf:"bytes,8,opt,name=pullRequest"`
+}
+
+// RevisionPullRequest contains the metadata of the pull request which introduced a revision
+type RevisionPullRequest struct {
+	// Number is the number of the pull request
+	Number int64 `json:"number" protobuf:"varint,1,opt,name=number"`
+	// Title is the title of the pull request
+	Title string `json:"title,omitempty" protobuf:"bytes,2,opt,name=title"`
+	// Author is the login of the author of the pull request
+	Author string `json:"author,omitempty" protobuf:"bytes,3,opt,name=author"`
+	// URL is the web URL of the pull request
+	URL string `json:"url,omitempty" protobuf:"bytes,4,opt,name=url"`
 }
 
 // OCIMetadata contains metadata for a specific revision in an OCI repository
@@ -1803,6 +2125,17 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionServerSideApplyConflict indicates that the last sync found fields of the application resources
+	// which are managed by other field managers
+	ApplicationConditionServerSideApplyConflict = "ServerSideApplyConflict"
+	// ApplicationConditionSyncFrozen indicates that the application is frozen and cannot be synced
+	ApplicationConditionSyncFrozen = "SyncFrozen"
+	// ApplicationConditionClusterUnreachable indicates that the live state of the application could not be retrieved
+	// because its destination cluster is unreachable
+	ApplicationConditionClusterUnreachable = "ClusterUnreachable"
+	// ApplicationConditionConfigurationDrift indicates that the live configuration of Argo CD differs from the state
+	// declared in the source of the argocd-config application
+	ApplicationConditionConfigurationDrift = "ConfigurationDriftWarning"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -2018,7 +2351,7 @@ func (t *ApplicationTree) FindNode(group string, kind string, namespace string,
 //
 // - It collects external URLs from the networking information of all resource nodes.
 // - It extracts container images referenced in the application's resources.
-// - Additionally, it includes links from application annotations that start with `common.AnnotationKeyLinkPrefix`.
+// - Additionally, it includes non-templated links from application annotations that start with `common.AnnotationKeyLinkPrefix`.
 // - The collected URLs and images are sorted alphabetically before being returned.
 //
 // Returns an `ApplicationSummary` containing a list of unique external URLs and container images.
@@ -2038,9 +2371,9 @@ func (t *ApplicationTree) GetSummary(app *Application) ApplicationSummary {
 		}
 	}
 
-	// Include application-specific links from annotations
+	// Include application-specific links from annotations, except the templated ones which are resolved by the API server
 	for k, v := range app.GetAnnotations() {
-		if strings.HasPrefix(k, common.AnnotationKeyLinkPrefix) {
+		if strings.HasPrefix(k, common.AnnotationKeyLinkPrefix) && !strings.Contains(v, "{{") {
 			urlsSet[v] = true
 		}
 	}
@@ -2198,6 +2531,10 @@ type ConnectionState struct {
 	Message string `json:"message" protobuf:"bytes,2,opt,name=message"`
 	// ModifiedAt contains the timestamp when this connection status has been determined
 	ModifiedAt *metav1.Time `json:"attemptedAt" protobuf:"bytes,3,opt,name=attemptedAt"`
+	// LatencyMs is the duration in milliseconds of the connection attempt
+	LatencyMs int64 `json:"latencyMs,omitempty" protobuf:"varint,4,opt,name=latencyMs"`
+	// ConsecutiveFailures is the number of the consecutive failed connection attempts
+	ConsecutiveFailures int64 `json:"consecutiveFailures,omitempty" protobuf:"varint,5,opt,name=consecutiveFailures"`
 }
 
 // Cluster is the definition of a cluster resource
@@ -2284,6 +2621,8 @@ type ClusterInfo struct {
 	ApplicationsCount int64 `json:"applicationsCount" protobuf:"bytes,4,opt,name=applicationsCount"`
 	// APIVersions contains list of API versions supported by the cluster
 	APIVersions []string `json:"apiVersions,omitempty" protobuf:"bytes,5,opt,name=apiVersions"`
+	// ConnectivityInfo contains information about the connectivity of the cluster cache to the cluster
+	ConnectivityInfo ClusterConnectivityInfo `json:"connectivityInfo,omitempty" protobuf:"bytes,6,opt,name=connectivityInfo"`
 }
 
 func (c *ClusterInfo) GetKubeVersion() string {
@@ -2304,6 +2643,18 @@ type ClusterCacheInfo struct {
 	LastCacheSyncTime *metav1.Time `json:"lastCacheSyncTime,omitempty" protobuf:"bytes,3,opt,name=lastCacheSyncTime"`
 }
 
+// ClusterConnectivityInfo contains information about the connectivity of the cluster cache to the cluster
+type ClusterConnectivityInfo struct {
+	// WatchDisconnects holds number of watches of the cluster disconnected by an error
+	WatchDisconnects int64 `json:"watchDisconnects,omitempty" protobuf:"bytes,1,opt,name=watchDisconnects"`
+	// ListLatencyMs holds average duration in milliseconds of recent list requests
+	ListLatencyMs int64 `json:"listLatencyMs,omitempty" protobuf:"bytes,2,opt,name=listLatencyMs"`
+	// RequestsCount holds number of recent requests to the cluster
+	RequestsCount int64 `json:"requestsCount,omitempty" protobuf:"bytes,3,opt,name=requestsCount"`
+	// FailedRequestsCount holds number of recent requests to the cluster which failed
+	FailedRequestsCount int64 `json:"failedRequestsCount,omitempty" protobuf:"bytes,4,opt,name=failedRequestsCount"`
+}
+
 // ClusterList is a collection of Clusters.
 type ClusterList struct {
 	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
@@ -2505,6 +2856,11 @@ type ResourceActionDefinition struct {
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 	// ActionLua contains the Lua script that defines the behavior of the action.
 	ActionLua string `json:"action.lua" yaml:"action.lua" protobuf:"bytes,2,opt,name=actionLua"`
+	// Params declares the typed input parameters accepted by the action. When set, the parameters supplied
+	// when running the action are validated against the declaration and defaults are filled in.
+	Params []ResourceActionParam `json:"params,omitempty" yaml:"params,omitempty" protobuf:"bytes,3,rep,name=params"`
+	// RequiresConfirmation marks the action as destructive. The API refuses to run it unless the request is confirmed.
+	RequiresConfirmation bool `json:"requiresConfirmation,omitempty" yaml:"requiresConfirmation,omitempty" protobuf:"varint,4,opt,name=requiresConfirmation"`
 }
 
 // ResourceAction represents an individual action that can be performed on a resource.
@@ -2520,6 +2876,8 @@ type ResourceAction struct {
 	IconClass string `json:"iconClass,omitempty" protobuf:"bytes,4,opt,name=iconClass"`
 	// DisplayName provides a user-friendly name for the action.
 	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,5,opt,name=displayName"`
+	// RequiresConfirmation indicates that the action is destructive and must be explicitly confirmed when run.
+	RequiresConfirmation bool `json:"requiresConfirmation,omitempty" protobuf:"varint,6,opt,name=requiresConfirmation"`
 }
 
 // ResourceActionParam represents a parameter for a resource action.
@@ -2696,6 +3054,161 @@ type AppProjectSpec struct {
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
 	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
 	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// MaxRefreshInterval is the maximum interval at which the applications of the project are refreshed (e.g. "1h"). It
+	// bounds both the instance-wide refresh interval and the refresh interval of the applications.
+	MaxRefreshInterval string `json:"maxRefreshInterval,omitempty" protobuf:"bytes,15,opt,name=maxRefreshInterval"`
+	// AllowedLinkURLs are the glob patterns of the URLs the link annotations of the applications of the project may point
+	// to (e.g. "https://grafana.example.com/*"). All the http and https URLs are allowed if not set.
+	AllowedLinkURLs []string `json:"allowedLinkURLs,omitempty" protobuf:"bytes,16,rep,name=allowedLinkURLs"`
+	// DestinationExpression is a CEL expression permitting the destinations it evaluates to true for, in addition to the
+	// destinations of the project. Its variables are the destination with its server, name and namespace, and the
+	// project with its name and labels.
+	DestinationExpression string `json:"destinationExpression,omitempty" protobuf:"bytes,17,opt,name=destinationExpression"`
+	// SourceRepoExpression is a CEL expression permitting the source repositories it evaluates to true for, in addition
+	// to the source repositories of the project. Its variables are the source with its repoURL, and the project with
+	// its name and labels.
+	SourceRepoExpression string `json:"sourceRepoExpression,omitempty" protobuf:"bytes,18,opt,name=sourceRepoExpression"`
+	// ApplicationSetTargetProjects are the glob patterns of the projects the applications generated by the ApplicationSets
+	// of the project may belong to, in addition to the project itself.
+	ApplicationSetTargetProjects []string `json:"applicationSetTargetProjects,omitempty" protobuf:"bytes,19,rep,name=applicationSetTargetProjects"`
+	// HelmPostRenderers are the glob patterns of the helm post-render steps the applications of the project may use. The
+	// steps are named kustomize, plugin:<name> and transformer:<name>. No step is permitted if not set.
+	HelmPostRenderers []string `json:"helmPostRenderers,omitempty" protobuf:"bytes,20,rep,name=helmPostRenderers"`
+	// SyncOptionsPolicies enforce or forbid sync options on the resources of the applications of the project, per group
+	// and kind
+	SyncOptionsPolicies []SyncOptionsPolicy `json:"syncOptionsPolicies,omitempty" protobuf:"bytes,21,rep,name=syncOptionsPolicies"`
+	// ImageRegistryRewriteRules replace the registry of the container images of the resources of the applications of
+	// the project. The first rule matching an image applies.
+	ImageRegistryRewriteRules []ImageRegistryRewriteRule `json:"imageRegistryRewriteRules,omitempty" protobuf:"bytes,22,rep,name=imageRegistryRewriteRules"`
+	// MetadataPropagationPolicy selects the labels and annotations of the applications of the project which are
+	// propagated onto their resources
+	MetadataPropagationPolicy *MetadataPropagationPolicy `json:"metadataPropagationPolicy,omitempty" protobuf:"bytes,23,opt,name=metadataPropagationPolicy"`
+	// NotificationAllowlist restricts the notification services and triggers the applications of the project subscribe to
+	NotificationAllowlist *NotificationAllowlist `json:"notificationAllowlist,omitempty" protobuf:"bytes,24,opt,name=notificationAllowlist"`
+}
+
+// SyncOptionsPolicy enforces or forbids sync options on the resources matching a group and a kind, regardless of the
+// sync options of the applications and of the resources
+type SyncOptionsPolicy struct {
+	// Group is a glob matching the group of the resources, empty for the core group
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Kind is a glob matching the kind of the resources
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Enforce holds the sync options set on the resources, e.g. ServerSideApply=true
+	Enforce []string `json:"enforce,omitempty" protobuf:"bytes,3,rep,name=enforce"`
+	// Forbid holds the sync options removed from the resources, e.g. Force=true
+	Forbid []string `json:"forbid,omitempty" protobuf:"bytes,4,rep,name=forbid"`
+}
+
+// NotificationAllowlist restricts the notification services and triggers the applications of a project subscribe to.
+// The subscriptions of the applications to the other services and triggers are ignored.
+type NotificationAllowlist struct {
+	// Services holds globs matching the names of the permitted notification services. All the services are permitted
+	// if it is empty.
+	Services []string `json:"services,omitempty" protobuf:"bytes,1,rep,name=services"`
+	// Triggers holds globs matching the names of the permitted notification triggers. All the triggers are permitted if
+	// it is empty.
+	Triggers []string `json:"triggers,omitempty" protobuf:"bytes,2,rep,name=triggers"`
+}
+
+// Validate returns an error if the notification allowlist is invalid
+func (a *NotificationAllowlist) Validate() error {
+	for _, patterns := range [][]string{a.Services, a.Triggers} {
+		for _, pattern := range patterns {
+			if pattern == "" {
+				return errors.New("service and trigger names cannot be empty")
+			}
+			if _, err := glob.MatchWithError(pattern, ""); err != nil {
+				return fmt.Errorf("name %q has an invalid format: %w", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// MetadataPropagationPolicy selects the Application labels and annotations which are propagated onto the resources of
+// the application. The labels and annotations set by the manifests of the resources are not overwritten.
+type MetadataPropagationPolicy struct {
+	// Labels holds globs matching the keys of the propagated labels, e.g. team
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,1,rep,name=labels"`
+	// Annotations holds globs matching the keys of the propagated annotations
+	Annotations []string `json:"annotations,omitempty" protobuf:"bytes,2,rep,name=annotations"`
+	// PodTemplates also propagates the labels and annotations onto the pod templates of the workload resources
+	PodTemplates bool `json:"podTemplates,omitempty" protobuf:"varint,3,opt,name=podTemplates"`
+}
+
+// Validate returns an error if the metadata propagation policy is invalid
+func (p *MetadataPropagationPolicy) Validate() error {
+	if err := validateMetadataKeyPatterns("label", p.Labels); err != nil {
+		return err
+	}
+	return validateMetadataKeyPatterns("annotation", p.Annotations)
+}
+
+func validateMetadataKeyPatterns(field string, patterns []string) error {
+	for _, pattern := range patterns {
+		if pattern == "" {
+			return fmt.Errorf("%s key cannot be empty", field)
+		}
+		if _, err := glob.MatchWithError(pattern, ""); err != nil {
+			return fmt.Errorf("%s key %q has an invalid format: %w", field, pattern, err)
+		}
+	}
+	return nil
+}
+
+// ImageRegistryRewriteRule replaces the registry of the container images of the resources deployed to the matching
+// clusters, e.g. with the registry mirroring them in an air-gapped environment
+type ImageRegistryRewriteRule struct {
+	// From is the registry, optionally followed by a repository prefix, of the rewritten images, e.g. docker.io or
+	// ghcr.io/argoproj. Images without a registry are images of docker.io.
+	From string `json:"from" protobuf:"bytes,1,opt,name=from"`
+	// To replaces From in the rewritten images, e.g. mirror.internal/docker.io
+	To string `json:"to" protobuf:"bytes,2,opt,name=to"`
+	// Clusters holds globs matching the server URL or the name of the destination clusters the rule applies to. The
+	// rule applies to all the clusters if it is empty.
+	Clusters []string `json:"clusters,omitempty" protobuf:"bytes,3,rep,name=clusters"`
+}
+
+// Validate returns an error if the image registry rewrite rule is invalid
+func (r ImageRegistryRewriteRule) Validate() error {
+	if r.From == "" || r.To == "" {
+		return errors.New("from and to are required")
+	}
+	for _, value := range []string{r.From, r.To} {
+		if strings.Contains(value, "://") || strings.ContainsAny(value, " \t@") {
+			return fmt.Errorf("%q is not a registry, optionally followed by a repository prefix", value)
+		}
+	}
+	for _, pattern := range r.Clusters {
+		if _, err := glob.MatchWithError(pattern, ""); err != nil {
+			return fmt.Errorf("cluster %q has an invalid format: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the sync options policy is invalid
+func (p SyncOptionsPolicy) Validate() error {
+	if p.Kind == "" {
+		return errors.New("kind is required")
+	}
+	for _, pattern := range []string{p.Group, p.Kind} {
+		if _, err := glob.MatchWithError(pattern, ""); err != nil {
+			return fmt.Errorf("%q has an invalid format: %w", pattern, err)
+		}
+	}
+	for _, option := range append(slices.Clone(p.Enforce), p.Forbid...) {
+		if key, _, ok := strings.Cut(option, "="); !ok || key == "" {
+			return fmt.Errorf("sync option %q is not in the key=value format", option)
+		}
+	}
+	for _, option := range p.Enforce {
+		if slices.Contains(p.Forbid, option) {
+			return fmt.Errorf("sync option %s cannot be both enforced and forbidden", option)
+		}
+	}
+	return nil
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -3153,6 +3666,17 @@ type ProjectRole struct {
 	JWTTokens []JWTToken `json:"jwtTokens,omitempty" protobuf:"bytes,4,rep,name=jwtTokens"`
 	// Groups are a list of OIDC group claims bound to this role
 	Groups []string `json:"groups,omitempty" protobuf:"bytes,5,rep,name=groups"`
+	// GroupExpirations are the expiry times of the bindings of the groups to this role. The groups are unbound from the
+	// role once their bindings expire, while the groups without expiry time stay bound.
+	GroupExpirations []GroupExpiration `json:"groupExpirations,omitempty" protobuf:"bytes,6,rep,name=groupExpirations"`
+}
+
+// GroupExpiration is the expiry time of the binding of an OIDC group to a project role
+type GroupExpiration struct {
+	// Group is the OIDC group claim bound to the role
+	Group string `json:"group" protobuf:"bytes,1,opt,name=group"`
+	// ExpiresAt is the time the group is unbound from the role at
+	ExpiresAt metav1.Time `json:"expiresAt" protobuf:"bytes,2,opt,name=expiresAt"`
 }
 
 // JWTToken holds the issuedAt and expiresAt values of a token
@@ -3371,7 +3895,7 @@ func (status *ApplicationStatus) GetConditions(conditionTypes map[ApplicationCon
 
 // IsError returns true if a condition indicates an error condition
 func (condition *ApplicationCondition) IsError() bool {
-	return strings.HasSuffix(condition.Type, "Error")
+	return strings.HasSuffix(condition.Type, "Error") || condition.Type == ApplicationConditionClusterUnreachable
 }
 
 // Equals compares two instances of ApplicationSource and return true if instances are equal.
@@ -3439,6 +3963,22 @@ func (spec ApplicationSpec) GetRevisionHistoryLimit() int {
 	return RevisionHistoryLimit
 }
 
+// GetRefreshInterval returns the interval at which the application is refreshed: its own refresh interval if set, or
+// else the given instance-wide one, bounded by the maximum refresh interval of the project
+func (spec ApplicationSpec) GetRefreshInterval(proj *AppProject, defaultInterval time.Duration) time.Duration {
+	interval := defaultInterval
+	if d, err := time.ParseDuration(spec.RefreshInterval); err == nil && d > 0 {
+		interval = d
+	}
+	if proj != nil {
+		maxInterval, err := time.ParseDuration(proj.Spec.MaxRefreshInterval)
+		if err == nil && maxInterval > 0 && (interval <= 0 || interval > maxInterval) {
+			interval = maxInterval
+		}
+	}
+	return interval
+}
+
 func isResourceInList(res metav1.GroupKind, list []metav1.GroupKind) bool {
 	for _, item := range list {
 		ok, err := filepath.Match(item.Kind, res.Kind)
@@ -247,6 +247,65 @@ func TestAppProject_IsDestinationPermitted(t *testing.T) {
 	}
 }
 
+func TestAppProject_IsDestinationPermittedByExpression(t *testing.T) {
+	proj := AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "team-a",
+		},
+		Spec: AppProjectSpec{
+			Destinations: []ApplicationDestination{
+				{Server: "https://kubernetes.default.svc", Namespace: "shared"},
+			},
+			DestinationExpression: "destination.server.endsWith('.prod.internal') && destination.namespace.startsWith(project.name)",
+		},
+	}
+	isPermitted := func(server, namespace string) bool {
+		permitted, err := proj.IsDestinationPermitted(&Cluster{Server: server}, namespace, func(_ string) ([]*Cluster, error) {
+			return []*Cluster{}, nil
+		})
+		require.NoError(t, err)
+		return permitted
+	}
+
+	// permitted by the destinations
+	assert.True(t, isPermitted("https://kubernetes.default.svc", "shared"))
+	// permitted by the expression
+	assert.True(t, isPermitted("https://eu.prod.internal", "team-a-payments"))
+	assert.False(t, isPermitted("https://eu.prod.internal", "team-b-payments"))
+	assert.False(t, isPermitted("https://eu.staging.internal", "team-a-payments"))
+	// the deny patterns of the destinations take precedence
+	proj.Spec.DestinationExpression = "true"
+	assert.True(t, isPermitted("https://eu.prod.internal", "kube-system"))
+	proj.Spec.Destinations = append(proj.Spec.Destinations, ApplicationDestination{Server: "https://eu.prod.internal", Namespace: "!kube-system"})
+	assert.False(t, isPermitted("https://eu.prod.internal", "kube-system"))
+	// invalid expressions do not permit any destination
+	proj.Spec.DestinationExpression = "destination +"
+	assert.False(t, isPermitted("https://us.prod.internal", "team-a-payments"))
+}
+
+func TestAppProject_IsSourcePermittedByExpression(t *testing.T) {
+	proj := AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "team-a",
+			Labels: map[string]string{"org": "acme"},
+		},
+		Spec: AppProjectSpec{
+			SourceRepos:          []string{"https://github.com/acme/shared.git"},
+			SourceRepoExpression: "source.repoURL.startsWith('https://github.com/' + project.labels.org + '/' + project.name + '-')",
+		},
+	}
+	assert.True(t, proj.IsSourcePermitted(ApplicationSource{RepoURL: "https://github.com/acme/shared.git"}))
+	assert.True(t, proj.IsSourcePermitted(ApplicationSource{RepoURL: "https://github.com/acme/team-a-payments.git"}))
+	assert.False(t, proj.IsSourcePermitted(ApplicationSource{RepoURL: "https://github.com/acme/team-b-payments.git"}))
+
+	// a label missing from the project fails the evaluation
+	proj.Labels = nil
+	assert.False(t, proj.IsSourcePermitted(ApplicationSource{RepoURL: "https://github.com/acme/team-a-payments.git"}))
+
+	proj.Spec.SourceRepoExpression = ""
+	assert.False(t, proj.IsSourcePermitted(ApplicationSource{RepoURL: "https://github.com/acme/team-a-payments.git"}))
+}
+
 func TestAppProject_IsNegatedDestinationPermitted(t *testing.T) {
 	testData := []struct {
 		projDest    []ApplicationDestination
@@ -618,6 +677,109 @@ func TestAppProject_RemoveGroupFromRole(t *testing.T) {
 	})
 }
 
+func TestAppProject_AddGroupToRoleWithExpiry(t *testing.T) {
+	expiresAt := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+	t.Run("NewGroup", func(t *testing.T) {
+		p := &AppProject{Spec: AppProjectSpec{Roles: []ProjectRole{{Name: "test-role"}}}}
+		got, err := p.AddGroupToRoleWithExpiry("test-role", "test-group", &expiresAt)
+		require.NoError(t, err)
+		assert.True(t, got)
+		assert.Equal(t, []string{"test-group"}, p.Spec.Roles[0].Groups)
+		assert.Equal(t, []GroupExpiration{{Group: "test-group", ExpiresAt: expiresAt}}, p.Spec.Roles[0].GroupExpirations)
+	})
+	t.Run("ExistingGroup", func(t *testing.T) {
+		p := &AppProject{Spec: AppProjectSpec{Roles: []ProjectRole{{Name: "test-role", Groups: []string{"test-group"}}}}}
+		got, err := p.AddGroupToRoleWithExpiry("test-role", "test-group", &expiresAt)
+		require.NoError(t, err)
+		assert.True(t, got)
+		assert.Equal(t, []GroupExpiration{{Group: "test-group", ExpiresAt: expiresAt}}, p.Spec.Roles[0].GroupExpirations)
+
+		got, err = p.AddGroupToRoleWithExpiry("test-role", "test-group", &expiresAt)
+		require.NoError(t, err)
+		assert.False(t, got)
+	})
+	t.Run("ExtendExpiry", func(t *testing.T) {
+		p := &AppProject{Spec: AppProjectSpec{Roles: []ProjectRole{{
+			Name:             "test-role",
+			Groups:           []string{"test-group"},
+			GroupExpirations: []GroupExpiration{{Group: "test-group", ExpiresAt: metav1.NewTime(expiresAt.Add(-time.Minute))}},
+		}}}}
+		got, err := p.AddGroupToRoleWithExpiry("test-role", "test-group", &expiresAt)
+		require.NoError(t, err)
+		assert.True(t, got)
+		assert.Equal(t, []GroupExpiration{{Group: "test-group", ExpiresAt: expiresAt}}, p.Spec.Roles[0].GroupExpirations)
+	})
+}
+
+func TestAppProject_RemoveGroupFromRole_Expiry(t *testing.T) {
+	p := &AppProject{Spec: AppProjectSpec{Roles: []ProjectRole{{
+		Name:             "test-role",
+		Groups:           []string{"test-group", "other-group"},
+		GroupExpirations: []GroupExpiration{{Group: "test-group", ExpiresAt: metav1.Now()}, {Group: "other-group", ExpiresAt: metav1.Now()}},
+	}}}}
+	got, err := p.RemoveGroupFromRole("test-role", "test-group")
+	require.NoError(t, err)
+	assert.True(t, got)
+	require.Len(t, p.Spec.Roles[0].GroupExpirations, 1)
+	assert.Equal(t, "other-group", p.Spec.Roles[0].GroupExpirations[0].Group)
+}
+
+func TestProjectRole_GroupExpirations(t *testing.T) {
+	now := time.Now()
+	role := ProjectRole{
+		Name:   "test-role",
+		Groups: []string{"expired", "soon", "later", "forever"},
+		GroupExpirations: []GroupExpiration{
+			{Group: "later", ExpiresAt: metav1.NewTime(now.Add(30 * 24 * time.Hour))},
+			{Group: "soon", ExpiresAt: metav1.NewTime(now.Add(time.Hour))},
+			{Group: "expired", ExpiresAt: metav1.NewTime(now.Add(-time.Hour))},
+			{Group: "removed", ExpiresAt: metav1.NewTime(now.Add(time.Hour))},
+		},
+	}
+	assert.True(t, role.IsGroupExpired("expired", now))
+	assert.False(t, role.IsGroupExpired("soon", now))
+	assert.False(t, role.IsGroupExpired("forever", now))
+
+	expirations := role.GroupExpirationsBefore(now.Add(7 * 24 * time.Hour))
+	require.Len(t, expirations, 2)
+	assert.Equal(t, "expired", expirations[0].Group)
+	assert.Equal(t, "soon", expirations[1].Group)
+}
+
+func TestAppProject_ProjectPoliciesString_GroupExpiry(t *testing.T) {
+	p := &AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-proj"},
+		Spec: AppProjectSpec{Roles: []ProjectRole{{
+			Name:             "my-role",
+			Groups:           []string{"expired-group", "active-group"},
+			GroupExpirations: []GroupExpiration{{Group: "expired-group", ExpiresAt: metav1.NewTime(time.Now().Add(-time.Minute))}},
+		}}},
+	}
+	policies := p.ProjectPoliciesString()
+	assert.Contains(t, policies, "g, active-group, proj:my-proj:my-role")
+	assert.NotContains(t, policies, "expired-group")
+}
+
+func TestAppProject_ValidateGroupExpirations(t *testing.T) {
+	t.Run("UnknownGroup", func(t *testing.T) {
+		p := newTestProject()
+		p.Spec.Roles[0].GroupExpirations = []GroupExpiration{{Group: "my-group", ExpiresAt: metav1.Now()}}
+		require.Error(t, p.ValidateProject())
+	})
+	t.Run("Duplicate", func(t *testing.T) {
+		p := newTestProject()
+		p.Spec.Roles[0].Groups = []string{"my-group"}
+		p.Spec.Roles[0].GroupExpirations = []GroupExpiration{{Group: "my-group", ExpiresAt: metav1.Now()}, {Group: "my-group", ExpiresAt: metav1.Now()}}
+		require.Error(t, p.ValidateProject())
+	})
+	t.Run("Valid", func(t *testing.T) {
+		p := newTestProject()
+		p.Spec.Roles[0].Groups = []string{"my-group"}
+		p.Spec.Roles[0].GroupExpirations = []GroupExpiration{{Group: "my-group", ExpiresAt: metav1.Now()}}
+		require.NoError(t, p.ValidateProject())
+	})
+}
+
 func newTestProject() *AppProject {
 	p := AppProject{
 		ObjectMeta: metav1.ObjectMeta{Name: "my-proj"},
@@ -649,6 +811,138 @@ func TestAppProject_ValidateSources(t *testing.T) {
 	require.Error(t, err)
 }
 
+// TestAppProject_ValidateExpressions tests for an invalid destination or source repository expression
+func TestAppProject_ValidateExpressions(t *testing.T) {
+	p := newTestProject()
+	p.Spec.DestinationExpression = "destination.namespace.startsWith(project.name)"
+	p.Spec.SourceRepoExpression = "source.repoURL.contains(project.name)"
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.SourceRepoExpression = "source +"
+	require.ErrorContains(t, p.ValidateProject(), "source repository expression is invalid")
+
+	p.Spec.SourceRepoExpression = "'not a bool'"
+	require.ErrorContains(t, p.ValidateProject(), "expression must evaluate to a bool")
+
+	// the variables of the destinations are not available to the source repository expressions
+	p.Spec.SourceRepoExpression = "destination.namespace == 'default'"
+	require.ErrorContains(t, p.ValidateProject(), "undeclared reference to 'destination'")
+}
+
+func TestAppProject_ValidateMaxRefreshInterval(t *testing.T) {
+	p := newTestProject()
+	p.Spec.MaxRefreshInterval = "1h"
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.MaxRefreshInterval = "hourly"
+	require.ErrorContains(t, p.ValidateProject(), "maxRefreshInterval 'hourly' is not a positive duration")
+
+	p.Spec.MaxRefreshInterval = "-1m"
+	require.Error(t, p.ValidateProject())
+}
+
+func TestAppProject_ValidateAllowedLinkURLs(t *testing.T) {
+	p := newTestProject()
+	p.Spec.AllowedLinkURLs = []string{"https://grafana.example.com/*"}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.AllowedLinkURLs = []string{"https://grafana.example.com/[*"}
+	require.ErrorContains(t, p.ValidateProject(), "allowed link URL 'https://grafana.example.com/[*' has an invalid format")
+}
+
+func TestAppProject_ValidateHelmPostRenderers(t *testing.T) {
+	p := newTestProject()
+	p.Spec.HelmPostRenderers = []string{"kustomize", "plugin:vault-substitution", "transformer:*", "*"}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.HelmPostRenderers = []string{"plugin:["}
+	require.ErrorContains(t, p.ValidateProject(), "helm post-renderer 'plugin:[' has an invalid format")
+
+	p.Spec.HelmPostRenderers = []string{"vault"}
+	require.ErrorContains(t, p.ValidateProject(), "helm post-renderer 'vault' must be kustomize, plugin:<name> or transformer:<name>")
+
+	p.Spec.HelmPostRenderers = []string{""}
+	require.ErrorContains(t, p.ValidateProject(), "helm post-renderer cannot be empty")
+}
+
+func TestAppProject_ValidateSyncOptionsPolicies(t *testing.T) {
+	p := newTestProject()
+	p.Spec.SyncOptionsPolicies = []SyncOptionsPolicy{
+		{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition", Enforce: []string{"ServerSideApply=true"}},
+		{Kind: "*", Forbid: []string{"Force=true"}},
+	}
+	require.NoError(t, p.ValidateProject())
+
+	for name, policy := range map[string]SyncOptionsPolicy{
+		"missing kind":       {Group: "*", Forbid: []string{"Force=true"}},
+		"invalid kind":       {Kind: "[", Forbid: []string{"Force=true"}},
+		"invalid option":     {Kind: "*", Forbid: []string{"Force"}},
+		"enforced forbidden": {Kind: "*", Enforce: []string{"Force=true"}, Forbid: []string{"Force=true"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			p.Spec.SyncOptionsPolicies = []SyncOptionsPolicy{policy}
+			require.ErrorContains(t, p.ValidateProject(), "sync options policy 0 is invalid")
+		})
+	}
+}
+
+func TestAppProject_ValidateImageRegistryRewriteRules(t *testing.T) {
+	p := newTestProject()
+	p.Spec.ImageRegistryRewriteRules = []ImageRegistryRewriteRule{
+		{From: "docker.io", To: "mirror.internal/docker.io", Clusters: []string{"air-gapped-*"}},
+		{From: "ghcr.io/argoproj/", To: "mirror.internal/argoproj"},
+	}
+	require.NoError(t, p.ValidateProject())
+
+	for name, rule := range map[string]ImageRegistryRewriteRule{
+		"missing to":      {From: "docker.io"},
+		"url scheme":      {From: "https://docker.io", To: "mirror.internal/docker.io"},
+		"invalid cluster": {From: "docker.io", To: "mirror.internal/docker.io", Clusters: []string{"["}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			p.Spec.ImageRegistryRewriteRules = []ImageRegistryRewriteRule{rule}
+			require.ErrorContains(t, p.ValidateProject(), "image registry rewrite rule 0 is invalid")
+		})
+	}
+}
+
+func TestAppProject_ValidateMetadataPropagationPolicy(t *testing.T) {
+	p := newTestProject()
+	p.Spec.MetadataPropagationPolicy = &MetadataPropagationPolicy{Labels: []string{"team", "cost-*"}, Annotations: []string{"example.com/*"}}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.MetadataPropagationPolicy = &MetadataPropagationPolicy{Labels: []string{""}}
+	require.ErrorContains(t, p.ValidateProject(), "metadata propagation policy is invalid: label key cannot be empty")
+
+	p.Spec.MetadataPropagationPolicy = &MetadataPropagationPolicy{Annotations: []string{"example.com/["}}
+	require.ErrorContains(t, p.ValidateProject(), "metadata propagation policy is invalid: annotation key")
+}
+
+func TestAppProject_ValidateNotificationAllowlist(t *testing.T) {
+	p := newTestProject()
+	p.Spec.NotificationAllowlist = &NotificationAllowlist{Services: []string{"slack", "webhook"}, Triggers: []string{"on-sync-*"}}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.NotificationAllowlist = &NotificationAllowlist{Services: []string{""}}
+	require.ErrorContains(t, p.ValidateProject(), "notification allowlist is invalid: service and trigger names cannot be empty")
+
+	p.Spec.NotificationAllowlist = &NotificationAllowlist{Triggers: []string{"on-["}}
+	require.ErrorContains(t, p.ValidateProject(), `notification allowlist is invalid: name "on-[" has an invalid format`)
+}
+
+// TestAppProject_ValidateApplicationSetTargetProjects tests for an invalid ApplicationSet target project
+func TestAppProject_ValidateApplicationSetTargetProjects(t *testing.T) {
+	p := newTestProject()
+	p.Spec.ApplicationSetTargetProjects = []string{"team-*"}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.ApplicationSetTargetProjects = []string{"team-["}
+	require.ErrorContains(t, p.ValidateProject(), "ApplicationSet target project 'team-[' has an invalid format")
+
+	p.Spec.ApplicationSetTargetProjects = []string{""}
+	require.ErrorContains(t, p.ValidateProject(), "ApplicationSet target project cannot be empty")
+}
+
 // TestAppProject_ValidateDestinations tests for an invalid destination
 func TestAppProject_ValidateDestinations(t *testing.T) {
 	p := newTestProject()
@@ -1802,6 +2096,24 @@ func TestApplicationSourceKustomize_MergeImage(t *testing.T) {
 	})
 }
 
+func TestApplicationFreeze_IsActive(t *testing.T) {
+	now := time.Now()
+	var notFrozen *ApplicationFreeze
+	assert.False(t, notFrozen.IsActive(now))
+	assert.True(t, (&ApplicationFreeze{Reason: "incident 42"}).IsActive(now))
+	freeze := &ApplicationFreeze{Until: &metav1.Time{Time: now.Add(time.Hour)}}
+	assert.True(t, freeze.IsActive(now))
+	assert.False(t, freeze.IsActive(now.Add(2*time.Hour)))
+}
+
+func TestApplicationFreeze_Message(t *testing.T) {
+	assert.Equal(t, "application is frozen", (&ApplicationFreeze{}).Message())
+	assert.Equal(t, "application is frozen by alice: incident 42", (&ApplicationFreeze{By: "alice", Reason: "incident 42"}).Message())
+	until := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	freeze := &ApplicationFreeze{By: "alice", Reason: "release", Until: &until}
+	assert.Equal(t, "application is frozen by alice until 2024-01-01T00:00:00Z: release", freeze.Message())
+}
+
 func TestSyncWindows_HasWindows(t *testing.T) {
 	t.Run("True", func(t *testing.T) {
 		proj := newTestProjectWithSyncWindows()
@@ -3408,6 +3720,21 @@ func TestApplicationSpec_GetRevisionHistoryLimit(t *testing.T) {
 	assert.Equal(t, 11, ApplicationSpec{RevisionHistoryLimit: &n}.GetRevisionHistoryLimit())
 }
 
+func TestApplicationSpec_GetRefreshInterval(t *testing.T) {
+	proj := &AppProject{Spec: AppProjectSpec{MaxRefreshInterval: "1h"}}
+	// default
+	assert.Equal(t, 3*time.Minute, ApplicationSpec{}.GetRefreshInterval(nil, 3*time.Minute))
+	// configured
+	assert.Equal(t, time.Minute, ApplicationSpec{RefreshInterval: "1m"}.GetRefreshInterval(nil, 3*time.Minute))
+	// invalid
+	assert.Equal(t, 3*time.Minute, ApplicationSpec{RefreshInterval: "hourly"}.GetRefreshInterval(nil, 3*time.Minute))
+	// bounded by the project
+	assert.Equal(t, time.Hour, ApplicationSpec{RefreshInterval: "24h"}.GetRefreshInterval(proj, 3*time.Minute))
+	assert.Equal(t, 30*time.Minute, ApplicationSpec{RefreshInterval: "30m"}.GetRefreshInterval(proj, 3*time.Minute))
+	// disabled refreshes are bounded by the project
+	assert.Equal(t, time.Hour, ApplicationSpec{}.GetRefreshInterval(proj, 0))
+}
+
 func TestProjectNormalize(t *testing.T) {
 	issuedAt := int64(1)
 	secondIssuedAt := issuedAt + 1
@@ -3556,6 +3883,32 @@ func TestSourceAllowsConcurrentProcessing_KustomizeParams(t *testing.T) {
 	})
 }
 
+func TestSourceAllowsConcurrentProcessing_HelmPostRenderers(t *testing.T) {
+	t.Run("Has Transformer", func(t *testing.T) {
+		src := ApplicationSource{Path: ".", Helm: &ApplicationSourceHelm{
+			PostRenderers: []HelmPostRenderer{{Transformer: HelmPostRendererTransformerCommonLabels}},
+		}}
+
+		assert.True(t, src.AllowsConcurrentProcessing())
+	})
+
+	t.Run("Has Kustomize", func(t *testing.T) {
+		src := ApplicationSource{Path: ".", Helm: &ApplicationSourceHelm{
+			PostRenderers: []HelmPostRenderer{{Transformer: HelmPostRendererTransformerCommonLabels}, {Kustomize: "overlay"}},
+		}}
+
+		assert.False(t, src.AllowsConcurrentProcessing())
+	})
+
+	t.Run("Has Plugin", func(t *testing.T) {
+		src := ApplicationSource{Path: ".", Helm: &ApplicationSourceHelm{
+			PostRenderers: []HelmPostRenderer{{Plugin: "my-plugin"}},
+		}}
+
+		assert.False(t, src.AllowsConcurrentProcessing())
+	})
+}
+
 func TestUnSetCascadedDeletion(t *testing.T) {
 	a := &Application{
 		ObjectMeta: metav1.ObjectMeta{
@@ -3925,6 +4278,72 @@ func TestAppProjectIsSourceNamespacePermitted(t *testing.T) {
 	})
 }
 
+func TestAppProject_IsApplicationSetTargetProjectPermitted(t *testing.T) {
+	proj := &AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "platform",
+			Namespace: "argocd",
+		},
+	}
+	// the project itself is always permitted
+	assert.True(t, proj.IsApplicationSetTargetProjectPermitted("platform"))
+	assert.False(t, proj.IsApplicationSetTargetProjectPermitted("team-a"))
+
+	proj.Spec.ApplicationSetTargetProjects = []string{"team-*", "shared"}
+	assert.True(t, proj.IsApplicationSetTargetProjectPermitted("platform"))
+	assert.True(t, proj.IsApplicationSetTargetProjectPermitted("team-a"))
+	assert.True(t, proj.IsApplicationSetTargetProjectPermitted("shared"))
+	assert.False(t, proj.IsApplicationSetTargetProjectPermitted("default"))
+	assert.False(t, proj.IsApplicationSetTargetProjectPermitted("shared-2"))
+}
+
+func TestAppProject_IsHelmPostRendererPermitted(t *testing.T) {
+	proj := &AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"}}
+	kustomize := HelmPostRenderer{Kustomize: "overlay"}
+	plugin := HelmPostRenderer{Plugin: "vault"}
+	labels := HelmPostRenderer{Transformer: HelmPostRendererTransformerCommonLabels}
+
+	// nothing is permitted without helm post-renderers
+	assert.False(t, proj.IsHelmPostRendererPermitted(kustomize))
+	assert.False(t, proj.IsHelmPostRendererPermitted(plugin))
+
+	proj.Spec.HelmPostRenderers = []string{"kustomize", "transformer:*"}
+	assert.True(t, proj.IsHelmPostRendererPermitted(kustomize))
+	assert.False(t, proj.IsHelmPostRendererPermitted(plugin))
+	assert.True(t, proj.IsHelmPostRendererPermitted(labels))
+
+	proj.Spec.HelmPostRenderers = []string{"*"}
+	assert.True(t, proj.IsHelmPostRendererPermitted(plugin))
+}
+
+func TestAppProject_IsLinkURLPermitted(t *testing.T) {
+	proj := &AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"}}
+
+	// all the http and https URLs are permitted without allowed link URLs
+	assert.True(t, proj.IsLinkURLPermitted("https://grafana.example.com/d/abc"))
+	assert.True(t, proj.IsLinkURLPermitted("http://docs.example.com"))
+	assert.False(t, proj.IsLinkURLPermitted("javascript:alert(1)"))
+	assert.False(t, proj.IsLinkURLPermitted("/relative/path"))
+	assert.False(t, proj.IsLinkURLPermitted("https://"))
+
+	proj.Spec.AllowedLinkURLs = []string{"https://grafana.example.com/*", "https://docs.example.com"}
+	assert.True(t, proj.IsLinkURLPermitted("https://grafana.example.com/d/abc?var-namespace=guestbook"))
+	assert.True(t, proj.IsLinkURLPermitted("https://docs.example.com"))
+	assert.False(t, proj.IsLinkURLPermitted("https://docs.example.com/other"))
+	assert.False(t, proj.IsLinkURLPermitted("http://grafana.example.com/d/abc"))
+	assert.False(t, proj.IsLinkURLPermitted("https://evil.example.com/?https://grafana.example.com/"))
+}
+
+func TestHelmPostRenderer_Validate(t *testing.T) {
+	require.NoError(t, HelmPostRenderer{Kustomize: "overlay"}.Validate())
+	require.NoError(t, HelmPostRenderer{Plugin: "vault"}.Validate())
+	require.NoError(t, HelmPostRenderer{Transformer: HelmPostRendererTransformerCommonAnnotations, Values: map[string]string{"team": "a"}}.Validate())
+	require.EqualError(t, HelmPostRenderer{}.Validate(), "exactly one of kustomize, plugin and transformer must be set")
+	require.EqualError(t, HelmPostRenderer{Kustomize: "overlay", Plugin: "vault"}.Validate(), "exactly one of kustomize, plugin and transformer must be set")
+	require.EqualError(t, HelmPostRenderer{Transformer: "namePrefix"}.Validate(), `unknown transformer "namePrefix"`)
+	require.EqualError(t, HelmPostRenderer{Plugin: "vault", Values: map[string]string{"a": "b"}}.Validate(), "values are only supported by transformers")
+}
+
 func Test_RBACName(t *testing.T) {
 	testApp := func(namespace, project string) *Application {
 		return &Application{
@@ -3978,6 +4397,9 @@ func TestGetSummary(t *testing.T) {
 	summary = tree.GetSummary(app)
 	assert.Len(t, summary.ExternalURLs, 1)
 	assert.Equal(t, url, summary.ExternalURLs[0])
+	app.Annotations[argocdcommon.AnnotationKeyLinkPrefix+"grafana"] = "https://grafana.example.com/d/abc?var-env={{.app.metadata.labels.env}}"
+	summary = tree.GetSummary(app)
+	assert.Equal(t, []string{url}, summary.ExternalURLs, "the templated links are not included")
 }
 
 func TestApplicationSourcePluginParameters_Environ_string(t *testing.T) {
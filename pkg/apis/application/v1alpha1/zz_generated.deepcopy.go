@@ -42,6 +42,46 @@ func (in *AWSAuthConfig) DeepCopy() *AWSAuthConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSOrganizationsGenerator) DeepCopyInto(out *AWSOrganizationsGenerator) {
+	*out = *in
+	if in.TagFilters != nil {
+		in, out := &in.TagFilters, &out.TagFilters
+		*out = make([]*TagFilter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TagFilter)
+				**out = **in
+			}
+		}
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSOrganizationsGenerator.
+func (in *AWSOrganizationsGenerator) DeepCopy() *AWSOrganizationsGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSOrganizationsGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppHealthStatus) DeepCopyInto(out *AppHealthStatus) {
 	*out = *in
@@ -194,6 +234,45 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]ApplicationDestinationServiceAccount, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedLinkURLs != nil {
+		in, out := &in.AllowedLinkURLs, &out.AllowedLinkURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApplicationSetTargetProjects != nil {
+		in, out := &in.ApplicationSetTargetProjects, &out.ApplicationSetTargetProjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HelmPostRenderers != nil {
+		in, out := &in.HelmPostRenderers, &out.HelmPostRenderers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncOptionsPolicies != nil {
+		in, out := &in.SyncOptionsPolicies, &out.SyncOptionsPolicies
+		*out = make([]SyncOptionsPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImageRegistryRewriteRules != nil {
+		in, out := &in.ImageRegistryRewriteRules, &out.ImageRegistryRewriteRules
+		*out = make([]ImageRegistryRewriteRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MetadataPropagationPolicy != nil {
+		in, out := &in.MetadataPropagationPolicy, &out.MetadataPropagationPolicy
+		*out = new(MetadataPropagationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NotificationAllowlist != nil {
+		in, out := &in.NotificationAllowlist, &out.NotificationAllowlist
+		*out = new(NotificationAllowlist)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -315,6 +394,30 @@ func (in *ApplicationDestinationServiceAccount) DeepCopy() *ApplicationDestinati
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationFreeze) DeepCopyInto(out *ApplicationFreeze) {
+	*out = *in
+	if in.At != nil {
+		in, out := &in.At, &out.At
+		*out = (*in).DeepCopy()
+	}
+	if in.Until != nil {
+		in, out := &in.Until, &out.Until
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationFreeze.
+func (in *ApplicationFreeze) DeepCopy() *ApplicationFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationList) DeepCopyInto(out *ApplicationList) {
 	*out = *in
@@ -521,6 +624,11 @@ func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AWSOrganizations != nil {
+		in, out := &in.AWSOrganizations, &out.AWSOrganizations
+		*out = new(AWSOrganizationsGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -642,6 +750,11 @@ func (in *ApplicationSetNestedGenerator) DeepCopyInto(out *ApplicationSetNestedG
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AWSOrganizations != nil {
+		in, out := &in.AWSOrganizations, &out.AWSOrganizations
+		*out = new(AWSOrganizationsGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -677,6 +790,27 @@ func (in ApplicationSetNestedGenerators) DeepCopy() ApplicationSetNestedGenerato
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetParam) DeepCopyInto(out *ApplicationSetParam) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetParam.
+func (in *ApplicationSetParam) DeepCopy() *ApplicationSetParam {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetParam)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetResourceIgnoreDifferences) DeepCopyInto(out *ApplicationSetResourceIgnoreDifferences) {
 	*out = *in
@@ -690,6 +824,11 @@ func (in *ApplicationSetResourceIgnoreDifferences) DeepCopyInto(out *Application
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.JSONPathExpressions != nil {
+		in, out := &in.JSONPathExpressions, &out.JSONPathExpressions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -797,6 +936,13 @@ func (in *ApplicationSetSpec) DeepCopyInto(out *ApplicationSetSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ParamsSchema != nil {
+		in, out := &in.ParamsSchema, &out.ParamsSchema
+		*out = make([]ApplicationSetParam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -985,6 +1131,11 @@ func (in *ApplicationSetTerminalGenerator) DeepCopyInto(out *ApplicationSetTermi
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AWSOrganizations != nil {
+		in, out := &in.AWSOrganizations, &out.AWSOrganizations
+		*out = new(AWSOrganizationsGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1066,6 +1217,11 @@ func (in *ApplicationSource) DeepCopyInto(out *ApplicationSource) {
 		*out = new(ApplicationSourcePlugin)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WatchPaths != nil {
+		in, out := &in.WatchPaths, &out.WatchPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1124,6 +1280,13 @@ func (in *ApplicationSourceHelm) DeepCopyInto(out *ApplicationSourceHelm) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PostRenderers != nil {
+		in, out := &in.PostRenderers, &out.PostRenderers
+		*out = make([]HelmPostRenderer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1377,6 +1540,11 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Freeze != nil {
+		in, out := &in.Freeze, &out.Freeze
+		*out = new(ApplicationFreeze)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1728,6 +1896,22 @@ func (in *ClusterConfig) DeepCopy() *ClusterConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConnectivityInfo) DeepCopyInto(out *ClusterConnectivityInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConnectivityInfo.
+func (in *ClusterConnectivityInfo) DeepCopy() *ClusterConnectivityInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConnectivityInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterGenerator) DeepCopyInto(out *ClusterGenerator) {
 	*out = *in
@@ -1740,6 +1924,11 @@ func (in *ClusterGenerator) DeepCopyInto(out *ClusterGenerator) {
 			(*out)[key] = val
 		}
 	}
+	if in.LabelTransforms != nil {
+		in, out := &in.LabelTransforms, &out.LabelTransforms
+		*out = make([]ClusterLabelTransform, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1763,6 +1952,7 @@ func (in *ClusterInfo) DeepCopyInto(out *ClusterInfo) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	out.ConnectivityInfo = in.ConnectivityInfo
 	return
 }
 
@@ -1776,6 +1966,22 @@ func (in *ClusterInfo) DeepCopy() *ClusterInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterLabelTransform) DeepCopyInto(out *ClusterLabelTransform) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLabelTransform.
+func (in *ClusterLabelTransform) DeepCopy() *ClusterLabelTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterLabelTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterList) DeepCopyInto(out *ClusterList) {
 	*out = *in
@@ -2080,6 +2286,22 @@ func (in *ExecProviderConfig) DeepCopy() *ExecProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCVerificationProbe) DeepCopyInto(out *GRPCVerificationProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCVerificationProbe.
+func (in *GRPCVerificationProbe) DeepCopy() *GRPCVerificationProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCVerificationProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitDirectoryGeneratorItem) DeepCopyInto(out *GitDirectoryGeneratorItem) {
 	*out = *in
@@ -2189,6 +2411,44 @@ func (in *GnuPGPublicKeyList) DeepCopy() *GnuPGPublicKeyList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupExpiration) DeepCopyInto(out *GroupExpiration) {
+	*out = *in
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupExpiration.
+func (in *GroupExpiration) DeepCopy() *GroupExpiration {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupExpiration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPVerificationProbe) DeepCopyInto(out *HTTPVerificationProbe) {
+	*out = *in
+	if in.ExpectedStatus != nil {
+		in, out := &in.ExpectedStatus, &out.ExpectedStatus
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPVerificationProbe.
+func (in *HTTPVerificationProbe) DeepCopy() *HTTPVerificationProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPVerificationProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
 	*out = *in
@@ -2262,6 +2522,29 @@ func (in *HelmParameter) DeepCopy() *HelmParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmPostRenderer) DeepCopyInto(out *HelmPostRenderer) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmPostRenderer.
+func (in *HelmPostRenderer) DeepCopy() *HelmPostRenderer {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmPostRenderer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostInfo) DeepCopyInto(out *HostInfo) {
 	*out = *in
@@ -2367,6 +2650,27 @@ func (in IgnoreDifferences) DeepCopy() IgnoreDifferences {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRegistryRewriteRule) DeepCopyInto(out *ImageRegistryRewriteRule) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRegistryRewriteRule.
+func (in *ImageRegistryRewriteRule) DeepCopy() *ImageRegistryRewriteRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRegistryRewriteRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Info) DeepCopyInto(out *Info) {
 	*out = *in
@@ -2665,6 +2969,22 @@ func (in *ListGenerator) DeepCopy() *ListGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LuaVerificationProbe) DeepCopyInto(out *LuaVerificationProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LuaVerificationProbe.
+func (in *LuaVerificationProbe) DeepCopy() *LuaVerificationProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(LuaVerificationProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedNamespaceMetadata) DeepCopyInto(out *ManagedNamespaceMetadata) {
 	*out = *in
@@ -2682,6 +3002,11 @@ func (in *ManagedNamespaceMetadata) DeepCopyInto(out *ManagedNamespaceMetadata)
 			(*out)[key] = val
 		}
 	}
+	if in.IgnoredKeys != nil {
+		in, out := &in.IgnoredKeys, &out.IgnoredKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2735,6 +3060,13 @@ func (in *MergeGenerator) DeepCopyInto(out *MergeGenerator) {
 		copy(*out, *in)
 	}
 	in.Template.DeepCopyInto(&out.Template)
+	if in.Overlays != nil {
+		in, out := &in.Overlays, &out.Overlays
+		*out = make([]MergeGeneratorOverlay, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2748,6 +3080,53 @@ func (in *MergeGenerator) DeepCopy() *MergeGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MergeGeneratorOverlay) DeepCopyInto(out *MergeGeneratorOverlay) {
+	*out = *in
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeGeneratorOverlay.
+func (in *MergeGeneratorOverlay) DeepCopy() *MergeGeneratorOverlay {
+	if in == nil {
+		return nil
+	}
+	out := new(MergeGeneratorOverlay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataPropagationPolicy) DeepCopyInto(out *MetadataPropagationPolicy) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataPropagationPolicy.
+func (in *MetadataPropagationPolicy) DeepCopy() *MetadataPropagationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataPropagationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NestedMatrixGenerator) DeepCopyInto(out *NestedMatrixGenerator) {
 	*out = *in
@@ -2786,6 +3165,13 @@ func (in *NestedMergeGenerator) DeepCopyInto(out *NestedMergeGenerator) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Overlays != nil {
+		in, out := &in.Overlays, &out.Overlays
+		*out = make([]MergeGeneratorOverlay, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2799,6 +3185,32 @@ func (in *NestedMergeGenerator) DeepCopy() *NestedMergeGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationAllowlist) DeepCopyInto(out *NotificationAllowlist) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationAllowlist.
+func (in *NotificationAllowlist) DeepCopy() *NotificationAllowlist {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationAllowlist)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OCIMetadata) DeepCopyInto(out *OCIMetadata) {
 	*out = *in
@@ -3119,6 +3531,13 @@ func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.GroupExpirations != nil {
+		in, out := &in.GroupExpirations, &out.GroupExpirations
+		*out = make([]GroupExpiration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3620,6 +4039,11 @@ func (in *ResourceAction) DeepCopy() *ResourceAction {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceActionDefinition) DeepCopyInto(out *ResourceActionDefinition) {
 	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make([]ResourceActionParam, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3655,7 +4079,9 @@ func (in *ResourceActions) DeepCopyInto(out *ResourceActions) {
 	if in.Definitions != nil {
 		in, out := &in.Definitions, &out.Definitions
 		*out = make([]ResourceActionDefinition, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -4014,6 +4440,16 @@ func (in *RevisionMetadata) DeepCopyInto(out *RevisionMetadata) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ChangedFiles != nil {
+		in, out := &in.ChangedFiles, &out.ChangedFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(RevisionPullRequest)
+		**out = **in
+	}
 	return
 }
 
@@ -4027,6 +4463,22 @@ func (in *RevisionMetadata) DeepCopy() *RevisionMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevisionPullRequest) DeepCopyInto(out *RevisionPullRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevisionPullRequest.
+func (in *RevisionPullRequest) DeepCopy() *RevisionPullRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(RevisionPullRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RevisionReference) DeepCopyInto(out *RevisionReference) {
 	*out = *in
@@ -4565,6 +5017,32 @@ func (in SyncOptions) DeepCopy() SyncOptions {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncOptionsPolicy) DeepCopyInto(out *SyncOptionsPolicy) {
+	*out = *in
+	if in.Enforce != nil {
+		in, out := &in.Enforce, &out.Enforce
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Forbid != nil {
+		in, out := &in.Forbid, &out.Forbid
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncOptionsPolicy.
+func (in *SyncOptionsPolicy) DeepCopy() *SyncOptionsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncOptionsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 	*out = *in
@@ -4588,6 +5066,18 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServerSideApplyIgnoredConflicts != nil {
+		in, out := &in.ServerSideApplyIgnoredConflicts, &out.ServerSideApplyIgnoredConflicts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VerificationProbes != nil {
+		in, out := &in.VerificationProbes, &out.VerificationProbes
+		*out = make([]VerificationProbe, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4822,3 +5312,39 @@ func (in *TagFilter) DeepCopy() *TagFilter {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationProbe) DeepCopyInto(out *VerificationProbe) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPVerificationProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPCVerificationProbe)
+		**out = **in
+	}
+	if in.Lua != nil {
+		in, out := &in.Lua, &out.Lua
+		*out = new(LuaVerificationProbe)
+		**out = **in
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationProbe.
+func (in *VerificationProbe) DeepCopy() *VerificationProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationProbe)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,113 @@
+package apiclient
+
+import (
+	"context"
+	"net/url"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// MetadataAuditComponent is the gRPC metadata of the component sending the requests to the repo server
+	MetadataAuditComponent = "x-argocd-audit-component"
+	// MetadataAuditUser is the gRPC metadata of the user triggering the requests to the repo server
+	MetadataAuditUser = "x-argocd-audit-user"
+	// MetadataAuditApplication is the gRPC metadata of the application triggering the requests to the repo server
+	MetadataAuditApplication = "x-argocd-audit-application"
+)
+
+// AuditInfo identifies who triggers the requests to the repo server, so that the accesses to the repositories can be
+// traced in the repository access audit
+type AuditInfo struct {
+	// Component is the Argo CD component sending the requests, e.g. argocd-server
+	Component string
+	// User is the user triggering the requests, empty for the requests of the controllers
+	User string
+	// Application is the qualified name of the application triggering the requests
+	Application string
+}
+
+type auditInfoContextKey struct{}
+
+// ContextWithAuditInfo returns a context whose requests to the repo server are attributed to the given user and
+// application. The empty fields are set by the clientset, see WithAuditComponent and WithAuditUser.
+func ContextWithAuditInfo(ctx context.Context, info AuditInfo) context.Context {
+	return context.WithValue(ctx, auditInfoContextKey{}, info)
+}
+
+// AuditInfoFromIncomingContext returns the audit info propagated by the client of the repo server
+func AuditInfoFromIncomingContext(ctx context.Context) AuditInfo {
+	md, _ := metadata.FromIncomingContext(ctx)
+	get := func(key string) string {
+		values := md.Get(key)
+		if len(values) == 0 {
+			return ""
+		}
+		value, err := url.QueryUnescape(values[0])
+		if err != nil {
+			return values[0]
+		}
+		return value
+	}
+	return AuditInfo{
+		Component:   get(MetadataAuditComponent),
+		User:        get(MetadataAuditUser),
+		Application: get(MetadataAuditApplication),
+	}
+}
+
+// ClientsetOption configures the repo server clientset
+type ClientsetOption func(*clientSet)
+
+// WithAuditComponent attributes the requests of the clientset to the given component
+func WithAuditComponent(component string) ClientsetOption {
+	return func(c *clientSet) {
+		c.auditComponent = component
+	}
+}
+
+// WithAuditUser attributes the requests of the clientset to the user returned by the given function for the context
+// of each request, e.g. the user authenticated by the API server
+func WithAuditUser(user func(ctx context.Context) string) ClientsetOption {
+	return func(c *clientSet) {
+		c.auditUser = user
+	}
+}
+
+// auditDialOptions returns the interceptors propagating the audit info of the requests as gRPC metadata
+func (c *clientSet) auditDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(c.outgoingAuditContext(ctx), method, req, reply, cc, opts...)
+		}),
+		grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(c.outgoingAuditContext(ctx), desc, cc, method, opts...)
+		}),
+	}
+}
+
+func (c *clientSet) outgoingAuditContext(ctx context.Context) context.Context {
+	info, _ := ctx.Value(auditInfoContextKey{}).(AuditInfo)
+	if info.Component == "" {
+		info.Component = c.auditComponent
+	}
+	if info.User == "" && c.auditUser != nil {
+		info.User = c.auditUser(ctx)
+	}
+	var kv []string
+	for key, value := range map[string]string{
+		MetadataAuditComponent:   info.Component,
+		MetadataAuditUser:        info.User,
+		MetadataAuditApplication: info.Application,
+	} {
+		if value != "" {
+			// the metadata values must be printable ASCII
+			kv = append(kv, key, url.QueryEscape(value))
+		}
+	}
+	if len(kv) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
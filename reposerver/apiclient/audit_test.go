@@ -0,0 +1,37 @@
+package apiclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuditInfoPropagation(t *testing.T) {
+	c := NewRepoServerClientset("localhost:8081", 60, TLSConfiguration{},
+		WithAuditComponent("argocd-server"),
+		WithAuditUser(func(_ context.Context) string { return "alice@example.com" }),
+	).(*clientSet)
+
+	t.Run("Clientset defaults", func(t *testing.T) {
+		md, _ := metadata.FromOutgoingContext(c.outgoingAuditContext(t.Context()))
+		ctx := metadata.NewIncomingContext(t.Context(), md)
+		assert.Equal(t, AuditInfo{Component: "argocd-server", User: "alice@example.com"}, AuditInfoFromIncomingContext(ctx))
+	})
+
+	t.Run("Context info", func(t *testing.T) {
+		ctx := ContextWithAuditInfo(t.Context(), AuditInfo{User: "bob", Application: "argocd/guestbook"})
+		md, _ := metadata.FromOutgoingContext(c.outgoingAuditContext(ctx))
+		assert.Equal(t, []string{"argocd%2Fguestbook"}, md.Get(MetadataAuditApplication))
+		ctx = metadata.NewIncomingContext(t.Context(), md)
+		assert.Equal(t, AuditInfo{Component: "argocd-server", User: "bob", Application: "argocd/guestbook"}, AuditInfoFromIncomingContext(ctx))
+	})
+
+	t.Run("No info", func(t *testing.T) {
+		c := NewRepoServerClientset("localhost:8081", 60, TLSConfiguration{}).(*clientSet)
+		_, ok := metadata.FromOutgoingContext(c.outgoingAuditContext(t.Context()))
+		assert.False(t, ok)
+		assert.Equal(t, AuditInfo{}, AuditInfoFromIncomingContext(t.Context()))
+	})
+}
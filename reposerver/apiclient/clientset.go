@@ -1,6 +1,7 @@
 package apiclient
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -32,6 +33,12 @@ type TLSConfiguration struct {
 	StrictValidation bool
 	// List of certificates to validate the peer against (if StrictCerts is true)
 	Certificates *x509.CertPool
+	// Function returning the certificates to validate the peer against, taking precedence over Certificates, so
+	// that the rotated certificates are used by the new connections
+	GetCertificates func() *x509.CertPool
+	// TLS config of the client, e.g. the mTLS config of the SPIFFE identities, taking precedence over the other
+	// settings if TLS is not disabled
+	ClientConfig *tls.Config
 }
 
 // Clientset represents repository server api clients
@@ -43,17 +50,20 @@ type clientSet struct {
 	address        string
 	timeoutSeconds int
 	tlsConfig      TLSConfiguration
+	auditComponent string
+	auditUser      func(ctx context.Context) string
 }
 
 func (c *clientSet) NewRepoServerClient() (utilio.Closer, RepoServerServiceClient, error) {
-	conn, err := NewConnection(c.address, c.timeoutSeconds, &c.tlsConfig)
+	conn, err := NewConnection(c.address, c.timeoutSeconds, &c.tlsConfig, c.auditDialOptions()...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to open a new connection to repo server: %w", err)
 	}
 	return conn, NewRepoServerServiceClient(conn), nil
 }
 
-func NewConnection(address string, timeoutSeconds int, tlsConfig *TLSConfiguration) (*grpc.ClientConn, error) {
+// NewConnection creates a new connection to the repo server, with the given additional dial options
+func NewConnection(address string, timeoutSeconds int, tlsConfig *TLSConfiguration, dialOpts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	retryOpts := []grpc_retry.CallOption{
 		grpc_retry.WithMax(3),
 		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(1000 * time.Millisecond)),
@@ -69,13 +79,19 @@ func NewConnection(address string, timeoutSeconds int, tlsConfig *TLSConfigurati
 		grpc.WithUnaryInterceptor(argogrpc.OTELUnaryClientInterceptor()),
 		grpc.WithStreamInterceptor(argogrpc.OTELStreamClientInterceptor()),
 	}
+	opts = append(opts, dialOpts...)
 
 	tlsC := &tls.Config{}
 	if !tlsConfig.DisableTLS {
-		if !tlsConfig.StrictValidation {
+		if tlsConfig.ClientConfig != nil {
+			tlsC = tlsConfig.ClientConfig.Clone()
+		} else if !tlsConfig.StrictValidation {
 			tlsC.InsecureSkipVerify = true
 		} else {
 			tlsC.RootCAs = tlsConfig.Certificates
+			if tlsConfig.GetCertificates != nil {
+				tlsC.RootCAs = tlsConfig.GetCertificates()
+			}
 		}
 		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsC)))
 	} else {
@@ -92,6 +108,10 @@ func NewConnection(address string, timeoutSeconds int, tlsConfig *TLSConfigurati
 }
 
 // NewRepoServerClientset creates new instance of repo server Clientset
-func NewRepoServerClientset(address string, timeoutSeconds int, tlsConfig TLSConfiguration) Clientset {
-	return &clientSet{address: address, timeoutSeconds: timeoutSeconds, tlsConfig: tlsConfig}
+func NewRepoServerClientset(address string, timeoutSeconds int, tlsConfig TLSConfiguration, opts ...ClientsetOption) Clientset {
+	c := &clientSet{address: address, timeoutSeconds: timeoutSeconds, tlsConfig: tlsConfig}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
@@ -63,7 +63,9 @@ type ManifestRequest struct {
 	// argocd.argoproj.io/manifest-generate-paths annotation value of the Application to allow optimize which resources propagated to cmpserver
 	AnnotationManifestGeneratePaths string `protobuf:"bytes,26,opt,name=annotationManifestGeneratePaths,proto3" json:"annotationManifestGeneratePaths,omitempty"`
 	// Holds instance installation id
-	InstallationID       string   `protobuf:"bytes,27,opt,name=installationID,proto3" json:"installationID,omitempty"`
+	InstallationID string `protobuf:"bytes,27,opt,name=installationID,proto3" json:"installationID,omitempty"`
+	// Namespace of the application for which the request is triggered
+	AppNamespace         string   `protobuf:"bytes,28,opt,name=appNamespace,proto3" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -270,6 +272,13 @@ func (m *ManifestRequest) GetInstallationID() string {
 	return ""
 }
 
+func (m *ManifestRequest) GetAppNamespace() string {
+	if m != nil {
+		return m.AppNamespace
+	}
+	return ""
+}
+
 type ManifestRequestWithFiles struct {
 	// Types that are valid to be assigned to Part:
 	//	*ManifestRequestWithFiles_Request
@@ -711,10 +720,14 @@ type ManifestResponse struct {
 	// Raw response of git verify-commit operation (always the empty string for Helm)
 	VerifyResult string `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
 	// Commands is the list of commands used to hydrate the manifests
-	Commands             []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Commands []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
+	// ToolVersions are the versions of the tools used to generate the manifests, by tool name
+	ToolVersions map[string]string `protobuf:"bytes,9,rep,name=toolVersions,proto3" json:"toolVersions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// BuildEnv is the build environment given to the tools used to generate the manifests
+	BuildEnv             []*v1alpha1.EnvEntry `protobuf:"bytes,10,rep,name=buildEnv,proto3" json:"buildEnv,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
@@ -799,6 +812,20 @@ func (m *ManifestResponse) GetCommands() []string {
 	return nil
 }
 
+func (m *ManifestResponse) GetToolVersions() map[string]string {
+	if m != nil {
+		return m.ToolVersions
+	}
+	return nil
+}
+
+func (m *ManifestResponse) GetBuildEnv() []*v1alpha1.EnvEntry {
+	if m != nil {
+		return m.BuildEnv
+	}
+	return nil
+}
+
 type ListRefsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -1323,7 +1350,11 @@ type RepoServerRevisionMetadataRequest struct {
 	// the revision within the repo
 	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
 	// whether to check signature on revision
-	CheckSignature       bool     `protobuf:"varint,3,opt,name=checkSignature,proto3" json:"checkSignature,omitempty"`
+	CheckSignature bool `protobuf:"varint,3,opt,name=checkSignature,proto3" json:"checkSignature,omitempty"`
+	// the revision to list the changed files from, if any
+	BaseRevision string `protobuf:"bytes,4,opt,name=baseRevision,proto3" json:"baseRevision,omitempty"`
+	// whether to look up the pull request which introduced the revision
+	IncludePullRequest   bool     `protobuf:"varint,5,opt,name=includePullRequest,proto3" json:"includePullRequest,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1383,6 +1414,20 @@ func (m *RepoServerRevisionMetadataRequest) GetCheckSignature() bool {
 	return false
 }
 
+func (m *RepoServerRevisionMetadataRequest) GetBaseRevision() string {
+	if m != nil {
+		return m.BaseRevision
+	}
+	return ""
+}
+
+func (m *RepoServerRevisionMetadataRequest) GetIncludePullRequest() bool {
+	if m != nil {
+		return m.IncludePullRequest
+	}
+	return false
+}
+
 type RepoServerRevisionChartDetailsRequest struct {
 	// the repo
 	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -2440,6 +2485,7 @@ func init() {
 	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
 	proto.RegisterType((*ResolveRevisionResponse)(nil), "repository.ResolveRevisionResponse")
 	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
+	proto.RegisterMapType((map[string]string)(nil), "repository.ManifestResponse.ToolVersionsEntry")
 	proto.RegisterType((*ListRefsRequest)(nil), "repository.ListRefsRequest")
 	proto.RegisterType((*Refs)(nil), "repository.Refs")
 	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
@@ -2478,158 +2524,164 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0xcb, 0x72, 0x1c, 0x49,
-	0x71, 0x9e, 0xd2, 0x4c, 0xea, 0x5d, 0xb6, 0xe5, 0xf6, 0xd8, 0x16, 0xda, 0x06, 0x3b, 0xbc, 0xf6,
-	0xee, 0x28, 0x6c, 0xc7, 0xae, 0xc1, 0xbb, 0x2c, 0xa1, 0x95, 0x6d, 0x49, 0x6b, 0xcb, 0x16, 0x6d,
-	0xef, 0x12, 0x06, 0x03, 0x51, 0xd3, 0x53, 0xea, 0xe9, 0x55, 0x3f, 0xca, 0xdd, 0xd5, 0x5a, 0xe4,
-	0x08, 0x2e, 0x40, 0x70, 0xe1, 0xc2, 0x69, 0x0f, 0x5c, 0xf9, 0x06, 0x82, 0x23, 0x27, 0x02, 0x8e,
-	0x04, 0x17, 0x2e, 0x44, 0x40, 0xf8, 0x4b, 0x88, 0x7a, 0xf4, 0x73, 0x7a, 0x46, 0x5a, 0x8f, 0xad,
-	0x05, 0x2e, 0x52, 0x57, 0x56, 0x56, 0x66, 0x56, 0x56, 0x66, 0x56, 0x66, 0xd6, 0xc0, 0xe5, 0x80,
-	0x50, 0x3f, 0x24, 0xc1, 0x01, 0x09, 0xd6, 0xc4, 0xa7, 0xcd, 0xfc, 0xe0, 0x30, 0xf3, 0xd9, 0xa5,
-	0x81, 0xcf, 0x7c, 0x04, 0x29, 0xa4, 0xf3, 0xc0, 0xb2, 0xd9, 0x20, 0xea, 0x75, 0x4d, 0xdf, 0x5d,
-	0xc3, 0x81, 0xe5, 0xd3, 0xc0, 0xff, 0x5c, 0x7c, 0xbc, 0x6b, 0xf6, 0xd7, 0x0e, 0x6e, 0xae, 0xd1,
-	0x7d, 0x6b, 0x0d, 0x53, 0x3b, 0x5c, 0xc3, 0x94, 0x3a, 0xb6, 0x89, 0x99, 0xed, 0x7b, 0x6b, 0x07,
-	0xd7, 0xb1, 0x43, 0x07, 0xf8, 0xfa, 0x9a, 0x45, 0x3c, 0x12, 0x60, 0x46, 0xfa, 0x92, 0x72, 0xe7,
-	0xbc, 0xe5, 0xfb, 0x96, 0x43, 0xd6, 0xc4, 0xa8, 0x17, 0xed, 0xad, 0x11, 0x97, 0x32, 0xc5, 0x56,
-	0xff, 0xe7, 0x1c, 0x2c, 0xec, 0x60, 0xcf, 0xde, 0x23, 0x21, 0x33, 0xc8, 0xf3, 0x88, 0x84, 0x0c,
-	0x3d, 0x83, 0x06, 0x17, 0x46, 0xab, 0xae, 0x56, 0xaf, 0xcc, 0xdc, 0xd8, 0xea, 0xa6, 0xd2, 0x74,
-	0x63, 0x69, 0xc4, 0xc7, 0x4f, 0xcd, 0x7e, 0xf7, 0xe0, 0x66, 0x97, 0xee, 0x5b, 0x5d, 0x2e, 0x4d,
-	0x37, 0x23, 0x4d, 0x37, 0x96, 0xa6, 0x6b, 0x24, 0xdb, 0x32, 0x04, 0x55, 0xd4, 0x81, 0x56, 0x40,
-	0x0e, 0xec, 0xd0, 0xf6, 0x3d, 0xad, 0xb6, 0x5a, 0xbd, 0xd2, 0x36, 0x92, 0x31, 0xd2, 0x60, 0xda,
-	0xf3, 0x37, 0xb0, 0x39, 0x20, 0x5a, 0x7d, 0xb5, 0x7a, 0xa5, 0x65, 0xc4, 0x43, 0xb4, 0x0a, 0x33,
-	0x98, 0xd2, 0x07, 0xb8, 0x47, 0x9c, 0xfb, 0xe4, 0x50, 0x6b, 0x88, 0x85, 0x59, 0x10, 0x5f, 0x8b,
-	0x29, 0x7d, 0x88, 0x5d, 0xa2, 0x35, 0xc5, 0x6c, 0x3c, 0x44, 0x17, 0xa0, 0xed, 0x61, 0x97, 0x84,
-	0x14, 0x9b, 0x44, 0x6b, 0x89, 0xb9, 0x14, 0x80, 0x7e, 0x0e, 0x4b, 0x19, 0xc1, 0x1f, 0xfb, 0x51,
-	0x60, 0x12, 0x0d, 0xc4, 0xd6, 0x1f, 0x4d, 0xb6, 0xf5, 0xf5, 0x22, 0x59, 0x63, 0x98, 0x13, 0xfa,
-	0x09, 0x34, 0xc5, 0xc9, 0x6b, 0x33, 0xab, 0xf5, 0xd7, 0xaa, 0x6d, 0x49, 0x16, 0x79, 0x30, 0x4d,
-	0x9d, 0xc8, 0xb2, 0xbd, 0x50, 0x9b, 0x15, 0x1c, 0x9e, 0x4c, 0xc6, 0x61, 0xc3, 0xf7, 0xf6, 0x6c,
-	0x6b, 0x07, 0x7b, 0xd8, 0x22, 0x2e, 0xf1, 0xd8, 0xae, 0x20, 0x6e, 0xc4, 0x4c, 0xd0, 0x0b, 0x58,
-	0xdc, 0x8f, 0x42, 0xe6, 0xbb, 0xf6, 0x0b, 0xf2, 0x88, 0xf2, 0xb5, 0xa1, 0x36, 0x27, 0xb4, 0xf9,
-	0x70, 0x32, 0xc6, 0xf7, 0x0b, 0x54, 0x8d, 0x21, 0x3e, 0xdc, 0x48, 0xf6, 0xa3, 0x1e, 0xf9, 0x8c,
-	0x04, 0xc2, 0xba, 0xe6, 0xa5, 0x91, 0x64, 0x40, 0xd2, 0x8c, 0x6c, 0x35, 0x0a, 0xb5, 0x85, 0xd5,
-	0xba, 0x34, 0xa3, 0x04, 0x84, 0xae, 0xc0, 0xc2, 0x01, 0x09, 0xec, 0xbd, 0xc3, 0xc7, 0xb6, 0xe5,
-	0x61, 0x16, 0x05, 0x44, 0x5b, 0x14, 0xa6, 0x58, 0x04, 0x23, 0x17, 0xe6, 0x06, 0xc4, 0x71, 0xb9,
-	0xca, 0x37, 0x02, 0xd2, 0x0f, 0xb5, 0x25, 0xa1, 0xdf, 0xcd, 0xc9, 0x4f, 0x50, 0x90, 0x33, 0xf2,
-	0xd4, 0xb9, 0x60, 0x9e, 0x6f, 0x28, 0x4f, 0x91, 0x3e, 0x82, 0xa4, 0x60, 0x05, 0x30, 0xba, 0x0c,
-	0xf3, 0x2c, 0xc0, 0xe6, 0xbe, 0xed, 0x59, 0x3b, 0x84, 0x0d, 0xfc, 0xbe, 0x76, 0x4a, 0x68, 0xa2,
-	0x00, 0x45, 0x26, 0x20, 0xe2, 0xe1, 0x9e, 0x43, 0xfa, 0xd2, 0x16, 0x9f, 0x1c, 0x52, 0x12, 0x6a,
-	0xa7, 0xc5, 0x2e, 0x6e, 0x76, 0x33, 0x11, 0xaa, 0x10, 0x20, 0xba, 0x77, 0x87, 0x56, 0xdd, 0xf5,
-	0x58, 0x70, 0x68, 0x94, 0x90, 0x43, 0xfb, 0x30, 0xc3, 0xf7, 0x11, 0x9b, 0xc2, 0x19, 0x61, 0x0a,
-	0xdb, 0x93, 0xe9, 0x68, 0x2b, 0x25, 0x68, 0x64, 0xa9, 0xa3, 0x2e, 0xa0, 0x01, 0x0e, 0x77, 0x22,
-	0x87, 0xd9, 0xd4, 0x21, 0x52, 0x8c, 0x50, 0x5b, 0x16, 0x6a, 0x2a, 0x99, 0x41, 0xf7, 0x01, 0x02,
-	0xb2, 0x17, 0xe3, 0x9d, 0x15, 0x3b, 0xbf, 0x36, 0x6e, 0xe7, 0x46, 0x82, 0x2d, 0x77, 0x9c, 0x59,
-	0xce, 0x99, 0xf3, 0x6d, 0x10, 0x93, 0x29, 0x6f, 0x17, 0x6e, 0xad, 0x09, 0x13, 0x2b, 0x99, 0xe1,
-	0xb6, 0xa8, 0xa0, 0x22, 0x68, 0x9d, 0x93, 0xd6, 0x9a, 0x01, 0xa1, 0x2d, 0xf8, 0x06, 0xf6, 0x3c,
-	0x9f, 0x89, 0xed, 0xc7, 0xa2, 0x6c, 0xaa, 0xf0, 0xbe, 0x8b, 0xd9, 0x20, 0xd4, 0x3a, 0x62, 0xd5,
-	0x51, 0x68, 0xdc, 0x24, 0x6c, 0x2f, 0x64, 0xd8, 0x71, 0x04, 0xd2, 0xf6, 0x1d, 0xed, 0xbc, 0x34,
-	0x89, 0x3c, 0xb4, 0x73, 0x17, 0xce, 0x8e, 0x38, 0x5c, 0xb4, 0x08, 0xf5, 0x7d, 0x72, 0x28, 0x2e,
-	0x85, 0xb6, 0xc1, 0x3f, 0xd1, 0x69, 0x68, 0x1e, 0x60, 0x27, 0x22, 0x22, 0x8c, 0xb7, 0x0c, 0x39,
-	0xb8, 0x5d, 0xfb, 0x76, 0xb5, 0xf3, 0xeb, 0x2a, 0x2c, 0x14, 0x54, 0x55, 0xb2, 0xfe, 0xc7, 0xd9,
-	0xf5, 0xaf, 0xc1, 0x71, 0xf6, 0x9e, 0xe0, 0xc0, 0x22, 0x2c, 0x23, 0x88, 0xfe, 0xf7, 0x2a, 0x68,
-	0x85, 0x33, 0xfc, 0x81, 0xcd, 0x06, 0xf7, 0x6c, 0x87, 0x84, 0xe8, 0x16, 0x4c, 0x07, 0x12, 0xa6,
-	0xae, 0xba, 0xf3, 0x63, 0x8e, 0x7e, 0xab, 0x62, 0xc4, 0xd8, 0xe8, 0x23, 0x68, 0xb9, 0x84, 0xe1,
-	0x3e, 0x66, 0x58, 0xc9, 0xbe, 0x5a, 0xb6, 0x92, 0x73, 0xd9, 0x51, 0x78, 0x5b, 0x15, 0x23, 0x59,
-	0x83, 0xde, 0x83, 0xa6, 0x39, 0x88, 0xbc, 0x7d, 0x71, 0xc9, 0xcd, 0xdc, 0xb8, 0x38, 0x6a, 0xf1,
-	0x06, 0x47, 0xda, 0xaa, 0x18, 0x12, 0xfb, 0xe3, 0x29, 0x68, 0x50, 0x1c, 0x30, 0xfd, 0x1e, 0x9c,
-	0x2e, 0x63, 0xc1, 0x6f, 0x56, 0x73, 0x40, 0xcc, 0xfd, 0x30, 0x72, 0x95, 0x9a, 0x93, 0x31, 0x42,
-	0xd0, 0x08, 0xed, 0x17, 0x52, 0xd5, 0x75, 0x43, 0x7c, 0xeb, 0x6f, 0xc3, 0xd2, 0x10, 0x37, 0x7e,
-	0xa8, 0x52, 0x36, 0x4e, 0x61, 0x56, 0xb1, 0xd6, 0x23, 0x38, 0xf3, 0x44, 0xe8, 0x22, 0xb9, 0x5e,
-	0x4e, 0x22, 0x57, 0xd0, 0xb7, 0x60, 0xb9, 0xc8, 0x36, 0xa4, 0xbe, 0x17, 0x12, 0xee, 0x6c, 0x22,
-	0x1e, 0xdb, 0xa4, 0x9f, 0xce, 0x0a, 0x29, 0x5a, 0x46, 0xc9, 0x8c, 0xfe, 0xfb, 0x1a, 0x2c, 0x1b,
-	0x24, 0xf4, 0x9d, 0x03, 0x12, 0x07, 0xcb, 0x93, 0x49, 0x77, 0x7e, 0x04, 0x75, 0x4c, 0xa9, 0x32,
-	0x93, 0xed, 0xd7, 0x96, 0x50, 0x18, 0x9c, 0x2a, 0x7a, 0x07, 0x96, 0xb0, 0xdb, 0xb3, 0xad, 0xc8,
-	0x8f, 0xc2, 0x78, 0x5b, 0xc2, 0xa8, 0xda, 0xc6, 0xf0, 0x04, 0x0f, 0x38, 0xa1, 0xf0, 0xc8, 0x6d,
-	0xaf, 0x4f, 0x7e, 0x26, 0x72, 0xa8, 0xba, 0x91, 0x05, 0xe9, 0x26, 0x9c, 0x1d, 0x52, 0x92, 0x52,
-	0x78, 0x36, 0x6d, 0xab, 0x16, 0xd2, 0xb6, 0x52, 0x31, 0x6a, 0x23, 0xc4, 0xd0, 0x5f, 0x56, 0x61,
-	0x31, 0x75, 0x2e, 0x45, 0xfe, 0x02, 0xb4, 0x5d, 0x05, 0x0b, 0xb5, 0xaa, 0x88, 0x99, 0x29, 0x20,
-	0x9f, 0xc1, 0xd5, 0x8a, 0x19, 0xdc, 0x32, 0x4c, 0xc9, 0x04, 0x5b, 0x6d, 0x5d, 0x8d, 0x72, 0x22,
-	0x37, 0x0a, 0x22, 0xaf, 0x00, 0x84, 0x49, 0x84, 0xd3, 0xa6, 0xc4, 0x6c, 0x06, 0x82, 0x74, 0x98,
-	0x95, 0xf7, 0xbd, 0x41, 0xc2, 0xc8, 0x61, 0xda, 0xb4, 0xc0, 0xc8, 0xc1, 0x84, 0xbf, 0xf9, 0xae,
-	0x8b, 0xbd, 0x7e, 0xa8, 0xb5, 0x84, 0xc8, 0xc9, 0x58, 0xf7, 0x61, 0xe1, 0x81, 0xcd, 0xf7, 0xb7,
-	0x17, 0x9e, 0x8c, 0xab, 0xbc, 0x0f, 0x0d, 0xce, 0x8c, 0x0b, 0xd5, 0x0b, 0xb0, 0x67, 0x0e, 0x48,
-	0xac, 0xc7, 0x64, 0xcc, 0x83, 0x00, 0xc3, 0x56, 0xa8, 0xd5, 0x04, 0x5c, 0x7c, 0xeb, 0x7f, 0xac,
-	0x49, 0x49, 0xd7, 0x29, 0x0d, 0xbf, 0xfe, 0x02, 0xa0, 0x3c, 0x25, 0xa9, 0x0f, 0xa7, 0x24, 0x05,
-	0x91, 0xbf, 0x4a, 0x4a, 0xf2, 0x9a, 0x2e, 0x39, 0x3d, 0x82, 0xe9, 0x75, 0x4a, 0xb9, 0x20, 0xe8,
-	0x3a, 0x34, 0x30, 0xa5, 0x52, 0xe1, 0x85, 0x78, 0xae, 0x50, 0xf8, 0x7f, 0x25, 0x92, 0x40, 0xed,
-	0xdc, 0x82, 0x76, 0x02, 0x3a, 0x8a, 0x6d, 0x3b, 0xcb, 0x76, 0x15, 0x40, 0xe6, 0xdc, 0xdb, 0xde,
-	0x9e, 0xcf, 0x8f, 0x94, 0x3b, 0x82, 0x5a, 0x2a, 0xbe, 0xf5, 0xdb, 0x31, 0x86, 0x90, 0xed, 0x1d,
-	0x68, 0xda, 0x8c, 0xb8, 0xb1, 0x70, 0xcb, 0x59, 0xe1, 0x52, 0x42, 0x86, 0x44, 0xd2, 0xff, 0xd2,
-	0x82, 0x73, 0xfc, 0xc4, 0x1e, 0x0b, 0x17, 0x5a, 0xa7, 0xf4, 0x0e, 0x61, 0xd8, 0x76, 0xc2, 0xef,
-	0x47, 0x24, 0x38, 0x7c, 0xc3, 0x86, 0x61, 0xc1, 0x94, 0xf4, 0x40, 0x15, 0x2d, 0x5f, 0x7b, 0xf9,
-	0xa5, 0xc8, 0xa7, 0x35, 0x57, 0xfd, 0xcd, 0xd4, 0x5c, 0x65, 0x35, 0x50, 0xe3, 0x84, 0x6a, 0xa0,
-	0xd1, 0x65, 0x70, 0xa6, 0xb8, 0x9e, 0xca, 0x17, 0xd7, 0x25, 0xa5, 0xc5, 0xf4, 0x71, 0x4b, 0x8b,
-	0x56, 0x69, 0x69, 0xe1, 0x96, 0xfa, 0x71, 0x5b, 0xa8, 0xfb, 0xbb, 0x59, 0x0b, 0x1c, 0x69, 0x6b,
-	0x93, 0x14, 0x19, 0xf0, 0x46, 0x8b, 0x8c, 0x4f, 0x73, 0x45, 0x83, 0x2c, 0xdb, 0xdf, 0x3b, 0xde,
-	0x9e, 0xc6, 0x94, 0x0f, 0xff, 0x77, 0xa9, 0xf7, 0xaf, 0x44, 0xc6, 0x45, 0xfd, 0x54, 0x07, 0xc9,
-	0x65, 0xcf, 0xef, 0x21, 0x7e, 0xed, 0xaa, 0xa0, 0xc5, 0xbf, 0xd1, 0x35, 0x68, 0x70, 0x25, 0xab,
-	0x94, 0xf8, 0x6c, 0x56, 0x9f, 0xfc, 0x24, 0xd6, 0x29, 0x7d, 0x4c, 0x89, 0x69, 0x08, 0x24, 0x74,
-	0x1b, 0xda, 0x89, 0xe1, 0x2b, 0xcf, 0xba, 0x90, 0x5d, 0x91, 0xf8, 0x49, 0xbc, 0x2c, 0x45, 0xe7,
-	0x6b, 0xfb, 0x76, 0x40, 0x4c, 0x91, 0x30, 0x36, 0x87, 0xd7, 0xde, 0x89, 0x27, 0x93, 0xb5, 0x09,
-	0x3a, 0xba, 0x0e, 0x53, 0xb2, 0xcf, 0x21, 0x3c, 0x68, 0xe6, 0xc6, 0xb9, 0xe1, 0x60, 0x1a, 0xaf,
-	0x52, 0x88, 0xfa, 0x9f, 0xab, 0xf0, 0x56, 0x6a, 0x10, 0xb1, 0x37, 0xc5, 0x39, 0xfb, 0xd7, 0x7f,
-	0xe3, 0x5e, 0x86, 0x79, 0x51, 0x24, 0xa4, 0xed, 0x0e, 0xd9, 0x79, 0x2b, 0x40, 0xf5, 0x3f, 0x54,
-	0xe1, 0xd2, 0xf0, 0x3e, 0x36, 0x06, 0x38, 0x60, 0xc9, 0xf1, 0x9e, 0xc4, 0x5e, 0xe2, 0x0b, 0xaf,
-	0x96, 0x5e, 0x78, 0xb9, 0xfd, 0xd5, 0xf3, 0xfb, 0xd3, 0xff, 0x54, 0x83, 0x99, 0x8c, 0x01, 0x95,
-	0x5d, 0x98, 0x3c, 0x19, 0x14, 0x76, 0x2b, 0xca, 0x42, 0x71, 0x29, 0xb4, 0x8d, 0x0c, 0x04, 0xed,
-	0x03, 0x50, 0x1c, 0x60, 0x97, 0x30, 0x12, 0xf0, 0x48, 0xce, 0x3d, 0xfe, 0xfe, 0xe4, 0xd1, 0x65,
-	0x37, 0xa6, 0x69, 0x64, 0xc8, 0xf3, 0x6c, 0x56, 0xb0, 0x0e, 0x55, 0xfc, 0x56, 0x23, 0xf4, 0x05,
-	0xcc, 0xef, 0xd9, 0x0e, 0xd9, 0x4d, 0x05, 0x99, 0x12, 0x82, 0x3c, 0x9a, 0x5c, 0x90, 0x7b, 0x59,
-	0xba, 0x46, 0x81, 0x8d, 0x7e, 0x15, 0x16, 0x8b, 0xfe, 0xc4, 0x85, 0xb4, 0x5d, 0x6c, 0x25, 0xda,
-	0x52, 0x23, 0x1d, 0xc1, 0x62, 0xd1, 0x7f, 0xf4, 0x7f, 0xd5, 0xe0, 0x4c, 0x42, 0x6e, 0xdd, 0xf3,
-	0xfc, 0xc8, 0x33, 0x45, 0xeb, 0xb0, 0xf4, 0x2c, 0x4e, 0x43, 0x93, 0xd9, 0xcc, 0x49, 0x12, 0x1f,
-	0x31, 0xe0, 0x77, 0x17, 0xf3, 0x7d, 0x87, 0xd9, 0x54, 0x1d, 0x70, 0x3c, 0x94, 0x67, 0xff, 0x3c,
-	0xb2, 0x03, 0xd2, 0x17, 0x91, 0xa0, 0x65, 0x24, 0x63, 0x3e, 0xc7, 0xb3, 0x1a, 0x91, 0xe2, 0x4b,
-	0x65, 0x26, 0x63, 0x61, 0xf7, 0xbe, 0xe3, 0x10, 0x93, 0xab, 0x23, 0x53, 0x04, 0x14, 0xa0, 0xa2,
-	0xb8, 0x60, 0x81, 0xed, 0x59, 0xaa, 0x04, 0x50, 0x23, 0x2e, 0x27, 0x0e, 0x02, 0x7c, 0xa8, 0x32,
-	0x7f, 0x39, 0x40, 0x1f, 0x42, 0xdd, 0xc5, 0x54, 0x5d, 0x74, 0x57, 0x73, 0xd1, 0xa1, 0x4c, 0x03,
-	0xdd, 0x1d, 0x4c, 0xe5, 0x4d, 0xc0, 0x97, 0x75, 0xde, 0x87, 0x56, 0x0c, 0xf8, 0x4a, 0x29, 0xe1,
-	0xe7, 0x30, 0x97, 0x0b, 0x3e, 0xe8, 0x29, 0x2c, 0xa7, 0x16, 0x95, 0x65, 0xa8, 0x92, 0xc0, 0xb7,
-	0x8e, 0x94, 0xcc, 0x18, 0x41, 0x40, 0x7f, 0x0e, 0x4b, 0xdc, 0x64, 0x84, 0xe3, 0x9f, 0x50, 0x69,
-	0xf3, 0x01, 0xb4, 0x13, 0x96, 0xa5, 0x36, 0xd3, 0x81, 0xd6, 0x41, 0xdc, 0xd2, 0x95, 0xb5, 0x4d,
-	0x32, 0xd6, 0xd7, 0x01, 0x65, 0xe5, 0x55, 0x37, 0xd0, 0xb5, 0x7c, 0x52, 0x7c, 0xa6, 0x78, 0xdd,
-	0x08, 0xf4, 0x38, 0x27, 0xfe, 0x47, 0x0d, 0x16, 0x36, 0x6d, 0xd1, 0x23, 0x39, 0xa1, 0x20, 0x77,
-	0x15, 0x16, 0xc3, 0xa8, 0xe7, 0xfa, 0xfd, 0xc8, 0x21, 0x2a, 0x29, 0x50, 0x37, 0xfd, 0x10, 0x7c,
-	0x5c, 0xf0, 0xe3, 0xca, 0xa2, 0x98, 0x0d, 0x54, 0xf5, 0x2b, 0xbe, 0xd1, 0x87, 0x70, 0xee, 0x21,
-	0xf9, 0x42, 0xed, 0x67, 0xd3, 0xf1, 0x7b, 0x3d, 0xdb, 0xb3, 0x62, 0x26, 0x4d, 0xc1, 0x64, 0x34,
-	0x42, 0x59, 0xaa, 0x38, 0x55, 0x9e, 0x2a, 0x26, 0x15, 0xf4, 0x86, 0xef, 0xba, 0x36, 0x53, 0x19,
-	0x65, 0x0e, 0xa6, 0xff, 0xb2, 0x0a, 0x8b, 0xa9, 0x66, 0xd5, 0xd9, 0xdc, 0x92, 0x3e, 0x24, 0x4f,
-	0xe6, 0x52, 0xf6, 0x64, 0x8a, 0xa8, 0xaf, 0xee, 0x3e, 0xb3, 0x59, 0xf7, 0xf9, 0x4d, 0x0d, 0xce,
-	0x6c, 0xda, 0x2c, 0x0e, 0x5c, 0xf6, 0xff, 0xda, 0x29, 0x97, 0x9c, 0x49, 0xe3, 0x78, 0x67, 0xd2,
-	0x2c, 0x39, 0x93, 0x2e, 0x2c, 0x17, 0x95, 0xa1, 0x0e, 0xe6, 0x34, 0x34, 0xa9, 0x68, 0x3a, 0xcb,
-	0xbe, 0x82, 0x1c, 0xe8, 0xbf, 0x98, 0x86, 0x8b, 0x9f, 0xd2, 0x3e, 0x66, 0x49, 0xcf, 0xe8, 0x9e,
-	0x1f, 0x88, 0xae, 0xf3, 0xc9, 0x68, 0xb1, 0xf0, 0x32, 0x58, 0x1b, 0xfb, 0x32, 0x58, 0x1f, 0xf3,
-	0x32, 0xd8, 0x38, 0xd6, 0xcb, 0x60, 0xf3, 0xc4, 0x5e, 0x06, 0x87, 0x6b, 0xad, 0xa9, 0xd2, 0x5a,
-	0xeb, 0x69, 0xae, 0x1e, 0x99, 0x16, 0x6e, 0xf3, 0x9d, 0xac, 0xdb, 0x8c, 0x3d, 0x9d, 0xb1, 0x4f,
-	0x1a, 0x85, 0x07, 0xb5, 0xd6, 0x91, 0x0f, 0x6a, 0xed, 0xe1, 0x07, 0xb5, 0xf2, 0x37, 0x19, 0x18,
-	0xf9, 0x26, 0x73, 0x19, 0xe6, 0xc3, 0x43, 0xcf, 0x24, 0xfd, 0xa4, 0x93, 0x38, 0x23, 0xb7, 0x9d,
-	0x87, 0xe6, 0x3c, 0x62, 0xb6, 0xe0, 0x11, 0x89, 0xa5, 0xce, 0x65, 0x2c, 0xb5, 0xcc, 0x4f, 0xe6,
-	0x47, 0x96, 0xb9, 0x85, 0xe7, 0x92, 0x85, 0xd2, 0xe7, 0x92, 0xff, 0x9a, 0x62, 0xeb, 0x33, 0x58,
-	0x19, 0x75, 0xca, 0xca, 0x79, 0x35, 0x98, 0x36, 0x07, 0xd8, 0xb3, 0x44, 0x5b, 0x50, 0x54, 0xff,
-	0x6a, 0x38, 0xae, 0x3a, 0xb8, 0xf1, 0xe5, 0x2c, 0x2c, 0xa5, 0x59, 0x3f, 0xff, 0x6b, 0x9b, 0x04,
-	0x3d, 0x82, 0xc5, 0xf8, 0x79, 0x29, 0x6e, 0xe4, 0xa2, 0x71, 0x6f, 0x27, 0x9d, 0x0b, 0xe5, 0x93,
-	0x52, 0x34, 0xbd, 0x82, 0x4c, 0x38, 0x57, 0x24, 0x98, 0x3e, 0xd3, 0x7c, 0x6b, 0x0c, 0xe5, 0x04,
-	0xeb, 0x28, 0x16, 0x57, 0xaa, 0xe8, 0x29, 0xcc, 0xe7, 0x1f, 0x13, 0x50, 0x2e, 0x0d, 0x2a, 0x7d,
-	0xdf, 0xe8, 0xe8, 0xe3, 0x50, 0x12, 0xf9, 0x9f, 0x71, 0x33, 0xc8, 0xf5, 0xcd, 0x91, 0x9e, 0xef,
-	0x08, 0x94, 0xbd, 0x3c, 0x74, 0xbe, 0x39, 0x16, 0x27, 0xa1, 0xfe, 0x01, 0xb4, 0xe2, 0x5e, 0x72,
-	0x5e, 0xcd, 0x85, 0x0e, 0x73, 0x67, 0x31, 0x4f, 0x6f, 0x2f, 0xd4, 0x2b, 0xe8, 0x23, 0x98, 0xe1,
-	0x68, 0x8f, 0x36, 0xb6, 0x9f, 0x60, 0xeb, 0x95, 0xd6, 0xb7, 0xe2, 0x5e, 0xeb, 0xf0, 0xe2, 0x4c,
-	0x07, 0xb6, 0x73, 0xaa, 0xa4, 0xeb, 0xa9, 0x57, 0xd0, 0xf7, 0x24, 0xff, 0x5d, 0xf5, 0xf3, 0x80,
-	0xe5, 0xae, 0xfc, 0x35, 0x4a, 0x37, 0xfe, 0x35, 0x4a, 0xf7, 0xae, 0x4b, 0xd9, 0x61, 0xa7, 0xa4,
-	0x2d, 0xa9, 0x08, 0x3c, 0x83, 0xb9, 0x4d, 0xc2, 0xd2, 0x2e, 0x02, 0xba, 0x74, 0xac, 0x5e, 0x4b,
-	0x47, 0x2f, 0xa2, 0x0d, 0x37, 0x22, 0xf4, 0x0a, 0xfa, 0xb2, 0x0a, 0xa7, 0x36, 0x09, 0x2b, 0xd6,
-	0xe5, 0xe8, 0xdd, 0x72, 0x26, 0x23, 0xea, 0xf7, 0xce, 0xc3, 0x49, 0x7d, 0x3a, 0x4f, 0x56, 0xaf,
-	0xa0, 0xdf, 0x56, 0x61, 0x7e, 0x93, 0xf0, 0x73, 0x4b, 0x64, 0xba, 0x3e, 0x5e, 0xa6, 0x92, 0x5a,
-	0xbc, 0x33, 0x61, 0x0f, 0x2c, 0xc3, 0x5d, 0xaf, 0xa0, 0xdf, 0x55, 0xe1, 0x6c, 0x46, 0x57, 0x59,
-	0x7e, 0xaf, 0x22, 0xdb, 0x27, 0x13, 0xfe, 0x10, 0x25, 0x43, 0x52, 0xaf, 0xa0, 0x5d, 0x61, 0x26,
-	0x69, 0xaa, 0x8f, 0x2e, 0x96, 0xe6, 0xf4, 0x09, 0xf7, 0x95, 0x51, 0xd3, 0x89, 0x69, 0x7c, 0x02,
-	0x33, 0x9b, 0x84, 0xc5, 0x39, 0x67, 0xde, 0xf8, 0x0b, 0xe5, 0x40, 0x3e, 0xfa, 0x14, 0xd3, 0x54,
-	0x61, 0xc4, 0x4b, 0x92, 0x56, 0x26, 0xaf, 0xca, 0x87, 0x9f, 0xd2, 0x04, 0x34, 0x6f, 0xc4, 0xe5,
-	0x69, 0x99, 0x5e, 0x41, 0xcf, 0x61, 0xb9, 0x3c, 0xfa, 0xa3, 0xb7, 0x8f, 0x9d, 0x07, 0x74, 0xae,
-	0x1e, 0x07, 0x35, 0x66, 0xf9, 0xf1, 0xfa, 0x5f, 0x5f, 0xae, 0x54, 0xff, 0xf6, 0x72, 0xa5, 0xfa,
-	0xef, 0x97, 0x2b, 0xd5, 0x1f, 0xde, 0x3c, 0xe2, 0x07, 0x6b, 0x99, 0xdf, 0xc0, 0x61, 0x6a, 0x9b,
-	0x8e, 0x4d, 0x3c, 0xd6, 0x9b, 0x12, 0x21, 0xe0, 0xe6, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc9,
-	0x9b, 0xb9, 0x62, 0x22, 0x27, 0x00, 0x00,
+	// 2506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x5d, 0x73, 0x1c, 0x47,
+	0x51, 0x77, 0x27, 0x9d, 0xee, 0x5a, 0xb2, 0x3e, 0xc6, 0xb6, 0xbc, 0x5e, 0xcb, 0x42, 0x59, 0xb0,
+	0xcb, 0xb1, 0x93, 0x53, 0xd9, 0xae, 0xc4, 0xe0, 0x84, 0xa4, 0x14, 0x59, 0x96, 0x1c, 0x5b, 0xb6,
+	0x58, 0x2b, 0xa1, 0x0c, 0x06, 0x6a, 0x6e, 0x6f, 0xb4, 0xb7, 0xd1, 0x7e, 0x8c, 0x77, 0x67, 0x2f,
+	0xc8, 0x55, 0x54, 0x51, 0x40, 0xf1, 0xc2, 0x0b, 0x4f, 0xa9, 0x82, 0x57, 0x7e, 0x03, 0xc5, 0x23,
+	0x8f, 0xf0, 0x48, 0xf1, 0xc2, 0x03, 0x0f, 0x50, 0xfe, 0x25, 0xd4, 0x7c, 0xec, 0xde, 0xee, 0xde,
+	0xde, 0x49, 0xf6, 0xd9, 0x0a, 0xf0, 0x22, 0xed, 0xf4, 0xf4, 0x74, 0xf7, 0xf4, 0x74, 0xf7, 0x74,
+	0xf7, 0x1c, 0x5c, 0x0e, 0x09, 0x0d, 0x22, 0x12, 0xf6, 0x48, 0xb8, 0x26, 0x3e, 0x1d, 0x16, 0x84,
+	0x87, 0x99, 0xcf, 0x16, 0x0d, 0x03, 0x16, 0x20, 0xe8, 0x43, 0xf4, 0x07, 0xb6, 0xc3, 0xba, 0x71,
+	0xbb, 0x65, 0x05, 0xde, 0x1a, 0x0e, 0xed, 0x80, 0x86, 0xc1, 0x17, 0xe2, 0xe3, 0x5d, 0xab, 0xb3,
+	0xd6, 0xbb, 0xb9, 0x46, 0x0f, 0xec, 0x35, 0x4c, 0x9d, 0x68, 0x0d, 0x53, 0xea, 0x3a, 0x16, 0x66,
+	0x4e, 0xe0, 0xaf, 0xf5, 0xae, 0x63, 0x97, 0x76, 0xf1, 0xf5, 0x35, 0x9b, 0xf8, 0x24, 0xc4, 0x8c,
+	0x74, 0x24, 0x65, 0xfd, 0x82, 0x1d, 0x04, 0xb6, 0x4b, 0xd6, 0xc4, 0xa8, 0x1d, 0xef, 0xaf, 0x11,
+	0x8f, 0x32, 0xc5, 0xd6, 0xf8, 0xf9, 0x1c, 0xcc, 0xef, 0x60, 0xdf, 0xd9, 0x27, 0x11, 0x33, 0xc9,
+	0xb3, 0x98, 0x44, 0x0c, 0x3d, 0x85, 0x49, 0x2e, 0x8c, 0x56, 0x59, 0xad, 0x5c, 0x99, 0xb9, 0xb1,
+	0xdd, 0xea, 0x4b, 0xd3, 0x4a, 0xa4, 0x11, 0x1f, 0x3f, 0xb1, 0x3a, 0xad, 0xde, 0xcd, 0x16, 0x3d,
+	0xb0, 0x5b, 0x5c, 0x9a, 0x56, 0x46, 0x9a, 0x56, 0x22, 0x4d, 0xcb, 0x4c, 0xb7, 0x65, 0x0a, 0xaa,
+	0x48, 0x87, 0x46, 0x48, 0x7a, 0x4e, 0xe4, 0x04, 0xbe, 0x56, 0x5d, 0xad, 0x5c, 0x69, 0x9a, 0xe9,
+	0x18, 0x69, 0x30, 0xed, 0x07, 0x1b, 0xd8, 0xea, 0x12, 0xad, 0xb6, 0x5a, 0xb9, 0xd2, 0x30, 0x93,
+	0x21, 0x5a, 0x85, 0x19, 0x4c, 0xe9, 0x03, 0xdc, 0x26, 0xee, 0x7d, 0x72, 0xa8, 0x4d, 0x8a, 0x85,
+	0x59, 0x10, 0x5f, 0x8b, 0x29, 0x7d, 0x88, 0x3d, 0xa2, 0x4d, 0x89, 0xd9, 0x64, 0x88, 0x96, 0xa1,
+	0xe9, 0x63, 0x8f, 0x44, 0x14, 0x5b, 0x44, 0x6b, 0x88, 0xb9, 0x3e, 0x00, 0xfd, 0x0c, 0x16, 0x33,
+	0x82, 0x3f, 0x0e, 0xe2, 0xd0, 0x22, 0x1a, 0x88, 0xad, 0x3f, 0x1a, 0x6f, 0xeb, 0xeb, 0x45, 0xb2,
+	0xe6, 0x20, 0x27, 0xf4, 0x63, 0x98, 0x12, 0x27, 0xaf, 0xcd, 0xac, 0xd6, 0x5e, 0xab, 0xb6, 0x25,
+	0x59, 0xe4, 0xc3, 0x34, 0x75, 0x63, 0xdb, 0xf1, 0x23, 0x6d, 0x56, 0x70, 0xd8, 0x1b, 0x8f, 0xc3,
+	0x46, 0xe0, 0xef, 0x3b, 0xf6, 0x0e, 0xf6, 0xb1, 0x4d, 0x3c, 0xe2, 0xb3, 0x5d, 0x41, 0xdc, 0x4c,
+	0x98, 0xa0, 0xe7, 0xb0, 0x70, 0x10, 0x47, 0x2c, 0xf0, 0x9c, 0xe7, 0xe4, 0x11, 0xe5, 0x6b, 0x23,
+	0xed, 0x94, 0xd0, 0xe6, 0xc3, 0xf1, 0x18, 0xdf, 0x2f, 0x50, 0x35, 0x07, 0xf8, 0x70, 0x23, 0x39,
+	0x88, 0xdb, 0xe4, 0x73, 0x12, 0x0a, 0xeb, 0x9a, 0x93, 0x46, 0x92, 0x01, 0x49, 0x33, 0x72, 0xd4,
+	0x28, 0xd2, 0xe6, 0x57, 0x6b, 0xd2, 0x8c, 0x52, 0x10, 0xba, 0x02, 0xf3, 0x3d, 0x12, 0x3a, 0xfb,
+	0x87, 0x8f, 0x1d, 0xdb, 0xc7, 0x2c, 0x0e, 0x89, 0xb6, 0x20, 0x4c, 0xb1, 0x08, 0x46, 0x1e, 0x9c,
+	0xea, 0x12, 0xd7, 0xe3, 0x2a, 0xdf, 0x08, 0x49, 0x27, 0xd2, 0x16, 0x85, 0x7e, 0xb7, 0xc6, 0x3f,
+	0x41, 0x41, 0xce, 0xcc, 0x53, 0xe7, 0x82, 0xf9, 0x81, 0xa9, 0x3c, 0x45, 0xfa, 0x08, 0x92, 0x82,
+	0x15, 0xc0, 0xe8, 0x32, 0xcc, 0xb1, 0x10, 0x5b, 0x07, 0x8e, 0x6f, 0xef, 0x10, 0xd6, 0x0d, 0x3a,
+	0xda, 0x69, 0xa1, 0x89, 0x02, 0x14, 0x59, 0x80, 0x88, 0x8f, 0xdb, 0x2e, 0xe9, 0x48, 0x5b, 0xdc,
+	0x3b, 0xa4, 0x24, 0xd2, 0xce, 0x88, 0x5d, 0xdc, 0x6c, 0x65, 0x22, 0x54, 0x21, 0x40, 0xb4, 0x36,
+	0x07, 0x56, 0x6d, 0xfa, 0x2c, 0x3c, 0x34, 0x4b, 0xc8, 0xa1, 0x03, 0x98, 0xe1, 0xfb, 0x48, 0x4c,
+	0xe1, 0xac, 0x30, 0x85, 0x7b, 0xe3, 0xe9, 0x68, 0xbb, 0x4f, 0xd0, 0xcc, 0x52, 0x47, 0x2d, 0x40,
+	0x5d, 0x1c, 0xed, 0xc4, 0x2e, 0x73, 0xa8, 0x4b, 0xa4, 0x18, 0x91, 0xb6, 0x24, 0xd4, 0x54, 0x32,
+	0x83, 0xee, 0x03, 0x84, 0x64, 0x3f, 0xc1, 0x3b, 0x27, 0x76, 0x7e, 0x6d, 0xd4, 0xce, 0xcd, 0x14,
+	0x5b, 0xee, 0x38, 0xb3, 0x9c, 0x33, 0xe7, 0xdb, 0x20, 0x16, 0x93, 0x10, 0xe1, 0x8b, 0x9a, 0x26,
+	0x4c, 0xac, 0x64, 0x86, 0xdb, 0xa2, 0x82, 0x8a, 0xa0, 0x75, 0x5e, 0x5a, 0x6b, 0x06, 0x84, 0xb6,
+	0xe1, 0x1b, 0xd8, 0xf7, 0x03, 0x26, 0xb6, 0x9f, 0x88, 0xb2, 0xa5, 0xc2, 0xfb, 0x2e, 0x66, 0xdd,
+	0x48, 0xd3, 0xc5, 0xaa, 0xa3, 0xd0, 0xb8, 0x49, 0x38, 0x7e, 0xc4, 0xb0, 0xeb, 0x0a, 0xa4, 0x7b,
+	0x77, 0xb4, 0x0b, 0xd2, 0x24, 0xf2, 0x50, 0x64, 0xc0, 0xac, 0x8a, 0x9a, 0x32, 0x5a, 0x2e, 0x0b,
+	0xac, 0x1c, 0x4c, 0xdf, 0x84, 0x73, 0x43, 0x0c, 0x00, 0x2d, 0x40, 0xed, 0x80, 0x1c, 0x8a, 0x8b,
+	0xa3, 0x69, 0xf2, 0x4f, 0x74, 0x06, 0xa6, 0x7a, 0xd8, 0x8d, 0x89, 0x08, 0xf5, 0x0d, 0x53, 0x0e,
+	0x6e, 0x57, 0xbf, 0x5d, 0xd1, 0x7f, 0x5d, 0x81, 0xf9, 0x82, 0x3a, 0x4b, 0xd6, 0xff, 0x28, 0xbb,
+	0xfe, 0x35, 0x38, 0xd7, 0xfe, 0x1e, 0x0e, 0x6d, 0xc2, 0x32, 0x82, 0x18, 0x7f, 0xaf, 0x80, 0x56,
+	0x38, 0xe7, 0xef, 0x3b, 0xac, 0x7b, 0xd7, 0x71, 0x49, 0x84, 0x6e, 0xc1, 0x74, 0x28, 0x61, 0xea,
+	0x3a, 0xbc, 0x30, 0xc2, 0x3c, 0xb6, 0x27, 0xcc, 0x04, 0x1b, 0x7d, 0x04, 0x0d, 0x8f, 0x30, 0xdc,
+	0xc1, 0x0c, 0x2b, 0xd9, 0x57, 0xcb, 0x56, 0x72, 0x2e, 0x3b, 0x0a, 0x6f, 0x7b, 0xc2, 0x4c, 0xd7,
+	0xa0, 0xf7, 0x60, 0xca, 0xea, 0xc6, 0xfe, 0x81, 0xb8, 0x08, 0x67, 0x6e, 0x5c, 0x1c, 0xb6, 0x78,
+	0x83, 0x23, 0x6d, 0x4f, 0x98, 0x12, 0xfb, 0x93, 0x3a, 0x4c, 0x52, 0x1c, 0x32, 0xe3, 0x2e, 0x9c,
+	0x29, 0x63, 0xc1, 0x6f, 0x5f, 0xab, 0x4b, 0xac, 0x83, 0x28, 0xf6, 0x94, 0x9a, 0xd3, 0x31, 0x42,
+	0x30, 0x19, 0x39, 0xcf, 0xa5, 0xaa, 0x6b, 0xa6, 0xf8, 0x36, 0xde, 0x86, 0xc5, 0x01, 0x6e, 0xfc,
+	0x50, 0xa5, 0x6c, 0x9c, 0xc2, 0xac, 0x62, 0x6d, 0xc4, 0x70, 0x76, 0x4f, 0xe8, 0x22, 0xbd, 0x82,
+	0x4e, 0x22, 0x9f, 0x30, 0xb6, 0x61, 0xa9, 0xc8, 0x36, 0xa2, 0x81, 0x1f, 0x11, 0xee, 0x90, 0x22,
+	0x66, 0x3b, 0xa4, 0xd3, 0x9f, 0x15, 0x52, 0x34, 0xcc, 0x92, 0x19, 0xe3, 0x0f, 0x55, 0x58, 0x32,
+	0x49, 0x14, 0xb8, 0x3d, 0x92, 0x04, 0xd4, 0x93, 0x49, 0x89, 0x7e, 0x08, 0x35, 0x4c, 0xa9, 0x56,
+	0x7d, 0x1d, 0xb1, 0x31, 0x93, 0x74, 0x98, 0x9c, 0x2a, 0x7a, 0x07, 0x16, 0xb1, 0xd7, 0x76, 0xec,
+	0x38, 0x88, 0xa3, 0x64, 0x5b, 0xc2, 0xa8, 0x9a, 0xe6, 0xe0, 0x04, 0x0f, 0x4a, 0x91, 0xf0, 0xc8,
+	0x7b, 0x7e, 0x87, 0xfc, 0x54, 0xe4, 0x59, 0x35, 0x33, 0x0b, 0x32, 0x2c, 0x38, 0x37, 0xa0, 0x24,
+	0xa5, 0xf0, 0x6c, 0x6a, 0x57, 0x29, 0xa4, 0x76, 0xa5, 0x62, 0x54, 0x87, 0x88, 0x61, 0xfc, 0xb3,
+	0x06, 0x0b, 0x7d, 0xe7, 0x52, 0xe4, 0x97, 0xa1, 0xe9, 0x29, 0x58, 0xa4, 0x55, 0x44, 0x5c, 0xed,
+	0x03, 0xf2, 0x59, 0x5e, 0xb5, 0x98, 0xe5, 0x2d, 0x41, 0x5d, 0x26, 0xe1, 0x6a, 0xeb, 0x6a, 0x94,
+	0x13, 0x79, 0xb2, 0x20, 0xf2, 0x0a, 0x40, 0x94, 0x46, 0x38, 0xad, 0x2e, 0x66, 0x33, 0x10, 0x1e,
+	0x2c, 0x65, 0x4e, 0x60, 0x92, 0x28, 0x76, 0x99, 0x36, 0x2d, 0x83, 0x65, 0x16, 0x26, 0xfc, 0x2d,
+	0xf0, 0x3c, 0xec, 0x77, 0x22, 0xad, 0x21, 0x44, 0x4e, 0xc7, 0xc8, 0x84, 0x59, 0x16, 0x04, 0x6e,
+	0x9a, 0x8d, 0x34, 0xc5, 0xfd, 0xd3, 0x2a, 0x0f, 0x30, 0x52, 0x07, 0xad, 0xbd, 0xcc, 0x02, 0x79,
+	0x05, 0xe5, 0x68, 0xa0, 0x36, 0x34, 0xda, 0xb1, 0xe3, 0x76, 0x36, 0xfd, 0x9e, 0x06, 0x82, 0xde,
+	0xdd, 0xf1, 0xec, 0x69, 0xd3, 0xef, 0x49, 0x3e, 0x29, 0x5d, 0xfd, 0x63, 0x58, 0x1c, 0x10, 0xe3,
+	0xa8, 0xd0, 0xdf, 0xcc, 0x46, 0xdc, 0x00, 0xe6, 0x1f, 0x38, 0x7c, 0x53, 0xfb, 0xd1, 0xc9, 0xc4,
+	0x88, 0xf7, 0x61, 0x92, 0x33, 0xe3, 0xa7, 0xd1, 0x0e, 0xb1, 0x6f, 0x75, 0x49, 0x62, 0x40, 0xe9,
+	0x98, 0x47, 0x3f, 0x86, 0xed, 0x48, 0xab, 0x0a, 0xb8, 0xf8, 0x36, 0xfe, 0x54, 0x95, 0x92, 0xae,
+	0x53, 0x1a, 0x7d, 0xfd, 0xd5, 0x51, 0x79, 0xbe, 0x56, 0x1b, 0xcc, 0xd7, 0x0a, 0x22, 0xbf, 0x4c,
+	0xbe, 0xf6, 0x9a, 0x6e, 0x77, 0x23, 0x86, 0xe9, 0x75, 0x4a, 0xb9, 0x20, 0xe8, 0x3a, 0x4c, 0x62,
+	0x4a, 0xa5, 0xc2, 0x0b, 0x17, 0x99, 0x42, 0xe1, 0xff, 0x95, 0x48, 0x02, 0x55, 0xbf, 0x05, 0xcd,
+	0x14, 0xf4, 0x52, 0x96, 0xb5, 0x0a, 0x20, 0x0b, 0x92, 0x7b, 0xfe, 0x7e, 0xc0, 0x8f, 0x94, 0x47,
+	0x00, 0xb5, 0x54, 0x7c, 0x1b, 0xb7, 0x13, 0x0c, 0x21, 0xdb, 0x3b, 0x30, 0xe5, 0x30, 0xe2, 0x25,
+	0xc2, 0x2d, 0x65, 0x85, 0xeb, 0x13, 0x32, 0x25, 0x92, 0xf1, 0x97, 0x06, 0x9c, 0xe7, 0x27, 0xf6,
+	0x58, 0xc4, 0x8e, 0x75, 0x4a, 0xef, 0x10, 0x86, 0x1d, 0x37, 0xfa, 0x5e, 0x4c, 0xc2, 0xc3, 0x37,
+	0x6c, 0x18, 0x36, 0xd4, 0x65, 0xe8, 0xd1, 0xaa, 0x6f, 0xa6, 0x36, 0xad, 0x47, 0x85, 0x82, 0xb4,
+	0xf6, 0x66, 0x0a, 0xd2, 0xb2, 0x02, 0x71, 0xf2, 0x84, 0x0a, 0xc4, 0xe1, 0x3d, 0x82, 0x4c, 0xe7,
+	0xa1, 0x9e, 0xef, 0x3c, 0x94, 0xd4, 0x5d, 0xd3, 0xc7, 0xad, 0xbb, 0x1a, 0xa5, 0x75, 0x97, 0x57,
+	0xea, 0xc7, 0x32, 0xfa, 0x7f, 0x37, 0x6b, 0x81, 0x43, 0x6d, 0x6d, 0x9c, 0x0a, 0x0c, 0xde, 0x68,
+	0x05, 0xf6, 0x59, 0xae, 0xa2, 0x92, 0x3d, 0x8d, 0xf7, 0x8e, 0xb7, 0xa7, 0x11, 0xb5, 0xd5, 0xff,
+	0x5d, 0xcd, 0xf1, 0x2b, 0x91, 0x6a, 0xd2, 0xa0, 0xaf, 0x83, 0x34, 0xcb, 0xe1, 0xf7, 0x10, 0xcf,
+	0x37, 0x54, 0xd0, 0xe2, 0xdf, 0xe8, 0x1a, 0x4c, 0x72, 0x25, 0xab, 0x5a, 0xe0, 0x5c, 0x56, 0x9f,
+	0xfc, 0x24, 0xd6, 0x29, 0x7d, 0x4c, 0x89, 0x65, 0x0a, 0x24, 0x74, 0x1b, 0x9a, 0xa9, 0xe1, 0x2b,
+	0xcf, 0x5a, 0xce, 0xae, 0x48, 0xfd, 0x24, 0x59, 0xd6, 0x47, 0xe7, 0x6b, 0x3b, 0x4e, 0x48, 0x2c,
+	0x8e, 0xa8, 0x4d, 0x0d, 0xae, 0xbd, 0x93, 0x4c, 0xa6, 0x6b, 0x53, 0x74, 0x74, 0x1d, 0xea, 0xb2,
+	0x09, 0x24, 0x3c, 0x68, 0xe6, 0xc6, 0xf9, 0xc1, 0x60, 0x9a, 0xac, 0x52, 0x88, 0xc6, 0xef, 0xaa,
+	0xf0, 0x56, 0xdf, 0x20, 0x12, 0x6f, 0x4a, 0x8a, 0x95, 0xaf, 0xff, 0xc6, 0xbd, 0x0c, 0x73, 0xa2,
+	0x3a, 0xea, 0xf7, 0x82, 0x64, 0x5b, 0xb2, 0x00, 0xe5, 0x99, 0x60, 0x1b, 0x47, 0x69, 0x42, 0xac,
+	0x32, 0xc9, 0x1c, 0x8c, 0x57, 0x23, 0x8e, 0x6f, 0xb9, 0x71, 0x87, 0xec, 0xc6, 0xae, 0xab, 0xf6,
+	0x26, 0x74, 0xdc, 0x30, 0x4b, 0x66, 0x8c, 0x3f, 0x56, 0xe0, 0xd2, 0xa0, 0x6e, 0x36, 0xba, 0x38,
+	0x64, 0xa9, 0xc9, 0x9c, 0x84, 0x7e, 0x92, 0x4b, 0xb4, 0xda, 0xbf, 0x44, 0x73, 0x3a, 0xab, 0xe5,
+	0x75, 0x66, 0xfc, 0xb9, 0x0a, 0x33, 0x19, 0xa3, 0x2c, 0xbb, 0x84, 0x79, 0x66, 0x2d, 0x7c, 0x41,
+	0xd4, 0xd8, 0xe2, 0xa2, 0x69, 0x9a, 0x19, 0x08, 0x3a, 0x00, 0xa0, 0x38, 0xc4, 0x1e, 0x61, 0x24,
+	0xe4, 0xb7, 0x03, 0x8f, 0x22, 0xf7, 0xc7, 0x8f, 0x58, 0xbb, 0x09, 0x4d, 0x33, 0x43, 0x9e, 0x97,
+	0x06, 0x82, 0x75, 0xa4, 0xee, 0x04, 0x35, 0x42, 0x5f, 0xc2, 0xdc, 0xbe, 0xe3, 0x92, 0xdd, 0xbe,
+	0x20, 0xf5, 0xd5, 0xda, 0xf8, 0x37, 0x2f, 0x17, 0xe4, 0x6e, 0x96, 0xae, 0x59, 0x60, 0x63, 0x5c,
+	0x85, 0x85, 0xa2, 0x8f, 0x72, 0x21, 0x1d, 0x0f, 0xdb, 0xa9, 0xb6, 0xd4, 0xc8, 0x40, 0xb0, 0x50,
+	0xf4, 0x49, 0xe3, 0x5f, 0x55, 0x38, 0x9b, 0x92, 0x5b, 0xf7, 0xfd, 0x20, 0xf6, 0x2d, 0xd1, 0xab,
+	0x2d, 0x3d, 0x8b, 0x33, 0x30, 0xc5, 0x1c, 0xe6, 0xa6, 0xc9, 0x94, 0x18, 0xf0, 0xfb, 0x90, 0xd7,
+	0x15, 0xcc, 0xa1, 0xea, 0x80, 0x93, 0xa1, 0x3c, 0xfb, 0x67, 0xb1, 0x13, 0x92, 0x8e, 0xb0, 0xf3,
+	0x86, 0x99, 0x8e, 0xf9, 0x1c, 0xcf, 0x94, 0x44, 0xbd, 0x24, 0x95, 0x99, 0x8e, 0x85, 0x2f, 0x05,
+	0xae, 0x4b, 0x2c, 0xae, 0x8e, 0x4c, 0x45, 0x55, 0x80, 0xf2, 0x9d, 0x46, 0x2c, 0x74, 0x7c, 0x5b,
+	0xd5, 0x53, 0x6a, 0xc4, 0xe5, 0xc4, 0x61, 0x88, 0x0f, 0x55, 0x19, 0x25, 0x07, 0xe8, 0x43, 0xa8,
+	0x79, 0x98, 0xaa, 0xcb, 0xf3, 0x6a, 0x2e, 0xe2, 0x94, 0x69, 0xa0, 0xb5, 0x83, 0xa9, 0xbc, 0x5d,
+	0xf8, 0x32, 0xfd, 0x7d, 0x68, 0x24, 0x80, 0x97, 0x4a, 0x33, 0xbf, 0x80, 0x53, 0xb9, 0x80, 0x86,
+	0x9e, 0xc0, 0x52, 0xdf, 0xa2, 0xb2, 0x0c, 0x55, 0x62, 0xf9, 0xd6, 0x91, 0x92, 0x99, 0x43, 0x08,
+	0x18, 0xcf, 0x60, 0x91, 0x9b, 0x8c, 0x70, 0xfc, 0x13, 0x2a, 0x97, 0x3e, 0x80, 0x66, 0xca, 0xb2,
+	0xd4, 0x66, 0x74, 0x68, 0xf4, 0x92, 0xaa, 0x55, 0xd6, 0x4b, 0xe9, 0xd8, 0x58, 0x07, 0x94, 0x95,
+	0x57, 0xdd, 0x6a, 0xd7, 0xf2, 0x89, 0xf6, 0xd9, 0xe2, 0x15, 0x26, 0xd0, 0x93, 0x3c, 0xfb, 0x1f,
+	0x55, 0x98, 0xdf, 0x72, 0x44, 0xc3, 0xe9, 0x84, 0x82, 0xdc, 0x55, 0x58, 0x88, 0xe2, 0xb6, 0x17,
+	0x74, 0x62, 0x97, 0xa8, 0x44, 0x43, 0x65, 0x0f, 0x03, 0xf0, 0x51, 0xc1, 0x8f, 0x2b, 0x8b, 0x62,
+	0xd6, 0x55, 0x17, 0x80, 0xf8, 0x46, 0x1f, 0xc2, 0xf9, 0x87, 0xe4, 0x4b, 0xb5, 0x9f, 0x2d, 0x37,
+	0x68, 0xb7, 0x1d, 0xdf, 0x4e, 0x98, 0xc8, 0xf8, 0x3f, 0x1c, 0xa1, 0x2c, 0xfd, 0xac, 0x97, 0xa7,
+	0x9f, 0x69, 0x3b, 0x62, 0x23, 0xf0, 0x3c, 0x87, 0xa9, 0x2c, 0x35, 0x07, 0x33, 0x7e, 0x59, 0x81,
+	0x85, 0xbe, 0x66, 0xd5, 0xd9, 0xdc, 0x92, 0x3e, 0x24, 0x4f, 0xe6, 0x52, 0xf6, 0x64, 0x8a, 0xa8,
+	0xaf, 0xee, 0x3e, 0xb3, 0x59, 0xf7, 0xf9, 0x4d, 0x15, 0xce, 0x6e, 0x39, 0x2c, 0x09, 0x5c, 0xce,
+	0xff, 0xda, 0x29, 0x97, 0x9c, 0xc9, 0xe4, 0xf1, 0xce, 0x64, 0xaa, 0xe4, 0x4c, 0x5a, 0xb0, 0x54,
+	0x54, 0x86, 0x3a, 0x98, 0x33, 0x30, 0x45, 0x45, 0x97, 0x5f, 0xf6, 0x2a, 0xe4, 0xc0, 0xf8, 0xc5,
+	0x34, 0x5c, 0xfc, 0x8c, 0x76, 0x30, 0x4b, 0x73, 0x8b, 0xbb, 0x41, 0x28, 0xda, 0xfc, 0x27, 0xa3,
+	0xc5, 0xc2, 0x53, 0x6c, 0x75, 0xe4, 0x53, 0x6c, 0x6d, 0xc4, 0x53, 0xec, 0xe4, 0xb1, 0x9e, 0x62,
+	0xa7, 0x4e, 0xec, 0x29, 0x76, 0xb0, 0x7e, 0xab, 0x97, 0xd6, 0x6f, 0x4f, 0x72, 0x35, 0xce, 0xb4,
+	0x70, 0x9b, 0xef, 0x64, 0xdd, 0x66, 0xe4, 0xe9, 0x8c, 0x7c, 0x43, 0x2a, 0xbc, 0x60, 0x36, 0x8e,
+	0x7c, 0xc1, 0x6c, 0x0e, 0xbe, 0x60, 0x96, 0x3f, 0x82, 0xc1, 0xd0, 0x47, 0xb0, 0xcb, 0x30, 0x17,
+	0x1d, 0xfa, 0x16, 0xe9, 0x24, 0x02, 0x6b, 0x33, 0x72, 0xdb, 0x79, 0x68, 0xce, 0x23, 0x66, 0x0b,
+	0x1e, 0x91, 0x5a, 0xea, 0xa9, 0x8c, 0xa5, 0x96, 0xf9, 0xc9, 0xdc, 0xd0, 0xd2, 0xb9, 0xf0, 0x3e,
+	0x35, 0x5f, 0xf6, 0x3e, 0xf5, 0xdf, 0x53, 0xc0, 0x7d, 0x0e, 0x2b, 0xc3, 0x4e, 0x59, 0x39, 0xaf,
+	0x06, 0xd3, 0x56, 0x17, 0xfb, 0xb6, 0x68, 0x35, 0x8a, 0x8e, 0x82, 0x1a, 0x8e, 0xaa, 0x38, 0x6e,
+	0x7c, 0x35, 0x0b, 0x8b, 0xfd, 0xac, 0x9f, 0xff, 0x75, 0x2c, 0x82, 0x1e, 0xc1, 0x42, 0xf2, 0x9e,
+	0x97, 0x74, 0x84, 0xd1, 0xa8, 0x87, 0x28, 0x7d, 0x79, 0x54, 0x13, 0xd9, 0x98, 0x40, 0x16, 0x9c,
+	0x2f, 0x12, 0xec, 0xbf, 0x79, 0x7d, 0x6b, 0x04, 0xe5, 0x14, 0xeb, 0x28, 0x16, 0x57, 0x2a, 0xe8,
+	0x09, 0xcc, 0xe5, 0x5f, 0x66, 0x50, 0x2e, 0x0d, 0x2a, 0x7d, 0x2c, 0xd2, 0x8d, 0x51, 0x28, 0xa9,
+	0xfc, 0x4f, 0x61, 0xbe, 0xf0, 0x08, 0x81, 0x8c, 0x7c, 0x97, 0xa1, 0xec, 0x19, 0x47, 0xff, 0xe6,
+	0x48, 0x9c, 0x94, 0xfa, 0x07, 0xd0, 0x48, 0xfa, 0xd3, 0x79, 0x35, 0x17, 0xba, 0xd6, 0xfa, 0x42,
+	0x9e, 0xde, 0x7e, 0x64, 0x4c, 0xa0, 0x8f, 0x60, 0x86, 0xa3, 0x3d, 0xda, 0xb8, 0xb7, 0x87, 0xed,
+	0x57, 0x5a, 0xdf, 0x48, 0xfa, 0xb7, 0x83, 0x8b, 0x33, 0x5d, 0x5d, 0xfd, 0x74, 0x49, 0x27, 0xd5,
+	0x98, 0x40, 0x1f, 0x4b, 0xfe, 0xbb, 0xea, 0xf7, 0x18, 0x4b, 0x2d, 0xf9, 0xf3, 0x9f, 0x56, 0xf2,
+	0xf3, 0x9f, 0xd6, 0x26, 0xff, 0xf9, 0x8f, 0x5e, 0xd2, 0xea, 0x54, 0x04, 0x9e, 0xc2, 0xa9, 0x2d,
+	0xc2, 0xfa, 0x9d, 0x09, 0x74, 0xe9, 0x58, 0xfd, 0x1b, 0xdd, 0x28, 0xa2, 0x0d, 0x36, 0x37, 0x8c,
+	0x09, 0xf4, 0x55, 0x05, 0x4e, 0x6f, 0x11, 0x56, 0xac, 0xf5, 0xd1, 0xbb, 0xe5, 0x4c, 0x86, 0xf4,
+	0x04, 0xf4, 0x87, 0xe3, 0xfa, 0x74, 0x9e, 0xac, 0x31, 0x81, 0x7e, 0x5b, 0x81, 0xb9, 0x2d, 0xc2,
+	0xcf, 0x2d, 0x95, 0xe9, 0xfa, 0x68, 0x99, 0x4a, 0x6a, 0x71, 0x7d, 0xcc, 0xbe, 0x5a, 0x86, 0xbb,
+	0x31, 0x81, 0x7e, 0x5f, 0x81, 0x73, 0x19, 0x5d, 0x65, 0xf9, 0xbd, 0x8a, 0x6c, 0x9f, 0x8e, 0xf9,
+	0xcb, 0x9f, 0x0c, 0x49, 0x63, 0x02, 0xed, 0x0a, 0x33, 0xe9, 0xa7, 0xfa, 0xe8, 0x62, 0x69, 0x4e,
+	0x9f, 0x72, 0x5f, 0x19, 0x36, 0x9d, 0x9a, 0xc6, 0xa7, 0x30, 0xb3, 0x45, 0x58, 0x92, 0x73, 0xe6,
+	0x8d, 0xbf, 0x50, 0x0e, 0xe8, 0xcb, 0xe5, 0x93, 0x99, 0x00, 0xb1, 0x28, 0x69, 0x65, 0xf2, 0xaa,
+	0x7c, 0xf8, 0x29, 0x4d, 0x40, 0x75, 0x63, 0x14, 0x4a, 0x4a, 0xfd, 0x19, 0x2c, 0x95, 0x47, 0x7f,
+	0xf4, 0xf6, 0xb1, 0xf3, 0x00, 0xfd, 0xea, 0x71, 0x50, 0x13, 0x96, 0x9f, 0xac, 0xff, 0xf5, 0xc5,
+	0x4a, 0xe5, 0x6f, 0x2f, 0x56, 0x2a, 0xff, 0x7e, 0xb1, 0x52, 0xf9, 0xc1, 0xcd, 0x23, 0x7e, 0x21,
+	0x98, 0xf9, 0xd1, 0x21, 0xa6, 0x8e, 0xe5, 0x3a, 0xc4, 0x67, 0xed, 0xba, 0x08, 0x01, 0x37, 0xff,
+	0x33, 0x00, 0xce, 0x8d, 0xfe, 0x80, 0x93, 0x28, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3343,6 +3395,15 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.AppNamespace) > 0 {
+		i -= len(m.AppNamespace)
+		copy(dAtA[i:], m.AppNamespace)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.AppNamespace)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
 	if len(m.InstallationID) > 0 {
 		i -= len(m.InstallationID)
 		copy(dAtA[i:], m.InstallationID)
@@ -4005,6 +4066,39 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BuildEnv) > 0 {
+		for iNdEx := len(m.BuildEnv) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuildEnv[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ToolVersions) > 0 {
+		for k := range m.ToolVersions {
+			v := m.ToolVersions[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRepository(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -4611,6 +4705,23 @@ func (m *RepoServerRevisionMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IncludePullRequest {
+		i--
+		if m.IncludePullRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BaseRevision) > 0 {
+		i -= len(m.BaseRevision)
+		copy(dAtA[i:], m.BaseRevision)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.BaseRevision)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.CheckSignature {
 		i--
 		if m.CheckSignature {
@@ -5708,6 +5819,10 @@ func (m *ManifestRequest) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRepository(uint64(l))
 	}
+	l = len(m.AppNamespace)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5916,6 +6031,20 @@ func (m *ManifestResponse) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	if len(m.ToolVersions) > 0 {
+		for k, v := range m.ToolVersions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + 1 + len(v) + sovRepository(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
+		}
+	}
+	if len(m.BuildEnv) > 0 {
+		for _, e := range m.BuildEnv {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6162,6 +6291,13 @@ func (m *RepoServerRevisionMetadataRequest) Size() (n int) {
 	if m.CheckSignature {
 		n += 2
 	}
+	l = len(m.BaseRevision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.IncludePullRequest {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7553,6 +7689,38 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.InstallationID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -8618,6 +8786,167 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToolVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ToolVersions == nil {
+				m.ToolVersions = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ToolVersions[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildEnv", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildEnv = append(m.BuildEnv, &v1alpha1.EnvEntry{})
+			if err := m.BuildEnv[len(m.BuildEnv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -10343,6 +10672,58 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CheckSignature = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePullRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePullRequest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
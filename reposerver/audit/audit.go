@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// DefaultMaxRecords is the default number of the most recent repository accesses kept by the store
+	DefaultMaxRecords = 10000
+	// DefaultQueryLimit is the default number of the records returned by a query
+	DefaultQueryLimit = 100
+
+	recordsKey = "repo_access_audit"
+)
+
+// Record is a repository access of the repo server
+type Record struct {
+	// Time is the time of the access
+	Time time.Time `json:"time"`
+	// Repo is the URL of the repository
+	Repo string `json:"repo"`
+	// Project is the project of the repository or of the application, if any
+	Project string `json:"project,omitempty"`
+	// Revision is the requested revision
+	Revision string `json:"revision,omitempty"`
+	// Operation is the repo server operation, e.g. GenerateManifest
+	Operation string `json:"operation"`
+	// Application is the application triggering the access, if any
+	Application string `json:"application,omitempty"`
+	// User is the user triggering the access, empty for the accesses of the controllers
+	User string `json:"user,omitempty"`
+	// Component is the Argo CD component requesting the access, e.g. argocd-server
+	Component string `json:"component,omitempty"`
+}
+
+// Query selects the recorded repository accesses. The empty fields match any record.
+type Query struct {
+	Repo        string
+	User        string
+	Application string
+	Since       time.Time
+	// Limit is the maximum number of the returned records, DefaultQueryLimit if not positive
+	Limit int
+}
+
+// Matches returns true if the record is selected by the query
+func (q Query) Matches(record Record) bool {
+	return (q.Repo == "" || q.Repo == record.Repo) &&
+		(q.User == "" || q.User == record.User) &&
+		(q.Application == "" || q.Application == record.Application) &&
+		(q.Since.IsZero() || !record.Time.Before(q.Since))
+}
+
+// Store keeps the most recent repository accesses
+type Store interface {
+	// Record saves a repository access
+	Record(ctx context.Context, record Record) error
+	// Query returns the records selected by the query, the most recent first
+	Query(ctx context.Context, query Query) ([]Record, error)
+}
+
+type redisStore struct {
+	client     redis.UniversalClient
+	key        string
+	maxRecords int64
+}
+
+// NewRedisStore returns a store keeping the given number of the most recent repository accesses in Redis, so that
+// they are shared by the replicas of the repo server and can be queried by the API server. The key of the records is
+// prefixed with the given prefix.
+func NewRedisStore(client redis.UniversalClient, keyPrefix string, maxRecords int) Store {
+	if maxRecords <= 0 {
+		maxRecords = DefaultMaxRecords
+	}
+	return &redisStore{client: client, key: keyPrefix + recordsKey, maxRecords: int64(maxRecords)}
+}
+
+func (s *redisStore) Record(ctx context.Context, record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal repository access record: %w", err)
+	}
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LPush(ctx, s.key, data)
+		pipe.LTrim(ctx, s.key, 0, s.maxRecords-1)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save repository access record: %w", err)
+	}
+	return nil
+}
+
+func (s *redisStore) Query(ctx context.Context, query Query) ([]Record, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultQueryLimit
+	}
+	values, err := s.client.LRange(ctx, s.key, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load repository access records: %w", err)
+	}
+	records := make([]Record, 0)
+	for _, value := range values {
+		var record Record
+		if err := json.Unmarshal([]byte(value), &record); err != nil {
+			continue
+		}
+		// the records are sorted by time, the most recent first
+		if !query.Since.IsZero() && record.Time.Before(query.Since) {
+			break
+		}
+		if !query.Matches(record) {
+			continue
+		}
+		records = append(records, record)
+		if len(records) == limit {
+			break
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,72 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRedisStore(t *testing.T, maxRecords int) Store {
+	t.Helper()
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	return NewRedisStore(client, "", maxRecords)
+}
+
+func TestRedisStore(t *testing.T) {
+	store := newTestRedisStore(t, 3)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	records := []Record{
+		{Time: now.Add(-3 * time.Hour), Repo: "https://github.com/argoproj/argocd-example-apps", Operation: "GenerateManifest", Component: "argocd-application-controller"},
+		{Time: now.Add(-2 * time.Hour), Repo: "https://github.com/argoproj/argocd-example-apps", Operation: "GenerateManifest", Application: "argocd/guestbook", Component: "argocd-application-controller"},
+		{Time: now.Add(-time.Hour), Repo: "https://github.com/argoproj/argocd-example-apps", Operation: "GetAppDetails", Application: "argocd/guestbook", User: "alice", Component: "argocd-server"},
+		{Time: now, Repo: "https://github.com/argoproj/argo-cd", Operation: "ListRefs", User: "bob", Component: "argocd-server"},
+	}
+	for _, record := range records {
+		require.NoError(t, store.Record(t.Context(), record))
+	}
+
+	t.Run("All", func(t *testing.T) {
+		result, err := store.Query(t.Context(), Query{})
+		require.NoError(t, err)
+		// the oldest record is trimmed
+		assert.Equal(t, []Record{records[3], records[2], records[1]}, result)
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		result, err := store.Query(t.Context(), Query{Repo: "https://github.com/argoproj/argocd-example-apps"})
+		require.NoError(t, err)
+		assert.Equal(t, []Record{records[2], records[1]}, result)
+
+		result, err = store.Query(t.Context(), Query{User: "alice"})
+		require.NoError(t, err)
+		assert.Equal(t, []Record{records[2]}, result)
+
+		result, err = store.Query(t.Context(), Query{Application: "argocd/guestbook", Since: now.Add(-90 * time.Minute)})
+		require.NoError(t, err)
+		assert.Equal(t, []Record{records[2]}, result)
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		result, err := store.Query(t.Context(), Query{Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []Record{records[3]}, result)
+	})
+}
+
+func TestQueryMatches(t *testing.T) {
+	now := time.Now()
+	record := Record{Time: now, Repo: "https://github.com/argoproj/argo-cd", User: "alice", Application: "argocd/guestbook"}
+	assert.True(t, Query{}.Matches(record))
+	assert.True(t, Query{Repo: record.Repo, User: "alice", Application: "argocd/guestbook", Since: now}.Matches(record))
+	assert.False(t, Query{User: "bob"}.Matches(record))
+	assert.False(t, Query{Application: "argocd/other"}.Matches(record))
+	assert.False(t, Query{Since: now.Add(time.Second)}.Matches(record))
+}
@@ -0,0 +1,118 @@
+package audit
+
+import (
+	"context"
+	"path"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+// recordTimeout bounds the time spent saving a record, so that an unavailable store doesn't delay the requests
+const recordTimeout = 5 * time.Second
+
+type repoRequest interface {
+	GetRepo() *v1alpha1.Repository
+}
+
+// UnaryServerInterceptor records the repository accessed by each request of the repo server, attributed to the user
+// and the application propagated by the client
+func UnaryServerInterceptor(store Store) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		recordAccess(ctx, store, info.FullMethod, req)
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor records the repository accessed by each stream of the repo server, see UnaryServerInterceptor
+func StreamServerInterceptor(store Store) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &auditServerStream{ServerStream: ss, store: store, method: info.FullMethod})
+	}
+}
+
+type auditServerStream struct {
+	grpc.ServerStream
+	store    Store
+	method   string
+	recorded bool
+}
+
+func (s *auditServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.recorded {
+		// the manifest request is the first message of the streams of the manifest generation with files
+		if msg, ok := m.(*apiclient.ManifestRequestWithFiles); ok && msg.GetRequest() != nil {
+			recordAccess(s.Context(), s.store, s.method, msg.GetRequest())
+			s.recorded = true
+		}
+	}
+	return nil
+}
+
+func recordAccess(ctx context.Context, store Store, method string, req any) {
+	record, ok := newRecord(ctx, method, req)
+	if !ok {
+		return
+	}
+	log.WithFields(log.Fields{
+		"repo":        record.Repo,
+		"project":     record.Project,
+		"revision":    record.Revision,
+		"operation":   record.Operation,
+		"application": record.Application,
+		"user":        record.User,
+		"component":   record.Component,
+	}).Info("Repository access")
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), recordTimeout)
+	defer cancel()
+	if err := store.Record(ctx, record); err != nil {
+		log.Warnf("Failed to record repository access: %v", err)
+	}
+}
+
+func newRecord(ctx context.Context, method string, req any) (Record, bool) {
+	r, ok := req.(repoRequest)
+	if !ok || r.GetRepo() == nil || r.GetRepo().Repo == "" {
+		return Record{}, false
+	}
+	info := apiclient.AuditInfoFromIncomingContext(ctx)
+	record := Record{
+		Time:        time.Now().UTC(),
+		Repo:        r.GetRepo().Repo,
+		Project:     r.GetRepo().Project,
+		Operation:   path.Base(method),
+		Application: info.Application,
+		User:        info.User,
+		Component:   info.Component,
+	}
+	switch r := req.(type) {
+	case interface{ GetRevision() string }:
+		record.Revision = r.GetRevision()
+	case interface{ GetAmbiguousRevision() string }:
+		record.Revision = r.GetAmbiguousRevision()
+	}
+	if r, ok := req.(interface{ GetProjectName() string }); ok && r.GetProjectName() != "" {
+		record.Project = r.GetProjectName()
+	}
+	if record.Application == "" {
+		switch r := req.(type) {
+		case interface{ GetAppName() string }:
+			record.Application = r.GetAppName()
+		case interface {
+			GetApp() *v1alpha1.Application
+		}:
+			if app := r.GetApp(); app != nil && app.Name != "" {
+				record.Application = app.QualifiedName()
+			}
+		}
+	}
+	return record, true
+}
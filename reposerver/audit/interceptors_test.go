@@ -0,0 +1,137 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+const testRepo = "https://github.com/argoproj/argocd-example-apps"
+
+func incomingContext(t *testing.T, kv ...string) context.Context {
+	t.Helper()
+	return metadata.NewIncomingContext(t.Context(), metadata.Pairs(kv...))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	store := newTestRedisStore(t, 0)
+	interceptor := UnaryServerInterceptor(store)
+	handler := func(_ context.Context, _ any) (any, error) {
+		return &apiclient.ManifestResponse{}, nil
+	}
+
+	t.Run("Manifest request", func(t *testing.T) {
+		ctx := incomingContext(t,
+			apiclient.MetadataAuditComponent, "argocd-server",
+			apiclient.MetadataAuditUser, "alice%40example.com",
+			apiclient.MetadataAuditApplication, "argocd%2Fguestbook",
+		)
+		req := &apiclient.ManifestRequest{
+			Repo:        &v1alpha1.Repository{Repo: testRepo},
+			Revision:    "HEAD",
+			AppName:     "guestbook",
+			ProjectName: "default",
+		}
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/repository.RepoServerService/GenerateManifest"}, handler)
+		require.NoError(t, err)
+
+		records, err := store.Query(t.Context(), Query{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		record := records[0]
+		assert.NotZero(t, record.Time)
+		assert.Equal(t, Record{
+			Time:        record.Time,
+			Repo:        testRepo,
+			Project:     "default",
+			Revision:    "HEAD",
+			Operation:   "GenerateManifest",
+			Application: "argocd/guestbook",
+			User:        "alice@example.com",
+			Component:   "argocd-server",
+		}, record)
+	})
+
+	t.Run("Application of the request", func(t *testing.T) {
+		ctx := incomingContext(t, apiclient.MetadataAuditComponent, "argocd-application-controller")
+		req := &apiclient.ResolveRevisionRequest{
+			Repo:              &v1alpha1.Repository{Repo: testRepo, Project: "team"},
+			App:               &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "apps"}},
+			AmbiguousRevision: "main",
+		}
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/repository.RepoServerService/ResolveRevision"}, handler)
+		require.NoError(t, err)
+
+		records, err := store.Query(t.Context(), Query{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, "apps/guestbook", records[0].Application)
+		assert.Equal(t, "team", records[0].Project)
+		assert.Equal(t, "main", records[0].Revision)
+		assert.Equal(t, "ResolveRevision", records[0].Operation)
+		assert.Empty(t, records[0].User)
+	})
+
+	t.Run("No repository", func(t *testing.T) {
+		before, err := store.Query(t.Context(), Query{})
+		require.NoError(t, err)
+		_, err = interceptor(t.Context(), &apiclient.ListAppsRequest{}, &grpc.UnaryServerInfo{FullMethod: "/repository.RepoServerService/ListApps"}, handler)
+		require.NoError(t, err)
+		after, err := store.Query(t.Context(), Query{})
+		require.NoError(t, err)
+		assert.Len(t, after, len(before))
+	})
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages []*apiclient.ManifestRequestWithFiles
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m any) error {
+	msg := s.messages[0]
+	s.messages = s.messages[1:]
+	*m.(*apiclient.ManifestRequestWithFiles) = *msg
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	store := newTestRedisStore(t, 0)
+	stream := &fakeServerStream{
+		ctx: incomingContext(t, apiclient.MetadataAuditUser, "alice"),
+		messages: []*apiclient.ManifestRequestWithFiles{
+			{Part: &apiclient.ManifestRequestWithFiles_Request{Request: &apiclient.ManifestRequest{Repo: &v1alpha1.Repository{Repo: testRepo}, AppName: "guestbook"}}},
+			{Part: &apiclient.ManifestRequestWithFiles_Chunk{Chunk: &apiclient.ManifestFileChunk{Chunk: []byte("data")}}},
+		},
+	}
+	handler := func(_ any, ss grpc.ServerStream) error {
+		for range 2 {
+			if err := ss.RecvMsg(&apiclient.ManifestRequestWithFiles{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	err := StreamServerInterceptor(store)(nil, stream, &grpc.StreamServerInfo{FullMethod: "/repository.RepoServerService/GenerateManifestWithFiles"}, handler)
+	require.NoError(t, err)
+
+	records, err := store.Query(t.Context(), Query{})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "GenerateManifestWithFiles", records[0].Operation)
+	assert.Equal(t, "guestbook", records[0].Application)
+	assert.Equal(t, "alice", records[0].User)
+}
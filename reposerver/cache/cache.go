@@ -54,6 +54,10 @@ type CachedManifestResponse struct {
 	FirstFailureTimestamp           int64                       `json:"firstFailureTimestamp"`
 	NumberOfConsecutiveFailures     int                         `json:"numberOfConsecutiveFailures"`
 	NumberOfCachedResponsesReturned int                         `json:"numberOfCachedResponsesReturned"`
+	// MostRecentErrorTimestamp is the time of the most recent failure, in seconds since the epoch
+	MostRecentErrorTimestamp int64 `json:"mostRecentErrorTimestamp,omitempty"`
+	// MostRecentErrorType is the type of the most recent failure, e.g. template or transient
+	MostRecentErrorType string `json:"mostRecentErrorType,omitempty"`
 }
 
 func NewCache(cache *cacheutil.Cache, repoCacheExpiration time.Duration, revisionCacheExpiration time.Duration, revisionCacheLockTimeout time.Duration) *Cache {
@@ -529,6 +533,8 @@ func (cmr *CachedManifestResponse) shallowCopy() *CachedManifestResponse {
 		MostRecentError:                 cmr.MostRecentError,
 		NumberOfCachedResponsesReturned: cmr.NumberOfCachedResponsesReturned,
 		NumberOfConsecutiveFailures:     cmr.NumberOfConsecutiveFailures,
+		MostRecentErrorTimestamp:        cmr.MostRecentErrorTimestamp,
+		MostRecentErrorType:             cmr.MostRecentErrorType,
 	}
 }
 
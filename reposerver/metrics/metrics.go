@@ -19,6 +19,9 @@ type MetricsServer struct {
 	repoPendingRequestsGauge *prometheus.GaugeVec
 	redisRequestCounter      *prometheus.CounterVec
 	redisRequestHistogram    *prometheus.HistogramVec
+	workspaceDiskUsageGauge  *prometheus.GaugeVec
+	workspaceEvictionCounter *prometheus.CounterVec
+	coalescedRequestsCounter *prometheus.CounterVec
 }
 
 type GitRequestType string
@@ -99,6 +102,33 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(redisRequestHistogram)
 
+	workspaceDiskUsageGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_repo_workspace_disk_usage_bytes",
+			Help: "Disk usage of the git checkouts, Helm charts and OCI images cached by repo server",
+		},
+		[]string{"type"},
+	)
+	registry.MustRegister(workspaceDiskUsageGauge)
+
+	workspaceEvictionCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_workspace_evictions_total",
+			Help: "Number of cached workspaces removed by repo server to enforce the workspace quotas",
+		},
+		[]string{"type", "reason"},
+	)
+	registry.MustRegister(workspaceEvictionCounter)
+
+	coalescedRequestsCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_manifest_requests_coalesced_total",
+			Help: "Number of manifest requests served with the manifests generated for an identical concurrent request",
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(coalescedRequestsCounter)
+
 	return &MetricsServer{
 		handler:                  promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
 		gitFetchFailCounter:      gitFetchFailCounter,
@@ -108,6 +138,9 @@ func NewMetricsServer() *MetricsServer {
 		repoPendingRequestsGauge: repoPendingRequestsGauge,
 		redisRequestCounter:      redisRequestCounter,
 		redisRequestHistogram:    redisRequestHistogram,
+		workspaceDiskUsageGauge:  workspaceDiskUsageGauge,
+		workspaceEvictionCounter: workspaceEvictionCounter,
+		coalescedRequestsCounter: coalescedRequestsCounter,
 	}
 }
 
@@ -147,3 +180,19 @@ func (m *MetricsServer) IncRedisRequest(failed bool) {
 func (m *MetricsServer) ObserveRedisRequestDuration(duration time.Duration) {
 	m.redisRequestHistogram.WithLabelValues("argocd-repo-server").Observe(duration.Seconds())
 }
+
+// SetWorkspaceDiskUsage sets the disk usage of the workspaces of the given type
+func (m *MetricsServer) SetWorkspaceDiskUsage(workspaceType string, bytes int64) {
+	m.workspaceDiskUsageGauge.WithLabelValues(workspaceType).Set(float64(bytes))
+}
+
+// IncWorkspaceEviction increments the counter of the workspaces of the given type removed to enforce a quota
+func (m *MetricsServer) IncWorkspaceEviction(workspaceType string, reason string) {
+	m.workspaceEvictionCounter.WithLabelValues(workspaceType, reason).Inc()
+}
+
+// IncManifestRequestCoalesced increments the counter of the manifest requests of the given repo coalesced with an
+// identical concurrent request
+func (m *MetricsServer) IncManifestRequestCoalesced(repo string) {
+	m.coalescedRequestsCounter.WithLabelValues(repo).Inc()
+}
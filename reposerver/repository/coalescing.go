@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+// manifestRequestKey returns the key of the given manifest request, which is the same for the identical requests
+func manifestRequestKey(q *apiclient.ManifestRequest) (string, error) {
+	// the keys of the maps are sorted by the JSON encoding, unlike by the protobuf encoding
+	data, err := json.Marshal(q)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// coalesceManifestRequest generates the manifests of the given request with the given function, unless an identical
+// request is already being processed, e.g. during a storm of webhooks, in which case it waits for the manifests of
+// that request instead of generating them again
+func (s *Service) coalesceManifestRequest(ctx context.Context, q *apiclient.ManifestRequest, generate func(ctx context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error)) (*apiclient.ManifestResponse, error) {
+	key, err := manifestRequestKey(q)
+	if err != nil {
+		log.Warnf("Failed to compute the key of the manifest request of %s, not coalescing it: %v", q.AppName, err)
+		return generate(ctx, q)
+	}
+
+	// the function is only run by the first of the identical requests
+	leader := false
+	resultCh := s.manifestRequests.DoChan(key, func() (any, error) {
+		leader = true
+		return generate(ctx, q)
+	})
+	var result any
+	select {
+	case r := <-resultCh:
+		result, err = r.Val, r.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if leader {
+		res, _ := result.(*apiclient.ManifestResponse)
+		return res, err
+	}
+
+	s.metricsServer.IncManifestRequestCoalesced(q.Repo.Repo)
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		// the request whose manifests were awaited has been canceled, but not this one
+		return generate(ctx, q)
+	}
+	res, _ := result.(*apiclient.ManifestResponse)
+	return res, err
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
+)
+
+func TestManifestRequestKey(t *testing.T) {
+	newRequest := func(revision string) *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{
+			Repo:     &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"},
+			Revision: revision,
+			AppName:  "guestbook",
+			ApplicationSource: &v1alpha1.ApplicationSource{
+				Path: "guestbook",
+				Kustomize: &v1alpha1.ApplicationSourceKustomize{
+					CommonLabels: map[string]string{"a": "1", "b": "2", "c": "3"},
+				},
+			},
+		}
+	}
+	key1, err := manifestRequestKey(newRequest("HEAD"))
+	require.NoError(t, err)
+	key2, err := manifestRequestKey(newRequest("HEAD"))
+	require.NoError(t, err)
+	assert.Equal(t, key1, key2)
+	key3, err := manifestRequestKey(newRequest("main"))
+	require.NoError(t, err)
+	assert.NotEqual(t, key1, key3)
+}
+
+func TestCoalesceManifestRequest(t *testing.T) {
+	s := &Service{metricsServer: metrics.NewMetricsServer()}
+	q := &apiclient.ManifestRequest{Repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"}, Revision: "HEAD", AppName: "guestbook"}
+
+	var calls atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	generate := func(_ context.Context, _ *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+		if calls.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		return &apiclient.ManifestResponse{Revision: "abc"}, nil
+	}
+
+	results := make(chan *apiclient.ManifestResponse, 3)
+	for range 3 {
+		go func() {
+			res, err := s.coalesceManifestRequest(t.Context(), q, generate)
+			assert.NoError(t, err)
+			results <- res
+		}()
+	}
+	<-started
+	// give the other requests the time to join the first one
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	for range 3 {
+		assert.Equal(t, "abc", (<-results).Revision)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+
+	// the requests are not coalesced once the manifests are generated
+	_, err := s.coalesceManifestRequest(t.Context(), q, generate)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestCoalesceManifestRequest_Canceled(t *testing.T) {
+	s := &Service{metricsServer: metrics.NewMetricsServer()}
+	q := &apiclient.ManifestRequest{Repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"}, Revision: "HEAD", AppName: "guestbook"}
+
+	started := make(chan struct{})
+	leaderCtx, cancel := context.WithCancel(t.Context())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := s.coalesceManifestRequest(leaderCtx, q, func(ctx context.Context, _ *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+		leaderErr <- err
+	}()
+	<-started
+
+	// the manifests are generated again when the awaited request is canceled
+	followerRes := make(chan *apiclient.ManifestResponse, 1)
+	go func() {
+		res, err := s.coalesceManifestRequest(t.Context(), q, func(_ context.Context, _ *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+			return &apiclient.ManifestResponse{Revision: "abc"}, nil
+		})
+		assert.NoError(t, err)
+		followerRes <- res
+	}()
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	require.ErrorIs(t, <-leaderErr, context.Canceled)
+	assert.Equal(t, "abc", (<-followerRes).Revision)
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ManifestGenerationErrorType is the type of the errors of the manifest generation, deciding whether they are cached
+type ManifestGenerationErrorType string
+
+const (
+	// ManifestGenerationErrorTypeTemplate is the type of the errors of the rendering of the manifests, such as an invalid
+	// template or values, which fail again as long as the sources do not change
+	ManifestGenerationErrorTypeTemplate ManifestGenerationErrorType = "template"
+	// ManifestGenerationErrorTypeTransient is the type of the errors which may not fail again, such as the network errors
+	// of the download of the Helm dependencies or of the Kustomize remote bases
+	ManifestGenerationErrorTypeTransient ManifestGenerationErrorType = "transient"
+)
+
+// transientErrorMessages are the lowercase messages of the transient errors of the tools executed by the manifest
+// generation, whose errors are only available as messages
+var transientErrorMessages = []string{
+	"connection refused",
+	"connection reset by peer",
+	"broken pipe",
+	"i/o timeout",
+	"no such host",
+	"temporary failure in name resolution",
+	"tls handshake timeout",
+	"timeout awaiting response headers",
+	"context deadline exceeded",
+	"429 too many requests",
+	"502 bad gateway",
+	"503 service unavailable",
+	"504 gateway timeout",
+}
+
+// classifyManifestGenerationError returns the type of the given error of the manifest generation
+func classifyManifestGenerationError(err error) ManifestGenerationErrorType {
+	var netErr net.Error
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.As(err, &netErr) {
+		return ManifestGenerationErrorTypeTransient
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Canceled, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted, codes.Unavailable:
+			return ManifestGenerationErrorTypeTransient
+		}
+	}
+	message := strings.ToLower(err.Error())
+	for _, transientMessage := range transientErrorMessages {
+		if strings.Contains(message, transientMessage) {
+			return ManifestGenerationErrorTypeTransient
+		}
+	}
+	return ManifestGenerationErrorTypeTemplate
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassifyManifestGenerationError(t *testing.T) {
+	for _, tt := range []struct {
+		err      error
+		expected ManifestGenerationErrorType
+	}{
+		{errors.New("`helm template . --name-template test` failed exit status 1: Error: parse error at (test/templates/deployment.yaml:5): unexpected EOF"), ManifestGenerationErrorTypeTemplate},
+		{errors.New("kustomize build failed: accumulating resources: missing Resource metadata"), ManifestGenerationErrorTypeTemplate},
+		{fmt.Errorf("failed to generate manifests: %w", context.DeadlineExceeded), ManifestGenerationErrorTypeTransient},
+		{fmt.Errorf("failed to download chart: %w", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("refused")}), ManifestGenerationErrorTypeTransient},
+		{status.Error(codes.Unavailable, "cmp server is not available"), ManifestGenerationErrorTypeTransient},
+		{status.Error(codes.InvalidArgument, "invalid plugin configuration"), ManifestGenerationErrorTypeTemplate},
+		{errors.New("helm dependency build failed: Get \"https://charts.example.com/index.yaml\": dial tcp: lookup charts.example.com: no such host"), ManifestGenerationErrorTypeTransient},
+		{errors.New("kustomize build failed: git fetch: 503 Service Unavailable"), ManifestGenerationErrorTypeTransient},
+	} {
+		assert.Equal(t, tt.expected, classifyManifestGenerationError(tt.err), tt.err.Error())
+	}
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	apppathutil "github.com/argoproj/argo-cd/v3/util/app/path"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/kustomize"
+)
+
+// helmRenderedManifestsFile is the file the manifests rendered by helm template are written to for the kustomize and
+// plugin post-render steps
+const helmRenderedManifestsFile = "helm-rendered.yaml"
+
+// postRenderHelm runs the post-render steps of the helm source of the request, in order, on the manifests rendered by
+// helm template. It returns the transformed manifests and the commands run by the steps.
+func postRenderHelm(ctx context.Context, appPath, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, objs []*unstructured.Unstructured, gitCredsStore git.CredsStore, opt *generateManifestOpt) ([]*unstructured.Unstructured, []string, error) {
+	if q.ApplicationSource.Helm == nil {
+		return objs, nil, nil
+	}
+	var commands []string
+	for i, renderer := range q.ApplicationSource.Helm.PostRenderers {
+		if err := renderer.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("helm post-renderer %d is invalid: %w", i, err)
+		}
+		var err error
+		switch {
+		case renderer.Kustomize != "":
+			var stepCommands []string
+			objs, stepCommands, err = postRenderKustomize(appPath, repoRoot, renderer.Kustomize, env, q, objs, gitCredsStore)
+			commands = append(commands, stepCommands...)
+		case renderer.Plugin != "":
+			objs, err = postRenderPlugin(ctx, appPath, repoRoot, renderer.Plugin, env, q, objs, gitCredsStore, opt)
+		default:
+			postRenderTransformer(renderer.Transformer, renderer.Values, env, objs)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("error running helm post-renderer %s: %w", renderer.Name(), err)
+		}
+	}
+	return objs, commands, nil
+}
+
+// writeRenderedManifests writes the manifests to the helmRenderedManifestsFile file of the directory and returns a
+// function removing it. It fails if the file already exists, rather than overwriting and then removing a file of the
+// repository.
+func writeRenderedManifests(dir string, objs []*unstructured.Unstructured) (func(), error) {
+	var docs []string
+	for _, obj := range objs {
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling manifest: %w", err)
+		}
+		docs = append(docs, string(data))
+	}
+	p := filepath.Join(dir, helmRenderedManifestsFile)
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			return nil, fmt.Errorf("error writing rendered manifests: %s already exists in %s", helmRenderedManifestsFile, dir)
+		}
+		return nil, fmt.Errorf("error writing rendered manifests: %w", err)
+	}
+	remove := func() { _ = os.Remove(p) }
+	_, err = f.WriteString(strings.Join(docs, "---\n"))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		remove()
+		return nil, fmt.Errorf("error writing rendered manifests: %w", err)
+	}
+	return remove, nil
+}
+
+func postRenderKustomize(appPath, repoRoot, kustomizePath string, env *v1alpha1.Env, q *apiclient.ManifestRequest, objs []*unstructured.Unstructured, gitCredsStore git.CredsStore) ([]*unstructured.Unstructured, []string, error) {
+	relPath, err := filepath.Rel(repoRoot, filepath.Join(appPath, kustomizePath))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error resolving kustomization path: %w", err)
+	}
+	overlayPath, err := apppathutil.Path(repoRoot, relPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	remove, err := writeRenderedManifests(overlayPath, objs)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer remove()
+
+	repoURL, proxy, noProxy, kustomizeBinary := "", "", "", ""
+	if q.Repo != nil {
+		repoURL, proxy, noProxy = q.Repo.Repo, q.Repo.Proxy, q.Repo.NoProxy
+	}
+	if q.KustomizeOptions != nil {
+		kustomizeBinary = q.KustomizeOptions.BinaryPath
+	}
+	k := kustomize.NewKustomizeApp(repoRoot, overlayPath, q.Repo.GetGitCreds(gitCredsStore), repoURL, kustomizeBinary, proxy, noProxy)
+	objs, _, commands, err := k.Build(nil, q.KustomizeOptions, env, &kustomize.BuildOpts{
+		KubeVersion: q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion),
+		APIVersions: q.ApplicationSource.GetAPIVersionsOrDefault(q.ApiVersions),
+	})
+	return objs, commands, err
+}
+
+func postRenderPlugin(ctx context.Context, appPath, repoRoot, pluginName string, env *v1alpha1.Env, q *apiclient.ManifestRequest, objs []*unstructured.Unstructured, gitCredsStore git.CredsStore, opt *generateManifestOpt) ([]*unstructured.Unstructured, error) {
+	remove, err := writeRenderedManifests(appPath, objs)
+	if err != nil {
+		return nil, err
+	}
+	defer remove()
+
+	// the plugin parameters of the source do not apply to the post-render step
+	source := q.ApplicationSource.DeepCopy()
+	source.Plugin = &v1alpha1.ApplicationSourcePlugin{Name: pluginName}
+	pluginQ := *q
+	pluginQ.ApplicationSource = source
+	// the tar done channel is not used since the repository must stay locked until the rendered manifests are removed
+	objs, _, err = runConfigManagementPluginSidecars(ctx, appPath, repoRoot, pluginName, env, &pluginQ, q.Repo.GetGitCreds(gitCredsStore), nil, opt.cmpTarExcludedGlobs, opt.cmpUseManifestGeneratePaths)
+	return objs, err
+}
+
+func postRenderTransformer(transformer string, values map[string]string, env *v1alpha1.Env, objs []*unstructured.Unstructured) {
+	for _, obj := range objs {
+		var current map[string]string
+		if transformer == v1alpha1.HelmPostRendererTransformerCommonLabels {
+			current = obj.GetLabels()
+		} else {
+			current = obj.GetAnnotations()
+		}
+		if current == nil {
+			current = map[string]string{}
+		}
+		for k, v := range values {
+			current[k] = env.Envsubst(v)
+		}
+		if transformer == v1alpha1.HelmPostRendererTransformerCommonLabels {
+			obj.SetLabels(current)
+		} else {
+			obj.SetAnnotations(current)
+		}
+	}
+}
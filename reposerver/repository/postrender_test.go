@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func renderedObjs(t *testing.T) []*unstructured.Unstructured {
+	t.Helper()
+	objs, err := kube.SplitYAML([]byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: a
+  labels:
+    app: a
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: b
+`))
+	require.NoError(t, err)
+	return objs
+}
+
+func TestPostRenderHelm_Transformers(t *testing.T) {
+	q := &apiclient.ManifestRequest{
+		ApplicationSource: &v1alpha1.ApplicationSource{
+			Helm: &v1alpha1.ApplicationSourceHelm{
+				PostRenderers: []v1alpha1.HelmPostRenderer{
+					{Transformer: v1alpha1.HelmPostRendererTransformerCommonLabels, Values: map[string]string{"team": "$ARGOCD_APP_NAME"}},
+					{Transformer: v1alpha1.HelmPostRendererTransformerCommonAnnotations, Values: map[string]string{"owner": "platform"}},
+				},
+			},
+		},
+	}
+	env := &v1alpha1.Env{{Name: "ARGOCD_APP_NAME", Value: "guestbook"}}
+
+	objs, commands, err := postRenderHelm(t.Context(), t.TempDir(), t.TempDir(), env, q, renderedObjs(t), nil, newGenerateManifestOpt())
+	require.NoError(t, err)
+	assert.Empty(t, commands)
+	require.Len(t, objs, 2)
+	assert.Equal(t, map[string]string{"app": "a", "team": "guestbook"}, objs[0].GetLabels())
+	assert.Equal(t, map[string]string{"team": "guestbook"}, objs[1].GetLabels())
+	assert.Equal(t, map[string]string{"owner": "platform"}, objs[1].GetAnnotations())
+}
+
+func TestPostRenderHelm_InvalidStep(t *testing.T) {
+	q := &apiclient.ManifestRequest{
+		ApplicationSource: &v1alpha1.ApplicationSource{
+			Helm: &v1alpha1.ApplicationSourceHelm{
+				PostRenderers: []v1alpha1.HelmPostRenderer{{Kustomize: "overlay", Plugin: "vault"}},
+			},
+		},
+	}
+	_, _, err := postRenderHelm(t.Context(), t.TempDir(), t.TempDir(), &v1alpha1.Env{}, q, renderedObjs(t), nil, newGenerateManifestOpt())
+	require.EqualError(t, err, "helm post-renderer 0 is invalid: exactly one of kustomize, plugin and transformer must be set")
+}
+
+func TestPostRenderHelm_KustomizeOutsideRepository(t *testing.T) {
+	repoRoot := t.TempDir()
+	q := &apiclient.ManifestRequest{
+		Repo: &v1alpha1.Repository{},
+		ApplicationSource: &v1alpha1.ApplicationSource{
+			Helm: &v1alpha1.ApplicationSourceHelm{
+				PostRenderers: []v1alpha1.HelmPostRenderer{{Kustomize: "../../overlay"}},
+			},
+		},
+	}
+	_, _, err := postRenderHelm(t.Context(), filepath.Join(repoRoot, "chart"), repoRoot, &v1alpha1.Env{}, q, renderedObjs(t), nil, newGenerateManifestOpt())
+	require.ErrorContains(t, err, "app path outside root")
+}
+
+func TestWriteRenderedManifests(t *testing.T) {
+	dir := t.TempDir()
+	remove, err := writeRenderedManifests(dir, renderedObjs(t))
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, helmRenderedManifestsFile))
+	require.NoError(t, err)
+	objs, err := kube.SplitYAML(data)
+	require.NoError(t, err)
+	require.Len(t, objs, 2)
+	assert.Equal(t, "a", objs[0].GetName())
+	assert.Equal(t, "b", objs[1].GetName())
+
+	remove()
+	assert.NoFileExists(t, filepath.Join(dir, helmRenderedManifestsFile))
+}
+
+func TestWriteRenderedManifests_FileExists(t *testing.T) {
+	dir := t.TempDir()
+	p := filepath.Join(dir, helmRenderedManifestsFile)
+	require.NoError(t, os.WriteFile(p, []byte("tracked"), 0o644))
+
+	_, err := writeRenderedManifests(dir, renderedObjs(t))
+	require.ErrorContains(t, err, helmRenderedManifestsFile+" already exists")
+
+	// the file of the repository is neither overwritten nor removed
+	data, err := os.ReadFile(p)
+	require.NoError(t, err)
+	assert.Equal(t, "tracked", string(data))
+}
+
+func TestPostRenderHelm_KustomizeWithoutRepo(t *testing.T) {
+	repoRoot := t.TempDir()
+	q := &apiclient.ManifestRequest{
+		ApplicationSource: &v1alpha1.ApplicationSource{
+			Helm: &v1alpha1.ApplicationSourceHelm{
+				PostRenderers: []v1alpha1.HelmPostRenderer{{Kustomize: "overlay"}},
+			},
+		},
+		KustomizeOptions: &v1alpha1.KustomizeOptions{BinaryPath: "false"},
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, "chart", "overlay"), 0o755))
+	assert.NotPanics(t, func() {
+		_, _, _ = postRenderHelm(t.Context(), filepath.Join(repoRoot, "chart"), repoRoot, &v1alpha1.Env{}, q, renderedObjs(t), nil, newGenerateManifestOpt())
+	})
+}
@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	goio "io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// The types of the workspaces of the repo server
+const (
+	workspaceTypeGit  = "git"
+	workspaceTypeHelm = "helm"
+	workspaceTypeOCI  = "oci"
+)
+
+// The reasons of the evictions of the workspaces
+const (
+	workspaceEvictionReasonRepoQuota   = "repo-quota"
+	workspaceEvictionReasonGlobalQuota = "global-quota"
+)
+
+// workspaceIdleGracePeriod is the period after their last use during which the cached Helm charts and OCI images, whose
+// use is not tracked, are not evicted
+const workspaceIdleGracePeriod = time.Minute
+
+// workspace is a git checkout, a Helm chart or an OCI image cached in the root directory of the repo server
+type workspace struct {
+	workspaceType string
+	lastUsed      time.Time
+	// inUse is the number of the operations using the git checkout
+	inUse int
+	// size is the disk usage of the git checkout measured after its last checkout, or -1 if unknown
+	size int64
+	// evict is whether the git checkout is removed once it is not in use anymore
+	evict bool
+}
+
+// workspaceQuotas enforces the disk quotas of the workspaces of the repo server: the workspaces exceeding the quota of
+// a single repository are removed, and the least recently used workspaces are removed while all the workspaces exceed
+// the global quota. The git checkouts in use are never removed. A nil workspaceQuotas enforces no quota.
+type workspaceQuotas struct {
+	repoQuota     int64
+	globalQuota   int64
+	metricsServer *metrics.MetricsServer
+	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
+	now func() time.Time
+
+	lock       sync.Mutex
+	workspaces map[string]*workspace
+}
+
+// newWorkspaceQuotas returns a workspaceQuotas enforcing the given quotas in bytes, or nil if no quota is given
+func newWorkspaceQuotas(metricsServer *metrics.MetricsServer, repoQuota int64, globalQuota int64) *workspaceQuotas {
+	if repoQuota <= 0 && globalQuota <= 0 {
+		return nil
+	}
+	return &workspaceQuotas{
+		repoQuota:     repoQuota,
+		globalQuota:   globalQuota,
+		metricsServer: metricsServer,
+		now:           time.Now,
+		workspaces:    map[string]*workspace{},
+	}
+}
+
+// trackPaths returns the given paths, recording the use of the workspaces of the given type they return
+func (q *workspaceQuotas) trackPaths(workspaceType string, paths utilio.TempPaths) utilio.TempPaths {
+	if q == nil {
+		return paths
+	}
+	return &trackedTempPaths{TempPaths: paths, quotas: q, workspaceType: workspaceType}
+}
+
+// acquire marks the git checkout of the given path as in use until the returned closer is closed. The returned closer
+// closes the given closer before enforcing the quotas.
+func (q *workspaceQuotas) acquire(path string, closer goio.Closer) goio.Closer {
+	if q == nil {
+		return closer
+	}
+	q.lock.Lock()
+	w := q.getLocked(workspaceTypeGit, path)
+	w.inUse++
+	w.lastUsed = q.now()
+	q.lock.Unlock()
+
+	return utilio.NewCloser(func() error {
+		err := closer.Close()
+		q.release(path)
+		return err
+	})
+}
+
+// release marks the git checkout of the given path as no longer in use, and enforces the quotas
+func (q *workspaceQuotas) release(path string) {
+	if q == nil {
+		return
+	}
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	w, ok := q.workspaces[path]
+	if !ok || w.inUse == 0 {
+		return
+	}
+	w.inUse--
+	w.lastUsed = q.now()
+	if w.inUse == 0 && w.evict {
+		q.evictLocked(path, w, workspaceEvictionReasonRepoQuota)
+	}
+	q.enforceLocked()
+}
+
+// checkRepoQuota measures the disk usage of the git checkout of the given repository, which must be in use, and returns
+// an error if it exceeds the quota of a single repository. The checkout is then removed once it is no longer in use.
+func (q *workspaceQuotas) checkRepoQuota(repoURL string, path string) error {
+	if q == nil {
+		return nil
+	}
+	size, err := diskUsage(path)
+	if err != nil {
+		log.Warnf("Failed to measure the disk usage of the checkout of %s: %v", repoURL, err)
+		return nil
+	}
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	w := q.getLocked(workspaceTypeGit, path)
+	w.size = size
+	if q.repoQuota > 0 && size > q.repoQuota {
+		w.evict = true
+		return fmt.Errorf("the checkout of repository %s uses %d bytes, exceeding the workspace quota of %d bytes", repoURL, size, q.repoQuota)
+	}
+	return nil
+}
+
+// touch records the use of the workspace of the given path
+func (q *workspaceQuotas) touch(workspaceType string, path string) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.getLocked(workspaceType, path).lastUsed = q.now()
+	if workspaceType != workspaceTypeGit {
+		// the downloads of the charts and images are not tracked, so the quotas are enforced whenever they are used
+		q.enforceLocked()
+	}
+}
+
+func (q *workspaceQuotas) getLocked(workspaceType string, path string) *workspace {
+	w, ok := q.workspaces[path]
+	if !ok {
+		w = &workspace{workspaceType: workspaceType, lastUsed: q.now(), size: -1}
+		q.workspaces[path] = w
+	}
+	return w
+}
+
+func (q *workspaceQuotas) evictableLocked(w *workspace) bool {
+	if w.inUse > 0 {
+		return false
+	}
+	return w.workspaceType == workspaceTypeGit || q.now().Sub(w.lastUsed) >= workspaceIdleGracePeriod
+}
+
+// sizeLocked returns the disk usage of the given workspace
+func (q *workspaceQuotas) sizeLocked(path string, w *workspace) int64 {
+	if w.workspaceType == workspaceTypeGit && w.size >= 0 {
+		return w.size
+	}
+	if w.workspaceType == workspaceTypeGit && w.inUse == 0 {
+		// the git checkouts which are not in use have no permissions
+		if err := os.Chmod(path, 0o700); err == nil {
+			defer func() {
+				if err := os.Chmod(path, 0o000); err != nil {
+					log.Warnf("Failed to remove permissions on %s: %v", path, err)
+				}
+			}()
+		}
+	}
+	size, err := diskUsage(path)
+	if err != nil {
+		log.Warnf("Failed to measure the disk usage of %s: %v", path, err)
+		return 0
+	}
+	if w.workspaceType == workspaceTypeGit {
+		w.size = size
+	}
+	return size
+}
+
+// enforceLocked removes the workspaces exceeding the quota of a single repository, then the least recently used
+// workspaces while all the workspaces exceed the global quota, and updates the disk usage metrics
+func (q *workspaceQuotas) enforceLocked() {
+	usage := map[string]int64{workspaceTypeGit: 0, workspaceTypeHelm: 0, workspaceTypeOCI: 0}
+	sizes := map[string]int64{}
+	var total int64
+	var evictable []string
+	for path, w := range q.workspaces {
+		size := q.sizeLocked(path, w)
+		if !q.evictableLocked(w) {
+			usage[w.workspaceType] += size
+			total += size
+			continue
+		}
+		if q.repoQuota > 0 && size > q.repoQuota {
+			q.evictLocked(path, w, workspaceEvictionReasonRepoQuota)
+			continue
+		}
+		usage[w.workspaceType] += size
+		total += size
+		sizes[path] = size
+		evictable = append(evictable, path)
+	}
+
+	if q.globalQuota > 0 && total > q.globalQuota {
+		sort.Slice(evictable, func(i, j int) bool {
+			return q.workspaces[evictable[i]].lastUsed.Before(q.workspaces[evictable[j]].lastUsed)
+		})
+		for _, path := range evictable {
+			if total <= q.globalQuota {
+				break
+			}
+			w := q.workspaces[path]
+			if q.evictLocked(path, w, workspaceEvictionReasonGlobalQuota) {
+				usage[w.workspaceType] -= sizes[path]
+				total -= sizes[path]
+			}
+		}
+		if total > q.globalQuota {
+			log.Warnf("The workspaces use %d bytes, exceeding the global workspace quota of %d bytes", total, q.globalQuota)
+		}
+	}
+
+	for workspaceType, bytes := range usage {
+		q.metricsServer.SetWorkspaceDiskUsage(workspaceType, bytes)
+	}
+}
+
+// evictLocked removes the given workspace, and returns whether it was removed
+func (q *workspaceQuotas) evictLocked(path string, w *workspace, reason string) bool {
+	// the git checkouts which are not in use have no permissions
+	if err := os.Chmod(path, 0o700); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Warnf("Failed to restore permissions on %s: %v", path, err)
+	}
+	if err := os.RemoveAll(path); err != nil {
+		log.Warnf("Failed to remove the %s workspace %s: %v", w.workspaceType, path, err)
+		return false
+	}
+	delete(q.workspaces, path)
+	q.metricsServer.IncWorkspaceEviction(w.workspaceType, reason)
+	log.Infof("Removed the %s workspace %s to enforce the %s", w.workspaceType, path, reason)
+	return true
+}
+
+// diskUsage returns the total size of the regular files of the given path, which is 0 if the path does not exist
+func diskUsage(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	return size, err
+}
+
+// trackedTempPaths records the use of the workspaces of the paths it returns
+type trackedTempPaths struct {
+	utilio.TempPaths
+	quotas        *workspaceQuotas
+	workspaceType string
+}
+
+func (p *trackedTempPaths) Add(key string, value string) {
+	p.TempPaths.Add(key, value)
+	p.quotas.touch(p.workspaceType, value)
+}
+
+func (p *trackedTempPaths) GetPath(key string) (string, error) {
+	path, err := p.TempPaths.GetPath(key)
+	if err == nil {
+		p.quotas.touch(p.workspaceType, path)
+	}
+	return path, err
+}
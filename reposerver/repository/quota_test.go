@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+func writeWorkspace(t *testing.T, path string, size int) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, make([]byte, size), 0o600))
+}
+
+func newTestWorkspaceQuotas(repoQuota int64, globalQuota int64, now *time.Time) *workspaceQuotas {
+	q := newWorkspaceQuotas(metrics.NewMetricsServer(), repoQuota, globalQuota)
+	q.now = func() time.Time {
+		return *now
+	}
+	return q
+}
+
+func TestWorkspaceQuotas_Disabled(t *testing.T) {
+	q := newWorkspaceQuotas(metrics.NewMetricsServer(), 0, 0)
+	assert.Nil(t, q)
+
+	paths := utilio.NewRandomizedTempPaths(t.TempDir())
+	assert.Same(t, paths, q.trackPaths(workspaceTypeGit, paths))
+	assert.Same(t, utilio.NopCloser, q.acquire("path", utilio.NopCloser))
+	require.NoError(t, q.checkRepoQuota("https://github.com/org/repo", "path"))
+}
+
+func TestWorkspaceQuotas_RepoQuota(t *testing.T) {
+	now := time.Now()
+	q := newTestWorkspaceQuotas(150, 0, &now)
+	root := t.TempDir()
+
+	small := filepath.Join(root, "small")
+	writeWorkspace(t, filepath.Join(small, "file"), 100)
+	closer := q.acquire(small, utilio.NopCloser)
+	require.NoError(t, q.checkRepoQuota("https://github.com/org/small", small))
+	require.NoError(t, closer.Close())
+	assert.DirExists(t, small)
+
+	// the checkout exceeding the quota fails, and is removed once it is no longer in use
+	large := filepath.Join(root, "large")
+	writeWorkspace(t, filepath.Join(large, "file1"), 100)
+	writeWorkspace(t, filepath.Join(large, "dir", "file2"), 100)
+	closer = q.acquire(large, utilio.NopCloser)
+	require.EqualError(t, q.checkRepoQuota("https://github.com/org/large", large), "the checkout of repository https://github.com/org/large uses 200 bytes, exceeding the workspace quota of 150 bytes")
+	assert.DirExists(t, large)
+	require.NoError(t, closer.Close())
+	assert.NoDirExists(t, large)
+	assert.DirExists(t, small)
+
+	// the cached charts exceeding the quota are removed once they are idle
+	chart := filepath.Join(root, "chart")
+	writeWorkspace(t, chart, 200)
+	q.touch(workspaceTypeHelm, chart)
+	assert.FileExists(t, chart)
+	now = now.Add(workspaceIdleGracePeriod)
+	q.touch(workspaceTypeHelm, filepath.Join(root, "other-chart"))
+	assert.NoFileExists(t, chart)
+}
+
+func TestWorkspaceQuotas_GlobalQuota(t *testing.T) {
+	now := time.Now()
+	q := newTestWorkspaceQuotas(0, 250, &now)
+	root := t.TempDir()
+
+	gitPaths := q.trackPaths(workspaceTypeGit, utilio.NewRandomizedTempPaths(root))
+	chartPaths := q.trackPaths(workspaceTypeHelm, utilio.NewRandomizedTempPaths(root))
+
+	use := func(path string) {
+		closer := q.acquire(path, utilio.NopCloser)
+		require.NoError(t, q.checkRepoQuota(path, path))
+		require.NoError(t, closer.Close())
+	}
+
+	repo1, err := gitPaths.GetPath("https://github.com/org/repo1")
+	require.NoError(t, err)
+	writeWorkspace(t, filepath.Join(repo1, "file"), 100)
+	use(repo1)
+
+	now = now.Add(time.Minute)
+	repo2, err := gitPaths.GetPath("https://github.com/org/repo2")
+	require.NoError(t, err)
+	writeWorkspace(t, filepath.Join(repo2, "file"), 100)
+	use(repo2)
+
+	now = now.Add(time.Minute)
+	chart, err := chartPaths.GetPath("chart")
+	require.NoError(t, err)
+	writeWorkspace(t, chart, 100)
+
+	// the git checkout in use is not evicted even though it is the least recently used one
+	closer := q.acquire(repo1, utilio.NopCloser)
+	now = now.Add(time.Minute)
+	_, err = chartPaths.GetPath("chart")
+	require.NoError(t, err)
+	assert.DirExists(t, repo1)
+	assert.NoDirExists(t, repo2)
+	assert.FileExists(t, chart)
+
+	now = now.Add(30 * time.Second)
+	require.NoError(t, closer.Close())
+
+	// the least recently used workspace is evicted
+	now = now.Add(time.Minute)
+	repo3, err := gitPaths.GetPath("https://github.com/org/repo3")
+	require.NoError(t, err)
+	writeWorkspace(t, filepath.Join(repo3, "file"), 100)
+	use(repo3)
+	assert.NoFileExists(t, chart)
+	assert.DirExists(t, repo1)
+	assert.DirExists(t, repo3)
+}
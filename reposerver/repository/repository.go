@@ -3,6 +3,7 @@ package repository
 import (
 	"bytes"
 	"context"
+	"crypto/rsa"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -27,12 +28,15 @@ import (
 	"github.com/argoproj/pkg/v2/sync"
 	jsonpatch "github.com/evanphx/json-patch"
 	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/go-jsonnet"
 	"github.com/google/uuid"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/semaphore"
+	"golang.org/x/sync/singleflight"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -44,6 +48,7 @@ import (
 
 	pluginclient "github.com/argoproj/argo-cd/v3/cmpserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/errorcode"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/reposerver/cache"
@@ -52,6 +57,7 @@ import (
 	apppathutil "github.com/argoproj/argo-cd/v3/util/app/path"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/cmp"
+	cryptoutil "github.com/argoproj/argo-cd/v3/util/crypto"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
@@ -70,6 +76,7 @@ const (
 	helmDepUpMarkerFile            = ".argocd-helm-dep-up"
 	repoSourceFile                 = ".argocd-source.yaml"
 	appSourceFile                  = ".argocd-source-%s.yaml"
+	argoCDIgnoreFile               = ".argocdignore"
 	ociPrefix                      = "oci://"
 	skipFileRenderingMarker        = "+argocd:skip-file-rendering"
 )
@@ -83,6 +90,7 @@ type Service struct {
 	gitRepoPaths              utilio.TempPaths
 	chartPaths                utilio.TempPaths
 	ociPaths                  utilio.TempPaths
+	workspaces                *workspaceQuotas
 	gitRepoInitializer        func(rootPath string) goio.Closer
 	repoLock                  *repositoryLock
 	cache                     *cache.Cache
@@ -94,6 +102,8 @@ type Service struct {
 	initConstants             RepoServerInitConstants
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
 	now func() time.Time
+	// manifestRequests coalesces the identical manifest requests processed concurrently
+	manifestRequests singleflight.Group
 }
 
 type RepoServerInitConstants struct {
@@ -115,6 +125,23 @@ type RepoServerInitConstants struct {
 	DisableHelmManifestMaxExtractedSize          bool
 	IncludeHiddenDirectories                     bool
 	CMPUseManifestGeneratePaths                  bool
+	// ParameterDecryptionKeysPath is the directory of the PEM files holding the private keys unsealing the parameters
+	ParameterDecryptionKeysPath string
+	// WorkspaceRepoQuota is the maximum disk usage in bytes of the checkout of a single repository, or of a single cached
+	// Helm chart or OCI image. 0 for no quota.
+	WorkspaceRepoQuota int64
+	// WorkspaceGlobalQuota is the maximum disk usage in bytes of all the checkouts and cached Helm charts and OCI images,
+	// enforced by removing the least recently used ones. 0 for no quota.
+	WorkspaceGlobalQuota int64
+	// ManifestGenerationErrorCacheTTL is the duration during which the template errors of the manifest generation are
+	// returned from the cache instead of rendering the manifests again. 0 to not cache them besides the pause of the
+	// generation after several failures.
+	ManifestGenerationErrorCacheTTL time.Duration
+	// SSHAgentSocket is the socket of the SSH agent of the repo server, with which the SSH repositories matching
+	// SSHAgentRepositories are authenticated when they have no private key
+	SSHAgentSocket string
+	// SSHAgentRepositories are the glob patterns of the URLs of the SSH repositories allowed to use the SSH agent
+	SSHAgentRepositories []string
 }
 
 var manifestGenerateLock = sync.NewKeyLock()
@@ -129,6 +156,7 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 	gitRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
 	helmRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
 	ociRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
+	workspaces := newWorkspaceQuotas(metricsServer, initConstants.WorkspaceRepoQuota, initConstants.WorkspaceGlobalQuota)
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
 		repoLock:                  repoLock,
@@ -142,9 +170,10 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 		initConstants:      initConstants,
 		now:                time.Now,
 		gitCredsStore:      gitCredsStore,
-		gitRepoPaths:       gitRandomizedPaths,
-		chartPaths:         helmRandomizedPaths,
-		ociPaths:           ociRandomizedPaths,
+		gitRepoPaths:       workspaces.trackPaths(workspaceTypeGit, gitRandomizedPaths),
+		chartPaths:         workspaces.trackPaths(workspaceTypeHelm, helmRandomizedPaths),
+		ociPaths:           workspaces.trackPaths(workspaceTypeOCI, ociRandomizedPaths),
+		workspaces:         workspaces,
 		gitRepoInitializer: directoryPermissionInitializer,
 		rootDir:            rootDir,
 	}
@@ -245,7 +274,7 @@ func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*
 	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
 
 	closer, err := s.repoLock.Lock(gitClient.Root(), commitSHA, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, commitSHA, s.initConstants.SubmoduleEnabled)
+		return s.checkoutRevision(ctx, gitClient, q.Repo.Repo, commitSHA, s.initConstants.SubmoduleEnabled)
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error acquiring repository lock: %w", err)
@@ -379,7 +408,9 @@ func (s *Service) runRepoOperation(
 			}
 		}
 
-		ociPath, closer, err := ociClient.Extract(ctx, revision)
+		extractCtx, endSpan := startSpan(ctx, spanOCIExtract, repoURLAttribute(repo.Repo), attrRevision.String(revision))
+		ociPath, closer, err := ociClient.Extract(extractCtx, revision)
+		endSpan(err)
 		if err != nil {
 			return err
 		}
@@ -421,7 +452,9 @@ func (s *Service) runRepoOperation(
 		if source.Helm != nil {
 			helmPassCredentials = source.Helm.PassCredentials
 		}
+		_, endSpan := startSpan(ctx, spanHelmChartExtract, repoURLAttribute(repo.Repo), attrChart.String(source.Chart), attrRevision.String(revision))
 		chartPath, closer, err := helmClient.ExtractChart(source.Chart, revision, helmPassCredentials, s.initConstants.HelmManifestMaxExtractedSize, s.initConstants.DisableHelmManifestMaxExtractedSize)
+		endSpan(err)
 		if err != nil {
 			return err
 		}
@@ -447,7 +480,7 @@ func (s *Service) runRepoOperation(
 		})
 	}
 	closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled)
+		return s.checkoutRevision(ctx, gitClient, repo.Repo, revision, s.initConstants.SubmoduleEnabled)
 	})
 	if err != nil {
 		return err
@@ -581,6 +614,10 @@ func resolveReferencedSources(hasMultipleSources bool, source *v1alpha1.Applicat
 }
 
 func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+	return s.coalesceManifestRequest(ctx, q, s.generateManifest)
+}
+
+func (s *Service) generateManifest(ctx context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
 	var res *apiclient.ManifestResponse
 	var err error
 
@@ -832,7 +869,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 							return
 						}
 						closer, err := s.repoLock.Lock(gitClient.Root(), referencedCommitSHA, true, func() (goio.Closer, error) {
-							return s.checkoutRevision(gitClient, referencedCommitSHA, s.initConstants.SubmoduleEnabled)
+							return s.checkoutRevision(ctx, gitClient, refSourceMapping.Repo.Repo, referencedCommitSHA, s.initConstants.SubmoduleEnabled)
 						})
 						if err != nil {
 							log.Errorf("failed to acquire lock for referenced source %s", normalizedRepoURL)
@@ -872,7 +909,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			}
 		}
 
-		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths))
+		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths), WithParameterDecryptionKeysPath(s.initConstants.ParameterDecryptionKeysPath))
 	}
 	refSourceCommitSHAs := make(map[string]string)
 	if len(repoRefs) > 0 {
@@ -886,8 +923,10 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			"appNamespace": q.Namespace,
 		})
 
-		// If manifest generation error caching is enabled
-		if s.initConstants.PauseGenerationAfterFailedGenerationAttempts > 0 {
+		errorType := classifyManifestGenerationError(err)
+		// If manifest generation error caching is enabled. The transient errors are not cached, so that the manifests
+		// are generated again by the next request.
+		if (s.initConstants.PauseGenerationAfterFailedGenerationAttempts > 0 || s.initConstants.ManifestGenerationErrorCacheTTL > 0) && errorType != ManifestGenerationErrorTypeTransient {
 			cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifests error", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
 
 			// Retrieve a new copy (if available) of the cached response: this ensures we are updating the latest copy of the cache,
@@ -911,6 +950,8 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			// Update the cache to include failure information
 			innerRes.NumberOfConsecutiveFailures++
 			innerRes.MostRecentError = err.Error()
+			innerRes.MostRecentErrorTimestamp = s.now().Unix()
+			innerRes.MostRecentErrorType = string(errorType)
 			cacheErr = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs, q.InstallationID)
 
 			if cacheErr != nil {
@@ -956,6 +997,20 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 	if err == nil {
 		// The cache contains an existing value
 
+		if res.FirstFailureTimestamp > 0 {
+			// If res is a recent template error, the manifests are not generated again until it expires
+			if s.isRecentTemplateError(&res) {
+				log.Infof("manifest error cache hit: %s/%s", q.ApplicationSource.String(), cacheKey)
+				// nolint:staticcheck // Error message constant is very old, best not to lowercase the first letter.
+				return true, nil, fmt.Errorf(cachedManifestGenerationPrefix+": %s", res.MostRecentError)
+			}
+			// The errors are only cached for the TTL if the pause of the generation is disabled
+			if s.initConstants.PauseGenerationAfterFailedGenerationAttempts == 0 {
+				log.Infof("manifest error cache miss: %s/%s", q.ApplicationSource.String(), cacheKey)
+				return false, nil, nil
+			}
+		}
+
 		// If caching of manifest generation errors is enabled, and res is a cached manifest generation error...
 		if s.initConstants.PauseGenerationAfterFailedGenerationAttempts > 0 && res.FirstFailureTimestamp > 0 {
 			// If we are already in the 'manifest generation caching' state, due to too many consecutive failures...
@@ -1033,6 +1088,15 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 	return false, nil, nil
 }
 
+// isRecentTemplateError returns true if the given cached response is a template error more recent than the TTL of the
+// cached manifest generation errors
+func (s *Service) isRecentTemplateError(res *cache.CachedManifestResponse) bool {
+	if s.initConstants.ManifestGenerationErrorCacheTTL <= 0 || res.MostRecentErrorType != string(ManifestGenerationErrorTypeTemplate) {
+		return false
+	}
+	return s.now().Sub(time.Unix(res.MostRecentErrorTimestamp, 0)) < s.initConstants.ManifestGenerationErrorCacheTTL
+}
+
 func getHelmRepos(appPath string, repositories []*v1alpha1.Repository, helmRepoCreds []*v1alpha1.RepoCreds) ([]helm.HelmRepository, error) {
 	dependencies, err := getHelmDependencyRepos(appPath)
 	if err != nil {
@@ -1140,15 +1204,18 @@ func sanitizeRepoName(repoName string) string {
 // if multiple threads are trying to run it.
 // Multiple goroutines might process same helm app in one repo concurrently when repo server process multiple
 // manifest generation requests of the same commit.
-func runHelmBuild(appPath string, h helm.Helm) error {
+func runHelmBuild(ctx context.Context, appPath string, h helm.Helm) (err error) {
 	manifestGenerateLock.Lock(appPath)
 	defer manifestGenerateLock.Unlock(appPath)
 
+	_, endSpan := startSpan(ctx, spanHelmDependencyBuild, attrAppPath.String(appPath))
+	defer func() { endSpan(err) }()
+
 	// the `helm dependency build` is potentially a time-consuming 1~2 seconds,
 	// a marker file is used to check if command already run to avoid running it again unnecessarily
 	// the file is removed when repository is re-initialized (e.g. when another commit is processed)
 	markerFile := path.Join(appPath, helmDepUpMarkerFile)
-	_, err := os.Stat(markerFile)
+	_, err = os.Stat(markerFile)
 	if err == nil {
 		return nil
 	} else if !os.IsNotExist(err) {
@@ -1162,12 +1229,36 @@ func runHelmBuild(appPath string, h helm.Helm) error {
 	return os.WriteFile(markerFile, []byte("marker"), 0o644)
 }
 
+// loadParameterDecryptionKeys loads the private keys unsealing the parameters from the PEM files of the given directory
+func loadParameterDecryptionKeys(dir string) ([]*rsa.PrivateKey, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("error listing parameter decryption keys: %w", err)
+	}
+	var keys []*rsa.PrivateKey
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading parameter decryption key: %w", err)
+		}
+		fileKeys, err := cryptoutil.ParseSealingPrivateKeys(data)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing parameter decryption key %s: %w", filepath.Base(file), err)
+		}
+		keys = append(keys, fileKeys...)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("the parameters are sealed but no parameter decryption key is found in %s", dir)
+	}
+	return keys, nil
+}
+
 func isSourcePermitted(url string, repos []string) bool {
 	p := v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{SourceRepos: repos}}
 	return p.IsSourcePermitted(v1alpha1.ApplicationSource{RepoURL: url})
 }
 
-func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths) ([]*unstructured.Unstructured, string, error) {
+func helmTemplate(ctx context.Context, appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths, parameterDecryptionKeysPath string) ([]*unstructured.Unstructured, string, error) {
 	// We use the app name as Helm's release name property, which must not
 	// contain any underscore characters and must not exceed 53 characters.
 	// We are not interested in the fully qualified application name while
@@ -1224,11 +1315,27 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 			templateOpts.ExtraValues = pathutil.ResolvedFilePath(p)
 		}
 
+		var decryptionKeys []*rsa.PrivateKey
+		// the instance name of the application is prefixed with its namespace when it is not the control plane one
+		appName := strings.TrimPrefix(q.AppName, q.AppNamespace+"_")
 		for _, p := range appHelm.Parameters {
+			value := p.Value
+			if cryptoutil.IsSealedValue(value) {
+				if decryptionKeys == nil {
+					if decryptionKeys, err = loadParameterDecryptionKeys(parameterDecryptionKeysPath); err != nil {
+						return nil, "", err
+					}
+				}
+				label := cryptoutil.SealLabel(q.ProjectName, q.AppNamespace, appName, p.Name)
+				if value, err = cryptoutil.UnsealValue(decryptionKeys, label, value); err != nil {
+					return nil, "", fmt.Errorf("error unsealing helm parameter %s: %w", p.Name, err)
+				}
+				templateOpts.SensitiveValues = append(templateOpts.SensitiveValues, value)
+			}
 			if p.ForceString {
-				templateOpts.SetString[p.Name] = p.Value
+				templateOpts.SetString[p.Name] = value
 			} else {
-				templateOpts.Set[p.Name] = p.Value
+				templateOpts.Set[p.Name] = value
 			}
 		}
 		for _, p := range appHelm.FileParameters {
@@ -1283,10 +1390,13 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 	out, command, err := h.Template(templateOpts)
 	if err != nil {
 		if !helm.IsMissingDependencyErr(err) {
+			if schemaErr := parseValuesSchemaError(err); schemaErr != nil {
+				return nil, "", schemaErr
+			}
 			return nil, "", err
 		}
 
-		err = runHelmBuild(appPath, h)
+		err = runHelmBuild(ctx, appPath, h)
 		if err != nil {
 			var reposNotPermitted []string
 			// We do a sanity check here to give a nicer error message in case any of the Helm repositories are not permitted by
@@ -1311,6 +1421,9 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 
 		out, command, err = h.Template(templateOpts)
 		if err != nil {
+			if schemaErr := parseValuesSchemaError(err); schemaErr != nil {
+				return nil, "", schemaErr
+			}
 			return nil, "", err
 		}
 	}
@@ -1436,6 +1549,7 @@ type (
 		cmpTarDoneCh                chan<- bool
 		cmpTarExcludedGlobs         []string
 		cmpUseManifestGeneratePaths bool
+		parameterDecryptionKeysPath string
 	}
 )
 
@@ -1472,6 +1586,14 @@ func WithCMPUseManifestGeneratePaths(enabled bool) GenerateManifestOpt {
 	}
 }
 
+// WithParameterDecryptionKeysPath defines the directory of the PEM files holding the private keys unsealing the
+// sealed Helm parameters.
+func WithParameterDecryptionKeysPath(path string) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.parameterDecryptionKeysPath = path
+	}
+}
+
 // GenerateManifests generates manifests from a path. Overrides are applied as a side effect on the given ApplicationSource.
 func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool, gitCredsStore git.CredsStore, maxCombinedManifestQuantity resource.Quantity, gitRepoPaths utilio.TempPaths, opts ...GenerateManifestOpt) (*apiclient.ManifestResponse, error) {
 	opt := newGenerateManifestOpt(opts...)
@@ -1491,13 +1613,23 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 	}
 
 	var commands []string
+	var pluginDigests map[string]string
 
+	spanAttrs := []attribute.KeyValue{repoURLAttribute(repoURL), attrRevision.String(revision), attrAppName.String(q.AppName), attrSourceType.String(string(appSourceType))}
+	var endSpan func(err error)
 	switch appSourceType {
 	case v1alpha1.ApplicationSourceTypeHelm:
 		var command string
-		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths)
+		ctx, endSpan = startSpan(ctx, spanHelmTemplate, spanAttrs...)
+		targetObjs, command, err = helmTemplate(ctx, appPath, repoRoot, env, q, isLocal, gitRepoPaths, opt.parameterDecryptionKeysPath)
 		commands = append(commands, command)
+		if err == nil {
+			var postRenderCommands []string
+			targetObjs, postRenderCommands, err = postRenderHelm(ctx, appPath, repoRoot, env, q, targetObjs, gitCredsStore, opt)
+			commands = append(commands, postRenderCommands...)
+		}
 	case v1alpha1.ApplicationSourceTypeKustomize:
+		_, endSpan = startSpan(ctx, spanKustomizeBuild, spanAttrs...)
 		kustomizeBinary := ""
 		if q.KustomizeOptions != nil {
 			kustomizeBinary = q.KustomizeOptions.BinaryPath
@@ -1512,8 +1644,9 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		if q.ApplicationSource.Plugin != nil {
 			pluginName = q.ApplicationSource.Plugin.Name
 		}
+		ctx, endSpan = startSpan(ctx, spanPluginGenerate, append(spanAttrs, attrPluginName.String(pluginName))...)
 		// if pluginName is provided it has to be `<metadata.name>-<spec.version>` or just `<metadata.name>` if plugin version is empty
-		targetObjs, err = runConfigManagementPluginSidecars(ctx, appPath, repoRoot, pluginName, env, q, q.Repo.GetGitCreds(gitCredsStore), opt.cmpTarDoneCh, opt.cmpTarExcludedGlobs, opt.cmpUseManifestGeneratePaths)
+		targetObjs, pluginDigests, err = runConfigManagementPluginSidecars(ctx, appPath, repoRoot, pluginName, env, q, q.Repo.GetGitCreds(gitCredsStore), opt.cmpTarDoneCh, opt.cmpTarExcludedGlobs, opt.cmpUseManifestGeneratePaths)
 		if err != nil {
 			err = fmt.Errorf("plugin sidecar failed. %s", err.Error())
 		}
@@ -1523,8 +1656,12 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 			directory = &v1alpha1.ApplicationSourceDirectory{}
 		}
 		logCtx := log.WithField("application", q.AppName)
+		_, endSpan = startSpan(ctx, spanDirectoryManifests, spanAttrs...)
 		targetObjs, err = findManifests(logCtx, appPath, repoRoot, env, *directory, q.EnabledSourceTypes, maxCombinedManifestQuantity)
 	}
+	if endSpan != nil {
+		endSpan(err)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1571,9 +1708,11 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 	}
 
 	return &apiclient.ManifestResponse{
-		Manifests:  manifests,
-		SourceType: string(appSourceType),
-		Commands:   commands,
+		Manifests:    manifests,
+		SourceType:   string(appSourceType),
+		Commands:     commands,
+		ToolVersions: getToolVersions(appSourceType, q, pluginDigests),
+		BuildEnv:     *env,
 	}, nil
 }
 
@@ -1939,15 +2078,37 @@ func getPotentiallyValidManifests(logCtx *log.Entry, appPath string, repoRoot st
 	currentCombinedManifestFileSize := int64(0)
 
 	var potentiallyValidManifests []potentiallyValidManifest
+	var ignorePatterns []gitignore.Pattern
 	err := filepath.Walk(appPath, func(path string, f os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
+		relPath, err := filepath.Rel(appPath, path)
+		if err != nil {
+			return fmt.Errorf("failed to get relative path of %q: %w", path, err)
+		}
+		var relParts []string
+		if relPath != "." {
+			relParts = strings.Split(filepath.ToSlash(relPath), "/")
+		}
+		if len(ignorePatterns) > 0 && len(relParts) > 0 && gitignore.NewMatcher(ignorePatterns).Match(relParts, f.IsDir()) {
+			if f.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
 		if f.IsDir() {
 			if path != appPath && !recurse {
 				return filepath.SkipDir
 			}
+			patterns, err := readArgoCDIgnorePatterns(path, relParts)
+			if err != nil {
+				return fmt.Errorf("failed to read %s of %q: %w", argoCDIgnoreFile, path, err)
+			}
+			// patterns of subdirectories are appended last so that they take precedence, as with .gitignore files
+			ignorePatterns = append(ignorePatterns, patterns...)
 			return nil
 		}
 
@@ -1981,6 +2142,36 @@ func getPotentiallyValidManifests(logCtx *log.Entry, appPath string, repoRoot st
 	return potentiallyValidManifests, nil
 }
 
+// readArgoCDIgnorePatterns reads the patterns of the .argocdignore file of the given directory, if there is one. The
+// domain is the path of the directory relative to the application path, below which the patterns apply.
+func readArgoCDIgnorePatterns(dir string, domain []string) ([]gitignore.Pattern, error) {
+	ignorePath := filepath.Join(dir, argoCDIgnoreFile)
+	info, err := os.Lstat(ignorePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// symlinks are not followed, so that an ignore file cannot be read from outside of the repository
+	if !info.Mode().IsRegular() {
+		return nil, nil
+	}
+	data, err := os.ReadFile(ignorePath)
+	if err != nil {
+		return nil, err
+	}
+	var patterns []gitignore.Pattern
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		patterns = append(patterns, gitignore.ParsePattern(line, domain))
+	}
+	return patterns, nil
+}
+
 func makeJsonnetVM(appPath string, repoRoot string, sourceJsonnet v1alpha1.ApplicationSourceJsonnet, env *v1alpha1.Env) (*jsonnet.VM, error) {
 	vm := jsonnet.MakeVM()
 	for i, j := range sourceJsonnet.TLAs {
@@ -2059,17 +2250,17 @@ func getPluginParamEnvs(envVars []string, plugin *v1alpha1.ApplicationSourcePlug
 	return env, nil
 }
 
-func runConfigManagementPluginSidecars(ctx context.Context, appPath, repoPath, pluginName string, envVars *v1alpha1.Env, q *apiclient.ManifestRequest, creds git.Creds, tarDoneCh chan<- bool, tarExcludedGlobs []string, useManifestGeneratePaths bool) ([]*unstructured.Unstructured, error) {
+func runConfigManagementPluginSidecars(ctx context.Context, appPath, repoPath, pluginName string, envVars *v1alpha1.Env, q *apiclient.ManifestRequest, creds git.Creds, tarDoneCh chan<- bool, tarExcludedGlobs []string, useManifestGeneratePaths bool) ([]*unstructured.Unstructured, map[string]string, error) {
 	// compute variables.
 	env, err := getPluginEnvs(envVars, q)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// detect config management plugin server
 	conn, cmpClient, err := discovery.DetectConfigManagementPlugin(ctx, appPath, repoPath, pluginName, env, tarExcludedGlobs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer utilio.Close(conn)
 
@@ -2082,14 +2273,14 @@ func runConfigManagementPluginSidecars(ctx context.Context, appPath, repoPath, p
 
 	pluginConfigResponse, err := cmpClient.CheckPluginConfiguration(ctx, &emptypb.Empty{})
 	if err != nil {
-		return nil, fmt.Errorf("error calling cmp-server checkPluginConfiguration: %w", err)
+		return nil, nil, fmt.Errorf("error calling cmp-server checkPluginConfiguration: %w", err)
 	}
 
 	if pluginConfigResponse.ProvideGitCreds {
 		if creds != nil {
 			closer, environ, err := creds.Environ()
 			if err != nil {
-				return nil, fmt.Errorf("failed to retrieve git creds environment variables: %w", err)
+				return nil, nil, fmt.Errorf("failed to retrieve git creds environment variables: %w", err)
 			}
 			defer func() { _ = closer.Close() }()
 			env = append(env, environ...)
@@ -2099,7 +2290,7 @@ func runConfigManagementPluginSidecars(ctx context.Context, appPath, repoPath, p
 	// generate manifests using commands provided in plugin config file in detected cmp-server sidecar
 	cmpManifests, err := generateManifestsCMP(ctx, appPath, rootPath, env, cmpClient, tarDoneCh, tarExcludedGlobs)
 	if err != nil {
-		return nil, fmt.Errorf("error generating manifests in cmp: %w", err)
+		return nil, nil, fmt.Errorf("error generating manifests in cmp: %w", err)
 	}
 	var manifests []*unstructured.Unstructured
 	for _, manifestString := range cmpManifests.Manifests {
@@ -2110,11 +2301,11 @@ func runConfigManagementPluginSidecars(ctx context.Context, appPath, repoPath, p
 				sanitizedManifestString = sanitizedManifestString[:1000]
 			}
 			log.Debugf("Failed to convert generated manifests. Beginning of generated manifests: %q", sanitizedManifestString)
-			return nil, fmt.Errorf("failed to convert CMP manifests to unstructured objects: %s", err.Error())
+			return nil, nil, fmt.Errorf("failed to convert CMP manifests to unstructured objects: %s", err.Error())
 		}
 		manifests = append(manifests, manifestObjs...)
 	}
-	return manifests, nil
+	return manifests, cmpManifests.ToolDigests, nil
 }
 
 // generateManifestsCMP will send the appPath files to the cmp-server over a gRPC stream.
@@ -2367,7 +2558,49 @@ func populatePluginAppDetails(ctx context.Context, res *apiclient.RepoAppDetails
 	return nil
 }
 
-func (s *Service) GetRevisionMetadata(_ context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+	metadata, err := s.getRevisionMetadata(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	// The changes are not cached along with the metadata, since the changed files depend on the base revision. Failing
+	// to get them does not fail the request, as they only complement the metadata of the revision.
+	if q.BaseRevision != "" {
+		files, err := s.getChangedFiles(ctx, q)
+		if err != nil {
+			log.Warnf("failed to get the files changed between %s and %s in repo %s: %v", q.BaseRevision, q.Revision, q.Repo.Repo, err)
+		} else {
+			metadata.ChangedFiles = files
+		}
+	}
+	if q.IncludePullRequest {
+		pr, err := git.FindGitHubPullRequest(ctx, q.Repo.Repo, q.Repo.GetGitCreds(s.gitCredsStore), q.Repo.Proxy, q.Repo.NoProxy, q.Revision)
+		if err != nil {
+			log.Warnf("failed to get the pull request of revision %s in repo %s: %v", q.Revision, q.Repo.Repo, err)
+		} else if pr != nil {
+			metadata.PullRequest = &v1alpha1.RevisionPullRequest{Number: int64(pr.Number), Title: pr.Title, Author: pr.Author, URL: pr.URL}
+		}
+	}
+	return metadata, nil
+}
+
+// getChangedFiles returns the files changed between the base revision and the revision of the request
+func (s *Service) getChangedFiles(ctx context.Context, q *apiclient.RepoServerRevisionMetadataRequest) ([]string, error) {
+	gitClient, _, err := s.newClientResolveRevision(q.Repo, q.Revision)
+	if err != nil {
+		return nil, err
+	}
+	closer, err := s.repoLock.Lock(gitClient.Root(), q.Revision, true, func() (goio.Closer, error) {
+		return s.checkoutRevision(ctx, gitClient, q.Repo.Repo, q.Revision, s.initConstants.SubmoduleEnabled)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error acquiring repo lock: %w", err)
+	}
+	defer utilio.Close(closer)
+	return gitClient.ChangedFiles(q.BaseRevision, q.Revision)
+}
+
+func (s *Service) getRevisionMetadata(ctx context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
 	if !git.IsCommitSHA(q.Revision) && !git.IsTruncatedCommitSHA(q.Revision) {
 		return nil, fmt.Errorf("revision %s must be resolved", q.Revision)
 	}
@@ -2403,7 +2636,7 @@ func (s *Service) GetRevisionMetadata(_ context.Context, q *apiclient.RepoServer
 	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
 
 	closer, err := s.repoLock.Lock(gitClient.Root(), q.Revision, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, q.Revision, s.initConstants.SubmoduleEnabled)
+		return s.checkoutRevision(ctx, gitClient, q.Repo.Repo, q.Revision, s.initConstants.SubmoduleEnabled)
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error acquiring repo lock: %w", err)
@@ -2535,7 +2768,26 @@ func (s *Service) newClient(repo *v1alpha1.Repository, opts ...git.ClientOpts) (
 		return nil, err
 	}
 	opts = append(opts, git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)))
-	return s.newGitClient(repo.Repo, repoPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, repo.NoProxy, opts...)
+	return s.newGitClient(repo.Repo, repoPath, s.getGitCreds(repo), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, repo.NoProxy, opts...)
+}
+
+// getGitCreds returns the credentials of the given Git repository. The SSH repositories without private key are
+// authenticated with the SSH agent of the repo server when their URL matches one of the patterns of the repositories
+// allowed to use it, so that the agent cannot be used with any repository added by the users.
+func (s *Service) getGitCreds(repo *v1alpha1.Repository) git.Creds {
+	creds := repo.GetGitCreds(s.gitCredsStore)
+	if _, ok := creds.(git.NopCreds); !ok || s.initConstants.SSHAgentSocket == "" || repo == nil {
+		return creds
+	}
+	if isSSH, _ := git.IsSSHURL(repo.Repo); !isSSH {
+		return creds
+	}
+	for _, pattern := range s.initConstants.SSHAgentRepositories {
+		if glob.Match(pattern, repo.Repo) {
+			return git.NewSSHAgentCreds(s.initConstants.SSHAgentSocket, repo.IsInsecure(), repo.Proxy)
+		}
+	}
+	return creds
 }
 
 // newClientResolveRevision is a helper to perform the common task of instantiating a git client
@@ -2626,19 +2878,27 @@ func directoryPermissionInitializer(rootPath string) goio.Closer {
 
 // checkoutRevision is a convenience function to initialize a repo, fetch, and checkout a revision
 // Returns the 40 character commit SHA after the checkout has been performed
-func (s *Service) checkoutRevision(gitClient git.Client, revision string, submoduleEnabled bool) (goio.Closer, error) {
-	closer := s.gitRepoInitializer(gitClient.Root())
-	err := checkoutRevision(gitClient, revision, submoduleEnabled)
+func (s *Service) checkoutRevision(ctx context.Context, gitClient git.Client, repoURL, revision string, submoduleEnabled bool) (goio.Closer, error) {
+	ctx, endSpan := startSpan(ctx, spanGitCheckout, repoURLAttribute(repoURL), attrRevision.String(revision))
+	closer := s.workspaces.acquire(gitClient.Root(), s.gitRepoInitializer(gitClient.Root()))
+	err := checkoutRevision(ctx, gitClient, revision, submoduleEnabled)
 	if err != nil {
 		s.metricsServer.IncGitFetchFail(gitClient.Root(), revision)
+	} else {
+		err = s.workspaces.checkRepoQuota(repoURL, gitClient.Root())
 	}
+	if err != nil {
+		// the repository lock drops the closer of a failed checkout, so the checkout is released right away
+		s.workspaces.release(gitClient.Root())
+	}
+	endSpan(err)
 	return closer, err
 }
 
 // fetch is a convenience function to fetch revisions
 // We assumed that the caller has already initialized the git repo, i.e. gitClient.Init() has been called
-func (s *Service) fetch(gitClient git.Client, targetRevisions []string) error {
-	err := fetch(gitClient, targetRevisions)
+func (s *Service) fetch(ctx context.Context, gitClient git.Client, targetRevisions []string) error {
+	err := fetch(ctx, gitClient, targetRevisions)
 	if err != nil {
 		for _, revision := range targetRevisions {
 			s.metricsServer.IncGitFetchFail(gitClient.Root(), revision)
@@ -2647,7 +2907,7 @@ func (s *Service) fetch(gitClient git.Client, targetRevisions []string) error {
 	return err
 }
 
-func fetch(gitClient git.Client, targetRevisions []string) error {
+func fetch(ctx context.Context, gitClient git.Client, targetRevisions []string) error {
 	revisionPresent := true
 	for _, revision := range targetRevisions {
 		revisionPresent = gitClient.IsRevisionPresent(revision)
@@ -2660,7 +2920,7 @@ func fetch(gitClient git.Client, targetRevisions []string) error {
 		return nil
 	}
 	// Fetching with no revision first. Fetching with an explicit version can cause repo bloat. https://github.com/argoproj/argo-cd/issues/8845
-	err := gitClient.Fetch("")
+	err := fetchRevision(ctx, gitClient, "")
 	if err != nil {
 		return err
 	}
@@ -2671,7 +2931,7 @@ func fetch(gitClient git.Client, targetRevisions []string) error {
 			log.Infof("Failed to fetch revision %s: %v", revision, err)
 			log.Infof("Fallback to fetching specific revision %s. ref might not have been in the default refspec fetched.", revision)
 
-			if err := gitClient.Fetch(revision); err != nil {
+			if err := fetchRevision(ctx, gitClient, revision); err != nil {
 				return status.Errorf(codes.Internal, "Failed to fetch revision %s: %v", revision, err)
 			}
 		}
@@ -2679,7 +2939,15 @@ func fetch(gitClient git.Client, targetRevisions []string) error {
 	return nil
 }
 
-func checkoutRevision(gitClient git.Client, revision string, submoduleEnabled bool) error {
+// fetchRevision fetches the given revision, or the default refspec if the revision is empty, in its own span
+func fetchRevision(ctx context.Context, gitClient git.Client, revision string) error {
+	_, endSpan := startSpan(ctx, spanGitFetch, attrRevision.String(revision))
+	err := gitClient.Fetch(revision)
+	endSpan(err)
+	return err
+}
+
+func checkoutRevision(ctx context.Context, gitClient git.Client, revision string, submoduleEnabled bool) error {
 	err := gitClient.Init()
 	if err != nil {
 		return status.Errorf(codes.Internal, "Failed to initialize git repo: %v", err)
@@ -2694,7 +2962,7 @@ func checkoutRevision(gitClient git.Client, revision string, submoduleEnabled bo
 	// Fetching can be skipped if the revision is already present locally.
 	if !revisionPresent {
 		// Fetching with no revision first. Fetching with an explicit version can cause repo bloat. https://github.com/argoproj/argo-cd/issues/8845
-		err = gitClient.Fetch("")
+		err = fetchRevision(ctx, gitClient, "")
 		if err != nil {
 			return status.Errorf(codes.Internal, "Failed to fetch default: %v", err)
 		}
@@ -2707,7 +2975,7 @@ func checkoutRevision(gitClient git.Client, revision string, submoduleEnabled bo
 		log.Infof("Failed to checkout revision %s: %v", revision, err)
 		log.Infof("Fallback to fetching specific revision %s. ref might not have been in the default refspec fetched.", revision)
 
-		err = gitClient.Fetch(revision)
+		err = fetchRevision(ctx, gitClient, revision)
 		if err != nil {
 			return status.Errorf(codes.Internal, "Failed to checkout revision %s: %v", revision, err)
 		}
@@ -2744,7 +3012,7 @@ func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositor
 	}
 	checks := map[string]func() error{
 		"git": func() error {
-			return git.TestRepo(repo.Repo, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy)
+			return git.TestRepo(repo.Repo, s.getGitCreds(repo), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy)
 		},
 		"oci": func() error {
 			client, err := oci.NewClient(repo.Repo, repo.GetOCICreds(), repo.Proxy, repo.NoProxy, s.initConstants.OCIMediaTypes)
@@ -2803,13 +3071,17 @@ func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevis
 			AmbiguousRevision: fmt.Sprintf("%v (%v)", ambiguousRevision, revision),
 		}, nil
 	}
-	gitClient, err := git.NewClient(repo.Repo, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy)
+	gitClient, err := git.NewClient(repo.Repo, s.getGitCreds(repo), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy)
 	if err != nil {
 		return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
 	}
 	revision, err := gitClient.LsRemote(ambiguousRevision)
 	if err != nil {
 		s.metricsServer.IncGitLsRemoteFail(gitClient.Root(), revision)
+		var notFoundErr *git.RevisionNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, errorcode.Error(codes.NotFound, errorcode.RevisionNotFound, err.Error())
+		}
 		return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
 	}
 	return &apiclient.ResolveRevisionResponse{
@@ -2818,7 +3090,7 @@ func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevis
 	}, nil
 }
 
-func (s *Service) GetGitFiles(_ context.Context, request *apiclient.GitFilesRequest) (*apiclient.GitFilesResponse, error) {
+func (s *Service) GetGitFiles(ctx context.Context, request *apiclient.GitFilesRequest) (*apiclient.GitFilesResponse, error) {
 	repo := request.GetRepo()
 	revision := request.GetRevision()
 	gitPath := request.GetPath()
@@ -2854,7 +3126,7 @@ func (s *Service) GetGitFiles(_ context.Context, request *apiclient.GitFilesRequ
 
 	// cache miss, generate the results
 	closer, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, revision, request.GetSubmoduleEnabled())
+		return s.checkoutRevision(ctx, gitClient, repo.Repo, revision, request.GetSubmoduleEnabled())
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to checkout git repo %s with revision %s pattern %s: %v", repo.Repo, revision, gitPath, err)
@@ -2906,7 +3178,7 @@ func verifyCommitSignature(verifyCommit bool, gitClient git.Client, revision str
 	return nil
 }
 
-func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDirectoriesRequest) (*apiclient.GitDirectoriesResponse, error) {
+func (s *Service) GetGitDirectories(ctx context.Context, request *apiclient.GitDirectoriesRequest) (*apiclient.GitDirectoriesResponse, error) {
 	repo := request.GetRepo()
 	revision := request.GetRevision()
 	noRevisionCache := request.GetNoRevisionCache()
@@ -2936,7 +3208,7 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 
 	// cache miss, generate the results
 	closer, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, revision, request.GetSubmoduleEnabled())
+		return s.checkoutRevision(ctx, gitClient, repo.Repo, revision, request.GetSubmoduleEnabled())
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to checkout git repo %s with revision %s: %v", repo.Repo, revision, err)
@@ -2988,7 +3260,7 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 // If no files were changed, it will store the already cached manifest to the key corresponding to the old revision, avoiding an unnecessary generation.
 // Example: cache has key "a1a1a1" with manifest "x", and the files for that manifest have not changed,
 // "x" will be stored again with the new revision "b2b2b2".
-func (s *Service) UpdateRevisionForPaths(_ context.Context, request *apiclient.UpdateRevisionForPathsRequest) (*apiclient.UpdateRevisionForPathsResponse, error) {
+func (s *Service) UpdateRevisionForPaths(ctx context.Context, request *apiclient.UpdateRevisionForPathsRequest) (*apiclient.UpdateRevisionForPathsResponse, error) {
 	logCtx := log.WithFields(log.Fields{"application": request.AppName, "appNamespace": request.Namespace})
 
 	repo := request.GetRepo()
@@ -3028,14 +3300,14 @@ func (s *Service) UpdateRevisionForPaths(_ context.Context, request *apiclient.U
 	defer s.metricsServer.DecPendingRepoRequest(repo.Repo)
 
 	closer, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, revision, false)
+		return s.checkoutRevision(ctx, gitClient, repo.Repo, revision, false)
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to checkout git repo %s with revision %s: %v", repo.Repo, revision, err)
 	}
 	defer utilio.Close(closer)
 
-	if err := s.fetch(gitClient, []string{syncedRevision}); err != nil {
+	if err := s.fetch(ctx, gitClient, []string{syncedRevision}); err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to fetch git repo %s with syncedRevisions %s: %v", repo.Repo, syncedRevision, err)
 	}
 
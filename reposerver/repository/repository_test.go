@@ -3,7 +3,12 @@ package repository
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	goio "io"
@@ -45,6 +50,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
 	fileutil "github.com/argoproj/argo-cd/v3/test/fixture/path"
 	"github.com/argoproj/argo-cd/v3/util/argo"
+	cryptoutil "github.com/argoproj/argo-cd/v3/util/crypto"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	gitmocks "github.com/argoproj/argo-cd/v3/util/git/mocks"
 	"github.com/argoproj/argo-cd/v3/util/helm"
@@ -494,6 +500,16 @@ func TestGenerateManifestsHelmWithRefs_CachedNoLsRemote(t *testing.T) {
 	})
 }
 
+// clearGenerationInfo asserts that the given response reports the version of the given tool and the build environment
+// of the tools, and clears them so that the rest of the response can be compared
+func clearGenerationInfo(t *testing.T, response *apiclient.ManifestResponse, tool string) {
+	t.Helper()
+	assert.Contains(t, response.ToolVersions, tool)
+	assert.Contains(t, response.BuildEnv, &v1alpha1.EnvEntry{Name: "ARGOCD_APP_PROJECT_NAME", Value: "something"})
+	response.ToolVersions = nil
+	response.BuildEnv = nil
+}
+
 // ensure we can use a semver constraint range (>= 1.0.0) and get back the correct chart (1.0.0)
 func TestHelmManifestFromChartRepo(t *testing.T) {
 	root := t.TempDir()
@@ -506,6 +522,7 @@ func TestHelmManifestFromChartRepo(t *testing.T) {
 	response, err := service.GenerateManifest(t.Context(), request)
 	require.NoError(t, err)
 	assert.NotNil(t, response)
+	clearGenerationInfo(t, response, "helm")
 	assert.Equal(t, &apiclient.ManifestResponse{
 		Manifests:  []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"},
 		Namespace:  "",
@@ -544,6 +561,7 @@ func TestHelmChartReferencingExternalValues(t *testing.T) {
 	response, err := service.GenerateManifest(t.Context(), request)
 	require.NoError(t, err)
 	assert.NotNil(t, response)
+	clearGenerationInfo(t, response, "helm")
 	assert.Equal(t, &apiclient.ManifestResponse{
 		Manifests:  []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"},
 		Namespace:  "",
@@ -1046,6 +1064,42 @@ func TestManifestGenErrorCacheByMinutesElapsed(t *testing.T) {
 	}
 }
 
+func TestManifestGenErrorCacheByTTL(t *testing.T) {
+	service := newService(t, ".")
+	currentTime := time.Now()
+	service.now = func() time.Time {
+		return currentTime
+	}
+	service.initConstants = RepoServerInitConstants{
+		ParallelismLimit:                1,
+		ManifestGenerationErrorCacheTTL: time.Minute,
+	}
+	manifestRequest := func() *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{
+			Repo:    &v1alpha1.Repository{},
+			AppName: "test",
+			ApplicationSource: &v1alpha1.ApplicationSource{
+				Path: "./testdata/invalid-helm",
+			},
+		}
+	}
+
+	// the first template error is cached, even though the pause of the generation is disabled
+	_, err := service.GenerateManifest(t.Context(), manifestRequest())
+	require.Error(t, err)
+	assert.False(t, strings.HasPrefix(err.Error(), cachedManifestGenerationPrefix))
+	currentTime = currentTime.Add(30 * time.Second)
+	_, err = service.GenerateManifest(t.Context(), manifestRequest())
+	require.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), cachedManifestGenerationPrefix))
+
+	// the manifests are generated again once the error expires
+	currentTime = currentTime.Add(time.Minute)
+	_, err = service.GenerateManifest(t.Context(), manifestRequest())
+	require.Error(t, err)
+	assert.False(t, strings.HasPrefix(err.Error(), cachedManifestGenerationPrefix))
+}
+
 func TestManifestGenErrorCacheRespectsNoCache(t *testing.T) {
 	service := newService(t, ".")
 
@@ -1138,6 +1192,94 @@ func TestGenerateHelmWithValues(t *testing.T) {
 	assert.True(t, replicasVerified)
 }
 
+func TestGenerateHelmWithSealedParameters(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keysPath := t.TempDir()
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	require.NoError(t, os.WriteFile(filepath.Join(keysPath, "private.pem"), keyPEM, 0o600))
+
+	sealed, err := cryptoutil.SealValue(&key.PublicKey, cryptoutil.SealLabel("something", "team", "test", "password"), "s3cr3t-password")
+	require.NoError(t, err)
+
+	newRequest := func(name string) *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{
+			Repo:         &v1alpha1.Repository{},
+			AppName:      "team_test",
+			AppNamespace: "team",
+			ApplicationSource: &v1alpha1.ApplicationSource{
+				Path: ".",
+				Helm: &v1alpha1.ApplicationSourceHelm{
+					Parameters: []v1alpha1.HelmParameter{{Name: name, Value: sealed, ForceString: true}},
+				},
+			},
+			ProjectName:        "something",
+			ProjectSourceRepos: []string{"*"},
+			NoCache:            true,
+		}
+	}
+
+	t.Run("unsealed", func(t *testing.T) {
+		service := newService(t, "../../util/helm/testdata/redis")
+		service.initConstants.ParameterDecryptionKeysPath = keysPath
+
+		res, err := service.GenerateManifest(t.Context(), newRequest("password"))
+		require.NoError(t, err)
+
+		passwordVerified := false
+		for _, src := range res.Manifests {
+			obj := unstructured.Unstructured{}
+			require.NoError(t, json.Unmarshal([]byte(src), &obj))
+			if obj.GetKind() == "Secret" {
+				password, _, _ := unstructured.NestedString(obj.Object, "data", "redis-password")
+				assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("s3cr3t-password")), password)
+				passwordVerified = true
+			}
+		}
+		assert.True(t, passwordVerified)
+		require.Len(t, res.Commands, 1)
+		assert.NotContains(t, res.Commands[0], "s3cr3t-password")
+		assert.Contains(t, res.Commands[0], "--set-string password=******")
+	})
+
+	t.Run("sealed for another parameter", func(t *testing.T) {
+		service := newService(t, "../../util/helm/testdata/redis")
+		service.initConstants.ParameterDecryptionKeysPath = keysPath
+
+		_, err := service.GenerateManifest(t.Context(), newRequest("cluster.password"))
+		assert.ErrorContains(t, err, "error unsealing helm parameter cluster.password")
+	})
+
+	t.Run("sealed for another application", func(t *testing.T) {
+		service := newService(t, "../../util/helm/testdata/redis")
+		service.initConstants.ParameterDecryptionKeysPath = keysPath
+
+		req := newRequest("password")
+		req.AppName = "team_other"
+		_, err := service.GenerateManifest(t.Context(), req)
+		assert.ErrorContains(t, err, "the value was sealed for another label")
+	})
+
+	t.Run("sealed for another project", func(t *testing.T) {
+		service := newService(t, "../../util/helm/testdata/redis")
+		service.initConstants.ParameterDecryptionKeysPath = keysPath
+
+		req := newRequest("password")
+		req.ProjectName = "other"
+		req.ProjectSourceRepos = []string{"*"}
+		_, err := service.GenerateManifest(t.Context(), req)
+		assert.ErrorContains(t, err, "the value was sealed for another label")
+	})
+
+	t.Run("no decryption key", func(t *testing.T) {
+		service := newService(t, "../../util/helm/testdata/redis")
+		service.initConstants.ParameterDecryptionKeysPath = t.TempDir()
+
+		_, err := service.GenerateManifest(t.Context(), newRequest("password"))
+		assert.ErrorContains(t, err, "no parameter decryption key is found")
+	})
+}
+
 func TestHelmWithMissingValueFiles(t *testing.T) {
 	service := newService(t, "../../util/helm/testdata/redis")
 	missingValuesFile := "values-prod-overrides.yaml"
@@ -1262,6 +1404,7 @@ func TestHelmManifestFromChartRepoWithValueFile(t *testing.T) {
 	response, err := service.GenerateManifest(t.Context(), request)
 	require.NoError(t, err)
 	assert.NotNil(t, response)
+	clearGenerationInfo(t, response, "helm")
 	assert.Equal(t, &apiclient.ManifestResponse{
 		Manifests:  []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"},
 		Namespace:  "",
@@ -1601,6 +1744,7 @@ func TestListApps(t *testing.T) {
 		"helm-with-local-dependency":        "Helm",
 		"simple-chart":                      "Helm",
 		"broken-schema-verification":        "Helm",
+		"values-schema":                     "Helm",
 	}
 	assert.Equal(t, expectedApps, res.Apps)
 }
@@ -1778,6 +1922,40 @@ func TestGetRevisionMetadata(t *testing.T) {
 	assert.NotEmpty(t, res.SignatureInfo)
 }
 
+func TestGetRevisionMetadataChangedFiles(t *testing.T) {
+	service, gitClient, _ := newServiceWithMocks(t, "../..", false)
+	gitClient.On("RevisionMetadata", mock.Anything).Return(&git.RevisionMetadata{Message: "test", Date: time.Now()}, nil)
+	gitClient.On("ChangedFiles", "da52afd3b2df1ec49470603d8bbb46954dab1091", "c0b400fc458875d925171398f9ba9eabd5529923").Return([]string{"app/deployment.yaml"}, nil)
+	gitClient.On("ChangedFiles", "unknown", mock.Anything).Return(nil, errors.New("unknown revision"))
+
+	res, err := service.GetRevisionMetadata(t.Context(), &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:         &v1alpha1.Repository{},
+		Revision:     "c0b400fc458875d925171398f9ba9eabd5529923",
+		BaseRevision: "da52afd3b2df1ec49470603d8bbb46954dab1091",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "test", res.Message)
+	assert.Equal(t, []string{"app/deployment.yaml"}, res.ChangedFiles)
+
+	// the changed files are not cached with the metadata
+	res, err = service.GetRevisionMetadata(t.Context(), &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:     &v1alpha1.Repository{},
+		Revision: "c0b400fc458875d925171398f9ba9eabd5529923",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.ChangedFiles)
+
+	// failing to get the changed files does not fail the request
+	res, err = service.GetRevisionMetadata(t.Context(), &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:         &v1alpha1.Repository{},
+		Revision:     "c0b400fc458875d925171398f9ba9eabd5529923",
+		BaseRevision: "unknown",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "test", res.Message)
+	assert.Empty(t, res.ChangedFiles)
+}
+
 func TestGetSignatureVerificationResult(t *testing.T) {
 	// Commit with signature and verification requested
 	{
@@ -2663,6 +2841,20 @@ func Test_getPotentiallyValidManifests(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run(".argocdignore files exclude paths", func(t *testing.T) {
+		manifests, err := getPotentiallyValidManifests(logCtx, "./testdata/argocdignore", "./testdata/argocdignore", true, "", "", resource.MustParse("0"))
+		require.NoError(t, err)
+		var paths []string
+		for _, manifest := range manifests {
+			paths = append(paths, manifest.path)
+		}
+		assert.ElementsMatch(t, []string{
+			"testdata/argocdignore/deployment.yaml",
+			"testdata/argocdignore/tests/config.yaml",
+			"testdata/argocdignore/tests/keep.test.yaml",
+		}, paths)
+	})
+
 	t.Run("non-JSON/YAML is skipped", func(t *testing.T) {
 		manifests, err := getPotentiallyValidManifests(logCtx, "./testdata/non-manifest-file", "./testdata/non-manifest-file", false, "", "", resource.MustParse("0"))
 		assert.Empty(t, manifests)
@@ -3093,10 +3285,10 @@ func TestCheckoutRevisionCanGetNonstandardRefs(t *testing.T) {
 	pullSha, err := gitClient.LsRemote("refs/pull/123/head")
 	require.NoError(t, err)
 
-	err = checkoutRevision(gitClient, "does-not-exist", false)
+	err = checkoutRevision(t.Context(), gitClient, "does-not-exist", false)
 	require.Error(t, err)
 
-	err = checkoutRevision(gitClient, pullSha, false)
+	err = checkoutRevision(t.Context(), gitClient, pullSha, false)
 	require.NoError(t, err)
 }
 
@@ -3108,7 +3300,7 @@ func TestCheckoutRevisionPresentSkipFetch(t *testing.T) {
 	gitClient.On("IsRevisionPresent", revision).Return(true)
 	gitClient.On("Checkout", revision, mock.Anything).Return("", nil)
 
-	err := checkoutRevision(gitClient, revision, false)
+	err := checkoutRevision(t.Context(), gitClient, revision, false)
 	require.NoError(t, err)
 }
 
@@ -3121,7 +3313,7 @@ func TestCheckoutRevisionNotPresentCallFetch(t *testing.T) {
 	gitClient.On("Fetch", "").Return(nil)
 	gitClient.On("Checkout", revision, mock.Anything).Return("", nil)
 
-	err := checkoutRevision(gitClient, revision, false)
+	err := checkoutRevision(t.Context(), gitClient, revision, false)
 	require.NoError(t, err)
 }
 
@@ -3137,7 +3329,7 @@ func TestFetch(t *testing.T) {
 	gitClient.On("IsRevisionPresent", revision1).Once().Return(true)
 	gitClient.On("IsRevisionPresent", revision2).Once().Return(true)
 
-	err := fetch(gitClient, []string{revision1, revision2})
+	err := fetch(t.Context(), gitClient, []string{revision1, revision2})
 	require.NoError(t, err)
 }
 
@@ -3173,10 +3365,10 @@ func TestFetchRevisionCanGetNonstandardRefs(t *testing.T) {
 	pullSha, err := gitClient.LsRemote("refs/pull/123/head")
 	require.NoError(t, err)
 
-	err = fetch(gitClient, []string{"does-not-exist"})
+	err = fetch(t.Context(), gitClient, []string{"does-not-exist"})
 	require.Error(t, err)
 
-	err = fetch(gitClient, []string{pullSha})
+	err = fetch(t.Context(), gitClient, []string{pullSha})
 	require.NoError(t, err)
 }
 
@@ -4492,6 +4684,30 @@ func Test_SkipSchemaValidation(t *testing.T) {
 	})
 }
 
+func TestGenerateManifest_ValuesSchemaViolation(t *testing.T) {
+	service := newService(t, "testdata/values-schema")
+
+	q := apiclient.ManifestRequest{
+		AppName: "test-app",
+		Repo:    &v1alpha1.Repository{},
+		ApplicationSource: &v1alpha1.ApplicationSource{
+			Path: ".",
+			Helm: &v1alpha1.ApplicationSourceHelm{
+				Parameters: []v1alpha1.HelmParameter{{Name: "replicaCount", Value: "two", ForceString: true}},
+			},
+		},
+	}
+
+	_, err := service.GenerateManifest(t.Context(), &q)
+
+	var schemaErr *ValuesSchemaError
+	require.ErrorAs(t, err, &schemaErr)
+	require.Len(t, schemaErr.Violations, 1)
+	assert.Equal(t, "values-schema", schemaErr.Violations[0].Chart)
+	assert.Equal(t, "replicaCount", schemaErr.Violations[0].Path)
+	assert.Contains(t, schemaErr.Violations[0].Constraint, "integer")
+}
+
 func TestGenerateManifest_OCISourceSkipsGitClient(t *testing.T) {
 	svc := newService(t, t.TempDir())
 
@@ -4522,3 +4738,29 @@ func TestGenerateManifest_OCISourceSkipsGitClient(t *testing.T) {
 	// verify that newGitClient was never invoked
 	assert.False(t, gitCalled, "GenerateManifest should not invoke Git for OCI sources")
 }
+
+func TestGetGitCreds_SSHAgent(t *testing.T) {
+	service := &Service{
+		gitCredsStore: git.NoopCredsStore{},
+		initConstants: RepoServerInitConstants{
+			SSHAgentSocket:       "/var/run/ssh-agent/agent.sock",
+			SSHAgentRepositories: []string{"git@github.com:my-org/*"},
+		},
+	}
+
+	creds := service.getGitCreds(&v1alpha1.Repository{Repo: "git@github.com:my-org/my-repo.git"})
+	agentCreds, ok := creds.(git.SSHCreds)
+	require.Truef(t, ok, "expected SSHCreds but got %T", creds)
+	_, env, err := agentCreds.Environ()
+	require.NoError(t, err)
+	assert.Contains(t, env, "SSH_AUTH_SOCK=/var/run/ssh-agent/agent.sock")
+
+	// the repositories not allowed to use the agent are not given its socket
+	assert.Equal(t, git.NopCreds{}, service.getGitCreds(&v1alpha1.Repository{Repo: "git@github.com:other-org/my-repo.git"}))
+	// the repositories with a private key use their key
+	creds = service.getGitCreds(&v1alpha1.Repository{Repo: "git@github.com:my-org/my-repo.git", SSHPrivateKey: "key"})
+	closer, env, err := creds.Environ()
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+	assert.NotContains(t, env, "SSH_AUTH_SOCK=/var/run/ssh-agent/agent.sock")
+}
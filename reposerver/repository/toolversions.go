@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/google/go-jsonnet"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	"github.com/argoproj/argo-cd/v3/util/kustomize"
+)
+
+// toolVersionsCache caches the versions of the binaries of the tools, by binary, as they do not change while the repo
+// server runs
+var toolVersionsCache sync.Map
+
+// toolVersion returns the version of the binary of a tool, getting it with the given function the first time. Like the
+// version API, the error getting the version is returned as the version, and the version is retried on the next call.
+func toolVersion(binary string, getVersion func() (string, error)) string {
+	if version, ok := toolVersionsCache.Load(binary); ok {
+		return version.(string)
+	}
+	version, err := getVersion()
+	if err != nil {
+		return err.Error()
+	}
+	toolVersionsCache.Store(binary, version)
+	return version
+}
+
+// getToolVersions returns the versions of the tools generating the manifests of the given source type, by tool name.
+// The plugins are identified by their name, which includes their version, and by the digests of the binaries of their
+// commands reported by their sidecars, by path prefixed with "plugin:", as the sidecars do not report the versions of
+// their tools.
+func getToolVersions(appSourceType v1alpha1.ApplicationSourceType, q *apiclient.ManifestRequest, pluginDigests map[string]string) map[string]string {
+	versions := map[string]string{"argocd": common.GetVersion().Version}
+	switch appSourceType {
+	case v1alpha1.ApplicationSourceTypeHelm:
+		versions["helm"] = toolVersion("helm", helm.Version)
+	case v1alpha1.ApplicationSourceTypeKustomize:
+		binaryPath := ""
+		var buildOptions string
+		if q.KustomizeOptions != nil {
+			binaryPath = q.KustomizeOptions.BinaryPath
+			buildOptions = q.KustomizeOptions.BuildOptions
+		}
+		versions["kustomize"] = toolVersion("kustomize:"+binaryPath, func() (string, error) {
+			return kustomize.VersionWithBinaryPath(binaryPath)
+		})
+		// kustomize inflates the helm charts with the helm binary
+		if strings.Contains(buildOptions, "--enable-helm") {
+			versions["helm"] = toolVersion("helm", helm.Version)
+		}
+	case v1alpha1.ApplicationSourceTypeDirectory:
+		versions["jsonnet"] = jsonnet.Version()
+	case v1alpha1.ApplicationSourceTypePlugin:
+		if q.ApplicationSource.Plugin != nil && q.ApplicationSource.Plugin.Name != "" {
+			versions["plugin"] = q.ApplicationSource.Plugin.Name
+		}
+		for path, digest := range pluginDigests {
+			versions["plugin:"+path] = digest
+		}
+	}
+	return versions
+}
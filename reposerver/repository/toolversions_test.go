@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-jsonnet"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func TestToolVersion(t *testing.T) {
+	calls := 0
+	getVersion := func() (string, error) {
+		calls++
+		if calls == 1 {
+			return "", errors.New("executable file not found")
+		}
+		return "v1.0.0", nil
+	}
+	assert.Equal(t, "executable file not found", toolVersion("test-tool", getVersion))
+	assert.Equal(t, "v1.0.0", toolVersion("test-tool", getVersion))
+	assert.Equal(t, "v1.0.0", toolVersion("test-tool", getVersion))
+	assert.Equal(t, 2, calls)
+}
+
+func TestGetToolVersions(t *testing.T) {
+	argocdVersion := common.GetVersion().Version
+
+	assert.Equal(t, map[string]string{"argocd": argocdVersion, "jsonnet": jsonnet.Version()},
+		getToolVersions(v1alpha1.ApplicationSourceTypeDirectory, &apiclient.ManifestRequest{ApplicationSource: &v1alpha1.ApplicationSource{}}, nil))
+
+	assert.Equal(t, map[string]string{"argocd": argocdVersion, "plugin": "my-plugin-v1.0", "plugin:/usr/local/bin/my-plugin": "sha256:abcd"},
+		getToolVersions(v1alpha1.ApplicationSourceTypePlugin, &apiclient.ManifestRequest{ApplicationSource: &v1alpha1.ApplicationSource{
+			Plugin: &v1alpha1.ApplicationSourcePlugin{Name: "my-plugin-v1.0"},
+		}}, map[string]string{"/usr/local/bin/my-plugin": "sha256:abcd"}))
+
+	assert.Equal(t, map[string]string{"argocd": argocdVersion},
+		getToolVersions(v1alpha1.ApplicationSourceTypePlugin, &apiclient.ManifestRequest{ApplicationSource: &v1alpha1.ApplicationSource{}}, nil))
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"net/url"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+)
+
+// Names of the spans recorded for the phases of manifest generation
+const (
+	spanGitCheckout         = "git.checkout"
+	spanGitFetch            = "git.fetch"
+	spanOCIExtract          = "oci.extract"
+	spanHelmChartExtract    = "helm.extractChart"
+	spanHelmDependencyBuild = "helm.dependencyBuild"
+	spanHelmTemplate        = "helm.template"
+	spanKustomizeBuild      = "kustomize.build"
+	spanPluginGenerate      = "cmp.generateManifest"
+	spanDirectoryManifests  = "directory.findManifests"
+)
+
+// Attributes set on the spans
+const (
+	attrRepoURL    = attribute.Key("argocd.repo.url")
+	attrRevision   = attribute.Key("argocd.revision")
+	attrAppName    = attribute.Key("argocd.app.name")
+	attrAppPath    = attribute.Key("argocd.app.path")
+	attrSourceType = attribute.Key("argocd.source.type")
+	attrChart      = attribute.Key("argocd.helm.chart")
+	attrPluginName = attribute.Key("argocd.plugin.name")
+)
+
+var tracer = otel.Tracer("github.com/argoproj/argo-cd/v3/reposerver/repository")
+
+// startSpan starts a child span of the span found in the context, which is the one propagated from the caller of
+// the repo server when tracing is enabled. The returned function ends the span and records the error, if any.
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, func(err error)) {
+	ctx, span := tracer.Start(ctx, name)
+	span.SetAttributes(attrs...)
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+// repoURLAttribute returns the repository URL as a span attribute, without any credentials embedded in it
+func repoURLAttribute(repoURL string) attribute.KeyValue {
+	if u, err := url.Parse(repoURL); err == nil && u.User != nil {
+		u.User = nil
+		repoURL = u.String()
+	}
+	return attrRepoURL.String(repoURL)
+}
@@ -52,10 +52,15 @@ func getApplicationRootPath(q *apiclient.ManifestRequest, appPath, repoPath stri
 	return string(filepath.Separator) + filepath.Join(commonParts...)
 }
 
-// getPaths retrieves all absolute paths associated with the generation of application manifests.
+// getPaths retrieves all absolute paths associated with the generation of application manifests, read from the
+// manifest-generate-paths annotation and from the watch paths of the source.
 func getPaths(q *apiclient.ManifestRequest, appPath, repoPath string) []string {
 	var paths []string
-	for _, annotationPath := range strings.Split(q.AnnotationManifestGeneratePaths, ";") {
+	annotationPaths := strings.Split(q.AnnotationManifestGeneratePaths, ";")
+	if q.ApplicationSource != nil {
+		annotationPaths = append(annotationPaths, q.ApplicationSource.WatchPaths...)
+	}
+	for _, annotationPath := range annotationPaths {
 		if annotationPath == "" {
 			continue
 		}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// helmValuesSchemaErrorMessage is the message of the error of helm template listing, per chart, the values which violate
+// the values.schema.json of the chart or of its dependencies
+const helmValuesSchemaErrorMessage = "values don't meet the specifications of the schema(s) in the following chart(s):"
+
+var (
+	// helmValuesSchemaPointerViolationRegexp matches the violations reported by Helm 3.18 and later, e.g.
+	// "- at '/replicaCount': got string, want integer"
+	helmValuesSchemaPointerViolationRegexp = regexp.MustCompile(`^\s*- at '([^']*)': (.+)$`)
+	// helmValuesSchemaFieldViolationRegexp matches the violations reported by the former versions of Helm, e.g.
+	// "- replicaCount: Invalid type. Expected: integer, given: string"
+	helmValuesSchemaFieldViolationRegexp = regexp.MustCompile(`^\s*- ([^:\s]+): (.+)$`)
+)
+
+// ValuesSchemaViolation is a value of a Helm chart violating a constraint of the values.schema.json of the chart
+type ValuesSchemaViolation struct {
+	// Chart is the name of the chart, or of the dependency, whose schema is violated
+	Chart string
+	// Path is the path of the value in the format of the --set flag of Helm, empty for the whole values
+	Path string
+	// Constraint describes the violated constraint
+	Constraint string
+}
+
+func (v ValuesSchemaViolation) String() string {
+	path := v.Path
+	if path == "" {
+		path = "(root)"
+	}
+	return fmt.Sprintf("%s: %s: %s", v.Chart, path, v.Constraint)
+}
+
+// ValuesSchemaError is returned by the manifest generation of a Helm chart whose values violate the values.schema.json
+// of the chart or of its dependencies
+type ValuesSchemaError struct {
+	Violations []ValuesSchemaViolation
+}
+
+func (e *ValuesSchemaError) Error() string {
+	violations := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		violations[i] = v.String()
+	}
+	return "helm values do not match the values.schema.json of the chart: " + strings.Join(violations, "; ")
+}
+
+// GRPCStatus returns the status of the error, so that the invalid values are not reported as an internal error
+func (e *ValuesSchemaError) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
+// parseValuesSchemaError returns the violations of the values.schema.json reported by the given error of helm template,
+// or nil if the error is not about invalid values, e.g. if the schema itself cannot be loaded
+func parseValuesSchemaError(err error) *ValuesSchemaError {
+	var schemaErr *ValuesSchemaError
+	if errors.As(err, &schemaErr) {
+		return schemaErr
+	}
+	message := err.Error()
+	i := strings.Index(message, helmValuesSchemaErrorMessage)
+	if i < 0 {
+		return nil
+	}
+	var violations []ValuesSchemaViolation
+	chart := ""
+	for _, line := range strings.Split(message[i+len(helmValuesSchemaErrorMessage):], "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+		case !strings.HasPrefix(trimmed, "-") && strings.HasSuffix(trimmed, ":"):
+			chart = strings.TrimSuffix(trimmed, ":")
+		case helmValuesSchemaPointerViolationRegexp.MatchString(line):
+			match := helmValuesSchemaPointerViolationRegexp.FindStringSubmatch(line)
+			if match[2] == "validation failed" {
+				// the violations of the nested values follow
+				continue
+			}
+			violations = append(violations, ValuesSchemaViolation{Chart: chart, Path: valuesPathFromPointer(match[1]), Constraint: match[2]})
+		case helmValuesSchemaFieldViolationRegexp.MatchString(line):
+			match := helmValuesSchemaFieldViolationRegexp.FindStringSubmatch(line)
+			path := match[1]
+			if path == "(root)" {
+				path = ""
+			}
+			violations = append(violations, ValuesSchemaViolation{Chart: chart, Path: path, Constraint: match[2]})
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return &ValuesSchemaError{Violations: violations}
+}
+
+// valuesPathFromPointer converts the JSON pointer of a value into its path in the format of the --set flag of Helm
+func valuesPathFromPointer(pointer string) string {
+	var path strings.Builder
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		if token == "" {
+			continue
+		}
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		if _, err := strconv.Atoi(token); err == nil && path.Len() > 0 {
+			path.WriteString("[" + token + "]")
+			continue
+		}
+		if path.Len() > 0 {
+			path.WriteString(".")
+		}
+		path.WriteString(strings.ReplaceAll(token, ".", `\.`))
+	}
+	return path.String()
+}
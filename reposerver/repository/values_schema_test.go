@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseValuesSchemaError(t *testing.T) {
+	t.Run("JSONPointers", func(t *testing.T) {
+		err := errors.New(`failed to execute helm template command: exit status 1: Error: values don't meet the specifications of the schema(s) in the following chart(s):
+values-schema:
+- at '': missing property 'name'
+- at '/replicaCount': got string, want integer
+- at '/ingress/hosts/0': validation failed
+- at '/ingress/hosts/0/host.name': got number, want string
+`)
+		schemaErr := parseValuesSchemaError(err)
+		require.NotNil(t, schemaErr)
+		assert.Equal(t, []ValuesSchemaViolation{
+			{Chart: "values-schema", Path: "", Constraint: "missing property 'name'"},
+			{Chart: "values-schema", Path: "replicaCount", Constraint: "got string, want integer"},
+			{Chart: "values-schema", Path: `ingress.hosts[0].host\.name`, Constraint: "got number, want string"},
+		}, schemaErr.Violations)
+	})
+	t.Run("Fields", func(t *testing.T) {
+		err := errors.New(`failed to execute helm template command: exit status 1: Error: values don't meet the specifications of the schema(s) in the following chart(s):
+values-schema:
+- (root): name is required
+- replicaCount: Invalid type. Expected: integer, given: string
+dependency:
+- image.tag: Invalid type. Expected: string, given: integer
+`)
+		schemaErr := parseValuesSchemaError(err)
+		require.NotNil(t, schemaErr)
+		assert.Equal(t, []ValuesSchemaViolation{
+			{Chart: "values-schema", Path: "", Constraint: "name is required"},
+			{Chart: "values-schema", Path: "replicaCount", Constraint: "Invalid type. Expected: integer, given: string"},
+			{Chart: "dependency", Path: "image.tag", Constraint: "Invalid type. Expected: string, given: integer"},
+		}, schemaErr.Violations)
+		assert.Equal(t, "helm values do not match the values.schema.json of the chart: values-schema: (root): name is required; "+
+			"values-schema: replicaCount: Invalid type. Expected: integer, given: string; "+
+			"dependency: image.tag: Invalid type. Expected: string, given: integer", schemaErr.Error())
+		assert.Equal(t, codes.InvalidArgument, status.Code(fmt.Errorf("wrapped: %w", schemaErr)))
+	})
+	t.Run("NotAViolation", func(t *testing.T) {
+		assert.Nil(t, parseValuesSchemaError(errors.New("failed to execute helm template command: exit status 1: Error: unable to parse values.schema.json")))
+	})
+}
@@ -1,6 +1,7 @@
 package reposerver
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"os"
@@ -22,6 +23,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	versionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/audit"
 	reposervercache "github.com/argoproj/argo-cd/v3/reposerver/cache"
 	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
 	"github.com/argoproj/argo-cd/v3/reposerver/repository"
@@ -41,8 +43,9 @@ type ArgoCDRepoServer struct {
 // The hostnames to generate self-signed issues with
 var tlsHostList = []string{"localhost", "reposerver"}
 
-// NewServer returns a new instance of the Argo CD Repo server
-func NewServer(metricsServer *metrics.MetricsServer, cache *reposervercache.Cache, tlsConfCustomizer tlsutil.ConfigCustomizer, initConstants repository.RepoServerInitConstants, gitCredsStore git.CredsStore) (*ArgoCDRepoServer, error) {
+// NewServer returns a new instance of the Argo CD Repo server. The TLS certificate is reloaded when it is rotated, until
+// the context is done. The repository accesses are recorded in the audit store, unless it is nil.
+func NewServer(ctx context.Context, metricsServer *metrics.MetricsServer, cache *reposervercache.Cache, tlsConfCustomizer tlsutil.ConfigCustomizer, initConstants repository.RepoServerInitConstants, gitCredsStore git.CredsStore, auditStore audit.Store) (*ArgoCDRepoServer, error) {
 	var tlsConfig *tls.Config
 
 	// Generate or load TLS server certificates to use with this instance of
@@ -51,7 +54,7 @@ func NewServer(metricsServer *metrics.MetricsServer, cache *reposervercache.Cach
 		var err error
 		certPath := env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath) + "/reposerver/tls/tls.crt"
 		keyPath := env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath) + "/reposerver/tls/tls.key"
-		tlsConfig, err = tlsutil.CreateServerTLSConfig(certPath, keyPath, tlsHostList)
+		tlsConfig, err = tlsutil.CreateReloadingServerTLSConfig(ctx, certPath, keyPath, tlsHostList)
 		if err != nil {
 			return nil, fmt.Errorf("error creating server TLS config: %w", err)
 		}
@@ -80,6 +83,10 @@ func NewServer(metricsServer *metrics.MetricsServer, cache *reposervercache.Cach
 		recovery.UnaryServerInterceptor(recovery.WithRecoveryHandler(grpc_util.LoggerRecoveryHandler(serverLog))),
 		grpc_util.ErrorSanitizerUnaryServerInterceptor(),
 	}
+	if auditStore != nil {
+		streamInterceptors = append(streamInterceptors, audit.StreamServerInterceptor(auditStore))
+		unaryInterceptors = append(unaryInterceptors, audit.UnaryServerInterceptor(auditStore))
+	}
 
 	serverOpts := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(unaryInterceptors...),
@@ -109,8 +116,8 @@ func NewServer(metricsServer *metrics.MetricsServer, cache *reposervercache.Cach
 	}, nil
 }
 
-// CreateGRPC creates new configured grpc server
-func (a *ArgoCDRepoServer) CreateGRPC() *grpc.Server {
+// CreateGRPC creates new configured grpc server. The reflection service is only registered if enabled.
+func (a *ArgoCDRepoServer) CreateGRPC(reflectionEnabled bool) *grpc.Server {
 	server := grpc.NewServer(a.opts...)
 	versionpkg.RegisterVersionServiceServer(server, version.NewServer(nil, func() (bool, error) {
 		return true, nil
@@ -120,8 +127,10 @@ func (a *ArgoCDRepoServer) CreateGRPC() *grpc.Server {
 	healthService := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(server, healthService)
 
-	// Register reflection service on gRPC server.
-	reflection.Register(server)
+	if reflectionEnabled {
+		// Register reflection service on gRPC server.
+		reflection.Register(server)
+	}
 
 	return server
 }
@@ -1,3 +0,0 @@
-local actions = {}
-actions["restart"] = {}
-return actions
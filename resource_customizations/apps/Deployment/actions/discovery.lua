@@ -1,6 +1,4 @@
 local actions = {}
-actions["restart"] = {}
-
 local paused = false
 if obj.spec.paused ~= nil then
     paused = obj.spec.paused
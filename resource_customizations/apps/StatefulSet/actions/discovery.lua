@@ -1,6 +1,4 @@
 local actions = {}
-actions["restart"] = {}
-
 actions["scale"] = {
   ["params"] = {
         {
@@ -78,6 +78,82 @@ function isWorkloadGenerationObserved(obj)
   return workloadGen == observedWorkloadGen
 end
 
+-- getAnalysisRuns returns the analysis runs of the current rollout step or promotion, as recorded in status
+function getAnalysisRuns(obj)
+  local runs = {}
+  if obj.status.canary ~= nil then
+    if obj.status.canary.currentStepAnalysisRunStatus ~= nil then
+      table.insert(runs, {kind = "step analysis", run = obj.status.canary.currentStepAnalysisRunStatus})
+    end
+    if obj.status.canary.currentBackgroundAnalysisRunStatus ~= nil then
+      table.insert(runs, {kind = "background analysis", run = obj.status.canary.currentBackgroundAnalysisRunStatus})
+    end
+  end
+  if obj.status.blueGreen ~= nil then
+    if obj.status.blueGreen.prePromotionAnalysisRunStatus ~= nil then
+      table.insert(runs, {kind = "pre-promotion analysis", run = obj.status.blueGreen.prePromotionAnalysisRunStatus})
+    end
+    if obj.status.blueGreen.postPromotionAnalysisRunStatus ~= nil then
+      table.insert(runs, {kind = "post-promotion analysis", run = obj.status.blueGreen.postPromotionAnalysisRunStatus})
+    end
+  end
+  return runs
+end
+
+-- getAnalysisMessage describes the progress and the results of the analysis runs, e.g.
+-- "step analysis guestbook-6b8cf6f7db-2-1 is Running"
+function getAnalysisMessage(runs)
+  local messages = {}
+  for _, analysis in ipairs(runs) do
+    local message = analysis.kind .. " " .. (analysis.run.name or "") .. " is " .. (analysis.run.status or "Pending")
+    if analysis.run.message ~= nil and analysis.run.message ~= "" then
+      message = message .. ": " .. analysis.run.message
+    end
+    table.insert(messages, message)
+  end
+  return table.concat(messages, ", ")
+end
+
+-- withAnalysisMessage appends the description of the analysis runs to the message of the health status
+function withAnalysisMessage(hs, runs)
+  local analysisMessage = getAnalysisMessage(runs)
+  if analysisMessage == "" then
+    return hs
+  end
+  if hs.message ~= nil and hs.message ~= "" then
+    hs.message = hs.message .. " (" .. analysisMessage .. ")"
+  else
+    hs.message = analysisMessage
+  end
+  return hs
+end
+
+-- checkAnalysis keeps the rollout Progressing while its analysis runs are pending or running, and Degraded if they
+-- failed, when the rollout is annotated with argocd.argoproj.io/wait-for-analysis: "true". Since the PostSync hooks
+-- only run once the synced resources are Healthy, this gates the PostSync hooks on the success of the analysis.
+function checkAnalysis(obj, runs)
+  if obj.metadata.annotations == nil or obj.metadata.annotations["argocd.argoproj.io/wait-for-analysis"] ~= "true" then
+    return nil
+  end
+  local hs = {}
+  for _, analysis in ipairs(runs) do
+    local status = analysis.run.status
+    if status == "Failed" or status == "Error" or status == "Inconclusive" then
+      hs.status = "Degraded"
+      return withAnalysisMessage(hs, runs)
+    end
+  end
+  for _, analysis in ipairs(runs) do
+    local status = analysis.run.status
+    if status == nil or status == "" or status == "Pending" or status == "Running" then
+      hs.status = "Progressing"
+      hs.message = "Waiting for analysis to complete"
+      return withAnalysisMessage(hs, runs)
+    end
+  end
+  return nil
+end
+
 local hs = {}
 if not isGenerationObserved(obj) or not isWorkloadGenerationObserved(obj) then
   hs.status = "Progressing"
@@ -94,7 +170,15 @@ if obj.status.phase ~= nil then
     hs.status = obj.status.phase
   end
   hs.message = obj.status.message
-  return hs
+  local runs = getAnalysisRuns(obj)
+  if hs.status == "Healthy" then
+    local analysisHS = checkAnalysis(obj, runs)
+    if analysisHS ~= nil then
+      return analysisHS
+    end
+    return hs
+  end
+  return withAnalysisMessage(hs, runs)
 end
 
 for _, condition in ipairs(obj.status.conditions) do
@@ -145,7 +229,7 @@ if obj.spec.strategy.blueGreen ~= nil then
   if stableRS ~= "" and stableRS ~= obj.status.currentPodHash then
     hs.status = "Progressing"
     hs.message = "waiting for analysis to complete"
-    return hs
+    return withAnalysisMessage(hs, getAnalysisRuns(obj))
   end
 elseif obj.spec.strategy.canary ~= nil then
   if statusReplicas > updatedReplicas then
@@ -156,10 +240,15 @@ elseif obj.spec.strategy.canary ~= nil then
   if stableRS == "" or stableRS ~= obj.status.currentPodHash then
     hs.status = "Progressing"
     hs.message = "Waiting for rollout to finish steps"
-    return hs
+    return withAnalysisMessage(hs, getAnalysisRuns(obj))
   end
 end
 
+local analysisHS = checkAnalysis(obj, getAnalysisRuns(obj))
+if analysisHS ~= nil then
+  return analysisHS
+end
+
 hs.status = "Healthy"
 hs.message = ""
 return hs
\ No newline at end of file
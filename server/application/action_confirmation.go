@@ -0,0 +1,76 @@
+package application
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+)
+
+// actionConfirmationTTL is how long a confirmation token of a resource action is valid
+const actionConfirmationTTL = 5 * time.Minute
+
+// actionConfirmationDomain separates the signatures of the confirmation tokens from the other uses of the server
+// signature
+const actionConfirmationDomain = "resource-action-confirmation"
+
+var errInvalidActionConfirmationToken = errors.New("invalid or expired confirmation token")
+
+// actionConfirmationMAC signs the expiry of the token together with the user, the application, the resource, the
+// action and its parameters, so that a token only confirms the action it was issued for
+func actionConfirmationMAC(key []byte, user string, expiry int64, q *application.ResourceActionRunRequest) []byte {
+	fields := []string{
+		actionConfirmationDomain,
+		strconv.FormatInt(expiry, 10),
+		user,
+		q.GetProject(),
+		q.GetAppNamespace(),
+		q.GetName(),
+		q.GetGroup(),
+		q.GetVersion(),
+		q.GetKind(),
+		q.GetNamespace(),
+		q.GetResourceName(),
+		q.GetAction(),
+	}
+	for _, p := range q.GetResourceActionParameters() {
+		fields = append(fields, p.GetName(), p.GetValue())
+	}
+	mac := hmac.New(sha256.New, key)
+	for _, f := range fields {
+		// fields are length-prefixed so that they cannot be shifted into each other
+		_, _ = fmt.Fprintf(mac, "%d:%s", len(f), f)
+	}
+	return mac.Sum(nil)
+}
+
+// newActionConfirmationToken returns a token confirming the action of the request for the user, valid for
+// actionConfirmationTTL
+func newActionConfirmationToken(key []byte, user string, now time.Time, q *application.ResourceActionRunRequest) string {
+	expiry := now.Add(actionConfirmationTTL).Unix()
+	return strconv.FormatInt(expiry, 10) + "." + base64.RawURLEncoding.EncodeToString(actionConfirmationMAC(key, user, expiry, q))
+}
+
+// verifyActionConfirmationToken checks that the token of the request was issued for the user and the action of the
+// request, and that it has not expired
+func verifyActionConfirmationToken(key []byte, user string, now time.Time, q *application.ResourceActionRunRequest) error {
+	expiryStr, signature, ok := strings.Cut(q.GetConfirmationToken(), ".")
+	if !ok {
+		return errInvalidActionConfirmationToken
+	}
+	expiry, err := strconv.ParseInt(expiryStr, 10, 64)
+	if err != nil || now.Unix() > expiry {
+		return errInvalidActionConfirmationToken
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, actionConfirmationMAC(key, user, expiry, q)) {
+		return errInvalidActionConfirmationToken
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package application
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+)
+
+func TestActionConfirmationToken(t *testing.T) {
+	key := []byte("signature")
+	now := time.Now()
+	newRequest := func() *application.ResourceActionRunRequest {
+		return &application.ResourceActionRunRequest{
+			Name:         ptr.To("guestbook"),
+			AppNamespace: ptr.To("argocd"),
+			Namespace:    ptr.To("default"),
+			ResourceName: ptr.To("guestbook-ui"),
+			Group:        ptr.To("apps"),
+			Version:      ptr.To("v1"),
+			Kind:         ptr.To("Deployment"),
+			Action:       ptr.To("scale"),
+			ResourceActionParameters: []*application.ResourceActionParameters{
+				{Name: ptr.To("replicas"), Value: ptr.To("0")},
+			},
+		}
+	}
+	confirmed := func(q *application.ResourceActionRunRequest) *application.ResourceActionRunRequest {
+		q.ConfirmationToken = ptr.To(newActionConfirmationToken(key, "alice", now, newRequest()))
+		return q
+	}
+
+	require.NoError(t, verifyActionConfirmationToken(key, "alice", now.Add(time.Minute), confirmed(newRequest())))
+
+	t.Run("Expired", func(t *testing.T) {
+		err := verifyActionConfirmationToken(key, "alice", now.Add(actionConfirmationTTL+time.Second), confirmed(newRequest()))
+		assert.ErrorIs(t, err, errInvalidActionConfirmationToken)
+	})
+	t.Run("OtherUser", func(t *testing.T) {
+		assert.ErrorIs(t, verifyActionConfirmationToken(key, "bob", now, confirmed(newRequest())), errInvalidActionConfirmationToken)
+	})
+	t.Run("OtherKey", func(t *testing.T) {
+		assert.ErrorIs(t, verifyActionConfirmationToken([]byte("other"), "alice", now, confirmed(newRequest())), errInvalidActionConfirmationToken)
+	})
+	t.Run("OtherResource", func(t *testing.T) {
+		q := confirmed(newRequest())
+		q.ResourceName = ptr.To("other")
+		assert.ErrorIs(t, verifyActionConfirmationToken(key, "alice", now, q), errInvalidActionConfirmationToken)
+	})
+	t.Run("OtherParameters", func(t *testing.T) {
+		q := confirmed(newRequest())
+		q.ResourceActionParameters[0].Value = ptr.To("10")
+		assert.ErrorIs(t, verifyActionConfirmationToken(key, "alice", now, q), errInvalidActionConfirmationToken)
+	})
+	t.Run("ExtendedExpiry", func(t *testing.T) {
+		q := confirmed(newRequest())
+		_, signature, _ := strings.Cut(q.GetConfirmationToken(), ".")
+		q.ConfirmationToken = ptr.To(strconv.FormatInt(now.Add(time.Hour).Unix(), 10) + "." + signature)
+		assert.ErrorIs(t, verifyActionConfirmationToken(key, "alice", now, q), errInvalidActionConfirmationToken)
+	})
+	t.Run("Malformed", func(t *testing.T) {
+		for _, token := range []string{"", "invalid", "abc.def", "1.!"} {
+			q := newRequest()
+			q.ConfirmationToken = ptr.To(token)
+			assert.ErrorIs(t, verifyActionConfirmationToken(key, "alice", now, q), errInvalidActionConfirmationToken)
+		}
+	})
+}
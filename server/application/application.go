@@ -2,6 +2,8 @@ package application
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -43,6 +45,7 @@ import (
 
 	argocommon "github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/errorcode"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
@@ -51,7 +54,10 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/deeplinks"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
 	"github.com/argoproj/argo-cd/v3/util/argo"
+	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/collections"
+	"github.com/argoproj/argo-cd/v3/util/cost"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/git"
@@ -188,7 +194,7 @@ func (s *Server) getAppEnforceRBAC(ctx context.Context, action, project, namespa
 		if apierrors.IsNotFound(err) {
 			if project != "" {
 				// We know that the user was allowed to get the Application, but the Application does not exist. Return 404.
-				return nil, nil, status.Error(codes.NotFound, apierrors.NewNotFound(schema.GroupResource{Group: "argoproj.io", Resource: "applications"}, name).Error())
+				return nil, nil, errorcode.Error(codes.NotFound, errorcode.ApplicationNotFound, apierrors.NewNotFound(schema.GroupResource{Group: "argoproj.io", Resource: "applications"}, name).Error())
 			}
 			// We don't know if the user was allowed to get the Application, and we don't want to leak information about
 			// the Application's existence. Return 403.
@@ -210,7 +216,7 @@ func (s *Server) getAppEnforceRBAC(ctx context.Context, action, project, namespa
 			// The user specified a project. We would have returned a 404 if the user had access to the app, but the app
 			// did not exist. So we have to return a 404 when the app does exist, but the user does not have access.
 			// Otherwise, they could infer that the app exists based on the error code.
-			return nil, nil, status.Error(codes.NotFound, apierrors.NewNotFound(schema.GroupResource{Group: "argoproj.io", Resource: "applications"}, name).Error())
+			return nil, nil, errorcode.Error(codes.NotFound, errorcode.ApplicationNotFound, apierrors.NewNotFound(schema.GroupResource{Group: "argoproj.io", Resource: "applications"}, name).Error())
 		}
 		// The user didn't specify a project. We always return permission denied for both lack of access and lack of
 		// existence.
@@ -227,7 +233,7 @@ func (s *Server) getAppEnforceRBAC(ctx context.Context, action, project, namespa
 		}).Warnf("user tried to %s application in project %s, but the application is in project %s", action, project, effectiveProject)
 		// The user has access to the app, but the app is in a different project. Return 404, meaning "app doesn't
 		// exist in that project".
-		return nil, nil, status.Error(codes.NotFound, apierrors.NewNotFound(schema.GroupResource{Group: "argoproj.io", Resource: "applications"}, name).Error())
+		return nil, nil, errorcode.Error(codes.NotFound, errorcode.ApplicationNotFound, apierrors.NewNotFound(schema.GroupResource{Group: "argoproj.io", Resource: "applications"}, name).Error())
 	}
 	// Get the app's associated project, and make sure all project restrictions are enforced.
 	proj, err := s.getAppProject(ctx, a, logCtx)
@@ -306,9 +312,13 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 		}
 	}
 
-	// Sort found applications by name
+	// Sort found applications by name, and by namespace for the applications with the same name, so that the pages
+	// of the list are consistent
 	sort.Slice(newItems, func(i, j int) bool {
-		return newItems[i].Name < newItems[j].Name
+		if newItems[i].Name != newItems[j].Name {
+			return newItems[i].Name < newItems[j].Name
+		}
+		return newItems[i].Namespace < newItems[j].Namespace
 	})
 
 	appList := v1alpha1.ApplicationList{
@@ -317,9 +327,32 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 		},
 		Items: newItems,
 	}
+	if q.Offset != nil || q.Limit != nil {
+		if err := paginateApplications(&appList, q.GetOffset(), q.GetLimit()); err != nil {
+			return nil, err
+		}
+	}
 	return &appList, nil
 }
 
+// paginateApplications restricts the items of the given list to the page starting at the given offset and holding
+// at most limit applications, all the following applications if the limit is zero. The number of applications
+// following the page is set as the remaining item count of the list.
+func paginateApplications(appList *v1alpha1.ApplicationList, offset int64, limit int64) error {
+	if offset < 0 || limit < 0 {
+		return status.Errorf(codes.InvalidArgument, "offset and limit must not be negative, got offset %d and limit %d", offset, limit)
+	}
+	total := int64(len(appList.Items))
+	start := min(offset, total)
+	end := total
+	if limit > 0 && limit < total-start {
+		end = start + limit
+	}
+	appList.Items = appList.Items[start:end]
+	appList.RemainingItemCount = ptr.To(total - end)
+	return nil
+}
+
 // Create creates an application
 func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateRequest) (*v1alpha1.Application, error) {
 	if q.GetApplication() == nil {
@@ -330,6 +363,7 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionCreate, a.RBACName(s.ns)); err != nil {
 		return nil, err
 	}
+	argo.SetFieldManager(a, q.GetFieldManager())
 
 	s.projectLock.RLock(a.Spec.GetProject())
 	defer s.projectLock.RUnlock(a.Spec.GetProject())
@@ -344,6 +378,10 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 		return nil, err
 	}
 
+	if err := s.recordFreeze(ctx, &v1alpha1.Application{}, a, false); err != nil {
+		return nil, err
+	}
+
 	err = s.validateAndNormalizeApp(ctx, a, proj, validate)
 	if err != nil {
 		return nil, fmt.Errorf("error while validating and normalizing app: %w", err)
@@ -379,6 +417,9 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to check existing application details (%s): %v", appNs, err)
 	}
+	if err := argo.ValidateFieldManager(existing, q.GetFieldManager()); err != nil {
+		return nil, err
+	}
 
 	if _, err := argo.GetDestinationCluster(ctx, existing.Spec.Destination, s.db); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", existing.Name, err.Error())
@@ -481,8 +522,32 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil, security.NamespaceNotPermittedError(a.Namespace)
 	}
 
-	manifestInfos := make([]*apiclient.ManifestResponse, 0)
-	err = s.queryRepoServer(ctx, proj, func(
+	_, manifestInfos, err := s.generateManifests(ctx, a, proj, q)
+	if err != nil {
+		return nil, err
+	}
+
+	redactor, err := s.newRedactor()
+	if err != nil {
+		return nil, err
+	}
+	manifests := &apiclient.ManifestResponse{}
+	for _, manifestInfo := range manifestInfos {
+		if err := s.redactManifests(redactor, manifestInfo.Manifests); err != nil {
+			return nil, err
+		}
+		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
+	}
+
+	return manifests, nil
+}
+
+// generateManifests generates the manifests of the sources of the given application, at the revisions of the query if
+// any, and returns the requests sent to the repo server along with its responses
+func (s *Server) generateManifests(ctx context.Context, a *v1alpha1.Application, proj *v1alpha1.AppProject, q *application.ApplicationManifestQuery) ([]*apiclient.ManifestRequest, []*apiclient.ManifestResponse, error) {
+	var manifestRequests []*apiclient.ManifestRequest
+	var manifestInfos []*apiclient.ManifestResponse
+	err := s.queryRepoServer(ctx, proj, func(
 		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, ociRepos []*v1alpha1.Repository, ociCreds []*v1alpha1.RepoCreds, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
 	) error {
 		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
@@ -506,7 +571,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		}
 
 		sources := make([]v1alpha1.ApplicationSource, 0)
-		appSpec := a.Spec
+		appSpec := *a.Spec.DeepCopy()
 		if a.Spec.HasMultipleSources() {
 			numOfSources := int64(len(a.Spec.GetSources()))
 			for i, pos := range q.SourcePositions {
@@ -566,11 +631,12 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				helmRepoCreds = append(helmRepoCreds, ociCreds...)
 			}
 
-			manifestInfo, err := client.GenerateManifest(ctx, &apiclient.ManifestRequest{
+			manifestRequest := &apiclient.ManifestRequest{
 				Repo:                            repo,
 				Revision:                        source.TargetRevision,
 				AppLabelKey:                     appInstanceLabelKey,
 				AppName:                         a.InstanceName(s.ns),
+				AppNamespace:                    a.Namespace,
 				Namespace:                       a.Spec.Destination.Namespace,
 				ApplicationSource:               &source,
 				Repos:                           repos,
@@ -587,42 +653,128 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				RefSources:                      refSources,
 				AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 				InstallationID:                  installationID,
-			})
+			}
+			manifestInfo, err := client.GenerateManifest(ctx, manifestRequest)
 			if err != nil {
 				return fmt.Errorf("error generating manifests: %w", err)
 			}
+			manifestRequests = append(manifestRequests, manifestRequest)
 			manifestInfos = append(manifestInfos, manifestInfo)
 		}
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	return manifestRequests, manifestInfos, nil
+}
 
-	manifests := &apiclient.ManifestResponse{}
-	for _, manifestInfo := range manifestInfos {
-		for i, manifest := range manifestInfo.Manifests {
-			obj := &unstructured.Unstructured{}
-			err = json.Unmarshal([]byte(manifest), obj)
+// redactManifests hides the data of the secrets and redacts the resources configured to be redacted in the given
+// manifests, in place
+func (s *Server) redactManifests(redactor *argodiff.Redactor, manifests []string) error {
+	for i, manifest := range manifests {
+		obj := &unstructured.Unstructured{}
+		err := json.Unmarshal([]byte(manifest), obj)
+		if err != nil {
+			return fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+		}
+		isSecret := obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == ""
+		if !isSecret && !redactor.Matches(obj.GroupVersionKind().GroupKind()) {
+			continue
+		}
+		if isSecret {
+			obj, _, err = diff.HideSecretData(obj, nil, s.settingsMgr.GetSensitiveAnnotations())
 			if err != nil {
-				return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
-			}
-			if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
-				obj, _, err = diff.HideSecretData(obj, nil, s.settingsMgr.GetSensitiveAnnotations())
-				if err != nil {
-					return nil, fmt.Errorf("error hiding secret data: %w", err)
-				}
-				data, err := json.Marshal(obj)
-				if err != nil {
-					return nil, fmt.Errorf("error marshaling manifest: %w", err)
-				}
-				manifestInfo.Manifests[i] = string(data)
+				return fmt.Errorf("error hiding secret data: %w", err)
 			}
 		}
-		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
+		obj, _ = redactor.Redact(obj, nil)
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("error marshaling manifest: %w", err)
+		}
+		manifests[i] = string(data)
 	}
+	return nil
+}
 
-	return manifests, nil
+// GetManifestBundle returns the manifests of an application along with the inputs of their generation, at the
+// revisions of the query, or at the revisions of the last deployment of the application if not given, so that they can
+// be generated again and compared with the deployed ones
+func (s *Server) GetManifestBundle(ctx context.Context, q *application.ApplicationManifestQuery) (*application.ManifestBundle, error) {
+	if q.Name == nil || *q.Name == "" {
+		return nil, errors.New("invalid request: application name is missing")
+	}
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return nil, security.NamespaceNotPermittedError(a.Namespace)
+	}
+
+	manifestRequests, manifestInfos, err := s.generateManifests(ctx, a, proj, deployedRevisionsQuery(a, q))
+	if err != nil {
+		return nil, err
+	}
+
+	redactor, err := s.newRedactor()
+	if err != nil {
+		return nil, err
+	}
+	bundle := &application.ManifestBundle{KubeVersion: ptr.To(""), AppLabelKey: ptr.To(""), TrackingMethod: ptr.To("")}
+	for i, manifestRequest := range manifestRequests {
+		manifestInfo := manifestInfos[i]
+		if i == 0 {
+			bundle.KubeVersion = ptr.To(manifestRequest.KubeVersion)
+			bundle.ApiVersions = manifestRequest.ApiVersions
+			bundle.AppLabelKey = ptr.To(manifestRequest.AppLabelKey)
+			bundle.TrackingMethod = ptr.To(manifestRequest.TrackingMethod)
+			bundle.InstallationID = ptr.To(manifestRequest.InstallationID)
+		}
+		if err := s.redactManifests(redactor, manifestInfo.Manifests); err != nil {
+			return nil, err
+		}
+		// the digest is computed after the redaction, so that it does not disclose anything about the secret data
+		digest := sha256.Sum256([]byte(strings.Join(manifestInfo.Manifests, "\n")))
+		bundle.Sources = append(bundle.Sources, &application.ManifestBundleSource{
+			Source:           manifestRequest.ApplicationSource,
+			Revision:         ptr.To(manifestInfo.Revision),
+			SourceType:       ptr.To(manifestInfo.SourceType),
+			Commands:         manifestInfo.Commands,
+			ToolVersions:     manifestInfo.ToolVersions,
+			BuildEnv:         manifestInfo.BuildEnv,
+			KustomizeOptions: manifestRequest.KustomizeOptions,
+			HelmOptions:      manifestRequest.HelmOptions,
+			Manifests:        manifestInfo.Manifests,
+			ManifestsDigest:  ptr.To(hex.EncodeToString(digest[:])),
+		})
+	}
+	return bundle, nil
+}
+
+// deployedRevisionsQuery returns the given manifest query, with the revisions of the last deployment of the given
+// application if the query does not set any revision and the application was deployed
+func deployedRevisionsQuery(a *v1alpha1.Application, q *application.ApplicationManifestQuery) *application.ApplicationManifestQuery {
+	if q.GetRevision() != "" || len(q.Revisions) > 0 || len(a.Status.History) == 0 {
+		return q
+	}
+	deployment := a.Status.History.LastRevisionHistory()
+	deployedQuery := *q
+	if a.Spec.HasMultipleSources() {
+		deployedQuery.SourcePositions = nil
+		for i, revision := range deployment.Revisions {
+			if i >= len(a.Spec.Sources) {
+				break
+			}
+			deployedQuery.SourcePositions = append(deployedQuery.SourcePositions, int64(i+1))
+			deployedQuery.Revisions = append(deployedQuery.Revisions, revision)
+		}
+	} else if deployment.Revision != "" {
+		deployedQuery.Revision = ptr.To(deployment.Revision)
+	}
+	return &deployedQuery
 }
 
 func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_GetManifestsWithFilesServer) error {
@@ -696,6 +848,7 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 			Revision:                        source.TargetRevision,
 			AppLabelKey:                     appInstanceLabelKey,
 			AppName:                         a.Name,
+			AppNamespace:                    a.Namespace,
 			Namespace:                       a.Spec.Destination.Namespace,
 			ApplicationSource:               &source,
 			Repos:                           helmRepos,
@@ -733,23 +886,32 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 		return err
 	}
 
+	redactor, err := s.newRedactor()
+	if err != nil {
+		return err
+	}
 	for i, manifest := range manifestInfo.Manifests {
 		obj := &unstructured.Unstructured{}
 		err = json.Unmarshal([]byte(manifest), obj)
 		if err != nil {
 			return fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
 		}
-		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+		isSecret := obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == ""
+		if !isSecret && !redactor.Matches(obj.GroupVersionKind().GroupKind()) {
+			continue
+		}
+		if isSecret {
 			obj, _, err = diff.HideSecretData(obj, nil, s.settingsMgr.GetSensitiveAnnotations())
 			if err != nil {
 				return fmt.Errorf("error hiding secret data: %w", err)
 			}
-			data, err := json.Marshal(obj)
-			if err != nil {
-				return fmt.Errorf("error marshaling manifest: %w", err)
-			}
-			manifestInfo.Manifests[i] = string(data)
 		}
+		obj, _ = redactor.Redact(obj, nil)
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("error marshaling manifest: %w", err)
+		}
+		manifestInfo.Manifests[i] = string(data)
 	}
 
 	stream.SendAndClose(manifestInfo)
@@ -944,6 +1106,10 @@ func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *v1alpha1.Appl
 		return nil, err
 	}
 
+	if err := s.recordFreeze(ctx, app, newApp, merge); err != nil {
+		return nil, err
+	}
+
 	err = s.validateAndNormalizeApp(ctx, newApp, proj, validate)
 	if err != nil {
 		return nil, fmt.Errorf("error validating and normalizing app: %w", err)
@@ -956,6 +1122,39 @@ func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *v1alpha1.Appl
 	return a, nil
 }
 
+// recordFreeze checks that the user is permitted to freeze the application if the update changes its freeze, and
+// records the user and the time of the new freeze.
+func (s *Server) recordFreeze(ctx context.Context, app *v1alpha1.Application, newApp *v1alpha1.Application, merge bool) error {
+	if newApp.Spec.Freeze == nil && merge {
+		return nil
+	}
+	if reflect.DeepEqual(newApp.Spec.Freeze, app.Spec.Freeze) {
+		return nil
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionFreeze, newApp.RBACName(s.ns)); err != nil {
+		return err
+	}
+	if newApp.Spec.Freeze == nil {
+		return nil
+	}
+	newApp.Spec.Freeze.By = session.Username(ctx)
+	now := metav1.Now()
+	newApp.Spec.Freeze.At = &now
+	return nil
+}
+
+// enforceFreeze denies syncing a frozen application to users who are not permitted to freeze it
+func (s *Server) enforceFreeze(ctx context.Context, a *v1alpha1.Application) error {
+	freeze := a.Spec.Freeze
+	if !freeze.IsActive(time.Now()) {
+		return nil
+	}
+	if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionFreeze, a.RBACName(s.ns)) {
+		return errorcode.Errorf(codes.PermissionDenied, errorcode.ApplicationFrozen, "cannot sync: %s", freeze.Message())
+	}
+	return nil
+}
+
 var informerSyncTimeout = 2 * time.Second
 
 // waitSync is a helper to wait until the application informer cache is synced after create/update.
@@ -1330,8 +1529,6 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 		return status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", app.Name, err.Error())
 	}
 
-	var conditions []v1alpha1.ApplicationCondition
-
 	if validate {
 		conditions := make([]v1alpha1.ApplicationCondition, 0)
 		condition, err := argo.ValidateRepo(ctx, app, s.repoClientset, s.db, s.kubectl, proj, s.settingsMgr)
@@ -1344,12 +1541,12 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 		}
 	}
 
-	conditions, err = argo.ValidatePermissions(ctx, &app.Spec, proj, s.db)
+	conditions, code, err := argo.ValidatePermissionsWithErrorCode(ctx, &app.Spec, proj, s.db)
 	if err != nil {
 		return fmt.Errorf("error validating project permissions: %w", err)
 	}
 	if len(conditions) > 0 {
-		return status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(conditions))
+		return errorcode.Errorf(codes.InvalidArgument, code, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(conditions))
 	}
 
 	app.Spec = *argo.NormalizeApplicationSpec(&app.Spec)
@@ -1475,7 +1672,20 @@ func (s *Server) replaceSecretValues(obj *unstructured.Unstructured) (*unstructu
 		}
 		return obj, err
 	}
-	return obj, nil
+	redactor, err := s.newRedactor()
+	if err != nil {
+		return nil, err
+	}
+	return redactor.RedactObject(obj), nil
+}
+
+// newRedactor returns the redactor of the resource redactions configured in argocd-cm
+func (s *Server) newRedactor() (*argodiff.Redactor, error) {
+	redactions, err := s.settingsMgr.GetResourceRedactions()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource redactions: %w", err)
+	}
+	return argodiff.NewRedactor(redactions), nil
 }
 
 // PatchResource patches a resource
@@ -1501,6 +1711,10 @@ func (s *Server) PatchResource(ctx context.Context, q *application.ApplicationRe
 		if res.Kind == kube.SecretKind && res.Group == "" {
 			return nil, fmt.Errorf("failed to patch Secret %s/%s", res.Namespace, res.Name)
 		}
+		// same for the resources with redacted fields
+		if redactor, redactorErr := s.newRedactor(); redactorErr != nil || redactor.Matches(schema.GroupKind{Group: res.Group, Kind: res.Kind}) {
+			return nil, fmt.Errorf("failed to patch %s %s/%s", res.Kind, res.Namespace, res.Name)
+		}
 		return nil, fmt.Errorf("error patching resource: %w", err)
 	}
 	if manifest == nil {
@@ -1568,19 +1782,48 @@ func (s *Server) ResourceTree(ctx context.Context, q *application.ResourcesQuery
 }
 
 func (s *Server) WatchResourceTree(q *application.ResourcesQuery, ws application.ApplicationService_WatchResourceTreeServer) error {
-	_, _, err := s.getApplicationEnforceRBACInformer(ws.Context(), rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+	var sentTree *v1alpha1.ApplicationTree
+	return s.watchResourceTree(ws.Context(), q, func(tree *v1alpha1.ApplicationTree) error {
+		// the tree is updated on every change of the application resources, skip the ones which did not affect it
+		if sentTree != nil && reflect.DeepEqual(sentTree, tree) {
+			return nil
+		}
+		sentTree = tree
+		return ws.Send(tree)
+	})
+}
+
+// WatchResourceTreeDeltas streams the resource tree of an application like WatchResourceTree, but sends only the changes
+// of the tree after the whole tree, so that the watchers of applications with many resources do not receive all of
+// them on every change
+func (s *Server) WatchResourceTreeDeltas(q *application.ResourcesQuery, ws application.ApplicationService_WatchResourceTreeDeltasServer) error {
+	var sentTree *v1alpha1.ApplicationTree
+	return s.watchResourceTree(ws.Context(), q, func(tree *v1alpha1.ApplicationTree) error {
+		delta := application.NewResourceTreeDelta(sentTree, tree)
+		if delta == nil {
+			return nil
+		}
+		sentTree = tree
+		return ws.Send(delta)
+	})
+}
+
+// watchResourceTree calls the given function with the resource tree of the application of the given query whenever
+// the resources of the application change, until the context is done
+func (s *Server) watchResourceTree(ctx context.Context, q *application.ResourcesQuery, send func(tree *v1alpha1.ApplicationTree) error) error {
+	_, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
 	if err != nil {
 		return err
 	}
 
 	cacheKey := argo.AppInstanceName(q.GetApplicationName(), q.GetAppNamespace(), s.ns)
-	return s.cache.OnAppResourcesTreeChanged(ws.Context(), cacheKey, func() error {
+	return s.cache.OnAppResourcesTreeChanged(ctx, cacheKey, func() error {
 		var tree v1alpha1.ApplicationTree
 		err := s.cache.GetAppResourcesTree(cacheKey, &tree)
 		if err != nil {
 			return fmt.Errorf("error getting app resource tree: %w", err)
 		}
-		return ws.Send(&tree)
+		return send(&tree)
 	})
 }
 
@@ -1604,11 +1847,54 @@ func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMe
 		return nil, fmt.Errorf("error creating repo server client: %w", err)
 	}
 	defer utilio.Close(conn)
-	return repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
+	req := &apiclient.RepoServerRevisionMetadataRequest{
 		Repo:           repo,
 		Revision:       q.GetRevision(),
 		CheckSignature: len(proj.Spec.SignatureKeys) > 0,
-	})
+	}
+	if q.GetIncludeChanges() {
+		req.BaseRevision = previousSyncedRevision(a, int(q.GetSourceIndex()), q.GetRevision())
+		req.IncludePullRequest = true
+	}
+	return repoClient.GetRevisionMetadata(ctx, req)
+}
+
+// previousSyncedRevision returns the revision of the source synced before the given revision, according to the history
+// of the application. If the revision has not been synced yet, the currently synced revision is returned instead. An
+// empty string is returned when there is no such revision.
+func previousSyncedRevision(a *v1alpha1.Application, sourceIndex int, revision string) string {
+	historyRevision := func(h v1alpha1.RevisionHistory) string {
+		if len(h.Revisions) > 0 {
+			if sourceIndex < len(h.Revisions) {
+				return h.Revisions[sourceIndex]
+			}
+			return ""
+		}
+		return h.Revision
+	}
+	history := a.Status.History
+	for i := len(history) - 1; i >= 0; i-- {
+		if historyRevision(history[i]) != revision {
+			continue
+		}
+		for j := i - 1; j >= 0; j-- {
+			if previous := historyRevision(history[j]); previous != "" && previous != revision {
+				return previous
+			}
+		}
+		return ""
+	}
+	synced := a.Status.Sync.Revision
+	if len(a.Status.Sync.Revisions) > 0 {
+		synced = ""
+		if sourceIndex < len(a.Status.Sync.Revisions) {
+			synced = a.Status.Sync.Revisions[sourceIndex]
+		}
+	}
+	if synced == revision {
+		return ""
+	}
+	return synced
 }
 
 // RevisionChartDetails returns the helm chart metadata, as fetched from the reposerver
@@ -1753,6 +2039,19 @@ func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQ
 		return nil, err
 	}
 
+	if s.isAppManagedResourcesOutdated(a) {
+		// the managed resources were computed for another state of the application: let the controller recompute
+		// them rather than returning outdated diffs
+		a, err = s.Get(ctx, &application.ApplicationQuery{
+			Name:         ptr.To(a.GetName()),
+			AppNamespace: ptr.To(a.GetNamespace()),
+			Refresh:      ptr.To(string(v1alpha1.RefreshTypeNormal)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error refreshing application: %w", err)
+		}
+	}
+
 	items := make([]*v1alpha1.ResourceDiff, 0)
 	err = s.getCachedAppState(ctx, a, func() error {
 		return s.cache.GetAppManagedResources(a.InstanceName(s.ns), &items)
@@ -1760,17 +2059,128 @@ func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQ
 	if err != nil {
 		return nil, fmt.Errorf("error getting cached app managed resources: %w", err)
 	}
-	res := &application.ManagedResourcesResponse{}
+	res := &application.ManagedResourcesResponse{DiffHash: ptr.To(managedResourcesDiffHash(items))}
 	for i := range items {
 		item := items[i]
 		if !item.Hook && isMatchingResource(q, kube.ResourceKey{Name: item.Name, Namespace: item.Namespace, Kind: item.Kind, Group: item.Group}) {
 			res.Items = append(res.Items, item)
 		}
 	}
+	if q.GetEstimateCost() {
+		res.CostEstimate, err = s.estimateCost(ctx, res.Items)
+		if err != nil {
+			return nil, fmt.Errorf("error estimating cost: %w", err)
+		}
+	}
 
 	return res, nil
 }
 
+// managedResourcesDiffHash returns the hash of the changes which a sync would apply to the given managed resources,
+// i.e. of the live and target states of the modified resources, excluding the hooks
+func managedResourcesDiffHash(items []*v1alpha1.ResourceDiff) string {
+	modified := make([]*v1alpha1.ResourceDiff, 0)
+	keys := make(map[*v1alpha1.ResourceDiff]string)
+	for _, item := range items {
+		if !item.Hook && item.Modified {
+			modified = append(modified, item)
+			key := kube.NewResourceKey(item.Group, item.Kind, item.Namespace, item.Name)
+			keys[item] = key.String()
+		}
+	}
+	sort.Slice(modified, func(i, j int) bool {
+		return keys[modified[i]] < keys[modified[j]]
+	})
+	h := sha256.New()
+	for _, item := range modified {
+		_, _ = fmt.Fprintf(h, "%s\n%s\n%s\n", keys[item], item.NormalizedLiveState, item.TargetState)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// verifyDiffHash checks that a sync of the given application would apply the changes of the given hash, i.e. that
+// neither the target revisions nor the target or live states changed since the changes were previewed
+func (s *Server) verifyDiffHash(ctx context.Context, a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest, revision string, sourceRevisions []string) error {
+	if syncReq.Manifests != nil || syncReq.GetRevision() != "" || len(syncReq.Revisions) > 0 {
+		return status.Error(codes.InvalidArgument, "cannot confirm the changes of a sync to other revisions or manifests than the target ones")
+	}
+	if a.Spec.HasMultipleSources() {
+		if !slices.Equal(sourceRevisions, a.Status.Sync.Revisions) {
+			return errorcode.Errorf(codes.FailedPrecondition, errorcode.DiffHashMismatch, "the target revisions changed since the changes were previewed: %s", strings.Join(sourceRevisions, ", "))
+		}
+	} else if revision != a.Status.Sync.Revision {
+		return errorcode.Errorf(codes.FailedPrecondition, errorcode.DiffHashMismatch, "the target revision changed since the changes were previewed: %s", revision)
+	}
+	resources, err := s.ManagedResources(ctx, &application.ResourcesQuery{
+		ApplicationName: ptr.To(a.Name),
+		AppNamespace:    ptr.To(a.Namespace),
+		Project:         syncReq.Project,
+	})
+	if err != nil {
+		return err
+	}
+	if resources.GetDiffHash() != syncReq.GetDiffHash() {
+		return errorcode.Errorf(codes.FailedPrecondition, errorcode.DiffHashMismatch, "the changes to apply differ from the previewed ones: expected diff hash %s, got %s", syncReq.GetDiffHash(), resources.GetDiffHash())
+	}
+	return nil
+}
+
+// estimateCost estimates the cost of syncing the given managed resources, from the delta of the requests of their
+// workloads. It returns nil if the cost estimation is not configured.
+func (s *Server) estimateCost(ctx context.Context, items []*v1alpha1.ResourceDiff) (*application.CostEstimate, error) {
+	costEstimation, err := s.settingsMgr.GetCostEstimationSettings()
+	if err != nil {
+		return nil, err
+	}
+	if costEstimation == nil {
+		return nil, nil
+	}
+	policy, err := s.settingsMgr.GetOutboundURLPolicy()
+	if err != nil {
+		return nil, err
+	}
+	pricing, err := cost.NewBackend(costEstimation, policy).GetPricing(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requests := cost.Requests{}
+	for _, item := range items {
+		target, err := item.TargetObject()
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling target state of %s/%s: %w", item.Kind, item.Name, err)
+		}
+		live, err := item.LiveObject()
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling live state of %s/%s: %w", item.Kind, item.Name, err)
+		}
+		if err := requests.AddDelta(target, live); err != nil {
+			return nil, fmt.Errorf("error getting requests of %s/%s: %w", item.Kind, item.Name, err)
+		}
+	}
+	return &application.CostEstimate{
+		Currency:            ptr.To(pricing.Currency),
+		CpuCoreHour:         ptr.To(pricing.CPUCoreHour),
+		MemoryGiBHour:       ptr.To(pricing.MemoryGiBHour),
+		CpuRequestsDelta:    ptr.To(requests.Format(corev1.ResourceCPU)),
+		MemoryRequestsDelta: ptr.To(requests.Format(corev1.ResourceMemory)),
+		MonthlyCostDelta:    ptr.To(math.Round(pricing.MonthlyCost(requests)*100) / 100),
+	}, nil
+}
+
+// isAppManagedResourcesOutdated returns whether the managed resources cached by the controller were computed for
+// another generation or revision of the application. The managed resources cached by controllers which do not record
+// their version are never considered outdated.
+func (s *Server) isAppManagedResourcesOutdated(a *v1alpha1.Application) bool {
+	var version appstatecache.ManagedResourcesVersion
+	if err := s.cache.GetAppManagedResourcesVersion(a.InstanceName(s.ns), &version); err != nil {
+		if !errors.Is(err, servercache.ErrCacheMiss) {
+			log.Warnf("Failed to get managed resources version of application %s: %v", a.QualifiedName(), err)
+		}
+		return false
+	}
+	return !version.Equal(appstatecache.NewManagedResourcesVersion(a.Generation, a.Status.Sync))
+}
+
 func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.ApplicationService_PodLogsServer) error {
 	if q.PodName != nil {
 		podKind := "Pod"
@@ -2007,13 +2417,17 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
 	}
 	if !canSync {
-		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
+		return a, errorcode.Error(codes.PermissionDenied, errorcode.SyncWindowDenied, "cannot sync: blocked by sync window")
 	}
 
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionSync, a.RBACName(s.ns)); err != nil {
 		return nil, err
 	}
 
+	if err := s.enforceFreeze(ctx, a); err != nil {
+		return nil, err
+	}
+
 	if syncReq.Manifests != nil {
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
 			return nil, err
@@ -2023,7 +2437,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		}
 	}
 	if a.DeletionTimestamp != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
+		return nil, errorcode.Error(codes.FailedPrecondition, errorcode.ApplicationDeleting, "application is deleting")
 	}
 
 	revision, displayRevision, sourceRevisions, displayRevisions, err := s.resolveSourceRevisions(ctx, a, syncReq)
@@ -2031,6 +2445,12 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		return nil, err
 	}
 
+	if syncReq.GetDiffHash() != "" {
+		if err := s.verifyDiffHash(ctx, a, syncReq, revision, sourceRevisions); err != nil {
+			return nil, err
+		}
+	}
+
 	var retry *v1alpha1.RetryStrategy
 	var syncOptions v1alpha1.SyncOptions
 	if a.Spec.SyncPolicy != nil {
@@ -2125,7 +2545,7 @@ func (s *Server) resolveSourceRevisions(ctx context.Context, a *v1alpha1.Applica
 			}
 			revision, displayRevision, err := s.resolveRevision(ctx, a, syncReq, index)
 			if err != nil {
-				return "", "", nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+				return "", "", nil, nil, errorcode.Error(codes.FailedPrecondition, errorcode.FromError(err), err.Error())
 			}
 			sourceRevisions[index] = revision
 			displayRevisions[index] = displayRevision
@@ -2140,7 +2560,7 @@ func (s *Server) resolveSourceRevisions(ctx context.Context, a *v1alpha1.Applica
 	}
 	revision, displayRevision, err := s.resolveRevision(ctx, a, syncReq, -1)
 	if err != nil {
-		return "", "", nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+		return "", "", nil, nil, errorcode.Error(codes.FailedPrecondition, errorcode.FromError(err), err.Error())
 	}
 	return revision, displayRevision, nil, nil, nil
 }
@@ -2154,11 +2574,14 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	s.inferResourcesStatusHealth(a)
 
 	if a.DeletionTimestamp != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
+		return nil, errorcode.Error(codes.FailedPrecondition, errorcode.ApplicationDeleting, "application is deleting")
 	}
 	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.IsAutomatedSyncEnabled() {
 		return nil, status.Errorf(codes.FailedPrecondition, "rollback cannot be initiated when auto-sync is enabled")
 	}
+	if err := s.enforceFreeze(ctx, a); err != nil {
+		return nil, err
+	}
 
 	var deploymentInfo *v1alpha1.RevisionHistory
 	for _, info := range a.Status.History {
@@ -2229,12 +2652,19 @@ func (s *Server) ListLinks(ctx context.Context, req *application.ListAppLinksReq
 	}
 
 	deepLinksObject := deeplinks.CreateDeepLinksObject(nil, obj, clstObj, nil)
+	deepLinksObject[deeplinks.ParamsDeepLinkKey] = deeplinks.AppParams(a)
 
 	finalList, errorList := deeplinks.EvaluateDeepLinksResponse(deepLinksObject, obj.GetName(), deepLinks)
 	if len(errorList) > 0 {
 		log.Errorf("errorList while evaluating application deep links, %v", strings.Join(errorList, ", "))
 	}
 
+	appLinks, errorList := deeplinks.EvaluateAppAnnotationLinks(deepLinksObject, a, proj)
+	if len(errorList) > 0 {
+		log.Warnf("errors while evaluating application link annotations, %v", strings.Join(errorList, ", "))
+	}
+	finalList.Items = append(finalList.Items, appLinks...)
+
 	return finalList, nil
 }
 
@@ -2409,7 +2839,7 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 
 func (s *Server) logAppEvent(ctx context.Context, a *v1alpha1.Application, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
-	user := session.Username(ctx)
+	user := session.AuditUsername(ctx)
 	if user == "" {
 		user = "Unknown user"
 	}
@@ -2420,7 +2850,7 @@ func (s *Server) logAppEvent(ctx context.Context, a *v1alpha1.Application, reaso
 
 func (s *Server) logResourceEvent(ctx context.Context, res *v1alpha1.ResourceNode, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
-	user := session.Username(ctx)
+	user := session.AuditUsername(ctx)
 	if user == "" {
 		user = "Unknown user"
 	}
@@ -2487,7 +2917,11 @@ func (s *Server) getAvailableActions(resourceOverrides map[string]v1alpha1.Resou
 	if err != nil {
 		return nil, fmt.Errorf("error getting Lua discovery script: %w", err)
 	}
-	if len(discoveryScripts) == 0 {
+	hasNativeActions, err := luaVM.HasNativeResourceActions(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error getting native actions: %w", err)
+	}
+	if len(discoveryScripts) == 0 && !hasNativeActions {
 		return []v1alpha1.ResourceAction{}, nil
 	}
 	availableActions, err := luaVM.ExecuteResourceActionDiscovery(obj, discoveryScripts)
@@ -2532,7 +2966,37 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 		return nil, fmt.Errorf("error getting Lua resource action: %w", err)
 	}
 
-	newObjects, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua, q.GetResourceActionParameters())
+	// Discovery scripts may declare parameters and the confirmation requirement for built-in actions as well
+	availableActions, err := s.getAvailableActions(resourceOverrides, liveObj)
+	if err != nil {
+		return nil, fmt.Errorf("error getting available actions: %w", err)
+	}
+	for _, availableAction := range availableActions {
+		if availableAction.Name != action.Name {
+			continue
+		}
+		action.RequiresConfirmation = action.RequiresConfirmation || availableAction.RequiresConfirmation
+		if len(action.Params) == 0 {
+			action.Params = availableAction.Params
+		}
+	}
+	if action.RequiresConfirmation {
+		argoCDSettings, err := s.settingsMgr.GetSettings()
+		if err != nil {
+			return nil, fmt.Errorf("error getting settings: %w", err)
+		}
+		user := session.GetUserIdentifier(ctx)
+		// the first call only returns the token confirming the action, which is run by a second call with the token
+		if q.GetConfirmationToken() == "" {
+			token := newActionConfirmationToken(argoCDSettings.ServerSignature, user, time.Now(), q)
+			return &application.ApplicationResponse{ConfirmationToken: &token}, nil
+		}
+		if err := verifyActionConfirmationToken(argoCDSettings.ServerSignature, user, time.Now(), q); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot run action %q: %v", q.GetAction(), err)
+		}
+	}
+
+	newObjects, err := luaVM.ExecuteResourceActionDefinition(liveObj, action, q.GetResourceActionParameters())
 	if err != nil {
 		return nil, fmt.Errorf("error executing Lua resource action: %w", err)
 	}
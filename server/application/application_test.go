@@ -43,6 +43,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/errorcode"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	apps "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
 	appinformer "github.com/argoproj/argo-cd/v3/pkg/client/informers/externalversions"
@@ -645,6 +646,14 @@ func (t *TestResourceTreeServer) RecvMsg(_ any) error {
 	return nil
 }
 
+type TestResourceTreeDeltasServer struct {
+	TestResourceTreeServer
+}
+
+func (t *TestResourceTreeDeltasServer) Send(_ *application.ResourceTreeDelta) error {
+	return nil
+}
+
 type TestPodLogsServer struct {
 	ctx context.Context
 }
@@ -810,6 +819,19 @@ func TestNoAppEnumeration(t *testing.T) {
 		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
 	})
 
+	t.Run("GetManifestBundle", func(t *testing.T) {
+		bundle, err := appServer.GetManifestBundle(adminCtx, &application.ApplicationManifestQuery{Name: ptr.To("test")})
+		require.NoError(t, err)
+		require.Len(t, bundle.Sources, 1)
+		assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", bundle.Sources[0].GetManifestsDigest())
+		_, err = appServer.GetManifestBundle(noRoleCtx, &application.ApplicationManifestQuery{Name: ptr.To("test")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.GetManifestBundle(adminCtx, &application.ApplicationManifestQuery{Name: ptr.To("doest-not-exist")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.GetManifestBundle(adminCtx, &application.ApplicationManifestQuery{Name: ptr.To("doest-not-exist"), Project: ptr.To("test")})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
+	})
+
 	t.Run("ListResourceEvents", func(t *testing.T) {
 		_, err := appServer.ListResourceEvents(adminCtx, &application.ApplicationResourceEventsQuery{Name: ptr.To("test")})
 		require.NoError(t, err)
@@ -1034,6 +1056,17 @@ func TestNoAppEnumeration(t *testing.T) {
 		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"does-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
 	})
 
+	t.Run("WatchResourceTreeDeltas", func(t *testing.T) {
+		err := appServer.WatchResourceTreeDeltas(&application.ResourcesQuery{ApplicationName: ptr.To("test")}, &TestResourceTreeDeltasServer{TestResourceTreeServer{ctx: adminCtx}})
+		require.NoError(t, err)
+		err = appServer.WatchResourceTreeDeltas(&application.ResourcesQuery{ApplicationName: ptr.To("test")}, &TestResourceTreeDeltasServer{TestResourceTreeServer{ctx: noRoleCtx}})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		err = appServer.WatchResourceTreeDeltas(&application.ResourcesQuery{ApplicationName: ptr.To("does-not-exist")}, &TestResourceTreeDeltasServer{TestResourceTreeServer{ctx: adminCtx}})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		err = appServer.WatchResourceTreeDeltas(&application.ResourcesQuery{ApplicationName: ptr.To("does-not-exist"), Project: ptr.To("test")}, &TestResourceTreeDeltasServer{TestResourceTreeServer{ctx: adminCtx}})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"does-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
+	})
+
 	t.Run("PodLogs", func(t *testing.T) {
 		err := appServer.PodLogs(&application.ApplicationPodLogsQuery{Name: ptr.To("test")}, &TestPodLogsServer{ctx: adminCtx})
 		require.NoError(t, err)
@@ -1292,6 +1325,51 @@ func TestListApps(t *testing.T) {
 	assert.Equal(t, []string{"abc", "bcd", "def"}, names)
 }
 
+func TestListAppsPaginated(t *testing.T) {
+	appServer := newTestAppServer(t, newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "bcd"
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "abc"
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "def"
+	}))
+
+	listNames := func(t *testing.T, q *application.ApplicationQuery) ([]string, *int64) {
+		t.Helper()
+		res, err := appServer.List(t.Context(), q)
+		require.NoError(t, err)
+		var names []string
+		for i := range res.Items {
+			names = append(names, res.Items[i].Name)
+		}
+		return names, res.RemainingItemCount
+	}
+
+	names, remaining := listNames(t, &application.ApplicationQuery{Limit: ptr.To(int64(2))})
+	assert.Equal(t, []string{"abc", "bcd"}, names)
+	assert.Equal(t, ptr.To(int64(1)), remaining)
+
+	names, remaining = listNames(t, &application.ApplicationQuery{Offset: ptr.To(int64(2)), Limit: ptr.To(int64(2))})
+	assert.Equal(t, []string{"def"}, names)
+	assert.Equal(t, ptr.To(int64(0)), remaining)
+
+	names, remaining = listNames(t, &application.ApplicationQuery{Offset: ptr.To(int64(1))})
+	assert.Equal(t, []string{"bcd", "def"}, names)
+	assert.Equal(t, ptr.To(int64(0)), remaining)
+
+	names, remaining = listNames(t, &application.ApplicationQuery{Offset: ptr.To(int64(5)), Limit: ptr.To(int64(2))})
+	assert.Empty(t, names)
+	assert.Equal(t, ptr.To(int64(0)), remaining)
+
+	names, remaining = listNames(t, &application.ApplicationQuery{})
+	assert.Equal(t, []string{"abc", "bcd", "def"}, names)
+	assert.Nil(t, remaining)
+
+	_, err := appServer.List(t.Context(), &application.ApplicationQuery{Limit: ptr.To(int64(-1))})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestCoupleAppsListApps(t *testing.T) {
 	var objects []runtime.Object
 	ctx := t.Context()
@@ -1478,6 +1556,30 @@ func TestCreateApp(t *testing.T) {
 	assert.Equal(t, "default", app.Spec.Project)
 }
 
+func TestCreateAppWithFieldManager(t *testing.T) {
+	appServer := newTestAppServer(t)
+	app, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{
+		Application:  newTestApp(),
+		FieldManager: ptr.To("terraform"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "terraform", app.Annotations[common.AnnotationKeyFieldManager])
+
+	managedApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Annotations = map[string]string{common.AnnotationKeyFieldManager: "terraform"}
+	})
+	appServer = newTestAppServer(t, managedApp)
+	updatedApp := newTestApp()
+	updatedApp.Spec.Source.Path = "other"
+	_, err = appServer.Create(t.Context(), &application.ApplicationCreateRequest{
+		Application:  updatedApp,
+		Upsert:       ptr.To(true),
+		FieldManager: ptr.To("tofu"),
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
+
 func TestCreateAppWithDestName(t *testing.T) {
 	appServer := newTestAppServer(t)
 	testApp := newTestAppWithDestName()
@@ -2030,6 +2132,63 @@ func TestAppMergePatch(t *testing.T) {
 	assert.Equal(t, "foo", app.Spec.Source.Path)
 }
 
+func TestAppFreeze(t *testing.T) {
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appServer.enf.SetDefaultRole("")
+	freezePatch := `{"spec": {"freeze": {"reason": "incident", "by": "someone-else"}}}`
+
+	t.Run("freeze without freeze permission", func(t *testing.T) {
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/test-app, allow
+p, test-user, applications, update, default/test-app, allow
+p, test-user, applications, sync, default/test-app, allow
+`)
+		_, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{Name: &testApp.Name, Patch: &freezePatch, PatchType: ptr.To("merge")})
+		assert.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+
+	t.Run("freeze with freeze permission", func(t *testing.T) {
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/test-app, allow
+p, test-user, applications, update, default/test-app, allow
+p, test-user, applications, freeze, default/test-app, allow
+`)
+		app, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{Name: &testApp.Name, Patch: &freezePatch, PatchType: ptr.To("merge")})
+		require.NoError(t, err)
+		freeze := app.Spec.Freeze
+		require.NotNil(t, freeze)
+		assert.Equal(t, "test-user", freeze.By)
+		assert.Equal(t, "incident", freeze.Reason)
+		assert.NotNil(t, freeze.At)
+	})
+
+	t.Run("sync frozen app without freeze permission", func(t *testing.T) {
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/test-app, allow
+p, test-user, applications, sync, default/test-app, allow
+`)
+		app, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name})
+		assert.Nil(t, app)
+		assert.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+		assert.ErrorContains(t, err, "application is frozen by test-user: incident")
+	})
+
+	t.Run("sync frozen app with freeze permission", func(t *testing.T) {
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/test-app, allow
+p, test-user, applications, sync, default/test-app, allow
+p, test-user, applications, freeze, default/test-app, allow
+`)
+		app, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+}
+
 func TestServer_GetApplicationSyncWindowsState(t *testing.T) {
 	t.Run("Active", func(t *testing.T) {
 		testApp := newTestApp()
@@ -2449,6 +2608,111 @@ func TestInferResourcesStatusHealth(t *testing.T) {
 	assert.Nil(t, testApp.Status.Resources[1].Health)
 }
 
+func TestIsAppManagedResourcesOutdated(t *testing.T) {
+	cacheClient := cache.NewCache(cache.NewInMemoryCache(1 * time.Hour))
+
+	testApp := newTestApp()
+	testApp.Generation = 2
+	testApp.Status.Sync.Revision = "abc"
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cacheClient, time.Minute)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+
+	// managed resources cached without version are never outdated
+	assert.False(t, appServer.isAppManagedResourcesOutdated(testApp))
+
+	err := appStateCache.SetAppManagedResourcesVersion(testApp.Name, appstate.NewManagedResourcesVersion(2, v1alpha1.SyncStatus{Revision: "abc"}))
+	require.NoError(t, err)
+	assert.False(t, appServer.isAppManagedResourcesOutdated(testApp))
+
+	err = appStateCache.SetAppManagedResourcesVersion(testApp.Name, appstate.NewManagedResourcesVersion(1, v1alpha1.SyncStatus{Revision: "abc"}))
+	require.NoError(t, err)
+	assert.True(t, appServer.isAppManagedResourcesOutdated(testApp))
+
+	err = appStateCache.SetAppManagedResourcesVersion(testApp.Name, appstate.NewManagedResourcesVersion(2, v1alpha1.SyncStatus{Revision: "def"}))
+	require.NoError(t, err)
+	assert.True(t, appServer.isAppManagedResourcesOutdated(testApp))
+}
+
+func TestManagedResourcesDiffHash(t *testing.T) {
+	deployment := &v1alpha1.ResourceDiff{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", TargetState: `{"spec":{"replicas":2}}`, Modified: true}
+	service := &v1alpha1.ResourceDiff{Kind: "Service", Namespace: "default", Name: "guestbook", TargetState: `{"spec":{}}`, Modified: true}
+	synced := &v1alpha1.ResourceDiff{Kind: "ConfigMap", Namespace: "default", Name: "guestbook", TargetState: `{"data":{}}`}
+	hook := &v1alpha1.ResourceDiff{Group: "batch", Kind: "Job", Namespace: "default", Name: "migrate", TargetState: `{"spec":{}}`, Modified: true, Hook: true}
+
+	hash := managedResourcesDiffHash([]*v1alpha1.ResourceDiff{deployment, service})
+	// the order of the resources, the synced resources and the hooks do not change the hash
+	assert.Equal(t, hash, managedResourcesDiffHash([]*v1alpha1.ResourceDiff{service, synced, hook, deployment}))
+
+	scaled := deployment.DeepCopy()
+	scaled.TargetState = `{"spec":{"replicas":3}}`
+	assert.NotEqual(t, hash, managedResourcesDiffHash([]*v1alpha1.ResourceDiff{scaled, service}))
+
+	drifted := deployment.DeepCopy()
+	drifted.NormalizedLiveState = `{"spec":{"replicas":1}}`
+	assert.NotEqual(t, hash, managedResourcesDiffHash([]*v1alpha1.ResourceDiff{drifted, service}))
+	assert.NotEqual(t, hash, managedResourcesDiffHash([]*v1alpha1.ResourceDiff{deployment}))
+}
+
+func TestSyncWithDiffHash(t *testing.T) {
+	ctx := t.Context()
+	testApp := newTestApp()
+	testApp.Status.Sync.Revision = fakeResolveRevisionResponse().Revision
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Hour, time.Hour)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{{
+		Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", TargetState: `{"spec":{"replicas":2}}`, Modified: true,
+	}})
+	require.NoError(t, err)
+
+	resources, err := appServer.ManagedResources(ctx, &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	require.NotEmpty(t, resources.GetDiffHash())
+
+	_, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name, DiffHash: ptr.To("other")})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, errorcode.DiffHashMismatch, errorcode.FromError(err))
+
+	_, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name, Revision: ptr.To("main"), DiffHash: resources.DiffHash})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	app, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name, DiffHash: resources.DiffHash})
+	require.NoError(t, err)
+	assert.NotNil(t, app.Operation)
+}
+
+func TestEstimateCost(t *testing.T) {
+	items := []*v1alpha1.ResourceDiff{{
+		Kind: "Deployment",
+		Name: "guestbook",
+		LiveState: `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "guestbook"}, "spec": {"replicas": 1,
+			"template": {"spec": {"containers": [{"name": "guestbook", "resources": {"requests": {"cpu": "1", "memory": "1Gi"}}}]}}}}`,
+		TargetState: `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "guestbook"}, "spec": {"replicas": 3,
+			"template": {"spec": {"containers": [{"name": "guestbook", "resources": {"requests": {"cpu": "1", "memory": "1Gi"}}}]}}}}`,
+	}, {
+		Kind:        "ConfigMap",
+		Name:        "guestbook",
+		LiveState:   "null",
+		TargetState: `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "guestbook"}}`,
+	}}
+
+	appServer := newTestAppServer(t)
+	estimate, err := appServer.estimateCost(t.Context(), items)
+	require.NoError(t, err)
+	assert.Nil(t, estimate)
+
+	appServer = newTestAppServerWithEnforcerConfigure(t, func(*rbac.Enforcer) {}, map[string]string{
+		"costEstimation": "{currency: EUR, static: {cpuCoreHour: 0.04, memoryGiBHour: 0.005}}",
+	})
+	estimate, err = appServer.estimateCost(t.Context(), items)
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", estimate.GetCurrency())
+	assert.Equal(t, "+2", estimate.GetCpuRequestsDelta())
+	assert.Equal(t, "+2Gi", estimate.GetMemoryRequestsDelta())
+	assert.InDelta(t, 65.7, estimate.GetMonthlyCostDelta(), 0.001)
+}
+
 func TestRunNewStyleResourceAction(t *testing.T) {
 	cacheClient := cache.NewCache(cache.NewInMemoryCache(1 * time.Hour))
 
@@ -2647,6 +2911,58 @@ func TestRunOldStyleResourceAction(t *testing.T) {
 		require.NoError(t, runErr)
 		assert.NotNil(t, appResponse)
 	})
+
+	t.Run("RequiresConfirmation", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Status.ResourceHealthSource = v1alpha1.ResourceHealthLocationAppTree
+		testApp.Status.Resources = resources
+
+		argoCM := map[string]string{"resource.customizations.actions.apps_Deployment": `
+discovery.lua: |
+  actions = {}
+  actions["pause"] = {}
+  return actions
+definitions:
+- name: pause
+  requiresConfirmation: true
+  action.lua: |
+    obj.spec.paused = true
+    return obj
+`}
+		appServer := newTestAppServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:admin")
+		}, argoCM, testApp, kube.MustToUnstructured(&deployment))
+		appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+
+		err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: nodes})
+		require.NoError(t, err)
+
+		request := &application.ResourceActionRunRequest{
+			Name:         &testApp.Name,
+			Namespace:    &namespace,
+			Action:       &action,
+			AppNamespace: &testApp.Namespace,
+			ResourceName: &resourceName,
+			Version:      &version,
+			Group:        &group,
+			Kind:         &kind,
+		}
+		appResponse, runErr := appServer.RunResourceAction(t.Context(), request)
+		require.NoError(t, runErr)
+		token := appResponse.GetConfirmationToken()
+		require.NotEmpty(t, token)
+
+		request.ConfirmationToken = ptr.To("invalid")
+		_, runErr = appServer.RunResourceAction(t.Context(), request)
+		require.Error(t, runErr)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(runErr))
+
+		request.ConfirmationToken = &token
+		appResponse, runErr = appServer.RunResourceAction(t.Context(), request)
+		require.NoError(t, runErr)
+		assert.Empty(t, appResponse.GetConfirmationToken())
+	})
 }
 
 func TestIsApplicationPermitted(t *testing.T) {
@@ -2967,6 +3283,33 @@ func TestAppNamespaceRestrictions(t *testing.T) {
 		require.NoError(t, err)
 		assert.Empty(t, links.Items)
 	})
+	t.Run("Get list of links of the link annotations", func(t *testing.T) {
+		t.Parallel()
+		testApp := newTestApp()
+		testApp.Annotations = map[string]string{
+			common.AnnotationKeyLinkPrefix + "grafana":   "Dashboard|https://grafana.example.com/d/abc?var-app={{.app.metadata.name}}&var-env={{.params.env}}",
+			common.AnnotationKeyLinkPrefix + "docs":      "https://docs.example.com/{{.app.spec.destination.namespace}}",
+			common.AnnotationKeyLinkPrefix + "other":     "https://other.example.com",
+			common.AnnotationKeyLinkPrefix + "undefined": "https://grafana.example.com/d/abc?var-team={{.app.metadata.labels.team}}",
+		}
+		testApp.Spec.Project = "links"
+		testApp.Spec.Source.Helm = &v1alpha1.ApplicationSourceHelm{Parameters: []v1alpha1.HelmParameter{{Name: "env", Value: "prod"}}}
+		linksProj := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "links", Namespace: "default"},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:     []string{"*"},
+				Destinations:    []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				AllowedLinkURLs: []string{"https://grafana.example.com/*", "https://docs.example.com/*"},
+			},
+		}
+		appServer := newTestAppServer(t, testApp, linksProj)
+		links, err := appServer.ListLinks(t.Context(), &application.ListAppLinksRequest{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		assert.Equal(t, []*application.LinkInfo{
+			{Title: ptr.To("docs"), Url: ptr.To("https://docs.example.com/" + testApp.Spec.Destination.Namespace)},
+			{Title: ptr.To("Dashboard"), Url: ptr.To("https://grafana.example.com/d/abc?var-app=test-app&var-env=prod")},
+		}, links.Items)
+	})
 }
 
 func TestGetAmbiguousRevision_MultiSource(t *testing.T) {
@@ -3350,6 +3693,47 @@ func Test_RevisionMetadata(t *testing.T) {
 	}
 }
 
+func Test_previousSyncedRevision(t *testing.T) {
+	t.Parallel()
+
+	singleSourceApp := newTestApp()
+	singleSourceApp.Status.History = []v1alpha1.RevisionHistory{{ID: 1, Revision: "a"}, {ID: 2, Revision: "b"}, {ID: 3, Revision: "b"}, {ID: 4, Revision: "c"}}
+	singleSourceApp.Status.Sync.Revision = "c"
+	assert.Equal(t, "b", previousSyncedRevision(singleSourceApp, 0, "c"))
+	assert.Equal(t, "a", previousSyncedRevision(singleSourceApp, 0, "b"))
+	assert.Empty(t, previousSyncedRevision(singleSourceApp, 0, "a"))
+	assert.Equal(t, "c", previousSyncedRevision(singleSourceApp, 0, "d"))
+
+	multiSourceApp := newTestApp()
+	multiSourceApp.Status.History = []v1alpha1.RevisionHistory{{ID: 1, Revisions: []string{"a", "x"}}, {ID: 2, Revisions: []string{"b", "x"}}}
+	multiSourceApp.Status.Sync.Revisions = []string{"b", "x"}
+	assert.Equal(t, "a", previousSyncedRevision(multiSourceApp, 0, "b"))
+	assert.Empty(t, previousSyncedRevision(multiSourceApp, 1, "x"))
+	assert.Equal(t, "x", previousSyncedRevision(multiSourceApp, 1, "y"))
+	assert.Empty(t, previousSyncedRevision(multiSourceApp, 2, "z"))
+}
+
+func Test_deployedRevisionsQuery(t *testing.T) {
+	t.Parallel()
+
+	singleSourceApp := newTestApp()
+	q := &application.ApplicationManifestQuery{Name: ptr.To("test")}
+	assert.Same(t, q, deployedRevisionsQuery(singleSourceApp, q), "the query of an app never deployed is unchanged")
+
+	singleSourceApp.Status.History = []v1alpha1.RevisionHistory{{ID: 1, Revision: "a"}, {ID: 2, Revision: "b"}}
+	assert.Equal(t, "b", deployedRevisionsQuery(singleSourceApp, q).GetRevision())
+	assert.Empty(t, q.GetRevision(), "the given query is not modified")
+	assert.Equal(t, "c", deployedRevisionsQuery(singleSourceApp, &application.ApplicationManifestQuery{Name: ptr.To("test"), Revision: ptr.To("c")}).GetRevision())
+
+	multiSourceApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Sources = v1alpha1.ApplicationSources{{RepoURL: "https://some-fake-source"}, {RepoURL: "https://some-other-fake-source"}}
+		app.Status.History = []v1alpha1.RevisionHistory{{ID: 1, Revisions: []string{"a", "x"}}}
+	})
+	deployedQuery := deployedRevisionsQuery(multiSourceApp, q)
+	assert.Equal(t, []int64{1, 2}, deployedQuery.SourcePositions)
+	assert.Equal(t, []string{"a", "x"}, deployedQuery.Revisions)
+}
+
 func Test_DeepCopyInformers(t *testing.T) {
 	t.Parallel()
 
@@ -42,6 +42,8 @@ type terminalHandler struct {
 type TerminalOptions struct {
 	DisableAuth bool
 	Enf         *rbac.Enforcer
+	// Draining is closed when the server starts draining its connections before shutting down
+	Draining <-chan struct{}
 }
 
 // NewHandler returns a new terminal handler.
@@ -239,6 +241,7 @@ func (s *terminalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// send pings across the WebSocket channel at regular intervals to keep it alive through
 	// load balancers which may close an idle connection after some period of time
 	go session.StartKeepalives(time.Second * 5)
+	go session.CloseOnDrain(s.terminalOptions.Draining)
 
 	if isValidShell(s.allowedShells, shell) {
 		cmd := []string{shell}
@@ -98,6 +98,22 @@ func (t *terminalSession) StartKeepalives(dur time.Duration) {
 	}
 }
 
+// CloseOnDrain ends the session with a going away close message once the server starts draining its connections, so
+// that the session is not dropped abruptly when the server shuts down
+func (t *terminalSession) CloseOnDrain(draining <-chan struct{}) {
+	select {
+	case <-draining:
+		t.writeLock.Lock()
+		err := t.wsConn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, "server is shutting down"), time.Now().Add(time.Second))
+		t.writeLock.Unlock()
+		if err != nil {
+			log.Warnf("failed to close terminal session: %v", err)
+		}
+		_ = t.Close()
+	case <-t.doneChan:
+	}
+}
+
 // Next called in a loop from remotecommand as long as the process is running
 func (t *terminalSession) Next() *remotecommand.TerminalSize {
 	select {
@@ -63,6 +63,7 @@ type Server struct {
 	AllowedScmProviders      []string
 	EnableScmProviders       bool
 	EnableGitHubAPIMetrics   bool
+	MatrixMaxGenerators      int
 }
 
 // NewServer returns a new instance of the ApplicationSet service
@@ -85,6 +86,7 @@ func NewServer(
 	allowedScmProviders []string,
 	enableScmProviders bool,
 	enableGitHubAPIMetrics bool,
+	matrixMaxGenerators int,
 	enableK8sEvent []string,
 ) applicationset.ApplicationSetServiceServer {
 	s := &Server{
@@ -107,6 +109,7 @@ func NewServer(
 		AllowedScmProviders:      allowedScmProviders,
 		EnableScmProviders:       enableScmProviders,
 		EnableGitHubAPIMetrics:   enableGitHubAPIMetrics,
+		MatrixMaxGenerators:      matrixMaxGenerators,
 	}
 	return s
 }
@@ -265,7 +268,7 @@ func (s *Server) generateApplicationSetApps(ctx context.Context, logEntry *log.E
 
 	scmConfig := generators.NewSCMConfig(s.ScmRootCAPath, s.AllowedScmProviders, s.EnableScmProviders, s.EnableGitHubAPIMetrics, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), true)
 	argoCDService := services.NewArgoCDService(s.db, s.GitSubmoduleEnabled, s.repoClientSet, s.EnableNewGitFileGlobbing)
-	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, namespace, argoCDService, s.dynamicClient, scmConfig)
+	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, namespace, argoCDService, s.dynamicClient, scmConfig, s.MatrixMaxGenerators)
 
 	apps, _, err := appsettemplate.GenerateApplications(logEntry, appset, appSetGenerators, &appsetutils.Render{}, s.client)
 	if err != nil {
@@ -275,7 +278,7 @@ func (s *Server) generateApplicationSetApps(ctx context.Context, logEntry *log.E
 }
 
 func (s *Server) updateAppSet(ctx context.Context, appset *v1alpha1.ApplicationSet, newAppset *v1alpha1.ApplicationSet, merge bool) (*v1alpha1.ApplicationSet, error) {
-	if appset != nil && appset.Spec.Template.Spec.Project != newAppset.Spec.Template.Spec.Project {
+	if appset != nil && appset.GetProject() != newAppset.GetProject() {
 		// When changing projects, caller must have applicationset create and update privileges in new project
 		// NOTE: the update check was already verified in the caller to this function
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionCreate, newAppset.RBACName(s.ns)); err != nil {
@@ -327,8 +330,8 @@ func (s *Server) Delete(ctx context.Context, q *applicationset.ApplicationSetDel
 		return nil, err
 	}
 
-	s.projectLock.RLock(appset.Spec.Template.Spec.Project)
-	defer s.projectLock.RUnlock(appset.Spec.Template.Spec.Project)
+	s.projectLock.RLock(appset.GetProject())
+	defer s.projectLock.RUnlock(appset.GetProject())
 
 	err = s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Delete(ctx, q.Name, metav1.DeleteOptions{})
 	if err != nil {
@@ -423,11 +426,15 @@ func (s *Server) validateAppSet(appset *v1alpha1.ApplicationSet) (string, error)
 		return "", errors.New("ApplicationSet cannot be validated for nil value")
 	}
 
-	projectName := appset.Spec.Template.Spec.Project
-
-	if strings.Contains(projectName, "{{") {
-		return "", errors.New("the Argo CD API does not currently support creating ApplicationSets with templated `project` fields")
+	// a templated project is only supported with the project of the ApplicationSet, whose AppProject restricts the
+	// projects of the generated applications
+	if strings.Contains(appset.Spec.Project, "{{") {
+		return "", errors.New("the `spec.project` field of an ApplicationSet cannot be templated")
+	}
+	if strings.Contains(appset.Spec.Template.Spec.Project, "{{") && appset.Spec.Project == "" {
+		return "", errors.New("the Argo CD API only supports creating ApplicationSets with templated `project` fields with the `spec.project` field")
 	}
+	projectName := appset.GetProject()
 
 	if err := appsetutils.CheckInvalidGenerators(appset); err != nil {
 		return "", err
@@ -487,7 +494,7 @@ func (s *Server) waitSync(appset *v1alpha1.ApplicationSet) {
 
 func (s *Server) logAppSetEvent(ctx context.Context, a *v1alpha1.ApplicationSet, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
-	user := session.Username(ctx)
+	user := session.AuditUsername(ctx)
 	if user == "" {
 		user = "Unknown user"
 	}
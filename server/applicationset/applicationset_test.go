@@ -15,6 +15,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	k8scache "k8s.io/client-go/tools/cache"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/generators"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -163,6 +164,7 @@ func newTestAppSetServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforce
 		[]string{},
 		true,
 		true,
+		generators.DefaultMatrixMaxGenerators,
 		testEnableEventList,
 	)
 	return server.(*Server)
@@ -356,7 +358,28 @@ func TestCreateAppSetTemplatedProject(t *testing.T) {
 		Applicationset: testAppSet,
 	}
 	_, err := appServer.Create(t.Context(), &createReq)
-	assert.EqualError(t, err, "error validating ApplicationSets: the Argo CD API does not currently support creating ApplicationSets with templated `project` fields")
+	assert.EqualError(t, err, "error validating ApplicationSets: the Argo CD API only supports creating ApplicationSets with templated `project` fields with the `spec.project` field")
+
+	testAppSet.Spec.Project = "{{ .project }}"
+	_, err = appServer.Create(t.Context(), &createReq)
+	assert.EqualError(t, err, "error validating ApplicationSets: the `spec.project` field of an ApplicationSet cannot be templated")
+}
+
+func TestCreateAppSetTemplatedProjectWithProject(t *testing.T) {
+	testAppSet := newTestAppSet()
+	appServer := newTestAppSetServer(t)
+	testAppSet.Spec.Template.Spec.Project = "{{ .project }}"
+	testAppSet.Spec.Project = "default"
+	testAppSet.Spec.Generators = []appsv1.ApplicationSetGenerator{
+		{
+			List: &appsv1.ListGenerator{},
+		},
+	}
+	createReq := applicationset.ApplicationSetCreateRequest{
+		Applicationset: testAppSet,
+	}
+	_, err := appServer.Create(t.Context(), &createReq)
+	require.NoError(t, err)
 }
 
 func TestCreateAppSetWrongNamespace(t *testing.T) {
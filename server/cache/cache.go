@@ -59,10 +59,18 @@ func (c *Cache) OnAppResourcesTreeChanged(ctx context.Context, appName string, c
 	return c.cache.OnAppResourcesTreeChanged(ctx, appName, callback)
 }
 
+func (c *Cache) RequestAppsRefresh(appNames []string) error {
+	return c.cache.RequestAppsRefresh(appNames)
+}
+
 func (c *Cache) GetAppManagedResources(appName string, res *[]*appv1.ResourceDiff) error {
 	return c.cache.GetAppManagedResources(appName, res)
 }
 
+func (c *Cache) GetAppManagedResourcesVersion(appName string, res *appstatecache.ManagedResourcesVersion) error {
+	return c.cache.GetAppManagedResourcesVersion(appName, res)
+}
+
 func (c *Cache) SetRepoConnectionState(repo string, project string, state *appv1.ConnectionState) error {
 	return c.cache.SetItem(repoConnectionStateKey(repo, project), &state, c.connectionStatusCacheExpiration, state == nil)
 }
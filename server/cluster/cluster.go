@@ -145,6 +145,9 @@ func (s *Server) Create(ctx context.Context, q *cluster.ClusterCreateRequest) (*
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceClusters, rbac.ActionCreate, CreateClusterRBACObject(q.Cluster.Project, q.Cluster.Server)); err != nil {
 		return nil, fmt.Errorf("permission denied while creating cluster: %w", err)
 	}
+	if err := db.ValidateNoSecretRefs(q.Cluster); err != nil {
+		return nil, err
+	}
 	c := q.Cluster
 	clusterRESTConfig, err := c.RESTConfig()
 	if err != nil {
@@ -295,6 +298,9 @@ var clusterFieldsByPath = map[string]func(updated *appv1.Cluster, existing *appv
 
 // Update updates a cluster
 func (s *Server) Update(ctx context.Context, q *cluster.ClusterUpdateRequest) (*appv1.Cluster, error) {
+	if err := db.ValidateNoSecretRefs(q.Cluster); err != nil {
+		return nil, err
+	}
 	c, err := s.getClusterAndVerifyAccess(ctx, &cluster.ClusterQuery{
 		Server: q.Cluster.Server,
 		Name:   q.Cluster.Name,
@@ -16,6 +16,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -377,6 +379,23 @@ func TestUpdateCluster_NoFieldsPaths(t *testing.T) {
 	assert.Equal(t, []string{"default", "kube-system"}, updated.Namespaces)
 }
 
+func TestCluster_RejectSecretRefs(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	c := &v1alpha1.Cluster{
+		Name:   "minikube",
+		Server: "https://127.0.0.1",
+		Config: v1alpha1.ClusterConfig{BearerToken: "${vault:secret/data/cluster#token}"},
+	}
+
+	_, err := server.Create(t.Context(), &cluster.ClusterCreateRequest{Cluster: c})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.Update(t.Context(), &cluster.ClusterUpdateRequest{Cluster: c, UpdatedFields: []string{"config"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	db.AssertNotCalled(t, "CreateCluster", mock.Anything, mock.Anything)
+	db.AssertNotCalled(t, "UpdateCluster", mock.Anything, mock.Anything)
+}
+
 func TestUpdateCluster_FieldsPathSet(t *testing.T) {
 	db := &dbmocks.ArgoDB{}
 	var updated *v1alpha1.Cluster
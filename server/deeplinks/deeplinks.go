@@ -3,6 +3,8 @@ package deeplinks
 import (
 	"bytes"
 	"fmt"
+	"sort"
+	"strings"
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
@@ -11,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/settings"
@@ -31,6 +34,7 @@ const (
 	AppDeepLinkShortKey = "app"
 	ClusterDeepLinkKey  = "cluster"
 	ProjectDeepLinkKey  = "project"
+	ParamsDeepLinkKey   = "params"
 )
 
 type ClusterLinksData struct {
@@ -124,3 +128,73 @@ func EvaluateDeepLinksResponse(obj map[string]any, name string, links []settings
 		Items: finalLinks,
 	}, errors
 }
+
+// AppParams returns the parameters of the sources of the given application by name, i.e. the Helm parameters and the
+// parameters of the plugins, for the templates of the links. The parameters of the last sources take precedence.
+func AppParams(app *v1alpha1.Application) map[string]any {
+	params := map[string]any{}
+	for _, source := range app.Spec.GetSources() {
+		if source.Helm != nil {
+			for _, param := range source.Helm.Parameters {
+				params[param.Name] = param.Value
+			}
+		}
+		if source.Plugin != nil {
+			for _, param := range source.Plugin.Parameters {
+				switch {
+				case param.String_ != nil:
+					params[param.Name] = *param.String_
+				case param.OptionalArray != nil:
+					params[param.Name] = param.Array
+				case param.OptionalMap != nil:
+					params[param.Name] = param.Map
+				}
+			}
+		}
+	}
+	return params
+}
+
+// EvaluateAppAnnotationLinks returns the links of the common.AnnotationKeyLinkPrefix annotations of the given
+// application, sorted by annotation, whose URLs are templated with the given object like the URLs of the deep links.
+// The links which cannot be evaluated, reference missing values, or point to URLs not permitted by the given project
+// are skipped and reported in the returned errors.
+func EvaluateAppAnnotationLinks(obj map[string]any, app *v1alpha1.Application, proj *v1alpha1.AppProject) ([]*application.LinkInfo, []string) {
+	var keys []string
+	for key := range app.GetAnnotations() {
+		if strings.HasPrefix(key, common.AnnotationKeyLinkPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	links := []*application.LinkInfo{}
+	errors := []string{}
+	for _, key := range keys {
+		// the value is the URL, optionally preceded by the title of the link and a "|"
+		title, linkURL, found := strings.Cut(app.GetAnnotations()[key], "|")
+		if !found {
+			title, linkURL = strings.TrimPrefix(key, common.AnnotationKeyLinkPrefix), title
+		}
+		t, err := template.New("app-link").Funcs(sprigFuncMap).Option("missingkey=error").Parse(linkURL)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("failed to parse link template '%v' of annotation %v, error=%v", linkURL, key, err.Error()))
+			continue
+		}
+		finalURL := bytes.Buffer{}
+		err = t.Execute(&finalURL, obj)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("failed to evaluate link template '%v' of annotation %v with application %v, error=%v", linkURL, key, app.Name, err.Error()))
+			continue
+		}
+		if !proj.IsLinkURLPermitted(finalURL.String()) {
+			errors = append(errors, fmt.Sprintf("link '%v' of annotation %v of application %v is not permitted by project %v", finalURL.String(), key, app.Name, proj.Name))
+			continue
+		}
+		links = append(links, &application.LinkInfo{
+			Title: ptr.To(title),
+			Url:   ptr.To(finalURL.String()),
+		})
+	}
+	return links, errors
+}
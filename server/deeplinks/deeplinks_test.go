@@ -209,3 +209,96 @@ func TestDeepLinks(t *testing.T) {
 		})
 	}
 }
+
+func TestAppParams(t *testing.T) {
+	app := &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{Sources: v1alpha1.ApplicationSources{
+		{Helm: &v1alpha1.ApplicationSourceHelm{Parameters: []v1alpha1.HelmParameter{{Name: "env", Value: "dev"}, {Name: "replicas", Value: "1"}}}},
+		{Helm: &v1alpha1.ApplicationSourceHelm{Parameters: []v1alpha1.HelmParameter{{Name: "env", Value: "prod"}}}},
+		{Plugin: &v1alpha1.ApplicationSourcePlugin{Parameters: v1alpha1.ApplicationSourcePluginParameters{
+			{Name: "team", String_: ptr.To("payments")},
+			{Name: "regions", OptionalArray: &v1alpha1.OptionalArray{Array: []string{"eu", "us"}}},
+		}}},
+	}}}
+	assert.Equal(t, map[string]any{
+		"env":      "prod",
+		"replicas": "1",
+		"team":     "payments",
+		"regions":  []string{"eu", "us"},
+	}, AppParams(app))
+}
+
+func TestEvaluateAppAnnotationLinks(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(annotations map[string]string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "guestbook",
+				Namespace:   "argocd",
+				Labels:      map[string]string{"team": "payments"},
+				Annotations: annotations,
+			},
+			Spec: v1alpha1.ApplicationSpec{
+				Source: &v1alpha1.ApplicationSource{Helm: &v1alpha1.ApplicationSourceHelm{
+					Parameters: []v1alpha1.HelmParameter{{Name: "env", Value: "prod"}},
+				}},
+			},
+		}
+	}
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec:       v1alpha1.AppProjectSpec{AllowedLinkURLs: []string{"https://grafana.example.com/*"}},
+	}
+	evaluate := func(t *testing.T, app *v1alpha1.Application, proj *v1alpha1.AppProject) ([]*application.LinkInfo, []string) {
+		t.Helper()
+		appObj, err := kube.ToUnstructured(app)
+		require.NoError(t, err)
+		obj := CreateDeepLinksObject(nil, appObj, nil, nil)
+		obj[ParamsDeepLinkKey] = AppParams(app)
+		return EvaluateAppAnnotationLinks(obj, app, proj)
+	}
+
+	t.Run("templated links", func(t *testing.T) {
+		t.Parallel()
+		links, errs := evaluate(t, newApp(map[string]string{
+			"link.argocd.argoproj.io/metrics":   "https://grafana.example.com/d/abc?var-team={{.app.metadata.labels.team}}",
+			"link.argocd.argoproj.io/dashboard": "Dashboard|https://grafana.example.com/d/{{.application.metadata.name}}?var-env={{.params.env}}",
+			"kubernetes.io/description":         "not a link",
+		}), proj)
+		assert.Empty(t, errs)
+		assert.Equal(t, []*application.LinkInfo{
+			{Title: ptr.To("Dashboard"), Url: ptr.To("https://grafana.example.com/d/guestbook?var-env=prod")},
+			{Title: ptr.To("metrics"), Url: ptr.To("https://grafana.example.com/d/abc?var-team=payments")},
+		}, links)
+	})
+
+	t.Run("missing value", func(t *testing.T) {
+		t.Parallel()
+		links, errs := evaluate(t, newApp(map[string]string{
+			"link.argocd.argoproj.io/logs": "https://grafana.example.com/logs?version={{.params.version}}",
+		}), proj)
+		assert.Empty(t, links)
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0], "failed to evaluate link template 'https://grafana.example.com/logs?version={{.params.version}}' of annotation link.argocd.argoproj.io/logs")
+		assert.Contains(t, errs[0], `map has no entry for key "version"`)
+	})
+
+	t.Run("links not permitted", func(t *testing.T) {
+		t.Parallel()
+		app := newApp(map[string]string{
+			"link.argocd.argoproj.io/evil":   "javascript:alert('{{.app.metadata.name}}')",
+			"link.argocd.argoproj.io/other":  "https://other.example.com/{{.app.metadata.name}}",
+			"link.argocd.argoproj.io/broken": "https://grafana.example.com/{{.app.metadata.name",
+		})
+		links, errs := evaluate(t, app, proj)
+		assert.Empty(t, links)
+		require.Len(t, errs, 3)
+		assert.Contains(t, errs[0], "failed to parse link template 'https://grafana.example.com/{{.app.metadata.name' of annotation link.argocd.argoproj.io/broken")
+		assert.Equal(t, "link 'javascript:alert('guestbook')' of annotation link.argocd.argoproj.io/evil of application guestbook is not permitted by project default", errs[1])
+		assert.Equal(t, "link 'https://other.example.com/guestbook' of annotation link.argocd.argoproj.io/other of application guestbook is not permitted by project default", errs[2])
+
+		links, errs = evaluate(t, app, &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+		assert.Equal(t, []*application.LinkInfo{{Title: ptr.To("other"), Url: ptr.To("https://other.example.com/guestbook")}}, links)
+		assert.Len(t, errs, 2)
+	})
+}
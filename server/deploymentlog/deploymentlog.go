@@ -0,0 +1,266 @@
+package deploymentlog
+
+import (
+	"cmp"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/security"
+)
+
+const (
+	// defaultLimit is the number of the deployments returned by a query without limit
+	defaultLimit = 100
+	// maxLimit bounds the number of the deployments returned by a query
+	maxLimit = 1000
+	// initiatorAutomated is the initiator of the deployments started by the application controller
+	initiatorAutomated = "automated"
+)
+
+// Deployment is a deployment of an application: either an entry of its revision history, which records a successful
+// sync, or its last sync operation if it did not succeed
+type Deployment struct {
+	// Application is the qualified name of the application, i.e. <namespace>/<name>
+	Application string `json:"application"`
+	// Project is the project of the application
+	Project string `json:"project"`
+	// ID is the ID of the revision history entry, absent for an unsuccessful sync
+	ID *int64 `json:"id,omitempty"`
+	// Revision is the revision deployed by a single source application
+	Revision string `json:"revision,omitempty"`
+	// Revisions are the revisions deployed by a multi-source application
+	Revisions []string `json:"revisions,omitempty"`
+	// StartedAt is the time the sync started, if known
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	// FinishedAt is the time the sync finished
+	FinishedAt time.Time `json:"finishedAt"`
+	// Initiator is the user who started the sync, or "automated" if the application controller started it
+	Initiator string `json:"initiator,omitempty"`
+	// Outcome is the phase of the sync: Succeeded, Failed or Error
+	Outcome synccommon.OperationPhase `json:"outcome"`
+	// Message is the message of an unsuccessful sync
+	Message string `json:"message,omitempty"`
+}
+
+// Response is a page of the deployments. Continue is the value of the continue query parameter of the next page, and
+// is empty on the last page.
+type Response struct {
+	Items    []Deployment `json:"items"`
+	Continue string       `json:"continue,omitempty"`
+}
+
+// query filters the deployments
+type query struct {
+	project     string
+	application string
+	since       time.Time
+	until       time.Time
+	revision    string
+	initiator   string
+	outcome     string
+	offset      int
+	limit       int
+}
+
+func (q *query) matches(deployment Deployment) bool {
+	return (q.project == "" || deployment.Project == q.project) &&
+		(q.since.IsZero() || !deployment.FinishedAt.Before(q.since)) &&
+		(q.until.IsZero() || deployment.FinishedAt.Before(q.until)) &&
+		(q.revision == "" || deployment.Revision == q.revision || slices.Contains(deployment.Revisions, q.revision)) &&
+		(q.initiator == "" || deployment.Initiator == q.initiator) &&
+		(q.outcome == "" || strings.EqualFold(string(deployment.Outcome), q.outcome))
+}
+
+// matchesApplication returns whether the given application is the one of the query, which is named either by its name
+// or by its qualified name
+func (q *query) matchesApplication(app *v1alpha1.Application) bool {
+	return q.application == "" || q.application == app.Name || q.application == app.QualifiedName()
+}
+
+// NewHandler creates handler serving the deployments of the applications, from their revision history
+func NewHandler(appLister applisters.ApplicationLister, namespace string, enabledNamespaces []string, enf *rbac.Enforcer) *Handler {
+	return &Handler{appLister: appLister, namespace: namespace, enabledNamespaces: enabledNamespaces, enf: enf}
+}
+
+// Handler serves the deployments of the applications the user is allowed to get, the most recent first. The
+// deployments are filtered by the project, application, since and until (RFC 3339), revision, initiator and outcome
+// query parameters, and paginated by the limit and continue query parameters.
+type Handler struct {
+	appLister         applisters.ApplicationLister
+	namespace         string
+	enabledNamespaces []string
+	enf               *rbac.Enforcer
+}
+
+// ServeHTTP serves the deployments as JSON
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	// the claims are set by the auth middleware
+	claims := r.Context().Value("claims")
+
+	q, errMsg := parseQuery(r)
+	if errMsg != "" {
+		http.Error(w, errMsg, http.StatusBadRequest)
+		return
+	}
+
+	apps, err := h.appLister.List(labels.Everything())
+	if err != nil {
+		log.Errorf("Failed to list applications: %v", err)
+		http.Error(w, "Failed to list applications", http.StatusInternalServerError)
+		return
+	}
+	var deployments []Deployment
+	for _, app := range apps {
+		if !security.IsNamespaceEnabled(app.Namespace, h.namespace, h.enabledNamespaces) || !q.matchesApplication(app) {
+			continue
+		}
+		if !h.enf.Enforce(claims, rbac.ResourceApplications, rbac.ActionGet, app.RBACName(h.namespace)) {
+			continue
+		}
+		for _, deployment := range getDeployments(app) {
+			if q.matches(deployment) {
+				deployments = append(deployments, deployment)
+			}
+		}
+	}
+	slices.SortStableFunc(deployments, func(a, b Deployment) int {
+		if c := b.FinishedAt.Compare(a.FinishedAt); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Application, b.Application); c != 0 {
+			return c
+		}
+		return compareIDs(b.ID, a.ID)
+	})
+
+	resp := Response{Items: []Deployment{}}
+	if q.offset < len(deployments) {
+		end := min(q.offset+q.limit, len(deployments))
+		resp.Items = deployments[q.offset:end]
+		if end < len(deployments) {
+			resp.Continue = strconv.Itoa(end)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Errorf("Failed to write deployments: %v", err)
+	}
+}
+
+// parseQuery parses the query parameters of the given request, and returns the error message of the invalid ones
+func parseQuery(r *http.Request) (*query, string) {
+	params := r.URL.Query()
+	q := &query{
+		project:     params.Get("project"),
+		application: params.Get("application"),
+		revision:    params.Get("revision"),
+		initiator:   params.Get("initiator"),
+		outcome:     params.Get("outcome"),
+		limit:       defaultLimit,
+	}
+	for name, t := range map[string]*time.Time{"since": &q.since, "until": &q.until} {
+		if value := params.Get(name); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, "Invalid " + name + " parameter, expected RFC 3339 time"
+			}
+			*t = parsed
+		}
+	}
+	if limit := params.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			return nil, "Invalid limit parameter"
+		}
+		q.limit = min(n, maxLimit)
+	}
+	if cont := params.Get("continue"); cont != "" {
+		n, err := strconv.Atoi(cont)
+		if err != nil || n < 0 {
+			return nil, "Invalid continue parameter"
+		}
+		q.offset = n
+	}
+	return q, ""
+}
+
+// getDeployments returns the deployments of the given application: the entries of its revision history, and its last
+// sync operation if it completed without succeeding
+func getDeployments(app *v1alpha1.Application) []Deployment {
+	deployments := make([]Deployment, 0, len(app.Status.History)+1)
+	for _, history := range app.Status.History {
+		deployment := Deployment{
+			Application: app.QualifiedName(),
+			Project:     app.Spec.GetProject(),
+			ID:          &history.ID,
+			Revision:    history.Revision,
+			Revisions:   history.Revisions,
+			FinishedAt:  history.DeployedAt.UTC(),
+			Initiator:   initiator(history.InitiatedBy),
+			Outcome:     synccommon.OperationSucceeded,
+		}
+		if history.DeployStartedAt != nil {
+			startedAt := history.DeployStartedAt.UTC()
+			deployment.StartedAt = &startedAt
+		}
+		deployments = append(deployments, deployment)
+	}
+	state := app.Status.OperationState
+	if state == nil || state.Operation.Sync == nil || !state.Phase.Completed() || state.Phase.Successful() || state.FinishedAt == nil {
+		return deployments
+	}
+	startedAt := state.StartedAt.UTC()
+	deployment := Deployment{
+		Application: app.QualifiedName(),
+		Project:     app.Spec.GetProject(),
+		Revision:    state.Operation.Sync.Revision,
+		Revisions:   state.Operation.Sync.Revisions,
+		StartedAt:   &startedAt,
+		FinishedAt:  state.FinishedAt.UTC(),
+		Initiator:   initiator(state.Operation.InitiatedBy),
+		Outcome:     state.Phase,
+		Message:     state.Message,
+	}
+	if result := state.SyncResult; result != nil {
+		deployment.Revision = result.Revision
+		deployment.Revisions = result.Revisions
+	}
+	return append(deployments, deployment)
+}
+
+func initiator(initiatedBy v1alpha1.OperationInitiator) string {
+	if initiatedBy.Automated {
+		return initiatorAutomated
+	}
+	return initiatedBy.Username
+}
+
+// compareIDs compares the given revision history IDs, an absent ID being the smallest
+func compareIDs(a, b *int64) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	return cmp.Compare(*a, *b)
+}
@@ -0,0 +1,189 @@
+package deploymentlog
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/session"
+)
+
+var now = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type fakeTokenVerifier struct{}
+
+func (fakeTokenVerifier) VerifyToken(token string) (jwt.Claims, string, error) {
+	if token != "valid" {
+		return nil, "", errors.New("invalid token")
+	}
+	return jwt.MapClaims{"sub": "alice"}, "", nil
+}
+
+func newEnforcer() *rbac.Enforcer {
+	enf := rbac.NewEnforcer(fake.NewClientset(), "argocd", common.ArgoCDRBACConfigMapName, nil)
+	// alice is only allowed to get the applications of the team project
+	enf.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...any) bool {
+		sub, _ := claims.(jwt.MapClaims)["sub"].(string)
+		return sub == "alice" && rvals[1] == rbac.ResourceApplications && rvals[2] == rbac.ActionGet && strings.HasPrefix(rvals[3].(string), "team/")
+	})
+	return enf
+}
+
+func newApp(name, namespace, project string, history ...v1alpha1.RevisionHistory) *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       v1alpha1.ApplicationSpec{Project: project},
+		Status:     v1alpha1.ApplicationStatus{History: history},
+	}
+}
+
+func newHistory(id int64, revision string, deployedAt time.Time, initiatedBy v1alpha1.OperationInitiator) v1alpha1.RevisionHistory {
+	return v1alpha1.RevisionHistory{ID: id, Revision: revision, DeployedAt: metav1.NewTime(deployedAt), InitiatedBy: initiatedBy}
+}
+
+func newLister(t *testing.T, apps ...*v1alpha1.Application) applisters.ApplicationLister {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, app := range apps {
+		require.NoError(t, indexer.Add(app))
+	}
+	return applisters.NewApplicationLister(indexer)
+}
+
+func serve(h http.Handler, target string, token string) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, target, http.NoBody)
+	if token != "" {
+		req.AddCookie(&http.Cookie{Name: common.AuthCookieName, Value: token})
+	}
+	h.ServeHTTP(rr, req)
+	return rr
+}
+
+func get(t *testing.T, h http.Handler, params string) Response {
+	t.Helper()
+	rr := serve(h, common.DeploymentLogEndpoint+params, "valid")
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var resp Response
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	return resp
+}
+
+func applications(resp Response) []string {
+	var apps []string
+	for _, item := range resp.Items {
+		apps = append(apps, item.Application)
+	}
+	return apps
+}
+
+func TestHandler(t *testing.T) {
+	alice := v1alpha1.OperationInitiator{Username: "alice"}
+	automated := v1alpha1.OperationInitiator{Automated: true}
+	failed := newApp("failed", "argocd", "team", newHistory(0, "aaa", now.Add(-4*time.Hour), alice))
+	failed.Status.OperationState = &v1alpha1.OperationState{
+		Operation:  v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "HEAD"}, InitiatedBy: automated},
+		Phase:      synccommon.OperationFailed,
+		Message:    "one or more objects failed to apply",
+		StartedAt:  metav1.NewTime(now.Add(-31 * time.Minute)),
+		FinishedAt: &metav1.Time{Time: now.Add(-30 * time.Minute)},
+		SyncResult: &v1alpha1.SyncOperationResult{Revision: "ccc"},
+	}
+	h := session.WithAuthMiddleware(false, fakeTokenVerifier{}, NewHandler(newLister(t,
+		newApp("guestbook", "argocd", "team",
+			newHistory(0, "aaa", now.Add(-3*time.Hour), alice),
+			newHistory(1, "bbb", now.Add(-time.Hour), automated),
+		),
+		failed,
+		newApp("other", "argocd", "other", newHistory(0, "aaa", now.Add(-2*time.Hour), alice)),
+		newApp("disabled", "apps", "team", newHistory(0, "aaa", now.Add(-2*time.Hour), alice)),
+	), "argocd", nil, newEnforcer()))
+
+	t.Run("Deployments of the allowed applications", func(t *testing.T) {
+		resp := get(t, h, "")
+		assert.Equal(t, []string{"argocd/failed", "argocd/guestbook", "argocd/guestbook", "argocd/failed"}, applications(resp))
+		assert.Empty(t, resp.Continue)
+
+		startedAt := now.Add(-31 * time.Minute)
+		assert.Equal(t, Deployment{
+			Application: "argocd/failed",
+			Project:     "team",
+			Revision:    "ccc",
+			StartedAt:   &startedAt,
+			FinishedAt:  now.Add(-30 * time.Minute),
+			Initiator:   "automated",
+			Outcome:     synccommon.OperationFailed,
+			Message:     "one or more objects failed to apply",
+		}, resp.Items[0])
+		id := int64(1)
+		assert.Equal(t, Deployment{
+			Application: "argocd/guestbook",
+			Project:     "team",
+			ID:          &id,
+			Revision:    "bbb",
+			FinishedAt:  now.Add(-time.Hour),
+			Initiator:   "automated",
+			Outcome:     synccommon.OperationSucceeded,
+		}, resp.Items[1])
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		assert.Equal(t, []string{"argocd/failed"}, applications(get(t, h, "?outcome=failed")))
+		assert.Equal(t, []string{"argocd/guestbook", "argocd/failed"}, applications(get(t, h, "?revision=aaa")))
+		assert.Equal(t, []string{"argocd/guestbook", "argocd/failed"}, applications(get(t, h, "?initiator=alice")))
+		assert.Equal(t, []string{"argocd/failed", "argocd/guestbook"}, applications(get(t, h, "?initiator=automated")))
+		assert.Equal(t, []string{"argocd/guestbook", "argocd/guestbook"}, applications(get(t, h, "?application=guestbook")))
+		assert.Equal(t, []string{"argocd/guestbook", "argocd/guestbook"}, applications(get(t, h, "?application=argocd/guestbook")))
+		assert.Equal(t, []string{"argocd/guestbook", "argocd/guestbook"}, applications(get(t, h, "?since=2025-01-01T08:30:00Z&until=2025-01-01T11:30:00Z")))
+		assert.Empty(t, get(t, h, "?project=other").Items)
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		resp := get(t, h, "?limit=3")
+		assert.Len(t, resp.Items, 3)
+		assert.Equal(t, "3", resp.Continue)
+
+		resp = get(t, h, "?limit=3&continue=3")
+		assert.Equal(t, []string{"argocd/failed"}, applications(resp))
+		assert.Empty(t, resp.Continue)
+
+		resp = get(t, h, "?continue=10")
+		assert.Empty(t, resp.Items)
+	})
+
+	t.Run("Invalid parameters", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, serve(h, common.DeploymentLogEndpoint+"?since=yesterday", "valid").Code)
+		assert.Equal(t, http.StatusBadRequest, serve(h, common.DeploymentLogEndpoint+"?until=today", "valid").Code)
+		assert.Equal(t, http.StatusBadRequest, serve(h, common.DeploymentLogEndpoint+"?limit=0", "valid").Code)
+		assert.Equal(t, http.StatusBadRequest, serve(h, common.DeploymentLogEndpoint+"?continue=-1", "valid").Code)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, serve(h, common.DeploymentLogEndpoint, "").Code)
+		assert.Equal(t, http.StatusUnauthorized, serve(h, common.DeploymentLogEndpoint, "invalid").Code)
+	})
+
+	t.Run("Method not allowed", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, common.DeploymentLogEndpoint, http.NoBody)
+		req.AddCookie(&http.Cookie{Name: common.AuthCookieName, Value: "valid"})
+		h.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
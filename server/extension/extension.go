@@ -460,6 +460,13 @@ func parseAndValidateConfig(s *settings.ArgoCDSettings) (*ExtensionConfigs, erro
 	if err != nil {
 		return nil, fmt.Errorf("validation error: %w", err)
 	}
+	for _, ext := range configs.Extensions {
+		for _, svc := range ext.Backend.Services {
+			if err := s.OutboundURLPolicy.ValidateURL(svc.URL); err != nil {
+				return nil, fmt.Errorf("validation error: extension %s: %w", ext.Name, err)
+			}
+		}
+	}
 	return &configs, nil
 }
 
@@ -510,14 +517,15 @@ func validateConfigs(configs *ExtensionConfigs) error {
 
 // NewProxy will instantiate a new reverse proxy based on the provided
 // targetURL and config. It will remove sensitive information from the
-// incoming request such as the Authorization and Cookie headers.
-func NewProxy(targetURL string, headers []Header, config ProxyConfig) (*httputil.ReverseProxy, error) {
+// incoming request such as the Authorization and Cookie headers. The
+// connections are restricted by the given outbound URL policy.
+func NewProxy(targetURL string, headers []Header, config ProxyConfig, outboundURLPolicy *security.OutboundURLPolicy) (*httputil.ReverseProxy, error) {
 	url, err := url.Parse(targetURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse proxy URL: %w", err)
 	}
 	proxy := &httputil.ReverseProxy{
-		Transport: newTransport(config),
+		Transport: outboundURLPolicy.WrapTransport(newTransport(config)),
 		Director: func(req *http.Request) {
 			req.Host = url.Host
 			req.URL.Scheme = url.Scheme
@@ -596,7 +604,7 @@ func (m *Manager) UpdateExtensionRegistry(s *settings.ArgoCDSettings) error {
 		proxyReg := NewProxyRegistry()
 		singleBackend := len(ext.Backend.Services) == 1
 		for _, service := range ext.Backend.Services {
-			proxy, err := NewProxy(service.URL, service.Headers, ext.Backend.ProxyConfig)
+			proxy, err := NewProxy(service.URL, service.Headers, ext.Backend.ProxyConfig, s.OutboundURLPolicy)
 			if err != nil {
 				return fmt.Errorf("error creating proxy: %w", err)
 			}
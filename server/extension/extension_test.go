@@ -22,6 +22,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/extension"
 	"github.com/argoproj/argo-cd/v3/server/extension/mocks"
 	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+	"github.com/argoproj/argo-cd/v3/util/security"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
@@ -230,6 +231,26 @@ func TestRegisterExtensions(t *testing.T) {
 			})
 		}
 	})
+	t.Run("will return error if backend URL is not allowed", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup()
+		outboundURLPolicy, err := security.NewOutboundURLPolicy([]string{"*.example.com"}, nil, false)
+		require.NoError(t, err)
+		settings := &settings.ArgoCDSettings{
+			ExtensionConfig: map[string]string{
+				"another-ext": getSingleExtensionConfigString(),
+			},
+			OutboundURLPolicy: outboundURLPolicy,
+		}
+		f.settingsGetterMock.On("Get", mock.Anything).Return(settings, nil)
+
+		// when
+		err = f.manager.RegisterExtensions()
+
+		// then
+		require.ErrorContains(t, err, "host localhost is not allowed")
+	})
 }
 
 func TestCallExtension(t *testing.T) {
@@ -684,7 +705,7 @@ func TestCallExtension(t *testing.T) {
 		f.appGetterMock.On("Get", "ns1", "app1").Return(getApp(maliciousName, destinationServer, defaultProjectName), nil)
 
 		withRbac(f, true, true)
-		withExtensionConfig(getExtensionConfigWith2Backends(extName, "url1", "cluster1Name", "cluster1URL", "url2", "cluster2Name", "cluster2URL"), f)
+		withExtensionConfig(getExtensionConfigWith2Backends(extName, "http://url1", "cluster1Name", "cluster1URL", "http://url2", "cluster2Name", "cluster2URL"), f)
 		withProject(getProjectWithDestinations("project-name", nil, []string{"srv1", destinationServer}), f)
 		withMetrics(f)
 		withUser(f, "some-user", []string{"group1", "group2"})
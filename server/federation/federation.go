@@ -0,0 +1,237 @@
+package federation
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/security"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// peerTimeout bounds the time to list the applications of a peer
+	peerTimeout = 10 * time.Second
+	// peerCacheExpiration is the duration the applications of a peer are cached for, so that the users refreshing the
+	// view do not each list the applications of all the peers
+	peerCacheExpiration = 10 * time.Second
+)
+
+// applicationFields are the fields of the applications listed by the peers, which are not asked for their
+// resources, history and conditions
+var applicationFields = []string{
+	"items.metadata.name",
+	"items.metadata.namespace",
+	"items.spec",
+	"items.status.sync.status",
+	"items.status.health",
+}
+
+// Application is an application of a peer instance
+type Application struct {
+	// Instance is the name of the peer instance of the application
+	Instance     string `json:"instance"`
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace"`
+	Project      string `json:"project"`
+	SyncStatus   string `json:"syncStatus"`
+	HealthStatus string `json:"healthStatus"`
+	// URL is the URL of the application in the UI of the peer instance, where the actions are performed
+	URL string `json:"url"`
+}
+
+// Instance is the status of a peer instance
+type Instance struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Error is the error listing the applications of the instance, if any
+	Error string `json:"error,omitempty"`
+}
+
+// ApplicationList is the federated view of the applications of the peer instances
+type ApplicationList struct {
+	Items     []Application `json:"items"`
+	Instances []Instance    `json:"instances"`
+}
+
+// SettingsGetter returns the settings of the federation
+type SettingsGetter interface {
+	GetFederationPeers() ([]settings.FederationPeer, error)
+	GetSettings() (*settings.ArgoCDSettings, error)
+}
+
+// NewHandler creates handler serving the read-only view of the applications of the peer Argo CD instances
+func NewHandler(settingsGetter SettingsGetter, enf *rbac.Enforcer, namespace string) *Handler {
+	return &Handler{settingsGetter: settingsGetter, enf: enf, namespace: namespace, cache: gocache.New(peerCacheExpiration, time.Minute)}
+}
+
+// Handler serves the applications of the peer instances the user is allowed to get. The applications are filtered by
+// the instance and project query parameters.
+type Handler struct {
+	settingsGetter SettingsGetter
+	enf            *rbac.Enforcer
+	// namespace is the namespace of the control plane, in which the applications are named without their namespace
+	// by the RBAC policies, as for the local applications
+	namespace string
+	// cache holds the applications of the peers, which are the same for all the users
+	cache *gocache.Cache
+}
+
+// ServeHTTP serves the applications of the peer instances as JSON
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	// the claims are set by the auth middleware
+	claims := r.Context().Value("claims")
+	peers, err := h.settingsGetter.GetFederationPeers()
+	if err != nil {
+		log.Errorf("Failed to get federation peers: %v", err)
+		http.Error(w, "Failed to get federation peers", http.StatusInternalServerError)
+		return
+	}
+	argoCDSettings, err := h.settingsGetter.GetSettings()
+	if err != nil {
+		log.Errorf("Failed to get settings: %v", err)
+		http.Error(w, "Failed to get settings", http.StatusInternalServerError)
+		return
+	}
+
+	params := r.URL.Query()
+	instances := params["instance"]
+	projects := params["project"]
+	peers = slices.DeleteFunc(peers, func(peer settings.FederationPeer) bool {
+		if len(instances) > 0 && !slices.Contains(instances, peer.Name) {
+			return true
+		}
+		return !h.enf.Enforce(claims, rbac.ResourceFederation, rbac.ActionGet, peer.Name)
+	})
+
+	list := ApplicationList{Items: []Application{}, Instances: make([]Instance, len(peers))}
+	apps := make([][]Application, len(peers))
+	var wg sync.WaitGroup
+	for i, peer := range peers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			list.Instances[i] = Instance{Name: peer.Name, URL: peer.URL}
+			peerApps, err := h.listApplications(r.Context(), peer, argoCDSettings.OutboundURLPolicy)
+			if err != nil {
+				log.Warnf("Failed to list the applications of federation peer %s: %v", peer.Name, err)
+				list.Instances[i].Error = err.Error()
+				return
+			}
+			apps[i] = peerApps
+		}()
+	}
+	wg.Wait()
+	for _, peerApps := range apps {
+		for _, app := range peerApps {
+			if len(projects) > 0 && !slices.Contains(projects, app.Project) {
+				continue
+			}
+			// the applications of a peer are subject to the permissions of the user on their project, as if they
+			// were local applications
+			if !h.enf.Enforce(claims, rbac.ResourceApplications, rbac.ActionGet, security.RBACName(h.namespace, app.Project, app.Namespace, app.Name)) {
+				continue
+			}
+			list.Items = append(list.Items, app)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		log.Errorf("Failed to write federated applications: %v", err)
+	}
+}
+
+// listApplications returns the applications of the peer, from the cache if they were listed recently
+func (h *Handler) listApplications(ctx context.Context, peer settings.FederationPeer, policy *security.OutboundURLPolicy) ([]Application, error) {
+	key := peer.Name + "|" + peer.URL
+	if apps, ok := h.cache.Get(key); ok {
+		return apps.([]Application), nil
+	}
+	apps, err := listApplications(ctx, peer, policy)
+	if err != nil {
+		return nil, err
+	}
+	h.cache.SetDefault(key, apps)
+	return apps, nil
+}
+
+// listApplications lists the applications of the peer with its API
+func listApplications(ctx context.Context, peer settings.FederationPeer, policy *security.OutboundURLPolicy) ([]Application, error) {
+	client, err := newClient(peer, policy)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, peerTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, peer.URL+"/api/v1/applications?fields="+url.QueryEscape(strings.Join(applicationFields, ",")), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+peer.Token)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer utilio.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	var appList v1alpha1.ApplicationList
+	if err := json.NewDecoder(resp.Body).Decode(&appList); err != nil {
+		return nil, fmt.Errorf("error decoding applications: %w", err)
+	}
+	apps := make([]Application, 0, len(appList.Items))
+	for _, app := range appList.Items {
+		apps = append(apps, Application{
+			Instance:     peer.Name,
+			Name:         app.Name,
+			Namespace:    app.Namespace,
+			Project:      app.Spec.GetProject(),
+			SyncStatus:   string(app.Status.Sync.Status),
+			HealthStatus: string(app.Status.Health.Status),
+			URL:          fmt.Sprintf("%s/applications/%s/%s", peer.URL, url.PathEscape(app.Namespace), url.PathEscape(app.Name)),
+		})
+	}
+	return apps, nil
+}
+
+func newClient(peer settings.FederationPeer, policy *security.OutboundURLPolicy) (*http.Client, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: peer.Insecure}
+	if peer.RootCA != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(peer.RootCA)) {
+			return nil, errors.New("invalid root CA")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{
+		Transport: policy.WrapTransport(transport),
+		// the peer tokens must not be forwarded to other hosts
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}, nil
+}
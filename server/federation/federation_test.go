@@ -0,0 +1,175 @@
+package federation
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/session"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+type fakeSettingsGetter struct {
+	peers []settings.FederationPeer
+}
+
+func (g *fakeSettingsGetter) GetFederationPeers() ([]settings.FederationPeer, error) {
+	return g.peers, nil
+}
+
+func (g *fakeSettingsGetter) GetSettings() (*settings.ArgoCDSettings, error) {
+	return &settings.ArgoCDSettings{}, nil
+}
+
+type fakeTokenVerifier struct{}
+
+func (fakeTokenVerifier) VerifyToken(token string) (jwt.Claims, string, error) {
+	if token != "valid" {
+		return nil, "", errors.New("invalid token")
+	}
+	return jwt.MapClaims{"sub": "alice"}, "", nil
+}
+
+func newEnforcer() *rbac.Enforcer {
+	enf := rbac.NewEnforcer(fake.NewClientset(), "argocd", common.ArgoCDRBACConfigMapName, nil)
+	// alice is only allowed to get the applications of the eu-west and broken peers, outside the finance project
+	enf.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...any) bool {
+		sub, _ := claims.(jwt.MapClaims)["sub"].(string)
+		if sub != "alice" || rvals[2] != rbac.ActionGet {
+			return false
+		}
+		switch rvals[1] {
+		case rbac.ResourceFederation:
+			return rvals[3] != "us-east"
+		case rbac.ResourceApplications:
+			return !strings.HasPrefix(rvals[3].(string), "finance/")
+		}
+		return false
+	})
+	return enf
+}
+
+type fakePeer struct {
+	*httptest.Server
+	requests atomic.Int32
+}
+
+func newPeer(t *testing.T, token string, apps ...v1alpha1.Application) *fakePeer {
+	t.Helper()
+	peer := &fakePeer{}
+	peer.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		peer.requests.Add(1)
+		if r.URL.Path != "/api/v1/applications" || r.Header.Get("Authorization") != "Bearer "+token {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("fields") != strings.Join(applicationFields, ",") {
+			http.Error(w, "Unexpected fields", http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(v1alpha1.ApplicationList{Items: apps})
+	}))
+	t.Cleanup(peer.Close)
+	return peer
+}
+
+func newApp(name, project string) v1alpha1.Application {
+	return v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+		Spec:       v1alpha1.ApplicationSpec{Project: project},
+		Status: v1alpha1.ApplicationStatus{
+			Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced},
+			Health: v1alpha1.AppHealthStatus{Status: "Healthy"},
+		},
+	}
+}
+
+func TestHandler(t *testing.T) {
+	euWest := newPeer(t, "eu-west-token", newApp("guestbook", "default"), newApp("billing", "team"), newApp("payroll", "finance"))
+	usEast := newPeer(t, "us-east-token", newApp("guestbook", "default"))
+	broken := newPeer(t, "broken-token")
+	settingsGetter := &fakeSettingsGetter{peers: []settings.FederationPeer{
+		{Name: "eu-west", URL: euWest.URL, Token: "eu-west-token"},
+		{Name: "us-east", URL: usEast.URL, Token: "us-east-token"},
+		{Name: "broken", URL: broken.URL, Token: "wrong-token"},
+	}}
+	handler := session.WithAuthMiddleware(false, fakeTokenVerifier{}, NewHandler(settingsGetter, newEnforcer(), "argocd"))
+
+	get := func(t *testing.T, query, token string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, common.FederationApplicationsEndpoint+query, http.NoBody)
+		if token != "" {
+			req.AddCookie(&http.Cookie{Name: common.AuthCookieName, Value: token})
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, get(t, "", "").Code)
+		assert.Equal(t, http.StatusUnauthorized, get(t, "", "invalid").Code)
+	})
+
+	t.Run("Allowed peers", func(t *testing.T) {
+		rr := get(t, "", "valid")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var list ApplicationList
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		assert.Equal(t, []Application{
+			{Instance: "eu-west", Name: "guestbook", Namespace: "argocd", Project: "default", SyncStatus: "Synced", HealthStatus: "Healthy", URL: euWest.URL + "/applications/argocd/guestbook"},
+			{Instance: "eu-west", Name: "billing", Namespace: "argocd", Project: "team", SyncStatus: "Synced", HealthStatus: "Healthy", URL: euWest.URL + "/applications/argocd/billing"},
+		}, list.Items)
+		require.Len(t, list.Instances, 2)
+		assert.Equal(t, Instance{Name: "eu-west", URL: euWest.URL}, list.Instances[0])
+		assert.Equal(t, "broken", list.Instances[1].Name)
+		assert.Contains(t, list.Instances[1].Error, "401")
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		rr := get(t, "?instance=eu-west&project=team", "valid")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var list ApplicationList
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "billing", list.Items[0].Name)
+		assert.Len(t, list.Instances, 1)
+	})
+
+	t.Run("Applications of forbidden projects", func(t *testing.T) {
+		rr := get(t, "?project=finance", "valid")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var list ApplicationList
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		assert.Empty(t, list.Items)
+	})
+
+	t.Run("Cached applications", func(t *testing.T) {
+		requests := euWest.requests.Load()
+		require.Equal(t, http.StatusOK, get(t, "?instance=eu-west", "valid").Code)
+		require.Equal(t, http.StatusOK, get(t, "?instance=eu-west&project=team", "valid").Code)
+		assert.Equal(t, requests, euWest.requests.Load(), "the applications listed by the previous requests are cached")
+		assert.Zero(t, usEast.requests.Load(), "the applications of the forbidden peers are not listed")
+	})
+
+	t.Run("Method not allowed", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, common.FederationApplicationsEndpoint, http.NoBody)
+		req.AddCookie(&http.Cookie{Name: common.AuthCookieName, Value: "valid"})
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
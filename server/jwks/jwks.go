@@ -0,0 +1,58 @@
+package jwks
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// NewHandler creates handler serving the .well-known/jwks.json endpoint
+func NewHandler(settingsMgr *settings.SettingsManager) *Handler {
+	return &Handler{settingsMgr: settingsMgr, now: time.Now}
+}
+
+// Handler publishes the public keys of the trusted token signing keys as a JSON Web Key Set, so that external
+// systems can verify the tokens issued by Argo CD. The key set is empty unless the signing keys are rotated, since
+// the tokens are otherwise signed with the symmetric server signature.
+type Handler struct {
+	settingsMgr *settings.SettingsManager
+	now         func() time.Time
+}
+
+// ServeHTTP serves the JSON Web Key Set
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	argoCDSettings, err := h.settingsMgr.GetSettings()
+	if err != nil {
+		log.Errorf("Failed to retrieve settings: %v", err)
+		http.Error(w, "Failed to retrieve settings", http.StatusInternalServerError)
+		return
+	}
+
+	keySet := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{}}
+	if argoCDSettings.SigningKeyRotationPeriod > 0 {
+		for _, key := range argoCDSettings.TrustedSigningKeys(h.now()) {
+			keySet.Keys = append(keySet.Keys, jose.JSONWebKey{
+				Key:       &key.PrivateKey.PublicKey,
+				KeyID:     key.KeyID,
+				Algorithm: string(jose.ES256),
+				Use:       "sig",
+			})
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	// consumers refresh the key set well within the delay after which a newly published key is used
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	if err := json.NewEncoder(w).Encode(keySet); err != nil {
+		log.Errorf("Failed to write JSON Web Key Set: %v", err)
+	}
+}
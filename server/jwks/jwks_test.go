@@ -0,0 +1,69 @@
+package jwks
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func newSettingsManager(t *testing.T, data map[string]string) *settings.SettingsManager {
+	t.Helper()
+	kubeClient := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: "argocd",
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: data,
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "argocd"},
+		Data:       map[string][]byte{"server.secretkey": []byte("test")},
+	})
+	return settings.NewSettingsManager(t.Context(), kubeClient, "argocd")
+}
+
+func getKeySet(t *testing.T, h *Handler) jose.JSONWebKeySet {
+	t.Helper()
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", http.NoBody))
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	var keySet jose.JSONWebKeySet
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &keySet))
+	return keySet
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("Signing keys not rotated", func(t *testing.T) {
+		h := NewHandler(newSettingsManager(t, nil))
+		assert.Empty(t, getKeySet(t, h).Keys)
+	})
+
+	t.Run("Signing keys rotated", func(t *testing.T) {
+		settingsMgr := newSettingsManager(t, map[string]string{"server.signingkeys.rotation.period": "24h"})
+		require.NoError(t, settingsMgr.RotateSigningKeys(time.Now()))
+		argoCDSettings, err := settingsMgr.GetSettings()
+		require.NoError(t, err)
+		require.Len(t, argoCDSettings.SigningKeys, 1)
+
+		keySet := getKeySet(t, NewHandler(settingsMgr))
+		require.Len(t, keySet.Keys, 1)
+		key := keySet.Keys[0]
+		assert.Equal(t, argoCDSettings.SigningKeys[0].KeyID, key.KeyID)
+		assert.Equal(t, "ES256", key.Algorithm)
+		assert.Equal(t, "sig", key.Use)
+		assert.True(t, key.IsPublic())
+		assert.True(t, argoCDSettings.SigningKeys[0].PrivateKey.PublicKey.Equal(key.Key))
+	})
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+
+	tlsutil "github.com/argoproj/argo-cd/v3/util/tls"
+)
+
+// Schemes of the additional listener addresses
+const (
+	listenerSchemeUnix = "unix"
+	listenerSchemeTCP  = "tcp"
+	listenerSchemeTLS  = "tls"
+)
+
+// AdditionalListener is an address the API server serves its gRPC, gRPC-Web and REST APIs on, in addition to its main
+// port. It lets sidecars and local clients reach the API over a unix domain socket, or over a TCP port with TLS
+// settings differing from the main port.
+type AdditionalListener struct {
+	// Network is the network of the listener, either tcp or unix
+	Network string
+	// Address is the host and port of a TCP listener, or the path of the socket of a unix listener
+	Address string
+	// TLS indicates whether the connections are secured with the TLS certificate of the main port
+	TLS bool
+	// TLSConfigCustomizer customizes the TLS settings of the listener. The TLS settings of the main port are used
+	// when it is nil.
+	TLSConfigCustomizer tlsutil.ConfigCustomizer
+}
+
+func (l *AdditionalListener) String() string {
+	if l.Network == listenerSchemeUnix {
+		return listenerSchemeUnix + "://" + l.Address
+	}
+	if l.TLS {
+		return listenerSchemeTLS + "://" + l.Address
+	}
+	return listenerSchemeTCP + "://" + l.Address
+}
+
+// ParseAdditionalListener parses an additional listener address, in one of the following forms:
+//
+//	unix:///path/to/socket                                       plaintext unix domain socket
+//	tcp://host:port                                              plaintext TCP port
+//	tls://host:port?minversion=1.3&maxversion=1.3&ciphers=a:b    TCP port secured with TLS
+//
+// The TLS settings of a tls:// listener default to those of the main port when none is specified, and otherwise
+// default to the default TLS settings.
+func ParseAdditionalListener(spec string) (*AdditionalListener, error) {
+	u, err := url.Parse(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid listener %q: %w", spec, err)
+	}
+	query := u.Query()
+	if u.Scheme != listenerSchemeTLS && len(query) > 0 {
+		return nil, fmt.Errorf("invalid listener %q: only tls listeners accept settings", spec)
+	}
+	switch u.Scheme {
+	case listenerSchemeUnix:
+		if u.Host != "" || u.Path == "" {
+			return nil, fmt.Errorf("invalid listener %q: expected unix:///path/to/socket", spec)
+		}
+		return &AdditionalListener{Network: listenerSchemeUnix, Address: u.Path}, nil
+	case listenerSchemeTCP, listenerSchemeTLS:
+		if _, _, err := net.SplitHostPort(u.Host); err != nil || u.Path != "" {
+			return nil, fmt.Errorf("invalid listener %q: expected %s://host:port", spec, u.Scheme)
+		}
+	default:
+		return nil, fmt.Errorf("invalid listener %q: unsupported scheme %q, expected one of unix, tcp or tls", spec, u.Scheme)
+	}
+
+	listener := &AdditionalListener{Network: listenerSchemeTCP, Address: u.Host, TLS: u.Scheme == listenerSchemeTLS}
+	if len(query) == 0 {
+		return listener, nil
+	}
+	settings := map[string]string{
+		"minversion": tlsutil.DefaultTLSMinVersion,
+		"maxversion": tlsutil.DefaultTLSMaxVersion,
+		"ciphers":    tlsutil.DefaultTLSCipherSuite,
+	}
+	for key := range query {
+		if _, ok := settings[key]; !ok {
+			return nil, fmt.Errorf("invalid listener %q: unknown setting %q", spec, key)
+		}
+		settings[key] = query.Get(key)
+	}
+	listener.TLSConfigCustomizer, err = tlsutil.NewConfigCustomizer(settings["minversion"], settings["maxversion"], settings["ciphers"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid listener %q: %w", spec, err)
+	}
+	return listener, nil
+}
+
+// ParseAdditionalListeners parses the given additional listener addresses
+func ParseAdditionalListeners(specs []string) ([]*AdditionalListener, error) {
+	listeners := make([]*AdditionalListener, 0, len(specs))
+	for _, spec := range specs {
+		listener, err := ParseAdditionalListener(spec)
+		if err != nil {
+			return nil, err
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners, nil
+}
+
+// startAdditionalListener starts listening on the address of the given additional listener
+func startAdditionalListener(l *AdditionalListener) (net.Listener, error) {
+	if l.Network == listenerSchemeUnix {
+		// the socket file is removed when the listener is closed, but may be left behind by a crashed server
+		info, err := os.Lstat(l.Address)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to stat socket %s: %w", l.Address, err)
+		}
+		if err == nil {
+			if info.Mode()&os.ModeSocket == 0 {
+				return nil, fmt.Errorf("cannot listen on %s: file exists and is not a socket", l.Address)
+			}
+			if err := os.Remove(l.Address); err != nil {
+				return nil, fmt.Errorf("failed to remove stale socket %s: %w", l.Address, err)
+			}
+		}
+	}
+	return startNetworkListener(l.Network, l.Address)
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"crypto/tls"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAdditionalListener(t *testing.T) {
+	t.Run("Unix socket", func(t *testing.T) {
+		l, err := ParseAdditionalListener("unix:///var/run/argocd/server.sock")
+		require.NoError(t, err)
+		assert.Equal(t, &AdditionalListener{Network: "unix", Address: "/var/run/argocd/server.sock"}, l)
+		assert.Equal(t, "unix:///var/run/argocd/server.sock", l.String())
+	})
+
+	t.Run("Plaintext TCP port", func(t *testing.T) {
+		l, err := ParseAdditionalListener("tcp://127.0.0.1:8081")
+		require.NoError(t, err)
+		assert.Equal(t, &AdditionalListener{Network: "tcp", Address: "127.0.0.1:8081"}, l)
+		assert.Equal(t, "tcp://127.0.0.1:8081", l.String())
+	})
+
+	t.Run("TLS port with the TLS settings of the main port", func(t *testing.T) {
+		l, err := ParseAdditionalListener("tls://:8443")
+		require.NoError(t, err)
+		assert.Equal(t, &AdditionalListener{Network: "tcp", Address: ":8443", TLS: true}, l)
+		assert.Equal(t, "tls://:8443", l.String())
+	})
+
+	t.Run("TLS port with its own TLS settings", func(t *testing.T) {
+		l, err := ParseAdditionalListener("tls://0.0.0.0:8443?minversion=1.3")
+		require.NoError(t, err)
+		assert.True(t, l.TLS)
+		require.NotNil(t, l.TLSConfigCustomizer)
+		config := &tls.Config{}
+		l.TLSConfigCustomizer(config)
+		assert.Equal(t, uint16(tls.VersionTLS13), config.MinVersion)
+		assert.Equal(t, uint16(tls.VersionTLS13), config.MaxVersion)
+	})
+
+	for _, spec := range []string{
+		"http://127.0.0.1:8081",
+		"tcp://127.0.0.1",
+		"tcp://127.0.0.1:8081/path",
+		"tcp://127.0.0.1:8081?minversion=1.3",
+		"tls://127.0.0.1:8443?minversion=1.4",
+		"tls://127.0.0.1:8443?version=1.3",
+		"tls://127.0.0.1:8443?ciphers=list",
+		"unix://relative.sock",
+		"unix://",
+	} {
+		t.Run("Invalid "+spec, func(t *testing.T) {
+			_, err := ParseAdditionalListener(spec)
+			assert.ErrorContains(t, err, "invalid listener")
+		})
+	}
+}
+
+func TestStartAdditionalListener_Unix(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "server.sock")
+	l := &AdditionalListener{Network: "unix", Address: socket}
+
+	// a socket left behind by a previous server is replaced
+	stale, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+	ln, err := startAdditionalListener(l)
+	require.NoError(t, err)
+	require.NoError(t, ln.Close())
+	_, err = os.Stat(socket)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// other files are not
+	require.NoError(t, os.WriteFile(socket, []byte("data"), 0o600))
+	_, err = startAdditionalListener(l)
+	require.ErrorContains(t, err, "is not a socket")
+}
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/metrics/kubectl"
 	"github.com/argoproj/argo-cd/v3/util/profile"
 )
@@ -21,8 +23,23 @@ type MetricsServer struct {
 	extensionRequestCounter  *prometheus.CounterVec
 	extensionRequestDuration *prometheus.HistogramVec
 	loginRequestCounter      *prometheus.CounterVec
+	loginFailureCounter      *prometheus.CounterVec
+	tokenFailureCounter      *prometheus.CounterVec
+	tokenAnomalyCounter      *prometheus.CounterVec
+	repoConnectionStatus     *prometheus.GaugeVec
+	repoConnectionLatency    *prometheus.GaugeVec
+	repoConnectionFailures   *prometheus.GaugeVec
+	loginFailureSourcesLock  sync.Mutex
+	loginFailureSources      map[string]bool
 }
 
+const (
+	// maxLoginFailureSources is the maximum number of sources the failed logins are counted by. The failed logins from
+	// the other sources are counted with the "other" source.
+	maxLoginFailureSources  = 1000
+	otherLoginFailureSource = "other"
+)
+
 var (
 	redisRequestCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -61,6 +78,48 @@ var (
 		},
 		[]string{"status"},
 	)
+	loginFailureCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_login_failures_total",
+			Help: "Number of failed login requests to the Argo CD API server, by client IP.",
+		},
+		[]string{"source"},
+	)
+	tokenFailureCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_token_verification_failures_total",
+			Help: "Number of tokens which failed to be verified by the Argo CD API server.",
+		},
+		[]string{"issuer", "reason"},
+	)
+	tokenAnomalyCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_token_usage_anomalies_total",
+			Help: "Number of tokens used from a new client IP or user agent.",
+		},
+		[]string{"anomaly"},
+	)
+	repoConnectionStatus = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_repo_connection_status",
+			Help: "Connection status of the repositories probed by the Argo CD API server, 1 if the last connection succeeded and 0 otherwise.",
+		},
+		[]string{"repo", "project"},
+	)
+	repoConnectionLatency = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_repo_connection_latency_seconds",
+			Help: "Duration in seconds of the last connection to the repositories probed by the Argo CD API server.",
+		},
+		[]string{"repo", "project"},
+	)
+	repoConnectionFailures = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_repo_connection_consecutive_failures",
+			Help: "Number of the consecutive failed connections to the repositories probed by the Argo CD API server.",
+		},
+		[]string{"repo", "project"},
+	)
 	argoVersion = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "argocd_info",
@@ -87,6 +146,12 @@ func NewMetricsServer(host string, port int) *MetricsServer {
 	registry.MustRegister(extensionRequestCounter)
 	registry.MustRegister(extensionRequestDuration)
 	registry.MustRegister(loginRequestCounter)
+	registry.MustRegister(loginFailureCounter)
+	registry.MustRegister(tokenFailureCounter)
+	registry.MustRegister(tokenAnomalyCounter)
+	registry.MustRegister(repoConnectionStatus)
+	registry.MustRegister(repoConnectionLatency)
+	registry.MustRegister(repoConnectionFailures)
 	registry.MustRegister(argoVersion)
 
 	kubectl.RegisterWithClientGo()
@@ -102,6 +167,13 @@ func NewMetricsServer(host string, port int) *MetricsServer {
 		extensionRequestCounter:  extensionRequestCounter,
 		extensionRequestDuration: extensionRequestDuration,
 		loginRequestCounter:      loginRequestCounter,
+		loginFailureCounter:      loginFailureCounter,
+		tokenFailureCounter:      tokenFailureCounter,
+		tokenAnomalyCounter:      tokenAnomalyCounter,
+		repoConnectionStatus:     repoConnectionStatus,
+		repoConnectionLatency:    repoConnectionLatency,
+		repoConnectionFailures:   repoConnectionFailures,
+		loginFailureSources:      map[string]bool{},
 	}
 }
 
@@ -127,3 +199,45 @@ func (m *MetricsServer) ObserveExtensionRequestDuration(extension string, durati
 func (m *MetricsServer) IncLoginRequestCounter(status string) {
 	m.loginRequestCounter.WithLabelValues(status).Inc()
 }
+
+// IncLoginFailureCounter increments the failed login counter of the given source, the IP of the client
+func (m *MetricsServer) IncLoginFailureCounter(source string) {
+	m.loginFailureSourcesLock.Lock()
+	if !m.loginFailureSources[source] {
+		if len(m.loginFailureSources) < maxLoginFailureSources {
+			m.loginFailureSources[source] = true
+		} else {
+			source = otherLoginFailureSource
+		}
+	}
+	m.loginFailureSourcesLock.Unlock()
+	m.loginFailureCounter.WithLabelValues(source).Inc()
+}
+
+// IncTokenVerificationFailureCounter increments the token verification failure counter of the given issuer and reason
+func (m *MetricsServer) IncTokenVerificationFailureCounter(issuer string, reason string) {
+	m.tokenFailureCounter.WithLabelValues(issuer, reason).Inc()
+}
+
+// IncTokenUsageAnomalyCounter increments the token usage anomaly counter of the given anomaly
+func (m *MetricsServer) IncTokenUsageAnomalyCounter(anomaly string) {
+	m.tokenAnomalyCounter.WithLabelValues(anomaly).Inc()
+}
+
+// SetRepoConnectionState records the given connection state of the given repository
+func (m *MetricsServer) SetRepoConnectionState(repo string, project string, state v1alpha1.ConnectionState) {
+	status := 0.0
+	if state.Status == v1alpha1.ConnectionStatusSuccessful {
+		status = 1
+	}
+	m.repoConnectionStatus.WithLabelValues(repo, project).Set(status)
+	m.repoConnectionLatency.WithLabelValues(repo, project).Set((time.Duration(state.LatencyMs) * time.Millisecond).Seconds())
+	m.repoConnectionFailures.WithLabelValues(repo, project).Set(float64(state.ConsecutiveFailures))
+}
+
+// DeleteRepoConnectionState removes the connection state of the given repository, which is no longer configured
+func (m *MetricsServer) DeleteRepoConnectionState(repo string, project string) {
+	m.repoConnectionStatus.DeleteLabelValues(repo, project)
+	m.repoConnectionLatency.DeleteLabelValues(repo, project)
+	m.repoConnectionFailures.DeleteLabelValues(repo, project)
+}
@@ -0,0 +1,98 @@
+package parameterencryption
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	cryptoutil "github.com/argoproj/argo-cd/v3/util/crypto"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// PublicKeyKey is the key of the PEM encoded public keys in the parameter encryption secret
+const PublicKeyKey = "public.pem"
+
+// NewHandler creates handler serving the public keys sealing the application parameters
+func NewHandler(settingsMgr *settings.SettingsManager) *Handler {
+	return &Handler{settingsMgr: settingsMgr}
+}
+
+// Handler publishes the PEM encoded public keys of the parameter encryption secret, so that the users can seal the
+// parameters without access to the secret. The first key seals the new values.
+type Handler struct {
+	settingsMgr *settings.SettingsManager
+
+	lock sync.Mutex
+	// resourceVersion is the version of the secret whose public keys are cached
+	resourceVersion string
+	publicKeys      []byte
+}
+
+// ServeHTTP serves the PEM encoded public keys
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	// the secret is read from the informer of the settings manager, since the endpoint is not authenticated
+	secret, err := h.settingsMgr.GetSecretByName(common.ArgoCDParameterEncryptionSecretName)
+	if apierrors.IsNotFound(err) {
+		http.Error(w, "Parameter encryption is not configured", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Errorf("Failed to retrieve parameter encryption secret: %v", err)
+		http.Error(w, "Failed to retrieve parameter encryption key", http.StatusInternalServerError)
+		return
+	}
+	if len(secret.Data[PublicKeyKey]) == 0 {
+		http.Error(w, "Parameter encryption is not configured", http.StatusNotFound)
+		return
+	}
+	publicKeys, err := h.getPublicKeys(secret.ResourceVersion, secret.Data[PublicKeyKey])
+	if err != nil {
+		log.Errorf("Invalid parameter encryption public key: %v", err)
+		http.Error(w, "Invalid parameter encryption key", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-pem-file")
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	if _, err := w.Write(publicKeys); err != nil {
+		log.Errorf("Failed to write parameter encryption key: %v", err)
+	}
+}
+
+// getPublicKeys returns the PEM encoded public keys of the given version of the secret. Only the parsed public keys
+// are encoded, so that the other PEM blocks of the secret, e.g. a private key, are never published.
+func (h *Handler) getPublicKeys(resourceVersion string, data []byte) ([]byte, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.publicKeys != nil && h.resourceVersion == resourceVersion {
+		return h.publicKeys, nil
+	}
+	keys, err := cryptoutil.ParseSealingPublicKeys(data)
+	if err != nil {
+		return nil, err
+	}
+	var publicKeys bytes.Buffer
+	for _, key := range keys {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling public key: %w", err)
+		}
+		if err := pem.Encode(&publicKeys, &pem.Block{Type: "PUBLIC KEY", Bytes: der}); err != nil {
+			return nil, fmt.Errorf("error encoding public key: %w", err)
+		}
+	}
+	h.resourceVersion = resourceVersion
+	h.publicKeys = publicKeys.Bytes()
+	return h.publicKeys, nil
+}
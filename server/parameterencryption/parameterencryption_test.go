@@ -0,0 +1,86 @@
+package parameterencryption
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func newSecret(data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDParameterEncryptionSecretName, Namespace: "argocd"},
+		Data:       data,
+	}
+}
+
+func newHandler(t *testing.T, objects ...runtime.Object) *Handler {
+	t.Helper()
+	return NewHandler(settings.NewSettingsManager(t.Context(), fake.NewClientset(objects...), "argocd"))
+}
+
+func serve(h *Handler, method string) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(method, common.ParameterEncryptionKeyEndpoint, http.NoBody))
+	return rr
+}
+
+func TestHandler(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	publicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	t.Run("Public key published", func(t *testing.T) {
+		h := newHandler(t, newSecret(map[string][]byte{
+			PublicKeyKey:  publicKey,
+			"private.pem": pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		}))
+		rr := serve(h, http.MethodGet)
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/x-pem-file", rr.Header().Get("Content-Type"))
+		assert.Equal(t, publicKey, rr.Body.Bytes())
+	})
+
+	t.Run("Only public keys published", func(t *testing.T) {
+		privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+		h := newHandler(t, newSecret(map[string][]byte{PublicKeyKey: append(append([]byte("comment\n"), privateKey...), publicKey...)}))
+		rr := serve(h, http.MethodGet)
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, publicKey, rr.Body.Bytes())
+	})
+
+	for name, objects := range map[string][]runtime.Object{
+		"Secret missing":     nil,
+		"Public key missing": {newSecret(map[string][]byte{"private.pem": []byte("private")})},
+	} {
+		t.Run(name, func(t *testing.T) {
+			h := newHandler(t, objects...)
+			assert.Equal(t, http.StatusNotFound, serve(h, http.MethodGet).Code)
+		})
+	}
+
+	t.Run("Invalid public key", func(t *testing.T) {
+		h := newHandler(t, newSecret(map[string][]byte{PublicKeyKey: []byte("invalid")}))
+		assert.Equal(t, http.StatusInternalServerError, serve(h, http.MethodGet).Code)
+	})
+
+	t.Run("Method not allowed", func(t *testing.T) {
+		h := newHandler(t, newSecret(map[string][]byte{PublicKeyKey: publicKey}))
+		assert.Equal(t, http.StatusMethodNotAllowed, serve(h, http.MethodPost).Code)
+	})
+}
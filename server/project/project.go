@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/pkg/v2/sync"
@@ -255,6 +257,7 @@ func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*
 		return nil, err
 	}
 	q.Project.NormalizePolicies()
+	argo.SetFieldManager(q.Project, q.GetFieldManager())
 	err := validateProject(q.Project)
 	if err != nil {
 		return nil, fmt.Errorf("error validating project: %w", err)
@@ -265,6 +268,9 @@ func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*
 		if getErr != nil {
 			return nil, status.Errorf(codes.Internal, "unable to check existing project details: %v", getErr)
 		}
+		if err := argo.ValidateFieldManager(existing, q.GetFieldManager()); err != nil {
+			return nil, err
+		}
 		if !q.GetUpsert() {
 			if !reflect.DeepEqual(existing.Spec, q.GetProject().Spec) {
 				return nil, status.Error(codes.InvalidArgument, argo.GenerateSpecIsDifferentErrorMessage("project", existing.Spec, q.GetProject().Spec))
@@ -275,6 +281,7 @@ func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*
 			return nil, err
 		}
 		existing.Spec = q.GetProject().Spec
+		argo.SetFieldManager(existing, q.GetFieldManager())
 		res, err = s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, existing, metav1.UpdateOptions{})
 	}
 	if err == nil {
@@ -500,7 +507,7 @@ func (s *Server) ListEvents(ctx context.Context, q *project.ProjectQuery) (*core
 
 func (s *Server) logEvent(ctx context.Context, a *v1alpha1.AppProject, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
-	user := session.Username(ctx)
+	user := session.AuditUsername(ctx)
 	if user == "" {
 		user = "Unknown user"
 	}
@@ -532,6 +539,44 @@ func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindows
 	return res, nil
 }
 
+// defaultExpiringGroupBindingsWithin is the default duration the bindings listed by ListExpiringGroupBindings expire within
+const defaultExpiringGroupBindingsWithin = 30 * 24 * time.Hour
+
+// ListExpiringGroupBindings returns the bindings of the OIDC groups to the roles of the project which expire soon, or
+// have expired, soonest first
+func (s *Server) ListExpiringGroupBindings(ctx context.Context, q *project.ExpiringGroupBindingsQuery) (*project.ExpiringGroupBindingsResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, q.Name); err != nil {
+		return nil, err
+	}
+	within := defaultExpiringGroupBindingsWithin
+	if q.Within != "" {
+		var err error
+		if within, err = time.ParseDuration(q.Within); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid duration %q: %v", q.Within, err)
+		}
+	}
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res := &project.ExpiringGroupBindingsResponse{Items: []*project.ExpiringGroupBinding{}}
+	for _, role := range proj.Spec.Roles {
+		for _, expiration := range role.GroupExpirationsBefore(now.Add(within)) {
+			res.Items = append(res.Items, &project.ExpiringGroupBinding{
+				Role:       role.Name,
+				Expiration: expiration.DeepCopy(),
+				Expired:    role.IsGroupExpired(expiration.Group, now),
+			})
+		}
+	}
+	sort.SliceStable(res.Items, func(i, j int) bool {
+		return res.Items[i].Expiration.ExpiresAt.Before(&res.Items[j].Expiration.ExpiresAt)
+	})
+	return res, nil
+}
+
 func (s *Server) NormalizeProjs() error {
 	projList, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(context.Background(), metav1.ListOptions{})
 	if err != nil {
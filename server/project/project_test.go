@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -22,6 +23,7 @@ import (
 	k8scache "k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/errorcode"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	apps "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
@@ -150,7 +152,8 @@ func TestProjectServer(t *testing.T) {
 
 		_, err := projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: updatedProj})
 
-		assert.Equal(t, status.Error(codes.PermissionDenied, "permission denied: clusters, update, https://server1"), err)
+		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: clusters, update, https://server1")
+		assert.Equal(t, errorcode.PermissionDenied, errorcode.FromError(err))
 	})
 
 	t.Run("TestReposUpdateDenied", func(t *testing.T) {
@@ -164,7 +167,8 @@ func TestProjectServer(t *testing.T) {
 
 		_, err := projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: updatedProj})
 
-		assert.Equal(t, status.Error(codes.PermissionDenied, "permission denied: repositories, update, https://github.com/argoproj/argo-cd.git"), err)
+		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: repositories, update, https://github.com/argoproj/argo-cd.git")
+		assert.Equal(t, errorcode.PermissionDenied, errorcode.FromError(err))
 	})
 
 	t.Run("TestClusterResourceWhitelistUpdateDenied", func(t *testing.T) {
@@ -178,7 +182,8 @@ func TestProjectServer(t *testing.T) {
 
 		_, err := projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: updatedProj})
 
-		assert.Equal(t, status.Error(codes.PermissionDenied, "permission denied: clusters, update, https://server1"), err)
+		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: clusters, update, https://server1")
+		assert.Equal(t, errorcode.PermissionDenied, errorcode.FromError(err))
 	})
 
 	t.Run("TestNamespaceResourceBlacklistUpdateDenied", func(t *testing.T) {
@@ -192,7 +197,8 @@ func TestProjectServer(t *testing.T) {
 
 		_, err := projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: updatedProj})
 
-		assert.Equal(t, status.Error(codes.PermissionDenied, "permission denied: clusters, update, https://server1"), err)
+		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: clusters, update, https://server1")
+		assert.Equal(t, errorcode.PermissionDenied, errorcode.FromError(err))
 	})
 
 	enforcer = newEnforcer(kubeclientset)
@@ -680,6 +686,17 @@ p, role:admin, projects, update, *, allow`)
 		assert.ErrorContains(t, err, "effect must be: 'allow' or 'deny'")
 	})
 
+	t.Run("TestValidateProjectInvalidExpressionFailure", func(t *testing.T) {
+		projWithExpression := existingProj.DeepCopy()
+		projWithExpression.Spec.DestinationExpression = "destination.namespace.startsWith("
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithExpression), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		request := &project.ProjectUpdateRequest{Project: projWithExpression}
+		_, err := projectServer.Update(t.Context(), request)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.ErrorContains(t, err, "destination expression is invalid")
+	})
+
 	t.Run("TestNormalizeProjectRolePolicies", func(t *testing.T) {
 		action := "create"
 		object := "testApplication"
@@ -727,6 +744,43 @@ p, role:admin, projects, update, *, allow`)
 		assert.Nil(t, res)
 	})
 
+	t.Run("TestListExpiringGroupBindings", func(t *testing.T) {
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		now := time.Now()
+		projectWithGroups := existingProj.DeepCopy()
+		projectWithGroups.Spec.Roles = []v1alpha1.ProjectRole{{
+			Name:   "deployer",
+			Groups: []string{"my-org:team-a", "my-org:team-b", "my-org:team-c"},
+			GroupExpirations: []v1alpha1.GroupExpiration{
+				{Group: "my-org:team-a", ExpiresAt: metav1.NewTime(now.Add(48 * time.Hour))},
+				{Group: "my-org:team-b", ExpiresAt: metav1.NewTime(now.Add(60 * 24 * time.Hour))},
+			},
+		}, {
+			Name:             "admin",
+			Groups:           []string{"my-org:oncall"},
+			GroupExpirations: []v1alpha1.GroupExpiration{{Group: "my-org:oncall", ExpiresAt: metav1.NewTime(now.Add(-time.Hour))}},
+		}}
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithGroups), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		res, err := projectServer.ListExpiringGroupBindings(ctx, &project.ExpiringGroupBindingsQuery{Name: projectWithGroups.Name})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		assert.Equal(t, "admin", res.Items[0].Role)
+		assert.Equal(t, "my-org:oncall", res.Items[0].Expiration.Group)
+		assert.True(t, res.Items[0].Expired)
+		assert.Equal(t, "deployer", res.Items[1].Role)
+		assert.Equal(t, "my-org:team-a", res.Items[1].Expiration.Group)
+		assert.False(t, res.Items[1].Expired)
+
+		res, err = projectServer.ListExpiringGroupBindings(ctx, &project.ExpiringGroupBindingsQuery{Name: projectWithGroups.Name, Within: "1500h"})
+		require.NoError(t, err)
+		assert.Len(t, res.Items, 3)
+
+		_, err = projectServer.ListExpiringGroupBindings(ctx, &project.ExpiringGroupBindingsQuery{Name: projectWithGroups.Name, Within: "soon"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
 	t.Run("TestGetSyncWindowsStateDenied", func(t *testing.T) {
 		enforcer = newEnforcer(kubeclientset)
 		_ = enforcer.SetBuiltinPolicy(`p, *, *, *, *, deny`)
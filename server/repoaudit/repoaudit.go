@@ -0,0 +1,123 @@
+package repoaudit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/audit"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+// maxLimit bounds the number of the records returned by a query
+const maxLimit = 1000
+
+// NewHandler creates handler serving the repository accesses recorded by the repo server. The store is nil if the
+// API server doesn't use Redis.
+func NewHandler(store audit.Store, db db.ArgoDB, enf *rbac.Enforcer) *Handler {
+	return &Handler{store: store, db: db, enf: enf}
+}
+
+// Handler serves the recorded repository accesses of the repositories the user is allowed to get, the most recent
+// first. The records are filtered by the repo, user, application and since (RFC 3339) query parameters, and limited
+// by the limit query parameter.
+type Handler struct {
+	store audit.Store
+	db    db.ArgoDB
+	enf   *rbac.Enforcer
+}
+
+// ServeHTTP serves the recorded repository accesses as JSON
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	// the claims are set by the auth middleware
+	claims := r.Context().Value("claims")
+	if h.store == nil {
+		http.Error(w, "Repository access audit is not available", http.StatusNotFound)
+		return
+	}
+
+	params := r.URL.Query()
+	query := audit.Query{
+		Repo:        params.Get("repo"),
+		User:        params.Get("user"),
+		Application: params.Get("application"),
+		Limit:       audit.DefaultQueryLimit,
+	}
+	if since := params.Get("since"); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			http.Error(w, "Invalid since parameter, expected RFC 3339 time", http.StatusBadRequest)
+			return
+		}
+		query.Since = t
+	}
+	if limit := params.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			http.Error(w, "Invalid limit parameter", http.StatusBadRequest)
+			return
+		}
+		query.Limit = min(n, maxLimit)
+	}
+
+	// the records of the repositories the user is not allowed to get are filtered out after the query, so the store
+	// is queried for all the records up to the maximum
+	limit := query.Limit
+	query.Limit = maxLimit
+	records, err := h.store.Query(r.Context(), query)
+	if err != nil {
+		log.Errorf("Failed to query repository accesses: %v", err)
+		http.Error(w, "Failed to query repository accesses", http.StatusInternalServerError)
+		return
+	}
+	allowed := make([]audit.Record, 0, len(records))
+	rbacObjects := map[string]string{}
+	for _, record := range records {
+		if len(allowed) == limit {
+			break
+		}
+		object, err := h.rbacObject(r.Context(), record, rbacObjects)
+		if err != nil {
+			log.Warnf("Failed to get repository %s: %v", record.Repo, err)
+			continue
+		}
+		if h.enf.Enforce(claims, rbac.ResourceRepositories, rbac.ActionGet, object) {
+			allowed = append(allowed, record)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := json.NewEncoder(w).Encode(map[string][]audit.Record{"items": allowed}); err != nil {
+		log.Errorf("Failed to write repository accesses: %v", err)
+	}
+}
+
+// rbacObject returns the RBAC object of the repository of the given record, which is named with the project of the
+// repository as by the repositories API, rather than with the project of the application accessing it. The objects
+// are resolved once per repository and project of the records.
+func (h *Handler) rbacObject(ctx context.Context, record audit.Record, rbacObjects map[string]string) (string, error) {
+	key := record.Project + "/" + record.Repo
+	if object, ok := rbacObjects[key]; ok {
+		return object, nil
+	}
+	repo, err := h.db.GetRepository(ctx, record.Repo, record.Project)
+	if err != nil {
+		return "", err
+	}
+	object := record.Repo
+	if repo.Project != "" {
+		object = repo.Project + "/" + record.Repo
+	}
+	rbacObjects[key] = object
+	return object, nil
+}
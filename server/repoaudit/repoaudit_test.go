@@ -0,0 +1,151 @@
+package repoaudit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/audit"
+	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/session"
+)
+
+type fakeStore struct {
+	records []audit.Record
+	query   audit.Query
+}
+
+func (s *fakeStore) Record(_ context.Context, record audit.Record) error {
+	s.records = append([]audit.Record{record}, s.records...)
+	return nil
+}
+
+func (s *fakeStore) Query(_ context.Context, query audit.Query) ([]audit.Record, error) {
+	s.query = query
+	var records []audit.Record
+	for _, record := range s.records {
+		if query.Matches(record) && len(records) < query.Limit {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+type fakeTokenVerifier struct{}
+
+func (fakeTokenVerifier) VerifyToken(token string) (jwt.Claims, string, error) {
+	if token != "valid" {
+		return nil, "", errors.New("invalid token")
+	}
+	return jwt.MapClaims{"sub": "alice"}, "", nil
+}
+
+func newEnforcer() *rbac.Enforcer {
+	enf := rbac.NewEnforcer(fake.NewClientset(), "argocd", common.ArgoCDRBACConfigMapName, nil)
+	// alice is only allowed to get the repositories of the team project
+	enf.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...any) bool {
+		sub, _ := claims.(jwt.MapClaims)["sub"].(string)
+		return sub == "alice" && rvals[1] == rbac.ResourceRepositories && rvals[2] == rbac.ActionGet && strings.HasPrefix(rvals[3].(string), "team/")
+	})
+	return enf
+}
+
+func newDB() *dbmocks.ArgoDB {
+	db := &dbmocks.ArgoDB{}
+	db.On("GetRepository", mock.Anything, "https://github.com/team/apps", "team").Return(&v1alpha1.Repository{Repo: "https://github.com/team/apps", Project: "team"}, nil)
+	db.On("GetRepository", mock.Anything, "https://github.com/other/apps", "other").Return(&v1alpha1.Repository{Repo: "https://github.com/other/apps", Project: "other"}, nil)
+	// the global repositories are not scoped by the project of the applications accessing them
+	db.On("GetRepository", mock.Anything, "https://github.com/global/apps", "team").Return(&v1alpha1.Repository{Repo: "https://github.com/global/apps"}, nil)
+	return db
+}
+
+func newHandler(store audit.Store) http.Handler {
+	return session.WithAuthMiddleware(false, fakeTokenVerifier{}, NewHandler(store, newDB(), newEnforcer()))
+}
+
+func serve(h http.Handler, target string, token string) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, target, http.NoBody)
+	if token != "" {
+		req.AddCookie(&http.Cookie{Name: common.AuthCookieName, Value: token})
+	}
+	h.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestHandler(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := &fakeStore{}
+	for _, record := range []audit.Record{
+		{Time: now.Add(-4 * time.Hour), Repo: "https://github.com/global/apps", Project: "team", Operation: "GenerateManifest", Application: "argocd/c"},
+		{Time: now.Add(-3 * time.Hour), Repo: "https://github.com/team/apps", Project: "team", Operation: "GenerateManifest", Application: "argocd/a"},
+		{Time: now.Add(-2 * time.Hour), Repo: "https://github.com/other/apps", Project: "other", Operation: "GenerateManifest", Application: "argocd/b"},
+		{Time: now.Add(-time.Hour), Repo: "https://github.com/team/apps", Project: "team", Operation: "GetAppDetails", User: "alice"},
+	} {
+		require.NoError(t, store.Record(t.Context(), record))
+	}
+	h := newHandler(store)
+
+	t.Run("Records of the allowed repositories", func(t *testing.T) {
+		rr := serve(h, common.RepositoryAccessAuditEndpoint, "valid")
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+		var result map[string][]audit.Record
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &result))
+		assert.Equal(t, []audit.Record{store.records[0], store.records[2]}, result["items"])
+	})
+
+	t.Run("Query parameters", func(t *testing.T) {
+		rr := serve(h, common.RepositoryAccessAuditEndpoint+"?repo=https://github.com/team/apps&user=alice&application=argocd/a&since=2025-01-01T10:00:00Z&limit=1", "valid")
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, audit.Query{
+			Repo:        "https://github.com/team/apps",
+			User:        "alice",
+			Application: "argocd/a",
+			Since:       now.Add(-2 * time.Hour),
+			Limit:       maxLimit,
+		}, store.query)
+
+		rr = serve(h, common.RepositoryAccessAuditEndpoint+"?limit=1", "valid")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var result map[string][]audit.Record
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &result))
+		assert.Equal(t, []audit.Record{store.records[0]}, result["items"])
+	})
+
+	t.Run("Invalid parameters", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, serve(h, common.RepositoryAccessAuditEndpoint+"?since=yesterday", "valid").Code)
+		assert.Equal(t, http.StatusBadRequest, serve(h, common.RepositoryAccessAuditEndpoint+"?limit=0", "valid").Code)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, serve(h, common.RepositoryAccessAuditEndpoint, "").Code)
+		assert.Equal(t, http.StatusUnauthorized, serve(h, common.RepositoryAccessAuditEndpoint, "invalid").Code)
+	})
+
+	t.Run("Not available", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, serve(newHandler(nil), common.RepositoryAccessAuditEndpoint, "valid").Code)
+	})
+
+	t.Run("Method not allowed", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, common.RepositoryAccessAuditEndpoint, http.NoBody)
+		req.AddCookie(&http.Cookie{Name: common.AuthCookieName, Value: "valid"})
+		h.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
@@ -85,6 +85,9 @@ func (s *Server) CreateRepositoryCredentials(ctx context.Context, q *repocredspk
 	if q.Creds == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload in request")
 	}
+	if err := db.ValidateNoSecretRefs(q.Creds); err != nil {
+		return nil, err
+	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionCreate, q.Creds.URL); err != nil {
 		return nil, err
 	}
@@ -120,6 +123,9 @@ func (s *Server) CreateWriteRepositoryCredentials(ctx context.Context, q *repocr
 	if q.Creds == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload in request")
 	}
+	if err := db.ValidateNoSecretRefs(q.Creds); err != nil {
+		return nil, err
+	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceWriteRepositories, rbac.ActionCreate, q.Creds.URL); err != nil {
 		return nil, err
 	}
@@ -155,6 +161,9 @@ func (s *Server) UpdateRepositoryCredentials(ctx context.Context, q *repocredspk
 	if q.Creds == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload in request")
 	}
+	if err := db.ValidateNoSecretRefs(q.Creds); err != nil {
+		return nil, err
+	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionUpdate, q.Creds.URL); err != nil {
 		return nil, err
 	}
@@ -167,6 +176,9 @@ func (s *Server) UpdateWriteRepositoryCredentials(ctx context.Context, q *repocr
 	if q.Creds == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload in request")
 	}
+	if err := db.ValidateNoSecretRefs(q.Creds); err != nil {
+		return nil, err
+	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceWriteRepositories, rbac.ActionUpdate, q.Creds.URL); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// connectionProbeTimeout is the maximum duration of the test of the connection to a single repository
+const connectionProbeTimeout = time.Minute
+
+// ConnectionMetrics records the connection states of the repositories
+type ConnectionMetrics interface {
+	SetRepoConnectionState(repo string, project string, state v1alpha1.ConnectionState)
+	DeleteRepoConnectionState(repo string, project string)
+}
+
+type repoKey struct {
+	repo    string
+	project string
+}
+
+// ConnectionProber periodically tests the connections to all the configured repositories, so that their connection
+// states are kept up to date in the repository API and in the metrics
+type ConnectionProber struct {
+	server   *Server
+	interval time.Duration
+	metrics  ConnectionMetrics
+	// probed are the repositories whose connection states are recorded in the metrics
+	probed map[repoKey]bool
+}
+
+// NewConnectionProber returns a prober testing the connections to the repositories of the given server every interval
+func NewConnectionProber(server *Server, interval time.Duration, metrics ConnectionMetrics) *ConnectionProber {
+	return &ConnectionProber{server: server, interval: interval, metrics: metrics, probed: map[repoKey]bool{}}
+}
+
+// Run probes the connections to the repositories until the given context is done
+func (p *ConnectionProber) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.probe(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *ConnectionProber) probe(ctx context.Context) {
+	repos, err := p.server.db.ListRepositories(ctx)
+	if err != nil {
+		log.Warnf("Failed to list the repositories to probe: %v", err)
+		return
+	}
+	probed := map[repoKey]bool{}
+	for _, repo := range repos {
+		if ctx.Err() != nil {
+			return
+		}
+		key := repoKey{repo: repo.Repo, project: repo.Project}
+		if probed[key] {
+			continue
+		}
+		probed[key] = true
+		state, err := p.server.cache.GetRepoConnectionState(repo.Repo, repo.Project)
+		// the connection states tested recently, on request or by another replica of the API server, are not tested again
+		if err != nil || state.ModifiedAt == nil || time.Since(state.ModifiedAt.Time) >= p.interval/2 {
+			probeCtx, cancel := context.WithTimeout(ctx, connectionProbeTimeout)
+			state = p.server.testConnection(probeCtx, repo, state)
+			cancel()
+			if state.Status == v1alpha1.ConnectionStatusFailed {
+				log.WithFields(log.Fields{"repo": repo.Repo, "project": repo.Project, "consecutiveFailures": state.ConsecutiveFailures}).
+					Warnf("Failed to connect to repository: %s", state.Message)
+			}
+		}
+		p.metrics.SetRepoConnectionState(repo.Repo, repo.Project, state)
+	}
+	for key := range p.probed {
+		if !probed[key] {
+			p.metrics.DeleteRepoConnectionState(key.repo, key.project)
+		}
+	}
+	p.probed = probed
+}
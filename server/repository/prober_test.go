@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+)
+
+type fakeConnectionMetrics struct {
+	states map[repoKey]appsv1.ConnectionState
+}
+
+func (m *fakeConnectionMetrics) SetRepoConnectionState(repo string, project string, state appsv1.ConnectionState) {
+	m.states[repoKey{repo: repo, project: project}] = state
+}
+
+func (m *fakeConnectionMetrics) DeleteRepoConnectionState(repo string, project string) {
+	delete(m.states, repoKey{repo: repo, project: project})
+}
+
+func newTestRepoServerClientset(failingRepo string) *mocks.Clientset {
+	repoServerClient := mocks.RepoServerServiceClient{}
+	repoServerClient.On("TestRepository", mock.Anything, mock.MatchedBy(func(req *apiclient.TestRepositoryRequest) bool {
+		return req.Repo.Repo == failingRepo
+	})).Return(nil, errors.New("connection refused"))
+	repoServerClient.On("TestRepository", mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
+	return &mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+}
+
+func TestConnectionProber(t *testing.T) {
+	healthy := &appsv1.Repository{Repo: "https://github.com/org/healthy"}
+	failing := &appsv1.Repository{Repo: "https://github.com/org/failing", Project: "proj"}
+	db := &dbmocks.ArgoDB{}
+	db.On("ListRepositories", mock.Anything).Return([]*appsv1.Repository{healthy, failing}, nil).Twice()
+	db.On("ListRepositories", mock.Anything).Return([]*appsv1.Repository{healthy}, nil)
+
+	s := NewServer(newTestRepoServerClientset(failing.Repo), db, nil, newFixtures().Cache, nil, nil, testNamespace, nil, false)
+	metrics := &fakeConnectionMetrics{states: map[repoKey]appsv1.ConnectionState{}}
+	prober := NewConnectionProber(s, time.Nanosecond, metrics)
+
+	prober.probe(t.Context())
+	prober.probe(t.Context())
+	require.Len(t, metrics.states, 2)
+	healthyState := metrics.states[repoKey{repo: healthy.Repo}]
+	assert.Equal(t, appsv1.ConnectionStatusSuccessful, healthyState.Status)
+	assert.Zero(t, healthyState.ConsecutiveFailures)
+	failingState := metrics.states[repoKey{repo: failing.Repo, project: failing.Project}]
+	assert.Equal(t, appsv1.ConnectionStatusFailed, failingState.Status)
+	assert.Equal(t, "Unable to connect to repository: connection refused", failingState.Message)
+	assert.Equal(t, int64(2), failingState.ConsecutiveFailures)
+
+	// the connection states are exposed by the repository API
+	cached, err := s.cache.GetRepoConnectionState(failing.Repo, failing.Project)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), cached.ConsecutiveFailures)
+
+	// the metrics of the removed repositories are deleted
+	prober.probe(t.Context())
+	assert.Len(t, metrics.states, 1)
+	assert.Contains(t, metrics.states, repoKey{repo: healthy.Repo})
+}
+
+func TestConnectionProber_RecentState(t *testing.T) {
+	repo := &appsv1.Repository{Repo: "https://github.com/org/repo"}
+	db := &dbmocks.ArgoDB{}
+	db.On("ListRepositories", mock.Anything).Return([]*appsv1.Repository{repo}, nil)
+	repoServerClientset := newTestRepoServerClientset("")
+
+	s := NewServer(repoServerClientset, db, nil, newFixtures().Cache, nil, nil, testNamespace, nil, false)
+	now := metav1.Now()
+	recent := appsv1.ConnectionState{Status: appsv1.ConnectionStatusFailed, ModifiedAt: &now, ConsecutiveFailures: 3}
+	require.NoError(t, s.cache.SetRepoConnectionState(repo.Repo, "", &recent))
+	metrics := &fakeConnectionMetrics{states: map[repoKey]appsv1.ConnectionState{}}
+
+	NewConnectionProber(s, time.Hour, metrics).probe(t.Context())
+	repoServerClientset.RepoServerServiceClient.(*mocks.RepoServerServiceClient).AssertNotCalled(t, "TestRepository", mock.Anything, mock.Anything)
+	assert.Equal(t, int64(3), metrics.states[repoKey{repo: repo.Repo}].ConsecutiveFailures)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/text"
@@ -95,20 +96,34 @@ func createRBACObject(project string, repo string) string {
 // repo and evaluate the results. Unless forceRefresh is set to true, the
 // result may be retrieved out of the cache.
 func (s *Server) getConnectionState(ctx context.Context, url string, project string, forceRefresh bool) v1alpha1.ConnectionState {
-	if !forceRefresh {
-		if connectionState, err := s.cache.GetRepoConnectionState(url, project); err == nil {
-			return connectionState
-		}
+	previous, err := s.cache.GetRepoConnectionState(url, project)
+	if err == nil && !forceRefresh {
+		return previous
+	}
+	repo, err := s.db.GetRepository(ctx, url, project)
+	if err != nil {
+		return s.setConnectionState(url, project, previous, 0, err)
 	}
+	start := time.Now()
+	err = s.testRepo(ctx, repo)
+	return s.setConnectionState(url, project, previous, time.Since(start), err)
+}
+
+// testConnection connects to the given repository, and returns its connection state following the given previous one
+func (s *Server) testConnection(ctx context.Context, repo *v1alpha1.Repository, previous v1alpha1.ConnectionState) v1alpha1.ConnectionState {
+	start := time.Now()
+	err := s.testRepo(ctx, repo)
+	return s.setConnectionState(repo.Repo, repo.Project, previous, time.Since(start), err)
+}
+
+// setConnectionState caches and returns the connection state resulting from the given connection attempt. The failures
+// are counted from the given previous connection state.
+func (s *Server) setConnectionState(url string, project string, previous v1alpha1.ConnectionState, latency time.Duration, err error) v1alpha1.ConnectionState {
 	now := metav1.Now()
 	connectionState := v1alpha1.ConnectionState{
 		Status:     v1alpha1.ConnectionStatusSuccessful,
 		ModifiedAt: &now,
-	}
-	var err error
-	repo, err := s.db.GetRepository(ctx, url, project)
-	if err == nil {
-		err = s.testRepo(ctx, repo)
+		LatencyMs:  latency.Milliseconds(),
 	}
 	if err != nil {
 		connectionState.Status = v1alpha1.ConnectionStatusFailed
@@ -118,6 +133,10 @@ func (s *Server) getConnectionState(ctx context.Context, url string, project str
 		} else {
 			connectionState.Message = fmt.Sprintf("Unable to connect to repository: %v", err)
 		}
+		connectionState.ConsecutiveFailures = 1
+		if previous.Status == v1alpha1.ConnectionStatusFailed {
+			connectionState.ConsecutiveFailures = previous.ConsecutiveFailures + 1
+		}
 	}
 	err = s.cache.SetRepoConnectionState(url, project, &connectionState)
 	if err != nil {
@@ -439,6 +458,9 @@ func (s *Server) CreateRepository(ctx context.Context, q *repositorypkg.RepoCrea
 	if q.Repo == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload in request")
 	}
+	if err := db.ValidateNoSecretRefs(q.Repo); err != nil {
+		return nil, err
+	}
 
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionCreate, createRBACObject(q.Repo.Project, q.Repo.Repo)); err != nil {
 		return nil, err
@@ -448,7 +470,7 @@ func (s *Server) CreateRepository(ctx context.Context, q *repositorypkg.RepoCrea
 	var err error
 
 	// check we can connect to the repo, copying any existing creds (not supported for project scoped repositories)
-	if q.Repo.Project == "" {
+	if q.Repo.Project == "" && !q.SkipValidation {
 		repo := q.Repo.DeepCopy()
 		if !repo.HasCredentials() {
 			creds, err := s.db.GetRepositoryCredentials(ctx, repo.Repo)
@@ -475,6 +497,7 @@ func (s *Server) CreateRepository(ctx context.Context, q *repositorypkg.RepoCrea
 		}
 
 		existing.Type = text.FirstNonEmpty(existing.Type, "git")
+		r.Type = text.FirstNonEmpty(r.Type, "git")
 		// repository ConnectionState may differ, so make consistent before testing
 		existing.ConnectionState = r.ConnectionState
 		switch {
@@ -490,7 +513,7 @@ func (s *Server) CreateRepository(ctx context.Context, q *repositorypkg.RepoCrea
 	if err != nil {
 		return nil, err
 	}
-	return &v1alpha1.Repository{Repo: repo.Repo, Type: repo.Type, Name: repo.Name}, nil
+	return &v1alpha1.Repository{Repo: repo.Repo, Type: text.FirstNonEmpty(repo.Type, "git"), Name: repo.Name}, nil
 }
 
 // CreateWriteRepository creates a repository configuration with write credentials
@@ -502,6 +525,9 @@ func (s *Server) CreateWriteRepository(ctx context.Context, q *repositorypkg.Rep
 	if q.Repo == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload in request")
 	}
+	if err := db.ValidateNoSecretRefs(q.Repo); err != nil {
+		return nil, err
+	}
 
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceWriteRepositories, rbac.ActionCreate, createRBACObject(q.Repo.Project, q.Repo.Repo)); err != nil {
 		return nil, err
@@ -511,9 +537,10 @@ func (s *Server) CreateWriteRepository(ctx context.Context, q *repositorypkg.Rep
 		return nil, status.Errorf(codes.InvalidArgument, "missing credentials in request")
 	}
 
-	err := s.testRepo(ctx, q.Repo)
-	if err != nil {
-		return nil, err
+	if !q.SkipValidation {
+		if err := s.testRepo(ctx, q.Repo); err != nil {
+			return nil, err
+		}
 	}
 
 	repo, err := s.db.CreateWriteRepository(ctx, q.Repo)
@@ -523,6 +550,8 @@ func (s *Server) CreateWriteRepository(ctx context.Context, q *repositorypkg.Rep
 		if getErr != nil {
 			return nil, status.Errorf(codes.Internal, "unable to check existing repository details: %v", getErr)
 		}
+		existing.Type = text.FirstNonEmpty(existing.Type, "git")
+		q.Repo.Type = text.FirstNonEmpty(q.Repo.Type, "git")
 		switch {
 		case reflect.DeepEqual(existing, q.Repo):
 			repo, err = existing, nil
@@ -535,7 +564,7 @@ func (s *Server) CreateWriteRepository(ctx context.Context, q *repositorypkg.Rep
 	if err != nil {
 		return nil, err
 	}
-	return &v1alpha1.Repository{Repo: repo.Repo, Type: repo.Type, Name: repo.Name}, nil
+	return &v1alpha1.Repository{Repo: repo.Repo, Type: text.FirstNonEmpty(repo.Type, "git"), Name: repo.Name}, nil
 }
 
 // Update updates a repository or credential set
@@ -549,6 +578,9 @@ func (s *Server) UpdateRepository(ctx context.Context, q *repositorypkg.RepoUpda
 	if q.Repo == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload in request")
 	}
+	if err := db.ValidateNoSecretRefs(q.Repo); err != nil {
+		return nil, err
+	}
 
 	repo, err := s.getRepo(ctx, q.Repo.Repo, q.Repo.Project)
 	if err != nil {
@@ -564,7 +596,7 @@ func (s *Server) UpdateRepository(ctx context.Context, q *repositorypkg.RepoUpda
 		return nil, err
 	}
 	_, err = s.db.UpdateRepository(ctx, q.Repo)
-	return &v1alpha1.Repository{Repo: q.Repo.Repo, Type: q.Repo.Type, Name: q.Repo.Name}, err
+	return &v1alpha1.Repository{Repo: q.Repo.Repo, Type: text.FirstNonEmpty(q.Repo.Type, "git"), Name: q.Repo.Name}, err
 }
 
 // UpdateWriteRepository updates a repository configuration with write credentials
@@ -576,6 +608,9 @@ func (s *Server) UpdateWriteRepository(ctx context.Context, q *repositorypkg.Rep
 	if q.Repo == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload in request")
 	}
+	if err := db.ValidateNoSecretRefs(q.Repo); err != nil {
+		return nil, err
+	}
 
 	repo, err := s.getWriteRepo(ctx, q.Repo.Repo, q.Repo.Project)
 	if err != nil {
@@ -591,7 +626,7 @@ func (s *Server) UpdateWriteRepository(ctx context.Context, q *repositorypkg.Rep
 		return nil, err
 	}
 	_, err = s.db.UpdateWriteRepository(ctx, q.Repo)
-	return &v1alpha1.Repository{Repo: q.Repo.Repo, Type: q.Repo.Type, Name: q.Repo.Name}, err
+	return &v1alpha1.Repository{Repo: q.Repo.Repo, Type: text.FirstNonEmpty(q.Repo.Type, "git"), Name: q.Repo.Name}, err
 }
 
 // Delete removes a repository from the configuration
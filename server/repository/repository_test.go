@@ -485,6 +485,52 @@ func TestRepositoryServer(t *testing.T) {
 		assert.Equal(t, "test", repo.Repo)
 	})
 
+	t.Run("Test_CreateRepositoryWithoutValidation", func(t *testing.T) {
+		// the repo server is not expected to be called
+		repoServerClient := mocks.RepoServerServiceClient{}
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+
+		db := &dbmocks.ArgoDB{}
+		db.On("CreateRepository", t.Context(), mock.Anything).Return(&appsv1.Repository{Repo: "test"}, nil)
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		repo, err := s.CreateRepository(t.Context(), &repository.RepoCreateRequest{
+			Repo:           &appsv1.Repository{Repo: "test", Username: "test"},
+			SkipValidation: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "test", repo.Repo)
+		assert.Equal(t, "git", repo.Type)
+		repoServerClient.AssertNotCalled(t, "TestRepository", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test_CreateRepositoryWithSecretRefs", func(t *testing.T) {
+		db := &dbmocks.ArgoDB{}
+
+		s := NewServer(&mocks.Clientset{}, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		_, err := s.CreateRepository(t.Context(), &repository.RepoCreateRequest{
+			Repo:           &appsv1.Repository{Repo: "test", Username: "test", Password: "${vault:secret/data/git#password}"},
+			SkipValidation: true,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		db.AssertNotCalled(t, "CreateRepository", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test_CreateRepositoryUnchangedWithDefaultType", func(t *testing.T) {
+		db := &dbmocks.ArgoDB{}
+		db.On("GetRepository", t.Context(), "test", "").Return(&appsv1.Repository{Repo: "test", Username: "test"}, nil)
+		db.On("CreateRepository", t.Context(), mock.Anything).Return(nil, status.Errorf(codes.AlreadyExists, "repository already exists"))
+
+		s := NewServer(&mocks.Clientset{}, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		repo, err := s.CreateRepository(t.Context(), &repository.RepoCreateRequest{
+			Repo:           &appsv1.Repository{Repo: "test", Username: "test"},
+			SkipValidation: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "git", repo.Type)
+		db.AssertNotCalled(t, "UpdateRepository", mock.Anything, mock.Anything)
+	})
+
 	t.Run("Test_ListRepositories", func(t *testing.T) {
 		repoServerClient := mocks.RepoServerServiceClient{}
 		repoServerClient.On("TestRepository", mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
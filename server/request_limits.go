@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	settings_util "github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// manifestsMethods are the gRPC methods uploading manifests, to which the manifests request limit applies
+var manifestsMethods = map[string]bool{
+	"/application.ApplicationService/GetManifestsWithFiles": true,
+	"/application.ApplicationService/Sync":                  true,
+}
+
+// manifestsPaths are the glob patterns of the HTTP paths uploading manifests, to which the manifests request limit
+// applies
+var manifestsPaths = []string{
+	"/api/v1/applications/manifestsWithFiles",
+	"/api/v1/applications/*/sync",
+}
+
+// sizer is implemented by the generated gRPC messages
+type sizer interface {
+	Size() int
+}
+
+func (server *ArgoCDServer) requestLimit(route string, manifests bool) settings_util.RequestLimit {
+	return server.settings.RequestLimits.GetRequestLimit(route, manifests)
+}
+
+func checkRequestSize(size int64, limit settings_util.RequestLimit) error {
+	if limit.MaxBodySize > 0 && size > limit.MaxBodySize {
+		return status.Errorf(codes.ResourceExhausted, "request size %d exceeds the limit of %d bytes", size, limit.MaxBodySize)
+	}
+	return nil
+}
+
+// requestLimitsUnaryServerInterceptor enforces the timeout and maximum size of the unary requests
+func (server *ArgoCDServer) requestLimitsUnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	limit := server.requestLimit(info.FullMethod, manifestsMethods[info.FullMethod])
+	if msg, ok := req.(sizer); ok {
+		if err := checkRequestSize(int64(msg.Size()), limit); err != nil {
+			return nil, err
+		}
+	}
+	if limit.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, limit.Timeout)
+		defer cancel()
+	}
+	return handler(ctx, req)
+}
+
+// requestLimitsStreamServerInterceptor enforces the maximum size of the streamed requests, summed over their
+// messages, and the timeout of the client streams. The server streams, such as watches and logs, are long-lived and
+// are never timed out.
+func (server *ArgoCDServer) requestLimitsStreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	limit := server.requestLimit(info.FullMethod, manifestsMethods[info.FullMethod])
+	stream := &limitedServerStream{ServerStream: ss, ctx: ss.Context(), limit: limit}
+	if limit.Timeout > 0 && !info.IsServerStream {
+		var cancel context.CancelFunc
+		stream.ctx, cancel = context.WithTimeout(stream.ctx, limit.Timeout)
+		defer cancel()
+	}
+	return handler(srv, stream)
+}
+
+type limitedServerStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	limit settings_util.RequestLimit
+	size  int64
+}
+
+func (s *limitedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *limitedServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(sizer); ok {
+		s.size += int64(msg.Size())
+		return checkRequestSize(s.size, s.limit)
+	}
+	return nil
+}
+
+// limitRequestBody limits the size of the bodies of the HTTP requests, before they are decoded
+func (server *ArgoCDServer) limitRequestBody(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		manifests := false
+		for _, pattern := range manifestsPaths {
+			if glob.Match(pattern, r.URL.Path) {
+				manifests = true
+				break
+			}
+		}
+		if limit := server.requestLimit(r.URL.Path, manifests); limit.MaxBodySize > 0 {
+			if r.ContentLength > limit.MaxBodySize {
+				http.Error(w, "Request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, limit.MaxBodySize)
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
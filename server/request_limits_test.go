@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	settings_util "github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func newRequestLimitsServer(limits *settings_util.RequestLimits) *ArgoCDServer {
+	return &ArgoCDServer{settings: &settings_util.ArgoCDSettings{RequestLimits: limits}}
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	msgs []*applicationpkg.ApplicationManifestQueryWithFilesWrapper
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m any) error {
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	*m.(*applicationpkg.ApplicationManifestQueryWithFilesWrapper) = *msg
+	return nil
+}
+
+func TestRequestLimitsUnaryServerInterceptor(t *testing.T) {
+	server := newRequestLimitsServer(&settings_util.RequestLimits{
+		Default:   settings_util.RequestLimit{Timeout: time.Minute, MaxBodySize: 100},
+		Manifests: settings_util.RequestLimit{MaxBodySize: 1000},
+	})
+	name := "guestbook"
+	handler := func(ctx context.Context, _ any) (any, error) {
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+		return nil, nil
+	}
+
+	syncInfo := &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Sync"}
+	req := &applicationpkg.ApplicationSyncRequest{Name: &name, Manifests: []string{strings.Repeat("a", 500)}}
+	_, err := server.requestLimitsUnaryServerInterceptor(t.Context(), req, syncInfo, handler)
+	require.NoError(t, err)
+
+	req.Manifests = []string{strings.Repeat("a", 2000)}
+	_, err = server.requestLimitsUnaryServerInterceptor(t.Context(), req, syncInfo, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	getInfo := &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Get"}
+	_, err = server.requestLimitsUnaryServerInterceptor(t.Context(), &applicationpkg.ApplicationQuery{Name: &name}, getInfo, handler)
+	require.NoError(t, err)
+}
+
+func TestRequestLimitsStreamServerInterceptor(t *testing.T) {
+	server := newRequestLimitsServer(&settings_util.RequestLimits{
+		Default: settings_util.RequestLimit{Timeout: time.Minute, MaxBodySize: 1000},
+	})
+	chunk := func(size int) *applicationpkg.ApplicationManifestQueryWithFilesWrapper {
+		return &applicationpkg.ApplicationManifestQueryWithFilesWrapper{
+			Part: &applicationpkg.ApplicationManifestQueryWithFilesWrapper_Chunk{Chunk: &applicationpkg.FileChunk{Chunk: make([]byte, size)}},
+		}
+	}
+
+	t.Run("Client stream exceeding the limit", func(t *testing.T) {
+		info := &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/GetManifestsWithFiles", IsClientStream: true}
+		ss := &fakeServerStream{ctx: t.Context(), msgs: []*applicationpkg.ApplicationManifestQueryWithFilesWrapper{chunk(600), chunk(600)}}
+		err := server.requestLimitsStreamServerInterceptor(nil, ss, info, func(_ any, stream grpc.ServerStream) error {
+			_, ok := stream.Context().Deadline()
+			assert.True(t, ok)
+			msg := &applicationpkg.ApplicationManifestQueryWithFilesWrapper{}
+			require.NoError(t, stream.RecvMsg(msg))
+			return stream.RecvMsg(msg)
+		})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("Server stream is not timed out", func(t *testing.T) {
+		info := &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/Watch", IsServerStream: true}
+		err := server.requestLimitsStreamServerInterceptor(nil, &fakeServerStream{ctx: t.Context()}, info, func(_ any, stream grpc.ServerStream) error {
+			_, ok := stream.Context().Deadline()
+			assert.False(t, ok)
+			return nil
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestLimitRequestBody(t *testing.T) {
+	server := newRequestLimitsServer(&settings_util.RequestLimits{
+		Default:   settings_util.RequestLimit{MaxBodySize: 10},
+		Manifests: settings_util.RequestLimit{MaxBodySize: 100},
+	})
+	handler := server.limitRequestBody(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(path string, size int) int {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, path, strings.NewReader(strings.Repeat("a", size))))
+		return rr.Code
+	}
+	assert.Equal(t, http.StatusOK, serve("/api/v1/applications", 10))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, serve("/api/v1/applications", 11))
+	assert.Equal(t, http.StatusOK, serve("/api/v1/applications/guestbook/sync", 100))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, serve("/api/v1/applications/guestbook/sync", 101))
+}
@@ -3,7 +3,9 @@ package server
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	goio "io"
@@ -11,6 +13,7 @@ import (
 	"math"
 	"net"
 	"net/http"
+	"net/netip"
 	"net/url"
 	"os"
 	"os/exec"
@@ -21,6 +24,8 @@ import (
 	"regexp"
 	go_runtime "runtime"
 	"runtime/debug"
+	"slices"
+	"strconv"
 	"strings"
 	gosync "sync"
 	"sync/atomic"
@@ -48,8 +53,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v2"
@@ -84,6 +92,7 @@ import (
 	appinformer "github.com/argoproj/argo-cd/v3/pkg/client/informers/externalversions"
 	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	repoapiclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/audit"
 	repocache "github.com/argoproj/argo-cd/v3/reposerver/cache"
 	"github.com/argoproj/argo-cd/v3/server/account"
 	"github.com/argoproj/argo-cd/v3/server/application"
@@ -92,19 +101,26 @@ import (
 	servercache "github.com/argoproj/argo-cd/v3/server/cache"
 	"github.com/argoproj/argo-cd/v3/server/certificate"
 	"github.com/argoproj/argo-cd/v3/server/cluster"
+	"github.com/argoproj/argo-cd/v3/server/deploymentlog"
 	"github.com/argoproj/argo-cd/v3/server/extension"
+	"github.com/argoproj/argo-cd/v3/server/federation"
 	"github.com/argoproj/argo-cd/v3/server/gpgkey"
+	"github.com/argoproj/argo-cd/v3/server/jwks"
 	"github.com/argoproj/argo-cd/v3/server/logout"
 	"github.com/argoproj/argo-cd/v3/server/metrics"
 	"github.com/argoproj/argo-cd/v3/server/notification"
+	"github.com/argoproj/argo-cd/v3/server/parameterencryption"
 	"github.com/argoproj/argo-cd/v3/server/project"
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v3/server/repoaudit"
 	"github.com/argoproj/argo-cd/v3/server/repocreds"
 	"github.com/argoproj/argo-cd/v3/server/repository"
 	"github.com/argoproj/argo-cd/v3/server/session"
 	"github.com/argoproj/argo-cd/v3/server/settings"
+	"github.com/argoproj/argo-cd/v3/server/tokenexchange"
 	"github.com/argoproj/argo-cd/v3/server/version"
 	"github.com/argoproj/argo-cd/v3/ui"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/assets"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -122,6 +138,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/notification/k8s"
 	settings_notif "github.com/argoproj/argo-cd/v3/util/notification/settings"
 	"github.com/argoproj/argo-cd/v3/util/oidc"
+	"github.com/argoproj/argo-cd/v3/util/proxyproto"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	util_session "github.com/argoproj/argo-cd/v3/util/session"
 	settings_util "github.com/argoproj/argo-cd/v3/util/settings"
@@ -134,8 +151,18 @@ const (
 	maxConcurrentLoginRequestsCountEnv = "ARGOCD_MAX_CONCURRENT_LOGIN_REQUESTS_COUNT"
 	replicasCountEnv                   = "ARGOCD_API_SERVER_REPLICAS"
 	renewTokenKey                      = "renew-token"
+	// impersonateUserHeader designates the header naming the user an API call is made as
+	impersonateUserHeader = "impersonate-user"
+	// impersonateGroupHeader designates the header naming a group an API call is made as, which can be repeated
+	impersonateGroupHeader = "impersonate-group"
+	// signingKeyRotationCheckInterval is the interval at which the API server checks whether the token signing key
+	// is due for rotation
+	signingKeyRotationCheckInterval = time.Minute
 )
 
+// DefaultShutdownTimeout is the default time the in-flight requests are given to finish when the server shuts down
+const DefaultShutdownTimeout = 20 * time.Second
+
 // ErrNoSession indicates no auth token was supplied as part of a request
 var ErrNoSession = status.Errorf(codes.Unauthenticated, "no session information")
 
@@ -189,6 +216,7 @@ type ArgoCDServer struct {
 	appsetInformer cache.SharedIndexInformer
 	appsetLister   applisters.ApplicationSetLister
 	db             db.ArgoDB
+	auditLogger    *argo.AuditLogger
 
 	// stopCh is the channel which when closed, will shutdown the Argo CD server
 	stopCh             chan os.Signal
@@ -196,12 +224,16 @@ type ArgoCDServer struct {
 	indexDataInit      gosync.Once
 	indexData          []byte
 	indexDataErr       error
+	indexETag          string
 	staticAssets       http.FileSystem
+	staticAssetETags   gosync.Map
 	apiFactory         api.Factory
 	secretInformer     cache.SharedIndexInformer
 	configMapInformer  cache.SharedIndexInformer
 	serviceSet         *ArgoCDServiceSet
 	extensionManager   *extension.Manager
+	streamDrainer      *grpc_util.StreamDrainer
+	healthServer       *health.Server
 	Shutdown           func()
 	terminateRequested atomic.Bool
 	available          atomic.Bool
@@ -230,15 +262,26 @@ type ArgoCDServerOpts struct {
 	Cache                   *servercache.Cache
 	RepoServerCache         *repocache.Cache
 	RedisClient             *redis.Client
+	RedisKeyPrefix          string
 	TLSConfigCustomizer     tlsutil.ConfigCustomizer
+	AdditionalListeners     []*AdditionalListener
 	XFrameOptions           string
 	ContentSecurityPolicy   string
 	ApplicationNamespaces   []string
 	EnableProxyExtension    bool
 	WebhookParallelism      int
+	WebhookRefreshHints     bool
 	EnableK8sEvent          []string
 	HydratorEnabled         bool
 	SyncWithReplaceAllowed  bool
+	ShutdownDelay           time.Duration
+	ShutdownTimeout         time.Duration
+	RepoProbeInterval       time.Duration
+	GRPCReflectionEnabled   bool
+	GRPCHealthEnabled       bool
+	H2CEnabled              bool
+	ProxyProtocol           bool
+	ProxyProtocolNetworks   []*net.IPNet
 }
 
 type ApplicationSetOpts struct {
@@ -248,6 +291,7 @@ type ApplicationSetOpts struct {
 	AllowedScmProviders      []string
 	EnableScmProviders       bool
 	EnableGitHubAPIMetrics   bool
+	MatrixMaxGenerators      int
 }
 
 // GracefulRestartSignal implements a signal to be used for a graceful restart trigger.
@@ -318,7 +362,7 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 	appsetInformer := appFactory.Argoproj().V1alpha1().ApplicationSets().Informer()
 	appsetLister := appFactory.Argoproj().V1alpha1().ApplicationSets().Lister()
 
-	userStateStorage := util_session.NewUserStateStorage(opts.RedisClient)
+	userStateStorage := util_session.NewUserStateStorageWithKeyPrefix(opts.RedisClient, opts.RedisKeyPrefix)
 	sessionMgr := util_session.NewSessionManager(settingsMgr, projLister, opts.DexServerAddr, opts.DexTLSConfig, userStateStorage)
 	enf := rbac.NewEnforcer(opts.KubeClientset, opts.Namespace, common.ArgoCDRBACConfigMapName, nil)
 	enf.EnableEnforce(!opts.DisableAuth)
@@ -343,7 +387,7 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 		staticFS = utilio.NewComposableFS(staticFS, root.FS())
 	}
 
-	argocdService, err := service.NewArgoCDService(opts.KubeClientset, opts.Namespace, opts.RepoClientset)
+	argocdService, err := service.NewArgoCDService(opts.KubeClientset, opts.AppClientset, opts.Namespace, opts.RepoClientset, opts.Cache)
 	errorsutil.CheckError(err)
 
 	secretInformer := k8s.NewSecretInformer(opts.KubeClientset, opts.Namespace, "argocd-notifications-secret")
@@ -379,6 +423,7 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 		policyEnforcer:     policyEnf,
 		userStateStorage:   userStateStorage,
 		staticAssets:       http.FS(staticFS),
+		auditLogger:        argo.NewAuditLogger(opts.KubeClientset, "argocd-server", opts.EnableK8sEvent),
 		db:                 dbInstance,
 		apiFactory:         apiFactory,
 		secretInformer:     secretInformer,
@@ -386,6 +431,7 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 		extensionManager:   em,
 		Shutdown:           noopShutdown,
 		stopCh:             make(chan os.Signal, 1),
+		streamDrainer:      grpc_util.NewStreamDrainer(),
 	}
 
 	err = a.logInClusterWarnings()
@@ -420,8 +466,10 @@ func (server *ArgoCDServer) healthCheck(r *http.Request) error {
 }
 
 type Listeners struct {
-	Main        net.Listener
-	Metrics     net.Listener
+	Main    net.Listener
+	Metrics net.Listener
+	// Additional are the listeners of the AdditionalListeners, in the same order
+	Additional  []net.Listener
 	GatewayConn *grpc.ClientConn
 }
 
@@ -438,6 +486,15 @@ func (l *Listeners) Close() error {
 		}
 		l.Metrics = nil
 	}
+	for i, ln := range l.Additional {
+		if ln == nil {
+			continue
+		}
+		if err := ln.Close(); err != nil {
+			return err
+		}
+		l.Additional[i] = nil
+	}
 	if l.GatewayConn != nil {
 		if err := l.GatewayConn.Close(); err != nil {
 			return err
@@ -489,10 +546,14 @@ func (server *ArgoCDServer) logInClusterWarnings() error {
 }
 
 func startListener(host string, port int) (net.Listener, error) {
+	return startNetworkListener("tcp", fmt.Sprintf("%s:%d", host, port))
+}
+
+func startNetworkListener(network string, address string) (net.Listener, error) {
 	var conn net.Listener
 	var realErr error
 	_ = wait.ExponentialBackoff(backoff, func() (bool, error) {
-		conn, realErr = net.Listen("tcp", fmt.Sprintf("%s:%d", host, port))
+		conn, realErr = net.Listen(network, address)
 		if realErr != nil {
 			return false, nil
 		}
@@ -502,15 +563,49 @@ func startListener(host string, port int) (net.Listener, error) {
 }
 
 func (server *ArgoCDServer) Listen() (*Listeners, error) {
+	if server.useTLS() && tlsutil.FIPSRequired() {
+		if err := tlsutil.ValidateFIPSCertificate(server.settings.Certificate); err != nil {
+			return nil, fmt.Errorf("server certificate is not FIPS compliant: %w", err)
+		}
+	}
+	if server.H2CEnabled && server.useTLS() {
+		return nil, errors.New("h2c requires TLS to be disabled")
+	}
 	mainLn, err := startListener(server.ListenHost, server.ListenPort)
 	if err != nil {
 		return nil, err
 	}
+	if server.ProxyProtocol {
+		mainLn = proxyproto.NewListener(mainLn, server.ProxyProtocolNetworks)
+	}
 	metricsLn, err := startListener(server.ListenHost, server.MetricsPort)
 	if err != nil {
 		utilio.Close(mainLn)
 		return nil, err
 	}
+	additionalLns := make([]net.Listener, 0, len(server.AdditionalListeners))
+	closeAll := func() {
+		utilio.Close(mainLn)
+		utilio.Close(metricsLn)
+		for _, ln := range additionalLns {
+			utilio.Close(ln)
+		}
+	}
+	for _, l := range server.AdditionalListeners {
+		if l.TLS && !server.useTLS() {
+			closeAll()
+			return nil, fmt.Errorf("listener %s requires TLS, which is disabled", l)
+		}
+		ln, err := startAdditionalListener(l)
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("failed to listen on %s: %w", l, err)
+		}
+		if server.ProxyProtocol && l.Network == listenerSchemeTCP {
+			ln = proxyproto.NewListener(ln, server.ProxyProtocolNetworks)
+		}
+		additionalLns = append(additionalLns, ln)
+	}
 	var dOpts []grpc.DialOption
 	dOpts = append(dOpts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(apiclient.MaxGRPCMessageSize)))
 	dOpts = append(dOpts, grpc.WithUserAgent(fmt.Sprintf("%s/%s", common.ArgoCDUserAgentName, common.GetVersion().Version)))
@@ -534,11 +629,39 @@ func (server *ArgoCDServer) Listen() (*Listeners, error) {
 	//nolint:staticcheck
 	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", server.ListenPort), dOpts...)
 	if err != nil {
-		utilio.Close(mainLn)
-		utilio.Close(metricsLn)
+		closeAll()
 		return nil, err
 	}
-	return &Listeners{Main: mainLn, Metrics: metricsLn, GatewayConn: conn}, nil
+	return &Listeners{Main: mainLn, Metrics: metricsLn, Additional: additionalLns, GatewayConn: conn}, nil
+}
+
+// newTLSConfig returns the TLS config of the connections to the API server, customized with the given customizer
+func (server *ArgoCDServer) newTLSConfig(customizer tlsutil.ConfigCustomizer) *tls.Config {
+	tlsConfig := &tls.Config{
+		// Advertise that we support both http/1.1 and http2 for application level communication.
+		// By putting http/1.1 first, we ensure that HTTPS clients will use http/1.1, which is the only
+		// protocol our server supports for HTTPS clients. By including h2 in the list, we ensure that
+		// gRPC clients know we support http2 for their communication.
+		NextProtos: []string{"http/1.1", "h2"},
+	}
+	tlsConfig.GetCertificate = func(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		if tlsutil.FIPSRequired() {
+			if err := tlsutil.ValidateFIPSCertificate(server.settings.Certificate); err != nil {
+				log.Errorf("Refusing TLS connection, the server certificate is not FIPS compliant: %v", err)
+				return nil, err
+			}
+		}
+		return server.settings.Certificate, nil
+	}
+	if clientCAs := server.clientCertCAs(); clientCAs != nil {
+		// Client certificates are optional, clients without one authenticate with a token
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		tlsConfig.ClientCAs = clientCAs
+	}
+	if customizer != nil {
+		customizer(tlsConfig)
+	}
+	return tlsConfig
 }
 
 // Init starts informers used by the API server
@@ -577,6 +700,8 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 		server.sessionMgr.CollectMetrics(metricsServ)
 	}
 	server.serviceSet = svcSet
+	// the streams of the previous run, if any, were drained when it was shut down
+	server.streamDrainer = grpc_util.NewStreamDrainer()
 	grpcS, appResourceTreeFn := server.newGRPCServer()
 	grpcWebS := grpcweb.WrapServer(grpcS)
 	var httpS *http.Server
@@ -597,6 +722,17 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 	httpS.Handler = &bug21955Workaround{handler: httpS.Handler}
 	if httpsS != nil {
 		httpsS.Handler = &bug21955Workaround{handler: httpsS.Handler}
+		httpsS.Handler = &tlsStateHandler{handler: httpsS.Handler}
+		httpsS.ConnContext = withTLSConnectionState
+	}
+	if server.H2CEnabled {
+		// the HTTP/2 connections of the clients like the service mesh sidecars carry both the gRPC and the other
+		// requests, which are therefore told apart per request rather than per connection
+		protocols := &http.Protocols{}
+		protocols.SetHTTP1(true)
+		protocols.SetUnencryptedHTTP2(true)
+		httpS.Protocols = protocols
+		httpS.Handler = &grpcHandler{grpcServer: grpcS, handler: httpS.Handler}
 	}
 
 	// CMux is used to support servicing gRPC and HTTP1.1+JSON on the same port
@@ -605,29 +741,21 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 	var grpcL net.Listener
 	var httpL net.Listener
 	var httpsL net.Listener
+	var h2cL net.Listener
 	if !server.useTLS() {
 		httpL = tcpm.Match(cmux.HTTP1Fast("PATCH"))
-		grpcL = tcpm.MatchWithWriters(cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc"))
+		if server.H2CEnabled {
+			h2cL = tcpm.Match(cmux.HTTP2())
+		} else {
+			grpcL = tcpm.MatchWithWriters(cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc"))
+		}
 	} else {
 		// We first match on HTTP 1.1 methods.
 		httpL = tcpm.Match(cmux.HTTP1Fast("PATCH"))
 
 		// If not matched, we assume that its TLS.
 		tlsl := tcpm.Match(cmux.Any())
-		tlsConfig := tls.Config{
-			// Advertise that we support both http/1.1 and http2 for application level communication.
-			// By putting http/1.1 first, we ensure that HTTPS clients will use http/1.1, which is the only
-			// protocol our server supports for HTTPS clients. By including h2 in the list, we ensure that
-			// gRPC clients know we support http2 for their communication.
-			NextProtos: []string{"http/1.1", "h2"},
-		}
-		tlsConfig.GetCertificate = func(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
-			return server.settings.Certificate, nil
-		}
-		if server.TLSConfigCustomizer != nil {
-			server.TLSConfigCustomizer(&tlsConfig)
-		}
-		tlsl = tls.NewListener(tlsl, &tlsConfig)
+		tlsl = tls.NewListener(tlsl, server.newTLSConfig(server.TLSConfigCustomizer))
 
 		// Now, we build another mux recursively to match HTTPS and gRPC.
 		tlsm = cmux.New(tlsl)
@@ -635,12 +763,46 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 		grpcL = tlsm.MatchWithWriters(cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc"))
 	}
 
+	// The API server, as opposed to the server redirecting HTTP requests to HTTPS
+	apiS := httpS
+	if httpsS != nil {
+		apiS = httpsS
+	}
+	additionalMuxes := make([]cmux.CMux, 0, len(listeners.Additional))
+	for i, ln := range listeners.Additional {
+		l := server.AdditionalListeners[i]
+		if l.TLS {
+			customizer := l.TLSConfigCustomizer
+			if customizer == nil {
+				customizer = server.TLSConfigCustomizer
+			}
+			ln = tls.NewListener(ln, server.newTLSConfig(customizer))
+		}
+		m := cmux.New(ln)
+		additionalHTTPL := m.Match(cmux.HTTP1Fast("PATCH"))
+		name := l.String()
+		if server.H2CEnabled {
+			additionalH2CL := m.Match(cmux.HTTP2())
+			go func() { server.checkServeErr("h2cS "+name, apiS.Serve(additionalH2CL)) }()
+		} else {
+			additionalGRPCL := m.MatchWithWriters(cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc"))
+			go func() { server.checkServeErr("grpcS "+name, grpcS.Serve(additionalGRPCL)) }()
+		}
+		go func() { server.checkServeErr("httpS "+name, apiS.Serve(additionalHTTPL)) }()
+		additionalMuxes = append(additionalMuxes, m)
+		log.Infof("argocd %s also serving on %s", common.GetVersion(), name)
+	}
+
 	// Start the muxed listeners for our servers
 	log.Infof("argocd %s serving on port %d (url: %s, tls: %v, namespace: %s, sso: %v)",
 		common.GetVersion(), server.ListenPort, server.settings.URL, server.useTLS(), server.Namespace, server.settings.IsSSOConfigured())
 	log.Infof("Enabled application namespace patterns: %s", server.allowedApplicationNamespacesAsString())
 
-	go func() { server.checkServeErr("grpcS", grpcS.Serve(grpcL)) }()
+	if h2cL != nil {
+		go func() { server.checkServeErr("h2cS", httpS.Serve(h2cL)) }()
+	} else {
+		go func() { server.checkServeErr("grpcS", grpcS.Serve(grpcL)) }()
+	}
 	go func() { server.checkServeErr("httpS", httpS.Serve(httpL)) }()
 	if server.useTLS() {
 		go func() { server.checkServeErr("httpsS", httpsS.Serve(httpsL)) }()
@@ -648,7 +810,15 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 	}
 	go server.watchSettings()
 	go server.rbacPolicyLoader(ctx)
+	go server.signingKeyRotator(ctx)
+	if server.RepoProbeInterval > 0 {
+		go repository.NewConnectionProber(svcSet.RepoService, server.RepoProbeInterval, metricsServ).Run(ctx)
+	}
 	go func() { server.checkServeErr("tcpm", tcpm.Serve()) }()
+	for i, m := range additionalMuxes {
+		name := server.AdditionalListeners[i].String()
+		go func() { server.checkServeErr("cmux "+name, m.Serve()) }()
+	}
 	go func() { server.checkServeErr("metrics", metricsServ.Serve(listeners.Metrics)) }()
 	if !cache.WaitForCacheSync(ctx.Done(), server.projInformer.HasSynced, server.appInformer.HasSynced) {
 		log.Fatal("Timed out waiting for project cache to sync")
@@ -657,10 +827,27 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 	shutdownFunc := func() {
 		log.Info("API Server shutdown initiated. Shutting down servers...")
 		server.available.Store(false)
-		shutdownCtx, cancel := context.WithTimeout(ctx, 20*time.Second)
+		if server.healthServer != nil {
+			server.healthServer.Shutdown()
+		}
+		if server.terminateRequested.Load() && server.ShutdownDelay > 0 {
+			// the readiness checks now fail, keep serving until the load balancers stop routing new connections
+			log.Infof("Waiting %s for the load balancers to stop routing traffic to the server", server.ShutdownDelay)
+			time.Sleep(server.ShutdownDelay)
+		}
+		// the shutdown is not cut short when the context of the run is canceled
+		shutdownTimeout := server.ShutdownTimeout
+		if shutdownTimeout <= 0 {
+			shutdownTimeout = DefaultShutdownTimeout
+		}
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
 		defer cancel()
 		var wg gosync.WaitGroup
 
+		// End the long-lived streams, such as watches, logs and terminals, which would otherwise hold the shutdown
+		// until its deadline. Their clients reconnect to another replica.
+		server.streamDrainer.Drain()
+
 		// Shutdown http server
 		wg.Add(1)
 		go func() {
@@ -716,6 +903,15 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 			tcpm.Close()
 		}()
 
+		// Shutdown additional listeners
+		for _, m := range additionalMuxes {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				m.Close()
+			}()
+		}
+
 		c := make(chan struct{})
 		// This goroutine will wait for all servers to conclude the shutdown
 		// process
@@ -728,12 +924,20 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 		case <-c:
 			log.Info("All servers were gracefully shutdown. Exiting...")
 		case <-shutdownCtx.Done():
-			log.Warn("Graceful shutdown timeout. Exiting...")
+			log.Warn("Graceful shutdown timeout. Closing the remaining connections and exiting...")
+			grpcS.Stop()
+			utilio.Close(httpS)
+			if httpsS != nil {
+				utilio.Close(httpsS)
+			}
 		}
 	}
 	server.Shutdown = shutdownFunc
 	signal.Notify(server.stopCh, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 	server.available.Store(true)
+	if server.healthServer != nil {
+		server.healthServer.Resume()
+	}
 
 	select {
 	case signal := <-server.stopCh:
@@ -802,6 +1006,7 @@ func (server *ArgoCDServer) watchSettings() {
 	prevBitbucketServerSecret := server.settings.WebhookBitbucketServerSecret
 	prevGogsSecret := server.settings.WebhookGogsSecret
 	prevExtConfig := server.settings.ExtensionConfig
+	prevClientCertAuthConfig := server.settings.ClientCertAuthConfigRAW
 	var prevCert, prevCertKey string
 	if server.settings.Certificate != nil && !server.Insecure {
 		prevCert, prevCertKey = tlsutil.EncodeX509KeyPairString(*server.settings.Certificate)
@@ -848,6 +1053,10 @@ func (server *ArgoCDServer) watchSettings() {
 			log.Infof("gogs secret modified. restarting")
 			break
 		}
+		if prevClientCertAuthConfig != server.settings.ClientCertAuthConfigRAW {
+			log.Infof("client certificate authentication config modified. restarting")
+			break
+		}
 		if !reflect.DeepEqual(prevExtConfig, server.settings.ExtensionConfig) {
 			prevExtConfig = server.settings.ExtensionConfig
 			log.Infof("extensions configs modified. Updating proxy registry...")
@@ -893,6 +1102,41 @@ func (server *ArgoCDServer) rbacPolicyLoader(ctx context.Context) {
 	errorsutil.CheckError(err)
 }
 
+// signingKeyRotator periodically rotates the token signing keys, when their rotation is configured
+func (server *ArgoCDServer) signingKeyRotator(ctx context.Context) {
+	ticker := time.NewTicker(signingKeyRotationCheckInterval)
+	defer ticker.Stop()
+	for {
+		if err := server.settingsMgr.RotateSigningKeys(time.Now()); err != nil {
+			log.Warnf("Failed to rotate the token signing keys: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// clientCertCAs returns the pool of the CAs issuing the client certificates API clients can authenticate with, or
+// nil if the authentication with client certificates is not configured
+func (server *ArgoCDServer) clientCertCAs() *x509.CertPool {
+	config, err := server.settings.ClientCertAuthConfig()
+	if err != nil {
+		log.Errorf("Client certificate authentication is disabled: %v", err)
+		return nil
+	}
+	if config == nil {
+		return nil
+	}
+	pool, err := config.CertPool()
+	if err != nil {
+		log.Errorf("Client certificate authentication is disabled: %v", err)
+		return nil
+	}
+	return pool
+}
+
 func (server *ArgoCDServer) useTLS() bool {
 	if server.Insecure || server.settings.Certificate == nil {
 		return false
@@ -945,12 +1189,17 @@ func (server *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResour
 		"/application.ApplicationService/GetManifestsWithFiles": true,
 	}
 	// NOTE: notice we do not configure the gRPC server here with TLS (e.g. grpc.Creds(creds))
-	// This is because TLS handshaking occurs in cmux handling
+	// This is because TLS handshaking occurs in cmux handling. The credentials only expose the
+	// state of the TLS connections, so that clients can authenticate with a client certificate.
+	if server.useTLS() {
+		sOpts = append(sOpts, grpc.Creds(grpc_util.NewTLSInfoCredentials()))
+	}
 	sOpts = append(sOpts, grpc.ChainStreamInterceptor(
 		otelgrpc.StreamServerInterceptor(), //nolint:staticcheck // TODO: ignore SA1019 for depreciation: see https://github.com/argoproj/argo-cd/issues/18258
 		logging.StreamServerInterceptor(grpc_util.InterceptorLogger(server.log)),
 		serverMetrics.StreamServerInterceptor(),
 		grpc_auth.StreamServerInterceptor(server.Authenticate),
+		server.requestLimitsStreamServerInterceptor,
 		grpc_util.UserAgentStreamServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
 		grpc_util.PayloadStreamServerInterceptor(server.log, true, func(_ context.Context, c interceptors.CallMeta) bool {
 			return !sensitiveMethods[c.FullMethod()]
@@ -958,6 +1207,7 @@ func (server *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResour
 		grpc_util.ErrorCodeK8sStreamServerInterceptor(),
 		grpc_util.ErrorCodeGitStreamServerInterceptor(),
 		recovery.StreamServerInterceptor(recovery.WithRecoveryHandler(grpc_util.LoggerRecoveryHandler(server.log))),
+		server.streamDrainer.StreamServerInterceptor(),
 	))
 	sOpts = append(sOpts, grpc.ChainUnaryInterceptor(
 		bug21955WorkaroundInterceptor,
@@ -965,6 +1215,7 @@ func (server *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResour
 		logging.UnaryServerInterceptor(grpc_util.InterceptorLogger(server.log)),
 		serverMetrics.UnaryServerInterceptor(),
 		grpc_auth.UnaryServerInterceptor(server.Authenticate),
+		server.requestLimitsUnaryServerInterceptor,
 		grpc_util.UserAgentUnaryServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
 		grpc_util.PayloadUnaryServerInterceptor(server.log, true, func(_ context.Context, c interceptors.CallMeta) bool {
 			return !sensitiveMethods[c.FullMethod()]
@@ -988,13 +1239,31 @@ func (server *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResour
 	accountpkg.RegisterAccountServiceServer(grpcS, server.serviceSet.AccountService)
 	certificatepkg.RegisterCertificateServiceServer(grpcS, server.serviceSet.CertificateService)
 	gpgkeypkg.RegisterGPGKeyServiceServer(grpcS, server.serviceSet.GpgkeyService)
-	// Register reflection service on gRPC server.
-	reflection.Register(grpcS)
+	if server.GRPCHealthEnabled {
+		// the server is reported as serving once it is available, see Run
+		server.healthServer = health.NewServer()
+		server.healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+		grpc_health_v1.RegisterHealthServer(grpcS, &grpcHealthServer{Server: server.healthServer})
+	}
+	if server.GRPCReflectionEnabled {
+		// Register reflection service on gRPC server.
+		reflection.Register(grpcS)
+	}
 	serverMetrics.InitializeMetrics(grpcS)
 	errorsutil.CheckError(server.serviceSet.ProjectService.NormalizeProjs())
 	return grpcS, server.serviceSet.AppResourceTreeFn
 }
 
+// grpcHealthServer is the grpc.health.v1 health service of the API server, which does not require authentication
+type grpcHealthServer struct {
+	*health.Server
+}
+
+// AuthFuncOverride allows the health of the server to be checked without auth
+func (s *grpcHealthServer) AuthFuncOverride(ctx context.Context, _ string) (context.Context, error) {
+	return ctx, nil
+}
+
 type ArgoCDServiceSet struct {
 	ClusterService        *cluster.Server
 	RepoService           *repository.Server
@@ -1021,7 +1290,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 	if maxConcurrentLoginRequestsCount > 0 {
 		loginRateLimiter = session.NewLoginRateLimiter(maxConcurrentLoginRequestsCount)
 	}
-	sessionService := session.NewServer(a.sessionMgr, a.settingsMgr, a, a.policyEnforcer, loginRateLimiter)
+	sessionService := session.NewServer(a.sessionMgr, a.settingsMgr, a, a.policyEnforcer, loginRateLimiter, a.auditLogger)
 	projectLock := sync.NewKeyLock()
 	applicationService, appResourceTreeFn := application.NewServer(
 		a.Namespace,
@@ -1062,6 +1331,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.AllowedScmProviders,
 		a.EnableScmProviders,
 		a.EnableGitHubAPIMetrics,
+		a.MatrixMaxGenerators,
 		a.EnableK8sEvent,
 	)
 
@@ -1169,13 +1439,23 @@ func compressHandler(handler http.Handler) http.Handler {
 func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandler http.Handler, appResourceTreeFn application.AppResourceTreeFn, conn *grpc.ClientConn, metricsReg HTTPMetricsRegistry) *http.Server {
 	endpoint := fmt.Sprintf("localhost:%d", port)
 	mux := http.NewServeMux()
+	var repoAccessAuditStore audit.Store
+	if server.RedisClient != nil {
+		repoAccessAuditStore = audit.NewRedisStore(server.RedisClient, server.RedisKeyPrefix, audit.DefaultMaxRecords)
+	}
 	httpS := http.Server{
 		Addr: endpoint,
 		Handler: &handlerSwitcher{
 			handler: mux,
 			urlToHandler: map[string]http.Handler{
-				"/api/badge":          badge.NewHandler(server.AppClientset, server.settingsMgr, server.Namespace, server.ApplicationNamespaces),
-				common.LogoutEndpoint: logout.NewHandler(server.settingsMgr, server.sessionMgr, server.RootPath, server.BaseHRef),
+				"/api/badge":                          badge.NewHandler(server.AppClientset, server.settingsMgr, server.Namespace, server.ApplicationNamespaces),
+				common.LogoutEndpoint:                 logout.NewHandler(server.settingsMgr, server.sessionMgr, server.RootPath, server.BaseHRef),
+				common.TokenExchangeEndpoint:          tokenexchange.NewHandler(server.AppClientset, server.settingsMgr, server.sessionMgr, server.Namespace),
+				common.JWKSEndpoint:                   jwks.NewHandler(server.settingsMgr),
+				common.ParameterEncryptionKeyEndpoint: parameterencryption.NewHandler(server.settingsMgr),
+				common.RepositoryAccessAuditEndpoint:  server.withAuthentication(repoaudit.NewHandler(repoAccessAuditStore, server.db, server.enf)),
+				common.DeploymentLogEndpoint:          server.withAuthentication(deploymentlog.NewHandler(server.appLister, server.Namespace, server.ApplicationNamespaces, server.enf)),
+				common.FederationApplicationsEndpoint: server.withAuthentication(federation.NewHandler(server.settingsMgr, server.enf, server.Namespace)),
 			},
 			contentTypeToHandler: map[string]http.Handler{
 				"application/grpc-web+proto": grpcWebHandler,
@@ -1191,12 +1471,15 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 	// we use our own Marshaler
 	gwMuxOpts := runtime.WithMarshalerOption(runtime.MIMEWildcard, new(grpc_util.JSONMarshaler))
 	gwCookieOpts := runtime.WithForwardResponseOption(server.translateGrpcCookieHeader)
-	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts)
+	gwHeaderOpts := runtime.WithIncomingHeaderMatcher(impersonationHeaderMatcher)
+	gwErrorOpts := runtime.WithProtoErrorHandler(grpc_util.HTTPErrorHandler)
+	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts, gwHeaderOpts, gwErrorOpts)
 
 	var handler http.Handler = gwmux
 	if server.EnableGZip {
 		handler = compressHandler(handler)
 	}
+	handler = server.limitRequestBody(handler)
 	if len(server.ContentTypes) > 0 {
 		handler = enforceContentTypes(handler, server.ContentTypes)
 	} else {
@@ -1204,7 +1487,7 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 	}
 	mux.Handle("/api/", handler)
 
-	terminalOpts := application.TerminalOptions{DisableAuth: server.DisableAuth, Enf: server.enf}
+	terminalOpts := application.TerminalOptions{DisableAuth: server.DisableAuth, Enf: server.enf, Draining: server.streamDrainer.Draining()}
 
 	terminal := application.NewHandler(server.appLister, server.Namespace, server.ApplicationNamespaces, server.db, appResourceTreeFn, server.settings.ExecShells, server.sessionMgr, &terminalOpts).
 		WithFeatureFlagMiddleware(server.settingsMgr.GetSettings)
@@ -1236,6 +1519,7 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 
 	// Swagger UI
 	swagger.ServeSwaggerUI(mux, assets.SwaggerJSON, "/swagger-ui", server.RootPath)
+	swagger.ServeOpenAPI(mux, assets.OpenAPIJSON, "/swagger-ui")
 	healthz.ServeHealthCheck(mux, server.healthCheck)
 
 	// Dex reverse proxy and client app and OAuth2 login/callback
@@ -1243,7 +1527,7 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 
 	// Webhook handler for git events (Note: cache timeouts are hardcoded because API server does not write to cache and not really using them)
 	argoDB := db.NewDB(server.Namespace, server.settingsMgr, server.KubeClientset)
-	acdWebhookHandler := webhook.NewHandler(server.Namespace, server.ApplicationNamespaces, server.WebhookParallelism, server.AppClientset, server.settings, server.settingsMgr, server.RepoServerCache, server.Cache, argoDB, server.settingsMgr.GetMaxWebhookPayloadSize())
+	acdWebhookHandler := webhook.NewHandler(server.Namespace, server.ApplicationNamespaces, server.WebhookParallelism, server.AppClientset, server.settings, server.settingsMgr, server.RepoServerCache, server.Cache, argoDB, server.settingsMgr.GetMaxWebhookPayloadSize(), server.WebhookRefreshHints)
 
 	mux.HandleFunc("/api/webhook", acdWebhookHandler.Handler)
 
@@ -1262,9 +1546,18 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 	mux.Handle("/extensions.js", extensionsHandler)
 
 	// Serve UI static assets
-	var assetsHandler http.Handler = http.HandlerFunc(server.newStaticAssetsHandler())
+	staticAssetsHandler := http.HandlerFunc(server.newStaticAssetsHandler())
+	var assetsHandler http.Handler = staticAssetsHandler
 	if server.EnableGZip {
-		assetsHandler = compressHandler(assetsHandler)
+		compressedAssetsHandler := compressHandler(staticAssetsHandler)
+		assetsHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// the pre-compressed Brotli variants are served as is
+			if acceptsBrotli(r) && server.uiAssetExists(r.URL.Path+brotliAssetSuffix) {
+				staticAssetsHandler.ServeHTTP(w, r)
+			} else {
+				compressedAssetsHandler.ServeHTTP(w, r)
+			}
+		})
 	}
 	mux.Handle("/", assetsHandler)
 	return &httpS
@@ -1352,7 +1645,7 @@ func (server *ArgoCDServer) registerDexHandlers(mux *http.ServeMux) {
 	// Run dex OpenID Connect Identity Provider behind a reverse proxy (served at /api/dex)
 	var err error
 	mux.HandleFunc(common.DexAPIEndpoint+"/", dexutil.NewDexHTTPReverseProxy(server.DexServerAddr, server.BaseHRef, server.DexTLSConfig))
-	server.ssoClientApp, err = oidc.NewClientApp(server.settings, server.DexServerAddr, server.DexTLSConfig, server.BaseHRef, cacheutil.NewRedisCache(server.RedisClient, server.settings.UserInfoCacheExpiration(), cacheutil.RedisCompressionNone))
+	server.ssoClientApp, err = oidc.NewClientApp(server.settings, server.DexServerAddr, server.DexTLSConfig, server.BaseHRef, cacheutil.NewRedisCacheWithKeyPrefix(server.RedisClient, server.settings.UserInfoCacheExpiration(), cacheutil.RedisCompressionNone, server.RedisKeyPrefix))
 	errorsutil.CheckError(err)
 	mux.HandleFunc(common.LoginEndpoint, server.ssoClientApp.HandleLogin)
 	mux.HandleFunc(common.CallbackEndpoint, server.ssoClientApp.HandleCallback)
@@ -1419,6 +1712,7 @@ func (server *ArgoCDServer) getIndexData() ([]byte, error) {
 		} else {
 			server.indexData = []byte(replaceBaseHRef(string(data), fmt.Sprintf(`<base href="/%s/">`, strings.Trim(server.BaseHRef, "/"))))
 		}
+		server.indexETag = newETag(sha256.Sum256(server.indexData))
 	})
 
 	return server.indexData, server.indexDataErr
@@ -1470,6 +1764,7 @@ func (server *ArgoCDServer) newStaticAssetsHandler() func(http.ResponseWriter, *
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 				return
 			}
+			w.Header().Set("ETag", server.indexETag)
 
 			modTime, err := time.Parse(common.GetVersion().BuildDate, time.RFC3339)
 			if err != nil {
@@ -1477,23 +1772,122 @@ func (server *ArgoCDServer) newStaticAssetsHandler() func(http.ResponseWriter, *
 			}
 			http.ServeContent(w, r, "index.html", modTime, utilio.NewByteReadSeeker(data))
 		} else {
-			if isMainJsBundle(r.URL) {
+			if isHashedAsset(r.URL) {
 				cacheControl := "public, max-age=31536000, immutable"
 				if !fileRequest {
 					cacheControl = "no-cache"
 				}
 				w.Header().Set("Cache-Control", cacheControl)
 			}
+			if fileRequest && (r.Method == http.MethodGet || r.Method == http.MethodHead) {
+				server.serveStaticAsset(w, r)
+				return
+			}
 			http.FileServer(server.staticAssets).ServeHTTP(w, r)
 		}
 	}
 }
 
-var mainJsBundleRegex = regexp.MustCompile(`^main\.[0-9a-f]{20}\.js$`)
+// serveStaticAsset serves the requested UI static asset with a strong ETag. Its pre-compressed Brotli variant, the
+// <asset>.br file, is served instead if it exists and the client accepts it.
+func (server *ArgoCDServer) serveStaticAsset(w http.ResponseWriter, r *http.Request) {
+	name := strings.Trim(r.URL.Path, "/")
+	servedName := name
+	if server.uiAssetExists(name + brotliAssetSuffix) {
+		if !slices.Contains(w.Header().Values("Vary"), "Accept-Encoding") {
+			w.Header().Add("Vary", "Accept-Encoding")
+		}
+		if acceptsBrotli(r) {
+			servedName = name + brotliAssetSuffix
+			w.Header().Set("Content-Encoding", brotliEncoding)
+		}
+	}
+	f, err := server.staticAssets.Open(servedName)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer utilio.Close(f)
+	stat, err := f.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	etag, err := server.getStaticAssetETag(servedName, f, stat)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("ETag", etag)
+	// the content type is detected from the name of the asset rather than of its variant
+	http.ServeContent(w, r, path.Base(name), stat.ModTime(), f)
+}
+
+// staticAssetETag is the ETag of a static asset, along with the modification time and size of the asset it was
+// computed for
+type staticAssetETag struct {
+	modTime time.Time
+	size    int64
+	etag    string
+}
+
+// getStaticAssetETag returns the strong ETag of the given static asset, computed from its content. The ETags are cached
+// until the assets of the static assets directory are modified.
+func (server *ArgoCDServer) getStaticAssetETag(name string, f http.File, stat fs.FileInfo) (string, error) {
+	if cached, ok := server.staticAssetETags.Load(name); ok {
+		if cached := cached.(staticAssetETag); cached.modTime.Equal(stat.ModTime()) && cached.size == stat.Size() {
+			return cached.etag, nil
+		}
+	}
+	hash := sha256.New()
+	if _, err := goio.Copy(hash, f); err != nil {
+		return "", fmt.Errorf("failed to read static asset %s: %w", name, err)
+	}
+	if _, err := f.Seek(0, goio.SeekStart); err != nil {
+		return "", fmt.Errorf("failed to read static asset %s: %w", name, err)
+	}
+	etag := newETag([sha256.Size]byte(hash.Sum(nil)))
+	server.staticAssetETags.Store(name, staticAssetETag{modTime: stat.ModTime(), size: stat.Size(), etag: etag})
+	return etag, nil
+}
+
+// newETag returns the strong ETag of the content with the given hash
+func newETag(sum [sha256.Size]byte) string {
+	return fmt.Sprintf(`"%x"`, sum[:16])
+}
+
+const (
+	// brotliEncoding is the content coding of the pre-compressed Brotli variants of the static assets
+	brotliEncoding = "br"
+	// brotliAssetSuffix is the suffix of the files of the pre-compressed Brotli variants of the static assets
+	brotliAssetSuffix = ".br"
+)
+
+// acceptsBrotli returns whether the client accepts the Brotli content coding
+func acceptsBrotli(r *http.Request) bool {
+	for _, encoding := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		name, params, _ := strings.Cut(encoding, ";")
+		if strings.TrimSpace(name) != brotliEncoding {
+			continue
+		}
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if weight, err := strconv.ParseFloat(q, 64); err == nil && weight == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// hashedAssetRegex matches the names of the bundles and chunks of the UI, which embed the hash of their content
+var hashedAssetRegex = regexp.MustCompile(`^[\w-]+\.[0-9a-f]{20}(\.chunk)?\.(js|css)$`)
 
-func isMainJsBundle(url *url.URL) bool {
+// isHashedAsset returns whether the given URL is the URL of an asset whose name embeds the hash of its content, which
+// can be cached forever
+func isHashedAsset(url *url.URL) bool {
 	filename := path.Base(url.Path)
-	return mainJsBundleRegex.MatchString(filename)
+	return hashedAssetRegex.MatchString(filename)
 }
 
 type registerFunc func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error
@@ -1516,11 +1910,23 @@ func (server *ArgoCDServer) Authenticate(ctx context.Context) (context.Context,
 		return ctx, nil
 	}
 	claims, newToken, claimsErr := server.getClaims(ctx)
+	if claims != nil && claimsErr == nil {
+		server.observeTokenUsage(ctx, claims)
+		impersonatedClaims, err := server.impersonate(ctx, claims)
+		if err != nil {
+			return ctx, err
+		}
+		if impersonatedClaims != nil {
+			claims = impersonatedClaims
+			// the renewed token would be issued to the impersonated user
+			newToken = ""
+		}
+	}
 	if claims != nil {
 		// Add claims to the context to inspect for RBAC
 		//nolint:staticcheck
 		ctx = context.WithValue(ctx, "claims", claims)
-		if newToken != "" {
+		if newToken != "" && grpc.ServerTransportStreamFromContext(ctx) != nil {
 			// Session tokens that are expiring soon should be regenerated if user stays active.
 			// The renewed token is stored in outgoing ServerMetadata. Metadata is available to grpc-gateway
 			// response forwarder that will translate it into Set-Cookie header.
@@ -1549,6 +1955,39 @@ func (server *ArgoCDServer) Authenticate(ctx context.Context) (context.Context,
 	return ctx, nil
 }
 
+// withAuthentication authenticates the requests to the given HTTP handler the same way as the API calls: with the token
+// of the Authorization header or of the auth cookie, or with a verified client certificate, and with the impersonation
+// headers. The claims of the caller are added to the context of the request.
+func (server *ArgoCDServer) withAuthentication(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		md := metadata.MD{}
+		for _, header := range []string{"Authorization", "User-Agent", impersonateUserHeader, impersonateGroupHeader} {
+			if values := r.Header.Values(header); len(values) > 0 {
+				md.Set(header, values...)
+			}
+		}
+		if cookies := r.Header.Values("Cookie"); len(cookies) > 0 {
+			md.Set("grpcgateway-cookie", cookies...)
+		}
+		if forwardedFor := r.Header.Values("X-Forwarded-For"); len(forwardedFor) > 0 {
+			md.Set("x-forwarded-for", forwardedFor...)
+		}
+		p := &peer.Peer{}
+		if addr, err := netip.ParseAddrPort(r.RemoteAddr); err == nil {
+			p.Addr = net.TCPAddrFromAddrPort(addr)
+		}
+		if r.TLS != nil {
+			p.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+		}
+		ctx, err := server.Authenticate(peer.NewContext(metadata.NewIncomingContext(r.Context(), md), p))
+		if err != nil {
+			http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func (server *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -1556,6 +1995,14 @@ func (server *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, string,
 	}
 	tokenString := getToken(md)
 	if tokenString == "" {
+		// Clients presenting a verified client certificate do not need a token
+		if cert, ok := grpc_util.VerifiedClientCertificate(ctx); ok {
+			claims, err := server.sessionMgr.VerifyClientCertificate(cert)
+			if err != nil {
+				return nil, "", status.Errorf(codes.Unauthenticated, "invalid client certificate: %v", err)
+			}
+			return claims, "", nil
+		}
 		return nil, "", ErrNoSession
 	}
 	claims, newToken, err := server.sessionMgr.VerifyToken(tokenString)
@@ -1593,6 +2040,125 @@ func (server *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, string,
 	return groupClaims, newToken, nil
 }
 
+// impersonate returns the claims of the user an API call is made as, or nil if the call does not impersonate anyone.
+// The authenticated user must be allowed to impersonate the user and each of the groups.
+func (server *ArgoCDServer) impersonate(ctx context.Context, claims jwt.Claims) (jwt.Claims, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	users := md.Get(impersonateUserHeader)
+	groups := md.Get(impersonateGroupHeader)
+	if len(users) == 0 {
+		if len(groups) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "%s requires %s", impersonateGroupHeader, impersonateUserHeader)
+		}
+		return nil, nil
+	}
+	if len(users) > 1 || users[0] == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must name a single user", impersonateUserHeader)
+	}
+	user := users[0]
+
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid session: %v", err)
+	}
+	impersonator := util_session.UsernameFromClaims(mapClaims)
+	logCtx := log.WithFields(log.Fields{
+		"impersonator":       impersonator,
+		"user":               user,
+		"groups":             groups,
+		"grpc.method":        getGRPCMethod(ctx),
+		common.SecurityField: common.SecurityMedium,
+	})
+	objects := []string{"users/" + user}
+	for _, group := range groups {
+		objects = append(objects, "groups/"+group)
+	}
+	for _, object := range objects {
+		if !server.enf.Enforce(claims, rbac.ResourceAccounts, rbac.ActionImpersonate, object) {
+			logCtx.Warnf("Denied impersonation of %s", object)
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied: %s, %s, %s", rbac.ResourceAccounts, rbac.ActionImpersonate, object)
+		}
+	}
+	logCtx.Info("Impersonating user")
+
+	now := time.Now()
+	impersonatedClaims := jwt.MapClaims{
+		"iss":                          util_session.SessionManagerClaimsIssuer,
+		"sub":                          user,
+		"iat":                          now.Unix(),
+		"nbf":                          now.Unix(),
+		util_session.ImpersonatorClaim: impersonator,
+	}
+	if len(groups) > 0 {
+		scopes := server.policyEnforcer.GetScopes()
+		if len(scopes) == 0 {
+			// the groups are looked up in the default scope when the RBAC configuration sets no scope
+			scopes = rbac.DefaultScopes
+		}
+		impersonatedClaims[scopes[0]] = groups
+	}
+	return impersonatedClaims, nil
+}
+
+// observeTokenUsage records the client IP and user agent the token of the given claims is used from, and reports the
+// usages of the tokens from new client IPs or user agents, which may be stolen tokens
+func (server *ArgoCDServer) observeTokenUsage(ctx context.Context, claims jwt.Claims) {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return
+	}
+	trustedProxies, err := server.settingsMgr.GetLoginTrustedProxies()
+	if err != nil {
+		log.Warnf("Failed to get the trusted proxies: %v", err)
+	}
+	var clientIP, userAgent string
+	if ip := grpc_util.ClientIP(ctx, trustedProxies); ip != nil {
+		clientIP = ip.String()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		// the user agent of the REST API clients is forwarded by the gateway
+		for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+			if values := md.Get(key); len(values) > 0 {
+				userAgent = values[0]
+				break
+			}
+		}
+	}
+	anomalies := server.sessionMgr.ObserveTokenUsage(mapClaims, clientIP, userAgent)
+	if len(anomalies) == 0 {
+		return
+	}
+	user := util_session.UsernameFromClaims(mapClaims)
+	for _, anomaly := range anomalies {
+		message := fmt.Sprintf("Token of %s used from a new client IP %s", user, clientIP)
+		if anomaly == util_session.TokenUsageAnomalyNewUserAgent {
+			message = fmt.Sprintf("Token of %s used with a new user agent %q", user, userAgent)
+		}
+		if server.auditLogger != nil {
+			server.auditLogger.LogLoginEvent(server.Namespace, argo.EventInfo{Reason: argo.EventReasonTokenUsageAnomaly, Type: corev1.EventTypeWarning}, message, user, clientIP)
+		}
+	}
+}
+
+// getGRPCMethod returns the full name of the gRPC method being called
+func getGRPCMethod(ctx context.Context) string {
+	method, _ := grpc.Method(ctx)
+	return method
+}
+
+// impersonationHeaderMatcher forwards the impersonation headers of the REST API calls to the gRPC server, in addition
+// to the headers forwarded by default
+func impersonationHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case impersonateUserHeader, impersonateGroupHeader:
+		return strings.ToLower(key), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 // getToken extracts the token from gRPC metadata or cookie headers
 func getToken(md metadata.MD) string {
 	// check the "token" metadata
@@ -1642,6 +2208,47 @@ func (s *handlerSwitcher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+type tlsStateCtxKey struct{}
+
+// withTLSConnectionState stores the state of the TLS connection terminated in front of cmux in the context of the
+// connection, since the HTTP server only sees the connection wrapping it.
+func withTLSConnectionState(ctx context.Context, conn net.Conn) context.Context {
+	if tlsConn := grpc_util.UnwrapTLSConn(conn); tlsConn != nil {
+		state := tlsConn.ConnectionState()
+		return context.WithValue(ctx, tlsStateCtxKey{}, &state)
+	}
+	return ctx
+}
+
+// tlsStateHandler sets the state of the TLS connection on the requests, so that the client certificate of gRPC-Web
+// requests is exposed to the gRPC handlers.
+type tlsStateHandler struct {
+	handler http.Handler
+}
+
+func (h *tlsStateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if state, ok := r.Context().Value(tlsStateCtxKey{}).(*tls.ConnectionState); ok && r.TLS == nil {
+		r.TLS = state
+	}
+	h.handler.ServeHTTP(w, r)
+}
+
+// grpcHandler serves the gRPC requests over HTTP/2 with the gRPC server, and the other requests, e.g. the gRPC-Web and
+// REST requests, with the given handler
+type grpcHandler struct {
+	grpcServer *grpc.Server
+	handler    http.Handler
+}
+
+func (h *grpcHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	contentType := r.Header.Get("Content-Type")
+	if r.ProtoMajor == 2 && (contentType == "application/grpc" || strings.HasPrefix(contentType, "application/grpc+") || strings.HasPrefix(contentType, "application/grpc;")) {
+		h.grpcServer.ServeHTTP(w, r)
+		return
+	}
+	h.handler.ServeHTTP(w, r)
+}
+
 // Workaround for https://github.com/golang/go/issues/21955 to support escaped URLs in URL path.
 type bug21955Workaround struct {
 	handler http.Handler
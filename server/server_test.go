@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -18,6 +19,9 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -41,6 +45,7 @@ import (
 	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/oidc"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
+	util_session "github.com/argoproj/argo-cd/v3/util/session"
 	settings_util "github.com/argoproj/argo-cd/v3/util/settings"
 	testutil "github.com/argoproj/argo-cd/v3/util/test"
 )
@@ -484,6 +489,101 @@ func TestGracefulShutdown(t *testing.T) {
 	assert.True(t, shutdown)
 }
 
+// runServer runs an API server with the given options on a free port until the returned function is called
+func runServer(t *testing.T, opts ArgoCDServerOpts) (*ArgoCDServer, func()) {
+	t.Helper()
+	port, err := test.GetFreePort()
+	require.NoError(t, err)
+	redis, redisCloser := test.NewInMemoryRedis()
+	opts.ListenPort = port
+	opts.Namespace = test.FakeArgoCDNamespace
+	opts.KubeClientset = fake.NewSimpleClientset(test.NewFakeConfigMap(), test.NewFakeSecret())
+	opts.AppClientset = apps.NewSimpleClientset()
+	opts.RepoClientset = &mocks.Clientset{RepoServerServiceClient: &mocks.RepoServerServiceClient{}}
+	opts.RedisClient = redis
+	s := NewServer(t.Context(), opts, ApplicationSetOpts{})
+
+	projInformerCancel := test.StartInformer(s.projInformer)
+	appInformerCancel := test.StartInformer(s.appInformer)
+	appsetInformerCancel := test.StartInformer(s.appsetInformer)
+
+	lns, err := s.Listen()
+	require.NoError(t, err)
+
+	runCtx, runCancel := context.WithTimeout(t.Context(), 5*time.Second)
+	var wg gosync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.Run(runCtx, lns)
+	}()
+	for !s.available.Load() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	return s, func() {
+		s.stopCh <- syscall.SIGINT
+		wg.Wait()
+		runCancel()
+		appsetInformerCancel()
+		appInformerCancel()
+		projInformerCancel()
+		redisCloser()
+	}
+}
+
+func TestGRPCHealth(t *testing.T) {
+	s, stop := runServer(t, ArgoCDServerOpts{Insecure: true, GRPCHealthEnabled: true})
+
+	conn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", s.ListenPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// the health is checked without auth
+	res, err := grpc_health_v1.NewHealthClient(conn).Check(t.Context(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.Status)
+
+	stop()
+
+	res, err = s.healthServer.Check(t.Context(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, res.Status)
+}
+
+func TestH2C(t *testing.T) {
+	s, stop := runServer(t, ArgoCDServerOpts{Insecure: true, H2CEnabled: true, GRPCHealthEnabled: true})
+	defer stop()
+
+	// the gRPC and HTTP requests are served over the same HTTP/2 cleartext connections
+	protocols := &http.Protocols{}
+	protocols.SetUnencryptedHTTP2(true)
+	client := &http.Client{Transport: &http.Transport{Protocols: protocols}}
+	res, err := client.Get(fmt.Sprintf("http://localhost:%d/healthz", s.ListenPort))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, 2, res.ProtoMajor)
+
+	conn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", s.ListenPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	healthRes, err := grpc_health_v1.NewHealthClient(conn).Check(t.Context(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, healthRes.Status)
+}
+
+func TestH2C_RequiresInsecure(t *testing.T) {
+	s := NewServer(t.Context(), ArgoCDServerOpts{
+		Namespace:     test.FakeArgoCDNamespace,
+		KubeClientset: fake.NewSimpleClientset(test.NewFakeConfigMap(), test.NewFakeSecret()),
+		AppClientset:  apps.NewSimpleClientset(),
+		RepoClientset: &mocks.Clientset{RepoServerServiceClient: &mocks.RepoServerServiceClient{}},
+		H2CEnabled:    true,
+	}, ApplicationSetOpts{})
+	_, err := s.Listen()
+	assert.EqualError(t, err, "h2c requires TLS to be disabled")
+}
+
 func TestAuthenticate(t *testing.T) {
 	type testData struct {
 		test             string
@@ -542,6 +642,120 @@ func TestAuthenticate(t *testing.T) {
 	}
 }
 
+func TestAuthenticate_impersonation(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap(), test.NewFakeSecret())
+	argoCDOpts := ArgoCDServerOpts{
+		Namespace:     test.FakeArgoCDNamespace,
+		KubeClientset: kubeclientset,
+		AppClientset:  apps.NewSimpleClientset(),
+		RepoClientset: &mocks.Clientset{RepoServerServiceClient: &mocks.RepoServerServiceClient{}},
+	}
+	argocd := NewServer(t.Context(), argoCDOpts, ApplicationSetOpts{})
+	token, err := argocd.sessionMgr.Create("admin:login", 0, "abc")
+	require.NoError(t, err)
+	impersonate := func(kv ...string) (context.Context, error) {
+		return argocd.Authenticate(metadata.NewIncomingContext(t.Context(), metadata.Pairs(append([]string{apiclient.MetaDataTokenKey, token}, kv...)...)))
+	}
+
+	t.Run("Not allowed", func(t *testing.T) {
+		_, err := impersonate(impersonateUserHeader, "alice")
+		require.ErrorContains(t, err, "permission denied: accounts, impersonate, users/alice")
+	})
+
+	require.NoError(t, argocd.enf.SetUserPolicy("p, role:admin, accounts, impersonate, users/*, allow\np, role:admin, accounts, impersonate, groups/devs, allow"))
+
+	t.Run("User impersonated", func(t *testing.T) {
+		ctx, err := impersonate(impersonateUserHeader, "alice", impersonateGroupHeader, "devs")
+		require.NoError(t, err)
+		assert.Equal(t, "alice", util_session.Username(ctx))
+		assert.Equal(t, "admin", util_session.Impersonator(ctx))
+		assert.Equal(t, "alice (impersonated by admin)", util_session.AuditUsername(ctx))
+		assert.Equal(t, []string{"devs"}, util_session.Groups(ctx, []string{"groups"}))
+	})
+
+	t.Run("Empty scopes", func(t *testing.T) {
+		argocd.policyEnforcer.SetScopes([]string{})
+		defer argocd.policyEnforcer.SetScopes(nil)
+		ctx, err := impersonate(impersonateUserHeader, "alice", impersonateGroupHeader, "devs")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"devs"}, util_session.Groups(ctx, rbac.DefaultScopes))
+	})
+
+	t.Run("Group not allowed", func(t *testing.T) {
+		_, err := impersonate(impersonateUserHeader, "alice", impersonateGroupHeader, "admins")
+		require.ErrorContains(t, err, "permission denied: accounts, impersonate, groups/admins")
+	})
+
+	t.Run("Group without user", func(t *testing.T) {
+		_, err := impersonate(impersonateGroupHeader, "devs")
+		require.ErrorContains(t, err, "impersonate-group requires impersonate-user")
+	})
+
+	t.Run("No impersonation", func(t *testing.T) {
+		ctx, err := impersonate()
+		require.NoError(t, err)
+		assert.Equal(t, "admin", util_session.Username(ctx))
+		assert.Empty(t, util_session.Impersonator(ctx))
+	})
+}
+
+func TestWithAuthentication(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap(), test.NewFakeSecret())
+	argoCDOpts := ArgoCDServerOpts{
+		Namespace:     test.FakeArgoCDNamespace,
+		KubeClientset: kubeclientset,
+		AppClientset:  apps.NewSimpleClientset(),
+		RepoClientset: &mocks.Clientset{RepoServerServiceClient: &mocks.RepoServerServiceClient{}},
+	}
+	argocd := NewServer(t.Context(), argoCDOpts, ApplicationSetOpts{})
+	token, err := argocd.sessionMgr.Create("admin:login", 0, "abc")
+	require.NoError(t, err)
+	require.NoError(t, argocd.enf.SetUserPolicy("p, role:admin, accounts, impersonate, users/*, allow"))
+
+	var username string
+	handler := argocd.withAuthentication(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		username = util_session.AuditUsername(r.Context())
+	}))
+	serve := func(header http.Header) *httptest.ResponseRecorder {
+		username = ""
+		req := httptest.NewRequest(http.MethodGet, common.DeploymentLogEndpoint, http.NoBody)
+		req.Header = header
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Bearer token", func(t *testing.T) {
+		rr := serve(http.Header{"Authorization": {"Bearer " + token}})
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "admin", username)
+	})
+
+	t.Run("Cookie", func(t *testing.T) {
+		rr := serve(http.Header{"Cookie": {common.AuthCookieName + "=" + token}})
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "admin", username)
+	})
+
+	t.Run("Impersonation", func(t *testing.T) {
+		rr := serve(http.Header{"Authorization": {"Bearer " + token}, "Impersonate-User": {"alice"}})
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "alice (impersonated by admin)", username)
+	})
+
+	t.Run("No token", func(t *testing.T) {
+		rr := serve(http.Header{})
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Empty(t, username)
+	})
+
+	t.Run("Invalid token", func(t *testing.T) {
+		rr := serve(http.Header{"Authorization": {"Bearer " + token + "x"}})
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Empty(t, username)
+	})
+}
+
 func dexMockHandler(t *testing.T, url string) func(http.ResponseWriter, *http.Request) {
 	t.Helper()
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -1389,43 +1603,53 @@ func TestOIDCConfigChangeDetection_NoChange(t *testing.T) {
 	assert.False(t, result, "no error since no config change")
 }
 
-func TestIsMainJsBundle(t *testing.T) {
+func TestIsHashedAsset(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		name           string
-		url            string
-		isMainJsBundle bool
+		name          string
+		url           string
+		isHashedAsset bool
 	}{
 		{
-			name:           "localhost with valid main bundle",
-			url:            "https://localhost:8080/main.e4188e5adc97bbfc00c3.js",
-			isMainJsBundle: true,
+			name:          "localhost with valid main bundle",
+			url:           "https://localhost:8080/main.e4188e5adc97bbfc00c3.js",
+			isHashedAsset: true,
 		},
 		{
-			name:           "localhost and deep path with valid main bundle",
-			url:            "https://localhost:8080/some/argo-cd-instance/main.e4188e5adc97bbfc00c3.js",
-			isMainJsBundle: true,
+			name:          "localhost and deep path with valid main bundle",
+			url:           "https://localhost:8080/some/argo-cd-instance/main.e4188e5adc97bbfc00c3.js",
+			isHashedAsset: true,
 		},
 		{
-			name:           "font file",
-			url:            "https://localhost:8080/assets/fonts/google-fonts/Heebo-Bols.woff2",
-			isMainJsBundle: false,
+			name:          "chunk",
+			url:           "https://localhost:8080/vendors.0fc5e0f7b2ab1d05a7c4.chunk.js",
+			isHashedAsset: true,
 		},
 		{
-			name:           "no dot after main",
-			url:            "https://localhost:8080/main/e4188e5adc97bbfc00c3.js",
-			isMainJsBundle: false,
+			name:          "stylesheet",
+			url:           "https://localhost:8080/main.e4188e5adc97bbfc00c3.css",
+			isHashedAsset: true,
 		},
 		{
-			name:           "wrong extension character",
-			url:            "https://localhost:8080/main.e4188e5adc97bbfc00c3/js",
-			isMainJsBundle: false,
+			name:          "font file",
+			url:           "https://localhost:8080/assets/fonts/google-fonts/Heebo-Bols.woff2",
+			isHashedAsset: false,
 		},
 		{
-			name:           "wrong hash length",
-			url:            "https://localhost:8080/main.e4188e5adc97bbfc00c3abcdefg.js",
-			isMainJsBundle: false,
+			name:          "no dot after main",
+			url:           "https://localhost:8080/main/e4188e5adc97bbfc00c3.js",
+			isHashedAsset: false,
+		},
+		{
+			name:          "wrong extension character",
+			url:           "https://localhost:8080/main.e4188e5adc97bbfc00c3/js",
+			isHashedAsset: false,
+		},
+		{
+			name:          "wrong hash length",
+			url:           "https://localhost:8080/main.e4188e5adc97bbfc00c3abcdefg.js",
+			isHashedAsset: false,
 		},
 	}
 	for _, testCase := range testCases {
@@ -1433,8 +1657,8 @@ func TestIsMainJsBundle(t *testing.T) {
 		t.Run(testCaseCopy.name, func(t *testing.T) {
 			t.Parallel()
 			testURL, _ := url.Parse(testCaseCopy.url)
-			isMainJsBundle := isMainJsBundle(testURL)
-			assert.Equal(t, testCaseCopy.isMainJsBundle, isMainJsBundle)
+			isHashedAsset := isHashedAsset(testURL)
+			assert.Equal(t, testCaseCopy.isHashedAsset, isHashedAsset)
 		})
 	}
 }
@@ -1506,6 +1730,53 @@ func TestCacheControlHeaders(t *testing.T) {
 	}
 }
 
+func TestStaticAssetsETagAndBrotli(t *testing.T) {
+	argocd, closer := fakeServer(t)
+	defer closer()
+	handler := argocd.newStaticAssetsHandler()
+	require.NoError(t, os.WriteFile(filepath.Join(argocd.TmpAssetsDir, "main.e4188e5adc97bbfc00c3.js"), []byte("console.log('main')"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(argocd.TmpAssetsDir, "main.e4188e5adc97bbfc00c3.js.br"), []byte("compressed"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(argocd.TmpAssetsDir, "logo.svg"), []byte("<svg/>"), 0o644))
+
+	serve := func(filename string, header http.Header) *http.Response {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/"+filename, http.NoBody)
+		req.Header = header
+		handler(rr, req)
+		return rr.Result()
+	}
+
+	res := serve("main.e4188e5adc97bbfc00c3.js", http.Header{})
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Empty(t, res.Header.Get("Content-Encoding"))
+	assert.Equal(t, "Accept-Encoding", res.Header.Get("Vary"))
+	assert.Equal(t, "public, max-age=31536000, immutable", res.Header.Get("Cache-Control"))
+	etag := res.Header.Get("ETag")
+	assert.Regexp(t, `^"[0-9a-f]{32}"$`, etag)
+
+	res = serve("main.e4188e5adc97bbfc00c3.js", http.Header{"If-None-Match": {etag}})
+	assert.Equal(t, http.StatusNotModified, res.StatusCode)
+
+	res = serve("main.e4188e5adc97bbfc00c3.js", http.Header{"Accept-Encoding": {"gzip, deflate, br"}})
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "br", res.Header.Get("Content-Encoding"))
+	assert.Equal(t, "text/javascript; charset=utf-8", res.Header.Get("Content-Type"))
+	assert.NotEqual(t, etag, res.Header.Get("ETag"))
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "compressed", string(body))
+
+	res = serve("main.e4188e5adc97bbfc00c3.js", http.Header{"Accept-Encoding": {"gzip, br;q=0"}})
+	assert.Empty(t, res.Header.Get("Content-Encoding"))
+
+	res = serve("logo.svg", http.Header{"Accept-Encoding": {"br"}})
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Empty(t, res.Header.Get("Content-Encoding"))
+	assert.Empty(t, res.Header.Get("Vary"))
+	assert.Empty(t, res.Header.Get("Cache-Control"))
+	assert.NotEmpty(t, res.Header.Get("ETag"))
+}
+
 func TestReplaceBaseHRef(t *testing.T) {
 	testCases := []struct {
 		name        string
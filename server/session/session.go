@@ -7,11 +7,15 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/settings"
 
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	grpc_util "github.com/argoproj/argo-cd/v3/util/grpc"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	sessionmgr "github.com/argoproj/argo-cd/v3/util/session"
 )
@@ -23,6 +27,7 @@ type Server struct {
 	authenticator      Authenticator
 	policyEnf          *rbacpolicy.RBACPolicyEnforcer
 	limitLoginAttempts func() (utilio.Closer, error)
+	auditLogger        *argo.AuditLogger
 }
 
 type Authenticator interface {
@@ -32,60 +37,135 @@ type Authenticator interface {
 const (
 	success = "success"
 	failure = "failure"
+	// unknownAddress is the address of the clients whose IP cannot be determined
+	unknownAddress = "unknown address"
 )
 
 // NewServer returns a new instance of the Session service
-func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsManager, authenticator Authenticator, policyEnf *rbacpolicy.RBACPolicyEnforcer, rateLimiter func() (utilio.Closer, error)) *Server {
-	return &Server{mgr, settingsMgr, authenticator, policyEnf, rateLimiter}
+func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsManager, authenticator Authenticator, policyEnf *rbacpolicy.RBACPolicyEnforcer, rateLimiter func() (utilio.Closer, error), auditLogger *argo.AuditLogger) *Server {
+	return &Server{mgr, settingsMgr, authenticator, policyEnf, rateLimiter, auditLogger}
 }
 
 // Create generates a JWT token signed by Argo CD intended for web/CLI logins of the admin user
 // using username/password
-func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*session.SessionResponse, error) {
+func (s *Server) Create(ctx context.Context, q *session.SessionCreateRequest) (*session.SessionResponse, error) {
 	if s.limitLoginAttempts != nil {
 		closer, err := s.limitLoginAttempts()
 		if err != nil {
-			s.mgr.IncLoginRequestCounter(failure)
+			s.loginFailed(ctx)
 			return nil, err
 		}
 		defer utilio.Close(closer)
 	}
 
 	if q.Token != "" {
-		s.mgr.IncLoginRequestCounter(failure)
+		s.loginFailed(ctx)
 		return nil, status.Errorf(codes.Unauthenticated, "token-based session creation no longer supported. please upgrade argocd cli to v0.7+")
 	}
 	if q.Username == "" || q.Password == "" {
-		s.mgr.IncLoginRequestCounter(failure)
+		s.loginFailed(ctx)
 		return nil, status.Errorf(codes.Unauthenticated, "no credentials supplied")
 	}
-	err := s.mgr.VerifyUsernamePassword(q.Username, q.Password)
+	lockedOut := s.mgr.IsLoginLockedOut(q.Username)
+	// users authenticated by the LDAP server are not local accounts, local accounts take precedence
+	identity, err := s.mgr.VerifyLDAPUsernamePassword(q.Username, q.Password)
+	if err == nil && identity == nil {
+		// the network allowlist is verified before the password, so that a blocked login does not tell whether the
+		// password is valid
+		err = s.verifyLoginAllowedFrom(ctx, q.Username)
+		if err == nil {
+			err = s.mgr.VerifyUsernamePassword(q.Username, q.Password)
+		}
+	}
 	if err != nil {
-		s.mgr.IncLoginRequestCounter(failure)
+		s.loginFailed(ctx)
+		if !lockedOut && s.auditLogger != nil && s.mgr.IsLoginLockedOut(q.Username) {
+			address := s.clientAddress(ctx)
+			s.auditLogger.LogLoginEvent(s.settingsMgr.GetNamespace(), argo.EventInfo{Reason: argo.EventReasonLoginLockedOut, Type: corev1.EventTypeWarning},
+				fmt.Sprintf("Login of account %s locked out after too many failed logins, the last one from %s", q.Username, address), q.Username, address)
+		}
 		return nil, err
 	}
 	uniqueId, err := uuid.NewRandom()
 	if err != nil {
-		s.mgr.IncLoginRequestCounter(failure)
+		s.loginFailed(ctx)
 		return nil, err
 	}
 	argoCDSettings, err := s.settingsMgr.GetSettings()
 	if err != nil {
-		s.mgr.IncLoginRequestCounter(failure)
+		s.loginFailed(ctx)
 		return nil, err
 	}
-	jwtToken, err := s.mgr.Create(
-		fmt.Sprintf("%s:%s", q.Username, settings.AccountCapabilityLogin),
-		int64(argoCDSettings.UserSessionDuration.Seconds()),
-		uniqueId.String())
+	var jwtToken string
+	if identity != nil {
+		jwtToken, err = s.mgr.CreateLDAPSession(identity, int64(argoCDSettings.UserSessionDuration.Seconds()), uniqueId.String())
+	} else {
+		jwtToken, err = s.mgr.Create(
+			fmt.Sprintf("%s:%s", q.Username, settings.AccountCapabilityLogin),
+			int64(argoCDSettings.UserSessionDuration.Seconds()),
+			uniqueId.String())
+	}
 	if err != nil {
-		s.mgr.IncLoginRequestCounter(failure)
+		s.loginFailed(ctx)
 		return nil, err
 	}
 	s.mgr.IncLoginRequestCounter(success)
 	return &session.SessionResponse{Token: jwtToken}, nil
 }
 
+// verifyLoginAllowedFrom verifies that the local account can log in from the address of the client. A blocked login
+// is recorded as an audit event and counted as a failed login of the account, and fails with the same error as an
+// invalid password.
+func (s *Server) verifyLoginAllowedFrom(ctx context.Context, username string) error {
+	account, err := s.settingsMgr.GetAccount(username)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			// unknown accounts are rejected by the verification of the password, like the other invalid logins
+			return nil
+		}
+		return err
+	}
+	if len(account.LoginAllowedCIDRs) == 0 {
+		return nil
+	}
+	trustedProxies, err := s.settingsMgr.GetLoginTrustedProxies()
+	if err != nil {
+		return err
+	}
+	clientIP := grpc_util.ClientIP(ctx, trustedProxies)
+	if account.IsLoginAllowedFrom(clientIP) {
+		return nil
+	}
+	address := unknownAddress
+	if clientIP != nil {
+		address = clientIP.String()
+	}
+	if s.auditLogger != nil {
+		s.auditLogger.LogLoginEvent(s.settingsMgr.GetNamespace(), argo.EventInfo{Reason: argo.EventReasonLoginBlocked, Type: corev1.EventTypeWarning},
+			fmt.Sprintf("Login of account %s from %s blocked by the network allowlist", username, address), username, address)
+	}
+	s.mgr.RecordLoginFailure(username)
+	return sessionmgr.InvalidLoginErr
+}
+
+// loginFailed counts a failed login, by the address of the client
+func (s *Server) loginFailed(ctx context.Context) {
+	s.mgr.IncLoginRequestCounter(failure)
+	s.mgr.IncLoginFailureCounter(s.clientAddress(ctx))
+}
+
+// clientAddress returns the IP of the client of the given context, or "unknown address" if it cannot be determined
+func (s *Server) clientAddress(ctx context.Context) string {
+	trustedProxies, err := s.settingsMgr.GetLoginTrustedProxies()
+	if err != nil {
+		log.Warnf("Failed to get the trusted proxies of the logins: %v", err)
+	}
+	if clientIP := grpc_util.ClientIP(ctx, trustedProxies); clientIP != nil {
+		return clientIP.String()
+	}
+	return unknownAddress
+}
+
 // Delete an authentication cookie from the client.  This makes sense only for the Web client.
 func (s *Server) Delete(_ context.Context, _ *session.SessionDeleteRequest) (*session.SessionResponse, error) {
 	return &session.SessionResponse{Token: ""}, nil
@@ -0,0 +1,128 @@
+package session
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	sessionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/password"
+	sessionutil "github.com/argoproj/argo-cd/v3/util/session"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const testNamespace = "default"
+
+func TestCreate_LoginAllowedCIDRs(t *testing.T) {
+	hash, err := password.HashPassword("password")
+	require.NoError(t, err)
+	kubeClient := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{"admin.loginAllowedCIDRs": "10.0.0.0/8"},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-secret",
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string][]byte{
+			"admin.password":   []byte(hash),
+			"server.secretkey": []byte("test"),
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, testNamespace)
+	sessionMgr := sessionutil.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, sessionutil.NewUserStateStorage(nil))
+	server := NewServer(sessionMgr, settingsMgr, nil, nil, nil, argo.NewAuditLogger(kubeClient, "argocd-server", argo.DefaultEnableEventList()))
+	request := &sessionpkg.SessionCreateRequest{Username: "admin", Password: "password"}
+	clientPeer := func(ip string) *peer.Peer {
+		return &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}}
+	}
+
+	response, err := server.Create(peer.NewContext(t.Context(), clientPeer("10.1.2.3")), request)
+	require.NoError(t, err)
+	assert.NotEmpty(t, response.Token)
+
+	_, err = server.Create(peer.NewContext(t.Context(), clientPeer("203.0.113.1")), request)
+	assert.Equal(t, sessionutil.InvalidLoginErr, err)
+	// a blocked login fails like an invalid password, whether the password is valid or not
+	_, err = server.Create(peer.NewContext(t.Context(), clientPeer("203.0.113.1")), &sessionpkg.SessionCreateRequest{Username: "admin", Password: "wrong"})
+	assert.Equal(t, sessionutil.InvalidLoginErr, err)
+	assert.Equal(t, 2, sessionMgr.GetLoginFailures()["admin"].FailCount)
+
+	events, err := kubeClient.CoreV1().Events(testNamespace).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, events.Items, 2)
+	assert.Equal(t, argo.EventReasonLoginBlocked, events.Items[0].Reason)
+	assert.Equal(t, "admin", events.Items[0].Annotations["user"])
+}
+
+// fakeMetricsRegistry counts the failed logins by source
+type fakeMetricsRegistry struct {
+	loginFailures map[string]int
+}
+
+func (r *fakeMetricsRegistry) IncLoginRequestCounter(_ string) {}
+
+func (r *fakeMetricsRegistry) IncLoginFailureCounter(source string) {
+	r.loginFailures[source]++
+}
+
+func (r *fakeMetricsRegistry) IncTokenVerificationFailureCounter(_ string, _ string) {}
+
+func (r *fakeMetricsRegistry) IncTokenUsageAnomalyCounter(_ string) {}
+
+func TestCreate_LoginFailures(t *testing.T) {
+	t.Setenv("ARGOCD_SESSION_FAILURE_MAX_FAIL_COUNT", "2")
+	hash, err := password.HashPassword("password")
+	require.NoError(t, err)
+	kubeClient := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-secret",
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string][]byte{
+			"admin.password":   []byte(hash),
+			"server.secretkey": []byte("test"),
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, testNamespace)
+	sessionMgr := sessionutil.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, sessionutil.NewUserStateStorage(nil))
+	registry := &fakeMetricsRegistry{loginFailures: map[string]int{}}
+	sessionMgr.CollectMetrics(registry)
+	server := NewServer(sessionMgr, settingsMgr, nil, nil, nil, argo.NewAuditLogger(kubeClient, "argocd-server", argo.DefaultEnableEventList()))
+	ctx := peer.NewContext(t.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.1"), Port: 1234}})
+
+	for range 3 {
+		_, err = server.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "wrong"})
+		require.Error(t, err)
+	}
+	_, err = server.Create(t.Context(), &sessionpkg.SessionCreateRequest{Username: "admin"})
+	require.Error(t, err)
+	assert.Equal(t, map[string]int{"203.0.113.1": 3, "unknown address": 1}, registry.loginFailures)
+
+	// the lockout is recorded once
+	events, err := kubeClient.CoreV1().Events(testNamespace).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, events.Items, 1)
+	assert.Equal(t, argo.EventReasonLoginLockedOut, events.Items[0].Reason)
+	assert.Equal(t, "Login of account admin locked out after too many failed logins, the last one from 203.0.113.1", events.Items[0].Message)
+}
@@ -0,0 +1,258 @@
+package tokenexchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v3/util/session"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// Token exchange parameter values defined by RFC 8693
+const (
+	GrantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+	TokenTypeJWT           = "urn:ietf:params:oauth:token-type:jwt"
+	TokenTypeIDToken       = "urn:ietf:params:oauth:token-type:id_token"
+	TokenTypeAccessToken   = "urn:ietf:params:oauth:token-type:access_token"
+)
+
+// maxRequestSize limits the size of the token exchange requests
+const maxRequestSize = 64 * 1024
+
+// Error codes of the token exchange responses, as defined by RFC 6749 and RFC 8693
+const (
+	errInvalidRequest       = "invalid_request"
+	errInvalidScope         = "invalid_scope"
+	errUnsupportedGrantType = "unsupported_grant_type"
+	errServerError          = "server_error"
+)
+
+// supportedSigningAlgs are the algorithms accepted for the exchanged tokens when their keys are not discovered
+var supportedSigningAlgs = []string{
+	gooidc.RS256, gooidc.RS384, gooidc.RS512,
+	gooidc.ES256, gooidc.ES384, gooidc.ES512,
+	gooidc.PS256, gooidc.PS384, gooidc.PS512,
+	gooidc.EdDSA,
+}
+
+// TokenResponse is the successful response of a token exchange
+type TokenResponse struct {
+	AccessToken     string `json:"access_token"`
+	IssuedTokenType string `json:"issued_token_type"`
+	TokenType       string `json:"token_type"`
+	ExpiresIn       int64  `json:"expires_in"`
+	Scope           string `json:"scope"`
+}
+
+// ErrorResponse is the error response of a token exchange
+type ErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// NewHandler creates handler serving the api/token-exchange endpoint
+func NewHandler(appClientset versioned.Interface, settingsMgr *settings.SettingsManager, sessionMgr *session.SessionManager, namespace string) *Handler {
+	return &Handler{
+		appClientset: appClientset,
+		settingsMgr:  settingsMgr,
+		namespace:    namespace,
+		createToken:  sessionMgr.CreateExchangedToken,
+		client:       &http.Client{Transport: &http.Transport{Proxy: http.ProxyFromEnvironment}},
+		verifiers:    map[string]*gooidc.IDTokenVerifier{},
+	}
+}
+
+// Handler exchanges tokens issued to workloads by trusted OIDC issuers for short-lived project role tokens, following
+// RFC 8693. It spares CI systems from storing long-lived project role tokens.
+type Handler struct {
+	appClientset versioned.Interface
+	settingsMgr  *settings.SettingsManager
+	namespace    string
+	createToken  func(subject string, duration time.Duration, id string, actor session.ExchangedTokenActor) (string, error)
+	client       *http.Client
+
+	verifiersLock sync.Mutex
+	verifiers     map[string]*gooidc.IDTokenVerifier
+}
+
+type exchangeError struct {
+	status      int
+	code        string
+	description string
+}
+
+func (e *exchangeError) Error() string {
+	return e.description
+}
+
+func newExchangeError(status int, code string, format string, args ...any) *exchangeError {
+	return &exchangeError{status: status, code: code, description: fmt.Sprintf(format, args...)}
+}
+
+// ServeHTTP handles a token exchange request
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	resp, err := h.exchange(r)
+	if err != nil {
+		var exchangeErr *exchangeError
+		if !errors.As(err, &exchangeErr) {
+			log.Errorf("Token exchange failed: %v", err)
+			exchangeErr = newExchangeError(http.StatusInternalServerError, errServerError, "token exchange failed")
+		}
+		w.WriteHeader(exchangeErr.status)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{Error: exchangeErr.code, ErrorDescription: exchangeErr.description})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (h *Handler) exchange(r *http.Request) (*TokenResponse, error) {
+	if r.Method != http.MethodPost {
+		return nil, newExchangeError(http.StatusMethodNotAllowed, errInvalidRequest, "token exchange requests must use the POST method")
+	}
+	r.Body = http.MaxBytesReader(nil, r.Body, maxRequestSize)
+	if err := r.ParseForm(); err != nil {
+		return nil, newExchangeError(http.StatusBadRequest, errInvalidRequest, "invalid request: %v", err)
+	}
+	if grantType := r.PostForm.Get("grant_type"); grantType != GrantTypeTokenExchange {
+		return nil, newExchangeError(http.StatusBadRequest, errUnsupportedGrantType, "unsupported grant type %q", grantType)
+	}
+	subjectToken := r.PostForm.Get("subject_token")
+	if subjectToken == "" {
+		return nil, newExchangeError(http.StatusBadRequest, errInvalidRequest, "subject_token is required")
+	}
+	if tokenType := r.PostForm.Get("subject_token_type"); tokenType != TokenTypeJWT && tokenType != TokenTypeIDToken {
+		return nil, newExchangeError(http.StatusBadRequest, errInvalidRequest, "unsupported subject token type %q", tokenType)
+	}
+	if tokenType := r.PostForm.Get("requested_token_type"); tokenType != "" && tokenType != TokenTypeAccessToken {
+		return nil, newExchangeError(http.StatusBadRequest, errInvalidRequest, "unsupported requested token type %q", tokenType)
+	}
+	scope := r.PostForm.Get("scope")
+	projName, roleName, ok := rbacpolicy.GetProjectRoleFromSubject(scope)
+	if !ok {
+		return nil, newExchangeError(http.StatusBadRequest, errInvalidScope, "scope must be a single project role, in the form proj:<project>:<role>")
+	}
+
+	argoCDSettings, err := h.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting settings: %w", err)
+	}
+	config, err := argoCDSettings.TokenExchangeConfig()
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, newExchangeError(http.StatusBadRequest, errUnsupportedGrantType, "token exchange is not configured")
+	}
+
+	// the issuer of the subject token is only trusted once the token is verified with its keys
+	var unverifiedClaims jwt.RegisteredClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(subjectToken, &unverifiedClaims); err != nil {
+		return nil, newExchangeError(http.StatusBadRequest, errInvalidRequest, "invalid subject token: %v", err)
+	}
+	issuer, err := config.GetIssuer(unverifiedClaims.Issuer)
+	if err != nil {
+		return nil, newExchangeError(http.StatusBadRequest, errInvalidRequest, "invalid subject token: %v", err)
+	}
+	idToken, err := h.verify(r.Context(), issuer, subjectToken)
+	if err != nil {
+		return nil, newExchangeError(http.StatusBadRequest, errInvalidRequest, "invalid subject token: %v", err)
+	}
+	var claims map[string]any
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, newExchangeError(http.StatusBadRequest, errInvalidRequest, "invalid subject token: %v", err)
+	}
+
+	binding, err := issuer.FindBinding(projName, roleName, claims)
+	if err != nil {
+		return nil, newExchangeError(http.StatusBadRequest, errInvalidScope, "subject token cannot be exchanged for role %s of project %s: %v", roleName, projName, err)
+	}
+	proj, err := h.appClientset.ArgoprojV1alpha1().AppProjects(h.namespace).Get(r.Context(), projName, metav1.GetOptions{})
+	if err != nil {
+		return nil, newExchangeError(http.StatusBadRequest, errInvalidScope, "project %s cannot be retrieved", projName)
+	}
+	if _, _, err := proj.GetRoleByName(roleName); err != nil {
+		return nil, newExchangeError(http.StatusBadRequest, errInvalidScope, "%v", err)
+	}
+	duration, err := binding.TokenDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token id: %w", err)
+	}
+	actor := session.ExchangedTokenActor{Issuer: idToken.Issuer, Subject: idToken.Subject}
+	token, err := h.createToken(scope, duration, id.String(), actor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token: %w", err)
+	}
+	log.WithFields(log.Fields{
+		"project": projName,
+		"role":    roleName,
+		"issuer":  actor.Issuer,
+		"subject": actor.Subject,
+		"id":      id.String(),
+	}).Info("Exchanged workload token for project role token")
+
+	return &TokenResponse{
+		AccessToken:     token,
+		IssuedTokenType: TokenTypeAccessToken,
+		TokenType:       "Bearer",
+		ExpiresIn:       int64(duration.Seconds()),
+		Scope:           scope,
+	}, nil
+}
+
+// verify verifies the signature, expiry and audience of a token of the given issuer
+func (h *Handler) verify(ctx context.Context, issuer *settings.TokenExchangeIssuer, token string) (*gooidc.IDToken, error) {
+	verifier, err := h.verifier(ctx, issuer)
+	if err != nil {
+		return nil, err
+	}
+	return verifier.Verify(ctx, token)
+}
+
+// verifier returns the memoized verifier of the tokens of the given issuer
+func (h *Handler) verifier(ctx context.Context, issuer *settings.TokenExchangeIssuer) (*gooidc.IDTokenVerifier, error) {
+	key := strings.Join([]string{issuer.Issuer, issuer.JWKSURL, issuer.Audience}, "|")
+	h.verifiersLock.Lock()
+	defer h.verifiersLock.Unlock()
+	if verifier, ok := h.verifiers[key]; ok {
+		return verifier, nil
+	}
+
+	// the key sets are refreshed in the background of later requests, so they must not use the request context
+	clientCtx := gooidc.ClientContext(context.Background(), h.client)
+	config := &gooidc.Config{ClientID: issuer.Audience}
+	var verifier *gooidc.IDTokenVerifier
+	if issuer.JWKSURL != "" {
+		config.SupportedSigningAlgs = supportedSigningAlgs
+		verifier = gooidc.NewVerifier(issuer.Issuer, gooidc.NewRemoteKeySet(clientCtx, issuer.JWKSURL), config)
+	} else {
+		provider, err := gooidc.NewProvider(gooidc.ClientContext(ctx, h.client), issuer.Issuer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query issuer %q: %w", issuer.Issuer, err)
+		}
+		verifier = provider.VerifierContext(clientCtx, config)
+	}
+	h.verifiers[key] = verifier
+	return verifier, nil
+}
@@ -0,0 +1,191 @@
+package tokenexchange
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v3/util/session"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+	utiltest "github.com/argoproj/argo-cd/v3/util/test"
+)
+
+type createdToken struct {
+	subject  string
+	duration time.Duration
+	actor    session.ExchangedTokenActor
+}
+
+func newTestHandler(t *testing.T, issuerURL string, client *http.Client) (*Handler, *createdToken) {
+	t.Helper()
+	kubeClient := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: "argocd",
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{
+			"tokenexchange.config": fmt.Sprintf(`
+issuers:
+- issuer: %s
+  audience: argocd
+  bindings:
+  - project: default
+    role: ci
+    claims:
+      repository: argoproj/*
+    duration: 10m
+`, issuerURL),
+		},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "argocd"},
+		Data:       map[string][]byte{"server.secretkey": []byte("test")},
+	})
+	appClientset := appclientset.NewSimpleClientset(&v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec:       v1alpha1.AppProjectSpec{Roles: []v1alpha1.ProjectRole{{Name: "ci"}}},
+	})
+
+	created := &createdToken{}
+	return &Handler{
+		appClientset: appClientset,
+		settingsMgr:  settings.NewSettingsManager(t.Context(), kubeClient, "argocd"),
+		namespace:    "argocd",
+		createToken: func(subject string, duration time.Duration, _ string, actor session.ExchangedTokenActor) (string, error) {
+			*created = createdToken{subject: subject, duration: duration, actor: actor}
+			return "exchanged-token", nil
+		},
+		client:    client,
+		verifiers: map[string]*gooidc.IDTokenVerifier{},
+	}, created
+}
+
+func signToken(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(utiltest.PrivateKey)
+	require.NoError(t, err)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS512, claims).SignedString(key)
+	require.NoError(t, err)
+	return token
+}
+
+func exchange(h *Handler, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/token-exchange", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestHandler(t *testing.T) {
+	oidcTestServer := utiltest.GetOIDCTestServer(t, nil)
+	t.Cleanup(oidcTestServer.Close)
+	h, created := newTestHandler(t, oidcTestServer.URL, oidcTestServer.Client())
+
+	subjectToken := func(repository, audience string) string {
+		return signToken(t, jwt.MapClaims{
+			"iss":        oidcTestServer.URL,
+			"sub":        "repo:" + repository + ":ref:refs/heads/main",
+			"aud":        audience,
+			"repository": repository,
+			"iat":        time.Now().Unix(),
+			"exp":        time.Now().Add(time.Hour).Unix(),
+		})
+	}
+	form := func(token, scope string) url.Values {
+		return url.Values{
+			"grant_type":         {GrantTypeTokenExchange},
+			"subject_token":      {token},
+			"subject_token_type": {TokenTypeJWT},
+			"scope":              {scope},
+		}
+	}
+
+	t.Run("Exchanged", func(t *testing.T) {
+		rr := exchange(h, form(subjectToken("argoproj/argo-cd", "argocd"), "proj:default:ci"))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+
+		var resp TokenResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, TokenResponse{
+			AccessToken:     "exchanged-token",
+			IssuedTokenType: TokenTypeAccessToken,
+			TokenType:       "Bearer",
+			ExpiresIn:       600,
+			Scope:           "proj:default:ci",
+		}, resp)
+		assert.Equal(t, createdToken{
+			subject:  "proj:default:ci",
+			duration: 10 * time.Minute,
+			actor:    session.ExchangedTokenActor{Issuer: oidcTestServer.URL, Subject: "repo:argoproj/argo-cd:ref:refs/heads/main"},
+		}, *created)
+	})
+
+	errorCode := func(t *testing.T, rr *httptest.ResponseRecorder) string {
+		t.Helper()
+		var resp ErrorResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		return resp.Error
+	}
+
+	t.Run("Claims not matching", func(t *testing.T) {
+		rr := exchange(h, form(subjectToken("evil/argo-cd", "argocd"), "proj:default:ci"))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, errInvalidScope, errorCode(t, rr))
+	})
+
+	t.Run("Wrong audience", func(t *testing.T) {
+		rr := exchange(h, form(subjectToken("argoproj/argo-cd", "other"), "proj:default:ci"))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, errInvalidRequest, errorCode(t, rr))
+	})
+
+	t.Run("Role without binding", func(t *testing.T) {
+		rr := exchange(h, form(subjectToken("argoproj/argo-cd", "argocd"), "proj:default:admin"))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, errInvalidScope, errorCode(t, rr))
+	})
+
+	t.Run("Untrusted issuer", func(t *testing.T) {
+		token := signToken(t, jwt.MapClaims{"iss": "https://accounts.google.com", "aud": "argocd", "repository": "argoproj/argo-cd"})
+		rr := exchange(h, form(token, "proj:default:ci"))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "is not trusted")
+	})
+
+	t.Run("Unsupported grant type", func(t *testing.T) {
+		values := form(subjectToken("argoproj/argo-cd", "argocd"), "proj:default:ci")
+		values.Set("grant_type", "client_credentials")
+		rr := exchange(h, values)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, errUnsupportedGrantType, errorCode(t, rr))
+	})
+
+	t.Run("Invalid scope", func(t *testing.T) {
+		rr := exchange(h, form(subjectToken("argoproj/argo-cd", "argocd"), "admin"))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, errInvalidScope, errorCode(t, rr))
+	})
+
+	t.Run("GET request", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/token-exchange", http.NoBody))
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
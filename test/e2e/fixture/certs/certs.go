@@ -15,7 +15,7 @@ import (
 // on the file system, so argocd-server and argocd-repo-server can use it.
 func AddCustomCACert(t *testing.T) {
 	t.Helper()
-	caCertPath, err := filepath.Abs("../fixture/certs/argocd-test-ca.crt")
+	caCertPath, err := filepath.Abs(fixture.FixturePath("certs/argocd-test-ca.crt"))
 	require.NoError(t, err)
 	// We need to setup TLS certs according to whether we are running tests
 	// against a local workload (repositories available as localhost) and
@@ -46,7 +46,7 @@ func AddCustomSSHKnownHostsKeys(t *testing.T) {
 	t.Helper()
 	source := os.Getenv("ARGOCD_E2E_SSH_KNOWN_HOSTS")
 	if source == "" {
-		source = "../fixture/testrepos/ssh_known_hosts"
+		source = fixture.FixturePath("testrepos/ssh_known_hosts")
 	}
 	knownHostsPath, err := filepath.Abs(source)
 	require.NoError(t, err)
@@ -1,3 +1,7 @@
+// Package fixture implements the fixtures of the E2E tests, which run against a live Argo CD. Its subpackages implement
+// the Given/When/Then steps of the tests of each kind of resource, e.g. the app package for the applications. The
+// packages can be imported by the E2E tests of other modules, such as the ones of extensions and plugins, as described
+// in docs/developer-guide/test-e2e.md.
 package fixture
 
 import (
@@ -52,6 +56,10 @@ const (
 	// notifications controller, metrics server port
 	defaultNotificationServer = "localhost:9001"
 
+	// the paths of the argocd CLI binary and of the directory of the fixture files, relative to the test/e2e directory
+	defaultCLIPath    = "../../dist/argocd"
+	defaultFixtureDir = "../fixture"
+
 	// ensure all repos are in one directory tree, so we can easily clean them up
 	TmpDir             = "/tmp/argo-e2e"
 	repoDir            = "testdata.git"
@@ -79,6 +87,8 @@ const (
 	EnvArgoCDRedisName         = "ARGOCD_E2E_REDIS_NAME"
 	EnvArgoCDRepoServerName    = "ARGOCD_E2E_REPO_SERVER_NAME"
 	EnvArgoCDAppControllerName = "ARGOCD_E2E_APPLICATION_CONTROLLER_NAME"
+	EnvCLIPath                 = "ARGOCD_E2E_CLI_PATH"
+	EnvFixtureDir              = "ARGOCD_E2E_FIXTURE_DIR"
 )
 
 var (
@@ -167,6 +177,20 @@ func GetEnvWithDefault(envName, defaultValue string) string {
 	return r
 }
 
+// CLIPath returns the path of the argocd CLI binary run by the tests. It defaults to the binary built in the dist
+// directory of the repository, and can be set with the ARGOCD_E2E_CLI_PATH environment variable, e.g. by the tests of
+// other modules.
+func CLIPath() string {
+	return GetEnvWithDefault(EnvCLIPath, defaultCLIPath)
+}
+
+// FixturePath returns the path of the given fixture file, such as the certificates, the GPG keys and the SSH keys of
+// the test repositories, given relative to the test/fixture directory of the repository. The directory can be set
+// with the ARGOCD_E2E_FIXTURE_DIR environment variable, e.g. by the tests of other modules.
+func FixturePath(name string) string {
+	return filepath.Join(GetEnvWithDefault(EnvFixtureDir, defaultFixtureDir), filepath.FromSlash(name))
+}
+
 // IsRemote returns true when the tests are being run against a workload that
 // is running in a remote cluster.
 func IsRemote() bool {
@@ -920,7 +944,7 @@ func EnsureCleanState(t *testing.T, opts ...TestOption) {
 			t.Setenv("GNUPGHOME", TmpDir+"/gpg")
 			//nolint:errcheck
 			Run("", "pkill", "-9", "gpg-agent")
-			_, err = Run("", "gpg", "--import", "../fixture/gpg/signingkey.asc")
+			_, err = Run("", "gpg", "--import", FixturePath("gpg/signingkey.asc"))
 			if err != nil {
 				return err
 			}
@@ -1045,12 +1069,12 @@ func RunCliWithStdin(stdin string, isKubeConextOnlyCli bool, args ...string) (st
 
 	args = append(args, "--insecure")
 
-	return RunWithStdin(stdin, "", "../../dist/argocd", args...)
+	return RunWithStdin(stdin, "", CLIPath(), args...)
 }
 
 // RunPluginCli executes an Argo CD CLI plugin with optional stdin input.
 func RunPluginCli(stdin string, args ...string) (string, error) {
-	return RunWithStdin(stdin, "", "../../dist/argocd", args...)
+	return RunWithStdin(stdin, "", CLIPath(), args...)
 }
 
 func Patch(t *testing.T, path string, jsonPatch string) {
@@ -15,7 +15,7 @@ import (
 // Add GPG public key via API and create appropriate file where the ConfigMap mount would de it as well
 func AddGPGPublicKey(t *testing.T) {
 	t.Helper()
-	keyPath, err := filepath.Abs("../fixture/gpg/" + fixture.GpgGoodKeyID)
+	keyPath, err := filepath.Abs(fixture.FixturePath("gpg/" + fixture.GpgGoodKeyID))
 	require.NoError(t, err)
 	args := []string{"gpg", "add", "--from", keyPath}
 	errors.NewHandler(t).FailOnErr(fixture.RunCli(args...))
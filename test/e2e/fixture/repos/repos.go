@@ -15,12 +15,12 @@ import (
 
 func CertPath(t *testing.T) string {
 	t.Helper()
-	return mustToAbsPath(t, "../fixture/certs/argocd-test-client.crt")
+	return mustToAbsPath(t, fixture.FixturePath("certs/argocd-test-client.crt"))
 }
 
 func CertKeyPath(t *testing.T) string {
 	t.Helper()
-	return mustToAbsPath(t, "../fixture/certs/argocd-test-client.key")
+	return mustToAbsPath(t, fixture.FixturePath("certs/argocd-test-client.key"))
 }
 
 func mustToAbsPath(t *testing.T, relativePath string) string {
@@ -33,7 +33,7 @@ func mustToAbsPath(t *testing.T, relativePath string) string {
 // sets the current repo as the default SSH test repo
 func AddSSHRepo(t *testing.T, insecure bool, credentials bool, repoURLType fixture.RepoURLType) {
 	t.Helper()
-	keyPath, err := filepath.Abs("../fixture/testrepos/id_rsa")
+	keyPath, err := filepath.Abs(fixture.FixturePath("testrepos/id_rsa"))
 	require.NoError(t, err)
 	args := []string{"repo", "add", fixture.RepoURL(repoURLType)}
 	if credentials {
@@ -148,9 +148,9 @@ func AddHTTPSCredentialsUserPass(t *testing.T) {
 // AddHTTPSRepoCredentialsTLSClientCert adds E2E  for HTTPS repos to context
 func AddHTTPSCredentialsTLSClientCert(t *testing.T) {
 	t.Helper()
-	certPath, err := filepath.Abs("../fixture/certs/argocd-test-client.crt")
+	certPath, err := filepath.Abs(fixture.FixturePath("certs/argocd-test-client.crt"))
 	require.NoError(t, err)
-	keyPath, err := filepath.Abs("../fixture/certs/argocd-test-client.key")
+	keyPath, err := filepath.Abs(fixture.FixturePath("certs/argocd-test-client.key"))
 	require.NoError(t, err)
 	args := []string{
 		"repocreds",
@@ -167,9 +167,9 @@ func AddHTTPSCredentialsTLSClientCert(t *testing.T) {
 // AddHelmHTTPSCredentialsTLSClientCert adds credentials for Helm repos to context
 func AddHelmHTTPSCredentialsTLSClientCert(t *testing.T) {
 	t.Helper()
-	certPath, err := filepath.Abs("../fixture/certs/argocd-test-client.crt")
+	certPath, err := filepath.Abs(fixture.FixturePath("certs/argocd-test-client.crt"))
 	require.NoError(t, err)
-	keyPath, err := filepath.Abs("../fixture/certs/argocd-test-client.key")
+	keyPath, err := filepath.Abs(fixture.FixturePath("certs/argocd-test-client.key"))
 	require.NoError(t, err)
 	args := []string{
 		"repocreds",
@@ -197,7 +197,7 @@ func AddHelmoOCICredentialsWithoutUserPass(t *testing.T) {
 // AddSSHRepoCredentials adds E2E fixture credentials for SSH repos to context
 func AddSSHCredentials(t *testing.T) {
 	t.Helper()
-	keyPath, err := filepath.Abs("../fixture/testrepos/id_rsa")
+	keyPath, err := filepath.Abs(fixture.FixturePath("testrepos/id_rsa"))
 	require.NoError(t, err)
 	var repoURLType fixture.RepoURLType = fixture.RepoURLTypeSSH
 	args := []string{"repocreds", "add", fixture.RepoBaseURL(repoURLType), "--ssh-private-key-path", keyPath}
@@ -74,7 +74,9 @@ export const RevisionMetadataRows = (props: {applicationName: string; applicatio
     return (
         <DataLoader
             input={props}
-            load={input => services.applications.revisionMetadata(input.applicationName, input.applicationNamespace, input.source.targetRevision, input.index, input.versionId)}>
+            load={input =>
+                services.applications.revisionMetadata(input.applicationName, input.applicationNamespace, input.source.targetRevision, input.index, input.versionId, true)
+            }>
             {(m: RevisionMetadata) => (
                 <div>
                     <div className='row'>
@@ -95,6 +97,32 @@ export const RevisionMetadataRows = (props: {applicationName: string; applicatio
                         <div className='columns small-3'>GPG signature</div>
                         <div className='columns small-9'>{m.signatureInfo || '-'}</div>
                     </div>
+                    {m.pullRequest && (
+                        <div className='row'>
+                            <div className='columns small-3'>Pull request</div>
+                            <div className='columns small-9'>
+                                {m.pullRequest.url ? (
+                                    <a href={m.pullRequest.url} target='_blank' rel='noopener noreferrer' onClick={e => e.stopPropagation()}>
+                                        #{m.pullRequest.number}
+                                    </a>
+                                ) : (
+                                    `#${m.pullRequest.number}`
+                                )}{' '}
+                                {m.pullRequest.title}
+                                {m.pullRequest.author && ` by ${m.pullRequest.author}`}
+                            </div>
+                        </div>
+                    )}
+                    {m.changedFiles && m.changedFiles.length > 0 && (
+                        <div className='row'>
+                            <div className='columns small-3'>Changed files</div>
+                            <div className='columns small-9'>
+                                {m.changedFiles.map(file => (
+                                    <div key={file}>{file}</div>
+                                ))}
+                            </div>
+                        </div>
+                    )}
                 </div>
             )}
         </DataLoader>
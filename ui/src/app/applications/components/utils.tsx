@@ -584,7 +584,25 @@ export async function getResourceActionsMenuItems(resource: ResourceTreeNode, me
                                           default: param.default
                                       }))
                                     : [];
-                                await services.applications.runResourceAction(metadata.name, metadata.namespace, resource, action.name, resourceActionParameters);
+                                const res = await services.applications.runResourceAction(metadata.name, metadata.namespace, resource, action.name, resourceActionParameters);
+                                // the action requires confirmation, it is run by a second call with the returned token
+                                if (res.confirmationToken) {
+                                    const confirmed = await apis.popup.confirm(
+                                        `Confirm ${action.name} action`,
+                                        `The ${action.name} action is destructive. Are you sure you want to continue?`
+                                    );
+                                    if (!confirmed) {
+                                        return;
+                                    }
+                                    await services.applications.runResourceAction(
+                                        metadata.name,
+                                        metadata.namespace,
+                                        resource,
+                                        action.name,
+                                        resourceActionParameters,
+                                        res.confirmationToken
+                                    );
+                                }
                                 close();
                             } catch (e) {
                                 apis.notifications.show({
@@ -1323,7 +1341,7 @@ export const getPodReadinessGatesState = (pod: appModels.State): {nonExistingCon
 };
 
 export function getConditionCategory(condition: appModels.ApplicationCondition): 'error' | 'warning' | 'info' {
-    if (condition.type.endsWith('Error')) {
+    if (condition.type.endsWith('Error') || condition.type === 'ClusterUnreachable') {
         return 'error';
     } else if (condition.type.endsWith('Warning')) {
         return 'warning';
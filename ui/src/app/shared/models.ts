@@ -95,6 +95,15 @@ export interface RevisionMetadata {
     tags?: string[];
     message?: string;
     signatureInfo?: string;
+    changedFiles?: string[];
+    pullRequest?: RevisionPullRequest;
+}
+
+export interface RevisionPullRequest {
+    number: number;
+    title?: string;
+    author?: string;
+    url?: string;
 }
 
 export interface OCIMetadata {
@@ -440,6 +449,16 @@ export interface ApplicationTree {
     hosts: Node[];
 }
 
+export interface ResourceTreeDelta {
+    tree?: ApplicationTree;
+    updatedNodes?: ResourceNode[];
+    removedNodes?: ResourceRef[];
+    updatedOrphanedNodes?: ResourceNode[];
+    removedOrphanedNodes?: ResourceRef[];
+    hosts?: Node[];
+    hostsChanged?: boolean;
+}
+
 export interface ResourceID {
     group: string;
     kind: string;
@@ -589,6 +608,8 @@ export interface ConnectionState {
     status: ConnectionStatus;
     message: string;
     attemptedAt: models.Time;
+    latencyMs?: number;
+    consecutiveFailures?: number;
 }
 
 export interface RepoCert {
@@ -651,6 +672,7 @@ export interface Cluster {
         serverVersion: string;
         connectionState: ConnectionState;
         cacheInfo: ClusterCacheInfo;
+        connectivityInfo?: ClusterConnectivityInfo;
     };
     annotations?: {[name: string]: string};
     labels?: {[name: string]: string};
@@ -662,6 +684,13 @@ export interface ClusterCacheInfo {
     lastCacheSyncTime: models.Time;
 }
 
+export interface ClusterConnectivityInfo {
+    watchDisconnects?: number;
+    listLatencyMs?: number;
+    requestsCount?: number;
+    failedRequestsCount?: number;
+}
+
 export interface ClusterList extends ItemsList<Cluster> {}
 
 export interface HelmChart {
@@ -868,6 +897,7 @@ export interface ResourceAction {
     disabled: boolean;
     iconClass: string;
     displayName: string;
+    requiresConfirmation?: boolean;
 }
 
 export interface SyncWindowsState {
@@ -1,6 +1,6 @@
 import * as deepMerge from 'deepmerge';
 import {Observable} from 'rxjs';
-import {map, repeat, retry} from 'rxjs/operators';
+import {map, repeat, retry, scan} from 'rxjs/operators';
 
 import * as models from '../models';
 import {isValidURL} from '../utils';
@@ -13,6 +13,37 @@ interface QueryOptions {
     appNamespace?: string;
 }
 
+function resourceRefKey(ref: models.ResourceRef) {
+    return `${ref.group}/${ref.version}/${ref.kind}/${ref.namespace}/${ref.name}/${ref.uid}`;
+}
+
+function applyResourceNodesDelta(nodes: models.ResourceNode[], updated: models.ResourceNode[], removed: models.ResourceRef[]) {
+    const updatedByKey = new Map((updated || []).map(node => [resourceRefKey(node), node] as [string, models.ResourceNode]));
+    const removedKeys = new Set((removed || []).map(resourceRefKey));
+    const result = (nodes || [])
+        .filter(node => !removedKeys.has(resourceRefKey(node)))
+        .map(node => {
+            const key = resourceRefKey(node);
+            const updatedNode = updatedByKey.get(key);
+            updatedByKey.delete(key);
+            return updatedNode || node;
+        });
+    return result.concat(Array.from(updatedByKey.values()));
+}
+
+// applyResourceTreeDelta returns the resource tree resulting from the given delta of the resource tree stream applied to the given tree
+function applyResourceTreeDelta(tree: models.ApplicationTree, delta: models.ResourceTreeDelta): models.ApplicationTree {
+    if (delta.tree) {
+        return delta.tree;
+    }
+    return {
+        ...tree,
+        nodes: applyResourceNodesDelta(tree && tree.nodes, delta.updatedNodes, delta.removedNodes),
+        orphanedNodes: applyResourceNodesDelta(tree && tree.orphanedNodes, delta.updatedOrphanedNodes, delta.removedOrphanedNodes),
+        hosts: delta.hostsChanged ? delta.hosts : tree && tree.hosts
+    };
+}
+
 function optionsToSearch(options?: QueryOptions) {
     if (options) {
         return {fields: (options.exclude ? '-' : '') + options.fields.join(','), selector: options.selector || '', appNamespace: options.appNamespace || ''};
@@ -66,7 +97,14 @@ export class ApplicationsService {
         return r.then(res => res.body as models.OCIMetadata);
     }
 
-    public revisionMetadata(name: string, appNamespace: string, revision: string, sourceIndex: number | null, versionId: number | null): Promise<models.RevisionMetadata> {
+    public revisionMetadata(
+        name: string,
+        appNamespace: string,
+        revision: string,
+        sourceIndex: number | null,
+        versionId: number | null,
+        includeChanges = false
+    ): Promise<models.RevisionMetadata> {
         let r = requests.get(`/applications/${name}/revisions/${revision || 'HEAD'}/metadata`).query({appNamespace});
         if (sourceIndex !== null) {
             r = r.query({sourceIndex});
@@ -74,6 +112,9 @@ export class ApplicationsService {
         if (versionId !== null) {
             r = r.query({versionId});
         }
+        if (includeChanges) {
+            r = r.query({includeChanges});
+        }
         return r.then(res => res.body as models.RevisionMetadata);
     }
 
@@ -97,8 +138,9 @@ export class ApplicationsService {
 
     public watchResourceTree(name: string, appNamespace: string): Observable<models.ApplicationTree> {
         return requests
-            .loadEventSource(`/stream/applications/${name}/resource-tree?appNamespace=${appNamespace}`)
-            .pipe(map(data => JSON.parse(data).result as models.ApplicationTree));
+            .loadEventSource(`/stream/applications/${name}/resource-tree-deltas?appNamespace=${appNamespace}`)
+            .pipe(map(data => JSON.parse(data).result as models.ResourceTreeDelta))
+            .pipe(scan((tree: models.ApplicationTree, delta: models.ResourceTreeDelta) => applyResourceTreeDelta(tree, delta), null));
     }
 
     public managedResources(name: string, appNamespace: string, options: {id?: models.ResourceID; fields?: string[]} = {}): Promise<models.ResourceDiff[]> {
@@ -344,8 +386,9 @@ export class ApplicationsService {
         appNamespace: string,
         resource: models.ResourceNode,
         action: string,
-        resourceActionParameters: models.ResourceActionParam[]
-    ): Promise<models.ResourceAction[]> {
+        resourceActionParameters: models.ResourceActionParam[],
+        confirmationToken?: string
+    ): Promise<{confirmationToken?: string}> {
         return requests
             .post(`/applications/${name}/resource/actions`)
             .send(
@@ -357,10 +400,11 @@ export class ApplicationsService {
                     kind: resource.kind,
                     group: resource.group,
                     resourceActionParameters: resourceActionParameters,
-                    action
+                    action,
+                    confirmationToken
                 })
             )
-            .then(res => (res.body.actions as models.ResourceAction[]) || []);
+            .then(res => res.body as {confirmationToken?: string});
     }
 
     public patchResource(name: string, appNamespace: string, resource: models.ResourceNode, patch: string, patchType: string): Promise<models.State> {
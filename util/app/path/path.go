@@ -97,20 +97,39 @@ func CheckOutOfBoundsSymlinks(basePath string) error {
 	})
 }
 
-// GetAppRefreshPaths returns the list of paths that should trigger a refresh for an application
+// GetAppRefreshPaths returns the list of paths that should trigger a refresh for an application. The paths are read
+// from the manifest-generate-paths annotation, which applies to all the sources of the application, and from the
+// watchPaths of each source, which only apply to that source. An empty list is returned when any change should
+// trigger a refresh, which is the case when a source has no watchPaths and the annotation is not set.
 func GetAppRefreshPaths(app *v1alpha1.Application) []string {
-	var paths []string
+	var annotationPaths []string
+	sources := app.Spec.GetSources()
 	if val, ok := app.Annotations[v1alpha1.AnnotationKeyManifestGeneratePaths]; ok && val != "" {
-		for _, item := range strings.Split(val, ";") {
-			if item == "" {
-				continue
-			}
-			if filepath.IsAbs(item) {
-				paths = append(paths, item[1:])
-			} else {
-				for _, source := range app.Spec.GetSources() {
-					paths = append(paths, filepath.Clean(filepath.Join(source.Path, item)))
-				}
+		annotationPaths = resolveRefreshPaths(strings.Split(val, ";"), sources)
+	}
+	paths := annotationPaths
+	for _, source := range sources {
+		if len(source.WatchPaths) == 0 && len(annotationPaths) == 0 {
+			return nil
+		}
+		paths = append(paths, resolveRefreshPaths(source.WatchPaths, v1alpha1.ApplicationSources{source})...)
+	}
+	return paths
+}
+
+// resolveRefreshPaths returns the given paths relative to the root of the repository. Absolute paths are relative to
+// the root of the repository already, the other ones are resolved against the path of each of the given sources.
+func resolveRefreshPaths(items []string, sources v1alpha1.ApplicationSources) []string {
+	var paths []string
+	for _, item := range items {
+		if item == "" {
+			continue
+		}
+		if filepath.IsAbs(item) {
+			paths = append(paths, item[1:])
+		} else {
+			for _, source := range sources {
+				paths = append(paths, filepath.Clean(filepath.Join(source.Path, item)))
 			}
 		}
 	}
@@ -132,6 +132,18 @@ func getMultiSourceApp(annotation string, paths ...string) *v1alpha1.Application
 	}
 }
 
+func getWatchPathsApp(watchPaths map[string][]string, paths ...string) *v1alpha1.Application {
+	var sources v1alpha1.ApplicationSources
+	for _, path := range paths {
+		sources = append(sources, v1alpha1.ApplicationSource{Path: path, WatchPaths: watchPaths[path]})
+	}
+	return &v1alpha1.Application{
+		Spec: v1alpha1.ApplicationSpec{
+			Sources: sources,
+		},
+	}
+}
+
 func Test_AppFilesHaveChanged(t *testing.T) {
 	t.Parallel()
 
@@ -161,6 +173,9 @@ func Test_AppFilesHaveChanged(t *testing.T) {
 		{"glob path char range - not matching", getApp("/source/path[0-9]/my-deployment.yaml", "source/path"), []string{"source/path/my-deployment.yaml"}, false},
 		{"mixed glob path - matching", getApp("/source/path[0-9]/my-*.yaml", "source/path"), []string{"source/path1/my-deployment.yaml"}, true},
 		{"mixed glob path - not matching", getApp("/source/path[0-9]/my-*.yaml", "source/path"), []string{"README.md"}, false},
+		{"watch paths - matching", getWatchPathsApp(map[string][]string{"source/path": {"."}}, "source/path", "other/path"), []string{"source/path/my-deployment.yaml"}, true},
+		{"watch paths - not matching", getWatchPathsApp(map[string][]string{"source/path": {"."}, "other/path": {"."}}, "source/path", "other/path"), []string{"README.md"}, false},
+		{"watch paths, source without watch paths - matching", getWatchPathsApp(map[string][]string{"source/path": {"."}}, "source/path", "other/path"), []string{"README.md"}, true},
 		{"two relative paths - matching", getApp(".;../shared", "my-app"), []string{"shared/my-deployment.yaml"}, true},
 		{"two relative paths, multi source - matching #1", getMultiSourceApp(".;../shared", "my-app", "other/path"), []string{"shared/my-deployment.yaml"}, true},
 		{"two relative paths, multi source - matching #2", getMultiSourceApp(".;../shared", "my-app", "other/path"), []string{"shared/my-deployment.yaml"}, true},
@@ -209,6 +224,14 @@ func Test_GetAppRefreshPaths(t *testing.T) {
 		{"file two relative paths", getApp("./README.md;../shared/my-deployment.yaml", "my-app"), []string{"my-app/README.md", "shared/my-deployment.yaml"}},
 		{"glob path", getApp("/source/*/my-deployment.yaml", "source/path"), []string{"source/*/my-deployment.yaml"}},
 		{"empty path", getApp(".;", "source/path"), []string{"source/path"}},
+		{"watch paths", getWatchPathsApp(map[string][]string{"source/path": {".", "/shared"}}, "source/path"), []string{"source/path", "shared"}},
+		{"watch paths, source without watch paths", getWatchPathsApp(map[string][]string{"source/path": {"."}}, "source/path", "other/path"), []string{}},
+		{"watch paths and annotation", func() *v1alpha1.Application {
+			app := getApp(".", "source/path")
+			app.Spec.Source.WatchPaths = []string{"/shared"}
+			return app
+		}(), []string{"source/path", "shared"}},
+		{"watch paths - multi source", getWatchPathsApp(map[string][]string{"source/path": {"."}, "other/path": {"../shared"}}, "source/path", "other/path"), []string{"source/path", "other/shared"}},
 	}
 	for _, tt := range tests {
 		ttc := tt
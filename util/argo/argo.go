@@ -26,6 +26,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/errorcode"
 	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/typed/application/v1alpha1"
 	applicationsv1 "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
@@ -145,7 +146,7 @@ func FilterAppSetsByProjects(appsets []argoappv1.ApplicationSet, projects []stri
 	items := make([]argoappv1.ApplicationSet, 0)
 	for i := 0; i < len(appsets); i++ {
 		a := appsets[i]
-		if _, ok := projectsMap[a.Spec.Template.Spec.GetProject()]; ok {
+		if _, ok := projectsMap[a.GetProject()]; ok {
 			items = append(items, a)
 		}
 	}
@@ -552,6 +553,30 @@ func validateSourcePermissions(source argoappv1.ApplicationSource, hasMultipleSo
 	return conditions
 }
 
+// validateHelmPostRenderers checks that the helm post-render steps of the source are valid and permitted by the project
+func validateHelmPostRenderers(source argoappv1.ApplicationSource, proj *argoappv1.AppProject) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	if source.Helm == nil {
+		return conditions
+	}
+	for i, renderer := range source.Helm.PostRenderers {
+		if err := renderer.Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("helm post-renderer %d of source %s is invalid: %v", i, source.RepoURL, err),
+			})
+			continue
+		}
+		if !proj.IsHelmPostRendererPermitted(renderer) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("helm post-renderer %s is not permitted in project '%s'", renderer.Name(), proj.Name),
+			})
+		}
+	}
+	return conditions
+}
+
 func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.ApplicationCondition {
 	var conditions []argoappv1.ApplicationCondition
 	if hydrator.DrySource.RepoURL == "" {
@@ -577,16 +602,30 @@ func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.Appl
 
 // ValidatePermissions ensures that the referenced cluster has been added to Argo CD and the app source repo and destination namespace/cluster are permitted in app project
 func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
+	conditions, _, err := ValidatePermissionsWithErrorCode(ctx, spec, proj, db)
+	return conditions, err
+}
+
+// ValidatePermissionsWithErrorCode is like ValidatePermissions but also returns the error code of the first of the
+// conditions which has one, i.e. of the first source or destination which is not permitted in app project
+func ValidatePermissionsWithErrorCode(ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, db db.ArgoDB) ([]argoappv1.ApplicationCondition, errorcode.Code, error) {
 	conditions := make([]argoappv1.ApplicationCondition, 0)
+	var code errorcode.Code
+	setCode := func(c errorcode.Code) {
+		if code == "" {
+			code = c
+		}
+	}
 
 	switch {
 	case spec.SourceHydrator != nil:
 		condition := validateSourceHydrator(spec.SourceHydrator)
 		if len(condition) > 0 {
 			conditions = append(conditions, condition...)
-			return conditions, nil
+			return conditions, code, nil
 		}
 		if !proj.IsSourcePermitted(spec.SourceHydrator.GetDrySource()) {
+			setCode(errorcode.ProjectSourceDenied)
 			conditions = append(conditions, argoappv1.ApplicationCondition{
 				Type:    argoappv1.ApplicationConditionInvalidSpecError,
 				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.GetSource().RepoURL, spec.Project),
@@ -597,28 +636,84 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 			condition := validateSourcePermissions(source, spec.HasMultipleSources())
 			if len(condition) > 0 {
 				conditions = append(conditions, condition...)
-				return conditions, nil
+				return conditions, code, nil
 			}
 
 			if !proj.IsSourcePermitted(source) {
+				setCode(errorcode.ProjectSourceDenied)
 				conditions = append(conditions, argoappv1.ApplicationCondition{
 					Type:    argoappv1.ApplicationConditionInvalidSpecError,
 					Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", source.RepoURL, spec.Project),
 				})
 			}
+			conditions = append(conditions, validateHelmPostRenderers(source, proj)...)
 		}
 	default:
 		conditions = validateSourcePermissions(spec.GetSource(), spec.HasMultipleSources())
 		if len(conditions) > 0 {
-			return conditions, nil
+			return conditions, code, nil
 		}
 
 		if !proj.IsSourcePermitted(spec.GetSource()) {
+			setCode(errorcode.ProjectSourceDenied)
 			conditions = append(conditions, argoappv1.ApplicationCondition{
 				Type:    argoappv1.ApplicationConditionInvalidSpecError,
 				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.GetSource().RepoURL, spec.Project),
 			})
 		}
+		conditions = append(conditions, validateHelmPostRenderers(spec.GetSource(), proj)...)
+	}
+
+	if spec.RefreshInterval != "" {
+		if interval, err := time.ParseDuration(spec.RefreshInterval); err != nil || interval <= 0 {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("refresh interval '%s' is not a positive duration", spec.RefreshInterval),
+			})
+		}
+	}
+
+	if spec.DestinationServiceAccount != "" {
+		if strings.TrimSpace(spec.DestinationServiceAccount) == "" || strings.ContainsAny(spec.DestinationServiceAccount, argoappv1.ServiceAccountDisallowedCharSet) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("destination service account '%s' contains invalid chars", spec.DestinationServiceAccount),
+			})
+		}
+	}
+
+	if freeze := spec.Freeze; freeze != nil && freeze.At != nil && freeze.Until != nil && !freeze.Until.After(freeze.At.Time) {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("freeze until time '%s' is not after the time the application was frozen at '%s'", freeze.Until.UTC().Format(time.RFC3339), freeze.At.UTC().Format(time.RFC3339)),
+		})
+	}
+
+	if spec.SyncPolicy != nil {
+		for _, field := range spec.SyncPolicy.ServerSideApplyIgnoredConflicts {
+			if !strings.HasPrefix(field, ".") || strings.HasSuffix(field, ".") {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("server-side apply ignored conflict '%s' is not a field path, e.g. .spec.replicas", field),
+				})
+			}
+		}
+		if spec.SyncPolicy.ManagedNamespaceMetadata != nil {
+			if err := spec.SyncPolicy.ManagedNamespaceMetadata.Validate(); err != nil {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: err.Error(),
+				})
+			}
+		}
+		for i := range spec.SyncPolicy.VerificationProbes {
+			if err := spec.SyncPolicy.VerificationProbes[i].Validate(); err != nil {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: err.Error(),
+				})
+			}
+		}
 	}
 
 	destCluster, err := GetDestinationCluster(ctx, spec.Destination, db)
@@ -627,7 +722,7 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
 			Message: err.Error(),
 		})
-		return conditions, nil
+		return conditions, code, nil
 	}
 
 	if destCluster.Server != "" {
@@ -635,9 +730,10 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 			return db.GetProjectClusters(ctx, project)
 		})
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		if !permitted {
+			setCode(errorcode.ProjectDestinationDenied)
 			conditions = append(conditions, argoappv1.ApplicationCondition{
 				Type:    argoappv1.ApplicationConditionInvalidSpecError,
 				Message: fmt.Sprintf("application destination server '%s' and namespace '%s' do not match any of the allowed destinations in project '%s'", spec.Destination.Server, spec.Destination.Namespace, spec.Project),
@@ -646,7 +742,7 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 	} else if destCluster.Server == "" {
 		conditions = append(conditions, argoappv1.ApplicationCondition{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: ErrDestinationMissing})
 	}
-	return conditions, nil
+	return conditions, code, nil
 }
 
 // APIResourcesToStrings converts list of API Resources list into string list
@@ -838,6 +934,7 @@ func verifyGenerateManifests(
 			Repos:                           repos,
 			Revision:                        source.TargetRevision,
 			AppName:                         app.Name,
+			AppNamespace:                    app.Namespace,
 			Namespace:                       app.Spec.Destination.Namespace,
 			ApplicationSource:               &source,
 			AppLabelKey:                     appLabelKey,
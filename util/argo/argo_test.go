@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/errorcode"
 	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/v3/pkg/client/informers/externalversions/application/v1alpha1"
@@ -674,6 +676,214 @@ func TestValidatePermissions(t *testing.T) {
 		assert.Contains(t, conditions[0].Message, "is required if the manifest source is a helm chart")
 	})
 
+	t.Run("Invalid refresh interval result in condition", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+			RefreshInterval: "hourly",
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos: []string{"*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "refresh interval 'hourly' is not a positive duration")
+	})
+
+	t.Run("Invalid destination service account result in condition", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+			DestinationServiceAccount: "team-*",
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos: []string{"*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "destination service account 'team-*' contains invalid chars")
+	})
+
+	t.Run("Freeze expiring before it starts result in condition", func(t *testing.T) {
+		at := metav1.NewTime(time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC))
+		until := metav1.NewTime(time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC))
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+			Freeze: &argoappv1.ApplicationFreeze{Reason: "incident", At: &at, Until: &until},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos: []string{"*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "freeze until time '2024-01-01T01:00:00Z' is not after the time the application was frozen at '2024-01-01T02:00:00Z'")
+	})
+
+	t.Run("Server-side apply ignored conflicts which are not field paths result in condition", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+			SyncPolicy: &argoappv1.SyncPolicy{ServerSideApplyIgnoredConflicts: []string{".spec.replicas", "spec.template"}},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos: []string{"*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "server-side apply ignored conflict 'spec.template' is not a field path")
+	})
+
+	t.Run("Invalid managed namespace metadata policy results in condition", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+			SyncPolicy: &argoappv1.SyncPolicy{ManagedNamespaceMetadata: &argoappv1.ManagedNamespaceMetadata{LabelsPolicy: "overwrite"}},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos: []string{"*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, `invalid managed namespace metadata policy "overwrite"`)
+	})
+
+	t.Run("Invalid verification probe results in condition", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+			SyncPolicy: &argoappv1.SyncPolicy{VerificationProbes: []argoappv1.VerificationProbe{
+				{Name: "grpc", GRPC: &argoappv1.GRPCVerificationProbe{Address: "guestbook"}},
+			}},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos: []string{"*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, `verification probe grpc: invalid gRPC address "guestbook"`)
+	})
+
 	t.Run("Application source is not permitted in project", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
 			Source: &argoappv1.ApplicationSource{
@@ -701,10 +911,48 @@ func TestValidatePermissions(t *testing.T) {
 		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
 		db := &dbmocks.ArgoDB{}
 		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
-		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		conditions, code, err := ValidatePermissionsWithErrorCode(t.Context(), &spec, &proj, db)
 		require.NoError(t, err)
 		assert.Len(t, conditions, 1)
 		assert.Contains(t, conditions[0].Message, "application repo http://some/where is not permitted")
+		assert.Equal(t, errorcode.ProjectSourceDenied, code)
+	})
+
+	t.Run("Helm post-renderer is not permitted in project", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+				Helm: &argoappv1.ApplicationSourceHelm{
+					PostRenderers: []argoappv1.HelmPostRenderer{
+						{Kustomize: "overlay"},
+						{Plugin: "vault"},
+						{Transformer: "namePrefix"},
+					},
+				},
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+		}
+		proj := argoappv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: argoappv1.AppProjectSpec{
+				Destinations:      []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				SourceRepos:       []string{"*"},
+				HelmPostRenderers: []string{"kustomize"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 2)
+		assert.Equal(t, "helm post-renderer plugin:vault is not permitted in project 'default'", conditions[0].Message)
+		assert.Equal(t, `helm post-renderer 2 of source http://some/where is invalid: unknown transformer "namePrefix"`, conditions[1].Message)
 	})
 
 	t.Run("Application destination is not permitted in project", func(t *testing.T) {
@@ -734,10 +982,11 @@ func TestValidatePermissions(t *testing.T) {
 		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
 		db := &dbmocks.ArgoDB{}
 		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
-		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		conditions, code, err := ValidatePermissionsWithErrorCode(t.Context(), &spec, &proj, db)
 		require.NoError(t, err)
 		assert.Len(t, conditions, 1)
 		assert.Contains(t, conditions[0].Message, "application destination")
+		assert.Equal(t, errorcode.ProjectDestinationDenied, code)
 	})
 
 	t.Run("Destination cluster does not exist", func(t *testing.T) {
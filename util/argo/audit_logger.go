@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -43,6 +44,9 @@ const (
 	EventReasonResourceActionRan  = "ResourceActionRan"
 	EventReasonOperationStarted   = "OperationStarted"
 	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonLoginBlocked       = "LoginBlocked"
+	EventReasonLoginLockedOut     = "LoginLockedOut"
+	EventReasonTokenUsageAnomaly  = "TokenUsageAnomaly"
 )
 
 func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string, eventLabels map[string]string) {
@@ -100,6 +104,12 @@ func (l *AuditLogger) enableK8SEventLog(info EventInfo) bool {
 }
 
 func (l *AuditLogger) LogAppEvent(app *v1alpha1.Application, info EventInfo, message, user string, eventLabels map[string]string) {
+	l.LogAppEventAs(app, info, message, user, "", eventLabels)
+}
+
+// LogAppEventAs logs an application event recording the identity impersonated to operate on the destination cluster,
+// if any
+func (l *AuditLogger) LogAppEventAs(app *v1alpha1.Application, info EventInfo, message, user, impersonatedUser string, eventLabels map[string]string) {
 	if !l.enableK8SEventLog(info) {
 		return
 	}
@@ -117,6 +127,9 @@ func (l *AuditLogger) LogAppEvent(app *v1alpha1.Application, info EventInfo, mes
 	if user != "" {
 		fields["user"] = user
 	}
+	if impersonatedUser != "" {
+		fields["impersonated-user"] = impersonatedUser
+	}
 	l.logEvent(objectMeta, v1alpha1.ApplicationSchemaGroupVersionKind, info, message, fields, eventLabels)
 }
 
@@ -178,6 +191,24 @@ func (l *AuditLogger) LogAppProjEvent(proj *v1alpha1.AppProject, info EventInfo,
 	l.logEvent(objectMeta, v1alpha1.AppProjectSchemaGroupVersionKind, info, message, nil, nil)
 }
 
+// LogLoginEvent logs an event of a login or of a session of a user. The event involves the argocd-secret Secret, which
+// holds the local accounts.
+func (l *AuditLogger) LogLoginEvent(namespace string, info EventInfo, message, user, clientIP string) {
+	if !l.enableK8SEventLog(info) {
+		return
+	}
+
+	objectMeta := ObjectRef{
+		Name:      common.ArgoCDSecretName,
+		Namespace: namespace,
+	}
+	fields := map[string]string{"user": user}
+	if clientIP != "" {
+		fields["clientIP"] = clientIP
+	}
+	l.logEvent(objectMeta, corev1.SchemeGroupVersion.WithKind("Secret"), info, message, fields, nil)
+}
+
 func NewAuditLogger(kIf kubernetes.Interface, component string, enableK8sEvent []string) *AuditLogger {
 	return &AuditLogger{
 		kIf:            kIf,
@@ -7,6 +7,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
@@ -127,6 +128,24 @@ func TestLogAppEvent(t *testing.T) {
 	assert.Empty(t, output)
 }
 
+func TestLogAppEventAs(t *testing.T) {
+	logger := NewAuditLogger(fake.NewClientset(), _somecomponent, testEnableEventLog)
+
+	app := argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testapp",
+			Namespace: "argocd",
+		},
+	}
+
+	output := captureLogEntries(func() {
+		logger.LogAppEventAs(&app, EventInfo{Reason: _test, Type: "info"}, "This is a test message", "", "system:serviceaccount:testns:deployer", nil)
+	})
+
+	assert.Contains(t, output, "application=testapp")
+	assert.Contains(t, output, "impersonated-user=\"system:serviceaccount:testns:deployer\"")
+}
+
 func TestLogResourceEvent(t *testing.T) {
 	logger := NewAuditLogger(fake.NewClientset(), _somecomponent, testEnableEventLog)
 	assert.NotNil(t, logger)
@@ -166,3 +185,21 @@ func TestLogResourceEvent(t *testing.T) {
 
 	assert.Empty(t, output)
 }
+
+func TestLogLoginEvent(t *testing.T) {
+	kubeClient := fake.NewClientset()
+	logger := NewAuditLogger(kubeClient, _somecomponent, testEnableEventLog)
+
+	output := captureLogEntries(func() {
+		logger.LogLoginEvent("argocd", EventInfo{Reason: _test, Type: "Warning"}, "This is a test message", "alice", "203.0.113.1")
+	})
+
+	assert.Contains(t, output, "name=argocd-secret")
+	assert.Contains(t, output, "user=alice")
+	assert.Contains(t, output, "clientIP=203.0.113.1")
+	events, err := kubeClient.CoreV1().Events("argocd").List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, events.Items, 1)
+	assert.Equal(t, "Secret", events.Items[0].InvolvedObject.Kind)
+	assert.Equal(t, "203.0.113.1", events.Items[0].Annotations["clientIP"])
+}
@@ -0,0 +1,218 @@
+package diff
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// redactedValue replaces the redacted fields. The live value of a field which differs from the target value is
+	// replaced by a longer mask, so that the diff still shows that the field is changed without revealing the values.
+	redactedValue        = "++++++++"
+	redactedChangedValue = "+++++++++"
+	// wildcardToken matches all the items of an array or all the fields of an object
+	wildcardToken = "*"
+)
+
+// Redactor masks the fields of the resources selected by the resource redactions of argocd-cm, like the data of the
+// Secrets are masked by HideSecretData
+type Redactor struct {
+	redactions []settings.ResourceRedaction
+}
+
+// NewRedactor returns a redactor of the given resource redactions
+func NewRedactor(redactions []settings.ResourceRedaction) *Redactor {
+	return &Redactor{redactions: redactions}
+}
+
+// Matches returns true if fields of the resources of the given group and kind are redacted
+func (r *Redactor) Matches(gk schema.GroupKind) bool {
+	return len(r.pointers(gk)) > 0
+}
+
+func (r *Redactor) pointers(gk schema.GroupKind) []string {
+	if r == nil {
+		return nil
+	}
+	var pointers []string
+	for _, redaction := range r.redactions {
+		if glob.Match(redaction.Kind, gk.Kind) && (redaction.Group == gk.Group || (redaction.Group != "" && glob.Match(redaction.Group, gk.Group))) {
+			pointers = append(pointers, redaction.JSONPointers...)
+		}
+	}
+	return pointers
+}
+
+// Redact returns copies of the target and the live resources with the redacted fields masked. Either resource may be
+// nil. The resources are returned as is if none of their fields are redacted.
+func (r *Redactor) Redact(target, live *unstructured.Unstructured) (*unstructured.Unstructured, *unstructured.Unstructured) {
+	var gk schema.GroupKind
+	switch {
+	case target != nil:
+		gk = target.GroupVersionKind().GroupKind()
+	case live != nil:
+		gk = live.GroupVersionKind().GroupKind()
+	default:
+		return nil, nil
+	}
+	pointers := r.pointers(gk)
+	if len(pointers) == 0 {
+		return target, live
+	}
+
+	var targetObj, liveObj map[string]any
+	if target != nil {
+		target = target.DeepCopy()
+		targetObj = target.Object
+	}
+	if live != nil {
+		live = live.DeepCopy()
+		liveObj = live.Object
+	}
+	for _, pointer := range pointers {
+		tokens := parsePointer(pointer)
+		paths := map[string][]string{}
+		for _, path := range append(resolvePaths(targetObj, tokens, nil), resolvePaths(liveObj, tokens, nil)...) {
+			paths[strings.Join(path, "\x00")] = path
+		}
+		for _, path := range paths {
+			targetValue, inTarget := getPath(targetObj, path)
+			liveValue, inLive := getPath(liveObj, path)
+			if inTarget {
+				setPath(targetObj, path, redactedValue)
+			}
+			if inLive {
+				if inTarget && !reflect.DeepEqual(targetValue, liveValue) {
+					setPath(liveObj, path, redactedChangedValue)
+				} else {
+					setPath(liveObj, path, redactedValue)
+				}
+			}
+		}
+	}
+	return target, live
+}
+
+// RedactObject returns a copy of the resource with the redacted fields masked, see Redact
+func (r *Redactor) RedactObject(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	_, obj = r.Redact(nil, obj)
+	return obj
+}
+
+// Values returns the string values of the redacted fields of the resources, e.g. to mask them in the messages of the
+// operations which may quote the resources
+func (r *Redactor) Values(objs ...*unstructured.Unstructured) []string {
+	var values []string
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		for _, pointer := range r.pointers(obj.GroupVersionKind().GroupKind()) {
+			for _, path := range resolvePaths(obj.Object, parsePointer(pointer), nil) {
+				value, _ := getPath(obj.Object, path)
+				values = appendStringValues(values, value)
+			}
+		}
+	}
+	return values
+}
+
+func appendStringValues(values []string, value any) []string {
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			values = append(values, v)
+		}
+	case map[string]any:
+		for _, child := range v {
+			values = appendStringValues(values, child)
+		}
+	case []any:
+		for _, child := range v {
+			values = appendStringValues(values, child)
+		}
+	}
+	return values
+}
+
+// parsePointer returns the unescaped tokens of a JSON pointer, see RFC 6901
+func parsePointer(pointer string) []string {
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens
+}
+
+// resolvePaths returns the paths of the fields of the object matching the tokens, expanding the wildcard tokens
+func resolvePaths(value any, tokens []string, path []string) [][]string {
+	if len(tokens) == 0 {
+		return [][]string{path}
+	}
+	token := tokens[0]
+	var paths [][]string
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if token == wildcardToken || token == key {
+				paths = append(paths, resolvePaths(child, tokens[1:], appendPath(path, key))...)
+			}
+		}
+	case []any:
+		for i, child := range v {
+			if token == wildcardToken || token == strconv.Itoa(i) {
+				paths = append(paths, resolvePaths(child, tokens[1:], appendPath(path, strconv.Itoa(i)))...)
+			}
+		}
+	}
+	return paths
+}
+
+func appendPath(path []string, token string) []string {
+	return append(append([]string{}, path...), token)
+}
+
+func getPath(value any, path []string) (any, bool) {
+	for _, token := range path {
+		switch v := value.(type) {
+		case map[string]any:
+			child, ok := v[token]
+			if !ok {
+				return nil, false
+			}
+			value = child
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			value = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+func setPath(obj map[string]any, path []string, newValue any) {
+	parent, ok := getPath(obj, path[:len(path)-1])
+	if !ok {
+		return
+	}
+	token := path[len(path)-1]
+	switch v := parent.(type) {
+	case map[string]any:
+		v[token] = newValue
+	case []any:
+		if i, err := strconv.Atoi(token); err == nil && i >= 0 && i < len(v) {
+			v[i] = newValue
+		}
+	}
+}
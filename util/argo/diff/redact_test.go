@@ -0,0 +1,87 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	argo "github.com/argoproj/argo-cd/v3/util/argo/diff"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func database(spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.com/v1",
+		"kind":       "Database",
+		"metadata":   map[string]any{"name": "db"},
+		"spec":       spec,
+	}}
+}
+
+func TestRedactor(t *testing.T) {
+	redactor := argo.NewRedactor([]settings.ResourceRedaction{
+		{Group: "example.com", Kind: "Database", JSONPointers: []string{"/spec/password", "/spec/users/*/token", "/spec/connection"}},
+	})
+
+	t.Run("Matches", func(t *testing.T) {
+		assert.True(t, redactor.Matches(schema.GroupKind{Group: "example.com", Kind: "Database"}))
+		assert.False(t, redactor.Matches(schema.GroupKind{Group: "example.com", Kind: "Table"}))
+		assert.False(t, redactor.Matches(schema.GroupKind{Kind: "Database"}))
+		assert.False(t, (*argo.Redactor)(nil).Matches(schema.GroupKind{Group: "example.com", Kind: "Database"}))
+	})
+
+	t.Run("Target and live", func(t *testing.T) {
+		target := database(map[string]any{
+			"password":   "secret",
+			"users":      []any{map[string]any{"name": "admin", "token": "t1"}, map[string]any{"name": "reader", "token": "t2"}},
+			"connection": map[string]any{"host": "db.example.com"},
+			"replicas":   int64(1),
+		})
+		live := database(map[string]any{
+			"password": "secret",
+			"users":    []any{map[string]any{"name": "admin", "token": "t1"}, map[string]any{"name": "reader", "token": "changed"}},
+			"replicas": int64(2),
+		})
+
+		redactedTarget, redactedLive := redactor.Redact(target, live)
+		assert.Equal(t, map[string]any{
+			"password":   "++++++++",
+			"users":      []any{map[string]any{"name": "admin", "token": "++++++++"}, map[string]any{"name": "reader", "token": "++++++++"}},
+			"connection": "++++++++",
+			"replicas":   int64(1),
+		}, redactedTarget.Object["spec"])
+		assert.Equal(t, map[string]any{
+			"password": "++++++++",
+			"users":    []any{map[string]any{"name": "admin", "token": "++++++++"}, map[string]any{"name": "reader", "token": "+++++++++"}},
+			"replicas": int64(2),
+		}, redactedLive.Object["spec"])
+
+		assert.Equal(t, "secret", target.Object["spec"].(map[string]any)["password"], "the resources are not modified")
+		assert.Equal(t, "secret", live.Object["spec"].(map[string]any)["password"], "the resources are not modified")
+	})
+
+	t.Run("Single object", func(t *testing.T) {
+		obj := redactor.RedactObject(database(map[string]any{"password": "secret"}))
+		assert.Equal(t, map[string]any{"password": "++++++++"}, obj.Object["spec"])
+
+		target, live := redactor.Redact(database(map[string]any{"password": "secret"}), nil)
+		assert.Equal(t, map[string]any{"password": "++++++++"}, target.Object["spec"])
+		assert.Nil(t, live)
+	})
+
+	t.Run("Values", func(t *testing.T) {
+		values := redactor.Values(database(map[string]any{
+			"password":   "secret",
+			"users":      []any{map[string]any{"name": "admin", "token": "t1"}},
+			"connection": map[string]any{"host": "db.example.com", "port": int64(5432)},
+		}), nil)
+		assert.ElementsMatch(t, []string{"secret", "t1", "db.example.com"}, values)
+	})
+
+	t.Run("Not redacted", func(t *testing.T) {
+		obj := &unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "ConfigMap", "data": map[string]any{"password": "value"}}}
+		assert.Same(t, obj, redactor.RedactObject(obj))
+	})
+}
@@ -0,0 +1,41 @@
+package argo
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+// GetFieldManager returns the field manager of the object, stored in its common.AnnotationKeyFieldManager annotation
+func GetFieldManager(obj metav1.Object) string {
+	return obj.GetAnnotations()[common.AnnotationKeyFieldManager]
+}
+
+// SetFieldManager records the given field manager in the annotations of the object. Nothing is recorded if the field
+// manager is empty.
+func SetFieldManager(obj metav1.Object, manager string) {
+	if manager == "" {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[common.AnnotationKeyFieldManager] = manager
+	obj.SetAnnotations(annotations)
+}
+
+// ValidateFieldManager returns an Aborted error if the existing object is owned by a field manager other than the
+// given one, so that the external tools managing the same object do not overwrite each other's changes. Requests
+// without field manager are not checked.
+func ValidateFieldManager(existing metav1.Object, manager string) error {
+	if manager == "" {
+		return nil
+	}
+	if current := GetFieldManager(existing); current != "" && current != manager {
+		return status.Errorf(codes.Aborted, "%s is managed by %q, refusing the upsert requested by %q", existing.GetName(), current, manager)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestSetFieldManager(t *testing.T) {
+	app := &argoappv1.Application{}
+	SetFieldManager(app, "")
+	assert.Nil(t, app.Annotations)
+	assert.Empty(t, GetFieldManager(app))
+
+	SetFieldManager(app, "terraform")
+	assert.Equal(t, "terraform", GetFieldManager(app))
+}
+
+func TestValidateFieldManager(t *testing.T) {
+	unmanaged := &argoappv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	managed := unmanaged.DeepCopy()
+	SetFieldManager(managed, "terraform")
+
+	require.NoError(t, ValidateFieldManager(unmanaged, "tofu"))
+	require.NoError(t, ValidateFieldManager(managed, ""))
+	require.NoError(t, ValidateFieldManager(managed, "terraform"))
+
+	err := ValidateFieldManager(managed, "tofu")
+	require.Error(t, err)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
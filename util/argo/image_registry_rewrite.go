@@ -0,0 +1,134 @@
+package argo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+const defaultImageRegistry = "docker.io"
+
+// podSpecPaths are the paths of the pod specs of the pods and of the workload resources
+var podSpecPaths = [][]string{
+	{"spec"},
+	{"spec", "template", "spec"},
+	{"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+var containerFields = []string{"initContainers", "containers", "ephemeralContainers"}
+
+// ImageRegistryRewriteRules is the list of image registry rewrite rules of a project. The first rule matching an image
+// applies.
+type ImageRegistryRewriteRules []v1alpha1.ImageRegistryRewriteRule
+
+// GetImageRegistryRewriteRules returns the image registry rewrite rules of the given project
+func GetImageRegistryRewriteRules(proj *v1alpha1.AppProject) (ImageRegistryRewriteRules, error) {
+	var rules ImageRegistryRewriteRules
+	for i, rule := range proj.Spec.ImageRegistryRewriteRules {
+		if err := rule.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid image registry rewrite rules of project %s: rule %d: %w", proj.Name, i, err)
+		}
+		rule.From = strings.TrimSuffix(rule.From, "/")
+		rule.To = strings.TrimSuffix(rule.To, "/")
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// ForCluster returns the rules which apply to the given destination cluster
+func (r ImageRegistryRewriteRules) ForCluster(cluster *v1alpha1.Cluster) ImageRegistryRewriteRules {
+	var rules ImageRegistryRewriteRules
+	for _, rule := range r {
+		if len(rule.Clusters) == 0 {
+			rules = append(rules, rule)
+			continue
+		}
+		for _, pattern := range rule.Clusters {
+			if cluster != nil && (glob.Match(pattern, cluster.Server) || (cluster.Name != "" && glob.Match(pattern, cluster.Name))) {
+				rules = append(rules, rule)
+				break
+			}
+		}
+	}
+	return rules
+}
+
+// Rewrite returns the given image with its registry rewritten by the first matching rule, and whether a rule matched
+func (r ImageRegistryRewriteRules) Rewrite(image string) (string, bool) {
+	normalized := normalizeImage(image)
+	for _, rule := range r {
+		if rest, ok := strings.CutPrefix(normalized, rule.From); ok && (rest == "" || strings.HasPrefix(rest, "/")) {
+			return rule.To + rest, true
+		}
+	}
+	return image, false
+}
+
+// Apply rewrites the images of the containers of the given resource, and records the rewritten images in its
+// argocd.argoproj.io/image-registry-rewrites annotation so that the rewrites are visible in the diff
+func (r ImageRegistryRewriteRules) Apply(obj *unstructured.Unstructured) {
+	if len(r) == 0 {
+		return
+	}
+	rewrites := map[string]string{}
+	for _, path := range podSpecPaths {
+		for _, field := range containerFields {
+			containers, found, err := unstructured.NestedSlice(obj.Object, append(path, field)...)
+			if err != nil || !found {
+				continue
+			}
+			modified := false
+			for _, c := range containers {
+				container, ok := c.(map[string]any)
+				if !ok {
+					continue
+				}
+				image, ok := container["image"].(string)
+				if !ok || image == "" {
+					continue
+				}
+				if rewritten, ok := r.Rewrite(image); ok && rewritten != image {
+					container["image"] = rewritten
+					rewrites[image] = rewritten
+					modified = true
+				}
+			}
+			if modified {
+				_ = unstructured.SetNestedSlice(obj.Object, containers, append(path, field)...)
+			}
+		}
+	}
+	if len(rewrites) == 0 {
+		return
+	}
+	var items []string
+	for image, rewritten := range rewrites {
+		items = append(items, image+"="+rewritten)
+	}
+	sort.Strings(items)
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[common.AnnotationKeyImageRegistryRewrites] = strings.Join(items, ",")
+	obj.SetAnnotations(annotations)
+}
+
+// normalizeImage returns the given image prefixed with its registry, e.g. docker.io/library/nginx for nginx
+func normalizeImage(image string) string {
+	first, _, hasSlash := strings.Cut(image, "/")
+	switch {
+	case !hasSlash:
+		return defaultImageRegistry + "/library/" + image
+	case strings.ContainsAny(first, ".:") || first == "localhost":
+		return image
+	default:
+		return defaultImageRegistry + "/" + image
+	}
+}
@@ -0,0 +1,99 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestGetImageRegistryRewriteRules(t *testing.T) {
+	rules, err := GetImageRegistryRewriteRules(&v1alpha1.AppProject{})
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: v1alpha1.AppProjectSpec{ImageRegistryRewriteRules: []v1alpha1.ImageRegistryRewriteRule{
+			{From: "docker.io/", To: "mirror.internal/docker.io/", Clusters: []string{"air-gapped-*"}},
+		}},
+	}
+	rules, err = GetImageRegistryRewriteRules(proj)
+	require.NoError(t, err)
+	assert.Equal(t, ImageRegistryRewriteRules{{From: "docker.io", To: "mirror.internal/docker.io", Clusters: []string{"air-gapped-*"}}}, rules)
+	assert.Equal(t, "docker.io/", proj.Spec.ImageRegistryRewriteRules[0].From, "the project is not modified")
+
+	proj.Spec.ImageRegistryRewriteRules = []v1alpha1.ImageRegistryRewriteRule{{From: "docker.io"}}
+	_, err = GetImageRegistryRewriteRules(proj)
+	assert.ErrorContains(t, err, "invalid image registry rewrite rules of project default: rule 0: from and to are required")
+}
+
+func TestImageRegistryRewriteRules_ForCluster(t *testing.T) {
+	rules := ImageRegistryRewriteRules{
+		{From: "docker.io", To: "mirror.internal/docker.io", Clusters: []string{"air-gapped-*", "https://10.0.0.*"}},
+		{From: "ghcr.io", To: "mirror.internal/ghcr.io"},
+	}
+	assert.Equal(t, rules, rules.ForCluster(&v1alpha1.Cluster{Name: "air-gapped-1", Server: "https://kubernetes.default.svc"}))
+	assert.Equal(t, rules, rules.ForCluster(&v1alpha1.Cluster{Server: "https://10.0.0.1"}))
+	assert.Equal(t, rules[1:], rules.ForCluster(&v1alpha1.Cluster{Name: "in-cluster", Server: "https://kubernetes.default.svc"}))
+}
+
+func TestImageRegistryRewriteRules_Rewrite(t *testing.T) {
+	rules := ImageRegistryRewriteRules{
+		{From: "ghcr.io/argoproj", To: "mirror.internal/argoproj"},
+		{From: "docker.io", To: "mirror.internal/docker.io"},
+	}
+	for image, expected := range map[string]string{
+		"nginx":                           "mirror.internal/docker.io/library/nginx",
+		"nginx:1.27":                      "mirror.internal/docker.io/library/nginx:1.27",
+		"bitnami/redis@sha256:abc":        "mirror.internal/docker.io/bitnami/redis@sha256:abc",
+		"docker.io/bitnami/redis":         "mirror.internal/docker.io/bitnami/redis",
+		"ghcr.io/argoproj/argocd:v3":      "mirror.internal/argoproj/argocd:v3",
+		"ghcr.io/argoproj-labs/tool:v1":   "ghcr.io/argoproj-labs/tool:v1",
+		"quay.io/argoproj/argocd:v3":      "quay.io/argoproj/argocd:v3",
+		"localhost:5000/my-image:v1":      "localhost:5000/my-image:v1",
+		"registry.internal/team/my-image": "registry.internal/team/my-image",
+	} {
+		t.Run(image, func(t *testing.T) {
+			rewritten, _ := rules.Rewrite(image)
+			assert.Equal(t, expected, rewritten)
+		})
+	}
+}
+
+func TestImageRegistryRewriteRules_Apply(t *testing.T) {
+	rules := ImageRegistryRewriteRules{{From: "docker.io", To: "mirror.internal/docker.io"}}
+
+	cronJob := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "CronJob",
+		"metadata":   map[string]any{"name": "backup"},
+		"spec": map[string]any{"jobTemplate": map[string]any{"spec": map[string]any{"template": map[string]any{"spec": map[string]any{
+			"initContainers": []any{map[string]any{"name": "init", "image": "busybox"}},
+			"containers": []any{
+				map[string]any{"name": "backup", "image": "bitnami/kubectl:1.33"},
+				map[string]any{"name": "sidecar", "image": "quay.io/prometheus/node-exporter"},
+			},
+		}}}}},
+	}}
+	rules.Apply(cronJob)
+	containers, _, _ := unstructured.NestedSlice(cronJob.Object, "spec", "jobTemplate", "spec", "template", "spec", "containers")
+	assert.Equal(t, "mirror.internal/docker.io/bitnami/kubectl:1.33", containers[0].(map[string]any)["image"])
+	assert.Equal(t, "quay.io/prometheus/node-exporter", containers[1].(map[string]any)["image"])
+	initContainers, _, _ := unstructured.NestedSlice(cronJob.Object, "spec", "jobTemplate", "spec", "template", "spec", "initContainers")
+	assert.Equal(t, "mirror.internal/docker.io/library/busybox", initContainers[0].(map[string]any)["image"])
+	assert.Equal(t, "bitnami/kubectl:1.33=mirror.internal/docker.io/bitnami/kubectl:1.33,busybox=mirror.internal/docker.io/library/busybox",
+		cronJob.GetAnnotations()[common.AnnotationKeyImageRegistryRewrites])
+
+	configMap := &unstructured.Unstructured{}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetName("my-config")
+	rules.Apply(configMap)
+	assert.Empty(t, configMap.GetAnnotations())
+}
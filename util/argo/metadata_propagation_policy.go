@@ -0,0 +1,95 @@
+package argo
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+// podTemplateMetadataPaths are the paths of the pod template metadata of the workload resources
+var podTemplateMetadataPaths = [][]string{
+	{"spec", "template", "metadata"},
+	{"spec", "jobTemplate", "spec", "template", "metadata"},
+}
+
+// GetMetadataPropagationPolicy returns the validated metadata propagation policy of the given project, or nil if it has
+// none
+func GetMetadataPropagationPolicy(proj *v1alpha1.AppProject) (*v1alpha1.MetadataPropagationPolicy, error) {
+	if proj.Spec.MetadataPropagationPolicy == nil {
+		return nil, nil
+	}
+	if err := proj.Spec.MetadataPropagationPolicy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid metadata propagation policy of project %s: %w", proj.Name, err)
+	}
+	return proj.Spec.MetadataPropagationPolicy, nil
+}
+
+// ApplyMetadataPropagationPolicy sets the labels and annotations of the given application selected by the given policy
+// on the given resource
+func ApplyMetadataPropagationPolicy(p *v1alpha1.MetadataPropagationPolicy, app *v1alpha1.Application, obj *unstructured.Unstructured) {
+	if p == nil {
+		return
+	}
+	labels := selectMetadata(app.GetLabels(), p.Labels)
+	annotations := selectMetadata(app.GetAnnotations(), p.Annotations)
+	if len(labels) == 0 && len(annotations) == 0 {
+		return
+	}
+	if merged, ok := mergeMetadata(obj.GetLabels(), labels); ok {
+		obj.SetLabels(merged)
+	}
+	if merged, ok := mergeMetadata(obj.GetAnnotations(), annotations); ok {
+		obj.SetAnnotations(merged)
+	}
+	if !p.PodTemplates {
+		return
+	}
+	for _, path := range podTemplateMetadataPaths {
+		if _, found, err := unstructured.NestedMap(obj.Object, path...); err != nil || !found {
+			continue
+		}
+		for field, values := range map[string]map[string]string{"labels": labels, "annotations": annotations} {
+			existing, _, err := unstructured.NestedStringMap(obj.Object, append(path, field)...)
+			if err != nil {
+				continue
+			}
+			if merged, ok := mergeMetadata(existing, values); ok {
+				_ = unstructured.SetNestedStringMap(obj.Object, merged, append(path, field)...)
+			}
+		}
+	}
+}
+
+// selectMetadata returns the entries of the given metadata with a key matching one of the given globs
+func selectMetadata(metadata map[string]string, keys []string) map[string]string {
+	selected := map[string]string{}
+	for k, v := range metadata {
+		for _, key := range keys {
+			if glob.Match(key, k) {
+				selected[k] = v
+				break
+			}
+		}
+	}
+	return selected
+}
+
+// mergeMetadata adds the given entries to the existing metadata without overwriting it, and returns whether an entry
+// was added
+func mergeMetadata(existing map[string]string, entries map[string]string) (map[string]string, bool) {
+	merged := map[string]string{}
+	for k, v := range existing {
+		merged[k] = v
+	}
+	modified := false
+	for k, v := range entries {
+		if _, ok := merged[k]; !ok {
+			merged[k] = v
+			modified = true
+		}
+	}
+	return merged, modified
+}
@@ -0,0 +1,73 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestGetMetadataPropagationPolicy(t *testing.T) {
+	policy, err := GetMetadataPropagationPolicy(&v1alpha1.AppProject{})
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: v1alpha1.AppProjectSpec{MetadataPropagationPolicy: &v1alpha1.MetadataPropagationPolicy{
+			Labels: []string{"team", "cost-center"}, PodTemplates: true,
+		}},
+	}
+	policy, err = GetMetadataPropagationPolicy(proj)
+	require.NoError(t, err)
+	assert.Equal(t, &v1alpha1.MetadataPropagationPolicy{Labels: []string{"team", "cost-center"}, PodTemplates: true}, policy)
+
+	proj.Spec.MetadataPropagationPolicy.Annotations = []string{"example.com/["}
+	_, err = GetMetadataPropagationPolicy(proj)
+	assert.ErrorContains(t, err, "invalid metadata propagation policy of project default")
+}
+
+func TestApplyMetadataPropagationPolicy(t *testing.T) {
+	app := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{
+		Labels:      map[string]string{"team": "payments", "cost-center": "cc-1", "env": "prod"},
+		Annotations: map[string]string{"example.com/owner": "alice"},
+	}}
+	newDeployment := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "my-deploy", "labels": map[string]any{"team": "platform"}},
+			"spec":       map[string]any{"template": map[string]any{"metadata": map[string]any{"labels": map[string]any{"app": "my-deploy"}}}},
+		}}
+	}
+
+	t.Run("NoPolicy", func(t *testing.T) {
+		obj := newDeployment()
+		var policy *v1alpha1.MetadataPropagationPolicy
+		ApplyMetadataPropagationPolicy(policy, app, obj)
+		assert.Equal(t, newDeployment(), obj)
+	})
+
+	t.Run("Resource", func(t *testing.T) {
+		obj := newDeployment()
+		policy := &v1alpha1.MetadataPropagationPolicy{Labels: []string{"team", "cost-*"}, Annotations: []string{"example.com/*"}}
+		ApplyMetadataPropagationPolicy(policy, app, obj)
+		assert.Equal(t, map[string]string{"team": "platform", "cost-center": "cc-1"}, obj.GetLabels(), "the manifest labels are not overwritten")
+		assert.Equal(t, map[string]string{"example.com/owner": "alice"}, obj.GetAnnotations())
+		assert.Equal(t, newDeployment().Object["spec"], obj.Object["spec"])
+	})
+
+	t.Run("PodTemplates", func(t *testing.T) {
+		obj := newDeployment()
+		policy := &v1alpha1.MetadataPropagationPolicy{Labels: []string{"team"}, PodTemplates: true}
+		ApplyMetadataPropagationPolicy(policy, app, obj)
+		labels, _, err := unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "labels")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"app": "my-deploy", "team": "payments"}, labels)
+		assert.NotContains(t, obj.Object["spec"].(map[string]any)["template"].(map[string]any)["metadata"], "annotations")
+	})
+}
@@ -0,0 +1,107 @@
+package argo
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+// syncOptionNegations holds the sync options which can be forbidden on a resource despite being set on the
+// application, by setting their negation on the resource
+var syncOptionNegations = map[string]string{
+	synccommon.SyncOptionServerSideApply: synccommon.SyncOptionDisableServerSideApply,
+}
+
+// SyncOptionsPolicies is the list of sync options policies of a project
+type SyncOptionsPolicies []v1alpha1.SyncOptionsPolicy
+
+// GetSyncOptionsPolicies returns the sync options policies of the given project
+func GetSyncOptionsPolicies(proj *v1alpha1.AppProject) (SyncOptionsPolicies, error) {
+	for _, policy := range proj.Spec.SyncOptionsPolicies {
+		if err := policy.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid sync options policy of project %s: %w", proj.Name, err)
+		}
+	}
+	return proj.Spec.SyncOptionsPolicies, nil
+}
+
+func syncOptionsPolicyMatches(policy v1alpha1.SyncOptionsPolicy, group string, kind string) bool {
+	return glob.Match(policy.Group, group) && glob.Match(policy.Kind, kind)
+}
+
+// Apply updates the sync options annotation of the given resource with the options enforced and forbidden by the
+// policies matching it. An enforced option replaces the other values of the same option.
+func (p SyncOptionsPolicies) Apply(obj *unstructured.Unstructured) {
+	gvk := obj.GroupVersionKind()
+	var options []string
+	for _, item := range strings.Split(obj.GetAnnotations()[synccommon.AnnotationSyncOptions], ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			options = append(options, item)
+		}
+	}
+	modified := false
+	for _, policy := range p {
+		if !syncOptionsPolicyMatches(policy, gvk.Group, gvk.Kind) {
+			continue
+		}
+		for _, option := range policy.Forbid {
+			options = slices.DeleteFunc(options, func(item string) bool {
+				modified = modified || item == option
+				return item == option
+			})
+			if negation, ok := syncOptionNegations[option]; ok && !slices.Contains(options, negation) {
+				options = append(options, negation)
+				modified = true
+			}
+		}
+		for _, option := range policy.Enforce {
+			key, _, _ := strings.Cut(option, "=")
+			options = slices.DeleteFunc(options, func(item string) bool {
+				itemKey, _, _ := strings.Cut(item, "=")
+				conflicting := itemKey == key && item != option
+				modified = modified || conflicting
+				return conflicting
+			})
+			if !slices.Contains(options, option) {
+				options = append(options, option)
+				modified = true
+			}
+		}
+	}
+	if !modified {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if len(options) == 0 {
+		delete(annotations, synccommon.AnnotationSyncOptions)
+	} else {
+		annotations[synccommon.AnnotationSyncOptions] = strings.Join(options, ",")
+	}
+	obj.SetAnnotations(annotations)
+}
+
+// ForbiddenOption returns the first of the given application sync options which is forbidden for the given resource
+// and cannot be overridden on the resource itself
+func (p SyncOptionsPolicies) ForbiddenOption(obj *unstructured.Unstructured, options []string) (string, bool) {
+	gvk := obj.GroupVersionKind()
+	for _, policy := range p {
+		if !syncOptionsPolicyMatches(policy, gvk.Group, gvk.Kind) {
+			continue
+		}
+		for _, option := range policy.Forbid {
+			if _, ok := syncOptionNegations[option]; !ok && slices.Contains(options, option) {
+				return option, true
+			}
+		}
+	}
+	return "", false
+}
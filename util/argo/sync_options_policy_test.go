@@ -0,0 +1,84 @@
+package argo
+
+import (
+	"testing"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newSyncOptionsPolicyObj(apiVersion string, kind string, syncOptions string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName("my-obj")
+	if syncOptions != "" {
+		obj.SetAnnotations(map[string]string{synccommon.AnnotationSyncOptions: syncOptions})
+	}
+	return obj
+}
+
+func TestGetSyncOptionsPolicies(t *testing.T) {
+	policies, err := GetSyncOptionsPolicies(&v1alpha1.AppProject{})
+	require.NoError(t, err)
+	assert.Empty(t, policies)
+
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: v1alpha1.AppProjectSpec{SyncOptionsPolicies: []v1alpha1.SyncOptionsPolicy{
+			{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition", Enforce: []string{"ServerSideApply=true"}},
+			{Group: "*", Kind: "*", Forbid: []string{"Force=true"}},
+		}},
+	}
+	policies, err = GetSyncOptionsPolicies(proj)
+	require.NoError(t, err)
+	assert.Equal(t, SyncOptionsPolicies(proj.Spec.SyncOptionsPolicies), policies)
+
+	proj.Spec.SyncOptionsPolicies = []v1alpha1.SyncOptionsPolicy{{Group: "*", Forbid: []string{"Force=true"}}}
+	_, err = GetSyncOptionsPolicies(proj)
+	assert.ErrorContains(t, err, "invalid sync options policy of project default: kind is required")
+}
+
+func TestSyncOptionsPolicies_Apply(t *testing.T) {
+	policies := SyncOptionsPolicies{
+		{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition", Enforce: []string{"ServerSideApply=true"}},
+		{Group: "apps", Kind: "*", Forbid: []string{"Force=true", "ServerSideApply=true"}},
+	}
+
+	testCases := []struct {
+		name        string
+		obj         *unstructured.Unstructured
+		syncOptions string
+	}{
+		{"Enforced", newSyncOptionsPolicyObj("apiextensions.k8s.io/v1", "CustomResourceDefinition", ""), "ServerSideApply=true"},
+		{"EnforcedReplacingOption", newSyncOptionsPolicyObj("apiextensions.k8s.io/v1", "CustomResourceDefinition", "ServerSideApply=false, Replace=true"), "Replace=true,ServerSideApply=true"},
+		{"Forbidden", newSyncOptionsPolicyObj("apps/v1", "Deployment", "Force=true,Replace=true"), "Replace=true,ServerSideApply=false"},
+		{"NotMatching", newSyncOptionsPolicyObj("v1", "ConfigMap", "Force=true"), "Force=true"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policies.Apply(tc.obj)
+			assert.Equal(t, tc.syncOptions, tc.obj.GetAnnotations()[synccommon.AnnotationSyncOptions])
+		})
+	}
+}
+
+func TestSyncOptionsPolicies_ForbiddenOption(t *testing.T) {
+	policies := SyncOptionsPolicies{{Group: "apps", Kind: "Deployment", Forbid: []string{"ServerSideApply=true", "Replace=true"}}}
+	deployment := newSyncOptionsPolicyObj("apps/v1", "Deployment", "")
+
+	option, forbidden := policies.ForbiddenOption(deployment, []string{"Prune=true", "Replace=true"})
+	assert.True(t, forbidden)
+	assert.Equal(t, "Replace=true", option)
+
+	_, forbidden = policies.ForbiddenOption(deployment, []string{"ServerSideApply=true"})
+	assert.False(t, forbidden, "server-side apply is disabled on the resource instead")
+
+	_, forbidden = policies.ForbiddenOption(newSyncOptionsPolicyObj("v1", "ConfigMap", ""), []string{"Replace=true"})
+	assert.False(t, forbidden)
+}
@@ -8,6 +8,7 @@ var (
 	BuiltinPolicyCSV string
 	ModelConf        string
 	SwaggerJSON      string
+	OpenAPIJSON      string
 	BadgeSVG         string
 )
 
@@ -27,6 +28,11 @@ func init() {
 		panic(err)
 	}
 	SwaggerJSON = string(data)
+	data, err = assets.Embedded.ReadFile("openapi.json")
+	if err != nil {
+		panic(err)
+	}
+	OpenAPIJSON = string(data)
 	data, err = assets.Embedded.ReadFile("badge.svg")
 	if err != nil {
 		panic(err)
@@ -0,0 +1,323 @@
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/robfig/cron/v3"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/util/crypto"
+)
+
+const (
+	// AnnotationKeyEncrypted marks the secrets of a snapshot whose data is encrypted with the backup key
+	AnnotationKeyEncrypted = "backup.argocd.argoproj.io/encrypted"
+	// LatestSnapshot is the name referencing the latest snapshot of a location
+	LatestSnapshot = "latest"
+
+	snapshotPrefix     = "argocd-backup-"
+	snapshotSuffix     = ".yaml.gz"
+	snapshotTimeFormat = "20060102T150405Z"
+	yamlSeparator      = "---\n"
+)
+
+// ExportFunc writes the Argo CD resources to back up as a YAML stream, as `argocd admin export` does
+type ExportFunc func(ctx context.Context, w io.Writer) error
+
+// Retention configures the snapshots kept in the store. A zero value keeps all the snapshots.
+type Retention struct {
+	// Count is the number of the latest snapshots to keep
+	Count int
+	// MaxAge is the maximum age of the snapshots to keep
+	MaxAge time.Duration
+}
+
+// Snapshot is a snapshot in a store
+type Snapshot struct {
+	Name string
+	Time time.Time
+}
+
+// Backup creates the snapshots of the Argo CD configuration in a store
+type Backup struct {
+	store     Store
+	key       []byte
+	export    ExportFunc
+	retention Retention
+}
+
+// NewBackup returns a Backup uploading the resources exported by export to the given store. The data of the secrets is
+// encrypted with the given key, see crypto.KeyFromPassphrase.
+func NewBackup(store Store, key []byte, export ExportFunc, retention Retention) *Backup {
+	return &Backup{store: store, key: key, export: export, retention: retention}
+}
+
+// SnapshotName returns the name of the snapshot created at the given time
+func SnapshotName(t time.Time) string {
+	return snapshotPrefix + t.UTC().Format(snapshotTimeFormat) + snapshotSuffix
+}
+
+func parseSnapshotName(name string) (time.Time, bool) {
+	if !strings.HasPrefix(name, snapshotPrefix) || !strings.HasSuffix(name, snapshotSuffix) {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(snapshotTimeFormat, strings.TrimSuffix(strings.TrimPrefix(name, snapshotPrefix), snapshotSuffix))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// ListSnapshots returns the snapshots of the store, from the latest to the oldest
+func ListSnapshots(ctx context.Context, store Store) ([]Snapshot, error) {
+	names, err := store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var snapshots []Snapshot
+	for _, name := range names {
+		if t, ok := parseSnapshotName(name); ok {
+			snapshots = append(snapshots, Snapshot{Name: name, Time: t})
+		}
+	}
+	slices.SortFunc(snapshots, func(a, b Snapshot) int {
+		return b.Time.Compare(a.Time)
+	})
+	return snapshots, nil
+}
+
+// Create uploads a snapshot of the resources, and returns its name
+func (b *Backup) Create(ctx context.Context, now time.Time) (string, error) {
+	var buf bytes.Buffer
+	if err := b.export(ctx, &buf); err != nil {
+		return "", fmt.Errorf("error exporting resources: %w", err)
+	}
+	data, err := EncryptSecrets(buf.Bytes(), b.key)
+	if err != nil {
+		return "", err
+	}
+	var compressed bytes.Buffer
+	gw := gzip.NewWriter(&compressed)
+	if _, err := gw.Write(data); err != nil {
+		return "", fmt.Errorf("error compressing snapshot: %w", err)
+	}
+	if err := gw.Close(); err != nil {
+		return "", fmt.Errorf("error compressing snapshot: %w", err)
+	}
+	name := SnapshotName(now)
+	if err := b.store.Put(ctx, name, compressed.Bytes()); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// Prune deletes the snapshots exceeding the retention, and returns their names. The latest snapshot is always kept.
+func (b *Backup) Prune(ctx context.Context, now time.Time) ([]string, error) {
+	if b.retention.Count <= 0 && b.retention.MaxAge <= 0 {
+		return nil, nil
+	}
+	snapshots, err := ListSnapshots(ctx, b.store)
+	if err != nil {
+		return nil, err
+	}
+	var pruned []string
+	for i, snapshot := range snapshots {
+		if i == 0 {
+			continue
+		}
+		expired := b.retention.MaxAge > 0 && now.Sub(snapshot.Time) > b.retention.MaxAge
+		if (b.retention.Count > 0 && i >= b.retention.Count) || expired {
+			if err := b.store.Delete(ctx, snapshot.Name); err != nil {
+				return pruned, err
+			}
+			pruned = append(pruned, snapshot.Name)
+		}
+	}
+	return pruned, nil
+}
+
+// Run creates a snapshot and prunes the expired snapshots on the given schedule, until the context is done
+func (b *Backup) Run(ctx context.Context, schedule cron.Schedule) {
+	for {
+		next := schedule.Next(time.Now())
+		log.Infof("Next backup scheduled at %s", next.Format(time.RFC3339))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(next)):
+		}
+		now := time.Now()
+		name, err := b.Create(ctx, now)
+		if err != nil {
+			log.Errorf("Failed to create backup: %v", err)
+			continue
+		}
+		log.Infof("Created backup %s", name)
+		pruned, err := b.Prune(ctx, now)
+		if err != nil {
+			log.Errorf("Failed to prune backups: %v", err)
+		}
+		for _, name := range pruned {
+			log.Infof("Pruned backup %s", name)
+		}
+	}
+}
+
+// ReadSnapshot downloads the snapshot of the given location, e.g. s3://bucket/prefix/argocd-backup-20250101T000000Z.yaml.gz,
+// or s3://bucket/prefix/latest for the latest snapshot, and returns its resources as a YAML stream with the data of
+// the secrets decrypted with the given key.
+func ReadSnapshot(ctx context.Context, location string, key []byte) ([]byte, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot location %q: %w", location, err)
+	}
+	name := path.Base(u.Path)
+	u.Path = path.Dir(u.Path)
+	store, err := NewStore(u.String())
+	if err != nil {
+		return nil, err
+	}
+	if name == LatestSnapshot {
+		snapshots, err := ListSnapshots(ctx, store)
+		if err != nil {
+			return nil, err
+		}
+		if len(snapshots) == 0 {
+			return nil, fmt.Errorf("no snapshot found in %s", u.String())
+		}
+		name = snapshots[0].Name
+	}
+	compressed, err := store.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	gr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing snapshot %s: %w", name, err)
+	}
+	data, err := io.ReadAll(gr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing snapshot %s: %w", name, err)
+	}
+	return DecryptSecrets(data, key)
+}
+
+// EncryptSecrets encrypts the data of the secrets of the given YAML stream with the given key. The stringData of the
+// secrets is folded into their data first, so that it is encrypted too.
+func EncryptSecrets(data []byte, key []byte) ([]byte, error) {
+	return transformSecrets(data, func(secret *unstructured.Unstructured) error {
+		if len(key) == 0 {
+			return errors.New("the backup key is required to encrypt the secrets")
+		}
+		values, _, err := unstructured.NestedStringMap(secret.Object, "data")
+		if err != nil {
+			return fmt.Errorf("invalid data of secret %s: %w", secret.GetName(), err)
+		}
+		stringValues, _, err := unstructured.NestedStringMap(secret.Object, "stringData")
+		if err != nil {
+			return fmt.Errorf("invalid stringData of secret %s: %w", secret.GetName(), err)
+		}
+		if len(stringValues) > 0 && values == nil {
+			values = map[string]string{}
+		}
+		// like the API server, the stringData takes precedence over the data
+		for k, v := range stringValues {
+			values[k] = base64.StdEncoding.EncodeToString([]byte(v))
+		}
+		unstructured.RemoveNestedField(secret.Object, "stringData")
+		for k, v := range values {
+			encrypted, err := crypto.Encrypt([]byte(v), key)
+			if err != nil {
+				return fmt.Errorf("error encrypting secret %s: %w", secret.GetName(), err)
+			}
+			values[k] = base64.StdEncoding.EncodeToString(encrypted)
+		}
+		if len(values) > 0 {
+			if err := unstructured.SetNestedStringMap(secret.Object, values, "data"); err != nil {
+				return err
+			}
+		}
+		annotations := secret.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[AnnotationKeyEncrypted] = "true"
+		secret.SetAnnotations(annotations)
+		return nil
+	})
+}
+
+// DecryptSecrets decrypts the data of the secrets of the given YAML stream with the given key
+func DecryptSecrets(data []byte, key []byte) ([]byte, error) {
+	return transformSecrets(data, func(secret *unstructured.Unstructured) error {
+		annotations := secret.GetAnnotations()
+		if annotations[AnnotationKeyEncrypted] != "true" {
+			return nil
+		}
+		if len(key) == 0 {
+			return fmt.Errorf("the backup key is required to decrypt secret %s", secret.GetName())
+		}
+		values, _, err := unstructured.NestedStringMap(secret.Object, "data")
+		if err != nil {
+			return fmt.Errorf("invalid data of secret %s: %w", secret.GetName(), err)
+		}
+		for k, v := range values {
+			encrypted, err := base64.StdEncoding.DecodeString(v)
+			if err != nil {
+				return fmt.Errorf("invalid data of secret %s: %w", secret.GetName(), err)
+			}
+			decrypted, err := crypto.Decrypt(encrypted, key)
+			if err != nil {
+				return fmt.Errorf("error decrypting secret %s, is the backup key correct?: %w", secret.GetName(), err)
+			}
+			values[k] = string(decrypted)
+		}
+		if len(values) > 0 {
+			if err := unstructured.SetNestedStringMap(secret.Object, values, "data"); err != nil {
+				return err
+			}
+		}
+		delete(annotations, AnnotationKeyEncrypted)
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		secret.SetAnnotations(annotations)
+		return nil
+	})
+}
+
+func transformSecrets(data []byte, transform func(secret *unstructured.Unstructured) error) ([]byte, error) {
+	objs, err := kube.SplitYAML(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing snapshot: %w", err)
+	}
+	var buf bytes.Buffer
+	for _, obj := range objs {
+		if obj.GetAPIVersion() == "v1" && obj.GetKind() == "Secret" {
+			if err := transform(obj); err != nil {
+				return nil, err
+			}
+		}
+		out, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		buf.Write(out)
+		buf.WriteString(yamlSeparator)
+	}
+	return buf.Bytes(), nil
+}
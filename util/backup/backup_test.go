@@ -0,0 +1,160 @@
+package backup
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testResources = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: argocd-cm
+data:
+  url: https://argocd.example.com
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: argocd-secret
+  annotations:
+    foo: bar
+data:
+  server.secretkey: c2VjcmV0
+---
+apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+  name: default
+spec: {}
+`
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func testExport(_ context.Context, w io.Writer) error {
+	_, err := w.Write([]byte(testResources))
+	return err
+}
+
+func TestEncryptSecrets(t *testing.T) {
+	encrypted, err := EncryptSecrets([]byte(testResources), testKey)
+	require.NoError(t, err)
+	assert.NotContains(t, string(encrypted), "c2VjcmV0")
+	assert.Contains(t, string(encrypted), "https://argocd.example.com")
+
+	objs, err := kube.SplitYAML(encrypted)
+	require.NoError(t, err)
+	require.Len(t, objs, 3)
+	assert.Equal(t, "true", objs[1].GetAnnotations()[AnnotationKeyEncrypted])
+
+	t.Run("Decrypt", func(t *testing.T) {
+		decrypted, err := DecryptSecrets(encrypted, testKey)
+		require.NoError(t, err)
+		objs, err := kube.SplitYAML(decrypted)
+		require.NoError(t, err)
+		require.Len(t, objs, 3)
+		assert.Equal(t, map[string]string{"foo": "bar"}, objs[1].GetAnnotations())
+		assert.Equal(t, map[string]any{"server.secretkey": "c2VjcmV0"}, objs[1].Object["data"])
+	})
+
+	t.Run("Wrong key", func(t *testing.T) {
+		_, err := DecryptSecrets(encrypted, []byte("fedcba9876543210fedcba9876543210"))
+		assert.ErrorContains(t, err, "is the backup key correct?")
+	})
+
+	t.Run("String data", func(t *testing.T) {
+		resources := `apiVersion: v1
+kind: Secret
+metadata:
+  name: repo-creds
+data:
+  url: aHR0cHM6Ly9leGFtcGxlLmNvbQ==
+  password: b2xk
+stringData:
+  password: s3cr3t
+`
+		encrypted, err := EncryptSecrets([]byte(resources), testKey)
+		require.NoError(t, err)
+		assert.NotContains(t, string(encrypted), "s3cr3t")
+		assert.NotContains(t, string(encrypted), "stringData")
+
+		decrypted, err := DecryptSecrets(encrypted, testKey)
+		require.NoError(t, err)
+		objs, err := kube.SplitYAML(decrypted)
+		require.NoError(t, err)
+		require.Len(t, objs, 1)
+		assert.Equal(t, map[string]any{"url": "aHR0cHM6Ly9leGFtcGxlLmNvbQ==", "password": "czNjcjN0"}, objs[0].Object["data"])
+	})
+
+	t.Run("Missing key", func(t *testing.T) {
+		_, err := EncryptSecrets([]byte(testResources), nil)
+		require.ErrorContains(t, err, "the backup key is required")
+		_, err = DecryptSecrets(encrypted, nil)
+		assert.ErrorContains(t, err, "the backup key is required")
+	})
+}
+
+func TestBackup(t *testing.T) {
+	dir := t.TempDir()
+	store := NewFileStore(dir)
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	b := NewBackup(store, testKey, testExport, Retention{Count: 3, MaxAge: 72 * time.Hour})
+
+	for i := 5; i >= 0; i-- {
+		_, err := b.Create(t.Context(), now.Add(-time.Duration(i)*24*time.Hour))
+		require.NoError(t, err)
+	}
+	require.NoError(t, store.Put(t.Context(), "unrelated.txt", []byte("foo")))
+
+	snapshots, err := ListSnapshots(t.Context(), store)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 6)
+	assert.Equal(t, "argocd-backup-20250110T120000Z.yaml.gz", snapshots[0].Name)
+	assert.Equal(t, now, snapshots[0].Time)
+
+	pruned, err := b.Prune(t.Context(), now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"argocd-backup-20250107T120000Z.yaml.gz",
+		"argocd-backup-20250106T120000Z.yaml.gz",
+		"argocd-backup-20250105T120000Z.yaml.gz",
+	}, pruned)
+
+	names, err := store.List(t.Context())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"argocd-backup-20250110T120000Z.yaml.gz",
+		"argocd-backup-20250109T120000Z.yaml.gz",
+		"argocd-backup-20250108T120000Z.yaml.gz",
+		"unrelated.txt",
+	}, names)
+
+	t.Run("Latest snapshot is kept", func(t *testing.T) {
+		b := NewBackup(store, testKey, testExport, Retention{MaxAge: time.Hour})
+		pruned, err := b.Prune(t.Context(), now.Add(30*24*time.Hour))
+		require.NoError(t, err)
+		assert.Len(t, pruned, 2)
+		snapshots, err := ListSnapshots(t.Context(), store)
+		require.NoError(t, err)
+		assert.Len(t, snapshots, 1)
+	})
+
+	t.Run("Read snapshot", func(t *testing.T) {
+		for _, location := range []string{
+			"file://" + dir + "/argocd-backup-20250110T120000Z.yaml.gz",
+			"file://" + dir + "/" + LatestSnapshot,
+		} {
+			data, err := ReadSnapshot(t.Context(), location, testKey)
+			require.NoError(t, err, location)
+			assert.Contains(t, string(data), "c2VjcmV0", location)
+			assert.NotContains(t, string(data), AnnotationKeyEncrypted, location)
+		}
+		_, err := ReadSnapshot(t.Context(), "file://"+t.TempDir()+"/"+LatestSnapshot, testKey)
+		assert.ErrorContains(t, err, "no snapshot found")
+	})
+}
@@ -0,0 +1,191 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// gcsEndpoint is the endpoint of the S3 compatible XML API of Google Cloud Storage, authenticated with HMAC keys.
+const gcsEndpoint = "https://storage.googleapis.com"
+
+// Store stores the snapshots
+type Store interface {
+	// Put uploads the snapshot with the given name
+	Put(ctx context.Context, name string, data []byte) error
+	// Get downloads the snapshot with the given name
+	Get(ctx context.Context, name string) ([]byte, error)
+	// List returns the names of the snapshots
+	List(ctx context.Context) ([]string, error)
+	// Delete deletes the snapshot with the given name
+	Delete(ctx context.Context, name string) error
+}
+
+// NewStore returns the store of the given location, one of:
+//
+//   - s3://<bucket>/<prefix>, with the optional region and endpoint query parameters, e.g. for MinIO
+//   - gs://<bucket>/<prefix>, using the S3 compatible API of Google Cloud Storage with HMAC keys
+//   - file://<directory>, e.g. for a persistent volume
+//
+// The S3 and GCS credentials are read from the standard AWS environment variables, shared configuration files or
+// instance roles.
+func NewStore(location string) (Store, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup location %q: %w", location, err)
+	}
+	switch u.Scheme {
+	case "s3", "gs":
+		if u.Host == "" {
+			return nil, fmt.Errorf("invalid backup location %q: the bucket is missing", location)
+		}
+		config := aws.NewConfig()
+		if region := u.Query().Get("region"); region != "" {
+			config = config.WithRegion(region)
+		}
+		endpoint := u.Query().Get("endpoint")
+		if u.Scheme == "gs" {
+			endpoint = gcsEndpoint
+			config = config.WithRegion("auto")
+		}
+		if endpoint != "" {
+			config = config.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+		}
+		sess, err := session.NewSessionWithOptions(session.Options{Config: *config, SharedConfigState: session.SharedConfigEnable})
+		if err != nil {
+			return nil, fmt.Errorf("error creating session: %w", err)
+		}
+		return NewS3Store(s3.New(sess), u.Host, strings.Trim(u.Path, "/")), nil
+	case "file":
+		return NewFileStore(u.Path), nil
+	default:
+		return nil, fmt.Errorf("invalid backup location %q: the scheme must be s3, gs or file", location)
+	}
+}
+
+type s3Store struct {
+	client s3iface.S3API
+	bucket string
+	prefix string
+}
+
+// NewS3Store returns a store of the snapshots in the given bucket, under the given prefix
+func NewS3Store(client s3iface.S3API, bucket, prefix string) Store {
+	return &s3Store{client: client, bucket: bucket, prefix: prefix}
+}
+
+func (s *s3Store) key(name string) string {
+	return path.Join(s.prefix, name)
+}
+
+func (s *s3Store) Put(ctx context.Context, name string, data []byte) error {
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+		Body:   bytes.NewReader(data),
+	})
+	if err != nil {
+		return fmt.Errorf("error uploading %s to bucket %s: %w", s.key(name), s.bucket, err)
+	}
+	return nil
+}
+
+func (s *s3Store) Get(ctx context.Context, name string) ([]byte, error) {
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error downloading %s from bucket %s: %w", s.key(name), s.bucket, err)
+	}
+	defer utilio.Close(out.Body)
+	return io.ReadAll(out.Body)
+}
+
+func (s *s3Store) List(ctx context.Context) ([]string, error) {
+	prefix := s.prefix
+	if prefix != "" {
+		prefix += "/"
+	}
+	var names []string
+	err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket:    aws.String(s.bucket),
+		Prefix:    aws.String(prefix),
+		Delimiter: aws.String("/"),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			names = append(names, strings.TrimPrefix(aws.StringValue(object.Key), prefix))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing bucket %s: %w", s.bucket, err)
+	}
+	return names, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, name string) error {
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting %s from bucket %s: %w", s.key(name), s.bucket, err)
+	}
+	return nil
+}
+
+type fileStore struct {
+	dir string
+}
+
+// NewFileStore returns a store of the snapshots in the given directory
+func NewFileStore(dir string) Store {
+	return &fileStore{dir: dir}
+}
+
+func (s *fileStore) Put(_ context.Context, name string, data []byte) error {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return fmt.Errorf("error creating directory %s: %w", s.dir, err)
+	}
+	return os.WriteFile(filepath.Join(s.dir, name), data, 0o600)
+}
+
+func (s *fileStore) Get(_ context.Context, name string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(s.dir, name))
+}
+
+func (s *fileStore) List(_ context.Context) ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory %s: %w", s.dir, err)
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+func (s *fileStore) Delete(_ context.Context, name string) error {
+	return os.Remove(filepath.Join(s.dir, name))
+}
@@ -0,0 +1,94 @@
+package backup
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeS3Client struct {
+	s3iface.S3API
+	objects map[string][]byte
+}
+
+func (c *fakeS3Client) PutObjectWithContext(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) (*s3.PutObjectOutput, error) {
+	data, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	c.objects[aws.StringValue(input.Bucket)+"/"+aws.StringValue(input.Key)] = data
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (c *fakeS3Client) GetObjectWithContext(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	data, ok := c.objects[aws.StringValue(input.Bucket)+"/"+aws.StringValue(input.Key)]
+	if !ok {
+		return nil, awserr.New(s3.ErrCodeNoSuchKey, "the key does not exist", nil)
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data))}, nil
+}
+
+func (c *fakeS3Client) ListObjectsV2PagesWithContext(_ aws.Context, input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool, _ ...request.Option) error {
+	page := &s3.ListObjectsV2Output{}
+	for key := range c.objects {
+		key = strings.TrimPrefix(key, aws.StringValue(input.Bucket)+"/")
+		rest, ok := strings.CutPrefix(key, aws.StringValue(input.Prefix))
+		if ok && !strings.Contains(rest, aws.StringValue(input.Delimiter)) {
+			page.Contents = append(page.Contents, &s3.Object{Key: aws.String(key)})
+		}
+	}
+	fn(page, true)
+	return nil
+}
+
+func (c *fakeS3Client) DeleteObjectWithContext(_ aws.Context, input *s3.DeleteObjectInput, _ ...request.Option) (*s3.DeleteObjectOutput, error) {
+	delete(c.objects, aws.StringValue(input.Bucket)+"/"+aws.StringValue(input.Key))
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func TestS3Store(t *testing.T) {
+	client := &fakeS3Client{objects: map[string][]byte{
+		"backups/argocd/nested/foo": []byte("foo"),
+		"backups/other":             []byte("bar"),
+	}}
+	store := NewS3Store(client, "backups", "argocd")
+
+	require.NoError(t, store.Put(t.Context(), "snapshot", []byte("data")))
+	assert.Equal(t, []byte("data"), client.objects["backups/argocd/snapshot"])
+
+	data, err := store.Get(t.Context(), "snapshot")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+
+	names, err := store.List(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"snapshot"}, names)
+
+	require.NoError(t, store.Delete(t.Context(), "snapshot"))
+	assert.NotContains(t, client.objects, "backups/argocd/snapshot")
+}
+
+func TestNewStore(t *testing.T) {
+	for _, location := range []string{
+		"s3://backups/argocd?region=us-east-1",
+		"s3://backups?endpoint=http://minio:9000",
+		"gs://backups/argocd",
+		"file:///var/backups",
+	} {
+		_, err := NewStore(location)
+		require.NoError(t, err, location)
+	}
+	_, err := NewStore("s3:///argocd")
+	require.ErrorContains(t, err, "the bucket is missing")
+	_, err = NewStore("ftp://backups/argocd")
+	assert.ErrorContains(t, err, "the scheme must be s3, gs or file")
+}
@@ -2,7 +2,10 @@ package appstate
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"time"
 
@@ -20,6 +23,9 @@ var (
 
 const (
 	clusterInfoCacheExpiration = 10 * time.Minute
+	// appRefreshHintsKey is the key of the channel on which the refreshes of applications are requested to the
+	// application controller shards
+	appRefreshHintsKey = "app|refresh-hints"
 )
 
 type Cache struct {
@@ -71,6 +77,42 @@ func (c *Cache) SetAppManagedResources(appName string, managedResources []*appv1
 	return c.SetItem(appManagedResourcesKey(appName), managedResources, c.appStateCacheExpiration, managedResources == nil)
 }
 
+// ManagedResourcesVersion identifies the state of the application the managed resources were computed for, so that
+// their consumers can tell whether they are up to date
+type ManagedResourcesVersion struct {
+	// Generation is the generation of the application spec
+	Generation int64 `json:"generation"`
+	// Revisions are the revisions of the sources the live state was compared to
+	Revisions []string `json:"revisions,omitempty"`
+}
+
+// NewManagedResourcesVersion returns the version of the managed resources computed for the given application
+// generation and sync status
+func NewManagedResourcesVersion(generation int64, syncStatus appv1.SyncStatus) *ManagedResourcesVersion {
+	revisions := syncStatus.Revisions
+	if len(revisions) == 0 && syncStatus.Revision != "" {
+		revisions = []string{syncStatus.Revision}
+	}
+	return &ManagedResourcesVersion{Generation: generation, Revisions: revisions}
+}
+
+// Equal returns whether both versions identify the same application state
+func (v *ManagedResourcesVersion) Equal(other *ManagedResourcesVersion) bool {
+	return v.Generation == other.Generation && slices.Equal(v.Revisions, other.Revisions)
+}
+
+func appManagedResourcesVersionKey(appName string) string {
+	return "app|managed-resources-version|" + appName
+}
+
+func (c *Cache) GetAppManagedResourcesVersion(appName string, res *ManagedResourcesVersion) error {
+	return c.GetItem(appManagedResourcesVersionKey(appName), res)
+}
+
+func (c *Cache) SetAppManagedResourcesVersion(appName string, version *ManagedResourcesVersion) error {
+	return c.SetItem(appManagedResourcesVersionKey(appName), version, c.appStateCacheExpiration, version == nil)
+}
+
 func appResourcesTreeKey(appName string, shard int64) string {
 	key := "app|resources-tree|" + appName
 	if shard > 0 {
@@ -83,6 +125,10 @@ func clusterInfoKey(server string) string {
 	return "cluster|info|" + server
 }
 
+func clusterCacheStatsKey(server string) string {
+	return "cluster|cache-stats|" + server
+}
+
 func (c *Cache) GetAppResourcesTree(appName string, res *appv1.ApplicationTree) error {
 	err := c.GetItem(appResourcesTreeKey(appName, 0), &res)
 	if res.ShardsCount > 1 {
@@ -129,3 +175,196 @@ func (c *Cache) GetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	err := c.GetItem(clusterInfoKey(server), &res)
 	return err
 }
+
+// ClusterCacheStats holds the statistics of the cache of a cluster in the application controller
+type ClusterCacheStats struct {
+	// ResourcesByGVK holds the number of the cached resources of each API version and kind, e.g. "apps/v1/Deployment"
+	ResourcesByGVK map[string]int64 `json:"resourcesByGVK,omitempty"`
+	// ManifestsCount holds the number of the cached resources whose manifest is cached
+	ManifestsCount int64 `json:"manifestsCount,omitempty"`
+	// WatchesCount holds the number of the open watches of the cluster
+	WatchesCount int64 `json:"watchesCount,omitempty"`
+	// LastFullResyncTime holds the time of the last full synchronization of the cache
+	LastFullResyncTime *time.Time `json:"lastFullResyncTime,omitempty"`
+	// MemoryEstimateBytes holds a rough estimate of the memory used by the cache
+	MemoryEstimateBytes int64 `json:"memoryEstimateBytes,omitempty"`
+}
+
+func (c *Cache) SetClusterCacheStats(server string, stats *ClusterCacheStats) error {
+	return c.SetItem(clusterCacheStatsKey(server), stats, clusterInfoCacheExpiration, stats == nil)
+}
+
+func (c *Cache) GetClusterCacheStats(server string, res *ClusterCacheStats) error {
+	return c.GetItem(clusterCacheStatsKey(server), res)
+}
+
+// RequestAppsRefresh asks the application controller shards to refresh the given applications, identified by their
+// qualified names. Each shard refreshes the applications it manages and ignores the other ones.
+func (c *Cache) RequestAppsRefresh(appNames []string) error {
+	data, err := json.Marshal(appNames)
+	if err != nil {
+		return fmt.Errorf("error marshaling application names: %w", err)
+	}
+	return c.Cache.PublishMessage(appRefreshHintsKey, string(data))
+}
+
+// OnAppsRefreshRequested calls the given callback with the names of the applications whose refresh is requested using
+// RequestAppsRefresh, until the context is done
+func (c *Cache) OnAppsRefreshRequested(ctx context.Context, callback func(appNames []string) error) error {
+	return c.Cache.OnMessage(ctx, appRefreshHintsKey, func(message string) error {
+		var appNames []string
+		if err := json.Unmarshal([]byte(message), &appNames); err != nil {
+			return fmt.Errorf("error unmarshaling application names: %w", err)
+		}
+		return callback(appNames)
+	})
+}
+
+func syncSlotKey(scope string, slot int) string {
+	return fmt.Sprintf("sync-slot|%s|%d", scope, slot)
+}
+
+func syncQueueKey(scope string) string {
+	return "sync-queue|" + scope
+}
+
+// syncQueueEntry is a holder waiting for the sync slot of a queued scope
+type syncQueueEntry struct {
+	Holder    string    `json:"holder"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// AcquireSyncSlot acquires one of the given number of sync slots of the given scope for the given holder, or refreshes
+// the expiration of the slot the holder already acquired, and returns whether a slot is acquired. The slots expire
+// if they are not refreshed, so that the slots of crashed holders are eventually released.
+func (c *Cache) AcquireSyncSlot(scope string, slots int, holder string, expiration time.Duration) (bool, error) {
+	free := -1
+	for i := 0; i < slots; i++ {
+		var slotHolder string
+		err := c.GetItem(syncSlotKey(scope, i), &slotHolder)
+		switch {
+		case err == nil && slotHolder == holder:
+			return true, c.SetItem(syncSlotKey(scope, i), holder, expiration, false)
+		case errors.Is(err, ErrCacheMiss):
+			if free < 0 {
+				free = i
+			}
+		case err != nil:
+			return false, err
+		}
+	}
+	for i := free; i >= 0 && i < slots; i++ {
+		err := c.Cache.SetItem(syncSlotKey(scope, i), holder, &cacheutil.CacheActionOpts{Expiration: expiration, DisableOverwrite: true})
+		if err != nil {
+			return false, err
+		}
+		// the slot may have been acquired by another holder in the meantime
+		var slotHolder string
+		if err := c.GetItem(syncSlotKey(scope, i), &slotHolder); err != nil && !errors.Is(err, ErrCacheMiss) {
+			return false, err
+		}
+		if slotHolder == holder {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AcquireQueuedSyncSlot acquires the single sync slot of the given scope for the given holder, in the order the
+// holders first tried to acquire it, and returns whether the slot is acquired or else the position of the holder in
+// the queue of the scope, starting at 1. The queue entries expire if they are not refreshed, so that the crashed
+// holders eventually leave the queue.
+func (c *Cache) AcquireQueuedSyncSlot(scope string, holder string, expiration time.Duration) (bool, int, error) {
+	var slotHolder string
+	err := c.GetItem(syncSlotKey(scope, 0), &slotHolder)
+	if err == nil && slotHolder == holder {
+		return true, 0, c.SetItem(syncSlotKey(scope, 0), holder, expiration, false)
+	}
+	if err != nil && !errors.Is(err, ErrCacheMiss) {
+		return false, 0, err
+	}
+	slotFree := err != nil
+
+	queue, err := c.getSyncQueue(scope)
+	if err != nil {
+		return false, 0, err
+	}
+	expiresAt := time.Now().Add(expiration)
+	position := slices.IndexFunc(queue, func(entry syncQueueEntry) bool {
+		return entry.Holder == holder
+	})
+	if position < 0 {
+		position = len(queue)
+		queue = append(queue, syncQueueEntry{Holder: holder})
+	}
+	queue[position].ExpiresAt = expiresAt
+
+	if slotFree && position == 0 {
+		acquired, err := c.AcquireSyncSlot(scope, 1, holder, expiration)
+		if err != nil {
+			return false, 0, err
+		}
+		if acquired {
+			return true, 0, c.setSyncQueue(scope, queue[1:], expiration)
+		}
+	}
+	return false, position + 1, c.setSyncQueue(scope, queue, expiration)
+}
+
+// LeaveSyncQueue removes the given holder from the queue of the sync slot of the given scope, if it is queued
+func (c *Cache) LeaveSyncQueue(scope string, holder string) error {
+	queue, err := c.getSyncQueue(scope)
+	if err != nil {
+		return err
+	}
+	remaining := slices.DeleteFunc(slices.Clone(queue), func(entry syncQueueEntry) bool {
+		return entry.Holder == holder
+	})
+	if len(remaining) == len(queue) {
+		return nil
+	}
+	var expiration time.Duration
+	for _, entry := range remaining {
+		expiration = max(expiration, time.Until(entry.ExpiresAt))
+	}
+	return c.setSyncQueue(scope, remaining, expiration)
+}
+
+// getSyncQueue returns the unexpired entries of the queue of the sync slot of the given scope. The queue is updated
+// without transaction: the entry of a holder lost by concurrent updates is added back the next time the holder tries
+// to acquire the slot.
+func (c *Cache) getSyncQueue(scope string) ([]syncQueueEntry, error) {
+	var queue []syncQueueEntry
+	if err := c.GetItem(syncQueueKey(scope), &queue); err != nil && !errors.Is(err, ErrCacheMiss) {
+		return nil, err
+	}
+	now := time.Now()
+	return slices.DeleteFunc(queue, func(entry syncQueueEntry) bool {
+		return entry.ExpiresAt.Before(now)
+	}), nil
+}
+
+func (c *Cache) setSyncQueue(scope string, queue []syncQueueEntry, expiration time.Duration) error {
+	if len(queue) == 0 {
+		return c.SetItem(syncQueueKey(scope), queue, 0, true)
+	}
+	return c.SetItem(syncQueueKey(scope), queue, expiration, false)
+}
+
+// ReleaseSyncSlot releases the sync slot of the given scope acquired by the given holder, if any
+func (c *Cache) ReleaseSyncSlot(scope string, slots int, holder string) error {
+	for i := 0; i < slots; i++ {
+		var slotHolder string
+		err := c.GetItem(syncSlotKey(scope, i), &slotHolder)
+		if errors.Is(err, ErrCacheMiss) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if slotHolder == holder {
+			return c.SetItem(syncSlotKey(scope, i), holder, 0, true)
+		}
+	}
+	return nil
+}
@@ -1,9 +1,12 @@
 package appstate
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,6 +44,29 @@ func TestCache_GetAppManagedResources(t *testing.T) {
 	assert.Equal(t, &[]*ResourceDiff{{Name: "my-name"}}, value)
 }
 
+func TestCache_GetAppManagedResourcesVersion(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	value := &ManagedResourcesVersion{}
+	err := cache.GetAppManagedResourcesVersion("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	version := NewManagedResourcesVersion(2, SyncStatus{Revision: "abc"})
+	err = cache.SetAppManagedResourcesVersion("my-appname", version)
+	require.NoError(t, err)
+	// cache hit
+	err = cache.GetAppManagedResourcesVersion("my-appname", value)
+	require.NoError(t, err)
+	assert.True(t, version.Equal(value))
+	assert.False(t, value.Equal(NewManagedResourcesVersion(3, SyncStatus{Revision: "abc"})))
+	assert.False(t, value.Equal(NewManagedResourcesVersion(2, SyncStatus{Revisions: []string{"abc", "def"}})))
+	// delete
+	err = cache.SetAppManagedResourcesVersion("my-appname", nil)
+	require.NoError(t, err)
+	err = cache.GetAppManagedResourcesVersion("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+}
+
 func TestCache_GetAppResourcesTree(t *testing.T) {
 	cache := newFixtures().Cache
 	// cache miss
@@ -77,8 +103,129 @@ func TestCache_GetClusterInfo(t *testing.T) {
 	assert.Equal(t, &ClusterInfo{ServerVersion: "0.24.0"}, res)
 }
 
+func TestCache_GetClusterCacheStats(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	res := &ClusterCacheStats{}
+	err := cache.GetClusterCacheStats("http://kind-cluster", res)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	stats := &ClusterCacheStats{ResourcesByGVK: map[string]int64{"v1/Pod": 3}, WatchesCount: 2, MemoryEstimateBytes: 4096}
+	err = cache.SetClusterCacheStats("http://kind-cluster", stats)
+	require.NoError(t, err)
+	// cache hit
+	err = cache.GetClusterCacheStats("http://kind-cluster", res)
+	require.NoError(t, err)
+	assert.Equal(t, stats, res)
+}
+
 func TestAddCacheFlagsToCmd(t *testing.T) {
 	cache, err := AddCacheFlagsToCmd(&cobra.Command{})()
 	require.NoError(t, err)
 	assert.Equal(t, 1*time.Hour, cache.appStateCacheExpiration)
 }
+
+func TestCache_SyncSlots(t *testing.T) {
+	cache := newFixtures().Cache
+
+	acquired, err := cache.AcquireSyncSlot("cluster|https://kubernetes.default.svc", 2, "argocd/app1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	acquired, err = cache.AcquireSyncSlot("cluster|https://kubernetes.default.svc", 2, "argocd/app2", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	// no slot is left
+	acquired, err = cache.AcquireSyncSlot("cluster|https://kubernetes.default.svc", 2, "argocd/app3", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+	// the slots of the other scopes are independent
+	acquired, err = cache.AcquireSyncSlot("project|default", 2, "argocd/app3", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	// the holders keep their slot
+	acquired, err = cache.AcquireSyncSlot("cluster|https://kubernetes.default.svc", 2, "argocd/app1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	require.NoError(t, cache.ReleaseSyncSlot("cluster|https://kubernetes.default.svc", 2, "argocd/app1"))
+	// releasing a slot which is not held is a no-op
+	require.NoError(t, cache.ReleaseSyncSlot("cluster|https://kubernetes.default.svc", 2, "argocd/app3"))
+	acquired, err = cache.AcquireSyncSlot("cluster|https://kubernetes.default.svc", 2, "argocd/app3", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+}
+
+func TestCache_QueuedSyncSlot(t *testing.T) {
+	cache := newFixtures().Cache
+
+	acquired, position, err := cache.AcquireQueuedSyncSlot("concurrency|db", "argocd/app1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	assert.Equal(t, 0, position)
+	// the other holders are queued in order
+	acquired, position, err = cache.AcquireQueuedSyncSlot("concurrency|db", "argocd/app2", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+	assert.Equal(t, 1, position)
+	acquired, position, err = cache.AcquireQueuedSyncSlot("concurrency|db", "argocd/app3", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+	assert.Equal(t, 2, position)
+	acquired, position, err = cache.AcquireQueuedSyncSlot("concurrency|db", "argocd/app2", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+	assert.Equal(t, 1, position)
+
+	require.NoError(t, cache.ReleaseSyncSlot("concurrency|db", 1, "argocd/app1"))
+	// the slot is kept for the first queued holder
+	acquired, position, err = cache.AcquireQueuedSyncSlot("concurrency|db", "argocd/app3", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+	assert.Equal(t, 2, position)
+	acquired, _, err = cache.AcquireQueuedSyncSlot("concurrency|db", "argocd/app2", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	acquired, position, err = cache.AcquireQueuedSyncSlot("concurrency|db", "argocd/app3", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+	assert.Equal(t, 1, position)
+
+	// the holders leaving the queue do not hold the next ones back
+	_, position, err = cache.AcquireQueuedSyncSlot("concurrency|db", "argocd/app4", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 2, position)
+	require.NoError(t, cache.LeaveSyncQueue("concurrency|db", "argocd/app3"))
+	_, position, err = cache.AcquireQueuedSyncSlot("concurrency|db", "argocd/app4", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 1, position)
+}
+
+func TestCache_AppsRefreshRequested(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+	cache := NewCache(cacheutil.NewCache(cacheutil.NewRedisCache(redis.NewClient(&redis.Options{Addr: mr.Addr()}), time.Minute, cacheutil.RedisCompressionNone)), time.Minute)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	requested := make(chan []string, 10)
+	go func() {
+		_ = cache.OnAppsRefreshRequested(ctx, func(appNames []string) error {
+			requested <- appNames
+			return nil
+		})
+	}()
+
+	// the message is published until the subscription is established
+	var appNames []string
+	require.Eventually(t, func() bool {
+		require.NoError(t, cache.RequestAppsRefresh([]string{"argocd/app1", "apps/app2"}))
+		select {
+		case appNames = <-requested:
+			return true
+		case <-time.After(10 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"argocd/app1", "apps/app2"}, appNames)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	certutil "github.com/argoproj/argo-cd/v3/util/cert"
 	"github.com/argoproj/argo-cd/v3/util/env"
+	tlsutil "github.com/argoproj/argo-cd/v3/util/tls"
 )
 
 const (
@@ -38,6 +39,8 @@ const (
 const (
 	// CLIFlagRedisCompress is a cli flag name to define the redis compression setting for data sent to redis
 	CLIFlagRedisCompress = "redis-compress"
+	// CLIFlagRedisKeyPrefix is a cli flag name to define the prefix of the redis keys, allowing several Argo CD instances to share the same redis
+	CLIFlagRedisKeyPrefix = "redis-key-prefix"
 )
 
 func NewCache(client CacheClient) *Cache {
@@ -88,6 +91,9 @@ func buildFailoverRedisClient(sentinelMaster, sentinelUsername, sentinelPassword
 type Options struct {
 	FlagPrefix      string
 	OnClientCreated func(client *redis.Client)
+	// OnKeyPrefixParsed is called with the prefix of the redis keys, so that the other users of the client can prefix
+	// their keys too
+	OnKeyPrefixParsed func(keyPrefix string)
 }
 
 func (o *Options) callOnClientCreated(client *redis.Client) {
@@ -96,6 +102,12 @@ func (o *Options) callOnClientCreated(client *redis.Client) {
 	}
 }
 
+func (o *Options) callOnKeyPrefixParsed(keyPrefix string) {
+	if o.OnKeyPrefixParsed != nil {
+		o.OnKeyPrefixParsed(keyPrefix)
+	}
+}
+
 func (o *Options) getEnvPrefix() string {
 	return strings.ReplaceAll(strings.ToUpper(o.FlagPrefix), "-", "_")
 }
@@ -109,6 +121,9 @@ func mergeOptions(opts ...Options) Options {
 		if o.OnClientCreated != nil {
 			result.OnClientCreated = o.OnClientCreated
 		}
+		if o.OnKeyPrefixParsed != nil {
+			result.OnKeyPrefixParsed = o.OnKeyPrefixParsed
+		}
 	}
 	return result
 }
@@ -141,6 +156,7 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...Options) func() (*Cache, err
 	redisUseTLS := false
 	insecureRedis := false
 	compressionStr := ""
+	keyPrefix := ""
 	opt := mergeOptions(opts...)
 	var defaultCacheExpiration time.Duration
 
@@ -164,8 +180,10 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...Options) func() (*Cache, err
 	insecureRedisSrc := getFlagVal(cmd, opt, "redis-insecure-skip-tls-verify", cmd.Flags().GetBool)
 	cmd.Flags().StringVar(&redisCACertificate, opt.FlagPrefix+"redis-ca-certificate", "", "Path to Redis server CA certificate (e.g. /etc/certs/redis/ca.crt). If not specified, system trusted CAs will be used for server certificate validation.")
 	redisCACertificateSrc := getFlagVal(cmd, opt, "redis-ca-certificate", cmd.Flags().GetString)
-	cmd.Flags().StringVar(&compressionStr, opt.FlagPrefix+CLIFlagRedisCompress, env.StringFromEnv(opt.getEnvPrefix()+"REDIS_COMPRESSION", string(RedisCompressionGZip)), "Enable compression for data sent to Redis with the required compression algorithm. (possible values: gzip, none)")
+	cmd.Flags().StringVar(&compressionStr, opt.FlagPrefix+CLIFlagRedisCompress, env.StringFromEnv(opt.getEnvPrefix()+"REDIS_COMPRESSION", string(RedisCompressionGZip)), "Enable compression for data sent to Redis with the required compression algorithm. (possible values: gzip, zstd, none)")
 	compressionStrSrc := getFlagVal(cmd, opt, CLIFlagRedisCompress, cmd.Flags().GetString)
+	cmd.Flags().StringVar(&keyPrefix, opt.FlagPrefix+CLIFlagRedisKeyPrefix, env.StringFromEnv(opt.getEnvPrefix()+"REDIS_KEY_PREFIX", ""), "Prefix of the keys and of the channels in Redis, allowing several Argo CD instances to share the same Redis. All the Argo CD components and the CLI must use the same prefix.")
+	keyPrefixSrc := getFlagVal(cmd, opt, CLIFlagRedisKeyPrefix, cmd.Flags().GetString)
 	return func() (*Cache, error) {
 		redisAddress := redisAddressSrc()
 		redisDB := redisDBSrc()
@@ -178,6 +196,7 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...Options) func() (*Cache, err
 		insecureRedis := insecureRedisSrc()
 		redisCACertificate := redisCACertificateSrc()
 		compressionStr := compressionStrSrc()
+		keyPrefix := keyPrefixSrc()
 
 		var tlsConfig *tls.Config
 		if redisUseTLS {
@@ -189,6 +208,9 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...Options) func() (*Cache, err
 				}
 				tlsConfig.Certificates = []tls.Certificate{clientCert}
 			}
+			if err := tlsutil.EnforceFIPS(tlsConfig); err != nil {
+				return nil, fmt.Errorf("redis TLS configuration is not FIPS compliant: %w", err)
+			}
 			switch {
 			case insecureRedis:
 				tlsConfig.InsecureSkipVerify = true
@@ -230,10 +252,14 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...Options) func() (*Cache, err
 		if err != nil {
 			return nil, err
 		}
+		if err := ValidateKeyPrefix(keyPrefix); err != nil {
+			return nil, err
+		}
+		opt.callOnKeyPrefixParsed(keyPrefix)
 		if len(sentinelAddresses) > 0 {
 			client := buildFailoverRedisClient(sentinelMaster, sentinelUsername, sentinelPassword, password, username, redisDB, maxRetries, tlsConfig, sentinelAddresses)
 			opt.callOnClientCreated(client)
-			return NewCache(NewRedisCache(client, defaultCacheExpiration, compression)), nil
+			return NewCache(NewRedisCacheWithKeyPrefix(client, defaultCacheExpiration, compression, keyPrefix)), nil
 		}
 		if redisAddress == "" {
 			redisAddress = common.DefaultRedisAddr
@@ -241,7 +267,7 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...Options) func() (*Cache, err
 
 		client := buildRedisClient(redisAddress, password, username, redisDB, maxRetries, tlsConfig)
 		opt.callOnClientCreated(client)
-		return NewCache(NewRedisCache(client, defaultCacheExpiration, compression)), nil
+		return NewCache(NewRedisCacheWithKeyPrefix(client, defaultCacheExpiration, compression, keyPrefix)), nil
 	}
 }
 
@@ -301,3 +327,11 @@ func (c *Cache) OnUpdated(ctx context.Context, key string, callback func() error
 func (c *Cache) NotifyUpdated(key string) error {
 	return c.client.NotifyUpdated(c.generateFullKey(key))
 }
+
+func (c *Cache) OnMessage(ctx context.Context, key string, callback func(message string) error) error {
+	return c.client.OnMessage(ctx, c.generateFullKey(key), callback)
+}
+
+func (c *Cache) PublishMessage(key string, message string) error {
+	return c.client.PublishMessage(c.generateFullKey(key), message)
+}
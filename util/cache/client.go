@@ -34,4 +34,6 @@ type CacheClient interface {
 	Delete(key string) error
 	OnUpdated(ctx context.Context, key string, callback func() error) error
 	NotifyUpdated(key string) error
+	OnMessage(ctx context.Context, key string, callback func(message string) error) error
+	PublishMessage(key string, message string) error
 }
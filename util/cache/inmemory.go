@@ -97,6 +97,14 @@ func (i *InMemoryCache) NotifyUpdated(_ string) error {
 	return nil
 }
 
+func (i *InMemoryCache) OnMessage(_ context.Context, _ string, _ func(string) error) error {
+	return nil
+}
+
+func (i *InMemoryCache) PublishMessage(_ string, _ string) error {
+	return nil
+}
+
 // Items return a list of items in the cache; requires passing a constructor function
 // so that the items can be decoded from gob format.
 func (i *InMemoryCache) Items(createNewObject func() any) (map[string]any, error) {
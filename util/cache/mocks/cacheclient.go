@@ -72,3 +72,19 @@ func (c *MockCacheClient) NotifyUpdated(key string) error {
 	}
 	return c.BaseCache.NotifyUpdated(key)
 }
+
+func (c *MockCacheClient) OnMessage(ctx context.Context, key string, callback func(message string) error) error {
+	args := c.Called(ctx, key, callback)
+	if len(args) > 0 && args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+	return c.BaseCache.OnMessage(ctx, key, callback)
+}
+
+func (c *MockCacheClient) PublishMessage(key string, message string) error {
+	args := c.Called(key, message)
+	if len(args) > 0 && args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+	return c.BaseCache.PublishMessage(key, message)
+}
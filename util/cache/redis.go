@@ -9,12 +9,14 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"strings"
 	"sync"
 	"time"
 
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 
 	rediscache "github.com/go-redis/cache/v9"
+	"github.com/klauspost/compress/zstd"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -23,6 +25,14 @@ type RedisCompressionType string
 var (
 	RedisCompressionNone RedisCompressionType = "none"
 	RedisCompressionGZip RedisCompressionType = "gzip"
+	RedisCompressionZstd RedisCompressionType = "zstd"
+)
+
+// zstdEncoder and zstdDecoder are shared by all the caches. Unlike their streams, their EncodeAll and DecodeAll methods
+// are safe for concurrent use, which spares the allocation of the buffers of a new encoder or decoder per value.
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
 )
 
 func CompressionTypeFromString(s string) (RedisCompressionType, error) {
@@ -31,19 +41,37 @@ func CompressionTypeFromString(s string) (RedisCompressionType, error) {
 		return RedisCompressionNone, nil
 	case string(RedisCompressionGZip):
 		return RedisCompressionGZip, nil
+	case string(RedisCompressionZstd):
+		return RedisCompressionZstd, nil
 	}
 	return "", fmt.Errorf("unknown compression type: %s", s)
 }
 
 func NewRedisCache(client *redis.Client, expiration time.Duration, compressionType RedisCompressionType) CacheClient {
+	return NewRedisCacheWithKeyPrefix(client, expiration, compressionType, "")
+}
+
+// NewRedisCacheWithKeyPrefix returns a cache prefixing its keys and its channels with the given prefix, so that several
+// Argo CD instances can share the same Redis without collisions
+func NewRedisCacheWithKeyPrefix(client *redis.Client, expiration time.Duration, compressionType RedisCompressionType, keyPrefix string) CacheClient {
 	return &redisCache{
 		client:               client,
 		expiration:           expiration,
 		cache:                rediscache.New(&rediscache.Options{Redis: client}),
 		redisCompressionType: compressionType,
+		keyPrefix:            keyPrefix,
 	}
 }
 
+// ValidateKeyPrefix returns an error if the given prefix of the Redis keys contains the special characters of the
+// patterns of the SCAN command, which would make the scans of the prefixed keys match the keys of other instances
+func ValidateKeyPrefix(keyPrefix string) error {
+	if strings.ContainsAny(keyPrefix, "*?[]\\") {
+		return fmt.Errorf("invalid Redis key prefix %q: must not contain any of the characters '*', '?', '[', ']' or '\\'", keyPrefix)
+	}
+	return nil
+}
+
 // compile-time validation of adherence of the CacheClient contract
 var _ CacheClient = &redisCache{}
 
@@ -52,18 +80,33 @@ type redisCache struct {
 	client               *redis.Client
 	cache                *rediscache.Cache
 	redisCompressionType RedisCompressionType
+	keyPrefix            string
 }
 
 func (r *redisCache) getKey(key string) string {
+	key = r.keyPrefix + key
 	switch r.redisCompressionType {
 	case RedisCompressionGZip:
 		return key + ".gz"
+	case RedisCompressionZstd:
+		return key + ".zst"
 	default:
 		return key
 	}
 }
 
+func (r *redisCache) getChannel(key string) string {
+	return r.keyPrefix + key
+}
+
 func (r *redisCache) marshal(obj any) ([]byte, error) {
+	if r.redisCompressionType == RedisCompressionZstd {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		return zstdEncoder.EncodeAll(data, nil), nil
+	}
 	buf := bytes.NewBuffer([]byte{})
 	var w io.Writer = buf
 	if r.redisCompressionType == RedisCompressionGZip {
@@ -88,6 +131,16 @@ func (r *redisCache) marshal(obj any) ([]byte, error) {
 }
 
 func (r *redisCache) unmarshal(data []byte, obj any) error {
+	if r.redisCompressionType == RedisCompressionZstd {
+		decompressed, err := zstdDecoder.DecodeAll(data, nil)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(decompressed, obj); err != nil {
+			return fmt.Errorf("failed to decode cached data: %w", err)
+		}
+		return nil
+	}
 	buf := bytes.NewReader(data)
 	var reader io.Reader = buf
 	if r.redisCompressionType == RedisCompressionGZip {
@@ -148,7 +201,7 @@ func (r *redisCache) Delete(key string) error {
 }
 
 func (r *redisCache) OnUpdated(ctx context.Context, key string, callback func() error) error {
-	pubsub := r.client.Subscribe(ctx, key)
+	pubsub := r.client.Subscribe(ctx, r.getChannel(key))
 	defer utilio.Close(pubsub)
 
 	ch := pubsub.Channel()
@@ -165,7 +218,28 @@ func (r *redisCache) OnUpdated(ctx context.Context, key string, callback func()
 }
 
 func (r *redisCache) NotifyUpdated(key string) error {
-	return r.client.Publish(context.TODO(), key, "").Err()
+	return r.client.Publish(context.TODO(), r.getChannel(key), "").Err()
+}
+
+func (r *redisCache) OnMessage(ctx context.Context, key string, callback func(message string) error) error {
+	pubsub := r.client.Subscribe(ctx, r.getChannel(key))
+	defer utilio.Close(pubsub)
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg := <-ch:
+			if err := callback(msg.Payload); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (r *redisCache) PublishMessage(key string, message string) error {
+	return r.client.Publish(context.TODO(), r.getChannel(key), message).Err()
 }
 
 type MetricsRegistry interface {
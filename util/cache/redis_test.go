@@ -3,12 +3,16 @@ package cache
 import (
 	"bytes"
 	"compress/gzip"
+	"fmt"
 	"io"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/alicebob/miniredis/v2"
+	"github.com/klauspost/compress/zstd"
 	"github.com/prometheus/client_golang/prometheus"
 	promcm "github.com/prometheus/client_model/go"
 	"github.com/redis/go-redis/v9"
@@ -122,6 +126,105 @@ func TestRedisSetCacheCompressed(t *testing.T) {
 	assert.Equal(t, testValue, result)
 }
 
+func TestRedisSetCacheZstdCompressed(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	client := NewRedisCache(redisClient, 10*time.Second, RedisCompressionZstd)
+	testValue := strings.Repeat("my-value", 100)
+	require.NoError(t, client.Set(&Item{Key: "my-key", Object: testValue}))
+
+	compressedData, err := redisClient.Get(t.Context(), "my-key.zst").Bytes()
+	require.NoError(t, err)
+
+	assert.Less(t, len(compressedData), len([]byte(testValue)), "compressed data is smaller than uncompressed")
+
+	// trying to decompress compressed data
+	zstdReader, err := zstd.NewReader(bytes.NewBuffer(compressedData))
+	require.NoError(t, err)
+	defer zstdReader.Close()
+	_, err = io.ReadAll(zstdReader)
+	require.NoError(t, err)
+
+	var result string
+	require.NoError(t, client.Get("my-key", &result))
+
+	assert.Equal(t, testValue, result)
+}
+
+func TestRedisCacheZstdConcurrent(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	// the caches share the zstd encoder and decoder
+	redisClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	clients := []CacheClient{
+		NewRedisCache(redisClient, 10*time.Second, RedisCompressionZstd),
+		NewRedisCacheWithKeyPrefix(redisClient, 10*time.Second, RedisCompressionZstd, "argocd-2|"),
+	}
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client := clients[i%len(clients)]
+			key := fmt.Sprintf("key-%d", i)
+			value := strings.Repeat(key, 100)
+			assert.NoError(t, client.Set(&Item{Key: key, Object: value}))
+			var result string
+			assert.NoError(t, client.Get(key, &result))
+			assert.Equal(t, value, result)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRedisCacheKeyPrefix(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	client1 := NewRedisCacheWithKeyPrefix(redisClient, 10*time.Second, RedisCompressionGZip, "argocd-1|")
+	client2 := NewRedisCacheWithKeyPrefix(redisClient, 10*time.Second, RedisCompressionGZip, "argocd-2|")
+	require.NoError(t, client1.Set(&Item{Key: "my-key", Object: "value-1"}))
+	require.NoError(t, client2.Set(&Item{Key: "my-key", Object: "value-2"}))
+
+	// the compression suffix follows the prefixed key
+	assert.True(t, mr.Exists("argocd-1|my-key.gz"))
+	assert.True(t, mr.Exists("argocd-2|my-key.gz"))
+	var result string
+	require.NoError(t, client1.Get("my-key", &result))
+	assert.Equal(t, "value-1", result)
+	require.NoError(t, client2.Get("my-key", &result))
+	assert.Equal(t, "value-2", result)
+
+	require.NoError(t, client1.Delete("my-key"))
+	require.ErrorIs(t, client1.Get("my-key", &result), ErrCacheMiss)
+	require.NoError(t, client2.Get("my-key", &result))
+}
+
+func TestValidateKeyPrefix(t *testing.T) {
+	require.NoError(t, ValidateKeyPrefix(""))
+	require.NoError(t, ValidateKeyPrefix("argocd-1|"))
+	require.Error(t, ValidateKeyPrefix("argocd-*|"))
+	require.Error(t, ValidateKeyPrefix("argocd-[1]|"))
+}
+
+func TestCompressionTypeFromString(t *testing.T) {
+	for _, compression := range []RedisCompressionType{RedisCompressionNone, RedisCompressionGZip, RedisCompressionZstd} {
+		actual, err := CompressionTypeFromString(string(compression))
+		require.NoError(t, err)
+		assert.Equal(t, compression, actual)
+	}
+	_, err := CompressionTypeFromString("lz4")
+	require.EqualError(t, err, "unknown compression type: lz4")
+}
+
 func TestRedisMetrics(t *testing.T) {
 	mr, err := miniredis.Run()
 	if err != nil {
@@ -74,3 +74,11 @@ func (c *twoLevelClient) OnUpdated(ctx context.Context, key string, callback fun
 func (c *twoLevelClient) NotifyUpdated(key string) error {
 	return c.externalCache.NotifyUpdated(key)
 }
+
+func (c *twoLevelClient) OnMessage(ctx context.Context, key string, callback func(message string) error) error {
+	return c.externalCache.OnMessage(ctx, key, callback)
+}
+
+func (c *twoLevelClient) PublishMessage(key string, message string) error {
+	return c.externalCache.PublishMessage(key, message)
+}
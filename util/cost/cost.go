@@ -0,0 +1,162 @@
+package cost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	argoresource "github.com/argoproj/argo-cd/v3/util/resource"
+	"github.com/argoproj/argo-cd/v3/util/security"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// HoursPerMonth is the average number of hours per month the monthly costs are estimated for
+	HoursPerMonth = 730
+	// openCostConfigsPath is the path of the OpenCost API returning the custom pricing
+	openCostConfigsPath = "/getConfigs"
+	// requestTimeout is the timeout of the requests to the pricing backends
+	requestTimeout = 10 * time.Second
+	// maxErrorBodySize is the maximum size of the response body reported when a pricing request fails
+	maxErrorBodySize = 1024
+)
+
+// Pricing is the pricing of the requested resources
+type Pricing struct {
+	// Currency is the currency of the prices
+	Currency string
+	// CPUCoreHour is the hourly price of a requested CPU core
+	CPUCoreHour float64
+	// MemoryGiBHour is the hourly price of a requested GiB of memory
+	MemoryGiBHour float64
+}
+
+// MonthlyCost returns the monthly cost of the given requests
+func (p *Pricing) MonthlyCost(requests Requests) float64 {
+	cpuCores := float64(requests[corev1.ResourceCPU]) / 1000
+	memoryGiB := float64(requests[corev1.ResourceMemory]) / 1000 / (1 << 30)
+	return (cpuCores*p.CPUCoreHour + memoryGiB*p.MemoryGiBHour) * HoursPerMonth
+}
+
+// Backend provides the pricing of the requested resources
+type Backend interface {
+	GetPricing(ctx context.Context) (*Pricing, error)
+}
+
+// NewBackend returns the pricing backend configured by the given settings. The requests to the OpenCost API are
+// subject to the given outbound URL policy.
+func NewBackend(costEstimation *settings.CostEstimationSettings, policy *security.OutboundURLPolicy) Backend {
+	if costEstimation.OpenCost != nil {
+		return &openCostBackend{
+			url:      costEstimation.OpenCost.URL,
+			currency: costEstimation.Currency,
+			client:   &http.Client{Timeout: requestTimeout, Transport: policy.WrapTransport(nil)},
+		}
+	}
+	return &staticBackend{pricing: Pricing{
+		Currency:      costEstimation.Currency,
+		CPUCoreHour:   costEstimation.Static.CPUCoreHour,
+		MemoryGiBHour: costEstimation.Static.MemoryGiBHour,
+	}}
+}
+
+type staticBackend struct {
+	pricing Pricing
+}
+
+func (b *staticBackend) GetPricing(_ context.Context) (*Pricing, error) {
+	pricing := b.pricing
+	return &pricing, nil
+}
+
+type openCostBackend struct {
+	url      string
+	currency string
+	client   *http.Client
+}
+
+// openCostConfigs is the custom pricing returned by the OpenCost API. The prices are decimal strings.
+type openCostConfigs struct {
+	Data struct {
+		CPU          string `json:"CPU"`
+		RAM          string `json:"RAM"`
+		CurrencyCode string `json:"currencyCode"`
+	} `json:"data"`
+}
+
+// GetPricing returns the custom pricing of the OpenCost instance, whose CPU and RAM prices are hourly prices per core
+// and per GiB
+func (b *openCostBackend) GetPricing(ctx context.Context) (*Pricing, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.url+openCostConfigsPath, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the pricing from OpenCost: %w", err)
+	}
+	defer utilio.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, fmt.Errorf("failed to get the pricing from OpenCost: status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	var configs openCostConfigs
+	if err := json.NewDecoder(resp.Body).Decode(&configs); err != nil {
+		return nil, fmt.Errorf("failed to decode the pricing of OpenCost: %w", err)
+	}
+	pricing := &Pricing{Currency: b.currency}
+	if configs.Data.CurrencyCode != "" {
+		pricing.Currency = configs.Data.CurrencyCode
+	}
+	if pricing.CPUCoreHour, err = strconv.ParseFloat(configs.Data.CPU, 64); err != nil {
+		return nil, fmt.Errorf("invalid CPU price %q of OpenCost: %w", configs.Data.CPU, err)
+	}
+	if pricing.MemoryGiBHour, err = strconv.ParseFloat(configs.Data.RAM, 64); err != nil {
+		return nil, fmt.Errorf("invalid RAM price %q of OpenCost: %w", configs.Data.RAM, err)
+	}
+	return pricing, nil
+}
+
+// Requests are resource requests, in milli units
+type Requests map[corev1.ResourceName]int64
+
+// AddDelta adds the delta of the requests of the given workload when its live state is replaced by its target state.
+// The target or the live state is nil if the workload is created or deleted.
+func (r Requests) AddDelta(target *unstructured.Unstructured, live *unstructured.Unstructured) error {
+	targetRequests, targetReplicas, err := argoresource.GetWorkloadRequests(target)
+	if err != nil {
+		return err
+	}
+	liveRequests, liveReplicas, err := argoresource.GetWorkloadRequests(live)
+	if err != nil {
+		return err
+	}
+	for _, name := range argoresource.WorkloadResources {
+		r[name] += targetRequests[name]*targetReplicas - liveRequests[name]*liveReplicas
+	}
+	return nil
+}
+
+// Format formats the requests of the given resource as a signed quantity, e.g. +500m or -256Mi
+func (r Requests) Format(name corev1.ResourceName) string {
+	var quantity *resource.Quantity
+	if name == corev1.ResourceMemory {
+		quantity = resource.NewQuantity(r[name]/1000, resource.BinarySI)
+	} else {
+		quantity = resource.NewMilliQuantity(r[name], resource.DecimalSI)
+	}
+	if r[name] > 0 {
+		return "+" + quantity.String()
+	}
+	return quantity.String()
+}
@@ -0,0 +1,83 @@
+package cost
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func newDeployment(replicas int64) *unstructured.Unstructured {
+	deployment := test.YamlToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+spec:
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook
+        resources:
+          requests:
+            cpu: 500m
+            memory: 512Mi
+`)
+	_ = unstructured.SetNestedField(deployment.Object, replicas, "spec", "replicas")
+	return deployment
+}
+
+func TestRequests(t *testing.T) {
+	requests := Requests{}
+	require.NoError(t, requests.AddDelta(newDeployment(3), newDeployment(1)))
+	require.NoError(t, requests.AddDelta(nil, test.NewConfigMap()))
+	assert.Equal(t, "+1", requests.Format(corev1.ResourceCPU))
+	assert.Equal(t, "+1Gi", requests.Format(corev1.ResourceMemory))
+
+	require.NoError(t, requests.AddDelta(nil, newDeployment(4)))
+	assert.Equal(t, "-1", requests.Format(corev1.ResourceCPU))
+	assert.Equal(t, "-1Gi", requests.Format(corev1.ResourceMemory))
+
+	assert.Equal(t, "0", Requests{}.Format(corev1.ResourceCPU))
+}
+
+func TestPricing_MonthlyCost(t *testing.T) {
+	pricing := &Pricing{CPUCoreHour: 0.04, MemoryGiBHour: 0.005}
+	requests := Requests{corev1.ResourceCPU: 2000, corev1.ResourceMemory: 4 * (1 << 30) * 1000}
+	assert.InDelta(t, (2*0.04+4*0.005)*HoursPerMonth, pricing.MonthlyCost(requests), 0.0001)
+}
+
+func TestStaticBackend(t *testing.T) {
+	backend := NewBackend(&settings.CostEstimationSettings{Currency: "EUR", Static: &settings.StaticPricing{CPUCoreHour: 0.04, MemoryGiBHour: 0.005}}, nil)
+	pricing, err := backend.GetPricing(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, &Pricing{Currency: "EUR", CPUCoreHour: 0.04, MemoryGiBHour: 0.005}, pricing)
+}
+
+func TestOpenCostBackend(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/getConfigs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"code": 200, "data": {"CPU": "0.031611", "RAM": "0.004237", "GPU": "0.95", "currencyCode": "CHF"}}`))
+	}))
+	defer ts.Close()
+
+	backend := NewBackend(&settings.CostEstimationSettings{Currency: "USD", OpenCost: &settings.OpenCostSettings{URL: ts.URL}}, nil)
+	pricing, err := backend.GetPricing(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, &Pricing{Currency: "CHF", CPUCoreHour: 0.031611, MemoryGiBHour: 0.004237}, pricing)
+
+	backend = NewBackend(&settings.CostEstimationSettings{Currency: "USD", OpenCost: &settings.OpenCostSettings{URL: ts.URL + "/missing"}}, nil)
+	_, err = backend.GetPricing(t.Context())
+	require.ErrorContains(t, err, "status 404")
+}
@@ -0,0 +1,158 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// SealedValuePrefix is the prefix of the values sealed with SealValue
+	SealedValuePrefix = "sealed:v1:"
+	sealKeyIDLength   = 8
+	sealDataKeyLength = 32
+)
+
+// IsSealedValue returns true if the value was sealed with SealValue
+func IsSealedValue(value string) bool {
+	return strings.HasPrefix(value, SealedValuePrefix)
+}
+
+// SealLabel returns the label binding a sealed Helm parameter to the application it is set on, so that the sealed value
+// cannot be copied to another parameter, application or project. The names of the project, namespace and application
+// cannot contain a slash, hence the parameter name comes last.
+func SealLabel(project, appNamespace, appName, parameter string) string {
+	return strings.Join([]string{project, appNamespace, appName, parameter}, "/")
+}
+
+// SealValue envelope encrypts the value: the value is encrypted with a random AES key, which is encrypted with the
+// given RSA public key. The label, e.g. built with SealLabel, must be given to unseal the value, so that a sealed value
+// cannot be reused for another purpose.
+func SealValue(key *rsa.PublicKey, label string, value string) (string, error) {
+	keyID, err := sealKeyID(key)
+	if err != nil {
+		return "", err
+	}
+	dataKey := make([]byte, sealDataKeyLength)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	encryptedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, key, dataKey, []byte(label))
+	if err != nil {
+		return "", fmt.Errorf("error encrypting data key: %w", err)
+	}
+	encryptedValue, err := Encrypt([]byte(value), dataKey)
+	if err != nil {
+		return "", fmt.Errorf("error encrypting value: %w", err)
+	}
+	sealed := make([]byte, 0, len(keyID)+2+len(encryptedKey)+len(encryptedValue))
+	sealed = append(sealed, keyID...)
+	sealed = binary.BigEndian.AppendUint16(sealed, uint16(len(encryptedKey)))
+	sealed = append(sealed, encryptedKey...)
+	sealed = append(sealed, encryptedValue...)
+	return SealedValuePrefix + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// UnsealValue decrypts a value sealed with SealValue with the private key matching the public key it was sealed with
+func UnsealValue(keys []*rsa.PrivateKey, label string, value string) (string, error) {
+	if !IsSealedValue(value) {
+		return "", errors.New("value is not sealed")
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(value, SealedValuePrefix))
+	if err != nil {
+		return "", fmt.Errorf("error decoding sealed value: %w", err)
+	}
+	if len(sealed) < sealKeyIDLength+2 {
+		return "", errors.New("sealed value is truncated")
+	}
+	keyID, sealed := sealed[:sealKeyIDLength], sealed[sealKeyIDLength:]
+	encryptedKeyLength := int(binary.BigEndian.Uint16(sealed))
+	sealed = sealed[2:]
+	if len(sealed) < encryptedKeyLength {
+		return "", errors.New("sealed value is truncated")
+	}
+	encryptedKey, encryptedValue := sealed[:encryptedKeyLength], sealed[encryptedKeyLength:]
+
+	for _, key := range keys {
+		if id, err := sealKeyID(&key.PublicKey); err != nil || string(id) != string(keyID) {
+			continue
+		}
+		dataKey, err := rsa.DecryptOAEP(sha256.New(), nil, key, encryptedKey, []byte(label))
+		if err != nil {
+			return "", errors.New("error decrypting data key: the value was sealed for another label")
+		}
+		plaintext, err := Decrypt(encryptedValue, dataKey)
+		if err != nil {
+			return "", fmt.Errorf("error decrypting value: %w", err)
+		}
+		return string(plaintext), nil
+	}
+	return "", fmt.Errorf("no key %x to unseal the value", keyID)
+}
+
+// ParseSealingPublicKeys parses the PEM encoded RSA public keys sealing the values
+func ParseSealingPublicKeys(data []byte) ([]*rsa.PublicKey, error) {
+	var keys []*rsa.PublicKey
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "PUBLIC KEY" {
+			continue
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing public key: %w", err)
+		}
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("unsupported public key type %T, only RSA keys are supported", key)
+		}
+		keys = append(keys, rsaKey)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no PEM encoded public key found")
+	}
+	return keys, nil
+}
+
+// ParseSealingPrivateKeys parses the PEM encoded RSA private keys unsealing the values, in PKCS #1 or PKCS #8 form.
+// The other PEM blocks, e.g. the public keys, are ignored.
+func ParseSealingPrivateKeys(data []byte) ([]*rsa.PrivateKey, error) {
+	var keys []*rsa.PrivateKey
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		switch block.Type {
+		case "RSA PRIVATE KEY":
+			key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing private key: %w", err)
+			}
+			keys = append(keys, key)
+		case "PRIVATE KEY":
+			key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing private key: %w", err)
+			}
+			rsaKey, ok := key.(*rsa.PrivateKey)
+			if !ok {
+				return nil, fmt.Errorf("unsupported private key type %T, only RSA keys are supported", key)
+			}
+			keys = append(keys, rsaKey)
+		}
+	}
+	return keys, nil
+}
+
+// sealKeyID identifies the key a value is sealed with, so that the keys can be rotated
+func sealKeyID(key *rsa.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling public key: %w", err)
+	}
+	sum := sha256.Sum256(der)
+	return sum[:sealKeyIDLength], nil
+}
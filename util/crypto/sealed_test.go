@@ -0,0 +1,70 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealValue(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	sealed, err := SealValue(&key.PublicKey, "db.password", "s3cr3t")
+	require.NoError(t, err)
+	assert.True(t, IsSealedValue(sealed))
+	assert.NotContains(t, sealed, "s3cr3t")
+
+	value, err := UnsealValue([]*rsa.PrivateKey{otherKey, key}, "db.password", sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", value)
+
+	_, err = UnsealValue([]*rsa.PrivateKey{key}, "image.tag", sealed)
+	require.ErrorContains(t, err, "sealed for another label")
+
+	_, err = UnsealValue([]*rsa.PrivateKey{otherKey}, "db.password", sealed)
+	require.ErrorContains(t, err, "no key")
+
+	_, err = UnsealValue([]*rsa.PrivateKey{key}, "db.password", "s3cr3t")
+	require.ErrorContains(t, err, "value is not sealed")
+
+	_, err = UnsealValue([]*rsa.PrivateKey{key}, "db.password", sealed[:20])
+	require.ErrorContains(t, err, "truncated")
+}
+
+func TestSealLabel(t *testing.T) {
+	assert.Equal(t, "default/argocd/guestbook/db.password", SealLabel("default", "argocd", "guestbook", "db.password"))
+	assert.NotEqual(t, SealLabel("default", "argocd", "guestbook", "db.password"), SealLabel("default", "team", "guestbook", "db.password"))
+}
+
+func TestParseSealingKeys(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	public, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	data := append(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}), pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: public})...)
+	data = append(data, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})...)
+
+	privateKeys, err := ParseSealingPrivateKeys(data)
+	require.NoError(t, err)
+	require.Len(t, privateKeys, 2)
+	assert.True(t, key.Equal(privateKeys[0]))
+	assert.True(t, key.Equal(privateKeys[1]))
+
+	publicKeys, err := ParseSealingPublicKeys(data)
+	require.NoError(t, err)
+	require.Len(t, publicKeys, 1)
+	assert.True(t, key.PublicKey.Equal(publicKeys[0]))
+
+	_, err = ParseSealingPublicKeys([]byte("not a key"))
+	require.ErrorContains(t, err, "no PEM encoded public key found")
+}
@@ -57,7 +57,7 @@ func (db *db) getLocalCluster() *appv1.Cluster {
 }
 
 // ListClusters returns list of clusters
-func (db *db) ListClusters(_ context.Context) (*appv1.ClusterList, error) {
+func (db *db) ListClusters(ctx context.Context) (*appv1.ClusterList, error) {
 	clusterSecrets, err := db.listSecretsByType(common.LabelValueSecretTypeCluster)
 	if err != nil {
 		return nil, err
@@ -72,7 +72,7 @@ func (db *db) ListClusters(_ context.Context) (*appv1.ClusterList, error) {
 	inClusterEnabled := settings.InClusterEnabled
 	hasInClusterCredentials := false
 	for _, clusterSecret := range clusterSecrets {
-		cluster, err := SecretToCluster(clusterSecret)
+		cluster, err := SecretToCluster(db.resolveClusterSecretRefs(ctx, clusterSecret))
 		if err != nil {
 			log.Errorf("could not unmarshal cluster secret %s", clusterSecret.Name)
 			continue
@@ -162,7 +162,7 @@ func (db *db) WatchClusters(ctx context.Context,
 		common.LabelValueSecretTypeCluster,
 
 		func(secret *corev1.Secret) {
-			cluster, err := SecretToCluster(secret)
+			cluster, err := SecretToCluster(db.resolveClusterSecretRefs(ctx, secret))
 			if err != nil {
 				log.Errorf("could not unmarshal cluster secret %s", secret.Name)
 				return
@@ -179,12 +179,12 @@ func (db *db) WatchClusters(ctx context.Context,
 		},
 
 		func(oldSecret *corev1.Secret, newSecret *corev1.Secret) {
-			oldCluster, err := SecretToCluster(oldSecret)
+			oldCluster, err := SecretToCluster(db.resolveClusterSecretRefs(ctx, oldSecret))
 			if err != nil {
 				log.Errorf("could not unmarshal cluster secret %s", oldSecret.Name)
 				return
 			}
-			newCluster, err := SecretToCluster(newSecret)
+			newCluster, err := SecretToCluster(db.resolveClusterSecretRefs(ctx, newSecret))
 			if err != nil {
 				log.Errorf("could not unmarshal cluster secret %s", newSecret.Name)
 				return
@@ -225,7 +225,7 @@ func (db *db) getClusterSecret(server string) (*corev1.Secret, error) {
 }
 
 // GetCluster returns a cluster from a query
-func (db *db) GetCluster(_ context.Context, server string) (*appv1.Cluster, error) {
+func (db *db) GetCluster(ctx context.Context, server string) (*appv1.Cluster, error) {
 	argoSettings, err := db.settingsMgr.GetSettings()
 	if err != nil {
 		return nil, err
@@ -244,7 +244,11 @@ func (db *db) GetCluster(_ context.Context, server string) (*appv1.Cluster, erro
 	}
 
 	if len(res) > 0 {
-		return SecretToCluster(res[0].(*corev1.Secret))
+		secret, err := db.resolveSecretRefs(ctx, res[0].(*corev1.Secret))
+		if err != nil {
+			return nil, err
+		}
+		return SecretToCluster(secret)
 	}
 	if server == appv1.KubernetesInternalAPIServerAddr {
 		return db.getLocalCluster(), nil
@@ -319,9 +323,11 @@ func (db *db) UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 		}
 		return nil, err
 	}
+	existing := maps.Clone(clusterSecret.Data)
 	if err := clusterToSecret(c, clusterSecret); err != nil {
 		return nil, err
 	}
+	db.preserveSecretRefs(ctx, clusterSecret, existing)
 
 	clusterSecret, err = db.kubeclientset.CoreV1().Secrets(db.ns).Update(ctx, clusterSecret, metav1.UpdateOptions{})
 	if err != nil {
@@ -350,6 +356,17 @@ func (db *db) DeleteCluster(ctx context.Context, server string) error {
 	return db.settingsMgr.ResyncInformers()
 }
 
+// resolveClusterSecretRefs returns the given cluster Secret with the references to the external secrets resolved, or as
+// is if they cannot be resolved, so that the cluster is still known but fails to connect
+func (db *db) resolveClusterSecretRefs(ctx context.Context, secret *corev1.Secret) *corev1.Secret {
+	resolved, err := db.resolveSecretRefs(ctx, secret)
+	if err != nil {
+		log.Warnf("could not resolve the external secrets of cluster secret %s: %v", secret.Name, err)
+		return secret
+	}
+	return resolved
+}
+
 // clusterToSecret converts a cluster object to string data for serialization to a secret
 func clusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
 	data := make(map[string][]byte)
@@ -130,6 +130,9 @@ type db struct {
 	ns            string
 	kubeclientset kubernetes.Interface
 	settingsMgr   *settings.SettingsManager
+	// secretRefResolver resolves the references to the external secrets instead of the secret backends configured in
+	// argocd-cm, if set
+	secretRefResolver SecretRefResolver
 }
 
 // NewDB returns a new instance of the argo database
@@ -0,0 +1,220 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/externalsecrets"
+)
+
+// clusterConfigKey is the key of the cluster Secrets holding the configuration of the connection to the cluster, in JSON
+const clusterConfigKey = "config"
+
+// SecretRefResolver resolves the references to the secrets of external secret managers, e.g.
+// ${vault:secret/data/git#password}, in the values of the repository and cluster Secrets
+type SecretRefResolver interface {
+	// Resolve replaces the references to the external secrets in the given value with their values
+	Resolve(ctx context.Context, value string) (string, error)
+}
+
+// secretRefResolvers is the resolver of the references to the external secrets shared by the instances of the
+// database, so that the values of the external secrets are cached across them. It is rebuilt when the configuration of
+// the external secret backends changes.
+var secretRefResolvers = &secretRefResolverCache{}
+
+type secretRefResolverCache struct {
+	lock     sync.Mutex
+	config   string
+	resolver SecretRefResolver
+}
+
+// get returns the resolver of the given configuration of the external secret backends
+func (c *secretRefResolverCache) get(config *externalsecrets.Config) (SecretRefResolver, error) {
+	key, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.resolver != nil && c.config == string(key) {
+		return c.resolver, nil
+	}
+	resolver, err := externalsecrets.NewResolverForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	c.config = string(key)
+	c.resolver = resolver
+	return resolver, nil
+}
+
+// getSecretRefResolver returns the resolver of the references to the external secrets configured in argocd-cm
+func (db *db) getSecretRefResolver() (SecretRefResolver, error) {
+	if db.secretRefResolver != nil {
+		return db.secretRefResolver, nil
+	}
+	configYAML, resolveSecretRefs, err := db.settingsMgr.GetSecretBackendsConfig()
+	if err != nil {
+		return nil, err
+	}
+	config, err := externalsecrets.ParseConfig(configYAML, resolveSecretRefs)
+	if err != nil {
+		return nil, err
+	}
+	// the credentials can only reference the secrets the administrators allowed, e.g. not the secrets of other tenants
+	// of the same secret manager
+	if err := config.ValidateAllowedPathPrefixes(); err != nil {
+		return nil, err
+	}
+	return secretRefResolvers.get(config)
+}
+
+// ValidateNoSecretRefs returns an InvalidArgument error if the given repository, repository credentials or cluster
+// references external secrets. The references can only be set in the Secrets, by the administrators, and not through
+// the API.
+func ValidateNoSecretRefs(obj any) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if hasJSONSecretRefs(value) {
+		return status.Error(codes.InvalidArgument, "references to external secrets can only be set in the Secrets of the repositories and clusters")
+	}
+	return nil
+}
+
+// hasJSONSecretRefs returns whether the string values of the given JSON value reference external secrets
+func hasJSONSecretRefs(value any) bool {
+	switch v := value.(type) {
+	case string:
+		return externalsecrets.HasRefs(v)
+	case map[string]any:
+		for _, item := range v {
+			if hasJSONSecretRefs(item) {
+				return true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if hasJSONSecretRefs(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// resolveSecretRefs returns the given Secret with the references to the external secrets in its values replaced with
+// their values. The references in the configuration of the clusters are replaced in the values of its fields. The
+// given Secret is returned as is if it does not reference external secrets.
+func (db *db) resolveSecretRefs(ctx context.Context, secret *corev1.Secret) (*corev1.Secret, error) {
+	resolved := secret
+	var resolver SecretRefResolver
+	for key, value := range secret.Data {
+		if !externalsecrets.HasRefs(string(value)) {
+			continue
+		}
+		if resolver == nil {
+			var err error
+			if resolver, err = db.getSecretRefResolver(); err != nil {
+				return nil, fmt.Errorf("failed to configure the external secret backends: %w", err)
+			}
+			resolved = secret.DeepCopy()
+		}
+		var resolvedValue []byte
+		var err error
+		if key == clusterConfigKey {
+			resolvedValue, err = resolveJSONSecretRefs(ctx, resolver, value)
+		} else {
+			var s string
+			s, err = resolver.Resolve(ctx, string(value))
+			resolvedValue = []byte(s)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve the external secrets of key %q of secret %s: %w", key, secret.Name, err)
+		}
+		resolved.Data[key] = resolvedValue
+	}
+	return resolved, nil
+}
+
+// resolveJSONSecretRefs replaces the references to the external secrets in the string values of the given JSON value
+func resolveJSONSecretRefs(ctx context.Context, resolver SecretRefResolver, value []byte) ([]byte, error) {
+	var data any
+	if err := json.Unmarshal(value, &data); err != nil {
+		return nil, err
+	}
+	var resolve func(v any) (any, error)
+	resolve = func(v any) (any, error) {
+		switch v := v.(type) {
+		case string:
+			if !externalsecrets.HasRefs(v) {
+				return v, nil
+			}
+			return resolver.Resolve(ctx, v)
+		case map[string]any:
+			for k, item := range v {
+				resolvedItem, err := resolve(item)
+				if err != nil {
+					return nil, err
+				}
+				v[k] = resolvedItem
+			}
+		case []any:
+			for i, item := range v {
+				resolvedItem, err := resolve(item)
+				if err != nil {
+					return nil, err
+				}
+				v[i] = resolvedItem
+			}
+		}
+		return v, nil
+	}
+	resolved, err := resolve(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(resolved)
+}
+
+// preserveSecretRefs keeps the references to the external secrets of the given existing values of a Secret in the
+// given updated values, when the updated values are the resolved existing ones, so that updating a repository or a
+// cluster read with its resolved credentials does not persist the values of the external secrets
+func (db *db) preserveSecretRefs(ctx context.Context, secret *corev1.Secret, existing map[string][]byte) {
+	for key, value := range existing {
+		updated, ok := secret.Data[key]
+		if !ok || !externalsecrets.HasRefs(string(value)) {
+			continue
+		}
+		resolved, err := db.resolveSecretRefs(ctx, &corev1.Secret{Data: map[string][]byte{key: value}})
+		if err != nil {
+			continue
+		}
+		if bytes.Equal(resolved.Data[key], updated) || key == clusterConfigKey && clusterConfigEqual(resolved.Data[key], updated) {
+			secret.Data[key] = value
+		}
+	}
+}
+
+// clusterConfigEqual returns whether the given configurations of a cluster, in JSON, are equal
+func clusterConfigEqual(a, b []byte) bool {
+	var configA, configB appv1.ClusterConfig
+	if json.Unmarshal(a, &configA) != nil || json.Unmarshal(b, &configB) != nil {
+		return false
+	}
+	return reflect.DeepEqual(configA, configB)
+}
@@ -0,0 +1,181 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+type fakeSecretRefResolver map[string]string
+
+func (r fakeSecretRefResolver) Resolve(_ context.Context, value string) (string, error) {
+	for ref, secret := range r {
+		value = strings.ReplaceAll(value, ref, secret)
+	}
+	if strings.Contains(value, "${") {
+		return "", errors.New("secret not found")
+	}
+	return value, nil
+}
+
+func newExternalSecretsTestDB(t *testing.T, secrets ...*corev1.Secret) *db {
+	t.Helper()
+	clientset := getClientset()
+	for _, secret := range secrets {
+		_, err := clientset.CoreV1().Secrets(testNamespace).Create(t.Context(), secret, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	return &db{
+		ns:            testNamespace,
+		kubeclientset: clientset,
+		settingsMgr:   settings.NewSettingsManager(t.Context(), clientset, testNamespace),
+		secretRefResolver: fakeSecretRefResolver{
+			"${vault:secret/data/git#password}":  "git-password",
+			"${vault:secret/data/cluster#token}": "cluster-token",
+		},
+	}
+}
+
+func TestGetRepository_ExternalSecrets(t *testing.T) {
+	argoDB := newExternalSecretsTestDB(t, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "repo",
+			Labels: map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepository},
+		},
+		Data: map[string][]byte{
+			"url":      []byte("https://github.com/argoproj/argo-cd"),
+			"username": []byte("admin"),
+			"password": []byte("${vault:secret/data/git#password}"),
+		},
+	})
+
+	repo, err := argoDB.GetRepository(t.Context(), "https://github.com/argoproj/argo-cd", "")
+	require.NoError(t, err)
+	assert.Equal(t, "admin", repo.Username)
+	assert.Equal(t, "git-password", repo.Password)
+
+	t.Run("UpdateKeepsReferences", func(t *testing.T) {
+		repo.Username = "user"
+		_, err := argoDB.UpdateRepository(t.Context(), repo)
+		require.NoError(t, err)
+
+		secret, err := argoDB.kubeclientset.CoreV1().Secrets(testNamespace).Get(t.Context(), "repo", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "user", string(secret.Data["username"]))
+		assert.Equal(t, "${vault:secret/data/git#password}", string(secret.Data["password"]))
+	})
+
+	t.Run("UpdateReplacesReferences", func(t *testing.T) {
+		repo.Password = "new-password"
+		_, err := argoDB.UpdateRepository(t.Context(), repo)
+		require.NoError(t, err)
+
+		secret, err := argoDB.kubeclientset.CoreV1().Secrets(testNamespace).Get(t.Context(), "repo", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "new-password", string(secret.Data["password"]))
+	})
+}
+
+func TestListRepositories_ExternalSecretsNotFound(t *testing.T) {
+	argoDB := newExternalSecretsTestDB(t, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "repo",
+			Labels: map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepository},
+		},
+		Data: map[string][]byte{
+			"url":      []byte("https://github.com/argoproj/argo-cd"),
+			"password": []byte("${vault:secret/data/unknown#password}"),
+		},
+	})
+
+	repos, err := argoDB.ListRepositories(t.Context())
+	require.NoError(t, err)
+	require.Len(t, repos, 1)
+	assert.Equal(t, "https://github.com/argoproj/argo-cd", repos[0].Repo)
+	assert.Equal(t, appsv1.ConnectionStatusFailed, repos[0].ConnectionState.Status)
+
+	_, err = argoDB.GetRepository(t.Context(), "https://github.com/argoproj/argo-cd", "")
+	require.ErrorContains(t, err, "secret not found")
+}
+
+func TestGetRepository_ExternalSecretsAllowedPathPrefixes(t *testing.T) {
+	argoDB := newExternalSecretsTestDB(t, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "repo",
+			Labels: map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepository},
+		},
+		Data: map[string][]byte{
+			"url":      []byte("https://github.com/argoproj/argo-cd"),
+			"password": []byte("${vault:secret/data/git#password}"),
+		},
+	})
+	argoDB.secretRefResolver = nil
+	cm, err := argoDB.kubeclientset.CoreV1().ConfigMaps(testNamespace).Get(t.Context(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	cm.Data = map[string]string{"secretBackends": "vault:\n  address: https://vault.example.com\n  token: s.token"}
+	_, err = argoDB.kubeclientset.CoreV1().ConfigMaps(testNamespace).Update(t.Context(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	_, err = argoDB.GetRepository(t.Context(), "https://github.com/argoproj/argo-cd", "")
+	require.ErrorContains(t, err, `the allowed path prefixes of secret backend "vault" are not configured`)
+}
+
+func TestValidateNoSecretRefs(t *testing.T) {
+	require.NoError(t, ValidateNoSecretRefs(&appsv1.Repository{Repo: "https://github.com/argoproj/argo-cd", Password: "$password"}))
+	err := ValidateNoSecretRefs(&appsv1.Repository{Repo: "https://github.com/argoproj/argo-cd", Password: "${vault:secret/data/git#password}"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = ValidateNoSecretRefs(&appsv1.Cluster{Server: "https://mycluster", Config: appsv1.ClusterConfig{
+		ExecProviderConfig: &appsv1.ExecProviderConfig{Args: []string{"--token", "${aws-secrets-manager:cluster-token}"}},
+	}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetCluster_ExternalSecrets(t *testing.T) {
+	argoDB := newExternalSecretsTestDB(t, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "mycluster",
+			Labels: map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeCluster},
+		},
+		Data: map[string][]byte{
+			"name":   []byte("mycluster"),
+			"server": []byte("https://mycluster"),
+			"config": []byte(`{"bearerToken":"${vault:secret/data/cluster#token}","tlsClientConfig":{"insecure":true}}`),
+		},
+	})
+
+	cluster, err := argoDB.GetCluster(t.Context(), "https://mycluster")
+	require.NoError(t, err)
+	assert.Equal(t, "cluster-token", cluster.Config.BearerToken)
+	assert.True(t, cluster.Config.Insecure)
+
+	clusters, err := argoDB.ListClusters(t.Context())
+	require.NoError(t, err)
+	for _, c := range clusters.Items {
+		if c.Server == "https://mycluster" {
+			assert.Equal(t, "cluster-token", c.Config.BearerToken)
+		}
+	}
+
+	t.Run("UpdateKeepsReferences", func(t *testing.T) {
+		cluster.Namespaces = []string{"default"}
+		_, err := argoDB.UpdateCluster(t.Context(), cluster)
+		require.NoError(t, err)
+
+		secret, err := argoDB.kubeclientset.CoreV1().Secrets(testNamespace).Get(t.Context(), "mycluster", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "default", string(secret.Data["namespaces"]))
+		assert.Contains(t, string(secret.Data["config"]), "${vault:secret/data/cluster#token}")
+	})
+}
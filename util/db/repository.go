@@ -126,7 +126,7 @@ func (db *db) getRepositories(indexer, project string) ([]*v1alpha1.Repository,
 	}
 	var res []*v1alpha1.Repository
 	for i := range secrets {
-		repo, err := secretToRepository(secrets[i].(*corev1.Secret))
+		repo, err := SecretToRepository(secrets[i].(*corev1.Secret))
 		if err != nil {
 			return nil, err
 		}
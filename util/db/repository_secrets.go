@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -75,15 +76,15 @@ func (s *secretsRepositoryBackend) hasRepoTypeLabel(secretName string) (bool, er
 	return false, nil
 }
 
-func (s *secretsRepositoryBackend) GetRepoCredsBySecretName(_ context.Context, name string) (*appsv1.RepoCreds, error) {
+func (s *secretsRepositoryBackend) GetRepoCredsBySecretName(ctx context.Context, name string) (*appsv1.RepoCreds, error) {
 	secret, err := s.db.getSecret(name, map[string]*corev1.Secret{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get secret %s: %w", name, err)
 	}
-	return s.secretToRepoCred(secret)
+	return s.resolvedSecretToRepoCred(ctx, secret)
 }
 
-func (s *secretsRepositoryBackend) GetRepository(_ context.Context, repoURL, project string) (*appsv1.Repository, error) {
+func (s *secretsRepositoryBackend) GetRepository(ctx context.Context, repoURL, project string) (*appsv1.Repository, error) {
 	secret, err := s.getRepositorySecret(repoURL, project, true)
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
@@ -93,7 +94,7 @@ func (s *secretsRepositoryBackend) GetRepository(_ context.Context, repoURL, pro
 		return nil, err
 	}
 
-	repository, err := secretToRepository(secret)
+	repository, err := s.resolvedSecretToRepository(ctx, secret)
 	if err != nil {
 		return nil, err
 	}
@@ -101,7 +102,7 @@ func (s *secretsRepositoryBackend) GetRepository(_ context.Context, repoURL, pro
 	return repository, err
 }
 
-func (s *secretsRepositoryBackend) ListRepositories(_ context.Context, repoType *string) ([]*appsv1.Repository, error) {
+func (s *secretsRepositoryBackend) ListRepositories(ctx context.Context, repoType *string) ([]*appsv1.Repository, error) {
 	var repos []*appsv1.Repository
 
 	secrets, err := s.db.listSecretsByType(s.getSecretType())
@@ -110,7 +111,15 @@ func (s *secretsRepositoryBackend) ListRepositories(_ context.Context, repoType
 	}
 
 	for _, secret := range secrets {
-		r, err := secretToRepository(secret)
+		// the repositories whose external secrets cannot be resolved are listed as misconfigured
+		resolved, resolveErr := s.db.resolveSecretRefs(ctx, secret)
+		if resolveErr != nil {
+			resolved = secret
+		}
+		r, err := SecretToRepository(resolved)
+		if err == nil {
+			err = resolveErr
+		}
 		if err != nil {
 			if r == nil {
 				return nil, err
@@ -142,7 +151,9 @@ func (s *secretsRepositoryBackend) UpdateRepository(ctx context.Context, reposit
 		return nil, err
 	}
 
+	existing := maps.Clone(repositorySecret.Data)
 	s.repositoryToSecret(repository, repositorySecret)
+	s.db.preserveSecretRefs(ctx, repositorySecret, existing)
 
 	_, err = s.db.kubeclientset.CoreV1().Secrets(s.db.ns).Update(ctx, repositorySecret, metav1.UpdateOptions{})
 	if err != nil {
@@ -200,7 +211,7 @@ func (s *secretsRepositoryBackend) CreateRepoCreds(ctx context.Context, repoCred
 	return repoCreds, s.db.settingsMgr.ResyncInformers()
 }
 
-func (s *secretsRepositoryBackend) GetRepoCreds(_ context.Context, repoURL string) (*appsv1.RepoCreds, error) {
+func (s *secretsRepositoryBackend) GetRepoCreds(ctx context.Context, repoURL string) (*appsv1.RepoCreds, error) {
 	secret, err := s.getRepoCredsSecret(repoURL)
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
@@ -210,7 +221,7 @@ func (s *secretsRepositoryBackend) GetRepoCreds(_ context.Context, repoURL strin
 		return nil, err
 	}
 
-	return s.secretToRepoCred(secret)
+	return s.resolvedSecretToRepoCred(ctx, secret)
 }
 
 func (s *secretsRepositoryBackend) ListRepoCreds(_ context.Context) ([]string, error) {
@@ -237,14 +248,16 @@ func (s *secretsRepositoryBackend) UpdateRepoCreds(ctx context.Context, repoCred
 		return nil, err
 	}
 
+	existing := maps.Clone(repoCredsSecret.Data)
 	repoCredsToSecret(repoCreds, repoCredsSecret)
+	s.db.preserveSecretRefs(ctx, repoCredsSecret, existing)
 
 	repoCredsSecret, err = s.db.kubeclientset.CoreV1().Secrets(s.db.ns).Update(ctx, repoCredsSecret, metav1.UpdateOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	updatedRepoCreds, err := s.secretToRepoCred(repoCredsSecret)
+	updatedRepoCreds, err := SecretToRepoCreds(repoCredsSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -278,7 +291,7 @@ func (s *secretsRepositoryBackend) RepoCredsExists(_ context.Context, repoURL st
 	return true, nil
 }
 
-func (s *secretsRepositoryBackend) GetAllHelmRepoCreds(_ context.Context) ([]*appsv1.RepoCreds, error) {
+func (s *secretsRepositoryBackend) GetAllHelmRepoCreds(ctx context.Context) ([]*appsv1.RepoCreds, error) {
 	var helmRepoCreds []*appsv1.RepoCreds
 
 	secrets, err := s.db.listSecretsByType(common.LabelValueSecretTypeRepoCreds)
@@ -288,7 +301,7 @@ func (s *secretsRepositoryBackend) GetAllHelmRepoCreds(_ context.Context) ([]*ap
 
 	for _, secret := range secrets {
 		if strings.EqualFold(string(secret.Data["type"]), "helm") {
-			repoCreds, err := s.secretToRepoCred(secret)
+			repoCreds, err := s.resolvedSecretToRepoCred(ctx, secret)
 			if err != nil {
 				return nil, err
 			}
@@ -300,7 +313,7 @@ func (s *secretsRepositoryBackend) GetAllHelmRepoCreds(_ context.Context) ([]*ap
 	return helmRepoCreds, nil
 }
 
-func (s *secretsRepositoryBackend) GetAllOCIRepoCreds(_ context.Context) ([]*appsv1.RepoCreds, error) {
+func (s *secretsRepositoryBackend) GetAllOCIRepoCreds(ctx context.Context) ([]*appsv1.RepoCreds, error) {
 	var ociRepoCreds []*appsv1.RepoCreds
 
 	secrets, err := s.db.listSecretsByType(common.LabelValueSecretTypeRepoCreds)
@@ -310,7 +323,7 @@ func (s *secretsRepositoryBackend) GetAllOCIRepoCreds(_ context.Context) ([]*app
 
 	for _, secret := range secrets {
 		if strings.EqualFold(string(secret.Data["type"]), "oci") {
-			repoCreds, err := s.secretToRepoCred(secret)
+			repoCreds, err := s.resolvedSecretToRepoCred(ctx, secret)
 			if err != nil {
 				return nil, err
 			}
@@ -322,7 +335,28 @@ func (s *secretsRepositoryBackend) GetAllOCIRepoCreds(_ context.Context) ([]*app
 	return ociRepoCreds, nil
 }
 
-func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
+// resolvedSecretToRepository converts the given Secret to a repository, with the references to the external secrets in
+// its credentials resolved
+func (s *secretsRepositoryBackend) resolvedSecretToRepository(ctx context.Context, secret *corev1.Secret) (*appsv1.Repository, error) {
+	resolved, err := s.db.resolveSecretRefs(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+	return SecretToRepository(resolved)
+}
+
+// resolvedSecretToRepoCred converts the given Secret to repository credentials, with the references to the external
+// secrets resolved
+func (s *secretsRepositoryBackend) resolvedSecretToRepoCred(ctx context.Context, secret *corev1.Secret) (*appsv1.RepoCreds, error) {
+	resolved, err := s.db.resolveSecretRefs(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+	return SecretToRepoCreds(resolved)
+}
+
+// SecretToRepository converts a repository secret into a Repository object
+func SecretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 	repository := &appsv1.Repository{
 		Name:                       string(secret.Data["name"]),
 		Repo:                       string(secret.Data["url"]),
@@ -430,7 +464,8 @@ func (s *secretsRepositoryBackend) repositoryToSecret(repository *appsv1.Reposit
 	addSecretMetadata(secret, s.getSecretType())
 }
 
-func (s *secretsRepositoryBackend) secretToRepoCred(secret *corev1.Secret) (*appsv1.RepoCreds, error) {
+// SecretToRepoCreds converts a repository credentials secret into a RepoCreds object
+func SecretToRepoCreds(secret *corev1.Secret) (*appsv1.RepoCreds, error) {
 	repository := &appsv1.RepoCreds{
 		URL:                        string(secret.Data["url"]),
 		Username:                   string(secret.Data["username"]),
@@ -31,6 +31,10 @@ type DexTLSConfig struct {
 	StrictValidation bool
 	RootCAs          *x509.CertPool
 	Certificate      []byte
+	// GetRootCAs and GetCertificate return the current RootCAs and Certificate, taking precedence over them, so that
+	// they are reloaded when the dex server certificate is rotated
+	GetRootCAs     func() *x509.CertPool
+	GetCertificate func() []byte
 }
 
 func TLSConfig(tlsConfig *DexTLSConfig) *tls.Config {
@@ -42,6 +46,9 @@ func TLSConfig(tlsConfig *DexTLSConfig) *tls.Config {
 			InsecureSkipVerify: true,
 		}
 	}
+	if tlsConfig.GetRootCAs != nil || tlsConfig.GetCertificate != nil {
+		return reloadingTLSConfig(tlsConfig)
+	}
 	return &tls.Config{
 		InsecureSkipVerify: false,
 		RootCAs:            tlsConfig.RootCAs,
@@ -54,6 +61,41 @@ func TLSConfig(tlsConfig *DexTLSConfig) *tls.Config {
 	}
 }
 
+// reloadingTLSConfig verifies the dex server certificate with the current root CAs and certificate. The standard
+// verification is skipped since it only supports static root CAs, and made by VerifyConnection instead.
+func reloadingTLSConfig(tlsConfig *DexTLSConfig) *tls.Config {
+	return &tls.Config{
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return stderrors.New("dex server did not present a certificate")
+			}
+			rootCAs, certificate := tlsConfig.RootCAs, tlsConfig.Certificate
+			if tlsConfig.GetRootCAs != nil {
+				rootCAs = tlsConfig.GetRootCAs()
+			}
+			if tlsConfig.GetCertificate != nil {
+				certificate = tlsConfig.GetCertificate()
+			}
+			opts := x509.VerifyOptions{
+				DNSName:       cs.ServerName,
+				Roots:         rootCAs,
+				Intermediates: x509.NewCertPool(),
+			}
+			for _, cert := range cs.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(cert)
+			}
+			if _, err := cs.PeerCertificates[0].Verify(opts); err != nil {
+				return err
+			}
+			if !bytes.Equal(cs.PeerCertificates[0].Raw, certificate) {
+				return stderrors.New("dex server certificate does not match")
+			}
+			return nil
+		},
+	}
+}
+
 // NewDexHTTPReverseProxy returns a reverse proxy to the Dex server. Dex is assumed to be configured
 // with the external issuer URL muxed to the same path configured in server.go. In other words, if
 // Argo CD API server wants to proxy requests at /api/dex, then the dex config yaml issuer URL should
@@ -2,6 +2,7 @@ package dex
 
 import (
 	"bytes"
+	"crypto/x509"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -527,3 +528,29 @@ func Test_DexReverseProxy(t *testing.T) {
 		assert.Equal(t, req.Host, target.Host)
 	})
 }
+
+func Test_TLSConfig_Reloading(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+
+	get := func(rootCAs *x509.CertPool, certificate []byte) error {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: TLSConfig(&DexTLSConfig{
+			StrictValidation: true,
+			GetRootCAs:       func() *x509.CertPool { return rootCAs },
+			GetCertificate:   func() []byte { return certificate },
+		})}}
+		resp, err := client.Get(server.URL)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		return err
+	}
+
+	require.NoError(t, get(rootCAs, server.Certificate().Raw))
+	assert.ErrorContains(t, get(rootCAs, []byte("rotated")), "dex server certificate does not match")
+	assert.ErrorContains(t, get(x509.NewCertPool(), server.Certificate().Raw), "certificate signed by unknown authority")
+}
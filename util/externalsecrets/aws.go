@@ -0,0 +1,60 @@
+package externalsecrets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+)
+
+// AWSSecretsManagerConfig is the configuration of the AWS Secrets Manager backend. The credentials are read from the
+// standard AWS environment variables, shared configuration files, web identities or instance roles.
+type AWSSecretsManagerConfig struct {
+	// Region is the region of the secrets referenced by name rather than by ARN. Defaults to the region of the AWS
+	// configuration.
+	Region string `json:"region,omitempty"`
+	// Endpoint is the endpoint of the API, e.g. for a VPC endpoint
+	Endpoint string `json:"endpoint,omitempty"`
+	// AllowedPathPrefixes are the prefixes of the names or ARNs of the secrets which can be referenced, e.g. argocd/
+	// or arn:aws:secretsmanager:us-east-1:123456789012:secret:argocd/
+	AllowedPathPrefixes []string `json:"allowedPathPrefixes,omitempty"`
+}
+
+type awsSecretsManagerBackend struct {
+	client secretsmanageriface.SecretsManagerAPI
+}
+
+// NewAWSSecretsManagerBackend returns a backend reading the secrets of AWS Secrets Manager, referenced by name or ARN
+func NewAWSSecretsManagerBackend(config AWSSecretsManagerConfig) (Backend, error) {
+	awsConfig := aws.NewConfig()
+	if config.Region != "" {
+		awsConfig = awsConfig.WithRegion(config.Region)
+	}
+	if config.Endpoint != "" {
+		awsConfig = awsConfig.WithEndpoint(config.Endpoint)
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{Config: *awsConfig, SharedConfigState: session.SharedConfigEnable})
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+	return NewAWSSecretsManagerBackendWithClient(secretsmanager.New(sess)), nil
+}
+
+// NewAWSSecretsManagerBackendWithClient returns a backend reading the secrets of AWS Secrets Manager with the given
+// client
+func NewAWSSecretsManagerBackendWithClient(client secretsmanageriface.SecretsManagerAPI) Backend {
+	return &awsSecretsManagerBackend{client: client}
+}
+
+// GetSecret returns the given key of the JSON object stored in the secret, or the whole value of the secret if the key
+// is empty
+func (b *awsSecretsManagerBackend) GetSecret(ctx context.Context, path, key string) (string, error) {
+	out, err := b.client.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(path)})
+	if err != nil {
+		return "", err
+	}
+	return getJSONKey(aws.StringValue(out.SecretString), key)
+}
@@ -0,0 +1,48 @@
+package externalsecrets
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSecretsManager struct {
+	secretsmanageriface.SecretsManagerAPI
+	values map[string]string
+}
+
+func (c *fakeSecretsManager) GetSecretValueWithContext(_ aws.Context, input *secretsmanager.GetSecretValueInput, _ ...request.Option) (*secretsmanager.GetSecretValueOutput, error) {
+	value, ok := c.values[aws.StringValue(input.SecretId)]
+	if !ok {
+		return nil, errors.New("ResourceNotFoundException")
+	}
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(value)}, nil
+}
+
+func TestAWSSecretsManagerBackend(t *testing.T) {
+	arn := "arn:aws:secretsmanager:us-east-1:123456789012:secret:notifications"
+	backend := NewAWSSecretsManagerBackendWithClient(&fakeSecretsManager{values: map[string]string{
+		arn:           `{"slack-token":"xoxb-1"}`,
+		"slack-token": "xoxb-2",
+	}})
+
+	value, err := backend.GetSecret(t.Context(), arn, "slack-token")
+	require.NoError(t, err)
+	assert.Equal(t, "xoxb-1", value)
+	value, err = backend.GetSecret(t.Context(), "slack-token", "")
+	require.NoError(t, err)
+	assert.Equal(t, "xoxb-2", value)
+
+	_, err = backend.GetSecret(t.Context(), arn, "missing")
+	require.ErrorContains(t, err, `key "missing" not found`)
+	_, err = backend.GetSecret(t.Context(), "slack-token", "token")
+	require.ErrorContains(t, err, "not a JSON object")
+	_, err = backend.GetSecret(t.Context(), "missing", "")
+	require.Error(t, err)
+}
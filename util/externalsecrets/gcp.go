@@ -0,0 +1,95 @@
+package externalsecrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2/google"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// defaultGCPSecretManagerEndpoint is the default endpoint of the API of GCP Secret Manager
+	defaultGCPSecretManagerEndpoint = "https://secretmanager.googleapis.com"
+	// gcpCloudPlatformScope is the OAuth scope of the requests to GCP Secret Manager
+	gcpCloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// GCPSecretManagerConfig is the configuration of the GCP Secret Manager backend. The credentials are the application
+// default credentials, e.g. of the GKE workload identity.
+type GCPSecretManagerConfig struct {
+	// Endpoint is the endpoint of the API, e.g. for a regional or a Private Service Connect endpoint
+	Endpoint string `json:"endpoint,omitempty"`
+	// AllowedPathPrefixes are the prefixes of the resource names of the secrets which can be referenced, e.g.
+	// projects/my-project/secrets/argocd-
+	AllowedPathPrefixes []string `json:"allowedPathPrefixes,omitempty"`
+}
+
+type gcpSecretManagerBackend struct {
+	endpoint  string
+	getClient func() (*http.Client, error)
+}
+
+// NewGCPSecretManagerBackend returns a backend reading the secrets of GCP Secret Manager, referenced by their resource
+// names, e.g. projects/my-project/secrets/git-token, optionally followed by their version, the latest version being
+// read otherwise. The default credentials are looked up on the first read.
+func NewGCPSecretManagerBackend(config GCPSecretManagerConfig) Backend {
+	return newGCPSecretManagerBackend(config.Endpoint, sync.OnceValues(func() (*http.Client, error) {
+		client, err := google.DefaultClient(context.Background(), gcpCloudPlatformScope)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the default GCP credentials: %w", err)
+		}
+		return client, nil
+	}))
+}
+
+func newGCPSecretManagerBackend(endpoint string, getClient func() (*http.Client, error)) *gcpSecretManagerBackend {
+	if endpoint == "" {
+		endpoint = defaultGCPSecretManagerEndpoint
+	}
+	return &gcpSecretManagerBackend{endpoint: strings.TrimSuffix(endpoint, "/"), getClient: getClient}
+}
+
+// GetSecret returns the given key of the JSON object stored in the secret version, or the whole value of the secret
+// version if the key is empty
+func (b *gcpSecretManagerBackend) GetSecret(ctx context.Context, path, key string) (string, error) {
+	name := strings.Trim(path, "/")
+	if !strings.Contains(name, "/versions/") {
+		name += "/versions/latest"
+	}
+	client, err := b.getClient()
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.endpoint+"/v1/"+name+":access", http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer utilio.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s from %s", resp.Status, name)
+	}
+	var res struct {
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return "", err
+	}
+	data, err := base64.StdEncoding.DecodeString(res.Payload.Data)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode the payload of %s: %w", name, err)
+	}
+	return getJSONKey(string(data), key)
+}
@@ -0,0 +1,41 @@
+package externalsecrets
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGCPSecretManagerBackend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/projects/my-project/secrets/git-token/versions/latest:access":
+			_, _ = w.Write([]byte(`{"payload":{"data":"` + base64.StdEncoding.EncodeToString([]byte("ghp_1")) + `"}}`))
+		case "/v1/projects/my-project/secrets/git/versions/2:access":
+			_, _ = w.Write([]byte(`{"payload":{"data":"` + base64.StdEncoding.EncodeToString([]byte(`{"password":"ghp_2"}`)) + `"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	backend := newGCPSecretManagerBackend(server.URL, func() (*http.Client, error) {
+		return server.Client(), nil
+	})
+
+	value, err := backend.GetSecret(t.Context(), "projects/my-project/secrets/git-token", "")
+	require.NoError(t, err)
+	assert.Equal(t, "ghp_1", value)
+	value, err = backend.GetSecret(t.Context(), "projects/my-project/secrets/git/versions/2", "password")
+	require.NoError(t, err)
+	assert.Equal(t, "ghp_2", value)
+
+	_, err = backend.GetSecret(t.Context(), "projects/my-project/secrets/git/versions/2", "username")
+	require.ErrorContains(t, err, `key "username" not found`)
+	_, err = backend.GetSecret(t.Context(), "projects/my-project/secrets/missing", "")
+	require.ErrorContains(t, err, "404")
+}
@@ -0,0 +1,236 @@
+// Package externalsecrets resolves the references to the secrets of external secret managers, e.g. HashiCorp Vault,
+// in the configuration of the notification services and in the repository and cluster credentials.
+package externalsecrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConfigKey is the key of the notifications ConfigMap and of the argocd-cm ConfigMap configuring the external secret
+	// backends
+	ConfigKey = "secretBackends"
+	// BackendVault is the name of the HashiCorp Vault backend in the references to the secrets, e.g.
+	// ${vault:secret/data/notifications#slack-token}
+	BackendVault = "vault"
+	// BackendAWSSecretsManager is the name of the AWS Secrets Manager backend in the references to the secrets, e.g.
+	// ${aws-secrets-manager:arn:aws:secretsmanager:us-east-1:123456789012:secret:notifications#slack-token}
+	BackendAWSSecretsManager = "aws-secrets-manager"
+	// BackendGCPSecretManager is the name of the GCP Secret Manager backend in the references to the secrets, e.g.
+	// ${gcp-secret-manager:projects/my-project/secrets/git-token}
+	BackendGCPSecretManager = "gcp-secret-manager"
+	// DefaultCacheTTL is the duration the values of the secrets are cached for, unless configured otherwise
+	DefaultCacheTTL = 5 * time.Minute
+)
+
+// refPattern matches the references to the secrets of the external backends, i.e. ${<backend>:<path>#<key>}, the key
+// being optional for the backends storing plain values
+var refPattern = regexp.MustCompile(`\$\{([\w-]+):([^#}]+)(?:#([^}]+))?\}`)
+
+// Backend reads the secrets of an external secret manager
+type Backend interface {
+	// GetSecret returns the value of the given key of the secret of the given path. The whole value of the secret is
+	// returned if the key is empty.
+	GetSecret(ctx context.Context, path, key string) (string, error)
+}
+
+// Config is the configuration of the external secret backends, in the secretBackends key of the notifications ConfigMap.
+// Only the configured backends are enabled.
+type Config struct {
+	// CacheTTL is the duration the values of the secrets are cached for. Defaults to DefaultCacheTTL.
+	CacheTTL string `json:"cacheTTL,omitempty"`
+	// Vault is the configuration of the HashiCorp Vault backend
+	Vault *VaultConfig `json:"vault,omitempty"`
+	// AWSSecretsManager is the configuration of the AWS Secrets Manager backend
+	AWSSecretsManager *AWSSecretsManagerConfig `json:"awsSecretsManager,omitempty"`
+	// GCPSecretManager is the configuration of the GCP Secret Manager backend
+	GCPSecretManager *GCPSecretManagerConfig `json:"gcpSecretManager,omitempty"`
+}
+
+// allowedPathPrefixes returns the allowed path prefixes of the secrets of the configured backends, per name
+func (c *Config) allowedPathPrefixes() map[string][]string {
+	prefixes := map[string][]string{}
+	if c.Vault != nil {
+		prefixes[BackendVault] = c.Vault.AllowedPathPrefixes
+	}
+	if c.AWSSecretsManager != nil {
+		prefixes[BackendAWSSecretsManager] = c.AWSSecretsManager.AllowedPathPrefixes
+	}
+	if c.GCPSecretManager != nil {
+		prefixes[BackendGCPSecretManager] = c.GCPSecretManager.AllowedPathPrefixes
+	}
+	return prefixes
+}
+
+// ValidateAllowedPathPrefixes returns an error if a configured backend does not restrict the paths of its secrets
+func (c *Config) ValidateAllowedPathPrefixes() error {
+	allowedPathPrefixes := c.allowedPathPrefixes()
+	for _, name := range []string{BackendVault, BackendAWSSecretsManager, BackendGCPSecretManager} {
+		if prefixes, ok := allowedPathPrefixes[name]; ok && len(prefixes) == 0 {
+			return fmt.Errorf("the allowed path prefixes of secret backend %q are not configured", name)
+		}
+	}
+	return nil
+}
+
+// HasRefs returns whether the given value references secrets of the external backends
+func HasRefs(value string) bool {
+	return refPattern.MatchString(value)
+}
+
+type cacheEntry struct {
+	value   string
+	expires time.Time
+}
+
+// Resolver resolves the references to the secrets of the external backends, caching their values
+type Resolver struct {
+	backends map[string]Backend
+	ttl      time.Duration
+	now      func() time.Time
+	// allowedPathPrefixes are the prefixes of the paths of the secrets which can be read, per backend. Any secret of
+	// a backend can be read if it has no prefixes.
+	allowedPathPrefixes map[string][]string
+
+	lock  sync.Mutex
+	cache map[string]cacheEntry
+}
+
+// NewResolver returns a resolver of the references to the secrets of the given backends, per name, caching their
+// values for the given duration
+func NewResolver(backends map[string]Backend, ttl time.Duration) *Resolver {
+	return &Resolver{backends: backends, ttl: ttl, now: time.Now, cache: map[string]cacheEntry{}}
+}
+
+// NewResolverFromConfig returns a resolver of the references to the secrets of the backends of the given configuration,
+// in YAML. The references to the backends which are not configured fail.
+func NewResolverFromConfig(configYAML string, resolveSecretRefs func(string) string) (*Resolver, error) {
+	config, err := ParseConfig(configYAML, resolveSecretRefs)
+	if err != nil {
+		return nil, err
+	}
+	return NewResolverForConfig(config)
+}
+
+// ParseConfig parses the given configuration of the backends, in YAML, replacing the references to the keys of the
+// Kubernetes secret in the credentials of the backends with the given function
+func ParseConfig(configYAML string, resolveSecretRefs func(string) string) (*Config, error) {
+	var config Config
+	if err := yaml.Unmarshal([]byte(configYAML), &config); err != nil {
+		return nil, fmt.Errorf("failed to parse the configuration of the secret backends: %w", err)
+	}
+	if config.Vault != nil {
+		config.Vault.Token = resolveSecretRefs(config.Vault.Token)
+	}
+	return &config, nil
+}
+
+// NewResolverForConfig returns a resolver of the references to the secrets of the backends of the given configuration
+func NewResolverForConfig(config *Config) (*Resolver, error) {
+	ttl := DefaultCacheTTL
+	if config.CacheTTL != "" {
+		var err error
+		if ttl, err = time.ParseDuration(config.CacheTTL); err != nil {
+			return nil, fmt.Errorf("invalid cache TTL of the secret backends %q: %w", config.CacheTTL, err)
+		}
+	}
+	backends := map[string]Backend{}
+	if config.Vault != nil {
+		vault, err := NewVaultBackend(*config.Vault)
+		if err != nil {
+			return nil, err
+		}
+		backends[BackendVault] = vault
+	}
+	if config.AWSSecretsManager != nil {
+		asm, err := NewAWSSecretsManagerBackend(*config.AWSSecretsManager)
+		if err != nil {
+			return nil, err
+		}
+		backends[BackendAWSSecretsManager] = asm
+	}
+	if config.GCPSecretManager != nil {
+		backends[BackendGCPSecretManager] = NewGCPSecretManagerBackend(*config.GCPSecretManager)
+	}
+	resolver := NewResolver(backends, ttl)
+	resolver.allowedPathPrefixes = config.allowedPathPrefixes()
+	return resolver, nil
+}
+
+// Resolve replaces the references to the secrets of the external backends in the given value with their values
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	var resolveErr error
+	resolved := refPattern.ReplaceAllStringFunc(value, func(ref string) string {
+		if resolveErr != nil {
+			return ref
+		}
+		secretValue, err := r.get(ctx, ref)
+		if err != nil {
+			resolveErr = err
+			return ref
+		}
+		return secretValue
+	})
+	if resolveErr != nil {
+		return "", resolveErr
+	}
+	return resolved, nil
+}
+
+// get returns the value of the secret of the given reference, from the cache if it has not expired
+func (r *Resolver) get(ctx context.Context, ref string) (string, error) {
+	r.lock.Lock()
+	entry, ok := r.cache[ref]
+	r.lock.Unlock()
+	if ok && r.now().Before(entry.expires) {
+		return entry.value, nil
+	}
+
+	match := refPattern.FindStringSubmatch(ref)
+	path := strings.TrimSpace(match[2])
+	backend, ok := r.backends[match[1]]
+	if !ok {
+		return "", fmt.Errorf("secret backend %q of secret %s is not configured", match[1], path)
+	}
+	if prefixes := r.allowedPathPrefixes[match[1]]; len(prefixes) > 0 && !slices.ContainsFunc(prefixes, func(prefix string) bool {
+		return strings.HasPrefix(path, prefix)
+	}) {
+		return "", fmt.Errorf("secret %s is not in the allowed paths of secret backend %q", path, match[1])
+	}
+	value, err := backend.GetSecret(ctx, path, strings.TrimSpace(match[3]))
+	if err != nil {
+		return "", fmt.Errorf("failed to get secret %s from secret backend %q: %w", path, match[1], err)
+	}
+	r.lock.Lock()
+	r.cache[ref] = cacheEntry{value: value, expires: r.now().Add(r.ttl)}
+	r.lock.Unlock()
+	return value, nil
+}
+
+// getJSONKey returns the given key of the given JSON object, or the whole value if the key is empty
+func getJSONKey(value, key string) (string, error) {
+	if key == "" {
+		return value, nil
+	}
+	var data map[string]any
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return "", fmt.Errorf("the value of the secret is not a JSON object: %w", err)
+	}
+	keyValue, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found", key)
+	}
+	if s, ok := keyValue.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(keyValue), nil
+}
@@ -0,0 +1,119 @@
+package externalsecrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBackend struct {
+	values map[string]string
+	calls  int
+}
+
+func (b *fakeBackend) GetSecret(_ context.Context, path, key string) (string, error) {
+	b.calls++
+	value, ok := b.values[path+"#"+key]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return value, nil
+}
+
+func TestHasRefs(t *testing.T) {
+	assert.True(t, HasRefs("token: ${vault:secret/data/notifications#slack-token}"))
+	assert.True(t, HasRefs("token: ${aws-secrets-manager:arn:aws:secretsmanager:us-east-1:123456789012:secret:slack}"))
+	assert.False(t, HasRefs("token: $slack-token"))
+	assert.False(t, HasRefs("url: https://hooks.example.com"))
+}
+
+func TestResolver(t *testing.T) {
+	backend := &fakeBackend{values: map[string]string{
+		"secret/data/notifications#slack-token": "xoxb-1",
+		"secret/data/notifications#url":         "https://hooks.example.com",
+	}}
+	now := time.Now()
+	resolver := NewResolver(map[string]Backend{BackendVault: backend}, time.Minute)
+	resolver.now = func() time.Time { return now }
+
+	value, err := resolver.Resolve(t.Context(), "token: ${vault:secret/data/notifications#slack-token}, url: ${vault:secret/data/notifications#url}/hook")
+	require.NoError(t, err)
+	assert.Equal(t, "token: xoxb-1, url: https://hooks.example.com/hook", value)
+	assert.Equal(t, 2, backend.calls)
+
+	// the values are cached until they expire
+	backend.values["secret/data/notifications#slack-token"] = "xoxb-2"
+	value, err = resolver.Resolve(t.Context(), "${vault:secret/data/notifications#slack-token}")
+	require.NoError(t, err)
+	assert.Equal(t, "xoxb-1", value)
+	now = now.Add(2 * time.Minute)
+	value, err = resolver.Resolve(t.Context(), "${vault:secret/data/notifications#slack-token}")
+	require.NoError(t, err)
+	assert.Equal(t, "xoxb-2", value)
+
+	_, err = resolver.Resolve(t.Context(), "${vault:secret/data/other#token}")
+	require.ErrorContains(t, err, "failed to get secret secret/data/other")
+	_, err = resolver.Resolve(t.Context(), "${gcp:projects/argocd/secrets/token}")
+	require.ErrorContains(t, err, `secret backend "gcp" of secret projects/argocd/secrets/token is not configured`)
+}
+
+func TestResolver_AllowedPathPrefixes(t *testing.T) {
+	backend := &fakeBackend{values: map[string]string{
+		"secret/data/argocd/git#password": "pass",
+		"secret/data/admin#password":      "admin",
+	}}
+	resolver := NewResolver(map[string]Backend{BackendVault: backend}, time.Minute)
+	resolver.allowedPathPrefixes = map[string][]string{BackendVault: {"secret/data/argocd/"}}
+
+	value, err := resolver.Resolve(t.Context(), "${vault:secret/data/argocd/git#password}")
+	require.NoError(t, err)
+	assert.Equal(t, "pass", value)
+	_, err = resolver.Resolve(t.Context(), "${vault:secret/data/admin#password}")
+	require.ErrorContains(t, err, `secret secret/data/admin is not in the allowed paths of secret backend "vault"`)
+	assert.Equal(t, 1, backend.calls)
+}
+
+func TestConfig_ValidateAllowedPathPrefixes(t *testing.T) {
+	require.NoError(t, (&Config{}).ValidateAllowedPathPrefixes())
+	require.NoError(t, (&Config{Vault: &VaultConfig{AllowedPathPrefixes: []string{"secret/data/argocd/"}}}).ValidateAllowedPathPrefixes())
+	require.EqualError(t, (&Config{
+		Vault:            &VaultConfig{AllowedPathPrefixes: []string{"secret/data/argocd/"}},
+		GCPSecretManager: &GCPSecretManagerConfig{},
+	}).ValidateAllowedPathPrefixes(), `the allowed path prefixes of secret backend "gcp-secret-manager" are not configured`)
+}
+
+func TestNewResolverFromConfig(t *testing.T) {
+	resolveSecretRefs := func(value string) string {
+		if value == "$vault-token" {
+			return "s.token"
+		}
+		return value
+	}
+	resolver, err := NewResolverFromConfig("cacheTTL: 1m\nvault:\n  address: https://vault.example.com\n  token: $vault-token", resolveSecretRefs)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, resolver.ttl)
+	require.Contains(t, resolver.backends, BackendVault)
+	assert.Equal(t, "s.token", resolver.backends[BackendVault].(*vaultBackend).config.Token)
+	// the backends are only enabled if they are configured
+	assert.NotContains(t, resolver.backends, BackendAWSSecretsManager)
+	assert.NotContains(t, resolver.backends, BackendGCPSecretManager)
+
+	resolver, err = NewResolverFromConfig("", resolveSecretRefs)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultCacheTTL, resolver.ttl)
+	assert.Empty(t, resolver.backends)
+
+	resolver, err = NewResolverFromConfig("awsSecretsManager:\n  region: us-east-1\ngcpSecretManager: {}", resolveSecretRefs)
+	require.NoError(t, err)
+	assert.Contains(t, resolver.backends, BackendAWSSecretsManager)
+	assert.Contains(t, resolver.backends, BackendGCPSecretManager)
+
+	_, err = NewResolverFromConfig("cacheTTL: soon", resolveSecretRefs)
+	require.Error(t, err)
+	_, err = NewResolverFromConfig("vault:\n  address: https://vault.example.com", resolveSecretRefs)
+	require.Error(t, err)
+}
@@ -0,0 +1,170 @@
+package externalsecrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// defaultVaultKubernetesMountPath is the default mount path of the Kubernetes auth method of Vault
+	defaultVaultKubernetesMountPath = "kubernetes"
+	// serviceAccountTokenPath is the path of the token of the service account of the pod, used to log in to Vault
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token" //nolint:gosec // not a credential
+	// vaultTokenRenewMargin is the duration before the expiry of the Vault token after which the backend logs in again
+	vaultTokenRenewMargin = 30 * time.Second
+	// vaultTimeout is the timeout of the requests to Vault
+	vaultTimeout = 10 * time.Second
+)
+
+// VaultConfig is the configuration of the HashiCorp Vault backend
+type VaultConfig struct {
+	// Address is the address of Vault, e.g. https://vault.example.com
+	Address string `json:"address"`
+	// Namespace is the Vault Enterprise namespace of the secrets
+	Namespace string `json:"namespace,omitempty"`
+	// Token is the token authenticating the requests, typically a reference to a key of the notifications secret, e.g.
+	// $vault-token. The Kubernetes auth method is used instead if the KubernetesRole is set.
+	Token string `json:"token,omitempty"`
+	// KubernetesRole is the role of the Kubernetes auth method of Vault, which the backend logs in with the token of
+	// the service account of the notifications controller
+	KubernetesRole string `json:"kubernetesRole,omitempty"`
+	// KubernetesMountPath is the mount path of the Kubernetes auth method. Defaults to kubernetes.
+	KubernetesMountPath string `json:"kubernetesMountPath,omitempty"`
+	// AllowedPathPrefixes are the prefixes of the paths of the secrets which can be referenced, e.g. secret/data/argocd/.
+	// Any secret can be referenced if empty, except in the repository and cluster credentials, which require them.
+	AllowedPathPrefixes []string `json:"allowedPathPrefixes,omitempty"`
+}
+
+type vaultBackend struct {
+	config    VaultConfig
+	client    *http.Client
+	tokenPath string
+	now       func() time.Time
+
+	lock        sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+// NewVaultBackend returns a backend reading the secrets of the KV secrets engines, version 1 or 2, of HashiCorp Vault.
+// The paths of the secrets are the paths of the API, e.g. secret/data/notifications for the notifications secret of the
+// secret mount of a KV version 2 engine.
+func NewVaultBackend(config VaultConfig) (Backend, error) {
+	if config.Address == "" {
+		return nil, errors.New("the address of the Vault secret backend is missing")
+	}
+	if config.Token == "" && config.KubernetesRole == "" {
+		return nil, errors.New("either the token or the Kubernetes role of the Vault secret backend must be set")
+	}
+	if config.KubernetesMountPath == "" {
+		config.KubernetesMountPath = defaultVaultKubernetesMountPath
+	}
+	return &vaultBackend{
+		config:    config,
+		client:    &http.Client{Timeout: vaultTimeout},
+		tokenPath: serviceAccountTokenPath,
+		now:       time.Now,
+	}, nil
+}
+
+func (b *vaultBackend) GetSecret(ctx context.Context, path, key string) (string, error) {
+	if key == "" {
+		return "", errors.New("the key of the Vault secret is missing")
+	}
+	token, err := b.getToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	var res struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := b.do(ctx, http.MethodGet, strings.Trim(path, "/"), token, nil, &res); err != nil {
+		return "", err
+	}
+	data := res.Data
+	// the KV version 2 engines nest the data of the secrets with their metadata
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+	value, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found", key)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(value), nil
+}
+
+// getToken returns the token authenticating the requests, logging in with the Kubernetes auth method if configured
+func (b *vaultBackend) getToken(ctx context.Context) (string, error) {
+	if b.config.KubernetesRole == "" {
+		return b.config.Token, nil
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.token != "" && b.now().Before(b.tokenExpiry) {
+		return b.token, nil
+	}
+	jwt, err := os.ReadFile(b.tokenPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the service account token: %w", err)
+	}
+	var res struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int64  `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	body := map[string]string{"role": b.config.KubernetesRole, "jwt": strings.TrimSpace(string(jwt))}
+	if err := b.do(ctx, http.MethodPost, "auth/"+strings.Trim(b.config.KubernetesMountPath, "/")+"/login", "", body, &res); err != nil {
+		return "", fmt.Errorf("failed to log in to Vault: %w", err)
+	}
+	b.token = res.Auth.ClientToken
+	b.tokenExpiry = b.now().Add(time.Duration(res.Auth.LeaseDuration)*time.Second - vaultTokenRenewMargin)
+	return b.token, nil
+}
+
+// do sends a request to the given path of the API of Vault and decodes its response
+func (b *vaultBackend) do(ctx context.Context, method, path, token string, body, res any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(b.config.Address, "/")+"/v1/"+path, reqBody)
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if b.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", b.config.Namespace)
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer utilio.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s from %s", resp.Status, path)
+	}
+	return json.NewDecoder(resp.Body).Decode(res)
+}
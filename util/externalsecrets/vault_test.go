@@ -0,0 +1,63 @@
+package externalsecrets
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultBackend(t *testing.T) {
+	logins := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/kubernetes/login":
+			var body map[string]string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]string{"role": "argocd-notifications", "jwt": "sa-token"}, body)
+			logins++
+			_, _ = w.Write([]byte(`{"auth":{"client_token":"s.login","lease_duration":3600}}`))
+		case "/v1/secret/data/notifications":
+			assert.Equal(t, "s.login", r.Header.Get("X-Vault-Token"))
+			_, _ = w.Write([]byte(`{"data":{"data":{"slack-token":"xoxb-1"},"metadata":{"version":1}}}`))
+		case "/v1/kv/notifications":
+			assert.Equal(t, "s.login", r.Header.Get("X-Vault-Token"))
+			_, _ = w.Write([]byte(`{"data":{"slack-token":"xoxb-2"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("sa-token\n"), 0o600))
+	backend, err := NewVaultBackend(VaultConfig{Address: server.URL, KubernetesRole: "argocd-notifications"})
+	require.NoError(t, err)
+	backend.(*vaultBackend).tokenPath = tokenPath
+
+	value, err := backend.GetSecret(t.Context(), "secret/data/notifications", "slack-token")
+	require.NoError(t, err)
+	assert.Equal(t, "xoxb-1", value)
+	value, err = backend.GetSecret(t.Context(), "/kv/notifications", "slack-token")
+	require.NoError(t, err)
+	assert.Equal(t, "xoxb-2", value)
+	// the token of the login is reused until it expires
+	assert.Equal(t, 1, logins)
+
+	_, err = backend.GetSecret(t.Context(), "secret/data/notifications", "missing")
+	require.ErrorContains(t, err, `key "missing" not found`)
+	_, err = backend.GetSecret(t.Context(), "secret/data/missing", "slack-token")
+	require.ErrorContains(t, err, "unexpected status 404")
+}
+
+func TestNewVaultBackend(t *testing.T) {
+	_, err := NewVaultBackend(VaultConfig{Token: "s.token"})
+	require.ErrorContains(t, err, "address")
+	_, err = NewVaultBackend(VaultConfig{Address: "https://vault.example.com"})
+	require.ErrorContains(t, err, "token or the Kubernetes role")
+}
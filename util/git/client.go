@@ -40,11 +40,22 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/env"
 	executil "github.com/argoproj/argo-cd/v3/util/exec"
 	"github.com/argoproj/argo-cd/v3/util/proxy"
+	tlsutil "github.com/argoproj/argo-cd/v3/util/tls"
 	"github.com/argoproj/argo-cd/v3/util/versions"
 )
 
 var ErrInvalidRepoURL = errors.New("repo URL is invalid")
 
+// RevisionNotFoundError is returned when a revision cannot be resolved to a commit SHA, e.g. because the branch or the
+// tag does not exist
+type RevisionNotFoundError struct {
+	Revision string
+}
+
+func (e *RevisionNotFoundError) Error() string {
+	return fmt.Sprintf("unable to resolve '%s' to a commit SHA", e.Revision)
+}
+
 // CommitMetadata contains metadata about a commit that is related in some way to another commit.
 type CommitMetadata struct {
 	// Author is the author of the commit.
@@ -279,6 +290,12 @@ func GetRepoHTTPClient(repoURL string, insecure bool, creds Creds, proxyURL stri
 				log.Errorf("Could not load Client Certificate: %v", err)
 				return &cert, nil
 			}
+			// the handshake is refused rather than made without the certificate
+			if tlsutil.FIPSRequired() {
+				if err := tlsutil.ValidateFIPSCertificate(&cert); err != nil {
+					return nil, fmt.Errorf("client certificate is not FIPS compliant: %w", err)
+				}
+			}
 		}
 
 		return &cert, nil
@@ -310,6 +327,20 @@ func GetRepoHTTPClient(repoURL string, insecure bool, creds Creds, proxyURL stri
 	return customHTTPClient
 }
 
+// newSSHHostKeyCallback returns the callback verifying the keys of the SSH hosts
+func newSSHHostKeyCallback(insecure bool) ssh.HostKeyCallback {
+	if insecure {
+		return ssh.InsecureIgnoreHostKey()
+	}
+	// Set up validation of SSH known hosts for using our ssh_known_hosts
+	// file.
+	callback, err := knownhosts.New(certutil.GetSSHKnownHostsDataPath())
+	if err != nil {
+		log.Errorf("Could not set-up SSH known hosts callback: %v", err)
+	}
+	return callback
+}
+
 func newAuth(repoURL string, creds Creds) (transport.AuthMethod, error) {
 	switch creds := creds.(type) {
 	case SSHCreds:
@@ -317,6 +348,11 @@ func newAuth(repoURL string, creds Creds) (transport.AuthMethod, error) {
 		if isSSH, user := IsSSHURL(repoURL); isSSH {
 			sshUser = user
 		}
+		if creds.agentSocket != "" {
+			auth := &SSHAgentWithOptions{User: sshUser, Socket: creds.agentSocket}
+			auth.HostKeyCallback = newSSHHostKeyCallback(creds.insecure)
+			return auth, nil
+		}
 		signer, err := ssh.ParsePrivateKey([]byte(creds.sshPrivateKey))
 		if err != nil {
 			return nil, err
@@ -324,16 +360,7 @@ func newAuth(repoURL string, creds Creds) (transport.AuthMethod, error) {
 		auth := &PublicKeysWithOptions{}
 		auth.User = sshUser
 		auth.Signer = signer
-		if creds.insecure {
-			auth.HostKeyCallback = ssh.InsecureIgnoreHostKey()
-		} else {
-			// Set up validation of SSH known hosts for using our ssh_known_hosts
-			// file.
-			auth.HostKeyCallback, err = knownhosts.New(certutil.GetSSHKnownHostsDataPath())
-			if err != nil {
-				log.Errorf("Could not set-up SSH known hosts callback: %v", err)
-			}
-		}
+		auth.HostKeyCallback = newSSHHostKeyCallback(creds.insecure)
 		return auth, nil
 	case HTTPSCreds:
 		if creds.bearerToken != "" {
@@ -373,6 +400,13 @@ func newAuth(repoURL string, creds Creds) (transport.AuthMethod, error) {
 		return &auth, nil
 	}
 
+	// go-git authenticates to the SSH repositories with the keys of the SSH agent of the process when no auth method
+	// is given, so that the SSH repositories without credentials are given one without key
+	if isSSH, sshUser := IsSSHURL(repoURL); isSSH {
+		auth := &NoAuthWithOptions{User: sshUser}
+		auth.HostKeyCallback = newSSHHostKeyCallback(false)
+		return auth, nil
+	}
 	return nil, nil
 }
 
@@ -758,7 +792,7 @@ func (m *nativeGitClient) lsRemote(revision string) (string, error) {
 
 	// If we get here, revision string had non hexadecimal characters (indicating its a branch, tag,
 	// or symbolic ref) and we were unable to resolve it to a commit SHA.
-	return "", fmt.Errorf("unable to resolve '%s' to a commit SHA", revision)
+	return "", &RevisionNotFoundError{Revision: revision}
 }
 
 // CommitSHA returns current commit sha from `git rev-parse HEAD`
@@ -1098,7 +1132,7 @@ func (m *nativeGitClient) runCredentialedCmd(args ...string) error {
 
 func (m *nativeGitClient) runCmdOutput(cmd *exec.Cmd, ropts runOpts) (string, error) {
 	cmd.Dir = m.root
-	cmd.Env = append(os.Environ(), cmd.Env...)
+	cmd.Env = append(CommandEnviron(), cmd.Env...)
 	// Set $HOME to nowhere, so we can execute Git regardless of any external
 	// authentication keys (e.g. in ~/.ssh) -- this is especially important for
 	// running tests on local machines and/or CircleCI.
@@ -2,9 +2,13 @@ package git
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/mail"
 	"os"
 	"os/exec"
@@ -22,7 +26,10 @@ import (
 	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/workloadidentity"
 	"github.com/argoproj/argo-cd/v3/util/workloadidentity/mocks"
 )
@@ -905,6 +912,87 @@ func Test_newAuth_AzureWorkloadIdentity(t *testing.T) {
 	require.Truef(t, ok, "expected TokenAuth but got %T", auth)
 }
 
+// startSSHAgent starts an SSH agent holding an ed25519 and an RSA key, and returns its socket
+func startSSHAgent(t *testing.T) string {
+	t.Helper()
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyring := agent.NewKeyring()
+	require.NoError(t, keyring.Add(agent.AddedKey{PrivateKey: ed25519Key}))
+	require.NoError(t, keyring.Add(agent.AddedKey{PrivateKey: rsaKey}))
+
+	socket := filepath.Join(t.TempDir(), "agent.sock")
+	ln, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_ = agent.ServeAgent(keyring, conn)
+				_ = conn.Close()
+			}()
+		}
+	}()
+	return socket
+}
+
+func Test_newAuth_SSHAgent(t *testing.T) {
+	socket := startSSHAgent(t)
+
+	auth, err := newAuth("git@github.com:argoproj/argo-cd.git", NewSSHAgentCreds(socket, true, ""))
+	require.NoError(t, err)
+	agentAuth, ok := auth.(*SSHAgentWithOptions)
+	require.Truef(t, ok, "expected SSHAgentWithOptions but got %T", auth)
+	assert.Equal(t, "git", agentAuth.User)
+
+	signers, err := agentAuth.signers()
+	require.NoError(t, err)
+	require.Len(t, signers, 2)
+	for _, signer := range signers {
+		signature, err := signer.Sign(rand.Reader, []byte("data"))
+		require.NoError(t, err)
+		require.NoError(t, signer.PublicKey().Verify([]byte("data"), signature))
+	}
+
+	rsaSigner, ok := signers[1].(ssh.AlgorithmSigner)
+	require.True(t, ok)
+	signature, err := rsaSigner.SignWithAlgorithm(rand.Reader, []byte("data"), ssh.KeyAlgoRSASHA512)
+	require.NoError(t, err)
+	assert.Equal(t, ssh.KeyAlgoRSASHA512, signature.Format)
+	require.NoError(t, rsaSigner.PublicKey().Verify([]byte("data"), signature))
+}
+
+func Test_newAuth_NoCredsWithoutSSHAgent(t *testing.T) {
+	// the SSH agent of the process is not used by the SSH repositories without credentials
+	t.Setenv("SSH_AUTH_SOCK", startSSHAgent(t))
+	sshDataPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(sshDataPath, common.DefaultSSHKnownHostsName), nil, 0o600))
+	t.Setenv(common.EnvVarSSHDataPath, sshDataPath)
+
+	auth, err := newAuth("git@github.com:argoproj/argo-cd.git", NopCreds{})
+	require.NoError(t, err)
+	noAuth, ok := auth.(*NoAuthWithOptions)
+	require.Truef(t, ok, "expected NoAuthWithOptions but got %T", auth)
+	assert.Equal(t, "git", noAuth.User)
+	config, err := noAuth.ClientConfig()
+	require.NoError(t, err)
+	assert.Empty(t, config.Auth)
+
+	auth, err = newAuth("https://github.com/argoproj/argo-cd.git", NopCreds{})
+	require.NoError(t, err)
+	assert.Nil(t, auth)
+
+	for _, e := range CommandEnviron() {
+		assert.NotContains(t, e, "SSH_AUTH_SOCK=")
+	}
+}
+
 func TestNewAuth(t *testing.T) {
 	tests := []struct {
 		name     string
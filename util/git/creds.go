@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -50,6 +51,8 @@ const (
 	githubAccessTokenUsername = "x-access-token"
 	forceBasicAuthHeaderEnv   = "ARGOCD_GIT_AUTH_HEADER"
 	bearerAuthHeaderEnv       = "ARGOCD_GIT_BEARER_AUTH_HEADER"
+	// sshAuthSockEnv is the environment variable giving the socket of the SSH agent to the SSH clients
+	sshAuthSockEnv = "SSH_AUTH_SOCK"
 	// This is the resource id of the OAuth application of Azure Devops.
 	azureDevopsEntraResourceId = "499b84ac-1321-427f-aa17-267ca6975798/.default"
 )
@@ -101,6 +104,15 @@ func (c NopCloser) Close() error {
 	return nil
 }
 
+// CommandEnviron returns the environment of the process for the commands accessing the repositories, without the
+// socket of the SSH agent of the process. The SSH agent is only used with the credentials of NewSSHAgentCreds, which
+// set the socket of the agent in their environment.
+func CommandEnviron() []string {
+	return slices.DeleteFunc(os.Environ(), func(e string) bool {
+		return strings.HasPrefix(e, sshAuthSockEnv+"=")
+	})
+}
+
 var _ Creds = NopCreds{}
 
 type NopCreds struct{}
@@ -272,10 +284,19 @@ type SSHCreds struct {
 	caPath        string
 	insecure      bool
 	proxy         string
+	// agentSocket is the socket of the SSH agent holding the keys, used instead of the private key
+	agentSocket string
 }
 
 func NewSSHCreds(sshPrivateKey string, caPath string, insecureIgnoreHostKey bool, proxy string) SSHCreds {
-	return SSHCreds{sshPrivateKey, caPath, insecureIgnoreHostKey, proxy}
+	return SSHCreds{sshPrivateKey: sshPrivateKey, caPath: caPath, insecure: insecureIgnoreHostKey, proxy: proxy}
+}
+
+// NewSSHAgentCreds returns the credentials authenticating with the keys, or the certificates, held by the SSH agent
+// listening on the given socket rather than with a private key. The agent may hold hardware-backed
+// keys or short-lived certificates.
+func NewSSHAgentCreds(agentSocket string, insecureIgnoreHostKey bool, proxy string) SSHCreds {
+	return SSHCreds{insecure: insecureIgnoreHostKey, proxy: proxy, agentSocket: agentSocket}
 }
 
 // GetUserInfo returns empty strings for user info.
@@ -308,6 +329,10 @@ func (f authFilePaths) Close() error {
 }
 
 func (c SSHCreds) Environ() (io.Closer, []string, error) {
+	if c.agentSocket != "" {
+		return c.environ(NopCloser{}, []string{"ssh"}, []string{sshAuthSockEnv + "=" + c.agentSocket})
+	}
+
 	// use the SHM temp dir from util, more secure
 	file, err := os.CreateTemp(argoio.TempDir, "")
 	if err != nil {
@@ -331,8 +356,12 @@ func (c SSHCreds) Environ() (io.Closer, []string, error) {
 		return nil, nil, err
 	}
 
-	args := []string{"ssh", "-i", file.Name()}
-	var env []string
+	return c.environ(sshCloser, []string{"ssh", "-i", file.Name()}, nil)
+}
+
+// environ returns the environment of the git commands running the given ssh command, which is completed with the
+// options of the credentials. The given closer is closed on error.
+func (c SSHCreds) environ(sshCloser io.Closer, args []string, env []string) (io.Closer, []string, error) {
 	if c.caPath != "" {
 		env = append(env, "GIT_SSL_CAINFO="+c.caPath)
 	}
@@ -255,6 +255,19 @@ func Test_SSHCreds_Environ(t *testing.T) {
 	}
 }
 
+func Test_SSHCreds_Environ_Agent(t *testing.T) {
+	creds := NewSSHAgentCreds("/tmp/agent.sock", false, "")
+	closer, env, err := creds.Environ()
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+	require.Len(t, env, 2)
+
+	assert.Equal(t, "SSH_AUTH_SOCK=/tmp/agent.sock", env[0])
+	assert.True(t, strings.HasPrefix(env[1], "GIT_SSH_COMMAND=ssh "))
+	assert.Contains(t, env[1], "-o StrictHostKeyChecking=yes")
+	assert.NotContains(t, env[1], "-i ")
+}
+
 func Test_SSHCreds_Environ_WithProxy(t *testing.T) {
 	for _, insecureIgnoreHostKey := range []bool{false, true} {
 		tempDir := t.TempDir()
@@ -0,0 +1,119 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	"github.com/google/go-github/v69/github"
+)
+
+// githubHost is the host of the repositories for which the public GitHub API is used
+const githubHost = "github.com"
+
+// githubRepoPathRegex matches the path of a repository URL, capturing its owner and name
+var githubRepoPathRegex = regexp.MustCompile(`^/([^/]+)/([^/]+?)/?$`)
+
+// PullRequest contains the metadata of a pull request
+type PullRequest struct {
+	Number int
+	Title  string
+	Author string
+	URL    string
+}
+
+// FindGitHubPullRequest returns the pull request which introduced the given commit of a repository hosted on GitHub, or
+// nil if the commit is not associated with a pull request. Merged pull requests take precedence over the other ones.
+// Only repositories hosted on github.com, or on GitHub Enterprise with GitHub App credentials, are supported: nil is
+// returned for the other repositories.
+func FindGitHubPullRequest(ctx context.Context, repoURL string, creds Creds, proxy string, noProxy string, sha string) (*PullRequest, error) {
+	client, owner, repo, err := newGitHubClient(repoURL, creds, proxy, noProxy)
+	if err != nil || client == nil {
+		return nil, err
+	}
+	return findGitHubPullRequest(ctx, client, owner, repo, sha)
+}
+
+// newGitHubClient returns a GitHub API client authenticated with the credentials of the repository, together with the
+// owner and the name of the repository. A nil client is returned when the repository is not hosted on GitHub.
+func newGitHubClient(repoURL string, creds Creds, proxy string, noProxy string) (*github.Client, string, string, error) {
+	normalized := NormalizeGitURL(repoURL)
+	if normalized == "" {
+		return nil, "", "", nil
+	}
+	// the normalized SSH URLs are stripped of their scheme
+	if isSSH, _ := IsSSHURL(repoURL); isSSH {
+		normalized = ensurePrefix(normalized, "ssh://")
+	}
+	u, err := url.Parse(normalized)
+	if err != nil {
+		return nil, "", "", nil
+	}
+	matches := githubRepoPathRegex.FindStringSubmatch(u.Path)
+	if matches == nil {
+		return nil, "", "", nil
+	}
+	owner, repo := matches[1], matches[2]
+
+	switch c := creds.(type) {
+	case GitHubAppCreds:
+		itr, err := c.getInstallationTransport()
+		if err != nil {
+			return nil, "", "", fmt.Errorf("failed to create GitHub app installation transport: %w", err)
+		}
+		client := github.NewClient(&http.Client{Transport: itr})
+		if c.baseURL == "" {
+			if u.Hostname() != githubHost {
+				return nil, "", "", nil
+			}
+			return client, owner, repo, nil
+		}
+		client, err = client.WithEnterpriseURLs(c.baseURL, c.baseURL)
+		if err != nil {
+			return nil, "", "", fmt.Errorf("failed to set GitHub Enterprise URL %q: %w", c.baseURL, err)
+		}
+		return client, owner, repo, nil
+	case HTTPSCreds:
+		if u.Hostname() != githubHost {
+			return nil, "", "", nil
+		}
+		client := github.NewClient(GetRepoHTTPClient("https://api.github.com", c.insecure, c, proxy, noProxy))
+		if c.password != "" {
+			client = client.WithAuthToken(c.password)
+		} else if c.bearerToken != "" {
+			client = client.WithAuthToken(c.bearerToken)
+		}
+		return client, owner, repo, nil
+	default:
+		if u.Hostname() != githubHost {
+			return nil, "", "", nil
+		}
+		// public repositories can be queried without credentials, within the rate limits of the anonymous requests
+		return github.NewClient(GetRepoHTTPClient("https://api.github.com", false, creds, proxy, noProxy)), owner, repo, nil
+	}
+}
+
+func findGitHubPullRequest(ctx context.Context, client *github.Client, owner string, repo string, sha string) (*PullRequest, error) {
+	pulls, _, err := client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, sha, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the pull requests of commit %s: %w", sha, err)
+	}
+	if len(pulls) == 0 {
+		return nil, nil
+	}
+	pull := pulls[0]
+	for _, p := range pulls {
+		if p.MergedAt != nil {
+			pull = p
+			break
+		}
+	}
+	return &PullRequest{
+		Number: pull.GetNumber(),
+		Title:  pull.GetTitle(),
+		Author: pull.GetUser().GetLogin(),
+		URL:    pull.GetHTMLURL(),
+	}, nil
+}
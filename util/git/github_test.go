@@ -0,0 +1,68 @@
+package git
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newGitHubClient(t *testing.T) {
+	for _, tc := range []struct {
+		repoURL string
+		creds   Creds
+		owner   string
+		repo    string
+	}{
+		{repoURL: "https://github.com/argoproj/argo-cd.git", creds: NopCreds{}, owner: "argoproj", repo: "argo-cd"},
+		{repoURL: "https://github.com/argoproj/argo-cd", creds: NewHTTPSCreds("user", "token", "", "", "", false, NoopCredsStore{}, false), owner: "argoproj", repo: "argo-cd"},
+		{repoURL: "git@github.com:argoproj/argo-cd.git", creds: NopCreds{}, owner: "argoproj", repo: "argo-cd"},
+		{repoURL: "https://gitlab.com/argoproj/argo-cd.git", creds: NopCreds{}},
+		{repoURL: "https://github.com/argoproj/argo-cd/subgroup", creds: NopCreds{}},
+	} {
+		t.Run(tc.repoURL, func(t *testing.T) {
+			client, owner, repo, err := newGitHubClient(tc.repoURL, tc.creds, "", "")
+			require.NoError(t, err)
+			if tc.owner == "" {
+				assert.Nil(t, client)
+				return
+			}
+			require.NotNil(t, client)
+			assert.Equal(t, tc.owner, owner)
+			assert.Equal(t, tc.repo, repo)
+		})
+	}
+}
+
+func Test_findGitHubPullRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/repos/argoproj/argo-cd/commits/merged/pulls":
+			_, _ = w.Write([]byte(`[
+				{"number": 1, "title": "Open", "user": {"login": "alice"}, "html_url": "https://github.com/argoproj/argo-cd/pull/1"},
+				{"number": 2, "title": "Merged", "user": {"login": "bob"}, "html_url": "https://github.com/argoproj/argo-cd/pull/2", "merged_at": "2025-01-01T00:00:00Z"}
+			]`))
+		case "/api/v3/repos/argoproj/argo-cd/commits/none/pulls":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	client, err := github.NewClient(nil).WithEnterpriseURLs(ts.URL, ts.URL)
+	require.NoError(t, err)
+
+	pull, err := findGitHubPullRequest(t.Context(), client, "argoproj", "argo-cd", "merged")
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequest{Number: 2, Title: "Merged", Author: "bob", URL: "https://github.com/argoproj/argo-cd/pull/2"}, pull)
+
+	pull, err = findGitHubPullRequest(t.Context(), client, "argoproj", "argo-cd", "none")
+	require.NoError(t, err)
+	assert.Nil(t, pull)
+
+	_, err = findGitHubPullRequest(t.Context(), client, "argoproj", "argo-cd", "missing")
+	require.Error(t, err)
+}
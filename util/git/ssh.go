@@ -2,9 +2,12 @@ package git
 
 import (
 	"fmt"
+	"io"
+	"net"
 
 	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
 )
 
 // List of all currently supported algorithms for SSH key exchange
@@ -46,14 +49,134 @@ func (a *PublicKeysWithOptions) String() string {
 
 // ClientConfig returns a custom SSH client configuration
 func (a *PublicKeysWithOptions) ClientConfig() (*ssh.ClientConfig, error) {
-	// Algorithms used for kex can be configured
-	var kexAlgos []string
-	if len(a.KexAlgorithms) > 0 {
-		kexAlgos = a.KexAlgorithms
-	} else {
-		kexAlgos = DefaultSSHKeyExchangeAlgorithms
-	}
-	config := ssh.Config{KeyExchanges: kexAlgos}
+	config := ssh.Config{KeyExchanges: kexAlgorithms(a.KexAlgorithms)}
 	opts := &ssh.ClientConfig{Config: config, User: a.User, Auth: []ssh.AuthMethod{ssh.PublicKeys(a.Signer)}}
 	return a.SetHostKeyCallback(opts)
 }
+
+// NoAuthWithOptions is an auth method for go-git's SSH client that
+// authenticates with no key. It is used for the SSH repositories without
+// credentials, for which go-git would otherwise use the keys of the SSH
+// agent of the process.
+type NoAuthWithOptions struct {
+	KexAlgorithms []string
+	User          string
+	gitssh.HostKeyCallbackHelper
+}
+
+// Name returns the name of the auth method
+func (a *NoAuthWithOptions) Name() string {
+	return "ssh-none"
+}
+
+// String returns the configured user and auth method name as string
+func (a *NoAuthWithOptions) String() string {
+	return fmt.Sprintf("user: %s, name: %s", a.User, a.Name())
+}
+
+// ClientConfig returns a custom SSH client configuration
+func (a *NoAuthWithOptions) ClientConfig() (*ssh.ClientConfig, error) {
+	config := ssh.Config{KeyExchanges: kexAlgorithms(a.KexAlgorithms)}
+	opts := &ssh.ClientConfig{Config: config, User: a.User}
+	return a.SetHostKeyCallback(opts)
+}
+
+// SSHAgentWithOptions is an auth method for go-git's SSH client that
+// authenticates with the keys of the SSH agent listening on a socket.
+// The agent is connected to whenever its keys are listed or used, so
+// that no connection to it is left open by the cached SSH clients.
+type SSHAgentWithOptions struct {
+	KexAlgorithms []string
+	User          string
+	Socket        string
+	gitssh.HostKeyCallbackHelper
+}
+
+// Name returns the name of the auth method
+func (a *SSHAgentWithOptions) Name() string {
+	return gitssh.PublicKeysCallbackName
+}
+
+// String returns the configured user and auth method name as string
+func (a *SSHAgentWithOptions) String() string {
+	return fmt.Sprintf("user: %s, name: %s", a.User, a.Name())
+}
+
+// ClientConfig returns a custom SSH client configuration
+func (a *SSHAgentWithOptions) ClientConfig() (*ssh.ClientConfig, error) {
+	config := ssh.Config{KeyExchanges: kexAlgorithms(a.KexAlgorithms)}
+	opts := &ssh.ClientConfig{Config: config, User: a.User, Auth: []ssh.AuthMethod{ssh.PublicKeysCallback(a.signers)}}
+	return a.SetHostKeyCallback(opts)
+}
+
+// signers returns a signer per key of the SSH agent
+func (a *SSHAgentWithOptions) signers() ([]ssh.Signer, error) {
+	var keys []*agent.Key
+	err := withSSHAgent(a.Socket, func(client agent.ExtendedAgent) error {
+		var err error
+		keys, err = client.List()
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the keys of the SSH agent: %w", err)
+	}
+	signers := make([]ssh.Signer, 0, len(keys))
+	for _, key := range keys {
+		publicKey, err := ssh.ParsePublicKey(key.Blob)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the key %q of the SSH agent: %w", key.Comment, err)
+		}
+		signers = append(signers, &sshAgentSigner{socket: a.Socket, publicKey: publicKey})
+	}
+	return signers, nil
+}
+
+// sshAgentSigner signs with a key of the SSH agent listening on a socket
+type sshAgentSigner struct {
+	socket    string
+	publicKey ssh.PublicKey
+}
+
+func (s *sshAgentSigner) PublicKey() ssh.PublicKey {
+	return s.publicKey
+}
+
+func (s *sshAgentSigner) Sign(rand io.Reader, data []byte) (*ssh.Signature, error) {
+	return s.SignWithAlgorithm(rand, data, "")
+}
+
+func (s *sshAgentSigner) SignWithAlgorithm(_ io.Reader, data []byte, algorithm string) (*ssh.Signature, error) {
+	var flags agent.SignatureFlags
+	switch algorithm {
+	case ssh.KeyAlgoRSASHA256:
+		flags = agent.SignatureFlagRsaSha256
+	case ssh.KeyAlgoRSASHA512:
+		flags = agent.SignatureFlagRsaSha512
+	}
+	var signature *ssh.Signature
+	err := withSSHAgent(s.socket, func(client agent.ExtendedAgent) error {
+		var err error
+		signature, err = client.SignWithFlags(s.publicKey, data, flags)
+		return err
+	})
+	return signature, err
+}
+
+// withSSHAgent calls the given function with a client of the SSH agent listening on the given socket
+func withSSHAgent(socket string, f func(client agent.ExtendedAgent) error) error {
+	conn, err := net.Dial("unix", socket)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return f(agent.NewClient(conn))
+}
+
+// kexAlgorithms returns the given algorithms for key exchange, or the
+// default ones if none is given
+func kexAlgorithms(algorithms []string) []string {
+	if len(algorithms) > 0 {
+		return algorithms
+	}
+	return DefaultSSHKeyExchangeAlgorithms
+}
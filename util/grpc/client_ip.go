@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// xForwardedForKey is the metadata key of the X-Forwarded-For header, also set by the gRPC gateway
+const xForwardedForKey = "x-forwarded-for"
+
+// ClientIP returns the address of the client of the gRPC call, or nil if it cannot be determined. The X-Forwarded-For
+// addresses are followed from the right as long as the previous hop is a trusted proxy. Loopback addresses are always
+// trusted, since the gRPC gateway of the API server connects from the loopback interface.
+func ClientIP(ctx context.Context, trustedProxies []*net.IPNet) net.IP {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+	ip := parseAddrIP(p.Addr.String())
+	var forwarded []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get(xForwardedForKey) {
+			for _, addr := range strings.Split(value, ",") {
+				forwarded = append(forwarded, strings.TrimSpace(addr))
+			}
+		}
+	}
+	for i := len(forwarded) - 1; i >= 0 && ip != nil && isTrustedProxy(ip, trustedProxies); i-- {
+		ip = parseAddrIP(forwarded[i])
+	}
+	return ip
+}
+
+func isTrustedProxy(ip net.IP, trustedProxies []*net.IPNet) bool {
+	if ip.IsLoopback() {
+		return true
+	}
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAddrIP parses an address with or without port
+func parseAddrIP(addr string) net.IP {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return net.ParseIP(addr)
+}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientIP(t *testing.T) {
+	_, trustedProxies, _ := net.ParseCIDR("10.0.0.0/8")
+	newPeer := func(peerAddr string) *peer.Peer {
+		addr, _ := net.ResolveTCPAddr("tcp", peerAddr)
+		return &peer.Peer{Addr: addr}
+	}
+
+	testCases := []struct {
+		name         string
+		peerAddr     string
+		forwardedFor []string
+		expected     string
+	}{
+		{"direct client", "203.0.113.1:1234", nil, "203.0.113.1"},
+		{"forwarded header of untrusted client is ignored", "203.0.113.1:1234", []string{"192.168.1.1"}, "203.0.113.1"},
+		{"grpc gateway", "127.0.0.1:1234", []string{"203.0.113.1"}, "203.0.113.1"},
+		{"trusted proxies", "127.0.0.1:1234", []string{"192.168.1.1, 203.0.113.1, 10.0.0.2", "10.0.0.1"}, "203.0.113.1"},
+		{"only trusted proxies", "127.0.0.1:1234", []string{"10.0.0.2"}, "10.0.0.2"},
+		{"invalid forwarded address", "127.0.0.1:1234", []string{"unknown"}, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := peer.NewContext(t.Context(), newPeer(tc.peerAddr))
+			if len(tc.forwardedFor) > 0 {
+				ctx = metadata.NewIncomingContext(ctx, metadata.MD{"x-forwarded-for": tc.forwardedFor})
+			}
+			ip := ClientIP(ctx, []*net.IPNet{trustedProxies})
+			if tc.expected == "" {
+				assert.Nil(t, ip)
+			} else {
+				assert.Equal(t, tc.expected, ip.String())
+			}
+		})
+	}
+
+	assert.Nil(t, ClientIP(t.Context(), nil), "no peer")
+}
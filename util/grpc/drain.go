@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamDrainer ends the long-lived server streams, such as watches and logs, when a server starts draining its
+// connections before shutting down. Those streams never end on their own, so they would otherwise hold the shutdown
+// until its deadline and then be dropped, instead of being told to reconnect to another replica. Client streams, such
+// as uploads, end once the client is done sending and are left to finish.
+type StreamDrainer struct {
+	lock     sync.Mutex
+	draining chan struct{}
+	nextID   uint64
+	streams  map[uint64]context.CancelFunc
+}
+
+// NewStreamDrainer returns a new StreamDrainer
+func NewStreamDrainer() *StreamDrainer {
+	return &StreamDrainer{
+		draining: make(chan struct{}),
+		streams:  map[uint64]context.CancelFunc{},
+	}
+}
+
+// Drain ends the in-flight server streams with an Unavailable error, and rejects the new ones
+func (d *StreamDrainer) Drain() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	select {
+	case <-d.draining:
+		return
+	default:
+	}
+	close(d.draining)
+	for _, cancel := range d.streams {
+		cancel()
+	}
+}
+
+// Draining returns a channel which is closed once the connections are drained
+func (d *StreamDrainer) Draining() <-chan struct{} {
+	return d.draining
+}
+
+func (d *StreamDrainer) track(cancel context.CancelFunc) (uint64, bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	select {
+	case <-d.draining:
+		return 0, false
+	default:
+	}
+	d.nextID++
+	d.streams[d.nextID] = cancel
+	return d.nextID, true
+}
+
+func (d *StreamDrainer) untrack(id uint64) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	delete(d.streams, id)
+}
+
+// StreamServerInterceptor returns a StreamServerInterceptor which ends the server streams when the connections are
+// drained
+func (d *StreamDrainer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !info.IsServerStream || info.IsClientStream {
+			return handler(srv, ss)
+		}
+		ctx, cancel := context.WithCancel(ss.Context())
+		defer cancel()
+		id, ok := d.track(cancel)
+		if !ok {
+			return status.Error(codes.Unavailable, "server is shutting down")
+		}
+		defer d.untrack(id)
+		err := handler(srv, &drainableServerStream{ServerStream: ss, ctx: ctx})
+		if ctx.Err() != nil && ss.Context().Err() == nil {
+			// the stream was drained rather than canceled by the client, which should reconnect
+			return status.Error(codes.Unavailable, "server is shutting down")
+		}
+		return err
+	}
+}
+
+type drainableServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *drainableServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamDrainer(t *testing.T) {
+	watchInfo := &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/Watch", IsServerStream: true}
+
+	t.Run("Server stream is drained", func(t *testing.T) {
+		d := NewStreamDrainer()
+		started := make(chan struct{})
+		result := make(chan error)
+		go func() {
+			result <- d.StreamServerInterceptor()(nil, &fakeServerStream{ctx: t.Context()}, watchInfo, func(_ any, ss grpc.ServerStream) error {
+				close(started)
+				<-ss.Context().Done()
+				return nil
+			})
+		}()
+		<-started
+		d.Drain()
+		err := <-result
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		select {
+		case <-d.Draining():
+		default:
+			t.Fatal("draining channel is not closed")
+		}
+	})
+
+	t.Run("Canceled server stream is not reported as drained", func(t *testing.T) {
+		d := NewStreamDrainer()
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		err := d.StreamServerInterceptor()(nil, &fakeServerStream{ctx: ctx}, watchInfo, func(_ any, ss grpc.ServerStream) error {
+			<-ss.Context().Done()
+			return ss.Context().Err()
+		})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("New server streams are rejected while draining", func(t *testing.T) {
+		d := NewStreamDrainer()
+		d.Drain()
+		d.Drain()
+		err := d.StreamServerInterceptor()(nil, &fakeServerStream{ctx: t.Context()}, watchInfo, func(_ any, _ grpc.ServerStream) error {
+			t.Fatal("handler should not be called")
+			return nil
+		})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("Client stream is left to finish", func(t *testing.T) {
+		d := NewStreamDrainer()
+		d.Drain()
+		info := &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/GetManifestsWithFiles", IsClientStream: true}
+		err := d.StreamServerInterceptor()(nil, &fakeServerStream{ctx: t.Context()}, info, func(_ any, ss grpc.ServerStream) error {
+			return ss.Context().Err()
+		})
+		require.NoError(t, err)
+	})
+}
@@ -2,13 +2,18 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"net/http"
 
 	giterr "github.com/go-git/go-git/v5/plumbing/transport"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/errorcode"
 )
 
 func rewrapError(err error, code codes.Code) error {
@@ -94,8 +99,9 @@ func kubeErrToGRPC(err error) error {
 	default:
 		// This is necessary as GRPC Status don't support wrapped errors:
 		// https://github.com/grpc/grpc-go/issues/2934
+		// The details of the status, such as its error code, are kept.
 		if grpcStatus := UnwrapGRPCStatus(err); grpcStatus != nil {
-			err = status.Error(grpcStatus.Code(), grpcStatus.Message())
+			err = grpcStatus.Err()
 		}
 	}
 	return err
@@ -132,3 +138,33 @@ func ErrorCodeK8sStreamServerInterceptor() grpc.StreamServerInterceptor {
 		return kubeErrToGRPC(err)
 	}
 }
+
+// HTTPErrorHandler replies to the REST requests failing with the given error like the default error handler of the gRPC
+// gateway, adding the error code carried by the error, if any, to the body of the reply as an errorCode field
+func HTTPErrorHandler(ctx context.Context, mux *gwruntime.ServeMux, marshaler gwruntime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if code := errorcode.FromError(err); code != "" {
+		marshaler = &errorCodeMarshaler{Marshaler: marshaler, code: code}
+	}
+	gwruntime.DefaultHTTPError(ctx, mux, marshaler, w, r, err)
+}
+
+// errorCodeMarshaler is a JSON marshaler adding the given error code to the errors it marshals
+type errorCodeMarshaler struct {
+	gwruntime.Marshaler
+	code errorcode.Code
+}
+
+// Marshal implements gwruntime.Marshaler.
+func (m *errorCodeMarshaler) Marshal(v any) ([]byte, error) {
+	data, err := m.Marshaler.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	body := map[string]any{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		// the error is not marshaled as a JSON object, which cannot hold the error code
+		return data, nil
+	}
+	body["errorCode"] = m.code
+	return json.Marshal(body)
+}
@@ -1,10 +1,14 @@
 package grpc
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -12,6 +16,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/errorcode"
 )
 
 func Test_gitErrToGRPC(t *testing.T) {
@@ -153,3 +159,31 @@ func Test_kubeErrToGRPC(t *testing.T) {
 		})
 	}
 }
+
+func Test_kubeErrToGRPC_KeepsErrorCode(t *testing.T) {
+	err := kubeErrToGRPC(fmt.Errorf("error creating application: %w", errorcode.Error(codes.InvalidArgument, errorcode.ProjectDestinationDenied, "destination is not permitted")))
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, errorcode.ProjectDestinationDenied, errorcode.FromError(err))
+}
+
+func TestHTTPErrorHandler(t *testing.T) {
+	mux := gwruntime.NewServeMux()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/applications", http.NoBody)
+
+	w := httptest.NewRecorder()
+	HTTPErrorHandler(t.Context(), mux, &JSONMarshaler{}, w, req, errorcode.Error(codes.InvalidArgument, errorcode.ProjectDestinationDenied, "destination is not permitted"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	body := map[string]any{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "ERR_PROJECT_DESTINATION_DENIED", body["errorCode"])
+	assert.Equal(t, "destination is not permitted", body["message"])
+
+	// the errors without error code are replied as by the default error handler
+	w = httptest.NewRecorder()
+	HTTPErrorHandler(t.Context(), mux, &JSONMarshaler{}, w, req, status.Error(codes.NotFound, "not found"))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	body = map[string]any{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.NotContains(t, body, "errorCode")
+}
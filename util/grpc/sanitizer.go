@@ -27,7 +27,10 @@ func ErrorSanitizerUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		}
 
 		if se, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
-			return resp, status.Error(se.GRPCStatus().Code(), sanitizer.Replace(se.GRPCStatus().Message()))
+			// the details of the status, such as its error code, are kept
+			s := se.GRPCStatus().Proto()
+			s.Message = sanitizer.Replace(s.GetMessage())
+			return resp, status.ErrorProto(s)
 		}
 
 		return resp, errors.New(sanitizer.Replace(err.Error()))
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+
+	"github.com/soheilhy/cmux"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// tlsInfoCredentials are server transport credentials for connections on which TLS is already terminated, e.g. by a
+// TLS listener in front of cmux. No handshake is performed: the state of the TLS connection is only exposed to the
+// gRPC handlers as credentials.TLSInfo, so that the client certificate can be inspected.
+type tlsInfoCredentials struct{}
+
+// plainAuthInfo is the auth info of a connection which is not a TLS connection
+type plainAuthInfo struct {
+	credentials.CommonAuthInfo
+}
+
+func (plainAuthInfo) AuthType() string {
+	return "insecure"
+}
+
+// NewTLSInfoCredentials returns server transport credentials exposing the state of connections on which TLS is
+// already terminated
+func NewTLSInfoCredentials() credentials.TransportCredentials {
+	return tlsInfoCredentials{}
+}
+
+func (tlsInfoCredentials) ClientHandshake(_ context.Context, _ string, _ net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("tls info credentials cannot be used by clients")
+}
+
+func (tlsInfoCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if tlsConn := UnwrapTLSConn(conn); tlsConn != nil {
+		return conn, credentials.TLSInfo{
+			State:          tlsConn.ConnectionState(),
+			CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		}, nil
+	}
+	return conn, plainAuthInfo{CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.NoSecurity}}, nil
+}
+
+func (tlsInfoCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "tls"}
+}
+
+func (c tlsInfoCredentials) Clone() credentials.TransportCredentials {
+	return c
+}
+
+func (tlsInfoCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+// UnwrapTLSConn returns the TLS connection wrapped by the given connection, or nil if it is not a TLS connection
+func UnwrapTLSConn(conn net.Conn) *tls.Conn {
+	for {
+		switch c := conn.(type) {
+		case *tls.Conn:
+			return c
+		case *cmux.MuxConn:
+			conn = c.Conn
+		default:
+			return nil
+		}
+	}
+}
+
+// VerifiedClientCertificate returns the client certificate of the gRPC peer, if one was presented and verified
+// during the TLS handshake
+func VerifiedClientCertificate(ctx context.Context) (*x509.Certificate, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+	return tlsInfo.State.VerifiedChains[0][0], true
+}
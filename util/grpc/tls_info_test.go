@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func TestVerifiedClientCertificate(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "ci"}}
+
+	t.Run("Verified certificate", func(t *testing.T) {
+		ctx := peer.NewContext(t.Context(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{cert},
+			VerifiedChains:   [][]*x509.Certificate{{cert}},
+		}}})
+		verified, ok := VerifiedClientCertificate(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, cert, verified)
+	})
+
+	t.Run("Unverified certificate", func(t *testing.T) {
+		ctx := peer.NewContext(t.Context(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{cert},
+		}}})
+		_, ok := VerifiedClientCertificate(ctx)
+		assert.False(t, ok)
+	})
+
+	t.Run("Plain connection", func(t *testing.T) {
+		ctx := peer.NewContext(t.Context(), &peer.Peer{AuthInfo: plainAuthInfo{}})
+		_, ok := VerifiedClientCertificate(ctx)
+		assert.False(t, ok)
+	})
+}
+
+func TestTLSInfoCredentials_ServerHandshake(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	conn, authInfo, err := NewTLSInfoCredentials().ServerHandshake(server)
+	require.NoError(t, err)
+	assert.Equal(t, server, conn)
+	assert.Equal(t, "insecure", authInfo.AuthType())
+
+	tlsConn := tls.Server(server, &tls.Config{})
+	_, authInfo, err = NewTLSInfoCredentials().ServerHandshake(tlsConn)
+	require.NoError(t, err)
+	assert.Equal(t, "tls", authInfo.AuthType())
+}
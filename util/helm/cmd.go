@@ -51,6 +51,10 @@ var redactor = func(text string) string {
 }
 
 func (c Cmd) run(args ...string) (string, string, error) {
+	return c.runWithRedactor(redactor, args...)
+}
+
+func (c Cmd) runWithRedactor(redactor func(text string) string, args ...string) (string, string, error) {
 	cmd := exec.Command("helm", args...)
 	cmd.Dir = c.WorkDir
 	cmd.Env = os.Environ()
@@ -355,6 +359,9 @@ type TemplateOpts struct {
 	SkipCrds             bool
 	SkipSchemaValidation bool
 	SkipTests            bool
+	// SensitiveValues are redacted from the logs, the returned command and the errors, e.g. the values of the unsealed
+	// parameters
+	SensitiveValues []string
 }
 
 func cleanSetParameters(val string) string {
@@ -430,9 +437,19 @@ func (c *Cmd) template(chartPath string, opts *TemplateOpts) (string, string, er
 		args = append(args, "--skip-tests")
 	}
 
-	out, command, err := c.run(args...)
+	var sensitiveValues []string
+	for _, val := range opts.SensitiveValues {
+		if val != "" {
+			sensitiveValues = append(sensitiveValues, cleanSetParameters(val), val)
+		}
+	}
+	redact := func(text string) string {
+		return executil.Redact(sensitiveValues)(redactor(text))
+	}
+	out, command, err := c.runWithRedactor(redact, args...)
+	command = redact(command)
 	if err != nil {
-		msg := err.Error()
+		msg := redact(err.Error())
 		if strings.Contains(msg, "--api-versions") {
 			log.Debug(msg)
 			msg = apiVersionsRemover.ReplaceAllString(msg, "<api versions removed> ")
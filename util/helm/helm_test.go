@@ -54,6 +54,21 @@ func TestHelmTemplateParams(t *testing.T) {
 	}
 }
 
+func TestHelmTemplateSensitiveValues(t *testing.T) {
+	h, err := NewHelmApp("./testdata/minio", []HelmRepository{}, false, "", "", "", false)
+	require.NoError(t, err)
+	opts := TemplateOpts{
+		Name:            "test",
+		SetString:       map[string]string{"service.annotations.secret": "s3cr3t,value"},
+		SensitiveValues: []string{"s3cr3t,value", ""},
+	}
+	out, command, err := h.Template(&opts)
+	require.NoError(t, err)
+	assert.Contains(t, out, "s3cr3t,value")
+	assert.NotContains(t, command, "s3cr3t")
+	assert.Contains(t, command, "--set-string service.annotations.secret=******")
+}
+
 func TestHelmTemplateValues(t *testing.T) {
 	repoRoot := "./testdata/redis"
 	repoRootAbs, err := filepath.Abs(repoRoot)
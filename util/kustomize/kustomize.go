@@ -128,7 +128,7 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 	// commands stores all the commands that were run as part of this build.
 	var commands []string
 
-	env := os.Environ()
+	env := git.CommandEnviron()
 	if envVars != nil {
 		env = append(env, envVars.Environ()...)
 	}
@@ -477,6 +477,11 @@ func Version() (string, error) {
 	return versionWithBinaryPath(&kustomize{})
 }
 
+// VersionWithBinaryPath returns the version of the kustomize binary of the given path, of the default binary if empty
+func VersionWithBinaryPath(binaryPath string) (string, error) {
+	return versionWithBinaryPath(&kustomize{binaryPath: binaryPath})
+}
+
 func versionWithBinaryPath(k *kustomize) (string, error) {
 	executable := k.getBinaryPath()
 	cmd := exec.Command(executable, "version", "--short")
@@ -0,0 +1,370 @@
+package ldap
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	defaultPoolSize  = 5
+	defaultTimeout   = 10 * time.Second
+	defaultMaxDepth  = 10
+	defaultEmailAttr = "mail"
+	defaultNameAttr  = "cn"
+	// dnAttr is the pseudo attribute of the user matchers matching the DN of the users and the groups
+	dnAttr = "DN"
+)
+
+// ErrInvalidCredentials is returned when the user does not exist or the password is invalid
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// Identity is a user authenticated by the LDAP server
+type Identity struct {
+	Username string
+	DN       string
+	Email    string
+	Name     string
+	Groups   []string
+}
+
+// conn is the subset of the LDAP connection used by the authenticator
+type conn interface {
+	Bind(username, password string) error
+	Search(request *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close() error
+}
+
+// Authenticator authenticates the users with their LDAP password and resolves their groups. The searches are made on
+// a pool of connections bound as the service account.
+type Authenticator struct {
+	config  settings.LDAPConfig
+	timeout time.Duration
+	dial    func() (conn, error)
+	pool    chan conn
+}
+
+// NewAuthenticator returns an authenticator for the given LDAP config
+func NewAuthenticator(config *settings.LDAPConfig) (*Authenticator, error) {
+	timeout := defaultTimeout
+	if config.Timeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(config.Timeout); err != nil {
+			return nil, fmt.Errorf("invalid ldap timeout %q: %w", config.Timeout, err)
+		}
+	}
+	poolSize := config.PoolSize
+	if poolSize <= 0 {
+		poolSize = defaultPoolSize
+	}
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	a := &Authenticator{
+		config:  *config,
+		timeout: timeout,
+		pool:    make(chan conn, poolSize),
+	}
+	a.dial = func() (conn, error) {
+		return dial(config, tlsConfig, timeout)
+	}
+	return a, nil
+}
+
+func newTLSConfig(config *settings.LDAPConfig) (*tls.Config, error) {
+	if config.InsecureNoSSL {
+		return nil, nil
+	}
+	serverName := config.Host
+	if host, _, err := net.SplitHostPort(config.Host); err == nil {
+		serverName = host
+	}
+	tlsConfig := &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+	if config.RootCA != "" {
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM([]byte(config.RootCA)) {
+			return nil, errors.New("invalid ldap root CA: no PEM encoded certificate found")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+	return tlsConfig, nil
+}
+
+func dial(config *settings.LDAPConfig, tlsConfig *tls.Config, timeout time.Duration) (conn, error) {
+	dialer := ldap.DialWithDialer(&net.Dialer{Timeout: timeout})
+	var c *ldap.Conn
+	var err error
+	switch {
+	case config.InsecureNoSSL:
+		c, err = ldap.DialURL("ldap://"+config.Host, dialer)
+	case config.StartTLS:
+		if c, err = ldap.DialURL("ldap://"+config.Host, dialer); err == nil {
+			if err = c.StartTLS(tlsConfig); err != nil {
+				_ = c.Close()
+			}
+		}
+	default:
+		c, err = ldap.DialURL("ldaps://"+config.Host, dialer, ldap.DialWithTLSConfig(tlsConfig))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to ldap server %s: %w", config.Host, err)
+	}
+	c.SetTimeout(timeout)
+	return c, nil
+}
+
+// Authenticate verifies the password of the user and returns its identity. ErrInvalidCredentials is returned if the
+// user does not exist or the password is invalid.
+func (a *Authenticator) Authenticate(username string, password string) (*Identity, error) {
+	// an empty password would be an unauthenticated bind, which succeeds for any user
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+	var user *ldap.Entry
+	err := a.withConn(func(c conn) error {
+		var err error
+		user, err = a.searchUser(c, username)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	// the user binds on a dedicated connection so that the pooled connections stay bound as the service account
+	c, err := a.dial()
+	if err != nil {
+		return nil, err
+	}
+	err = c.Bind(user.DN, password)
+	_ = c.Close()
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("error binding as %s: %w", user.DN, err)
+	}
+
+	identity := &Identity{
+		Username: user.GetAttributeValue(a.config.UserSearch.Username),
+		DN:       user.DN,
+		Email:    user.GetAttributeValue(withDefault(a.config.UserSearch.EmailAttr, defaultEmailAttr)),
+		Name:     user.GetAttributeValue(withDefault(a.config.UserSearch.NameAttr, defaultNameAttr)),
+	}
+	if identity.Username == "" {
+		identity.Username = username
+	}
+	err = a.withConn(func(c conn) error {
+		var err error
+		identity.Groups, err = a.searchGroups(c, user)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return identity, nil
+}
+
+// Close closes the pooled connections
+func (a *Authenticator) Close() {
+	for {
+		select {
+		case c := <-a.pool:
+			_ = c.Close()
+		default:
+			return
+		}
+	}
+}
+
+// withConn calls f with a connection bound as the service account. The call is retried once on a new connection if
+// a pooled connection was closed by the server.
+func (a *Authenticator) withConn(f func(c conn) error) error {
+	c, pooled, err := a.getConn(false)
+	if err != nil {
+		return err
+	}
+	err = f(c)
+	if pooled && isNetworkError(err) {
+		_ = c.Close()
+		if c, _, err = a.getConn(true); err != nil {
+			return err
+		}
+		err = f(c)
+	}
+	if isNetworkError(err) {
+		_ = c.Close()
+		return err
+	}
+	a.putConn(c)
+	return err
+}
+
+func (a *Authenticator) getConn(fresh bool) (conn, bool, error) {
+	if !fresh {
+		select {
+		case c := <-a.pool:
+			return c, true, nil
+		default:
+		}
+	}
+	c, err := a.dial()
+	if err != nil {
+		return nil, false, err
+	}
+	if a.config.BindDN != "" {
+		if err := c.Bind(a.config.BindDN, a.config.BindPW); err != nil {
+			_ = c.Close()
+			return nil, false, fmt.Errorf("error binding as %s: %w", a.config.BindDN, err)
+		}
+	}
+	return c, false, nil
+}
+
+func (a *Authenticator) putConn(c conn) {
+	select {
+	case a.pool <- c:
+	default:
+		_ = c.Close()
+	}
+}
+
+func isNetworkError(err error) bool {
+	var ldapErr *ldap.Error
+	return errors.As(err, &ldapErr) && ldapErr.ResultCode == ldap.ErrorNetwork
+}
+
+func (a *Authenticator) searchUser(c conn, username string) (*ldap.Entry, error) {
+	userSearch := a.config.UserSearch
+	attributes := []string{
+		userSearch.Username,
+		withDefault(userSearch.EmailAttr, defaultEmailAttr),
+		withDefault(userSearch.NameAttr, defaultNameAttr),
+	}
+	if a.config.GroupSearch != nil {
+		for _, matcher := range a.config.GroupSearch.UserMatchers {
+			if matcher.UserAttr != dnAttr {
+				attributes = append(attributes, matcher.UserAttr)
+			}
+		}
+	}
+	entries, err := a.search(c, userSearch.BaseDN, filter(userSearch.Filter, userSearch.Username, username), attributes)
+	if err != nil {
+		return nil, err
+	}
+	switch len(entries) {
+	case 0:
+		return nil, nil
+	case 1:
+		return entries[0], nil
+	default:
+		return nil, fmt.Errorf("%d ldap users match username %s", len(entries), username)
+	}
+}
+
+// searchGroups returns the names of the groups of the user. The nested groups are resolved breadth first with the DN
+// user matchers, up to the configured depth.
+func (a *Authenticator) searchGroups(c conn, user *ldap.Entry) ([]string, error) {
+	groupSearch := a.config.GroupSearch
+	if groupSearch == nil {
+		return nil, nil
+	}
+	nameAttr := withDefault(groupSearch.NameAttr, defaultNameAttr)
+	var groups []string
+	visited := map[string]bool{}
+	var parents []string
+	addGroups := func(entries []*ldap.Entry) {
+		for _, entry := range entries {
+			if visited[entry.DN] {
+				continue
+			}
+			visited[entry.DN] = true
+			parents = append(parents, entry.DN)
+			if name := entry.GetAttributeValue(nameAttr); name != "" {
+				groups = append(groups, name)
+			}
+		}
+	}
+
+	for _, matcher := range groupSearch.UserMatchers {
+		values := []string{user.DN}
+		if matcher.UserAttr != dnAttr {
+			values = user.GetAttributeValues(matcher.UserAttr)
+		}
+		for _, value := range values {
+			entries, err := a.search(c, groupSearch.BaseDN, filter(groupSearch.Filter, matcher.GroupAttr, value), []string{nameAttr})
+			if err != nil {
+				return nil, err
+			}
+			addGroups(entries)
+		}
+	}
+
+	if groupSearch.Nested {
+		maxDepth := groupSearch.MaxDepth
+		if maxDepth <= 0 {
+			maxDepth = defaultMaxDepth
+		}
+		for depth := 1; depth < maxDepth && len(parents) > 0; depth++ {
+			children := parents
+			parents = nil
+			for _, child := range children {
+				for _, matcher := range groupSearch.UserMatchers {
+					if matcher.UserAttr != dnAttr {
+						continue
+					}
+					entries, err := a.search(c, groupSearch.BaseDN, filter(groupSearch.Filter, matcher.GroupAttr, child), []string{nameAttr})
+					if err != nil {
+						return nil, err
+					}
+					addGroups(entries)
+				}
+			}
+		}
+	}
+	slices.Sort(groups)
+	return groups, nil
+}
+
+func (a *Authenticator) search(c conn, baseDN string, filter string, attributes []string) ([]*ldap.Entry, error) {
+	request := ldap.NewSearchRequest(baseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(a.timeout.Seconds()), false, filter, attributes, nil)
+	result, err := c.Search(request)
+	if err != nil {
+		return nil, fmt.Errorf("error searching %s with filter %s: %w", baseDN, filter, err)
+	}
+	return result.Entries, nil
+}
+
+// filter returns the filter matching the attribute with the value, combined with the configured filter
+func filter(configured string, attr string, value string) string {
+	f := fmt.Sprintf("(%s=%s)", attr, ldap.EscapeFilter(value))
+	if configured == "" {
+		return f
+	}
+	if !strings.HasPrefix(configured, "(") {
+		configured = "(" + configured + ")"
+	}
+	return "(&" + configured + f + ")"
+}
+
+func withDefault(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,177 @@
+package ldap
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+type fakeDirectory struct {
+	entries   []*ldap.Entry
+	passwords map[string]string
+	conns     []*fakeConn
+}
+
+type fakeConn struct {
+	directory *fakeDirectory
+	boundDN   string
+	closed    bool
+	searches  int
+}
+
+func (c *fakeConn) Bind(username, password string) error {
+	if c.closed {
+		return ldap.NewError(ldap.ErrorNetwork, errors.New("connection closed"))
+	}
+	if expected, ok := c.directory.passwords[username]; !ok || expected != password {
+		return ldap.NewError(ldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))
+	}
+	c.boundDN = username
+	return nil
+}
+
+// Search supports the (attr=value) filters, optionally combined with another filter which is ignored
+func (c *fakeConn) Search(request *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	if c.closed {
+		return nil, ldap.NewError(ldap.ErrorNetwork, errors.New("connection closed"))
+	}
+	c.searches++
+	f := strings.TrimRight(request.Filter, ")")
+	attr, value, _ := strings.Cut(f[strings.LastIndex(f, "(")+1:], "=")
+	result := &ldap.SearchResult{}
+	for _, entry := range c.directory.entries {
+		if strings.HasSuffix(entry.DN, request.BaseDN) && slices.Contains(entry.GetAttributeValues(attr), value) {
+			result.Entries = append(result.Entries, entry)
+		}
+	}
+	return result, nil
+}
+
+func (c *fakeConn) Close() error {
+	c.closed = true
+	return nil
+}
+
+func newFakeAuthenticator(t *testing.T, config *settings.LDAPConfig) (*Authenticator, *fakeDirectory) {
+	t.Helper()
+	directory := &fakeDirectory{
+		entries: []*ldap.Entry{
+			ldap.NewEntry("cn=argocd,dc=example,dc=com", map[string][]string{"cn": {"argocd"}}),
+			ldap.NewEntry("uid=alice,ou=People,dc=example,dc=com", map[string][]string{"uid": {"alice"}, "mail": {"alice@example.com"}, "cn": {"Alice"}}),
+			ldap.NewEntry("uid=bob,ou=People,dc=example,dc=com", map[string][]string{"uid": {"bob"}}),
+			ldap.NewEntry("cn=developers,ou=Groups,dc=example,dc=com", map[string][]string{"cn": {"developers"}, "member": {"uid=alice,ou=People,dc=example,dc=com"}}),
+			ldap.NewEntry("cn=engineering,ou=Groups,dc=example,dc=com", map[string][]string{"cn": {"engineering"}, "member": {"cn=developers,ou=Groups,dc=example,dc=com"}}),
+			ldap.NewEntry("cn=staff,ou=Groups,dc=example,dc=com", map[string][]string{"cn": {"staff"}, "member": {"cn=engineering,ou=Groups,dc=example,dc=com", "cn=staff,ou=Groups,dc=example,dc=com"}}),
+		},
+		passwords: map[string]string{
+			"cn=argocd,dc=example,dc=com":           "bind-password",
+			"uid=alice,ou=People,dc=example,dc=com": "alice-password",
+			"uid=bob,ou=People,dc=example,dc=com":   "bob-password",
+		},
+	}
+	authenticator, err := NewAuthenticator(config)
+	require.NoError(t, err)
+	authenticator.dial = func() (conn, error) {
+		c := &fakeConn{directory: directory}
+		directory.conns = append(directory.conns, c)
+		return c, nil
+	}
+	return authenticator, directory
+}
+
+func newLDAPConfig(nested bool, maxDepth int) *settings.LDAPConfig {
+	return &settings.LDAPConfig{
+		Host:       "ldap.example.com",
+		BindDN:     "cn=argocd,dc=example,dc=com",
+		BindPW:     "bind-password",
+		PoolSize:   1,
+		UserSearch: settings.LDAPUserSearch{BaseDN: "ou=People,dc=example,dc=com", Filter: "objectClass=person", Username: "uid"},
+		GroupSearch: &settings.LDAPGroupSearch{
+			BaseDN:       "ou=Groups,dc=example,dc=com",
+			UserMatchers: []settings.LDAPUserMatcher{{UserAttr: "DN", GroupAttr: "member"}},
+			Nested:       nested,
+			MaxDepth:     maxDepth,
+		},
+	}
+}
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	authenticator, _ := newFakeAuthenticator(t, newLDAPConfig(false, 0))
+
+	identity, err := authenticator.Authenticate("alice", "alice-password")
+	require.NoError(t, err)
+	assert.Equal(t, &Identity{
+		Username: "alice",
+		DN:       "uid=alice,ou=People,dc=example,dc=com",
+		Email:    "alice@example.com",
+		Name:     "Alice",
+		Groups:   []string{"developers"},
+	}, identity)
+
+	identity, err = authenticator.Authenticate("bob", "bob-password")
+	require.NoError(t, err)
+	assert.Empty(t, identity.Groups)
+
+	for name, credentials := range map[string][2]string{
+		"invalid password": {"alice", "bob-password"},
+		"unknown user":     {"carol", "carol-password"},
+		"empty password":   {"alice", ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := authenticator.Authenticate(credentials[0], credentials[1])
+			assert.ErrorIs(t, err, ErrInvalidCredentials)
+		})
+	}
+}
+
+func TestAuthenticator_NestedGroups(t *testing.T) {
+	authenticator, _ := newFakeAuthenticator(t, newLDAPConfig(true, 0))
+	identity, err := authenticator.Authenticate("alice", "alice-password")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"developers", "engineering", "staff"}, identity.Groups, "cycles are resolved once")
+
+	authenticator, _ = newFakeAuthenticator(t, newLDAPConfig(true, 2))
+	identity, err = authenticator.Authenticate("alice", "alice-password")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"developers", "engineering"}, identity.Groups)
+}
+
+func TestAuthenticator_ConnectionPool(t *testing.T) {
+	authenticator, directory := newFakeAuthenticator(t, newLDAPConfig(false, 0))
+
+	_, err := authenticator.Authenticate("alice", "alice-password")
+	require.NoError(t, err)
+	require.Len(t, directory.conns, 2, "one pooled connection and one connection binding as the user")
+	pooled := directory.conns[0]
+	assert.Equal(t, "cn=argocd,dc=example,dc=com", pooled.boundDN)
+	assert.False(t, pooled.closed)
+	assert.True(t, directory.conns[1].closed)
+
+	_, err = authenticator.Authenticate("alice", "alice-password")
+	require.NoError(t, err)
+	assert.Len(t, directory.conns, 3, "the pooled connection is reused")
+	assert.Equal(t, 4, pooled.searches)
+
+	// the server closes the pooled connection
+	pooled.closed = true
+	_, err = authenticator.Authenticate("alice", "alice-password")
+	require.NoError(t, err)
+	assert.Len(t, directory.conns, 5)
+	assert.False(t, directory.conns[3].closed, "the pooled connection is replaced")
+
+	authenticator.Close()
+	assert.True(t, directory.conns[3].closed)
+}
+
+func TestFilter(t *testing.T) {
+	assert.Equal(t, `(uid=alice)`, filter("", "uid", "alice"))
+	assert.Equal(t, `(&(objectClass=person)(uid=alice))`, filter("objectClass=person", "uid", "alice"))
+	assert.Equal(t, `(&(|(a=b)(c=d))(uid=\2a\29))`, filter("(|(a=b)(c=d))", "uid", "*)"))
+}
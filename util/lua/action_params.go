@@ -0,0 +1,85 @@
+package lua
+
+import (
+	"fmt"
+	"strconv"
+
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// Types that can be declared for a resource action parameter. Parameters without a declared type are passed to the
+// Lua script as strings.
+const (
+	ActionParamTypeString  = "string"
+	ActionParamTypeInteger = "integer"
+	ActionParamTypeNumber  = "number"
+	ActionParamTypeBoolean = "boolean"
+)
+
+// resolveResourceActionParameters validates the given parameters against the declared ones, fills in defaults for
+// the parameters that were not supplied and converts each value to its declared type.
+func resolveResourceActionParameters(declared []appv1.ResourceActionParam, params []*applicationpkg.ResourceActionParameters) (map[string]any, error) {
+	declaredByName := make(map[string]appv1.ResourceActionParam, len(declared))
+	for _, param := range declared {
+		declaredByName[param.Name] = param
+	}
+
+	resolved := make(map[string]any, len(declared))
+	for _, param := range params {
+		decl, ok := declaredByName[param.GetName()]
+		if !ok {
+			return nil, fmt.Errorf("unknown parameter %q", param.GetName())
+		}
+		if _, ok := resolved[param.GetName()]; ok {
+			return nil, fmt.Errorf("parameter %q is specified more than once", param.GetName())
+		}
+		value, err := parseActionParamValue(decl.Type, param.GetValue())
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", param.GetName(), err)
+		}
+		resolved[param.GetName()] = value
+	}
+
+	for _, decl := range declared {
+		if _, ok := resolved[decl.Name]; ok {
+			continue
+		}
+		if decl.Default == "" {
+			return nil, fmt.Errorf("parameter %q is required", decl.Name)
+		}
+		value, err := parseActionParamValue(decl.Type, decl.Default)
+		if err != nil {
+			return nil, fmt.Errorf("default value of parameter %q: %w", decl.Name, err)
+		}
+		resolved[decl.Name] = value
+	}
+	return resolved, nil
+}
+
+func parseActionParamValue(paramType string, value string) (any, error) {
+	switch paramType {
+	case "", ActionParamTypeString:
+		return value, nil
+	case ActionParamTypeInteger:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q is not a valid integer", value)
+		}
+		return i, nil
+	case ActionParamTypeNumber:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q is not a valid number", value)
+		}
+		return f, nil
+	case ActionParamTypeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("value %q is not a valid boolean", value)
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("unsupported parameter type %q", paramType)
+	}
+}
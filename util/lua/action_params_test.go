@@ -0,0 +1,115 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func actionParam(name, value string) *applicationpkg.ResourceActionParameters {
+	return &applicationpkg.ResourceActionParameters{Name: ptr.To(name), Value: ptr.To(value)}
+}
+
+func TestResolveResourceActionParameters(t *testing.T) {
+	declared := []appv1.ResourceActionParam{
+		{Name: "replicas", Type: ActionParamTypeInteger},
+		{Name: "ratio", Type: ActionParamTypeNumber, Default: "0.5"},
+		{Name: "force", Type: ActionParamTypeBoolean, Default: "false"},
+		{Name: "reason", Default: "manual"},
+	}
+
+	t.Run("Typed values and defaults", func(t *testing.T) {
+		resolved, err := resolveResourceActionParameters(declared, []*applicationpkg.ResourceActionParameters{
+			actionParam("replicas", "3"),
+			actionParam("force", "true"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"replicas": int64(3),
+			"ratio":    0.5,
+			"force":    true,
+			"reason":   "manual",
+		}, resolved)
+	})
+
+	t.Run("Missing required parameter", func(t *testing.T) {
+		_, err := resolveResourceActionParameters(declared, nil)
+		require.ErrorContains(t, err, `parameter "replicas" is required`)
+	})
+
+	t.Run("Unknown parameter", func(t *testing.T) {
+		_, err := resolveResourceActionParameters(declared, []*applicationpkg.ResourceActionParameters{
+			actionParam("replicas", "3"),
+			actionParam("foo", "bar"),
+		})
+		require.ErrorContains(t, err, `unknown parameter "foo"`)
+	})
+
+	t.Run("Duplicate parameter", func(t *testing.T) {
+		_, err := resolveResourceActionParameters(declared, []*applicationpkg.ResourceActionParameters{
+			actionParam("replicas", "3"),
+			actionParam("replicas", "4"),
+		})
+		require.ErrorContains(t, err, `parameter "replicas" is specified more than once`)
+	})
+
+	t.Run("Invalid value", func(t *testing.T) {
+		_, err := resolveResourceActionParameters(declared, []*applicationpkg.ResourceActionParameters{
+			actionParam("replicas", "three"),
+		})
+		require.ErrorContains(t, err, `value "three" is not a valid integer`)
+	})
+
+	t.Run("Unsupported type", func(t *testing.T) {
+		_, err := resolveResourceActionParameters([]appv1.ResourceActionParam{{Name: "foo", Type: "date"}}, []*applicationpkg.ResourceActionParameters{
+			actionParam("foo", "today"),
+		})
+		require.ErrorContains(t, err, `unsupported parameter type "date"`)
+	})
+}
+
+func TestExecuteResourceActionDefinition(t *testing.T) {
+	deploymentObj := createMockResource("Deployment", "test-deployment", 1)
+	action := appv1.ResourceActionDefinition{
+		Name: "scale",
+		ActionLua: `
+if type(actionParams["replicas"]) ~= "number" then
+  error("replicas is not a number")
+end
+obj.spec.replicas = actionParams["replicas"]
+return obj
+`,
+		Params: []appv1.ResourceActionParam{{Name: "replicas", Type: ActionParamTypeInteger, Default: "2"}},
+	}
+	vm := VM{}
+
+	t.Run("Parameter is passed with its declared type", func(t *testing.T) {
+		impactedResources, err := vm.ExecuteResourceActionDefinition(deploymentObj, action, []*applicationpkg.ResourceActionParameters{actionParam("replicas", "5")})
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		replicas, found, err := unstructured.NestedInt64(impactedResources[0].UnstructuredObj.Object, "spec", "replicas")
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, int64(5), replicas)
+	})
+
+	t.Run("Default is used when parameter is not supplied", func(t *testing.T) {
+		impactedResources, err := vm.ExecuteResourceActionDefinition(deploymentObj, action, nil)
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		replicas, _, err := unstructured.NestedInt64(impactedResources[0].UnstructuredObj.Object, "spec", "replicas")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), replicas)
+	})
+
+	t.Run("Invalid parameter is rejected", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionDefinition(deploymentObj, action, []*applicationpkg.ResourceActionParameters{actionParam("replicas", "many")})
+		require.ErrorContains(t, err, `invalid parameters for action "scale"`)
+	})
+}
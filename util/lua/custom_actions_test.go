@@ -169,7 +169,7 @@ func TestLuaResourceActionsScript(t *testing.T) {
 				}
 
 				require.NoError(t, err)
-				impactedResources, err := vm.ExecuteResourceAction(sourceObj, action.ActionLua, params)
+				impactedResources, err := vm.ExecuteResourceActionDefinition(sourceObj, action, params)
 
 				// Handle expected errors
 				if test.ExpectedErrorMessage != "" {
@@ -191,7 +191,7 @@ func TestLuaResourceActionsScript(t *testing.T) {
 						// Some resources' name is derived from the source object name, so the returned name is not actually equal to the testdata output name
 						// Considering the resource found in the testdata output if its name starts with source object name
 						// TODO: maybe this should use a normalizer function instead of hard-coding the resource specifics here
-						if (result.GetKind() == "Job" && sourceObj.GetKind() == "CronJob") || (result.GetKind() == "Workflow" && (sourceObj.GetKind() == "CronWorkflow" || sourceObj.GetKind() == "WorkflowTemplate")) {
+						if (result.GetKind() == "Job" && (sourceObj.GetKind() == "CronJob" || sourceObj.GetKind() == "Job")) || (result.GetKind() == "Workflow" && (sourceObj.GetKind() == "CronWorkflow" || sourceObj.GetKind() == "WorkflowTemplate")) {
 							return u.GroupVersionKind() == result.GroupVersionKind() && strings.HasPrefix(u.GetName(), sourceObj.GetName()) && u.GetNamespace() == result.GetNamespace()
 						}
 						return u.GroupVersionKind() == result.GroupVersionKind() && u.GetName() == result.GetName() && u.GetNamespace() == result.GetNamespace()
@@ -72,7 +72,7 @@ func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState,
 	return vm.runLuaWithResourceActionParameters(obj, script, nil)
 }
 
-func (vm VM) runLuaWithResourceActionParameters(obj *unstructured.Unstructured, script string, resourceActionParameters []*applicationpkg.ResourceActionParameters) (*lua.LState, error) {
+func (vm VM) runLuaWithResourceActionParameters(obj *unstructured.Unstructured, script string, resourceActionParameters map[string]any) (*lua.LState, error) {
 	l := lua.NewState(lua.Options{
 		SkipOpenLibs: !vm.UseOpenLibs,
 	})
@@ -105,9 +105,8 @@ func (vm VM) runLuaWithResourceActionParameters(obj *unstructured.Unstructured,
 
 	// Inject action parameters as a hash table global variable
 	actionParams := l.CreateTable(0, len(resourceActionParameters))
-	for _, resourceActionParameter := range resourceActionParameters {
-		value := decodeValue(l, resourceActionParameter.GetValue())
-		actionParams.RawSetH(lua.LString(resourceActionParameter.GetName()), value)
+	for name, value := range resourceActionParameters {
+		actionParams.RawSetH(lua.LString(name), decodeValue(l, value))
 	}
 	l.SetGlobal("actionParams", actionParams) // Set the actionParams table as a global variable
 
@@ -164,6 +163,42 @@ func (vm VM) ExecuteHealthLua(obj *unstructured.Unstructured, script string) (*h
 	return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
 }
 
+// ValidateResourceOverride compiles the health script and the action discovery and action scripts of the given resource
+// override, without running them
+func ValidateResourceOverride(override appv1.ResourceOverride) error {
+	if override.HealthLua != "" {
+		if err := compileScript(override.HealthLua); err != nil {
+			return fmt.Errorf("invalid health.lua: %w", err)
+		}
+	}
+	if override.Actions == "" {
+		return nil
+	}
+	actions, err := override.GetActions()
+	if err != nil {
+		return fmt.Errorf("invalid actions: %w", err)
+	}
+	if actions.ActionDiscoveryLua != "" {
+		if err := compileScript(actions.ActionDiscoveryLua); err != nil {
+			return fmt.Errorf("invalid discovery.lua: %w", err)
+		}
+	}
+	for _, definition := range actions.Definitions {
+		if err := compileScript(definition.ActionLua); err != nil {
+			return fmt.Errorf("invalid action.lua of action %s: %w", definition.Name, err)
+		}
+	}
+	return nil
+}
+
+// compileScript parses and compiles the given script
+func compileScript(script string) error {
+	l := lua.NewState(lua.Options{SkipOpenLibs: true})
+	defer l.Close()
+	_, err := l.LoadString(script)
+	return err
+}
+
 // GetHealthScript attempts to read lua script from config and then filesystem for that resource. If none exists, return
 // an empty string.
 func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (script string, useOpenLibs bool, err error) {
@@ -205,7 +240,45 @@ func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (script string, use
 }
 
 func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string, resourceActionParameters []*applicationpkg.ResourceActionParameters) ([]ImpactedResource, error) {
-	l, err := vm.runLuaWithResourceActionParameters(obj, script, resourceActionParameters)
+	actionParams := make(map[string]any, len(resourceActionParameters))
+	for _, resourceActionParameter := range resourceActionParameters {
+		actionParams[resourceActionParameter.GetName()] = resourceActionParameter.GetValue()
+	}
+	return vm.executeResourceAction(obj, script, actionParams)
+}
+
+// ExecuteResourceActionDefinition executes the given action after validating the supplied parameters against the
+// parameters declared by the action definition. Declared parameters are passed to the Lua script using their declared
+// type, and missing parameters are set to their default value.
+func (vm VM) ExecuteResourceActionDefinition(obj *unstructured.Unstructured, action appv1.ResourceActionDefinition, resourceActionParameters []*applicationpkg.ResourceActionParameters) ([]ImpactedResource, error) {
+	actionParams := make(map[string]any, len(resourceActionParameters))
+	if len(action.Params) == 0 {
+		for _, resourceActionParameter := range resourceActionParameters {
+			actionParams[resourceActionParameter.GetName()] = resourceActionParameter.GetValue()
+		}
+	} else {
+		var err error
+		actionParams, err = resolveResourceActionParameters(action.Params, resourceActionParameters)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parameters for action %q: %w", action.Name, err)
+		}
+	}
+
+	// Native actions have no Lua script
+	if action.ActionLua == "" {
+		natives, err := vm.getNativeActions(obj)
+		if err != nil {
+			return nil, err
+		}
+		if native, ok := natives[action.Name]; ok {
+			return native.run(obj, actionParams)
+		}
+	}
+	return vm.executeResourceAction(obj, action.ActionLua, actionParams)
+}
+
+func (vm VM) executeResourceAction(obj *unstructured.Unstructured, script string, actionParams map[string]any) ([]ImpactedResource, error) {
+	l, err := vm.runLuaWithResourceActionParameters(obj, script, actionParams)
 	if err != nil {
 		return nil, err
 	}
@@ -324,7 +397,11 @@ func cleanReturnedArray(newObj, obj []any) []any {
 }
 
 func (vm VM) ExecuteResourceActionDiscovery(obj *unstructured.Unstructured, scripts []string) ([]appv1.ResourceAction, error) {
-	if len(scripts) == 0 {
+	natives, err := vm.getNativeActions(obj)
+	if err != nil {
+		return nil, err
+	}
+	if len(scripts) == 0 && len(natives) == 0 {
 		return nil, errors.New("no action discovery script provided")
 	}
 	availableActionsMap := make(map[string]appv1.ResourceAction)
@@ -372,6 +449,17 @@ func (vm VM) ExecuteResourceActionDiscovery(obj *unstructured.Unstructured, scri
 		}
 	}
 
+	// Lua actions take precedence over the native actions with the same name
+	for name, native := range natives {
+		if _, exist := availableActionsMap[name]; !exist {
+			availableActionsMap[name] = native.discover(obj)
+		}
+	}
+
+	if err := vm.applyResourceActionDefinitions(obj, availableActionsMap); err != nil {
+		return nil, err
+	}
+
 	availableActions := make([]appv1.ResourceAction, 0, len(availableActionsMap))
 	for _, action := range availableActionsMap {
 		availableActions = append(availableActions, action)
@@ -380,6 +468,31 @@ func (vm VM) ExecuteResourceActionDiscovery(obj *unstructured.Unstructured, scri
 	return availableActions, nil
 }
 
+// applyResourceActionDefinitions copies the parameters and confirmation requirement declared by the configured action
+// definitions to the discovered actions, so that clients know about them before running the action.
+func (vm VM) applyResourceActionDefinitions(obj *unstructured.Unstructured, availableActionsMap map[string]appv1.ResourceAction) error {
+	override, ok := vm.ResourceOverrides[GetConfigMapKey(obj.GroupVersionKind())]
+	if !ok || override.Actions == "" {
+		return nil
+	}
+	actions, err := override.GetActions()
+	if err != nil {
+		return err
+	}
+	for _, definition := range actions.Definitions {
+		action, ok := availableActionsMap[definition.Name]
+		if !ok {
+			continue
+		}
+		if len(definition.Params) > 0 {
+			action.Params = definition.Params
+		}
+		action.RequiresConfirmation = action.RequiresConfirmation || definition.RequiresConfirmation
+		availableActionsMap[definition.Name] = action
+	}
+	return nil
+}
+
 // Actions are enabled by default
 func isActionDisabled(actionsMap any) bool {
 	actions, ok := actionsMap.(map[string]any)
@@ -459,6 +572,15 @@ func (vm VM) GetResourceAction(obj *unstructured.Unstructured, actionName string
 	actionKey := fmt.Sprintf("%s/actions/%s", key, actionName)
 	actionScript, err := vm.getPredefinedLuaScripts(actionKey, actionScriptFile)
 	if err != nil {
+		if errors.Is(err, errScriptDoesNotExist) {
+			natives, nativesErr := vm.getNativeActions(obj)
+			if nativesErr != nil {
+				return appv1.ResourceActionDefinition{}, nativesErr
+			}
+			if _, ok := natives[actionName]; ok {
+				return appv1.ResourceActionDefinition{Name: actionName}, nil
+			}
+		}
 		return appv1.ResourceActionDefinition{}, err
 	}
 
@@ -311,6 +311,26 @@ func TestGetResourceActionWithOverride(t *testing.T) {
 	assert.Equal(t, test, action)
 }
 
+func TestValidateResourceOverride(t *testing.T) {
+	require.NoError(t, ValidateResourceOverride(appv1.ResourceOverride{
+		HealthLua: "hs = {}\nhs.status = \"Healthy\"\nreturn hs",
+		Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+			ActionDiscoveryLua: validDiscoveryLua,
+			Definitions:        []appv1.ResourceActionDefinition{{Name: "test", ActionLua: "return obj"}},
+		})),
+	}))
+
+	err := ValidateResourceOverride(appv1.ResourceOverride{HealthLua: "hs = {"})
+	require.ErrorContains(t, err, "invalid health.lua")
+
+	err = ValidateResourceOverride(appv1.ResourceOverride{
+		Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+			Definitions: []appv1.ResourceActionDefinition{{Name: "restart", ActionLua: "return obj end"}},
+		})),
+	})
+	require.ErrorContains(t, err, "invalid action.lua of action restart")
+}
+
 func TestGetResourceActionDiscoveryPredefined(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	vm := VM{}
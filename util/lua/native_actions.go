@@ -0,0 +1,182 @@
+package lua
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// nativeAction is a built-in resource action implemented in Go rather than in Lua. Native actions are discovered and
+// run through the same API as the Lua actions, and a Lua action with the same name takes precedence over them.
+type nativeAction struct {
+	// discover returns the action as it should be presented for the given resource
+	discover func(obj *unstructured.Unstructured) appv1.ResourceAction
+	// run returns the resources impacted by the action
+	run func(obj *unstructured.Unstructured, params map[string]any) ([]ImpactedResource, error)
+}
+
+const (
+	restartedAtAnnotation     = "kubectl.kubernetes.io/restartedAt"
+	retryOfAnnotation         = "argocd.argoproj.io/retry-of"
+	lastAppliedAnnotation     = "kubectl.kubernetes.io/last-applied-configuration"
+	trackingIDAnnotation      = "argocd.argoproj.io/tracking-id"
+	maxResourceNameLength     = 63
+	retryJobNameSuffixPattern = "-retry-%s"
+)
+
+// Labels set by the Job controller, which must not be copied to a new Job
+var jobControllerLabels = []string{
+	"controller-uid",
+	"job-name",
+	"batch.kubernetes.io/controller-uid",
+	"batch.kubernetes.io/job-name",
+}
+
+var nativeActions = map[string]map[string]nativeAction{
+	"apps/Deployment":  {"restart": restartAction},
+	"apps/StatefulSet": {"restart": restartAction},
+	"apps/DaemonSet":   {"restart": restartAction},
+	"batch/CronJob": {
+		"suspend": {
+			discover: func(obj *unstructured.Unstructured) appv1.ResourceAction {
+				return appv1.ResourceAction{Name: "suspend", Disabled: isCronJobSuspended(obj), IconClass: "fa fa-fw fa-pause", DisplayName: "Suspend"}
+			},
+			run: func(obj *unstructured.Unstructured, _ map[string]any) ([]ImpactedResource, error) {
+				return setCronJobSuspended(obj, true)
+			},
+		},
+		"resume": {
+			discover: func(obj *unstructured.Unstructured) appv1.ResourceAction {
+				return appv1.ResourceAction{Name: "resume", Disabled: !isCronJobSuspended(obj), IconClass: "fa fa-fw fa-play", DisplayName: "Resume"}
+			},
+			run: func(obj *unstructured.Unstructured, _ map[string]any) ([]ImpactedResource, error) {
+				return setCronJobSuspended(obj, false)
+			},
+		},
+	},
+	"batch/Job": {
+		"retry": {
+			discover: func(obj *unstructured.Unstructured) appv1.ResourceAction {
+				return appv1.ResourceAction{Name: "retry", Disabled: !isJobFailed(obj), IconClass: "fa fa-fw fa-redo", DisplayName: "Retry"}
+			},
+			run: retryJob,
+		},
+	},
+}
+
+var restartAction = nativeAction{
+	discover: func(_ *unstructured.Unstructured) appv1.ResourceAction {
+		return appv1.ResourceAction{Name: "restart"}
+	},
+	run: func(obj *unstructured.Unstructured, _ map[string]any) ([]ImpactedResource, error) {
+		newObj := obj.DeepCopy()
+		err := unstructured.SetNestedField(newObj.Object, time.Now().UTC().Format(time.RFC3339), "spec", "template", "metadata", "annotations", restartedAtAnnotation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set restart annotation: %w", err)
+		}
+		return []ImpactedResource{{newObj, PatchOperation}}, nil
+	},
+}
+
+// getNativeActions returns the native actions available for the given resource. Native actions are hidden by a
+// resource action customization, unless it is configured to merge the built-in actions.
+func (vm VM) getNativeActions(obj *unstructured.Unstructured) (map[string]nativeAction, error) {
+	key := GetConfigMapKey(obj.GroupVersionKind())
+	override, ok := vm.ResourceOverrides[key]
+	if ok && override.Actions != "" {
+		actions, err := override.GetActions()
+		if err != nil {
+			return nil, err
+		}
+		if !actions.MergeBuiltinActions {
+			return nil, nil
+		}
+	}
+	return nativeActions[key], nil
+}
+
+// HasNativeResourceActions returns whether built-in actions implemented in Go are available for the given resource.
+func (vm VM) HasNativeResourceActions(obj *unstructured.Unstructured) (bool, error) {
+	actions, err := vm.getNativeActions(obj)
+	if err != nil {
+		return false, err
+	}
+	return len(actions) > 0, nil
+}
+
+func isCronJobSuspended(obj *unstructured.Unstructured) bool {
+	suspended, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend")
+	return suspended
+}
+
+func setCronJobSuspended(obj *unstructured.Unstructured, suspended bool) ([]ImpactedResource, error) {
+	newObj := obj.DeepCopy()
+	if err := unstructured.SetNestedField(newObj.Object, suspended, "spec", "suspend"); err != nil {
+		return nil, fmt.Errorf("failed to set suspend: %w", err)
+	}
+	return []ImpactedResource{{newObj, PatchOperation}}, nil
+}
+
+func isJobFailed(obj *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		if condition["type"] == "Failed" && condition["status"] == "True" {
+			return true
+		}
+	}
+	return false
+}
+
+// retryJob creates a copy of a failed Job. Since the Job spec is immutable, retrying a Job with a fresh backoff
+// counter requires a new Job, similarly to creating a Job from a CronJob.
+func retryJob(obj *unstructured.Unstructured, _ map[string]any) ([]ImpactedResource, error) {
+	spec, ok, err := unstructured.NestedMap(obj.Object, "spec")
+	if err != nil || !ok {
+		return nil, fmt.Errorf("job %s has no spec", obj.GetName())
+	}
+	// The selector and the pod template labels identifying the original Job are generated by the Job controller
+	delete(spec, "selector")
+	delete(spec, "manualSelector")
+	delete(spec, "suspend")
+	for _, label := range jobControllerLabels {
+		unstructured.RemoveNestedField(spec, "template", "metadata", "labels", label)
+	}
+
+	job := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	job.SetAPIVersion(obj.GetAPIVersion())
+	job.SetKind(obj.GetKind())
+	job.SetName(retryJobName(obj.GetName(), time.Now().UTC()))
+	job.SetNamespace(obj.GetNamespace())
+
+	labels := obj.GetLabels()
+	for _, label := range jobControllerLabels {
+		delete(labels, label)
+	}
+	job.SetLabels(labels)
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	delete(annotations, lastAppliedAnnotation)
+	delete(annotations, trackingIDAnnotation)
+	annotations[retryOfAnnotation] = obj.GetName()
+	job.SetAnnotations(annotations)
+
+	return []ImpactedResource{{job, CreateOperation}}, nil
+}
+
+func retryJobName(name string, now time.Time) string {
+	suffix := fmt.Sprintf(retryJobNameSuffixPattern, now.Format("0601021504"))
+	if len(name)+len(suffix) > maxResourceNameLength {
+		name = name[:maxResourceNameLength-len(suffix)]
+	}
+	return name + suffix
+}
@@ -0,0 +1,79 @@
+package lua
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestRetryJobName(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 0, 0, time.UTC)
+	assert.Equal(t, "hello-retry-2401020304", retryJobName("hello", now))
+
+	name := retryJobName(strings.Repeat("a", 63), now)
+	assert.Len(t, name, maxResourceNameLength)
+	assert.True(t, strings.HasSuffix(name, "-retry-2401020304"))
+}
+
+func TestNativeActions(t *testing.T) {
+	cronJob := StrToUnstructured(`
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: hello
+  namespace: default
+spec:
+  schedule: "* * * * *"
+`)
+
+	t.Run("Discovered without a discovery script", func(t *testing.T) {
+		vm := VM{}
+		actions, err := vm.ExecuteResourceActionDiscovery(cronJob, nil)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []appv1.ResourceAction{
+			{Name: "suspend", IconClass: "fa fa-fw fa-pause", DisplayName: "Suspend"},
+			{Name: "resume", Disabled: true, IconClass: "fa fa-fw fa-play", DisplayName: "Resume"},
+		}, actions)
+	})
+
+	t.Run("Run through the action definition", func(t *testing.T) {
+		vm := VM{}
+		action, err := vm.GetResourceAction(cronJob, "suspend")
+		require.NoError(t, err)
+		assert.Empty(t, action.ActionLua)
+		impactedResources, err := vm.ExecuteResourceActionDefinition(cronJob, action, nil)
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		assert.Equal(t, PatchOperation, impactedResources[0].K8SOperation)
+		assert.True(t, isCronJobSuspended(impactedResources[0].UnstructuredObj))
+		assert.False(t, isCronJobSuspended(cronJob), "the source object must not be modified")
+	})
+
+	t.Run("Hidden by a resource customization", func(t *testing.T) {
+		vm := VM{ResourceOverrides: map[string]appv1.ResourceOverride{
+			"batch/CronJob": {Actions: `
+discovery.lua: return {}
+`},
+		}}
+		hasNativeActions, err := vm.HasNativeResourceActions(cronJob)
+		require.NoError(t, err)
+		assert.False(t, hasNativeActions)
+	})
+
+	t.Run("Merged with a resource customization", func(t *testing.T) {
+		vm := VM{ResourceOverrides: map[string]appv1.ResourceOverride{
+			"batch/CronJob": {Actions: `
+mergeBuiltinActions: true
+discovery.lua: return {}
+`},
+		}}
+		hasNativeActions, err := vm.HasNativeResourceActions(cronJob)
+		require.NoError(t, err)
+		assert.True(t, hasNativeActions)
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/notification/expression/shared"
+	"github.com/argoproj/argo-cd/v3/util/security"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -107,6 +108,74 @@ func (_c *Service_GetAppDetails_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// GetAppProject provides a mock function for the type Service
+func (_mock *Service) GetAppProject(ctx context.Context, name string) (*v1alpha1.AppProject, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppProject")
+	}
+
+	var r0 *v1alpha1.AppProject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*v1alpha1.AppProject, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *v1alpha1.AppProject); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.AppProject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_GetAppProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppProject'
+type Service_GetAppProject_Call struct {
+	*mock.Call
+}
+
+// GetAppProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *Service_Expecter) GetAppProject(ctx interface{}, name interface{}) *Service_GetAppProject_Call {
+	return &Service_GetAppProject_Call{Call: _e.mock.On("GetAppProject", ctx, name)}
+}
+
+func (_c *Service_GetAppProject_Call) Run(run func(ctx context.Context, name string)) *Service_GetAppProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_GetAppProject_Call) Return(appProject *v1alpha1.AppProject, err error) *Service_GetAppProject_Call {
+	_c.Call.Return(appProject, err)
+	return _c
+}
+
+func (_c *Service_GetAppProject_Call) RunAndReturn(run func(ctx context.Context, name string) (*v1alpha1.AppProject, error)) *Service_GetAppProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCommitMetadata provides a mock function for the type Service
 func (_mock *Service) GetCommitMetadata(ctx context.Context, repoURL string, commitSHA string, project string) (*shared.CommitMetadata, error) {
 	ret := _mock.Called(ctx, repoURL, commitSHA, project)
@@ -186,3 +255,200 @@ func (_c *Service_GetCommitMetadata_Call) RunAndReturn(run func(ctx context.Cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetOutboundURLPolicy provides a mock function for the type Service
+func (_mock *Service) GetOutboundURLPolicy() (*security.OutboundURLPolicy, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutboundURLPolicy")
+	}
+
+	var r0 *security.OutboundURLPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*security.OutboundURLPolicy, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *security.OutboundURLPolicy); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*security.OutboundURLPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_GetOutboundURLPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOutboundURLPolicy'
+type Service_GetOutboundURLPolicy_Call struct {
+	*mock.Call
+}
+
+// GetOutboundURLPolicy is a helper method to define mock.On call
+func (_e *Service_Expecter) GetOutboundURLPolicy() *Service_GetOutboundURLPolicy_Call {
+	return &Service_GetOutboundURLPolicy_Call{Call: _e.mock.On("GetOutboundURLPolicy")}
+}
+
+func (_c *Service_GetOutboundURLPolicy_Call) Run(run func()) *Service_GetOutboundURLPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Service_GetOutboundURLPolicy_Call) Return(outboundURLPolicy *security.OutboundURLPolicy, err error) *Service_GetOutboundURLPolicy_Call {
+	_c.Call.Return(outboundURLPolicy, err)
+	return _c
+}
+
+func (_c *Service_GetOutboundURLPolicy_Call) RunAndReturn(run func() (*security.OutboundURLPolicy, error)) *Service_GetOutboundURLPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepoConnectionState provides a mock function for the type Service
+func (_mock *Service) GetRepoConnectionState(ctx context.Context, repoURL string, project string) (*v1alpha1.ConnectionState, error) {
+	ret := _mock.Called(ctx, repoURL, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepoConnectionState")
+	}
+
+	var r0 *v1alpha1.ConnectionState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.ConnectionState, error)); ok {
+		return returnFunc(ctx, repoURL, project)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.ConnectionState); ok {
+		r0 = returnFunc(ctx, repoURL, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ConnectionState)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, repoURL, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_GetRepoConnectionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepoConnectionState'
+type Service_GetRepoConnectionState_Call struct {
+	*mock.Call
+}
+
+// GetRepoConnectionState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoURL string
+//   - project string
+func (_e *Service_Expecter) GetRepoConnectionState(ctx interface{}, repoURL interface{}, project interface{}) *Service_GetRepoConnectionState_Call {
+	return &Service_GetRepoConnectionState_Call{Call: _e.mock.On("GetRepoConnectionState", ctx, repoURL, project)}
+}
+
+func (_c *Service_GetRepoConnectionState_Call) Run(run func(ctx context.Context, repoURL string, project string)) *Service_GetRepoConnectionState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_GetRepoConnectionState_Call) Return(connectionState *v1alpha1.ConnectionState, err error) *Service_GetRepoConnectionState_Call {
+	_c.Call.Return(connectionState, err)
+	return _c
+}
+
+func (_c *Service_GetRepoConnectionState_Call) RunAndReturn(run func(ctx context.Context, repoURL string, project string) (*v1alpha1.ConnectionState, error)) *Service_GetRepoConnectionState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetResourcesTree provides a mock function for the type Service
+func (_mock *Service) GetResourcesTree(ctx context.Context, app *v1alpha1.Application) (*v1alpha1.ApplicationTree, error) {
+	ret := _mock.Called(ctx, app)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetResourcesTree")
+	}
+
+	var r0 *v1alpha1.ApplicationTree
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application) (*v1alpha1.ApplicationTree, error)); ok {
+		return returnFunc(ctx, app)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application) *v1alpha1.ApplicationTree); ok {
+		r0 = returnFunc(ctx, app)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ApplicationTree)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1alpha1.Application) error); ok {
+		r1 = returnFunc(ctx, app)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_GetResourcesTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResourcesTree'
+type Service_GetResourcesTree_Call struct {
+	*mock.Call
+}
+
+// GetResourcesTree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - app *v1alpha1.Application
+func (_e *Service_Expecter) GetResourcesTree(ctx interface{}, app interface{}) *Service_GetResourcesTree_Call {
+	return &Service_GetResourcesTree_Call{Call: _e.mock.On("GetResourcesTree", ctx, app)}
+}
+
+func (_c *Service_GetResourcesTree_Call) Run(run func(ctx context.Context, app *v1alpha1.Application)) *Service_GetResourcesTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1alpha1.Application
+		if args[1] != nil {
+			arg1 = args[1].(*v1alpha1.Application)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_GetResourcesTree_Call) Return(applicationTree *v1alpha1.ApplicationTree, err error) *Service_GetResourcesTree_Call {
+	_c.Call.Return(applicationTree, err)
+	return _c
+}
+
+func (_c *Service_GetResourcesTree_Call) RunAndReturn(run func(ctx context.Context, app *v1alpha1.Application) (*v1alpha1.ApplicationTree, error)) *Service_GetResourcesTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -2,24 +2,42 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/argoproj/argo-cd/v3/util/notification/expression/shared"
 
 	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/security"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
 type Service interface {
 	GetCommitMetadata(ctx context.Context, repoURL string, commitSHA string, project string) (*shared.CommitMetadata, error)
 	GetAppDetails(ctx context.Context, app *v1alpha1.Application) (*shared.AppDetail, error)
+	GetOutboundURLPolicy() (*security.OutboundURLPolicy, error)
+	GetResourcesTree(ctx context.Context, app *v1alpha1.Application) (*v1alpha1.ApplicationTree, error)
+	GetRepoConnectionState(ctx context.Context, repoURL string, project string) (*v1alpha1.ConnectionState, error)
+	GetAppProject(ctx context.Context, name string) (*v1alpha1.AppProject, error)
 }
 
-func NewArgoCDService(clientset kubernetes.Interface, namespace string, repoClientset apiclient.Clientset) (*argoCDService, error) {
+// Cache is the cache of the resource trees of the applications, maintained by the application controller, and of the
+// connection states of the repositories, maintained by the API server
+type Cache interface {
+	GetAppResourcesTree(appName string, res *v1alpha1.ApplicationTree) error
+	GetRepoConnectionState(repo string, project string) (v1alpha1.ConnectionState, error)
+}
+
+// NewArgoCDService returns the service used by the notification expressions. The resource trees of the applications
+// and the connection states of the repositories are not available if the given cache is nil.
+func NewArgoCDService(clientset kubernetes.Interface, appClientset appclientset.Interface, namespace string, repoClientset apiclient.Clientset, cache Cache) (*argoCDService, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	settingsMgr := settings.NewSettingsManager(ctx, clientset, namespace)
 	closer, repoClient, err := repoClientset.NewRepoServerClient()
@@ -34,14 +52,16 @@ func NewArgoCDService(clientset kubernetes.Interface, namespace string, repoClie
 			log.Warnf("Failed to close repo server connection: %v", err)
 		}
 	}
-	return &argoCDService{settingsMgr: settingsMgr, namespace: namespace, repoServerClient: repoClient, dispose: dispose}, nil
+	return &argoCDService{settingsMgr: settingsMgr, appClientset: appClientset, namespace: namespace, repoServerClient: repoClient, cache: cache, dispose: dispose}, nil
 }
 
 type argoCDService struct {
 	clientset        kubernetes.Interface
+	appClientset     appclientset.Interface
 	namespace        string
 	settingsMgr      *settings.SettingsManager
 	repoServerClient apiclient.RepoServerServiceClient
+	cache            Cache
 	dispose          func()
 }
 
@@ -126,6 +146,51 @@ func (svc *argoCDService) GetAppDetails(ctx context.Context, app *v1alpha1.Appli
 	}, nil
 }
 
+// GetOutboundURLPolicy returns the policy of the URLs of the notification services, configured in argocd-cm.
+func (svc *argoCDService) GetOutboundURLPolicy() (*security.OutboundURLPolicy, error) {
+	return svc.settingsMgr.GetOutboundURLPolicy()
+}
+
+// GetResourcesTree returns the resource tree of the application, from the cache of the application controller
+func (svc *argoCDService) GetResourcesTree(_ context.Context, app *v1alpha1.Application) (*v1alpha1.ApplicationTree, error) {
+	if svc.cache == nil {
+		return nil, errors.New("the resource trees cache is not configured")
+	}
+	tree := &v1alpha1.ApplicationTree{}
+	if err := svc.cache.GetAppResourcesTree(app.InstanceName(svc.namespace), tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// GetRepoConnectionState returns the last connection state of the repository used by the given project, tested by the
+// API server. The repository scoped to the project takes precedence over the global one, and the state is unknown if
+// the connection was not tested recently.
+func (svc *argoCDService) GetRepoConnectionState(_ context.Context, repoURL string, project string) (*v1alpha1.ConnectionState, error) {
+	if svc.cache == nil {
+		return nil, errors.New("the repository connection states cache is not configured")
+	}
+	projects := []string{project}
+	if project != "" {
+		projects = append(projects, "")
+	}
+	for _, repoProject := range projects {
+		state, err := svc.cache.GetRepoConnectionState(repoURL, repoProject)
+		if err == nil {
+			return &state, nil
+		}
+		if !errors.Is(err, cacheutil.ErrCacheMiss) {
+			return nil, err
+		}
+	}
+	return &v1alpha1.ConnectionState{Status: v1alpha1.ConnectionStatusUnknown}, nil
+}
+
+// GetAppProject returns the project of the given name
+func (svc *argoCDService) GetAppProject(ctx context.Context, name string) (*v1alpha1.AppProject, error) {
+	return svc.appClientset.ArgoprojV1alpha1().AppProjects(svc.namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
 func (svc *argoCDService) Close() {
 	svc.dispose()
 }
@@ -0,0 +1,180 @@
+package cloudevents
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/argoproj/gitops-engine/pkg/utils/text"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/google/uuid"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/security"
+)
+
+const (
+	// ServiceType is the type of the CloudEvents notification service, configured in the service.cloudevents.<name>
+	// keys of the notifications ConfigMap
+	ServiceType = "cloudevents"
+	// SpecVersion is the version of the CloudEvents specification the events conform to
+	SpecVersion = "1.0"
+	// ContentType is the content type of the events sent in the structured content mode
+	ContentType = "application/cloudevents+json"
+	// DefaultSource is the source of the events if the service does not configure one
+	DefaultSource = "argocd"
+	// DefaultType is the type of the events if the service does not configure one
+	DefaultType = "io.argoproj.argocd.notification"
+	// defaultTimeout is the timeout of the requests sending the events
+	defaultTimeout = 30 * time.Second
+	// maxErrorBodySize is the maximum size of the response body reported when an event is rejected
+	maxErrorBodySize = 1024
+)
+
+// extensionNameRegex matches the valid names of the extension attributes, as defined by the CloudEvents specification
+var extensionNameRegex = regexp.MustCompile(`^[a-z0-9]{1,20}$`)
+
+// contextAttributes are the attributes defined by the CloudEvents specification, which cannot be used as extensions
+var contextAttributes = []string{"specversion", "id", "source", "type", "datacontenttype", "dataschema", "subject", "time", "data", "data_base64"}
+
+// Options are the options of a CloudEvents notification service
+type Options struct {
+	// URL is the URL the events are posted to, e.g. the URL of a Knative broker
+	URL string `json:"url"`
+	// Source is the source attribute of the events. Defaults to DefaultSource.
+	Source string `json:"source,omitempty"`
+	// Type is the type attribute of the events. Defaults to DefaultType.
+	Type string `json:"type,omitempty"`
+	// Extensions are the extension attributes of the events, per name. The values are templates rendered with the JSON
+	// data of the event and the recipient of the notification. The fields missing from the data render as an empty
+	// string, and the extensions rendered as an empty string are omitted.
+	Extensions map[string]string `json:"extensions,omitempty"`
+	// Headers are the additional headers of the requests
+	Headers []Header `json:"headers,omitempty"`
+	// InsecureSkipVerify disables the verification of the TLS certificate of the server
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// Header is a header of the requests sending the events
+type Header struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type service struct {
+	opts       Options
+	extensions map[string]*template.Template
+	client     *http.Client
+}
+
+// NewService returns a notification service which posts the notifications to the configured URL as CloudEvents, in the
+// structured content mode. The message of the notification is the data of the event: a message which is a valid JSON
+// document is sent as JSON data, the other messages as text data. The connections are checked against the given outbound
+// URL policy when they are established.
+func NewService(opts Options, policy *security.OutboundURLPolicy) (services.NotificationService, error) {
+	if opts.URL == "" {
+		return nil, fmt.Errorf("url of the %s notification service is required", ServiceType)
+	}
+	extensions := make(map[string]*template.Template, len(opts.Extensions))
+	for name, value := range opts.Extensions {
+		if !extensionNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("invalid extension name %q: names must consist of 1 to 20 lowercase letters or digits", name)
+		}
+		if slices.Contains(contextAttributes, name) {
+			return nil, fmt.Errorf("invalid extension name %q: the name is reserved by the CloudEvents specification", name)
+		}
+		tmpl, err := template.New(name).Funcs(sprig.TxtFuncMap()).Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the template of extension %s: %w", name, err)
+		}
+		extensions[name] = tmpl
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // explicitly configured by the user
+	}
+	return &service{
+		opts:       opts,
+		extensions: extensions,
+		client:     &http.Client{Transport: policy.WrapTransport(transport), Timeout: defaultTimeout},
+	}, nil
+}
+
+// Send posts the notification to the configured URL as a CloudEvent
+func (s *service) Send(notification services.Notification, dest services.Destination) error {
+	event, err := s.newEvent(notification.Message, dest.Recipient)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, s.opts.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	for _, header := range s.opts.Headers {
+		req.Header.Set(header.Name, header.Value)
+	}
+	req.Header.Set("Content-Type", ContentType)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send event to %s: %w", s.opts.URL, err)
+	}
+	defer utilio.Close(resp.Body)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return fmt.Errorf("event rejected by %s with status %d: %s", s.opts.URL, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return nil
+}
+
+// newEvent returns the CloudEvent of the given message, in the JSON format
+func (s *service) newEvent(message string, recipient string) (map[string]any, error) {
+	event := map[string]any{
+		"specversion": SpecVersion,
+		"id":          uuid.NewString(),
+		"source":      text.FirstNonEmpty(s.opts.Source, DefaultSource),
+		"type":        text.FirstNonEmpty(s.opts.Type, DefaultType),
+		"time":        time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	if recipient != "" {
+		event["subject"] = recipient
+	}
+
+	// the extensions of the events with text data are rendered with empty data
+	var data any = map[string]any{}
+	if trimmed := strings.TrimSpace(message); trimmed != "" && json.Valid([]byte(trimmed)) {
+		event["datacontenttype"] = "application/json"
+		event["data"] = json.RawMessage(trimmed)
+		if err := json.Unmarshal([]byte(trimmed), &data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event data: %w", err)
+		}
+	} else {
+		event["datacontenttype"] = "text/plain"
+		event["data"] = message
+	}
+
+	vars := map[string]any{"data": data, "recipient": recipient}
+	for name, tmpl := range s.extensions {
+		var value strings.Builder
+		if err := tmpl.Execute(&value, vars); err != nil {
+			return nil, fmt.Errorf("failed to render extension %s: %w", name, err)
+		}
+		// the fields missing from the data are rendered as "<no value>" by the templates
+		if value := strings.ReplaceAll(value.String(), "<no value>", ""); value != "" {
+			event[name] = value
+		}
+	}
+	return event, nil
+}
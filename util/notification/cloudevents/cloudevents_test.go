@@ -0,0 +1,119 @@
+package cloudevents
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/security"
+)
+
+func newTestServer(t *testing.T, status int, events *[]map[string]any) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, ContentType, r.Header.Get("Content-Type"))
+		assert.Equal(t, "secret", r.Header.Get("Authorization"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		var event map[string]any
+		assert.NoError(t, json.Unmarshal(body, &event))
+		*events = append(*events, event)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestSend(t *testing.T) {
+	var events []map[string]any
+	ts := newTestServer(t, http.StatusAccepted, &events)
+	service, err := NewService(Options{
+		URL:    ts.URL,
+		Source: "https://argocd.example.com",
+		Extensions: map[string]string{
+			"argocdapp":     "{{.data.app}}",
+			"argocdproject": "{{.data.project}}",
+		},
+		Headers: []Header{{Name: "Authorization", Value: "secret"}},
+	}, nil)
+	require.NoError(t, err)
+
+	t.Run("JSON", func(t *testing.T) {
+		events = nil
+		err := service.Send(services.Notification{Message: `{"app": "guestbook", "status": "Synced"}`}, services.Destination{Service: "router", Recipient: "sync"})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		event := events[0]
+		assert.Equal(t, SpecVersion, event["specversion"])
+		assert.NotEmpty(t, event["id"])
+		assert.NotEmpty(t, event["time"])
+		assert.Equal(t, "https://argocd.example.com", event["source"])
+		assert.Equal(t, DefaultType, event["type"])
+		assert.Equal(t, "sync", event["subject"])
+		assert.Equal(t, "application/json", event["datacontenttype"])
+		assert.Equal(t, map[string]any{"app": "guestbook", "status": "Synced"}, event["data"])
+		assert.Equal(t, "guestbook", event["argocdapp"])
+		// the extensions rendered as an empty string are omitted
+		assert.NotContains(t, event, "argocdproject")
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		events = nil
+		err := service.Send(services.Notification{Message: "Application guestbook is synced"}, services.Destination{Service: "router"})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		event := events[0]
+		assert.Equal(t, "text/plain", event["datacontenttype"])
+		assert.Equal(t, "Application guestbook is synced", event["data"])
+		assert.NotContains(t, event, "subject")
+		assert.NotContains(t, event, "argocdapp")
+	})
+}
+
+func TestSend_Rejected(t *testing.T) {
+	var events []map[string]any
+	ts := newTestServer(t, http.StatusBadRequest, &events)
+	service, err := NewService(Options{URL: ts.URL, Headers: []Header{{Name: "Authorization", Value: "secret"}}}, nil)
+	require.NoError(t, err)
+
+	err = service.Send(services.Notification{Message: "message"}, services.Destination{Service: "router"})
+	require.ErrorContains(t, err, "status 400")
+	assert.Len(t, events, 1)
+}
+
+func TestSend_DeniedByPolicy(t *testing.T) {
+	var events []map[string]any
+	ts := newTestServer(t, http.StatusAccepted, &events)
+	policy, err := security.NewOutboundURLPolicy(nil, nil, true)
+	require.NoError(t, err)
+	service, err := NewService(Options{URL: strings.Replace(ts.URL, "127.0.0.1", "localhost", 1)}, policy)
+	require.NoError(t, err)
+
+	// the IPs of the host names are checked when connecting
+	err = service.Send(services.Notification{Message: "message"}, services.Destination{Service: "router"})
+	require.ErrorContains(t, err, "is denied")
+	assert.Empty(t, events)
+}
+
+func TestNewService_Invalid(t *testing.T) {
+	for name, opts := range map[string]Options{
+		"MissingURL":         {},
+		"UppercaseExtension": {URL: "http://broker", Extensions: map[string]string{"argocdApp": "app"}},
+		"LongExtension":      {URL: "http://broker", Extensions: map[string]string{"argocdapplicationname": "app"}},
+		"ReservedExtension":  {URL: "http://broker", Extensions: map[string]string{"subject": "app"}},
+		"InvalidTemplate":    {URL: "http://broker", Extensions: map[string]string{"argocdapp": "{{.data.app"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewService(opts, nil)
+			require.Error(t, err)
+		})
+	}
+}
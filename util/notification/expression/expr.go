@@ -5,7 +5,9 @@ import (
 
 	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
 
+	"github.com/argoproj/argo-cd/v3/util/notification/expression/project"
 	"github.com/argoproj/argo-cd/v3/util/notification/expression/repo"
+	"github.com/argoproj/argo-cd/v3/util/notification/expression/resources"
 	"github.com/argoproj/argo-cd/v3/util/notification/expression/strings"
 	"github.com/argoproj/argo-cd/v3/util/notification/expression/time"
 )
@@ -31,6 +33,8 @@ func Spawn(app *unstructured.Unstructured, argocdService service.Service, vars m
 		clone[namespace] = helper
 	}
 	clone["repo"] = repo.NewExprs(argocdService, app)
+	clone["resources"] = resources.NewExprs(argocdService, app)
+	clone["project"] = project.NewExprs(argocdService, app)
 
 	return clone
 }
@@ -0,0 +1,60 @@
+package project
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	timeutil "github.com/argoproj/pkg/v2/time"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
+)
+
+// NewExprs returns the functions querying the project of the application
+func NewExprs(argocdService service.Service, obj *unstructured.Unstructured) map[string]any {
+	return map[string]any{
+		// GetExpiringGroupBindings returns the bindings of the groups to the roles of the project of the application
+		// which expire within the given duration, e.g. 7d, or have expired, soonest first
+		"GetExpiringGroupBindings": func(within string) []map[string]any {
+			bindings, err := getExpiringGroupBindings(argocdService, obj, within)
+			if err != nil {
+				panic(err)
+			}
+			return bindings
+		},
+	}
+}
+
+func getExpiringGroupBindings(argocdService service.Service, obj *unstructured.Unstructured, within string) ([]map[string]any, error) {
+	duration, err := timeutil.ParseDuration(within)
+	if err != nil {
+		return nil, err
+	}
+	projectName, _, err := unstructured.NestedString(obj.Object, "spec", "project")
+	if err != nil {
+		return nil, err
+	}
+	proj, err := argocdService.GetAppProject(context.Background(), projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	bindings := []map[string]any{}
+	for _, role := range proj.Spec.Roles {
+		for _, expiration := range role.GroupExpirationsBefore(now.Add(*duration)) {
+			bindings = append(bindings, map[string]any{
+				"role":      role.Name,
+				"group":     expiration.Group,
+				"expiresAt": expiration.ExpiresAt.UTC().Format(time.RFC3339),
+				"expired":   role.IsGroupExpired(expiration.Group, now),
+			})
+		}
+	}
+	// the expiry times in UTC and RFC 3339 sort chronologically
+	sort.SliceStable(bindings, func(i, j int) bool {
+		return bindings[i]["expiresAt"].(string) < bindings[j]["expiresAt"].(string)
+	})
+	return bindings, nil
+}
@@ -0,0 +1,78 @@
+package project
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/notification/argocd/mocks"
+)
+
+func newTestApp() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "guestbook", "namespace": "argocd"},
+		"spec":     map[string]any{"project": "default"},
+	}}
+}
+
+func TestGetExpiringGroupBindings(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	expired := metav1.NewTime(now.Add(-time.Hour))
+	soon := metav1.NewTime(now.Add(48 * time.Hour))
+	later := metav1.NewTime(now.Add(30 * 24 * time.Hour))
+
+	argocdService := mocks.NewService(t)
+	argocdService.EXPECT().GetAppProject(mock.Anything, "default").Return(&v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec: v1alpha1.AppProjectSpec{Roles: []v1alpha1.ProjectRole{{
+			Name:   "deployer",
+			Groups: []string{"my-org:team-a", "my-org:team-b"},
+			GroupExpirations: []v1alpha1.GroupExpiration{
+				{Group: "my-org:team-a", ExpiresAt: soon},
+				{Group: "my-org:team-b", ExpiresAt: later},
+			},
+		}, {
+			Name:             "admin",
+			Groups:           []string{"my-org:oncall"},
+			GroupExpirations: []v1alpha1.GroupExpiration{{Group: "my-org:oncall", ExpiresAt: expired}},
+		}}},
+	}, nil).Once()
+
+	exprs := NewExprs(argocdService, newTestApp())
+	bindings := exprs["GetExpiringGroupBindings"].(func(string) []map[string]any)("7d")
+
+	assert.Equal(t, []map[string]any{{
+		"role":      "admin",
+		"group":     "my-org:oncall",
+		"expiresAt": expired.Format(time.RFC3339),
+		"expired":   true,
+	}, {
+		"role":      "deployer",
+		"group":     "my-org:team-a",
+		"expiresAt": soon.Format(time.RFC3339),
+		"expired":   false,
+	}}, bindings)
+}
+
+func TestGetExpiringGroupBindings_Error(t *testing.T) {
+	argocdService := mocks.NewService(t)
+	argocdService.EXPECT().GetAppProject(mock.Anything, "default").Return(nil, errors.New("not found")).Once()
+
+	exprs := NewExprs(argocdService, newTestApp())
+	assert.Panics(t, func() {
+		exprs["GetExpiringGroupBindings"].(func(string) []map[string]any)("7d")
+	})
+}
+
+func TestGetExpiringGroupBindings_InvalidDuration(t *testing.T) {
+	exprs := NewExprs(mocks.NewService(t), newTestApp())
+	assert.Panics(t, func() {
+		exprs["GetExpiringGroupBindings"].(func(string) []map[string]any)("soon")
+	})
+}
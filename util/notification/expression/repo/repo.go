@@ -69,6 +69,15 @@ func getCommitMetadata(commitSHA string, app *unstructured.Unstructured, argocdS
 	return meta, nil
 }
 
+func getConnectionState(un *unstructured.Unstructured, argocdService service.Service) (*v1alpha1.ConnectionState, error) {
+	app, err := getApplication(un)
+	if err != nil {
+		return nil, err
+	}
+	source := app.Spec.GetSource()
+	return argocdService.GetRepoConnectionState(context.Background(), source.RepoURL, app.Spec.Project)
+}
+
 func FullNameByRepoURL(rawURL string) string {
 	parsed, err := giturls.Parse(rawURL)
 	if err != nil {
@@ -114,5 +123,13 @@ func NewExprs(argocdService service.Service, app *unstructured.Unstructured) map
 
 			return *appDetails
 		},
+		"GetConnectionState": func() any {
+			state, err := getConnectionState(app, argocdService)
+			if err != nil {
+				panic(err)
+			}
+
+			return *state
+		},
 	}
 }
@@ -0,0 +1,122 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
+)
+
+// resources gives access to the resources of an application. The resource tree of the application is loaded at most
+// once, and only if an expression references the resources.
+type resources struct {
+	argocdService service.Service
+	obj           *unstructured.Unstructured
+	load          func() []map[string]any
+}
+
+// NewExprs returns the functions querying the resources of the application: its managed resources and their children,
+// as found in the resource tree cached by the application controller. The managed resources of the application status
+// are used if the resource tree is not available, e.g. before the first reconciliation of the application.
+func NewExprs(argocdService service.Service, obj *unstructured.Unstructured) map[string]any {
+	r := &resources{argocdService: argocdService, obj: obj}
+	r.load = sync.OnceValue(r.loadResources)
+	return map[string]any{
+		// Get returns the resource of the given group, kind, namespace and name, or nil if the application has no such
+		// resource
+		"Get": func(group string, kind string, namespace string, name string) map[string]any {
+			for _, res := range r.load() {
+				if res["group"] == group && res["kind"] == kind && res["namespace"] == namespace && res["name"] == name {
+					return res
+				}
+			}
+			return nil
+		},
+		// Find returns the resources of the given group and kind
+		"Find": func(group string, kind string) []map[string]any {
+			var found []map[string]any
+			for _, res := range r.load() {
+				if res["group"] == group && res["kind"] == kind {
+					found = append(found, res)
+				}
+			}
+			return found
+		},
+		// List returns all the resources of the application
+		"List": func() []map[string]any {
+			return r.load()
+		},
+	}
+}
+
+func (r *resources) loadResources() []map[string]any {
+	var app v1alpha1.Application
+	if err := unstructuredToApplication(r.obj, &app); err != nil {
+		log.Warnf("Failed to convert application %s: %v", r.obj.GetName(), err)
+		return nil
+	}
+	// the versions of the managed resources may differ from the ones of the live resources of the tree
+	syncStatuses := map[v1alpha1.ResourceRef]v1alpha1.SyncStatusCode{}
+	for _, res := range app.Status.Resources {
+		syncStatuses[resourceRef(res.Group, "", res.Kind, res.Namespace, res.Name)] = res.Status
+	}
+
+	tree, err := r.argocdService.GetResourcesTree(context.Background(), &app)
+	if err != nil || tree == nil || len(tree.Nodes) == 0 {
+		if err != nil {
+			log.Debugf("Failed to get resource tree of application %s, using its managed resources: %v", app.Name, err)
+		}
+		items := make([]map[string]any, 0, len(app.Status.Resources))
+		for _, res := range app.Status.Resources {
+			items = append(items, newResource(resourceRef(res.Group, res.Version, res.Kind, res.Namespace, res.Name), res.Status, res.Health, nil))
+		}
+		return items
+	}
+
+	items := make([]map[string]any, 0, len(tree.Nodes))
+	for _, node := range tree.Nodes {
+		syncStatus := syncStatuses[resourceRef(node.Group, "", node.Kind, node.Namespace, node.Name)]
+		items = append(items, newResource(node.ResourceRef, syncStatus, node.Health, node.Info))
+	}
+	return items
+}
+
+func resourceRef(group, version, kind, namespace, name string) v1alpha1.ResourceRef {
+	return v1alpha1.ResourceRef{Group: group, Version: version, Kind: kind, Namespace: namespace, Name: name}
+}
+
+// newResource returns the representation of a resource in the expressions. The sync status is empty for the resources
+// which are not managed by the application.
+func newResource(ref v1alpha1.ResourceRef, syncStatus v1alpha1.SyncStatusCode, health *v1alpha1.HealthStatus, info []v1alpha1.InfoItem) map[string]any {
+	healthStatus := map[string]any{"status": "", "message": ""}
+	if health != nil {
+		healthStatus = map[string]any{"status": string(health.Status), "message": health.Message}
+	}
+	infoItems := map[string]any{}
+	for _, item := range info {
+		infoItems[item.Name] = item.Value
+	}
+	return map[string]any{
+		"group":      ref.Group,
+		"version":    ref.Version,
+		"kind":       ref.Kind,
+		"namespace":  ref.Namespace,
+		"name":       ref.Name,
+		"syncStatus": string(syncStatus),
+		"health":     healthStatus,
+		"info":       infoItems,
+	}
+}
+
+func unstructuredToApplication(obj *unstructured.Unstructured, app *v1alpha1.Application) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, app)
+}
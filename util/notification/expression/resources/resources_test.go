@@ -0,0 +1,89 @@
+package resources
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/notification/argocd/mocks"
+)
+
+func newTestApp(t *testing.T) *unstructured.Unstructured {
+	t.Helper()
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Status: v1alpha1.ApplicationStatus{
+			Resources: []v1alpha1.ResourceStatus{{
+				Group:     "argoproj.io",
+				Version:   "v1alpha1",
+				Kind:      "Rollout",
+				Namespace: "default",
+				Name:      "guestbook",
+				Status:    v1alpha1.SyncStatusCodeSynced,
+				Health:    &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing},
+			}},
+		},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(app)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func TestNewExprs_ResourcesTree(t *testing.T) {
+	argocdService := mocks.NewService(t)
+	argocdService.EXPECT().GetResourcesTree(mock.Anything, mock.Anything).Return(&v1alpha1.ApplicationTree{
+		Nodes: []v1alpha1.ResourceNode{{
+			ResourceRef: v1alpha1.ResourceRef{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout", Namespace: "default", Name: "guestbook"},
+			Health:      &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded, Message: "ProgressDeadlineExceeded"},
+			Info:        []v1alpha1.InfoItem{{Name: "Phase", Value: "Degraded"}},
+		}, {
+			ResourceRef: v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "ReplicaSet", Namespace: "default", Name: "guestbook-5d8f"},
+			Health:      &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy},
+		}},
+	}, nil).Once()
+
+	exprs := NewExprs(argocdService, newTestApp(t))
+
+	rollout := exprs["Get"].(func(string, string, string, string) map[string]any)("argoproj.io", "Rollout", "default", "guestbook")
+	require.NotNil(t, rollout)
+	assert.Equal(t, "Synced", rollout["syncStatus"])
+	assert.Equal(t, map[string]any{"status": "Degraded", "message": "ProgressDeadlineExceeded"}, rollout["health"])
+	assert.Equal(t, map[string]any{"Phase": "Degraded"}, rollout["info"])
+
+	replicaSets := exprs["Find"].(func(string, string) []map[string]any)("apps", "ReplicaSet")
+	require.Len(t, replicaSets, 1)
+	assert.Equal(t, "guestbook-5d8f", replicaSets[0]["name"])
+	// the resources which are not managed by the application have no sync status
+	assert.Empty(t, replicaSets[0]["syncStatus"])
+
+	assert.Nil(t, exprs["Get"].(func(string, string, string, string) map[string]any)("apps", "Deployment", "default", "guestbook"))
+	// the resource tree is loaded once
+	assert.Len(t, exprs["List"].(func() []map[string]any)(), 2)
+}
+
+func TestNewExprs_ManagedResources(t *testing.T) {
+	argocdService := mocks.NewService(t)
+	argocdService.EXPECT().GetResourcesTree(mock.Anything, mock.Anything).Return(nil, errors.New("cache miss")).Once()
+
+	exprs := NewExprs(argocdService, newTestApp(t))
+
+	resources := exprs["List"].(func() []map[string]any)()
+	require.Len(t, resources, 1)
+	assert.Equal(t, "Rollout", resources[0]["kind"])
+	assert.Equal(t, "Synced", resources[0]["syncStatus"])
+	assert.Equal(t, map[string]any{"status": "Progressing", "message": ""}, resources[0]["health"])
+}
+
+func TestNewExprs_Lazy(t *testing.T) {
+	argocdService := mocks.NewService(t)
+	NewExprs(argocdService, newTestApp(t))
+	argocdService.AssertNotCalled(t, "GetResourcesTree", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,98 @@
+package settings
+
+import (
+	"strings"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/externalsecrets"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
+)
+
+// projectServicePrefix is the prefix of the configuration keys of the project-scoped notification services, i.e.
+// project.<project>.service.<type>.<name> or project.<project>.service.<type>
+const projectServicePrefix = "project."
+
+// ProjectServiceName returns the name the project-scoped notification service of the given project and name is
+// registered with. The applications of the project subscribing to the service of the given name are sent the
+// notifications with the project-scoped service instead.
+func ProjectServiceName(project, name string) string {
+	return project + "/" + name
+}
+
+// parseProjectServiceKey returns the project and the service key, i.e. service.<type>.<name> or service.<type>, of the
+// configuration key of a project-scoped notification service
+func parseProjectServiceKey(key string) (string, string, bool) {
+	if !strings.HasPrefix(key, projectServicePrefix) {
+		return "", "", false
+	}
+	// the names of the projects may contain dots, unlike the types and names of the services
+	i := strings.LastIndex(key, ".service.")
+	if i <= len(projectServicePrefix) {
+		return "", "", false
+	}
+	return key[len(projectServicePrefix):i], key[i+1:], true
+}
+
+// applyProjectServices registers the project-scoped notification services, configured in the
+// project.<project>.service.<type>.<name> keys, which only the applications of the project can send notifications with
+func applyProjectServices(argocdService service.Service, cfg *api.Config, configMap *corev1.ConfigMap, secret *corev1.Secret, getResolver func() (*externalsecrets.Resolver, error)) {
+	for key, value := range configMap.Data {
+		project, serviceKey, ok := parseProjectServiceKey(key)
+		if !ok {
+			continue
+		}
+		registerResolvedService(argocdService, cfg, ProjectServiceName(project, serviceName(serviceKey)), serviceType(serviceKey), value, secret, getResolver)
+	}
+}
+
+// RestrictDestinations returns the destinations the application of the given project subscribed to, without the
+// services and triggers the notification allowlist of the project does not permit, and with the project-scoped services
+// applied.
+func RestrictDestinations(project string, allowlist *v1alpha1.NotificationAllowlist, destinations services.Destinations, cfg api.Config) services.Destinations {
+	if allowlist == nil {
+		allowlist = &v1alpha1.NotificationAllowlist{}
+	}
+	res := services.Destinations{}
+	for trigger, triggerDestinations := range destinations {
+		if len(allowlist.Triggers) > 0 && !glob.MatchStringInList(allowlist.Triggers, trigger, glob.GLOB) {
+			log.Warnf("Notification trigger %s is not permitted in project %s", trigger, project)
+			continue
+		}
+		for _, dest := range triggerDestinations {
+			name := strings.TrimPrefix(dest.Service, ProjectServiceName(project, ""))
+			if len(allowlist.Services) > 0 && !glob.MatchStringInList(allowlist.Services, name, glob.GLOB) {
+				log.Warnf("Notification service %s is not permitted in project %s", name, project)
+				continue
+			}
+			res[trigger] = append(res[trigger], dest)
+		}
+	}
+	return ScopeDestinations(project, res, cfg)
+}
+
+// ScopeDestinations returns the given destinations of an application of the given project with the services overridden
+// by project-scoped services of the project replaced with these, and without the project-scoped services of the other
+// projects.
+func ScopeDestinations(project string, destinations services.Destinations, cfg api.Config) services.Destinations {
+	res := services.Destinations{}
+	for trigger, triggerDestinations := range destinations {
+		for _, dest := range triggerDestinations {
+			if strings.Contains(dest.Service, "/") {
+				if !strings.HasPrefix(dest.Service, ProjectServiceName(project, "")) {
+					log.Warnf("Notification service %s is not permitted in project %s", dest.Service, project)
+					continue
+				}
+			} else if _, ok := cfg.Services[ProjectServiceName(project, dest.Service)]; ok {
+				dest.Service = ProjectServiceName(project, dest.Service)
+			}
+			res[trigger] = append(res[trigger], dest)
+		}
+	}
+	return res
+}
@@ -0,0 +1,129 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	servicemocks "github.com/argoproj/argo-cd/v3/util/notification/argocd/mocks"
+	"github.com/argoproj/argo-cd/v3/util/security"
+)
+
+func TestParseProjectServiceKey(t *testing.T) {
+	project, serviceKey, ok := parseProjectServiceKey("project.team-a.service.slack")
+	assert.True(t, ok)
+	assert.Equal(t, "team-a", project)
+	assert.Equal(t, "service.slack", serviceKey)
+
+	project, serviceKey, ok = parseProjectServiceKey("project.team.a.service.webhook.github")
+	assert.True(t, ok)
+	assert.Equal(t, "team.a", project)
+	assert.Equal(t, "service.webhook.github", serviceKey)
+
+	_, _, ok = parseProjectServiceKey("service.slack")
+	assert.False(t, ok)
+	_, _, ok = parseProjectServiceKey("project.service.slack")
+	assert.False(t, ok)
+}
+
+func TestApplyProjectServices(t *testing.T) {
+	policy, err := security.NewOutboundURLPolicy([]string{"*.example.com"}, nil, false)
+	require.NoError(t, err)
+	argocdService := &servicemocks.Service{}
+	argocdService.EXPECT().GetOutboundURLPolicy().Return(policy, nil)
+	configMap := corev1.ConfigMap{
+		Data: map[string]string{
+			"project.team-a.service.webhook.github": "url: $team-a-webhook-url",
+			"project.team-b.service.slack":          "token: $team-b-slack-token",
+			"project.team-b.service.webhook.denied": "url: https://hooks.example.org",
+			"service.slack":                         "token: $slack-token",
+		},
+	}
+	secret := corev1.Secret{
+		Data: map[string][]byte{
+			"team-a-webhook-url": []byte("https://hooks.example.com/team-a"),
+		},
+	}
+	cfg := api.Config{}
+
+	applyProjectServices(argocdService, &cfg, &configMap, &secret, newResolverGetter(&configMap, &secret))
+
+	assert.Len(t, cfg.Services, 3)
+	require.Contains(t, cfg.Services, "team-a/github")
+	svc, err := cfg.Services["team-a/github"]()
+	require.NoError(t, err)
+	_, err = svc.(*resolvedService).getService(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "url: https://hooks.example.com/team-a\n", svc.(*resolvedService).resolvedConfig)
+
+	assert.Contains(t, cfg.Services, "team-b/slack")
+	require.Contains(t, cfg.Services, "team-b/denied")
+	svc, err = cfg.Services["team-b/denied"]()
+	require.NoError(t, err)
+	_, err = svc.(*resolvedService).getService(t.Context())
+	require.Error(t, err)
+}
+
+func TestRestrictDestinations(t *testing.T) {
+	cfg := api.Config{Services: map[string]api.ServiceFactory{
+		"slack":        nil,
+		"webhook":      nil,
+		"team-a/slack": nil,
+		"team-b/slack": nil,
+	}}
+	destinations := services.Destinations{
+		"on-sync-succeeded": []services.Destination{
+			{Service: "slack", Recipient: "team-a"},
+			{Service: "webhook", Recipient: "github"},
+			{Service: "team-b/slack", Recipient: "team-b"},
+		},
+		"on-health-degraded": []services.Destination{
+			{Service: "team-a/slack", Recipient: "team-a"},
+		},
+	}
+
+	t.Run("Unrestricted", func(t *testing.T) {
+		assert.Equal(t, services.Destinations{
+			"on-sync-succeeded": []services.Destination{
+				{Service: "team-a/slack", Recipient: "team-a"},
+				{Service: "webhook", Recipient: "github"},
+			},
+			"on-health-degraded": []services.Destination{
+				{Service: "team-a/slack", Recipient: "team-a"},
+			},
+		}, RestrictDestinations("team-a", nil, destinations, cfg))
+	})
+
+	t.Run("Restricted", func(t *testing.T) {
+		assert.Equal(t, services.Destinations{
+			"on-health-degraded": []services.Destination{
+				{Service: "team-a/slack", Recipient: "team-a"},
+			},
+		}, RestrictDestinations("team-a", &v1alpha1.NotificationAllowlist{
+			Services: []string{"slack"},
+			Triggers: []string{"on-health-*"},
+		}, destinations, cfg))
+	})
+
+	t.Run("ServicesOnly", func(t *testing.T) {
+		assert.Equal(t, services.Destinations{
+			"on-sync-succeeded": []services.Destination{
+				{Service: "webhook", Recipient: "github"},
+			},
+		}, RestrictDestinations("team-a", &v1alpha1.NotificationAllowlist{Services: []string{"web*"}}, destinations, cfg))
+	})
+
+	t.Run("OtherProject", func(t *testing.T) {
+		assert.Equal(t, services.Destinations{
+			"on-sync-succeeded": []services.Destination{
+				{Service: "slack", Recipient: "team-a"},
+				{Service: "webhook", Recipient: "github"},
+			},
+		}, RestrictDestinations("team-c", nil, destinations, cfg))
+	})
+}
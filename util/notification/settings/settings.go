@@ -1,15 +1,24 @@
 package settings
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
 
 	"github.com/argoproj/notifications-engine/pkg/api"
 	"github.com/argoproj/notifications-engine/pkg/services"
+	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/yaml"
 
+	"github.com/argoproj/argo-cd/v3/util/externalsecrets"
+	"github.com/argoproj/argo-cd/v3/util/notification/cloudevents"
 	"github.com/argoproj/argo-cd/v3/util/notification/expression"
+	"github.com/argoproj/argo-cd/v3/util/security"
 
 	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
 )
@@ -58,11 +67,324 @@ func getContext(cfg *api.Config, configMap *corev1.ConfigMap, secret *corev1.Sec
 	return context, nil
 }
 
+// secretRefPattern matches the references to the keys of the notifications secret in the service configurations, like
+// the notifications engine does
+var secretRefPattern = regexp.MustCompile(`[$][\w-_]+`)
+
+// resolveSecretRefs replaces the references to the keys of the secret, e.g. $webhook-url, with their values. The
+// references to missing keys are kept.
+func resolveSecretRefs(value string, secret *corev1.Secret) string {
+	return secretRefPattern.ReplaceAllStringFunc(value, func(ref string) string {
+		if secret == nil {
+			return ref
+		}
+		secretValue, ok := secret.Data[ref[1:]]
+		if !ok {
+			return ref
+		}
+		return string(secretValue)
+	})
+}
+
+// getOutboundURLPolicy returns the outbound URL policy of Argo CD. The instance metadata services are still denied if
+// the policy cannot be loaded.
+func getOutboundURLPolicy(argocdService service.Service) *security.OutboundURLPolicy {
+	policy, err := argocdService.GetOutboundURLPolicy()
+	if err != nil {
+		log.Warnf("Failed to get outbound URL policy: %v", err)
+	}
+	return policy
+}
+
+// applyOutboundURLPolicy removes the notification services whose URLs are denied by the outbound URL policy of Argo CD.
+// The URLs are checked once the references to the secret, e.g. $webhook-url, are resolved. The services of the
+// notifications engine are also wrapped so that the IPs of their URLs are checked whenever a notification is sent.
+func applyOutboundURLPolicy(cfg *api.Config, configMap *corev1.ConfigMap, secret *corev1.Secret, policy *security.OutboundURLPolicy) {
+	for key, value := range configMap.Data {
+		// the URLs of the services referencing the secrets of the external backends are checked once these are resolved
+		if !strings.HasPrefix(key, "service.") || externalsecrets.HasRefs(value) {
+			continue
+		}
+		var serviceConfig any
+		if err := yaml.Unmarshal([]byte(value), &serviceConfig); err != nil {
+			// invalid configurations are reported by the notifications engine
+			continue
+		}
+		name := serviceName(key)
+		urls := findURLs(serviceConfig, secret)
+		denied := false
+		for _, rawURL := range urls {
+			if err := policy.ValidateURL(rawURL); err != nil {
+				log.Errorf("Notification service %s is disabled: %v", name, err)
+				delete(cfg.Services, name)
+				denied = true
+				break
+			}
+		}
+		// the connections of the CloudEvents services are checked by their own HTTP clients
+		factory, ok := cfg.Services[name]
+		if denied || !ok || serviceType(key) == cloudevents.ServiceType {
+			continue
+		}
+		cfg.Services[name] = func() (services.NotificationService, error) {
+			svc, err := factory()
+			if err != nil || svc == nil {
+				return svc, err
+			}
+			return newOutboundURLPolicyService(svc, policy, urls), nil
+		}
+	}
+}
+
+// outboundURLPolicyService is a notification service of the notifications engine whose URLs are checked against the
+// outbound URL policy whenever a notification is sent. The engine creates the HTTP clients of its services, whose
+// connections cannot be checked when they are established, so the IPs the host names resolve to are checked before
+// sending the notifications instead.
+type outboundURLPolicyService struct {
+	services.NotificationService
+	policy *security.OutboundURLPolicy
+	urls   []string
+}
+
+func newOutboundURLPolicyService(svc services.NotificationService, policy *security.OutboundURLPolicy, urls []string) services.NotificationService {
+	if len(urls) == 0 {
+		return svc
+	}
+	return &outboundURLPolicyService{NotificationService: svc, policy: policy, urls: urls}
+}
+
+func (s *outboundURLPolicyService) Send(notification services.Notification, dest services.Destination) error {
+	for _, rawURL := range s.urls {
+		if err := s.policy.ValidateResolvedURL(context.Background(), rawURL); err != nil {
+			return fmt.Errorf("notification not sent: %w", err)
+		}
+	}
+	return s.NotificationService.Send(notification, dest)
+}
+
+// applyCloudEventsServices registers the CloudEvents notification services, configured in the service.cloudevents
+// and service.cloudevents.<name> keys. The CloudEvents services are provided by Argo CD rather than by the notifications
+// engine, which does not know this service type.
+func applyCloudEventsServices(cfg *api.Config, configMap *corev1.ConfigMap, secret *corev1.Secret, policy *security.OutboundURLPolicy) {
+	for key, value := range configMap.Data {
+		parts := strings.SplitN(key, ".", 3)
+		if len(parts) < 2 || parts[0] != "service" || parts[1] != cloudevents.ServiceType {
+			continue
+		}
+		if cfg.Services == nil {
+			cfg.Services = map[string]api.ServiceFactory{}
+		}
+		name := serviceName(key)
+		var opts cloudevents.Options
+		if err := yaml.Unmarshal([]byte(resolveSecretRefs(value, secret)), &opts); err != nil {
+			cfg.Services[name] = func() (services.NotificationService, error) {
+				return nil, fmt.Errorf("failed to parse the configuration of notification service %s: %w", name, err)
+			}
+			continue
+		}
+		cfg.Services[name] = func() (services.NotificationService, error) {
+			return cloudevents.NewService(opts, policy)
+		}
+	}
+}
+
+// serviceName returns the name of the service of the configuration key, i.e. service.<type>.<name> or service.<type>.
+func serviceName(key string) string {
+	parts := strings.SplitN(strings.TrimPrefix(key, "service."), ".", 2)
+	return parts[len(parts)-1]
+}
+
+// serviceType returns the type of the service of the configuration key, i.e. service.<type>.<name> or service.<type>.
+func serviceType(key string) string {
+	return strings.SplitN(strings.TrimPrefix(key, "service."), ".", 2)[0]
+}
+
+// applyExternalSecretServices replaces the notification services whose configurations reference the secrets of the
+// external secret backends, e.g. ${vault:secret/data/notifications#slack-token}, with services resolving these
+// references when the notifications are sent. The backends are configured in the secretBackends key.
+func applyExternalSecretServices(argocdService service.Service, cfg *api.Config, configMap *corev1.ConfigMap, secret *corev1.Secret, getResolver func() (*externalsecrets.Resolver, error)) {
+	for key, value := range configMap.Data {
+		if !strings.HasPrefix(key, "service.") || !externalsecrets.HasRefs(value) {
+			continue
+		}
+		registerResolvedService(argocdService, cfg, serviceName(key), serviceType(key), value, secret, getResolver)
+	}
+}
+
+// newResolverGetter returns a function creating the resolver of the secrets of the external backends configured in
+// the secretBackends key on first use
+func newResolverGetter(configMap *corev1.ConfigMap, secret *corev1.Secret) func() (*externalsecrets.Resolver, error) {
+	return sync.OnceValues(func() (*externalsecrets.Resolver, error) {
+		return externalsecrets.NewResolverFromConfig(configMap.Data[externalsecrets.ConfigKey], func(value string) string {
+			return resolveSecretRefs(value, secret)
+		})
+	})
+}
+
+// registerResolvedService registers the notification service of the given name, type and configuration, whose
+// configuration is resolved when the notifications are sent
+func registerResolvedService(argocdService service.Service, cfg *api.Config, name, typ, value string, secret *corev1.Secret, getResolver func() (*externalsecrets.Resolver, error)) {
+	if cfg.Services == nil {
+		cfg.Services = map[string]api.ServiceFactory{}
+	}
+	var serviceConfig any
+	err := yaml.Unmarshal([]byte(value), &serviceConfig)
+	var resolver *externalsecrets.Resolver
+	if err == nil && externalsecrets.HasRefs(value) {
+		resolver, err = getResolver()
+	}
+	if err != nil {
+		cfg.Services[name] = func() (services.NotificationService, error) {
+			return nil, fmt.Errorf("failed to configure notification service %s: %w", name, err)
+		}
+		return
+	}
+	svc := &resolvedService{
+		serviceType:   typ,
+		config:        serviceConfig,
+		secret:        secret,
+		resolver:      resolver,
+		argocdService: argocdService,
+	}
+	cfg.Services[name] = func() (services.NotificationService, error) {
+		return svc, nil
+	}
+}
+
+// resolvedService is a notification service whose configuration is resolved when the notifications are sent, e.g.
+// because it references the secrets of the external secret backends. The service is created again whenever the values
+// of the secrets change, e.g. when they are rotated, once their cached values expire.
+type resolvedService struct {
+	serviceType   string
+	config        any
+	secret        *corev1.Secret
+	resolver      *externalsecrets.Resolver
+	argocdService service.Service
+
+	lock           sync.Mutex
+	resolvedConfig string
+	service        services.NotificationService
+}
+
+func (s *resolvedService) Send(notification services.Notification, dest services.Destination) error {
+	svc, err := s.getService(context.Background())
+	if err != nil {
+		return err
+	}
+	return svc.Send(notification, dest)
+}
+
+// getService returns the service of the configuration resolved with the current values of the secrets
+func (s *resolvedService) getService(ctx context.Context) (services.NotificationService, error) {
+	resolved, err := s.resolve(ctx, s.config)
+	if err != nil {
+		return nil, err
+	}
+	config, err := yaml.Marshal(resolved)
+	if err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.service != nil && string(config) == s.resolvedConfig {
+		return s.service, nil
+	}
+	policy := getOutboundURLPolicy(s.argocdService)
+	urls := findURLs(resolved, nil)
+	for _, rawURL := range urls {
+		if err := policy.ValidateURL(rawURL); err != nil {
+			return nil, err
+		}
+	}
+	var svc services.NotificationService
+	if s.serviceType == cloudevents.ServiceType {
+		var opts cloudevents.Options
+		if err := yaml.Unmarshal(config, &opts); err != nil {
+			return nil, err
+		}
+		svc, err = cloudevents.NewService(opts, policy)
+	} else {
+		svc, err = services.NewService(s.serviceType, config)
+		if err == nil {
+			svc = newOutboundURLPolicyService(svc, policy, urls)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.service = svc
+	s.resolvedConfig = string(config)
+	return svc, nil
+}
+
+// resolve returns the given value of the configuration with the references to the notifications secret and to the
+// secrets of the external backends replaced with their values. The strings are resolved one by one, so that the values
+// of the secrets cannot alter the structure of the configuration.
+func (s *resolvedService) resolve(ctx context.Context, value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		if s.resolver == nil {
+			return resolveSecretRefs(v, s.secret), nil
+		}
+		return s.resolver.Resolve(ctx, resolveSecretRefs(v, s.secret))
+	case map[string]any:
+		resolved := make(map[string]any, len(v))
+		for key, item := range v {
+			resolvedItem, err := s.resolve(ctx, item)
+			if err != nil {
+				return nil, err
+			}
+			resolved[key] = resolvedItem
+		}
+		return resolved, nil
+	case []any:
+		resolved := make([]any, len(v))
+		for i, item := range v {
+			resolvedItem, err := s.resolve(ctx, item)
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = resolvedItem
+		}
+		return resolved, nil
+	default:
+		return v, nil
+	}
+}
+
+// findURLs returns the HTTP(S) URLs of the given configuration, with the references to the secret resolved.
+func findURLs(value any, secret *corev1.Secret) []string {
+	var urls []string
+	switch v := value.(type) {
+	case string:
+		v = resolveSecretRefs(v, secret)
+		if strings.HasPrefix(v, "http://") || strings.HasPrefix(v, "https://") {
+			urls = append(urls, v)
+		}
+	case map[string]any:
+		for _, item := range v {
+			urls = append(urls, findURLs(item, secret)...)
+		}
+	case []any:
+		for _, item := range v {
+			urls = append(urls, findURLs(item, secret)...)
+		}
+	}
+	return urls
+}
+
 func initGetVarsWithoutSecret(argocdService service.Service, cfg *api.Config, configMap *corev1.ConfigMap, secret *corev1.Secret) (api.GetVars, error) {
 	context, err := getContext(cfg, configMap, secret)
 	if err != nil {
 		return nil, err
 	}
+	policy := getOutboundURLPolicy(argocdService)
+	applyCloudEventsServices(cfg, configMap, secret, policy)
+	applyOutboundURLPolicy(cfg, configMap, secret, policy)
+	getResolver := newResolverGetter(configMap, secret)
+	applyExternalSecretServices(argocdService, cfg, configMap, secret, getResolver)
+	applyProjectServices(argocdService, cfg, configMap, secret, getResolver)
 
 	return func(obj map[string]any, dest services.Destination) map[string]any {
 		return expression.Spawn(&unstructured.Unstructured{Object: obj}, argocdService, map[string]any{
@@ -77,6 +399,12 @@ func initGetVars(argocdService service.Service, cfg *api.Config, configMap *core
 	if err != nil {
 		return nil, err
 	}
+	policy := getOutboundURLPolicy(argocdService)
+	applyCloudEventsServices(cfg, configMap, secret, policy)
+	applyOutboundURLPolicy(cfg, configMap, secret, policy)
+	getResolver := newResolverGetter(configMap, secret)
+	applyExternalSecretServices(argocdService, cfg, configMap, secret, getResolver)
+	applyProjectServices(argocdService, cfg, configMap, secret, getResolver)
 
 	return func(obj map[string]any, dest services.Destination) map[string]any {
 		return expression.Spawn(&unstructured.Unstructured{Object: obj}, argocdService, map[string]any{
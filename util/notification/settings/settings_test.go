@@ -2,6 +2,9 @@ package settings
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/argoproj/notifications-engine/pkg/api"
@@ -12,8 +15,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
+	appclientsetfake "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
 	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
+	servicemocks "github.com/argoproj/argo-cd/v3/util/notification/argocd/mocks"
+	"github.com/argoproj/argo-cd/v3/util/security"
 )
 
 const (
@@ -59,7 +65,7 @@ func TestInitGetVars(t *testing.T) {
 			Data: notificationsSecret.Data,
 		})
 	mockRepoClient := &mocks.Clientset{RepoServerServiceClient: &mocks.RepoServerServiceClient{}}
-	argocdService, err := service.NewArgoCDService(kubeclientset, testNamespace, mockRepoClient)
+	argocdService, err := service.NewArgoCDService(kubeclientset, appclientsetfake.NewSimpleClientset(), testNamespace, mockRepoClient, nil)
 	require.NoError(t, err)
 	defer argocdService.Close()
 	config := api.Config{}
@@ -93,3 +99,158 @@ func TestInitGetVars(t *testing.T) {
 		assert.Equal(t, result["secrets"], notificationsSecret.Data)
 	})
 }
+
+func TestApplyOutboundURLPolicy(t *testing.T) {
+	policy, err := security.NewOutboundURLPolicy([]string{"*.example.com"}, nil, false)
+	require.NoError(t, err)
+	configMap := corev1.ConfigMap{
+		Data: map[string]string{
+			"service.webhook.allowed":  "url: https://hooks.example.com",
+			"service.webhook.denied":   "url: https://hooks.example.org",
+			"service.webhook.secret":   "url: $webhook-url",
+			"service.webhook.internal": "url: $internal-url/hook",
+			"service.webhook.missing":  "url: $missing-url",
+			"service.webhook.metadata": "url: https://hooks.example.com\nheaders:\n- name: X-Callback\n  value: http://169.254.169.254",
+			"service.slack":            "token: $slack-token\napiURL: http://attacker.local/api",
+		},
+	}
+	noop := func() (services.NotificationService, error) { return nil, nil }
+	cfg := api.Config{
+		Services: map[string]api.ServiceFactory{
+			"allowed":  noop,
+			"denied":   noop,
+			"secret":   noop,
+			"internal": noop,
+			"missing":  noop,
+			"metadata": noop,
+			"slack":    noop,
+		},
+	}
+
+	secret := corev1.Secret{
+		Data: map[string][]byte{
+			"webhook-url":  []byte("https://hooks.example.com/T0/B0"),
+			"internal-url": []byte("http://internal.example.org"),
+		},
+	}
+
+	applyOutboundURLPolicy(&cfg, &configMap, &secret, policy)
+
+	assert.Contains(t, cfg.Services, "allowed")
+	assert.Contains(t, cfg.Services, "secret")
+	// the references to missing keys are left to the notifications engine, which fails to send the notifications
+	assert.Contains(t, cfg.Services, "missing")
+	assert.NotContains(t, cfg.Services, "internal")
+	assert.NotContains(t, cfg.Services, "denied")
+	assert.NotContains(t, cfg.Services, "metadata")
+	assert.NotContains(t, cfg.Services, "slack")
+}
+
+func TestApplyOutboundURLPolicy_Send(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	policy, err := security.NewOutboundURLPolicy(nil, nil, true)
+	require.NoError(t, err)
+	configMap := corev1.ConfigMap{
+		Data: map[string]string{
+			"service.webhook.local": fmt.Sprintf("url: %s", strings.Replace(server.URL, "127.0.0.1", "localhost", 1)),
+		},
+	}
+	cfg := api.Config{
+		Services: map[string]api.ServiceFactory{
+			"local": func() (services.NotificationService, error) {
+				return services.NewService("webhook", []byte(configMap.Data["service.webhook.local"]))
+			},
+		},
+	}
+
+	applyOutboundURLPolicy(&cfg, &configMap, &corev1.Secret{}, policy)
+
+	require.Contains(t, cfg.Services, "local")
+	svc, err := cfg.Services["local"]()
+	require.NoError(t, err)
+	// the IPs of the host names are checked when the notifications are sent
+	err = svc.Send(services.Notification{Message: "message"}, services.Destination{Service: "local"})
+	require.ErrorContains(t, err, "resolves to a denied IP")
+}
+
+func TestApplyCloudEventsServices(t *testing.T) {
+	configMap := corev1.ConfigMap{
+		Data: map[string]string{
+			"service.cloudevents":        "url: $broker-url",
+			"service.cloudevents.router": "url: https://router.example.com\nextensions:\n  argocdapp: '{{.data.app}}'",
+			"service.cloudevents.broken": "url: [",
+			"service.webhook.test":       "url: https://hooks.example.com",
+		},
+	}
+	secret := corev1.Secret{
+		Data: map[string][]byte{
+			"broker-url": []byte("https://broker.example.com"),
+		},
+	}
+	cfg := api.Config{}
+
+	applyCloudEventsServices(&cfg, &configMap, &secret, nil)
+
+	assert.Len(t, cfg.Services, 3)
+	for _, name := range []string{"cloudevents", "router"} {
+		require.Contains(t, cfg.Services, name)
+		svc, err := cfg.Services[name]()
+		require.NoError(t, err)
+		assert.NotNil(t, svc)
+	}
+	require.Contains(t, cfg.Services, "broken")
+	_, err := cfg.Services["broken"]()
+	require.Error(t, err)
+}
+
+func TestApplyExternalSecretServices(t *testing.T) {
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "s.token", r.Header.Get("X-Vault-Token"))
+		_, _ = w.Write([]byte(`{"data":{"data":{"allowed-url":"https://hooks.example.com/T0","denied-url":"https://hooks.example.org/T0"},"metadata":{"version":1}}}`))
+	}))
+	defer vault.Close()
+
+	policy, err := security.NewOutboundURLPolicy([]string{"*.example.com"}, nil, false)
+	require.NoError(t, err)
+	argocdService := &servicemocks.Service{}
+	argocdService.EXPECT().GetOutboundURLPolicy().Return(policy, nil)
+	configMap := corev1.ConfigMap{
+		Data: map[string]string{
+			"secretBackends":           fmt.Sprintf("vault:\n  address: %s\n  token: $vault-token", vault.URL),
+			"service.webhook.allowed":  "url: ${vault:secret/data/notifications#allowed-url}",
+			"service.webhook.denied":   "url: ${vault:secret/data/notifications#denied-url}",
+			"service.webhook.missing":  "url: ${gcp:projects/argocd/secrets/webhook-url}",
+			"service.webhook.internal": "url: https://hooks.example.com",
+		},
+	}
+	secret := corev1.Secret{
+		Data: map[string][]byte{
+			"vault-token": []byte("s.token"),
+		},
+	}
+	cfg := api.Config{}
+
+	applyExternalSecretServices(argocdService, &cfg, &configMap, &secret, newResolverGetter(&configMap, &secret))
+
+	assert.Len(t, cfg.Services, 3)
+	assert.NotContains(t, cfg.Services, "internal")
+
+	require.Contains(t, cfg.Services, "allowed")
+	svc, err := cfg.Services["allowed"]()
+	require.NoError(t, err)
+	notificationService, err := svc.(*resolvedService).getService(t.Context())
+	require.NoError(t, err)
+	assert.NotNil(t, notificationService)
+	assert.Equal(t, "url: https://hooks.example.com/T0\n", svc.(*resolvedService).resolvedConfig)
+
+	for _, name := range []string{"denied", "missing"} {
+		require.Contains(t, cfg.Services, name)
+		svc, err := cfg.Services[name]()
+		require.NoError(t, err)
+		_, err = svc.(*resolvedService).getService(t.Context())
+		require.Error(t, err)
+	}
+}
@@ -153,6 +153,7 @@ func NewClientApp(settings *settings.ArgoCDSettings, dexServerAddr string, dexTL
 		a.client.Transport = dex.NewDexRewriteURLRoundTripper(addrWithProto, a.client.Transport)
 	} else {
 		transport.TLSClientConfig = settings.OIDCTLSConfig()
+		a.client.Transport = settings.OutboundURLPolicy.WrapTransport(transport)
 	}
 	if os.Getenv(common.EnvVarSSODebug) == "1" {
 		a.client.Transport = httputil.DebugTransport{T: a.client.Transport}
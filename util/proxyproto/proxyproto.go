@@ -0,0 +1,241 @@
+// Package proxyproto implements the PROXY protocol, versions 1 and 2, with which the L4 load balancers pass the
+// addresses of the clients to the servers behind them. See https://www.haproxy.org/download/2.9/doc/proxy-protocol.txt
+package proxyproto
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// headerTimeout is the time given to the clients to send the PROXY protocol header
+	headerTimeout = 10 * time.Second
+
+	// maxV1HeaderLength is the maximum length of a version 1 header, including the CRLF
+	maxV1HeaderLength = 107
+)
+
+var (
+	v1Prefix    = []byte("PROXY ")
+	v2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+)
+
+// NewListener returns a listener reading the PROXY protocol header, if any, of the connections accepted by the given
+// listener, and reporting the address of the client it carries as the remote address of the connections. The headers
+// are only read from the connections of the given trusted networks, and from none if no network is given, so that the
+// other clients cannot spoof their addresses. The connections without header are accepted as is, e.g. the ones of the
+// health probes.
+func NewListener(ln net.Listener, trustedNetworks []*net.IPNet) net.Listener {
+	return &listener{Listener: ln, trustedNetworks: trustedNetworks}
+}
+
+type listener struct {
+	net.Listener
+	trustedNetworks []*net.IPNet
+}
+
+func (l *listener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	if !l.isTrusted(conn.RemoteAddr()) {
+		return conn, nil
+	}
+	// the header is read on first use of the connection, so that slow clients do not hold the other connections
+	return &proxyConn{Conn: conn, reader: bufio.NewReader(conn)}, nil
+}
+
+func (l *listener) isTrusted(addr net.Addr) bool {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+	for _, network := range l.trustedNetworks {
+		if network.Contains(tcpAddr.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// proxyConn is a connection whose remote address is the one of the client given in its PROXY protocol header
+type proxyConn struct {
+	net.Conn
+	reader *bufio.Reader
+
+	once       sync.Once
+	remoteAddr net.Addr
+	err        error
+}
+
+// readHeader reads the PROXY protocol header of the connection, if any
+func (c *proxyConn) readHeader() {
+	c.once.Do(func() {
+		if err := c.Conn.SetReadDeadline(time.Now().Add(headerTimeout)); err != nil {
+			c.err = err
+			return
+		}
+		c.remoteAddr, c.err = readHeader(c.reader)
+		if err := c.Conn.SetReadDeadline(time.Time{}); err != nil && c.err == nil {
+			c.err = err
+		}
+		if c.err != nil {
+			c.err = fmt.Errorf("invalid PROXY protocol header from %s: %w", c.Conn.RemoteAddr(), c.err)
+		}
+	})
+}
+
+func (c *proxyConn) Read(b []byte) (int, error) {
+	c.readHeader()
+	if c.err != nil {
+		return 0, c.err
+	}
+	return c.reader.Read(b)
+}
+
+func (c *proxyConn) RemoteAddr() net.Addr {
+	c.readHeader()
+	if c.remoteAddr != nil {
+		return c.remoteAddr
+	}
+	return c.Conn.RemoteAddr()
+}
+
+func (c *proxyConn) SetDeadline(t time.Time) error {
+	c.readHeader()
+	return c.Conn.SetDeadline(t)
+}
+
+func (c *proxyConn) SetReadDeadline(t time.Time) error {
+	c.readHeader()
+	return c.Conn.SetReadDeadline(t)
+}
+
+// readHeader reads the PROXY protocol header at the start of the given reader, if any, and returns the address of the
+// client it carries. The address is nil if there is no header, or if the header does not carry the address of the
+// client, e.g. for the health checks of the load balancer.
+func readHeader(r *bufio.Reader) (net.Addr, error) {
+	first, err := r.Peek(1)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	switch first[0] {
+	case v1Prefix[0]:
+		if prefix, err := r.Peek(len(v1Prefix)); err != nil || !bytes.Equal(prefix, v1Prefix) {
+			// e.g. a PUT request
+			return nil, nil
+		}
+		return readV1Header(r)
+	case v2Signature[0]:
+		if signature, err := r.Peek(len(v2Signature)); err != nil || !bytes.Equal(signature, v2Signature) {
+			return nil, nil
+		}
+		return readV2Header(r)
+	default:
+		return nil, nil
+	}
+}
+
+// readV1Header reads a human-readable header, e.g. PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n
+func readV1Header(r *bufio.Reader) (net.Addr, error) {
+	var line []byte
+	for len(line) < maxV1HeaderLength {
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		line = append(line, b)
+		if bytes.HasSuffix(line, []byte("\r\n")) {
+			break
+		}
+	}
+	if !bytes.HasSuffix(line, []byte("\r\n")) {
+		return nil, errors.New("header too long")
+	}
+	fields := strings.Fields(string(line[:len(line)-2]))
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return nil, nil
+	}
+	if len(fields) != 6 || fields[1] != "TCP4" && fields[1] != "TCP6" {
+		return nil, fmt.Errorf("malformed header %q", strings.TrimSpace(string(line)))
+	}
+	ip := net.ParseIP(fields[2])
+	if ip == nil || (ip.To4() != nil) != (fields[1] == "TCP4") {
+		return nil, fmt.Errorf("invalid source address %q", fields[2])
+	}
+	port, err := strconv.ParseUint(fields[4], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source port %q", fields[4])
+	}
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
+
+// readV2Header reads a binary header
+func readV2Header(r *bufio.Reader) (net.Addr, error) {
+	header := make([]byte, len(v2Signature)+4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	versionCommand, family := header[12], header[13]
+	payload := make([]byte, binary.BigEndian.Uint16(header[14:16]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, err
+	}
+	if versionCommand>>4 != 2 {
+		return nil, fmt.Errorf("unsupported version %d", versionCommand>>4)
+	}
+	switch versionCommand & 0x0f {
+	case 0x0:
+		// LOCAL, e.g. the health checks of the load balancer
+		return nil, nil
+	case 0x1:
+		// PROXY
+	default:
+		return nil, fmt.Errorf("unsupported command %d", versionCommand&0x0f)
+	}
+	var ipLength int
+	switch family >> 4 {
+	case 0x1:
+		ipLength = net.IPv4len
+	case 0x2:
+		ipLength = net.IPv6len
+	default:
+		// e.g. unix sockets, whose addresses are not relevant
+		return nil, nil
+	}
+	if len(payload) < 2*ipLength+4 {
+		return nil, errors.New("truncated addresses")
+	}
+	ip := net.IP(payload[:ipLength])
+	port := binary.BigEndian.Uint16(payload[2*ipLength:])
+	if family&0x0f == 0x2 {
+		return &net.UDPAddr{IP: ip, Port: int(port)}, nil
+	}
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
+
+// ParseNetworks parses the given CIDRs, e.g. 10.0.0.0/8, of the networks trusted to send PROXY protocol headers
+func ParseNetworks(cidrs []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q: %w", cidr, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
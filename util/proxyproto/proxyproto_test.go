@@ -0,0 +1,134 @@
+package proxyproto
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loopbackNetworks trusts the connections of the tests
+var loopbackNetworks = []*net.IPNet{{IP: net.IPv4(127, 0, 0, 0), Mask: net.CIDRMask(8, 32)}}
+
+// accept sends the given data to a listener returned by NewListener and returns the connection it accepted
+func accept(t *testing.T, trustedNetworks []*net.IPNet, data []byte) net.Conn {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+	client, err := net.Dial("tcp", ln.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	_, err = client.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, client.(*net.TCPConn).CloseWrite())
+
+	conn, err := NewListener(ln, trustedNetworks).Accept()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func v2Header(command byte, family byte, addresses []byte) []byte {
+	header := append([]byte{}, v2Signature...)
+	header = append(header, 0x20|command, family, 0, 0)
+	binary.BigEndian.PutUint16(header[14:], uint16(len(addresses)))
+	return append(header, addresses...)
+}
+
+func TestListener(t *testing.T) {
+	t.Run("V1", func(t *testing.T) {
+		conn := accept(t, loopbackNetworks, []byte("PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\nGET / HTTP/1.1\r\n"))
+		assert.Equal(t, "192.0.2.1:56324", conn.RemoteAddr().String())
+		data, err := io.ReadAll(conn)
+		require.NoError(t, err)
+		assert.Equal(t, "GET / HTTP/1.1\r\n", string(data))
+	})
+
+	t.Run("V1IPv6", func(t *testing.T) {
+		conn := accept(t, loopbackNetworks, []byte("PROXY TCP6 2001:db8::1 2001:db8::2 56324 443\r\n"))
+		assert.Equal(t, "[2001:db8::1]:56324", conn.RemoteAddr().String())
+	})
+
+	t.Run("V1Unknown", func(t *testing.T) {
+		conn := accept(t, loopbackNetworks, []byte("PROXY UNKNOWN\r\nGET / HTTP/1.1\r\n"))
+		assert.Contains(t, conn.RemoteAddr().String(), "127.0.0.1:")
+		data, err := io.ReadAll(conn)
+		require.NoError(t, err)
+		assert.Equal(t, "GET / HTTP/1.1\r\n", string(data))
+	})
+
+	t.Run("V1Malformed", func(t *testing.T) {
+		conn := accept(t, loopbackNetworks, []byte("PROXY TCP4 192.0.2.1\r\nGET / HTTP/1.1\r\n"))
+		_, err := io.ReadAll(conn)
+		require.ErrorContains(t, err, "invalid PROXY protocol header")
+	})
+
+	t.Run("V2", func(t *testing.T) {
+		addresses := []byte{192, 0, 2, 1, 198, 51, 100, 1, 0xdc, 0x04, 0x01, 0xbb}
+		conn := accept(t, loopbackNetworks, append(v2Header(0x1, 0x11, addresses), []byte("PRI * HTTP/2.0\r\n")...))
+		assert.Equal(t, "192.0.2.1:56324", conn.RemoteAddr().String())
+		data, err := io.ReadAll(conn)
+		require.NoError(t, err)
+		assert.Equal(t, "PRI * HTTP/2.0\r\n", string(data))
+	})
+
+	t.Run("V2WithTLVs", func(t *testing.T) {
+		addresses := []byte{192, 0, 2, 1, 198, 51, 100, 1, 0xdc, 0x04, 0x01, 0xbb, 0x04, 0x00, 0x01, 0x00}
+		conn := accept(t, loopbackNetworks, append(v2Header(0x1, 0x11, addresses), []byte("data")...))
+		assert.Equal(t, "192.0.2.1:56324", conn.RemoteAddr().String())
+		data, err := io.ReadAll(conn)
+		require.NoError(t, err)
+		assert.Equal(t, "data", string(data))
+	})
+
+	t.Run("V2Local", func(t *testing.T) {
+		conn := accept(t, loopbackNetworks, append(v2Header(0x0, 0x00, nil), []byte("data")...))
+		assert.Contains(t, conn.RemoteAddr().String(), "127.0.0.1:")
+		data, err := io.ReadAll(conn)
+		require.NoError(t, err)
+		assert.Equal(t, "data", string(data))
+	})
+
+	t.Run("V2Truncated", func(t *testing.T) {
+		conn := accept(t, loopbackNetworks, v2Header(0x1, 0x11, []byte{192, 0, 2, 1}))
+		_, err := io.ReadAll(conn)
+		require.ErrorContains(t, err, "truncated addresses")
+	})
+
+	t.Run("WithoutHeader", func(t *testing.T) {
+		conn := accept(t, loopbackNetworks, []byte("PUT / HTTP/1.1\r\n"))
+		assert.Contains(t, conn.RemoteAddr().String(), "127.0.0.1:")
+		data, err := io.ReadAll(conn)
+		require.NoError(t, err)
+		assert.Equal(t, "PUT / HTTP/1.1\r\n", string(data))
+	})
+
+	t.Run("UntrustedAddress", func(t *testing.T) {
+		_, network, err := net.ParseCIDR("10.0.0.0/8")
+		require.NoError(t, err)
+		conn := accept(t, []*net.IPNet{network}, []byte("PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n"))
+		assert.Contains(t, conn.RemoteAddr().String(), "127.0.0.1:")
+		data, err := io.ReadAll(conn)
+		require.NoError(t, err)
+		assert.Equal(t, "PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n", string(data))
+	})
+
+	t.Run("NoTrustedNetwork", func(t *testing.T) {
+		conn := accept(t, nil, []byte("PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n"))
+		assert.Contains(t, conn.RemoteAddr().String(), "127.0.0.1:")
+		data, err := io.ReadAll(conn)
+		require.NoError(t, err)
+		assert.Equal(t, "PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n", string(data))
+	})
+
+	t.Run("TrustedAddress", func(t *testing.T) {
+		_, network, err := net.ParseCIDR("127.0.0.0/8")
+		require.NoError(t, err)
+		conn := accept(t, []*net.IPNet{network}, []byte("PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n"))
+		assert.Equal(t, "192.0.2.1:56324", conn.RemoteAddr().String())
+	})
+}
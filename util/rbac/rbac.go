@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/errorcode"
 	"github.com/argoproj/argo-cd/v3/util/assets"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
@@ -22,7 +23,6 @@ import (
 	gocache "github.com/patrickmn/go-cache"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -69,6 +69,7 @@ const (
 	ResourceLogs              = "logs"
 	ResourceExec              = "exec"
 	ResourceExtensions        = "extensions"
+	ResourceFederation        = "federation"
 
 	// please add new items to Actions
 	ActionGet      = "get"
@@ -79,6 +80,10 @@ const (
 	ActionOverride = "override"
 	ActionAction   = "action"
 	ActionInvoke   = "invoke"
+	// ActionFreeze allows freezing and unfreezing applications, and syncing frozen applications
+	ActionFreeze = "freeze"
+	// ActionImpersonate allows making API calls as another user or group
+	ActionImpersonate = "impersonate"
 )
 
 var (
@@ -96,6 +101,7 @@ var (
 		ResourceLogs,
 		ResourceExec,
 		ResourceExtensions,
+		ResourceFederation,
 	}
 	Actions = []string{
 		ActionGet,
@@ -106,6 +112,8 @@ var (
 		ActionOverride,
 		ActionAction,
 		ActionInvoke,
+		ActionImpersonate,
+		ActionFreeze,
 	}
 )
 
@@ -352,7 +360,7 @@ func (e *Enforcer) EnforceErr(rvals ...any) error {
 			errMsg = fmt.Sprintf("%s: %s", errMsg, strings.Join(rvalsStrs, ", "))
 		}
 
-		return status.Error(codes.PermissionDenied, errMsg)
+		return errorcode.Error(codes.PermissionDenied, errorcode.PermissionDenied, errMsg)
 	}
 	return nil
 }
@@ -0,0 +1,69 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	resourcehelper "k8s.io/kubectl/pkg/util/resource"
+)
+
+// WorkloadResources are the resources whose requests are returned by GetWorkloadRequests
+var WorkloadResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// GetWorkloadRequests returns the resource requests of each pod of the given workload, in milli units, along with its
+// number of pods. The number of pods is zero if the resource is not a workload.
+func GetWorkloadRequests(obj *unstructured.Unstructured) (map[corev1.ResourceName]int64, int64, error) {
+	if obj == nil {
+		return nil, 0, nil
+	}
+	gvk := obj.GroupVersionKind()
+	podSpecPath := []string{"spec", "template", "spec"}
+	var replicasPath []string
+	switch {
+	case gvk.Group == "" && gvk.Kind == kube.PodKind:
+		podSpecPath = []string{"spec"}
+	case gvk.Group == "apps" && (gvk.Kind == kube.DeploymentKind || gvk.Kind == kube.ReplicaSetKind || gvk.Kind == kube.StatefulSetKind):
+		replicasPath = []string{"spec", "replicas"}
+	case gvk.Group == "batch" && gvk.Kind == kube.JobKind:
+		replicasPath = []string{"spec", "parallelism"}
+	default:
+		return nil, 0, nil
+	}
+	replicas := int64(1)
+	if replicasPath != nil {
+		// the numbers of the manifests decoded from YAML are float64
+		value, found, err := unstructured.NestedFieldNoCopy(obj.Object, replicasPath...)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get the replicas of %s %s: %w", gvk.Kind, obj.GetName(), err)
+		}
+		switch value := value.(type) {
+		case int64:
+			replicas = value
+		case float64:
+			replicas = int64(value)
+		default:
+			if found && value != nil {
+				return nil, 0, fmt.Errorf("failed to get the replicas of %s %s: %v is of the type %T, expected a number", gvk.Kind, obj.GetName(), value, value)
+			}
+		}
+	}
+	spec, _, err := unstructured.NestedMap(obj.Object, podSpecPath...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get the pod spec of %s %s: %w", gvk.Kind, obj.GetName(), err)
+	}
+	var pod corev1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(spec, &pod.Spec); err != nil {
+		return nil, 0, fmt.Errorf("failed to convert the pod spec of %s %s: %w", gvk.Kind, obj.GetName(), err)
+	}
+	podRequests, _ := resourcehelper.PodRequestsAndLimits(&pod)
+	requests := map[corev1.ResourceName]int64{}
+	for _, name := range WorkloadResources {
+		if quantity, ok := podRequests[name]; ok {
+			requests[name] = quantity.MilliValue()
+		}
+	}
+	return requests, replicas, nil
+}
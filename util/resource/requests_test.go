@@ -0,0 +1,69 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func TestGetWorkloadRequests(t *testing.T) {
+	requests, replicas, err := GetWorkloadRequests(test.YamlToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+spec:
+  replicas: 3
+  template:
+    spec:
+      initContainers:
+      - name: init
+        image: init
+        resources:
+          requests:
+            cpu: "1"
+      containers:
+      - name: guestbook
+        image: guestbook
+        resources:
+          requests:
+            cpu: 500m
+            memory: 64Mi
+      - name: sidecar
+        image: sidecar
+        resources:
+          requests:
+            cpu: 100m
+`))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), replicas)
+	// the init containers run before the containers
+	assert.Equal(t, map[corev1.ResourceName]int64{corev1.ResourceCPU: 1000, corev1.ResourceMemory: 64 * 1024 * 1024 * 1000}, requests)
+
+	_, replicas, err = GetWorkloadRequests(test.YamlToUnstructured(`
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+spec:
+  template:
+    spec:
+      containers:
+      - name: migrate
+        image: migrate
+`))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), replicas)
+
+	_, replicas, err = GetWorkloadRequests(test.NewConfigMap())
+	require.NoError(t, err)
+	assert.Zero(t, replicas)
+
+	_, replicas, err = GetWorkloadRequests(nil)
+	require.NoError(t, err)
+	assert.Zero(t, replicas)
+}
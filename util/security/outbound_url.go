@@ -0,0 +1,256 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+// metadataNetworks are the networks of the instance metadata services of the cloud providers, which expose the
+// credentials of the nodes. The connections to these networks are denied unless they are explicitly allowed.
+var metadataNetworks = mustParseCIDRs(
+	"169.254.0.0/16",     // IPv4 link-local, e.g. AWS, GCP and Azure at 169.254.169.254
+	"fe80::/10",          // IPv6 link-local
+	"fd00:ec2::254/128",  // AWS IPv6
+	"100.100.100.200/32", // Alibaba Cloud
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	var networks []*net.IPNet
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// OutboundURLPolicy validates the URLs Argo CD connects to on behalf of the users, e.g. the URLs of the notification
+// services, of the plugin generators, of the OIDC issuers and of the proxy extensions, to protect against server-side
+// request forgery. The hosts must match the allowlist, if any, and must not match the denylist. The connections to the
+// instance metadata services of the cloud providers are always denied, unless their IPs are explicitly allowed. A nil
+// policy only denies the instance metadata services.
+type OutboundURLPolicy struct {
+	allowHosts           []string
+	allowNetworks        []*net.IPNet
+	denyHosts            []string
+	denyNetworks         []*net.IPNet
+	blockPrivateNetworks bool
+}
+
+// NewOutboundURLPolicy returns the policy of the given allowlist and denylist, whose entries are host name globs
+// (e.g. *.example.com), IPs or CIDRs. The connections to the private, loopback and unspecified IPs are also denied if
+// blockPrivateNetworks is set, unless they are allowed by the allowlist.
+func NewOutboundURLPolicy(allowlist, denylist []string, blockPrivateNetworks bool) (*OutboundURLPolicy, error) {
+	p := &OutboundURLPolicy{blockPrivateNetworks: blockPrivateNetworks}
+	var err error
+	if p.allowHosts, p.allowNetworks, err = parseOutboundURLEntries(allowlist); err != nil {
+		return nil, fmt.Errorf("invalid allowlist: %w", err)
+	}
+	if p.denyHosts, p.denyNetworks, err = parseOutboundURLEntries(denylist); err != nil {
+		return nil, fmt.Errorf("invalid denylist: %w", err)
+	}
+	return p, nil
+}
+
+func parseOutboundURLEntries(entries []string) ([]string, []*net.IPNet, error) {
+	var hosts []string
+	var networks []*net.IPNet
+	for _, entry := range entries {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		switch {
+		case entry == "":
+			return nil, nil, errors.New("empty entry")
+		case strings.Contains(entry, "/"):
+			_, network, err := net.ParseCIDR(entry)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid CIDR %q: %w", entry, err)
+			}
+			networks = append(networks, network)
+		case net.ParseIP(entry) != nil:
+			ip := net.ParseIP(entry)
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+		default:
+			hosts = append(hosts, entry)
+		}
+	}
+	return hosts, networks, nil
+}
+
+// ValidateURL returns an error if the URL is not an HTTP(S) URL allowed by the policy. The IPs of the host names are
+// only checked when connecting, see DialControl.
+func (p *OutboundURLPolicy) ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL %q: %w", rawURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("URL %q is not allowed: the scheme must be http or https", rawURL)
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "" {
+		return fmt.Errorf("URL %q is not allowed: the host is missing", rawURL)
+	}
+	if err := p.validateHost(host); err != nil {
+		return fmt.Errorf("URL %q is not allowed: %w", rawURL, err)
+	}
+	return nil
+}
+
+// ValidateResolvedURL returns an error if the URL is not allowed by the policy, or if its host name resolves to IPs
+// denied by the policy. It is meant for the HTTP clients whose connections cannot be checked by DialControl, e.g. the
+// clients created by third-party libraries, whose IPs are then checked before sending the requests.
+func (p *OutboundURLPolicy) ValidateResolvedURL(ctx context.Context, rawURL string) error {
+	if err := p.ValidateURL(rawURL); err != nil {
+		return err
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL %q: %w", rawURL, err)
+	}
+	host := u.Hostname()
+	if net.ParseIP(host) != nil {
+		return nil
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("URL %q is not allowed: failed to resolve host %s: %w", rawURL, host, err)
+	}
+	for _, addr := range addrs {
+		if err := p.validateIP(addr.IP); err != nil {
+			return fmt.Errorf("URL %q is not allowed: host %s resolves to a denied IP: %w", rawURL, host, err)
+		}
+	}
+	return nil
+}
+
+func (p *OutboundURLPolicy) validateHost(host string) error {
+	ip := net.ParseIP(host)
+	if ip != nil {
+		return p.validateIP(ip)
+	}
+	if p == nil {
+		return nil
+	}
+	for _, pattern := range p.denyHosts {
+		if glob.Match(pattern, host) {
+			return fmt.Errorf("host %s is denied", host)
+		}
+	}
+	if len(p.allowHosts) == 0 && len(p.allowNetworks) == 0 {
+		return nil
+	}
+	for _, pattern := range p.allowHosts {
+		if glob.Match(pattern, host) {
+			return nil
+		}
+	}
+	return fmt.Errorf("host %s is not allowed", host)
+}
+
+func (p *OutboundURLPolicy) validateIP(ip net.IP) error {
+	if p != nil {
+		if containsIP(p.denyNetworks, ip) {
+			return fmt.Errorf("IP %s is denied", ip)
+		}
+		if containsIP(p.allowNetworks, ip) {
+			return nil
+		}
+	}
+	if containsIP(metadataNetworks, ip) {
+		return fmt.Errorf("IP %s of an instance metadata service is denied", ip)
+	}
+	if p != nil && p.blockPrivateNetworks && (ip.IsPrivate() || ip.IsLoopback() || ip.IsUnspecified()) {
+		return fmt.Errorf("private IP %s is denied", ip)
+	}
+	return nil
+}
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// DialControl denies the connections to the IPs denied by the policy. It can be the Control function of the dialers,
+// so that the IPs resolved from the host names are checked too.
+func (p *OutboundURLPolicy) DialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid address %q: %w", address, err)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("invalid address %q", address)
+	}
+	if err := p.validateIP(ip); err != nil {
+		return fmt.Errorf("connection to %s is not allowed: %w", address, err)
+	}
+	return nil
+}
+
+// WrapTransport returns a round tripper denying the requests to the URLs denied by the policy, including the
+// redirects. The connections of a *http.Transport, or of the default transport if nil, are also checked after they are
+// established, so that the IPs resolved from the host names are checked too. Note that the IP of the HTTP proxy, if
+// any, must be allowed by the policy.
+func (p *OutboundURLPolicy) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	if transport, ok := rt.(*http.Transport); ok {
+		transport = transport.Clone()
+		dial := transport.DialContext
+		switch {
+		case dial == nil && transport.Dial != nil: //nolint:staticcheck // the deprecated Dial is still set by some callers
+			legacyDial := transport.Dial //nolint:staticcheck
+			dial = func(_ context.Context, network, address string) (net.Conn, error) {
+				return legacyDial(network, address)
+			}
+			transport.Dial = nil //nolint:staticcheck
+		case dial == nil:
+			dial = (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}).DialContext
+		}
+		transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+			conn, err := dial(ctx, network, address)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.DialControl(network, conn.RemoteAddr().String(), nil); err != nil {
+				_ = conn.Close()
+				return nil, err
+			}
+			return conn, nil
+		}
+		rt = transport
+	}
+	return &outboundURLRoundTripper{policy: p, next: rt}
+}
+
+type outboundURLRoundTripper struct {
+	policy *OutboundURLPolicy
+	next   http.RoundTripper
+}
+
+func (rt *outboundURLRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := rt.policy.ValidateURL(req.URL.String()); err != nil {
+		return nil, err
+	}
+	return rt.next.RoundTrip(req)
+}
@@ -0,0 +1,106 @@
+package security
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboundURLPolicy_ValidateURL(t *testing.T) {
+	policy, err := NewOutboundURLPolicy([]string{"*.example.com", "10.0.0.0/8", "169.254.10.10"}, []string{"internal.example.com", "10.1.0.0/16"}, true)
+	require.NoError(t, err)
+
+	for _, rawURL := range []string{
+		"https://hooks.example.com/notify",
+		"http://api.example.com:8080/plugin",
+		"https://10.2.3.4/api",
+		"https://169.254.10.10",
+	} {
+		assert.NoError(t, policy.ValidateURL(rawURL), rawURL)
+	}
+	for rawURL, expected := range map[string]string{
+		"https://internal.example.com":    "host internal.example.com is denied",
+		"https://github.com":              "host github.com is not allowed",
+		"https://10.1.2.3":                "IP 10.1.2.3 is denied",
+		"http://169.254.169.254/latest":   "IP 169.254.169.254 of an instance metadata service is denied",
+		"https://192.168.1.1":             "private IP 192.168.1.1 is denied",
+		"file:///etc/passwd":              "the scheme must be http or https",
+		"https:///path":                   "the host is missing",
+		"https://[fd00:ec2::254]/latest/": "IP fd00:ec2::254 of an instance metadata service is denied",
+	} {
+		assert.ErrorContains(t, policy.ValidateURL(rawURL), expected, rawURL)
+	}
+
+	t.Run("Nil policy", func(t *testing.T) {
+		var policy *OutboundURLPolicy
+		require.NoError(t, policy.ValidateURL("https://github.com"))
+		require.NoError(t, policy.ValidateURL("http://127.0.0.1:8080"))
+		assert.ErrorContains(t, policy.ValidateURL("http://169.254.169.254"), "instance metadata service")
+	})
+
+	t.Run("Invalid entries", func(t *testing.T) {
+		_, err := NewOutboundURLPolicy([]string{"10.0.0.0/33"}, nil, false)
+		require.ErrorContains(t, err, "invalid allowlist")
+		_, err = NewOutboundURLPolicy(nil, []string{" "}, false)
+		require.ErrorContains(t, err, "invalid denylist")
+	})
+}
+
+func TestOutboundURLPolicy_DialControl(t *testing.T) {
+	policy, err := NewOutboundURLPolicy(nil, nil, true)
+	require.NoError(t, err)
+	require.NoError(t, policy.DialControl("tcp", "140.82.112.3:443", nil))
+	require.ErrorContains(t, policy.DialControl("tcp", "127.0.0.1:443", nil), "private IP 127.0.0.1 is denied")
+	require.ErrorContains(t, policy.DialControl("tcp", "[fe80::1]:80", nil), "instance metadata service")
+	require.Error(t, policy.DialControl("tcp", "localhost", nil))
+}
+
+func TestOutboundURLPolicy_ValidateResolvedURL(t *testing.T) {
+	policy, err := NewOutboundURLPolicy(nil, nil, true)
+	require.NoError(t, err)
+	require.NoError(t, policy.ValidateResolvedURL(t.Context(), "http://140.82.112.3"))
+	require.ErrorContains(t, policy.ValidateResolvedURL(t.Context(), "http://127.0.0.1:8080"), "private IP 127.0.0.1 is denied")
+	// the IPs of the host names are checked
+	require.ErrorContains(t, policy.ValidateResolvedURL(t.Context(), "http://localhost:8080"), "resolves to a denied IP")
+	require.ErrorContains(t, policy.ValidateResolvedURL(t.Context(), "http://unknown.invalid"), "failed to resolve host")
+	require.NoError(t, (*OutboundURLPolicy)(nil).ValidateResolvedURL(t.Context(), "http://localhost:8080"))
+}
+
+func TestOutboundURLPolicy_WrapTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Run("Allowed", func(t *testing.T) {
+		client := &http.Client{Transport: (*OutboundURLPolicy)(nil).WrapTransport(nil)}
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Redirect denied", func(t *testing.T) {
+		client := &http.Client{Transport: (*OutboundURLPolicy)(nil).WrapTransport(nil)}
+		_, err := client.Get(server.URL + "/redirect")
+		assert.ErrorContains(t, err, "instance metadata service")
+	})
+
+	t.Run("Connection denied", func(t *testing.T) {
+		policy, err := NewOutboundURLPolicy(nil, nil, true)
+		require.NoError(t, err)
+		client := &http.Client{Transport: policy.WrapTransport(http.DefaultTransport)}
+		// the IPs of the host names are checked when connecting
+		_, err = client.Get(strings.Replace(server.URL, "127.0.0.1", "localhost", 1))
+		require.ErrorContains(t, err, "connection to")
+		assert.ErrorContains(t, err, "is denied")
+	})
+}
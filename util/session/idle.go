@@ -0,0 +1,53 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	log "github.com/sirupsen/logrus"
+
+	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
+)
+
+// sessionActivityRefreshInterval is the maximum interval between the records of the activity of a session, to avoid
+// writing to Redis on every request
+const sessionActivityRefreshInterval = time.Minute
+
+var errSessionIdle = errors.New("session has been idle for too long, please re-login")
+
+// verifySessionActivity verifies that the session of the given claims has been active within the given idle timeout,
+// and records its activity. The first activity of a session is its issue time. The sessions never expire from
+// inactivity if the idle timeout is zero, and are considered active if their activity cannot be read from Redis.
+func (mgr *SessionManager) verifySessionActivity(ctx context.Context, claims jwt.MapClaims, idleTimeout time.Duration) error {
+	if idleTimeout <= 0 {
+		return nil
+	}
+	key := tokenUsageKey(claims)
+	if key == "" {
+		return nil
+	}
+	lastSeen, err := mgr.storage.GetSessionLastSeen(ctx, key)
+	if err != nil {
+		log.Warnf("Failed to get the last activity of the session of %s: %v", jwtutil.GetUserIdentifier(claims), err)
+		return nil
+	}
+	recorded := !lastSeen.IsZero()
+	if !recorded {
+		if lastSeen, err = jwtutil.IssuedAtTime(claims); err != nil {
+			return nil
+		}
+	}
+
+	now := time.Now()
+	if now.Sub(lastSeen) >= idleTimeout {
+		return errSessionIdle
+	}
+	if !recorded || now.Sub(lastSeen) >= min(sessionActivityRefreshInterval, idleTimeout/2) {
+		if err := mgr.storage.SetSessionLastSeen(ctx, key, now, idleTimeout); err != nil {
+			log.Warnf("Failed to record the activity of the session of %s: %v", jwtutil.GetUserIdentifier(claims), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package session
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ldaputil "github.com/argoproj/argo-cd/v3/util/ldap"
+)
+
+// ldapClaim marks the tokens of the sessions of the users authenticated by the LDAP server
+const ldapClaim = "ldap"
+
+// ldapClaims are the claims of the tokens of the users authenticated by the LDAP server
+type ldapClaims struct {
+	jwt.RegisteredClaims
+	Groups []string `json:"groups,omitempty"`
+	Email  string   `json:"email,omitempty"`
+	Name   string   `json:"name,omitempty"`
+	LDAP   bool     `json:"ldap"`
+}
+
+// getLDAPAuthenticator returns the authenticator of the configured LDAP server, nil if LDAP authentication is not
+// configured. The authenticator and its connections are reused until the config changes.
+func (mgr *SessionManager) getLDAPAuthenticator() (*ldaputil.Authenticator, error) {
+	config, err := mgr.settingsMgr.GetLDAPConfig()
+	if err != nil {
+		return nil, err
+	}
+	mgr.ldapLock.Lock()
+	defer mgr.ldapLock.Unlock()
+	if reflect.DeepEqual(config, mgr.ldapConfig) {
+		return mgr.ldapAuthenticator, nil
+	}
+	if mgr.ldapAuthenticator != nil {
+		mgr.ldapAuthenticator.Close()
+	}
+	mgr.ldapConfig, mgr.ldapAuthenticator = nil, nil
+	if config == nil {
+		return nil, nil
+	}
+	authenticator, err := ldaputil.NewAuthenticator(config)
+	if err != nil {
+		return nil, err
+	}
+	mgr.ldapConfig, mgr.ldapAuthenticator = config, authenticator
+	return authenticator, nil
+}
+
+// VerifyLDAPUsernamePassword verifies the username and password with the LDAP server and returns the identity of the
+// user. A nil identity is returned if LDAP authentication is not configured or the username is a local account, which
+// takes precedence.
+func (mgr *SessionManager) VerifyLDAPUsernamePassword(username string, password string) (*ldaputil.Identity, error) {
+	authenticator, err := mgr.getLDAPAuthenticator()
+	if err != nil {
+		log.Errorf("Failed to configure LDAP authentication: %v", err)
+		return nil, status.Error(codes.Internal, "LDAP authentication is misconfigured")
+	}
+	if authenticator == nil {
+		return nil, nil
+	}
+	if _, err := mgr.settingsMgr.GetAccount(username); err == nil {
+		return nil, nil
+	}
+	if password == "" {
+		return nil, status.Errorf(codes.Unauthenticated, blankPasswordError)
+	}
+	if len(username) > maxUsernameLength {
+		return nil, status.Errorf(codes.InvalidArgument, usernameTooLongError, maxUsernameLength)
+	}
+	// the subject of the sessions must not be confused with the subject of the project roles
+	if strings.Contains(username, ":") {
+		return nil, InvalidLoginErr
+	}
+
+	attempt := mgr.getFailureCount(username)
+	if mgr.exceededFailedLoginAttempts(attempt) {
+		log.Warnf("User %s had too many failed logins (%d)", username, attempt.FailCount)
+		return nil, InvalidLoginErr
+	}
+
+	identity, err := authenticator.Authenticate(username, password)
+	if err != nil {
+		if errors.Is(err, ldaputil.ErrInvalidCredentials) {
+			mgr.updateFailureCount(username, true)
+			return nil, InvalidLoginErr
+		}
+		log.Errorf("Failed to authenticate user %s with LDAP: %v", username, err)
+		return nil, status.Error(codes.Unavailable, "LDAP server is unavailable")
+	}
+	mgr.updateFailureCount(username, false)
+	return identity, nil
+}
+
+// CreateLDAPSession creates a new token for the given user authenticated by the LDAP server. The groups of the user
+// are stored in the token and refreshed at the next login.
+func (mgr *SessionManager) CreateLDAPSession(identity *ldaputil.Identity, secondsBeforeExpiry int64, id string) (string, error) {
+	now := time.Now().UTC()
+	claims := ldapClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    SessionManagerClaimsIssuer,
+			NotBefore: jwt.NewNumericDate(now),
+			Subject:   identity.Username,
+			ID:        id,
+		},
+		Groups: identity.Groups,
+		Email:  identity.Email,
+		Name:   identity.Name,
+		LDAP:   true,
+	}
+	if secondsBeforeExpiry > 0 {
+		claims.ExpiresAt = jwt.NewNumericDate(now.Add(time.Duration(secondsBeforeExpiry) * time.Second))
+	}
+	return mgr.signClaims(claims)
+}
+
+// verifyLDAPSession verifies that LDAP authentication is still configured and the session has not been revoked
+func (mgr *SessionManager) verifyLDAPSession(id string) error {
+	config, err := mgr.settingsMgr.GetLDAPConfig()
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		return errors.New("LDAP authentication is disabled, please re-login")
+	}
+	if id == "" || mgr.storage.IsTokenRevoked(id) {
+		return errors.New("token is revoked, please re-login")
+	}
+	return nil
+}
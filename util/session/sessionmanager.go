@@ -2,13 +2,16 @@ package session
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"math/rand"
 	"net"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -28,6 +31,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/env"
 	httputil "github.com/argoproj/argo-cd/v3/util/http"
 	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
+	ldaputil "github.com/argoproj/argo-cd/v3/util/ldap"
 	oidcutil "github.com/argoproj/argo-cd/v3/util/oidc"
 	passwordutil "github.com/argoproj/argo-cd/v3/util/password"
 	"github.com/argoproj/argo-cd/v3/util/settings"
@@ -44,6 +48,10 @@ type SessionManager struct {
 	verificationDelayNoiseEnabled bool
 	failedLock                    sync.RWMutex
 	metricsRegistry               MetricsRegistry
+	ldapLock                      sync.Mutex
+	ldapConfig                    *settings.LDAPConfig
+	ldapAuthenticator             *ldaputil.Authenticator
+	tokenUsage                    *tokenUsageTracker
 }
 
 // LoginAttempts is a timestamped counter for failed login attempts
@@ -56,12 +64,17 @@ type LoginAttempts struct {
 
 type MetricsRegistry interface {
 	IncLoginRequestCounter(status string)
+	IncLoginFailureCounter(source string)
+	IncTokenVerificationFailureCounter(issuer string, reason string)
+	IncTokenUsageAnomalyCounter(anomaly string)
 }
 
 const (
 	// SessionManagerClaimsIssuer fills the "iss" field of the token.
 	SessionManagerClaimsIssuer = "argocd"
 	AuthErrorCtxKey            = "auth-error"
+	// ImpersonatorClaim holds the username of the user who made a request on behalf of the user of the claims
+	ImpersonatorClaim = "impersonator"
 
 	// invalidLoginError, for security purposes, doesn't say whether the username or password was invalid.  This does not mitigate the potential for timing attacks to determine which is which.
 	invalidLoginError           = "Invalid username or password"
@@ -123,6 +136,7 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, projectsLister v1a
 		sleep:                         time.Sleep,
 		projectsLister:                projectsLister,
 		verificationDelayNoiseEnabled: true,
+		tokenUsage:                    newTokenUsageTracker(),
 	}
 	settings, err := settingsMgr.GetSettings()
 	if err != nil {
@@ -149,6 +163,7 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, projectsLister v1a
 		s.client.Transport = dex.NewDexRewriteURLRoundTripper(addrWithProto, s.client.Transport)
 	} else {
 		transport.TLSClientConfig = settings.OIDCTLSConfig()
+		s.client.Transport = settings.OutboundURLPolicy.WrapTransport(transport)
 	}
 	if os.Getenv(common.EnvVarSSODebug) == "1" {
 		s.client.Transport = httputil.DebugTransport{T: s.client.Transport}
@@ -177,6 +192,35 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id
 	return mgr.signClaims(claims)
 }
 
+// ExchangedTokenActor identifies the workload a project role token was issued to by a token exchange. It is stored in
+// the act claim of the token, as defined by RFC 8693.
+type ExchangedTokenActor struct {
+	Issuer  string `json:"iss"`
+	Subject string `json:"sub"`
+}
+
+type exchangedTokenClaims struct {
+	jwt.RegisteredClaims
+	Actor ExchangedTokenActor `json:"act"`
+}
+
+// CreateExchangedToken creates a project role token on behalf of the given actor, expiring after the given duration
+func (mgr *SessionManager) CreateExchangedToken(subject string, duration time.Duration, id string, actor ExchangedTokenActor) (string, error) {
+	now := time.Now().UTC()
+	claims := exchangedTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    SessionManagerClaimsIssuer,
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
+			Subject:   subject,
+			ID:        id,
+		},
+		Actor: actor,
+	}
+	return mgr.signClaims(claims)
+}
+
 func (mgr *SessionManager) CollectMetrics(registry MetricsRegistry) {
 	mgr.metricsRegistry = registry
 	if mgr.metricsRegistry == nil {
@@ -191,15 +235,62 @@ func (mgr *SessionManager) IncLoginRequestCounter(status string) {
 	}
 }
 
+// IncLoginFailureCounter increments the failed login counter of the given source, the IP of the client
+func (mgr *SessionManager) IncLoginFailureCounter(source string) {
+	if mgr.metricsRegistry != nil {
+		mgr.metricsRegistry.IncLoginFailureCounter(source)
+	}
+}
+
+// signClaims signs the claims with the active signing key, or with the server signature if signing keys are not
+// rotated
 func (mgr *SessionManager) signClaims(claims jwt.Claims) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	settings, err := mgr.settingsMgr.GetSettings()
 	if err != nil {
 		return "", err
 	}
+	if key := settings.ActiveSigningKey(time.Now()); key != nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+		token.Header["kid"] = key.KeyID
+		return token.SignedString(key.PrivateKey)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString(settings.ServerSignature)
 }
 
+// verifyExchangedToken verifies that a project role token issued by a token exchange is short-lived, has not been
+// revoked and that the token exchange binding it was issued for still exists
+func (mgr *SessionManager) verifyExchangedToken(argoCDSettings *settings.ArgoCDSettings, project, role string, claims jwt.MapClaims, actor map[string]any) error {
+	id := jwtutil.StringField(claims, "jti")
+	if id == "" || mgr.storage.IsTokenRevoked(id) {
+		return errors.New("token is revoked")
+	}
+	issuedAt, err := jwtutil.IssuedAtTime(claims)
+	if err != nil {
+		return err
+	}
+	exp, err := jwtutil.ExpirationTime(claims)
+	if err != nil || exp.Sub(issuedAt) > settings.MaxTokenExchangeDuration {
+		return errors.New("exchanged token must expire")
+	}
+	config, err := argoCDSettings.TokenExchangeConfig()
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		return errors.New("token exchange is not configured")
+	}
+	issuerURL, _ := actor["iss"].(string)
+	issuer, err := config.GetIssuer(issuerURL)
+	if err != nil {
+		return err
+	}
+	if !issuer.HasBinding(project, role) {
+		return fmt.Errorf("tokens of issuer %s can no longer be exchanged for role %s of project %s", issuerURL, role, project)
+	}
+	return nil
+}
+
 // GetSubjectAccountAndCapability analyzes Argo CD account token subject and extract account name
 // and the capability it was generated for (default capability is API Key).
 func GetSubjectAccountAndCapability(subject string) (string, settings.AccountCapability) {
@@ -216,6 +307,44 @@ func GetSubjectAccountAndCapability(subject string) (string, settings.AccountCap
 	return subject, capability
 }
 
+// VerifyClientCertificate returns the claims of the local account a verified TLS client certificate is mapped to.
+// The account must be enabled and have the apiKey capability, since the certificate replaces an API token.
+func (mgr *SessionManager) VerifyClientCertificate(cert *x509.Certificate) (jwt.Claims, error) {
+	argoCDSettings, err := mgr.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	config, err := argoCDSettings.ClientCertAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, errors.New("client certificate authentication is not configured")
+	}
+
+	subject, ok := config.AccountForCertificate(cert)
+	if !ok {
+		return nil, fmt.Errorf("client certificate %q is not mapped to any account", cert.Subject.String())
+	}
+	account, err := mgr.settingsMgr.GetAccount(subject)
+	if err != nil {
+		return nil, err
+	}
+	if !account.Enabled {
+		return nil, fmt.Errorf("account %s is disabled", subject)
+	}
+	if !account.HasCapability(settings.AccountCapabilityApiKey) {
+		return nil, fmt.Errorf("account %s does not have '%s' capability", subject, settings.AccountCapabilityApiKey)
+	}
+
+	return jwt.MapClaims{
+		"iss": SessionManagerClaimsIssuer,
+		"sub": subject,
+		"iat": time.Now().Unix(),
+		"exp": cert.NotAfter.Unix(),
+	}, nil
+}
+
 // Parse tries to parse the provided string and returns the token claims for local login.
 func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error) {
 	// Parse takes the token string and a function for looking up the key. The latter is especially
@@ -229,10 +358,19 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 	}
 	token, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (any, error) {
 		// Don't forget to validate the alg is what you expect:
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			return argoCDSettings.ServerSignature, nil
+		case *jwt.SigningMethodECDSA:
+			keyID, _ := token.Header["kid"].(string)
+			key, err := argoCDSettings.TrustedSigningKey(keyID, time.Now())
+			if err != nil {
+				return nil, err
+			}
+			return &key.PrivateKey.PublicKey, nil
+		default:
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return argoCDSettings.ServerSignature, nil
 	})
 	if err != nil {
 		return nil, "", err
@@ -251,6 +389,15 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 		if err != nil {
 			return nil, "", err
 		}
+		if actor, ok := claims["act"].(map[string]any); ok {
+			if _, _, err := proj.GetRoleByName(role); err != nil {
+				return nil, "", err
+			}
+			if err := mgr.verifyExchangedToken(argoCDSettings, proj.Name, role, claims, actor); err != nil {
+				return nil, "", err
+			}
+			return token.Claims, "", nil
+		}
 		_, _, err = proj.GetJWTToken(role, issuedAt.Unix(), id)
 		if err != nil {
 			return nil, "", err
@@ -259,6 +406,16 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 		return token.Claims, "", nil
 	}
 
+	if ldap, _ := claims[ldapClaim].(bool); ldap {
+		if err := mgr.verifyLDAPSession(id); err != nil {
+			return nil, "", err
+		}
+		if err := mgr.verifySessionActivity(context.Background(), claims, argoCDSettings.UserSessionIdleTimeout); err != nil {
+			return nil, "", err
+		}
+		return token.Claims, "", nil
+	}
+
 	subject, capability := GetSubjectAccountAndCapability(subject)
 	claims["sub"] = subject
 
@@ -285,6 +442,12 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 		return nil, "", errors.New("account password has changed since token issued")
 	}
 
+	if capability == settings.AccountCapabilityLogin {
+		if err := mgr.verifySessionActivity(context.Background(), claims, argoCDSettings.UserSessionIdleTimeout); err != nil {
+			return nil, "", err
+		}
+	}
+
 	newToken := ""
 	if exp, err := jwtutil.ExpirationTime(claims); err == nil {
 		tokenExpDuration := exp.Sub(issuedAt)
@@ -385,6 +548,12 @@ func (mgr *SessionManager) updateFailureCount(username string, failed bool) {
 	}
 }
 
+// RecordLoginFailure counts a failed login of the given user which was rejected before the verification of the
+// password, e.g. by the network allowlist of the account
+func (mgr *SessionManager) RecordLoginFailure(username string) {
+	mgr.updateFailureCount(username, true)
+}
+
 // Get the current login failure attempts for given username
 func (mgr *SessionManager) getFailureCount(username string) LoginAttempts {
 	mgr.failedLock.RLock()
@@ -397,6 +566,12 @@ func (mgr *SessionManager) getFailureCount(username string) LoginAttempts {
 	return attempt
 }
 
+// IsLoginLockedOut returns whether the given user failed to log in too many times, and cannot log in until the failure
+// window ends
+func (mgr *SessionManager) IsLoginLockedOut(username string) bool {
+	return mgr.exceededFailedLoginAttempts(mgr.getFailureCount(username))
+}
+
 // Calculate a login delay for the given login attempt
 func (mgr *SessionManager) exceededFailedLoginAttempts(attempt LoginAttempts) bool {
 	maxFails := getMaxLoginFailures()
@@ -519,6 +694,15 @@ func WithAuthMiddleware(disabled bool, authn TokenVerifier, next http.Handler) h
 	})
 }
 
+const (
+	// tokenFailureReasonExpired is the reason of the verification failures of the expired tokens
+	tokenFailureReasonExpired = "expired"
+	// tokenFailureReasonInvalid is the reason of the verification failures of the other tokens
+	tokenFailureReasonInvalid = "invalid"
+	// unknownTokenIssuer is the issuer the verification failures of the tokens of unknown issuers are counted with
+	unknownTokenIssuer = "unknown"
+)
+
 // VerifyToken verifies if a token is correct. Tokens can be issued either from us or by an IDP.
 // We choose how to verify based on the issuer.
 func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, string, error) {
@@ -526,10 +710,46 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, string,
 	claims := jwt.MapClaims{}
 	_, _, err := parser.ParseUnverified(tokenString, &claims)
 	if err != nil {
+		mgr.incTokenVerificationFailureCounter(unknownTokenIssuer, false)
 		return nil, "", err
 	}
 	// Get issuer from MapClaims
 	issuer, _ := claims["iss"].(string)
+	verifiedClaims, newToken, err := mgr.verifyToken(tokenString, issuer)
+	if err != nil {
+		// the expired SSO tokens are reported with dummy claims
+		expired := errors.Is(err, jwt.ErrTokenExpired) || errors.Is(err, common.ErrTokenVerification) && verifiedClaims != nil
+		mgr.incTokenVerificationFailureCounter(mgr.tokenIssuerLabel(issuer), expired)
+	}
+	return verifiedClaims, newToken, err
+}
+
+// tokenIssuerLabel returns the issuer the verification failures of the tokens of the given issuer are counted with:
+// Argo CD, the configured SSO issuer, or unknown, so that forged issuers do not create new metric series
+func (mgr *SessionManager) tokenIssuerLabel(issuer string) string {
+	if issuer == SessionManagerClaimsIssuer {
+		return issuer
+	}
+	if argoSettings, err := mgr.settingsMgr.GetSettings(); err == nil && argoSettings.IsSSOConfigured() && issuer == argoSettings.IssuerURL() {
+		return issuer
+	}
+	return unknownTokenIssuer
+}
+
+// incTokenVerificationFailureCounter increments the token verification failure counter of the given issuer
+func (mgr *SessionManager) incTokenVerificationFailureCounter(issuer string, expired bool) {
+	if mgr.metricsRegistry == nil {
+		return
+	}
+	reason := tokenFailureReasonInvalid
+	if expired {
+		reason = tokenFailureReasonExpired
+	}
+	mgr.metricsRegistry.IncTokenVerificationFailureCounter(issuer, reason)
+}
+
+// verifyToken verifies the token of the given issuer
+func (mgr *SessionManager) verifyToken(tokenString string, issuer string) (jwt.Claims, string, error) {
 	switch issuer {
 	case SessionManagerClaimsIssuer:
 		// Argo CD signed token
@@ -557,7 +777,7 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, string,
 			log.Warnf("Failed to verify token: %s", err)
 			tokenExpiredError := &oidc.TokenExpiredError{}
 			if errors.As(err, &tokenExpiredError) {
-				claims = jwt.MapClaims{
+				claims := jwt.MapClaims{
 					"iss": "sso",
 				}
 				return claims, "", common.ErrTokenVerification
@@ -570,10 +790,51 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, string,
 		if err != nil {
 			return nil, "", err
 		}
+		if oidcConfig := argoSettings.OIDCConfig(); oidcConfig != nil && oidcConfig.ClientCredentials != nil && machineClientID(claims, oidcConfig.ClientCredentials) != "" {
+			return machineClaims(claims, oidcConfig.ClientCredentials), "", nil
+		}
+		if err := mgr.verifySessionActivity(context.Background(), claims, argoSettings.UserSessionIdleTimeout); err != nil {
+			return nil, "", err
+		}
 		return claims, "", nil
 	}
 }
 
+// clientIDClaims are the claims holding the ID of the client an access token was issued to, depending on the provider
+var clientIDClaims = []string{"azp", "client_id", "cid", "appid"}
+
+// machineClaims returns the claims of an access token obtained by a machine identity with the client credentials
+// grant, with its subject replaced by the RBAC subject of the machine identity. The claims of the other tokens are
+// returned unchanged.
+func machineClaims(claims jwt.MapClaims, config *settings.OIDCClientCredentialsConfig) jwt.MapClaims {
+	clientID := machineClientID(claims, config)
+	if clientID == "" {
+		return claims
+	}
+	subject := clientID
+	if config.SubjectClaim != "" {
+		if value := jwtutil.StringField(claims, config.SubjectClaim); value != "" {
+			subject = value
+		}
+	}
+	mapped := maps.Clone(claims)
+	mapped["sub"] = subject
+	// the federated claims identify the users authenticated by Dex, not the machine identities
+	delete(mapped, "federated_claims")
+	return mapped
+}
+
+// machineClientID returns the ID of the machine identity the access token of the given claims was issued to, or an empty
+// string if it was not issued to a machine identity
+func machineClientID(claims jwt.MapClaims, config *settings.OIDCClientCredentialsConfig) string {
+	for _, claim := range clientIDClaims {
+		if value := jwtutil.StringField(claims, claim); value != "" && slices.Contains(config.ClientIDs, value) {
+			return value
+		}
+	}
+	return ""
+}
+
 func (mgr *SessionManager) provider() (oidcutil.Provider, error) {
 	if mgr.prov != nil {
 		return mgr.prov, nil
@@ -603,6 +864,29 @@ func Username(ctx context.Context) string {
 	if !ok {
 		return ""
 	}
+	return UsernameFromClaims(mapClaims)
+}
+
+// Impersonator returns the username of the user impersonating the user of the context, if any
+func Impersonator(ctx context.Context) string {
+	mapClaims, ok := mapClaims(ctx)
+	if !ok {
+		return ""
+	}
+	return jwtutil.StringField(mapClaims, ImpersonatorClaim)
+}
+
+// AuditUsername returns the username recorded in the audit logs, which also names the impersonator, if any
+func AuditUsername(ctx context.Context) string {
+	user := Username(ctx)
+	if impersonator := Impersonator(ctx); impersonator != "" {
+		return fmt.Sprintf("%s (impersonated by %s)", user, impersonator)
+	}
+	return user
+}
+
+// UsernameFromClaims extracts a human readable username from claims
+func UsernameFromClaims(mapClaims jwt.MapClaims) string {
 	switch jwtutil.StringField(mapClaims, "iss") {
 	case SessionManagerClaimsIssuer:
 		return jwtutil.GetUserIdentifier(mapClaims)
@@ -2,6 +2,8 @@ package session
 
 import (
 	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
 	stderrors "errors"
 	"fmt"
@@ -30,6 +32,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/test"
 	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
+	ldaputil "github.com/argoproj/argo-cd/v3/util/ldap"
 	"github.com/argoproj/argo-cd/v3/util/password"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 	utiltest "github.com/argoproj/argo-cd/v3/util/test"
@@ -147,6 +150,37 @@ func TestSessionManager_AdminToken_Revoked(t *testing.T) {
 	assert.EqualError(t, err, "token is revoked, please re-login")
 }
 
+func TestSessionManager_AdminToken_IdleTimeout(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	kubeClient := getKubeClient(t, "pass", true)
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(t.Context(), "argocd-cm", metav1.GetOptions{})
+	require.NoError(t, err)
+	cm.Data["users.session.idle.timeout"] = "30m"
+	_, err = kubeClient.CoreV1().ConfigMaps("argocd").Update(t.Context(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, "argocd")
+	storage := NewUserStateStorage(redisClient)
+	mgr := newSessionManager(settingsMgr, getProjLister(), storage)
+
+	token, err := mgr.Create("admin:login", 0, "abc")
+	require.NoError(t, err)
+
+	// the activity of the session is recorded
+	_, _, err = mgr.Parse(token)
+	require.NoError(t, err)
+	lastSeen, err := storage.GetSessionLastSeen(t.Context(), "argocd|admin|abc")
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), lastSeen, time.Minute)
+
+	// the session expires once it has been idle for longer than the timeout
+	require.NoError(t, storage.SetSessionLastSeen(t.Context(), "argocd|admin|abc", time.Now().Add(-time.Hour), time.Hour))
+	_, _, err = mgr.Parse(token)
+	assert.EqualError(t, err, "session has been idle for too long, please re-login")
+}
+
 func TestSessionManager_AdminToken_Deactivated(t *testing.T) {
 	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClient(t, "pass", false), "argocd")
 	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
@@ -1014,6 +1048,45 @@ allowedAudiences:
 		require.NoError(t, err)
 	})
 
+	t.Run("OIDC provider is external, token obtained with the client credentials grant", func(t *testing.T) {
+		config := map[string]string{
+			"url": "",
+			"oidc.config": fmt.Sprintf(`
+name: Test
+issuer: %s
+clientID: xxx
+clientSecret: yyy
+requestedScopes: ["oidc"]
+clientCredentials:
+  clientIDs: [ci-pipeline]
+  audience: api://argocd`, oidcTestServer.URL),
+			"oidc.tls.insecure.skip.verify": "true", // This isn't what we're testing.
+		}
+
+		settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClientWithConfig(config, nil), "argocd")
+		mgr := NewSessionManager(settingsMgr, getProjLister(), "", nil, NewUserStateStorage(nil))
+		mgr.verificationDelayNoiseEnabled = false
+
+		claims := jwt.MapClaims{
+			"iss": oidcTestServer.URL,
+			"aud": "api://argocd",
+			"sub": "0b6c4d8e-service-account",
+			"azp": "ci-pipeline",
+			"exp": time.Now().Add(time.Hour * 24).Unix(),
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS512, claims)
+		key, err := jwt.ParseRSAPrivateKeyFromPEM(utiltest.PrivateKey)
+		require.NoError(t, err)
+		tokenString, err := token.SignedString(key)
+		require.NoError(t, err)
+
+		verifiedClaims, _, err := mgr.VerifyToken(tokenString)
+		require.NoError(t, err)
+		mapClaims, err := jwtutil.MapClaims(verifiedClaims)
+		require.NoError(t, err)
+		assert.Equal(t, "ci-pipeline", jwtutil.GetUserIdentifier(mapClaims))
+	})
+
 	t.Run("OIDC provider is external, audience is not in allowed list", func(t *testing.T) {
 		config := map[string]string{
 			"url": "",
@@ -1237,3 +1310,209 @@ func Test_PickFailureAttemptWhenOverflowed(t *testing.T) {
 		}
 	})
 }
+
+func TestSessionManager_VerifyClientCertificate(t *testing.T) {
+	kubeClient := getKubeClient(t, "pass", true)
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(t.Context(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	cm.Data["accounts.ci"] = string(settings.AccountCapabilityApiKey)
+	cm.Data["accounts.ui"] = string(settings.AccountCapabilityLogin)
+	cm.Data["clientcert.config"] = `
+caCert: ca
+mappings:
+- commonName: ci
+  account: ci
+- commonName: ui
+  account: ui
+`
+	_, err = kubeClient.CoreV1().ConfigMaps("argocd").Update(t.Context(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
+	notAfter := time.Now().Add(time.Hour)
+
+	t.Run("Mapped account", func(t *testing.T) {
+		claims, err := mgr.VerifyClientCertificate(&x509.Certificate{Subject: pkix.Name{CommonName: "ci"}, NotAfter: notAfter})
+		require.NoError(t, err)
+		mapClaims := claims.(jwt.MapClaims)
+		assert.Equal(t, "ci", mapClaims["sub"])
+		assert.Equal(t, SessionManagerClaimsIssuer, mapClaims["iss"])
+		assert.Equal(t, notAfter.Unix(), mapClaims["exp"])
+	})
+
+	t.Run("Account without the apiKey capability", func(t *testing.T) {
+		_, err := mgr.VerifyClientCertificate(&x509.Certificate{Subject: pkix.Name{CommonName: "ui"}, NotAfter: notAfter})
+		require.ErrorContains(t, err, "account ui does not have 'apiKey' capability")
+	})
+
+	t.Run("Unmapped certificate", func(t *testing.T) {
+		_, err := mgr.VerifyClientCertificate(&x509.Certificate{Subject: pkix.Name{CommonName: "unknown"}, NotAfter: notAfter})
+		require.ErrorContains(t, err, "is not mapped to any account")
+	})
+}
+
+func TestSessionManager_ExchangedProjectToken(t *testing.T) {
+	kubeClient := getKubeClientWithConfig(map[string]string{
+		"tokenexchange.config": `
+issuers:
+- issuer: https://token.actions.githubusercontent.com
+  audience: argocd
+  bindings:
+  - project: default
+    role: ci
+    claims:
+      repository: argoproj/argo-cd
+`,
+	}, nil)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, "argocd")
+	proj := appv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "default",
+			Namespace: "argocd",
+		},
+		Spec: appv1.AppProjectSpec{Roles: []appv1.ProjectRole{{Name: "ci"}, {Name: "other"}}},
+	}
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+	mgr := newSessionManager(settingsMgr, getProjLister(&proj), NewUserStateStorage(redisClient))
+	actor := ExchangedTokenActor{Issuer: "https://token.actions.githubusercontent.com", Subject: "repo:argoproj/argo-cd:ref:refs/heads/main"}
+
+	t.Run("Valid token", func(t *testing.T) {
+		jwtToken, err := mgr.CreateExchangedToken("proj:default:ci", time.Minute, "abc", actor)
+		require.NoError(t, err)
+
+		claims, _, err := mgr.Parse(jwtToken)
+		require.NoError(t, err)
+		mapClaims, err := jwtutil.MapClaims(claims)
+		require.NoError(t, err)
+		assert.Equal(t, "proj:default:ci", mapClaims["sub"])
+		assert.Equal(t, map[string]any{"iss": actor.Issuer, "sub": actor.Subject}, mapClaims["act"])
+	})
+
+	t.Run("Role without binding", func(t *testing.T) {
+		jwtToken, err := mgr.CreateExchangedToken("proj:default:other", time.Minute, "abc", actor)
+		require.NoError(t, err)
+
+		_, _, err = mgr.Parse(jwtToken)
+		require.ErrorContains(t, err, "can no longer be exchanged for role other of project default")
+	})
+
+	t.Run("Untrusted issuer", func(t *testing.T) {
+		jwtToken, err := mgr.CreateExchangedToken("proj:default:ci", time.Minute, "abc", ExchangedTokenActor{Issuer: "https://accounts.google.com"})
+		require.NoError(t, err)
+
+		_, _, err = mgr.Parse(jwtToken)
+		require.ErrorContains(t, err, "is not trusted")
+	})
+
+	t.Run("Token revoked", func(t *testing.T) {
+		jwtToken, err := mgr.CreateExchangedToken("proj:default:ci", time.Minute, "revoked", actor)
+		require.NoError(t, err)
+		require.NoError(t, mgr.RevokeToken(t.Context(), "revoked", time.Minute))
+
+		_, _, err = mgr.Parse(jwtToken)
+		require.ErrorContains(t, err, "token is revoked")
+	})
+}
+
+func TestSessionManager_RotatedSigningKeys(t *testing.T) {
+	kubeClient := getKubeClient(t, "pass", true)
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(t.Context(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	cm.Data["server.signingkeys.rotation.period"] = "24h"
+	_, err = kubeClient.CoreV1().ConfigMaps("argocd").Update(t.Context(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
+
+	// tokens are signed with the server signature until the first signing key is activated
+	legacyToken, err := mgr.Create("admin:login", 0, "legacy")
+	require.NoError(t, err)
+
+	require.NoError(t, settingsMgr.RotateSigningKeys(time.Now().Add(-settings.SigningKeyActivationDelay)))
+	argoCDSettings, err := settingsMgr.GetSettings()
+	require.NoError(t, err)
+	require.Len(t, argoCDSettings.SigningKeys, 1)
+
+	token, err := mgr.Create("admin:login", 0, "rotated")
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, jwt.SigningMethodES256, parsed.Method)
+	assert.Equal(t, argoCDSettings.SigningKeys[0].KeyID, parsed.Header["kid"])
+
+	_, _, err = mgr.Parse(token)
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(legacyToken)
+	require.NoError(t, err, "tokens signed with the server signature remain valid")
+
+	parsed.Header["kid"] = "unknown"
+	forged, err := parsed.SignedString(argoCDSettings.SigningKeys[0].PrivateKey)
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(forged)
+	require.ErrorContains(t, err, `signing key "unknown" is not trusted`)
+}
+
+func TestMachineClaims(t *testing.T) {
+	config := &settings.OIDCClientCredentialsConfig{ClientIDs: []string{"ci-pipeline", "deployer"}}
+
+	userClaims := jwt.MapClaims{"sub": "user-id", "azp": "argo-cd", "email": "user@example.com"}
+	assert.Equal(t, userClaims, machineClaims(userClaims, config))
+
+	oktaClaims := jwt.MapClaims{"sub": "deployer", "cid": "deployer"}
+	assert.Equal(t, "deployer", machineClaims(oktaClaims, config)["sub"])
+
+	keycloakClaims := jwt.MapClaims{
+		"sub":              "0b6c4d8e-service-account",
+		"azp":              "ci-pipeline",
+		"federated_claims": map[string]any{"user_id": "someone"},
+	}
+	mapped := machineClaims(keycloakClaims, config)
+	assert.Equal(t, "ci-pipeline", jwtutil.GetUserIdentifier(mapped))
+	assert.Equal(t, "0b6c4d8e-service-account", keycloakClaims["sub"], "the claims are not modified")
+
+	config.SubjectClaim = "sub"
+	assert.Equal(t, "0b6c4d8e-service-account", jwtutil.GetUserIdentifier(machineClaims(keycloakClaims, config)))
+}
+
+func TestSessionManager_LDAPSession(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	ldapConfig := map[string]string{"ldap.config": "{host: ldap.example.com, userSearch: {baseDN: dc=example, username: uid}}"}
+	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClientWithConfig(ldapConfig, nil), "argocd")
+	storage := NewUserStateStorage(redisClient)
+	mgr := newSessionManager(settingsMgr, getProjLister(), storage)
+
+	identity, err := mgr.VerifyLDAPUsernamePassword("admin", "password")
+	require.NoError(t, err)
+	assert.Nil(t, identity, "local accounts take precedence")
+
+	_, err = mgr.VerifyLDAPUsernamePassword("proj:my-proj:my-role", "password")
+	assert.Equal(t, InvalidLoginErr, err)
+
+	token, err := mgr.CreateLDAPSession(&ldaputil.Identity{Username: "alice", Email: "alice@example.com", Groups: []string{"developers"}}, 0, "123")
+	require.NoError(t, err)
+
+	claims, newToken, err := mgr.Parse(token)
+	require.NoError(t, err)
+	assert.Empty(t, newToken)
+	assert.Equal(t, "alice", jwtutil.GetUserIdentifier(*(claims.(*jwt.MapClaims))))
+	assert.Equal(t, []string{"developers"}, jwtutil.GetGroups(*(claims.(*jwt.MapClaims)), []string{"groups"}))
+
+	t.Run("LDAP disabled", func(t *testing.T) {
+		settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClientWithConfig(map[string]string{}, nil), "argocd")
+		mgr := newSessionManager(settingsMgr, getProjLister(), storage)
+
+		identity, err := mgr.VerifyLDAPUsernamePassword("alice", "password")
+		require.NoError(t, err)
+		assert.Nil(t, identity)
+
+		_, _, err = mgr.Parse(token)
+		assert.EqualError(t, err, "LDAP authentication is disabled, please re-login")
+	})
+
+	require.NoError(t, storage.RevokeToken(t.Context(), "123", time.Hour))
+	_, _, err = mgr.Parse(token)
+	assert.EqualError(t, err, "token is revoked, please re-login")
+}
@@ -2,6 +2,9 @@ package session
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -13,8 +16,9 @@ import (
 )
 
 const (
-	revokedTokenPrefix = "revoked-token|"
-	newRevokedTokenKey = "new-revoked-token"
+	revokedTokenPrefix    = "revoked-token|"
+	newRevokedTokenKey    = "new-revoked-token"
+	sessionLastSeenPrefix = "session-last-seen|"
 )
 
 type userStateStorage struct {
@@ -24,17 +28,25 @@ type userStateStorage struct {
 	recentRevokedTokens map[string]bool
 	lock                sync.RWMutex
 	resyncDuration      time.Duration
+	// keyPrefix is the prefix of the keys and of the channels of the storage in Redis
+	keyPrefix string
 }
 
 var _ UserStateStorage = &userStateStorage{}
 
 func NewUserStateStorage(redis *redis.Client) *userStateStorage {
+	return NewUserStateStorageWithKeyPrefix(redis, "")
+}
+
+// NewUserStateStorageWithKeyPrefix returns a storage prefixing its keys and its channels in Redis with the given prefix
+func NewUserStateStorageWithKeyPrefix(redis *redis.Client, keyPrefix string) *userStateStorage {
 	return &userStateStorage{
 		attempts:            map[string]LoginAttempts{},
 		revokedTokens:       map[string]bool{},
 		recentRevokedTokens: map[string]bool{},
 		resyncDuration:      time.Second * 15,
 		redis:               redis,
+		keyPrefix:           keyPrefix,
 	}
 }
 
@@ -56,7 +68,7 @@ func (storage *userStateStorage) Init(ctx context.Context) {
 }
 
 func (storage *userStateStorage) watchRevokedTokens(ctx context.Context) {
-	pubsub := storage.redis.Subscribe(ctx, newRevokedTokenKey)
+	pubsub := storage.redis.Subscribe(ctx, storage.keyPrefix+newRevokedTokenKey)
 	defer utilio.Close(pubsub)
 
 	ch := pubsub.Channel()
@@ -84,9 +96,9 @@ func (storage *userStateStorage) loadRevokedTokensSafe() {
 
 func (storage *userStateStorage) loadRevokedTokens() error {
 	redisRevokedTokens := map[string]bool{}
-	iterator := storage.redis.Scan(context.Background(), 0, revokedTokenPrefix+"*", 10000).Iterator()
+	iterator := storage.redis.Scan(context.Background(), 0, storage.keyPrefix+revokedTokenPrefix+"*", 10000).Iterator()
 	for iterator.Next(context.Background()) {
-		parts := strings.Split(iterator.Val(), "|")
+		parts := strings.Split(strings.TrimPrefix(iterator.Val(), storage.keyPrefix), "|")
 		if len(parts) != 2 {
 			log.Warnf("Unexpected redis key prefixed with '%s'. Must have token id after the prefix but got: '%s'.",
 				revokedTokenPrefix,
@@ -124,10 +136,10 @@ func (storage *userStateStorage) RevokeToken(ctx context.Context, id string, exp
 	storage.revokedTokens[id] = true
 	storage.recentRevokedTokens[id] = true
 	storage.lock.Unlock()
-	if err := storage.redis.Set(ctx, revokedTokenPrefix+id, "", expiringAt).Err(); err != nil {
+	if err := storage.redis.Set(ctx, storage.keyPrefix+revokedTokenPrefix+id, "", expiringAt).Err(); err != nil {
 		return err
 	}
-	return storage.redis.Publish(ctx, newRevokedTokenKey, id).Err()
+	return storage.redis.Publish(ctx, storage.keyPrefix+newRevokedTokenKey, id).Err()
 }
 
 func (storage *userStateStorage) IsTokenRevoked(id string) bool {
@@ -136,6 +148,24 @@ func (storage *userStateStorage) IsTokenRevoked(id string) bool {
 	return storage.revokedTokens[id]
 }
 
+func (storage *userStateStorage) GetSessionLastSeen(ctx context.Context, key string) (time.Time, error) {
+	val, err := storage.redis.Get(ctx, storage.keyPrefix+sessionLastSeenPrefix+key).Result()
+	if errors.Is(err, redis.Nil) {
+		return time.Time{}, nil
+	} else if err != nil {
+		return time.Time{}, err
+	}
+	unixMilli, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid last seen time of session %s: %w", key, err)
+	}
+	return time.UnixMilli(unixMilli), nil
+}
+
+func (storage *userStateStorage) SetSessionLastSeen(ctx context.Context, key string, lastSeen time.Time, expiringAt time.Duration) error {
+	return storage.redis.Set(ctx, storage.keyPrefix+sessionLastSeenPrefix+key, strconv.FormatInt(lastSeen.UnixMilli(), 10), expiringAt).Err()
+}
+
 func (storage *userStateStorage) GetLockObject() *sync.RWMutex {
 	return &storage.lock
 }
@@ -150,6 +180,11 @@ type UserStateStorage interface {
 	RevokeToken(ctx context.Context, id string, expiringAt time.Duration) error
 	// IsTokenRevoked checks if given token is revoked
 	IsTokenRevoked(id string) bool
+	// GetSessionLastSeen returns the time the session of the given key was last seen active, or the zero time if unknown
+	GetSessionLastSeen(ctx context.Context, key string) (time.Time, error)
+	// SetSessionLastSeen records the time the session of the given key was last seen active (the record expires after
+	// specified timeout)
+	SetSessionLastSeen(ctx context.Context, key string, lastSeen time.Time, expiringAt time.Duration) error
 	// GetLockObject returns a lock used by the storage
 	GetLockObject() *sync.RWMutex
 }
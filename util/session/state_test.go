@@ -27,3 +27,36 @@ func TestUserStateStorage_LoadRevokedTokens(t *testing.T) {
 
 	assert.True(t, storage.IsTokenRevoked("abc"))
 }
+
+func TestUserStateStorage_SessionLastSeen(t *testing.T) {
+	redis, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	storage := NewUserStateStorageWithKeyPrefix(redis, "argocd-1|")
+	lastSeen, err := storage.GetSessionLastSeen(t.Context(), "argocd|admin|abc")
+	require.NoError(t, err)
+	assert.True(t, lastSeen.IsZero())
+
+	now := time.Now()
+	require.NoError(t, storage.SetSessionLastSeen(t.Context(), "argocd|admin|abc", now, time.Hour))
+	lastSeen, err = storage.GetSessionLastSeen(t.Context(), "argocd|admin|abc")
+	require.NoError(t, err)
+	assert.Equal(t, now.UnixMilli(), lastSeen.UnixMilli())
+	assert.InDelta(t, time.Hour.Seconds(), redis.TTL(t.Context(), "argocd-1|"+sessionLastSeenPrefix+"argocd|admin|abc").Val().Seconds(), 1)
+}
+
+func TestUserStateStorage_KeyPrefix(t *testing.T) {
+	redis, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	require.NoError(t, redis.Set(t.Context(), "other|"+revokedTokenPrefix+"abc", "", time.Hour).Err())
+	storage := NewUserStateStorageWithKeyPrefix(redis, "argocd-1|")
+	require.NoError(t, storage.RevokeToken(t.Context(), "def", time.Hour))
+	assert.Equal(t, int64(1), redis.Exists(t.Context(), "argocd-1|"+revokedTokenPrefix+"def").Val())
+
+	// the tokens revoked by the other instances are ignored
+	storage = NewUserStateStorageWithKeyPrefix(redis, "argocd-1|")
+	require.NoError(t, storage.loadRevokedTokens())
+	assert.True(t, storage.IsTokenRevoked("def"))
+	assert.False(t, storage.IsTokenRevoked("abc"))
+}
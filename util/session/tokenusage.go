@@ -0,0 +1,114 @@
+package session
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	gocache "github.com/patrickmn/go-cache"
+
+	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
+)
+
+// TokenUsageAnomaly is an unusual usage of a token
+type TokenUsageAnomaly string
+
+const (
+	// TokenUsageAnomalyNewIP is the usage of a token from another client IP than the ones it was used from
+	TokenUsageAnomalyNewIP TokenUsageAnomaly = "new_ip"
+	// TokenUsageAnomalyNewUserAgent is the usage of a token with another user agent than the ones it was used with
+	TokenUsageAnomalyNewUserAgent TokenUsageAnomaly = "new_user_agent"
+)
+
+const (
+	// tokenUsageExpiration is the duration the clients a token was used from are remembered for after its first usage
+	tokenUsageExpiration = 24 * time.Hour
+	// maxTrackedTokens is the maximum number of tokens whose usage is tracked, to bound the memory of the tracking
+	maxTrackedTokens = 10000
+	// maxTrackedTokenClients is the maximum number of client IPs and user agents tracked per token
+	maxTrackedTokenClients = 20
+)
+
+// tokenClients are the client IPs and user agents a token was used from
+type tokenClients struct {
+	ips        map[string]bool
+	userAgents map[string]bool
+}
+
+// tokenUsageTracker tracks the clients the tokens are used from, in memory
+type tokenUsageTracker struct {
+	lock   sync.Mutex
+	tokens *gocache.Cache
+}
+
+func newTokenUsageTracker() *tokenUsageTracker {
+	return &tokenUsageTracker{tokens: gocache.New(tokenUsageExpiration, time.Hour)}
+}
+
+// observe records that the token of the given key was used from the given client, and returns the anomalies of the
+// usage. The first usage of a token is never an anomaly.
+func (t *tokenUsageTracker) observe(key, clientIP, userAgent string) []TokenUsageAnomaly {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	value, ok := t.tokens.Get(key)
+	if !ok {
+		if t.tokens.ItemCount() >= maxTrackedTokens {
+			return nil
+		}
+		clients := &tokenClients{ips: map[string]bool{}, userAgents: map[string]bool{}}
+		addTokenClient(clients.ips, clientIP)
+		addTokenClient(clients.userAgents, userAgent)
+		t.tokens.SetDefault(key, clients)
+		return nil
+	}
+	clients := value.(*tokenClients)
+	var anomalies []TokenUsageAnomaly
+	if addTokenClient(clients.ips, clientIP) && len(clients.ips) > 1 {
+		anomalies = append(anomalies, TokenUsageAnomalyNewIP)
+	}
+	if addTokenClient(clients.userAgents, userAgent) && len(clients.userAgents) > 1 {
+		anomalies = append(anomalies, TokenUsageAnomalyNewUserAgent)
+	}
+	return anomalies
+}
+
+// addTokenClient adds the given client IP or user agent to the given ones, and returns whether it is new
+func addTokenClient(clients map[string]bool, client string) bool {
+	if client == "" || clients[client] || len(clients) >= maxTrackedTokenClients {
+		return false
+	}
+	clients[client] = true
+	return true
+}
+
+// tokenUsageKey returns the key identifying the token of the given claims, or an empty string if the token cannot be
+// identified
+func tokenUsageKey(claims jwt.MapClaims) string {
+	issuer := jwtutil.StringField(claims, "iss")
+	subject := jwtutil.GetUserIdentifier(claims)
+	if id := jwtutil.StringField(claims, "jti"); id != "" {
+		return fmt.Sprintf("%s|%s|%s", issuer, subject, id)
+	}
+	if issuedAt, err := jwtutil.IssuedAtTime(claims); err == nil {
+		return fmt.Sprintf("%s|%s|%d", issuer, subject, issuedAt.Unix())
+	}
+	return ""
+}
+
+// ObserveTokenUsage records that the token of the given claims was used from the given client IP and user agent, and
+// returns the anomalies of the usage: the token was previously used from other client IPs or with other user agents.
+// The usage is tracked in memory, by each API server replica.
+func (mgr *SessionManager) ObserveTokenUsage(claims jwt.MapClaims, clientIP, userAgent string) []TokenUsageAnomaly {
+	key := tokenUsageKey(claims)
+	if key == "" {
+		return nil
+	}
+	anomalies := mgr.tokenUsage.observe(key, clientIP, userAgent)
+	if mgr.metricsRegistry != nil {
+		for _, anomaly := range anomalies {
+			mgr.metricsRegistry.IncTokenUsageAnomalyCounter(string(anomaly))
+		}
+	}
+	return anomalies
+}
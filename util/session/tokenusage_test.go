@@ -0,0 +1,91 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// fakeMetricsRegistry counts the metrics reported by the session manager
+type fakeMetricsRegistry struct {
+	loginRequests       map[string]int
+	loginFailures       map[string]int
+	tokenFailures       map[string]int
+	tokenUsageAnomalies map[string]int
+}
+
+func newFakeMetricsRegistry() *fakeMetricsRegistry {
+	return &fakeMetricsRegistry{loginRequests: map[string]int{}, loginFailures: map[string]int{}, tokenFailures: map[string]int{}, tokenUsageAnomalies: map[string]int{}}
+}
+
+func (r *fakeMetricsRegistry) IncLoginRequestCounter(status string) {
+	r.loginRequests[status]++
+}
+
+func (r *fakeMetricsRegistry) IncLoginFailureCounter(source string) {
+	r.loginFailures[source]++
+}
+
+func (r *fakeMetricsRegistry) IncTokenVerificationFailureCounter(issuer string, reason string) {
+	r.tokenFailures[issuer+"/"+reason]++
+}
+
+func (r *fakeMetricsRegistry) IncTokenUsageAnomalyCounter(anomaly string) {
+	r.tokenUsageAnomalies[anomaly]++
+}
+
+func TestSessionManager_ObserveTokenUsage(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClient(t, "pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
+	registry := newFakeMetricsRegistry()
+	mgr.CollectMetrics(registry)
+	claims := jwt.MapClaims{"iss": "argocd", "sub": "admin", "jti": "123"}
+
+	// the first usage of a token is never an anomaly
+	assert.Empty(t, mgr.ObserveTokenUsage(claims, "10.0.0.1", "argocd-client/3.1.0"))
+	assert.Empty(t, mgr.ObserveTokenUsage(claims, "10.0.0.1", "argocd-client/3.1.0"))
+	assert.Equal(t, []TokenUsageAnomaly{TokenUsageAnomalyNewIP}, mgr.ObserveTokenUsage(claims, "203.0.113.1", "argocd-client/3.1.0"))
+	assert.Equal(t, []TokenUsageAnomaly{TokenUsageAnomalyNewUserAgent}, mgr.ObserveTokenUsage(claims, "10.0.0.1", "curl/8.5.0"))
+	// the known clients are not anomalies anymore
+	assert.Empty(t, mgr.ObserveTokenUsage(claims, "203.0.113.1", "curl/8.5.0"))
+	// other tokens of the same user are tracked separately
+	assert.Empty(t, mgr.ObserveTokenUsage(jwt.MapClaims{"iss": "argocd", "sub": "admin", "jti": "456"}, "198.51.100.1", "Mozilla/5.0"))
+	// the tokens which cannot be identified are not tracked
+	assert.Empty(t, mgr.ObserveTokenUsage(jwt.MapClaims{"sub": "admin"}, "198.51.100.1", "Mozilla/5.0"))
+	assert.Empty(t, mgr.ObserveTokenUsage(jwt.MapClaims{"sub": "admin"}, "198.51.100.2", "Mozilla/5.0"))
+
+	assert.Equal(t, map[string]int{"new_ip": 1, "new_user_agent": 1}, registry.tokenUsageAnomalies)
+}
+
+func TestSessionManager_VerifyToken_FailureMetrics(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClient(t, "pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
+	registry := newFakeMetricsRegistry()
+	mgr.CollectMetrics(registry)
+
+	expiredToken, err := mgr.signClaims(jwt.RegisteredClaims{
+		Issuer:    SessionManagerClaimsIssuer,
+		Subject:   "admin:login",
+		ID:        "123",
+		IssuedAt:  jwt.NewNumericDate(time.Now().Add(-2 * time.Hour)),
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+	})
+	require.NoError(t, err)
+	_, _, err = mgr.VerifyToken(expiredToken)
+	require.ErrorIs(t, err, jwt.ErrTokenExpired)
+	_, _, err = mgr.VerifyToken("not-a-token")
+	require.Error(t, err)
+
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iss": "https://forged.example.com", "sub": "admin"})
+	forgedToken, err := forged.SignedString([]byte("forged"))
+	require.NoError(t, err)
+	_, _, err = mgr.VerifyToken(forgedToken)
+	require.Error(t, err)
+
+	assert.Equal(t, map[string]int{"argocd/expired": 1, "unknown/invalid": 2}, registry.tokenFailures)
+}
@@ -3,6 +3,7 @@ package settings
 import (
 	"encoding/json"
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -22,6 +23,8 @@ const (
 	accountPasswordMtimeSuffix = "passwordMtime"
 	accountEnabledSuffix       = "enabled"
 	accountTokensSuffix        = "tokens"
+	// accountLoginAllowedCIDRsSuffix designates the suffix of the key holding the networks the account can log in from
+	accountLoginAllowedCIDRsSuffix = "loginAllowedCIDRs"
 
 	// Admin superuser password storage
 	// settingAdminPasswordHashKey designates the key for a root password hash inside a Kubernetes secret.
@@ -30,6 +33,14 @@ const (
 	settingAdminPasswordMtimeKey = "admin.passwordMtime"
 	settingAdminEnabledKey       = "admin.enabled"
 	settingAdminTokensKey        = "admin.tokens"
+	// settingAdminLoginAllowedCIDRsKey designates the key holding the networks the admin user can log in from
+	settingAdminLoginAllowedCIDRsKey = "admin.loginAllowedCIDRs"
+	// settingLocalUsersLoginAllowedCIDRsKey designates the key holding the networks the local accounts other than admin
+	// can log in from, unless set for the account
+	settingLocalUsersLoginAllowedCIDRsKey = "users.local.loginAllowedCIDRs"
+	// settingLoginTrustedProxyCIDRsKey designates the key holding the networks of the proxies whose X-Forwarded-For
+	// header is trusted to determine the address of the clients logging in
+	settingLoginTrustedProxyCIDRsKey = "users.login.trustedProxyCIDRs"
 )
 
 type AccountCapability string
@@ -55,6 +66,8 @@ type Account struct {
 	Enabled       bool
 	Capabilities  []AccountCapability
 	Tokens        []Token
+	// LoginAllowedCIDRs are the networks or addresses the account can log in from, any if empty
+	LoginAllowedCIDRs []string
 }
 
 // FormatPasswordMtime return the formatted password modify time or empty string of password modify time is nil.
@@ -94,6 +107,58 @@ func (a *Account) HasCapability(capability AccountCapability) bool {
 	return false
 }
 
+// IsLoginAllowedFrom returns true if the account can log in from the given address. Invalid networks never match, so
+// that a misconfigured allowlist denies the logins.
+func (a *Account) IsLoginAllowedFrom(ip net.IP) bool {
+	if len(a.LoginAllowedCIDRs) == 0 {
+		return true
+	}
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range a.LoginAllowedCIDRs {
+		if _, network, err := net.ParseCIDR(cidr); err == nil {
+			if network.Contains(ip) {
+				return true
+			}
+		} else if allowedIP := net.ParseIP(cidr); allowedIP != nil && allowedIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetLoginTrustedProxies returns the networks of the proxies whose X-Forwarded-For header is trusted to determine the
+// address of the clients logging in
+func (mgr *SettingsManager) GetLoginTrustedProxies() ([]*net.IPNet, error) {
+	cm, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	var proxies []*net.IPNet
+	for _, cidr := range parseCIDRList(cm.Data[settingLoginTrustedProxyCIDRsKey]) {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			log.Warnf("ConfigMap has invalid network %q in key %s: %v", cidr, settingLoginTrustedProxyCIDRsKey, err)
+			continue
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+// parseCIDRList parses a comma separated list of networks. The list is not nil, even if empty, so that an empty value
+// can override a default list.
+func parseCIDRList(val string) []string {
+	cidrs := make([]string, 0)
+	for _, cidr := range strings.Split(val, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr != "" {
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	return cidrs
+}
+
 func (mgr *SettingsManager) saveAccount(name string, account Account) error {
 	return mgr.updateSecret(func(secret *corev1.Secret) error {
 		return mgr.updateConfigMap(func(cm *corev1.ConfigMap) error {
@@ -216,6 +281,10 @@ func parseAdminAccount(secret *corev1.Secret, cm *corev1.ConfigMap) (*Account, e
 		}
 	}
 
+	if cidrs, ok := cm.Data[settingAdminLoginAllowedCIDRsKey]; ok {
+		adminAccount.LoginAllowedCIDRs = parseCIDRList(cidrs)
+	}
+
 	if enabledStr, ok := cm.Data[settingAdminEnabledKey]; ok {
 		if enabled, err := strconv.ParseBool(enabledStr); err == nil {
 			adminAccount.Enabled = enabled
@@ -283,6 +352,8 @@ func parseAccounts(secret *corev1.Secret, cm *corev1.ConfigMap) (map[string]Acco
 			if err != nil {
 				return nil, err
 			}
+		case accountLoginAllowedCIDRsSuffix:
+			account.LoginAllowedCIDRs = parseCIDRList(val)
 		}
 		accounts[accountName] = account
 	}
@@ -292,6 +363,9 @@ func parseAccounts(secret *corev1.Secret, cm *corev1.ConfigMap) (map[string]Acco
 			continue
 		}
 
+		if cidrs, ok := cm.Data[settingLocalUsersLoginAllowedCIDRsKey]; ok && account.LoginAllowedCIDRs == nil {
+			account.LoginAllowedCIDRs = parseCIDRList(cidrs)
+		}
 		if passwordHash, ok := secret.Data[fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountPasswordSuffix)]; ok {
 			account.PasswordHash = string(passwordHash)
 		}
@@ -1,6 +1,7 @@
 package settings
 
 import (
+	"net"
 	"testing"
 	"time"
 
@@ -105,6 +106,49 @@ func TestGetAdminAccount(t *testing.T) {
 	assert.Equal(t, mTime, acc.FormatPasswordMtime())
 }
 
+func TestGetAccounts_LoginAllowedCIDRs(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"admin.loginAllowedCIDRs":          "10.0.0.0/8",
+		"users.local.loginAllowedCIDRs":    "192.168.0.0/16, 172.16.0.1",
+		"accounts.alice":                   "login",
+		"accounts.bob":                     "login",
+		"accounts.bob.loginAllowedCIDRs":   "",
+		"accounts.carol":                   "login",
+		"accounts.carol.loginAllowedCIDRs": "2001:db8::/32",
+	})
+	accounts, err := settingsManager.GetAccounts()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"10.0.0.0/8"}, accounts[common.ArgoCDAdminUsername].LoginAllowedCIDRs)
+	assert.Equal(t, []string{"192.168.0.0/16", "172.16.0.1"}, accounts["alice"].LoginAllowedCIDRs)
+	assert.Empty(t, accounts["bob"].LoginAllowedCIDRs, "the default is overridden")
+	assert.Equal(t, []string{"2001:db8::/32"}, accounts["carol"].LoginAllowedCIDRs)
+}
+
+func TestIsLoginAllowedFrom(t *testing.T) {
+	acc := Account{}
+	assert.True(t, acc.IsLoginAllowedFrom(net.ParseIP("10.1.2.3")))
+	assert.True(t, acc.IsLoginAllowedFrom(nil))
+
+	acc.LoginAllowedCIDRs = []string{"10.0.0.0/8", "192.168.1.10", "2001:db8::/32", "invalid"}
+	assert.True(t, acc.IsLoginAllowedFrom(net.ParseIP("10.1.2.3")))
+	assert.True(t, acc.IsLoginAllowedFrom(net.ParseIP("192.168.1.10")))
+	assert.True(t, acc.IsLoginAllowedFrom(net.ParseIP("2001:db8::1")))
+	assert.False(t, acc.IsLoginAllowedFrom(net.ParseIP("192.168.1.11")))
+	assert.False(t, acc.IsLoginAllowedFrom(nil))
+
+	acc.LoginAllowedCIDRs = []string{"invalid"}
+	assert.False(t, acc.IsLoginAllowedFrom(net.ParseIP("10.1.2.3")), "a misconfigured allowlist denies the logins")
+}
+
+func TestGetLoginTrustedProxies(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{"users.login.trustedProxyCIDRs": "10.0.0.0/8, invalid"})
+	proxies, err := settingsManager.GetLoginTrustedProxies()
+	require.NoError(t, err)
+	require.Len(t, proxies, 1)
+	assert.Equal(t, "10.0.0.0/8", proxies[0].String())
+}
+
 func TestFormatPasswordMtime_SuccessfullyFormatted(t *testing.T) {
 	mTime := time.Now()
 	acc := Account{PasswordMtime: &mTime}
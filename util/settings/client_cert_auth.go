@@ -0,0 +1,87 @@
+package settings
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+// settingsClientCertAuthConfigKey designates the key for the configuration of the authentication with TLS client
+// certificates
+const settingsClientCertAuthConfigKey = "clientcert.config"
+
+// ClientCertAuthConfig configures the authentication of API clients with TLS client certificates
+type ClientCertAuthConfig struct {
+	// CACert holds the PEM encoded certificates of the CAs issuing the client certificates
+	CACert string `json:"caCert,omitempty"`
+	// Mappings maps the identity of a client certificate to a local account. The first matching mapping is used.
+	Mappings []ClientCertMapping `json:"mappings,omitempty"`
+}
+
+// ClientCertMapping maps a client certificate, identified either by its SPIFFE ID or by its subject common name, to
+// a local account
+type ClientCertMapping struct {
+	// SPIFFEID matches the SPIFFE ID of the certificate, which is its URI SAN with the spiffe scheme
+	SPIFFEID string `json:"spiffeID,omitempty"`
+	// CommonName matches the common name of the certificate subject
+	CommonName string `json:"commonName,omitempty"`
+	// Account is the name of the local account the certificate authenticates as
+	Account string `json:"account"`
+}
+
+// ClientCertAuthConfig returns the configuration of the authentication with TLS client certificates, or nil if it is
+// not configured
+func (a *ArgoCDSettings) ClientCertAuthConfig() (*ClientCertAuthConfig, error) {
+	if a.ClientCertAuthConfigRAW == "" {
+		return nil, nil
+	}
+	return unmarshalClientCertAuthConfig(a.ClientCertAuthConfigRAW)
+}
+
+func unmarshalClientCertAuthConfig(configStr string) (*ClientCertAuthConfig, error) {
+	var config ClientCertAuthConfig
+	if err := yaml.Unmarshal([]byte(configStr), &config); err != nil {
+		return nil, fmt.Errorf("invalid client certificate authentication config: %w", err)
+	}
+	if config.CACert == "" {
+		return nil, errors.New("invalid client certificate authentication config: caCert is required")
+	}
+	for i, mapping := range config.Mappings {
+		if mapping.Account == "" {
+			return nil, fmt.Errorf("invalid client certificate authentication config: mapping %d has no account", i)
+		}
+		if (mapping.SPIFFEID == "") == (mapping.CommonName == "") {
+			return nil, fmt.Errorf("invalid client certificate authentication config: mapping %d must set exactly one of spiffeID and commonName", i)
+		}
+	}
+	return &config, nil
+}
+
+// CertPool returns the pool of the CAs issuing the client certificates
+func (c *ClientCertAuthConfig) CertPool() (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(c.CACert)) {
+		return nil, errors.New("invalid client certificate authentication config: caCert holds no valid PEM certificate")
+	}
+	return pool, nil
+}
+
+// AccountForCertificate returns the local account the given client certificate is mapped to
+func (c *ClientCertAuthConfig) AccountForCertificate(cert *x509.Certificate) (string, bool) {
+	for _, mapping := range c.Mappings {
+		if mapping.SPIFFEID != "" {
+			for _, uri := range cert.URIs {
+				if uri.Scheme == "spiffe" && uri.String() == mapping.SPIFFEID {
+					return mapping.Account, true
+				}
+			}
+			continue
+		}
+		if cert.Subject.CommonName == mapping.CommonName {
+			return mapping.Account, true
+		}
+	}
+	return "", false
+}
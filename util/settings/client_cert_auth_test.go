@@ -0,0 +1,77 @@
+package settings
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientCertAuthConfig(t *testing.T) {
+	t.Run("Not configured", func(t *testing.T) {
+		config, err := (&ArgoCDSettings{}).ClientCertAuthConfig()
+		require.NoError(t, err)
+		assert.Nil(t, config)
+	})
+
+	t.Run("Missing CA", func(t *testing.T) {
+		_, err := (&ArgoCDSettings{ClientCertAuthConfigRAW: `
+mappings:
+- commonName: ci
+  account: ci
+`}).ClientCertAuthConfig()
+		require.ErrorContains(t, err, "caCert is required")
+	})
+
+	t.Run("Mapping without account", func(t *testing.T) {
+		_, err := (&ArgoCDSettings{ClientCertAuthConfigRAW: `
+caCert: ca
+mappings:
+- commonName: ci
+`}).ClientCertAuthConfig()
+		require.ErrorContains(t, err, "mapping 0 has no account")
+	})
+
+	t.Run("Mapping with both SPIFFE ID and common name", func(t *testing.T) {
+		_, err := (&ArgoCDSettings{ClientCertAuthConfigRAW: `
+caCert: ca
+mappings:
+- commonName: ci
+  spiffeID: spiffe://example.org/ci
+  account: ci
+`}).ClientCertAuthConfig()
+		require.ErrorContains(t, err, "must set exactly one of spiffeID and commonName")
+	})
+
+	t.Run("Invalid CA", func(t *testing.T) {
+		config, err := (&ArgoCDSettings{ClientCertAuthConfigRAW: `caCert: ca`}).ClientCertAuthConfig()
+		require.NoError(t, err)
+		_, err = config.CertPool()
+		require.ErrorContains(t, err, "caCert holds no valid PEM certificate")
+	})
+}
+
+func TestClientCertAuthConfig_AccountForCertificate(t *testing.T) {
+	config := &ClientCertAuthConfig{Mappings: []ClientCertMapping{
+		{SPIFFEID: "spiffe://example.org/ci/deployer", Account: "deployer"},
+		{CommonName: "github-actions", Account: "ci"},
+	}}
+	spiffeID, err := url.Parse("spiffe://example.org/ci/deployer")
+	require.NoError(t, err)
+	otherSpiffeID, err := url.Parse("spiffe://example.org/ci/other")
+	require.NoError(t, err)
+
+	account, ok := config.AccountForCertificate(&x509.Certificate{URIs: []*url.URL{spiffeID}, Subject: pkix.Name{CommonName: "github-actions"}})
+	assert.True(t, ok)
+	assert.Equal(t, "deployer", account, "the first matching mapping is used")
+
+	account, ok = config.AccountForCertificate(&x509.Certificate{URIs: []*url.URL{otherSpiffeID}, Subject: pkix.Name{CommonName: "github-actions"}})
+	assert.True(t, ok)
+	assert.Equal(t, "ci", account)
+
+	_, ok = config.AccountForCertificate(&x509.Certificate{URIs: []*url.URL{otherSpiffeID}, Subject: pkix.Name{CommonName: "jenkins"}})
+	assert.False(t, ok)
+}
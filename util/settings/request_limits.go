@@ -0,0 +1,156 @@
+package settings
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/yaml"
+
+	timeutil "github.com/argoproj/pkg/v2/time"
+
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+// settingsRequestLimitsKey designates the key for the timeouts and maximum body sizes of the API requests
+const settingsRequestLimitsKey = "server.requestLimits"
+
+// RequestLimit is the timeout and maximum body size of a request. A zero value means no limit.
+type RequestLimit struct {
+	// Timeout is the time after which the request is canceled
+	Timeout time.Duration
+	// MaxBodySize is the maximum size of the request body in bytes. The size of a gRPC request is the size of its
+	// messages, summed for the client streams.
+	MaxBodySize int64
+}
+
+// RouteRequestLimit is the limit of the requests to the routes matching a glob pattern
+type RouteRequestLimit struct {
+	// Route is a glob pattern matching the full gRPC method names, such as /application.ApplicationService/Sync, and
+	// the HTTP paths, such as /api/v1/applications/*/sync
+	Route string
+	RequestLimit
+}
+
+// RequestLimits are the timeouts and maximum body sizes of the API requests
+type RequestLimits struct {
+	// Default is the limit of all the API requests
+	Default RequestLimit
+	// Manifests is the limit of the requests uploading manifests, which overrides the default limit
+	Manifests RequestLimit
+	// Routes are the limits of specific routes, which override the default and manifests limits. The first matching
+	// route applies.
+	Routes []RouteRequestLimit
+}
+
+type requestLimitConfig struct {
+	Timeout     string `json:"timeout,omitempty"`
+	MaxBodySize string `json:"maxBodySize,omitempty"`
+}
+
+type routeRequestLimitConfig struct {
+	Route              string `json:"route"`
+	requestLimitConfig `json:",inline"`
+}
+
+type requestLimitsConfig struct {
+	Default   requestLimitConfig        `json:"default"`
+	Manifests requestLimitConfig        `json:"manifests"`
+	Routes    []routeRequestLimitConfig `json:"routes,omitempty"`
+}
+
+func (c requestLimitConfig) parse() (RequestLimit, error) {
+	var limit RequestLimit
+	if c.Timeout != "" {
+		timeout, err := timeutil.ParseDuration(c.Timeout)
+		if err != nil {
+			return limit, fmt.Errorf("invalid timeout %q: %w", c.Timeout, err)
+		}
+		if *timeout < 0 {
+			return limit, fmt.Errorf("invalid timeout %q: must not be negative", c.Timeout)
+		}
+		limit.Timeout = *timeout
+	}
+	if c.MaxBodySize != "" {
+		size, err := resource.ParseQuantity(c.MaxBodySize)
+		if err != nil {
+			return limit, fmt.Errorf("invalid maxBodySize %q: %w", c.MaxBodySize, err)
+		}
+		if size.Sign() < 0 {
+			return limit, fmt.Errorf("invalid maxBodySize %q: must not be negative", c.MaxBodySize)
+		}
+		limit.MaxBodySize = size.Value()
+	}
+	return limit, nil
+}
+
+func unmarshalRequestLimits(data string) (*RequestLimits, error) {
+	var config requestLimitsConfig
+	if err := yaml.Unmarshal([]byte(data), &config); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", settingsRequestLimitsKey, err)
+	}
+	var limits RequestLimits
+	var err error
+	if limits.Default, err = config.Default.parse(); err != nil {
+		return nil, fmt.Errorf("invalid %s: default: %w", settingsRequestLimitsKey, err)
+	}
+	if limits.Manifests, err = config.Manifests.parse(); err != nil {
+		return nil, fmt.Errorf("invalid %s: manifests: %w", settingsRequestLimitsKey, err)
+	}
+	for i, route := range config.Routes {
+		if route.Route == "" {
+			return nil, fmt.Errorf("invalid %s: routes[%d]: route is required", settingsRequestLimitsKey, i)
+		}
+		if _, err := glob.MatchWithError(route.Route, ""); err != nil {
+			return nil, fmt.Errorf("invalid %s: routes[%d]: invalid route %q: %w", settingsRequestLimitsKey, i, route.Route, err)
+		}
+		limit, err := route.parse()
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: routes[%d]: %w", settingsRequestLimitsKey, i, err)
+		}
+		limits.Routes = append(limits.Routes, RouteRequestLimit{Route: route.Route, RequestLimit: limit})
+	}
+	return &limits, nil
+}
+
+func updateRequestLimitsFromConfigMap(settings *ArgoCDSettings, argoCDCM *corev1.ConfigMap) {
+	settings.RequestLimits = nil
+	if data, ok := argoCDCM.Data[settingsRequestLimitsKey]; ok && data != "" {
+		limits, err := unmarshalRequestLimits(data)
+		if err != nil {
+			log.Warnf("Failed to parse '%s' key: %v", settingsRequestLimitsKey, err)
+			return
+		}
+		settings.RequestLimits = limits
+	}
+}
+
+// GetRequestLimit returns the limit of the requests to the given gRPC method or HTTP path. The limit of the requests
+// uploading manifests applies when manifests is true.
+func (l *RequestLimits) GetRequestLimit(route string, manifests bool) RequestLimit {
+	if l == nil {
+		return RequestLimit{}
+	}
+	limit := l.Default
+	if manifests {
+		limit = overrideRequestLimit(limit, l.Manifests)
+	}
+	for _, r := range l.Routes {
+		if glob.Match(r.Route, route) {
+			return overrideRequestLimit(limit, r.RequestLimit)
+		}
+	}
+	return limit
+}
+
+func overrideRequestLimit(limit RequestLimit, override RequestLimit) RequestLimit {
+	if override.Timeout > 0 {
+		limit.Timeout = override.Timeout
+	}
+	if override.MaxBodySize > 0 {
+		limit.MaxBodySize = override.MaxBodySize
+	}
+	return limit
+}
@@ -0,0 +1,60 @@
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLimits(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		settingsRequestLimitsKey: `
+default:
+  timeout: 1m
+  maxBodySize: 1Mi
+manifests:
+  maxBodySize: 100Mi
+routes:
+- route: /application.ApplicationService/Sync
+  timeout: 5m
+- route: /api/v1/applications/*/sync
+  maxBodySize: 50Mi
+`,
+	}, withServerSecretKey)
+	settings, err := settingsManager.GetSettings()
+	require.NoError(t, err)
+	require.NotNil(t, settings.RequestLimits)
+
+	limits := settings.RequestLimits
+	assert.Equal(t, RequestLimit{Timeout: time.Minute, MaxBodySize: 1024 * 1024}, limits.GetRequestLimit("/application.ApplicationService/Get", false))
+	assert.Equal(t, RequestLimit{Timeout: time.Minute, MaxBodySize: 100 * 1024 * 1024}, limits.GetRequestLimit("/application.ApplicationService/GetManifestsWithFiles", true))
+	assert.Equal(t, RequestLimit{Timeout: 5 * time.Minute, MaxBodySize: 100 * 1024 * 1024}, limits.GetRequestLimit("/application.ApplicationService/Sync", true))
+	assert.Equal(t, RequestLimit{Timeout: time.Minute, MaxBodySize: 50 * 1024 * 1024}, limits.GetRequestLimit("/api/v1/applications/guestbook/sync", true))
+}
+
+func TestRequestLimits_NotConfigured(t *testing.T) {
+	_, settingsManager := fixtures(nil, withServerSecretKey)
+	settings, err := settingsManager.GetSettings()
+	require.NoError(t, err)
+	assert.Nil(t, settings.RequestLimits)
+	assert.Equal(t, RequestLimit{}, settings.RequestLimits.GetRequestLimit("/application.ApplicationService/Get", false))
+}
+
+func TestUnmarshalRequestLimits(t *testing.T) {
+	for name, data := range map[string]string{
+		"invalid timeout":      "default: {timeout: soon}",
+		"negative timeout":     "default: {timeout: -1m}",
+		"invalid size":         "manifests: {maxBodySize: large}",
+		"negative size":        "manifests: {maxBodySize: -1Mi}",
+		"route without route":  "routes: [{timeout: 1m}]",
+		"invalid route glob":   "routes: [{route: '/api/[', timeout: 1m}]",
+		"invalid routes field": "routes: {timeout: 1m}",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := unmarshalRequestLimits(data)
+			assert.ErrorContains(t, err, "invalid server.requestLimits")
+		})
+	}
+}
@@ -1,5 +1,14 @@
 package settings
 
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
 // The core exclusion list are K8s resources that we assume will never be managed by operators,
 // and are never child objects of managed resources that need to be presented in the resource tree.
 // This list contains high volume and  high churn metadata objects which we exclude for performance
@@ -17,6 +26,35 @@ type ResourcesFilter struct {
 	ResourceInclusions []FilteredResource
 }
 
+// ForCluster returns the resources filter of the given cluster. The resource inclusions and exclusions set by the
+// annotations of the cluster secret replace the ones of argocd-cm, the core exclusions always apply.
+func (rf *ResourcesFilter) ForCluster(cluster *v1alpha1.Cluster) (*ResourcesFilter, error) {
+	if cluster == nil {
+		return rf, nil
+	}
+	inclusions, inclusionsOk := cluster.Annotations[common.AnnotationKeyResourceInclusions]
+	exclusions, exclusionsOk := cluster.Annotations[common.AnnotationKeyResourceExclusions]
+	if !inclusionsOk && !exclusionsOk {
+		return rf, nil
+	}
+	clusterFilter := *rf
+	if inclusionsOk {
+		includedResources := make([]FilteredResource, 0)
+		if err := yaml.Unmarshal([]byte(inclusions), &includedResources); err != nil {
+			return nil, fmt.Errorf("error unmarshalling included resources of cluster %s: %w", cluster.Server, err)
+		}
+		clusterFilter.ResourceInclusions = includedResources
+	}
+	if exclusionsOk {
+		excludedResources := make([]FilteredResource, 0)
+		if err := yaml.Unmarshal([]byte(exclusions), &excludedResources); err != nil {
+			return nil, fmt.Errorf("error unmarshalling excluded resources of cluster %s: %w", cluster.Server, err)
+		}
+		clusterFilter.ResourceExclusions = excludedResources
+	}
+	return &clusterFilter, nil
+}
+
 func (rf *ResourcesFilter) getExcludedResources() []FilteredResource {
 	return append(coreExcludedResources, rf.ResourceExclusions...)
 }
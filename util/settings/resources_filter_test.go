@@ -4,6 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
 func TestIsExcludedResource(t *testing.T) {
@@ -60,3 +64,39 @@ func TestResourceInclusionsExclusionMultiCluster(t *testing.T) {
 	assert.True(t, filter.IsExcludedResource("whitelisted-resource", "", "cluster-two"))
 	assert.False(t, filter.IsExcludedResource("whitelisted-resource", "", "cluster-three"))
 }
+
+func TestResourcesFilterForCluster(t *testing.T) {
+	filter := &ResourcesFilter{
+		ResourceInclusions: []FilteredResource{{APIGroups: []string{"apps"}}},
+		ResourceExclusions: []FilteredResource{{APIGroups: []string{"noisy.example.com"}}},
+	}
+
+	clusterFilter, err := filter.ForCluster(&v1alpha1.Cluster{Server: "https://in-cluster"})
+	require.NoError(t, err)
+	assert.Same(t, filter, clusterFilter)
+
+	clusterFilter, err = filter.ForCluster(&v1alpha1.Cluster{Server: "https://noisy", Annotations: map[string]string{
+		common.AnnotationKeyResourceExclusions: `
+- apiGroups:
+  - noisy.example.com
+  - '*.crossplane.io'
+`,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, filter.ResourceInclusions, clusterFilter.ResourceInclusions)
+	assert.Equal(t, []FilteredResource{{APIGroups: []string{"noisy.example.com", "*.crossplane.io"}}}, clusterFilter.ResourceExclusions)
+	assert.Equal(t, []FilteredResource{{APIGroups: []string{"noisy.example.com"}}}, filter.ResourceExclusions, "the global filter is not modified")
+
+	clusterFilter, err = filter.ForCluster(&v1alpha1.Cluster{Server: "https://all", Annotations: map[string]string{
+		common.AnnotationKeyResourceInclusions: "[]",
+		common.AnnotationKeyResourceExclusions: "[]",
+	}})
+	require.NoError(t, err)
+	assert.False(t, clusterFilter.IsExcludedResource("noisy.example.com", "Widget", "https://all"))
+	assert.True(t, clusterFilter.IsExcludedResource("", "Event", "https://all"), "the core exclusions always apply")
+
+	_, err = filter.ForCluster(&v1alpha1.Cluster{Server: "https://invalid", Annotations: map[string]string{
+		common.AnnotationKeyResourceInclusions: "apiGroups: apps",
+	}})
+	assert.ErrorContains(t, err, "of cluster https://invalid")
+}
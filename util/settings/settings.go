@@ -7,6 +7,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -42,6 +43,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/crypto"
 	"github.com/argoproj/argo-cd/v3/util/kube"
 	"github.com/argoproj/argo-cd/v3/util/password"
+	"github.com/argoproj/argo-cd/v3/util/security"
 	tlsutil "github.com/argoproj/argo-cd/v3/util/tls"
 )
 
@@ -61,8 +63,21 @@ type ArgoCDSettings struct {
 	DexConfig string `json:"dexConfig,omitempty"`
 	// OIDCConfigRAW holds OIDC configuration as a raw string
 	OIDCConfigRAW string `json:"oidcConfig,omitempty"`
+	// ClientCertAuthConfigRAW holds the configuration of the authentication with TLS client certificates as a raw string
+	ClientCertAuthConfigRAW string `json:"clientCertAuthConfig,omitempty"`
+	// TokenExchangeConfigRAW holds the configuration of the exchange of workload identity tokens as a raw string
+	TokenExchangeConfigRAW string `json:"tokenExchangeConfig,omitempty"`
 	// ServerSignature holds the key used to generate JWT tokens.
 	ServerSignature []byte `json:"serverSignature,omitempty"`
+	// RequestLimits holds the timeouts and maximum body sizes of the API requests, or nil if they are not limited
+	RequestLimits *RequestLimits `json:"-"`
+	// SigningKeys holds the rotated keys used to sign JWT tokens instead of the server signature, oldest first
+	SigningKeys []*SigningKey `json:"-"`
+	// SigningKeyRotationPeriod is the period after which the active signing key is rotated. The signing keys are
+	// only used if it is set.
+	SigningKeyRotationPeriod time.Duration `json:"signingKeyRotationPeriod,omitempty"`
+	// SigningKeyRetentionPeriod is the period during which the tokens signed with a rotated out key remain valid
+	SigningKeyRetentionPeriod time.Duration `json:"signingKeyRetentionPeriod,omitempty"`
 	// Certificate holds the certificate/private key for the Argo CD API server.
 	// If nil, will run insecure without TLS.
 	Certificate *tls.Certificate `json:"-"`
@@ -90,6 +105,9 @@ type ArgoCDSettings struct {
 	AnonymousUserEnabled bool `json:"anonymousUserEnabled,omitempty"`
 	// Specifies token expiration duration
 	UserSessionDuration time.Duration `json:"userSessionDuration,omitempty"`
+	// UserSessionIdleTimeout is the duration of inactivity after which the sessions of the users expire, regardless of
+	// the expiration of their tokens. The sessions never expire from inactivity if it is zero.
+	UserSessionIdleTimeout time.Duration `json:"userSessionIdleTimeout,omitempty"`
 	// UiCssURL local or remote path to user-defined CSS to customize ArgoCD UI
 	UiCssURL string `json:"uiCssURL,omitempty"` //nolint:revive //FIXME(var-naming)
 	// Content of UI Banner
@@ -121,6 +139,8 @@ type ArgoCDSettings struct {
 	// token verification to pass despite the OIDC provider having an invalid certificate. Only set to `true` if you
 	// understand the risks.
 	OIDCTLSInsecureSkipVerify bool `json:"oidcTLSInsecureSkipVerify"`
+	// OutboundURLPolicy restricts the URLs of the OIDC provider and of the proxy extensions, nil if not configured
+	OutboundURLPolicy *security.OutboundURLPolicy `json:"-"`
 	// AppsInAnyNamespaceEnabled indicates whether applications are allowed to be created in any namespace
 	AppsInAnyNamespaceEnabled bool `json:"appsInAnyNamespaceEnabled"`
 	// ExtensionConfig configurations related to ArgoCD proxy extensions. The keys are the extension name.
@@ -136,6 +156,129 @@ type GoogleAnalytics struct {
 	AnonymizeUsers bool   `json:"anonymizeUsers,omitempty"`
 }
 
+// ResourceMutationWebhook is an HTTP endpoint which mutates the rendered resources of the applications before they are
+// compared with the live state and applied
+type ResourceMutationWebhook struct {
+	// Name identifies the webhook in the conditions and the logs
+	Name string `json:"name"`
+	// URL is the endpoint which receives the resources, e.g. https://mutator.example.com/mutate
+	URL string `json:"url"`
+	// Group is a glob matching the group of the mutated resources, all groups if empty
+	Group string `json:"group,omitempty"`
+	// Kind is a glob matching the kind of the mutated resources, all kinds if empty
+	Kind string `json:"kind,omitempty"`
+	// Headers are sent with the requests. A value starting with $ references a key of the argocd-secret Secret.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Timeout is the timeout of the requests, 10s by default
+	Timeout string `json:"timeout,omitempty"`
+	// FailurePolicy is Fail (the default) to report a comparison error when the webhook fails, or Ignore to keep the
+	// resource as rendered
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+	// Insecure skips the verification of the TLS certificate of the endpoint
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// ResourceRedaction selects fields of resources which are masked in the diffs, the manifests and the resources returned
+// by the API, like the data of the Secrets, e.g. the credentials embedded in the custom resources of some operators
+type ResourceRedaction struct {
+	// Group is a glob matching the group of the redacted resources, empty for the core group
+	Group string `json:"group,omitempty"`
+	// Kind is a glob matching the kind of the redacted resources
+	Kind string `json:"kind"`
+	// JSONPointers are the redacted fields, e.g. /spec/credentials/password. A * token matches all the items of an
+	// array or all the fields of an object, e.g. /spec/users/*/password.
+	JSONPointers []string `json:"jsonPointers"`
+}
+
+// ResourceOwnerReference declares a label which the resources created by an external controller, e.g. the resources
+// composed by Crossplane, carry instead of an owner reference. The resources whose label refers to an existing owner
+// are not reported as orphaned resources.
+type ResourceOwnerReference struct {
+	// Group is a glob matching the group of the owned resources, any group if empty
+	Group string `json:"group,omitempty"`
+	// Kind is a glob matching the kind of the owned resources, any kind if empty
+	Kind string `json:"kind,omitempty"`
+	// NameLabel is the label holding the name of the owner
+	NameLabel string `json:"nameLabel"`
+	// NamespaceLabel is the label holding the namespace of the owner. If not set, the owner is looked up in the
+	// namespace of the owned resource, then among the cluster-scoped resources.
+	NamespaceLabel string `json:"namespaceLabel,omitempty"`
+	// OwnerGroup is a glob matching the group of the owner, any group if empty
+	OwnerGroup string `json:"ownerGroup,omitempty"`
+	// OwnerKind is a glob matching the kind of the owner, any kind if empty
+	OwnerKind string `json:"ownerKind,omitempty"`
+}
+
+// OutboundURLSettings restricts the URLs Argo CD connects to on behalf of the users, e.g. the URLs of the notification
+// services, of the plugin generators, of the OIDC issuers and of the proxy extensions
+type OutboundURLSettings struct {
+	// Allowlist holds the host name globs, IPs and CIDRs of the allowed URLs. Any host is allowed if empty.
+	Allowlist []string `json:"allowlist,omitempty"`
+	// Denylist holds the host name globs, IPs and CIDRs of the denied URLs
+	Denylist []string `json:"denylist,omitempty"`
+	// BlockPrivateNetworks denies the connections to the private, loopback and unspecified IPs which are not allowed
+	// by the allowlist
+	BlockPrivateNetworks bool `json:"blockPrivateNetworks,omitempty"`
+}
+
+// FederationPeer is a peer Argo CD instance whose applications are presented in the federated application view
+type FederationPeer struct {
+	// Name is the unique name of the peer, e.g. its region
+	Name string `json:"name"`
+	// URL is the URL of the API server and of the UI of the peer
+	URL string `json:"url"`
+	// Token is the token of a read-only account of the peer, or a reference to a key of argocd-secret, e.g. $federation.eu-west.token
+	Token string `json:"token"`
+	// Insecure skips the verification of the TLS certificate of the peer
+	Insecure bool `json:"insecure,omitempty"`
+	// RootCA is the PEM encoded CA certificate verifying the TLS certificate of the peer
+	RootCA string `json:"rootCA,omitempty"`
+}
+
+// CostEstimationSettings configures the estimation of the cost delta of the syncs, from the prices of the requested
+// resources
+type CostEstimationSettings struct {
+	// Currency is the currency of the prices, USD by default
+	Currency string `json:"currency,omitempty"`
+	// OpenCost loads the prices from the custom pricing of an OpenCost instance
+	OpenCost *OpenCostSettings `json:"opencost,omitempty"`
+	// Static are the prices used if OpenCost is not configured
+	Static *StaticPricing `json:"static,omitempty"`
+}
+
+// OpenCostSettings locates the OpenCost instance the prices are loaded from
+type OpenCostSettings struct {
+	// URL is the URL of the OpenCost API, e.g. http://opencost.opencost:9003
+	URL string `json:"url"`
+}
+
+// StaticPricing are static prices of the requested resources
+type StaticPricing struct {
+	// CPUCoreHour is the hourly price of a requested CPU core
+	CPUCoreHour float64 `json:"cpuCoreHour"`
+	// MemoryGiBHour is the hourly price of a requested GiB of memory
+	MemoryGiBHour float64 `json:"memoryGiBHour"`
+}
+
+// ConfigManagementSettings declares the source of the configuration of Argo CD, from which the argocd-config
+// application is bootstrapped to detect the drift of the live configuration
+type ConfigManagementSettings struct {
+	// Project is the project of the argocd-config application, default by default
+	Project string `json:"project,omitempty"`
+	// Source is the source of the manifests of argocd-cm, argocd-rbac-cm, argocd-cmd-params-cm and argocd-secret
+	Source v1alpha1.ApplicationSource `json:"source"`
+}
+
+// ResourceHealthRollup selects the child resources whose health is rolled up into the health of their parent resource
+type ResourceHealthRollup struct {
+	// Group is a glob matching the group of the child resources, empty for the core group
+	Group string `json:"group,omitempty"`
+	// Kind is a glob matching the kind of the child resources
+	Kind string `json:"kind"`
+	// Required degrades the parent resource if it has no matching child resource
+	Required bool `json:"required,omitempty"`
+}
+
 type GlobalProjectSettings struct {
 	ProjectName   string               `json:"projectName,omitempty"`
 	LabelSelector metav1.LabelSelector `json:"labelSelector,omitempty"`
@@ -181,6 +324,7 @@ func (o *oidcConfig) toExported() *OIDCConfig {
 		RootCA:                   o.RootCA,
 		EnablePKCEAuthentication: o.EnablePKCEAuthentication,
 		DomainHint:               o.DomainHint,
+		ClientCredentials:        o.ClientCredentials,
 	}
 }
 
@@ -200,12 +344,95 @@ type OIDCConfig struct {
 	EnablePKCEAuthentication bool                   `json:"enablePKCEAuthentication,omitempty"`
 	DomainHint               string                 `json:"domainHint,omitempty"`
 	Azure                    *AzureOIDCConfig       `json:"azure,omitempty"`
+	// ClientCredentials allows the machine identities to authenticate with the access tokens they obtain from the OIDC
+	// provider with the client credentials grant
+	ClientCredentials *OIDCClientCredentialsConfig `json:"clientCredentials,omitempty"`
+}
+
+// OIDCClientCredentialsConfig configures the sessions of the machine identities authenticated by the OIDC provider
+// with the OAuth2 client credentials grant
+type OIDCClientCredentialsConfig struct {
+	// ClientIDs are the IDs of the OIDC clients of the machine identities, matched against the azp, client_id, cid or
+	// appid claim of the access tokens
+	ClientIDs []string `json:"clientIDs,omitempty"`
+	// SubjectClaim is the claim of the access tokens used as the RBAC subject of the machine identities. The subject is
+	// the client ID by default.
+	SubjectClaim string `json:"subjectClaim,omitempty"`
+	// Audience is the audience of the access tokens, allowed in addition to the client IDs of Argo CD when no
+	// allowed audiences are configured
+	Audience string `json:"audience,omitempty"`
 }
 
 type AzureOIDCConfig struct {
 	UseWorkloadIdentity bool `json:"useWorkloadIdentity,omitempty"`
 }
 
+// LDAPConfig configures the authentication of the users with an LDAP or Active Directory server by the API server,
+// without running Dex
+type LDAPConfig struct {
+	// Host is the host and optional port of the LDAP server, e.g. ldap.example.com:636
+	Host string `json:"host"`
+	// InsecureNoSSL connects to the LDAP server without TLS
+	InsecureNoSSL bool `json:"insecureNoSSL,omitempty"`
+	// StartTLS upgrades a plain connection to TLS with the StartTLS operation instead of connecting with LDAPS
+	StartTLS bool `json:"startTLS,omitempty"`
+	// InsecureSkipVerify skips the verification of the TLS certificate of the LDAP server
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// RootCA is the PEM encoded CA certificate of the LDAP server
+	RootCA string `json:"rootCA,omitempty"`
+	// BindDN is the DN of the service account searching the users and groups, searches are anonymous if empty
+	BindDN string `json:"bindDN,omitempty"`
+	// BindPW is the password of the service account. A value starting with $ references a key of the argocd-secret
+	// Secret.
+	BindPW string `json:"bindPW,omitempty"`
+	// PoolSize is the maximum number of idle connections of the service account, 5 by default
+	PoolSize int `json:"poolSize,omitempty"`
+	// Timeout is the timeout of the connections and the requests, 10s by default
+	Timeout string `json:"timeout,omitempty"`
+	// UserSearch finds the entries of the users from their username
+	UserSearch LDAPUserSearch `json:"userSearch"`
+	// GroupSearch finds the groups of the users, the users have no group if nil
+	GroupSearch *LDAPGroupSearch `json:"groupSearch,omitempty"`
+}
+
+// LDAPUserSearch configures the search of the users
+type LDAPUserSearch struct {
+	// BaseDN is the DN the users are searched under, e.g. ou=People,dc=example,dc=com
+	BaseDN string `json:"baseDN"`
+	// Filter is an optional filter combined with the username filter, e.g. (objectClass=person)
+	Filter string `json:"filter,omitempty"`
+	// Username is the attribute matched against the username, e.g. uid or sAMAccountName
+	Username string `json:"username"`
+	// EmailAttr is the attribute holding the email of the users, mail by default
+	EmailAttr string `json:"emailAttr,omitempty"`
+	// NameAttr is the attribute holding the display name of the users, cn by default
+	NameAttr string `json:"nameAttr,omitempty"`
+}
+
+// LDAPGroupSearch configures the search of the groups of the users
+type LDAPGroupSearch struct {
+	// BaseDN is the DN the groups are searched under, e.g. ou=Groups,dc=example,dc=com
+	BaseDN string `json:"baseDN"`
+	// Filter is an optional filter combined with the user matcher filters, e.g. (objectClass=groupOfNames)
+	Filter string `json:"filter,omitempty"`
+	// UserMatchers match the attributes of the users with the attributes of their groups
+	UserMatchers []LDAPUserMatcher `json:"userMatchers"`
+	// NameAttr is the attribute holding the names of the groups used in the RBAC policies, cn by default
+	NameAttr string `json:"nameAttr,omitempty"`
+	// Nested resolves the groups the groups of the users are members of. Only the user matchers whose user attribute
+	// is DN are used to resolve the nested groups.
+	Nested bool `json:"nested,omitempty"`
+	// MaxDepth is the maximum depth of the resolved nested groups, 10 by default
+	MaxDepth int `json:"maxDepth,omitempty"`
+}
+
+// LDAPUserMatcher matches a user attribute with a group attribute, e.g. the DN of the user with the member attribute
+// of the group
+type LDAPUserMatcher struct {
+	UserAttr  string `json:"userAttr"`
+	GroupAttr string `json:"groupAttr"`
+}
+
 // DEPRECATED. Helm repository credentials are now managed using RepoCredentials
 type HelmRepoCredentials struct {
 	URL            string                    `json:"url,omitempty"`
@@ -432,6 +659,11 @@ const (
 	settingDexConfigKey = "dex.config"
 	// settingsOIDCConfigKey designates the key for OIDC config
 	settingsOIDCConfigKey = "oidc.config"
+	// settingsLDAPConfigKey designates the key for the config of the built-in LDAP authentication
+	settingsLDAPConfigKey = "ldap.config"
+	// settingsSecretBackendsKey designates the key for the config of the external secret backends of the repository and
+	// cluster credentials
+	settingsSecretBackendsKey = "secretBackends"
 	// statusBadgeEnabledKey holds the key which enables of disables status badge feature
 	statusBadgeEnabledKey = "statusbadge.enabled"
 	// statusBadgeRootURLKey holds the key for the root badge URL override
@@ -472,6 +704,12 @@ const (
 	resourceSensitiveAnnotationsKey = "resource.sensitive.mask.annotations"
 	// resourceCustomLabelKey is the key to a custom label to show in node info, if present
 	resourceCustomLabelsKey = "resource.customLabels"
+	// resourceRedactionsKey is the key to the list of redacted fields of resources
+	resourceRedactionsKey = "resource.redactions"
+	// resourceOwnerReferencesKey is the key to the list of labels referring to the owners of the resources created by external controllers
+	resourceOwnerReferencesKey = "resource.ownerReferences"
+	// resourceMutationWebhooksKey is the key to the list of webhooks mutating the resources before they are applied
+	resourceMutationWebhooksKey = "resource.mutationWebhooks"
 	// resourceIncludeEventLabelKeys is the key to labels to be added onto Application k8s events if present on an Application or it's AppProject. Supports wildcard.
 	resourceIncludeEventLabelKeys = "resource.includeEventLabelKeys"
 	// resourceExcludeEventLabelKeys is the key to labels to be excluded from adding onto Application's k8s events. Supports wildcard.
@@ -486,6 +724,8 @@ const (
 	anonymousUserEnabledKey = "users.anonymous.enabled"
 	// userSessionDurationKey is the key which specifies token expiration duration
 	userSessionDurationKey = "users.session.duration"
+	// userSessionIdleTimeoutKey is the key which specifies the duration of inactivity after which the sessions expire
+	userSessionIdleTimeoutKey = "users.session.idle.timeout"
 	// diffOptions is the key where diff options are configured
 	resourceCompareOptionsKey = "resource.compareoptions"
 	// settingUICSSURLKey designates the key for user-defined CSS URL for UI customization
@@ -541,6 +781,14 @@ const (
 	RespectRBACValueNormal = "normal"
 	// impersonationEnabledKey is the key to configure whether the application sync decoupling through impersonation feature is enabled
 	impersonationEnabledKey = "application.sync.impersonation.enabled"
+	// outboundURLsKey is the key to the allowlist and the denylist of the URLs Argo CD connects to on behalf of the users
+	outboundURLsKey = "outbound.urls"
+	// federationPeersKey is the key to the peer Argo CD instances of the federated application view
+	federationPeersKey = "federation.peers"
+	// costEstimationKey is the key to the configuration of the estimation of the cost delta of the syncs
+	costEstimationKey = "costEstimation"
+	// configManagementKey is the key to the declared source of the configuration of Argo CD
+	configManagementKey = "configManagement"
 )
 
 const (
@@ -1007,6 +1255,37 @@ func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.Resource
 	return resourceOverrides, nil
 }
 
+// GetResourceHealthRollups loads the health rollups of the resources, configured per group and kind by the
+// resource.customizations.healthRollup.<group_kind> keys of argocd-cm
+func (mgr *SettingsManager) GetResourceHealthRollups() (map[string][]ResourceHealthRollup, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving config map: %w", err)
+	}
+	rollups := map[string][]ResourceHealthRollup{}
+	prefix := resourceCustomizationsKey + ".healthRollup."
+	for k, v := range argoCDCM.Data {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		overrideKey, err := convertToOverrideKey(strings.TrimPrefix(k, prefix))
+		if err != nil {
+			return nil, err
+		}
+		var resourceRollups []ResourceHealthRollup
+		if err := yaml.UnmarshalStrict([]byte(v), &resourceRollups); err != nil {
+			return nil, fmt.Errorf("error unmarshalling health rollups of %s: %w", overrideKey, err)
+		}
+		for _, rollup := range resourceRollups {
+			if rollup.Kind == "" {
+				return nil, fmt.Errorf("invalid health rollups of %s: kind is required", overrideKey)
+			}
+		}
+		rollups[overrideKey] = resourceRollups
+	}
+	return rollups, nil
+}
+
 func addStatusOverrideToGK(resourceOverrides map[string]v1alpha1.ResourceOverride, groupKind string) {
 	if val, ok := resourceOverrides[groupKind]; ok {
 		val.IgnoreDifferences.JSONPointers = append(val.IgnoreDifferences.JSONPointers, "/status")
@@ -1081,6 +1360,9 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 				return err
 			}
 			overrideVal.IgnoreResourceUpdates = overrideIgnoreUpdate
+		case "healthRollup":
+			// loaded by GetResourceHealthRollups
+			continue
 		case "knownTypeFields":
 			var knownTypeFields []v1alpha1.KnownTypeField
 			err := yaml.Unmarshal([]byte(v), &knownTypeFields)
@@ -1391,6 +1673,8 @@ func getDownloadBinaryUrlsFromConfigMap(argoCDCM *corev1.ConfigMap) map[string]s
 func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *corev1.ConfigMap) {
 	settings.DexConfig = argoCDCM.Data[settingDexConfigKey]
 	settings.OIDCConfigRAW = argoCDCM.Data[settingsOIDCConfigKey]
+	settings.ClientCertAuthConfigRAW = argoCDCM.Data[settingsClientCertAuthConfigKey]
+	settings.TokenExchangeConfigRAW = argoCDCM.Data[settingsTokenExchangeConfigKey]
 	settings.KustomizeBuildOptions = argoCDCM.Data[kustomizeBuildOptionsKey]
 	settings.StatusBadgeEnabled = argoCDCM.Data[statusBadgeEnabledKey] == "true"
 	settings.StatusBadgeRootUrl = argoCDCM.Data[statusBadgeRootURLKey]
@@ -1426,6 +1710,16 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *corev1.Conf
 			settings.UserSessionDuration = *val
 		}
 	}
+	settings.UserSessionIdleTimeout = 0
+	if idleTimeoutStr, ok := argoCDCM.Data[userSessionIdleTimeoutKey]; ok {
+		if val, err := timeutil.ParseDuration(idleTimeoutStr); err != nil {
+			log.Warnf("Failed to parse '%s' key: %v", userSessionIdleTimeoutKey, err)
+		} else {
+			settings.UserSessionIdleTimeout = *val
+		}
+	}
+	updateSigningKeySettingsFromConfigMap(settings, argoCDCM)
+	updateRequestLimitsFromConfigMap(settings, argoCDCM)
 	settings.PasswordPattern = argoCDCM.Data[settingsPasswordPatternKey]
 	if settings.PasswordPattern == "" {
 		settings.PasswordPattern = common.PasswordPatten
@@ -1450,6 +1744,13 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *corev1.Conf
 	settings.OIDCTLSInsecureSkipVerify = argoCDCM.Data[oidcTLSInsecureSkipVerifyKey] == "true"
 	settings.ExtensionConfig = getExtensionConfigs(argoCDCM.Data)
 	settings.ImpersonationEnabled = argoCDCM.Data[impersonationEnabledKey] == "true"
+	if argoCDCM.Data[outboundURLsKey] != "" {
+		outboundURLPolicy, err := GetOutboundURLPolicyFromConfigMap(argoCDCM)
+		if err != nil {
+			log.Warnf("Ignoring the invalid outbound URL settings: %v", err)
+		}
+		settings.OutboundURLPolicy = outboundURLPolicy
+	}
 }
 
 func getExtensionConfigs(cmData map[string]string) map[string]string {
@@ -1487,6 +1788,14 @@ func (mgr *SettingsManager) updateSettingsFromSecret(settings *ArgoCDSettings, a
 	} else {
 		errs = append(errs, &incompleteSettingsError{message: "server.secretkey is missing"})
 	}
+	if signingKeys, ok := argoCDSecret.Data[settingServerSigningKeysKey]; ok {
+		keys, err := unmarshalSigningKeys(signingKeys)
+		if err != nil {
+			log.Errorf("Failed to load the token signing keys: %v", err)
+		} else {
+			settings.SigningKeys = keys
+		}
+	}
 
 	// The TLS certificate may be externally managed. We try to load it from an
 	// external secret first. If the external secret doesn't exist, we either
@@ -1862,6 +2171,9 @@ func (a *ArgoCDSettings) OAuth2AllowedAudiences() []string {
 			if config.CLIClientID != "" {
 				allowedAudiences = append(allowedAudiences, config.CLIClientID)
 			}
+			if config.ClientCredentials != nil && config.ClientCredentials.Audience != "" {
+				allowedAudiences = append(allowedAudiences, config.ClientCredentials.Audience)
+			}
 			return allowedAudiences
 		}
 		return config.AllowedAudiences
@@ -2199,6 +2511,298 @@ func (mgr *SettingsManager) GetGlobalProjectsSettings() ([]GlobalProjectSettings
 	return globalProjectSettings, nil
 }
 
+// GetResourceMutationWebhooks loads the resource mutation webhooks from argocd-cm ConfigMap
+func (mgr *SettingsManager) GetResourceMutationWebhooks() ([]ResourceMutationWebhook, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	value := argoCDCM.Data[resourceMutationWebhooksKey]
+	if value == "" {
+		return nil, nil
+	}
+	var webhooks []ResourceMutationWebhook
+	if err := yaml.Unmarshal([]byte(value), &webhooks); err != nil {
+		return nil, fmt.Errorf("error unmarshalling resource mutation webhooks: %w", err)
+	}
+	argoCDSecret, err := mgr.getSecret()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd secret: %w", err)
+	}
+	secretValues := make(map[string]string, len(argoCDSecret.Data))
+	for k, v := range argoCDSecret.Data {
+		secretValues[k] = string(v)
+	}
+	for i, webhook := range webhooks {
+		if webhook.Name == "" || webhook.URL == "" {
+			return nil, fmt.Errorf("resource mutation webhook %d: name and url are required", i)
+		}
+		if webhook.FailurePolicy != "" && webhook.FailurePolicy != "Fail" && webhook.FailurePolicy != "Ignore" {
+			return nil, fmt.Errorf("resource mutation webhook %s: invalid failure policy %q", webhook.Name, webhook.FailurePolicy)
+		}
+		if webhook.Timeout != "" {
+			if _, err := time.ParseDuration(webhook.Timeout); err != nil {
+				return nil, fmt.Errorf("resource mutation webhook %s: invalid timeout %q: %w", webhook.Name, webhook.Timeout, err)
+			}
+		}
+		for k, v := range webhook.Headers {
+			webhooks[i].Headers[k] = ReplaceStringSecret(v, secretValues)
+		}
+	}
+	return webhooks, nil
+}
+
+// GetResourceOwnerReferences loads the labels referring to the owners of the resources from argocd-cm ConfigMap. The
+// invalid references are logged and ignored, so that they do not prevent the cluster caches from being built.
+func (mgr *SettingsManager) GetResourceOwnerReferences() ([]ResourceOwnerReference, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	value := argoCDCM.Data[resourceOwnerReferencesKey]
+	if value == "" {
+		return nil, nil
+	}
+	var entries []json.RawMessage
+	if err := yaml.Unmarshal([]byte(value), &entries); err != nil {
+		log.Warnf("Ignoring invalid %s in argocd-cm: %v", resourceOwnerReferencesKey, err)
+		return nil, nil
+	}
+	var references []ResourceOwnerReference
+	for i, entry := range entries {
+		var reference ResourceOwnerReference
+		if err := yaml.UnmarshalStrict(entry, &reference); err != nil {
+			log.Warnf("Ignoring invalid resource owner reference %d: %v", i, err)
+			continue
+		}
+		if reference.NameLabel == "" {
+			log.Warnf("Ignoring invalid resource owner reference %d: nameLabel is required", i)
+			continue
+		}
+		references = append(references, reference)
+	}
+	return references, nil
+}
+
+// GetResourceRedactions loads the redacted fields of resources from argocd-cm ConfigMap
+func (mgr *SettingsManager) GetResourceRedactions() ([]ResourceRedaction, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	value := argoCDCM.Data[resourceRedactionsKey]
+	if value == "" {
+		return nil, nil
+	}
+	var redactions []ResourceRedaction
+	if err := yaml.UnmarshalStrict([]byte(value), &redactions); err != nil {
+		return nil, fmt.Errorf("error unmarshalling resource redactions: %w", err)
+	}
+	for i, redaction := range redactions {
+		if redaction.Kind == "" {
+			return nil, fmt.Errorf("resource redaction %d: kind is required", i)
+		}
+		for _, pointer := range redaction.JSONPointers {
+			if !strings.HasPrefix(pointer, "/") {
+				return nil, fmt.Errorf("resource redaction %d: invalid JSON pointer %q", i, pointer)
+			}
+		}
+	}
+	return redactions, nil
+}
+
+// GetOutboundURLPolicy loads the policy of the URLs Argo CD connects to on behalf of the users from argocd-cm. The
+// instance metadata services are denied if the policy is not configured.
+func (mgr *SettingsManager) GetOutboundURLPolicy() (*security.OutboundURLPolicy, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	return GetOutboundURLPolicyFromConfigMap(argoCDCM)
+}
+
+// GetOutboundURLPolicyFromConfigMap loads the policy of the outbound URLs from the given argocd-cm ConfigMap, for the
+// components reading argocd-cm without a settings manager
+func GetOutboundURLPolicyFromConfigMap(argoCDCM *corev1.ConfigMap) (*security.OutboundURLPolicy, error) {
+	var outboundURLSettings OutboundURLSettings
+	if value := argoCDCM.Data[outboundURLsKey]; value != "" {
+		if err := yaml.UnmarshalStrict([]byte(value), &outboundURLSettings); err != nil {
+			return nil, fmt.Errorf("error unmarshalling outbound URL settings: %w", err)
+		}
+	}
+	policy, err := security.NewOutboundURLPolicy(outboundURLSettings.Allowlist, outboundURLSettings.Denylist, outboundURLSettings.BlockPrivateNetworks)
+	if err != nil {
+		return nil, fmt.Errorf("invalid outbound URL settings: %w", err)
+	}
+	return policy, nil
+}
+
+// GetLDAPConfig loads the config of the built-in LDAP authentication from argocd-cm ConfigMap, nil if not configured
+func (mgr *SettingsManager) GetLDAPConfig() (*LDAPConfig, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	value := argoCDCM.Data[settingsLDAPConfigKey]
+	if value == "" {
+		return nil, nil
+	}
+	var config LDAPConfig
+	if err := yaml.UnmarshalStrict([]byte(value), &config); err != nil {
+		return nil, fmt.Errorf("error unmarshalling ldap config: %w", err)
+	}
+	if config.Host == "" {
+		return nil, errors.New("ldap config: host is required")
+	}
+	if config.InsecureNoSSL && config.StartTLS {
+		return nil, errors.New("ldap config: insecureNoSSL and startTLS are mutually exclusive")
+	}
+	if config.UserSearch.BaseDN == "" || config.UserSearch.Username == "" {
+		return nil, errors.New("ldap config: userSearch.baseDN and userSearch.username are required")
+	}
+	if config.GroupSearch != nil {
+		if config.GroupSearch.BaseDN == "" || len(config.GroupSearch.UserMatchers) == 0 {
+			return nil, errors.New("ldap config: groupSearch.baseDN and groupSearch.userMatchers are required")
+		}
+		for _, matcher := range config.GroupSearch.UserMatchers {
+			if matcher.UserAttr == "" || matcher.GroupAttr == "" {
+				return nil, errors.New("ldap config: userAttr and groupAttr of the user matchers are required")
+			}
+		}
+	}
+	if config.Timeout != "" {
+		if _, err := time.ParseDuration(config.Timeout); err != nil {
+			return nil, fmt.Errorf("ldap config: invalid timeout %q: %w", config.Timeout, err)
+		}
+	}
+	argoCDSecret, err := mgr.getSecret()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd secret: %w", err)
+	}
+	secretValues := make(map[string]string, len(argoCDSecret.Data))
+	for k, v := range argoCDSecret.Data {
+		secretValues[k] = string(v)
+	}
+	config.BindPW = ReplaceStringSecret(config.BindPW, secretValues)
+	return &config, nil
+}
+
+// GetSecretBackendsConfig loads the config of the external secret backends of the repository and cluster credentials
+// from argocd-cm ConfigMap, empty if not configured, along with a function replacing the references to the keys of
+// argocd-secret in its credentials
+func (mgr *SettingsManager) GetSecretBackendsConfig() (string, func(string) string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return "", nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	argoCDSecret, err := mgr.getSecret()
+	if err != nil {
+		return "", nil, fmt.Errorf("error retrieving argocd secret: %w", err)
+	}
+	secretValues := make(map[string]string, len(argoCDSecret.Data))
+	for k, v := range argoCDSecret.Data {
+		secretValues[k] = string(v)
+	}
+	return argoCDCM.Data[settingsSecretBackendsKey], func(val string) string {
+		return ReplaceStringSecret(val, secretValues)
+	}, nil
+}
+
+// GetFederationPeers loads the peer Argo CD instances of the federated application view from argocd-cm ConfigMap
+func (mgr *SettingsManager) GetFederationPeers() ([]FederationPeer, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	value := argoCDCM.Data[federationPeersKey]
+	if value == "" {
+		return nil, nil
+	}
+	var peers []FederationPeer
+	if err := yaml.UnmarshalStrict([]byte(value), &peers); err != nil {
+		return nil, fmt.Errorf("error unmarshalling federation peers: %w", err)
+	}
+	argoCDSecret, err := mgr.getSecret()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd secret: %w", err)
+	}
+	secretValues := make(map[string]string, len(argoCDSecret.Data))
+	for k, v := range argoCDSecret.Data {
+		secretValues[k] = string(v)
+	}
+	names := make(map[string]bool, len(peers))
+	for i, peer := range peers {
+		if peer.Name == "" || peer.URL == "" {
+			return nil, fmt.Errorf("federation peer %d: name and url are required", i)
+		}
+		if names[peer.Name] {
+			return nil, fmt.Errorf("federation peer %s: duplicate name", peer.Name)
+		}
+		names[peer.Name] = true
+		peers[i].URL = strings.TrimSuffix(peer.URL, "/")
+		peers[i].Token = ReplaceStringSecret(peer.Token, secretValues)
+	}
+	return peers, nil
+}
+
+// GetCostEstimationSettings loads the configuration of the estimation of the cost delta of the syncs from argocd-cm
+// ConfigMap, nil if not configured
+func (mgr *SettingsManager) GetCostEstimationSettings() (*CostEstimationSettings, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	value := argoCDCM.Data[costEstimationKey]
+	if value == "" {
+		return nil, nil
+	}
+	var costEstimation CostEstimationSettings
+	if err := yaml.UnmarshalStrict([]byte(value), &costEstimation); err != nil {
+		return nil, fmt.Errorf("error unmarshalling cost estimation settings: %w", err)
+	}
+	switch {
+	case costEstimation.OpenCost != nil:
+		if costEstimation.OpenCost.URL == "" {
+			return nil, errors.New("cost estimation: opencost.url is required")
+		}
+		costEstimation.OpenCost.URL = strings.TrimSuffix(costEstimation.OpenCost.URL, "/")
+	case costEstimation.Static != nil:
+		if costEstimation.Static.CPUCoreHour < 0 || costEstimation.Static.MemoryGiBHour < 0 {
+			return nil, errors.New("cost estimation: the static prices must not be negative")
+		}
+	default:
+		return nil, errors.New("cost estimation: one of opencost or static is required")
+	}
+	if costEstimation.Currency == "" {
+		costEstimation.Currency = "USD"
+	}
+	return &costEstimation, nil
+}
+
+// GetConfigManagementSettings loads the declared source of the configuration of Argo CD from argocd-cm ConfigMap, nil
+// if not configured
+func (mgr *SettingsManager) GetConfigManagementSettings() (*ConfigManagementSettings, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	value := argoCDCM.Data[configManagementKey]
+	if value == "" {
+		return nil, nil
+	}
+	var configManagement ConfigManagementSettings
+	if err := yaml.UnmarshalStrict([]byte(value), &configManagement); err != nil {
+		return nil, fmt.Errorf("error unmarshalling config management settings: %w", err)
+	}
+	if configManagement.Source.RepoURL == "" {
+		return nil, errors.New("config management: source.repoURL is required")
+	}
+	if configManagement.Project == "" {
+		configManagement.Project = "default"
+	}
+	return &configManagement, nil
+}
+
 func (mgr *SettingsManager) GetNamespace() string {
 	return mgr.namespace
 }
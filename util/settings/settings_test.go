@@ -55,6 +55,11 @@ func fixtures(data map[string]string, opts ...func(secret *corev1.Secret)) (*fak
 	return kubeClient, settingsManager
 }
 
+// withServerSecretKey sets the server secret key, which is required to get the settings
+func withServerSecretKey(secret *corev1.Secret) {
+	secret.Data["server.secretkey"] = nil
+}
+
 func TestDocumentedArgoCDConfigMapIsValid(t *testing.T) {
 	var argocdCM *corev1.ConfigMap
 	settings := ArgoCDSettings{}
@@ -438,6 +443,315 @@ func TestSettingsManager_GetResourceOverrides_with_empty_string(t *testing.T) {
 	assert.Len(t, overrides, 1)
 }
 
+func TestGetResourceHealthRollups(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"resource.compareoptions": `ignoreResourceStatusField: none`,
+		"resource.customizations.healthRollup.example.com_MyApp": `
+- group: autoscaling
+  kind: HorizontalPodAutoscaler
+  required: true
+- group: policy
+  kind: PodDisruptionBudget
+`,
+	})
+	rollups, err := settingsManager.GetResourceHealthRollups()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]ResourceHealthRollup{
+		"example.com/MyApp": {
+			{Group: "autoscaling", Kind: "HorizontalPodAutoscaler", Required: true},
+			{Group: "policy", Kind: "PodDisruptionBudget"},
+		},
+	}, rollups)
+
+	overrides, err := settingsManager.GetResourceOverrides()
+	require.NoError(t, err)
+	assert.Empty(t, overrides, "health rollups are not resource overrides")
+
+	_, settingsManager = fixtures(map[string]string{
+		"resource.customizations.healthRollup.example.com_MyApp": `[{group: policy}]`,
+	})
+	_, err = settingsManager.GetResourceHealthRollups()
+	assert.ErrorContains(t, err, "kind is required")
+}
+
+func TestGetResourceRedactions(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	redactions, err := settingsManager.GetResourceRedactions()
+	require.NoError(t, err)
+	assert.Empty(t, redactions)
+
+	_, settingsManager = fixtures(map[string]string{
+		"resource.redactions": `
+- group: example.com
+  kind: Database
+  jsonPointers:
+  - /spec/password
+  - /spec/users/*/token
+`,
+	})
+	redactions, err = settingsManager.GetResourceRedactions()
+	require.NoError(t, err)
+	assert.Equal(t, []ResourceRedaction{
+		{Group: "example.com", Kind: "Database", JSONPointers: []string{"/spec/password", "/spec/users/*/token"}},
+	}, redactions)
+
+	_, settingsManager = fixtures(map[string]string{
+		"resource.redactions": `[{group: example.com, jsonPointers: [/spec/password]}]`,
+	})
+	_, err = settingsManager.GetResourceRedactions()
+	require.ErrorContains(t, err, "kind is required")
+
+	_, settingsManager = fixtures(map[string]string{
+		"resource.redactions": `[{kind: Database, jsonPointers: [spec.password]}]`,
+	})
+	_, err = settingsManager.GetResourceRedactions()
+	assert.ErrorContains(t, err, `invalid JSON pointer "spec.password"`)
+}
+
+func TestGetResourceOwnerReferences(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	references, err := settingsManager.GetResourceOwnerReferences()
+	require.NoError(t, err)
+	assert.Empty(t, references)
+
+	_, settingsManager = fixtures(map[string]string{
+		"resource.ownerReferences": `
+- nameLabel: crossplane.io/claim-name
+  namespaceLabel: crossplane.io/claim-namespace
+- kind: Secret
+  nameLabel: crossplane.io/composite
+  ownerGroup: "*.example.org"
+`,
+	})
+	references, err = settingsManager.GetResourceOwnerReferences()
+	require.NoError(t, err)
+	assert.Equal(t, []ResourceOwnerReference{
+		{NameLabel: "crossplane.io/claim-name", NamespaceLabel: "crossplane.io/claim-namespace"},
+		{Kind: "Secret", NameLabel: "crossplane.io/composite", OwnerGroup: "*.example.org"},
+	}, references)
+
+	// invalid references are ignored
+	_, settingsManager = fixtures(map[string]string{
+		"resource.ownerReferences": `[{kind: Secret}, {nameLabel: crossplane.io/composite, unknown: field}, {nameLabel: crossplane.io/claim-name}]`,
+	})
+	references, err = settingsManager.GetResourceOwnerReferences()
+	require.NoError(t, err)
+	assert.Equal(t, []ResourceOwnerReference{{NameLabel: "crossplane.io/claim-name"}}, references)
+
+	_, settingsManager = fixtures(map[string]string{
+		"resource.ownerReferences": `nameLabel: crossplane.io/claim-name`,
+	})
+	references, err = settingsManager.GetResourceOwnerReferences()
+	require.NoError(t, err)
+	assert.Empty(t, references)
+}
+
+func TestGetOutboundURLPolicy(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	policy, err := settingsManager.GetOutboundURLPolicy()
+	require.NoError(t, err)
+	require.NoError(t, policy.ValidateURL("https://github.com"))
+	require.Error(t, policy.ValidateURL("http://169.254.169.254"))
+
+	_, settingsManager = fixtures(map[string]string{
+		"outbound.urls": `
+allowlist:
+- "*.example.com"
+- 10.0.0.0/8
+denylist:
+- internal.example.com
+blockPrivateNetworks: true
+`,
+	})
+	policy, err = settingsManager.GetOutboundURLPolicy()
+	require.NoError(t, err)
+	require.NoError(t, policy.ValidateURL("https://hooks.example.com"))
+	require.NoError(t, policy.ValidateURL("https://10.0.0.1"))
+	require.Error(t, policy.ValidateURL("https://github.com"))
+	require.Error(t, policy.ValidateURL("https://internal.example.com"))
+	require.Error(t, policy.ValidateURL("https://192.168.0.1"))
+
+	_, settingsManager = fixtures(map[string]string{
+		"outbound.urls": `{allowlist: [10.0.0.0/33]}`,
+	})
+	_, err = settingsManager.GetOutboundURLPolicy()
+	require.ErrorContains(t, err, "invalid outbound URL settings")
+
+	_, settingsManager = fixtures(map[string]string{
+		"outbound.urls": `{allowed: [example.com]}`,
+	})
+	_, err = settingsManager.GetOutboundURLPolicy()
+	assert.ErrorContains(t, err, "error unmarshalling outbound URL settings")
+}
+
+func TestGetLDAPConfig(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	config, err := settingsManager.GetLDAPConfig()
+	require.NoError(t, err)
+	assert.Nil(t, config)
+
+	_, settingsManager = fixtures(map[string]string{
+		"ldap.config": `
+host: ldap.example.com:636
+bindDN: cn=argocd,dc=example,dc=com
+bindPW: $ldap.bindPW
+userSearch:
+  baseDN: ou=People,dc=example,dc=com
+  username: uid
+groupSearch:
+  baseDN: ou=Groups,dc=example,dc=com
+  userMatchers:
+  - userAttr: DN
+    groupAttr: member
+  nested: true
+`,
+	}, func(secret *corev1.Secret) {
+		secret.Data["ldap.bindPW"] = []byte("bind-password")
+	})
+	config, err = settingsManager.GetLDAPConfig()
+	require.NoError(t, err)
+	assert.Equal(t, &LDAPConfig{
+		Host:       "ldap.example.com:636",
+		BindDN:     "cn=argocd,dc=example,dc=com",
+		BindPW:     "bind-password",
+		UserSearch: LDAPUserSearch{BaseDN: "ou=People,dc=example,dc=com", Username: "uid"},
+		GroupSearch: &LDAPGroupSearch{
+			BaseDN:       "ou=Groups,dc=example,dc=com",
+			UserMatchers: []LDAPUserMatcher{{UserAttr: "DN", GroupAttr: "member"}},
+			Nested:       true,
+		},
+	}, config)
+
+	for name, value := range map[string]string{
+		"unknown field":        "{host: ldap, port: 636}",
+		"missing host":         "{userSearch: {baseDN: dc=example, username: uid}}",
+		"missing user attr":    "{host: ldap, userSearch: {baseDN: dc=example}}",
+		"starttls without ssl": "{host: ldap, insecureNoSSL: true, startTLS: true, userSearch: {baseDN: dc=example, username: uid}}",
+		"missing matchers":     "{host: ldap, userSearch: {baseDN: dc=example, username: uid}, groupSearch: {baseDN: dc=example}}",
+		"invalid timeout":      "{host: ldap, timeout: 10x, userSearch: {baseDN: dc=example, username: uid}}",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, settingsManager := fixtures(map[string]string{"ldap.config": value})
+			_, err := settingsManager.GetLDAPConfig()
+			assert.ErrorContains(t, err, "ldap config")
+		})
+	}
+}
+
+func TestGetFederationPeers(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	peers, err := settingsManager.GetFederationPeers()
+	require.NoError(t, err)
+	assert.Empty(t, peers)
+
+	_, settingsManager = fixtures(map[string]string{
+		"federation.peers": `
+- name: eu-west
+  url: https://argocd.eu-west.example.com/
+  token: $federation.eu-west.token
+- name: us-east
+  url: https://argocd.us-east.example.com
+  token: plain-token
+  insecure: true
+`,
+	}, func(secret *corev1.Secret) {
+		secret.Data["federation.eu-west.token"] = []byte("eu-west-token\n")
+	})
+	peers, err = settingsManager.GetFederationPeers()
+	require.NoError(t, err)
+	assert.Equal(t, []FederationPeer{
+		{Name: "eu-west", URL: "https://argocd.eu-west.example.com", Token: "eu-west-token"},
+		{Name: "us-east", URL: "https://argocd.us-east.example.com", Token: "plain-token", Insecure: true},
+	}, peers)
+
+	for name, value := range map[string]string{
+		"unknown field":  "[{name: eu-west, url: https://argocd.example.com, foo: bar}]",
+		"missing url":    "[{name: eu-west}]",
+		"duplicate name": "[{name: eu-west, url: https://a.example.com}, {name: eu-west, url: https://b.example.com}]",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, settingsManager := fixtures(map[string]string{"federation.peers": value})
+			_, err := settingsManager.GetFederationPeers()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestGetCostEstimationSettings(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	costEstimation, err := settingsManager.GetCostEstimationSettings()
+	require.NoError(t, err)
+	assert.Nil(t, costEstimation)
+
+	_, settingsManager = fixtures(map[string]string{
+		"costEstimation": `
+opencost:
+  url: http://opencost.opencost:9003/
+`,
+	})
+	costEstimation, err = settingsManager.GetCostEstimationSettings()
+	require.NoError(t, err)
+	assert.Equal(t, &CostEstimationSettings{Currency: "USD", OpenCost: &OpenCostSettings{URL: "http://opencost.opencost:9003"}}, costEstimation)
+
+	_, settingsManager = fixtures(map[string]string{
+		"costEstimation": `
+currency: EUR
+static:
+  cpuCoreHour: 0.03
+  memoryGiBHour: 0.004
+`,
+	})
+	costEstimation, err = settingsManager.GetCostEstimationSettings()
+	require.NoError(t, err)
+	assert.Equal(t, &CostEstimationSettings{Currency: "EUR", Static: &StaticPricing{CPUCoreHour: 0.03, MemoryGiBHour: 0.004}}, costEstimation)
+
+	for name, value := range map[string]string{
+		"unknown field":  "{static: {cpuCoreHour: 0.03}, foo: bar}",
+		"missing url":    "{opencost: {}}",
+		"negative price": "{static: {cpuCoreHour: -1}}",
+		"no pricing":     "{currency: USD}",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, settingsManager := fixtures(map[string]string{"costEstimation": value})
+			_, err := settingsManager.GetCostEstimationSettings()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestGetConfigManagementSettings(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	configManagement, err := settingsManager.GetConfigManagementSettings()
+	require.NoError(t, err)
+	assert.Nil(t, configManagement)
+
+	_, settingsManager = fixtures(map[string]string{
+		"configManagement": `
+source:
+  repoURL: https://github.com/argoproj/argocd-example-apps
+  path: argocd
+  targetRevision: HEAD
+`,
+	})
+	configManagement, err = settingsManager.GetConfigManagementSettings()
+	require.NoError(t, err)
+	assert.Equal(t, &ConfigManagementSettings{
+		Project: "default",
+		Source:  v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "argocd", TargetRevision: "HEAD"},
+	}, configManagement)
+
+	for name, value := range map[string]string{
+		"unknown field":   "{source: {repoURL: https://github.com/argoproj/argocd-example-apps}, foo: bar}",
+		"missing repoURL": "{project: platform, source: {path: argocd}}",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, settingsManager := fixtures(map[string]string{"configManagement": value})
+			_, err := settingsManager.GetConfigManagementSettings()
+			assert.Error(t, err)
+		})
+	}
+}
+
 func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 	data := map[string]string{
 		"resource.compareoptions": `ignoreResourceStatusField: none`,
@@ -1010,6 +1324,17 @@ func TestSettingsManager_GetSettings(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, time.Hour*10, s.UserSessionDuration)
 	})
+	t.Run("UserSessionIdleTimeout", func(t *testing.T) {
+		_, settingsManager := fixtures(nil, withServerSecretKey)
+		s, err := settingsManager.GetSettings()
+		require.NoError(t, err)
+		assert.Zero(t, s.UserSessionIdleTimeout)
+
+		_, settingsManager = fixtures(map[string]string{"users.session.idle.timeout": "30m"}, withServerSecretKey)
+		s, err = settingsManager.GetSettings()
+		require.NoError(t, err)
+		assert.Equal(t, 30*time.Minute, s.UserSessionIdleTimeout)
+	})
 }
 
 func TestGetOIDCConfig(t *testing.T) {
@@ -1618,6 +1943,17 @@ clientSecret: yyy
 requestedScopes: ["oidc"]`},
 			expected: []string{"xxx", "cli-xxx"},
 		},
+		{
+			name: "OIDC configured, no audiences specified, clientID and client credentials audience used",
+			settings: &ArgoCDSettings{OIDCConfigRAW: `name: Test
+issuer: aaa
+clientID: xxx
+clientSecret: yyy
+clientCredentials:
+  clientIDs: ["ci-pipeline"]
+  audience: api://argocd`},
+			expected: []string{"xxx", "api://argocd"},
+		},
 		{
 			name: "OIDC configured, audiences specified",
 			settings: &ArgoCDSettings{OIDCConfigRAW: `name: Test
@@ -0,0 +1,214 @@
+package settings
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	timeutil "github.com/argoproj/pkg/v2/time"
+)
+
+const (
+	// settingServerSigningKeysKey designates the key of the rotated token signing keys inside the argocd-secret
+	settingServerSigningKeysKey = "server.signingkeys"
+	// signingKeyRotationPeriodKey designates the key of the period after which the token signing key is rotated. The
+	// token signing keys are only used when it is set.
+	signingKeyRotationPeriodKey = "server.signingkeys.rotation.period"
+	// signingKeyRetentionPeriodKey designates the key of the period during which a rotated out token signing key is
+	// still trusted
+	signingKeyRetentionPeriodKey = "server.signingkeys.retention.period"
+	// SigningKeyActivationDelay is the delay between the creation of a token signing key and its use, which lets all
+	// the API server replicas and the consumers of the JWKS endpoint learn about it first
+	SigningKeyActivationDelay = 5 * time.Minute
+)
+
+// SigningKey is a key signing the tokens issued by Argo CD with ES256
+type SigningKey struct {
+	// KeyID identifies the key in the kid header of the tokens
+	KeyID string
+	// CreatedAt is the time the key was created at
+	CreatedAt time.Time
+	// PrivateKey is the ECDSA P-256 private key
+	PrivateKey *ecdsa.PrivateKey
+}
+
+// serializedSigningKey is the form of a SigningKey stored in the argocd-secret
+type serializedSigningKey struct {
+	KeyID      string    `json:"kid"`
+	CreatedAt  time.Time `json:"createdAt"`
+	PrivateKey string    `json:"privateKey"`
+}
+
+// ActiveSigningKey returns the key new tokens are signed with, or nil if the tokens are signed with the server
+// signature instead
+func (a *ArgoCDSettings) ActiveSigningKey(now time.Time) *SigningKey {
+	if a.SigningKeyRotationPeriod <= 0 {
+		return nil
+	}
+	var active *SigningKey
+	for _, key := range a.SigningKeys {
+		if !key.CreatedAt.Add(SigningKeyActivationDelay).After(now) {
+			active = key
+		}
+	}
+	return active
+}
+
+// TrustedSigningKeys returns the keys tokens signed with are trusted: the active key, the keys which will soon be
+// activated, and the keys rotated out less than the retention period ago
+func (a *ArgoCDSettings) TrustedSigningKeys(now time.Time) []*SigningKey {
+	var trusted []*SigningKey
+	for i, key := range a.SigningKeys {
+		if i+1 < len(a.SigningKeys) {
+			retiredAt := a.SigningKeys[i+1].CreatedAt.Add(SigningKeyActivationDelay)
+			if !retiredAt.Add(a.SigningKeyRetentionPeriod).After(now) {
+				continue
+			}
+		}
+		trusted = append(trusted, key)
+	}
+	return trusted
+}
+
+// TrustedSigningKey returns the trusted key with the given ID
+func (a *ArgoCDSettings) TrustedSigningKey(keyID string, now time.Time) (*SigningKey, error) {
+	for _, key := range a.TrustedSigningKeys(now) {
+		if key.KeyID == keyID {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("signing key %q is not trusted", keyID)
+}
+
+func updateSigningKeySettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *corev1.ConfigMap) {
+	settings.SigningKeyRotationPeriod = 0
+	if periodStr, ok := argoCDCM.Data[signingKeyRotationPeriodKey]; ok {
+		if val, err := timeutil.ParseDuration(periodStr); err != nil {
+			log.Warnf("Failed to parse '%s' key: %v", signingKeyRotationPeriodKey, err)
+		} else {
+			settings.SigningKeyRotationPeriod = *val
+		}
+	}
+	// by default, the sessions created just before a rotation outlive the key they were signed with
+	settings.SigningKeyRetentionPeriod = settings.UserSessionDuration
+	if periodStr, ok := argoCDCM.Data[signingKeyRetentionPeriodKey]; ok {
+		if val, err := timeutil.ParseDuration(periodStr); err != nil {
+			log.Warnf("Failed to parse '%s' key: %v", signingKeyRetentionPeriodKey, err)
+		} else {
+			settings.SigningKeyRetentionPeriod = *val
+		}
+	}
+}
+
+func unmarshalSigningKeys(data []byte) ([]*SigningKey, error) {
+	var serialized []serializedSigningKey
+	if err := json.Unmarshal(data, &serialized); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", settingServerSigningKeysKey, err)
+	}
+	keys := make([]*SigningKey, 0, len(serialized))
+	for _, s := range serialized {
+		block, _ := pem.Decode([]byte(s.PrivateKey))
+		if block == nil {
+			return nil, fmt.Errorf("invalid %s: key %s holds no PEM block", settingServerSigningKeysKey, s.KeyID)
+		}
+		privateKey, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: key %s: %w", settingServerSigningKeysKey, s.KeyID, err)
+		}
+		keys = append(keys, &SigningKey{KeyID: s.KeyID, CreatedAt: s.CreatedAt, PrivateKey: privateKey})
+	}
+	return keys, nil
+}
+
+func marshalSigningKeys(keys []*SigningKey) ([]byte, error) {
+	serialized := make([]serializedSigningKey, 0, len(keys))
+	for _, key := range keys {
+		der, err := x509.MarshalECPrivateKey(key.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal signing key %s: %w", key.KeyID, err)
+		}
+		serialized = append(serialized, serializedSigningKey{
+			KeyID:      key.KeyID,
+			CreatedAt:  key.CreatedAt,
+			PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})),
+		})
+	}
+	return json.Marshal(serialized)
+}
+
+// rotateSigningKeys returns the signing keys after the rotation due at the given time, and whether they changed
+func rotateSigningKeys(settings *ArgoCDSettings, now time.Time) ([]*SigningKey, bool, error) {
+	keys := settings.TrustedSigningKeys(now)
+	changed := len(keys) != len(settings.SigningKeys)
+	if len(keys) == 0 || !keys[len(keys)-1].CreatedAt.Add(settings.SigningKeyRotationPeriod).After(now) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to generate signing key: %w", err)
+		}
+		keys = append(keys, &SigningKey{KeyID: uuid.NewString(), CreatedAt: now.UTC(), PrivateKey: privateKey})
+		changed = true
+	}
+	return keys, changed, nil
+}
+
+// RotateSigningKeys creates a new token signing key if the active one is older than the rotation period, and drops
+// the keys which are no longer trusted. It does nothing unless the rotation period is configured.
+func (mgr *SettingsManager) RotateSigningKeys(now time.Time) error {
+	settings, err := mgr.GetSettings()
+	if err != nil {
+		return err
+	}
+	if settings.SigningKeyRotationPeriod <= 0 {
+		return nil
+	}
+	keys, changed, err := rotateSigningKeys(settings, now)
+	if err != nil || !changed {
+		return err
+	}
+	data, err := marshalSigningKeys(keys)
+	if err != nil {
+		return err
+	}
+	// the keys are only written if they were not rotated since they were read, so that concurrent rotations by
+	// several API server replicas do not overwrite each other
+	return mgr.updateSecret(func(argoCDSecret *corev1.Secret) error {
+		current, err := unmarshalSigningKeysOrEmpty(argoCDSecret.Data[settingServerSigningKeysKey])
+		if err != nil {
+			return err
+		}
+		if !sameSigningKeys(current, settings.SigningKeys) {
+			return errors.New("signing keys were rotated concurrently")
+		}
+		argoCDSecret.Data[settingServerSigningKeysKey] = data
+		return nil
+	})
+}
+
+func unmarshalSigningKeysOrEmpty(data []byte) ([]*SigningKey, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return unmarshalSigningKeys(data)
+}
+
+func sameSigningKeys(a, b []*SigningKey) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].KeyID != b[i].KeyID {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,81 @@
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func withServerSignature(secret *corev1.Secret) {
+	secret.Data[settingServerSignatureKey] = []byte("signature")
+}
+
+func TestSigningKeys_Disabled(t *testing.T) {
+	_, settingsManager := fixtures(nil, withServerSignature)
+
+	require.NoError(t, settingsManager.RotateSigningKeys(time.Now()))
+	settings, err := settingsManager.GetSettings()
+	require.NoError(t, err)
+	assert.Empty(t, settings.SigningKeys)
+	assert.Nil(t, settings.ActiveSigningKey(time.Now()))
+}
+
+func TestSigningKeys_Rotation(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		signingKeyRotationPeriodKey:  "24h",
+		signingKeyRetentionPeriodKey: "2h",
+	}, withServerSignature)
+	now := time.Now()
+
+	require.NoError(t, settingsManager.RotateSigningKeys(now))
+	settings, err := settingsManager.GetSettings()
+	require.NoError(t, err)
+	require.Len(t, settings.SigningKeys, 1)
+	first := settings.SigningKeys[0]
+	assert.Nil(t, settings.ActiveSigningKey(now), "a new key is not used before it is published")
+	assert.Equal(t, first.KeyID, settings.ActiveSigningKey(now.Add(SigningKeyActivationDelay)).KeyID)
+
+	// the active key is not rotated before the end of the rotation period
+	require.NoError(t, settingsManager.RotateSigningKeys(now.Add(time.Hour)))
+	settings, err = settingsManager.GetSettings()
+	require.NoError(t, err)
+	require.Len(t, settings.SigningKeys, 1)
+
+	rotatedAt := now.Add(24 * time.Hour)
+	require.NoError(t, settingsManager.RotateSigningKeys(rotatedAt))
+	settings, err = settingsManager.GetSettings()
+	require.NoError(t, err)
+	require.Len(t, settings.SigningKeys, 2)
+	second := settings.SigningKeys[1]
+	assert.Equal(t, first.KeyID, settings.ActiveSigningKey(rotatedAt).KeyID)
+	assert.Equal(t, second.KeyID, settings.ActiveSigningKey(rotatedAt.Add(SigningKeyActivationDelay)).KeyID)
+
+	// the rotated out key is trusted during the retention period
+	_, err = settings.TrustedSigningKey(first.KeyID, rotatedAt.Add(SigningKeyActivationDelay+time.Hour))
+	require.NoError(t, err)
+	expiredAt := rotatedAt.Add(SigningKeyActivationDelay + 2*time.Hour)
+	_, err = settings.TrustedSigningKey(first.KeyID, expiredAt)
+	require.ErrorContains(t, err, "is not trusted")
+
+	// and dropped afterwards
+	require.NoError(t, settingsManager.RotateSigningKeys(expiredAt))
+	settings, err = settingsManager.GetSettings()
+	require.NoError(t, err)
+	require.Len(t, settings.SigningKeys, 1)
+	assert.Equal(t, second.KeyID, settings.SigningKeys[0].KeyID)
+	assert.True(t, second.PrivateKey.Equal(settings.SigningKeys[0].PrivateKey))
+}
+
+func TestSigningKeys_DefaultRetentionPeriod(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		signingKeyRotationPeriodKey: "720h",
+		userSessionDurationKey:      "12h",
+	}, withServerSignature)
+	settings, err := settingsManager.GetSettings()
+	require.NoError(t, err)
+	assert.Equal(t, 720*time.Hour, settings.SigningKeyRotationPeriod)
+	assert.Equal(t, 12*time.Hour, settings.SigningKeyRetentionPeriod)
+}
@@ -0,0 +1,164 @@
+package settings
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+const (
+	// settingsTokenExchangeConfigKey designates the key for the configuration of the exchange of workload identity
+	// tokens for project role tokens
+	settingsTokenExchangeConfigKey = "tokenexchange.config"
+	// DefaultTokenExchangeDuration is the lifetime of the tokens issued by a token exchange binding without duration
+	DefaultTokenExchangeDuration = 15 * time.Minute
+	// MaxTokenExchangeDuration is the maximum lifetime of the tokens issued by a token exchange
+	MaxTokenExchangeDuration = 12 * time.Hour
+)
+
+// TokenExchangeConfig configures the exchange of tokens issued to workloads by trusted OIDC issuers, e.g. GitHub
+// Actions or Kubernetes service accounts, for short-lived project role tokens
+type TokenExchangeConfig struct {
+	// Issuers are the trusted issuers of the exchanged tokens
+	Issuers []TokenExchangeIssuer `json:"issuers,omitempty"`
+}
+
+// TokenExchangeIssuer is an OIDC issuer whose tokens can be exchanged for project role tokens
+type TokenExchangeIssuer struct {
+	// Issuer is the issuer URL, which must match the iss claim of the exchanged tokens
+	Issuer string `json:"issuer"`
+	// JWKSURL is the URL of the keys signing the tokens. If omitted, it is discovered from the issuer.
+	JWKSURL string `json:"jwksURL,omitempty"`
+	// Audience must be one of the audiences of the exchanged tokens
+	Audience string `json:"audience"`
+	// Bindings are the project roles tokens of the issuer can be exchanged for
+	Bindings []TokenExchangeBinding `json:"bindings,omitempty"`
+}
+
+// TokenExchangeBinding allows the tokens whose claims match to be exchanged for a token of a project role
+type TokenExchangeBinding struct {
+	// Project is the name of the project
+	Project string `json:"project"`
+	// Role is the name of the project role
+	Role string `json:"role"`
+	// Claims maps claim names to glob patterns which the claim values must match. A claim holding a list matches if
+	// any of its values does.
+	Claims map[string]string `json:"claims"`
+	// Duration is the lifetime of the issued tokens. Defaults to 15m.
+	Duration string `json:"duration,omitempty"`
+}
+
+// TokenExchangeConfig returns the configuration of the token exchange, or nil if it is not configured
+func (a *ArgoCDSettings) TokenExchangeConfig() (*TokenExchangeConfig, error) {
+	if a.TokenExchangeConfigRAW == "" {
+		return nil, nil
+	}
+	return unmarshalTokenExchangeConfig(a.TokenExchangeConfigRAW)
+}
+
+func unmarshalTokenExchangeConfig(configStr string) (*TokenExchangeConfig, error) {
+	var config TokenExchangeConfig
+	if err := yaml.Unmarshal([]byte(configStr), &config); err != nil {
+		return nil, fmt.Errorf("invalid token exchange config: %w", err)
+	}
+	for i, issuer := range config.Issuers {
+		if issuer.Issuer == "" {
+			return nil, fmt.Errorf("invalid token exchange config: issuer %d has no issuer URL", i)
+		}
+		if issuer.Audience == "" {
+			return nil, fmt.Errorf("invalid token exchange config: issuer %s has no audience", issuer.Issuer)
+		}
+		for j, binding := range issuer.Bindings {
+			if binding.Project == "" || binding.Role == "" {
+				return nil, fmt.Errorf("invalid token exchange config: binding %d of issuer %s must set project and role", j, issuer.Issuer)
+			}
+			// a binding without claims would let any workload of a shared issuer, e.g. any GitHub repository, in
+			if len(binding.Claims) == 0 {
+				return nil, fmt.Errorf("invalid token exchange config: binding %d of issuer %s must match at least one claim", j, issuer.Issuer)
+			}
+			if _, err := binding.TokenDuration(); err != nil {
+				return nil, fmt.Errorf("invalid token exchange config: binding %d of issuer %s: %w", j, issuer.Issuer, err)
+			}
+		}
+	}
+	return &config, nil
+}
+
+// TokenDuration returns the lifetime of the tokens issued by the binding
+func (b *TokenExchangeBinding) TokenDuration() (time.Duration, error) {
+	if b.Duration == "" {
+		return DefaultTokenExchangeDuration, nil
+	}
+	duration, err := time.ParseDuration(b.Duration)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", b.Duration, err)
+	}
+	if duration <= 0 || duration > MaxTokenExchangeDuration {
+		return 0, fmt.Errorf("duration %s must be positive and at most %s", duration, MaxTokenExchangeDuration)
+	}
+	return duration, nil
+}
+
+// GetIssuer returns the trusted issuer with the given URL
+func (c *TokenExchangeConfig) GetIssuer(issuerURL string) (*TokenExchangeIssuer, error) {
+	for i := range c.Issuers {
+		if c.Issuers[i].Issuer == issuerURL {
+			return &c.Issuers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("issuer %s is not trusted", issuerURL)
+}
+
+// FindBinding returns the binding of the project role matching the given token claims
+func (i *TokenExchangeIssuer) FindBinding(project, role string, claims map[string]any) (*TokenExchangeBinding, error) {
+	for j := range i.Bindings {
+		binding := &i.Bindings[j]
+		if binding.Project == project && binding.Role == role && binding.matches(claims) {
+			return binding, nil
+		}
+	}
+	return nil, errors.New("no binding matches the token claims")
+}
+
+// HasBinding returns whether tokens of the issuer may be exchanged for the project role at all
+func (i *TokenExchangeIssuer) HasBinding(project, role string) bool {
+	for _, binding := range i.Bindings {
+		if binding.Project == project && binding.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *TokenExchangeBinding) matches(claims map[string]any) bool {
+	for name, pattern := range b.Claims {
+		if !claimMatches(claims[name], pattern) {
+			return false
+		}
+	}
+	return true
+}
+
+func claimMatches(value any, pattern string) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case string:
+		return glob.Match(pattern, v)
+	case []any:
+		for _, item := range v {
+			if claimMatches(item, pattern) {
+				return true
+			}
+		}
+		return false
+	case map[string]any:
+		return false
+	default:
+		return glob.Match(pattern, fmt.Sprint(v))
+	}
+}
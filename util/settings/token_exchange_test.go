@@ -0,0 +1,100 @@
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenExchangeConfig(t *testing.T) {
+	t.Run("Not configured", func(t *testing.T) {
+		config, err := (&ArgoCDSettings{}).TokenExchangeConfig()
+		require.NoError(t, err)
+		assert.Nil(t, config)
+	})
+
+	t.Run("Missing audience", func(t *testing.T) {
+		_, err := (&ArgoCDSettings{TokenExchangeConfigRAW: `
+issuers:
+- issuer: https://token.actions.githubusercontent.com
+`}).TokenExchangeConfig()
+		require.ErrorContains(t, err, "has no audience")
+	})
+
+	t.Run("Binding without claims", func(t *testing.T) {
+		_, err := (&ArgoCDSettings{TokenExchangeConfigRAW: `
+issuers:
+- issuer: https://token.actions.githubusercontent.com
+  audience: argocd
+  bindings:
+  - project: default
+    role: ci
+`}).TokenExchangeConfig()
+		require.ErrorContains(t, err, "must match at least one claim")
+	})
+
+	t.Run("Duration too long", func(t *testing.T) {
+		_, err := (&ArgoCDSettings{TokenExchangeConfigRAW: `
+issuers:
+- issuer: https://token.actions.githubusercontent.com
+  audience: argocd
+  bindings:
+  - project: default
+    role: ci
+    claims:
+      repository: argoproj/argo-cd
+    duration: 24h
+`}).TokenExchangeConfig()
+		require.ErrorContains(t, err, "must be positive and at most 12h0m0s")
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		config, err := (&ArgoCDSettings{TokenExchangeConfigRAW: `
+issuers:
+- issuer: https://token.actions.githubusercontent.com
+  audience: argocd
+  bindings:
+  - project: default
+    role: ci
+    claims:
+      repository: argoproj/argo-cd
+`}).TokenExchangeConfig()
+		require.NoError(t, err)
+		issuer, err := config.GetIssuer("https://token.actions.githubusercontent.com")
+		require.NoError(t, err)
+		duration, err := issuer.Bindings[0].TokenDuration()
+		require.NoError(t, err)
+		assert.Equal(t, DefaultTokenExchangeDuration, duration)
+
+		_, err = config.GetIssuer("https://accounts.google.com")
+		require.ErrorContains(t, err, "is not trusted")
+	})
+}
+
+func TestTokenExchangeIssuer_FindBinding(t *testing.T) {
+	issuer := &TokenExchangeIssuer{Bindings: []TokenExchangeBinding{
+		{Project: "default", Role: "ci", Claims: map[string]string{"repository": "argoproj/*", "ref": "refs/heads/main"}, Duration: "5m"},
+		{Project: "default", Role: "admin", Claims: map[string]string{"groups": "admins"}},
+	}}
+
+	binding, err := issuer.FindBinding("default", "ci", map[string]any{"repository": "argoproj/argo-cd", "ref": "refs/heads/main"})
+	require.NoError(t, err)
+	duration, err := binding.TokenDuration()
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, duration)
+
+	_, err = issuer.FindBinding("default", "ci", map[string]any{"repository": "argoproj/argo-cd", "ref": "refs/heads/feature"})
+	require.Error(t, err, "all the claims must match")
+	_, err = issuer.FindBinding("default", "ci", map[string]any{"repository": "argoproj/argo-cd"})
+	require.Error(t, err, "a missing claim does not match")
+	_, err = issuer.FindBinding("other", "ci", map[string]any{"repository": "argoproj/argo-cd", "ref": "refs/heads/main"})
+	require.Error(t, err)
+
+	_, err = issuer.FindBinding("default", "admin", map[string]any{"groups": []any{"devs", "admins"}})
+	require.NoError(t, err)
+
+	assert.True(t, issuer.HasBinding("default", "admin"))
+	assert.False(t, issuer.HasBinding("default", "readonly"))
+}
@@ -0,0 +1,113 @@
+package spiffe
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
+	"github.com/spiffe/go-spiffe/v2/workloadapi"
+
+	log "github.com/sirupsen/logrus"
+
+	tlsutil "github.com/argoproj/argo-cd/v3/util/tls"
+)
+
+// EnvEndpointSocket is the environment variable of the address of the SPIFFE Workload API, e.g.
+// unix:///run/spire/agent-sockets/spire-agent.sock
+const EnvEndpointSocket = "SPIFFE_ENDPOINT_SOCKET"
+
+// Source is the source of the X509-SVID of the workload and of the trust bundles, fetched from the SPIFFE Workload API.
+// The SVID and the bundles are rotated by the Workload API, so the TLS configs created from the source always use the
+// current ones.
+type Source struct {
+	*workloadapi.X509Source
+	id spiffeid.ID
+}
+
+// NewSource connects to the SPIFFE Workload API at the address of the SPIFFE_ENDPOINT_SOCKET environment variable and
+// waits for the first X509-SVID of the workload. The source must be closed to stop watching the rotations.
+func NewSource(ctx context.Context) (*Source, error) {
+	source, err := workloadapi.NewX509Source(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the X509-SVID from the SPIFFE Workload API: %w", err)
+	}
+	svid, err := source.GetX509SVID()
+	if err != nil {
+		_ = source.Close()
+		return nil, fmt.Errorf("failed to get the X509-SVID: %w", err)
+	}
+	log.Infof("Using SPIFFE ID %s for mTLS", svid.ID)
+	return &Source{X509Source: source, id: svid.ID}, nil
+}
+
+// ID returns the SPIFFE ID of the workload
+func (s *Source) ID() spiffeid.ID {
+	return s.id
+}
+
+// ServerTLSConfigCustomizer returns a TLS config customizer which applies the given customizer and then replaces the
+// certificates of the server with the X509-SVID of the source, requiring the clients to present an X509-SVID with one
+// of the given SPIFFE IDs. Any workload of the trust domain of the server is authorized if no ID is given. The server
+// itself is always authorized, so that it can perform its own health checks.
+func (s *Source) ServerTLSConfigCustomizer(customizer tlsutil.ConfigCustomizer, authorizedIDs []string) (tlsutil.ConfigCustomizer, error) {
+	authorizer, err := serverAuthorizer(s.id, authorizedIDs)
+	if err != nil {
+		return nil, err
+	}
+	return func(config *tls.Config) {
+		if customizer != nil {
+			customizer(config)
+		}
+		tlsconfig.HookMTLSServerConfig(config, s, s, authorizer)
+	}, nil
+}
+
+// ServerTLSConfig returns the TLS config of a server, see ServerTLSConfigCustomizer
+func (s *Source) ServerTLSConfig(authorizedIDs []string) (*tls.Config, error) {
+	customizer, err := s.ServerTLSConfigCustomizer(nil, authorizedIDs)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	customizer(config)
+	return config, nil
+}
+
+// ClientTLSConfig returns the TLS config of a client presenting the X509-SVID of the source and only accepting a server
+// with the given SPIFFE ID
+func (s *Source) ClientTLSConfig(serverID string) (*tls.Config, error) {
+	authorizer, err := clientAuthorizer(serverID)
+	if err != nil {
+		return nil, err
+	}
+	return tlsconfig.MTLSClientConfig(s, s, authorizer), nil
+}
+
+func serverAuthorizer(serverID spiffeid.ID, authorizedIDs []string) (tlsconfig.Authorizer, error) {
+	if len(authorizedIDs) == 0 {
+		return tlsconfig.AuthorizeMemberOf(serverID.TrustDomain()), nil
+	}
+	ids := []spiffeid.ID{serverID}
+	for _, authorizedID := range authorizedIDs {
+		id, err := spiffeid.FromString(authorizedID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid authorized SPIFFE ID %q: %w", authorizedID, err)
+		}
+		ids = append(ids, id)
+	}
+	return tlsconfig.AuthorizeOneOf(ids...), nil
+}
+
+func clientAuthorizer(serverID string) (tlsconfig.Authorizer, error) {
+	if serverID == "" {
+		return nil, errors.New("the SPIFFE ID of the server is required")
+	}
+	id, err := spiffeid.FromString(serverID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid server SPIFFE ID %q: %w", serverID, err)
+	}
+	return tlsconfig.AuthorizeID(id), nil
+}
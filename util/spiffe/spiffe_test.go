@@ -0,0 +1,54 @@
+package spiffe
+
+import (
+	"testing"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	repoServerID = spiffeid.RequireFromString("spiffe://cluster.local/ns/argocd/sa/argocd-repo-server")
+	serverID     = spiffeid.RequireFromString("spiffe://cluster.local/ns/argocd/sa/argocd-server")
+	controllerID = spiffeid.RequireFromString("spiffe://cluster.local/ns/argocd/sa/argocd-application-controller")
+	foreignID    = spiffeid.RequireFromString("spiffe://example.com/ns/argocd/sa/argocd-server")
+	otherID      = spiffeid.RequireFromString("spiffe://cluster.local/ns/default/sa/default")
+)
+
+func TestServerAuthorizer(t *testing.T) {
+	t.Run("Trust domain", func(t *testing.T) {
+		authorizer, err := serverAuthorizer(repoServerID, nil)
+		require.NoError(t, err)
+		require.NoError(t, authorizer(serverID, nil))
+		require.NoError(t, authorizer(otherID, nil))
+		require.Error(t, authorizer(foreignID, nil))
+	})
+
+	t.Run("Authorized IDs", func(t *testing.T) {
+		authorizer, err := serverAuthorizer(repoServerID, []string{serverID.String(), controllerID.String()})
+		require.NoError(t, err)
+		require.NoError(t, authorizer(serverID, nil))
+		require.NoError(t, authorizer(controllerID, nil))
+		require.NoError(t, authorizer(repoServerID, nil), "the server authorizes itself")
+		require.Error(t, authorizer(otherID, nil))
+		require.Error(t, authorizer(foreignID, nil))
+	})
+
+	t.Run("Invalid ID", func(t *testing.T) {
+		_, err := serverAuthorizer(repoServerID, []string{"argocd-server"})
+		assert.ErrorContains(t, err, `invalid authorized SPIFFE ID "argocd-server"`)
+	})
+}
+
+func TestClientAuthorizer(t *testing.T) {
+	authorizer, err := clientAuthorizer(repoServerID.String())
+	require.NoError(t, err)
+	require.NoError(t, authorizer(repoServerID, nil))
+	require.Error(t, authorizer(serverID, nil))
+
+	_, err = clientAuthorizer("")
+	require.Error(t, err)
+	_, err = clientAuthorizer("https://cluster.local/argocd-repo-server")
+	assert.ErrorContains(t, err, "invalid server SPIFFE ID")
+}
@@ -0,0 +1,187 @@
+package swagger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// openAPIVersion is the version of the OpenAPI documents generated from the Swagger 2.0 documents
+	openAPIVersion = "3.1.0"
+	// streamResultTitlePrefix prefixes the title of the responses of the streaming endpoints generated by grpc-gateway
+	streamResultTitlePrefix = "Stream result of "
+	// streamingExtension marks the operations whose response is a stream of newline-delimited JSON objects
+	streamingExtension = "x-streaming"
+)
+
+// parameterSchemaFields are the fields of the non-body Swagger 2.0 parameters which belong to their schema in OpenAPI 3
+var parameterSchemaFields = []string{"type", "format", "items", "enum", "default", "minimum", "maximum", "pattern"}
+
+// ConvertToOpenAPI3 converts the given Swagger 2.0 document of the API into an OpenAPI 3.1 document. The streaming
+// operations are marked with the x-streaming extension, since their responses are a stream of newline-delimited JSON
+// objects, each one containing either a result or an error.
+func ConvertToOpenAPI3(swaggerJSON []byte) ([]byte, error) {
+	var swagger map[string]any
+	if err := json.Unmarshal(swaggerJSON, &swagger); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal swagger document: %w", err)
+	}
+	if version, _ := swagger["swagger"].(string); version != "2.0" {
+		return nil, fmt.Errorf("unsupported swagger version %q", version)
+	}
+
+	paths := map[string]any{}
+	swaggerPaths, _ := swagger["paths"].(map[string]any)
+	for path, item := range swaggerPaths {
+		operations, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid path item %s", path)
+		}
+		converted := map[string]any{}
+		for method, operation := range operations {
+			op, ok := operation.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("invalid operation %s %s", method, path)
+			}
+			converted[method] = convertOperation(op)
+		}
+		paths[path] = converted
+	}
+
+	schemas := map[string]any{}
+	definitions, _ := swagger["definitions"].(map[string]any)
+	for name, definition := range definitions {
+		schemas[name] = convertRefs(definition)
+	}
+
+	openAPI := map[string]any{
+		"openapi": openAPIVersion,
+		"info":    swagger["info"],
+		"paths":   paths,
+		"components": map[string]any{
+			"schemas": schemas,
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{
+					"type":        "http",
+					"scheme":      "bearer",
+					"description": "The Argo CD token of the user or of the project role",
+				},
+			},
+		},
+		"security": []any{map[string]any{"bearerAuth": []any{}}},
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(openAPI); err != nil {
+		return nil, fmt.Errorf("failed to marshal OpenAPI document: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func convertOperation(op map[string]any) map[string]any {
+	converted := map[string]any{}
+	for key, value := range op {
+		switch key {
+		case "parameters", "responses", "consumes", "produces":
+		default:
+			converted[key] = value
+		}
+	}
+
+	var parameters []any
+	params, _ := op["parameters"].([]any)
+	for _, p := range params {
+		param, ok := p.(map[string]any)
+		if !ok {
+			continue
+		}
+		if param["in"] == "body" {
+			requestBody := map[string]any{
+				"content": map[string]any{"application/json": map[string]any{"schema": convertRefs(param["schema"])}},
+			}
+			if description, ok := param["description"]; ok {
+				requestBody["description"] = description
+			}
+			if required, ok := param["required"]; ok {
+				requestBody["required"] = required
+			}
+			converted["requestBody"] = requestBody
+			continue
+		}
+		parameters = append(parameters, convertParameter(param))
+	}
+	if len(parameters) > 0 {
+		converted["parameters"] = parameters
+	}
+
+	responses := map[string]any{}
+	swaggerResponses, _ := op["responses"].(map[string]any)
+	for code, r := range swaggerResponses {
+		response, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		convertedResponse := map[string]any{"description": response["description"]}
+		if schema, ok := response["schema"].(map[string]any); ok {
+			if title, _ := schema["title"].(string); strings.HasPrefix(title, streamResultTitlePrefix) {
+				converted[streamingExtension] = true
+			}
+			convertedResponse["content"] = map[string]any{"application/json": map[string]any{"schema": convertRefs(schema)}}
+		}
+		responses[code] = convertedResponse
+	}
+	converted["responses"] = responses
+	return converted
+}
+
+func convertParameter(param map[string]any) map[string]any {
+	converted := map[string]any{}
+	schema := map[string]any{}
+	for key, value := range param {
+		switch {
+		case key == "collectionFormat":
+			// query parameters are exploded by default, as the "multi" collection format of Swagger 2.0
+			if value == "csv" {
+				converted["explode"] = false
+			}
+		case slices.Contains(parameterSchemaFields, key):
+			schema[key] = convertRefs(value)
+		default:
+			converted[key] = value
+		}
+	}
+	converted["schema"] = schema
+	if converted["in"] == "path" {
+		converted["required"] = true
+	}
+	return converted
+}
+
+// convertRefs returns a copy of the given schema whose references to the Swagger 2.0 definitions are replaced with
+// references to the OpenAPI 3 component schemas
+func convertRefs(schema any) any {
+	switch s := schema.(type) {
+	case map[string]any:
+		converted := make(map[string]any, len(s))
+		for key, value := range s {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				converted[key] = strings.Replace(ref, "#/definitions/", "#/components/schemas/", 1)
+			} else {
+				converted[key] = convertRefs(value)
+			}
+		}
+		return converted
+	case []any:
+		converted := make([]any, len(s))
+		for i, value := range s {
+			converted[i] = convertRefs(value)
+		}
+		return converted
+	default:
+		return schema
+	}
+}
@@ -0,0 +1,44 @@
+package swagger
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/assets"
+)
+
+func TestConvertToOpenAPI3(t *testing.T) {
+	openAPIJSON, err := ConvertToOpenAPI3([]byte(assets.SwaggerJSON))
+	require.NoError(t, err)
+	assert.JSONEq(t, assets.OpenAPIJSON, string(openAPIJSON), "assets/openapi.json is out of date, run `make protogen`")
+	assert.NotContains(t, string(openAPIJSON), "#/definitions/")
+
+	var openAPI struct {
+		OpenAPI string `json:"openapi"`
+		Paths   map[string]map[string]struct {
+			Streaming   bool `json:"x-streaming"`
+			RequestBody *struct {
+				Content map[string]any `json:"content"`
+			} `json:"requestBody"`
+			Parameters []map[string]any `json:"parameters"`
+		} `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(openAPIJSON, &openAPI))
+	assert.Equal(t, "3.1.0", openAPI.OpenAPI)
+	assert.True(t, openAPI.Paths["/api/v1/stream/applications"]["get"].Streaming)
+	assert.False(t, openAPI.Paths["/api/v1/applications"]["get"].Streaming)
+	require.NotNil(t, openAPI.Paths["/api/v1/applications"]["post"].RequestBody)
+	assert.Contains(t, openAPI.Paths["/api/v1/applications"]["post"].RequestBody.Content, "application/json")
+	for _, param := range openAPI.Paths["/api/v1/applications"]["get"].Parameters {
+		assert.NotEqual(t, "body", param["in"])
+		assert.Contains(t, param, "schema")
+	}
+}
+
+func TestConvertToOpenAPI3_UnsupportedVersion(t *testing.T) {
+	_, err := ConvertToOpenAPI3([]byte(`{"openapi": "3.0.0"}`))
+	require.EqualError(t, err, `unsupported swagger version ""`)
+}
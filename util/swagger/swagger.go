@@ -11,6 +11,14 @@ import (
 // filename of ReDoc script in UI's assets/scripts path
 const redocScriptName = "redoc.standalone.js"
 
+// ServeOpenAPI serves the OpenAPI 3.1 spec next to the Swagger UI
+func ServeOpenAPI(mux *http.ServeMux, openAPIJSON string, uiPath string) {
+	mux.HandleFunc(path.Join(path.Dir(uiPath), "openapi.json"), func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, openAPIJSON)
+	})
+}
+
 // ServeSwaggerUI serves the Swagger UI and JSON spec.
 func ServeSwaggerUI(mux *http.ServeMux, swaggerJSON string, uiPath string, rootPath string) {
 	prefix := path.Dir(uiPath)
@@ -25,6 +25,7 @@ func TestSwaggerUI(t *testing.T) {
 
 		mux := http.NewServeMux()
 		ServeSwaggerUI(mux, assets.SwaggerJSON, "/swagger-ui", "")
+		ServeOpenAPI(mux, assets.OpenAPIJSON, "/swagger-ui")
 		panic(http.Serve(listener, mux))
 	}
 
@@ -47,4 +48,9 @@ func TestSwaggerUI(t *testing.T) {
 	resp, err := http.Get(server + "/swagger-ui")
 	require.NoError(t, err)
 	require.Equalf(t, http.StatusOK, resp.StatusCode, "Was expecting status code 200 from swagger-ui, but got %d instead", resp.StatusCode)
+
+	resp, err = http.Get(server + "/openapi.json")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
 }
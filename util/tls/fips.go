@@ -0,0 +1,107 @@
+package tls
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/fips140"
+	"crypto/rsa"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+const (
+	// EnvFIPSRequired is the environment variable requiring the FIPS 140-3 mode. The components refuse to start when
+	// the mode is not enabled, and refuse the TLS configurations which are not compliant.
+	EnvFIPSRequired = "ARGOCD_FIPS_REQUIRED"
+	// fipsMinRSABits is the minimum size of the RSA keys approved by FIPS 186-5
+	fipsMinRSABits = 2048
+)
+
+// fipsCipherSuites are the FIPS approved TLS 1.2 cipher suites. The TLS 1.3 cipher suites are not configurable and are
+// restricted by the Go runtime in FIPS 140-3 mode.
+var fipsCipherSuites = []uint16{
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+}
+
+// FIPSRequired returns true if the FIPS 140-3 mode is required
+func FIPSRequired() bool {
+	return env.ParseBoolFromEnv(EnvFIPSRequired, false)
+}
+
+// ValidateFIPSMode returns an error if the FIPS 140-3 mode is required but the Go cryptographic module does not run in
+// FIPS 140-3 mode, i.e. the binary was neither built with GOFIPS140 nor started with GODEBUG=fips140=on.
+func ValidateFIPSMode() error {
+	if FIPSRequired() && !fips140.Enabled() {
+		return fmt.Errorf("%s is set but FIPS 140-3 mode is not enabled: build with GOFIPS140=latest or run with GODEBUG=fips140=on", EnvFIPSRequired)
+	}
+	return nil
+}
+
+// EnforceFIPS validates the given TLS config with ValidateFIPSConfig if the FIPS 140-3 mode is required
+func EnforceFIPS(config *tls.Config) error {
+	if !FIPSRequired() {
+		return nil
+	}
+	return ValidateFIPSConfig(config)
+}
+
+// ValidateFIPSConfig returns an error if the TLS config allows TLS versions, cipher suites or certificate keys which
+// are not FIPS approved. The default cipher suites are accepted since they are restricted by the Go runtime in FIPS
+// 140-3 mode.
+func ValidateFIPSConfig(config *tls.Config) error {
+	if config == nil {
+		return nil
+	}
+	if config.MinVersion != 0 && config.MinVersion < tls.VersionTLS12 {
+		return fmt.Errorf("TLS version %s is not FIPS approved, the minimum TLS version must be 1.2", tls.VersionName(config.MinVersion))
+	}
+	for _, suite := range config.CipherSuites {
+		if !slices.Contains(fipsCipherSuites, suite) {
+			return fmt.Errorf("cipher suite %s is not FIPS approved", tls.CipherSuiteName(suite))
+		}
+	}
+	for i := range config.Certificates {
+		if err := ValidateFIPSCertificate(&config.Certificates[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateFIPSCertificate returns an error if the key of the certificate is not FIPS approved
+func ValidateFIPSCertificate(cert *tls.Certificate) error {
+	if cert == nil {
+		return nil
+	}
+	return validateFIPSKey(cert.PrivateKey)
+}
+
+func validateFIPSKey(key crypto.PrivateKey) error {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if bits := k.N.BitLen(); bits < fipsMinRSABits {
+			return fmt.Errorf("RSA key size %d is not FIPS approved, the minimum key size is %d", bits, fipsMinRSABits)
+		}
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256(), elliptic.P384(), elliptic.P521():
+		default:
+			return fmt.Errorf("elliptic curve %s is not FIPS approved", k.Curve.Params().Name)
+		}
+	case ed25519.PrivateKey:
+	case nil:
+		return errors.New("certificate has no private key")
+	default:
+		return fmt.Errorf("private key type %T is not FIPS approved", key)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package tls
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateFIPSConfig(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	weakRSAKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	weakECDSAKey, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	t.Run("Compliant", func(t *testing.T) {
+		require.NoError(t, ValidateFIPSConfig(nil))
+		require.NoError(t, ValidateFIPSConfig(&tls.Config{}))
+		require.NoError(t, ValidateFIPSConfig(&tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			CipherSuites: []uint16{tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384, tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256},
+			Certificates: []tls.Certificate{{PrivateKey: rsaKey}, {PrivateKey: ecdsaKey}, {PrivateKey: ed25519Key}},
+		}))
+	})
+
+	for name, test := range map[string]struct {
+		config *tls.Config
+		err    string
+	}{
+		"TLS version": {
+			config: &tls.Config{MinVersion: tls.VersionTLS11},
+			err:    "TLS version TLS 1.1 is not FIPS approved, the minimum TLS version must be 1.2",
+		},
+		"Cipher suite": {
+			config: &tls.Config{CipherSuites: []uint16{tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256}},
+			err:    "cipher suite TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256 is not FIPS approved",
+		},
+		"RSA key size": {
+			config: &tls.Config{Certificates: []tls.Certificate{{PrivateKey: weakRSAKey}}},
+			err:    "RSA key size 1024 is not FIPS approved, the minimum key size is 2048",
+		},
+		"Elliptic curve": {
+			config: &tls.Config{Certificates: []tls.Certificate{{PrivateKey: weakECDSAKey}}},
+			err:    "elliptic curve P-224 is not FIPS approved",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.EqualError(t, ValidateFIPSConfig(test.config), test.err)
+		})
+	}
+}
+
+func TestEnforceFIPS(t *testing.T) {
+	config := &tls.Config{MinVersion: tls.VersionTLS10}
+
+	t.Setenv(EnvFIPSRequired, "false")
+	require.NoError(t, EnforceFIPS(config))
+	customizer, err := getTLSConfigCustomizer("1.0", DefaultTLSMaxVersion, "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256")
+	require.NoError(t, err)
+	assert.NotNil(t, customizer)
+
+	t.Setenv(EnvFIPSRequired, "true")
+	require.Error(t, EnforceFIPS(config))
+	_, err = getTLSConfigCustomizer("1.0", DefaultTLSMaxVersion, DefaultTLSCipherSuite)
+	require.ErrorContains(t, err, "TLS configuration is not FIPS compliant")
+	_, err = getTLSConfigCustomizer(DefaultTLSMinVersion, DefaultTLSMaxVersion, "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256")
+	require.ErrorContains(t, err, "TLS configuration is not FIPS compliant")
+	_, err = getTLSConfigCustomizer(DefaultTLSMinVersion, DefaultTLSMaxVersion, DefaultTLSCipherSuite)
+	require.NoError(t, err)
+}
@@ -0,0 +1,195 @@
+package tls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	log "github.com/sirupsen/logrus"
+)
+
+// reloadDelay is the delay after the last change of the watched files before they are reloaded, so that the files
+// being rotated are reloaded once
+var reloadDelay = time.Second
+
+// WatchFiles calls onChange when one of the given files changes, until the context is done. The directories of the
+// files are watched, since the files of the Kubernetes secret volumes are replaced by swapping a symbolic link.
+func WatchFiles(ctx context.Context, onChange func(), paths ...string) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create fsnotify Watcher: %w", err)
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.Errorf("Error closing watcher: %v", err)
+		}
+	}()
+	watched := map[string]bool{}
+	for _, path := range paths {
+		dir := filepath.Dir(path)
+		if watched[dir] {
+			continue
+		}
+		// the optional files are not mounted
+		if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err := watcher.Add(dir); err != nil {
+			return fmt.Errorf("failed to watch %s: %w", dir, err)
+		}
+		watched[dir] = true
+	}
+	if len(watched) == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(reloadDelay)
+	timer.Stop()
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			timer.Reset(reloadDelay)
+		case <-timer.C:
+			onChange()
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Errorf("Error watching %s: %v", strings.Join(paths, ", "), err)
+		}
+	}
+}
+
+// Reloader holds a value loaded from files, e.g. a certificate, and reloads it when the files change, so that the
+// certificates rotated by e.g. cert-manager are used without restart
+type Reloader[T any] struct {
+	paths []string
+	load  func() (T, error)
+	lock  sync.RWMutex
+	value T
+}
+
+// NewReloader returns a reloader of the value loaded from the given files by the load function
+func NewReloader[T any](load func() (T, error), paths ...string) (*Reloader[T], error) {
+	r := &Reloader[T]{paths: paths, load: load}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Get returns the last loaded value
+func (r *Reloader[T]) Get() T {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.value
+}
+
+// Reload loads the value from the files. The previous value is kept on error, e.g. when the new files are invalid.
+func (r *Reloader[T]) Reload() error {
+	value, err := r.load()
+	if err != nil {
+		return err
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.value = value
+	return nil
+}
+
+// Watch reloads the value when the files change, until the context is done
+func (r *Reloader[T]) Watch(ctx context.Context) error {
+	return WatchFiles(ctx, func() {
+		if err := r.Reload(); err != nil {
+			log.Errorf("Failed to reload %s, keeping the previous version: %v", strings.Join(r.paths, ", "), err)
+			return
+		}
+		log.Infof("Reloaded %s", strings.Join(r.paths, ", "))
+	}, r.paths...)
+}
+
+// StartWatch starts watching the files in the background, until the context is done
+func (r *Reloader[T]) StartWatch(ctx context.Context) {
+	go func() {
+		if err := r.Watch(ctx); err != nil {
+			log.Errorf("Failed to watch %s, the changes will not be reloaded: %v", strings.Join(r.paths, ", "), err)
+		}
+	}()
+}
+
+// NewCertificateReloader returns a reloader of the key pair of the given files
+func NewCertificateReloader(certPath, keyPath string) (*Reloader[*tls.Certificate], error) {
+	return NewReloader(func() (*tls.Certificate, error) {
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load TLS key pair from cert=%s and key=%s: %w", certPath, keyPath, err)
+		}
+		if FIPSRequired() {
+			if err := ValidateFIPSCertificate(&cert); err != nil {
+				return nil, fmt.Errorf("TLS key pair from cert=%s and key=%s is not FIPS compliant: %w", certPath, keyPath, err)
+			}
+		}
+		return &cert, nil
+	}, certPath, keyPath)
+}
+
+// NewCertPoolReloader returns a reloader of the cert pool of the given files, see LoadX509CertPool
+func NewCertPoolReloader(paths ...string) (*Reloader[*x509.CertPool], error) {
+	return NewReloader(func() (*x509.CertPool, error) {
+		return LoadX509CertPool(paths...)
+	}, paths...)
+}
+
+// NewCertReloader returns a reloader of the certificate of the given file, see LoadX509Cert
+func NewCertReloader(path string) (*Reloader[*x509.Certificate], error) {
+	return NewReloader(func() (*x509.Certificate, error) {
+		return LoadX509Cert(path)
+	}, path)
+}
+
+// CreateReloadingServerTLSConfig is like CreateServerTLSConfig, but the certificate is reloaded when the files change
+// until the context is done. A self-signed certificate is generated if the files do not exist at startup.
+func CreateReloadingServerTLSConfig(ctx context.Context, tlsCertPath, tlsKeyPath string, hosts []string) (*tls.Config, error) {
+	if !fileExists(tlsCertPath) || !fileExists(tlsKeyPath) {
+		return CreateServerTLSConfig(tlsCertPath, tlsKeyPath, hosts)
+	}
+	log.Infof("Loading TLS configuration from cert=%s and key=%s, reloaded on change", tlsCertPath, tlsKeyPath)
+	reloader, err := NewCertificateReloader(tlsCertPath, tlsKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	reloader.StartWatch(ctx)
+	return &tls.Config{
+		GetCertificate: func(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return reloader.Get(), nil
+		},
+	}, nil
+}
+
+func fileExists(path string) bool {
+	if path == "" {
+		return false
+	}
+	_, err := os.Stat(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Warnf("could not read TLS cert from %s: %v", path, err)
+	}
+	return err == nil
+}
@@ -0,0 +1,91 @@
+package tls
+
+import (
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeKeyPair(t *testing.T, dir string, organization string) {
+	t.Helper()
+	cert, key, err := generatePEM(CertOptions{Hosts: []string{"localhost"}, Organization: organization})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tls.crt"), cert, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tls.key"), key, 0o600))
+}
+
+func organization(t *testing.T, cert *tls.Certificate) string {
+	t.Helper()
+	// the leaf is parsed by tls.LoadX509KeyPair
+	require.NotNil(t, cert.Leaf)
+	return cert.Leaf.Subject.Organization[0]
+}
+
+func TestCertificateReloader(t *testing.T) {
+	previousDelay := reloadDelay
+	reloadDelay = 10 * time.Millisecond
+	t.Cleanup(func() { reloadDelay = previousDelay })
+
+	dir := t.TempDir()
+	certPath, keyPath := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+
+	_, err := NewCertificateReloader(certPath, keyPath)
+	require.Error(t, err, "the key pair must exist at startup")
+
+	writeKeyPair(t, dir, "Initial")
+	reloader, err := NewCertificateReloader(certPath, keyPath)
+	require.NoError(t, err)
+	assert.Equal(t, "Initial", organization(t, reloader.Get()))
+	reloader.StartWatch(t.Context())
+
+	writeKeyPair(t, dir, "Rotated")
+	assert.Eventually(t, func() bool {
+		return organization(t, reloader.Get()) == "Rotated"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// an invalid key pair is not loaded
+	require.NoError(t, os.WriteFile(keyPath, []byte("invalid"), 0o600))
+	require.Error(t, reloader.Reload())
+	assert.Equal(t, "Rotated", organization(t, reloader.Get()))
+}
+
+func TestCertPoolReloader(t *testing.T) {
+	dir := t.TempDir()
+	writeKeyPair(t, dir, "Initial")
+	caPath := filepath.Join(dir, "tls.crt")
+
+	reloader, err := NewCertPoolReloader(caPath, filepath.Join(dir, "ca.crt"))
+	require.NoError(t, err)
+	initial := reloader.Get()
+	require.NotNil(t, initial)
+
+	writeKeyPair(t, dir, "Rotated")
+	require.NoError(t, reloader.Reload())
+	assert.False(t, initial.Equal(reloader.Get()))
+}
+
+func TestCreateReloadingServerTLSConfig(t *testing.T) {
+	t.Run("Self-signed certificate", func(t *testing.T) {
+		dir := t.TempDir()
+		config, err := CreateReloadingServerTLSConfig(t.Context(), filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), []string{"localhost"})
+		require.NoError(t, err)
+		assert.Len(t, config.Certificates, 1)
+		assert.Nil(t, config.GetCertificate)
+	})
+
+	t.Run("Reloaded certificate", func(t *testing.T) {
+		dir := t.TempDir()
+		writeKeyPair(t, dir, "Initial")
+		config, err := CreateReloadingServerTLSConfig(t.Context(), filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), []string{"localhost"})
+		require.NoError(t, err)
+		assert.Empty(t, config.Certificates)
+		cert, err := config.GetCertificate(&tls.ClientHelloInfo{})
+		require.NoError(t, err)
+		assert.Equal(t, "Initial", organization(t, cert))
+	})
+}
@@ -155,6 +155,9 @@ func getTLSConfigCustomizer(minVersionStr, maxVersionStr, tlsCiphersStr string)
 	} else {
 		cipherSuites = make([]uint16, 0)
 	}
+	if err := EnforceFIPS(&tls.Config{MinVersion: minVersion, CipherSuites: cipherSuites}); err != nil {
+		return nil, fmt.Errorf("TLS configuration is not FIPS compliant: %w", err)
+	}
 
 	return func(config *tls.Config) {
 		config.MinVersion = minVersion
@@ -163,6 +166,15 @@ func getTLSConfigCustomizer(minVersionStr, maxVersionStr, tlsCiphersStr string)
 	}, nil
 }
 
+// NewConfigCustomizer returns a TLS config customizer enforcing the given minimum and maximum TLS versions and the
+// given colon separated list of cipher suites
+func NewConfigCustomizer(minVersionStr, maxVersionStr, tlsCiphersStr string) (ConfigCustomizer, error) {
+	if tlsCiphersStr == "list" {
+		return nil, errors.New("the cipher suites can only be listed with the --tlsciphers flag")
+	}
+	return getTLSConfigCustomizer(minVersionStr, maxVersionStr, tlsCiphersStr)
+}
+
 // Adds TLS server related command line options to a command and returns a TLS
 // config customizer object, set up to the options specified
 func AddTLSFlagsToCmd(cmd *cobra.Command) func() (ConfigCustomizer, error) {
@@ -439,5 +451,9 @@ func CreateServerTLSConfig(tlsCertPath, tlsKeyPath string, hosts []string) (*tls
 		cert = &c
 	}
 
-	return &tls.Config{Certificates: []tls.Certificate{*cert}}, nil
+	config := &tls.Config{Certificates: []tls.Certificate{*cert}}
+	if err := EnforceFIPS(config); err != nil {
+		return nil, fmt.Errorf("TLS configuration with cert=%s and key=%s is not FIPS compliant: %w", tlsCertPath, tlsKeyPath, err)
+	}
+	return config, nil
 }
@@ -70,9 +70,12 @@ type ArgoCDWebhookHandler struct {
 	settingsSrc            settingsSource
 	queue                  chan any
 	maxWebhookPayloadSizeB int64
+	// refreshHints makes the handler request the refreshes of the applications to the application controller
+	// shards via Redis instead of annotating the applications
+	refreshHints bool
 }
 
-func NewHandler(namespace string, applicationNamespaces []string, webhookParallelism int, appClientset appclientset.Interface, set *settings.ArgoCDSettings, settingsSrc settingsSource, repoCache *cache.Cache, serverCache *servercache.Cache, argoDB db.ArgoDB, maxWebhookPayloadSizeB int64) *ArgoCDWebhookHandler {
+func NewHandler(namespace string, applicationNamespaces []string, webhookParallelism int, appClientset appclientset.Interface, set *settings.ArgoCDSettings, settingsSrc settingsSource, repoCache *cache.Cache, serverCache *servercache.Cache, argoDB db.ArgoDB, maxWebhookPayloadSizeB int64, refreshHints bool) *ArgoCDWebhookHandler {
 	githubWebhook, err := github.New(github.Options.Secret(set.WebhookGitHubSecret))
 	if err != nil {
 		log.Warnf("Unable to init the GitHub webhook")
@@ -115,6 +118,7 @@ func NewHandler(namespace string, applicationNamespaces []string, webhookParalle
 		db:                     argoDB,
 		queue:                  make(chan any, payloadQueueSize),
 		maxWebhookPayloadSizeB: maxWebhookPayloadSizeB,
+		refreshHints:           refreshHints,
 	}
 
 	acdWebhook.startWorkerPool(webhookParallelism)
@@ -345,6 +349,8 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload any) {
 		}
 	}
 
+	var refreshApps []*v1alpha1.Application
+	refreshAppNames := map[string]bool{}
 	for _, webURL := range webURLs {
 		repoRegexp, err := GetWebURLRegex(webURL)
 		if err != nil {
@@ -372,6 +378,13 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload any) {
 				if sourceRevisionHasChanged(source, revision, touchedHead) && sourceUsesURL(source, webURL, repoRegexp) {
 					refreshPaths := path.GetAppRefreshPaths(&app)
 					if path.AppFilesHaveChanged(refreshPaths, changedFiles) {
+						if a.refreshHints {
+							if !refreshAppNames[app.QualifiedName()] {
+								refreshAppNames[app.QualifiedName()] = true
+								refreshApps = append(refreshApps, &app)
+							}
+							break
+						}
 						namespacedAppInterface := a.appClientset.ArgoprojV1alpha1().Applications(app.Namespace)
 						_, err = argo.RefreshApp(namespacedAppInterface, app.Name, v1alpha1.RefreshTypeNormal, true)
 						if err != nil {
@@ -389,6 +402,31 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload any) {
 			}
 		}
 	}
+	a.requestAppsRefresh(refreshApps)
+}
+
+// requestAppsRefresh requests the refresh of the given applications to the application controller shards with a single
+// Redis message, and falls back to annotating the applications if the message cannot be published
+func (a *ArgoCDWebhookHandler) requestAppsRefresh(apps []*v1alpha1.Application) {
+	if len(apps) == 0 {
+		return
+	}
+	appNames := make([]string, len(apps))
+	for i, app := range apps {
+		appNames[i] = app.QualifiedName()
+	}
+	err := a.serverCache.RequestAppsRefresh(appNames)
+	if err == nil {
+		log.Infof("Requested the refresh of %d apps to the application controller", len(apps))
+		return
+	}
+	log.Warnf("Failed to request the refresh of apps to the application controller, annotating them instead: %v", err)
+	for _, app := range apps {
+		namespacedAppInterface := a.appClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+		if _, err := argo.RefreshApp(namespacedAppInterface, app.Name, v1alpha1.RefreshTypeNormal, true); err != nil {
+			log.Warnf("Failed to refresh app '%s' for controller reprocessing: %v", app.Name, err)
+		}
+	}
 }
 
 // GetWebURLRegex compiles a regex that will match any targetRevision referring to the same repo as